@@ -13,67 +13,67 @@ func init() {
 	var bs []byte
 	var gr *gzip.Reader
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffbcbd797fdb36b32ffebf5e85cce3ab92112cdb699f8d34a3e338ced6d84963275d64b53f70d152add1e2c4b1f4de7ff31d2c0425397d9e73cfe776b1481004406030987d0e1f55aaa7e3ee722867afafaab7c78dc78d7f54aa7e1a541f1f1d1f1dd09f1faa2f2693ee3017d557e3b451ed2d16d3f0f050aa77fe9c3726b36ea5faa69fe6e3791e562f5e5d571e1d56fcce729c2efa93b1ff9bf849cc82fbef96f3bc3a5fccfae9e2bbc83cac3ef793e07e962f96b371d5be11dcdfca5955c672d65d8ef2f162de3a6a8b54c8d86b79753f6926752fa47fbda02eeb5edb0c289fcd26b379a334ae43fe9c43f3d6a17e2bea4c667e1a1f47e989eda331ccc7dd452f4aebf580baaefbc7719c36bd26bd52a377bc2935921e1cd355ecd5f3713ac9f20fef5f9d4d46d3c998def73d337c2f8e1777d37cd2a916e34fdb4df7a6b1985cd14c8cbb7ed098e5d3a14c73ffb07a73dfba99df5cb51fed1f0a1a66e82dc759dee98ff3ec81269d0aa137e706bdbd9d355f5fbdbd6ca81afdce9def3e0bc2d25da417e31cb3e9cb60bdb66bf529c162f53bfe78391cc671b25a7d955412a837f68e23b56a95c44ca56e8a26324e1a639ab06b1a5aad269b7b47e1337a73b5fa91ff1e5105b95a79e3e528c967f4b1f61b6ab5a313fa230f8eabfd713571c6e227428a54414a16d1a09280febcc178b0b819d70fbce96cb298a0356a355bad7c4f0d4ddd796339d20f92464fcedf7e1ebf9b4da6f96c7157abed6d16f9594063958d540e877e2a9256d6165910e543826bf4dfa07ecf65daabd5ece55e1c7f0aec9d8f01dbfa3c9b6aacf151949dd859cb00803b7b713e6cc7e0689a36dfd20be0ccdb5b5e43b5502dda546a273cd066ca6d4e97f31e5d9ac664633e992dfcc06933a5c5a8f06aa0355e91983b12397d5a7e92994fcb373ead95b7699cf4d7b69e15cdbe4b76e106ee26a1d74ba0f9abf48bce937820758f42464944f50f0e2249a5ada4dd487b727646b078baf08f0200cedffe41d0a781b8ca7562efd413d4c69f93fed8a79d885aff3a422dfdf8c8530b527a49ede8466736199de93e7c593f0edc96d674bd1ccf7bfd0e218c23cf7eb65bc57ed44f09803cb897cda4b1bf4f6bd3fb31bf8b6598e5c37c91579dc2e29d45b1bccef3e853b1e5453195d434416852abd163b131c3840b62b90ed641a487b10398ae9c059acad93c7f45789040e1f8c8f98af7fa2b74bd853fce3f57fd85ef60fbb5b8b7fb344cd64180c5b50d7c460d7b772a9d4e9db1ecef3e486cc5a2e6d7a26609c31aa4e334fa7467d5bd5d557f71fa077edcc3bc7a93e4cf3c5dec6efc99d3b846de3bebcd9c7db08d229d8aaf9da9f15aaaebea33b9c8dba8ff9bde788933b53fee78e1743693770fbef1c679c339f08ad1548a15d9359cf779f7fccbf4c1e6bf96561798349ba40cb7b81e4e52896ab896434251b898e70b02bb7c762b870eee488b76f6fc3d3aaaf67c75045d12ca07ba57add082125677b65c5a3a5708454638692c4025a22b3ac17da6906281cab8189b651b8d490dff7c445077f997b71d836d6c01edc5c8a0af9470665a1c07a047e85d89431407b47e35d55d1d1c3bcb295557aa15dd71747412a73c51d321d16834dae3029717ef76a41d262f02cd115edacf695e4fe777636e62ffb35ca4bd60d19b4d3e57df48df4ba79fe70a3fea2fa4515664a9425f3dffd19c767a70faf3b6bf551f38180f3e571d7df778a384cedc35a1e5d23851d22beb622af56129e9388cb9c1accdf80c2bbc5ea3619ad7844e391e5e537225d1229a88f712150059610bd1da75f3c5757f94fb4110eeeba70a9ae9d97cb29ca57910020b506bbaa17b4265bbe6faca2076503df7eb62e5bf710efbde3e104fcae7179f5d845eca45c701f70dcc9db8a49cd3f3b258652c9539f6f68e224bd681aed357b220edf0823a2b706438efa9e534db5f5498164b5d0aae40826960000103d8fb9180a6d403bd674021882d88a8835d11492953477879a99653606d9d56ccb8d4eaa29bd70e8d0a14290340b25d4cf4636f30085edb5a6d5f16a3a37d5a10ec78a1b8e3897968a310b50802ca7d24ed23bdcbbea21f10c2ee5ca4b1860a0dc0d4895aebcc5dfebd370aa2d56c3e302129609fe6c3b62679fad25df4e38e3ed01a95cdb8b787e6da9438c8ff3a290edf9f0847a7cb597f71f76e42288868ebcd92467f7e4aefdd1266fea9f16999cfeeae683fa78b09faf5f736ca7caf35ee1ea4733a46300bdb4f33b99007b68a83de93c445908fb798bfe6ad46e905b5f43808e910d05ff886d74ad234ce17729c02c0150a68ca30356d6c531f5bddc8869c4e8777405d8d7432a653cddfeef9889047e8d40cd6e17fd4b47d8456cc49ebd06569e9b08876d0200c11ccbc598868a6f12c6498a52b6fffe75797cfdefeec851502f49f8031b8f4d9dbb30f17e797d75e288190766e00a02ad4bd3a7bfbeebc2084d3628053e9128ebb49b5e62cdc606d13f19e7883a657ad7a21d098b3fa1f5c8602d497628b9972a5bbd021a52e980ed949e13413da4e21cdcd11f0a1997642a75f887aaf13cf43cf7dafe33122251a5ffd7624a1237d3d9e980bfddb023d44482aa4578f77d0d95d1e4d129fd24f231d4ec640558bd9dd7dd2c84753dabcc13ac59ce2105eabf53cf5bd93ac7ffbc40b000ff938c3abbdc568a8dfd49d7ccf54c551db72e7cd2f74da102c8fb8bdc3dffd93d6ef4fdaf527c161d03a6e17c28adf4ffcd6cde79b83763d38141587772848be13affe0520670697d9b9471f0e247ee475714695e55bd295c4fdc4e2d59f1d7696d8885464441bf89853fa70103cc466d5bcc02116681e19f212f338a6c7594c6348691e02f19431212de7532a386e074d7e4217e1de11e3d6e68f0a0f36f157d12a043b4c62b4f057246d7597b804a17306ff5262c137e94b9a25a645cac5442ba8ae3e4b3f137b4741dddf83d424697a5e0881d4676c963da08c35b6fbbf5db7e0ea2dfe508c680d0228af18f5dcdd3cdc42859a2800e2ff3cfefb61b72ff09e53f8fd332e8c4b858f9f7261dde5753f97367b75877c2d719bf8e1889bf8ef7267a75c18963bfbe1b02b088d95caceb8a228175293401c74414d940677a551a52d489977aed50c1f10ac35bb90b4450e5e206e79e36e483b8f3aa183485d7c39b097e684c25d5bf4e2c39bf9b8db0a693f51891fdecc1ff1f6cafea0fd153583e6cdfc9000a553e2dd3b2d89933d4afd9f40c19c0f7360fba777af32d012111197f6ebe88384777343331325e5d3f2743824682760db2af6bd865797019d3be2c1c7155957cd3e5cabe5b19096ab00de3e114cba5f01622457db21897b8dfc4b9efa5e955e224427e773b06b75baa78137fd3c96a21b1399f3b8ad76b85dbd9b791dcb4c2b1a84c41c34e4820e8464b9c8e7a54db497d76a9d163182d46a9bbe5b359834e8785ae6c054344008287d5ac466abdba6a3df01835f4bd48373162bcc1c690eef4f458604a622f02b6dbf9f9a9ee165bd90317a54b049c9824eb069e64140b866b6803043212a6a79fbd3d9e4b69fe55e597ca3464ec4e16c323110e0110e5e13f5ef489ae8580f88a8fc2d019f99d2186f27831cade2b5ab9448409aba721bde7e4a5baf3fe427e6a3702dc77d3a1ca8b884f5817a458e11ed2bfac39d9e06c0dd779bc968183403d8393c567bfa132a3efcfdf2c51fcfce9f9fbfffe3e9dbb7d757d7ef4fdfed1d62767f236a2f6bd0a22efcdf780d2d819ed2b1a68a62f563412383283dbac45e98d3e43fa56fa4a996d3d8858b07902d0e2f1aa77b56a52eebe6fde1d9d3da76789d6ea07355c3cf884684f201bcc49bc9e77c762689ecc0f717c2bbc4481fb02b12878d96b3fc9327d0659360847ee87ed99f1131b453c82b4d3b4470fda858d284e0d0502b07749c45d4198439548f089245555aa999a876e91e2a139777b3e44f2501c94a73b8c452f264d378deaa7aa1a916ec1ad79782fbf4caec075399cef7263263d93ce4170ef75a9a1f2bb34d18d7e9e3bce139ecbfc8e38436b89506778889ec9c74a30e3191592c5b1d3aad796e081324014b0734a54f1387d96912c7908b326d78eb9cdfd8dbd1c6d446465a63c6778a5da77001a1ec289b007a1ae3fccbe2aa9f0c896e8dd4e724b35c0e687b9a33e5738fb69fcf225a3b9ba7209d0a8a362d86f2dcdd60846d51c0fb9e86679462ab956faf895ec269b0bf3fea8fcf67b3b8b85cad9e17603d9a64cb21ad70c537d7f1869e2e01b3ba2979cd95a06cf74ae77aa55367a13dd5388db70b067a833bcd19845b793b669ade0e8f4af04d78e08caae0f70835315ada160ca72d802da6da6bfb2d54b3fc52818fc6eb3596a6abc74b80399ed0383d6ad22c685b404a2886715242709ec2b1b410e3f8fe0f75f3d3325fe6612afe982dc74f879374300f33a137f23cec0a4c44980b8de967215ab468df33c5a066253ab9dba8a04be9f93c9fddf6d37ce3b92ea5e77c686c3ce532c0cd8479db45f971c533e554551f2b54599d0534a15c5b9f0cefcc400561a96e7fbe5063ee0f17fa9bd4e5ee6ad4cd6236190e75d5e27677f58ca68e0507ba369f584e55fb5c35dde977c3a1a0e90fdd53d4527166d917bdfe7cbd8e3ab5dad0ef38c04067fa9aff1472e692bcda9e011f53513a1c5d18cc9b74844d3e1053a64f80305b1794cccfa9f02e265ff78f4bc4b1c67b384d6c616eb67ecee2dd660ba36ea8b9a54744bba0a02d4644e30cc4508cc554ccc519e39a4c89f8f608b1005746b911c0f2ed20ce1b8a70084465181f11080f4c85e1c9381a12f644bd29913d83d69098a65173da2082abdbcd672fe538a3e5a2d5c8e89c9e4d081ec351bc3712736a681afb67f1b4911262cb66396dc3c0b43b3f9946736a37576bf154fa67ad79bb3845ba5aac816f72241b4c7777e3a7b2d11983f8eec6ddc6fe8446d21fcbe16ad5a52fb3b7713732f5e2dc392cb5523b71a53a6f8bb305f3c5dd969ff33bcff86c254e77cf9591686cf12501ba98e7c3e28c863419fdadf5bb0677ffd44809f72f724d7df904b7b71e28b8fe784c537a7df12656ec7cedbf8eff7e149d1c326b5fa7838f006734b9cdcf30a344f776fab3f9826f82e82ed1b3a5e6fb9218aa79109dfaa6b3679a1a7d3e935dee35b0b202bc17b004b56a5aa994d4344909ec592481f689d1746a9df1e6d8475d6d1a70c44773c2936586a448dd420720090af0261d954e5b322db6004d1eb874679a279d0ec10434116a430c416878f92d60840ad56d8f21132243ff2be4579fe8902a2b3d4fb1cfa0598eb43a2167750273149abca8bac204c21d5fe93c6efaa76a83e618b3b0efd24df823f11274818f8404cfc51dfb257622696509ce929f20bf8f32c8cd9aba252e621a9a5a0afdaca1be84aa640df58d0db3d9c092aaa7fefd5a387b506002033b386e52709ff1cc1dd45096156015675c84353479f394be3ac8f8e4450b595cafff92ea4a6d10162203739a96b4e4f44d2875a4b11bca36b54cf84e0f9603e884363478b6a734d544bc1165b0f70bf03475dd59ad9cfad437c8191a5a1eb85d53419701c6aadcf428a205710652c3f8b6d2ee695969cdfa8253c353360f8e4f9847f5cbe5bec72cab173073ca5c6bc1a1b66ec6378b369854141bb51f73ba5c33dc7314796786cbd00a4edb05abea1f82c57255331a21a5ff3f1f6da554cec385f017d5f94b821203f3acd0756e0ddd00faffddcc45dff87ea2aa31b6e8000647e98e3986e0af1eab6b267e1f9a2f882a8aafba73be2a50b4bb5524ef11681a3d9556e634b14be5863a57ba2ace44131c50d2b9bc655a9681a15b6f9f268b6da3ba679616c214b9774513b9e590b44ce25f1bf25dc509b2de9b28f3c5880e27c52fc9182a28e251b1392c32762d7a2a47b429ed776427b9d1c5b13495597ca52082bea85053a3bb29f1af38609c8fcd987531add3d120d2d2b1404742ba7124a4c0a83cd828714eba28484aa7a0fbcc9d9a92bea343e74b99116f5b2144add6495b85b540bb5673b412bfa63b854029283c62dd668c909fe51db91c2e08416e16b96c4ada503d7e04e91dd3fe876c66be984cc1f6c82e13d6dcc44659b98d1424c9f0e932498835db3be24616741ae6aa7b7549db613e4b3579b15afdc4a892ceae46a686f54e0d32cf3449d28d37071e55befd299b0d4132d9d5f611c13ada55e1784d9b74fe6cb37c5bc9b5fd326d3cb5c99d195cf3e1df89af88eb037347df4e00cf476fb0253c351a313e94a37f3e892f9adb6b051a596c4fbf2ede3174664ee9845687ec667bc2966fb4583cd86e93e78e1e8fe264878eec992c040056079f4685d886d0b0a2f421fedfb250e59d6bcd2aa0d2736e89334961ce31d3fa92c2040dd6767868472461905b77c6f55e1ab11a7325d3e542289ab2d06915a20b0c78db783669fa6cc3a6786a42ae3e5b2c1ad4a407b5c390f6b714223f4227468bb5cfc2692871f4c921b30d8d907d37c94a7625a036b52c30c3414704941d512c89ba8388c8b15a3838166f95dd0ab1e363505dd033b1d9cd11e4df214be6f008fa971dbaa1df98b02ea85e5f6ecb2e122d0083ad4980297e97b0c0df2565a482ecb5abb1806aee0bb5e84802226d32ab0c7183241e371493b3e8b3cd4b10555812d9d8271ca2e9ed2be97b53baf51c9bc0614bd67bedd8119265ae2a907bbf471bb0ee7350b22f8d0809e431b1c95dd12b1bf7680dc100e402bef27e0060a21a983fa80c999392cab4288daf316382190ca556cf7de008256221fe4697a68d420603ed1fdd3b221a223a8f442fb656abdd13fa9f8ea07b855fb256175cf59887d481d2301ab53a045d6dcda38e44a7f5b8ad694b980c34f5813f3692771a6ff8e3430fdef21a197198567b0e837b35fd3fb2010bed0562061c71ae183646f97c2ebb38e1878460643aa8d54009e91b4b0ed98a6c2d348ced7dddbb197b755d9d387f2cb41ac56c468b2df413a2bbcd1b741944ebf57a97acb7ab00c00140c5b9c91d56d55c0ece218e3b0e94a5593ef5c4482b24ab2707a037eda6a1fa6b47673cb2da0edadccae04bfd24d8ba6a12733389a633e2058cdd58d61679b4ee405030bcbb1f1901c87a03a4456e0ec816f476dd9439133110a368a0d9db4edc33903338e9440340ce28eeb506cc3a1576fa06d18d9c4fee2f06841b46011d9d0c18b49af9e6848d8266de1ab5c394ae82b534f888112444da8e4ed7988274036da373afe02ccc84b3cf1d6118d8fc6dfdd69aa606879936d18d7d452aba0018caa0a810a56c37473c588c494b4521bffe851661b57a437f9b7998ae05700271dce3f16481917453415f1bbac8d9a098cd6948ea3d36c7df2ca6255bab8d4ae3eec55e210d1c61cd063cb4f704cef1bd916f86f756e60adadfca572560c90853a5bfcbca81a1a2e54a7e5dd46517a28c55d7841c8c14f61bcdc2a41915ad4876b3aec2e656321b4443b0be4934e51f70e1793a99491a56587e51adb0c26084b969b30307024147ea6753c62fe3b9415199287c016c61229828badfc77eea6225613b4eff883121183b3b71d71f0aa7e902f097e3e96c7bafd3274cb18cf3785a6a64bac1f7155fb23532c9df03561f442066bff46e516fb5faecd843cc9da333b38a0ea269999ec9fa7349e4f6e992c88f145c18ede8b24298885b5595679300e433e1dfc96728508d15b351b96afdab2b8ada10f4da531293bc753ec2da45029f7cf10bce14f45212cbe001dc6c1aacb01d60cf275081829d88ef4d530202c166d698f357be1a2f261ffbf9671f346e9cfb6ee5f9d33bf48bfa3b5ff088e4652d1f4cc0d4d3eb09514547305729ddebdd2bade9779440aca5ccc6fc5ddc81fa84b50b59996374e6779d69e8ae1d095496ed14ac4b658d64f02783f6b67dde31e466fabc20c47e767020606673a644ea7796854653778de964ea07d6502b01cbc40e6b0a616d1e9b7e6148e9838e3e2f033cd4c6d1928eb66b066cd719a783b55580f27e8f4ea2e56ce8e3a07f6fae8914ee4957798ff6f433165062fa7b310b7d4774a01c016716b6f7d07435e81910246d6e96a1c078764224fe1474d73097335330876ab0475ccd05515ab1f6103b23eaea0e964f3d6229a0b2c109fc76b90012cb6823bd27722d9f2fe29c2bf4c7e9f6b3b3c9725cc25167f53a3aa253a4dfb9fbb9978f2f27db6fcd63f7a3774f2aaf6093aec33bab87e5519fe3f058521f32cbde11b03e1fc76539f09266b9eb1f1f1d8971109d6faa94a46ae57d3c68f4667947bc8ca516c22472ce3a39b566bc1071592c3d508686d6f9c177d783903e3fd64bc2249dbd732cf30918acc2fc7d5c912233959a69736818a02b9cc20ae03d10f821918bf892cd72f1924d6c7c0f1fe395ef60b6eadfc634f4e6c03256d494fa721a85e895c4aeb7ab957ae41a63fd03f2beef3c3df93d66fd2e0141bdc664fc61363cebc971378f37f9844bfeb19f16b0c5243df668fbcd176cb6d2e149c91869a4dc4a51ab28e37a3c4a67c5a10cbc848ca52777ac6faf81a57c896fdc3a3dcb13645f6a4ac7a2d2871febbc791306cd9bc39bc3d6ef3787ed47da13d453f3f01187e1eb9896209171d19f1f50e7e96432e8e72e8c9b933e639bb49e8205f6d0983547ba7e9ccf5339cd59a41b4753b9208aa99e103f1fe55fa6609ae2ebde52548f8eabafe5b87afcaf7f1c558f8e42feaffae2e2da0b950a8c8e037f67935e5ddf257457b46ff47ef563f1c3d1bffe7e92d56a69e3b39cd11a9c7123d5ef5868fb5d753a99cffbc9f0ae0a2315c235d5c9ac3ab9cd679de1e4739e55933c95702cee2faa9fe5bcba984caa4348bcaabe57cfea5ef54915ed5793bb05f17f7b9e7127a1a930c3a5ddf39a51f6ebd8141147f8da089923489979de892b2456b0e00aa1ad54cc602fce0b6933ec498f4e7acca32dc7faebf3c67c9928ca9fceb85e404d824663c37a9f2f7756a5f9091c17928f6b40599677f2d98e654e23c28220bddd3352f33770ed8d2a5893b5207a81786794809eb13225d3b21628c63be8d8390db4e9db26655b4cc1fee4fee700fc3854180e739f3181f70009d4c5cf7cdcef74584fbf6f4de35cf98b3e991de5291fd8194bb8dd73bc53ee6bb72948b27dcacbbd18f874da9cb2a4cc9f42b61f84539805829812b4cf035c124ea7c38e7e1a4afc176c9dd8921dfe7c65f04e0761a02c8ba83ef1f46bad5fae1ad72818eba432ede5cf8dd106f17efd0ca67b5a8a0909c4024abc4cdcf733c84de834a6db410c0d162dd254a6fdc5dd6a75c91e818d8bd35ffef878fae6c339181b90dd8caca7ea54a958a63969c7f7d3e562273f306445a5cf4cc4fd20bf030d1fe570fd056afdeab8d1c003a23a5aad3af5ba1831b3213a4f06b51a2f8192c5fbd3063541b3a0783c179a14e9896e94dfb6d1778036476b6ba19a78e82565b2c52b0702640a814f3ce5e5a3f9c67ad18fe0071a56f19ab9460fa2737010986e4e8743d7b162c4be37b4d7318fd138565348eba054a96ed561dc8b476a86ad1c21a1e1f7c79dc90e5f0d5ece9eb89ff7bfd2b7ad0da30acbf50886a39dc94ed3aa0de7dc1cceb9793b96fc865fa2f02356abc53bd8671e988503d71db6bbb54f4b8029763496f8de2227ba8dced3b957da88fd927604d01b7bcfac0d8ec8e3c3df6fe68fac55ce8db674cee04a005be79b79dd6f3c0af60f09db1efaee13bf19de847eebf788ae8366d40c0e45875af327e3963cf8daaeaf08858f248f825e376c9aeec7ce4875a4665071ce8e7550042162d35fc0dcaf28b7bb536cf94bc1118835524a28ad4506c48366657180b79f7f49f3293ad7a631de867da83259604f809461b3d029751523a23042625855da906f88146976e27b6df114eed3fd3adceb347441add66910534a5bd2b76571856a7129547e8de9ac3f8107565c5cd2c1400ff8248bbb74c5f6b41d6de229a38e91a4c6f68a5040a3b0d142afa8cc3555a88fb8b82436f594c80aedde6938a1119d51f4c71122e6eb369d8f980a2d035336dd10738f82b28d965a66091ae84a8efb8bfe57a6897feef517f99048c05d3be129cbece537de021e626d45f8cd5a81eebe3fea5ecdd2ffb8ff6fbce60ee05bd5820d19830b757df8444f274365aabd0b0abd7d109cf8359bf90c1b1f05ec12a56cc0ad96596c588b1727361de4695e320df7f6f7e76ac4f98759df2b1bc35953347127cec552bc172f9d73f9b6644deeda5c9db284f394751ddb0ec0df97638ca86bd60b1a5fa69babfaa175906592fff34c4e7def643e95e3272787fce30556590dc9fe5a1dc7ddf8928725f5c0a21ee38f71f760ced3116cd9bf6ae5115b747ba692b6d66b9e4b6532a5250ab4cc1b6e1138937363354ff47249ddaf64599996362bdc90176af90eabe519670ce24a0e2725332dd13111bb8413fe859fd52a6fe4d46d60fa51a384f3404ad4389b3dc316974e53e21f72677b16e89ea721b1d2922c3b830945e1ac95969cb52e8ba50566734416665e95685d8c0846e60c25af2268a2f5b729e101c727f0bbca10604cb3303ee946635808b4c6ed38a53fd1ab78ac67675a582e8e4fa6d12baa368acf5aafda228d072d7a0b2683fa6248403122c4034530cf45d39fc3eb7c0cf995186e4cd39cb0123d16c46ea78dc54c8ee7e9709941ee0683a5a499fa5dfa86117dd647fabce16a05ffbea210488d27578a9163f52066f4646dd67580b3c54e067dd2b4b02399f2a780263ea72d15bf26b09eb605d7870ca3326d66211a137e371e1ae7aebef487822b8e782598466cf16bdd40b94bd298d4d7d76ab4baa7dc6ce0823a91a1187883d0cb485959ee619ea89a6b6748a5d62515ed8697fe5c749b5d67aa42c2720385e269c9415f8f57abee6a35a225619acad0fdcd0e0fcd71182c89ca9df3b28021880a529cd0c50a0292f6f78bfe61a2807d94e817233616cb5810600de74482c7a935b00b9c100f4ee40d0bd91db3f3e815b0fc6214cd3ff7d971d16ec3e03e25c6bd7a1c5ed3d61a49ff3994966563149af1738f9b63a867088846b1ebd864773f0186388b47b5dac8c2c649e58c0184c772477321de634286f1889689a9f98bd5ea9f27f1059479f3699ef63bfd9cd0d59c40850ff0714ce39a07d1cfcadb660cbc398fd3c41f6beed4ff3b0df100fc34bab88dc78520c3bf5ac8d962753ece0215c88a5a8be3dbbac7e51eb57417cf694073d3d49130a072f0b7a0eee563e283e63b1fff3d08f4d036a62b1a60f3cf236cff78083bb0a176ea8af294d937d80be021d6fcca9c43418425180bc4fba1c9a603e93d5122b0f0b2ce67915630d76a9ec7910f58ba4b7b40b9aac9200a784423a87c856eee4cad9de953e2f1f7a06ad851457dd648115af511ccb3b40559a45d40001edf87cf414b17e758e9e93f43adfc1e11ee5723716ad2902a3ca6e3624c173bc6444336a8fa1ca83a51b19dbeee521a24520179111a85a33ba9f807ac8673ac14cbf76ce99c4db4d78ee280ff84d2877008d1da1e371b1dc7b174edd4b6daa8d572e237378a111b23276e2e2aacf837bc6bb41fcdd149ae55f25bf6fea7be6b28f6ce7ce7267e5172b40ee1aafb3c4e60d87bd45643b756174515073bf4a539e0d4332073e7f8bb33c8c35818a62c3b7aa71541011b792bbafb85b9c2b4bfa45df562b57a0121f99c093da6cd021957fe64830bb73464cbb091956933e3cd3d25d453a27b4afeb39e92f84ff07b3b3a1a5b073ac726a8043f043cda749771b531e3608bc0c8fbdd8b633fc33eb446f5ecfb16c75914389be898b010ea66eccce9f5c7bd9c18993c7ba68c88bb71d7bea6acb322fa64d52ffc905870a9cc0a338e02478c35535cb26c60a99c266a35eb0904e84d17ca294fa4705263c8d2c619bc3936d5741aeacc2c40d5b2235ad039f85306932d103152bec74f36833ef02a4a5191f12c88be2acba802baa7ba471636bc12b7e29288ee77e23564c8106b26442fc1d0ab4b14c3554220ac880a3afbbb81c8d431063c1fbfa2f5cf95b68fa1420de85af1f47eebbfe35a3bf06f9acd00b7379f1fe19758f14e8c96a2d784a8f8240606eecb5aad0f27b997d4a671d0b0828266c77a8c3a90e589d741b8f3c9e5e45a7330a803e2bcc3148bae6389f44ffe4b45db6cd8fd2b842635a3701db0b57e37ce0867af56a9e8c40a84085d0a021342aa4c94d12e8e5e175be2699f754d2c57cdea5d73ec67fab4f7fedb0b69022709ec0972a2785cd878ada3ad4598625d65366f75db74cb038ef3e815ddd2d272d5c4c75d00d638aa38a782177b218cdd0952b9827a348ce7aa7e34052948fb632687cda50c775a1eb0eb22045fc3061d81fd2e116525e9180f60485d47c556184fc6aaae27d09178a9bc73a375e4d67fbd4387ab0460fc18708aca10ace07a1434e14728b98520547364a19a88a1b5a25fed27950e48afe685c587f3cb3b24da4374406cb47a515b9986c587f5e7e9d4d39fa33c43d6eb60dd4d206ebc8b3ec21cffa3d81653deab550b19c261a452469ccdd7612ecc560a6f056fb179f84a38d469d84dd6228dde01075a4c141114c5f13b02837771e5554b2a4776b8769ff9efc0af25d214c669f475b5ba2d9848555ee624531c2e45f3a7731659abc1b7ca4fa841b09d1dcd585dc696daec9c5cb2432d08922551989db658facbc6d607df8a5762698e955aedbd6f6fe811e12bc24486187911dc0ffd17a22bfd5b28d909fa5fd2c85e368ca0c246a82a8a3eccc0bbf89d981000a22e110ae89619ab69a0cdb3c6d66c09b1d13a1184c16af0e3ff8dc13fc5e09f9ac1afa7f194837c01322ee3832d71fd527c844b9c9d6a8b05e7e2253d208cf9ca198c7d2a2a843fa7f6f36d39ed717a6932be1e26d716988aa78669b80653f0157f167156e0f518fec5e2051d69e283781ee7e2adb88003a02c0cff2f4ec632ba0073f182b8be8b367fd80719833a9883c217577c4d647cf401ea900568a5547c90e28ad0d5877886c3e3f2c90b2b76d4688a4a3fd09b0c7cc1325e12c1215eb8eb4b78e10be19afcf3a15e9eacaa99f19734e445207ef13f00065ec62fa8a31443626666afd44aadf6a224b2e44e8b025a8d8f582f415d7de7d5535642ba62b08fad54b6557f7c499d99a13b62009adf238169580c13356ef370cea63f53d5c294de169e9e7cdada1f9afe57bc791917d3233e3813282a9beb65dcf9ce2623e53b5855a30ee937c300d9dd05f6d00bd0abbd84ce9e531b6eea03c24c217ac7f3f8966e72220e3a468c2bee1f84a370ba8696ff03f5ff3581bb0ccf20481262b417262a916934e0192a56014e235f0a1d8227fa524d0781f40bfadc376edd6671ed2f884a0d8b7baafa2b2db97861984f2299e217d18722fa11bc953e104fa93d26dd6112d2fc00ef7d1acbf15efc4183f76a75cc21f52caf5e9c058be990d8576c0df0b43c8f0b6050da1cf78cc143e2a0a8f3d7b456443f8cb5d3ffcf3a3601b1c617f563616d267147134388ad97d218996bd1e6c91faca1f2cf41f4141419b5557136a1a236172aa0d3878dd905267c7876ed5c31b224e83b8f3ffbc5088bf15dd042b245f673e658ee7761a8f0a378083f852fc5166e0a6924df04a912a2310e7c2f8c028391f4dbd8de031cc4f340bcf1df064d6da6f5566d93f02d9dcffe5b386008fa3b992f54b946d1bf0145ff0614bd2014fdc2cab2684eceed8dda871772d123c2f08b7f291c8c15accf15a28a97446b41e0b654f7d1b98305e2eb5acd86523877c4a61c3fa808570cdf27fbd9c9491a258883095ded7bf8b2246d51b92f5310cc65ad1d23b144730d03488a9891cc695003a35b65d667a03820c467dc8eafa048976994ab78887e5ecf8a401aca64cb0a99cf4eee606bc0eb318df3d6595b102cc5f18c48c527d362966080be47c791d1005923892ecb9358634b5f3815f7fae808e7820f8e70ac5c2d98639a0662104fcdd2dd62e96ee95cd55f3670a3f53acaec44cbdf40d7abab9c43881a06a6c49eb1dc90a31fe66ef0430e2c017eae1ec36efb6e9833d7d8f4222f0452ade379405b667f8e191388b6255294296bd372e058ea41791882d5f27b9225ec42b674693bf68beb667159870ba68a1c13847614d442ae6d8a549128dfc2da86a3d1d1d64641eceb8ba6feadf347e85611e5af5b7cf90eb3ea2e3be0102d1d2702362fc43577db25dfcfcfae745f4949ac0c1bf2cb33764424309236b8c0adb270b813f70eee6259af700861bed7484bddec6019c33bc21eefe9e4b82be1c166e996354561a928b221f4226544bce468a0b3e59cc88bf7b98aeb8a57df078402a11f039e6acc97699acfe7052733d6fb479c13e7f42bdd629bdd15e7d2b87c268d1f3893a64482da33a9322e1d4ad36f1c4a77eac07e0ff1a87318f5c0ce27a07c31b8251d4d533e9a82e8171a9ca2b4f8fc59e2fc599a5347e2d449a99a3a67a6f119ed453e6bc66e58a9db80584b166e4d69dd099a6925d5ca6faab3521869b38946a6a2d32ad51ad0cb3cbef4d943d5a1d589191c141ea8883ea121263a77aef1492f8b7bd193cecd5285e639a7793ba36e97319129844868425e12f5734e1502b43674b075f3a33f166e4110f66434f4a17c5912589f076b854169fd95e96e49ada9f47deec24c64e6890c069db064df52113a7ace41d31f188999a80c4cc87da31ec9ec15d446189179b314d6bc84fd0c123e90f6d28c00812bd3661a2a8e90ad4771d154f727faeee0383c86fe1928fb4007aa76423995849689039123e2a0fb4aaaabd966f52371dc42c85834f2dc15bf40cfc20a199b73e0de0c3c24ac5a183aecb05c2a3c8c09cba786e74d9444c663798e313cc8583eb455079a32888cec3b910954ba11afce7a532baf5d0e91efa2c1b76a5cece9334bb349ea3991a0960dc4f2d0a18358edc32e415f6094014303aa5b8139fff3b7ef2f102fb956f394498b0e14faeae2059d5190daa27113451406022a80a8ede6fdf9d5db0fefcfcefff8f0fe8d1bafbfac68856f14cfb90d93a0566faa0f3aeafeeafccdf9d935ee78b8ce3acff32157f6b0aed0c9a79bab065b68b36e5bd65054cd29c5a86009128f5c01181d39a57b13e3a1a3b452b93b1c5a92c9c846fcc007d1d78de82cc699fc96ad7e8200f771173bcbcf54e828fcd009450cccbe329900d557ee158a35572a975ba9dc6a9506064aba1bea7b7bd6631a611cd8a45696d30c9c1a2bc9512b1c19d2a1c291f0e04be7aad793b2ca850fd0dc7a9b4244a9011f78139857d9f82a31c711ccf70b21cd888534ec69d769c7313c5b5b540241d128eed4f383e3a8d03b3bef0d22b6f283baf964d0e49725dccfacc55b07910a35d310a31966e7d7ac24d6e79f72cc07b6888a6037dbf16722a903d0a8fa0812d83a6de45fa6729c4dda71e6dc4459acc65b4c870d49d08d391036a4cec618117490db74d79a06a26ac4211a531bf620764271fc9996a4a38b1d0e28253f11273691c08b2c753f4f36ec7595d98ee5e22b521bf2e0e4c6a6c6e17d9e382e77f7fb63d8b70d613a389262dfd85a946c2455ba1cab14785f586494fb83c853eceb98097fdd8a537167430e5487bb3ed37d7fa00c0403f3bd66800335cd686e9e97cd53d529a36c67f2746304d8119283766e3cc0dcb1e603febdb9ea4f79c765cd62ae5192c199a954045a77a30e74df526bbc73e0c2726f0a8f9f1aacd953b89c63440267eb6285b363a840d5602a49fc920d84cc83688fc50fb06cb5c1f861b13f6b6e7c9d365d05c59f05e1c6436604324c67e4a7fad30a0416406a5db634340e4f25cb1d0edcb0c68ae857771a0db39f107b0f96f076ba89b7056ce5a542b96c341998133909a23bd75bcbd960b9c1ca340b2afc876bedaa64aa29fbd8837bbcba1b251378388d51447bdd16fc1a7bf7f73850b120eb355d8d65f354863b7d358ba0b2f737f7875da8f78ab2f5fa1081fa68567e8e0f7f1f77b102add383dfda87665cb7ae3decc88d0f876071b6a55e262a5e2938fda0643beb21a6910952cdf16d03e6604b057049a0af80ca74c333e1beb00dd32e0a4751efc472f03d751410d39eb77aed001c487fbc246c1ea5c4eb1ed9d02b4dc37b76d6dbc127fa9963a62c24ac716faeea814f63937358f2de7c86adaeb1c63561fb5c5498394eac85b615319e9a0b9e8d30e1edf70d4347e355e0d212dbba7bb638e3f9103d3a269fa98892309c1be9b0e234451d56edd1cff7ea98dde0817b4133a1d90a9789dfd54a126a6c0ffcf21294145f2300842e86b6242db9fe62141c5261a4dc95a09dd6a13a6cde36d3b4eb29e04c0ec7948352aa07033ea6acd95d5c2b6d46edd82e5567ed82e29f0ffa63ec30523df58bcc3b2503efc1562beccbb1a38932fb97289669477282d22087e9663c77c116087b968486a9a48ddd7e33de88ed9ec7454a1d04028f5278c562df596322d081443db885394b6253631b2a395d1ffda9fb9e80143d08b3dda1dc47ce707fe170b1e16c8bcf03f38bf8a932e6af2b821d531f8884df56daac503a94df387522ddbe8180dd46d2e09c3e84c085db4112ab073bdd7d87c53e55aafa9bd6eae6bef5fbcd7d3b38c4be6dddac6fda6dd6d68b1475829bf6cdfa3bd1bc191f22b21aeb0c69b10e207ca069c522f695fbe1e19ff3c93882e4888eed78b9e81cfcd35bd3e651f6f74a9f4af89f996a58e8bfcfe7d309f244b60ae15b70ff4c05ddcf889e35f85105714914a5af92dbd94baaf801611d5c0b8ab670fa60774fa78b02bdffa24cb94c3aaae7c49bb461d765925111e2994a36c9a5167bb9cce8f80a61663f9a8cc3fbd31446d4db1320aa8bfccbe29046dea7eb47878f680e20740e09c9c3db2613536099305b8b2ff35947b9b8c1ba2cf47eb97afffce0faed8fe7971e3f7bc97dea6707ce5338a0297b731c8818c7844ed6169de2b146af6a665f951f9711272cbe9fca74c03676c4fd1286992bdbeeb2d3c786adf7a77210f02d1146d9a069ee6fc5ca57d0c7d23e29eec17b876398ace10a165d6aa245d6d8021407a41f1f1d9de0045cc8c592b8b2ef8f8e9e983bda78b040c400fdc444e3bfdf8288bcb15924b62133df1e04e6de0526c7abcc1cd89b26e91502eb37ca3732254e34102a0c53ca6923e23474028f80f353877e5ecc849e2c5310b0642d55a5481a038014690b3effa37cd19b6401515450c545d8199a2ae8c24f8b856f5f8cc574074599d2c07502263a9dd3df8a76d776634148a182254699194ddca56bd56dfc42fae69ac84d193fa7fe59e6d54c8c4faa1fb4b24619f07178954bdae10c93d5d5558b7d60aa16256c07a2889bd21e47a46f3dc248074cb040364218009acf4d0008a2afba0ad3c5db6cbc467d3a8768fc85d754af5e175e55aa85cffd45ef6c9667398efa2188eccade573fdf51be5d39deae6660fe8cf71831b28b5e0ed22685db1ac8b971e3334ab492e693bf2c51f3beb219a4ef832c5f5f724ad700da5d23c1b58fc446a5881f29e0572da8eba2099d00cf3c109b7520de2d64b832ee94e4b683e29ebea5a7be4e8a41b0ee19d97a69c3e91aee518ff39b5736d13800326ab37b1cacd183672b131fbb5a649efd7fd8acbdb2e8e00c1b3fdd726e6011ce92565e61308dba9a4b0e6535132db6464e410a04e1d2882c607337d254400495ff94c8515a8ccc645870588791091a9216faca541cd132728fa9ea316db22a6e3284b25563cbc057d8580af5b5616a4fbd11423699a8d9a5b85d83224373e2cf1b10ab385116608704bd1f3e74eba151344b71ac90de942099fd8e0912101b9ad8f6519fe069293e89594ca42270896001af1cd1bed86ed13091676a4507044511bdc6071a70075f60df316fad1f100df0e21c72cdc4e02ed612fc625e64ecc5ea9e5f7cd344535f84e7ccee2f81443fc5ac30a2d51295a7fe2715cbea138fc4906c9f8a71894fd18f54a939f23f81d5f804e94547d20d0cb1432a15b45e481ba8142f0640ce80623e8106f2cd70c58c16bc43870251483ad846b289444462f7246b8d2c4e596f1a966e50d826b47dc4997dcb08d1a4065cadbe2a1fc81fd58f8c39f8f1a6d5eb2f265cd9b4242446ba1f151ee03397af4bb988eafe011b835b4abee9054526eeb4c850a4846b44fb6a5f36e87dd891b26c5eb9b4088fbd3ca73a981c6d8222ecda7a33466421e278f0a56869c099b63dad9b99ed5d7285d4d7181b7d8912ae7ca8baa667f00227e2dd027d7cabefbefc509a5f163695fde58c4bbfbff053b677ba5780459880361c67e1e55e7d8f63fd79eac4a30ba007fa01d94b13fe3fecdf61d131826cd7080423a6b41808286a24425822d495c35de445d02657f49239cc28027cd296672adc9ce24dd839abb483bfa8f425be77d14f6793f9a4b368fc72f1e6e5f5f53b2f0851ed37be2710d353ef706a6586b8d22a51d545c88387021cd8214e10da6094d1a665740881b54ad4c18c494244fb5c708a32f72327368011943a854fb70bbef96670f9793aeb4f179cf6cc91b5a58dc998ea65771c274545418951083d265fa843946d48f24632c9ee4a61774179d66a89cf014b5966ed3153f4a7bc95bac788a3d0c632a2b5e090ab3bfb74c67488bef36c65a2041d2a1e10211de9250e4a03628cfa0c778cd4690855cca05d3d40ea70908aa03c38de91cb64e4665028d9e51372893b51e565adf612a44dad76db90c9467ef4739585c44aa0e24fd21fe97079e96418cd10fc4445dda073247a19eb584059ec75faac8aebd46ab031ca9a79930e88f087a31fc22c92fef1e3c7dfa3f431eeb9034ee8f2704c25ffb342994baef7706825fa94513c429a640e0bc5c26db5e1b1758cb1d0fbd8fbc3ab13b59fe29d7c56af07453cd6efff4e544beb7dd9ea1a054c5dc53a1ed2cbb8eb8f8af0e2c8c7f8c7d9e99b374f4fcf7ea45db3b5031a5efd7d508af3655a6c9efb433e3d6d4910a268b95a1d3cb601fdf1509faef7ca714dc2f4fcb6418cae5a68a8433ff9e38d83e0291f63b70876a5e74971239cf89ebd84ff0292690609387e88e35b17763515c544a88eeeb6dce3e5a613f39643a40f878609553dc2c032e1568a93bd59dc86ce13da7d41a4e7e0d652b39c383f82f1d5ed161f828f3fe3276ef3f119be6f8ec4100325ab62ffa1a3936980f1cfe8fcbd838d893216a9d5a64cf0985fffce55694e0b591444f242c690c72b218523c4df65ab2f9bece5a21ce3132d11b652fe5daef62a84b071a5df90215b1ff75d9ef1ecd0bee958eaa0d7aea8104628a4ec8c1ad80fb30513a7aed1d09ec3c29a6992f9c95d14802cf6c7f4d8503609fc8895999c3f75caa518d73b41d0f4e71ca1e64cbbc93aa18160e64714b37a30a725388fddc7f43a2d09d59843651a9f2384629dc773148468f56e77ab7823bea363fd2e3e2be2dafbba2a447277f131238461ad767c62eb2861f52485785a59117940471cbb77b53a2f2c13b486f54e94193ca89fca069177a2636d217744aeeec467c47c2b217e47a5871812815e186f21d26410e6ca0df42d3240084dbb7640bb72fc0c24c4b4f158b5fcbdc34d12b9dac1f42d6185d989ecf015e969c3f2f6c0da56f0d92c9523d8e98a9e13949ace5878dbadf53274e997e08e689633315f9bb01e1a567a85d6bdbb7b2f1480bda62a3b40bfd82beb9df10e3f6d49ef4b62be825af9b44da6d8463258c4408b620cfca49b835f0cd97b3697335b328e53cbe281dd332c9eda304fe92cacd5f646518f2e7b41b3171e45538739e73f5940655a17484dbecae281ab271cebc87f3e5208451c7d6bfe24865fdcd870bc04da62e8971b7172b5941fb4090de2f4b39c364783dbac1457c6d6c76eadf4d3f7eb28fb46002d950edc6903c2b8bc891431e5a60dedefa9b690e74f9426d5970f7dc9464b26309723292fe061f660dc2c132e380bbd7c7cb09c7be2f2c3c5d3f3f77fc034e9f4fa2abc7f767ef6eae2f4cd1f57e7ef42afe18917efdf7e78a7ee8427de9d5e5f9fbfbfbc0a5bf7a3fe98461d1e0bba783e9369782446f20b5f7d0f29f9bb591e7a1eaeae961d5c8df32e971df0a52eec5ec1f4e17b31d4176017361b7e6c1b7e6c1bbe591e1dc91f7635ef9b47a69360472f6d71f6e1fdfbf3cbb35fffb8faf50296b26b5179767a7d7efdeae2bc988c8bb797d72f43efb51c2fe5ecaefa3c4f667c71216769af7a3a9df587747d577dbd1ce7f46778573d5d76094d55afe8e8c9e111547d9b2e26f8bd248a9a0b9ee5295f786ebe93ab976fdf5f17bda12774822e4c07681fcda36db48a16d158a99d67a7bf86ded5729cd13b1713feb926b206bf3fe7d9585d5df79633be783eebe3e78a2888195d6c8fc83487b6d0101ac1eb7813af95de38bd78774180e19d5e10a4bcbbf0da629467fde528f42e2e2eaa99a8de557be16814cee75589bc753da2a709515f1c668777ea11ca3b84179e21d2b0774eff88ea8579d913c3c9b8ab1e3985aa0f5bac4bb9715dc8ed9b8a08611a7ace382a5cb528a6b2b5980e9733393c938b5d21ab8f55d265624768f413c268336fedc67a9da62aadb755671e3ab92b6561d7240f0ea280f5e048c2ccdeca362f913a8f0edd1c309fd22207cc27950800c68cfb86df889382f540798fd86a28bbe987dda5b82e7d6a7ce5534dba2002609eb58ab7b4c2d241231b899c683c7ba5acf19ccf2a41791d4e8ae5612126e48e8cdff40ad5e7ecf3a8c2495d8b34f44e6141bd1c135d68cb55ebf31c3b30469ef086ba56e588f4b9ab5756faf78a91d56a15fe1a735f720fb0c3c787991b67252645be53b06f8540cb89a46774d066b59dd73f3ac93d1cb5f67fd970432c256b26a1a3dd96cefb2fca59da6c1d6eb73194733ab4759b0440f563e7d5b9b645d1b643b0fdfe1ba245aab4b79e9610720711c31b9b0125c6ac4b11d7f1a6636c1e4fe0c8a6ac5ef79e15069c2f1322a0a68bde74d6f9e22989f70c1188b65a852dbcefdcc5bc677401f8de09e7d4dd28d98ec7fa4b625ad140413c95f9565aff264d72bd32b7951826e8ec30ed2246ef34750712d47d0911655d27f935356532ff4095d37af16611bbc08e73967f46d882d8d589f38461a27c9e36848a2b6556cae25ca8476cdb8a47cdb4eed33dcd894020a29cb6433d2b9a48379b48ebdc411ac7591d13694d7c0a4078554a52f76f2f78e62c3846be5ae9fe11f6e1bf6857bb7b597f42e8025b1334c14e30e9cd3598b04ab20426c68c8f574407ee3afcfde6b0d978d40cfd9bc3c6a3e090233ce7ce4ee4c81145bee8449965453abe0b0752cf901f5817d01436f3d6713bcc4a601967ff3114a63ba030df82c2ff10085306c2948130df00c2a4ee17af36a50392a8fe30302a0cc658238e3fb2bcdeb21905a42c1e4019af92dda93b4b38e4dfd90709779ed9ce19b20bc03678ae2e2d543f0cd6fd6497a55261e50bfbcca4bd5edb30e9d56539399b6b7ca35269d911e89723fd1b23a5c416709453cd1693e88847201cd93b566f62ed8968edf4bfec622b9e72ecca6de108afc1051d71df8843f8a07ca4c4193a226d27686f29617c595ab2a903857c19adc82ca573c72fb220a840de574ae7eb11f3ac801516db3a14bd18c59913ea1a1170b5585236fd117c7e9cd0ca459e1b6041f70e47dce380b6c8884eb043ce3099db10e8f3345ca44148cd118821f94d1ebf22b0ec7180b5dc1f096c45141854e7f70a70f5078c2f10372c1df6d381b795be8838e2c56cf8637e478c2edb6b48be7e0cbae273af4f3bdf117e9c125da1b2b6059149ec60bd4e38b74304cd23dbacc771977e1082cd4db3c7a10bd532eb988389328736f1c77f61f3476b0575f5f1c5a90add9829c1459b2d961d39062349a2daf206623c7e94c38023b61b2b0c773272658dbd97687f44f52948bed8e1afdf4e71e6078a982a674ba395eeec99a5a6e7c5d477108ed5b0e6e237a32769799dcec1dffff9c3e3a37f1e949bf238ec17c7182de06b0f911055f30ed055d805c98d31ef97ad068ad711db207dc07059ad6451db011c0925e8b7b602474963df81adb4774d671660d8cea34760411c646b9e166326809075fd2e64fe818962394426ca0763b0e89916895e4d3e0ea361ad2663e743101fba5e170f7df7bff365a52dbefd89ee4c85fed602217f8bd61847ce4081294d4c17d748c311762cdd3c2e845b81ed749ce03c5976cfc748e992c595ff00c13e68495b9697c98d48a6acf56590e78c5a4d38bddbec5d9cbd546e24edb2cf83a6a774c6486a5acedca5df099d5fe5bdfafe8d4a18b655dd3ce6ec7d12a181f3609799ab938106a667e3f96498b3c696100bdbf4231d4577b5fa1ca9130bae04f1de5eae0efe22d0efda4af2b7a08fb5f63b94c74991804f6543b261480b53e260bde19900f31225eb25f6db0b13eb2d7e4fc30c73df83cd72a0c274e710dd7626745b41fe01dce317e703cf33ddf305d4a50093708b8aa31afc6447145862c1a1db53239525a367bf6cf89c15aca24ae8345bb2ad25f85f4dfdde713ca23ced0c3337f79e63e8fb73d188ce69de70dadad5d4d8d3dc5861ee8dd8b945ae11c2e2c37cb6d0c97c8df471a31903fea62993df5d05c0b7b97293866a11794f828d26b2c988df5f6f7fd59f89abe3a6539bc3e948eb0be1351cb149572042d1711127154e6ff7dd98e6b7f03bcf143eec11f476db51053c650fe6e6b88d118531ee45895662e58de5187182df29a135f46633ce0be6edefdf12e85413273e162716ddb6542334758b7c5c6b20ed84ef54e607750def9884136cde06ebeda19a1961d3cc625a6ed3625a601c1adc330c75e9f8023fa57e53fd9be95fd6111b28ddf8b262533acefb1c56742bc55a73147604479250dbccc6991844fe201e28c5cc40cf20e6ab5bccd70047483c1043fef6193cae4b134673a81e49c480a0d6785e76f4259bb350f727ff37faabfc558769d161faffe203b3a2bfecff457f79d15ffebfdf9f8dd75160d06e112e97830e6dc35927ecee843205640f02858289079750ad60f1b8b231e56ac62327760a35d1e4e909d5ec382cdb6da690aec6e03bdcfc9d1426dc14c6e8672a55ffe60767a10c98f3b4ed7fce8c7035d35253dedfe9764f9c83da7e546abe6916a5f1ce449209e7604477e54fc524c1d9ce1d84eb8ff27acb33aa9067be4ed09c7657761034dcffacdb327b296f601f15e38243436d3f7a5c3c4ae7d3a01bfffd49de240498c180920332aae08c70dcca5a3fb439549f23922f4c6f8e442fca2684faf96df83f8b8afeddf98386f87dd12550c7c1a0a2c9764e72d714d81a9474624fc5d12112ab1c0583733d9825ecd4638f3e675e8d639ef4c024c35a12c147e7639e5103f318d6354d6fee85dff9fe001b7263debdefea49fd3b2f089a83701e7c17d4bf6be9a236bdff5d7d6b2a9bdff53b55eab656abce95916af5fe665c9d7e4653a913c3b9e5ddcc6ec6ed0069b86e6ef68f8979a67ea8d12a1ad8f31919407b390f7413f11c4fa122bdadc6a5efa84e3730c42dbdc215e35b04c6b819afa912223e5311df7cc70229c35d76ea9e31f08b3ca178f2e7a62d9a1de10d6016f899737615daf718493122f8043c74cea974a76615d9507b066a52c7d7eac6b4f70a104bb5854b37bed5c554e2955784f51e88d2896d10779dac83762b7dd9f09abc27880e6125521a244a884ad5b73f13458ad8a208fba4388f72ed6fe599d8a8b9b767053e1b8f74cbdbbd7eabf51db58bf6b71f6ef04d9dfe70b125552a3b149a4c10d8f88442e86f34937199bcdaeea756dbfbb215e48a65bd5f52ac0cb1f922d709c75a8add6c57751bd5ce84a017b04d505bcdbf4c67c43661e5fe3fcec4f0ff35aac8fb39220239adaa199c529755e2ebb461c31cef9e2aa184f33e15cfab594eb729242d0d38ff6ee1702bf0d4e163b5518a8d22abad5542a6b3b733295b5a918eaf9c37ca8f7051a26b1f37bf8002044ae32c17732473dd3bde09c4acb083a79293f8410756b5b638a115c49ac0a9bae0f3bac4dbdc7ddbeae49bf9710a1efc6b562231125702017f6c59328cfdaa4f655b90ba66188e5c3773ca2158d0861c1b5c5e4f656218582a26be379e141bb2be9e610b7b512f9e2150bc92f898700a892b2eb1ca5e019ba7ac4875919c642a085c0c701523ed20c3d10e808b2427188761913214298d62606d5e94a44428e3986f8df4dbc3a3a38b869717c3339136121a5ed626bee971db57014c84de03e13d465c3a820b622f675f0f17e621322886cd39e99b09d12680e92279f7c098c5e48180dd2675775742055bcd74836637cc02161dd8c006b69994987ec9510ec4f98e76b44d91af922d61344533089f82a8f1eba8d7ec2901458b13dab411d485578b6f0bbad7983dad85c74d78bbccfe799714b64f806b4fe7982dd7b7f09bb83194a058489b999d012282b2c28dc09a00d9a138bbc0314f663b5436c88b9116392dd2d6f69c14603da00eeb6a5eabbd41581e5014cdcec671efbc845ed6bb5264e333d05e10ea4a5b13b2e32524c9de3bdadd60661ae42cd4dd07acb12a6f7ca9472d3741b634aba57d514c70aa5d47b66696fa74d2805a2bff8d2eec9e186e77820518bab0cc4048b09c064e16e161e1f52a387c473198a133185d76cf6678616eb186f85c1a4f4f59baab318d799f4ec57c6b6ba8c66848234802785f14dbc24c2e64241dde22ee5edf6c6100d2350b4a81476c0b48c5aeb6e7f636b72d0c83e670730c7a6b96e6b34bd0bb0d495378e64f21102e2c05bb9c8e0535394955106c40d81401cacbaf9c010eb6ab59bbc43b0527c5e28c0b7420ddfc8356d08ab94ff950e064fbad76b83315605aaf47c578dd66b6b84ba5cae6d481e2e020853cce0c3fdb1cfbce97930295ac4daee41402bda299c230a9803d8b644e0bcaf718027884b62828017caec23c2a751f7417d78bb219e5eec4076e02c407c3846c531915c706dcea378b43c948d5ecf07b968ae967f1afd22ff4ffd0b868c5bd52c1192d3eab5ae02fadef9dac24d6546196df6e14b1f814d6fea5826bd91f1613d4f2f0d76beb2a984647a1b16fd21521c9d1b1350300adf4d3325fe6455c807d38553de3c9de7c821473f95885efd92c5309b88bf28d1c08b19bf66ba485d15333555af40bc9ef98c084a8fde251642fe3c4753875fc9998a07a0bd96652c4d270a25d18875b62f3e5e6905b69fb20ae244299636d3fb49eddbb1e6ad61f9267bdce8ec51450ccbae746ec7244ef614f20f54539cc1694ef20ca7b08206b17505da2c85dafad151472c7daed5ed220f4a54bcf22aa40314c6595c10341240603db41242d88c9486e82c3d6f4e84207fcb98edd007203fa6509cee53690cb6fec0dd48a36374b736bb36c166c0ca122c3bfde70b2508709f535e5d864d6d00920ea7105ce3bb381008818b8ef8cc344c0ea2e1c734adc5c106f181216ff14eeed65eb28b5e199f7de70c624c5780cfc64b5fa2c3c33dd5076343ae3524039807b8f4f4a8e3ba97844e32a013a27578a204920cbcd8e62b4b1bba1918a84a20043e537fa5132a9b25e77e908d9fa380ee6d5b53eb69d5d7a30dd82fec8b599cc334435e23adf082e469858e543c6ce1723b06203c84ea0cf76c9c46dcd761e635622cd62616a61f9c07edb092ec0e064c4ed56461c5466444d1a29257508d5116d0d13344b0c954fbb8f7888b6da1009ed897b23964d32cb9611c3067125fd98ba9038e05e271fcf541646ead07c067715499d5b833345e7c10e792df4b8dcf90ec1f137ba0d59676fefb937e5c3f344058be41ef99d6047dbb5dadeeeb10c0f0e8475aca37e747aad3d93592957df65a061ed3aee251c2f1b39450816d469bfa95acdd8c1bd478bbd8dfe860f203bf149dc8a599c884bf11180f25a9c8a77d10819fdb892674030ca26f7b77165ef58a7336194e5441d068df9ce0928fc4e854b565207ff5da390eb38793f8ac38bd9b79ccad61ae265a812a0f5e24b67ebf0ec7f8a0dff1d7d82a9b7ceec96c5bdd6a73680d5ef1058c2fdfc92ed377dc4fd0422c1caf85923ffd45c72be9320f4c69c5ea4d8fbc4986d95756bb5fefc525efac806a7aeba44bdde82a44de34ca8c6636eb8033f28e21332c214e882ce4de463ec8acb40fced097498afe31f0e66e263eb3562fbe107e8409cc66f30b22fd3a0e911e2abb29f28ddea64c2eadaf1930ab9449cd6632f42a6f78f728897d80dab4e45936156147503ee4fb1dda73aa77f95a70c7a0f5ad73d1d8514168a6a7516eeea54f4f22cd891714f2f8a3d0656ab4b9a664044ad76593e3514ce888ae7e665b74e145ca24c1d863afddc2514cbca0b746f8684334c036d000c93441d8254a4c4a30ffd8870c9eafddbd56a60d304726ad3d2abd1d082ae621c35dc5ad6fd2bcc22be8297dadf91c91cb3b049391ad30c97b0d5a7ae6be3633265063b48cf232edb53a1bf3ff9bb884824d854699c94d898c3e93b27b29e665fee3aa7dd4917652262eb3d2ead6c53dd2ad572b9ac8826ea14eea6df4b43d82e2b5a720a7793363bc654e626fe57b8078600e0b0ddd9bf70c4fada9e5a5764116b89749d166666d3125ab6f1ea3bdae1cf01b19d35e1246cedd4d6c136a7a2634aab745a3cae02f186920f0fe708288d72f7016133208a8a32212cbf63250b559c195cc133abc1985f3ac21718e1adb5786e03f7b3fcccfd362bdd501b1f892b451a414eb4ff0de2c7a5bba1d14e0bf707b73c4e99064b6d2c1c25b8e2338dd8a06c8b995181587714c7479cf36fabbc5ed7dc0f62f419ee471b977237db245fda920933696d8da1e8c43f66ef7382ab517fb1f3a3e9c8ce74443b4e0c4954df3d8e0ca2939521a94a7fd211f3c5049aaba9ecca32f9086b9fbd2308a25d1350f7796fcbe810faa0b5d82c85db24c8cd5edb24463059930a43b563983808a62632a2a9cbcbb25aa551af912e67982d1e78dc89069aca2b48cd6870328c062a226bd61ab41983e3c28d2b3d32203405dc4d6d2a531d806640231103a2c7882653e1dc5552ad0e06553a883a8ede5a14987c63358ce8df06f8e59fcecec5e0470f4f77677bba1f9ced6edceafcc56c133d48245c16a1e1d2d4a6910a435c5e81563beae9191f1433de3b199818b81902e0aa19ef9566bcd20d0ad3c59cfe6c4c790f53dea3291fa829dff3cbfd9bad030bc01271915ae221dd495ca40e71a19a2c131729caf49a5ada5ac5d3982a8942e196eca8c49e6e860145c8a47973d5594c57233a1e1693d5221fae10722408392ee8662df568c57179fa23d9cd6f0e756c5f09dbfc2b95f29ff7e3cfbd3e354633f19ff82bf4474865f017cdfc9b1e0cdbda0757a56cb8f6ac298929ef3a29b12b1701270ff804f1331bb90b4efcdc45f6571d2c38378a568f785ed9c943afde2d24518ee8eb2cf34d2e887cd851c69c4656a952de262a62d8c1f189e3e1f7e8d123cf1848ce41137e4ee52cf338c1639cb8561a077e70d3ba69dfafebcd478dfd9bdf5722fcaf93bd9b1bd770c30968fde5e89faa9c2e9c0754f088fef384d778b4514c65addfc3c346b316b51f1516aeeff3eef997a9effdce2af17d8fe9e87de91802b935b4a531578c9ccf1a292ec8737382dc39217569ef3e654eb39c4ac99a049f6dd8043b26cbcfaccef9eaecfc8fb3b797d7e7bf5c5fc5b9d481215b6a45da425a89e7acc8f653805de52fc5cf77ac03da14413b8d3da5c91c6cc1f08eb6647c570a10bce991b32300eb969db9faba1d6490b254d0b13154ee92ed2d42dc129267fa6ee601960c22b0902d3221357634f060677087739bb0463aff511b0ec7b82e667c43632f0d8d0518531b141016b13d08117573855f724f79b5a4cc59bbc5415451be83197c5020d9ecb472c9a95bda71c68154717f7675e5de7e785f7afabaf4d0cdc78272fb86596ca23ce9434f371328a82378cb3292d067874e96d00a0c53175b70c8d22fda87a4b072b4b904e0c1bb858a8a3828464c90b86d72f853c7bc115001605d8b22e24a58c6b045c774e6654ec759e1c1a7e679ebfb527c5f5a7c1f21dfcc75a0e8da161e0014e300b831f526efcd27c4ac75a3b470702f96060d35b1302efcef8727e368a88885028fb786ede6f3841638c4a5f20ded2aa728e275c0faaa3428507c048ade3b12e322c4cbae4665d1a8dcd9e8b1dba89d446799c6f39c68a221d24e399f17ad8be900145b835f3f73f171b15784dee13b13249416a2291f5801843377a98f73d7fbc6c46e521e377f8962f7f61ed6f159159e6341364ff367f930ef72f64b6743d97455a0bc46f37e8e3074eaca24a381b7a40bf79ce377809442158d66a49fc3a0aad19f9f6f8edf8dcf8328d2bca3e3cc5c456e782225d1d337518153337315216f6cd14ae9dd9dac7bb2164533a73c469e9cd3f946e847c51c4a37f89d49a10da364a32968662e322af4f2a5204b09021c713afbb5deccb657d1290d9a3839fb35d1279ac49db136119831ca5bef68eeb9993fe4fc0faf9e06ed5d04a00a73b8d6f5a9ab3f16aaaf875fe9945ee1eadfeca2a78c441db38adc85eae70f26557828e2a3f9d07be280e22bdf3f94e36c36e96755ff26ab07876acf7ff1e138236ffb04c29319fc5d82c6729ecf4ebb60bb399f59d03a6ec3aafaf0e9e44b9e1ff65564c46fbd46cc956467d17bd8a674adc30f401ee19c90bfe362f275f5394f06fdc5eaed6a340ffc66ccd94d824331a057103f11699d388e22a791144330e963a026da588382719c42a530007a1bc623f555534262bd7888bc7c88f96ce3511ce35cff3025d47f26e11b5def15211234c2532e3adecf3cb4b75399d281c4be08b59aa7c6eb2119e1de9eceb3dc670345e5acd0ab7bd7e53264494455c94eafa59aa74e51852aeed1d945bb62cc3e0fcf7cf7d31baae3a2ed80e6616715db6860ec9aeeb5e371b80747597db35a15d74ce07290c0d5ea8727198239083881abc882a137191777ec850497dbce6af58f930e573c0753bd69f1077dfb1269a689c6fb571c5fe87340addd579560c720e9ee66d4d0ac7f0be5096444d4bb57c7394038c7b03df017103065be46b07bea3d9bcc94c77ad813c59accc3a1b0d33e0fc742837f9809a0e1f0426c62e3b09cc6e4c5b78d491d47f54f7f6d5caa4e04db76ce995b2ad6f9a9085b368cadfd1e2db213b24c6e0b3acb4688b96fcdb5a4637a28756438233db401e5a1a5d6119d5f65edf5b81c86ac4750ee56887b51b7d56bc783c2f95561e17b9c409b81c89cb302663b4e3b58cb6ed3efb64aa53bc39015a1ef4b55859e0913c8b4f4342885202b61d04fd2c58a0942c3fabfc2d7109995fac972916baf7524294fe35fb5dff943550c398dfb505516266e11dd9bcb667159b0c7e1fe2107ff4090304443c2fbc81cad827384bfea00424d7351bcf9fb4df3509837695fe24d04f0503f4ebdff2aaae9704bba134ee483604b1824fd0813c6285441907edd1504a92844ad7a71eb88209e272ac814440d4d4c3611ac252b2d15132a8ebfa6f60ec0c151a1b81457457b2fcbfb6fdfffcd31823965e6ddde4a955899d5ee5960886fb6632b39c6106dd1e5c43b2c2e5e3b5e1376a01d9599c4cfeaf072d2e932bce7ca7f6023bf94fc4bb679471c8fc4c900068630a957604fd18e884c5062c6f4ce134f11b48a7021539667eac63831bccaf81671723df15add0cfb233a21269ef851df4f3ee73398d17be28d2ad1ca59f14c35369965f9ec29f574aeee973816d50b179933d3afb21d82adc2456bef47471493187580e2eb680263ce879af6f27450ce6b5244e08c92225b58a2f8943d180f14d15facc73d9c520aaf00ed3c40ab934c26c35c8e99e5238abbb9936abd940da2b1e55025c4dcf4e44e62df43e00d2210de60ea148160c35f9de0a9dc78eac4f6d12e44dd0d827997910c61446f0f43ad3871cbcc9732a1591025c186778634de19e68b4375abd7362cbb6e981393c7a27d2a7412b0b0dc6e62da358fcbaf1aaf0bb36a8cc5257394fbbc0c6eb032fa0436d5b7df74b4b6cba83a91b3998495396c6268fdb3c2873a53ebbfdd44649b287b8010b25fff5fcd918ceff721cf2d7fbecd69a73e94d396779a653536f2915a31984931b86d884dbc432a241badb0337e582937e35907b62232ecbfdff45229bedc0e1421bbe54263f591faf986274db2b68b0bd598cae297b859fcb4391712d3992dcdd449a61a1e0545261f471590ba91e5ca913db734796ce4f6d524de920d3720a645d5cfd941b461c27eb28f4ac38604a56b275ca878eccaa4974747a73f1c76915cc511dffec7037c601c47df1a07ba2c3c819ea76e9c018201651a9310bbb5d79f3fef8f11ddc602bf0e82d78d8f9e2451a2d2aec8646e94af9d38a9e3944718276db1a6c86cce8cd22964fd90376a17808e562f1cfaad9beca6d1ae07b97fd00c14631820148b7740103f6c3d6ed76ac3d6f7ed2779fd18518c8fbc90b8a30efa386249fc20383a810916d40ab5daf113b6d6ead14c2e26cffb5f886dcfb5d10c5c3c3ada4df7450a9e1232bac000d857952631d75965fa63dfa697910d1d755574607aa103af72ce43ae339d7cf68f916dcf4cce0c5e757ef2a8131c76228bd76dd7f41e676c4fe8478dc2c4ac5152330ec82aa674c9572a0db41ae693785c3732365376301650b41acd2aec7dfde1c120f83fd35a0df9cf2b30c0ebd563222ae96fc760ca41a03585f4da89cd5f645bb0ad534363d5d037dbb119854ff22848ea58290447a21f6d2347ef64f57272c2f54887e5463e7615aa56365434db20d2cf7af64ad742fc5aae4517764f8e8a68d516c8df241ba13abd8800987736e2ed26f141a2868e0064f5a4f800491f80f15359ca42b9643372e581a306a18f72c29214ac561aa7abd5d17686058eebd1e2542375a231587e7b744275f327076990d3fcaab87d088ff35825c8cde3e3c7b6bf37302794651c32481e88d8c6b9d994342f75fa145de432934d8f23da525958d897232f5ad9edff6c93d435ca1715aa4eeabd4c3be25e595723c82c42a16aaca14d5a93d63fdb4d0ea0fde1faecf97238fc95588b900bcc9d18956bbd9c2c677355852fa3a4f52fd87c7611b0952eebb4818edad05c14f7c76d0e97a4d4e902e5901ee1f7713b3838e6abef514735f2036dc0a3e0a01ba936fea66e3bc4efe2f6ef7c5bded7c7e7df3f6281ddf3e14412c378d4f0ea54f51f5c1599ac4cdf5d8e1d9ed863496a5a1ec963b3fb1fd6c141932e6e32fbeb37c36b7b1536775dde341847064dfcebffb6f25bf58376a01e9b6a78b47f185576064d506b42a8bac3a7ac18451c66c62b6206d3be2588df0cfccc39e4f3e8192d3393bc97994e501234afe84f08192bc150629e5b384895fdedde6be9e6f654388350853f8adf662600257d12ac38368c22466c0c91d383e9640a034a5fe764cb51ca965e1b098d207c7b87e4cb51b71ef79a3d90285b5f04b7ed42bffcfb77abeff65995ecaa91bffb0e25df79254eadd819af77b226b6680a861fe754f1c68f0f3033052b53abed3d2b7134922051851b72cb6513f9d59a849ad842e3881308eb1bc8718d01656843d8b2bd511ec927e659b308bc1cca9383c455cfda3bc498974d5f79c5729832fb525d3a19faf52960dc658d7d16c2b938f602858e247d207fee8694cae59f2e24c7492931d385031db35472ada61179baf6d222cd571aff08484d43f8b6a4f14fa99b54d68a64f68e4586a8bfa752cdb654160b5e1d72c482c3a0dd72502e09d278b34870d426275e7344130731985548edd009c0dac797c6d80c2a7f1888195eb66b2e73425671b7e970761dde7395b4cc1e72dcc75209e44aac1d6b1e85e949de3c380e8f83308d3b275dbe2e968aedd58b58532afbea51d429879bea6a9a1eec82dd208d39b2026d7e6041dd83e14a5d86cb9e5059db378adc238e8362c568fa02c65481bb9f161c385a671f4de2fb617f3c4046af885df5687ad2455c5cd2ca9d9e7966a0fb6cd368a53de9b61b3d208c26ac498b5b4fc1e8202b3e7654d27033fdd3493a4cc25112d4d3a061f3fc53e92809875cba2eac1479cf98f0958d22dfb5ef2155a747d0354e843a35b3c6be4aef74bf1670cb476e44c8bc08b2946d39fd769fd35b512583fde56c7107d21b568bb3fe7c412c1d1490747b2b87fd4c5ff7c7faee9898a87d8c560d010ad2a418fd4bb8f2fb2a44a453ab2443413e733522a1e5ed38790d97a71f81e06ae96b643345da887d3d7b3bdab46f392fb114df7a5f98e232ce577e708d7d22153ee2fbfad0ad8bbd239529fa47e9f784eedba9517608ea1967e08e8ea89404080945ef8ee999185b935fbf7b7020e01f94aad094767e45697e69f342854058051349dd8bbc3c3eb8740bceedcc0c0ab58806d98c419b4aed21ed247ad73b41d9e571b3636cc94a17112afdbde3ddad1f23a8dad812d2c5488f4b9070b43613f38c61c8f55d29013a0145012193845bd4607754063b062ed3a06f27fe9da9f06017d46a1908bf01d99f68513760c7e9c3677184ddced3c40d22a7ce5bc478659d8c8907407d4815dd96a3172b3d8e8ae559121e62ce82edaa9c6bdab507a50675c6e3de468aacbd8e417b129a6ce203e8784b693f5fcffa08db7bad6d76a4640e164bd7675f91650e9e2ed721f5d80cbba9a1da54f0110edda8529c6eb7ab71c6410e2edd309a34a33a0b02f579ea0eea181bf9692406eed7d070898aeb6eab877a9c5c8d7d82100046b537c8efb2c9e77179427158d283b3499647ff3a564937fde3bf9dd0be3ffed713baf9fe1f9c4df687a32731cef6019bd06f8c7b2ae78bdc8e9befaa29c63e08d67aa9941251a8bd402838cb4bb9f3d452606bcccf4753c49971a63d4080cd52c15af3ed58b67772b1c8676331de16c03aed41c2a2b3bb17a4dc06d642ea4634e581741449f4e0e30a02dae836666b084d10fd7e68442bbfdf1cfa8d4701fd6d75fba3f6a360ff1004f630d6f68a08ed2e72704522df65ba3af6392a4710e6a5e80e26e099f211182ae23e23724b3104daa2e4b9efd939f102a4ca9ae5ddfccbd41343da805de91a12c2031dc99ee9437104f24b734be12b1bfcb953a44c9e68ce2ffa634d7cde2b8be0ab8de2682bb4fb9eb3189253ed6ba67ffad06a8c4c6b1e826c88d9d67ab8158e5c8bc6ed91473b3f716d3e477e713f676e3ec716fff5e754ccf73c993ff83da6b907bfc7a9f03ffb9e02e95e68c144121338304e875465db8142ab320d9d16824c134ccbb986761b99e97436df23852e521c6859fee5ff3ce64cef1a4e3b7029f64097c07110673cf224ef2fa7d06ba9432613081084fc69fbf6e0c982750ff64f2e81d8d173fea39b1540cb9daa5ea05c6ea5b52a50617e45d9ae4842dfc8d3943a2c6552b4529615f48a20d6eccddae515a1814e125a86db1c51d831a7a264bbdb35b1baf925b61ab6b3af82b6d9563d3567a560105de38e9dd5588cdb6393f0634b1c6db41ef530df4d77f6c63c99eea93ee6c4b6f40fdaf8b24b4dcf4adba4ccad10212f5ec86fd6766c6950fb429c8aa752dcc2d45931c3e227a81378cec56f3256d96f7758e78a371ca5638c68c99732b6a1d6e91cb2d71cbef65a8ae7520ca807efc813faff7674c1164eb0e8d8306fb2464a3bcd9a222579bfc061af9aa0d1204925527844d5d96df86fb556d1cd05d1e786d60203004fa57bc73a06b9c3864fcd803b29448334bf31edf498e77b572a285dc7311898dfcc1f1d96e52a5cb6af2c0412d6193d97f1bf9e5c943a85e0d5845446ea1fc8cced8e6137b94be6143c187a12643b85cd17c81f6e6f39ea77d118a82373bdf31bdc7eed6558ca4ca0cefeeb343e64abb1c3ae789fc5f7c8d4157ac78dc78d7f786224ff9ccc54ce36fa7d2cb2c922fc87484d731eed8f3ffb07b483fbb3dbc91d6c49bcb5f8896054e55e67bbb984eee8d824327502144a7ca86f246b015b1cf64760f42bbfa4f1b1789512dc1a5b3750d14c18bdd14e3acd4de579b255850530082515eea8bb58d0a834a9c556517c689f266e9f6acfff55b73b6a7dabe72cdfd9f3479a7bbf7513de1cdcfcd1aefb8d00d2bb9f215e1d4dbefac696ef4b826dfde7a7377dd086e21cd3eb442ee10cb5251070e4dcc4fc83c9036397c0b6c9488922cfe41d8616fd2727cd6d73ae9664b2e404eaca811194e7b3b717e076f1c59ce71906e6e2adff5bc04f91cd1925f455061f6d4608d0078af24d73907d62d295d655765eadb26b01ded5a922e858a97b6d82adfcd32e603f3a8993e6a9af73b804a1be54113295a00faa5da1258547021d863f6502629e107816463cca538c6ff96a2d3a89b27ff146cbe1a24fd3559de78880916755d6ddd26fd69fb35d711533f8763cbca30b58215309b2149752e995d62869c17ab68d48e34a0799eabe985bd11d511b4a46947f21162e97d564b958e020278aa50aa0ea0fe70f7791b6804382b662f53ef9f7ec0b96a4a23feee548ff913d43419e08e5b1bb63664f917c0cafc1e742457e22ea03f9d994fce79250c16a950858eea8a842d6b84f55c7b4bb4ffeaa93522b38161e7a7839b9ce098221eda72e0a215498e2fb9411d1aece3078e1d819717e7f6c66d8a7856581b6d9e785e91a5c91895d6392207c9a88743edfdaee327e27b5b8fb29140689322f65cfdf8203cd2295e69ea5d1c63512f5405fb9f7f49ef0b4590e147f72b998fcffacbdf97fdbc6d13ffebbfe0a098f2a03e64a969cb4df1630c2ea72a4263e1a3b4f12534c1f10008ff0340f5d24fff7efbc6776810508ca6efbc92b1681c5622feccececccebc076e2f093c35f2b2f3e224737dea2756bcf4b58acafdcbcf15ef7573dbacec36ed8eeb1cbf906947d1800f6777441a5a5a37b4394c89817930bb91f8304b9419ce3203e9c726925ccfd3216bbbef70c09cc6bd762f4dea3b893fcd9022cc3016db10e750122d8ebe6d9e65070f61319dde7999c5156ed100b5180173b2312a5995f937b37b42248ed663c16bd650dc966ba10c450dd97a1196bb2956bb39ea2bedcb8db4c99a58a0bc49447bf66f0313e1023834245a401bcb47710f6a7c4309288e2577075e27944592d11e4d9ca1d9dd4f6e71569c99a5c0c6d7c61030a7358fd9f9c087cb1f2fcf3f62e2bc8e5813dd3a32342f77f62612d23a128a342b69ad0c5d04632eda53716e105dc1fddc12151888ce9cdfe063f8716e39c82fadf52f40fe15c2626d6979f6160f1358a820f78406189f0e6e0122350be2579943715cab79e7919b20324060bd1e527d2924513f89d72547006bcfc5acb08422f3bdc533b5055b2e1c79bf0cc3fc3c0aa9672df98de37a446b133e56dadc125f012d4fc3e3207d656d5b412ac65cf0046bc59e4497a3e902df2ed1a3d25b1d31b0ca1f7668b2749ac5a86550d9d3b4083a618a8aeb998d88559b3ad161fdccf188b1e0e253938e44e7d0b138a4b27693cff171005b4f6b5dbf9b9d0dadb7f7c7ea851531835b29db945013de9bf65b6a2749a3c138f6cf23652107f07959ae073d03288ee86dee5b60b1a269077e40d2fc01323becba4e89aaab13ba6c650e88aed5aa9085fd08492c34c93a23bdf81b1fbb79686652b9ef26e67cba0d546708f7d2406738267107bef3c0f649705c8d84411add82054b8c59d13fc52101fb59d41bcd56ab2c61881dd7d8dabfd7eada7a51381772fe37c6cb3394a71e65ccad760f008c19ac32626b13cf9424385a5badf6f61084e084038b9982b0b9640dabe797d0d546db1a08fdd4964730573af98b57342315ea1b6bdf7a9c075a6df4b4675cb469d308b7cf04684601bed8321f5c5f067a0c2d6afe0d4cea2dc2fcc24e72f3281547eb602f928f8c9ec08634a81c13952686f0b13144d72e1381b5861cb88e7966767176222d92c9b0ee64da256222c6edb61fc534a353bf7c761c21a89c56cde767bcbb1dd6eed27becb01998cb8eb7cef226ccccb080fa4b6fdef52b26078ec0ed213d6756e5338b64ef18c3d552942675c4de24297d7e96d2074951c5910d6fe067a138cf4168255c6c12c6e8a1892bb40197aab792c822ce85e1c7acdb89ac69176730309e7dba2bfbddacd2edd12a1b8a0ae27e26c48d977de4b6741b467f76fd27c504ec69529cee347fd0090dc4660d34614f0aab3173e83b6af7a6b33997b06df8a38dc117d46ef86f96ea8969cec488241898e2ad6fccd14b36bf535ce8459431c2850195f96156845528d30fad20cb0a88da585b5534292a4fbcaa3e537da5091711f92fcc389a5d0c24643475fe454b596a3affbc45f267a733d0b7e5b59559c6ee9d0916b717b849fda245ef79ae08e5d2c68a59c558a2790fbc8d8941db3a9f14a951813d148e25e26e680a6c7a934dd7cd67bcfba246abf7018ae75886c58a03af9c31d3c12ac4efaa760d65d7095de9ecece163d461755175320ca4b08ee20148d6238df2b4d7e910db249e5a1b031d87ee00916fb25d971d1c61bbc2728bd6e5a5616359c2a2b9db000bba5b37839dcfb6b90b267c16a72d35c6568927cba3d8599b6f8b2dbe2dd6614f0d8fa3cdb56d660516db8f40f276db61244c4b174166f0a23a63403e7a724a17240d3db4dcb62ae7cae8d5990082339313584d3a6ad1e7b1f53944c80bcf17a3cd1ced3611c29f6c5cd8e564312f7d646ec805562f029131866905a1b472552eff1c55a81185922fd0660c92d8b44ca3f893ee437d658ab8993d6ffc7ee3369fdfb840a7a16baff9dcbbf15e0c6901872fd40bd54a3472cdbf603bfda156f76e4e44b1fa8976ff17ae7bf3027ae47d6f85abe78d9bd9cd87e6f3facdf39b171e15d3511f049a3a97e7d5878453c471111ab38f09fb9e4fc7b7bd242d9eda6af80a115fc6167a72e69f649fde64663e35e7307747e5b3bc8303e7c8d943c4c5cca24ad8d10f098e0ee73155c12e6fe51a1b71eeaad20c11d0df784fa5a0f7929fcf48b00cc5872a2c6f97158ef8064faef4660110408e5135d9f28af0ace5374b9efcda5bb0ca4d6b291c96751ea3c1b93bf5ce11ef10b4547d0e72c51ece1cae3b8b86ab52bd2db11261a74d0c242dac63d6ac2ae1e8ec9205df1ff3d6e550b68899a2339726332d496e854633986b8d2d43e5e6db49b1d5b0744648f37aeaffc03ff9311724f782671cf5ffa2c538efb43a3b6849c7b4c4dea0feab0aceb754a0c1198854ae9beba6fa43d604f8ee1e0b38bd383f45b15682b39f01a9fc3cedbd97e4a923b4b98b95e9deb37afa5f4dc691d2d7de8b9e1ac77ad9d1384ec6a286533ff32bb4caebff43cbdcbda9d335ae8882fe0f0ef261d4ab6649b8044c95ffd763c57055feb7df7ea3da94f0f264adae58d99dc5a20594c33cb628ddb58d7633b39f2c7518730463ca22cde246a2d1fabd16e260c90db51581d43778355bad60906da603cd53fdac8d7cc1e2364e9a07079c035e9c6e92e24ce4e79faecf614933c24c4ee430cb45acb3d58aeff41be28fea22fe991cec722a7c4f29edcf9c66058ce32d23f78a955e985b08b3f07d95545c520a3ba6ea7c7489146aa3bf883925c2a9f8e6a265c755cbf8938802bc5489d7321eb5c5c1d2fe6265c2a3fdc68efde2204a11e24f75e21bcbd4c2c3f017897da4357abf6e66d889e4a9d0d5ab1662914ed16004732d3a6421c2fb6aa5357d71dddab1b2e280c8534ea08dd210bfcad0efe22e2c03894b479d469e91b86c7da02e250b777c5cd472f0847a90452a90289eba67948969a4aea370e9a0e98ebf79ce29fc25a030d28ac740ea73da110d52d5435a5d999f1cb1594ecd29716d4c4dc344ab56c3b410b8f08ce871fd0c342aa9a57ee29f09d59aae6151fa6f14e47241897fec1dbaba0c0485719e571562d4d7900362ef79aa59749a994f678ebd1759de3f7d31ef9fb2bcbf7f31efef59ded0c120d2fcfae23bd0a298b7f6be22ff9e95ffab8ab74aff8ac2b3dcafbe98f95596f7bb2fe6fd2e2ff7cbad7895b7e2bb2fe5a62fff5d9e9d18ad2f154e8c85c94dc4f44bb957abbcec2f179d97fc445ec924c91a7087be4df9856c614a1e925cfe97b6c6918f80dfaa4d3f6d474de967eaa839fd10af754b3fb70ebc397cfaa79e39cf7cfab7563f5480c8693d3d49cbc10fad6d912e7e68114b75bf09c40b95bdf1cc67f39a50a340c5089326325204ed057d3bedd03f1ff7d3d1cc1869c45a7c35707d5cc8abac6c0bb95d979a3fd25b50fe1ab100b421c8fdcc756ee0cce269263d4a346e962e27df4074feb7ecb19c3d5eadb2728e1cb32117734dd2b44f7c685e837eb851f4358c5cb292f3fc92ae7188ef8062b7546c0aeb2c0537ecb8c9aa446bd81ad6b571d003fb807c47c0280839d07c2c2394b9a3222a61b955ae83a8218de6910afcba19285db2c033f3eb7e3ebe729aa5fba1509b9f37dd6f286b9ca80b0dc71ec475d357702d52850c50fa9a0013667c94f58ee43060fd794b6ab5726f18db713681c54d36d0cbc20b3bd003f6468b749d1fad863a6fcdfaa088696bddbf8407e175d4d039e11a72cd0ef35dfca6cda05b77bf76e852d51ef9dd75a127e1379edffefa221214d12e15f1d2f33b5f5f84f9805450a7fc151bca2f7cc5daa1f5d1a43273f6c3dcd5257c365ce7e7517a3f117b07289776b13449384da7bb8eb2abcf8b2161c4a60eba4dc5f870dc1558055428240e45b16566b8f9e66b45ddd8967da7945fea86268f3eb45fb2d15ead4e0a72ae343bda204df52708522d62841c65e84645c39c6387adbd9dbfd1fe16216b3e93abb2ef3a62020a6a6faee6d9d528bbbacdae16c7c7d131dfa174a639550547ba1d8f68079c87f4fd277dff2f53e07e56d6e5fde4dd249d4605038abcc443f3464d5f146928d4c0f91cdad8f258fb970f6510873869af3bb35da706b58a53b3c6d9d96de87bfe10b92f1a8aaaed384dda7de83d03aaf800cc1612d1a65387dd24b23701929853f2c2c1bd89e9097f524d92a5614f6f5bc66ee2a96d8b4d2a8846877997cd20259e17d5c224b706d1e1a08454f17b7c2a982d59eb9bc07c3c7bd92295761628b9d3f2ee068d35179b897951b6d7948adcab2c9338a5cd326922a4c477569629429916da4ab4e55a5c77107297056647530e4dd7d7d1f3f024d846fb62b5c3342f1242b777cce473435a8a60b2abb23db9fac3cb391ebe7f627d7fd159a9ee17e6c11718173301c26e3603843bb038113d92b4b1e9acec346395a2e25ad8955194012d8c133c48f8b4b463cfdece5736b6236d74b96278fb64dac8f430216a1ec479cab1a234cf660a3b39c2e9e67e4d1dea980d5db211772b9f2f911d2b66bf9deb681350d643041b6cc4715325c200f14db0937bf084773868b5795d7bc1730173b7f2e063a879f865349bf53aa3923e5167faa3059f0e85d86d28917e742d6c07be31316960da46eb5a316153e9317d613d634fccf65b95b976a22d8cf2cc7a1e972d2b0b2afa0dca45c39ffbacd2044fc388e3347c05716b3f39b1e1965b6b4be8026781c9d3c6214915a9b699039b53f8b3a7320895c64d121db69bcb6fd72f7a666154d18ac5a8073ddb6e3a8ba349badbb8b95938b59d364c438bccccb72aa985da26bc3d1d0fcfa907f0cc7259db724d0bb0ad607ae0f9090234fd6f02efdf7a52ebf849ad2d436448a17373c3fdf310a84208362ce5c443a330d4db8995e612654468f63f49b4921cbe744dbd6817c962058f46fcd8b03782a5c2eee7c5185867b10178fd350acb1bb0b048d82533e835c1440be3a0203826ebe0d7e8e8d3e54fef2aeced8ff9e116a9f2d748f138579c4815244b5ed771417ccc5b87bf5008c66655c9621d66217606e34e2f8e06ef7e521227c854761e0d74bcb1762f1d24a7719cce74c1024d75cdb44be740cf6976f44661597d670f726fb63b1acf7765160a66dc526f40f28d8fd706be2c0963e11d27d3de309a3eb89aaf9ec1b89a55a5b4c11feba01145914f6faea58aa311cdfb8c0fe7171c9b7490380972a73181c3bdbdec3ac8e18139d9dce456a14a37b26caacde8015c87548c1d8258848d4133d21b4a5e0c53cae4940452f37e237f9fa1cb2ed27840c284d459fdca327f453e9c6b3970c63a3a94ceec056e443b557b54453ef0e9a25ddd552bc63fabd945bee6d853f9601d13b9cf06f4d8cb71bd024d69f36151d43505493cf0641b15211da12e36a625db09119bc1c76d3ebd6865878944b4314da105f7852fa8c8684d70ceb84938dfbc7ff7e1c3f5d98f978ee506b78d3f3750fce0a3670fa379744f23a4773fba2810714ead64cd33ee5704b08f98a4a5e865c761d5f4f7f2da17e93875eced7c5380a469bf563b2511cfc2033b7e555183b6cd2b16c3f8caa82630847db5c24f2c3f89fcc0288e03acaf560cf0eb65602ab97a5d66c556bda09912cce59b679e96f06516ee45fae26b48505464218c3e454ba77a4d6e2af7f4dc8daa960ad592131bb52b57f459218845d8679525a7783850a025f57a546d6062b15f3667c540a3c2809915e7c7d9e203c70e97dbcd722de038abe414a17ff2a271a65a8ff9c74f7404fbbc9a7c8dc37a29bf4e0ad5b77aff46ed79e55cabe05a6da9b2584dbe23540b268d66c0168f553399664afe219c3551208ffe05f44f2bd79801d924d79eda2bd0afc0c926b2d8a9195c1d5a17a50811a66129a03d48c0c850543ac62cb81dc2ee98f9e0341433c51c2c068639794bb68b6256ac4e04550fb4d167a1d52bde196403301f0bdf88e8bfb2faec96bc1022d384a76be7fdc428033473e46a337695649fa5f882e33b905af261b7bbe1d982be35a95200767411b32ce3d38734b6c3dc527d4863abf14892c6b0c9a7ffb911646603d5cd23ac6cf26a08eb6e07e2dbcc51e2af805d9ddf6df00686cde7358a91528939db4b8a63123a206f912eba8adc0c27835e4a3c4e561b3c9cb6697d004e229b0b743fbb3171462089ad54bfcfec91832d362eb7d1224416b5d00d83a0c70fd898d68f3202b4a5eb19e7a93b5f665ca0765f969b1070c4d38d2953d8d80cd9732ded957e5dcbddf6e0653ca18f3eaf4b333d6be413935de7397d7bb17da9ad722eac72a9d9656c2cb7fce196a1643461f8eca3fecdda0f0e2a6bdfd9567d5eb329784bbd6cda4da9802da9ac390c1d3e4e95135e39b77d7a20f2aed8edc82bdad29228497af31ecc972adbf1cac1d92b9fa9f251e9d36db0bb65b7c254f2c45cd0ee3e3dd8dde8d6949a5273183c2a78b205e562ec56149f3dd19685a12ce5263c77d48ef3c2c1a1fe17dab130cb26af7e51b9a40bac65de55af2cc495e9fd21685bb17e2d2e93ec506ec5c6db7bd9db0bd3f8cd978a2dc0e695b1fb5b3440aa48613c61d353a8ca92adaab24dae46fc17f2d3ecee6ac5ee369ef03665b5590a45a9ad38031bd6f5101bd4c80f71417ba62c09e7cb3dc9b6d160a7286c369daac6a706fe0146bb6caadb0d851354c340608b0ce24c40ecfe2f91db66835d190db6e925329b8ec021ea461f49cca4f7125362863cc3b00df3b95b84f368033de8886ec2a91af2bbaedd297914ad3d40e121eae8ad1d65bd6234d94c5103586070b3ecd4d688134c6b114da2c3236a8b999506f80df6462236d1a81e32e14c4f136fc9c167b77130128fb4308989e9d49ad082fc9f9f676cf024dc33b3d88107a786614cec3ad2fd8e06014e6c24df8cf9e93729d70e5caffa212c1fdb6a089fd180c664a8523d95e9a69fdd74c3be304875fd4b19bb9edf77bb10fabac00eea365ee2cf37f8f36dd31ee7ae29069eb32585c52625a78ec0c79e47b8f9e408db07d485b91d657ae45c4a10d7fd136ffd75835fbd1e0acc7b3b07268f6c33f7b64141ec36856fb767a8d6844063990b6cf9327e7a38d6ffce7008eb8d705bc2f221f63c4be6a595ef17a659e5402efbe9839f486c343f5d0373e63f1cd6f5978795912e84758f6cd65d87a68988750fd2461f00614d9a95dc28f1e7c978f4edc32caadc7f008b40cdc4687016c364308dc225dc567d0786af8e3afff0c1776280e9fcfc13257268393ba09eef58812e1df50fcafdc7cc59abdfc27f96e3074554fc638cd07b9f8e8c2daec467017ccf69a5397133382ba4e3bd01922faa93d1abefe3106722ef9370f940fff9bfb88e415276d4b79eda29a7bd84c9f3b1a74ac9279e7a43fff9fd96ebbc198fe65d8713ac7b7e8b527ec9125ef25b56c209129204298c220c6f71eb8e1e5e5de196819cf9a97d8bf8c25dfbb13a3ca12c76d289240d875c696f043f782ea7984025d10e4f291f529a0889642926709699bc3518d0a6a4d3bff1d425fdc7fdbe88100dddbee33188aa9535272fbf63cf1f6eaaebd5e3a3d337efdf0093df379727cdb5fa5432a8383c791e191497c7f1287dd76ecfd2796e371b852ea3fcc2dad471bc9afb638b61e91bc0e475da83f1784a0fe2b4377048ba7df1176ae04b12ef742e06e98ffe24a920c5ef92f085ebd6fdc6ef0f6f92abee70167dba7cd6ac792b4a7bc6e9cf9aab67cfbce7cf38e9b2b67aa8added456496d75555b756bab616d35abada2d527cf8345fa0bf5961d500eeb37496dff4570be7daafe9884fbee3dcd18fc7e1f79c1657115b01daf64fd05592c28b04b47695bfcd2d86bcc8698971600c0041435663c33132789864d198f43d7acd4f3f15056eaf5e56ebb77ef781aa35a8a3a38d893b2bcf20e2cde820225489c78bf680b1e334083ae9881fe8ade590248b9f6d45b8d8cd26ea90d23fa0c2d05660186fd18227a0ec07f622f780b9889cd43a525b1bae32964b693e36392f1ca235605b6cc0c4564b0c0da654fdeb60c64ac109452e0bbc4c7bce1cca6310d2dfda50c74c13dae7203ce1a1e71c3e3271bfeb7bf9520dfb4934727c73b2bb9b1010a5e1a89e153341b521cab4dd0378ef026ad96ed6e44a36e21d9fa77aa08438b040b084fdf3280a9be36c8a2fedd3ab82accdf54483f9c4230076616848bec3f7105c262a52350465324fcbc8002ab6c35d0b2bf3c3d77f4aac8605baee2cd35620d7061e5ec681e99ddeb8f224e361c73813c95a66f7d733e33823aea9028cb7024bf8e5d3826d1e458b4863d0d1cca2b663f4127c60f0584d4d8c60b3d6d6dbcbbd64e3a02be3494909bdba0933970a310818e4146ee1f1c40a6527de279fbbcca87de13ed1996b0895d921ff222a62802c7dfa396591044d57f4dc27fc4c479fdc6bfd8253eb12b0fe2c8c34787ffcc3c1fce78eecd5dcd5f1eab93f5cdddf3bf7b7578ed79aedf383efc1b1162ba7db10260e7cdddffecf947f55a78f0a7bfefdd1cdebc688afbcf3e0a06b45574f848af1cfdeb4fb5c366edef560addde1ce9fbe6f2a5facb9a5e8bd270471c056f0e57375c0fd174fa430947f497e89fb80dfe108772343b69a951d93ccf60e762f24c5a857b601216b0213749416a2355b2ae8628642a58a8b9df5009c3388b19865165689e48a39ed423df0ac2c02160b7bc6983a472b63262e5e6cadc2d3416b8af0e7bf3907431ecf17167b8d941ab399c4db694b48437fa2aded6557ac54072563f3a2ea8e84a032ef8cfe5ae0165752741c89aaf6a74745fdde8efb6371ac79c5b1acd8ffec3467fe13331f2afd5c6d56adafaca59147de54c596f38b96d9ffd511997b5d4b84fc997e6398b1bd5cd934705a8dfca41db58833026dd498751efff5537f6bfd80dae6d5b47ccc3ffb02bd328e98d2b8e871f69e632b7069d937060b875d46f11542fd5ec1aed3c4073d4407438f3db84cb4e4b70d93a5eb5c7d62a69157eb45da6096f0d10981c339a517433f456cee0a8ac2c06fea0b75be3fb8a8ecaf17bc218e10b01b2e22001b4d3c16b8d13820bb703e0371706571eddb5e9ce050ef9f691f89abedb3dfbda0128f63b9f4b5560d31140662b674355e610999fde744cd67a079e016bd5ed25493a22464e40008194c04c065d107395d2ef5afd188397b3e2ea6681d6ab84bea288a2810b7da73eea2096efa08c5f9cad3a9cf0fe1037ee897585f597d75cad7ed01a499349016204cc851a3272f2211b2e508972a781fb1d7515f1bdc1c477d4ce58723062bea35a293a3433a0829b11822d76d5626175a0f94da26161b5b5ddedd131d27f373a861c160f316cc2494a42367b4231f33d7a1bbdd55677fabb672e52d6b4c9d3f0fe3943be1b9851eba11d484052b23003fa6d1334436eadb81992c0913884f488cb77d8012592afefa961d8d527a4bca50e6d607499200c8b3e923c8eca5e053a7a6a8e2ccd1abb33add8be841e9946ef38dab140fbefd215d656247ab07e0888180bbf92d8608cdc79c64beb0024128c4eea343148e8bb162233b4393c88e4d912548366c88804316ac01e2340d55ddc1e1c0c0e0fd5008489d9e5e278abf27873c427174c46e9c971f1cd1335a8d53c35626045921419a847f5cb5131e470d833e810dbc25254ce097bd2a44fc6adb04acfa8685ebc3125b5a67852aa2243a130013654b909272a7d323a87dae9db31383c0d1f9b2d9e82bc9e841150cfcdf7ccd7a20e72bbb1441335148b3c536ac56a2ba2854c1f96b423eb08e140b941851c1942d0fbf9d023574714f5e271d8d572c3a4d4baec906662530178acd9507c85b7004b79781878719834d226c9dcc1a4185f0393d54e0a6335316bd1cded72a0606eaa38add06a98fda0912d77e5fc5e6739b5694beeadb4b44f94b6fb546cec528e14396a05ed42cc9e0e87d9294ab37678d776599ced88dcaa9254946c7ad3dab267656d492b3f6f69d7654e2295081aa38ee4e5ea3cf5267e6288b66e99b645a2c62a1174602c3f0d14586458f32c500559ccab5766464d3ee1476dcb8b277282adad64523a0566c4786f76bc02b79717648d45c74d0b214558aba0d2a7a6d4f6afb553983a24f0bd30314034803c3e0c3e1f4e6a75181000a17a382a9227ab154ce19ee2acb09b3d66810e188f2e3a3800249d403a768a9864510e41c7513f6c18baaf96c71173a11e5980d6be96e5b7ef877bb4f3ed99f3241ed4760aad0cd01f560cf3b002b2fefe0bd5a91aec823ab55aff6c14aa5a8981581d3a504c61068b069355d29adf37cba493bd518271c43b1921cc721586b554e48ed1d0aa2e714f915b36be8cf39d01fc2160b110392e03873609cc869cd18d975362935452768363a529164ea11fe1ea3dd54b250c7d8e6bf364247a3d5d63ec3d22ba218c04fdea1a0d48350740faca0ec4c50019c5828acd49741f78e89aea0fcd2da75b78e0cdf61b14a2af6d5b47f7eb6a3e1c1878d5d82da626151ba036106cb31b21022918947818b6ecd8bae3f408a799ae9c2a4d1744a8b9587e55de95bef6d3f04dcb7584a60de4e65d42a4896e87720bc07d3e2b1bf18c5a562f0273da820023e38804cb29668ecd966048627ec65bc198467973b90986ba1cde9aadd1f9bba3b265f8e7e363b4fbad017a67317637690de482014911574aae1693dd0ca274d74098ca051ab69b6399eee660a7bb3a34157ea900facb07e3bb2228eeb6c7f162b6db1a2c88e88d270f082db52b21a72ab16cabcf40629c813436a757661fbd39cc96494ee276187579731ded68342fba81db4369a96ecaf7608e97fbaccdf70109a9a947539f954c78291810b82a98b2f9341acde2c12241200b233a665feb2f4434b567151fc95b817d1cb5bf3f278190522b02fc58e63b6a6845bfa125726d45fba3117913d1dfbac41ea37ca3f40e96aaeeb07842d43060d9b55a33dc38fedb25866e1725fbbb4e4deaa8712c9f6eb814acc61861cb04ef0cf0ade6f081c144492c19c298e9c8f05e2ea43e764f511c45f588a7957bdb72bb475c9c07732ace20e3dd549f79a0714000a233d764ea1cf13f583a1fc5ddf1f4438c1521f7fa8b683ab55325a36faa27ce4fad6ff36de9db547e496b19be0554e678b2b909b64dc44488ba3476232e64b5ea1ecda6b1ea53e27884c8168cc9358038bc988f67dc978d198c48c99fd5543d4889a7247e2ed45c5dd93bf382c674610ff64207ff9dc3665e0ff69ca444495e8357365b28afb7d3d94fb909873bf40af3493a6255b777e60e88640e0e0ef6da59b433629440733f87b5da69d0a90ba1753b6ac9215b607172345b884f518967fa4cc2f8a9a1096d3d6183e991f9e6611444e143292eed15bcb632bc3d740b219bc143ed2ce0fc472fd188a443501fda76f90314e38c7881697c1fe4f78885799b1270bb0e0eae703aadda5b0afb8958cf149b8983594f7995d570196da11fb4d231a10d785e15e5d8362f0f31312d1e57cfa7ca386098e6896c759dac21b4f1688c6d7114f4002e8c36cd6403cb57c09f2b58ba6dc7a5822a6d0936d7a3de5c8e95691971c1f66acaf9c6cb6f28c722b54c21d456d628b7347cb15cbf3008b4f6509d96c701ae3bb9a92382662f4673a23f6dcd41ddb1156647d82a3b11c7a46de0a0d2bebd5a111586a2967b37649c593582add404aeeb887cf9e161d81ac3d07b4629442fb3fb2971d328cf85e9cdccabbb47e0a64b91b8996b3291c269990e1af76e9cc58172f0be53080dc5863c333196f69a61b1fdd8fc3c364118d875a4d032a54d62a5f288de899ad4ba0c89d1afcd3cadb0dea259b00ec1cc5074c52244a2736571cb1d2788112f60c04670d1d184988168704eefc5877d2ba021b5932d51e1fd58e2da2d8e9366e66d6a3103951b6e3e335873f8533aa11d0cc08e5fb50fd3242cd0fa2d5baf866b20caa26deeda47a62a9a1efd3ca224606819441650b3b35a6f447ff214c4a79fd5a845719f7e5b0f85875e9d8f98899d54133503a927fabe0897fbbdc4bf8818cc606fa095a489ebf43858649fc8573820099576e441e365337007f4fb4d9366db888406da68279b3edad8261a73c41a0e168d07c42059e8d88061927d22eaf302fe2aee2cace4f12f10ae7eada6958715349b0661e680ff3bf59bfab97f73075baa1b37bba60e2b19327def2106e7464f7b09f7b5ed050fdc39da73d0e3b9f4181fe44cb4af327fcfb12d4b93bac5c07f1a8a993e59075aa3118677ad1ed57b75a1eed54775a75e6b4be6cf7c5afa31a43d259cac563371b1e2eb69f01199a033bb90d8181b101317c042d977f6c28b1cf2f2e0e0a348ef175ef05122807bebc7f06366271bbed697791aea90f018124d1cd096210e773ed6dbfe47c4c7b80fa3c6057e462ebad0f6d47de4de2be7ff7892edb61efe6fb7477b923a2b37f1def3eec2b3c67d5369f84abe5ed09f9046818a0eef32388245c5cbf2753ebbafcbba8c48a2cad11c3b6b4447bd84435a133fe83ac96202fbc3bebaf702ae614933fb7e1d70a57b27eb6c44cfbc8de662445d34f802ca3e6218ef34c3a862cdd7b4a1686d97c31defbffdfe5f3f5dbe79f7bf9717e10e0255a93b699fc52661091df358db43af877c591cf040063cb80bd10374e31058adb4eae4525ad5b06f2cc435cc2c5dbfb77c1feacb60140e8264bc3b0a47059475b11fa63d6a54e80742184a2dc7cdbd901d0918a0d81e1466844e69f9631d9aec77e5f68853d77b6c6fc26cbd0735b86776f13d0804d5f5de5086362e39aa4008076bbe05165608f7e5c7c313dc0f7b4942b2f29e6bb2ae563a1717344e127906b18a4b71db07271c93910762b51abaef8b5fb0117d8578228418224a5fa493b8c811ee9891884c4de17b338860106964304fef68cf390b177ae7b9fb9284b7a9a5b185b10fddf15d31dc4203f1abbdba6389fe8eef18c588d3a41d19627017c6ce5a0971ffdfb5e18a8aaa6e4356ab5f684e551b1e2ad56505dd170712b3b7642071112590939122cab4e8da620e2bcbca9680f59f31630cac9921f0d4e317a5eb22e36731c51f1839b8209fe527bc3be5e32b400603959dfab98589365bc61034bff885b92644cb1d8f0a7a0a63b62f478443435106af4601cedf868d41d316d28c14dca57408d654cf50ef42800ee026369c3da7963461b6a7efeb4ed3cbe2cc1e49dcee1205cc01e5cdfa1e5a718973bec82d4e12792739d2cc52d035f1782d413fb7325462eea117cda9e6f79fe4b9fe6a8b31bfdb9fec2925b2c5cd0a3386c39b7c70e2ec8bfc422c7333b49fed941e728088275ed62e1e562fba4a7703be1e2cd99cfd373dad3cc5114569fe7943fb869b6c276cb6b193b5319633a573a396b4578265c590bf5be6a7d9d4db3e8fff9867f55c673c9dd07c734c947239ddcc4b0be38de5649f935913afa884e3d8686e1e39464b019eba607250d2fd3c4d149c0257bf69edcd448c03dbbd207a678a06281edb9c08c6014cfe7a89629b1dedad453dbb4c45d27827de7bc45cbde6b342ab426af3f745317f8bbe5c8bb42c371c3d370242348360807b62e339a5331d2f2699c8c058f774418406b719732d7f9fb36f820e8951482fb2db56ea0ddbb76a69256f47515091f6ecbf5000c3b74fed61edfd94c54fc3917014340ad94166536d9d9699358eb6bc296f3da287622fce3ed87712cfd588481bce125a24e32761662912ec0c8f7aa3de7c4304022b300206d5102713f2250b41fcefe191faccd10152e50ce99917f4a19dde3b0e06f6d1371f86734442841b1a65a4118c9b091ee1a12ab929d7668053c0f14e740a8208095bde4780ce627591c66f91d3f69f47fdd1f86e94bdaa6bda7854e85e1c3a75629758862396a9ee0423ddfe808dd879f98d70a35347e236e098488c7c2e829ab25657c981fd321b0fb38fb87c1e6f6dcad52dbf632b82b244aee5f1cce02a07bd59269959d04e499711d1e4c8c79228aa3dea75f7defa78f7d9c7231903c310190beb8383bbaae1f0fc47fe747775c98fd8dde996afb436c686bc717fd1dab0ba5d380a2b1a2172bd9eecc7d9c00cec95930649955da28c0d6266fd041da175e01e7ca66211e6c9d5abc1f14aa192cc2084675adb1b6b3b50b4a2d25e65013fe3422d1ca7a55daa5a25b64989f7ef0d99f4688783c17d650732649b383364dd18e2a834b832136dc3bf6e56f9d27124aa156d0544f112769098abdb604e7c9d656d13dc86a78c75aae1cdcfc351fd5dcbbdf5fc5bf55a5dabc7e01afa8d29ccacf0fc525d813b9c411f707770f0833bf718a3736a3e60a3493c34c9b48faf32dcc547e23caf89c30ce78dc7a69af2ee76476dbff614528c8587298104369488425e87e72682e7e3abd75cd0b21f3ea205235c9dd3ebec8bcb18bf7d2b4491c1ce0eae1bc491f5d75cff6da38f285d13ae1b271f810b17d6043616fa8a3d7c8dc3b217ccea985a5333edefead26eff33ff40df7e07dc324483e70eaa24dcb9e3f846d41ec90b24c639ddc92b6a1a4e57abc40b2ec32127d0c5997be9d52f619cd937f08a899f5535aaa397fea31a44ad74e05f2a3365fc8426c38c5afe901914ceeb113e7766e4828270bec36fd245f6eade74edf9d4908aecf5cafcc4577834154457719e85b90dae5f9d07d7f82c491837ae9b818c6270af9fbf0aafebee3c5c1af6f042245fd7d3c6e05c1303db489dfd23fe5dabdbb031272154c6e316871f9a319b1be100837f1bde53a56a4e5ff6987ee4e53d461736d98a15e92c21beed251f8e048fba53af69fe9809abe7da34ec63c6d207be6d3cd64ea0d9bccca5132211babe702a5707073b97a244d68fcc0390935ec2197bd4b8cb2339afe8259ce0a94bb07966b0395b4e172e37e87d9293bd3c238683b708a9e2a17e153ef8ee55b83023ee71a55c9f1e93bc44ab18697fd6ee5b3d23cd07bcd21f4a6750346350643e53f2a28813bdac5feab858579e6f7d12f994579421bcd2abbd560b6ef5f87ff71878542f75dacb5e31fb0e6beab2e9f5dd75e0dd4b4e8ce046665698b34e9796f75ceb8413cfa8102f53a3cb9e43a00004837078b1dbe7e0467dd85f41cbdef896effed224a9badff8731347309447c739523b9ff52b75bce1d3b89d72c2ff476fdfd10b7f6dd671fbd7a6c701c9d47dd8686443daced6dbbad90c1ec0d0b90f1e1165f5b061aba123d5674f588398492fed6d9b54ba6d93527146fe1593b12e6d14433581fa9f360ba6f418f13ee8e20407bfa119fb60f26a114c80b0c0f130ee1b13605e9cd062cc81025ecd052c80ca71bb0c1690ad5e7bc6032aac2bfc43000fae0434bb2b2a4b2efe96d6e8edab388f01c7d4366edc369550db3d64bfa5aa642fd18fbb4d43513f732e12a20c1e274ee5b842120c1c2044f7c33c2abb23a0d17d211026b5aa295c271b37a2c61dab458c3add0fa5628d26bd2e364e597d5559d64e69d7ef8b4b4727639dbaf96959d70342f510b3742976ba43444a1d8648e2b93ca381355ecbf469600ba0854705d36975475ff4d4dd404930dc0a09c05b1eb358880cf7398909ba9a96a2e65be84174a45c1ae6d7e16d76dc40e4b52b470a3cd2b734169aeb59de850f9431fd4c5dd383366131ca1daa0728ef6762d4f1b469e1b105f807abe9ba704a43cf9fd507e6b22f176aa20fc7af9e368f93d3c86526b2b16f4a2e534110dda992449fb6553416b48f6e6a18576d200a7e9449b1c77e7a1d28fb0a5685e67166f06c2b3a8da1693fb4ec62705aa8adde64e5153d0bfab9fbeed65c01ed71835cdcad279bc2c889e70fc238e8d47323c992e6a1d411448416b3ee41414c85ec37c86564667f7d3bc10c59906cf7192e1599e66e62fcd1afd30d3c83822e2570cfa2f0d3d11fff5ca4d307dafe4fc3b348cd29119856da30ee521f3ba99dde8c27ce07936cdfdb4a87cbc872905626c027bfa1af55c111449e5809384b6ab95a7f0e0190ff3f9154de0df021e47f49141c159d0679f1347c17bc8d0c390e4f8382460c4efbcbd6783ca7818926fe6f2d05b33abf1d299a7308063d171cba99bf8832dddfa9a2b57f19c55dff73dea94f2d050b253877215e6f0b518cff0194f049a40060ced73fb78891a022b04e4e23d59bfd9c85737ba4bb0b7d7d46d71a27fe822e5feb06fb3fd2cd3b41ecf9358f5d326d21e4876edbfb986e4e0177e4ffa06ed329e345fe94a070fa42fe3f223518dfa5532839fd7bb5200645aebfa7191b0d06ad28eecffc25db7150d1c76bb5bf4fb3e4723af55fab9dfdfd7836f13fb668be7c8cc29f63f713119de9c3f2235b17fe280626c6b9821640215d91c474a49166a66e6e8f324dbcb564a42c79f6a6da16f135ca4b5916c25ffa67c95a942b59259972b017d36aef4d71664ddcc432f27fa1311921e8ee8f82ee4e2421c2350cc1fd5f89d18ba7bd0931f2a966f9fcef01fffe4044ed3a55b26df857a912ab59bf6bae60e8eaff61ee8cc9afdf438a840fed6797306ef587d9edbb24f10772378efafe882ff3c534c63de004fcdf1225074ffe3dd2aedbfe847fc556c9ff2c37bdb93fc3d5dbf1084d4178517f8e84f76c304243e62f70ab4ff66e718d1335ff8eafb8ab0f7cc91a75ff9116beadc2f74ff38726f4f31992f4beebbfc64daed5f6cf71cf3a433fc6252cfdfd947bc99c9c9fa4469f99f86f626e9a7d278ad04eba2e7cc9bcdfd3e293b7adc25d5c9819cb8255a31f27ca1c85f91fe95abb5ffa295db351dd6b89e8ebb791907f131208f69371cc0134fd3fe8a6ecdde8f7295143d69ec6cade76fd49a2ef8954fb9fe98663aa0ef4c519ad4410a011dd1bfc267fce371d7f41bf02d07f4f5753a25f428b4fe9eeb3ff407f67315166f9bda0f9db418517b8176f52ff355d17b4fd7e07290205e27f4fd777346968365c41fa6ed246e2bea50d6c8798248f63a41578ec0fb1fb4ff51be35178aebca8ccb878c11e7dddc5209a1e31fd807ecd24688aea6679b512a94b15385ea6257df68a57de2e072a90130580647df777e841688bdd757efef8faf0af4ed018756e6e7c36f426fa616cbee9123bf9a1757f6fdd1c994b759427732ace6897496f46a3f4e08f88f5dbddeb0d11911510fc6bae0be422cbd2a20fd50fd6af5e7073bf7b46e3f5ff030000ffff010000ffff56757336ef870100")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffbcbd7b7bda38bb2ffc7f3e05686579a44121a433eb39d85159699a9ea6693b3dcd8130f3cab66c18c0501bd2a6c0777faf9f2cd932903eebd97b5dfb9a69b0255967ddbacff7e9f747ad8b2c5d4d65fee25debf6acfba0fbf7a3168d58eb41efac77f2a077f663ebe97c9e4e156f3dcfa26e6bb45c2efcd353597ef357d19de7e951ebe5385259a1fcd6f5f3f747df9f1ed1649545cbf13ca3bff39f79ced6dfad0ad52a96f9385a7e17d8ccd6131ab275ae96ab3c6b555fb0f5adcc5b52c83c5dcd54b62c06bd218fb81464403a34ec871de2139f10d6911d32b41d52793ecf8b6ea35fa77a38a7f6ab53f35590cc731a89b3203aafdae84e55962e4741d4e9302964879e0911f5499ff8c423ac4316a443a39333d62182745416cd63f5e1edf3cbf96c31cf54b6a4c4769f08b1bc5ba879d2aafb1f0dfbee4b77397fb7ccc7594a5937578ba98c143d6dddac0737c5cdbbe1f7c7a79c10e6935516ab649ca9f89e2a9d023ec1d46629691f2cf9e2ddeb57ddb2c438b9a36e1ef31b6f81598c2bcc26956cbbb5e36a7d0ab158e38466abe9548870b3f92a69c858f945fb2c2857ed28b45369aa3a134284dd6c1eabf7770be579b2dfeef98f69c8369b9ff4df9e10426e36245bcd429513518fd6f37ae7d2f3e4c9596b9cb542a72f34e49247e54e8983714243364ee84bf4078b1bebf28c2cf2f9728eda8810f1664349d9b5f28d64726632c2ee4816af3f676ff2f942e5cb3bcf6bef26d198b1cd467623399dd288878378c86316a869a15a68bf9bccf32b198d3caf7a6c0bf189556f141daecaebd92cfb2a7a417c5ecd5a8c0d78b0156760073ae7797b5f990570e6ed355a35c76b30e4517912eea933d2752e56c588465565b25bccf325654e9d110df91106b7466da83c16ba21ae442f50e7b11d9ada19da400d873c1ea87adbc575b56fc243b0413713d28837b6e66f92d68d8762224d8b5c0661c0d6e1c94920c5440ec261371ac9fc721eab8b25ed316c9cfffabb10d26ce2962e23c805e113d9fd6b3ece2821bad43f7b2865b27ba45cc6c647e589ee26f97c7669daa0b273c6dc9ab613d95d65c5689c2c29e9916ad86e916a503f87d8e46c2dfb61f7f878248bd14fea4e483f5653b5542d27b1fe66592faf931f7caa8f3cb765a9646bd91622f4bc4f543ac97a8607d150c82ddbb2c074e3c0667ae72cd042e6857a9e2db115ce7ace28de9a5198724b9aa9cf2dbaacef07b6def275754efd70cb18974e059f295bd76f17d269d4e9cbb1936c13a953b02ef9b52ed980b016e838953e3a58b47da8e8af75d116e0631bf34ae6e15f2a5a1eaefcb153b901de07cbe5ce39d807914ec117ced49041d974ebb15caa213af0bb3978a133b53f1df8e022cfe5ddbd5fbc74beb0898dde1c55458f0f75e7ad4aafbe2ceeadfeabf34d2b04248de791deb7789ece238962789653952ff150a8e5f36ca9f25b39adeb7913d5f5b4693bdc6cdab4bc825ec999da6cc26e594b32ce62e6b4ff73d4b857c460187ca2617d36429ef284ade312289a0144bc4cc661d90763d2ec7f7d458cb3587d799d5868532550c9020bbe22d10ba2fa3a003e324ea8c4253a8886f6d3c83475725637f5932c9bc2f98f846938e89d8b08632d16d371a468c4cfaa6ecafadbc47c3b4ea85e84cd464fffb1ba95d38be22ed3551c7f96cb68c496a37cfeb9f55252122d3e17257c342314421cc946817199ff93bded4ce7ccf0f6c76a2e1cf407c32dafbe35be688033774d22b63630510ea2e1b69e4a7359ca413c14bac278a8e1195678bb45c5548a90799eee5e5fea427c3064be3e4b7d2900ac708468d84dd5f2fd78a62863feb1c92d77330dbbc57c95478af980029e474d45eb2d6387e6fa9dd9121258cf7a5baffc37ee614a8e495b8848df5ffaeef2bc9da4338672988272a71c687955af7228ea6bafdd0b2ab40e789d79aab2cf901d9677856c3b193d8df345d5f1e7471a178b2a80205d201831bb11d081f64f543ac8e338a13412762b30516d91f2622f91a4486347f81823019683b5756a310fbd7275d1cc0b07476dbd905432cf731613ed542fe8bb5e5bcf3b9675ef5aa183b0e383fa0d5fdc7b50361be0ae8d2c59659953f615ed6c36cdb98884d91566038f13b3fcb1bbfced977a0acc6cde332111f67ebbb7ad6a93588476b4bbc762e67907da406d6d2172ddda7d736d531ce0ff3eac2fdf9fbb858a56f97879f7663e1d47779eb79bd21d1717d1727cab369b9fbb9f562abf7ba7a62a5aced12e6defa45132c8d293a8580c09f3bc03b9b15cca93aa8803de4373f0ca4dfb608ff8ebdf1a905e634b0f983f18daa1bed46b255be3ac58ca2cc2962f41405ffa91ad631ffbd86b4676e56231bda3218fbad13c8be492eeb7dc638cf94e49b6f5ffadaaab2ce6d7376d3d176f23772e64700007d13b0210a1de11fd48e4bedeb3fd4890e35f9ebf7afcfa17e21f49cffb191043a73e7e7df9e1faead57be24b00a4830700a00a65df5dbe7e735523c251ddc18581557bf897d3c37eeeef90b6217f1b71d927ad16f101c69cd5ffe01214c0befafa5b8db9d290f90e2a752d51f42086d30f45bbe7879ed76b8b0a5cf56928be50423a21e3a1685392104d20939ef94de4b450e6399bdb07f33b003e1432e687a23a562e6297eade84e28286ac1b4de71940d532bf5b875d355b2cef28db46b89881d16fcbbd7d41c9793cbe7d4818f683ca627c3a5acea6e64bd3c80f58dd416f5851e7fd2f34627ed49de9fa4effa0e7833f1e0e3b0fd9291b9c0d6b66c51fe77470f3f9e664d861a7bc3af654f26a86c939e97cc196b39d8babb9471bce4efca8d7c5e955acf6b82ba13bc4fad35f1c7276bde5118f834f94629209d308cf92827be3200b6c1dea9d17da6c41188fc5c7904683de90f1479a92a6a17844a3c1d990f575ced990f9ed1e0700ecff44f1c3faf85be22a21f3f12206f8cbc361f916ba08a17307ffda20c177f1cb98ad7143f69bc96c6dd0a2cf92c6bcdd631dda06d724ec13e28321f51987a50d90b165febf51b6ea5f0d3f4a5ad503038ad4bd2edcc3a35b3b6af71ceed57f3ef8db693ae6986d27f187c73a5134121f3cd2891d97d6fddc38ecad03fcb5d0ade2c79eaee2bf9b8d5de844bfd9d88fa72927c7cdb44b5d9037137ba72900c77f3ee811bfd9b97706545609114e9af43c4b07b0ad2117c22157a005c48064a92f170bc249969e940f5f4eaa477b432163c847e2f4a6c8d2817f7332948b05f56f8aeff5f18aff1c7658d067fd9be234f844937a47a03703899b3d88e8cfc04dafa60ad0fed1ddf318984b2085ac46477cc2c9cd8d4f5810366fcb8be9d4f3e827ba9f4c49977424e311e3f7661fc94e59edfda5064433697511ecb74f346e8c02c8882a4996508cbaea8b8a2869918eec4653591420d73aa4858ef7a91292a782868307c3f28457ab775374b0cc9c30e67fa2b22b97cb7c1cae96ca6177846cdd569e970cc22ef87e43cf331586dd5b395d2940aa2d0b94e749aa78da1fa4437f3074b6c16f0deca1aad742668d0a76c719c8ee794e992d180e7a4321c4cf7d626959e26b881ed46452b82c96f9222660106e355930180635ab68408e17f9fc761c2bd2983c59f69c92e37c3eb73b80f0906d87ccf99c64296141247e0f416746dd71763b9f283a283f7b17cd178af09d3ac871349f2dc6539d63078567998d6772a94813ea03f4720530755c222c55265b875d6c77ea5613b320a221c3c9d17d35c73edaf2589cfef1eae99f8faf9e5cbdfdf3d1ebd7efdfbd7f7bf1a67d8ad9fdddf3da7177a98a25fd5dafa145465b1165419924ca9f6a6bc460a507af70168ad54c3d9acf31d57221aa0e866c7d0fb0c5e51544d4bdab22b307f41a913f89859f61d5e0fbc8a98a57971e8dfb520b1f404bbc9c7f56f9a52c14c5f8abca97a1e53ee05484acde1f32579f0847937dc2a3cd86e4ead36a9cab98b003f8c26b69eb893c0fb789e7d15084038bad9c9c0d59b00c35b79c4b4eb2f9b2252b162b6fa5f3650b22139776abd09fa310286bb1cc57584a3dd99b0d795d96f36d3176a85f5f0c900739d3243fc00f72c71bca181513f02feaef578df9b144682bd4b0ce5ce75de290ff5c8990a71525c913d10b92f334483a1d160b3948861cd84028a81221c3ad6e7add8e3c0fb3d30f43aa781337bc75ee6f9ced60676a03cbadb1fdbbc0a9437f223190c3209eafd1df4c7d59be1b87d37196e28bb66461aee42488aa3be5f3683c5514f47658cde60550a7aa2b1fa2ba2b4fdc03c678249e98731f8ad00ac5361b5a3d8bf516b7c1f1f16c9c5de5b9a81f379b27b6b9b03b9bc7aba9da6c8ea87dae4f8e05dbebad2d5f65e95bf0be955666a52367a149593961410a72baf911551a271ba8a1d0387dd5bd811a624cc8707a651f5b21952558daed1e5b4703b5d9104c3519d2018a55f4520d8fb2ed164b939afe4a4ab2f96c1e13aeaa051df2580c867c2ac2068023258c258c6762fd67f9f2f34aad941ff13ff355f6683a8f26851f7373900b3fe5d8f1bee206d2e77e481db04f6c32b05989a377b753c0a412c60b95df8e23b5936f5209e3fad2d8c9d569b8a571b665b66c661f119b4e3831d70a61bcbc0bc6f34c973637c31bdb514e72958e8ba5c27e4cc6d3a51953f978b85834cf96f97c3a3545ebd7c3c5e371ae34e3c094d6379653b4ca2f47968c537fcaf3555653d2b2e6e656cbbe1c8d8bed36483c6f4a13673350c6b65beaf28bdf34f8d5d51df0d1b903240f1b7b50f5e3ee72fe61b1b037801f6f6b4ce6978893ebf9d7e3b306726ce01e6e932a51d9a3af347bb73f40afbbe5dcd2900d7d9d30e43321f9844f79c617bce0971ad6c4258baf2d420658192803bd02fd3a11aaab5798327e34153d9e89892d303dcf8269a7a3cb2dc4059d0ca643c667fd4577998fd354e5cf64164f554ec971ac8a653ebf23cc9f89f68c17a2c717825e8a45371a8da771ae32ca98adb7385f0445a7c35409fd1e497a392886f52d921ab606c6549fd412ef4ec523d94db24138e4a948bbc7f37c9c8e3339dd6cd240d5af220d6c39a1eac97d6d84daa1cbd5795ddf2d982fdd6c335f7f032682e79173d2767924065a7c09012e0a35adef687093d1ded67c6b61f7cfdd285772a90cf645493cbe25c0e0c659a6f267efaf5f8a929cf7fee3ec6fbde0fc14cfa41306406b66f35b758919a5b29b8cf362a95f587017ea6e7359cef7ab79ac0a165c50dbd863838d3ec9650ab84759c52bc0774c73505bb696a38698c62506c3922581fa69db95fc5deac3718cb24635a0a7af665c4875974a54b79601c84e87e1cb8174716f6904333802e384824a77a6799e24324f2189403b4a4c816810758b3d425850be8ef4ce04cb907e05ffea1355ee298dd8fa02e70c92e5c08813941627688ac2a0172d979920d9fa2b8d589fea0f2557e833afbe9543e6ff2429523148c81c5cd871dc2027c2411ce22ef919fcfb2006dfac6f6ad2491a871ec8a14fe36e3912cfa371b71c63d71e3690a4652e5d6fb97306392690559dd355424d201c8adcedd4b4421dd1ad581c39fd8a371b83de3ca2116350a7d0b922169dceaf9129340462c16310a751434a1e7b1e52ebb6c21d619b6e9b609c847105060ce3a900cdf6884ac613917a5efb57c0e978b349361ba73c8fd12a609b626ed3e384a67ac324acd98b60496348991b1dacbbf6c8ac8dc9d1f2820b4b53f64fcece358d4a9be994689295304d9c6aaab582eba7839bec663904918a642bf6432d5297f4db8e20efd2342f8d80b36a428beaefdb8bcda2b6375c4afa7fdedba3463aaa9160fea2b81e096b10308f4dbf8101ef76dd6ef4ffbb990bbe317e2974df8213281c4507e6188cbf8e289f35f27bdf7c45cca5f9ee9c51e9561c4172fb11b5d48515e6f4714a652dd4d3224e5b48ab978506e18090cea52da3c6a65368961c930e05dd97a597152e842972dfea2a544521199ec43f77f8bb40a34529f7a604fc60c298eeaa141041f5a50f12a502c6ae46cf518fab8a940ae2736565719a9b8a6358324619e445f6b845a08eba0b99eb0bc6196c0c7854d52e458f478d6b2190e7d1ce951001bceace06a173d3052c6cdc826e9e3b3551837b130e649310b7429e56e4794934a8b505869ee748257e6b56639269040c2fea2e720d901fab44aea6cbcd86ee26b9644ad42d5bfc08d45bb4cfc06ce816cbf902648f4c3562adabd8496bd6114110357db40ac3a912ed9eae6429f35495cd978f22ea167964d08bcde6672d92ff4aa36e5c76eb4dd96f151b942415bb1d0f8ebe3d94dd8ac0994c8d7e04db06870a9c6da3eeb878bc9bee546b5764efe3cda65d1e726706b71ac948c4bb904a10775117cc087df5b23de6a99588e94b39f8c74371dddf5f2be0c87c7ffa4df281ae2387f9d45cb2bbf5d9cb77afc63a63bf4e3d776aaa6622ac3668bd1b1fcb9a0160592f3c0a6ab68df43c740a3c84be2bce2a0bd3c8d51263fdc62b657e04758edcf39ae504b4ed9059f5484221b7e39c92b7ba5f60ab0195ec2e564bfde01cc7b4665da0c37b5d6b857de866740d4dcd361b2a41685bd0643a554be2ab0be3f7082c3f1e092bc53ad6cc694873cccd2163e7e28064adfa367498d34005e38a1718e3a2638c573d1292f9e0adf51dad8593330ede1b702b9264209045d82db4da4d0ffc6f1fb93a0b329c6af6ea59f91dc8724de3495a5f073651ef59dc45d0356198e23760eb1a15515ba52ee5de2da568ee8ba4d2e104044665b654c465a1c8ba2591b31c6b9d17161c81fc09bbc7a95a1a7cfb9da46491aa25717402a703d9190d85c3248b5d51a06e7d8d3aa0dd571752d400d2102b0b0e72ca474de51e23219800d1c228d7136c262af5fc4164a82929592afd46e23d668ce3ca31627545012334afb460cca646dd9a0703e95fd47559343c153d3e1295d66a7a3e0ad24ea7ec6a22e2410aaa3ad35d4a20340c66836470361c1a1a75c693c183a1c12da132d037177e6639ef9261efdc93f15aaf91658719b1e794adcbe9c767200e841cd8bb18ec5c3eedce5451c854791e9d768ba58c269e074cc8bc54e85055506b0b4d45f5de213719e998e28c1f61a1cb5ee439e1929b9ccda6fa62b399b260bbada588ce0648cb0d50bd47b4a4dce4bee249990e91a91089b3cba2582d089f198164ebfc04f8a66d0aa8c6d69119cf2a6947ccb49c5624e54f48633b89ca4ea26dccf30ce4450257c13601a3607ab79e5906888bb16044dce0b12976ec48a49004f2844ff82c9880b93ccf69224676e74cce9360829d3313a3c144934e56cda256229d39431e2f2719e13316a4e5c6509ea776276cc6fa6a301bfa119d31b695161e69000996763d45663f863c654647675dee333fe6ce39779861fc28dc436da09fabc096b62aba826213f61b1bd097ac2e10445a6f2e0a94001c8878cdbffe952ab6d9bca48af5951f6d396042c46596cd97e8491af1912cea0ed52064ba3b0d6167045d9cbd64b6dd6aae5022d65b3e12a4e606ceb06613ddb5b7924fc5daf237fdb579c87de0fe157f55d2b866a64a7a48cba185010e5cce6f5daa2a2377a0ea76c8b6960bfb8d6aa1d2ccb60e4b76b76c09cd2bce2c0ba6207dc360a17f4085ab689ecbe53c7797b85ae11282c9ce88f123c04000e8a0fc71571f932945614154cc6b5b802a31e4c032f8fa18e729c54a42777cbbddf24c4ced0e9de722a5d37a82ec6904a059658b7cffac6f195f60190bb16854b268ccb234b01823d9eb99d4e301a9cf824f14b3dff8b62eb7d97c76f4218afadc477125e868f702a031dd785cc870aa2e56cbf9bb0854d8384bdd290b81dc9645d1ba1890e3cfe32c9e7f8600d56a3113de90bf569fef337aab5b1293bc773f42db454271ef0b9515d1027c291492dd039b6d85475a0f7044198f21608f45b2ab4a1032d68fbb851ee5f36c39ff38569f29705ca1a85bb8787407593db6ecc10fc872be80ec2784466499fb7e4e7bbcc7fc9d77737a65a5fa1d84606b957a93f509a88e8a1dc2b69e15f0f46ba5339a3ad3906e87f544c4f141c6ba646bdc2c167e62d6f9be7ede1963d57d314ee8e5c909879acda54645823b7b07305475d75dcc1794558a5a2148266db0066ef076efdaac86d97a4f219ebe6aacb884d838588990bed71bdb35c649b059b5942c78db16a3ee2a9f525cf46fed33ff4447d215deb3b5a4260f18ae9efe91c0e685ec00a6771351ebdef3a908bba3710100c933a0b7a5f2ec7cb5e40be05d5325739b50408f75d41d17d7f368228c85d8a5e8f13b683e8dbac75a64831bf8f56a0908198fb3f4adfab452c552285d609c45fb7997f355d68051979d0e1acae6cb7172f7cb4865afe6fb5f15f517f2be49d52bd89794f977562a54d29557b83c56c1a82be3f8cd7c3a7d92356afb4a57ccf3527ad6ebf18c0557f663bbed6459cb5b31e98e7295f067421a264c280b2d932bd74c2f84533370fd495b380be079d45d0f164cdba25e128dd2556f8e66fedb76a5b5dd7a2b8e248f6da17ed49f5a02e81d6e618a9e700204dfa7533d92dd74fe4cabd85082c190e61bd456e9ad903cea4f2ac24a32bf1cb9908c8f1a6cd7dbcda6ccaa0a9ffee783bf8353fa1d31933fd2a4df2beca051779e7dc8a7972399a54aecd209aff44f3534061e58779e51b2982f0a8c81f0444f0ad8e4c528d2b5d4a5ea345d4ef7d2597108035f81c7329207d677d4c5523ecb55b27f7b3627a8faa85fab689dfe4161c75af46f7cd6bf39bd391dfc71733afcde588292721e3ee2327c2108e1a114757b9405a36e349f4fc6aa10fb377dac75d24618b66450fb3fcafb33535ea822920b5c311d2282855c8e04e984b24302f56501a249bc1fad78ab77d67a21b3d6d93fffde6bf57abefebff5f4fa3dd1769c9044087ab04ad2316f21eb90ba7e2bf7eb9cf11f7bfffcdb79ec7951f7b3cc334a2e75bf5adf69a6ed77adc5bc28c6e1f4ae052595422d5bf3bc35bf5579329d7f56712b54915cc10073d9fa2c8bd6723e6f4dc1f16a51d2893ba4f5b085fa5be1dd5215ac4dac39c938a9badb16e28506d92f844de2b1786199cc01b8cc7ade53d10bd2da961114a12a89c1915035b719faa4bdf391a6d1569919bdea16abb0c4fc698f8f18e31f81a369c57aaa1f0f161d75ce986342f2718b5d16ab44e50796390a2e3b1da0deee1d69e81b98f6064758932d0f379b1e0b90021e9da93aaa6a360c45a781eaa62d0672d8b7dc2dbcf005958c2bfa99811e8708a3be8814d0a67b51a09470725c64e32401664e8e2bd5b81a265737b3691d78bbbeb0636430f71e4f9a6d555538b73a18e37bb7bc6c8bccf3e8a2bfd09c32ba805e0ef317500ba4b29b71d95d303cf28cf14c489e754bf61fdbbbb1a536f883d883caee42400f1cbcf66e2624635b235f6e59d32828eb44321aa92756698392f13886ea9ee1628203b184102fe6eb710cbe099f61174e04e468dd482e64345ede6d36afb44560f7fae2d73f3f5ebcfc7005c2066837bac917bab7c19199426dbdba5eac9607e901500f9b0dc58f584fd49d2fa10e09d35f80d6af8e190d2c205ab3cd26e974f80cc5439e3c9c789e5e8292174f17dd89ba633c2c69bcba3d8b7aa21980a4d056aa7173d4b6e56515f77d84790e855e3920200ba8dc8b05d65184dd0ceb157633ae33cc5e455b96c98a16787272c26c3317d369dd124875d8de885e80790c32514ee1961b51aa5b742a4662a6b3adc01813bce5e32c99bbe5cc00f5728ef8ba187f557eb2b584aa443bb0044ce60755ab9af83e18105adf09446532a70d0c3f08bb8dfd5fd393ba63c1d10173d874ef9c3636263f505948c952cd1653b95405691cc471433a82dd2bc8e34a07872b71fac74df17da5957363349de39bcf3727d075be293ab4fb3d3b3ee5a938a56e0eedfb373e1dfc110c3b8cf5833e3be58938fd83ceb3813cf93aec6c92793e93baa7ecf8d49269a69d6a465ab372064bcab9ca272c780c96065d42ddaf4eaf4e27dfb3978221909648013309bb86654065276eb203c8b1fa12a905fa6554631c524f83242cb4023efc49d757e7463c2d09119448446849d588052f69c2fa89581b8d27ff98266cebb793ae49f0bca43b1d6713cfa3559a383aa6652a447edd453e9ee7e3e59da81f379b5e90943799488344eb768ac4a878ca20b19c54513d6d36a8de4a22d12a0aeb92b8c2a2a5a81f371b724102a3e093584a68c6d6319d318789a8b643c638a6c2f0c04a9d6efe2604c3cb96c2021b125b02077a27b3f172fc55a3c8bf8cc64b351d17074f026cbafb547ee32bc02154ccfc6f9662a6f9f12c7d9747ff76fbdff8ccedc0b78ad91ed883ebeeba71b654f9628e330af5bd03bb901c03e144597b982f71f091a04da2f050afed0eb7c2bdb1c97111a9866a38393e2ecaf9501ff27183b711d6aa68fc8e5ff1157fcb9f39f7f26d439bdcd5b9bad01c4e28d3b2260f04a28ef50f4d1f23e5b3960b5a5ba69b779dd3ca4056a3fc9f73b9a0e4bc58c8ece1f9a9fe21ac125683b3bf2d358a52f14a774b9a8e05230d3fb2f4a4d0d351ed4ab74f38db4ba01dc41632da7afd2b1837cc3365380acc973b6611402894d59a279db021eed7247f101b6e33ea4c44a5c0179f275a2caf21f0441c291829d96909ceda424c3cef9ff8d96c54577da231b3ed94bde4b0b4f2bc48ab3d431737a521575c554354cedda1a7c1d86e49d049975080a98db5a286b1d6ab7a6901d9aaf484da79d5bd4ef98c4f79a177c9f32015951a00322f85f64f40d3526b2113bd203b4f830c1a02836c28a241360c9e8bcccccea2d65cccce17c1f34e87cdc4e5e0f990476232c83a1da80c9a87a9b8a033c6230882f55cf46901abf30cfc2b3edd99a68231bf1092d3a988bacb5c6645345dc5606e436129ec4734e5059ff1987fa4924f371bd8f7d5898a315f4faee43347eb81e75cb1ad5dd709ee966a32442f589c5b967bb0d043014e7c15f2a97841e56031e4ba7c0f24dea21ffba88cd3544ccd47fdb1a453ae0bcef42103ea826f06439e321f6f606274f5103d6f24e985ae96b95b9dcf043ade5daa7c06ee175c2b1402c59c71601a2a9354d4ebbfa2054ffba933557ec838a479c588a67c06fc3adb6cd2cd66c6d312a7321b2eeb27ba6bf546fad86095db64eaee21b00a22dcd0f50aa660711fd7edc7a2dd63412c42f32190d194c59a5d5029cef110d951a560575f40b1b3b35f543b3bb1272fea1e83e4e7b3a0f83cc65990d53164eb4816aa75e6bfa7219f49fa0442cba6320ae3e48ae8ea34fcd13b209809d7b0a93afd0bd1e39762e6793333e1c1e2fce8526f10dd973bd13ee36f312153311b2cb414aa7dbdd9fce35c5c4398572c54344ec62a66eb424cf56d1f64622669c1825f4a6b9b0c70b310514833439dd2bf314e4e404fa3895b91d58c0cfa6e29f3e5e62a8b81c7c1fa261342dc76884e279e47ef44c1df8ac256d5e376ab9cfc17eb1095c5841707b3ffc698e9dace7405131cfe22c0f11753e8814d8d5157a0207cd603d09958f377f61e62019620e3f0f713f384dff1b76cbb95c2313e0b8c80d9f30878ec9261da8399484b5335c902a67b3483c8979bea2e7575559b52d2d9e0076035a8da0c6b56225a9dd9a03734e363813101c1f6f8c17f025cbabec71ab9fff08df07b2694e989539265e248f7e9aceed3f5813e3d18320baaaf00aae1c8225fd2af878406a186f5b5b6e660a8bd3b8182034b7f248b4a956ef71d94e33a9e1bab9d9202fe0b429fa5cae3714e70765870268474f5d4f6eaf03cd5e9f09d64f8a85227272ca8b5f877ac6b8c1d4def5c1991fc9ebeff057515c5ded8719a5c9b41f5bdc5133e636b254228f6f686baa4ad473a451ce83096f68283510c9fe16673aebf3b0b3cac866184c10b7403ec0fa8815bbcfba97dc2ae7c268478bad93c0593bcd0889ec6cd9814477f61cfafdd545f6b86cdecc83543a26c29146f0cb6028dc87faba550fc057aef4043596540574fc3dbc6fee14a18d5dd14a0c9aa71688dc080fc4184a0b1908e52bdb67d13220e987388ce18d765a12fad04196723958f972a7e8c9a01f2d3eab3b8bc5422cf2bdb154228cdb804a5817b005ee0066aa8312ed954b0c474b763cfab2c81b07ba3656994c72318a9e99d659433f4e16892ed9595899d05885a0e780bba027daab7c9de16315475f0e0612583327043f343243f922267c1579c7011d6bb7b615ad4cc86e7fc96bfe22bfe86bf000f196ccd30782ea0e895f663ff5d48635e2215f482a68cc7e53506382f9e778f8fa18906911c7645d9a1f7254d4f07ff2dbc21a337fd3e03517ff3f97bfc1e9f0689404dc10ba1ca9b1810782c3d6f2c8510cfbac7958146c528e82716d9727716e12f987f30e7d5fcbda160502618499a688cc594a990f44ff45989dbb8a87605d0a42114de33a80cf254c4831f869b4dc413516ea1c10328c2c7833320599a8a095ed447e2d158cb9a345f35eea4f6da8fcd6d4ffe9bf8cfbbc7f310fa048aa68dbdf1021f81db86293645f262900ebbc7c7bac34205cf07294c9675d190e28d51c55870e4dc0a44101fcaee9ed7d605caaca928caf2c102a8e078a97239edafa47f50f300ab126e03c8ed65518cd36cb3a9ca813ba63b30a5ca9a2fe32864f3ac2c4b381ae2cf3416c1826de0967f7140868b1d24cb6cec5314066305cf33d6cfa8e252d7c07cfc15f5ae9e09b92d950eaa21352e48e211bf1eb8fef88066c6140db0adf9d06899faf5c0c645b4206638f0400a7527b64d43b01bef828f50c7ffd85849c3b52b57cdc75c3e83924a1370f65ff88adba3e4df727dc40aff3977b0533f0db73c0ade000656902820ff4d8478e379f48d387a3e90ba5f4398765fd237a0d742691345147cdd6c6eed7181413dd29b9464c402b7fa8b0290a45b767ed0cc198a08646762c8ce57a2c23693f357daa01608c94acc6054bba2abeede806ff973beb2d78ae7bda5d50bbfe5a1643cade8c6a76c3da54f792ae92d84ec4205cf3c8f3eeb5a4645e5a1aa4efa904f816f25e2050be07589263c6d12560b66d4b332d3f39333f8464b023083cbce67ff1b9d7f84ce3fb29ddf2ec462b35997f2bb57e2648f5dbfe21fc5c299ea0a0a16fc99587433f5f9b9d3992a971f8da55854c3afd283053e9a67efa7e1fb6a33d5b99668780fa2e02bfe2c455cc37501fb62fe9447927fe04f84e2aff9350c0065adf87f7d9ec9e01ac4c5532107d7433db00f52003b2880e1f377fa596571f001e2902570a5887f90fc1d63c10791e3367df5f069c5763486cfe3847e104f4b00cd5662b5d93ce54fddf5dd6ce8174a32f5f9d42c4fdc32c4f833fe942f19ff957ec01e78269e321644e812b67cd06ed4e2794f9dd9f63cdd689dc03f8a8f582ffe8592ef4827d242c83a9bf08f83480ecbf6f4a378aae523a8a53ebdecbd68f738a661390dcb7edbcc020ad87c51d6b0104f192766f289101ffaf42bbe7c25eae9e11f9c09e447bbeb65cdf92ee733ac20cc6274affd16e9c4e8a036778141f872d01bf25148537e51b99bfac07b60b632fe44dcd20f5cf1573cb16c5cbebe771ff98b2da4fc1fc405fd1ac2184c4fa9ca9605657c69bd12d94a999ea17a156034f2a59621103e96e5748ca578ca3f88976ed97efd4c973c667efdce3f88dfe807c69f5ae293ad13f134f8507b3fea48493fb00e3116936e3783487c80f5fe38a1676df1c16cefcd063cb2a8a6d5ebbb60b998e64b82a3019a56cfe312c87f26c55a43707fbddd061fc40b1a81a79219a3ff5f8c6f8248d1ebce19b7e7e8046f8c01b08d22faa1a45a8c7af2874a51f917163c0246265970e41cc2928e597661c0433feccc2e20e1fdb35bcd9506964f19bf129f8d49157a58f7ef9af1a5d6c87ea22996757d06aa5d50f81ff97df0c97fc66d0feab4b1e4dfdc520d40630df89e5a0186d6a47a2daa776c07fe84f197f435eb1b35add7259cf15f7bde1d7ddd5de48abfee2ee6c5b24c3720fa7780e8df01a2978c6d9f56bc2ccfa357d54b790eafe572d49dc92ff415af8f2463dbab12508995e7694f4cabf23db872a08078ef79952b85ab1ac91f816f50d93e85daf6a91a76781e0561a7c320b1136f61cb120ef9d1ba89416872aeae10247e49784ec029d284a412424cac6c1573134c84fe817fc65d699632763f8b00265e500b559db876a451aa6c554ce6cbf3bbe0d2dcf80ba10697434e132144bed9240f17f52c4101bd2d169504a852924801a6816c2c30c2055f9babc32ff8f1b1ca623f831360a31cbf607c221676e96eb174b76c6b149a5b937a161eb9c2ecd0f0df4044964f185905389b1204b0ffd6dafba1aa294ee37102f45c4750522cefa6f0e026549f04c40750ed4010cbb8ec1e1798310e6f5b3c421a50a5a6531970bc8d8521482d3a8293a110aaea26752868fddcaf1f3b30c1f461501a32bfea45daa74a33036dc778f315da36a483fabb3a4150f3d037bfc8af6a3d468df5c80fa855a7c0cae52085a81f3a2f221aa4c386ede7e78a075a71492a1e36f89797da1091df09593917b8155a247ec7d716547cc8a79ad7cbeb4354be1ba055be1c2019fdbb2de36fc54b7ad78083fdc62b05e1ed379282ca855e502a11aff0f77dbe2a962a7eab4abfaef8f42de36b2d18c7d5d72d5651a48aa2a66432737ef8559089df68a661f25d7d2f65cd3b29bbe74e5a88acbe938e2ca25a5e4a8b6f5c4a779a12e06fc11e752ea311c8f9902fca9b68255ed085be9a58f02bbd339896be7f56b87f56f6d691b875229e197ed9425cf2b0bc6b32d7add42d0ba64233b7163ce28a87fcceacfcae382b626b294033d118845868446b002f857845f1eee2ea8a0595d8065337b13b26b8729e31a467f53b1f49e765a5775b70d516e2d2f3e84a7c0de98201fb79c62fe815e32bc6822b3175a075ff23cdb89bc0fc910ca614c297158ff915db961074cb8ceaae33422bef7317662e63c26328744293ddde03bb1f01f44cfad4484242c68fcc63548947e2ea4931e6a347f64b87cbf8b509fd2c103e91d5a3ed410f184e3ff24b8a506b8fe2a15fbe9f9bb79333ffcc879249acbe9c1847d5756b5fea316b56623df0d96aba1c975c5d4336973f12d72dd89975254f5cf60be42c600dc555cc81b5edb8dfe3e6ca87a283f97a67b84668cb95882ccd1b961c19d8e30f8656f120d6fca1bd3290948165547d135847a58d9dccd69535b5962d972ef25d30f8baec97b6f4c9a3781e11c713d4aa0b5f1ec6759016fb004c902fd37136813a0211e208eafc4f5ebfbd26027e5d48a9d2621c853ebf7e4ada48d6959bc40c0a0278aed4b356ddb757ef5e7f787b79f5e787b72febbebdb37c682b240378c09c576e12cad55b988bcef3c8bbab975797eff1a6bbeb409e424d75618275854c3eda5db5b35ebd6e7bda50eb45eea46237431344cc5c06d866431befd6c743524aa5947501826d97cde3f9acf2f881018990ce066a883b198ba21863781729485c8aa8139b8dfe1183216360e4416502585fb355cf6bbc0e54c595db6c22667789cbd0c30630d737f005ed333beccfbac7ab452c97aa1492639bf8338b3a1c41a8bf852d9d73a447a161ddda23820b5455d6a660519a8d0fb809c88bd929455489e8417ddf82d1e47ca69934c81f24432162b69683a4d381978e99483aeae4ac42f726ee77137cc76710379f4ffa30301372301bda281448086cf113816a3439bfd5426273ff9586f980908114f7fb9f09a4714053968793c0c145577d59c82c9e0f45ecbc04b128fb5b4f47e5922015708bcdc175368e0128e3cdaad34a351045031c6a11556e0f84e38ae32f63396b8e56236ec58e9d1dee065ef1e6d996638935d7fd2aac35819068f4ed2a2afe481a451eb95ce638d4a024af42c7e46e7d9c41bf6d0ad5c199e4c756d7a23e425067d5e172cc203cef6dad91d16c0f2c4f7e6c7c26fceb5a9c82072b7276b57f6898eef713088c002dcc786d0727e534a35f45435dd4de3298462554b4d3039c08a99d76ee6460eec0450e61dfab99d563682c8b3088fbf55c23258631532309b8ee4e19c8bea591782bf144eeb4a64128b9b05053abf99ba04680d926b984d9024ac065678e42f14c2b08d98ca00d4c0f00de3038c3cd061afb79bfd99e5913485c69ccfc9d4cf41a881f63018dccd06a00c6c0b56e6a1a5a83a786e68e76dcb0c58a984f7716d778fe879d90b61eac5b80de85fb0eb8cda12baff58bf103906b6fe4900577aeb556d50a74a00c54de6ce085590e1bdaae254f358297004d3dbebb9b85f329d55c84b0abb2b84af84d90f51a172a3ca96cb744884cf62f5c018873980dcca2a737eb9bf5690af19ec5e3e9e9767b0a477d6ccb7f11a77f642956607071f2fbf0d4f6ebd6d5879db9fee1e02caeaa6914f323d2704e6f8e86416908e171e5a45afbb7854f24d94c804982f40106772d132aee82b1571f895e303aaf28f85179152442a8c168c840818cb3956ac920ea08daab5caff42ded99587adb71f230ae0d23d75b2ea18d7bf3aec3e84dd191c54dd1a1379fa1ab6bb571addb3e1714c68e11abe12e11102792f5114948323fc46e89bfa1e868ad0aaa5a5d9b812a5181fe465f133ee2b3e071e951128a7333e3569c8f048cf8f94c24831fca6b7687061eb17e38180dfd5548532324e123183e6c362b6052fa190e204c32a42551c3f417bdd02e15661adb6a433a6d5c755471db6cd506b779d2f0824818301d2d941a41814f63d6da5cdc086d6643512d55b275b7e25ff7da635493546fd50b5a47de6928784ff66a99ced3835554491ac70f4b92e94070824627a7b557105831002dd41a08ed0a859670986e4e011c16343d7960d52a4b1cb8bb8ec4172a71ee8cc2400fdc6848fbdd44a539b191d50dc59f3efe7428e1e0a2333f3eecca7de67417ee38faa19fdb6255b740fcc27faa147a74553d515f0ebed0880d4b69962f1dcc2fab5ca3690f1d5185daeb74f0961c0f93985e5166b8e0b1aa6c5a9fd352547f33d8dcac077fdcac87ec14e77670b3bd190eb5b49e4710e7b39be1cdf63bdebfc94ee1594dcb0c55b63c01f381f8048b388eb43af4e95fc53c0bc0392ad452ac96c9c93fc896abf2d231f2d442ac35510d0dfdb7aa58ccb342f983aafb315b3f2e9deec722aee063e9c4252c317de4cafa91c6e243080f80d568e3ed903b6d6803527f7000bc03b4785e158eeac978aa86d0ebfadd9af7b2fe4252c97cb91df29192b1ca0b1f6af6b379e6af2f22a8f2ef4f006f2dd597e5e9622ac7196f7d7ffa3dd9f2c5bc58fa313f82b54dcc1780327ebce55f8a3cb9d486673097f6c9afefde3e3979fffaa7ab5744e73dd36d9abc132777cbd37256f585887eccf3028a268930e0b59cd9e7cdec26e084c6f723194db48e1d390ef3f9e70242ad5da38f1d5def4f4d27e07b2c8ca6425341a5f316d56271cded937c0ddadbcfa0b286276874e941173ceeee6d14674b3fe8f5cec13d5ccae5aaf0bc1f7abd87f6ad1ffad0404407a9c1b9e3c6ae2b778472ebd7497c7f67360a9569987b3ba0c6e0423bb8685725fd28666bb8d629b571809468374c38f4f1504435f91403a9d1544224543d13256bb49a1aa6396b51998aa031b3d93ce3d1e00b95dd995a8ee6311bb200a2b80027c3600529ecb4007fc417ab319d2029c6e5ff85264c63a3ceed5fb176ed1d126fc1a4289d2506b1ed8d4883d8342b9e4a6a9f5940a57812d292e7d50f8d396541d920ee36373e2eaf66cad0cf01035253b43e07b6689d321446b69688c6194f45355f817198506db259047526b9b70158f0d514d178f13e196f401f6e67e0e8086952b98d490721c28da186cfe3e5e83257b1c2553f2d98e71db5bf5275207dbfb0d82f66f7fc25bac153d65d8e142c822298adc1e234eb7e468a11d27ca22ba7e36c4d4b9d41552c379beaf10a37308374d77270ab2cbe5328d015949bbfaca17caeab3001f06c06df2d035d118b6d6203269635ab576652bff3911895a3937cc2b623cb5b6f1c3853a24a0a61af5fc615e0a181013cac4f8f033546b06cd5c8c7a11a71f3f2ffc36aab27fb7deb126151a23de306cdc259791e2d2198015dfd95766595f30196781a011560feca907634672c98192c2080c87f3192580c68e46924ca211d664657741dd5f2ca88f75850b618952d467d2d8a9b4f6f95bf30d092d1121a4b5e8ed68faa5b6f06974dd66b76c36fd7c4e0131231068bee4235bc2c400f09723f0c742fd30a9a253f2bc1f44264a5dd3165fc524078389f8d0bc557fc13cfc51d0d014b60cc2973392b58b05fa325222fcb159df0090b68d8d5171a60877ec0b9d3b4b5c9f03cf2f40a7ccdd0c22e2d25f8d57e08d75cbaa0ff2bb555f4cd837fa5c9fd1580e827a105463467fce811fd54c662fba47b6251b64f75bff8a7e027fa89f567f413488d4fe05e24927e82ee38f367f4137fd0ebf1f5d6085eec06b90488f9041c88daeef29c473ce1290fbb4be36c23dc05223cacce2410b76aeb5e6e77154b77306cebda3e781db9324c5dd4f025e4660327ca9b8df60505d920621b30deb805255bff6add952d1a4c6284fb2923697fd6e9db462ca20e85e332237b804d7d9fb03a1277649c0479c430d744448d2d1be32ba03c4d30ced6ab0ae04193bfbf30cee4fc85b5a89446609bf0432c8e7b3f0a56763b87fca8c7d776b60ff115226a2036dae2b6a486a7f71537f80c3e808c617feb63ac35bb44fb34a9e8aac60468665395e02ab717744923f01bf9ba3c07bee4ab7c0a7bf3b2554ab4af3f52de7884138007c209d0de0561ff87ed3b243a7a101fea01d78029aa3b028c9a70b2582d090b1cea42d98d53b8a4e42c7688d17f3c14d79e4734166e6ff13ef49c2fb4f4f8d7327c0925d7e3289f17f364d9fdf5fae5b3f7efdf10e6a3d8effa7db95c98a9af9bc99a04f1d1a081555b12fb7e07075517e7706d308b7958824330accb401d9a3009653429b80e51e60e725e3930825067d72781452a779ccb17513e5e2c75d833fb05853fa279962b19dfe12250a5171481c4e95c82bf37cfca4b14002050dd701edf99abca70f759804098543b2c05b62488268afe92b7d2b408f7b679246470ed795891fec1369d3e9da26d156fac97a0d392068cbafa23ed9486795e4cd9d63fd053a72214b19d76e500b5ed466cdc4e9d5401f8aacf159fb911149c79bea36cbd124970f4ccf39e01b5f1bcdbae0c77e2a35f9551482a0e94f824e9ccb8cb8be6d32087f393d2eb06cd59f04c185f40b120090230694785d0318afbaaffa0d7f37fecfde8c781a4670f1efc80d40778d70d04e1377d2ad1cf25c85c05e1375d2b5116ccc40c6192b55b28dc76e6c0e3e85865a1b782fc493a34ee46f31588ce4e87d591517ff81b0be2c15be7d823d2c6e0ed506357c2f8437a26523aab687ef2e2ddeb577f5e5ebc7cf9e8e2f227c2c9de09e892ce5b87e3e3d4d8bfa2537d7b5629cc47d26ab339795039f447a6b95d3506732b2454cf6fbbf38582b2f90c7cbbe013cdea36f4ad0707f458db422dcd3c95d408f03dcd75b9fd173b799cd05bcffb51885b77ef1a2c0aabcd8d77b7555b2f3795e21637cec5746a89d0b245285886e2b671b3f7eb57dfc9515f962c3073705b61b310fe6c03285fddee220cda2012ea124e2538c89798a10241242725af4a3b34e99d2f18fa9f8b88de41c744e32c0bcf5b6884c7fed23b57a4b9a8795160c97329c8764b020d401d267e63d798f3281164531ac3f8d070842b2ebf38c0822c5d085b53fa1d1e7265e37ec8325e1bb45735ee83d7941f4df8b4e6b2c35619014e2ec190b913a9a17cf81534ac354e529cdf050c6831cd445a6136212f985193a30b275df2ac9330d6a785f6507369cc640dc312e70b6a7e8c9b8c4244f44ab8d95927e10bc6182f20321557bc108b8eee4f8ff9a8f5ee70adf842dcb180de894b330848c34c51b0e4eec499467fa79e77765e952999d5f308ece9528b88001c01b19c6c365716b75c99f2e28e37093ca876dac92c1522ef7852e9421ef05c9d88cb4138045a9f08c8bc7922a67de5286fc1d324f3556906fa3aa149c98281db57e0aeda7f06026256fe580dff1d872f110b49134cdf0a5a9889bd125606f524564c36626b298e306ccd95cb091c6dd54090318e608cdbad5986941720649685b8e485b970acd0998f6aa97bea0ab4ea79aa37f636486b01d78102727bd0dfe1a73dee7d83cd57632b9ff6d194aa92181a317c542bf84937063f9fc262054e00ab944c4415890772cf9278e58179446788e23c0b46e2111db1fec8ef05067260bdf4a2417160616581b772fa3c16931af164ebcc78fea308211468ef5bc543011f5c99a57869c1f894362ba99400d4a0993164c1256ebf8ad2e6090bf60a89a3cccef2422fa682279ff81b0eb46098d2a803cc38d547889866d516f727655d2a8643737752a9bc6f243b3559c75cb6a7b1bb1ff2e67ed8db47f03c455476b22a087ff5e1fad1d5db3fa19a74f1fe9dbf7e7c75f9fcfae2e59fefaedef8a44bf8d3b7af3fbc29df38e16f2edebfbf7afbea9d3f58cfc6d9f36ce99ff1d9387b92cbc8eff199fca29f7e0097fc4dae7c42f0f46e95e02953a94e3bd18f26317d07d5871ff8d43c6cf97ec50faa8a1f5415dfac7a3df9e3a1eaa9cdb28db003ad0cf9e587b76faf5e5dfef6e7bbdfae7d724cb6fce8f1c5fbabf7cfafafeac9b87efdeafd339fbc90d94ae677ad272accf5c3b5cca351eb62918fa7ad6b79d77ab1ca54ebc56a7ad7ba58a5ab62d97aa7164b058ba0d6eb6839c7efabf96d99f05845fa815869173cd1bd7bf6faedfbba35b4d4ba96399ab00da07e548fba516bebd5fcb6f558458d7a1e5ffce69377ab2c9677adebb9fe79bf52057e7f5171563ebd1fad72fdf0241fe3e79d5caef258deedf7c85687ba5aef57aaf58b8a5bef47abd6937cdc7a27978d2f2eaedf5cbff307e4e29a70f2e69a0cf94cc5e3d5cc27d7d7d7ad98b7ee5a237f36f38ba2250927c5689e2f894fae4fe3d3bb320be9c96a3a7d2c97ca2757575757bc756d3f267c3acfd232cb492cdba8924daaaedc24eafa6d41b830f589d38f235db44e6e490879a6ab5c4e2fe5d23f807e204245d827f34c119fcc97239593adebeb750171e03aaca4fce4d4895d296bbd26797212302d072f428adf8a6809cd7d74eac680f954c90e43501aa0f6a1cc786ce90d61f48a407a207d342f9670203a2f9610279765e7f952bca3617731cf976cb329e241fd951158d62de6558b8082b120a710a93911d1743cab10e91d182936bb059f9007227ec79e474e41ab83f7b71ca16b75857d27b1c65ece985fa74353f9f8b850328f460271c2bbe573990e4f9f875ad5427f53063579de911e8d7dafbb00ae9649d403b32fce4acceb78a73de132b41c4f7aa6fbd4aeb6f3f94727b88723d6fe8fcadd90e692f543df916e4be7fba7cd286d55195d6f772a8be5735be729619d33e7d3c2e8a218dd21e87eff17bc4596616f89d160d50d047abfa12018bfe6038d5c376e407ca1c41c866cb2c40b1fd70a9ccf424ac68be56891275fb4453d0b727820daab7539aa54b3aa99afb2c12598c31d88c5f46d8a7bb119617d686b319b8287564f0aebdf27ff413a4745550869f0c26aeb858fde45a58a868eb00e957dd2271d13e4d7969461f1219f8aa863de57f9d42ec459ddcf5c7d86db82ba9f56e10d1345f5b4853c6e46568a85e26596d66d45563fead0b9c46a72382252cc0f3b715d45b45b45d451c88d84883b98489becc0a8e726f6d5bfb9e095c29ee9f96663da87db87ff2042b867d90cc16ab1e9cdd6074e70709b8c0ab34db448b2b14dac1a9fde1ac671d7e91f37a7fdeef77d9fde9c76bf67a7014e62edae15c23caa441d2f3a2cd5b202e3df05f308e7b6ca3a7c8919c22f9c0dfdd8193b20d8ce9efbd7bb303ab00bd5de2efc373761a43761a437a1dad98461c7165ee5d3be74b6248adfbf194b08a6a186101f35bfdeec14576565790fc8781e1e0eddd98021ff937310eac6e3aa71bdb3eb8d6d52c38eac76f5fddb7aec82c6aab52a05dd8168775bb9496fadccf076bf295574be3a2a3ae6e3c0fc0a8494d8d90ab4bac191ee4ea2c31e215cc2330a4ae84be94dae92f197c6b2984ac09d3ac41cd16b703d8f9d09ddf343782f7fa441193a2c6dc7696f23607cd5c441076fe02f1f87f95cc6912c96b48e82503af27e57ca7c091f75cbcd0a8d6de38a9ecf44ecb8bae613111bb6a4ecd399983808408f4fea7bf29434de70c53d60ac43679b0d801dc7a9b72ed08bc85f46cca7336cb109e3474a3c0f5930d2c142149d711c452458504747f576a5130d2fe61942088ea309e1bb6ec965375ae6d39fd49de7b5b5be86d4cf0fda42763f8fc6d1a8e624216e9f3451db5860033b545627507f6001248f4882d796416f08176c6e983d063141b9378ccf418dbc2d2affe388c2e26841bdfbf8f4a274dd1897d1b486c0bb94c3c7d040f29384cd61369e7d9453a63db65b2d0c773254a98ddd0e8d3d623914045f4cf4e8f7439c51b0c0a8dc899646194fda76a93d8fd6539f301e55a439ffddca490624494efef68f1f1ff4fe71d2ac8a0cc1f6821829a8f7571b9e10cbea9d4d77041668ecfa986f08b8dccfe1db20ba477119d3128abab4b371240bbe7d14c0f9011214b26ebc33537d6716a0d8ae7b0fc782b8c8b67a5aac9a005cd68d53f0fc99f5623915bd20bad7078b99691e9ad5d4d76130f53c299c81c03f74a7c3ef1bf7ff646475655c1e18a23b533edd5ba090f1c4488c03a7a3809406b04d5d250d87d9b1aa616bbbc7a5be698d9f6015aed2ab0c215d6271f46f00580b2b2de3acfab4c92f933b9e4cb5d4576f791d51ab0fa37713520bbc604d2a34837655f9ac4ff4d73e22b1dac4327297f9c6777e4bebd5b72fd196d82f6eb375f43e09d7c08a1d52737522d040f52c2be653a525b64a801c05a76c3a4f379bcf417963c19440b4dbaabcf80dab74c6d656a94cf6f7769f96da1f101e8775003e1aba4a02a6727d296f1d093aa435502f01db5093dfc40f2b6bf1f5749efa8a12e82cb3d24db702eb369913c68f107f00b9f8c5fda0e75951a2e59e1097629bf87b589ca244e71cf002cbd621647b654f6543e919a1c59ccd19d7a42254ce8a65bed2ba96a07f0df67b87f860858a9269ecc6de73147d7fa92b29953ac2ae53d7a1aa3262a8b15add1bbe73edb9c5b39caa7c6982f95aeee34e3576fbdbaa6c7c7710fdd5ad855d52d688b8276ca78a783ed3df6ff747f557e8cab895d05b4fbbcb306c93aec33649393c149d55f64b305e63eb54c492d676e771796f8d44384887c11168ca11d4cdf12a4660508c82d008b1547795c14ff09b92695d781ecd61c7cac9f1f12d1967add0f18f85837e4053ad7b7c7c2ba04105c7ce90bcde9ac80fe533ac63707d1f1fdfb2ed7e57ed8ca0738ef5c5ad61ba58837bb6d67b2865012a80f02506c955fec6e6177899ad30d919597d281de37ded5674c70e2264fd999ff02916bb3c66959f8949402762520a66266606315f693d5f135c2162c2a77aec399fee4c9864eb324bc207c4444cf0c20eb425fbb96fda93ff1bed1dfdab06a3bac1e8ffc500e3babdf8ff457baa6e4ffdefb7671a9938c03aaddde56aa743fbfb2cf1d3031d9d04e863383c90556e0a64cb43d951951d39d947557e5ce5c7553c6853455f4f8f8f3c357448b65bad82634e5f0d96ab414a47d7a9ac0a7da43a8ac7fe80635fb241e8d6ffd9a8f898162273bea3fd96740cea6a50911d531e44e26020c950c7604473b62af3653ff7616ce776a2e2f08e13fa62cf32aae667be08519d3157b67ced2e6ed32a9e2ad74b2a77a04fe9e342b183590feaaca858b054fceda1eadf46348602a576c8583a6784e1563cf811b16425abf75958abdef4f82888e7ad91d05fc3fe991f99df833fa84843559e8a1cb871507a934dce6b7dcad136a8144a1241f0b3086e32d2f48201065eb58449479071428b96107ad2990d86b5ca62958c331507375921a05dd32705f1bfa3748203b933efe4bb4ed8f98e30d69ff805fb8e75be1b98a46170937dd7d99bcafe77e3a4458b96e7b50a7d6c596b7d93b5169f29f9ae13554cb0533a2037f94d366408c37573737c4618da096eb2162a68530dcd5be3ac5530538528900b11e96d4b34c6d132e2dc6a2a6e596bad0b8a5b38c6b8c9b637590b1e9f8f8f6ff5cb779a2165a9cba443ccec140181eabbfadc7a62eb2205e16402b54058b2b1a096be0b04c50852b13707553fca70a7a6ee11851a762ed9d637f1c952f13976b65864345c52716b92231690e68a805a47781a7d0c447dd7a6f551fa529321b08611eba858f8d01269741229d3796a5e7f917906dfa270fb54521ecdd2dfe0efec966cb72b86cf4e96a979bfd56fd57ea0745dff7ee60edd948ca7cb3dae52d3a0d0a07dfae08b187f835c367a74a81dcf6b7fd97372a579bd5f22accc51bbc7950938362809ea61cbd4d14ae6ab2cc6de5e8e544b7d59e4aa28b072ff9f8ec4f0ff755b88fb3993cb71d42a677031ced2d63c6919c58602df5e944c09e7fba2352e5ab15ae42a82efc22e8c7fedfed867781af7b14629a5f2226bb455e0e5750f0a3b4c50383550faa0fc0413a540098a975f619b0a90a6a35c1408e6da3e3bb889b5c00e51926b30a78c6355db57e29b8c383044bc4df8bc6dd03677dfd63ad9d7bfb25f3638ae5fe3068a11ba1c08c9b620039d36bf9a5bb94a881c725e3a7cddd8490763c12872ec507923e875cdf8c4cec544acad254555b224bc46962c1c052391c3513ce6dfda534341d6a9da522d9243e729ae435d84e7b1567c92221a84433e330632804512d79d84c5007c85f05251a4d10b445dd47da31810dbf25239e65b3dfd76f7421e8b1e5775f7aca78d5044f0b4110e1e0c3175e88f3903fe1a3dae9b0cb983ec296deb51e5c16f527ee7749bc270ae1ffa118391a7e11f28a64b60b454310ebdcded96df3540c15e3529eba77ecc34eba0726c505513317e04eefc76cbaf0ed463748a68196cc98fdc6a621820c5d0a41cf547258362a003da0c996f564bbf56a76762d59eb69ce82a48633d4c297d4a6add27ec6b6262cc36cbdbe756e8fa50529405513fae6640323fb683ad51d3b8ea4a554b4c1df56420249a8993082a379ba80e5b3baa3a1ad2116f9fd9b0e2adc4f35ed2448f9bf5939debdef908ad6cf981816318a88ff9a6d0de841cf808b1b7dbbdc315c6b642a85cec986f9a22d2f38e5e52697a2d77b76c63561be7a29ee0c8988eeccdec76cb9d30a09596ff4e13d59998ee37820598560dd94d18331a559b3004a5659637c49108dd385a53a733266dad4d0b7c55410dfeb9d19f51a9e95e0e3c439fb45eebeed1282ba32fe90c9c007d2e4c8f6a5207a78327fa88f0cbfb6b9800758d9d1a6a83089c4c9ed0e8f031af6a98b2fe74b70fe66836e633a523634559254ab65ec0327f01a74fb5a660aac3b1e010ea20558cedecb0c566b3fbc92595878a557a8977c8df3a3ba5d282dc72e9c61fac18ad98fb485f0a30b0ea0f86fec1508051a713d4fd75abd9a32e4b5136fc5d497e7212811f67bb1feff6fde0c7610d4ab6365672e4796e35767861353c8788bca831df3330e09f501713c0704bc883235fca2ede2f9b6a941503b5baaf20f5ac5bfd869b907d2ce3c8d101afe49b556b1557cda6b4461516338ec56f9256425d2d713182fb520467a5f85ad402c765e6dd894a629320a9da49d2ec5368fb3712decbf1b49ea001c15f323445308d8e40e3d8862b527125423e3e96c55d16fdbc522b55fb05388651d5633dd9bb390872a732f47ebddd4dd356224efa4e0c04e186fd9a1966f4c24e9561fd82799c2d7260fb7556503d0a87053c71ed99b0dd83d7e06d862cd8f74a33b506b7f1bc2577bb3c888627e228d481da0f655696dd87320de90fce33760edc3ed5cd02646f47aec72e87f5ee8f38425f348eb984f01d48f9884b6701cb472e9bebb5b7825c1e58bbc34bca7cea104c0c44a7d34d7cc3cb8e482eabbdcd02596d3119b8b3a1b7c3def4984467fb07d23d007267f7cbc63e97fb9b5c7ee36ce02804bb87a5bf5b62ef939d2e1c497fb78ebd4f84b43b4c6e79391a6711b913bf135b94e80284f1741700f044ac93cc0ff954164b3fd3217115575f16bee4ea93df6ec7dbc0803fe80b00ffb584c784869bcd674eec7443d8d14ddce8f3e576c7d5b9855d8eb568b0a6122d09de9fde8d325b96d5ce44d24db2c315cdb4c1a151d7d149c14f1216b56cbb4d371bba3738edcc2bad6c6c93ea545635b3b5a9c10c726b27f3122e9ff46c36a6d51e753404fa1f421d3ed2a4149f81149b405c0379b66909050f48b695c0ac0486c402db119a0f60247e0af10002276e0b7134d34e65667c5a7129458f4374c4a5b04eb33802ed4b5887743abc2a364540fb50f482f05c6a922d1e8443b02b07e1d09605c7414128a219486bb488c8acb1b0c3d04d05d2c4d6d091a2153bc0af851c5737be97d3ff56b33e9dbaefba352d00983e2c9d45ea16f537ec40dd9ed73edc97e9c989b53b4063c66a1dc3537a784ab754ed0607c5606ba0f931a212c1c15c79dbd75bc060dedac07dc42707c0dff41e60c73ff15b9e8b90bfe21fb1515ef00bfe26985162dad02ea1348089e7eb5b71d43e33e14c509deb751838e61bc7a1f09bd25d72c975a06fba355fc7e29d8fd8babebcd00457f411db9a1d2ffd3200da48bc728e8e9efd4fc2d2dfc127a87a9bc86eb1180d3e0db1596922625836d1575a0594c2ef270009ec6668dc559ffa2b843c4a994fb2953619a8ce7ee2797b69a9e78d8b57f2154534b8f229658cdd02a58d448cbd2d0b8499509ffa89a409f3131e7713c805532104e231a6fc15e3fff510d1eb5f881f4f72fe71f002befdf00370c02fc44bf4eccb82f54992415ba17c35c184cbe75abf0874aafab2e0171d418256a63e7f94537ca4cdb03a2468cda7719d9422f4c60b131ef8c2c4f447a8801818295bdf02e9d15e485b726b5667e9aece91599ea536646c9b45a9ae81cde6555be80de679af9ab7460933823adf7eec9609d82b54585e8626fcdc2b08964b2bd0767e726270a09d0da351a2241ea70889b790f423dc2597dfdf6e36767796d2eee6a7818df1596e9fa9ddb715e9fe156a115f414b59dcd03d6e98855dccd1aa66b888adb9755d1d1f539eb060b702d1eee9b476e9fafb93d53e6d200e08b08901f069c936868f1e6729ec34d366aaa9c99df4fa3ba007fbdfe9d4a37dac9bf1fdb4da9ba893d86cef6017f6d3ea9a9cc466bd62bf29c60f51134e0d07beb1497b93548f1ef3ac770060d8e1e85fb862a9d1a73605358bd529ed1ca4cd66d100cb660f6e368931f8abbe62eb832561246c20b38e646fbaec94d3677cad61af46d7780d787d38dfe6ee15d0e8e5e10ba28a80c88f4a15c2e6371567a1853b4317207635306b543acc1705b68561cf39d0a5e29fb963abb81be5c157606e44017853c7df407e9cd3021f1a41b4d9ec9e22a40b786ba99cab84cc0860d16d483b63a7b83e74c611eb8164d163fc507aa763a81ff8e833fb9219e552dd8c99b56a206c1d0d247cba876c68201455fc0c6c7aecabd9787970d08321a294eab1ebc0907c24d6b8327cc94b4552cd2cf0135e2ce7905c2d642a9be823b47ddabd2d6faa80baf9a33da54371842f7653613609747334b481116cd4a48a0fc1cfa0e2c0a7413c5fc72271260ecbb2d944c1a81bad729c62dd7191041383e5d5a86630399f0693d2236b3c980c3504c783511cc3ecf199dd420b00f285c5b5ac039a093f637c7272c2a72727c0ec5213542b11c9ce4554a3e7a32dafb535eb09d25bd0b2fe2d0e5efe2407174367dd3fddc9fe74df3bdba91824ff62b6477c12440803ba4e9a531b0510a0ecaec060188ccc8c4fea191f9d4fac0fdc180e70cb191f3566fc28b5538e80af74b63be5234cf9e8e404d38e296fd366fbf6e84003d001cb9b4d54210fd141e4a2ceb755ba65021689a85a537bec8c33e48526e447768517ae48ec51cd7e2bdd80c26552d1df24cbc56626c7d3e57cb354d30d5c8e30ff94cbfd5265d6060e3cfcf14ca6eae6d4f8f695885ef0ae0cf9afcfe32fa3f15261261c3af55faad38e670865f02faad993411fb660a8ca57c5ab14c79f78dc977ec8532724f6d1358e610a13c5a85472e73af073ea79edd4380b5656d04a56592113e5934e6aa73c72399e97b1e17d91424d1328d5575eb642b4f958d3f9e3849e9c9d3b167edf7fff3db10a920570c2cf91cc63c2b5ed64ed42fa940e4e28bb19dc0cd7db4efffbeef1cd1f1beeffc779fbe6c655dca8cbdf7ce9fda34cffd2fb8793416e6ebebfb9f99e70d2fd7e27997032f8c33fedf6bd60f87dade1fa56a5575f1694fca145e2c744e3d1c7d2316c744b184de30e39d65862352c3d9b9aa3514fd99de35277300cb0c6f0cb181e5209d6d3cbb68754961f5732e77797577f5ebe7ef5feead7f7ef8492fa98846250aec890cb8ae399d7d17eea6dd7d006af009101233aeeee9d9601edb2a09dca1e4d6534d9dbc307ea92e2aee12058decbcebe5fcfbc1cdd0134a8d45430be31cad825fb47446eb7089e498dad2fe8450d50240b9c24eb52e34005f73646d9b65145a5a4f36fd5e1508c95ea44b82bb19716c7c21e2b0f287658a0f54128392e4af8a260924221d40665ed26b3e00855a622860d0a389bc940491dba6528103ba27cbf7cf7ce7dfdf0b691fba291e9c663417af5855decf51203bd280e22457b9a9192f593811cfa15c33072a105fcb9aa2fc686a4d672ac62096c36641f1459ae61bb62135865714ce3188be66a9d635760b36e79ed71a5eebb8d0b641a8e379bd86938b60dc7c646736f7c11c617d5e34b3d2f760d28d2aa867b368a3500dc997a1bf7e693a4b1cb7d289d7b696ed0d4200b596d7f3f3dcf8269892cd4707c301df69f84348587fae9b0b40d4df57dc1d81a0245130605820f860aa788a32ae4b72a9575a5f260a5676ea5d5243acb94152a5ae553c21bc30bb6f5746017dbd9d312bb4367859b13eeecc6ea78362c59d2bebc6705e0cedcc53eae6aecc3b005baca58dcfc4b10db6edf2fe3ab44788e065911a9c76aaa521dfdb2ae9ddb6da98d3266c558c10d5df96483d1c05ad2ddf73ae0efa4200626289148aaa050d51d1757bbfdafe628dc06f022ad4fb488ed53e0ba2712b1f312546e8a446c9f02c48dad6b697c7b90740fb7bcaee642f7514fce852bc0af7545a4ebfcce86d08652b29514f49d03ed3a2a747b02e36e4c2c7c349ac35cb5ca9346a7f94854a3093e5125f9215007c78c811abc9194e86afe94c59fa41331d77d64d593547fb935e553b5fc53d7afe2fb3f491a9fe8e2df6c025aa1470db50ae5eeea277baa6dd616e63e8f8f76a0eb2d8fc53b4a4f6516e7f371dca23771879d9667fe0b85e18cbc1da77239cf61efc2baab42e51729c86e1dcf8c0dce86d0aa3e7d34ffa2d4e9b8f48cf8adcf782aa436165d433725ad0c7eb0e5f988cf10bfe37afe75f3598593f172f37a332b18ed0b1ddd849df28948b5ff44cf2b7fcb30927c0a223d03681a277452138e0b881426809953312b47b5606c3d1253c4e583cf67e306a1c7cfe036f0c362a1f24b09f70d9d2aefcc8474df96263ae417ddb5d70b198d9777da98c4f348d95f826084edb689b33cc6741b63855187bc6fa6214a228a4a6df4da2879e1241d4d58d08e379ba9e765dae6e1317587de2d1baeeb663c3b5ca4aa9459bda6b5313cf6dbb41d5a2be4cda67ed66c2aed2470b3f9f1610c670e1c46e0a58f4c9fccb3fa0de384d2713bd96cfe7e9ee8825720aa1be01b17cd3883f3542dc5faa710d7e61e28d7ee6b1960c702e974d76b683cbe85f0043c2232cf4807f7402bb40a60f876cba1cafc3e44644f95c5f3bcb458f747bc5e93c29ff26ada0b3fe366fbfb310718f6aff92e34f69b614c9e7e5b99d43154fff4af954bcb1ba1aa5be9c82d4795f153edb66c2a2afd3d9eb92ecbe43ea3b3a984a868a5ae65543381c453693cc359eea111a3a50398ce188fcecfe3e1366bba211bb1a051408c8274301a8a4a3175aab1e11448abda73445683b5106a3b4e3d58cbb44fd34123f5a01bb2aaa7cda2dccc846994367259c3055903827e32368be8762442b886a5bfc1a11c222b8dc3d55219ab750e86a9f8cdd89ddf57c4b4b1c6bb5f16e6d66f91ff5be5c2a85f3f56e4eea97f7caa9d7ff884703845c2f7881c5dba88f17f330e84faf6a1fef28f9bfe29b75fca62842f6531ea973f4eb9ffa88b19774ba611b0d5f8629e2fd1c979bee4d68d915f3a41faadf677543b4ea91351aa53bf3a2c8827dad34528c06ae863b2991fda853004197c4209f135aadeb0393001652a9eeafa9e35cfdf31fddd5182b90049509f27d81d962649bf4291d720dfebedae614cc4d629e2c2963a3df5559b5ae812761309caf78ec61d5839997019e449693fb0135f4a060df870806cae75400f9c8d32fe75d839823ec5309094946cc6e88ef047705a4509e2dd117e59be582386e708bb51fac925fc45f9321dcfc6cbf773c27f32eff3cf2a871a3de12fcb14239ce58fcbcae679acf24777845f95ef2b5c8be507d7b133d3cf637a600c958956fb278715135a714049d7455c6b796123a968524307595fdea53e8315d268b506540ae581dafb4b6571dfee6d83da43a7311e880409e7f3a992f0d98994a87f106b7d25bbead34a4ecb8098bb96dca1a084744220082f3175258260da3d393b47aedcc9adf868a1352172b57f8dbbfb3d2599d0f3481b5d3d72fc96d9916a44b3464ad88e7586b4d61976c47ef96ad6d6bc9916ad914419f0d0d8549820607eb3de90ad9bd9cd4f0d07ddb7ab0612b9258172e950e622ae07e2792945a8195eed8a76cf1caef699e983cc737947746db1e805716d430d557f3045f7aab01be06cc702a47db6ddfe5fcd9114eb63f0739bc3b7034dca8192632244526f2bbdb088476a7198c48618ac8ad4544c0a47645a69451be3fb35a34f57432a03b6da33ecffbcea5529f8721b2811593320fb11f14d2dd2e637e7b1156eabc585360b2419a3f38a3101098651e742603a7ba43576129715cf2cc6d98a9d4be191c3640dbb4dcf9ec13e5489d9faab0dbc25bbae43cc0a543fd106a25debf653dba8742b97a05c761d77a1fc41cd633e8513ce8b1f4f530457a93bf8f8dfeee03dfde87dab1f68b25a77f3a9f533304e68a91a13b2cda63d2e9e8c3378b7a936bf7182978adec33008cbb02b322cacf035116107b7bc20c46aac9568f609cc5c939ad7af88b918a72231e285533ab8896fbac30e53f4a4cf4ac290c1150b3921424c070f869e371dfc307ca83a67f062dc233e1d89844fe0e606bc9e09eb9d43050b6205cf3b7b081e3a1d89b0bb9c3f197f513174dfb4d2cc3a11343166ba4f23d094600e32bbc1be6a3f415499a832e38c56e16564d7785de50954368ce3551df3509759cc3fd33344dbb39393c3aa8e86df27ec34092ab85e351d243abc31041ca617d6674dc935d30e59f942c8ae7ec254daf8420f45d6b13c369b769271085aad6415fabe747a3261ffb9f03cc43f3f8202dea82322c6471d91584839614652380d26e7b6aeba86aaf609fbcfacace89bf5541185cf55c0c20e560ace91488f181db95147c41dc735a3626c3b336eb9118fbdf4842bbba5375b1698bc51f504069cf15fab4bbd5bd5f2dd99f10eea3a077d699c69582e102141ef2188c7589013c243711296530007649db01e800c5888fe77421d28311455af6d9113470c12771ceded5f6a522b12d16653696dda12b4f4eb31d0a1463ae1906a4da9de79b4d9a8872711531d11696b03ed1ee74119205789b307557b2fa14e289b3064625c17ee3616f1a34afe17396df2543c95f0e2a83dda924ee8d7fae5888bd634fbbfdc45750d0da3e57a219cede8b31c31b62eb5abe16416ae500dd4302aade1e01fc3be76a0fde1fde593d574fa9b92395cf7a8a57de3b366a967f3555e9445f463100efe099dcf140e5b07ff1c76c2c1596f08c945fd7e36d4ee924ae5058e74708ff0fb60c84ece74ca0f285356f2e370b3e9b1933428ebf8aff2350946faf56ffab579aecfae7ef85e33ec9e4ce7724949af4b3a341cfc5d1745242ba3380133403e020bceccac34b83c82c7c6eb1fb7eca44f6fe29bb8faa57dff7df5e4f70f3dde74358c647dfc477fdfd041e76408b87913335b0c59c7a7c19169d62e1c8dea68f884f004a01a0162b59b1952fb0c2601428bee3a7ed631e455f0984600939178159b0025acff8e46cc078f95057968f3ab7d806460d42fa4e33830d2072f5001a333f13ab60e28599f428b63472962a695219448ba8bf9020a94d4c46453e00a4270b21bd008ccb73708be1ca41d31ea8f80a2ec8d0866dbd5dd4cfff86ef3ddb116253b62e1d3efbe43ca77a441a9d527e3c541d2a44a5a80e0c73d557ff1d33dc44c4dca785efb7183a291e21dcc40ac0cdda4cb3ee2abf5c36ea135347a5cc2237061228e1d5948c5fcca85add63752817c68f3fab5e3655f9e9fd8672d9eadde58d03b977d5a5ac56a3765d5471de944e837b7803597b5fa5983b876e1ec7aa5bc8a0e79a534a0b32aa51af4d33548fafec1d84e869cf625508236ccc8369b76e516440691f88946ac1ff983681844e2e7c80d2a5bb164da673c86d7df0b09c8f618f41f96857408dc9499fb0e3190c949338545623789c376c6f5d71cc402f6e91618287a4026006d1f6a023e2a01913f4f6a5a36b58f2a488448fb9520b30ca31289a3a8491e6abf8f8d14f095b474acdff3a373d53f39f3cf981f89e43cd5cff552697df5dad7148005dc4d254d7753a9c1e90749bdcc69b74054a0dd01d6d83d08aedabeda22f6b8a1e221b582dc1ed006bb80cace1a94a9987b9e96d81336fa6828d6d37136f1c32d84f8399473c7d152d48f9b0db9b824b6a3c710ea56353d8bf6cde8c1628844d42727a41381d02127449fa8d0c6839acaa2a054f6a7a13f0b592762dd2ace3f95fd59e84f75eab6d652e4ca715fd9ade35d5382509d846d3659c8cb5b33ee1e6b876c62bde530cb476c44f0bc8434bae5b29ba54fe6f92c388aa17f992fef209d80d6623e2e96e34c41381b778f6fe5741c9be77166dece02d53d466fcb2e40401ad6bd7f06537e5aba88744a35782888675ef6881b7e3b6e5e4be5992c205c03f38c68a65b8cc2ccde813aabaf9c8f3417df3063ebe426ccd7d020ee1e176af911e31b43b6cee1fd10fbf8274947dcb4ed94705ae621375e023291188f4a21cb3c8ffe2469c6478c6766cbc22ee8e484c33e08408c3bf3cb1bf3cb382a82d028a2ba235c35fb07936eec6743a0a49b0d2a44cb9951956a23ec245a3727a1d4cbd3d566389247293c54d2f6d9e1dacfe0542dab10e9baa7678d9dd0dbda89793ccedd5961ebe6467f269dfd3d871bea6adbf59adb4e6f2e5b21ad26fe8d2d706f13f3d069e299749bd8dbd99fe8a801c565a30d68593b9062e1f8d68303005c14aa6b6432d61f40fb2c90a5775bedc8ba94e394be3cdd8612cc19db2faa634d570575b93313f178e40e196d1b7378252424d99405d79246dd2c7d9f8fe1b6f7bdd1d991f0fea067e176ac6d45560a341d28aad0aa61f7cdaeb605a8627e254a719add2fb60530c586d3564f5a926645678c95c32bdff8c86c52ccd38c3bbc54b69e6d367426d27df1d048075703828676cc744dd45d3cff9c35271497e544dd5dce6315fc13c124e46643cffeeb5c7aded93f1fcacde687bf9f0308fcd87b2870b74fb0b8bbfd5ec862a9aa7eebb756b45a123e615bb3545aa4a807a3815016ab46ecbc722970348aabd9027e669c69677d42fc46c2d6d0ed58b63772b95479c69b46867aa99dfac06131d1dd6b542e6e9e5b846e445504f16c7818dc9b7d048736a68e7c0ba609a54a4c2d6be58f9b53dafd9edd9cd2413a9e0dbf67c7a708b33515465f11aeddb9827715de5429335566740a90c97c25f61d45189f3474aa2f6b888edb254160344a9e5052cd0961089595ab547d59103ee5314fa5ab4898516d1d07e0892b500fcfc4ba555a455f535b75926e12737e3dce0cf2a98fd242bcdb490e9a03d33dad170371ee2c3673beb86f3566b636c201d2f3e05b057ad5a0e436d8ef797070885b3b1cf9c51d4e21deed24ffebe11cd9f13c2cee1d8ffcf22fc623bffc5f8ea706bad78631110a92a5fa0e219d4a26578dc56eb9b5c5d37ca0695ce372bbdeb1ec7b2b35fac66d704be0bb05576facbefce703bcdb7d9ac0a49810168c6038883b7eb351dde3d502722ddd21c8e7e888217eda7175f1c46c3b12896c20888999f39f6ab9504b1abe538be84d8910c0966d5ebaf975a01c10140979a3dec611dbeec5b669911d5ec1a876620d81144f011270b3cec342e5b70a5ed831a7bc66e923363c353eabf54770c61154b35f3a6dab6a85a66dacbe38c038b6ce714622f6341b77a455c2cf2ae468a7f66084f9eebbb397e9c9746ff50c6a45704f833a1cc7fe3571079ab71f36e9173fdcf2a7f29ba51d5d1a94bee617fc91e4b7520c862565cc7f8ef18c39e7bf4b5146bf3da09dcb5f6a2f1d19bc25bf92a272b5bed9d0ea59bbaf7d2ff913c927520c488f70f36f185c6b0d276874eca837554a4a07d59a8292f37e8dcbbeac62998f63952d11c22368e5b7feffa8b623531d0b3e778df234e8850be9be61ee65c33f8299cd527bd29d947c3ceb7f63daf3f18cc235b87f7f998a85f2c74d71537c7fdae4abe8b4639de8875a66f4448a7f3ebc6e341a8adaa53242ff80676e1a094b1365e4781e81a227690b27b1ff5452e7557bfdae2b637efd7c700c7576bf7ef41b9109cabbff7d244eb5d6d869cadfc6629daca6539f9c751f74ff4ef84cfe35cfcb6070f3dc7fc0e3f9d2ff3b8f6c7544cde67f8d4fa2a91ce7b7f33be892902dff598ad765ec75507d71285ec37e5866f11c20f48474a8e5ac3108ef11a48b327ef46b24cef8f348fc5ee9ba018bd608dd4b63a4e3ccad8999b55704fa8db8e5b6fe81b2cba58c4606d5d25a51fad2be08dd36cb33ffaf9a3d50ea5b2dc7ea60cb1f2301f1917f7372f3e7b043bb0cdcbb5f2271fac76cfe955a5dbe2f218ef55f9f5e8e811bf22b4c6fb5d1e1da4ec68e5d6493cfcdd611883cd1eef19032ab8ad13e0b888d3b0c29facf4e98db7e512ec97ca503a897068cc03c1fbfbe06058d11eb38cf5030e7afe9ef4ce3a5d339028dc36f12b7f0a8ee53e3424185ce9d82e931e14a3b47b2be52c88074ecadc25b8475c8906ce17ee3c066ef9d8bb07fa1bb0a80ee9b47fc31c84407b2636e38853d8e06fd9f635eccf3a50f380b251e48b722a55ff5d39627f0fa197ca264b69a2ec78ba96a150a1e3054dcd2b25b15b7e271a1f58a5b98c1d7d9f4ae956b2d6415b710a5b8114acfed79120ea03d3b84a7717d0e93c8b4a5a915d3506baebd20b5109b5be64ab6c2d57239cf5ac0585ad854e369717f13d1003084219ec616fce5b5b6050b233ece460aa17be2c7485021d760e6d0cc5e5004b6904b09530c14027747213e5b69d2f66a1eabcd26e4d0dc29bd0a55ca7dba4e82697773fe55238d5a702ddc97f96afe5ecd166891b02daf99507e84f1e1c298e7871a43e7b9a367a4e3fbe3304385cdf980cbfa9cd7aa6b30451ec942a304fea3904745b177dca578230dbbfb118d180b4bf552703ca29a028d030455076b4984956924ca795ef37d20879c18b51c1a0b2257cb39612c8845bcd9d475d7d59585fbb95f7bf88ab75b2e77c757cb15bf98ee269ad96dfb1df575fc420d3b9a0a7c90dd31dfe49ef1fd698a0d43c83a4a46c9cd866a105c2a011600fdb885e2e74b35d3dceecfac5b2c54344ec62aee1fc57e5e798ab0d3d8ec8375f10b115e3775f22c6a1b8a663a95fc41155738ece77e08c3e3f9626f56aa26eb357347b2e5388ff5570ec4c5e6aae49572505ebdefef164a3b30875e86ed9cea870335d49c5878792b23da03e90bfef9f0ba4fe13854907196a9fcbdfab2245c0e7e180a82ea34294f607572361472f0c3b08cdd6f803309c2ee717c2b48c5240eb794f1860f012badf95ac907de5dbdbcba7c8f8df30420037bd0c23cc3d847cf3ed1b05b42a426048778b3848b40cc015ca9e643ac3483179d734805103b9677dec762f8912519c2f2a3adf9154772cb1116eb9e9e575fe969020a5571fb4b677c61571bf202481541745e1914479d0ebb94341e444316389f0bb9e50a5c0e3f8e1cff37ba51e7cec5aea83265b5dea5656a08953a88bc1f884ab2e479487d043ddeb61051d4977ecc606365d42db10ae8b912bd409d3bd756a04a652e588285112ba3cb0dd410b65d7a57e2abb454b0aa33532e07692dcd40f2162cfbe3f836488542c3fd4a47c4698d9f31df6ddc4e6629354983c40a3a46c234960c39c61f28a1faaa33f2477625d5f6fef1d41d954ec40ca4c170e3135d97d044df4dc7213f8a959cce23ff5272c7f7424b52970ffa88a6d606f74b48c93c93790a3ba0b2fb531426dae4bd208c8f4cc2483b17848baecda65144db11b260b4d9340af291f84d8bdd18ba191fbc77cd66484502afce20eecb0e92d97c5528f82f806f9f78b32913a64ade2a2458b5a29f4b9b05dc67729c159b4d9530c38d6b75eddf18766d8d9a3a6a22ff04395b419ebeac905b631ee043ea19e9a35a5de2761924446b9b4dbb8d20046063aaaa225c3f55c7faf523559043dfd341cfbb370bea4a677f632ec26ca16fc4b07d022d0fac110d1d490db23fb9afd308b3cf78082085855cd793eb97133d0717b55e039b7a8b30bfd093acbb60e11d44eb402fe2f7da7b8256a441e3d8a89bcd546f62d8526d36303b8ac1aad747f239b6480c718bed51b919b646d20896f596cf93c497119f67ae4fea52762cc505ee63cdefad65bcad547377e74982ea75b67e4c9965e32319c88c26507f192f473bf38a61c51081bbcb7ea951954f9a247b0d3727d5371046f5117bb350f9f2914ae6b942cb5dd7bd815f85e2bc04a085962610930823b47185fcfda9d55789748073ddaa646becbaa37affd6578a738940ba5bde77ae116e85e4b9758351b1e572b150d9ae8ddc3dc33e6bbbe7c7f3ce9a09b8d3caeacca0f5822e7275a0857142cf1aa7d19e51d94dc679b1d43504f7f443ee4d3e5adaf2cfb9747016dd4e242e68844882467bbcb1c63a7ac9fe3a458d51c80a1176aa66eb727fd813e154aae18761905515c864a9f243db4ec8dd8d7768cc92ad77369ce447cd1d17c4423b12b29c3aff7168ba5de2e497215fced3745acae21a5d6968c6b61f85ba7b2ca071ff71e85f864cbf6f79d9c703bb8acac608d8dec6e84b6d03bde559033d2c3116a9876120b01d4db55df7f3342a83169dd107a85ec7326c361cb0dd82b6e22d4fc67b9bb26a3555b6d1e2d1dd7b99021fef1f4e868214ce513405c8fa1af2653e4e53951b4bad460b98e848d029225b55b7ae367084ee8aa65b0c2f4f89c17ac717cde73d67419fb7c3e0e893abee820d6f9cbf48aeacb215fc22e378b85d69e26d9183b74526eca9c5718cbab68bac4063fb2b3c79d344480def072344abc187fc9176c84713714113c6987f17d284ef96aae0154af7138d5d6d03a74bdd709cc52e3fa73bcf1af9ab6cbf44926c59f0d6f50bbb5eacdc0d8771a3a9c1639c5e042283e1ff812ddd724a1d3cfeb557a1811465b9c0a8319489153f333236c5c7605fd92a6e8aef077fdcd0e1f737143e6c067fdcb0e1f7ec869dceb88cc5293fe5616c3cd7fc09dde9779d3ebb392b19abbf47e2e894d29b53f0918fd9064fdf0f6e8a9b77c3effb37dfdf9c32763a4bf93bcdf476e879fe2ed629a5e1223866ef636d7b9ecf6fc771c3c6dcda461d03110337a0f69e5cd9271d72441375c84965174980f5479e47baa42d2247a3aa4447dfc5101d2ea3424d09bcb3eeb63888ac6aee191b0a15c8ca7a4a01de97bdd132121cc3d286caa13ecaebf28021be6967f7cb864380528c6ac056b5570f7fb96dda69196bc1e66c1a1998c672fd7de7dc693fedea1b82c6cc077ea9b485b30ed76dd5891857e65ad24c84a3c4f3e02db3a739ab5ca3cb8d9ae13abddcb75487b245cc1453786733f308eaac66c97517ab34e73a71eb067c500291effbcaff49ffd4622e9fec848c926cfd18770af0bb2045b753db13f782fabf6ae0f29e068c7306ff33ac6f87fcaff24c00ef1e4f15047b512d45714e0239ae1ca97cc8c76fca03929312368f7032e917cd9efe73a8fd489967763ae6f3c81cbba5ca179a2b4718ffa03f19fc71d3ff8fe1f78cdef4e9e00f3cb13efd0f08f259fff89417b158c39995ff8f1ec76fe1fff8e30f3c592efc07675bfe4c33bbab58b470e5b034b68e007ee2b9e39746146eceda8431f7db67bc8a348b973280ad3f0e1107ab7c218c2390fa1eaee6b215cc3158e55383537d304abed0e8199c0d3d4f978015278d1564221fde3ebf84d24d06363fa25069ec62f060b8d9e837f34569a14ae3c10fda72c28004d89ed2586b6f13c7365643e86d6d155b8ec2be829885ed6b990adb4fc24ac354536e9eeb14b91cf9ab48a74848c5dd3d65d8eda74de5cfbed456aa72cbac456d73b28cbdd82ee03176633dbf39896515a53dd5996f35531b99e217e896561cbd5ff70b1cc932b784abcf42c422cdd1e194ec1a647da511b4104b873651dfb9b1aaea0672e8ef2688686b920e877e87adbe9d483c127e2199a5b8ea09aaae159323dabd2697436fa8bbf2906acd10c2f817f0ff792ef97329d604889e1343c6ae520be95b4e96f94a1dc86ef71081464e8b8399675b5ed9c9f99f39e938858c56195bc750dfc5f653c2f0584dcb8f68ccfa8f00a3e28ef2635f3f2a3fdf7272f2ef54447545b1df6327d4d4d1635b4ebe3f5489691b351d45ec7b65507472fa2f0a47ecb42afb9fffb2ec7f5665fff89765ffa8ca0a82491442fccb6fc045b15fb5ff07e5db4ef9ff51f54eedff83caabd2e7ffb2f07955f6e1bf2cfbb0aef75ff7f9bc1ee1c37f55fa28620febe29ef72f2bf7bcaaf466f32f4b6f3675ddffbaeabae66f942d0be14fe5708793f6ee07b678bb2cb3ddf28fb158673ee283f3c4273709e1b94f6e72c2973eb959127eeb939b5b026b0e9f7c47f877e43bff3bf2dd96ff74c0899ce1d30bb90d7e0aef8b74f153c8a7ea8bbff73158f6d6325fabd708e3052a429834fd3495c5f27224886f0cfa97f389ca0aaba41119f2d5baebd3959c57759bdb2230cd45235167992ba8fe8c0547e3441356dd7141c90d410c4d83a4cbd8f8cd32f5d4178829ff4a5b2c57d99b4d554f17eaa9074a2d949a50e6b46032f7aa7e0e2597aae6ba7c996efc107f9605256b8eb6285943c002b73e9a95e84cdbc07936737302f401e5baf0512074a0f9a89cbcca1cb54bd85eaf28416c93c1b0cb03bf6f27cad4acd9686bfdb95fcf6f29cd32e3e068cdafbbee0fb8334f94ac07c49dc4edd0e7302de28d0260fada0013767eea12db6159c23aebaf7bd2e9ec8e46fb762c16d0b8b115b175e38323b0a1c7d94a6d6bd1aa30653bce8222a6adf3fe00713f9ecb812909d390e7da607e249ecb811a06a33efd9f4e9de249e68faa4940998ef881f9c9ffbc8a1855243b553c607efa3fafc28c0415a5bbab38e07e63153b27cea2a18a8eb0b21f8d5d5dc16683920f99fab2d072bd16984b2d1c4d192d55de22ceb76e35676ceb4207d3272b15728602ad8006dcb15e223463cbee70f33ddbf2cff2dee2473be5cbb6c19255cad1be94e0accbcdc63ac26839dd967ba0a9ff0d80d491da430eb770e340c7488f686d6ff24ff210524577271f2aded29061b301b4b74fcbea29ab9e6eaba755af277bfa0db56b9873a86269faf115fd80f19079ffddbcff692b3caeeabafab278bd50b96c2850d4359ed82f3afa8bcda60943c147aef790534179e569ee5f3d9541241ed188f549d1221db05548c79967d21a9877bd10b52d1aaaea1c9121f1498b74ac53c53b49c9547d51790e6f9a11afbe64c196d790bcee53cdfe9330fd372059b7fd2fae2dab37f18d5d521a8d922e11f590ed24c58cc98e886b6d10130e4a83a6f23b2d15ac8eacb32654391f3ba0d22d0226b7f2bc66a3f00e40944be65955eb93b39d2adb07ebdc6c0ed4b9d91045da87eb2c893243b4edc096e7a5e90e42ee6a8299b0ad0bd7b7f27b7116dc07fb227e8475f56509e8da3d403d6759cd099750d9e5d59d7c78e1d1068fb0fe2608836e02cc19183fff8b7df02f1017bb01c4a8da01faa452071331333962cc14d546334e2d3cea8851b934e584d6799d0e34ef9596969ad014bae520fd1f76362d371bd50db375222a6ea43a893b6788bf53a5f4b83a890db742b722d2dac3e9fe7d3d622cb5177a592c1666f9e2f2c68ab668fcb9dc7728cb62c4ba7a2e07d190c72502a45f82a3eac4ccc467085a5d5cd73df0ba82253d28f898191c7e2d8b629c3a1bc7c5e1ff0a61d3c11587b1cb972597b615ad07beb73163162496eb7a60c3aa72c4a44bcc8e3db3d7efa1c29d33a361541736fb7857b3b2c1a23720b57eec742a8518a8854104a7e334fc8b4d8d73917c736373255427c1eaa58cacb079923e4dc421505d7f8551d52fffc578e542657013cb9364b8fe717b3ab607e310ac586563f0d95aaa88e442b50637372bd2394aa01ada44667ee47147189df0249fcf2e4732876516d5dc96e7d992261caa07cc8f054dc5c718d6bffdb893fa71077193da6715282437377a7c0c812a742aa47909aebe1d4cf4bee58c2c96080bde2cf5e36f03ad785b89b9b77147244db0b837371fc0c99e8d33682ab43eade6f075165907afbf4ab14b731a01d717c7f55ae9134d444183708cb7c1afb2fbfbd5dbd762bf933d9d790f55f9ab8410b5500724520dca12d320a206f958f70e7fc1108ceca92a0febac0ab1339da7e3484e5fbfe5659c20dbd8a59c9a7863c9584de38b285285a978ae4d279e63bd6c098cfc7224c78ed11b5bef4df2b86865f365abdc85e836e16b7301e985f17b5bebbe2c1651893b2ef2f14ce677d4e0d505dc83a2f7056541cf048db0dbc54a6d0e352cb3d6aac6c3f50784bbb4636fc882ca27b068b7abe7a0760fac93ed8b458063f0957527dd6d88d3afbd07e836ca8671433026f726cd526fa87935539430b24390daef07f5f7da75d963154d65ae79fcf49e4fd6f527e5c2998206e21db9855940a588ba4976087c60e964cb0cd589f18f831244fa7a81c70867b27a5cd613da63b55faf20126eb384124e0608c54058c0ca6bb424d211ea626f5b6a3d211eeb083bcc2703b7782c246f8c566f5370c17dd2bda7a0b3c175c1bd913fbf7ef3faddbbe78f5e5ed5d120e4bdf8b975c50f3cbab8cb96f20be191b9fd78d404e23af510bc37e518706ea0b3efe7135707488b597ae2d0f637fa7b687da5e6ca6e72688fdabe37b7fd961fed9078960f8736ce0fb46074f39ad568ffca184f6001fb66839fa8fc89cb1fb5d9b4298cf4361bede097edb8bd0441586ec603fda914486a2cdfe63143e197bbb02dcd76fc9f8020c9b83b46cb4f31d4a939934e6fd8daddbbf2d05119170eb0e1382b2a5a8eb3148498c43dcb1d3a8541a020f3bb27997f10cf5ad283989576345a2260f6c4f95175f880b12f557ea0de9a63ead6acf851ea540dad8b7ea4a3d2fb659c7cb799fa8c437ba97eae61239a0fc7ff46eb75e3ba55ad0f7b5f93cd66ea1be1306132180601bb67277b5ebb5e08b2259c30c249403831cc358d80ec836bc6db0df815906a2383c16889b73e989475aff4e06dc7145c7b0469ed4525b56ac18980deb1c68395806e625cb9d06e2928e6d43d393c62db35039f11543d304a9f8d5e6ff4cd505e0076b1b0f3ba49c69d31d31d2b0469bbf0edd6d104b3cc806353955163e771b52ccd0f884f18336e1cf786e1dc5baefd335770d83142ccb20a4f9f895e30ab35d5679d0e33fe48e2c10cbe6554ad5454eb408d58e5afd9199a494158773710df7e891dfc0abeabeb37b7bc3351e68c628d786c1aa771734e0481b9be34551f0237b3c574ac8a56dd1a2c9ceee3faf4786c2e17f07e5a91cc3456162af3bd468f088fabc5abfb588d411b079b61db8e81d0d3195a99d6971500ba67e815e66906bf8bb880edbedeed022e979de9f1ebc3e7962df7326f7ece82bdc9ab70421f63deeeecf4aa93dfd8eca6ccc5abc7f71fb54d8d851d3c6a6e1d7bc7adcebc672ab53761f82040fbfbad7bdec1d68fee6bbe6ed9567c4fbb5ab57b3ccfe4f49e96852010d69252c28b47f12f26a21e8adb8fbaa17b7a22e378bc1cdfaa7bfa714e387948b44c558b4abfdd0777586e2f6c23dfd80bc6dc671cc9aa373b5de91088fa59f0cd1eec56e3f6a299f78dbeac2c64d945b7bf27fc889c124efef35ff5c354e136bf3a78a46d819da1b25d228eca66574e00db9aed1b7299cbbd5eec7dddaebe3618143df051b3075beea0fbf7708078dc68866908ca155865f1bdacb27dac0698e9a802938a8e361b5855c40c70669f6da678e214ff2ba450c21b31c406b5f443641bd6dc33ee5038ff7a24d5351a1c3589cd2139d47965dd3f4069172f7c244a4c90cf70c0da8905ba794013f18ba48956d8d51dd522d7a4bb1ca9ccf3aa4e77cb98496fca981205cacc44c2c9f1f12dc27924f01ed43d3ebe15399fe96febde4884e84796dc3278f944d4d1dbaadbc9c1d9d46a8ac6810555bcf611fb8bd4539b30dbdbc16828523da3f673703f0e2ae00fb4351261c4b21e2ae2cc6c13b6d6c167efc360ca80b236436f624e18db369784916fc699d523b3871dfee0f84c44fd48a7fba971025c213f1317f9990c69ca8f12c6828990f880cf60331afc22e98c2bb3957f917452bd8cc4a44490fae697cef888f9133a1af4867c04df41a3c103fcf9017f7eac2de67e81af3553cd76cb7719167b30040389c01ec40c0fbf39c3ae80da9de0c0a0ec3173b836a5e9fe19dbfecf26fff0796820ef49ed98bcc2dfa1e66edc91cac168a80f7a551176a8e184f888386f7ae647f531fef6746cff9de9c0b6623c1671b7e4956e3670c9f465b97bf2fdc6363b3891eb89baf363d8bdae94afb608b0f57f38addb7f3dad0aba7b25ea5e4debcc9245132107b361a00693ee44dd0dc5a4b46ed535d4e4d1fdd35cead5bf08d146512a0d1611f4da95146b58bdfa048aaf845fbe7be79308ce743ebc7de9131d5ace0da8e71327d025e12fdef9e4af826cf96fe2e7ddf8419230fe35425cd5dfbb5617b78ccf02f73d1707d58987c1a3463abe9b2a320c1e1f4ec6a89e468274097f138bf5dddddd9dff0b25d69332e13f327eb49bf6003ad53dc67792cf18bfbebebef6272125d7f36c39228c37dff557d7d7fe2f55c20384047613ce9010c74881df5fc21f30eebc9d31feec195eb523679debbe22bef0c8cde627670f187793cecaa4d90c69d7e36cb55428c87833e18cf1a240ca3b15cdb3b82cd24cd04574d2f5783a1d1736fd07c6afaeaeaef43c3c96777017e2bce93990879935670f1e6acb1fdd7bcafa51f7e2facdf53bf001ece3d970cb7f77be8642c5c9d9f7d27a71f93acfd4eb2429d4b2d69b95826a2fbfd036258475e8cb50bba51fc0272f49a6f3794e7c12a9f1940ca93cfd5b8ff107ac73644a75655850f99f652a6c7b5ec7e294d2be3ff8e3ee3a7e369a15f2f7abef861db6a17dff3b9dfedd70f3dd77ecfbef74d2556773d7d95c77367167f3acb3197536b3cea6e86ce4e677c6a0917eca5f4167fde6a47f13778e4f83cbfbb7eacb581cd32f8c5fe3f7a964c155a3ac967898a2bfa088e30aec8a70a38bbf33f7c66743a48f161c80c1c5287ee1cfccc64922043efb8d51843da997f35979529f5fb592f117c28c8feab22acf6b97755954a7d96c04d352124dc7d1a4a90b1e69070da661ede8af699d059f85dbed96f157c6334a12f23d257a62f07cad1660d18f99a4a5f39f8805afe066a2e683d82db85ee4e3793e5edef967bd1e3fda9bb16aab562912327a58c51a5f60c9ae256fa21dddc18b6a1bae65e0be0bd2b54f74408a3c221c7f0bb524bc1cf12133e0aae352773cfa66c7fff9cf1d976fc6c823adfd9ded98b1791e4dcb4e6243f06bcf535df8a4a092a73ac25bd9ebf2bacb42b1763cd9fa9f79d30d2d121cc77ee6f5f138af9ead6751fff33678d6d8bfaa04fd08c3863d50382e5c74db2f22b1af0c74d010a8822965f8f9d22930af4e83ec93ab8b4b624e45e5b6e559b47f46aa8a16b9932af91118ad89de6cedb42b75618b31d7c5d87a77fbf6f7f7b3f6a08e363ee24e1630247f1e5118267152acc2d9d8380ed527e638c620e677ce60d93aaa7102b6be08f7bedd1a239dd2f9d24ca86fb94ed6811b316d9b4d6a3d234f3c0f34159ff0844ff4299fb16ff467d66d6e0a9a30a78a1c5540fc9d85f6400cb7fcd758bc8828e3bfe95fdc12bf032c52c491878d8efe53301fc678f4e673c75ff7f8d9f6e6f3f7ffcdfab0da63d41ff44efe39ece0f5747303af569fffa3ed77fb1de1fde77fb76f4e6e4e87a5f9cf312a1e5c9cfc2e4fbef64efed9fdf33f3b27c3ce7f3b2927c3ce4dd7bc0fd70ff8dfb6c7a75c2a71541a0ade9c6c6e743b7167436fe2efe94df726fe9e31569a0dfe14895234bb0879b6a3ef65642ee5e659845606a3df03b5e31b721f1428d75325d621da6c24c88462e9d80dedf830b63193f4ddab5df348e3f5a42f7d2708036c5feefbd27592aa8bed7aac74fb5a77a3ee2cfcbe22f8d616d4c56cacc59d8d93520ed0e98e2ea6375bbb5193e7c9f3e8bea1cec69975c97938cb75c6c97727bcf4ffbc3b3478593d8a11c5e67fd469f9e570a71fdedf69f9e5de4ecb2fffe79d3e90ec761e7a5b6e1f379b3cfc1fee2219dc9bdbd829db3d23b7fb77bf14dfeedceff1bfdae79adc38dcbd32cbeddba1d9294fc0ceec6ef9919ac9f1ffd6308effe530746bf70dc466fe1f0e2597f178be37121dafabc4d6c073c2ed4b095e09ff4d82f572185d63eb08de1c8d233ac8fcf6dd659bfe5b77cfd4c4ab06e4d793bdef3fdaadd386b7861398da67f436881defadba3ec2abbab4e30f154dc2f99703033591fab58ff095d257ae0e1290a54f60b5a61382c73485e3370a852b163ca609db6c28fc90df3f13ff93b1bb23fb9f4e4073dcf55e120700ae6c0b911edc0d870a0b14dedf25878af6533f01b765348e6395f99f79e904d0ffcc4b04c5ff0ce44a2dfdcf5bfe321283465cdd2ad0fa21a2af49a218c7853ee96729c24c4f77fd1757a70e12de9fa2c1179a766194cb869bcd4f8623690b71b882d96eb7433ed39e934fb4e202e1d3f2cd38ee27fc99d4efd6273ee147f3b28476ca4f78a830a0c23a15dc8f10eca0ab0e0a6b02cdbb83b6dea26c5a2bf946740cf5ef46c7d0fca9e319a64d6f37233dae768f28d57cbbafe42ba37567d6bd329172b64d9d86ef2fb5cb77eb66d4c974030994c5ab3003e66b131cc1e456d1126c3e800c4e9f9c298de38f440a4854ae3ee333313212527da5ce8c828ef631ac8b946ed1b3b20ce1d5a7f08e0e3975d98a39639fcdeb378e10ccaf379b23620c0b8cfdaedc6c70b664c9079b08d56df8afa444cf9cc1761135ba0c405206a32bdbb4314802552f1f2233243a3c4859c601f44edf7058b2811cb685684720d5fb14af9e373d39e153002654c19bf3cd77e75b477ca2403276727a26c116e4d34e8771b420da11870e85647cd2bc85ac709859ef102e61b7a7c6b8bb27dc4da3be19b7c2a9bd82a275f55695b4069022de69c26a6eda2ef478335fb4cf78b30bbbd139f8d1c48dc1c18cfbd8eaf0d4778064eb58480a375cc1ee5934416ef78e68cca1091e33fe89de7bda1a2d2058b8a4364238bcdca0412867ebadb9d0873ea8d911f6538baa8e0cddb0d8e95d25a459b897072cd62c33198ce6c657220ad4c949c022110fd490462c58384b813a3d8fba4922e20b7b1669ad970306f390474aec13ddf63e1854c79d933ffae81f19ba947ba876ee899deb5e13c48940b0061ccc2071a3f1d0141cfbe326355bfb8267eb64979c858e06345a625532d9cca575cf9d55f5451d5cde9d5b576312da333c6059b91574738c5f47df9822d2ff57cd03152d7d95e82f70f44d70c1baac09b5618b785e037965aa0906882d57e2a3aee6c5374a023f3f88a4a40d64c45a6fa6ac81edd51555bd86b37e174d325c05aebeb5a5c8bdd37564e6ab9c1b2ac5a98d01621cc86361703a21a9356140e4e06cc8202a2a73361ba8c27d0bb3c26df6b50a7410e146959ef7894ae3d231658d632f6b1774f080d47043f73fa6c77fa292f56db00538b4f60d2d7fff7dd8969b4ddbca93f4a4260a5c19787fd8244098373771871d9ff2547d8b2b0976ea1e6fcde5fd27868981101e26508c5bce7030c1a4b4f8be3d2669f5c58e1b479e3a7cd9aa54635a77aa3cb21c5a3e526229e99e47b1fa66007e08b758883e5d3987b6091a0d7934ce625643629bd468bfc458a9843b54427cdd3ce3638d70ba7e6df881d5ac92cc768d70f774818982e2c4af69d13aa986c3e6ffe900a266808c6645cdeec4660ce8fb76c8ff32d8b2ba0707deefbf418ad5ffb46fa919d7b3e56c6addab46b4991adb69af5ab1edd2449b11229082f5120fc5961ade47a0db20cda4a554295f154ba5abd59f96df96639d28711d5252c2b469f9f23a8e09ef313e2b5fe1705fcbca32bda3d6870f8195b620c0c85c4e08cfb1735cb404cb15e93c7d15cc9518ec0d6dad691423bcb55723f96fc2ab63f85fbd1efafdca3a7ad7646c2b0ea7e58376488ab852e5d36ad1aa5c949aa7d5b27c40c75ab52fd356edecb4654253e177b5c05fad61d02a09c556328f56452b9caef256345fdc21b4544b07993aec43feb00c241a444331d8df5e957ef4fe343b2a39314db5d7e5fd737464bc79cd330a7fe48d6e4407e87b20c7eb63cdcdf7e112d2408fa191952cf451b04ee01ab5996e2e739915d17415239085251dabd5fa5bafc78d659516c9d7a4f205e1c7c7cb6988d4e6f55533a2f468119ab68e7ed3cdd2e74eb4bf94adaf254d59bf8c3d16758f33f599329ed0595d08e4e9c03acbee7486624ffcd75259dc42cd7e8b74ca363a58c56024d6a5afc6681bc8aede28d038a8840f503e005932833253d7e25e14541fe82ec647e0b776f5b6a2b7211d7575750cee93758172be87fc939e680808007496064c5d22fe071264168de6f9bb0827827067450c9c3adac595787a883d7179e1accd8f3b6b7370259d63f84a76b3f97cb17f092636622248dd2c7d9ee9edb0d98cba451ef1891875e719225b00ecf029c8e1d5725ee8b15814c0ad8b7fe239bf2b4fcd85e8f1155ff267eecdbcf23cba72277b55da02054bcfa3ca4cf692f16599bc0d12bb7db487bc425d146f6b150e3a734e89396133b7b9f6233a659bcdd4f3da89b9b0f11e03e67e129dce4590423d3b554b9af2b50ed902c59e6eb1d24a86f5692b5d987e12425c589890980d1be45dbbe6420652dcb9124ec9d6cf60b565f69fd4cef7f988cf80431dad60fc2764b0ea1eab193c691f8fcb0568c6196181edfc04e0b7ab89791712e87e79de3348a779724f656fd5a795c26542b0eb9f51c69d8ea35b167ef05c6f68b3570e428efbf6e50936a683e39afd440e8309dcdefaaa4bab8eb0401a1fdb300ba18c5198726e87bc282fb0fa04fcd70194ee3e71a9469f5cc2e679365ea25ed8209715bba7a96ae4ecea872de32be5a842f07b51a35ad3f074bd3d4d83fd237cb13b0f30dda9551d11347b952df94c240683faacb530d312ad721319a237c20f6aa1969b4d8f4fc1a0d63b043a81eb2dcfa02bb580313b225fbebb9b8573287a1722eaaa2caede7371fa07eaa3d0c529589f76814d37a3b29678b28d14ee79743af842a32a0e14c1f7a4111a4a2bf280210915e1a168f61f971f36ff273a75db50e032a9a188681dd13be68bce48bbc498740a6618d6f770161c21989d8a51a9115246e7aae296131244881700d695f6e83c5de5727a2997343a99d4dadad9201a6a4d54c4fe763a0a42c0c138b7437eab1c64e0e0855bef0ccd397cab164a2ee1d8f17f740f9f5dfdd080f5f75cbdc65d039f599dbba46b9be25331a9234ac20dad7622dbe9b39ba233ce6e8a4e9d82f8f4456799cb68725374c2bb4626eb6b1133e3195ff002a09e2ff94aac8fc7b1ff586a6706eda96192c6948c75b0c8090b12311d9c0d8391980e1e0c033a15d3c10f43d6a7999074caf842ec9ed525ae89c112b18683d5e06e2822c0486dfa22acad4d0be767057b155a342ab050a0f518e1eab73c17fb10ac25b72c988aca00ff0fdaf7e9e0e6f8e63374a96e68f57c537ccf2174afde196270ee8d741cebb1262cb8d383db6cf48897e588b1208f80f4dafd7b09ecb8ecf0881fede29b58c6a948c135ca30bd5bfe95bfe18ff917fe9e7fe64f40ad8f13fa494b4bdf0bc933b1d86c0acd0059ebe73c788f42e0993dc65e976ccfc5c463f84239266df1d87a37e831cf7b5f52ef8f59f05e8784a26cfb55bc37e85730124fcc639d8636caf018653471b8b61488a1f0be9ff8ef07c9907f1172f0183f19c51012c6bf48fa8593ff4f6fb25678f7ffb5c63161fcd16e17bf30f6593c1a7c1972e3be523faf065f86e2337f324886e273e58e6075e0e3721f7ea24f1ac7577a9e2c83cc791e7d3490dd718c6db6653ca6245e2da07f38e15f58a05b588f63ffcb36d08db6cfb6d58c3e627bddc58c5274f8317c69dd86f4b341187964f09a84f12664d51c82e3574fff7c7b75fdfae3d56371d4ee6d19ff5cf6cf419370847ada44ce9d7a33e5ebe68407e584079f054680619cc0572b9de2fde46c58f66ae0be981ae1710d3bdbb4cfd66f84790c32310de2792b1359c3cb7aa9969d795ed618070bccd807bd615b684302906cd49d14601cfc824e19cea12dfe79b73fa551d71b5c6f25b2f506d0e08b4617df00403c66c11b0b19123ceaa8020206d6fa752a8ba580f9f2d79333bccfc6713c55a24d6dd1cdc694d215cde3b8cc0359a56ba18977c630203d119bcd8cbe69aee040fe0fc893121083449994d449d4c4088fec4c48db927863271108e2e0b3dea79fb76cfb48accccdf3f95f51782e75a989cb0631f66e34ff5c7f14b1b51c5c0362f68943fa139f58c60819d298830c1e8d636599105ffeeffaf06c1cabc37da85af51bdd39d487bb83ecb206ef4b0712737a078430f63c88d562cf6b7a99465ed48d8a024da18d40f33f9120d916dc962de35fffd5b877103f07297ea73d0737e8b35ac27bb42bbe8a64a10ab1c638ef41a2ed953103cc6faeb06e69b381b25cdd45476dbf1411cecc060ea6e75900f9db6c301dba449aa5824783e99005305b0966e61682eb00ddc50169934e3cdc6caaf73e19b22ace2c6e992c553b10b076286fcff7ccc68809e26e8d17d1e626c188211b32c852606c6422e610fab5962157ace28e0cf985c1f7bf8973fdc32563fe70976c87e238c85294d677168437f5e444d58afc3252d950b879473b9988d9127ce36363e2e1608e236e86015b0f4dd93cfabf19e941290e3813ca76aa4f86c27dd15d7613f6fb98567d84d46dcbf8a5654bba27a1ea2439aebf25fb6ad6edc820601aab7e5f176594ccf3c54866c44629d727c9a94d44dbe1e12162804e4107aa0028e8d868b48e1c63a800c61f6b70b0c3fbf93650200dac7e5fdb5b03311dd8ee344b4f6cd5448858eb9c008d59aca0f2378eb956ed31d65adb21bf523092c9d2d75a530411bf9e6859a1d3e096f1a74d32bfa2610e112ea5e669f77b4b20c8028401de59bf4c49f3f96a51910cdad7fd4dd149e6390a56c87589467faf6d134c488c467a13dd76526f18aba995eef7870915a46b1d5b38c377a5f6d0f63eb40276e70f08f8c711f65c25c81cf27bb765a58d63346fdc19e306a6cdb42c9c4f80be4f85e25930d3728e70beca60df659a098e66dd71365e362818d401542013f13698413251ae645d2862eb2fb048fd8e9800a9a50ce93b164cc09d6ef782a92bfad6c2701d911011aeb20a340271b3c123189a2a5f765bb38ebe80f12e4c0a82089568f9642087bcd99c34fe5b4a69fb876c92cd3f67d5a7a6a5bdacc6f02241fa2dd2d1345c87b4fa24c84cff612958c69aa21920be49cd4ab301b39246d68b96aa5e8b03d8c964178d87da47b42b8faf3e64ebc33d0762b60b5194a5c76d4aab767ab38e2bb520f7c6c7c6912276e632508d59efd32fcee27da9168f477a72a4d5b0f6bccf87a683f990fa7adee77e591eb1bbd5a1019969c02c9417f7bfd4363cdcaf20de5542d4ed8299b375e2f24fdd93a3827a729c069025056266bd95d49d22f092a26e32ce626a4e832bc3c156aaa2738a4786db1b193d50f4e3a0beca0a76c68d56361baa44b2d334afba8a03c4febd292b47743418fecf075042fd62540d60bb3fc5726772cb9de82afe8daac6d784f883e196476240c890c77cc273bee4b7c152a4aeb64d702b2eb4af53e3defc5264fdd721bd65fe2d7fc29ff3afc173f03772a859615857fc19b0c3020e353e7bde4f74c918c8d7dc2ee060c8f857d10bbe9e577e17bf763aecf9603a14cbc1d721cff5edf6992afe9c71a4580d0f5bc392698218953c1197a612fef5fc89ae683d115fd1838cad27e272f055b34c4a1fbf939ae3c1acefece0f9201b8ac956b77f3b98204ad742b70dc9474061c21a43c7c23cc18ad8a844204a4fd18fc5239adb6dffb95ff6dbffa47fc06fff0cbf6588068f06963c164728a283a0e2e1b9f6c4b81442949ff05ce49b4dcc822b31d309c1957844af58ff0aca9913835a8d63bf6a2aeb6394fe573e95a19afa57dc6e193fdeb26db1d9d0bb4aa170d99758eeca4539b81c90efe82f09a93f6de75be6e79bcd81e2fd83e57b5bc682e78657715985b90d9e9f5f06cfb12cb18806cf8730614778d02f26ff5c3cefd3a5589b3bd67f5c52be94196570dd129cbc9836275dfdbbe5b762b01cf22fe57cdcc2a3a641cc969638c0e4df8a2f83e743be14b7e0a92dcb8fdbd089ab8a351b324504d6f64a8ba282af66504fc4c40ecaeeb55c4cb063692c6e075f3b67e06c5ed5d4098f6d7b222f9f3cefe8aa545b30593683c1bf4fac0b8e63cfbbc219d7089e4e60fc0a689e9d6c5dccbea0f02ebc8faba24e414c87be2250a3e7ddf59f893b9f3e132b3be34c378a5c3bf9f653c29d6acafe57fdbe353bd28cd97f6616ca14e0e3d84795f54ea9abda327ed5bf3271b19e31df599272299f317e259e99d3dee904b766fe1f7e0dd86d77315f50567d62ef1dcda9abb6d7c3e701fb5296c40cee1506c49a0040b4e9442c0d4f3866968578a52c2f7b0982022e18f0c54c4474a2831b4da07f052efbe047fdf6b721cfc464f05f43bed0654c9c237ef4c97cd2c717fe94f10b9df0f721e39fc564f08f611fafff18321d908c7f11834175261233a5846c87c3e00e081dbd6354317eb7a7ab6122d5573930a2482aea25b9ef9252f75d523caac03fd7606cc4277cc61760fff35bcc5ca1c1fb04707101c1afb0731f2cce57c1a2d3d1f9527c192c867c26cef8b2f2b516ccce97a5b3807142e9483b0bb06bc4dc1d0f576123bd3d59000bae18307ba4853c9f75f5b7a217dc9e5ba0a34b4c87221adc0e39a064ccda62c482db4ec7dc25267b34b41015c0173edbb6d61f27a472ba414efa041ea227a28ecaaecf1192b05855eaa1aee8362141d62d1e393d429deb8986fab109e2b4dd363bc79db1f2aa68ba73eb4ff4ad1fa415ea34aaa56523060fd533ec527def2fc40c9152670249fa3e2fc4a88a77ce975a17c0108f1ceaf4fc335f890b6ab99b9597048bad30fef89e6c4d16a2c0971ac40423034bd1f22df82026522e65fc89b0c73b189d3fd1ae39ec4cdf0e464383f5ac3f8b3b71db559fe8c84eda42935174c6efc0bc2f4aa58e6fab16f61c877fd09aee9798d28cf9457f6a1f27e5035f18e1f8b36fabc76148915857249bb64da9692a10a2478728d16feb2a5a0ddaaf549919b00aa2c047352866c0ef8314ccbe8656a1cdae149eebded68aa613e1e8c5405a68b4de4a18ddb42c985425ef2f154c3d6f5a93bbfd789f183963fe544441daafb0efd499481eb1f5ce401011ba54eb9e1aa8564e2928c2694d23837261be9b60a72c88efb719dea9d27eb2358bfedca85a1f66de6c59401f49f17bf7af9f572abf637d7a211e49bea48fb44f2ba3187765c44efc685c68bdca7736d97d77990e57d23190e636c0a7fec23cf386214899e32440961452c33f073dacff3f2b53f56d00d5e9f2ff3211ea18551ae8c50bf13a78252d381617417d37802b40255f87f3f9b258e672e1ff1672a8d5f989e4eacb12c1a0975c7b852bfc95ac787f173c99e757321af99fea41fd1e726828c1b86b9cc57e8828c62fe0257c21391c98ebe70f211fc72a5b82717921f9b8f8508573fbcac7c563f3fc888f0be327fe311f174f4c87fd977c5cbc2e3df6fc5ac72ec9433e2e0cc8f2df447c5c5cc0dd91ff13bf55397c13f96f63542e97ca7f21f974fe59e56072fa5ff86ab130cf4f258fe4741aca6852f86badc7a172bfb7e5c7c7b3717695e7fe137e747c1c150bff7db865c17b293e44f47716c0a2e2bdd62e7c592a9858e38a8835d3f960c8708ae06926370122c1b6cb63b62d0b123ea88b0fb91be7d25d3359d7b26e84bff41fc59075b88d54ccc171c4baf1385791f68db796fe2f311f6708bafbb2f4ee2e7325fd97119676e6ff1af322cac78ba5ff581994cf7f0af7ef7753e53f577cae0fa6ff4cf1526bd61fd92728bafa7f29de54f9f5c748d1e88d3fa91ea1dceacfaad7d771ec4fcbb7b99cf8997eac0fd31cef4fd0bbdf625e0a9efc2f487b9ef80bfd5bea2af99fca97f1d22ff0f46a9ea12b082fea2f91f0462b8c8cbf2a7fa57825d9bbc533246afe67fda4877aa71f3547ddffaaf8917d010bdfbfa8338d3306ff91e2d5bdeb3f51dce56afb9778d73c433fc22334fd7d85a7d24cc38f95e567c6fe75a4bbe6be6934c14fd5b6b192f5b8f366ceabb0f1163576c6baa1d5e84731b7a230ff7dccadf9a5af627eac95ea9e4840ae3b3f4142bd26e3981fc7f368a5c1c25f31dfb36ef42731370e68fd8b88bbd7aebf88cdfbad9cfa9f62ae3530fda9797824a309005016f32a96aabfd42fa9bf8a79c9ecf6bfc4fc389fcf97252cbe88f9f127ff2ee6d0ccf4afcadfc76aaa521cfec7782fad49fd27316f6a7afa29524a5720fed3981f7f1e67f1fcb3ff0cd4f7906d197d25597074417f869e948c1b38f6bb88fecc7f0b350647cb0fb99d1716b46596aea6324754ce6201fe9a4d30109556650d1369a4644c58c525fdee5c9fbc16c406a29428c049d6c3ff061fa450cb16f9f0fec9c93f4830c8d29b1b5f2b7a0ff9c0ea7c0ff90037f989f3fec579e9da47dead9379d7c868d7f1b8584ce59d9fcd33d56a8f6708ce2ab365b0d56d015c5445c2e93c9a04dbf3d3627937550fbf63c1d1ff0f0000ffff030056757336ef870100")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["angular.min.js"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffec3c6b73db3892dffd2b30bacc88b265ca4e66e7f6a238531e27b3ebcdcb1527b9ab733c559408491c53a4c2876d95edff7edd0048e2c587ec24bb5b75aa9958021a8d46a3d1e86e34301a91a378b54e82f92223ced1803cdedbff99fcc3bb8827e4b73899132ff2499c2d6842a6719425c124cfe22475c9611812d62a25094d6972497d770bb07d4c298967245b042949e33c995268e853023fe7f1254d22ea93c91ad09237c71f76d36c1d521206531a41bb6ce165640a55138aa866710e9d07119453f2faf8e8e5dbd397641684d4ddda1a6dff998641949149125f41f74f4996e474c8880ca29c16bf57619ee2fffc37d91e41cb79184fbc903c7a4a665e98029017cdf3d04bc46f04daeae7f02585f14eb3fe786bebd24b48ba8ea630ac684e0e8a16ee32f6f3903afdb2ae3f2467e783316b9027e1c4033407a40f2c62784a3817089d0573679643411047c479b4c8b2d549125f063e4d06e4668bc04729747d3af3f2304bddeb3499fd9d7a50f6d65bb20efe67f7e8f4fdefbb1fe20b1a41472d6d8fe2f822a0455ba5e5dd4027334be230a489d33f2d4a8fb22484814ab4a7d378058c645d16b4230b5609bd7ce165d8cddeb82c9dd3ecdd2b28c229a94a91475e927106b399004ad84018762425a2acc314006eeec65a25b0d32c5fae8f5fe018fb4a6904128948cece2d488e231ca8429ea85f2571164fe3f06801f30f725c9229c1d02481f561e24e298d5e629d490d08110d4dd213ba8a2d23c5e22403a67a757527c0f4805ed9b91851ea1f565c6455f8e927cbfe53d27f416166e5423f48443971e0fb40ae4531c14ae4975c9ec5f97481151f573e4cbea8bad3c9389ed61091d025a80a2b1d665541841f5f4561ecf95642bc34a349905e94a4b03fa0634e618947f31434ce2c4e2899c47198921096079464d0469dc22c63c030b9acfc26f001f7eb0090478008960488d534111c2127425c08872087be0f329ed21400b3f50a74543fa3d759ff6e28617be35d9fd2c87f3559a512be7779368f7165bcc7c5f43a5806a0ad5f05bf8dd241852cca97139aa8e8de03022f3a8e6028975e782aa1e435a4a8224e3b2ab102edd844e506084fbcc403cd12bea75f7258fbf278810b04c60c0a21f2d9b02b900684d08a2f4d8d7b88ed77d83708afe54c6c609f3cb9f1d40b0fa3e804d6958491159317010806ec696b22aa1b682b10bd8cbc4948fd7a5c151a144615c9dfd8ce65c5c2abbaa139453dfb1bdf3865a1c5625295d7b6ff78129d9814f01282958d8ddf9b4d0fa3385a2fe33c05f3c1c309627a8c6fa71644e7aa4a9be7c1a97d698a1527b3e9e371db721c163b11b71954e2558e21b6c31ccc13303aa61edb0b3f2abc6b4578e2a5e9559cf8cd482528817855953452fbe1b5bc4ad138fbfb870f27a7605b25043ad2f8db84ecf0e4f8155dcb9376724c788940e1ad820bf8ad4d526925c0ae7f9a4fa7a0f8a9ef0c24ad1fcc88f303b309e45269828328c89cc158ad72faff11d10c7870c1b6d5fe00f7512f74fa0bb077fa1ab46971543b524142657f6c4846d5b09908844d1779863b5533a4cd145289be3399fbbb072acee46cfdb0129ae54954c70efb84d471fd66e24d2ffc245e811c407f20b92017200d93d84b7c6158dfd54c4ad3004bc36606e35820706974cab431c3d305844e6174ef90fe285dc3425f028d29ca5d9a4ad636d8259e3e385540c73611e018810c6c2f913ce0769f63a74e202f26486e577d7f37f913b64f1778963ab205387061adbef4a60b0979e01b13636500b32c7f4534077d28a011ba621fdf1f1fc5cb551c8182414c5dd923b181e13d0bfc738313faa8e4ef761a25b3bef34ca1bb10c568e1a27301e6fc9533182a10195ae70ec2ec964ec8808cc8fedede9e0a19f84251151fc96781f6ead0506d02cfd029ad6311d36658e92ebcf4dd550456e08a26d99ab1da028f9fc26b1d1bb577464906e6861d0b768a93028a0aec1f20ff8d972ddca577edec0dc95fc936e72583388e7e5b6734fd10676033ec5a3c2c030a9997f9039342a5e738cfdaba06b3ae4bdf0a586de7770476c8e98238b48eb50653f6ba0ec102a8cec69d4d43a85eaaae26da1603f71e3baf03dde7b4777737ded29d4430f5b23c55d42916eb7b076eecf14c5df50807ebfe00dcd83cf2e92c88c094b36f2d6070441711ee76b276577a3051c34481f7006bec07eca216f36916af56d0b315330bd7647c0df751f359ba61f555eb029cfd3ddb3b77b3f82374901cc1d48032dfe115302f93344b9cfdc1581d87687e407ae8fac166db23b7b7a42a3df643dad3c7c2ab77a09a38bd8a4af4684f683205fd0cc6b098981dd2fb71d0b38e56b0441a9065c68f42b018bffd8407d12cfe06b3eda3df967cb5c95666ac1fc0dcd4f62c1662bfce4caad0a47ce26b31ad9260e9816366c364619f3687aa507cf389847db26ec416f6f2882ad3d70cff5e37acca14aea6198c0a2060b7b0ca092e2cdec5c80620d130d6f9ca76a35918838506ddd8d522c6042d6a118b8f668aed8cd4a29a0738cbe6251ab86fe1eff18b7355e81050e74d51eea2551652d62d32115861dbd44ad5ba2259ccec9fbe667e118a51ecfa9642431107178bde33ec423f0e748c0d5aa7cf220e6cf8a536b67016637dff1e7c8d2f36e5a7704e3661da12acbdb49e5ba6b2fe976597a91f3bf1cc5486ad3cabd78d38568ce67c678e09c218d34430a949b7ffda30df12cbff6943d09440e33e553f944fb4751a901895522668d2894d0d9902e2922629e0b6d1f72dd91306b0f77eaaefbb659e61bbf5b163833bea7071044bf469d801957c5e0518c28cca3106a4b7e836aa584938d6b1e26fe010055e37a4d13c5b80c945f66b465cda070d0315d8c056ae150671c2d82a0dc25a11b51d4d945eafce4229e719baaf692c83348c519598c2fa079ff617bb1181c7de6f6d03d7a738e292a03573b40993f8d295294ece5d2ed668d0c8a236fe74614e67ce503fc8a428bd9d33a311986a17947804238c68a340db75595d706bb97ab72a3cece2241e011de51cd71540b2d3ad2370cbd3080c17d9aa0f617b5b8169439e83556bc384e1fa463200406e884160c187aed153168bb7b335f52e61f71067df05530d699be2a222ff387df7d64dd9816730d3a89428c406f12a6327b30b9664903e25377de8250305b8fb0164921da9ae56a1389f18fd99c651ffee4e8f6eace2d488f5415f30362068c83ab1453abac53639ae113bfebd4708533beb67013bb9ac3da4699989ce029ee609fc132f294f2899f2840275ceca24831f0ae1a25f722f4ced523e34857b406e6f8de1f34f334a90d8a12ae19a5e12b4e9ecc564a2059d5e204cce0ef292e2208f2c3c3c64a711a162bdc509f183947d37ecd0a675fad34fd6655caed36716f7539a267b2366e6ee596d5816cf7d0841a838362667775fb78c6b395d64a590ab200c092656a06338a1a50481108a43208dd1e6b4bbfcc890456314011015f503317363d4b336fb200e4189ac81e62b52265784781c1eaeb72c7da8125fa37525f91f3720a957db86c4d752e0f2936461fb2b36a709759a256e0a2a3373fa4354fcde4a5256d7922577ed828a5e8229c0348d8d8a4ae92b87495bcd9b4c0f4f1a7b753161261fb57a4b39905427b6e60474d303c19a1d4360ee9b5baf35594b56b4a757011e0d5cd1c90a5551b94c60c944ec8c6fcb5c099a146be2ce33da041ab0c690e4be36450dabca5c3c3a32c38d5671c3947e089634ce33a7daeacd1579059e467ce5e242421057eaa5f8aa753364eaaf46da1ab3de3895ec5f6d3b1407ddf71229bb2c4867e71d8c06db61fba64279d751ec2ce3477bd7eaee69ee4f81364f12b0ae448b472ebd0695e13b37303545238314ec02d8f7f21af48b958d0ad8290d67fc4c54f3bc15c7db1c6f45985b66cc802e2307a5735416bb7fc64104ea8d58962b02bf044ae2c47d04727c9230a2158f07a7ac60db57308d03d3dd56180fbd05fe172971245dc4577d3b2ecf6f41669bc51b995f98a1b88ebc25d07fd76126b525669d4a3bccf767b44f439ad156661b3d1aa92fb2a9a5b1a43d77c5c6876e2114abff5bac0fc912929702afeda02918a44e87a4685946016e2c3cab40c9fcb09ff6b23a7654ada1d62a3a0cf60103d6076d4e8069a5583ca646a929022547e82dfa71448724186f6d28546550c9322a03aad48a7ae407781b7a53ea8cc80868e9437765c96e51a29c1817469cd143a9146c1af4a1462232c9d40c3c6b85a53490803c5397040f0642c5ce8eed4840863d0bcee580adcaa2069fab685c8d786c800aca4da7013f93847a171d8e0a78ee0d60aa593a7cc4ab3c5d38e6ae5aa342dc143c58a788d27b09bdef4aefba2cd86d9b02579ded24c7c36b555b67cd865b7f6b80a30852362ed700ec155dbbdd43f2228d8d203c634334a38ea707f2e0eb85c83262f0439be7a15050d2ac57b3a72f7e217846c857029302aba9ddaac44c1ff4273b8a06cf0c3265a32eec4b5d7430c873e3d64aab744c43ea252f8b44a4462b49be0aa3d079a6522d8e2976c9fe3923abd54160ed468c921a3b6e96046056876b735ad32c9149adb6e5cd85b78ab8368a70ca8c68f8b7dc4aa6a5321deaa7285837183404e3014f8d67bf8abb084c01a7e69ed6ba3698a1bf996b235a2811975ad7466a03e212b2e48262ce6e4cfb590617b68f91336b183f6d5d9d15fae5dc4c1cd78c560b2a715088d67c1520b403b81852674aac970678066b248ad948659078ab45eac9b67fd6b67d45e90a5aec34d386977496a97b01c036e96bc3de5c7f7b4bfe725fb71605b4a39f83dd7e053f079c4043ec5be5fd4691a9a720bdf777ffbeff90d158691c33bfb8b98a5b4c74851cc3441708ace262409566d6d9b959a72e60c92630d770a9e1f969393a5f562c36a3d8de5d54688b03de97dd0e9106c10d821bae659e0a42f45b12ba8d821fee71dbe9d512534b188bbe308d000eab6814730cfde20890e3ec0f4d08ae3500c66ccdea519d2006cc40532944bd6022d412af550ee9dcb08cb4438357aa86b3253069ed6ce84d27827be5451b2e89e2579d2381729fe2320f98a0d76dcd9b3bdc0b705f526d3d171262d8b56075d42db3da3d16730e74531ef128a66f69d36899318e94503018d48461393ae693590c6756c9a290bd21a939ebe0b3d8acc93ba9adaf13ae1242a5488b65d754f295be93b8d08c5ffa53f8345545056dd6122af1223f5ef23bd5ce93bd2179f2b826361b05595dc282fdb85f247d6d7a354234b3de8d68e971c3db6305d3ec37c42408f12401cb38301def16a24406c5864495af23d412557764d972a82902574ae0bf36f062e07a5bf9b936785be4c5065c3c94807fdf782ba77e75d4226009bc8e5e2d9bf6eae8e5b3fa9a7c83d1881c37a441443460afaa78059e081322e834c4ec2e7303046c57945c79518687fb5e7ac11e45c953c000bf973c696aba88832975c96f7986d0608ef533d6c6860e7304f239a259123fc7154b7055065e88e78cf96a48d218b1c00f44cd5e0c215740b41519109361e4803dfd42c92c48c0e5bc0c80f12ef9ef058dc4db2e1c4b90e225bb94da09c317674a7c00bac48719a079840fc2246401ffa4c49bc743a44e70c28687bd1880e79f5b462dee6f8cc44f4821ae8b789a2f811e97535805779d5f9fc27f7fdcbadbe3cfe9f6a06a04bf3e1fc03fced91fe3f3ed81bbfd6870fb07fc3b827de7d17ecf72578d6d171582da0b6b2a29405caf6a7480d784aa3b8f782b68bcf4ae7741c858d593bdedc73f6f3ff945cf65c14f6d4e7641dc8ec49267722fbb84e3dc66a7c47604f8c16d337fd89de092d836137073a5b959aa98fdb8b7214dac031d5c0d6c4a82f2e04ba71b7e5ca77c7cdfeaa9d62b359e0f85cbe8b597cc51cbe002c46519e26f58dcb4f056397d44ecb4ed5686455214d3ca30d7984e7cd868e474aaaf45181e17bfa53c21a2f62e56b19fb18493d7b1e7db1228f8ad7a84b88fcb5e276fbd11e2e4b7bf7bf6dbdf8cda0dc59113da644b6883b587cef46cf1f25922859df8da97ee98cc5e708bf980fcbcf75fbf8cb5ba200157384ed01addffe5c95f7fd6dc6186d1fd3df4e629f909f0735c3b55bbc180f9ef968a9a6b7dfc6922c373343b132815fcf548bb61ac88e348db88e50f22b5b8b9e5d51ed4977ad04f3fac82659f047ef385446bb8bec75f27181518ee27a8b53ab3b4e85a044f7ed4034c9de2f5224745c413a430438aa9f9b27fc93875bc219914e448c9fb1e4ba3e7f75babf47d0c0a0b80891540bf2221d03c13e003db8cc95a4e148966cf4533c1822d092b8f7e31bcc639ae8697b7ab30f3a6cfcba6c01d89372c5850c31bcfad162a766d15dce6de2b04cf6504260de81284f2a3744be91021b444319483a0e55952046d041942588a5b306687ccf95eca3d86521c454bf858ca9d853c4eb2c4248e813cea90fb42124b552a42950ad8338325e67583240f49942bc4f8c13cc8527cc7675a040b714af0c92ee3eeae40bf57d0c2fe7004e440be56cbbe86f19c7ff126aceb01bedb50d6ecef15311dec99a86f44008d606672ccead038990a8fe528434823902d6cb5c5c99e70dad89fdb5bf244ec10ec56439e7197b6d71b62d2f169716676b5c0d7c11c5e5f9c3b3e23f085afb38a21bc55413ca704933d62410ea3c44df1454be7b1a47d44d73b070283008191732e80fb2c2a78ef43822303962834955131652d081025fd8471ac7ac6511c00f7230f5a78eae38dc57c0b6cd22b33d12acf8684ddcfb70805ab865e7f0faea9ef9422a7b4aa74aef6ae6441d024883cf65859577a74bdc80a99e096bab36ef3db23b5b7f83996e7a0e51fff4cb6e53f46f89f418e0e2ca0635bafcd5c7a3c401fae4ffe166c425a179a1e40cc9b6ec43452f180ee5fd9bb97aff227f8326c210fd8a6df26674b8a6fb9fe0bc9197be740fad3c04d1df42172b609655d487a889875a2a5918807f47ef10d840c3c42f60ce47d644c21de7a9bb2a6532fbcf2d6e95bfee4e4f7906febc32032f96d144f17797471fce22b136b2355be22cc2e6f7809330e3897d9056667e4deec0f7fb91bcdf5931d06dc8a561431601106dfedb74e1a93941390b27f3a1378b6e9e8ecf3e8f3e7f39195076846f1f11596d1017952231c92005805a4e7baee089d3c8e905b4132f2ddc703718037eab55b0f60ebe099dfbf27176b6fdfb73191a13953b8b67fdebaea42ea450f534eb6f7031968152fff512443d751e3333f2db8a44e3f66813a9ecfd244974c067f91fd29e91f495907a273f1e47b599c81759e4ec3dc376a986bafe72688fb7280fb407c95bab893d0d2257497e183a7cfb8f0a01f7fd0c3a75e7b249aefb2b0c6414f0d479e099c2e7876bde7cf46ace5f3e239ea2636e551f02567c7d512939a78f42587c6f80af1fc0d52228d220ca28ba7150ec68621a1e17248bc2c4bc0279c6649a80b2596b98f40da529aa46e1ea58b60265d40c3a7c63f79a13ddd85bd90d5e580bcf8f0732e508f79e8e3e915732380db56604e1970f6134205d95ae3164bc2191b4da5789a7ce85e0ee4bc81388c8023380f7fa7c40b13eaf9eb7b91c7a2c0f5f4b5d310a48423fcaadc314a848c95fc51dbdc1959a98de2cc2614c34581fffff2dc3e632abb9a05da4e01cb4646ddcf82f1e5e8bec28d169d31a297c2aefae3ec70f77f1feffee7f9cd5f1edf3d1ad53e7efa9091b78ebe13f2ba85c8b07fbb05f27f000000ffff010000fffffeee1f117c650000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffec7d7173dbb6b2effffa141b350d2947a69ca4cd3bcf8ad2499db4c7a74de28993be37d771676012925053a00a80763489bffb9d05011224414a4ed29e73676eed4e6462f1c3ee62b100164b683281a36cbd116cb154101e8de0e1c183efe05fe432bb801f33b100c213c8d4920a8833ae04bbc855266404cfd214742d09824a2aae68120d26137827296473504b264166b98829c45942814958645754709ac0c506088797c76ff7a5daa4145216532e29a8255110130e1714a1e659ce13601cd492c2afc7472f5e9dbe80394b6934184cf6fe9029e30a2e44762da9380425723ad64c329e53fbf73acd25fe5ffc0d7b93c1646f9166172485bb873027a9a463207c91a744947fafa8220951c43cc05a83209714a4122c56c17430b82202e486c76ac9f8026616225a65499ed23028cb82319c9d8fa6ba422ed20b2229cc2010546a9c922e8a333e678b709ef358b18c437877a9d4fa4464572ca162041f070000b5875142e7244f958c3e4831ff27250915afc84a37f0fff78f4edffcb4ff36bba47c3f80fba55011cf127afcfc74990935dd86799465978c5acc9d106f464db194c8d2948a3038b54f8f9448833138b2ca385bd371c18a951555b616f4ea395128d2c1b47cbaa0eaf52f30d37d5a3d459d12a18a0ed13d371de8c2021d59e1542b57c20c3ede4c1b8573b6683f5f6d8e9fa3ec41ed294a8c2067e7b5c7451f1e7314b4c69e295f8b4c6571961e2d095fd0a462d3a1a14264c2832d29e52fb0accdcd2a4b68da665dd075e691141f0bf59c28d2557622e815a3d77e2d724a936795167511fe0662151c42f09ca6c1d87d9830619e43983031724bd1fab110f5e53e57591e2fb1e0dd3a218a9aa29b261bc771071382aeb22beae5a35d649948b26b9e6624f13242a4a282c94b53785318d66402a74a30be907041e799a0709165a98434cb2ee1822a4585cbb3a40aad13593ed3cf3fb2e410825f9954949f2a118c21a1321646237062cc050a0a789624824a49653006b559d3430814fda0829bb183f6927c38a53cf9e5622d1dbcd7b95a643832dee060fa95ad9882f017f6e3448e2a309eaf2ea868c1bda1f15503ee98c7d9ea73e0de5019137ecc151557243d75209f177e070a0ab02410eec0e151c6e35c08cad5694cb8cbe84bf201aa52b0c5bd1c1a3fe163b22cbc057f27449034a5e91bfa674ea56a72f73a5752119e686d56243d8028b076208d4e41b49f584aa1702f45dff4f48a03f96b1693f419e72799500ea27e0ccf998c71eade8029eee1cd02bd3c4263ed813a3eb97a0c2ff354b19848654dbbcfb02df40b4e2e529a74635720381aeb203febb9df8b5214ed0673aa88503f164b0f07423f86ea7967fd7727fca4cd41f104b0b0b7f29b76d5673ce39b55964b782709f6bd76f2c5fac303745ef7e98b9c9dfa7d53d52fb6a59fdf1d6ff347633b1517abae3af3758d21dab35c2d294743c059050cc5ae802744caeb4c24fda00e95015e574f7ab97dfbabeb007079fbcfb76f4f4e619e09f8f9dd7105a83baa0fecd9c9f12f74e3803d3b3986e28981206b764937cd4e2a97490baa4ef338a634a149685749f8c3e610ded18b22f7a9d3c18c33158ea6f5a230f88653759d894bbdae0846b87a2569182c59428306757bc9554dc99605233ae38b5bb25155ec67e26e187c2397b9c2a9ba9fb242ac2fb22aa66fdacafd89b0d4a3d96eb10455b9e05deaf0774897d63f5e90f83211d9fa1002a988627130864bbab9c88848cc4ee4a6a353fa0434aa17742ea85cc2ac92b826a85e79470baa42bb4bb90fc1446ea4a2ab601449b43b299ded09ee259ac2d50d74ea338102116680f51d9647c5c237f47367c06d07b9f5aacfaf2ffea0b18a2ee9469a0d855ecfca5134cfc40b122f1d709634e1fd0ad04beb1f1066869b28ca7133fbeecdf151b65a679c7215b264b4ab7a1c3568dc33969cb734d194cafdece7d12c497045be734fe17e8967d73003dc5d453cbb0e47d5ba177f146e4f42a4d92f77612398c0838383833a254ba683da0367d3c6b3eb8a7dfc41b71932bdadef52118e9d3b58182d897c7dcd4f44b6a6426db4aa3df4f86bf7fdf5c6ea63d2fea7c4a603051bc54e8918bf58e354f892a865b4221fc28331fc03f68a6ed514c7fcc78da2f26da6480afbb65b9dae6851a1f254e2f4a6b7e52c57db9a7e9dab5ddaae9175367e033151f112423ada55296623de49578a70b0ad37ea7fb5e5f0b8896d83417b91ddc74173d3ed6feec618b8a1467770aa88ca65cd9de263171fed1827f66c6e9b29463dd29dc36c368320e7099d334e93a0c95831b540f08e5f729ced1ca6aac1862db4a123c6af48ca12b8834d74229faa6cbda6891f19fd03ce42187809d0f3799ad1e5556d4baeff3d3b388f54f66ebda6e288481a8ee07e5110c9fc422a113e18394e03e530d56730c46800e38b217cfa644167303c4e523a6cca5214df9fc110c261c5256ee94fa888295764414dc7dc87e1b7a3a157da46fb31e15c3370ef9e57ee9870830e4f6770b08d293d92e76996095f673968a8a4e1b70e8b96b78e6a2fde3ef3325067620cae668a6a954a20a573359cf60ccb0aa9a63da0181abdbde6ecfe8a26b85795f074bb027744ba0f43608aae64472f1bc377ccd633ae8f5222ff8661cdf83cfb0bc674821b7ff1d58674ad770396a4b4b365e36e6b4dfb617070e2c6b40b692dd88a888d17c9a3be461fda71d1d7835a1330f32aa0a85eb5ad798727f0f8a0835fa94791ac995cb3f2a3c7075dd5b5731078d2114a98e866106fc5b817d15fabc0c77acba15f2b3587f8979bf78383031ff31da65d9cc7e8258dc63fd80db5fc88ddb98e15cc706d0a7b1eb623a643f2451313e8e7613a68b4ebf8ef75acfc4b023c10f02c09f0f1d1bcb66f64f3f27174427249930e7183a2b4360eca8f28332ef860e659369d59fc57faa8e77c5a6b1c099b4ddae711ee6b528a28ba2b1eb4edd6e5f0dd1a54a677100e97ceecd05dd3ccf110e2daa2d9f27d08be1d35117d5ab0687a4ed1e297faf2f40f1e176ceb9dff0cbd6697b7d5a7d9de37abf5296dc5782ebbb5d59e08ff63d5d59e7b76d2597ba2d9aab3ee79079583f1d0bf59638631c4884c38763a688b62f9ffa19b7957e5ff36111c26d009047db2748bf21b15b7f1c205a77a5c1a103cf4ed60d3505c512159e60dfdfd95ea4919e5eab7eeb6b7f4f39cf1041b6e69a72e2e4ab0c2a800959510c8b88ce62c5554389b6ae4d736cb8d54b85f43f2e3e7d3da8e1d87b7c18d52ca176a097766f0a043e27295d123a8413b3b38f7ca8b4c98e485add660d63ca674c785ced0bb48ab191759d18eca2e498f8c2599d6a8dd3f1f8ce1b17f2982a937af7c82bb6c60172330cc9ad5dce86a432fbb2a25cc8ba085ae54df8c3555b44d3fbb286767cdd08429e79ccbaf99c9045e924b0a0430468f6b94385b6fca62abadd5faf5dac6a86cf20f12da056e910a1219a2d1b41b202acff330e0ea2b7e16c774ad68a2f7ca3e243cf0ea65e3e777c72e0b788c62f4b0ebf9039efb7484c324b9a247367dc62ab5a653b4b6788ec5ff3a7dfd2ac2342abe60f306970e8758215b2b54eec7a5ce6b9287f03138cab8a25cedbfddac29a66c90f53a35277c933f64c6839b9b667c709dc956b47ccef058349e2fc6ba115facd0e5be3bd858604d7047bb73c4b13b5d08638fb567952ccdd8674f4fec6ce032171464b6a23aff0c629d34909464d805e619cce08e352efa674e52d9302f63aa636859ef083e7d2a21eb3ffd903fbf3b76e1ea068cdec3f0d6542f26342e697c893e3dd747e1c21e85c392609e0ee540cd78cb04244ceacf351036f70f443b4eefdd6b4bea8ed3271d41b9de4a7a997be05dc3223f77be8421741cb76667ff41839941a7a66d621b5cb33405cccdc28de1052d2d28e3f648b686c1e6ed6e8f8a43771de9aa38ac0aba0569a7d7d54fabfd423c5bafd30d707a0d657e568a0925e966e069c3f0d9effc2b8d8ea63d20dd6ebb65f19d1c44452e8659fbc3ac97ea548948ae53a6c2608c8e9fac1d67f5c159c97d889460ab7054acdf7c5c544ebf761c3be89f648678563ff43b2f631f9d7ecb94e3406e766c470ec16d8fd43b660c83eca618f81d78c1544984d981d70c0fd7aee9c51a5d51394cd85c0f12c7ed5423a161c50d7347b75cc2cc20409683e960d751d51e3c4db0d636ba8e2da97acb5634cb55693a611b14ae194fb2eb0807129a692914ccca061bcd8cb5fbebb0b6864e3a531e4c6d53cba68a7c9649f96dc1c93ed961d1e04b57b9ad51deec68761ef971bdfb2a4bb66e7f2c6c9124696adc8de8074579127ebc19dbad4a9b156c82f1c58b0f4cfad558233ba5e91c66250f66e10e8d8df7b487311be4a0f254e126df22958fa33f32c6c3600c9ee18ac42f12a63211dd95549d08cd746dc7839ec4aaed2b2c8d597bbb5d537c187cc3923fab24a0402eb3ebc08f45922d60be5efce8eaeb108264c3c98ac5c14d5717393dd91862deaef4d3fcfd8a4e684a15edd78fafc556f298bbd46aa8c4bf4d9d0e3c4b8bc66b02db43281ee02a9ce2ac84dca180d21e3f77da77c4b0e4da5344af3c7c18fd95393938b1147939860ad7cdb2c99a5ba6933d1ad08d821d84fd02819b42b73ba0bd4a69d80d12f45a8d0d941ce16e31c9381d039b0e6e695406007c52b5a8ac122ae766e21b82ae5312d3700293c5180f81ab27fbf6492de7c22ee25a2d944ec1e741bf7491884a6a7b066d634c2705018327561128a20d064e81ddbfdf340f672ba669cfd879a9a0595345cdca4e47dbca95c4d34183d2725e9fc1ec7f178292cbfae31b9fe521c37710a9c98ccb48b4cee5b29c8aa73e9c1ab9cc840a6d949e083a9a36e9761be9bb0e0bfdc69fc5f20f0c6304db5c5b5d073d031d63ee8e22fa639bbdc3958d814f075f6c794e00df1acfb4659a7cc7d30357f86e23f2484cd2b4bf1fac83727abdeabde6e03786d7d0663872c82c9758dddb073ac30e779d9d2c190897de631b5d615f1ae106039eb65e7c9b0e9a4ebfc1599c52225ed8543e3f6f4dd04a6b5a2e7956fbcbd807ecc38373cdd6d6cda24699684e023f9773c1284fd24dbb5ba52adff32c0d17bbf7338cb78ab8f69ab0d48b68a9443995c4a5331d374f51c278be702de566d0e839a98457625c14ec623096ce3a7cfc5b8ebc90b8347b43d7590dd2fa5307b93edf9a1ab5884bdb09b7eb4492a63ab9c0f6d9c79b5e72b3f669659db7163fdb9a3ab3fee5bc352fb90b1f679274a1cc4121eecbeeddf3b55511441852d74e6c28d96aed49b5f454c777b19d36aabad36d358b367ea1740d33b8dfcf19be3fb792d125a5ebadb8e658563e67cec0eec775aa4c079eb8ebd7d3ab228b05e63d7ea66acbea752d6ce5d10aba221f9e2d5a4eb7a3fd92d997ba96cd472f52ce6ed11c4ce01f8fbf3b708fadeaaee3afeec8414f23352bec2ffff409be9f0eb6e8bca5b4d90ccad3d21d3abdadf4478fbfef91a5b13f6db90843866e74c7dd38b2f11576e324495aced995bf2d3b1ef4d53cdf2160dca9ea8143f87e0c0d051dc2a3c7df8fcd1bc3e5ebba871d21f006d9e74740fe7e7de22eb557a138dd235b5b76a935765abb5403e01d0b2daae855e30a04b7ac3e8719b4d5c6378d958b1c9c878f9f63fcc18be24a6b87a0bf396e27cc59d156b3664b88624dfc113f1f3f3f34992bb627da83affa54049dfcfc3ada77596dfaf59e09d75671c93da204f630bc8009c66d8ac22307879edaf81be0d48a08988b695bad465e1bb0d280b155cfb4d92b70e74c786b992dd2e7885d4c8856f0ca9a1a5e78cfcc60b7d743bddd86b554223af5bc36e328e3debdb2be33dd6dd7a2678e84990f6abaddcceb3d3aed22ab0ca89ba4aee94eba1a7f2591712b28843cb3a47ae0db8ad326ad9911d00d4af4df4c7bb0ae6dc7ada326724904950d476dbbd1ed2474d79cac3afd67e7fe01f3a91a9dad716adbfa72bfd6c8fa330957dabd8d6a1b39e77301a7e34da36973835614ea1396e1183ace718bceeb9dae769b8fbe4e28ded892c1c1ce9067edf92daae562fe4de642557125404d4f71dd54703f5e5209c2936c555c39133e3a18c3a3877e687cdfdf41ad2bdf9bca64c6d8ce694ca62153cdfbe6e496166ff96e79fffbe3ed1b9bb0dc1354dcc294c90ebb2553e5e5519d4c35d6a2653a06cc7a294c50be76a8e9836f04000d96598874d1fba2ca3e707b8f14fefb92acc37a23eee8e804d02f2784cd62771b5597decd43eac8a59a4ce0b827c58b5386515020168763b2178d537c2daf3d954f26704de19a7085894b445eea4be7724905febd2a1242e365c6621ac18fb942ea24e381d2757c7098ff942f106605498e231670543292629e51be1e83cc1045520504627dd11a5c33b5f4822d29288c8aeaabf528cc99900aae98642a82ffb7a4dcdc9d57a030896fec4bea670c6ff42bf1988415de5ba59684c33ccb052cb35c48208b6c8cdc194df870f4554598db51ef543bbf69167f430e715c6471bea25c45859c65b47112fe7018fe70f8fba7686ffa5eee8daa4aefe5defbd97bb9179efd3e3ddf1b457b77479f7e8ff6ee4ec630bcfbc04e3dee7f684d772a009fcde04f83150cad549566f8ba69752302beda375d910ffb644175d1a383bd87dfede1eb76edf6bd8b3efb83ccddafda81276e2bfb5060eee90c183f809d36f32fbb31a4bdbaf33fb9b9bdd3bc5d1aac3f95a5270576073e0a37705b166af7e1d51df88d7f762d7ccabb37b579db45dfeed474b2d31487d1af442cd0cbe000c46199e2df5201b56188823f3033edf65586c7526a4bab8630c6277e99346eaae8d7620c53615e612669dfdbaa86b648a6fb3523892f390cb55150ec3a76dc584c97bd0d278859dc0d33f4df0d83653bdf0e5313a5658addc2d6e3291d268bcad417a8391974dd4a0d836f5287f8eb6a4d239bbce0afae3d97ed5d877375a3654d2bb8cf755bd193daf362373183ef0efeefe30ab9286382c62a131b1cde8f1ffde33bd38cf5ff1a31fa29250b09f7203458f7ab7aa3918e8f780a1ac29aed98b9d5d21b7fa93766206bf8dda0bb2156cc15a0db982deed26c41fb89f55ce2d0fafa0d6f91102ca1fdb6ec3ba61d4ef4bbdf138bf07966d814b4bddadd6278825e51a13e9ffda2febf89798c7ceb17d45bfc8f61dd7aaf1d0708b163ac28861f4a41f483211c3a92c97ce59e237af56000efc3f02bebc04694cecd1bfa25cf533ffd2d74867b328fdecc29f46d5487555ccde9bf6fad38acd5a538c3541932da4185b6cad7d59b7b6fa0a4ca9ee18475a022831c8f1ad164072593ce0e372463b8b03c3b6f3712fd9e21dca9bfdf08f7ee8121b8f012b8d2239a817962c8473ed7e62e95cc2353eda9a9665450f2484c0714b8ad44b706eecdc07958567d5a569d0e6e1cdda09577e98644a54fd74d7b3d7c7feb15c05317a0cd03c615528b8b16bf72b22c524f28b49629b33a1336f23b1db876641859b51bc4084cb8725b4c9d606c957aa333624b3afc4d8b60eb0ad35f4d3337864d1d5071545ae722ad7391d098adf0c5b72b928e81e7356612b6604ae255a1b13d4ac22ec10b875b57a41878b32535a1cf02c09edb17b74fe98b4cd26c517c2017bae9115e0d57963c38b081616cb9710d1dcf57b06f90eba2217143c76ea832a57c0c174cc9d1a0d0377e86997e84b77c3d324b29ec862c57455c6c381c03a7d7a736a9e87ac9520a61516e13b39e404a7931ce2a8514b52cf345d034c4dc58c30e363b8a247eed406803a8f8639abe3f330886e4606cb4c078680a8ad6c710a694c33ed4782a43ebb5b160486af9b95a63d555f926432ee044e582d42fc8b7fd6dd0aa02c6d7b91a83bef0cd6314ba3852d94fec034dc2d2e46ab52cbbadbbfb2d43178ce33d19bbf3e3328266ab9beb4f873040c101b84b440fca537870f0f03bd873ff698215ed4d661ed2a9afd57e2d3d1c8d30bc003fb3dbb0b60b4f5fc0cccbdd98e9e5e20b9affc5dfbcc17072858c3da0fe826d76b6a2f8fd1aff4176a6af9372fee9d1669374ea6b7537ddfe7c1bce7661e90b7879b9132fbd4c7c41eb97ded6bfccc8247ef1c967da98a1d0cffdd74d74344ad26bb291af8a0bf3ff0efb3ee8519c46d9c671bcccf9e5f1f3afcc6c49e0c0b877a8e002604d845e1c68d048dff0124ea28f0fc68f6f266e9a2e2ae48e26de0a6b1e69627396b61f8cb6a9405bca0951cb7fbb128ad7712667ef27efdf9f4fbc3ac06594fe50ae8c66f0c8e5c66f005e0319465134c13d610158ac825cf0fd8723930530196e55236ed63171e07fa616e1c1672a51c39cd5b4f6e07c9baef0bd01fe998a32149e7d7c415a1dba7d6bde16ebe226d1bb367645c320d3d1fe22dbb18f2f970dbcde255687101c395959a671f3bd5ce563250897719a27ad12bdb56fe66e990b050e2198998f4e13e66b13f057d1d53a250abf96e189965eefe36743fc569121f0c5be0ea0cd86f5338d338319b1e47cf8f4c944d77c1a8cb7ab29e7eccf9ce251b8a3a43e1dfd993381dcf1c54be4c4912265fcf2b0c2305f8c45d3d5188852428e2156a2dc2adb1f7c16dd5d1321a99051cee592cd9d37f4f1eb9c7e23a93f1912cd7fa72c1bfb531c96cb6596a7091e81ebcd0751d44b5c7026a9fa0da998da34b485f6e08cc54674b8e20db7d5f2ac14e4bc87393c4643f2e20c4d02490525c9e6b3d8d329c0ddfc6de7814928e4fdace6bbb4d37a626cacd44fbdcecda8e9a97acd597728868b58f2bff6bcdd9eebeaea37683f07b8eed1be5fc74c4be9bec22bbfee0f2ac6b462d755bf9f3ddbffaf87fbffe7fce3f70f6fee4e3abf5fe14b24df2afd4ee05d03d13f1cbeda00f96f000000ffff03002e1c500321730000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["app.js"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffe45a4b6fdbb813bff753e88fe28f368525eb61c7968be6b0dd6211a0ddcbe6b057bee408952541621e45e0efbe7c488e6493964c59cea121d25414fd9be16f86c3e1d0d34fff7b677db2fec8325ad202e4d663e0788e6b7dbca7345f4da76b4261fdce41d9e68a8ffe9ae5bf8a787d4f2ddff53c9bfd33b3ee9e624a4931b16e53e4f041df6344d29260eb21c5a4b07edcde49d092a3c6f4fe0172bc297d82e57427620a930c4e37a06450d3efb75fbffdfdcf372e72faee9d03696a63128187844ec4435ec41b50fc920fe50342a42ce5439c4699fcdf1328d2385dcb070cd235295e2879a676790f70f6b4722ddbcb9f2dd72ad6107c7427a239fed567fb89c09f31b561f65c8f8dd974281bdaf8803f9f4fea5fd7f1e65713f99affb610ddc5fceaf319b1b64d3a5600d1f891b45869f555e4b4fa3847ad8e8aaa569f64acdd25653a0a998e42a6b32fd351c8740e65565d2f47ec103062e6fbe478be9a68ed60c1647386b56408d0cf759131f7b5d9ecd66495662969f17e38a4d6368953020a7b5d001c93947ea4593eb1de475164b9ec2f7179b33cd7fd3fd3751fe3f0b316cc28cd363a84284ed87259e545b68ef1eacf7f6f39cc5d01d232ca8a8df3234645566611757690250505fd9a2559c156dd970f1c56fc7c985824c5ad1752127bf157f5e1bb5f39f9e29a48252980090b085fac082425694dbd2039017425ffd8cfcc82058b1a36e2aaacde63c8dbe7f6c2954b87ebbe371a21d45e1ef7d9238b4cadae28430f65d37ed567e5749b9ae55919d3384b45a8601ea45f79ed9571045c31b76d731d9dea57337f0911108ee1e36b047c13d7d2819cc3bb24b6c2bba4a437f62e1f5e5f87a06581a6cbd45d3a979173e8761955606e07ce23e05a85ab307baacbcc115cce91b0f6cc0baf679e89cbe840cee132125be13252d21bbb4c409651405a1668ba4cdda5731939876e9751eddbed7df508b85661be0b9fec2f10b998c8e80000c44621460772167f11d8aa102324bd75885982190a5fe96f3a8b78d60617a17db7a71c64738d54eb08ac56c92a313b39c371019e490b131806deb55192a301394b9e23b055798e90f4c66e428225f68296059a9e527769931731876e675165faed4cfc08b856e1ea7475a2c7e0701ecc64528b3c9ff8c0c4637420e7f01889adf01829e98d3d06863ef2974d03b4b25dd9a3f31739831ec9aee214d83aa4e9a195cab2c3ff06a6204e264ebc59dbbb47d5614fe6f97ed779fac8b0ad838b2c6783527b43d2879b24be0135498a370764f58a7b73de64c85af26614f7ba41f69de12c215188558544a184c2c115c1410cdd67ba728f1bb04ff4ee85da0eafaf8d8cd138c904f3058178e0714807328631b42725a9442f63c8a15b27058f904d6f60b1225af236a458a14318c59975750ca9c448d15a4639fefea15ccdf2e781d5c3833ad580eaa1126bdf37acfa99fd795d7da77a0c81bc499307bc99384d0f9031fc468a55f98d5042e1371d85c9b0af0db5837746826c2a78d23411dfa85e54d530852bf80da43865d1b65494368f1b3640bcc9739b6f74f2eb4218c3a452a6ea44287e460905fb4c9f676171ca04754bde4cf8ef421883ff1dcd07fc0b25ceb0a48e94fa5563b5c6692f3095e9140b4e796fd410c208a131b2b905ebae287e2658d123cdf4d2de46dcad031252d0deebdceccecadfed1487c9f3899bce71a86a3aa6054bcc8eee78298f784b3287c8e89cd80d32c64a9062554748a1842aa96b9f9b7c0c8157136852bec321c1d1424c1c866cd337ba7cea01320a7b42ac823da944277b21401101357ba6852d142d4950a5b3244266b777dd20a3e4c442ac3227e64a741218cdc92224358166659ec8c7a42afd9205df988df8eb0619853f21567540164a74f287115880c5d6e14a1406a1af9912cb12c1c0bc5a0372d9bcbaae3aec71f74a93cd36c821477f77e18170e8d15f0372d9a3bf50a28baa73dc04ce423c9b0dbd09d4808c4199fe925028d149d9c09bb0c0832e365a8d3d4046e14b77492695e8e4eb0cb742285c78d1d05b210dc828c15f7b612494e8a46cf8ad4818b8be59b6db0d324ebea6bb30114aa8184be292da5ca5fca5572ded1c9702af32ed9892cdee2b68eafeba50ad792bebd4ca0362b5871c9aaeff4ee62f96d02861ea0172d99d4c28d19930c9616c2181949c7429d4cffcdcfa02ba2e7fde548ff784696510de7ea7cb9f9abaea9b4b03a9fbadae6a6aeaaa446d20758d5a067649888cbe6ad103e4a20511a9849e3a9eb00de5edb58a8166c4f096a207c8454b2152093d6f55e23634d035ea1720f2915156d203e4b24510a1849e3a99c00d65ae51b980081932d70d72d9f2875042c5dc13499293ab1c72d71b58e5e80619a5ca516f967dab1c071522debaeaf7812293991cbb04d696ef4f47dafe070000ffff010000ffff5a28829329340000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffe45a4b6fdb3a16def75768500cda5c58b21e562c396817d3b91804b8773693c56cf9387484da9240d1898bc0ff7d40524a249bb46cc94e80b9258c5614f59dc3ef7c7c1d76fadbdf3e39bf39ff280a51098e4ae729f202cf77be3e0a512ea6d32508dcbcf348b1be91ad7f14e52f9e2d1f8513fa41e0867e30731e9e3321804f9cfb9c78b2d11f1981bc02ea6c720adcf9f3fe4183561235138f1b2cf1a6e21957d3571353bc2af0748d2a017cfac7fd8fdffffd9fdfa5c9e9a74f1e16b94b81a1cd4a4cd443c9b335e2bff443b52104aa4a3f64392bf4bf9e11cfb37ca91f28ca97c05f046c855b3d225a3c2f7cc70dcaade33b7c89d1577fa28a17dedcb9cf807f66c2c5c5b6699be51508c7775a1f84713c697ebe17c43713fd5afe3a88fe3cbeb9bb20d6ae4dc70211913d4187954e5d4d4ea74e72d4a9a8a9ead469c6ba55daa667b0e9196c76eaa44dcf60b353a76dd6552f47e210955b27de272708cd445b1b2b26db3d6c2c63447e2e79b1c9a99badd1121679914387f7c3268db7ab2c07c4dd254734835c7c154539713e33c61c7fe27c065f1627f0fdbfdfdc1d601c7eebe04288626d4360d94a005f94bc586674f1cfffde4b98078ef28a157cedfd99115e540513de2b642510173f8a55c12bc1bf7d91b0aa7c993890d3ce0be929f85f26cebfea8f1f7e95f0cd1f6215728457409d6f0e43ab0a3a5de75002120bfd97bbbdc305a7c05d225d597ca65896bbeec095e3c25794ecb526847487c763f104bca3de052bc8a66ac7affe567616fcb667655165222b723555c4e5b60b6d1f1947c00d7ddbb5c7d1b9ba9a850926480923a4b7048543a46503b984ba34b6415ddadd0f5657886f6f53d489405b324d954d32ba0ffd926970da92a9ebec9269c0ad0ed7d3ecb99289094e62a2a23d0bd2db5930443236904b4846631b24a3ddfd60c94490b0083a11684ba6a9b24946f7a15f320d4e5b32759d5d320db8d561b90a9fad174c7c0a2ad42142980e9a626c2017d18bc2364d31cadd8f9e62123423e91bfd6db1a867ebe4a2bcef57cac16eaeb5d53a026b75b2de989d2b13e6233ad311069c46c1ed1099d8402e21138d6d908976f7836502514283a81381b6529a2a9b58741ffac5d2e0b4f552d7d925d3805b1dae4f57672a86a67134d3db62128410a2218ab1815c42311adba018edee072b06a72109937600da82a96b6c7ad13de8d74b0d633fa4d9a18dce8ac7cd1ae7285b4dbc6cbd745f1f4d873dbdcf0ffbced3479aed3cca8b9216cfb9bb867cf37d957d470d49863707649d34efc52c66b1523124b20c51f10920fb62b884c0a551169ba644e58441e087e1960d21d967ba96c77734b1bd30c7e1edf5a060b44e32513c074c471e876c20d70886f5a4a49d382918bae9cecbd11346bc39960e4d56b0449631c90a1bc255c46ccb6348175862e0ef12b3b59ee5e4fb4db59895db91d9c3b86fb61b8bb5af0da779ced1d3dbe83b5731806551438645b20c11cd0920d7d08d340ad8a41be584413747421c955b273d3586d6c6af41c21ce574d20e915ca8f6d2d85629842da42c7f025ec1b9818d882c2a266138e8e4d787708d904a8b91298310aa3f57990af699beccc092a42bf2933009074dc57d08d7e0ff95e603fe55372e30a4c233465418db83d31d60a6d019069cf1dea865a4124864c495116caa58b6056aa8d1617ae92e23fece432be0e2e4717e943ffb67af2bc5e1e6d94cef40a8ba3b4dfeeadc314019f369a2661092408cc990617002c8354682366b1809ba278691504ba1ded0e190621434040e49dfd114289bab8ee394021b74f97402c855d853660dece99ef4b29722c20035ecd5798e730964842510d5db596064108127805c83406dd640a076a2974016c33c8586c061691e1652a853bf30970bf320fefa41aec29f326be04f3bd1cb1f25688ee63b4fee2bf880a9afbd255667f5b1fb6a0bc8fbeeab9bacc31e776f34b918f131477f7f1ea074ecd1df02f2be477fe5441f5597b8099ca574361b7b136801b90665f64b42e5442f65236fc2a200fb341ec4573fc855f8b25d9269277af9bac0ad1049e7011b7b2b6401b90665f60b23e5442f65e36f45d2c80fc9d85b110bc83518b35f9828274c8cadb24ab8d2a5f2e5a45c9aed94b1976239d2ac6dd3cd04acebc3f9c452df24aa2d6f759eda7840acd790bb3315d05e84c27982a3b12b9905e47d5732e544ef864937db7925cae1ac4ba1d3c22f0fe90aba497f7eaf1f1f01d121d3db5fe9f2a7a1aefe9f4b23a93be196e5ffe7aaa6a1aedea88da4ae95cba03ea4e4766442c406f2ae0911ed849d3ab9611bcbdb5b1683cc60e02dc50920ef9a0ad13db1f3566fdc4652d7ce5f201612323609620179df248872c24e9ddec08d65ae95b9c084103236fd610179dff48772c2c4dc33ac56e75254af7a23b31cfd20d7a0e875b13c35cb719021a28492befc7d64d8c94c8ee4e3839bbb8b21edfe070000ffff03005a28829329340000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/css/bootstrap-theme.min.css"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffecbd5b93e3388e30fabebfc2531d1ddd3565bb24df2fd17966cfecc6ee44ececcb99878de8ae7342b6e4b4a665cb23c975e93cfeef1fef044150925d591ddf173193bb5d992408820048801009beffe31ffe65f0c7c1ff5d964ddd54c965f0713a8ec7f1e0c763d35c36efdf3f67cd4ed78df7e5e92d87fe7379f952e5cfc7663089e278c4fe331bfced53de3459351cfce5bc1f73a0ffcaf7d9b9ced2c1f59c66d5e0af7ff99b445a73ac7973bcee38bef7cda75dfdde74f17e5794bbf7a7a466a8deffd75ffefceffffdfffc3beff2fdbffc0ba374702eab5352e4bf65e37d5d7352a37134f8ff056ed51dfb8b211fe7e57b03cb5a1f9b53f17228cfcde8909cf2e2cba64ecef5a8ceaafcb01d9dea51937d6e4635831d25e9dfaf75b389a3e8fbede853b6fb356fe8dadbae4cbfbc9c92ea393f6fa25b5235f9bec886499da7d930cd9a242feae1217fde2797262fcffcd76b950d0f6ca48c4bc72c49f93fcf5579bd0c4f497e1e9e938fc33adb0be0fa7a6298bfbca4797d29922f1bc695fdafb7e49ae6e5709f9c3f26f5f05295cf5556d7c38facc3d240e6e7223f6723d160fb31e35425c588f1e1f9bcd92575c66b25a2cdb96c7efc79cf98529545fde1ad41712ecfd9f69871f9b281fd7cccd3343b7f1836d98955379903774b5e76c9fe573e8c73ba89068c119b848de123e3c4e65832025eca6bc33be53cdaedaa9f9bbc29b20f2fbbb2620c18edcaa6294f9bf8f27990b25fb3f4b61b324528cfcf525c9f2419cb28baa587b32cab9b2f45b6c91b36a8fded18ab42269ecd243b6d9544c68b657662e4b03f7f85247e773844db7d5994d5e6bb8861ad998e1400c58a49b6be3222ae1750ba9c7fbf157cd56cd95eca3ae7a2da5419630a1b6f90d91c53535e36a3f13c3b71dc2f6ad0a3f18497e4a767c50dc6a2fae3b390cba6627af2f68533f050949f36520837a9445aeb6236c25974f97c3b562fa353f91be3e6674e6f7e7ede70b9668c7c56b40d141b115f184ad353726dcadbbe644afceb2e655a960debe4747126cfa93c97f525d96743f3dbd6f28a5175db5dd908cfc3fc7cb936c3f2d24835670c61fa3de4d329a9b2e4458a213f1fd93c6c0406f3879957129325ef635ee7bb22d33d48942f6286b2f5e35c1fd8ac979aa920f8d41f08427e6ebe5cb29f64f187212862d3286b9c1226a553de7c78d12b4072b9640943bfcf36b2fd767fad6a46fca5cc19432bd5d9cf6c6a248cbaf403ecd614bea846697648ae45a31a6d36427687727fad47f9f9cc9605d1ce2f376ab2bd2469cac519dd04e80bd44db9eeddc068f6c76cff2b93b83be884ad017c1e1add3053f233c62f5b9cafa75d567d607429ae08a246f5253f8fa0c003d06c1d70a15f14c142e320f319abf74792f95cce873c2bd26d9bbeeb86774d0782024bbb2c18ed39110531d8508334db9755c2d7096a34424dc57098fe69e1f2a5b02e8b3c1dd479c1b4de4c85c1e46205339eb2a563305e4cc43f4bbe8e14d973764e291d3113ce9de47a5e7a2b6dc3d555afd06c9216c9a5ce36fa97adaae0f35ee14f87cdf1c5f6f7a75396e6c9e052b1b9f1f2473939eb6392b28ef990ff909f2e65d524e7062cc4a010acd6624a5f18ede70602700152f86e09b33a7c85608644766b05b0116e88347f3f1fabecf061931c9846be281dd8bc19fcf86690344df523af7d3b78f3f60db458416851adc005e2fff7a7377f4f9889de57f98501aa964353f9dd1b0fd91bbe060f85d1fec7953908be327cb75eaf99489f99696792fc95cd3dee686c928f659ede1aee4e18bb2cc437921ec64848f8d6b035859999507b5e774a3e8f3ee5697314de0de0e965789c0c8fd397b2ba1c993436d32d032b3fb15f6eb2026015c35248d5d2ecdade03403c661ecf2ea95c7f622ca81f3095d2bf1d018a9152188468d79c9fc67ba626cd709c56e5e57a7902655a9399111e510a771b17c92e2b089e730761dc3e1b201ac97809c9fcdee638f48a52a297344d0196db1f5f88f50baccd78e5035564e96db3cb9855cc864ae95e19bb75ad85f55f4cc673e0392797d191ad2b055f5b14f3abe75df26334143f6fa5170d9d8b37ff99b1658f7b5283ffceaed99ba1f97bf8af559e1443e0ba03a763c116476805e3f16cb29a2fe3d9542f32d3e9744b6a925cf9878e47619d14481b745564bfba0476adca6edac1f96e3a5bafd2dd162f48d27f965e325bb784a1d74de2747148e65e13b0862978ed5d37c7fcac5ce8ad2e9b331de32bfd408b43fa126c597e96c3d790a3f27060266833622606f99891581c906f7b62de28f3c1c6ac6ac49ca74bc91613e6f18fd9a6eeb43bb39dcf13ab017f0e1259c0a66379650c969ec3d398add3a7961ad1cadd036ddd656a0b5d08490e176ea6a7d9887b3957b654b171896a43923157b3b0e290dab22527f0d6ebcfce016ec3e45e816d3506e3493dc8d8de808d94bb44db8e6a725fd7c5847d5eeded9aa5689ab39d0ddb2848b9f2c57033e11e85fa5bed4944917121ecc209879b654cf475352acfc59717b30f4a76ac9ab9785b45d8c56c3062d3cb6614031f26daa2edcd765fe417b6a1da37667d30b4b08d9eb443c3e36c789c0f8f8be198158d59d998158e59e998158f8f8bf08c552ece2c8a90c4e3adb315617d0dc4be90f5a87f99ea5f66fa97b9fe65a17e199b6663d36e6c1a8e4dcbb1693a366d59d3b1e9726cfa1c9b4ec7a6d7b1e9766cfb1ddb8ec7b6e7b1ed7a6cfb1edbcec760fb1be28e5e3f990372131c1782184b61b05e3a342a8ef90635f6780458e431d9720d0e8d60d198e2961d39b44b73a6fd33a127424d94062d20f5711ca07ee6c9108890d083c500cb6d4c89704c4b73e153bfe4d40bde83c299a04d8802944e67bc544a06444656621c9c0e582a8207821d2fae2dbd29ee80528ef762ccc2201a08de8c0bee7a128b0868b9d27f2a159b781370a6f70c3f9e181ab9822c17acdddb17d901a099d37153bcf2a2378c4f7b663b60a048ed87c7c29616d941450ba425e37fab2a11d08475a24055ee33becd87b5b24455f3a8607ef802eb559102385df99604cc2459ccb64822cee7380ab7c4a954613405328997e96ea79ad7d7fd3eab8dd33059ef96b34c375795a879b4caa67bd53c3f1f4a5db13ccc7689e99ad7b80d17f3e96eb5540d3f25d599ade0ba2e4d568728d56d55a5db3c4916872851cdd3e4fc6cabb2d9643e9dead6b2ce6dbc3bc4abc98cb9f9cf9861c21e7b56dab0d13650087d58cd4f06aab9490065fbfd3296085db612b0f384fd9f4028f8eb43ac77f3f56e21b10146fb80ab6836e5aac2e034c7099724decfa283c4e6b2de87dd4fd6692407ab64e0c364cbd99e6948628182e8d2c5641fb3a18a0da0dc6f6ab7caac9f9135fe333673d9b2015c0c18fe05cec5b51896055c98236a55be160301c8ffcb7e2fc5efb69d02651bb822af9bd1f52c5683d410c867fe86af43b55d28f8fe5314486fab035613256a4773b118dac64f454e07e51da473eb0fc9058897dcd2d6d17306ded26698a62fb4e3ca2afde8b959a4e560880577b68ac4829b16a36359e5bf310c4931e0b88a3269c452a99dbb05172b7331934a16e355d3f3ec048029cc0ae6e8d579bdfd74648bb5882471967eaa92cb0d77efd21d731a6f302e3314bfa749938c582b06c87629ea1b830ab71eb3e242289cdc2c0de46a9c9f9917cb06509f80355933df1a4596af974b56ed997f7e03c11aedd072b51c0017485849640cb9c9d5c4680d80ba6fd10e2e9b2261eab43fe6450a62434cdf031525acf0e60100541fa14089b4a7a040995677f3e57c26e9d86e73b67a5dea5004ee99281fc30a1329fbe117f1b1f19728fad7e807b6901978b60f65da554314e3cbb528945977e7580ca79d9adc7ab3a42721108a23afc853768a8ce07801510886c212600e44e2805038c63d9004984d7258932da39aad239320e181b5a180102dc36a430141800671dd19083dfa0168a8cf203f48cb34867f720dbbbbc0e10c4c8f8e4f6b7fcdce4539fc6b794ef6e5f0cfe5992961520fdffcb9bc5679560dfe3bfbf4c67e7413b8cceac3a818cc9cb586af5fdaf22f27f359464534d687c961462cc47e44e3c6a8eed75bc02de3013807690443f9f9b9ce1ab660f21801fb078409c793f95bf191d25d8dccb21b8de7ee9a2b36726003320fc7783e318264f07aa342d845210b39135419ffbb238c38e73f446c68bfdf139c64831938c28b8898a2fb79144a88351f49196142c00713cc6be6a7b166f5bee2916b1ed2e65124c59029ff8c6c8cf6e8cb4682ddc65cf9939c7f7b543a5f995093e3fe880267458d7dd78617b56cf46c5faa7c2e6cbddf60bd9e900dd6cb408398ed38c916712c9bd88ad1a1b8e6e9ab8d765c959f1c0766145b5d55802309c90438fa5c8fd82e9fff569ff46fa754ff563cebdf18dcc4c04d0cdcc4c04d0cdcd4c04d0ddcd4c04d0ddcccc0cd0cdcccc0cd0cdcdcc0cd0ddcdcc0cd0ddcc2c02d0cdcc2c02d0cdcd2c02d0ddcd2c02d0ddccac0ad0cdccac0ad0cdcdac0ad0ddcdac0ad0d5c1c59464796d39165756461815080548058ac5c622b98d84a26b6a289272ffee111aeab205cda4fb75c8db13a61a56ee56a25676563b96ff96b3908780458204608760437506a43d2b634d673331e2fe4ff96a03652b5abe978aafe676bd7661db0652b55b65810e896aa72be22b02d7425a06eaeca661471335539a5689baaca09a0cd3080a24df381224db82c8c7f4ab6907fb22a565524132548a440484e0a90b58280ec14152b5541f254402c1504c95801b1d01098f6b9aa20592c20660a82e4b380982a8809a6dcb02c48b9e65c9070cdb70814d7472e0d39f75c61f09a58d60464c121220911100583584b005712ac7c25cb038260004b09109003035828004cf55c9607a4c000661220200406309500134cb366549066c5af20c98a5b5600f263241781b3f38792d020b103428a4483460e28291b05ba7620a19014c0ca0120a5a520970e24293605b97021fdb1ce1d0052900a72e64092125590530772e28f1489a065a4ae245a061a75c6a1ac3f08dd20ebe85857c63a2bd61db10e877529acd360dd0260f781591756db336fb2149b37d12c68de04fea079e37460f3c6a90c9a373e98a079e363c6e68d732468de38e382e68df3179b37cefda079e3430d99375617326fa62a6cde0c48d8bc6910cfbce98ab079d31061f3a6213cf3a62bc2e64d4384cd9b86f0cc9bae089b37c3979079d300c8bc8962d2bc999aa079331041f3a621b079d3e541f3a60182e64d0360f3a6cb83e64d0304cd9b06c0e64d9707cd9b6147c0bce97ad7bcb1d22ef30640bacc1b00ed326f163460de2c409779b3905de6cd4206cc9b05e8326f16b2cbbc59c88079b3005de60df0b7ddbc59406cde5ac31770736fb7ef76836eb7e076936db7d176a36cb7c276b30b36b360af2ab6a29e7d93a5d8be896641fb26f007ed1ba703db374e65d0bef1c104ed1b1f33b66f9c2341fbc61917b46f9cbfd8be71ee07ed1b1f6ac8beb1ba907d335561fb6640c2f64d8378f64d5784ed9b8608db370de1d9375d11b66f1a226cdf348467df7445d8be19be84ec9b0640f64d1493f6cdd404ed9b8108da370d81ed9b2e0fda370d10b46f1a00db375d1eb46f1a2068df3400b66fba3c68df0c3b02f64dd7bbf68d9576d93700d265df0068977db3a001fb6601baec9b85ecb26f163260df2c40977db3905df6cd4206ec9b05e8b26f80bfedf6cd02f6b06f20da0e63d6362a6de3ce36b26c63c7363a6ce3bf36c26b63b820440b22b032c08a0d9c2cc5064e340b1a38813f68e0381dd8c0712a83068e0f2668e0f898b181e31c091a38ceb8a081e3fcc5068e733f68e0f85043068ed5850c9ca90a1b38031236701ac43370ba226ce03444d8c06908cfc0e98ab081d3106103a7213c03a72bc206cef02564e034003270a2983470a62668e00c44d0c069086ce07479d0c06980a081d300d8c0e9f2a081d3004103a701b081d3e5410367d8113070bade3570acb4cbc001902e030740bb0c9c050d18380bd065e02c649781b39001036701ba0c9c85ec3270163260e02c40978103fc6d377016d033708df9580eae5cb47d78bf3547e288b1c002301067fa24d093b8cdf7d4544fe602d953c3af49a1227ec606159986a9df30f51bdad31cabf0010974f5a7292f816b21699a1223c05787e478d171bc098945654e78a7b16d0e79a54fb7815133fe8b4b8e5d70a2daad0ba26ced39edd973dabfe714dc51dc443728bc77e2bfb09ee4d640df53246fb8a97b88fbf29c8a9c1c848ec14a4fdb60a5a77724dab40d2d554968e5d4cc09738392be3e89a1a8e1d93a7f74b6ce1f1c81336dc149d48191bd02f5960a3787865a9dec3a32aa9b2abf00e236e7e62815eec7324ddf025a3b21c93bb86bfea33b1367c42d02f5a7e981ae26d18a935472d51db0b29ff74552d77ffc89afd21fecc989ba49d8b2b2958ebe388ded5e7f66e0d7d3f9a66f133b5886fa66f1a3b8337e34ca5b73c72af189bff4e21aab11b8c64a3e88cdabb13a1bc0a68a09b340d4286c440dc6e65927a206636b9138a9299645ea9e6c00ead803ca0109ea2682a208ce56fc87d20075398452015c0574005701250822f4ab801a0410ea724a11882a2d3ba2ca43e8eb0251e521a498abeeda04b5c1b97f1356871e602e4c5821101849f47ab75ccc298de0177c287570ca812e38e54011683cc7009e2389471452c2c7e55a50b8dcc5e3cb1c97bb7828c6a9bb504169dbfb51615177c10080b090210c45e84ade8f2324ac2e5d5142c65540ceb80a883a88d0af02020f20d4e594d8892a2d31a2ca43e8cb9fa8f210921640de610b6a8173af2dac083dc05c98b03a20308ae834d9c511b1e549c7f2da1ca510a806e803aa01ea10c2e6d50065a0b1a9624a15fc1a2d38bf0663f3f5c0afc1d848b32a6f1e06b500de460c2b41379403125601178a2478365d4ee636026e4204cbc55204c0255e9b2123bcff8f17e20e033881af2ece9992cfea4cbec857684ae58d135ec4cfa4b336fc8cb004dc2555205785c9c865b088065cdf9bf2ba3fde3cc29fc63a0a82ee560600a92d0c01e4efc408207f4bd6d65ddaa7bb3620b05ba36e2706da791bd4306fc87d1e0c07048923f7b0f7b6b4ecbcb7a5e5f1c3d4deddd24a03b67c712ee4ddc9697077f23e46dfd710f0f9be8680cd0f927a6f43c064707fd4b910d98bc97a776f91b44d5a9f80fb1b523dde3364b7214a4a1add6c723e7b4f4b5f33b35972ec97cf4827e1732faa81551fe309a4cc98e0ac44ee9d307095cede5c25eed2cff9cf4d66370b24128244cc519e0e7e6fbc252fe32b2510f372520ea9d4953af188cc2160d8a763d0bfacb7ed39300f39bf11eee6d3757a66c6fe43506e2a99ddcfa76bd1e4177eeb5c1570697d0825b2147dca3c5d7ee64dbfdc8cf59ba5f662653c5b28798d52b072e9de9bec4eaa369fb35d2fbf193f528984c3aaafaf1a2e400a28f6fb209e3cd0692837972dcd4fc9b3cad3d0f356a69b37d4bd94cadbf2ff87b752a3e5fc2d758135084b6404b36905cb0ae6fd1a8ce3793db4c8bdbaed6b207145a7d40e62db7cb75824876c6df42e7a8449437e2777a52be268328c97f3e1643a1d8e177771b015111a8ccc9ecb54719f1dcb22b5d9647822cd92e72e6dbe6c62d4883bd26246061a7a7d984cb87ddb8014c16e79c5cc3ecfa8f661a88d8e051db8534ce5b53897fc1323f3dfd9ba406c4eb20c0c53a7337411dd9f8057e44801a069d2b0f50f4e573ebfd590657659b4d191b762f912381ceb150fe7f8b39721614231b1d6477e8aae085f981469ad641f0361fc6c4f03ca187ad9e150726785ca3754b242257ca1ea4db7643666534d60b0860f645f712e128351bec30c7d67590b9837b2bc573d7a24f4485933f14510e1afc43241643fbeaa9edfb513f6cea394ca90a34e625c08af024c3c8acd705e8a065e81ea1796ebd654990f4ecc6b5f65da81acee508b845482608d662d05a011b7566acefbeb0f4fa0c357c0faa4d794e9149879eead8869eaa69343667eee652c904e168ddb5973f89762b3c469f02176da3c442a63a6282d9e75e96c0d48e7640f844f8272f8b8b47ba92d38450ef9083f6cce0b30f9c573887c8b48927f4cead121cb526e02fc9be56e3d3224eea5f299581ec2e0442f26e9a73897c0bd47b55ddc526ea070fda01b88edc696c8afc7e9515f6006639eca49ae4943b74293a8967ba74ead8d2ebcd67702d42c41640b33099c9c7b2eacc364d79f92f0dfdad56ca187f4f2645ac0877d3beec67fb758a7e96a79b73b07da22aaa5ba0b2762c414b53cbb3cdf928c0d7f500c72c4ea352153f521c0573d5341a89ea903aa67e11dd573411dd5f35a20d55369175dd816d593f0bf8fea91f490aa2733437e9dea1de6bbddfc41d5936d11d541d5533c24191bfeae15e488a77a50a65955b1fd9ca778aa98503b5503944ec33a2a07c11c8543d048dd549e4e08d9a26c12faf75136821a52d5641ed1af53b5ecb08a56abc7544db675680e2a9ae21fc9d4f0a7b300373c35d3b27480e4b127ff1b8f55402f17bbf1c0e7f4b64c75b79cf29f96abfe820eb509827bc71e41cbc0dee346e0c4212a07ab24486476ea831008eec9c50e6f99384d9c198bd9dcab5335b35dac780f4df18a4a231ae10c88bd29a076ba244d6d1b5a2f836a84baeae388cae3b2aa21c81a8a5747af1e72d26967564aba89592f832ddbb6a71ee755cc95184017812f3048b22651f831988e0c643406b43c60d23b267550287ea66d8280de3ab08579b176cdb9dfca81e313def62310d770e3187da3ce30371f916ff1c19878388c7dadf92b4d62132929121151a2b4f60b718160aafa64217e55a7246dc918967c836f17a25775aac3d0f202be8791cfab005a2195f651c280f05a7c04c6e2c11cd9f97842fb2d215841d75887580465ed8122a1d344dc57a9e028fbc8b4aa96b4eba8ef7831df1ef24224622d2ec7e44755f1d3027c8b406f69e1b7b504a123f5601de476dbdb2826ddf87eefb407e2334556814c1110972e33079bcb4b761e88379e18857c757d7e2eb2fe34663bfe83c84c52fe737b451a489d723a7065af4bbb7540430e496404836d7b55d981984061d1fbc202e86565177a1f85454f301de057b55d1d5092d3a0e3d61ec6fd7ad04bc8233380214ad2e7ace3f902ee99f346773c76808321f3d53e8d1d2c50b0ba08084317410ea9b21e8adf8bd2c97435ddcf10a5f17eb15ea7b757a4a165f26964eee453a53d269f821c92c80806f79e7c61199193cf47df3ef9c2f2a6279f8fbf636ab4498e9c7c5e0f5d93cfd50362f2f59d07680aea66f4b539de0e3db212d06d1164244292fc5600c00225ac8b8054741164952aeb3103fa51ba487673bc4ec5d96a3e4b6eaf4843cb2cd4c8dc59a84a7bcc4273bb85424630b8f72c0ccb889c853efaf6591896373d0b7dfc1d73a44d72e42cf47ae89a85ae1e046fef74cf03340b75b3f02c846f15d18a2daf92a0aef5b50d8d02ca56fc0d8421fe86ece1053d54be9bb4d562b6482244da62375dada2dbd777dd32d5041a779ef1a21e934cde1ff27060fef59e5b14f3c95985b0b64f294a84f46442683bf49c960739875cc45d1308c835781baa4385d1d4d16dc25307bdd645aba8fc54e17dd890575c001628435d0404a08b208754590f75ee45e97e97cca33ddec345ab49b4bebd220d2db34a237327962aed31b7cc552d0a19c1e0de932c2c2372aaf9e8db675b58def49cf3f177cc8e36c99193cfeba16bfeb97a10bc8ad63d0ff04e4e350bcf42f7d13b5ab5e5671cefa38fb8650490387b745902b7d5b2c4d9ea8aa23e018c1e44a687491663477bb798cce3c5edb508680ba04854287e220afb844fd4c5380293cfd5feb1938058e8c809c6dd11380908381036c1c8bb621a4159d1311384be336402e51ebcf2d7a9f068b2e956e1c956e4e75fd10eaffd2ca2ff588e463334bf395ce2053df44d10d2fa4acf5d815844105038f137501240b0fb151c262aeac0d4f731f2f66c4804bb3aa6134551eff9e293cf0f7c073f0ef08376e25ff90dbe787ee27fbdbcc6f13fb9df3941f4f5c945ffe8c14881f2730d517fae11e5f2d3c34398a98ffdfe2526fdddd83d450851bc03d8dcc302ce71f3ebee94371f2cac73592663320ed4c917fb41a5fbed3d49b317fd2d24a22e5fa84a711562c0199354dbf66a89759c9f5fc00d823d7f50eb52dbb3d352b974318776ef1de92aee66fa4f0129e1f8afa3132390b083f1742e5f8ddfb6d4ddfea41e9adb67ce93733ffc47f1e572ccf7e5b91efc67521cf83de0fa876d5ded37d7aaf8713c7ecfa1ebf7cf066c74d460a32a7bbe164935cecae6edfd4dfeafeff2ec907f7e3be05f7693e6c71fb2d32e63634d47dc43e032fee1edb03fc64fe5e16071f1bfee6ade34a075535db3bb09a83f3e7f6701fe3f03a0ea2d7606f8c3dbdbd8c0126aa06eb66dc90fd93de4e73f48d8fecabbfd18cbdb9dca927fba65aef859bc8bcaf42795df8fcbfa338679ae922ff53ee18745cc884649cdcc6a5eff8a1f957cf3cb247903012f055b787ca09d03945dab92008a92bd0376cacf24b2493c71e0f645794d09b84514bbdd9e3f6605d345027419addd6164e77dee3da129000f0ee033cf39e5c36511eafb74adf33d09e78e45ded62101a70ee091013624dcdc45d8241509b6f0c046d9e9c216410a78e900f3f30424d8ca013be4c589047379dd1c476c3a3d1362c9a23842a02450ece1cb6b923748714a429f1990cbe82a3bb1b59b049c3980bf95253fef4442ce7dc8f24a93e8ca852d7b24942b903a7f3e2784ba32405724fbf299844212a9929ae4f4c415c7b13c918c99c4580f6830571a4d1ec086e45126c4646760ae34f85eb460a0a3a420f93c9993e024a82b92eb2508e84a253f33b79f845ba13533f932dae7d53ec0a635d2c74b9690439a4608f0c0fc2d528e535740e2a1f3009fa6ae90b8c122c15c211d8a8454b4e90c2f62e9e5c81c2c72099dba22fac8930066a119315d50c05cac24f49282be5e4858575affa8f85baf24a02ba85d12849ca1658d66d62cc650249b66ae847625bdaccda61e18f3e26950574a978aedaa49385740fbe494550909e80a877b1c24d812915890d36ce60a246f984b43dad6195ad6f8b651b94804f43cf2a1e51684027665230e14ca2d14053c2180e59944127c4a805741b26704f4dfaf75931f485b3e9f7b739f045ba0b58c6d5a9af008f1ca27a0c334234781ed62f8ea3ffa98a719e119b206c83dcbf7cdb522a7d6c295e229b98cb89ad39c5e20c1a49c6f24e014992a5a8117ae2cb234a7c1908b764c02637165204e0b93702ef743feca62855cbeec32e2c19e4f4945ceb3c51a4989598936f86584d6bf16d0d8b38024982b9f4bc23c4f126e8a4656922bf9728696a12a48dfdc1f7a1b3876a61967dbc05d79657fe7077329b81596ffc7aa0c2f33cb35091e9c85abc8dbba094f92848dfdad5918784278d061e82972cac390aefcfe71cd6abecd0ec3cfd1aa7428c3b04884fb2acbcef5b1a439b7a4061876e1562b3cc41658ec459c5b80d7ae0893aa2a3f05f5631d13c041ed584f0868da435a4f09d090ebb59ef98b5fc8f95ccf119f79e87374b816e45e67bda0a0eb53120047b3f0f3be484e499b42c56853ff9c938c8ed19ebec812ca658dd18efe909356208e9051f99289801a093af740f74549ae99310a00a82fa1e1a12ff18a7da6d1a2352b29b2734a8620621407a892735a520183184501f6e5e99491063846a18053f27cce68c009b95692fa1da38880060e68788ce20255d67cca02546047a0bc5cc4c5093ab613c7d88f2ec4a7a0908851944081879407850ad4f4d17766c81678672a5ad82b3f541b1c4248290b19a308c28ecd788696241b4511761939db631445d8f3611dd8c01a92732898d01cafa75d1dd00e144950b021e540c1842353fae01a1ca38082000eacee310a2a08d800c16b1f32442e8a29484bd4613a62145e701a85c8477106a70d3d0c1472705a0487e3caf5b92877a4fc51e8e153959dc9a86c8cc20e4d52ff4a6dd26314705037792840578cbb2acf0efb849edf28e0c0eda2f45b286014734893fab82b6907354691874b72c9187373520c28fc20e2d2c148728ca210e2fb2e058622103c4644c2b972e24f3c5dc8106c8c4210d79a1eb8cbfde71d3d6497ef7549afd628a0c0c146bb2f23717d6b471b041456c04d027e528c020cba99bcb348c14fc3f0c13e6634694d53e5bb6b4386f062146cf01b057b43e23a8bcd6f460a6d8e1db90b5bd148401c0c97df7483ab058a3a18787a3d429187a27ca6bf06c48b18c74ac9286dbcc0a1d7e7c04783188527ced9a7d1a7fccc4f9b50c0d83dd997f42a80c31409195688519422e45ea02005c746f78aa27ba74b607aa1f004137b0010c525ea8cd68e25160b73c6be8c52f2ab27839e50d0c1512d717c5c8007bf2dc5385461d193d0730a3a240914ad601623cd1bee73d294bb721367120ef4b282e315d7a6c82ad20ca05005ff0e40a35c79aeff85b99935cd6414a46096286838508842c085d62214a068ca4f015ad10ad9240db928a2b0449d06e39e318a4a1cdb40d1fcbaee9a9cf19fa6004502c5612ffe893f801adbbbabf0188b1d29db35367b1c7a3e8a49586cef38ec22008b8d1c875d0660916f585ebe086f7514f8e411aff1a2f89cd78d3c5e166e833e7ff063086d1f12631471900d829f13e3f50acdbc4cbcb1960766df1a7fc465e069b6cfd36b491da3c8261117149bf659d3964425b267989cf71b2f9f03f914c0e376339d2e61ebe41f9f915914601a7812e2660e195399f1d0016494ba200200a7ec7c0d64bd1327e2d449b8dfc4678ccfbccc66c013a73961124ffb50f2224249124d7af1894a732dbeb3ea533c3a53a9cd09d1760799ce770c4a9da404227f418f84c8329511cf4fe1b647899b5ac100d17c87a0cf0ff264e02ecbc7e2095ca101fa195cc96a99f9d0811d8cd39caf7495ce8e189b2c27f214a77fa08e388f2c33a6bb989f8afc29a19366732e0dc47950b691e421abb239b69fb3f29259f34405d443133e09fa482c512335973ae4ba35d73ef90fa530eaf53517ab7eb02dc1dd990a9a1a5bed1cc1e55a495247e6e1700e6e7b94e9f3be046db62a401d00c047845bfae98b8c1c61ebe16f3af988daea5faaf2394f37fff63f7fe1557f135e54599dc67fcdf75559978766fccce72ac3f363761694fc74488a3a7bbba512b472d7ee09ad68e858aa0049820ba09a8866026ecd815f178c03a8379315089aaed277eb9a51ad0797f12c7205c8d9caffb04bf621ff9ca55b87287e1f53e501922984f4eabd5eb321d99507332db0105d2f03691987e373f271975423d1a74a36646fbb2828e7c157f48483b581c6f6beb19d38d47476e6d2ce7b13a23349dafc9788c89c4eb3957cd25ff556c9a3c52e7242315ac0436a72b367dae1f176ed38102765494f2490cccc201487e5a90ed4297aaf176bc13116785581c0e555cb527057836a84ab6529bc8142b5f2ea45f1b8a399be9fa327c0c41b611f62e1ba6100c59d8c7788d7b6d093f3c0fe4ab60255646a337deda2294bfe00965b3b47b5034c822907d9fd5eb0e0151050205842a07b72d039cfa86b8200abd9a2fd237cebe8ad2cb10fd5c802ecbcbe7d216f72401982f79350debd30e49d9daba788e5c6495302dc7b54e3f56c3bf2f9e0683576dd3d6844111f2949905381e909680006e82133b9aa74884861832f8323aee0994db540ac7925d1a89ec07b499e527d25c7bd0ba1fe6a465f1c25179e27b9c66050e7ead20a3c1c212dd0ca9f90a3e2b9038d48d6e7e289270ea200ddbf4792b93602ec0dc57b2e216a078658438a67e4dd48e761ae08447e8e7934cbb8362d38a281c2720b59a1a0790a3b134f48d6c82505894a9d871bf5338ff29e590fb41651101c5b3ba2b6d738daf104cda7781ec4ee987dcb802c7ef78ad7b2d861efa1c5dcbc8416281e9ee8b3b251ab51989436fbd3b2be0162fc95b4cfda4749e915ac0a89b68ddd2df6e6015c8f58a2bbe4e519259f674133f5a0a0e431e93cb36ffc89a705e10221df1a6455fcd39bdc78da1bd8e273e0a6ce2e4995341989d95bcddc1ac729118b9343c9689f158526e7fb7007c0a986d7667f4e9326510253176ceb0fa2c1939ff5b91fb0cdfcec5e90855e71785727d91b6620c0f2f39e5fadfbe34f0c6ae4a499eebab00c70e017523cbacc2609c67483effabac8c5f572887fe8d57a09e1db4138ab8508bfe9db3501e20330fe107a009203e97a2427405710caa7ac17a8a62df4224f2b7b5aa0438cead904b30cbe6664d30d84d58dd776a81b06c15dbefe2b4f01c20330bd54add720ba9e930ad01584eaa96a21dada3521c09e16e83b55ad8b65beaa11ca23ac587869f5ad1681b18713e4757a6f1bf472c823ae6d3727b48dfd9e4afd1e0856fa8f8374e5886fbfe20f9e4ff533ddd32f48f67c3a95a0d53eb6d6b53e7473d3be7cd669dafa9046bd5fd10a845f219651fb16bfc17de6dcd7ea60357256952bd80501f69a1dc0c825f7a17180a75ffcaf130fdcd28436307747a85af9eabd5f1e1616dceff83843b5c4287bb2a1076ca79cbc2021112feda736813dd383e1bc36fee167fbf112497c7431933b223f9293368a38e941c1892808f1f98082d5df70c83af57184acf3bfacbcd66c7f412fb810a43fa89e044fcec947efc122b4a9422755449ba7226fd9d9a9afce12ee29e98244db9ab9a2cb391a61febee7833c37741a15f8a8ef9c0df06add1edb0e16d0071f5a8f0550dfef594703f5857e08ff00849822f5f8598b45c7672c446bf69fd12b1ea2d1c278ca4fcf2f366a6ab463c43cbfda5d66a0a391a6a905e39ae43f84ac1a191dd5a04c78ceec98743d9f1378a5c7732644ac3d1a44a8372903fc025e960de47ff048c0e11aaa1448d4af73b4abe5217ccf6f6344a0185b50efd463159666f18b8d7c8168872bbd28d4c48acf95be07c524e7bba6aeb4e750d80841f7c1077b6a23fc7055780cad91b6b641e1a8502f687b4ec5f36943e3f7352b0481b52c08075f52c4f3f3011eb2e1b54df93e73ee771eb43b5f44925149cb252f0ab432b91576ac58761ae21d6b8dcec8ba0068705e311c915fe91dc20b9fb2e308ea26e13766a8d96aab00c9e2d567ffa35168b5b8752f0a8fae05df6209b86fe6f79ef08035c1d5f3f105a17d5ef49913df601178ed05e077182439e959cb913a92f724feb824679cf6d38151eebfeff10a22b086e26fb18f7ca99227ee085f1abc17398fbcd75a2713d31fed0b759e092456baee7384ea2428583fcd99419355957b8e3a303be3946b0f74f479f331af73fe79089dbe98830319f288c6dc6167d0dd231ff58de4c98ac97c3ed4ff3f8eed0b7a869c7a5f319af90ea529affba33712913656037f09ad408843860de07d5a7474141dd1c0bd7ae9698516fe41de924bd8b0414c1794da53f96abf671c7fc5740bdb3552d5e0e69e5565e40f7043739c553e36da0ee31e79c5fceaf8e67713af91266c53503db9da38b435a34371cdd370fd934f5ba82d5067fcf0ead67b9db5452dfe77a03a4206ffe64bedc5b90a834ef28869d5adfa001b0e11fbaa446a053effed1cdd86d775a65137391d5d7593f8e29cf576991140a9a61e0ebd382862601d398a1dcf8a0237cb266e32537193b6cfc1c210003be1a20566d49685632d9d4c7db29a3770900e7d00a99a2e98ffb0b1e5bb3eaa1738c92d74c17d0619da8dcd5a7fad005679e5d9cd157509abfb86c77d51073424ffb8b5533d18ab2c50f88685140014f0c4fb60127bbd5974ef2c62c093599fa9ac64e1784aa61f1b5ce40f4a0f4626b4a72a557410bc3b1d837b73ce7bf358932d6526fc345bae2165266c869d31a3377219f20f030a53ecbcf2ddae0852e461831da2440c7fd80535f0aed7389fddf411cc09e26db8c7178f97bd9aa115a21dd68b5382c75eba958aeb8d171304d341aa0ede9c87956a4628d5cc1db773a1057ed9209e53ef1e80b80163c9231c2a79e30a2c5800c6da8cb23af57ae1dd8fa0f7f189e9ad5ac71326ddfef3b0d5b97e4554608a2ebdf5bbfd057bc060e7397df2c61136d2818ffa3e4e7c2082b8512dd69a3e08c1071ef788080ec3d8260a40bdcaffc828c437f421c2aa3e99b7f24a2e9b1d1f967a53407dd62769a23fed8305de8f675924c7a41e1db22ce5ab962b40532c1dbd5bb7f1f114cb8f6ef5f04bf9f4f77687d849df220f1eae7c91bfd9eb7d21001071d7e28896e27024a4e50440671884de22d25d3e7e38593ba4f66b7278ad01c0e2836b7d826d56e3b9ef618ee744bbcf356c274c17bac6092d17f7cdfbc077fb711c91ff1dcec6583c7fdaa1a19f8680bd13f1a0be0ec8adf80f7e396cc97f706bb465b0dfceda01912743c33801f6e98eff74bc8615402738db8334e3badc01db3192b3fbd11ce4257868241c9d1b95ed84ea41e05d5f403bf440e0836904fac1f5a132740e409c9a7b482f9cab13fa0946f54139dca083566723493cf1d8891f6c3289e78e57ab60732f8a87018439bb6b5a0bc68393111d307dc4d8758e421004ce53769b7aa29b96bd57bfc9dd7b17765fdb575b06e83e7aad0d1d4d1f1ddf2baf22814efa2d2d9d8d1f1ee4c38b5070acf03dcd36a5b42f3702fd31d0f99955d4e4b3f1930932e5d18affe0a6b429d727b482808893344cf7b7724aed312e68c7dbe8c276bc0f6cc730683bfef048683bde0ad583c0bbce26742841c88e77c0f5a132348566b3d9837a41d9716272b4dbf10050c84e75e36fb5e3e239dd4073cf8e6300c28ec711ff691727b2e32d307dc4d861c7a57e6dc1703bed38d14d681546f1d8bbd6b7105a9be5ec91d9d2ed71742c43bd3d8efbdabeda82d5d7e3b8bfe9a3e37be5f5aebfc7f148e38707f9f072191c2bf438da94d2f738e423e0159b74fbea7ada99ef202b1df3264ebee0348ba13479e42bcfa62b784e0c863b11ccbb22f7726bbeff258a92e88d89d08b74175bebb73928f4b122c89e4bc2c624bebcd211571454d5692627302d071c95c5e78f0ad572a5730b9874db53686dbd8b6c81e3d96d290dd5c3eef71c80f66f3638843b572df0a89c4a314237c0da3b3d04aac234803b191e09a04e50d012b46ccb49e1f76ae7be2f49ba26d1f75e8806d4ebf17d6e2388b803c007166ea2947745565063818d5aaaa951394de5d06c12801e676bc92b17de597bd8a1594efd213a55d4405c006234d056d0e5ad68890b2e6d33108896bcd662fbe0f7dbf162a2cae47ad271f5d3c7159ece443d319f1e9bc30a77681afbd5af3893f9c56d8f87b2cce161e02aae8faa85857e7d070bdbbed7787883ecf3aabbd8d77a859421b6472568e3887d03ef4cbac232a0eda4a8506390bf0b82db8cb414cfec8efccefeb969f9edc5f60fe6b42eb9ff8e9c6c3c3eb3267644f24f31287050c1005faaec635e5e6bd0c0148146f27c8602404b945be48ec45b98fc0ad14be772452d4ef293b82b2a23a4f1243b0dc60bfe9f697602336939ffdeb988bf0c5dc43759919d1b0eddf90176499d09525c918f27f3eca488fef95865870f9a55b0a85f5e66c58d4b994b0d171836e28d1e74de5d6484938cf23d3e7d945d2168f99e279c5807881802aa0a6ceb9fabe48bc675a972369bbf50b11175efc581a3fa74ab42a18449bc4c77065d7dddefb39a84cbf6fb658ce0a86eddaa60b7f384fd9f46c79faba478bb9baf770b08447508ca43bdada2d9d4ca49bdff47058ee2fd2c3a2038aa4fb72ad4ed7eb24e23c3db34393f93f18f6c39db4ff72e18a944b026f8096c31d9c78cb1bb247dce0287586c227f98c79fdf5659fa592ef08a0066a0bb3884263bb12ef4c9e221563cd7340867490c2c3ca3e5b8db66b43c86a0219d7324c234710394a86e24fff55f0f2d41c958186279779d71e2a4dd728914de8033cebcaa7be9b161c417f77453b8c713ced6dfd90ebc6c2a908c65ea5fa7114512797e3e6655de844caa413738c643f0d7f818bf380820283e28840f75637d9b44d10d9eb4b6632076fe8006d3e40525bd546152f974dc2039a7d4e9398f53f2bcf0ca3f663913795749021dd70cbe97a10e8b2c2297669f8bc0304fc4c923f1dce239c98b40067aff70cca4ed02f9c32e1a7c6143c4c4d4eb224531184fea41c6263e5b94f9035edbf66a30247ed77e68ff1ce8cbf75689e1b16590763db18dcc6cb525d2b302253a04456713b0fd8ff7c94504a5c0b1fa2dfc3a91146cb9b37bbb407ceedef3f202ebe038730f92a1f92481e43f32de88d66805f27419aa5faedef94803f2ce49a53a3744f0d7c04e792d5cd1175775a73414e3197f9d35b4f67276a893749318af321a5d97ff8136e793f56e39cbe0771017cfe058c1b499c0f798cd3128e4a75ee816fc4703b63a2988b0e561b64b7604611c094dd572227d1400d749529727836319c9ea10a504550a0f4dd87eb23c702fc605eda4adc3ddc127e06693f9744a9026d1d094a58b3899ac106498b03fe945ebd7eccba14a4e593de04f95f047d6f88744b657aef24b56bf1c2a7e87c9d26d147a263289dc9a92ace577996e7ffa86b8c71ae30bce6502ef4051ab50efaf025d47e2bd77918227de7d484bbff86ceb652135af6f89041d2db918c5985ab7a2c18866cbf046e028bef3b8545f48c2248a81b1bdb6b47adb600d608d549474e032cbbb6aa23be37c61ea651ed499cdd3ec7948dc2798bf1d4ce6df0f81fdf1fe9e47df075a866b960807fafbad7f8fecff248a85fe89993993815a2513e5452021699924e75c3eaebfa1968001f381240f066c91cfcf6c4bb0bdbb853b977a6cdbdbf58b42f04f3dfbb61423117684403ae4875bff5378bfabf0ba234a1df22310fc5384bfab083ba3731d12f4dbff5380df5a8022983394ff30972efde239c6bf953ccae4420ee43fcebd22b1a315c5d473532a6baeeca7dcfd3ddb3738358eace327e6f834d6577a07d1c0627e926ff389cbb3ee75a308c3c8ebb3ce25250b33e2f144fce9cfcb6269838e68eb3f89709299e8864394f7a7b424321bde1361f228e8f5f245d7b76d8cd3bfb8853353747cc6a6bfaf7b1de918acf359918679e7866ba542c80834960938c6ee550e7081514510b3f25a998099c7fc7bbeb0aa875843716e1fbdaa08f5aeab5febc44a90b220d33a487ea09d184b9790742cac3fbd5c427713dbde284029bc1e90c587e890781a6d36116e702eb827f148a01184e11ee6a74610d24053ef7cf0098f87552c8809a0d104270202e822c79d18add341d34a444b7db989cd831bb80c6e447ce2fcd601a0c7c5c55b0799232a1d9604c7b06a232f2c2550db4ac51df2d1241241635f3e7a73e04671837b0d9f38124118ee71412904412ee97a8743c1f1a4c92e6ea3332c3117a08b9c3be4a66925c2eabedcd496c08d700737183e7954fb20d8e34293ed834c52d50e73c263994d97136a299458c21273ea3b68b9435e9a50e25b832f2fd77b27937ccc096fcfc952601c4af454153f2276f6d29484a2f3949bdcfae5900a6ccb387c8ce2f0911bd30e022982e5b60a7ee6d4159a5bc1dc3cada977422efd24ecd24fbcbec99cb9eac21afabc28db357953646df28de0778795ff9512a031778f50e5a12c1b90c50ab0a5e32b8c9bcb88c87b1e6214b12bb1bc7a0aef042302c45b465efc9c7dfec3371e1ab87df35006f6762d075e7b6985d3bfddeaf9ddfb0f7bf4d906f665b856ce776d5c0d6d6fcdcbb26a44226bf3d0f96b5465f5a53cd7e2089128098a55d4ba6ff60430b96f7578ed823bf09e82f1103e359c4b6e49e5284d93be06e15fd78fdb8eaf84bf07c55fd3cf9d633ffe4e3c6ee9e7ceb1bf12c5f7f5f3f2cd541cbed1f40d353cd4cddd8af71af47e453777abddefc2df7037772bddefc2df236101a9951de9761fdae0bb4cb855db5d97fede0cc6aac60b0b2a68fdfbaeca6d947f4d1f4e2bee1b7e6b521fefe39e11f75c87bf8aab6d56e38e11bf0aa9f7f4d17295ec2b35f96ba7e05774719f8e7d3da10f77719f867d738eb61887bbf4eb9b7394b20a81a5daa8b00d11bc435b15af06d0f912dcf0ba73a38f291bdedbc2dd71ba1d8e645516663106d42fcb841049ef8111f1d473197a108105e70cf8aafe7a21b0e05cabbeaabf5e080876f433960f2220d8f1687fbd1010ec78b43f1a81d67afdc1bf5b5b7b2c388fb52755eda1defab42715eda1defab427d5eceb38d9d29e54b2afe364afde808a7d1d2753c2e698778fdb19eb2ef20f70b61d81c79ac7fbeb85a083bce3d78e0f23e820ef9efe6804fe1b711dfd43cfe411f6b6b5f754efe1defab46fa7ed11d6b6b56fa7ed9edec8f61d72f4bc3aff938a8e8b9387d140dc5c45caa9ef6444a627fc9e2f85e59d8bcc5c70f321cdd72c7ab424acc26e320a0ee017b32e17d7c5a8be19119e2905d7a7e3c0b3c412d06433a09218018027c41c7b96acf363565f9c3d99d88def5ed610184dce05f26ea80313e0ccfd67d35ad1dec99c56948ff2c7456aee8990078b1c98bb58d472bbb31ded9d2c6a45f9288b5ca4f22a0679b0c702dcc59c961ba62d38efe44c18dfa36c71319a1b0ee4d11907e62ee6b45c746d477befcad386f2e1c5c741aaaf1090875520c85d0c6ab96edb8af54efeb4617c943d1ae7a7ac6046dcbe030b8fb06fe2b57fe935ea3e6e014d6336e53ff7df86ed385bd3012ac7351007eaff71651cf2edb12b3574e154b61f15f6088ec89f4164151070f5c9c965e09de19097f7e11b832d893982a9a1f8ab99e2dcb873be281a08e52c2fc93e6fbe6cc693ed212f984a6c92e2724c7e54e53f4da2b78a0e9df846fee11cf4323db4e75db19dcde9cee6acb3dd95e9cb59e72dd0294c3126ab49fc46c7d6f8b9e6bee7e592255572deebbb17a7324d8a114f388b2fa4a83a27718df564458a0bf0feac7c98778b1e03954765ec93dafac0197e1b741e753ef5bb056f310ac2c687246513530e20cd93a27c7e71ee35f3ecd832116e913419d3c6d164fefddbede854b7d6b7b6f5ef4d7b5d0ec6539539846715199dcadf1c70f337015b3a9065080e00d1109a43f9f92efe446dcc89429ce113c1e9c4bf90e33de6a8ef0889662a412fd1aeefad1c9b7f0994c22568f2b6ef39449e596a8d16407c0c310403a82df2cbc626e0f9ec29ef8803f37380f8f9dcbe3366463c85c9d71b8c5fcc9217bdc044f4fa6245689af1d7a57bac4aaa197a0eb3f568e577d99cffc0c7d2e385c9f563d542e2d4995ae0bbed130b044f497a2759354e3338713ed4d3326392804eaa5d2eba77e718f2018717ff81591da439a2b7d472e06e17039ee3ebdd183c6c2644ed5f538b88767ac74da29019c4fc36c288bfb3bfba29965b5e6a7426b9ac5a44362f9748c83510898ed0c426279a7932b56da605807407b57e946fcae9a0def15baf2780f642d3bd96f0e3a62c8b26079330d931595d9bcc79b71a5d28144fade7059f1afa6dfc960c204c0db73da69fa2c499776b1a7c0de1f99bd7706e4c8196ce459a1603eadfd484c0eafaa70656cf6303d42d98e13d51a97b61cc8caf6eb2b3498433fbadba72a6f4bced079e45872ea62624a9aaf213217a94e225723d5be264b8bccb2a26b9239801eaca5dcde7d1f76eae74b0684a12f483c2ce6163b8d483be048e8e0ebf0abfd09e6007e632e8833d90d845109471c97d5d53a879b027a737791880ea8fe296ee0e3dc01fee2fd23d6ec1174bb23bfd3aa6df616f65f03a73f79f4477a44ac03e5fa18ba0dc822ad1a7934b7911af4df87313900fbda1d8aed1ea7159b3ba2c17d06ebbc65a64740a38992dce9cef808a57bf5ec701d569c3fde63d80dcfca5d529290c333d4b215d705d1bbac6afeb7d7be0d6fb26c0a9c78e9af360c8cccde4ba401bf819dec0afe890c992ffb4b89d3bfe83c460968c81553bbb21b13188814add2e219ec642d187e8ef4d726848ad75fd87afb32f6e97e8ca4bec13a98872e164ea54f52eca1b474734627a598a636fe2eae1f82b3c488b6b4b1d8d15fe9ecac20069078428fa0db583375b2bdbada771bd891379fb1cedd72377cc8e1cb1336f62b4e0a33e1ca30333038372c4049a1062e472628afe15d7a23b8871862c31f49736b8f243afdc60a84e0516b86162801662d86a1f1596762771ced0797b2c6cfd70769bb42533513fd0e6031680e2a0b0011dc4a055c2953bba97c111ab18b7f13e614b644da58db775ca21f7f7c538410d7cddde6dfc3416d7ef1c13ece32382673aa9a04ab23b1006b9a39eee5c26e5256b647e5ec782f838d46d5faf9c3fbce097f2d715705a1d1ae38b3ec57607e290dbd326008eee45d96daa5e20d61327844068244d94721042a39183955e405b2f0a0e204370ea9dff2ed7cf6c3c144fe6dff709a2a3d76c7a6c329d4f03384fe8e2ad4fb86441efd45a82e1820c66e5cbcb8f6e9c6310bd0d55323f397e3be0cc7bdb231f56530e04cb8718ff308013a2acb24bc63fb4887f469f3577794ab13cddfcdbfffc8577f9371d1b1eff35df57655d1e9ab1e9be6e92aaf9331f48dd543ffdf0dd2a92fffb6138c8ce29a8886cc57fa8c67ffb72c97e8a294e032512a16513347d0de64b5e84f93fff4ae62bfc5820bf03f3a310f3577730df7cf4c2e5f2fb9709777b530c476d7a05bb702fea4555be2ef924a84ab4be9acae7e2cbe52820f6c7ec63554a77a21fa4d4387a69e24e84de90ceb7f41385778e8326d5f86a417cfd872e07a476ea2d183b39fda263796c55855fbef1869370e1c49a7c484e79f1655367557e68a502bfeaf8c32f9368bafea19517649be407c73aa5f93e69d84c20a4abb71b9c58e3279bd8c37cab43e1c8799eb282f6cc77d433570449fea35723b8918c01cf018b6dac8313ca463d62de2995e61f3e24075e2f097f5906a19ac12fc4534f604973262f1891768e4c3c400d65028612cc370265ad5f3ef084267d8bb976ecf96ff0c4850d1d6de1c3194ec24108ffaa9e82245a7c8d79816dc517f95ecf7e7c83652d38bdfbcefd29d0c3a937f7e7dedc77df53993afb152358013b31fbb3099853e019187a2603f9ddf8590d66940ff967b5180c6d81d87a0dedd32816c2946090d1a1b832f3eb01aa7205cef7751ac4ecf186636ea847c7b2ca7fe3cd18274581cc05a2c1db60141afe018fc75d7789a51896013099cc433f7af464cb9c86612885ea9c7c340df8efb618d2a0fe742ad5c75c04a34b5d507de60a039b72052e9ea03340f22f53a50f6b817a53a480e0975103e614fa7b72bd0c8b03e5b716150a280c508c1eca4008ba975081b85c1191320970dfe1b2cf578a898a5f9c291b36f98e8c41628154df99dd28688ff77260825970d8eb0ff9e952564dc29f29b7696aed1b0db0fe98a79913978095f5b1fce452056bf3b3fabafb023ef8eaa35162d916c8f912b689de478364eb0770f1eadefa50b739ad25c866dd0408df7af440b29303534874aae4f6277eaee7639e7de2606abd4eb38ff93e9386e53656631d7dae87e6f7fa647f3fa5f6f7e239c452ff69ffe562292c96c51fe4b8985414a09c6d4d15ac1bb10905111de1709a34dc6e9f150568183cfca0ecb019d57a1d3ba3aa4f3d470500bd51e1baf0a89860c0a8bc76dda392c722f0a8e2987b886058a7b4e7b000a0372c5c171e16d331302caf5df7b0627196000c00686afb0000a037005c171e40f10c07e0b50b0ec09b2d725a437d4513ed6e4d5518ebd31d183bb444a10472ea466924a41a879712bb245d2ab603e95871244ca049bbe85d584ffa447558010430d401aa75400dd41014635a077dfb5f000000ffff010000ffffb86fedbe52860100")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffccbdeb8fe438722ffadd7f456e0f06d33d93524bf9aa7c60ebda77af71bd80d75f8e3f1c60b6cf0153624adad6cba2b22a7bd2f9bf1f90e29b414955d3bb38db183b8bf16330181164f0adcf3fffe19f163f2ffedfa6e949dfa176f1b20ee3305e7cccfbbe3d7efe9ce1fe2c6861d2549f28fa4f4dfbad2bb2bc5faca2380e5651bc59fce76bd1f7b85b2efe5c272105fd7b91e09ae07471ad53dc2dfef2e7ff1c9812cab5e8f3eb99f2fbdcbf9ec96759c4e773d99c3f5788f4b8fbfcef7ffed3bffec7fff8575ae4e77ffaa7cf3fff6151375d85cae2371c26845051a3305afc37e3cd8b5bfcf7222bfab0683e4bece2e7cf795f95f74b53f7c1055545f9ed48504d0282bbe2720a2a12f4f8d607a4f80d0728fddb95f4c7388a7e3c05aff8fcb5e861eae3dca4dfee15eab2a23e460fd4f54552e22522458a9729ee515192e5a5c812d4f64553d39fd70e2f2f4d43b5946394d2ff9775cdb55d56a8a897357a59129c3030b95615eabeddd382b425fa763c974df2f581ae69d12c1354bf20b26cbb26eb3021cb9722c58d44167559d43860194e2f984a85ca009545561fcf88604a1d181deba6fff86bd2d47dd794e4cb27c9a26e6a7cca31b5ef317afc9a17698aeb2fcb1e576d897a6ce01ee87e46c9575a8d3a3d468be8818e28e98b17bc44c7bc79c1ddbdb9f6b450aaa3f3b9fbb52ffa127fb99f9b2ec55d706efabea98e717b5ba44ddfe3f4715e92be6bea6c30d7eb20c653143dd24b3da491fe5b898f458fca2279e4314f2c7ec3c715ae4edc22e1ee09578be851a1eeab2ee20f974b744a9ab2e98e3f4451f420152ab96b500b1ff7d18f0f723d2fc9b5d5529fb63f9e682502a19653db90829aead8e112d1fa7a954d39f54d7b0cc22dae28ef3baf7410ae684a51655c1bc7e8415e32669763d734fda73b55e0a56c5e8f83111e831309af8b71b5d844eded9177f7a06a7e0bcecd8d7a715167476a575cf734e9e44996266e3bac4a42d7be79244d8a975fcfe9b2edf092a0aa351a4fd5d40d69518297f2d749e92ac6d5e37cedfba65e16757bed974ddb53ff6897049738e997b439a10ea3fb6086a2ce7157f48c83fc83d730e29c94782f0529ce2516250c2cef9465d077a82697a6ab06cfe408daf4174c905ffb6f2dfee390fc65a9257598e0de4821d77355f45feea207406d8b5187ea041f87fca7e4da91a63bb64d51f7b8e385fd9a16049d4b9c7ed18b9589779e29c517742d7b9ee97864b6bb34c99504455de36e90c44d976e726a519a522b470f06bdebbe39f47b0fad36498e93afe7e666561aa545f3e5aef9866c92379bffc0a6be5667dc7d391e855698b001698b3ae0ca1e4337d7de44dfb9c0cce3b49c04a32ec941e5533b5f0a5ca6a7317f17193d6438199040c93e24040915a2042aebcb90e2a4e910ed27a0da303765d521b817c6a55d2169ca225d90a27cc19de8d0a2c5aa558609d75b5c2dc21ded4116e113ed474a9ce13a857c443638b3918b76e9f4b43df561ce28489ab2442dc147f1e3c409b4dd73fee9b2cfefaabc7fae705aa045db15757fff79689c244769f3ca5ae61f8aaa6dba1ed5bdd6116b895a6fcd9a748b3a5cf73aa0b9c1fc1e6889580fd1e374285619e0c88621b4a13cd0af79872f5f8ee8d2e3eece7de0f861f1f1c302f57df791523f2d3e7cfaa0472c2f9a91399c31fe5f7ffcf037f48248d2156d7ffcc0732e25f1870f0eb30f0fdad1b2a0fd5fd7a6c7ae33fc70381c4e2dca7070ee30fa1a14351d681cd14b53a48f9e0e27645c66e60b6812ee02aac8f6d1774b1a667cf929ad42b7e0b548fb9c8d7d349db6cb7cb5ccd7f7a66b735493e3faf45aa4cd2b39ae1f0341e3ca6aca99f2aed98cbd178d7158a39733eaccf144c8a45ff4e952fccab5f01d7087b1189dfbfa394c5087fb6598764d7b6d9fb534e1c97dd30690c33dc2129d7109e89c0e10c2f1d6a0b361c8606083d3459f2f9da41428254d538dcbe3e73bd07ff1e259ef66f57c1a094c7d1ccff8d27478c99dee3b7357436b3652daadc2ad3672466d9017595ed2be852bbfcbcee863b464ff3e0da3687d70f1e1df70f982e9486af11ff88a3f2ce5dfcb7fe90a542eb5a1bb36e8d8b537638416879bd57efb146fd6a29359afd727d093869e7fc97b757b90a2cba60f558672458a5e344f7b8801ce0febcd619f9e4f768744fbae071f252fd1910d00449638dd5dd0f634d28771bc185df77951f321f449a46ddbdb8206d7853007cb1274459d0dd517c8a0b95c08ee8fc1aabd5963cc88750ed6d8b62ad2b4c48fb0a8b2a0c3a46d6a4247fc619f5fab738d8af2b9a832edcf051a1212d4355782cb6138f31c163dae46282c97ec1e58ef7832bb29314da1b5e4e250e362d1cc820ea5c5951cd7ed6d204b9164b8daf81d07f49613d8804f4e79aa0dd01836cc1550592ec2155960447050d44173ed4f1364705e37a584a4e892125b3ad8463fd289c260d780ce48567444c1ffe6731296c46b18894a51ac565d8cf123245dd0d4e5b7bb9c07a13369ca6b8f4f5cb0564e306259ca3188b5314c7492e391617a734acaa23d7638e965ffc04538468f3c1ee2d032df2cf3ed32df2dc33c5e86f96a19e6eb65986f9661be5d86f9cedf62f91067134596c563de4788969bc70b362f5ce62bf1632d7e6cc48fadf8b1e33f42992d94f942993194394399359479f37811f25f2bf96b2d7f6de4afadfcb513bf429599162c7fcaecb468f95332a08587daf4d7a71dd17f1e0e076a04aa75a674668c305f4f78541cb737a5d0d0556de82a59692d8f815ae62ba0bef9daadb91e97b63f3e988f30ef098507ed74e9e3d823fdc6b161be758c99ef5cab6e0013e45bc018d2168e59c4fa03d7bd96b861b2315368a9eb0d4da52d62ada5aef63495d55f4f658b07b4d56cb5d478475377aa2d0da9946fcbd545577a16545d8fb0a4434f5369cc0bb49cfbf66634c095d3003762cef0b12a6adeb53dedf6ededd37d2840715b51391e5c57b69ef6db1f1f49d1639ec4168af87c3864b1b4c417be5a304432fa3727b1054d9dc6123831c1749aaf5387144ea66b86c5e59b4ee7491c505de994446b494372db156c9d8f138681c2031944be8cc621abf8293d9f3957724d124c88e0bb3a9c9f36f8810ca2953ddae375c2b317f5a51184a7cbe68c64d1946266dc6dd7e7fd13cff88ababaa833414bd1fe12a50f6410cdec08ed2e11e2d95354678a8437abed7a2d720f3433f3f912ef579b4778ce6c85b178ec4469a9469581337423bad0e739130a03a61b2b9c244ff10369283fc32d5a6d116348b508200ee7ede1bc1bb8698a7681fb68b3a6ae72ce8452016e9738d9449707d2505e86c9ea904667c690dbc0c5e0a74db24e067e86315c68ba5b25317a846c0238cc37c5b04af69f91ec528f9b4d7b5b440b6d882151e6e0e25a2e9b52f429b4638ea05ef95a2e1890fedf6bb968d86f958f43a3475816a40fae355b364ea580b4e51f693f44544751638e1e465b13582114e3146cdb9b91f9b92ceee0e0cd60ba55e3a180f53747ca271dad3d55e023ed97697a8707ae8fb477d67452d949b37223a0c3ddec23d6e1a66590375df15b53f7a85c505e6583fa23cd2706773b6ad6a4c4a81b92b57e8fd5c219d931804cc46559b4a420a7d7bce8315b49a2ea7fed50fbb08b37e58ea98c0f7d5d66c97ea7a84741d3155951a332e07b0c7cb935c7650b38dc30595a0cbd7151177d81ca82545a3439443f9eac95e56bdbe22e41043fb4c51a6e40e6960b6d08c4a2a4150c5791f27ee101baef2bb68bf65822d207495e94a9b636b4b8961e42a313eea6d3443a67be09a5a5b040aaf3e24310e1c18ca255631ffd78829d4f1f2e6afc8622c552844660e500e9a14e902b653ffd956d36fe358afe25fae9112a7cd0e117dc119d45d85ecb928775b38dc57ab3e38d9bdb443642cd2886bd22c7d92131b88add7a69425918888b47393a130302f1086730f128fba7b1aab1d5a4f19a0d1088c750de180b1d3152ad31163a44f320ea3b0be6473f691eea2ac8caf8e1c303a529dd72f50f77796ca0034e4ff398d85afb0baecb66f997a64649b3fc535393a64464f9e14fcdb52b70b7f80ffcfa416dba315e22481d57ed6db131fa1ada7ff1d6983cadb61b0cad681c2eabcb06e8884fe6ea015d41f97a4ee795e61996add716d3485fca2f6a82fb45b4a06b048b68a12d1386abed27ba406e2d050951e228dc9a7d6e1c1b92b1e6eee9ad5e9b2e1d16af8f7c09bb2c87441a8d781afd7b6219717bd95eb6bc7afa78264912abd254936d871786f1224d5cb166616e8fea166a3bccc47305d1364cac62a34748b391a4a32bd7748387ae227185ace936b20cdac1b7e3007b84b4d5a0a2c672dda8934b4d5ce1435c6609bc323c52bb431b6a8791899e2a8ba76f59ac77331c0e2b30c3e1c993215e451198238e872c8a105cca6b917eb7da865df32a9831b504b1f2550e644954d565702341bc64bf48257e55a9f85566e2d78d042b89e3bfaa54a49599f87523c15ae2f8af2a156965267edd48b09138feab4a455a99895f37126c258effaa52915666e2d78d043b89e3bfaa54a49599f87523c193c4f15f552ad2ca4cfcba91602f71fc57958ab43213bf6e2438481cff55a522adccc42faae74802c5cf2a953fcb4cfea458ae7652c99f552a7f9699fc49b15cf5a4923fab54fea4d8d5dd3d3c429d5b744eed6da66f891265d98ed56fc4b5dc8db8dabf1157833702a980a6aeb41981926225da5414fda852639e7a88c3ddf0bf278d1a71ea7e1daef9ff14f5c0894f5b95b6e769bb1dc0ee8913b77b80db4e1035e9b63c6d0309b7e1c43524db9a13579a6c5201906c420f90686ce014afeedcb6bafe0652cc49a0120748c421a02619e4c011ba3a1961cf09a04e19e2892340c532c44e206cd9b79c00aa9865dd7004a86786587384ae6c46902af34a2e34e7155ce82dd292494ebd9975d786330f9478a0786c4111d180f09882e4c1610098962079b01fd23d862079f034003c762079b0e3005beaed90eeb102c983cd00f01881e4c17a00983620792014e59599ebcb2b32d79632c0b019494da0074ec31202121b10d024021a1950d0361c7a30904f5b07b03700a0b538f2c9408266e348b911e1d88f03b606003424476e0c2468518e947b378e6939c032c1484d4d4b8c54349a5c8752e3417d18a4063a6a28a3062b6a38a2061c6a48a1060d6a58a0c57d2dac930a0a6fa482c21ba9c6c21ba9c6c21b958313a5835129799aeb53b4329ce8ba11adb3206ad2f9c31b551c27bafe41f5cb89d225a8f6799adbd26955391112cd17de483519de483519de48e5096fa49a0a6fa49a0a6fa4f28437524d8537524d85375279c21ba9a6c21ba926c21ba9c0f0462a5f7823d5547823d5547823151cde483511de483511de48058737524d8437524d843752c1e18d5413e18d54e3e18d54507823d5647823d5ecf046aad9e18d5413e18d5473c31ba9e68637524d843752cd0d6fa49a1bde483511de483537bc916a667823952fbc8d2e5fe8937b357d571374350557936c358d561365351556935d6d32abcd55ab148a6f550ac5b72a1d8b6f553a16dfa81c9c283d8c4ac9d35ca7a295e144d78f689d055193ce1fdfa8e238d17510aa5f4e943e41b5cfd35c37a055e54448345f7cabd2c9f856a593f1ad4a3df1ad4aa7e25b954ec5b72af5c4b72a9d8a6f553a15dfaad413dfaa742abe55e9447cab5230be55a92fbe55e9547cabd2a9f856a5707cabd289f856a513f1ad4ae1f856a513f1ad4a27e25b95c2f1ad4a27e25b958ec7b72a85e25b954ec6b72a9d1ddfaa74767cabd289f856a573e35b95ce8d6f553a11dfaa746e7cabd2b9f1ad4a27e25b95ce8d6f553a33be55e91be29bb6daaeaf59ab5569b5eeac5696d5dab15a1d56ebbf6a8557ade16a4bb4da0a6c9941016e48e5e2494f2cb3b100576663018ecac189d2c5a8943ccdf52a5a194e741d89d6591035e9fc018e2a8e135d0fa1fae544e91454fb3ccdf5035a554e8444f305b8329b0c70653619e0cacc13e0ca6c2ac095d954802b334f802bb3a90057665301aecc3c01aecca6025c994d04b83203035c99f9025c994d05b8329b0a70650607b8329b0870653611e0ca0c0e70653611e0ca6c22c095191ce0ca6c22c095d978802b3328c095d964802bb3d901aecc6607b8329b0870653637c095d9dc0057661301aecce606b8329b1be0ca6c22c095d9dc00576633035c9979035c2f37cb55fc18dd787ff43970c49871d138b8674838e8b9a747299ffbee595e207beee935292b891ec3b19264c6d4cd98ba19d5698ebdff808475f5a76f5acfb590344d811adcadfcc39919c142d41ee4c25f4ef845703b5e8a4e9c6ed36a9d34253d8bd14ee118d9a479598e969cce2c399d5fb2bcafc44ebb4a3552e3fdc26cafd3416d2dc43d45db317fb85c2e1c13244d9db23739740b2d5da2e36d3ad1f13b906d0a101d5f04d92aaf5cb737fb52e5dd3d61a374205150f514cdad9da2b9950378a62ecdad1ac0f3bb48afa4b81b8d88f74eaa1f0948df15ad26dcb1eef3c1ff3e3669fa4993751209dec13d5c0e9783288c1d39570cf89fe972940cb26527a91e8ceb2269ca5f931211f2f31f6977fe459d9c203dea8be4340cf4e9c9ed93381c263cabbc563567d3a70697254fcddfcb1b97a56ca3ca1e217ff8446fbd304579844d91dc722fb7dccb2df770e3c9ca1c921b40e1dc008acd2df772cbbddc462c0e7a8a5211bf27eb41e533500644556c1c05098cf7f41fe401fc7208e72c794224ae19882419e67e86b99f61ee6328d275a3728610893384480e43d7170092c330f7dfb5016da3296bca1d66c04c8caade040c14fa707eda6d1f967aa8b8f4820fe72b393ae95c314ebae4937bf8e41e3e39c88725ea66e47c9c74cec74937f9b836b7d34d3eb9ff2e14a878a18e29534f613480aacc180612743fdc8fb3344145e497ae385fc91122717d4024c930f733ccfd0c731f4391ae9b913384489c21447218baf607480ec3dc7f870db48ca6ac294798013331aa7a133048e8149de3287d58eaa1e20ed7e63867c913a070e50014c92df772cbbddc720f379eac1b957303289c1b40b1b9b97ee0526c6eb9ffe6216810a5a229279846191055b1711428f066fdb4daaa1570b944f0b47b620be043e9ea850cfffc3fde855bf3043ebf3827536efc4c3e7baf50a60e371769123d939e17ec8cf0003ca3ee04ce37e4cb148a0bcb40fdbd6fae49fe70047fe6b34b53e8c80b944ea27c000271558f83b8eb8c836471e908481697ce292ebdbb9762bc1596133431c58ba6a1ba92f4e580e58c9c4a736fcda9d4f9d69c4ac7ef96367db7b4464e31f3150bb36f903dd7ef4ebe45f4b766d4f45ca277aaf99da2a6ef1555cf28742cb71f660ba031196bb4ae006fcf089598beb7446b41e5183dd4e37c62494adcc5961746e9ed6cb9f3198947f8c47a01bb4c282e4dd35edfe603dc21148f5c180bb2e69d30ed2a9dbab9ca39697d3dded27f8fe17533f02eba29c4d67aa783bebaea3e72f8bd9f27d34aa057d59a2f4be8e94abe2a7f1cde1090ea136bd07f3d9cc6dfc0bc1425fe62dac52cb9cef017afdd1ec3ab60bf56d7b22fda127fe10f8ffe4a2fec7df13d64c9ca1cdee9d2eb34d4d24d9775fdbb3dedd55c7bfa5aa8594bee926c39ffa9bdbded51b5ed76fb08e99bab017f48d8ab42f11ad47aa76e331d77ed6d11afde51a8bb01635f3a2d2a94f1771adc5110782b538e8b804ba9d4c7e87ffaadd4e869fbe9f416ace0af3df9c5856055d0dffd5a84f1962c15738776fa1e4c4cd371b7d3b91d7fd8edd0051fa4df45ef51d292be83b01784385a2de3a7ed72b55e2fc3dda7d3f762645566783db72d5182f3a64cd56b32f421cd86be5dda7f3bc656263a90662dd593d129832b637e1eed896033bdc328a52faa7d598aa0a3a00b032a9e11ae1bbac55836af38059a037db74055533c676832f2f45b230ff0d2f6a44353d4e32fc63324b47df32ad3d9527b37630cbf154bbbc065287a3cabd3d02e43ea0f8ad10e4a7b4f46728cb4eb916c44b88a64190b16fc54490b28181a418fbe0e673deecc59b9816a2004434885e8b2588d26d23432c041a2b4b34ca2e2ac928156cb5f6c85fe227f09f553e5054af7bc4447047898e068d73241643f7e3e3c10394fafbce45f8cbf1cc17eb113f48a4527f324460b8c2ab48607a959230f823809bc5c3d5de486d25c38d0ae1d2927408239dc493031fd142e1108108c47899c01d0ffd00774680f482ad1a7acd75a98a7cfa9c49119e6dd7737b756488ef8a80be66df43974a758767102beb4076d0e23fe62264b2d3391ba3968a253b117f1ce907d1559e5c7a1f3b40595c810dfe2af67df1c5cf1cbcc27be6234889f23125c304e6908706f969b742b909897ca37ac7bf0c38152e4a39fb415aee8e8914f174fd03070bd538f82aeed611e4b00ded7a3f2f01d9845489f721aa62e4b932044e4ddbd41e37da38917fe6eb231da099c433602b5f3b3c10f136b2899fb050fcb03feef3dd41c91071ce50dcf02be7b6c4787f13fec0e69ba7f3afd8ebc96d483bbb3414480d2b4a94d9d9fac2af044ef86a25723caaf0d5683347c23c0753d49005c4fd234d75378c3f54ca8e17a4e0ecbf5f8b38b2656af9865e401ff8f713d501ed0f58697217f9feb5db6e7f3f69dae37e4b5a4f6ba1ed721a858ffbe9657238eebe936c55dd774aee3f164c0ed3845733a81355c4e87190e67a12d77e3ef74eac811671bd0ff186703a4015d6d7847f4f7b91abeeca3fdfe7dae36e43564f63a1ad71fa854ffd699471b8e9b095b1aa0e148d5dd1ada3f3407b4e7696a04be75e60474e024166c9ed6f4dfc8557f26079f04e973477036326beef10078f28ac25c87455a3aa49ac55033dcb3c95d5b3534b370c0d062efefa9056fd92657de5a4775053d232abf10c3b751e64b004c5b6099c626b4ce0baa9155d49c8128abd78367d45e0db5c7830e5dd7a4918f4be9cbc2a51bc9c911f7599ae76bae8fb70b78d717490e200b770d66e205329883d53dd8a24f346aaf51f423f24c0ac88cb37d80cf3b68d51ef4d32fa3ad419ac15e9f70a61f709bb0d631e6ae3aeb6ff3b95bcbef5d1377a69d32be5d09fd4a139b440eebe06c451448256ea29dc094cab72cd84f7e4a52a58873937fafbd0b5a8638d5216511f18cee87819f57d164d5a594f2451ee38d8c11d6ed6db1b5c608f10a1eb7f8b04c9da158626192a90517700d86fab4bbee72e22e18e0175cf76430b358f50d77dbd3a528d943ac659ba38f9cf0c7ddf6135441eeb5c6df4cd0807fb04ed7b6a636e7db286254922489915f339f4c520e2493347389346eb665d8b4b85eb06f3ca5cd2bed5db3acc4f365c467facf1213a5f4dfe33bca00fa945180697b913aed0302694a26308082557e4e9c600cb050ec5d6369ec07e2147b9785620f285de3cfa95305004c64092140547cc2792570d8ddf5aee916b008cf28cdf01ddeb2e4a96b3a32a705bee1630756b9abed3e4963838b6e5891a4194324e91ae269331c7f96a4abf57e9d6c2c49e3647738a40f9dcbef9461a4f10966c2b04611cadc5e0fe0485332810114acf273e20463808562ef1a6b76e3e3788085620f285de3cfa95305004c64092140547cc27925f81bdfdc7660354191cde1c7aecdd192f99ae1846fb34546bb747e2b40e3a25b582469561149baaa78da8c16304fd21d3a6fed7e2ac6fbed063dbea30c23ad50301316368a5076f7ba02479a92090ca060959f132718032c147bd758b35b21c7032c147b40e91a7f4e9d2a0060224b0801a2e213ce2bc1df0ae7b603ab158a6cfe56a87fab0876ece12a8955b4b8b62158e8b6657f6bc6607febeaa109335c7e5ab4fd6eb343f2a01e4fdc9dd7fb7df4f8fd458f34354a97a6579c9559bd96a6304d1441b5f5a7b271ca183f3bb3944c6f1216d7f12605995071b515aab1e5a451be7676c938b4292a7b3883b1bff5cc7261abe9883cfea6c3771ec65d74d8aab08a16575c342eba0d4592660091a46b88a7cd70e759922667b48de4f13d9e88a2fd2a3a3cbea30c23ad4a3013b6358a5016f73a01479a92090ca060959f132718032c147bd758b35b1bc7032c147b40e91a7f4e9d2a0060224b0801a2e213ce2bc1df04e7b603ab158a6cfe56687ef40e76ed611bc72a9cdf32d298e8f6e5299a49788aaea6216986f3cf1132bdac706c0fb4cfbbd536de3dbe9700238d8fb31276d5f92b637bed2f2ec6a9dfca751dadaacc9c36ced565a0783bf699dde87c0656bc5d456bcc397182bbcb42b20f5d9a6212ce62ef6f6d331dde6a6c2297bfb19545fdd59ae18d9f45b496d1f9b08cb259ca5f86a26982aaad570114761f7b2ce86d0bb196409ac3b1bf3527d1043677c1b5b2154f98d3dc8f919fc62af800d4c58b1b539a2dd1ecf6e28a4f0f7c7b370782321b0aa18ed90665f64cffba7f8fe37f9451402a9d3da94cf6ef3d18499904376eea81f58d58920f5b0fefe20c6df66b3739ac7d63f1a7bc292659fca271330f0be8e773c9f55c15fd1785352ecb60827db4e18bfd1ad1dc7b4729be8bbd9008ba7cc189ec2ac4822a0675a771f2c0352c6ac938660f8d95a825eaf364837389648a3654294974a8eb1cd8140724ddafa3033518b08b70bd1d3e2a7f1aa13dfe997f682ec1c627e77efaffcb6f6d5e244d4d16ff86ca4b59d419f9e944bae478edca8f61f899a2c9e74cc2825cc0820e67d71275216efa4f6fcff2fffc50e04b71fbb4a03bbba8fff813aece384d711ad01142ffadc53f7d5acee7f8da5c2e8a17fdeb4dd9fb5ecbdd7757fc6601c84bf68302fc6f09e074c59dbc643f7d7a84120bb801bfd9760237b267d8cffd20a11d018dae407a174391aa69e8d66d764435fb2e2a22381daef635e46663b20e7d23092ab156a300911e7705f96a7f54f2c35f57e8830e6ccb2b0140670384af5d0380229418b0aaa84166ab7865e092b2b9a6006e17c5060ed72fb86c5a0c409fa283016d719d14ce273419f0620033fae6948bc391557675254502e2ccba0cb77540e0da00e618753d88db9a0c7bd481b09d030b70d5f6df40f09301a6e70940d8de805d8ab20261a6aefb3c2851970166c1511c59501064aa9af22b08a81bcb711ac09f71149b8aee70d5bcc0c26d0ce06f4d430f4d8148d3260cd95c61114dbb34970b88320d428aac4680bbe228364d92341988b22cd22102fae0ca3447de54a06256a641e8cd5910665aa32f3cdc2c7b340868ec385a99d6489bd7ba6c501aa012d4f36a0bc241a869926beb059a5629ea73730371a65168440892a24b3c6a328dd3e11623b04a6bd33a1dbe7418b6e3da3410fbd0b9474f6bd34874d406c24c235d4a043adada34127d1da1cd9b1a835de8da34d14b535e2bec6b11eb1d04a66605d14f10fada8258d35affd5d16fbd8240d35067e4456e4c4bf5b0b236a6957a94816ada98163a3770b7b6312d446115ea60a869a5b62b6ad03736a6811254e10e8140d33897c6c3cfb4cab929c166b6310d52f4a88463ebc634089b36f2211280de5a46a1e8610a02814ddbb00385c3d15b086c5a68000f671241b869a901de79c5368d35a0ff76257d710163f9d6349a2f546e4d9315f439d9de5fc32708ed97d9b4e0052598f6fec14b9162606488a3ad69c9b648fa6b0736ad9d69c50ab501757358d33bcb3029d51b08344dd27b1ac4ceb4054e0b18669a80e4c85317d306ecd0358833b5ef1bafec4cad931eb7015dec79451dd8ce76a6d22ff4759531fc93a9fa51a8d97c68040461a67d5a742560cd9e4cf3103add8260a6792e4de795cfb410abfa18dc3414d3ec18dcb417fe1b3d980be14c8325397ee91a7ef01b821f40b8b715ee4d7bd1a91b1b498258d3606c6ae6079b661b46d07eb469bce6ab1f69daefbfae98d085133fdeb422ddaef5632d13261dc635c91b58734f5005fd43b8bd69c8e6ebc8706f6f5af18cea11f0c13421eabae6d5eb1f07d38603d8eb1d07d388031a1e211d4c0b0e50dfd0eb601a91757ebe41fac1346087e9d26770b996e05ce7b083d0a4421eb8d50a6f49892a34e650b135a9cf0a50d17164aaaec4081ab2c691a9b64b01468138321586bf61b6a006424d7d51685236609f195b0b007c27d45f75535b6d896a98add567a112d729b804115b6b2e1daad3065a3088ad5580a4a92a0c06e038360d54a1acc6307005f695a07fc7f11a047b3c3cb6d6053adcbf628f14a6bd48deb42d354202afedc4d6e2c085bd68e33771fc04c17dce13efa1e623eecc80392c030e39d4951f288fbd8490421132b65610ced7b2cc9b0e147b655af28cc1d61eaf2c1bd26a5d8a04f5a0e6acc5843ebf5667e2f18ed516c2fa9c63659a304775eaed83e395694006f6f4eef1ca341fc37a0436cdc6903e71ad358521124d848e781dfb33f9c45faffc79e06aacd7fe1cdeea9876cdcae60cdadf5a7a78ed700daecac6d6b2438fc85768921eafada638dce48180a619cf5d812f0982dbf7da34248d8bc3b805025b6b0e2922f9b98107a8b1b5f2d0a2167749598066b0961fd8bab4772539b65621d8fe2e0433edc4d6884076a69de827e85b700936de9886ba12b8e2a6f6b3335c6553efa4817b6b6b4181c282f3b7805ddf3ac301611b8f66f18c93e2ed0accc6768341fcda8ff796b101f3a0beef8af3b50797f0e2ed762293b734cb5c359bfc62d068d6e203beb5a88681a675c59eaeb7afde1e603cdc1f592b0f6593c1bb01f1ce34f2a58457db636b75823284370de29d69cf1abf06af454d4f9b4060d3901d3d4701aacb5ea640e0b242bc334de51b5eec4c2b11f4025bd35aa528aad6d3bcace5097cf301ad75098261ef78b2cdd2b4edb72005773d71fcb482d0de5a3dad21b8776f297edaf8d883e82d84f659c25aad483aba4e15249e58602d56b0330917b85bb1d72bae7d893b300c584b15741f006669ad51244dd576981058c97bd3821875dec0612d51309caf2fb21628fae6d523ab69337ac51eec14ad6509927ad73d636b55221f835aedeb7aee8bbe84bb656b3d82b0537c748bdfc3dab4d6004f9bf20cdad65a9318d0db2006b1a6c506ecce83354d36609f3c58d36449d37e6383ecc0b3e5115bab121dce0ad20f47e3fc794c3bb26308631b89f1c134d190c1bb9d181f4c2bf51da65fc2aa0b4feb3b985662f01427457a6da063147815514325a8c3fd1d3c97c29fc1566798f8a92f3684a76f1ac0ef29f04368740d76239e4b9027d3a81154f2423b5a28208c3b8878c843c6ee099b877d00999f1a944f0368800ad757cfab77ec441c3f09f71bdbc6b8d10368913cb8434f83f16f895198f65c78bcd39e2ea5a7e2e4bbd6ec8b88f4996bbad7224ff1d4d87e1362ec163efcdeb1966a3c4ab0fd641d05f43c883cbc9a15afec470dac879b46619ad07486c015404fc05a2a0fd997875933149fc13d511df2970f0dec224c0bdad375e275c458be72329ce2740fd4b9dae32fa69b523c97c533328ff049a3d1071ed873904989e99255d3e7e3e7ac9cc7ace94305d087265c11c491588032782e74c85514b3dad17f27ffd7d74caefcc8f6335afa08b0348a6c1cc1a55e094a07bec3c1330d57731dc9c4795f403645f248a701ec23c28fdfcf0caca1532fa30383ee3988473bdaaec98af4f8fffdcf3fd32b10ff49b3d1f383e15f8aa46b4873e9c38cb6555cf71f71cd44fde30595047f3ab90f853cd8a58f67b30aa64f7308526fa3582a19566feeb2018ab73e6d1805f06f267388d55c87b1db548b1a3db86cb722d38054e7b434d5655f8a1b4e4f8650d149be0344038feabd0f87e8a1f53cb6d23c1dd1b55d0c917119d6e8e58cba8095c9df7c52b75d384a1cca1f8ae67f708164283bc9d8fb4115624833599881bed3d2a83144d5a12f11816f3a6df6117ba88d97c6746033071c6304ee7393873ad3ae1f6f170307378ec32764c18186c69b1d96870ae0a7e89d525404b7b988eec1c3cb213f5b6f2981996cf2b3fd921198cba13f6b8f1e79b371fa5d3480d5e33dc2eafd8604b20b1dbf58ba56898e9d17ea27984b23814f9b896b177dd39467d499d4ad45d5382ecd74ed753fedfd7523b3e6407a0ac0ee993fdcecca2b05d2545d37fd47fd5b479fe87b4f1fb50fd50c09aa310dd7f73eddcde15be4da50fb7e922ec428f28d85f34f11b3f62f2511bd5bdfb416c5295915e4ea4173856767e8eea02d89a803800219045b1e8f07d8801936638a15328d17a733b2b5b29c91c352cd77320d2f4989e23ad5efd4f8c231aad36dc11747c18ee779e8786c281f6530218e7b35fbe2d3ccbddb2083329b6013af1c3eb178b16e546e607a35f570dce90dd84901e47db9fb5b2e218a010cd087949935ba19d603b65cc0e864ba085f2d50439b111ed1827379f8a2d0d247d0bb642ff9d9783592dfb2510f95aa8b6527f59947fae7630e5bf5e2a9176e473b803aab1ee37cbce1930e0c033563762383c967468f27cc07b4748b97c6c6cbc5e9a0e8f284e94d66ff751ae98dfca2a892dd1adefd1c3561dc9e54a7cc1383f7b5bf3faa806cc7d43d126fdec1cbeeef4c057e077b7129c6ace60d53ef34143dee5b5c0aacbef1c7be49a877102c2128d137baf5c6268182b3d80e3c12dca20ef518e4ecf46626c51894d03e454e808682135c96429c1f1f136c589fab5f9bfd35453de206e3176cc91796e159bf954b5f27feb29c07164f16abcfeb51a9c57757981823b33a56a911056a5c7e4de8d5ba9fff983465603c333d756159e361be320cc8252749fa9aae52a0359b3699b3ebe53affa543d5ff660fc28f43a85da8beffbedfaef108efc1e87f0f559801042b32f5911c8f5c5e94fef720d92ca890cdf7451e8f1893689fa26666b155a67fcd483d376088e45027dc8d54e3457effaf3c7904f7607c1a7c7b257421a1cf4979e4f2a266ba9a4fb6714ff0a86704ed53d4cc2cd3ae0638cfb93713acaed5e8e059d40238ba01dd19673885be358f29d78cdc77db83676842c4d81fa1a7df3d8b950ed7fbd41bf1a35b4f62ad7cbbdd022fddbbfb2ff40b92635b89a2feb4b5b81ae029a4ba4ff50fd3dae42965769f0c6d734483be5f310a5223181edde974297a8c3891e63f006f3fd91aacf2a1e014429b6b4e80ad21b98b566eae4df426d7ff26f9f091fae80466745ee00ed701c3ea75958d747290a70964d663e0e9a302b59c048c1aaa446fb0936155b0d39bcea31b45cf0959c598108dcc94c6f427588ac5054b3e60b0ad1a77046e925b1c3c7b37308ead8200db071056ece18034be3902d2dc9d9529a32c27117cca647dc10510ddf6bee514c0bba552a397bb35b1b12755d6499507dd987c2e8b91991ddf751e70cf680a29c799ec34c7f0a5179157d847fefd960df91f30c68295b6a96f9c0d70a8668963070b4ef3a4d08f0540fbf7357a59f01dfaa5fe8726884ce21f3f832a2a9aae75c682e5aed14bf01d0fd108633c17557657aba6d23b821e9de527dab85b69038d344d158c7a9236df367d51faa8803e23e1ad2c641c57d6005f3b3fe00c708ce319f66082adb5478bc82a6db0c1dd542dc6784135eed684efb772433aa99a455d9ae15d231fc277c66d699a9ecc2eddeb77fc63154a66f6432e1f69cb4526c763e4cba2cc675adf413da3bb3b3435ad2d1a3ec440c64defc107794861e4c3555ee9eea32b6dde6cca1da59e66a1d53915674cebc90d78960f617b9917a77f49d172a8f7e8f0198976c29501fbe8589bfb0757da6c2fec91d14196b6284bab673209aaaeb6ed04e297b2b85b67644d8014690927eb357289466f013fe7ab4500faa457f2156ead8aa4894c5bd5cadd34f2f51616052ae6bd7dc1dfa30b785bcb879b30d0e015f2eeed3ddfdf216839817631a74d4cb687b77702dfbb03f80754126cf43d3a07fc48de33fba345b5fdeca781e1c37f77c4cbac617ba8bd17eb9dfa8eccbf861377c0585afb5ee436526d96db60b592e5c163a1c93381426f5c141aa526f38893a05aff29cf0c8aad1e3672e482af37547231020d6ec7978214747bc83a7db155abd2fc88c6d650275c45e86c050546c311f6d576bb14ff85b1fa829e1487245d53d2d7378f7d734d72a726ecd95801fee6eb812c0d493568dfa7d52a12b94734ec522507c30bff30dc9243752f2e6b50d65aaad01f77106de0cf95aeb05335e519248ced8952f1177646799c95de842a92718c79e4d542dd27f6fce823bc758f8a1a77cf22efe08d4b45092ee5b548fdf467bb547f5e2997356dd7bec5cae7dbdbd103b8ff37486d5eebe1b1cdb49a5cf1605761ac933cac594dbbbec6cdec5ba287eb4aa057a86e90f98a38972cce812b19d7d1b43813454d8bc8bf540b2ac3c392373dae6ef9b7c122d6a2236571a6afa2f08115ada8707df6fef722de8e6f07b340c07fd33861b2d5c2a84af3afb54c2af559799e6cc18ce9d2050c8dd184dddda62305e6874edc4838a886f9827064376e1c0f62b78243a8f1f6edcdb2c51eba8465c414f086c758a43d999e244677aa4aee716b83bc08c55b92e00beeba8155d017a5e9be68b3fb4531d674b299d394b92d843c5407aa81a8c5c5e3537b5bf08f512b225f1de41d38b543acdd9b139688214f5692c9e5a7cdd341974c2e9bd98331e9374337e41e06a4f152778e68c21198554702b64f1256fde5146ae15caf118ebce5ad9e1e9e5cac2cddfa4bbc3bba9c95cdea21c6b1ce3aa56a2233068fd46fb46e8ebb906a0e7c015a41269c6a0338d5c6acb7f839f4176af51c88ea332a4065d26a000ca8183f0e61bf358c04d18d47b7233cb9229dccddd399636278aaa66380d3c3d3e3e7e5e8e0fa3bb2e27ae0dfab176ae1151aff82bda660be6548fdb4bd83378e4cc6916f53dfe5c9b72161ae5a5f3387a1b6c1631e11b197615416f3abfcefa9058d1dcdd2e2cab7cc4775456de28e6eac91fb6c09a06d7d5026786b5febe0cde1adc9244724b8609cd25ecb34a04c1e067a8fe9e0e33896a98a79bd08cdeece0e955eb91aad11bc50321355aa5cda60f685004d8837758e56576c4687bb1956dfbd0c620ca717e3451a1d9da7204e84cbd27693fd7d8d06e69f07d2f3ecc3ad938ba539f96e44cf176f9c6c46e4a263f339f8495f638c54c4123cd41a8b339e366498e721dadc89ef44015b99973dfd270cc313f113fd67e7b6a60c1cbbdd6ec781d64806c6180beceb33fd373a0cf416c9343b4334eebce82dd8899a48985999f5fa7d35a1ece49aea72166a86800a3b630774c20f98846ac77e391337474a0d6dc8c94ecdbd479b7ce664fab9d850f6679890d59848bafa9be6af4d32ddecfbfdde9b5dad3979002c9cbda95933736a2723263073cc38758e82f997769e723ad403c5f827469a773fdec560ba8e2379bf5b370097215bf2f2fd59df5b3fc5e0bbf4229e425427b0fc3d99df5d498dc5db3a216f5df5ef698e39a5fa72a340afd76b892eea17dc11e83ba0abd5ca0ae5d19efeb3b3c2a15c9cd0f2022d4dc298e9bd72c8ed6d5e7a1c1f934b04ddb76027aa2161dfa72646d45dce42cd105061a7a59c72022be22e67e2e648a9a10d39379bcdfbb409c671a071581926649d88e3d3fcff0f7bcfd6db3acedc5f517711ec06c7f647cb711cdbd860811628fad0b7efa140b70fb225c7fa2a5baea49c93ac91ff5e0c352487575d9c73800fd80d70d62287732339bc0d87c1711c3c5d7cc5ad71dc04708ce373067f5e9464e49df480e9538d1de338b42ef624c67110b7731c7790f15961633f76907df3a15551ce7cd20c4639c40cf91174d746a0eca7192c370d697326e38b8e954f21e81672740d127335b9a5f06821098a61e6d22b2b9d71841aa53de3806e3cdb555992eeabd7d34e380240b48f68beb4363ee068c30ab368574d1b264f4c5270f3879fcd2952d44f8c6e771a305f8adc8aadf9b73f184bd84f6223ae0d77210cd37ebfd750609bd2d473495ef2333f7975efb822627d37909f9145cc2595c2674b65e4c234574fa82f493884d6d6bac8e671cf76faceeb0fbb0f7180d64f492c39b5ab16c92490c925a4d8d8b677780823cbe4416d8b598aa5799c83c0a6652826854d55f57d3d19a8b873b0a73b0bb85e8fef731b81ef3b107cd2784e5ca940ca99e19285160a64bba4d28af26c759f87cc1d1c128a16aa098989a6af3d2528cda92da296e512440770482301124f41a7fa7c161e1ed70ff5409102bd0f45d6c2522a1a70bfdd342698066ae8bcfa69e3f2776747bea33f8debc388bb4882a4bf4f4f868bdb960eeb93a543cf555c1b554085f569a00a994f81cc41d7abbefa34507dccabbc96ae7295700f8ee6dcc0f249472c917b9cccaa48d648fb9bab293448835b7bc4cf68868c6d2825ca06331d4a9ff4699132fc8e5c5b7876cede1a2551fbc985228e0a12f852655ff3f2b5260564122904aa976594b199d849ba243443193b2d8353e934572ee3d41e89eb55252b691667a768f608ff2cb213e949abe59d16037ae5bb882fa3226b371cbae303ec923ae3ace8553e8b97d90999feef63951dfe47a88a2669d65b72604db15a6d5cca1c7842a41bfe468fd4078908c7b31d333ee1ca8e08c47ea163f1ca27b11a90430423cbb3ac7fa9927781eb52e5a7a47abffaefbd68702e9a7a966f2b219eafd29d4457bfeef759ed84cbf6fbd5dc807391d5b3bc649749bc4c043a78aed201b4de2dd7bb470ae42248d27dd49ed8c342d513beffe7803bccf70fec60c0b968ea593eb2fb789d32a9db3439bf38f73fb2d5c37eb1d7c15c44b51c1fcdf431decf938fd92e495f32b7b556a7e4d41f1186e468650dad9645403ad00375e3e0ebec0ebb601b0f5b103e41d387063e59e282f97b34cf0ef668705f78ab05a4e647c287261880923617f52fbe4699a064c6076210ee32cd9bec24a6e52d527a034ee4249877d567defe8d4e79834e15a56b3cbe6cfac7eb6957361509c6b2b0afd3f0a4966a7e3e6655de38a8f26be7125d749c4fc8d7ec38bf6a0828a8e928643a759bed2d66ec837a5a4b4c57bd65e80246aac855ed87f28b7cb84dda3e1d1725e7d4e53d67690a66609b071a7515f987340f830212e6071bfa5e063a8b3c329d675b8b4a2f2bf008fe98f1a719cf49ae46767df6f5e0db2372ee50385b53af291a7d61831f2de1eb224511cde23aca923a9be6e769f9da6cc3d94424b86b3f519f91b87caf1a31755b2661d7135548f65695c27b2c4dc12e26da8f31a64a3008e57a6972d504c0ad5e4c7ff8e94452645523737dfb73963ac91ea2ad55c41a1d1f84e4d0f0d8d6e84f2d500bdb86c1356c34823c5f26f8e3d5f28f94205f649f045a4bc904bc0676ca6b3e15bdea4d77e1868a66fc75569fed0575a0275d3c37ad8c40879307c7b8d6ce3f84ce44e27ab77a90fb6adc1a6a78a2a33cbe8015a828b54ce287a5098a9f74ff367b843f01189ca4188cad0e0fbb64e7600c90b8b95ac5ed1c85c075b284d3100757ed4cc6e02a4d9e0e2c75708578dc8cede3d581ed4cd04ede3aa63b066bd943bc5c2c1cacb568dc9ca58ff3247e3220fd8cfd2e8cd6ff66ef872a396575044f95c0236b709038ad9b2abf64f5f5509527cab76cd0708b33621f4de9cc85bb4c1fbf7f47dc3381f16ac63241eb1ec71e2ba430769c0a74b9c45bef22dd6f7b432afe412364f98cbee24cdcee8191da9c85d2a92617d39c8575cc26710a15106f4a5cf105cfeeb8e51e48c790c8058b668feda0b8f5e610d5b48d308d74651181daab268218e825a9a6f2419d87659abd4c1cf70996f751bcbc9b90f1c7fa5eb23b4f497fcecac0617c6b8f66b59cff3371ccdb1fef99f08faa259c45189524ea2439e7ede3fa1b970988e23a6a7510e5e7437ece9b6c3bb8046930dc72752edbc3edcb85e0af76f67ddb9951851d5b201df56796feabf27e68e5e1a428b0a3d4517f0e047f55e10fadc2ceddb98e1ab4cbff5581dfbb02f966cea4fddf7457a6efd6c4f8cf12ae08e99051fb3fbaec9df3152d4fa60783148461feb4dcfd23db3766689c360f3ce6a01be3411c8bb83b0ce63eb76ff3c186d055bf6ec44c189e2e80a0008599c27ea279f4671cf67d904dc7abb122a07b51e2a6a0b145e958c37784b434961d3045deda7dc9bbc36471a05583ef1cb4eb6cdbc4a90e5f0d9db06de01c9650c1ec2e42620f563b5674c37cd1b76b39613846b5b78dc56216dcd8adccc84c904d114bc19e95554a6e9899e9834e58c1bfece0e00927c9135f868fbac8fe2c8f152f675ea575b03ca21c97c55beeaaef85f9b0d8e5a18606331b2ee4e1945e0fc8e6077648ac162d1711fae69c774d92f443e0871bad4f81c0d70265be76e0e39767bd5b3d2e3fbc6850b5932e802e760498750ce566e9c1f452437d5ae8f9e241dfb8f42e44921ea51d9a00a0f1d505a5bdcae1999a4abc323c85d843ed4e82b9412e06d48f6051ab1fd49e855b2c0ef45d5cef5a23e987c00f37bea21081574b225fd390579e34d9cd437ca2be275d005dec0ca837c1ab566fa84f0b3d2e09f41d6eef0223e955de0b36bed25a325e2561b6a61cbf2c0f8b55ec32852d16d4f4a423bf839701f52518d5ea0b35e9555820c8c7d231dbd3a214c809a5e61b315b808bd8d90a5312c7fda7c964e923e4211354c7c676bb633f3776ec99bea7ed054286db65959ceea3731e64086dd94b01e40e650c4a604de963ff943eb668abbb4ae462075e58d35b3d966bf2a6c842f5cbe8b9c3933c6670a1794edc340e65d964954b2d1da7307a2c237aeeee5a95c4c15589d2d53369ae46eb630e10cb8c88633774a2705cbb70a1a1cbb7a8e7da6ec36e6b151afd22f19327cb40442a6bff13142e1ae71742b7af0a50cb4aa3fc8da989f635adb2fa529e6b70146a53bcd5ca7329292f260ae42877bdb1622c84cf0d68494fa9e867d4a49fc1f86d7468ce730396702ca6211cdf4267a0ec473fa64fd57180ce40d98f3f48c7475ff3ffdc265e24b7cb720399814af91c7e6f203350f0e38fd1af9fcc40c18f3f46bf944cc8b21b6dbb0f6f0ac62e75d547cf5183ab8d15e5a50915c9ee6d95439cdf42432b0573c37168fab33a9ec610897bdae130abe3690c91f8f803b4aad1b87eb7965c24b7ca319ac4106d7c06a3a3490c11b79fc155309f4a6288b8c7efafd1637f532d9bb0da22f8622c55ac1cc2e7d5bbe0d5fb06ea3638944d869690b2e1a12e2d3e6db332bf8a4d4044e645d44e529e9beab9a7191a89408183026ea2d70b810287567513bd5e081cea4847d2eb85c0a18ef4567da6c3f499deaa4f0d8168f5e2c0bf4bbbbd0cceb8f20ed58ca4d6a7bc433123a9f529efd0443a8e5a9ff20e4da4376a321da4c9f4464dd2f2a2858a58ba1d8aa58dfbb919a1d930024b35e3e9f542d0c1def156f98e5e7a4ef68eb7ca7734b7563fbae817895f3b7dc887ca5b4d6f34b53ee5c3bc8d516da87c98b7e38db275d5a3c28780f6910a96703ba3a9d9a4d829779d93c98d799c832ec86b6ae8eae7c2f24547262fb8d99062d3de23ad1316b1cb8882221b2aad738aab63c4332352c84db985eb43180570d3c6680402164fa36c80675de060685de330ab2fce9e4aece671a86a1c1831608280d39dc874188f6686fba605d10e544e10e558fde848d11349801a8e451acc2015056e7786d10e545110e55815e948c19748c0198e3d0a60907202374c0338076ac68f6fac5a748ce86b23400dd7190d66907202175dc36807ea278872ac8a74a4e8ef839086b30a0519a4a0c075db20d681fa09611cab1e81f35b561457f20e2c7561dfccd7f6a557d67de9950ecbd902fe1c738cc075d11ebe351da0ad5c1177a8ffbfd7b2113328c12e7100695368f9b9283f2d940b4efc7079b3a540b8fa24e1d6161884d9e097f789cb7a283087373414bc9a09fe504207e2852bde38cb4bb2cf9bf7cd2cde1ef2a2c9aa4d525c8ec9af98fe5bccee910ff400c30fcdd14b5208c75d51c4966e624b76ffb17b6d9af28ca20bed3013936a49f0ec256a8ebc35945c2e595225e7bdb87b712ad3a49842c059f3420ae6c9db2300af66b210d0427e4ddf37edc3bc5b791983bf0ac4a32930f924283679c7dba04bc5a1efa9df2d798b9133363b246916a100699e14e5cb5520e1ee5b101dbb7d1eb0489aec573699c6cbbbfbedf45407f3836529fef6d68945329a2d3072084415999eca3f3570f9ed802d35c8d2074780dc104243f979907e584839cca719e8081a11fb420e79390baf1be11d215e0c03f43a2ef2f4743724f197482a3541f1bd6144bc7e888bcb5bb436fc104d37441f0ce1b6c82f1beca4109fc06abc53100dfc00af237bcc83e329cc9f19b3f0f35e72150686b9ed0bbbb78ae56759286495b098f11c66d0b5f2e76c097f5b3248ce1f65ac1fd52c5a9c22520b59174f630544bd242d4f56815300f3d1db6e6582eb98b4495ce512aafae38e50fd00cfffa1511d78cd6ddd4b6a103b5bea24220805f665461e36e3c671694d1498a39c58713b51c0bd3657193e887f513fb5422cf052a3d6c9dbac47a6e272f1805c117472b3633b3bda127d4d833dcd032408d4e251be0563ee77fcd6eb98f05e08bed72dfcac29cba2c949274c767559bc3619e9690b665c28e44fade745dec857d7431140e6b3876d8fee879c68fd6eed065f53f8a6d482224d17a49582f69802b56f6a5260bcfe2980f1796c823a8099de1385df9ba91ff3343febc1ce6246a7c8605b9533b327664acfdb7ee4597465f61523495595df1c556f84789138acc8fa22a30d6acf3bb9c43ee3cfeaeba4444f6eadf992dd892ecebfa7c468b62c880785d9d65ec370534f68f10aed2078137ede7abc04e465d091149cd8c17c829670f0436541aa5f571a358ed549cfa52d414e0cbe9df498a028b200ab939c781dd326d8bb3158c4f4f5a7839cb349509a9f40c25b6fde26d187c8a5bcc032c0d13709fb74363457369adb02625d568fc4baccf5c11af038ec86b939614ee6ec09287f7af07326a0226cb85dbc07901ebfb43a258554a63552001195ebbbc62ff2edf140cfb787002ddf9ca8890a791251b8c908fa682ce01fcc05fc937bcb6475581d5642cd6899481d653bf833aa01db620441cb24af7241824cae914909f13ce31d78627c6f9243e36cb5fafce1b6f14527695c7999db4c22533a1c8cb828e5e6a79f6411682302b1db2ccde756c715e2d8165e2dcb48aad66261d524a23050de0923c8bfe436fa4914995fde0ce6d800e6784841418eb7fee7993e0e1089910ce4682a0010938636e81015d07443096e461c92033db03b4a6b6c00339ac82d86feb54daefc183ab645d532cc0a974af4f0e2101bd751fedaee644e131dca9b952d1ece0ed5368731e9d0319fa880247b2b9bf0e1101a03ae0c20cfc72dd2303e66fba42a5f6b57d878958713720b626b06a811b683dd99859f67fcfa9d3604dbf8c47047b6af668f5a90dd0834b2edc877138718bc13774e1b9f571b416c1c78dbd74a8787176cbcf0ba821956c784c1e8bdc28b6d00627b008156ca821500e8ae386ebbf23962d1717c086600e0660a27083e695a6179f920158423c80c387ce7bf6bea87f64fea6479d767139d1e13b05e8b4c39e372c5097dbcb7196f55d03bb416404f381bd3ba292fbf52f4cbfb88ddfb321963f3fb089477df231e5653465ce51313ffc48393a2acb24b06b181f8ffa66f42bb10522c4f37fff65fff0124ff2ef68667ff99efabb22e0fcd4c92af9ba46afe1504a99beab75f7e7e62ed7fbf4ca2ec9c920c4c67bf4ca27fc7c27f7fbf64bfcd5d9a268d08769dd4a6e96728bfd5af5fffcb1b958ff8272e9cdf57f9cca7fca701ca97875e667a7bfe25b7bbad2e66eedaf4daec32a9e38baa609726be4cc3becacc97e2fd72e410fb63f6b582c7a2b2434f48dec23ca60926116241badcba1eb3182c879b553957fbb85df45620fe6f1063a7a6715f95ef86e3201b93271be48253248831422cf50ec9292fde377556e5872017e6ab8ebffc11b3c5fa176f196806ce32092d939fd37c9f3465553b6a17c71aceac9c27cbbd87250e418fe6e479c1eec432d713f9ce1e749c2cd98f5ee1e328623ea0744e54acf63a80d173b399aed7eb8b6bf3429da989553147e33d5916d34dc658f487e3a92762d2d8bd5b22313992fb01284a4c44f1c61b2128e5cb0756a5718dcf9762620fbfb01e79bb94d527b722f8745f0b3848e13f75a6d032cd4f63aeb42ccc9bfb3dfb613776b7ad18600526b7f6fd05e9fbf4b916d0eb74beb4fabefe9eca425baf081db5b62e96ebb398dd99d56314548d4c6c4e439d7e80af46965487fc0d8dc14425f0a5d7443d8da220648a09323d14af796a03623a82c3ba4e80c835de640603f5f45856f99f50ac88da0468e617091e824134708007bbb1bb44714cd308182f37158f1e3dab34ada01f0a519d93afb200fc56c99407fcd432f130d78011a93aa8f0b93281653a82f3b7e92450fb25b384b316c9974908444f4625989668afc98519e60ee5a44d99edc34ab01b468fc6e0a8e85e958a40761539ebc4a37d4cf6e9d5a544d417f4abcdae6c8e1fb3d640e239b3501ebeecd5fd5e0e0d304b9cbdfe253f5dcaaa49ce0d42000e04809f34ff98a7ea6531b0af34b33e96df6426e78ae6e6673cddbd92035fe11ac5cd36470e266cc3fec6a2646b6fe09ad6dd1ed0eced5e585eb7643c8cd303e81690b29d1c0ef99b1a10b957c9c7efe0d7f335cfbe010ab4d769f635df67ed76d6c70c659dbed513f9bb3ea9dfa754fd2e5e3c9ca9037879feb27a5cf1037851f6adf66a9c772a1720cff8682a6fdeb42abf514447023969527fb97d5614a4a0c381a01d71711c9652add7734daafad453aafae497aa3ef595aa3e51a9ead360a95ab70853aaf97cbdd6c43aa53dc53aa57eb14e695fb14e2915eb940e166bce7d09eeafae961a16a078f10b50bcf415a078a102142f7d05b07a4bdbad697b353adae0968a18ebd3008c1dad04519ed20128650d61e1e2c55b58eaf152e5e7c60786245a184f9170d5ebb056ed3bb2fd0d8003d336e02aed690628022a2628f4c7ff030000ffff0300b86fedbe52860100")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/css/bootstrap.min.css"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ff8cbb65501cd1b336beb00b2cee6e8bbbbb0677b7e0eeee9640586491e0eeeeee4182bb05d790e02e014270c83fbf7bfff5be55f77e79fbd49939fdf4d34f77cdd4d4cc7c3852ea00c0be2a0000ff6f8000ff3104c07f1b1c20110ef00f53d100fc0f83fbffcfa9fad5ecff3306003002e4012a000380064001a0089006a803d400da00c83fcf02e00cb0fd371d00ae003b80d73f2e06400b60f36fedf30fb50078fe43fc017aff10cf7f510780db3f1e04c005600770fe1b5c00917f9aff51fa6fefffa2f6ff98de00abffe2fbfe9f0c7680c0bfa308c0e59fb2d33fcdff706cffa1ffa96f09e0feb7e2fbaf2908e0f9e709fdab2df8ffdc39e47ff50d004869cbffaf2b0504f89602f8d4017cba00362d9c99939871f3b5f713f6ca1e4a9ffca975853d5939d2798d7336f131d09c1d16be2f5e474d666322e6c98ea9c8a9b6928f86e01de831da2dbd2722e7efacd8d7db4f37c77309334bb435cc0f024506bf4de0cd2a4c3e755c39d00d19c95893466451fbedd5f4cf0526b3226f651e4f594a2e233830c3dd1d2cb370a361c02e05430aa7580faa5b864857a4361535566d15cb678a1441773f23e758669ae056fcf04b78cad9ba01276d3596584b96fbc8539fe18cd9db6cab38e3898176b5cd6cb74171f01a5c190cb3fd40b1e8aaf67ad72089fe5289b5a663d435cf9c795d949e96ca667066c7230b236b6d95aeddb055eb1e571d9edfcb1e4ce5a558a83df93ae4d1f51866be8e5420ef1b8f478550cc08cd33d65e1e57d2f9e5b5c8635d4defe973a6f6fafdc56423684fc5a3237689b77161800fe13d7e874d911ba684c0a64426fc34a1f9908bf9242c95bfe2944c03d9ddb8c4c33689c71e4f544292786948975e7399b9b36dcf443f97cea18d15db0ee913951685d48205073721ce353755004f3266a3b76ac4200a862c21ca73a0ec06f77c21de54ee95fb60a52cd6198279e71fee06a621912da989edd05bfb780d5ce6c9b9fa20761398a3ec971e16c507664695d1b19b24b103622fc47d3d2f1c52fd79b5f45de1b4b13f85383836cb9fd4dd883f8131cb8cf062b2b677ae5734f853b52a49faf8459de28cc631563e26c6c8e694a5a22f46283ec8803caeb0fba3d90f5a73ccca3bf72bff487541f45498ebf2745a2723204417a45aabbde90e06847aae40044f96c585311c9fb5d07343f35997b8edf3763f13b1ad847a1c815d6f3ad277a78d1ff38c0b6d211bb0f3fe4160e79c6c2b56382ac7132d022eea2c8d12f117bf684ec50106b299878f4bb4dee588c86f50632299348b724d4e6a444fecf81c09ae40b7d4ec80f5c4425ae1b190167ab0d5705ca0ce3cdda871e171fa4151c9a42cf236dd49ad501b6b9d85f3bf3b257ddf65f1a3e950308ab44929c225bcb44788ec6b7f3dcd7e140a2a5fa976751d323d7c96206a8a521326955053f3dc0418137528fe288c3466bcf18360177e590ff4bc75d0b6f0187caff19ba4c94ef4a934bb941bde849eb9e88c0ad79ead257b3d53da5828c1f035fb3c7b67d6081944cceb2da226ed6d89f02ac7c4c32ca394af4951a25c46c381c6f2c17024468c7fdee672ff153122cb80507f043314dc01a00a7036c7a73990ac3bff8b524cd9a6d4bce6a34ea8353a7ca83ec0b0c1804b0899a21defc96ecd15895006edfbfc5e50a8970814ce8ee9d159accda1bd4e34e674f802424db329b4f8d3814a3f7f87f84a51accef2bb4a718b31f993cebd26a7855aaab4e28eaadeb9d743b7981db6d0a84b41d09dc7c0f586a0bbda3e13e5436edb17b312fc6e3787f6a4069465bcb53c19f1aa1c3cbca56fdecdd81ca7cecb76fe8c78c901a00f16e0679c46b00c75c3afc8c47bc3d0fc842dc838d73dac8371d6fabddad38fab364f8a65f9e27ad28f43aa65b2abfa158835a51edfd3220a8587c2a9f9843499786c7e974891991b9fd61d145d1bc88beb63302942181ea6cc8c1d27e63eebb3c0d6ddf2f6c302337f81750204982d7c18572ef6a7a7a570907bdb24ba6e11ec772f362e4aaecafee6765848081e555c280c61d67fe0589a1e147ded5d7a795bb1b46c75be72ec49480062f27b9d42927d57989469e5729f0df856654f654298da64018608ed3c7ad8f81d21b6f5daf4c4efa8079ed360cbe8838de9944150c445226ecd775945c85124f6c907f57de1e45024826b7e5e9283619e95c1050316def771e1d925755a1290368dddeff30ddc18064a674bebb1e7e73a73d213790fb256ccababbcbb76fc7fe3abcebf961aa1f21060c0637f5c93c6febd0d57fb68501594f919dd0655ab931ccbece3756b31991b34475671e243d2b415f94df323325dbe6dc7b059ac816617cefb745443f39f41a29a3bbd598964b48f210401ce6ae0df6bdf0e6af9469944c71158541102d5f7d0f086e313c4d9c1fba4b0d8d04feb09f8d1782f7093c893bd9d98499c20db731b1e7f2e4d86a83bf74627d3e23f37ac2f46249afd8b2116d08ab6354a67db6609f631936c250337deac5034b3d519507e671decd92465386c5048500a39d4ff64ebc8f213183e0aefd3e063ba105f2fb54f345021158b339e54bbf25d3bf282c74f966818a45f679581d1625944384a94d22559e1874a9fb54d91ea17f8e9d24192f97c905b04b7b6a61c516c11b7d5db3d5119e090b14058aa6dc7f9fcb77048ddf60363e40f31d1f9bb0e258243d668481710eb2eda89599e74973b477aacee95b6fdfe9b317236f65ea1d14c957b173b0b18b4f2318e93ecf3a48a90b0fc90b8e5d72b0319b74c3d217b6bd64c1cd4f984e196f22497fa5e9d491b7deef7b118a12da61ecf7d6b8e77e2540bcc1656bb6581078206c50fd40ccbf79e6221e08959983666da3533158531d58e2fb4fb6f4f356f7186a26652b3c56464ddc90c0922e057496cee944bcca443edaed64915e6f41c8f1a16ddc8fc2b4869c03f915a5c20363c999b5bf55330767afb640fb72d627ba3fc3e1d1593c7aae4f40e2a28214990f12f4a9465856fb2e926f3ba91bb316494dfbbed1ac52f469ad0abf13563219195b0821ce23c618b2cbdd879e640d989af6d8528139fc1c0333ba81a9a4b175f655b3863f1a7aa1192f75bb92da50481d1238999a459b50c4eaa2693f67af75621650311c978c2ae60f0a2c6fde9f3544e7431f7526888fe98a4fe9520b1a82d51a6a706238bb4b43f2484dc8f474c9f4cf133308205be428958fbbd97c81023ede96bbbb70dae90fbe1fb0ddf4e1934c7ae26e272424bbc0fb4d9e291ee6b738c8b558ec3556e52b6dfa4512134532a7456b66d6bdeae27ee361f8cbf149da544a68c8e089017a3b03e0deb570c37698cd0dd86502f92c3098ce8179b6e69f041595571917d2388283126527c26a775478525ef6fee153bb98c0948623c05e1424af7ab1c64affd4ff4ef13fe468145acb5796f6d77c67e52421587be2a3e01d453b48a40c99b0abce8c4db8c72619f5ae53cb3f0f02213fdf32d0574e7a2246ede607bad4c01c15660c1e246d4637864c172b19eeb1237b6f81896cd4dd7c2cf8052789ece4e196e38c86bf829846c982f4ac596eaa9afff4ee0fb40e88181356c171b0e28ded68ba1b61bd089811a5491d28fcd0f1f33fefb0ebeec6237f19ca5e1ef85d07dc9b4cebe530c13adec525cab92b5e14b4be37a81e5c3d4f800612a86413b50d35b086b1f4c0a9adde5c477a678c79abc1b7fb5409e280f613a0bb020959724de2426111191f56e589227c62906c41cf2f90ec42c6380a93cff0c9210cf5656d2cfdc839eb332142461adde37030a17519a8af924fc1780bf66468086885f6bcb39c311d915ea7533a1247d324eb7eb3c9ac9faac83834c4f2c48510bf017ceba960c07be37676f8f1c39f0bcf81c7bf6178288e31a973d57e024574985fcdc14a47024a6ba4e14752ba3dfd3003411daef9cb66084df9545751ded06ae8478ca9c89847ba1c35ec1d03e272db35c3b44c9bccf56102edce722ffbc889dafaf83f290aeeb5f4ce652048049b00134f923863152b846c9a8200e06349cd3f6f76e4815dc92c3bb6a7eaacfa25848cd487f316bf5a0089e73be61a107e5dc7501f3786775c37579888c1756dd90a5dea224fbb34b7736b64ace61ad6544dd61a972b738a01cd288225396fa48f9700bb76331a90c95c147f37ce410c78b6156124ccc3c6a74505bb98d15135789b5b02b73cde5d4013c7859ee8709ec23912728e693186982071a7af7c92c3af2cb045a88331ef0807e92c635cc1763174af464d48b496297a3f2e26078ccfa755b51e34b545a876f18734b8ac906c7200a2e90fe6cc24c925d43a2fad0a5888ff9fdb5ad36dee4553991092e336ea379a11d94af0c30d5e5c9f3033a9d674228e58632603f7c825d33e639c1a12e13158bd3acef144995a88cfa2c6473b544b77d1a4db4b33f3d47f301288024e98e809a314420774cecee0d2f5272dccc339d2a86354bcbb42fb11d8b0bdfa261e1479f5909aed8f3700e115b2ef2acc0c66e8c4cec2f55dafbdddd3f0993da06f75e353f8c909a94e4e2b8b6a2fb4494607680e837c73a942437e03ef431b968dc6e624291e4b69d39323859e9fdaacbdc17c37b9536dbbaac149449d6075d502240fba6559eb7d6e09bab4cf75abcf09d5525d012bc05f2f6084a93455628bdd1ebcb7d607668359924d1208c219891fc85a39ec188c8ded7ad46dffcc9a6e41a676750629e13485f67eb541359e05e695d69ddfb1dfbda5e0ecd06e70e50a373ffd72409ee4b269d2633416943653be5f2773a809654d2e94f83dab63318a57e20148ac7a0c5fdfbc10b662c681bb12916421965ffba77e9050c0d94f770f5d66d08a516b335b9ad92221e348ff898e94f6038d2cabaca48ccf081168b7691305dc540fb8460243594b077d7adf4b70b801ad2a2217c82f4428c503679b2d90fb885db27d6a84cb901d1702851c8d4bc6d915f9e940dc5dc5a43d9587f5e4608ab51d74b7ecffde0426de3d89782a6f423740cb7686981822e529a31c6779e47d57f4c3829a04d529167f0956f88a3a10348e5968a647674d3339e166c3712d1f4e5c4e743d2da5ad015d4a075841e3c90b4f35531ccd34f2174bd0c32df5cb6911ffd378fba4622256ea3c5f788d76245eeef4d212bf20ad5fb8af21ad9289b0e5a3f3c9c546e7b990dda8416aac108292c7240a1f243d9e1082129931ef538d6354a6074b6cff3dc977c9551d3b4b80f4fa2f16d570233832c8921645327174502a58be3b27254afbbc8babe97095454e9c00c5eb195de30f6a120dab107cbbdb0dcf2a2bc882fc4a3b851c439f43756a36f8203ac27f7a29b25400c1489a12c3201ef20ca9d9285f415aae6e22779412f8ba1a090bfa185ed88e8cdd6a68af2de03f48cd394d43571df998cf745092608ada2059b1be87efb76f80e8ca2436c355cdbd939868f56e0ce21abe854c5431947d6128b43682572f659eb9c4ea5fd9593430655deed187103dd3466ca1d76da086b37c29c0b5e8c291daf66663cbc28a3facc6831212b7044481fa5787d832c9a42c195532019e6f77ec0c63c3f65a6b71f63eed3fcd073c0ee27b29f7895003ca2eb3ad27dbcc0a12b290032df472d266d8dcafd0e848dbaa3754268ef521898044a0ffa222e02470bbd32e6a4b586b673d7dd7503ff3214e591cfc519a29a580789d878139a599bf6d2e63b9d9d04b2764258882326f64b26eac6c3ad1e04e722fa3831a8d43fcb6890ac61d75a97b139270842522fea2f70b1bf493c203da9d285c41ceccf47f8879eb71ba79132bd0f8b6a6b8d3a36706e8c25612cffd8a60784a2a53b0370d410ced5dc96a472413b27ab12dad79f8f84836dba26e2917fe02f4815d7877ffac5de6241b64cd27f825d6cf93db17dcadac5e7978102037c287c7be40e0dc3956f5e57a3554c0f546c657ece592d9992562a723f54771d246e55e148a07ad05fb43bcf848844a95595009d008cafdf6a0d1f91024fdf34504e6e807f597f2557cf51a5bf6b8a60863ed91dbbd186fe39e391d8b72ebce1179de406bc9baf2be6b8fd365b4da14f17cdaaa6f154fd1971f7538ed21338cbad4a140e2d1de4e5f21736e9fd8311ca4386d6a4ffb82d6170d8cf93c0511e7fbccf635d2468a2b8112d40286480b46f7ea842db67981c06d20b1d5f0a839b941276b28c3722e11b41400893d030dc0d7da2b2a16623e6ff2cc71e466bc4b21054d1d56a2029b58700f84c00869a73974cac7ccc4b7ab1695fe40be4ebe3ee608da69eb8fd69896404e49854ca4d6267597e7f3cb55c187e36af590362e4ad1a9723b93f452be1e221e79ecfde1dd639b1e2c443ecd8018e84cb12aa0a8f42e41b5d89a5be97eedb54f2f3a519b0993721ac9072c29667a10b00da0735c84f0598fea886a1c5737e0e70d751c5bc19d554261f6f1f2b195e5f09f99c6ac27a021d2e42e1585fe8a45df638687e6862ce78b411c091c84b868a498d5e52737df4c1afba1b9d8b4903362315a23748fdeef668e445ec03de08797f493b7e9863f596e6171ddcc45f52d42bd41308c91b2b71708d38b9cd1567f851df8d8f099dd0a2881a150b523c3a02dc69254568a1b831c1f9b92ac6d393a9b7937ed6bad1a412e901cfff7ec4289b2244d27d0e6ca9b39224214ce21d570c7350965aaa919447eb4ab661870532aecf4954290f76f65d76f9c67076c41b702183d4694668d32d2696b59d5c52ab1750029a5ac9d561185c22edcef13c8689af3f405465aca10042cb09974eef6ed9a3b02814c32fafb443b5998946cf195b9358f953e0077b0ffc69fc64bd65e224a985148e89a22987d4ecc72ab652565544f76b0cdd9fc2b2eeb606327ec70c59fd013c8d31735ed5fef46fd4e869e6c254b3c9d8ed385c13483e7f14f252eee0848af4290dd54be465bcaac1c8645392417bf0ac6aefc2756f18bec21c7a781c8642ae87a9cd28c8064fcaa44355abb19a06e2bbf5b35fcbd1f8e022df6fc6f83511b770f4215ecdfe44f6325e0c2f8c0ae2bcc530378b8b6a919074ad0846c5228a2a926a63d468f860692b32b450724a0737a6fb9030d8f1aaf285c404f411fa03abf59b3c2d0f3556805234b22fbabf55800b5a3242cbae6f0a27d608cf6ebc5410d2f90f8e0ed7ab6b17a4e5f2e6a2ea6d9667dfaa62e3c5e6c11fdd44c1a5f6705eb0cd4d536cb64b87412ac3de0d87535e52dd01c3a4581c9b0999028c49b98bab270556133f09147bb9e8c976f3755ef9861919a0b0fb9ee253622296051fbb0e9836091f2d99bcbff4c02054e72673b1b62cf8f7807852539fbd71bacb786bcc6c12b50390248cec7e0e40cc78d3c2c911758d58807d3692837b71715dcf6bfc5d632095886747e4c8935f804714574e6c49be973b63fd098b4cfb6d262da9127cf9916518c0921627b11e7c33ace44f465dbd22a410efb4e05f9160e5c5f5797833939a529a3880db4d39a3ca5cd0f629f28858d4e06d10755d7e6345ebfd3867fe643fa6084db9bedc19404a3df0bcbc8e1b3713e5bbb10aff4df6f32d4e11bd8dccd8f7922f0b97644e82e17f2d8881069558384c8067a960eb4260e22fa32202182d7ee63113965b50618ffaaecfd256f26afde1decdc99a781a2eb1245bffce928c7c8c957a9f4586b16c599ffe1aea21ec714764095ae19cccfd6e723c64bb7fe7cba2705052c77ed21dee89a2232ba0a79a95648b2b66220928cb8dacabddf0a76bee3534093baff47d32d34131111eb174029813f64cb0fd2582310605f36b5f12c857bc89f36cacabd4d5dd48a5e5cbb9068f04ef8bc9079d60c977d75068c845dc65eb2430479ff71587c08ed480890ced88a74694db94821d9ee5c4a8f855c9021d6120641243c52a0b1c99289b2422a3ab684ebba54063ceed094e632b92607e95952542221c69fca28e6105d7188a86accb81b557dd43f7c3e4fb6c29bc949e4dfb8ff478b9f2857d46576c2a009832223addee448da34154089a392585584e21da19f5757c13d527496708356b39aa3659fc7d05c78335211ce34e65b9f0869016925a058bfdd1c7bfe8598b37646b6bb4ad345891db76121e54e58c62b7c1f57c7f68d3a291aaa1ef8e36fde13396b28d5aa8ba6dfa43335c36c90bb9922304a3ffd0a1f4a2b2ae5c7cbaa99485a372362abcefb9225ab8e5586732ddae9d2cdb33e83939aad91a5f2409c16c7982787c57638a2049dc39aca82e9cb9de8a0f1d56efc30bae50bb727f674152e1009913231c075e7570bec031021a68b57f41b3530792a8d2fcc66ccdd9481a9cd91d5b840463feaa9482521ab66e407e2b0e2224f7c3d43349c923389f8d33ae8dcb965870b07fbc1b228f3073d6a41e35fc5157ac76fee6f15d97bd1fdd005b479f472569ecfadf9b6e428dc1ea7e426027aa68eaafc6e5e4b7d7450fc15c0468dc0faf0ab3af6ad25aecd85c54a8e85efad7cdb46dc4aa0d19fdac971f054525d066c497c849829a0b6489d5bcab129df597163f9cc479c346512172a5fb9331785ca900b89f5417028e5f79012723bbb2fd2bd489a65371686f9da70718673870579d43eef038ec308cfde4e9f4cf1dbfd01fc74e33abb07178daaf25ec8da2a1f79c815348d6f0a13ad4f5941ef827f5572ef668f43df5092dcd3161df0a4f959d95e09e1f905460cdea02498ff9cea89a54e32b8fb874de75a8351469d9093624147a62fda7271430e9e40409ad4b5a553af94ee62342ae188928d3440d4dadf565c3719c7015b644d670ae061ae44d1b49904ba3ec02ef8067c24c6650278295d2afd2ddefb49d24e9279a5bdacc5adaf7bd4bfe5404e80333f96f16fabf8f0576444a24a8dd0cba9c2cbe3eaf3e72a5c025abbfb074e4042ff2867c25035f6d2a6d6b960753aea61aeb7d71a72790a2424bf60bc587bb22165b46e86370c6172bcc49b9040fc71fb1cbecbfb1cd4496d25e74299f39c30c640ae31033dab171aa2e517a727541142dbc877fbfa8cef0919142c2ec646e3711f6ae534fe5f71fec7fdc85f4978be2b48832842e89c29b7d36eff30cfb3b47900501d489f6c0c1cc578816173506ed5ceeb7a66f0bf535cce72b6a0be0fc5ed1a2aab01e0f4d318a089ff0cc69ee03d98c8f6976c49596d890c9d6a54aeaba38c601efa7e163794cbaaf259a54dc50277cead09e56e0394c3d2db63f49c0b33dea481a201a32ef5d804680ac78a511990a5f6cb90c3764d23ae43a3289f696970c8954a844457f1002aeb520d938c3c2ec3b574a2bc7ba6c4a55986cd6b5b955aef08274833c8efdc5186891fe8f119cc9831da53a6da7fb459fc3edd74725abd0f8f707afd313a3a88175bd8e838a3923931f127d6802e2f50c5817ba4a1aea30b2a5ad710d129a7a911ac2ca91a63faa09534a7531aeea63e3a835376b9c5f423b3ed661c9f2f8693afce25fe8b880e05a50f0a8254c8f33bbde357a9b767a216739b14cbca5b5b72b2cd8a78fa1fa72b7917316edf4dd5843e847af425eb7b00029238ed3c852829333930b6aa0b0f25f2a6b23adc9fda6d88f828075e6644a861ff659941726c25e07871991bd63d5cf448db49d947c04e31ec38766ecb84ea4e5c11632d9b22b5e416f7934e41c0ef5e0b152a0eb137d2c35800f5ff06baf80f585c30677045aad0e9687c18e46a84aa59c18efdccf258350ea77c4dfe50ef78d8a130c4c0fb785d00de10bbf50a82909ccdb295450e63980261d431ca826d2e6d930e30c193e85ec738fbb776bb8ff02b112b45c993f08236eeaa8a3aaf2ef56eb0c0d0e9098d5d0e77b7f4b3e3c1d85ac3243ddd611ef33a70580d5c322ab439b88f26b2e15cc2fe0938318bbb577bca5d644e8de1aa0abbf458a64d3b78068b918fa294632bf85a9f7edbe461d8cea785155afb7b4ca1251d372936c1f4a5033d1ad9ba491c8895d7b24e9688d24fbbfe30bb0aa58dfde2eec6e3ec8ef7509739f8cdaadf34eb7f1dc4ea7c5f9cf41744e3a8e06ee453503535d55cd2fb1044dfea5a36a9dc6a8488aae94e7405ca58d111377da0c4ff9ec60e3582f14082c39f0f56f2e03214dc916d02f284ebb7543d22fc30b9228331aea7b0b09296f2cc9697c7dd339b807d46b6bc114de85722e3e55a72ae96b463832179e9b596bf4a4a254c750e778ed221aa23f7f551a71bfa52c143996c7c5ef44d10d0eae08e8a621dedc4bc1178d933992a78a36c4b7de0c60ea7d8a8c980ba586551bad6a28e0f8e4a5204a52920be3756bf5a8468df9760f5c8fd3148e155876a1c8610f4bf4f06af5a0ba3bc4da37d9a3b2a3ee9170edd51296c760e8c166150ca461b2777e5f0643fb73f15a2883aa35899e29196d3ef325d506035bd4ad66d110f9addb80c092a643f5c37806061ced7298afcab4d277e500d5a444f8dfd8e911f888fb16d2c50a54189af4be139b50a86cce7c3ff2ef76312a0f09544f468cb1b6c9742bf22f60e3cd232e17ae99f12896b011f68daee38227c5e39b87aaf9678ee066a35a50db9cc59fc240c5d95095e86832df04836c9a04123d8e2a87531bf5a78b10b86bff4b89b82188c2c164ef9277622e02396019337d5aa22d303226fd9da2cc0588a1c81dbf543a8444727e3eb6b40fb952205b639122b2205c11349f37e6948be3323bf35482f6552e830641a0de3729498867c22eec328bd197a232cd00f53753256e913b639c9d047939a67523c3ad649186bfe54e1314ced2a6eaf738b344d45366e355c93312b87752c45205668dce016fd96cdee8bdb6a605171427f064c996b0e9a0e9e73960f009412e7ee8edcd276e222b13fb50ed6cba021cefccd433f217379694ba800753e97800c19af715a9fca1412c7f7feb2e497eb86985921aa483222ce711b6b6cf88303f557235c8038b285d4b5c6c102f35359c925376ff8e5ca98cc0a2ffb533297db6c3e91251a7f64c8250c6b7e6f6848bfbe1452c5a9e98d73ed1e2da6079966cef7cdd3d4d093f5fc90b5f9a810c3b0560ab60b51ea5dacfa69153a810cb84ebe6cdc19787ef46758b53207125b62af8e46e458186211077622545a663ff11cfe3c7d6d9d21cedfa6e01a4a39192207f7772db0cdce8a1c125f7022aedffe9d1451ffbc45da35e0eebd07ba8183ce0b57f10ee798815ddd7e3fdc3de01f7710e9d06ad3e5d7463de37ac64a929efe18e061d49b23d90ecc4c8fb8f4b5d716173cac52ba64ed17293d771b60bad697e7074d5c35cb3d3a79c452b7cd52c7749c2b4f14208ec2689acefb3273c91da8e14540f076f9a5b5624f79246e1ad12b3580980cb99e59fdc58a026c7cb3670cca918ec66ad33676b1cc7688fc5eeeda1292f6ade33609db8fb9171d671f6112455b4734eb856f611400509d95e691f509fb63989382e4e6c4ea890c260627229d1d6cf37891ef5e98fd6c653d0c7e32e65a1d846c30a823f6851a9ce974b8cc84433a1539afc4e14a3ce198ea17bc7ec5e34a3778012e130a128e90d57d6bdc55f2d7faed373df7dd588f4571a0a6770f09a5bfdfd305848f423286bb4f87b1509fd155208cb21f66cda9397c07c4429a988a73641b2b29a38c452b9e92fd81559d9e9a54f285ad8c4fc044bcc3ee452da53a22d22fa8520c04bf0a7e7035b4547198175a035e0853869dc26c9f89a48e9303e2ae523447b4b184274b54a975dba933c784bbf900a0099532f2eccc65a8e3704d0a1bd18e651fbff1c89e367d235474bf9693da0fccaaaf1e280cd555046376b20c8d46fbdecf8b7a56854fad181c6e00f0ad0d33a7d4be6c8e0e084f22322c53cd30460b248c368ab5bc3c391a24a84158ef45e56cf4a05585d4805cfebf682203e6528f7ad0ee37dcc50407069124d5aa3bd3a97fd479775717efaefdf207615f4cac00807c257338f3b5a9758cfa6b11aa1e8753bbd33d29ce83739073e26767326af72faf8a9d668b43d17ba682a4e51682dbcf0558462e2796aaef289926d922ac2cc5f9d015547acaf5f9a76be1dee43d5cca49154dee208e49f8892a840bd567f558999af32f812d7b9fa5c118253f2398123608ff31042bf321c2a2feb9e916fddef445a71da7355421dc95e6cfa774d29dfe69722d9f21a0e38c932b6c779e5a57075f6e166f6d54c2998553a98fc4d683abd3185c9c746a55e944b9d004568bcf3cfd39b58d552ef0b53c469b5ee8ec686b63ab4a3c6b849659947bff6880b1b7fc43bd578d1c598428ed83bc2d7ff30b89322f211ddd6098245417499f70dc3facbbab5266dc564b3f8b637a56dceb7a4ece92bfe665635636f19d781d09fb2e729a3a5ac655baeb63f0f8821b2f4d5803d8716a087430022ef37bceeca89a2d169a577c831711d7d7f18662aae71df2ae5ba69da9ed69e38968912323116ec4929e59f628fd0e3daa413f0308f9162c0ed0cc9c408d8979103824ab6677d1ba2beaa85d89f529f29144dd59aea15bb818a7ce6358db3773b2b587b6a96b4058d7ac1f2a93e055ab5d78d8c9f2dde395806ebf926d0167f47ba1841c2c3deaa931f567deddaf5aa30b890ff202fab24d8a757f2e78178ad3ddb7c220feb50a6dbd70332486939c22853a985a94114fe77ddd09d581d0788ca8afd6978abb383c7102ac74f50ca585a68d434b17146eb8980eeeccbc8fbde85031e4c3a8ba10008091fafd4c1881008f96d213ab7ff752f4339ef9ba2b8ab2d01a8cb42bb2ca232b48666617d3124efaf1b142bb4903346134b46d26ef580629663a42cfc2260f210c66edd85228d595d4de04e0f7fd88f73ace99fc31053f8339a50338df45d5984815ea4fcc87baae8c68311fc02a837bdc22b66fe394eedd32728a4c64a67ccf0201d3ec9c75ea0ca4e9ab2c4dc94f1a5bacc0c6d0a50ff632cbef925b7652d561eea034dba4376d680e949ac104a1c3c3655e0078e2969a4dbe43cc7ffaafaced1544d7dfbf7bd0d22a260ba1202199b170e3aae82c0b64584519930f0e83a11e9e3b556492ff118d41dc5c2ea0a5d97a1e8faf2dd007e9f2057c5b5f8dc78006a358c3da5f60d513dc357e98afed9853cab12a9a43801016ed0dd10e9b37f69ba8be6effdabd8e218ad0d737ff928954cf2f46fe1fe6b72cdc873c19883a30da0e8caf552d7703b5e8f76bdf31323de84cba6dd5f8f6f99c6006797b15c1aedf117a79907bb13894f2d6a779ebbf1ed29bf003b846a9ada786109f6828bdfe3c9ab95ed98ae2970484ce5ca714d1ba22517979a105b75c34b12fbf35e9429fd75867c690614c59d6b624aadb6ba6d67056765fd010be92c6cf0e45e1b54912852cf5e36d54556ecda6084cad18418c12b0e783de6a86a42ae423237f2c253a6029290a14bdf81afee486c38b9a45fd96e5211d7167b8b4c943497d05a901b857ef1de0b2c8cc42b57b58ef6cae24f6192048aa17d7206548939fdae18097204cc02e8d37f0b690b496a28becfbd32c80c7774bdb9b6285111ab494505141760c950221fb259913c725949fcb53059414f3dcbd6b49b9e370fdf0afaa358ecc128f958a7d2c1709f4ac0aba2a452764a272e7299aceb97b1e64abf9008889d7b4b15523a3f33fad6e1b024ed282b00538f0d717a6227a430fcb376ade954211b5bc2b162b2da40f7cdf8389f7b15db84291d4fc6c1bae11ec13f885f34ffe79918eacdaedebb801e55397741803e9059bc595a84e0932ec12bac538f0a43b77ac20defe3065dae06813ff748237c3ced92b9ae33be94e3e1cf2a2c5274e39e3effb3c4298a21dc740fb4f3d62c461274396734c558a71f319954cf216d58385fe81d89ec63b5c551da94df61f6a7537d6b85e6fd5440449fd7ffc0f172ef43f0903fe72bb81dde23d3114a1f046d90603095cc129c6d372f2034c43df512bae867836b1206baf8ec8ad6b86a33c521eb1b7314338b206f678bfd91699ef2e4e587d19173964a717d92a0d94d9b3eb439a706b067509fcb224614dc9736ed87be6ef233ab12182c6a1d190faf18aa410f46ae3081335571a96d62f622ada40282a22c82341ea35692407a849598885e53e187d0dbc94afae0faf0a0503c09986048cf445a3a71ce9fec2a1f3c4450d52962b6565c6581255422440b139040ec1d1b03c95349f9a51307b679125c4267487697ae1e36d4e343efee74325c3cb0aef2ac8522cd741a2a7845f05f298584556f13f2c12b4cc66fe265a091c924b7e6688a6de82a7c1b0db511760206fcf9492353560fa7dc5d765c400016f0edbfe520bfbad6434b4c20a2fa29ec06ae8cb4106d10906a9f48808bef6abb8b2441b84e2b77fdbc47a53efee0917fa81a699179816ec11bb78bb0188336f2fbae22576f497ac0fccbb92043165e87bf4eb6f15cb1c86e4ce82d53075987ce31993103bef11283b8960c8bdcf538765c4a5b94bd61a1e1099216f8b10badc2e964fa7abb567eb550711f14f495dcbf25f137ffa17cff51c191b2b0e90769a4374e4b8d506a9a3f57bc3201458654a6428e8d65bf420cd4becdd259680f60fb2188007598260942529c96689f500eec3d1416d489ebcb31c4f7d2085ef13930e1b1813ba21691c541d68a7fbbe34c96f37ae71c96848372d77b3e42b7fa9d17ca832a2d6b528bf2ef02637f5166ecc1573d3a2c7e8952c05ace67f41099ee80f360eb08e1914d260947e892984c890b555c40a45678c01fe724c30c630a1501ca7369cf77f656045ea5afbaa262595585926076ec8a8a3e1c49481efa0f6ae9dc4a883e2acd9099c6976e180447b061bd0ce2532c94af6c43504217f2117e4d4ae69ebcc136f9e8937313a91334b5a7509da8da9ccee81ea968f6a202c8a2e0d6fa8b592aab3116f36e4d4e698e0482955418b58690f0967d6e48874d74e224d2abe36a16ece2ea15604d63dbf8dce09bc2f4219cefc8b96e1542ee9d5a67b8147d3fdb07fa0d730718c4730fbb1722e40e3558f0712ab384db5fcd4f2f7791954d73de6a7cfa73958aef3e450f8bfbfbbf32c96be7e691a8ff08c3032bb3faebee5d08ad685ec01fc8254542b5fde994d44505d51c05b2cb6d2b0b624c9717b2db6662d6ccb471d13db663103c885ea528e4c9b5c3332f0e220818062455e6d7bed4938aead9aac93fc189d93898bf9764c39593bfdfe47619a51ebd912fbfb8081d14e3c51ce1f540eb242264ae95d3259726e61c49d77837cef18605ce4032afce3268d1ce5f447a16ac8493c176cd2e90acdd014ff296944afcf1a56c23c6119c6af9c3047fd4686749d505a2e71bccfe22ed8176c3f03537c7c79cb6b8dd5b96ab819e7ba9b30c9f3aaefbd4ca19a908ac43d0335bbb403333f303e9734c98032ef1c4621d935f47553f3e0ba041ed28cadd87cf5ff6d276dcf8ea571b774cd828306bed4a396fcdbce3786b2268be8d09a2d4688fe1a28d3b3de1ff3d667edfb59fadbad16642188e6040ff4f07acc7a256804988702be89d41e3e3874da896138d3a1d1d5d58dacad14e01a6a659e4ad4d18b7aca1fe41c6945a014aac493c10ab90112165eb1d0eda0714f841b7c99b1ec864132c84048d526a4d9d2a0b8d1a5f4902f7e6dc399fbb8cb32fd268bf66d702c8059fcc4d5dd31b1a10d13219695bee3ae76e2df1451d6149427eef663f46ea6f606403cc399387a8ccb8a579d6fc4a7e753c0679bc62e5170f4dbfe79aac8396365d12099bd3f6002fafc91fa44668aa22acad1c41e1e66f92a3705c62c44f24a096faa56e8e7bb4b3ad3a5ce872b04e09f827ae009f7d133d46c5fe845697529af2b8a0e7fe0c3c9893533c07992b9666c99df94d2671abbb7d2046d45cce6d08aa5b35a35ab96dc71702b9ca9b7e0f06de94f57725821b46ffbacb56a00d67e8c01fc2fb07801d0c5c5807e8c631679fec7554f9bf6a6ea27592eb824351f2f9e851d98b945bad35dde94bc4fa56a7e13878787d813daefb22c99ed8f06d91c573bf8eb1fbda06063209e23e95317f939681fc197e1e363668c5cd754e8178f0766225a0b93304260d6f46c907611245408dac52ea584d3f146dc44a9af1f91bd67006d0bab8c68234f48904281312e42a1c1726563906f154515c3c6657340ed3a97e45529e1619d18691da4ef31bf621f6797c1d31c23799cd9834f85d9accd5265e5bf579adb061cd119ad43e3495861fd126be6ad8c86b86386931fab5a86f1b6b082fd6dc68096e4e3640fd03dbb90b5a9e580a7ca452f17742110bd74a29905c01edd954488ef74b85fee743d7a783306f8bc76af1af32ec7cab40d56b8feb5fd2108fcf8f46147493bec4cce331d53478f2e6c585560df0891eac188b602fe7f98fc58146a4e1b9808fab89ebf7529751d0d2e821cfc572cfead9c2693933abd8ec5adc53a3fa1e62da7008a9943440090f35bc1213bbffb6ba8dedec5b9ec24029ba63a77c2393968c011cf158b8f4c5858b065db05979a0941156c700f5d5e8bb3c785ffb964d73e151c97f1f8ca26beee8300bdbf30ec0fe638816551b553b5f6aa0ee2a0ddabea2de6a9a0502ae9f8cfa0a7eb45b1056d3e90212111f9c4f44415caeb7b80db3b0807cc3b0d0665c856a83f007bbc000ed62af1a779573c0733465aad01db73e34e3f6daec7b5cf43ae52b4a3a3153edafc167f1e88b18228b76d380c6a3296aee8a9e916ed22d65535db7a0b26268bab6f2cf32e645ef615f95e06dca7d1afc69614b127c02b606447b5fa05a342aea5cc03b1918d3364301def4c49f3da57aa860106d399f63e767f835b935d088a545c03893c74643492d326a433191fcea9207223c06dc4df8f8b2ce91d5bdf3a9c2c8531fc6a55b9e197a948ed8ec8aabab30d28252a5113a7aafb039320d5f0b524e02f4d6a27962b8ca7d085d914dd18f862c096c5a10e7e0022efd982d61082047db97b99061e9db4242fdcc73f7e6f2192a9f2301d83b7ef140723b1d6c51db4e6a9bc0a569c78f62e1be1f7d1c24f44217d44fbe9e917ca6b3180b1334b07435f46ff9c011a63a2fd6d61b352543df4b1e2be5dd307712a702a5f9b2dbf508ecca7630a89b556305a19a9f341d0437f9ecd3e866814fbca36452db0ae04edf1156af8567fba6d92170aecb6244c8f314592bb8b3825fc859434eef0e74dc5d56436ae80f78461b84141b1f5df5b4a61c0c1c0adb702deee27112fc272aea70fba744982c17c405baf3b2bcf5d4389baa0268aa9908f9a38c229c8615c9eabbf5caa23c23743e0965f088731614deab7cf34108f39ca4bc7227a656f58fbb133f76b1faf664a3b397d2215a37a770ffbc4e04878798084b333f68b56318b15f79e95f3399b3e79aad52570afd9ad534202df6c8e30535a310e85636b5033c22cc573236c39f7a7b8c5abb1a67b83eeae76c7f66ff1c6e7984c3a709c44a35238f99bb2e271f3cec569c22669b4c6bd11262dcb71aa890b14cb9e634f61e5d701e59f63d7c874d47902b2a19ae52682eae4ff08150122eefc1ba45d2f01d0c3636c0708c87f04b0b1770b532e04c878ab9bca8c2cd76c59b26ad0af836f514358e20c84aba9bbac06f05d2101ffd257537d6de4a9b612630e82687231ad8168c21fbb9e2a764383e2d2ce023069c9c27863956c691148c2617f973883a2ae51df633943aab5e24b58e98445347032f5ffa57be29f354a21aaac5d09f077a552a6a72cd2e39b14fbac6f7d3484e9543fed47e037daa5b4561e71ebd3ef827e3a825ea4b16e124b9bc18cc341c9285fe279011ca5265e80839f0119bf33315cb8daef30e0237f9af1312d6d8eb78a7b17e19d1c050a8603af29e5f7b62a4d743052ecf7ae7bbc15a77a61fc7c90cb41f06b5bc726556ccb89c64985534443b70b959dcd203cf8c9ff3ae8ca0829f37f57a9269d99a1c08c0c1192c1e12f16361c829bcb9c8d25ab606ac49981a748cd1a055654d46d8237646bc6c38020d0e4cfd8a60190c535fd9c491a46f55a0d6c787a0797ca2143d2ac18c524289a39aaf925e68213b7d0d77c42b02f06b6b5b12c34804fb874ab6f92b94bf304d416a8a11c45acf47291807d52af626703196d46ca369cf20e7f0ea00b79fe56851936d62a7b2d38ad7efb17cab276dae82be46724638467137451b1f03aa0c50792f938e9dd11d1d9f0dd04556a2c11d7ec75e274b64250395592c253c894d28a35227ecb3d883e962240208c386766692e904c4d70ef8d698e7589f42700cbc766090ccf9d9490fc262fb1e17ebce315e3de3711901a72c8de16904de089a0597978a1826d335325428f84f39cf730078ab05565b8562b9baa2e1aee38f1512bedb9ea3dbad9d5be3d4daf8ce731d1765c05dd8161123096add7eaf061ab554b3214c9d975d88a219b3e7169a6552ec4628f11ed4097df41b659f3edaccda8a5fe8bbcea788c9cefab183432daab98bf9d51e91cc6ec8894863230c2ba1c3892a11d442382793e401e6a60937e602ca3a526b445a16e1f5178d58d29b67ed2284bb838e215068782c6ec747c80e15dea020681b73fb213c19374a626e1c49c21c4e073a09cc6402786336ba0ca02055e26fa784f80363101bf1b74d07e011197121e4214a4063140d927ed4104bb835b87e409fcbdbfb5f3d9abfcd1e82311edf8d7d1a79561ed822f13fdb71a314d3ba7fdffe6afc7c1f9a8f12f4fce5960229b0622f274db4fea673a00149d4d79705d655d3421adde93b189eb24e5ef1076c4e37fb35302db8ddf6b6b287ac3bc9cd931a0928e84e7b38a8e6cc471ffa6ccade6659a29377a3952c100e6cc37a12cb0e205b5bcc4bf6f0a0f363cec2a2a07b54682bc1daa02283605f5f47e711ae0f109a0c9710514ca124989721d5175badec2b8ca7e0ebe3e24b26e20b60e13326e05b5030d773ea6a98579b0fe85858568422280fc5a446e2336130cbe0e9d0e2e960e0bdbf85340852996249754844b8c2a12c00f00be0f44411f409978ddf961d1f75df2feb08e8aa0a2f333be9c4e56996fbc8e82fe35be94847688ce24b662cc39bbcdf98ecd25d1ea9aecbe37374a86629af4cce999b212cdce0f874dcf323ff5ecd6933bac1adb427443ef03c5ee9677cf58270a57f757e51b52eac0a83a444d0316abbfa20b486fca97ab5ba8ca2da29b0ca60a334d2312eaf9adeb7d265a37e8cb98bacbad9b35a76b262f434ed7bb5ac6de5729ac205dabcf020fc8a681b6a7a6b449da6a33e5258f4f2e2f8f6e402c9c8690c61359961a5a463a16ef50bdb7b0bc38e53dd36b5ca531fb9856ae6384d5ec7c8121d219222465db0542535be845a0d9e8f3e4f754f8eeef6647db9ae3b770d5c9ade7475b28d4ef269d854350a7bfda38faf524daaae31bca31e2e7c66d6c4821d03383389da92363acae29012293380c2622d00967482bad76f4c82c038c71001a565050f984b7d86bbd5c794eaf7c301262612858790e00054140049906064a0bd203155ff170c047b77a621283b1e00a0212a056dc743eb2fb44080ef60c407f49722820a39a90742d8e001b960b87e080200fa02429fed9fba1d992d6779c92297da6c580c67cb9a5102f3e8c119dc7df90989b6f2351b11ee66c62a2231fee3b57dbdb9c466f18d509272a0a7ad5e9db396ea3f5bcf8070e600c204369ecb1c82188b881f332bee82d3a145db73d8064fa1c5315134ba58bb12fa0ce6588dcef11b1a4ccf65bbd56e83492f8f5dddaea12c800ee76bfff9b9480d911ffafede2eef7885d0246ab444aeb473dc8fe327bf13fa9f9e4ae539e17a0c2637a8d498c27b8964d28a1e42a70412b94c17bf1062d325430104096b170cba0219860a17a7070930c454806577edd00ed1fd9fe9ec15dc333673179f2d12b4b186f1dc636050c4d458bf1c88150523beb707689cfd1ef1f3aa7cbdbc2f9e8d9c9b94a9874d20ee7564347b991241c734db3b81879ff33876a5ec7fec8acd879eda6d9cdde27972bb2840a0d1226a9e4805002e4b7bcd8e2d4ea7b75608af98df6dc89e7cf2b0ac0d283eaa17e200f66f34197f0361b5ffe6295f102fac7d1c54e33629dc04c6b3ae0b5f32a65d7598c65c2323aabf56ad1348982efe39c8edcd4dcfef45c6940a7d202af9c1fbf52a508947f369cf971839f3272747ad29f06c9e654ba8dda2aafc04935cde5806192acf291a8539e1c57d1c72bd7d7d752378d1ef039886d848d810cc1228d42b3e8bfd917c511c27b2bde015cdad592c068ee0cbab2ab11389b1f9935decec576b8e9817276622f20d775f8d8717d65b2e7e70ceb1db7ff7c3be33d488398a30529cbbb313efaad1e1149b5b66bd707edb4ad9dd01753a64083e11136ed061775a4e8c439ce524738f78568052dc640d0b987f722a865d879855ebff86d6c7b6648a851ca0caf2369168f4692d13929023bc966ffb89a7ae885ef107da59ca89feca5f685af7a51701429aa0bdb2e807c3fafb525850bf2b9973f2d248806a9c746677f5d09cf466ebf371a830f966f6e2aad2873bcbc2d72e14fa0465845eb5b8eb696758c1c44efdafc157124af8c12efaeb6e4ad463ec18336bbf9ab3b075f50985f9773e0c591e5ac36a64cd4edb0493bea466d870a8443271e9c67ef3bd142edf9a314e87c96b21622f96dab172bcef2a58ebd47e7c98bad1718823e351ec5d184e773121037f641339ab2ad8e91a503c6ff250d70fe60de9534b8525b330c5c8a7852c327e5b8ce6eeff4bed8d2859deac8a86a7710d011d3717397fd15aa4b2bf4a3f6133e4d213298297bd9ad1e8d915ccba5ac4103117dc2d7ca114acba19f65a6d3be55df9a6f9ea4985699514992a55dd036ce95a8619e43187b070030d6af7bf893e1d180d71a8c4ee1a46c73d842f4e19231f0483cbb2f887402ebc1ed8b4130fcdd60b4d9433a460fadf0b77996a417221fdc55820110254d40bb2b6351b26a0e046a33c8e20e5a7ab4e76f28cb2ef79c66146c136c28e3426002378f36ad1d17e5ada44a00d030d02e1279b49b4abccfb7ee94646f25476da4fa5ead1fc71a159c8d8839dee91f1c672acec2a5ccaa423853046df3545979163e666c28f12d05783634544f4c646feee2884e95c778924176977e1314dbaf186e3a20acfc43a18a846c13e65d9575323867a3dc67cfc439f319cef9f652d5d3474eeb766b444ad7d4e9af9148c79da9c2c773433f2455e3cc5137b3c05ef18c60b3fa1c02d112a952f1ff0b2b35202fa0d86c7b2cdc9bdc5b08e2a1923ec9dd98ca7df1630ab8c3a3791c9e1cfc398626da4cf63a27fca72f3feb6f46f99f15796530c2b994e41d712ef632c9751c3d98e9a66d76c7409194b45442631527e21ebe762f4345e231d9c93ed56ad35e4f1df839536ae5eccd9537de69d71518c4c34d11b240caab21cc7b905bd993a1e2f80c2474b0d3714e8841170783fab8d9742cd324796887ea7e456598e45604691781075faa468b3263d6a55c219a262ff6e92a7b1460f12c7d4c2ec8c61623473d008506ab3e342ace418e84c3d956553a91f514ce4e4e238a9c22e76f6be6bd3984c52dae0f386b6e83055c78aba69e36fb703b423dd7c906db5334a43228e350dd9f3cc8de7546efc15b133b3d1bea7f3cf48319c563810e3d748941d7b46f1088f44c629a5ad57a64b6a5cd9d74697d30de9b95c92892e09c9467dd2b021ba1a6f292bfbb9cacaad36f693338b482ff764d41030dee00e28dbe16d53ab92e5e05bd286cb2f9aa3069631de1d7dedddaee072f648fec077d9cdb79ec9125965bd307c7102ef698d025fa16628ad25e551eaab326c7729aebaf6a0cb6ccbba2c76a43a115279457128ed7213f32a1d8345dc7b8b610ddd4284e0e4138bd0cfcadb99962d1a6701bebec02609cf5a642c4bc47c1aa1db355c69785723feee3fe5445e785e0419bfcd4b9e3e05e101f271f01e71b219a38e6610c2c9cd17045bf3d3b1cc19002428937a2ee7642e526ae66f264bcf0fc946d4aef8212a08e6fb5c61aebdeef1cae83d56deb2298142e032c2eab92759e6fe98b0c9a3f156bf4db4b3bcfc3cc934eef3823347364b3ec4b0ccc3fb30addfa32adff4686c707f6c125382b75187de5c56b824299b8dec8e5684ee4144a95b18b2aaeb8268339992cd56fc64e17b79d3720389798bab4262b63ddaad8316af65803f584b2912e12b07fc65a03bde26151b98ea438b2376970932fac3325caebf9a695e4c321636acd8a3a43310c71e6b5d9366d8798cc39d4d616f2dd7e50dcd9aed998e3f5b36a719223743a3adcc3e30eb3dd389fdd5a33104e54e984e6c9a7872e4a1752451cedb119ba2c159d848f9e113f79c677aed881c7a2a6233999be1e810c954bf438a53c48b6cded7e91f5f67a1a331851aef28a8699c5007b16901702ee81d20f6eaeb28b4b8f7a88da8725e48819aa4b464422824f61d7756258bda44e64074f6ec1b4090a25e07139f509af81cd968ae76702a35547a592b362401cb64918daa809ef41a5b963c723b48000d69202693f9f7c676614ad1bea45b8872e9ad2cfa9b7c57e74a9d75d427faef1bc9e3bb41f83650c40618afe0b328e7fcc43b7f075c6c2a00fdd7e16d528e03577b0732c0e52c87bbbc8489c7b4d15c690bb448894dcac7dace6ebc61144f270014cf664af7ae8602baf7e5972ff7ab379714eec4b4e477acf146a7d7e2c8bf47365d099760acefccaa1fc92e87be49bb2ec9eb6427c19c5241487c951f249d798bfb24a8a14a98140a64fafb9704d34a5cf089d18821014b7873045a00dfa8ce42fefe9d4b2610f6135af474afe5cb2c71c0d7eb95b3d156be83ce29767445a7184a5dfb1211aadee4f6e6fc4e17cee965a109473b8a594fd5218cd387f725608bc00c7a308e068bd9f9f97bd996baf2bf9d7b5873db141293a0bcf6cb45329cbbd0c1f3b41546ff11c46feb4d1d95ebe6924f0acc1b8eae68642a96888793b804a0794e07230dc75bb4df37328186b8275a0fd4a27a7ad2ecdaa818d417b533c4b08b7f8f542684aadfc7b9506cb692eea77a9a587ff4cfa7892b52a6ea75665dcb51052b7e78afaf291d36617d8db9a6db981ab9eba4731c3489544a86ad6079c2763cbdd800472e80a73512317b7b1d182bd572ce345129d31bd9cc97e98d5a818920563a76bf634dc6f6f6955d66ee64347183683ff9f334401c17c2d0a4b21de5070ac6263e55fc0df9bdff1be146814783f71bbf1499b2545504d632b28ea5b71270ea8e15c97c3c4b5c08bbe12bf17ba1aadbca5d5ecc62bd3b3550b15e7cbc33578692f4ccc1610acf4269c91f7d80fdff076f0e90f12b545266eb1d49106ca8100476125abb259a06cc8eec1dc9298a2d8e830c0f829104ed2fc3a13be01a8572fea9c39d7ab6c9f3b59e96f216962d412aec04698ab14c13043956f99a48e4fc1ce01e5797171dce78f2b7a7c1b9e8a4534858d265fd952511262c0a06e46c0eb903080787909c74991053c50f2958544dcd8029ea18c1b96cf89525fbb59112983c648b1f0a26451a39bdc791bb6ad93dfcac38a430250fd33aa397187f2f33b347182591943d72013d19f301105c85c44e4f10e95dc2538965c4e800c6c9960d8a215f9479d5cf68829b9e3d0b9e395accafb562e78a8bda2a8a4b145a348bc829df059656940f68cfb580941408e24e3f51dad889b58433419b34d1f0598703faac8e345648838266c7ec881bba0a022c45bbc2e866f7cf7505016ccda1da94dda5aeac649eb303871162c4a54cb9378863602510fb26044150c99236020487e0ef5d822ce2a9d5252f260903909b88586b4cc9fbd9d14fd8f2bc5950b5218e26d278c1240440813343d48268d3ecac2d02701265d668eb61e786750c0db936629ad32d20f6c16876b484479d3e0bd31ab2be685d015af350109101c184d919f6a1571b71a56345b0d81a78ddf87268f3751bcc509cc3341472fec3a3d00523d35a491098295c6d790a21dfaf2f2779f5c9ac119869054c11483144e0cc29bc785757eece5ee851a602cdadd26736251c73a14e737a9d6d90802c59fb10bf4587848901659ed8f11f3208cd97191c808656cadb0a40ca94c7001aab2cc32049b06a873ddd77054f62bd34ab9a1836e0720c21f4a5226f8e10a8d1f8327e95ec7db2648a1f23293f21938b2fde09358857070b19d04b23ba7eca0538e04d3e9165d5e5e8936c4bd6105c43dfab9e8e57b40c06144cd9c69d763a0252a50d3caea0a257cc1d9d1a11a27053862d16c292c032823250e81da8d0914236a41588555b65d9a6b93717789452d77734a9b8871c86f9e868b2376de5cf9df812d7e5d5bdf21409f339de0c36487215585ca5ebdadf68ca810744d333549dc2ff65013c86b1b0245fa1207758a0a785d932a6387fdc54594d15fb83c95bc1878184a1ff4c8998d1b70560e760ceaa41e6db8adbec74e2f234d3d1e17adcc815d312aedb475e633a88f7b8d772bbbacd284ce4de94b6659a5a59b3d16e7106052515f15849596e0a9b310de9a75aa3a758df47eaf2ec46b13aa5aac028c394159b37565009e9e26e0c4f48296264085ad6640199a28124f2f4c9b01d6628e03c2e7bbcca3a36b630f2706b523ea9d9065783b919715d3f031f2439e8833e9c57caed7a48dd5edc6237faf06259386bb4ab2eb49a480822dfc3be7aa75f80ccb1b807f80f3cc4abe34b3031605fd0926de914cbb4cf11a2993693f92e76b40049a03221052d19343352a15f0c4733119fabf019ca9bead661d2e5a2b3656f8d961aa9de2e210592a60cd6094ac23194165a8520d44d544becb2d139e023514f0cd7e8f65bbfac0c83bc90057cf5c8e89e4a640c4860f61dce517d18c4e346009136e43ca844e04f18112a6c1d00c3a44cfbd2318fbb92b38484a66138ee2b740689431796720e5a84158e6d93373142268f28f855af2f50427476930e202b471d911a6a33bcbf7ff151264873777d19a487902b5045ac20c04c020a2cfb2558514a980abfd7b0f9a0017ed3db4a5c8eefc98448850bc4ec7d20828c952a6adfd0d576c652245ee91e14fbf042c056c6b9406548fec0d17596a088f7009632c06384e0e7612684fa2017a64da03adf1f10d925fa4b1be298d880503aee8805461106c45321889f92cc929017389513b35144a367d783a3e1bb475a82905cb1c89571a808ca9e4d57a380f5e243496789ac2f00c2103db0ea7ffc10212103e38c68e56a0b2d39651ca0a6288810dbe55e80f71b3c4d64248c228f3e5db38419dadeb104a1211da276fc266d14344b31203716d09e274400a19c2b14819eb1abe89e4cb1037ea18916ea170b0df340dc72496345a4706a9448e8b746654262ee8cee8b722d388324706d05e3628f45545ae275695a8279901fb30382eb70a5adfc583828c6f1d005188146d325b78956ce8d269830c08b578160d19ab68e48b17d02c849cb007b5f804f1b1fb98bebf45c7d188fff6029509820e8c2212286cfb4b374b96d1227d90b99094a79377846d5ca9145d48863aea19401b321788b8bb5be061079b3f05e86b94299d1128134303544ca67462f86fc90a95d4f36ed7a71f14ce416e66c86f282e6ec7e47e612e50a19fc60f3ff081408d99fc028ac6fca57cce6c6804cd56a27c3a2bd3c71c9fedfd1b8f6609fd06a0c2315d34d01a4f8ccd3ec259c4420aca068e5423d36bd8c3042f792f60c7b8c634d1f945156360b946ab194c485a9c7e1bfa87326d99bf0a243ec28112811815ec4ced3c07ce7482dba500320ded48d370d0fb0c4565349dc5a8e012b72c9139356ac7037f59b1bd77b3fd01f411d6c0007a6d98570b08e0760802bbe2c6a00810b5b627017845a411729cd02308d3a287484adae0de889f35ad18eac9566c533183e5d4e8827e03e610fd145a84bc371420a10fdca88daa3d65ffb98eca3fe31ff29ac11fdf3819ecbe13f48878c82d823b17cec99107ee945ed355a24252fac1e07976e2ba33e04c457307f29207cc64ae20c16be14288d2b24915adb38893d661fb76247b9dd5c767b22f38160901ab193117bda851bf0e4a142e27df3640ce5b5bb1bd50892116399046a9036e60aaa09830c9082c998bc01100028b0800e0099a051608991c83a4adc967a9e02cfe6d186a2f6b8bb18513333e2a1998593ed2fd82c746d4d904021a1dfd7092260537eca88572582e895a56f5c61a9d2bec0e86b047b10c4d39fc242142729d6b21fc8ff18bb387ac41867499832d1393047b7594ea952d285b37585aebbff69104b086eb062800acee89e850e340923192035b930099c41ccb28c6be26565ec95eac101209398e41a8fbda023db925f61888b64870cb4051d2366e810faa8b4f9be9fc65088178d25a8d4c57ef270bd2a27e8d29ee555989bcf7101f503ba3b6e2e32f401e71ba0547e3b50a0a7370a509345e278c4341098fa90196f27137ceac839cd805e893ed8f421180d5cfe83182c9baec17a8dba1caf926c74f9a9920c093e081fd4c589f85be75c9eec5a013d13204cfcbcec9811202afcc034551f2294a43364e1754d1804a18dfaae7d3dc9fa1455267bec8d0c748a7c85688dd9af9591886bc24a706aecd0c4fe14b66d0704ae0bc9467e33c1a6e83d1a8baba88cb776a49dfe99c207e65d28d50d9a94fc0a63d2b5c887e67c5151ec682baa4a8f3884a00eb3e719b0ed9cc97c00032326400197764ed2be01426d93c43447b279ab03f85e242bd3f387d3944eac6720090eae56010e7000a5199b559b09c964c8d09f848ba401394efde899b2bd95c5283430d9930859a1f2201c39a3cdad4f8f0d4a04714327e6e848d812da6943ad6c27b69ab61252c90b72c2ab212242c449a90810cbb20341c22159d8bdc79572136d0699520084b3234572b2937b234d40835ec9205cb0268816c35d9d32081587e26447405cf440a60cb8536e177c58f4534b61d007faa096a770aecab726bb79ba71c1485b9550174a81b774d221ce7a0498c08d020e8fe8855562770508615fd06db176d0a6c6010d91c3c41a2e018c00563879100bd1b32d2475229d8e3159be18c161fc104c38027f59d00aea8658976810be064d626488b6596308492d33316a82179edb682041c3edf1760971114dc9a72eb76dab20204d202c1b903881b9061282dc28f1c4841e5b67694025c4ddd1b0c401e8371cd9cc9306210628eb17f4fa3275d0a846db563b76c7b35f225bdbe480de421d1a72254506c8b8158760276c496929c5d8d0073ef6594b017a7ac03f37957a1bd390db81c3cbe49cd19a290227c1c63ca47bacdb61f00e5b2cdec5c3a19bd45f61d475ee379bc6cb49558c23b181edb12ffb9f83a2ae4a79ec8645056b8c86b3d5ec2d5869d1b99937005166229ca81364dc75f0b8216646314e346c0e05c63901fa989c0bc0afc8cb326d0444f938d0e1fb6875c22a383b5cc3d85ac0c459676380ba7df4a25c088be4024c227659efc858a0818eff5ec77c22b38463f9e47fcd0fd5891468c62d54040701e6cc21dbb232103a07e39a2981e61308b237223f200a3984803c443c4d39a6b95831cd7f5d4b9f553365e9c5236c98ebc95712d7d91285b4288036d1cb42d52541ba8fa7100486ad32d31118d4257a064935b064b4820f59b37a94e38a168ff5b47ac8fd3029a8cdd1312e1059432a990a9cda0d0b1e5f8058c8e012ab8536c97d11758e7ff54853715d7b4f0605ab9c9a29e37171f0769e843f150641a6b66980b8bb848065382a6ec212448b82dbbb0bf15be31301e1c1a408d0ec81f8de9af9d7f304d6425ca189c844cce66715e0cf3a6bfc5964628d1d4a9e9300ff17a102c34b64230f3c931b5178fa7da66544a418d1f3fd9dfb2d8bc591a2e686de58c451ec6e472b11600a8242b5820c15b3a47b4758239b394709b780831116c0c96d4806c68decb42499ed6f04a8a955054752a6497c426dff843a2a8391993513210ec0ea2672c783fa3153e8856e94785802cff3981a6364648c18440bb5ef70e79839fc0774a3a2d87d6fc7691211de65c90f017af13af2e3018e67a599b9053a3458cd62e6d56640a4dc204fe18f12626b5bd3e4ba7acd274e408f2e082352ec98003e720c7567e54cc17d22ca334818894042e41f28fe2530bfbd2804de0425a3821cc161839c2ed3e4c76d08c5f2c23b362b4485c008e923313e43e7828f84336caf568b42080a098c79487706679e4c6e0046f66330a49bad26df6191d939f2f0b5d27a669792276e962df4b3ca8adc0df010f9861e0668f94613207244e9439e1416e2830f4448e88cd932c7435731eada38835ec137fc04f8c3a6601e76474de57d3b1b90452e9018a761f7b17fd1ac45a41300e475f7a02778468327e9807b88048dca90540247863f07801031cfbfc0a6ce0973ddf90347157a443ae82292af1639ad5236bbc29aa48d6fb75ec35e5ad03c68f3c21db31ea192985add3fa8a800380b360411da793bd9978946e2d9c3082859b8cbb0ae5c44bf9c08cafc100f92462b2345724d00000000ffff010000ffff6fe4672e424f0000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ff00424fbdb0424f0000e44d000002000200040000000000050000000000000001009001000004004c500000000000000000000000000000000001000000000000009458aa2e000000000000000000000000000000000000280047004c00590050004800490043004f004e0053002000480061006c0066006c0069006e006700730000000e0052006500670075006c006100720000007800560065007200730069006f006e00200031002e003000300031003b005000530020003000300031002e003000300031003b0068006f00740063006f006e007600200031002e0030002e00370030003b006d0061006b0065006f00740066002e006c006900620032002e0035002e00350038003300320039000000380047004c00590050004800490043004f004e0053002000480061006c0066006c0069006e0067007300200052006500670075006c0061007200000000004253475000000000000000000000000000000000030076a400354f003555002d5212cde98ac860d857c9684b714a7f7822553a722c2f96345c9bdc150e0c6c69d2dbd3f188ca9a0f069d45c74c464db41dc68014e5562867d457181d36b9a7e456e49660146d835f90665a9e7d04877efec914cf48cafab8f06925c35b44641b85992277e3abc0d07a922c09df98e8cb6241d505692a01f6e5d52b320c0e86ee3880a0cb2ce5aab3c31bd642dc4950d76649a6cda14904f6de87d02bcdb101d67715a333a62dbb00e9b5ab6210d462e409cb8b015c2eb566a9308e190367acb22df47d8d0250319727cfc0033d89a9b6ae6e7d40c0a440d8b1e80bd8729bd1550abdb3a8b20898b8f92b282caca95567c463b4e331ad1477fce3864cfca239b692f180f96ee88660d9079f47768e142105a228819d5c53d5c84a54ef73d33364aa267275eb4efddbfc5dda7de34c71b88cd434b0d2c135055715b865a16f0f4037dc409802cc1760c36d60ca869436a7ea1c5009705ca37166913368143c404119d4c3552651d52ab9b5e35d589c2569b52c1167077f21521e42d2612f321712f132217933920fb0744d85c20a0e45170afb7a45da32d1a014cb9cf070c2a84510eb0560b9f532af29c33bdf42c9e082f4688e50132acad0ae7d2e5d866a45b7bd2b49f82a284cc6c7f2913de5197306e45673121b58d14e96e23a95c7f5a0121265d57f4f6f197f080fa55b148a99e0e1f1d650369452a81abf363c6b8d5bc80ed67c908a459ba5940dd38549f8440471f011c0892ee5b49b5fa6b9e4a8f542ba20564c2aad9d54b4ca852018ed1b52d7be96695917566e0305722bd3d2c71275d882ec5a60cf6d8de0ecb6775d3ddf8fae1237e274251b3feab07c9d5ca06620da86ecc0c203b9d045b410840b2ffa2405130bcf234a19b777893049c1271ccdf87cd42474a60609fe815c18449561a65130220672118e9b40317a6f949a79d990a0243ac780b3260863c48d7a54d125c65ca0e896e5a1a3ca4509e025e9ac39b52cad616c73d33343f7ba61ddb1e638881bb14a856d84a4bd391cbcc0ae23e4880a0b35a453aaad09260299380b934ab10f2139b1b2d0c9080f0bc38ee7831b8ac8b07b38ba15a5bd81ecb4c266a0e808f750f31ab1673cfaa49aa432025d262aa1eb2113682db39ad998435c398f5afd9aec9ae1cf5b09041934743b4e43746205fd4629332a444a9e511ea34ba5232f0c2b7b5ac58a3d36d165eee4fd068599591758c50f8208b80021796c601523e541adecff0aa21fb54ab2d8754f1752c6c4e64fc127da27131720cb24c8bd9ab49c3b854b04e475f3d248ae407a3a9a8abd54d3ac9b24f1905c3069b7040b9565a061f5b80b26d1f606fd1ea24f2bf3a949df28927f54f75130614e944349e14d56ec73f8bb3d671139c66d9f7df671c1f1da38704ee4291ff89cb5b75fa315bb6f69b691af0ad514d89d443ea99b1414d4ce74b2112fea6cd5677828149279047b6108fb12b0084422afef8790f75a829e8fdf20c831f786b828cf64574efaddf0b5721ed547a2ae1b7cc34da545d758a706aba471db9585a03ac38422353951293365f3a3421c605ceaade5a1f159473e580e29492027f8cb5f5c6ac9d08b582b86d96f9de4837a98ef085479372a617528d6ede4cccc421209beb540baf40568e2eddaeda3f0a5ff9cb8614038e7a7ed48c30fae7b2fd4ccc23cfdbed4fcfed66262b46cf06abd8f8f030f3673ea209276d6294b24469cfb5935d745ea448029b545005a05b633561115b88066ae5326193f22c1fb9586d50dc2b0961f7d8106d31832513f99a109881a6892e5aebf841d810716f136341ae5c433d6c2d2592b34578d849aa3ad524020b550e2dbd1af320e594aebd4d98cecec54d043c99df845632ad7d734e26736ff8ea9ecbca45b0b33160e02117cd84311f3e001acf959a97d988b0d650b52b91d105f7cf1b4a21c6f819b4549c97b91cc631df2b2f909259e66b8c45f8c5951ba1257960b5a60de7d3c51e1be99901c24f98016796c4e08f3d8cee5ac35c6293cc8052b4d057a4fe30c14c48e8f3e2e08e41b868c827fd98f158914fc01ca09cabeb90f91300466ec65337831512788f670b06b5ea2f5f22cfc5b1a51c0d3806181a7b5d81f6c66b2402ec75d9a4a596f3499820c5fd7cd049eb9ad86fb5d93c483c2393842098278e9dfe7627f080288147fc2f9963935be22758903489410308e1b53a83fb609d271de2b898620c054d7272861d53b3a883c1f6d9248dd4cbf6465a14db702cca4c21ad19e046f8532535146188ca13263fef718a500e6289f3a42b5e16cecf33a80ade0f82887dd3d3cd1f6b84a16e62c8920ba0310f6896b2a471be2329b43c7adfd24b6f7ce5c099a11e3a05bcda970ba2e2b0804d67c8d301c8bca4c393a47c33e62bcf059446f98563968642c98120bd18fc4b3a6916daebe54ca655832578c0e82b35e71fbbfd86af63e21d22a6163e2a57ae53859a2f82227d5f7723d8008c9cf86530f95f12a211e5c21e17681bbffbdaa34a297495191b261ca87ade9cd1a0600efa38c9ccb6d8aca69ace252e9a90fccd0334e1025c5d1fd044ac17890223e378c849232324d7f7e1196b6cabd326606b6b047e425212971d741cce10b048f913828ff0a8862a776925edc2ad95b705c20c63674b5f10a778a431773b0fd97d2902c17a71d206cc9660943d3b9eb691fb915536306448e972733cfc24d829c258ea966a0cd61f5a9b0209df34bb593a3052871912a1ab24da50142ab9824f1e2f44893da06075cc9679fc2edab70fa8bcb9b89a232d4828058c74158f038193c6618987250282b43437820801d77333d581c498b03852b02a74a195357733bc32824eafdb67465133970e657da76b9440c6abab119a61762147b249a3e8770d8d028d3a96ac4a96f4266ce430b2023cb4c256366b5d8746ee970657b5cb7a1eb938793c6c5371da20a2cfac458a7c4b150c525f48022d31d0137c558a25edf5035812c4d13097685181f0ec99375cacc55c63a41f7f2f749b9315c161a8a72380180a4e4a96945f178e958f78fff88083b645334f466e1c7de1f8149c3bc49fa004f9352a10492dc48340d19e02846837fb446729914148790789e623755d08840f2fe86e3b429797e630838a2b00be8020938a63dbdf1a36f2d8e8a2bdcdc6ea08b00a40233b9b944320120fd84ea201cc435884c6621fabfc0f637dbc182e5596486e21101033eb5be0e4ee279b90e0b7da793c01136028ac8f3f602a5ede290ec2bafffed39f7a3cc54e46b8a292445d48db44a645afcb3b0d99f62f8cbc8c117940e59b60351743910e4081b04cfe230156c1e3f2c92e28ef0d21d90472029eb79611b474119dc191a3b3b4574afd4471912a2008ae63576c18ad50e082172f5c21a19cfa8a826cdf704fb9997484186b474f2c148ed8851499e1a36ed00ff5f5b00af85efd8d59bc4859aa74fadcd821abf446bf4d9335192582cc2c229fa2b0788d202ed6c556227e576f2ebfef92f9b0234152fe368ed3806c850eebdf008916e41489e9c9307840729adb90a4f635bf3cc000c3b24db30660805f3a8a1adb8b6596e17144b309017e382c4737e81bf6b525f9c6706419d8b63203113fba1789d85e1bcfdc24720baf1ef2960421600ca085981927c0e5c078450a3c638120e81843066f3e280a901d4693faa36218b3c1007b207ff0fac56810572d0768382e5a632ad79d114ebadc4ad9d0628ed63bb20d4bea19168ebee702d2da830c452d50655c442a66f8d034607c54929994fc5a6c4b3326aa21b4421597c609e09806aedc42c1a0f0f9d220d04b5a665632931a3643a6e2aabeecbe50208d546dd5d867c1872048a7f3d1b8f710c0dbbe9cf0d09fcc90c806c1403e526ca236e83760ee28118fa5bbe0f1a679b4cfc695ae82cbce04950b78895b876832ab3935dda2fc20a130326ebc95f412e5040aae66da1352a57f1665314caf0a6185d132a13b060a0678135a500cbba7233dd81cc9d5d17426f0ac168c4e911abc772c92f4f44888c12b2586b8721904b0bfb3965f0d425e0ea8918e1e4ccd0237b0337074170050b97c31646e8197074023ba3e8b233cc943d10cf2429e440e08c1302b35061772698a808a7e3716c803dd5a172a71111b08790c0a44ae3f3bbc0ca8642e008bef0b2f8c51b5da39c126eb40d7585a30fb80426dcc7b84a41da017bbf296d50f4dc0f810746e06c2f97302c2677aaa570d384be4adcb5ba63484b1ad9c26d0a8504e45ea972f46408f2f09870ac1484e1d74a04d4026109e0c50ab1619982265bfdfcf77a9a82aa1c4118c286277e281dfc2fae275b1868766fab5860fa65a3f112e1c240d1300326ad666bab879f70a864a864bedb11f168460c6512f600ab54f7ff5d9101b79825512a16a4afa8b61fd5db2500524291eade59acb5eb861f587a818114275232f3f873865c386685dc3d801f8868fde3ee7303827a47716e7455af82223d665df4a94219c260857c9878165ac5b42cd72819277b241024d317964c5953e916c5948217bee26fa4f36d002220b3503ae907fc1905a5cae9dcc000f401e41950a893f204232f4a1e44abfedf1deee9a5c38af464812d2cdeee853a4e22ae41db9c7b6d22656abf930c4add82c713a1d4d21e258743288a76d1334d78c73a9179b5414933c2fd35c32fafb803216f94075fe7f2bdc8957e67c585b1b7e9757b91d8d87df08159b4c52608c191e1bc498372774882d9a520d1b2a5da9e89ff9d22ab40938ddab376e73461d646fff2a02c09d62157a7a6ab458865b82477141294326844da248fb321c28542101d00a04bf8a5e6c5171b295622c810f10a7a899a75fbd0b79e4cc65e958d7bfa3c8eb5f037cdc22b90801ccbe9eda137a4d3c8454621fde2095576ee8f2121960397343dd6be832ec37d24c7f862e3839ca6a19d85b719c61388199b26f263c6fe38c12ce9f73cdca3030e0a40c3991c37747d1fe1a3d296d621b2a2fa47387361c37d80ff82a0b6060db2645e494774c12628cc1f22ab8ddb295ce43c16c917638938b2af25f376b876c1c60d2066506eb62e2fc4e7d601ec20d70d21a2c397e76440d3c30ca3466899d9306ba4c0a8cac359a974b60e8dc1bb235f4bb8675305d8f23a30d2732893b8fd5f5f33340ac6582a0c8a80d60118077c4234767d61b18208a8cb04442a577703b09d774b29feddc770cc3681ec00e27f1cde14a8001418f1ad1be4147ac3f0420009357c52295350a8e4b805daade7d91781bed483081a812604b73e3b012481f05c30246481b632adf6bb03ff27a19d1dd08d5a0b5d647d3b6574175f645ebe249e6bebe97a2cb9202b1985c9e4a3c9adc88463f838d085bf300e214f8b44501ad811ac64a52d6c8f382094edaeed1311ce40f807fa4d25808ae5e4d1857882ecb65c951b29578388b5b488e8596cb08c1a28a67cb55603810c966c00124e05ec4e6fd4429c04e1e9d74053f18be73a7e65bac98e09dd15d242a2ae847fef2eb3611cd51ac0e911a262db90b6cb646d75ef594827028202b687e12327f0769dbab0638abd813dd6d1d06c4e921f244287e48255d9043e63a76a164de5c0a1e2d12906071f52a98f81c900c8d9f452c4e71b03eafe500ae9f203ff2cef92aad021963fb1852a81fa67e86f2482f5eb3340e464a0f2363cca32003fd1ada22ff4cecfaa1e5825892c4e50faaa8b06e27f9b4afa08996fa93c010c9604736dff86ca74dd2881331c0cb158f9e04059e6c0338f2fa68ee8e43364a1385d49da893717395907e460e64c24bf5a9283045682c8d48301ca423a6b628e5b1a35c505058340505ae282fa2165222d19368b462e83245b2bd27da7bab4594142e305008b1608816032a3c9d67c9d91fc65b6d3357a35bf32b82c8922c9f4de62075b032fca4a9c912e2bd557e8cbd5a084ebd151ab042834a9c8461af7ea0ca331331d32d19ae2c454e963301406b8e103c58445174d3ac62047b06e1942dba4e465a8363595592d60b105d61b3086d5cf81d20024f84e20de488658c6505a61fb9e9b03e26a4c60b20b5e449e14e4109202584a09ec21c964c90d018548e85839e9b645e87dd1c3c9d05cc6b0002f90341c0b0f220da3abf1e77b77aabb0d31295086cd428056c2223ff4e7c5fc3d7b14c51dc0a3b615836aaadf51b7541390ef4222d622c90592dad68d01b0129cb2a7eb1588db5ce8176b0c8818b0499f9371b8797024413b2481138a7e9e9c4c5cccca94f4ca776455891b409679fb6d9f7d1fb1853b9675e5662299914120293eb8f027d0812bac21c593f964a82dc469d21c318b912193f86768ba679e5c016719da0180c222955b0cccb35d62acb93142d7ed811623ac41d7c486019024e1e8d10e5d7678003c4cc7af9f2024300196267062bd48d3398a36474ae121dc980c26308cab90a8de37dd2ebd02ea8ecaae6290ca1b6142125d495e2f42cf7ca92da42c4d0670f10e55de3a457066594477e82799c07933b08ad073aa7826fe220d95603a21cf9211b61231c90775f5489d93f60139a1581f5a4fa3474473aa08091ccb417de3022c4e3f8455f227bc8669bd3369c380f1c4225f1e1cc2e9a543824daa10b1c18ebb589afda60c35018757dc8a77b119b32fbf06f0cfde09735cd384a0887d30f40e605f8d88b340416ea77e0b101888a142b52850af7b62663bc3d2a3ea2501c755f88fc2b8fd4cb71a5d047c81dd10b4ce4724332210794a8909760d7863796d0c9205b3e276933010c533e28e427d07ecdd2fb86ef0f16d07d5a6b2a1aae02bfb76a9a25cd3b2c2ddbb187ea468017386dcdc5e112dee69c2215abeda69ea341b55c15a918c1265c9449f0eca46edf0fa482c5d77400a684689cab660d93447afcd44033a70e349fa90901061352e54082491150c921dc0a4e5598254f298d3aca57ef3c13e91b1bf685c966dc8b48acf912269031a831cf7d0001928f2b3302f88f1069f11ebc59b13ededf1ae345cdb50c1941833e13be7723637333c13463dd447be3297d9fa101c53fecd9591a808ee7c2bc4002b958d40d97ef2c44a781c22aad639488e6bd278a78f6373318bc4dc98221f431979326f4b97a9603866fa87e7193c59fec20bd947dad65257c8309ecac00f3b23a65846eb00424f7aeca6ad3213980adb5c4c36f29afbf412a1266544c7dba3b7d2ee1c6b3884ff61190359a810122900fb427e64a00390ef5ba11686241598e829106f7da0bd4fe275d4df92d7aee6e3f2e9d83e951319412dc0e1d444478a634fbf61975c45a5bf58d80be686f9e13bd481a76c92323fcac880e0c0e1b7d33a7d91b8e491f613e9496a2c00bdaa2c1adf318ac991034532095553aff5bad0fd8291119da4579229e5a2181419438f083086fb16e0b785288a0a0fbcbf9104763eb130ebc7baa46e705b4cb3b7ec50334034fc5d7b547e413ff1818180ed8deeb4ca039b5834fd1216671b59291c0ce733ab3c325e1e2e022be95ba2fd4a610d05c180ca0e4c639908879045913b4455496024df482360327208952da1402afd4545180717c5b0b74f0e6301b0820c09552f10e3aabd25dd5ddbcfa402425665b6dd968e46a6d2e4286e112100081f85899667e94e2faf3c398198cb4206a61e3cb988bcc8dc0b759154c30b99d588ac923e57a72ff864170128e1a8a63ada3952074ea72b68e77cff0d99d3f21cd8d824b4231087e067407121a6283df47eae35f524958907aa813fe7dc780297d49a5bb321bb65c082976ddc1da03192853889f5250abe0b2cd6ed7ff2a84501216c5ba0f7bdf018d2f42fd9295ee0accaa5e359bde9e7abdfc8d31a800fb3fa2071db4e29851a90e1e6a7aaa0cdf1df8e82c44fbf147ea74ef0703f611aa76920d03d17c803f0b830fc012800af2453ef819a94c191219577c766602d1aaf6c2e6ab339388aff4ca40493afdff20477d6693b1af7c422cd9192c5128b5c97f15ca5d42b1211dd3fc31d855f6c5122c65addada24eecfe71db552ec00d59115458334c91c7f1482702c94e2763f7c937e188a1cbff50eecacefdd371389c05818ddd73a75fbc4e43522e1131210b0d7358f1b224b5194daf97c02cee7c383c400ccd3ed41d1a7db29f45194e74a9906c58d4d37be98d7486c60b2046a4e4ca8a1431940001de21ed376abc3400e9c5e245c0d622905ecb8d0cfeb9eae50eeb1201f0b9c37002122e860ee492cc968bb8ef0df9c7ea98a9111233b6f140685b8981f7307acb076402825422ae1f7115e9aef06d680c26bdae8fb3b28a83cea0ea4b6c4a01fbf8074c86b24f3791f99e6c0b5121c8d6f9132574ad0bab1e959a8f651a1e563dc0eae91e8643928f4052ee244d2547b92ae717318a40885e90505152d9d55ac812844c2d902c8431af2309dc6541046db045d234c1728fcd987db905634aefa4e2db24b91a96e951df532a5253f7bed47821160dc1cd9efe6158f3e837e13ba340226f59ba6b61bcfbd7f9319301924ffc2bb89b7ed33480bc1c08f0dc4ae051a67e1fd7945c56b770261642210c9277821501f0193dd505bf276c153da09b93ee08c48ee72e44e4fe2dcd87a424edba4beb6c865944a08d0697b61112a9ee0abd9c55041eb334e6ffb74dcd351c1b20836744f4dc05e3f194ea47e4f868ddf680c0a63c12b33c2782d43c025f8b60bf7283ffd016992000ad3cbd018660d618d3d350c2676df7dfccf4a0585060d1a7ac9f0177fd0c88a910f9e65e0ea117fa146023f77a2d5ce823e13e4ad42d091cd942a3adba2fc80057cc028c335dbbf140b14c6f0bc975ad0cfad6022f5e7261e07f40022df9544159065a2ae3ed04c5032c14a4859848b766315ada4495b846b89818e3d5d45a184c213c3cdd1700f152209a12c43a2ee1f04ad6e8552d4aa90f2d64ac9ca96e023825c2336ac0a20e0c8778f9853092c2b8a454b5eae4a18b8468d9c641a90c36e4c2f19690882203adbe6ac2499bc5ca7b907523798e56592cd2baa3afad0e9a436413598fb1be07af83a607cc7c4e3a1f42ba28d75811124eb2b250ecee81b87cad104533775169ef36d2b8ed2013611e334e8fccc82a6ef6b0745eb97309996b2bc9fe95b6b648d26896c70b7632b51d30a1e1bff2642026c6f080fddf100093c56acc094238cacdcad24e0483a6ef4cd8c5b492c05e11f93800d92a921f27dd68991fed5d3a73e5e69787bec7aafb18b024b91f3516c871c4e65f2e136b1ba67e61449a45d6737dfe9d231d2f69ae08c7a4036efa268c2200351538bcbe3764ed69da32850cac54107159e150824ca8463d34d0ded45982223f19db46e6e45ba28f595ee6e0390d5a02a0b71e4e4a37d16748a71f9d6904ca6a806921c995d12d0f8d5a277f80e432c870bb5070ef042c16b3f060f83a06dc544f0b4d36e2aa54c3c2c11a5fab269e74ce92e6eac620d72a816fd906f798cc3703aac43bd7c3dc0647d86da72afc08e9e58a67d83f34a464180d745004f0ffa1491cccf40089468a26cb281c77d2cb57b6765bb8284f1488a9fdf4244b4088cc46ca2d7ba30b980d64b143071d196ebd4125245b41c0db15372c4c64f4f4d2e2dcf847e3ba3a32e9c667d934ccb533f4cc958d78d02054e9b8e7c1f73c517a946e4d60ee10385d64b1430b52ea8a4c413cd6a69b206e435c0690e1b55aea356786c810fc1e052b9b02aefe6dc180b1926181b70a6be2adc343fcc4d2410acd504fe3d28f93fe2b929535bfcc9b13e522d8d87e1e74a0466d75a9ea4c96d85b1244ed72dc99d8261b3c21e8816c8e53a12318f5ff4cb013ce93d2a16aa68d8ee18825c2c2d637559506f2f74202a1c81c6a1d943caf8eb4f2c10f567f8785d0822286aa5bd7504812759d9306411f1d3a14bb52bd4d88c776bbc1f1c823a0c79f62ba0a4611c440bd3452bd0b70698c68b1680b2e0be31a31e3ac17a68a0e5686cf4859438645f677b7c282c09c14b31f7daa5118fb1f28658b3591ac7e03b34f63cf0c063664b50303a3b1e64fe68e27271253a686764ccc4adb4b794d919002f31196851506e46143a248210e512676c9dc8181459bd1c009f3b63d2171400b72280ec7b5ca96631db7085cdce7316d31cdc8882b83c52edabae89ffa49e8f2f8a9d1fb2f7eb25bac04b5d061f5a07a49cf824c89891c768f599a238f1a562fa969ea654ffaed8001f178ee408dc32048e5cabed474909c051d00d50f96cc40b57a878a963f4944ed0427a17015a443801a41d1d18ca4bf09a8379a50d31e879f844904d19dc76b9c126dcfcdfaa30cbc4697230537ae7691913933c96783ee9928761ea895590bf329a813a170288d67401da60f64b027f441a1507862e1b181016265a9f3c82a4088fa98b0f0a3fb0708beca3b624c86640d2f0262b260b074d8d852017d8d37fa622d8fdc69517772c1597f05468ec3fefe758dc9999811e64eebcca7088e6c29bdc5ff138c1b229f330f4ae8c1056434fd07a6f8af3d9ee4dff69147c4b1d61f2204540a3a467183d8e42805d1cba3f0c2338685a4d890d3af3ea45e5acb464b70db09a84b052b58bcb99196cfb86bee0654aceb40174476abf4e93132f8fc3d89471934689061f159d5cf1ce3ecd970954a159e4a731543271c119300664863d9884dc02d509ba0488c29136f742f359d156f21f4129f40f03dd738fa70631e0c0e378de963421c54687c1471dddfebd4d024d9e29f20b8b01f169ee2cae05f08b071503146fc04a4c0de71ba268cfa32ab4fa686b856f8a7c2e53468f83abdf88c3ce45634f52eb8017cd00d230cae963cdad17683a3d4b19df8f6230d0741b67a1ce95b74f56dada2a0b293edfd0f54a1d5b173e89a6d245e9e7e89a9f8d0b26b7f9bd363154d9970411795c8a02086f2911cbf8b7202184ee14281561214cc32453b9cee2348fea80fa24a001330997892b09f4e46342dc1e830497e6f6454d0665c8d2868102e747151473c60bdc16490e27c28cf6acff90442a1e1ec17d8a57c00212080e0d6b6c14fcc65e985e8a290b28fa7420e29296da353589e5d89c675c089531448581432a42b1ee00c107d02e5b34caa9e469085980c5d254acadb188a4f30840e55f62a70a1cd56e50a8569c949417401a5bbdf36aa47ec54bdf7bbf0b007ff43810159e5fd9caadbe67e393c7c0499c6a2dba91a26f007802be611cf95fa7337d185a41b3b725316924b4e3e20624bb1ff086e094b6bfcbf39893a5d60e9103d6880ee437240b58589fc16797338de72f82da7b8a516647a6400fc1991c865915cf1184ae68e88937c2053df3a2018f0d7259aa4e192c2f426d29400046022d3d2cf8c62c8af79250cfc5a9d2278dec58d362955600c93de64c14bfd2c50fc6911319fa525cf593829c064d56affb3a1c643f9692359f50a03726496df44e759e5e91f7c061ed129c900caa1505bdfbb50716a1ea93b2c011bf74556634c03aaa02102346ffc053ecb5a46cead0e578ac3797ea4912395b0684f31687c6dd93e3626dbce2067a7264b03856917fd5f71f6246d8c72b1c7ab3989d96756eb1b9b89fe466935292e602973a6268ecbb4890ce36d258921303c81ac023af0854990ec949c4be94a66914d05cdff54c14d6a10bcb7b1e1cee6e4814e5f43074cfe8d1647fa18912104be63ff4d192af0973590d931bf6e057e939e3003c50871ec801777a512217364bdc5cebb3f3bb412b69c4c826a1cfc65c0e25e1ffed1d4872d9dafe5301c97e0c83447421354e401cde44e5e1e2a4cb829785d23b9740f3a1dc7c642fc4ba46b00a10c34d1fbccdc10aa4257a0933e2452f6e7c7c3dfb85388c7321b452ac6bcbd3e3a5c7c14ea50fc550ecb0985e3e715587bc2e1933b7c0d666b20101e18ee9dce0e64b7d9645124a7cab23ee0741f669bce919accf036b22c4e5c74446ec182de45ec93c6a42ca5df6e66dee519204526aa00e369b3037169790634ce14bba8c9a1c6cc4a3f098a8db8bff527cda7f113fea846bb23b5fad8fa2fd5188f980ed2681b1f47fa8ce70d8db5438f140fe8078a08b62792190511ee87c1384a66ebbfda3c764da0117f94fb1e495e4eb1ae49bb03493560abb0cff6e1d610e34e62249fb0567e4794759f52acf1b0288b613f69627e56768f24a2db07edc5071411dfad47c44dfdbae273a759ed477b47454a38bf56a3f5f819d8b69a60c99d10e644bb767120c21f62c52844a8520f501884ffd95a70194f12030b2c117fc4dfb9b8335a81463616a428a4a05bb1c9daeb2472050d6c762834576d2f02081bcf0e48008084c8a8540e853780f3613cd0e4bce3974b9dce493e6e661604ba6153a585a882ab23d2d9d3809dff6f811082a0308a5110444167d7e51ecf2fc5a584ed79cae6862e64ba0a430faaaa16702602e6c012e851789b278aa0de891751951b3fa58559568747c5572189b0e5c5159f81742648fd0f9eec12acfa7f8120ab6354c75ae5960291756837a967431fa3605e28fcaaa55f0ccb00aeddc78eb2fc9cb3d88c4781758191f6096c50865640d61740e5f9b1a7157ac74a5096659bfb8eefa9db2fb1aa22f4ff5765060638964a051c2d73120d134837e061855ba53a03e7f190077cf152a3be19c781700a6163f00d5527a1f1ee3fc115bf3831a7f13ed0c8790baa862e93acfe064f97764af026fb6d1d99a807a3a28f0501c2705a078b78a4966d51f3e4f08ca28a52da607847884c981d96ce8478d846b209d07e0fc2c6af0489a8d9a46e84673471b656ece95b348feeb1e2eff9fe985c006c6dc79c2353c8fc6bcdf867e9407fb34ef672e28eb693ef00e1174e515314838f6838d3db8e1daa4b6729f11e121a5e46a6135eaf8941d3d4b106b45584a390c09dfc4b1f7854c37623c1493e6cab8aa463dfbb66cf38cf457800d2962b2d021d73da21870a42fb7365aaa1632e530805a8c68f8a38f02f02562a88aa1731a01c6f1da0ea299f1a80c3ee76c1bc70072d129c1bbc2df29406d8d3bea15d4a51d40cb309b039ef34f737d2c58e4ba9b4c6be4515cb0f1a7a3d0c7f6c00a93d6bf3a7c57d6a00cf002696f3395339415049579cf05998846a6ef2f161a34c3dab940b00a29f10441f09e62d631af9316340ff615dd60d94eb9a5167ccd16084df7df549a2712841f9ad4cb827f79416344c4a4ca5ea253e3bee92557797d86e26d290008cd0fe94394aeceb5bceb869d4436a4537864f8c806bfa2e171e5af5d8f1516ba7458ca32fd65dd7af25ce5ce89e32d7115d299614446964aff705787d363c9edeeb3d0bf2e2563f79bd4d4670380058032a3eb2433b167f5516fd86b956210e55aac96f147cda259c50167832c5b0028e24d460556c15426ae6dea9101b0d5cbdbf78eb90cb1ec31396710cecb499281a0d466c0c498c6bdd8a9821fb80b51012b7266a18688c533e0ab54b3f0fc09621ceb4819dde48060dd1587b2ffcf77516f89ed07638e084bd44b882267d81af40275e419938cfb6609f175a13ea7339edc02d01b13a036d75e23cab6e53298d09585c2fa22a3b09e09a3df544b2cb72343686251d30a442317f4023e295cce665ec22002e9722ec4506859ff7a4b1e482fddc362a4d1659d352e75cc4d65a4e81e5c5f00f03cda98d94b53d683bb41b37383c2b382371c66341032605d68a85be5e3af882f4caa8267eae847d821440863880bdc99596199bf59aa975140604a9ea069a528da89f62814080520f008f19748c8a209d4c93ef548d0865fa38d40d27927043aac45a56350dbbb96b5ad3e564a972ac8107cdb95a48738536f04a8083aae017350248ca8edc14970c1c4492b49b513dda5548cec682653a6b0379f5de2344a5ae014bbba5e89f16083776c0f42f1df0f1560c908f1821de3a6f08a887613caf3742b6c98f018ebab5f6871a05f195a66e8be3214fc8f8719ee64d876198ed0d61348de205d38a0cc5f3f6a79c56d443c160b7ec38279914b878549a5cd0a23be28a82f429b81cb854e81c5c27155cd4273e52442b46f1c8118d93b588685aa05ae9075208f9ba0ca76be9ccf1e0ac47d7a049e4047dbc1d78b390f336e647c0e79fe74b3a5e7b4307fe306250822223f5f0344479a15a215e396ab0a5ef80594ececf256153c11177053b035429ca1680936bd424fa0ae5687148d24f19aea60e3ee39516fd152f0f021103e13c6187a27d6449ffbb8d5dd59dbed069d43a7d9cd9bd7c81dfc06cd2a67da862ab94a19ef67a8cef1f97e302a9f969d3b7884810d59e28713bccb801712db8803345921a8405ba40ca938d8249d2062248f815c8301c0f8329823b03473153d1b9bea77af096d76e0b1063a9821a0fb8e23c3cf8e74041e678c25254f4403cf94acd1c5e8ea4da597e852d10fcc2151ed2a6d6e0200a82d29e05efca419ce9fe86dc3526929c90228f5153cb0b6b21b56beb25f92123e388791c8801d8c0a22ba8aa0ed39df45dea23e7377e4a4c2852c30714d575f21bc45d548d4096aa8dabd2678dae4886b1c7e0153208f2a096c312db099c6fa73970b952eb131ebfdeb6c1c05a9cc1273e9ce6bbd28546e6502b1512a8993ab6fd60eaeee2094b5e9bfeab75c09c4aa2f67f89c43e7878fd2947539c9d1a4fdd8314084b2c7855e3ed39d651ed02e5469187815378254a4f3c384e6a379aa666a89f8a5ed5d29ab5cd8ad85f53130d71e08a057118fd421e471d538498ede5851627c1414cd1d8b7ae1b3c56664e47fa013d65696057412d134647f7dc326dc622c6f247a6eded82c23d340fc534710cb918172aac46551d431930c596503fc82ffe1008970760aa9959b3539ed307fb38a80144116e9a9f41ace108ca34a3a440f5761fb5191238cbb3f52902e7b0676221ba042635c29ab743710cf227bc9c9b756024a2b6744531af2632d4b9a515228dbbea636c07a1085416bdcfacd223cdcddd078b8a83691319c9d3ad2977b8a9c0152b00af4ee7887075357845ee66c85cf0efb5328a83c60399d8f04b9dd860b8e8dfc97dd1b529204ab0680e6d5313ccc978db8ad1e46ec02daab065c93a0116c158f5b886bd61ac37293917df7d3f59b0f97bdc81526b234f2525258395d6e7e19f0f515f87f4658332455a58e597cb4e9f0a8c5d8e0310a0280593df3f120c7b0a02c26fcaf2bd20cab186801a0c1f42d84f21990c22154a4013f2ec6c9e32a530bf875be4abe261033102923222abbe827985b3ca8766a66ed0706440b74f05d44047e3f2e48a42ff7a87af866c983318a55cb3b2cf60f047d7c88a09c8a7d6ef3323c072d84569525325911a2d6be0c134345df507b081944986b5a60548f2ff916a3a8d9085de0703524fee559b70896c664f0182d57e54a308de13373568b1260ea7e4c952ba4a954bc83872e4cd020830303e9b09318c23d4702afe4490dfbbb6c18a3c60466fc38155a9cd4da8e067358020f09dcc570803f2a5c0ba9008afc6ff7045a70cc4975402e60278790869596d2cc125c80fcffa68544d36bc51dc0cb91d5508820a9e35260b2ea14bb464517026a33dbfd7cc012f33347603bd6ee43b92721102b587a2d0efe811bfb5c1c198168d7fa52ace434420f5c4fbc45c59b4139c6c93207134b9108f0c70277a91c4dc88477d0f1885815b6393ad2c5e7ba1da8c415f35785aab3079246d6e8c04505818371f8f8f46a76ad2c9d72fc2144d0a6d71cfd650e69621ef871feaa15b24831b66cc365abf06bf71bcc55b155dcf97ca59f3a49231b53eaca98bd8a0afd86a8191b6c3cb951585b53ed75ab0345f06ca61c6bca1bfe010c3148c605bd401d045794f7b2dec6d0c9d3d9302874aa2db173b616ea84204469f24ebcb809b74fca7588be66efae5837461f9aa3ebc442e35d7034df171f1ef4320718bf95b1e25ca76192ac88aaa23144760a2a063af0290712c0ed308736cd17ca27a5b1b849c007cd790d9f742ee8881a489c372d3a672aacfa0cc465f638c9aac13ea5baebd192484201b4243004a140b84a80f11c0f4aab52debce9d48c1a40d6ab947b0295244590119c78443eded6d50257e5fa67a425b10b8c122f0c63f9d027668b3dc603ac641e851783cd8700d866166ecb800e4f9805221b521b635947a55d7230ce44956d79561051377444fd615896f7d10b1ead20718350175859f8d9c743e652a3a3747afc439dc8da74daf3a7b677a7953a273ab704cec00fb891f9439f632588197f4f15fdb8d89d91ffd0a961929acefc2fb3e89ed8a1861b65e79b0e7cb2232a7bdc5bb1bdf4b5e556f7da5a28196534bdea52ad374c4764c7ee01fe42315cc3a621a15162daf066876034e890b0bd0793f448629e0279f14ccc9f5e34aae81387d24a6356ab98b02d846d804c5626179c82971daafa3ac090bafa21847d7d47106020e01f284c8d554e763f76cea48c5fabf0eee6f9d5f0d88543dcfd66e6e3a07d24aa85b0d0dbe3a60092335b3079b8f81f461b2c9c4a970c30d492d4977231c91085e9f8d696d08a47c9a405a001d0ce444ed442bbfdf070b8b33f6fe6d8b81479b4000e3e8fc71076feac2b34ee091b5c91323b615882b32f7c6f9c217730d385892f1cd41fb2561c7833859626e5a78b37b2f834dd13db5be88cb4426be1d8c4274afd0e98d4c8123b7e2baf903ba9a6959adcf4e2c040b60e9b1e57eb175e423559908e974884494b59f01ea3f17aad8849a7695670530d9450e854733a16448702c08fc1d2c80eaf6e554706dd780ab26e533c6af3949b0f96e5652cb695969a0b51361f68e128f78c9a7ad13c0cb3b2962d23e37bb9d9974564e30b1a97923aa291736885b856bc4491caebb56a5887285547f3997333e309fa0f74cce75058372ca1ff07019224d788df63ee86d63cefa6554e393b83cadd048f7fbd91801140690869560e5b46727c568d6fcc0d85dd06831cfa94f4a82bc99b1c1433df93373815f1e37bb4b5e6700f905d1a828b202ea7471c6646e5c976ef9589be08b2e8b8462e71235510310945f1d8ad0f1a61dc2e6bb49cb192dca95b3523928e463c1a7446293f1305c74c6d26cc5d576e63f1125169c3c41844ca0603e58167b1741019df864b3415a3f8686c7c127e81405ef521300f0d6c16c250b0fd5d34c322df03495434d00cecbfa88c805382c1be294d2366304503ec6ef42f6c7b6b424975f37c26f46123c7ab57e0e458ba7de4184152486797c0e0146720e601097e74286239c098b804f0b1ff611fb812299ae3b94ad191a515450149e43ef9e5e2acb904e0b61c3f5f8264d21221d51ba463d7f555cf7cc076ba8c3782277c1bf4ddfa183ec71be7515e9c80ba34fd461841a9c340e2a232f41a078e920c51fa44b81c51d03f911308b212bf2556ce105321defe91a2c8cf18e6bc7c0443c7a8281389609e377b6908773f8a71333d9b93fc2ac7029dde89227247bc252739c44d65f316b442a4c503cb813322b6f967aeb5cfb9df05b81388bdc56bd92242db16916087e972b71408ec7830993349c09435266592c910f5025288904d74b512886f911978e4584052312864ff016d508864fd6dc124126b448225815200c717f1f3ce7a30f884a0a8d21d1a943d2b31ceafd846a14a1003653536219861a38c0c3a3e036a74bb729cb20aba2053db4ecc61e28c24ea7e3c9130ed44e668924eb20ec024f006fdea60c88cab53dea45cc49f1571076aaca65f07dbc8730856a8832b1895ce800a9590b34ee91e86c0d6a6afb590d3bd68908b877e873e9d41ca3c1a8992ba33390b10ac642e92ecf11c2963d1a3705c45a675f41961619f16915640fec107f80125973e1862098d1cfca7117a268bd6d64b9c734565cc844376b9950c4cc05348523d26dfca78a295ed81c0b8108f5a633fb7908dfb386aca90a62d7d650705478108084e2b69b55bb539cab6bb4b03f72d9130ac1703a66060e918164b6f74e04c6624e651794d145d28823c7683239cf2949bb05a374c25482f977c62ecce7dc99df8ed2bff19e1e8a9a99dde112223c51e20fbc68061c67c36b1850da83108fb86b219004b317d0449171083223845c3103456a22508762a114c0a1c562266099e205847004e8208181848c13b87c20e12114c23804e00fe0f88492138840d0c8074060015481ca03982900740fb06dc10a07a815e0938078038a06b015e05ec102062813201d804a035c0a501ac00b806201d801c000bf6dfe57efbd51f35ff87e0ef93fc77fc5fb4bc1df1a78ebe16f1f3d52f757b6fd5cfbf7829e0a7dfbb7f41e077aa7e39b953caef2b9c1af073c76762b86baabb31b89b976c28493d91da7f5086025cfbc7a957eb666f4a8bd1cbb916f72220703387024e6c24e6c352682fb5e2eb562a3549df25292378403b510fa3d9a791cd8d39d92717125772a99101e25f948b5a310da211c2011f1f1899d17d9c1175dc4a3181ecb0a8f60a507aea263d6e448c89315581315419015371015288f15611e2aae30555060aa60c1546162a782054bc38a948715290e2a4414542414540e1457f420af38215e1042b840856e010ad200159f01563c055817d55cfed56af95557d55437554d3ad52a6b548d9d51a6754458d5156354438550c6154197d5025d57dacaba55d5c5aaad9d356a69aa2ba6a8929bd0cd17a0da2f7fe8bddd9ef74e6bdc89af6fa4bd80477aec8e4ade8eaad23aa878aa9ea1aa5586a90e1aa3386a88e0aae582ab1dfaaad7aaa51eaa6b7aa959daa4876a8d9daa2676a86ddaaec72aba1caab272aa45caa7c6ea95dbaa4566a8d59548ed0cd13ac202d63cb50b96b485ad516a58078389d5d3c8e0cad21ac5ea8a17aa1c5aa8416aa055aafc2d57615ab8ea55b54ea8ea7546d2aa2a8d51346a87a354391aa128550842a82215404eab14755833aabd9b55e0caaea6557032ab019556ccaa92655492ea83cbaa0a2eaef975764aab94555c026a5613029899c9d26727089891226224898861e61f0798791e61d8798691e90be3c05c1a0528d0278581242c08c160428b01f4580f42c077120390901884801a12004c480091207e090368381921c0b70e05687029c3812e140000503c4281b6140cc0a0610502b8281500c0a40604a03022c1802d02009c0801c020050081fc040dcfc0cbf4c5cfa62bfc991d42dcafd3842d99cd4a0833560159f6b7de208963765fc53abb2a10a11a5cf573e0f1dcd42d61ce17411fc1bdb5ae4f30ac210000000103016000178f2d33ee9b168a6185ddcdd67038cc82a1e0d01159fa82a28a88235510e24058276010b06c8eda5029fba5e2aa6fc291fcf9babb6e822b00b86cf178d1d087503bdd5878746d3f34390c40ab523bf0539b70e88ecadb1778eaea429d6b1371c292af4cab5e02733b98243ce681cb3790315ed3b7171125928100168fd8ed275537975a48edeae58f8606940062bbacc3e118f7f5cc9ad613eb2d606d75df1a0cc7afc89ce8037d85cbc7c046042c0a0e8ebebd035c9a57068bd747ae47761465466f425e71657a13f187892ea54a16b8cc2d3f37f8ded11267a42ef567a260c3fab6656c6f8e339cba1e0404c6a12233874800132bb6abe16612b9745280343d77f480e34792c44565048e88be20690878b05dc8fe0410b6f333a6d23ea0acf9c24e325da0dc038e2cd93aee2895f0b85e8af109064ffda9d916405ed3f5e59cbe9c969ebe090f4282f818a3dd34bcf07a4a3351fae376190998de302fac5e03ebd12bdf39b661a9a6e90f1d475c44098147303c880fc97332e880f1e0f1f0f238edc07500cc2065406516cf1648ae49fb3df541fc49c81866ed343c9cabd3a203c515474d4a2e183d2d781cede1e4aa51c560a290980635c4bbf0c884a0ae62d37b6c9b67e43fdd68b9825b2a8817873e32b9b914f0b069ea113244d9a0d1e0634b70690bcc80c302900f01c269e2cc463d2f3e2be998e319cf371f3e5dd810039ee9ea3d11ad0699ac78fe0cbf2dd45e1009794d2bfec9905b7fd898208f9b3ad89e667f33ada12649dd0ceb93e958d6ff8195bbee7316059538684b3c7b5aaef7a4867dc06e1c6c92d4c5794d8d4398bbaac3d043dcb4fbe8823a1ddc9ad3d74a7bf662a0fdba0a268f4b05be4e8df1cc6c869fc9e1aeefc2fd1a1f02c2ba26f1bb1f0be8118a5f6477abeb83d94fc948d13f7527997152c44e1cb26be016ca761b5f11010b18448d965c77d1be1e6dceb20eeac41dac203dfc62538c46343fa72cad4edd7bcbf254698d1c3349bed2c4966d5d1c087c2d3beba99fe1bac149ecb1714f587e3480bf4e9486922b298a479580d328ced38932c0ff22740641a6b24d0c1450f12004e8b2a16cb752d32168f096e92d5a31563ba10245f0cd50bde23899f0d30e3b6038e2a0fc1f38a9f2c473d7b5ea34d6ce51fd72210f6391879442aabd03b3bad586599d2a802b320359ace4ce3cfae55bc32f4c8ce25a898df884d3cb5c09e57e08a59936c3046d34711124c9f8b264d2b11fcd1b0826f3d2f6984e7d4680c0a210043b160b0bed1cb5d865290338dab04712381f7ff0b92e1d97a5db9d5c835b7e653a6a235d005b0260aa3cc6c02424900365272305177f65ca0cf098f7c043b0281d942e247fa44f89368d82990911c2b970878e5c462c2e4c314b1bc5a0862876d8496dc5e82ab2177c4081fd59e849060db0b036bb8805cc9c7040916d43703ac75125c8afe8a1a77290ad8604ab486893d57651508a22d98a60c87bbf5c0176f5d552550c0d32feb20c9052e43023d1930ed1249b99700c5f1dd3341449e9681448748a28ed77397adf3b214cb77b149a999379c059c4c79a601d743d10e7a82885686c2d5cccfed20fa95bb9dc091d02ec83298c6543ec8a89de453234f36278b3cd784b464983a3cc930d523ebf28a6444e12e088cc2e51b06d80c79406091a5b4bfc1cc03128725cd81bc2d045bb4dac5a3378e386a4b0d7edd068218b34cd6d4987905d56c2177da962c812f404fe5e6ae8d20484c6a45ada48253a0512348b4ec8a4815f98c5d418f3939ca962c7850f881a7118b97593532c91f952a8015a0b11f3b14723640d7d3e0f520fb98a29285f6950794ab5673106a82fc1b994ea452d4caec5499030312fe9a911a26c74f6b550ca914ada357481b35429aa8c3c6f5315e0b60053876f7509665397a48085e8515a2e9d605cd04cf2784911989a0cdb9636cd4227eaa7691915ab40a5e87288333ac5878d4a95d9a8964150f068ac89a625c3566dee1c3cd43945887f506518f0c8b3a5a5ded72c324ec7de309918569ce9c65a00c8af7000a5e134b54ea9452f35d453019ea1d02203a528f6e7bcbee0a7316c71927b35eebb35d9982b87a964d2c4ede90272640a02a74e5a43524271a4764cc467ee3e4dd8f8552a662cd53de74dc980a8f01ee6436df28afd438a1019bef40e6481df0620129e193b26cf47675ea5d35c9900bcfc86ca17ebb34462a33d75b081e0ab319514f0c57870de463bfc33fc3828ced141eafa7d14009e1214f9129a2888e7cd05841d60d238b49e96106027001a0aca566dec1c6d424e60fe5dd4fbf8925b226e15804c0560e431836ebe708e4b0dbd637445937a3908a517aad0fa452ac08a83cae7c8b477dc0ceb9d9e9d419532ec9feb6a2d6235068398e6f78352f3c64cce56236513dddc191f08e00bc3fe6dd631411fb25b67e7d639f7041894df0e454dd9380ccd9841b263361c84e39dcc46c1909dd3d7393d66bd53b4c261fda500f510d4934084d6e536a504f634b14c65864df8b36a11ba5d090df8d5019c784e5e9d8a92101186a271a3b97a126810d95123c46cc72f702d48e3d89c6dfe0c642dfb25369a459bb22046cd23534b2ec1cf74cdb911bc71b0800af63a253dcc19cac5f8b4c59046746a111eaf6c3a5b4bf87f32d07296acc5460d9406b21c6f5ac6c31acbc069936b85fc459dbcccddbccf81c68aa0503f1e22236b0bc2112400016d0db8042eaaf1880c8d570bb00b4673077a511b241c390a1a68b8f663a3d3531860030d2e9af2007d4956b8293539953ed01cc69c537e94228143d5528c808f105dd32d219f261bf111451d87e07d370c07c18a982af3dae0a093a1e4416f804ba4f4450dfe47bab9d6ad64887f26dbda92c8e27d15658106af863438fb3c30d1c93f78691311210026bcc4e01b2fe56e68691c00eecf2f7047405d71cc5bd0a4b381a14a2d4275646767c8afc6145479048e2d5e253fab1e81e3b7ef7632fd74314213c9cc41db10c8b06bfda287ffe765ba8fee0810db2a4d7c1ceec3ce436ed447549a91866b94040735a87b416c34a2bf4022814a0f1e481c58e4ee16cc4a31029089068079d414d01652007688b9a036c0e1ee290411fa0c89ccbe62fccf1979bcf1d6ebc6b435e5b9cb2e0d496b8a1f5568a33b21be92b6b29eb96d30ccd539c96a671ecf724dc30eeaf857a308617a97260812502b5fecec5745b3ada6ffb9e310d0e01e40ca049db6eed31c12f682c2ec95d62878c506ced9b1b8a8d96041fa482ada6a6e0c1c4c6206e6ca131604d130695b506af40cf3f2447a5a13e952f8223c5656432e530b0e22edaccd1986ed552a66982021c0756ca03dcfa825de4f953dc089defac9f01b29aad92a5562c64863360273bc93b86583bfb09c5ee00f509cd91bd02f2390214a5ab4084717b614568c796a3100726407192eb6ad08109462d05e904a9874095f7629fe224829168c438ce4b8d84466f4fd2e44d0e9c690da18e4928bcc003e132027b14ce08877047e1119ea49f320f3e4f305f248335034ce36421c4b42a98510d5095462744a03cbf62c0760c8993e3911afbc195739a9f4a8e2340fa256f64e04a2568e8e6c31974a43cde5e69333d2a4a3f55800682b545266eb1d49106ca8100476125abb259a06cc8eec1dc9298a2d8e830c0f829104ed2fc3a13be01a8572fea9c39d7ab6c9f3b59e96f216962d412aec04698ab14c13043956f99a48e4fc1ce01e5797171dce78f2b7a7c1b9e8a4534858d265fd952511262c0a06e46c0eb903080787909c74991053c50f2958544dcd8029ea18c1b96cf89525fbb59112983c648b1f0a26451a39bdc791bb6ad93dfcac38a430250fd33aa397187f2f33b347182591943d72013d19f301105c85c44e4f10e95dc2538965c4e800c6c9960d8a215f9479d5cf68829b9e3d0b9e395accafb562e78a8bda2a8a4b145a348bc829df059656940f68cfb580941408e24e3f51dad889b58433419b34d1f0598703faac8e345648838266c7ec881bba0a022c45bbc2e866f7cf7505016ccda1da94dda5aeac649eb303871162c4a54cb9378863602510fb26044150c99236020487e0ef5d822ce2a9d5252f260903909b88586b4cc9fbd9d14fd8f2bc5950b5218e26d278c1240440813343d48268d3ecac2d02701265d668eb61e786750c0db936629ad32d20f6c16876b484479d3e0bd31ab2be685d015af350109101c184d919f6a1571b71a56345b0d81a78ddf87268f3751bcc509cc3341472fec3a3d00523d35a491098295c6d790a21dfaf2f2779f5c9ac119869054c11483144e0cc29bc785757eece5ee851a602cdadd26736251c73a14e737a9d6d90802c59fb10bf4587848901659ed8f11f3208cd97191c808656cadb0a40ca94c7001aab2cc32049b06a873ddd77054f62bd34ab9a1836e0720c21f4a5226f8e10a8d1f8327e95ec7db2648a1f23293f21938b2fde09358857070b19d04b23ba7eca0538e04d3e9165d5e5e8936c4bd6105c43dfab9e8e57b40c06144cd9c69d763a0252a50d3caea0a257cc1d9d1a11a27053862d16c292c032823250e81da8d0914236a41588555b65d9a6b93717789452d77734a9b8871c86f9e868b2376de5cf9df812d7e5d5bdf21409f339de0c36487215585ca5ebdadf68ca810744d333549dc2ff65013c86b1b0245fa1207758a0a785d932a6387fdc54594d15fb83c95bc1878184a1ff4c8998d1b70560e760ceaa41e6db8adbec74e2f234d3d1e17adcc815d312aedb475e633a88f7b8d772bbbacd284ce4de94b6659a5a59b3d16e7106052515f15849596e0a9b310de9a75aa3a758df47eaf2ec46b13aa5aac028c394159b37565009e9e26e0c4f48296264085ad6640199a28124f2f4c9b01d6628e03c2e7bbcca3a36b630f2706b523ea9d9065783b919715d3f031f2439e8833e9c57caed7a48dd5edc6237faf06259386bb4ab2eb49a480822dfc3be7aa75f80ccb1b807f80f3cc4abe34b3031605fd0926de914cbb4cf11a2993693f92e76b40049a03221052d19343352a15f0c4733119fabf019ca9bead661d2e5a2b3656f8d961aa9de2e210592a60cd6094ac23194165a8520d44d544becb2d139e023514f0cd7e8f65bbfac0c83bc90057cf5c8e89e4a640c4860f61dce517d18c4e346009136e43ca844e04f18112a6c1d00c3a44cfbd2318fbb92b38484a66138ee2b740689431796720e5a84158e6d93373142268f28f855af2f50427476930e202b471d911a6a33bcbf7ff151264873777d19a487902b5045ac20c04c020a2cfb2558514a980abfd7b0f9a0017ed3db4a5c8eefc98448850bc4ec7d20828c952a6adfd0d576c652245ee91e14fbf042c056c6b9406548fec0d17596a088f7009632c06384e0e7612684fa2017a64da03adf1f10d925fa4b1be298d880503aee8805461106c45321889f92cc929017389513b35144a367d783a3e1bb475a82905cb1c89571a808ca9e4d57a380f5e243496789ac2f00c2103db0ea7ffc10212103e38c68e56a0b2d39651ca0a6288810dbe55e80f71b3c4d64248c228f3e5db38419dadeb104a1211da276fc266d14344b31203716d09e274400a19c2b14819eb1abe89e4cb1037ea18916ea170b0df340dc72496345a4706a9448e8b746654262ee8cee8b722d388324706d05e3628f45545ae275695a8279901fb30382eb70a5adfc583828c6f1d005188146d325b78956ce8d269830c08b578160d19ab68e48b17d02c849cb007b5f804f1b1fb98bebf45c7d188fff6029509820e8c2212286cfb4b374b96d1227d90b99094a79377846d5ca9145d48863aea19401b321788b8bb5be061079b3f05e86b94299d1128134303544ca67462f86fc90a95d4f36ed7a71f14ce416e66c86f282e6ec7e47e612e50a19fc60f3ff081408d99fc028ac6fca57cce6c6804cd56a27c3a2bd3c71c9fedfd1b8f6609fd06a0c2315d34d01a4f8ccd3ec259c4420aca068e5423d36bd8c3042f792f60c7b8c634d1f945156360b946ab194c485a9c7e1bfa87326d99bf0a243ec28112811815ec4ced3c07ce7482dba500320ded48d370d0fb0c4565349dc5a8e012b72c9139356ac7037f59b1bd77b3fd01f411d6c0007a6d98570b08e0760802bbe2c6a00810b5b627017845a411729cd02308d3a287484adae0de889f35ad18eac9566c533183e5d4e8827e03e610fd145a84bc371420a10fdca88daa3d65ffb98eca3fe31ff29ac11fdf3819ecbe13f48878c82d823b17cec99107ee945ed355a24252fac1e07976e2ba33e04c457307f29207cc64ae20c16be14288d2b24915adb38893d661fb76247b9dd5c767b22f38160901ab193117bda851bf0e4a142e27df3640ce5b5bb1bd50892116399046a9036e60aaa09830c9082c998bc01100028b0800e0099a051608991c83a4adc967a9e02cfe6d186a2f6b8bb18513333e2a1998593ed2fd82c746d4d904021a1dfd7092260537eca88572582e895a56f5c61a9d2bec0e86b047b10c4d39fc242142729d6b21fc8ff18bb387ac41867499832d1393047b7594ea952d285b37585aebbff69104b086eb062800acee89e850e340923192035b930099c41ccb28c6be26565ec95eac101209398e41a8fbda023db925f61888b64870cb4051d2366e810faa8b4f9be9fc65088178d25a8d4c57ef270bd2a27e8d29ee555989bcf7101f503ba3b6e2e32f401e71ba0547e3b50a0a7370a509345e278c4341098fa90196f27137ceac839cd805e893ed8f421180d5cfe83182c9baec17a8dba1caf926c74f9a9920c093e081fd4c589f85be75c9eec5a013d13204cfcbcec9811202afcc034551f2294a43364e1754d1804a18dfaae7d3dc9fa1455267bec8d0c748a7c85688dd9af9591886bc24a706aecd0c4fe14b66d0704ae0bc9467e33c1a6e83d1a8baba88cb776a49dfe99c207e65d28d50d9a94fc0a63d2b5c887e67c5151ec682baa4a8f3884a00eb3e719b0ed9cc97c00032326400197764ed2be01426d93c43447b279ab03f85e242bd3f387d3944eac6720090eae56010e7000a5199b559b09c964c8d09f848ba401394efde899b2bd95c5283430d9930859a1f2201c39a3cdad4f8f0d4a04714327e6e848d812da6943ad6c27b69ab61252c90b72c2ab212242c449a90810cbb20341c22159d8bdc79572136d0699520084b3234572b2937b234d40835ec9205cb0268816c35d9d32081587e26447405cf440a60cb8536e177c58f4534b61d007faa096a770aecab726bb79ba71c1485b9550174a81b774d221ce7a0498c08d020e8fe8855562770508615fd06db176d0a6c6010d91c3c41a2e018c00563879100bd1b32d2475229d8e3159be18c161fc104c38027f59d00aea8658976810be064d626488b6596308492d33316a82179edb682041c3edf1760971114dc9a72eb76dab20204d202c1b903881b9061282dc28f1c4841e5b67694025c4ddd1b0c401e8371cd9cc9306210628eb17f4fa3275d0a846db563b76c7b35f225bdbe480de421d1a72254506c8b8158760276c496929c5d8d0073ef6594b017a7ac03f37957a1bd390db81c3cbe49cd19a290227c1c63ca47bacdb61f00e5b2cdec5c3a19bd45f61d475ee379bc6cb49558c23b181edb12ffb9f83a2ae4a79ec8645056b8c86b3d5ec2d5869d1b99937005166229ca81364dc75f0b8216646314e346c0e05c63901fa989c0bc0afc8cb326d0444f938d0e1fb6875c22a383b5cc3d85ac0c459676380ba7df4a25c088be4024c227659efc858a0818eff5ec77c22b38463f9e47fcd0fd5891468c62d54040701e6cc21dbb232103a07e39a2981e61308b237223f200a3984803c443c4d39a6b95831cd7f5d4b9f553365e9c5236c98ebc95712d7d91285b4288036d1cb42d52541ba8fa7100486ad32d31118d4257a064935b064b4820f59b37a94e38a168ff5b47ac8fd3029a8cdd1312e1059432a990a9cda0d0b1e5f8058c8e012ab8536c97d11758e7ff54853715d7b4f0605ab9c9a29e37171f0769e843f150641a6b66980b8bb848065382a6ec212448b82dbbb0bf15be31301e1c1a408d0ec81f8de9af9d7f304d6425ca189c844cce66715e0cf3a6bfc5964628d1d4a9e9300ff17a102c34b64230f3c931b5178fa7da66544a418d1f3fd9dfb2d8bc591a2e686de58c451ec6e472b11600a8242b5820c15b3a47b4758239b394709b780831116c0c96d4806c68decb42499ed6f04a8a955054752a6497c426dff843a2a8391993513210ec0ea2672c783fa3153e8856e94785802cff3981a6364648c18440bb5ef70e79839fc0774a3a2d87d6fc7691211de65c90f017af13af2e3018e67a599b9053a3458cd62e6d56640a4dc204fe18f12626b5bd3e4ba7acd274e408f2e082352ec98003e720c7567e54cc17d22ca334818894042e41f28fe2530bfbd2804de0425a3821cc161839c2ed3e4c76d08c5f2c23b362b4485c008e923313e43e7828f84336caf568b42080a098c79487706679e4c6e0046f66330a49bad26df6191d939f2f0b5d27a669792276e962df4b3ca8adc0df010f9861e0668f94613207244e9439e1416e2830f4448e88cd932c7435731eada38835ec137fc04f8c3a6601e76474de57d3b1b90452e9018a761f7b17fd1ac45a41300e475f7a02778468327e9807b88048dca90540247863f07801031cfbfc0a6ce0973ddf90347157a443ae82292af1639ad5236bbc29aa48d6fb75ec35e5ad03c68f3c21db31ea192985add3fa8a800380b360411da793bd9978946e2d9c3082859b8cbb0ae5c44bf9c08cafc100f92462b2345724d00000000ffff03006fe4672e424f0000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/fonts/glyphicons-halflings-regular.eot"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffecbd6d8fe4c8721efa5dbf82772e703f5c8333ccf74c9f17019604c180c71660d9863f1983b3b3a716a076c51daacef1fc7ac7f3446692d55dc59aee9e19f5810cec6cb1c9643233323232dee3b77ffde77f9a87f3c75f3ffdf4cbcfbf7b63de4e6f864feb879f7ff830fff2f3c7dfbdf9f997377ffdfbbffaedfff3b7ffe56ffef17ffec3df0d9fce7f1cfee1bffd87fff41fff667833be7bf73fdcdfbc7bf7b7fff8b7c37ffdef7f3f98b7e6ddbbbffbcf6f8637a775fde77fffeedd9ffef4a7b77f726f7ff9f58feffefed70fff7cfae90f9fde49c37768282fbd93ce8c79fbc3fac39b41be81ae65303f7ffadd95f7ed344d68ff461afed3c7f5c30f1fd60fbfffedbb7ef957bffde1e38f9fe4e7c75f7e5e879f7ef8dd9b3fceff1b5ffce5e74fffebf461fe71fee9e73f7efaf5e31fff65fef0eb9be1f4cbaf3f7d1e3ffc701eff2c9396ce3902bc3bfef8e10f1f877ff9f9a7f5d3f8cf1f7f1d3ffe536bf0e1d31f3efebcfeee4d89f2c70f1feb5fa3f5f2e73b0ceba74f9fe423233f7cf98530d536faecda957cf10fbffc2010fffffedf3ffff09b37d79e0c57effeff3298dfbd796fa66990cf9c65a8271bca3c9aec06f9371b6f06f937e36fdc3ccbd393b43a8f68b66f35a2d9d8daa1176923ddf586b83d8f974dd1231a9cd0f4dcbfbc356ddf469768f8f9ea2cfe9dce621abcf4e2e4a3f8957f278791fa7a63c493d1b75bf8938df0d7f57e059a1fa6ebe09447c0aadf3cc08618ec4173f3b0b971933f686f9fd6bd7b62f7fe617bef8e9a8787cd9d8d07cde3c3e6d6a483e6e969cdf3a3b94677d0bc3cea3d4e07cd3f3c6c9e0ee06e7f7c52e7e151f343387ef8c36f2ef6e88c5ff97732c62d82f16908fc73b4266fcf5c585c1a4c947fc60e36a4c59884dd34d8c2dfc57a3b4c830b1ebbad2c7192dfc90d310eb21765439a6ca4fb10e4cff0360ca5ac63747211f33aa630b8f836c81de9cbbe0d8bd031e96c0c728d6ee401af9c952bbc2d0dd7314cfa349d5cc2469ff849dd88cb28dff5f22fea3d3fc5adc5a04dca30263f389007e958bf55cc1aad7cd8cb688234b065f5110f928cca1619944cc8b815234eab4e401ead3a27697932c92fa303514ad291b1593a4979c138e54f019afce97925b39c02a6e964b626481b17078eb9c8270ce0e1c3206da457598d7493a6586b6c5d54c13510ad53a9846b2c9550dd7e39928ce065a190832f5e9669ccb2549cb79169caeae332a4530ad322e78f0c1a43cf71cd186096377077e45f5c487d8c959409c99302c80b60bc1f0ae0e004c032e7da160b190a5a9b002c1aada20f202b3f9937e4319ec8270462023c60960124a7ac18418029c658f9b93de3c4edab145ed061f60ab419c0c3687861eb03a5ed38743f4b73d0f8284f1c9e38bd67d047c48d38395ec86f7ba5f04f7d81a7473c5a8b34fdd8d78278393bc14fd972f368ad1be4dfe7f7262719ad9b2d262fff9ba35cc8bf197f0cf2eff3fb9cb14ff76d4a198a5da44ffdcf5942562e0483656304b91b1cc08fa723bee9d651b7c6cdd17ebc725e91b750c8de9ee6c7a9a31c1147e80ba02d1b1a7b3e0890e47f7ad6723f67b932f5f4952747fdb67d20f3ce7e3119fd0922cafe15145e0da89be0511424125e602a4394fd2d2889458d5c3a13827cde1ac12fd9944031279d08ba25508358714b505de0c34d2f3bd92cd8d6f8106fb44fc9b560f9920497b08606b442c0bd7a5c156974cea48946662deb15b82469b0589114403c0a960488066a824fa3092e64af08260999e528531be5508719b661da6d98f60870ae111021d9050701f680119aeebc5de51faef93be2020f46f96be1b696ebc43d6afabe58644c79c0c0b28e3883fc16ec561934c73a6275848ccb4f9ac7ba3f64bea6f4ee70012a39914ad6418c7554b21352ac8395f9471216ebdc6a9d207d49f2eb2a515ef1bf817fafe3fe7a6b32e00f7d6fac1d1d812bec0ed1944132652184d61b2b67830355010e4ca072d1af9893e51d504997fbb3913f8bcf0368fe5440142734038ab82c2f92b6e9bb135754ba4b86c7188e1d6205211012f6adae344961016db49e586595965b25a801000b4a0270def0c892632fa38d07b6c81d3ce3786a4376c11e06f65dbbe627f9c52370c546d5e44ccc388ee5c0c6a7415b2daf94ab4e42e85cb63c32e5b68cc7fb825b02dc14fab3a02ffb243810057dcc2ca871f4fdf43dbe2f7b270d091c55e1d1247d092a08e18e7207b88b27a14a2bc203582b83280e0fe47c77d8f5b2fb0d1e02fd8dc1dd1b128a4e2b772a2a04d23b9e57c29b81580a3391b06b221028e95219ae20088f6c299ed94225b37cd2aef24f2ef933ca6fe6002c252e2297601c5f1ff9feaadd8ee897345a4f4a7c5a66d7cebe838197fdc04d3d00e45db39db59df5acc41f9fe1b7f491bbfd28dc7e946e3f2ad71fb93a8cd884bb58e53d7d14af3f92376e8d7ed087b7c77f3481a319dc9ac2ed6530535f86c02980ec434606f1c13293efc39f90771779340dca0ae2deca76236e70d6e4ce8c3ed3dbebb8b5a654bc8cec63ecfdae63ff1838a9f87d86311c8f234eaf031ee424bfc24086978ee40083cc1e83ecd140ed9d81dafb10b3f731c87f9f61dcc1a0498ed257000e3f7da365199e3190efb432f707f2c2a519bece48f2b75a9b678ce34b9666f82e23790ddbe680dad9d747ed6a2fbb4e767d5c8e621b84db78c03e82dd00ae7f5f9bade38369fc4551bbf415a85dfa2ad4ee592379c640ee2ecdf140be8cdadd1bc9c196ea7a872230f3b387f4efed346793872cbf104320a1ccc25d2768d892dc9aa288d3b7f5a1d2adeff2791c9cc9b315c14dfec9bbf5a2756b29d1653bef1f8cfce0c5a351dfbe78d83bc4ff8e4613feaf72e59172a5894fb41136a9c5369d1fa517bb9763d4a4d7449b2368c73db4fdd3a06d1a78ac42db960268a7236867857626b4b3421bef8dd4f6a4d4a09dee42dbdf8176eab0de20dde19c3a90874750363458da6eaf3c16cf4dda4150350530994eb29b9d596dc2cec1a2c4b8d0c603d5a7fc85ff05e806b0212c89864930e880664c02f2890691c00bfce2895c37eb81bc27afadec096a58f40f8b131f246ebc69c5284619067b07d8a9af92ef29c9b134f6427995a9fe02782ca99627d5d23ff41a4dd8026ff125f6a54d3ebf87363add3960cdd101ebef1cb0e6da017b79be1e5992659df24e0b69b8795cdb286a9cc00960cea1edac509f7e7e9ff820b707b93f50cdc5995a79dd8db63d3b1848d3e7d8086403c89ca00e1444b3a1ee4828b0ace30273842f0394d5c5cdc09c19a626d8831668db42219a0968c26ca48b6c66c082cfa46fa8345dc12dd99ed876c12c1eeaa90126b5b058a8bce432e0a93c9ce59c583c0c76437103df74d2afb4cd6609d0e0c9e7f04f87214f644cd28dc71357e4a5d90c718651cae545f046380ce91fab9af9557815f0e1201703b6431c385fbe3b4a67b3f64bcb57c854f8eaf7ea3066e9c62d34f4c1fcc8518e3262e81aa17b9547f828f485308b52a7c7c9b201be8e1e46b38cd4ea62ff2b907017c32314174076f4899055f056b06390237a0efabc2ecd88e5c2e4b8786a4dcdb4a62610951cb0ce502ce43c44188fcc0a4044c79f517ea3d3f58159b0e00e07bb8eedf958db8f7cfde8f8b4d31ecd271bcedc9546961a7b093a6dfc0f7f9d6c0ad47e0a0706d334ee424f8efff14fd890168f3dc75b23eead6c37e2869e34d2c96240a8d92d4cbf2b2ff0273691d29e7accae7ad4623b079eb058211cbb382c4cfe4c932c0eb15ccdb2d86ce801674dfdb259471d8e9b2a33c521ea4d37e1f8e454cca68f448fa99f8f095b37dc79d44c68cd465e5587e9f66be5e6a383d56a2a21031499a36cb3e8fd2cff60bbf0953ac50b871977e14313ef131c6b77282108618ca2841900f315e03ff91494e2f1c48b295c5fb67c7dd50095ac04b5f35da74343ba8caa31b3be1d9b30aa9019582d2c7b464ef252e4167f46fcd22140ee676536d6b15ff447636b3cd697c7d6d9a89dc3261ceb270549e5b0a2fd489a5b6277b5899bfe875e6b2b6d345efcb16f355ebc3feebb56bc0a7515b114ea13d5b9b87bf0f2ddd80dbe68f6ce0cf5e02933050e6ac923b86cd598db29b3553d95829c2bd82a441d0b838b6f06fbe0042c5ea8130f3f0beb78621702a0c331859d698236a10221a4b4ef4f3c4d5d3b4d69b7c6530e55da563c36dc5a590e1eb2b74a95f0ed704f296fe36effa895c60e342683d953039c29426e8b51731c984336e0c5c82b3cace63e7d63c42bec857ce5b8bbdc351877ef8d5b6f63ffc2b87d75dc8632b6e10111f38688183b899c308ddab15ee0174f2c3fd92eea9341afd8786cef0bdd0b6a6c918d7eee38e6f486ac40a8ee1807704dffd6366705d90ce08084cd0d4a279880abfb62671acda16385dd4c8d426e85b4d2470667024e33031824759ae185ec825bf496a4fac631d97654503f1c6c335cdbb6d144aa096a688b47632daf62a5edb6d26eb7cedb2af73586906d92195e40845d4942ad8cac3125bfc39de0a687e21d25a9bd20d5e5a82e46eda5a89d10d564a826426d121431aac980631702c72e058e4d0c1cab1cd8e4c1261f2e908405b2b0ce8351565f051f9b372eeea8c7021c971209a5c84d80995d38a569b894feda6c86bda4b89bf711d0cc2399b8c16c035983d806303490ed91292272012dfd0ae45f3d293011f825c93f0c9a982a675992df85b0e39e6d306b70d4fd4af6a71e530a0f80dac339d3d2870ffdc8efa2fd3c90bb4f6d81be408c1e2ee4681918a7d4a07d04b73da706275330a0b61d91e76aec6f3c72693c72a9be8bcaf036cdcfa60beab6797ffb51bcfd285f7f14f45ba7d0b8e4d0b9e4b0fb56e86f85f6285e7d34f059befaec00646eef9d788e47e28c999a7a37db01d2b317b2226d43a1a493e14d49ad5628f467956bd5ab993b72507c24a4e0c3e3d69acb7321a8b08fb18fb2ea9f9bd011ee5a1b867bda90e323caf93da655b7929d57c90e63e09d1ca7eacd843d6b3749124404040d9a5237c92f77c80a3d97b72b7914002870f2106ee98ca5be31d5874f192a05071cf3b8711de0e2a7553d68f41e7467da2cb6d7cff79063e34f6d3f62f584d503161eba8022cf561cad38dec946265d6b4bd762d9d8987224df21042924fe8cf2ab8f467d56171cef906378ca17e13e491f326ba18a06775ff9193dda1cb83e199657c481339b5e9a7adaa18121bbcf1babf6869655bfa58eba671fb9038628c8b4c21b568406417ee2be489d2bcf0da1b76c36aa1ec3d0c34d2e0535e4fe487a867757a85fbcda23bf51df07cb1b7fb38b3d3975e9d3aa024195cbaa1877dddb28669c44a507d7f44817e12992d9a26976b7c76476b1335b10cdfe51ef0aff3b1a737ac698295f8734c7320427f883af387812d2d34cd12c52c91e79e0cd880d087e110e0b5b527eaca7df399882e2e0f67634c4bc1ba239ef87d9f49f556fdfa45619201c22f3e100d3d407e87480e1ca00431fe0fb9ccb5082ac8060caa2a76c004581cfb557ed3f94654a7ca0c443c3192426c6390ef20e2c0729d39ce127ec7feb885b5efeccd01ce62330ecfcee781c858776959da9450fb72a75d526fbd3b6bfe2fa4919778e7bbced7a98946b8ff2f547f6f6316f6f7be735fd83d97768f7af5fda8e06bdd73ed255dbf4b5bafaf1a8b3a9f72f244fb7eba15bafaa7c526d2cead4707dc6a57fd25de84bf8a05c07853137c1747bd5fde6e6b7b3b5edc0d319c92b83d9adcbf6c53dbf244c9bd107f27bc146e93b767ba75b1eaebc938fbe53da3bb6bdb3d92a6e7de8001e9b8612a2aa4aaa57b5c93ef9395591b68527f0c33ecb36a66d54f6a185f06b1647465d08ae2a72575eb8095143fa5fa292437e16b443f3da641ddb4b0b1af47fe988eafa1ef2b39fc5a502f44b46afe3c02875f475ec18b983583bf0eeaa0f393e7da38e5b47ed261db13ec222574d9eacc79531e04af6edec033d258e66e92e99bac986d924ac533815abcab4698becaa889dc2ee1e82961a629463c4f0bb28aa3a3aeff93b7aef9a96d94e9d896d2c6c6090ca03fe75c7bd82090d87ab192e78fe54351ea69d9cb1fed1cd72a9111e52a0dccde52a4e0527f24099c19f1aeb4fa1d83942f6f333cc43ce4719eac0230d6c9bba0988284acdcc543533741fe0a574284790bc5c4d5216bb85f12c46b845f2fb384daacd44a585d5284b9caaa10e6fb13b0d5140df845222b51cfa7771531e32aae0e844f7714fd4aaa8b2935476820a967e27eb6ca20e259d2e5e6d92cbbac933aac518fd8049503943050cf4a10236017394ad212c02fecaae3eafce4a2a1ad13da4760ae497b72cc5b1110640c4300aaab24dfbd626518d3b916a93cb44ac7421e2dc23dd92698127b02b544ef567945f861fcaefd82fda93b1b61cf54511d404294285a2a00778c0801047617418e96856b9971842c01fbd37d616a3bea0643a3df5706acca3c0520e9f280b123b0c80a3ab1a8c57b2b245a8adf32757cc4cb85abb50c880e00218e54c40aba2c2115ef1e44d62a7026f43e3a9475895a032992c4875d8b72ed1ee0aab6d46786a3ed10eea0a99d0293226954650ac5f820e844a19f631529fc55057184de539be2c80853dd80a839dc0a1a7593558b295316a6704f99decd123f0e40bfa970b10b90a72ce3644464cec39db540dd33219e20de5cc58779ad5d307a2892f14ba85cac819615a402702ff725e0b435632a33e42457a1570e57d50055f837e9a6d00ee32597138895c34ba41b5936505b0286c462273c8d502512874d6709242b1c9d3f63fd68850fae480d3863388d588a9aa6cf195088a74ba64c4e32276d163d9110907902ce09e068d18364195088c658d552b59aa08e25b38852b4b82a6d1c8f145a137a0abccae8066d0e8b9490d21851f133e2e1d45e6f8b23f49ce212d092e441af6ead115c3983376bf4942ac7d01f50c8c561e00e2028c84dd9d441aeb47f72abd900e4f5e4e421012690404cc83fadd5003013473a9110e07029f94c100b521d8670d4dce36688861b09467b35d11a2e4e219e1be77741161ea6155818b9202addc2302a42cb40b89bfb2880937d5cf2491e0e95dd5c8b46065217fa7546d3c63952a16f220323daef1c87838701e1a77865d873972d68c07375578c9a19e9ea1d1a12abbf0aedc9406954e3b8d35d768f2954a1dd54eb97e8ce7cad38dbcf044475f3e5fcf81a1b0694ca62c8e087c8c9c07bf227c0b0e6b4028aa5e7c1edb95dcad4739a8a92164cc9743e6122e701b790495780f2af1165408e603b834a81c727361ef404dc7aaa93b5635b7aa401df1deabaafb5455cf8e038f2a73dfa5cad440ee8301e4e77eff310ff8e0f343ff7e3c02c0f46c004c5f0c80723080f8dcefc72ff0193fe607827b352872b844df70000d4970761ca1697aee101eba6bdf0201152fdf6d004f4313bf0fc530470eee0f96eab1abbd99da14fb00ba7bfbb505339b6fbb52e9787708d30b87f0705b9b0e2d8cc129a21c8f21bf6c080f49db05108222caf100e2cb06101f45dded46708028e19520ca172cd13718c0703182fb88fa8d41701749bec6009e8526f1cbd1e4c57bf96548f2b53fff5414f9a6d3bf8f20df72fa6ebabffcdf928e7e27427e7b83a8dfc4bf3608ee22c18b06301c8fe08046a4cd5dd574b39315118dd25cecd25cdca43979aa73aa7617b3d95dcc66c03a66c4274e94fcd543ee6ae3ad8e18f02bac5db8c35bba2b1f1e0ebfec9ef0e523ce3a3c75cae1091f3ee266cd8d0f0f37bffc85a2c4014675eddebf0a129827a3c057c10083f51fbe3f0298672dff4b57ff7d1662f1d01d6968864de787009dcc4e03d4cdf1967aa223f4297b5fa0b3ee2a67066b87e0d6807c71ccd8e730c840bdee0af7a6e0a8090228a0bad75bb466c835ad67cc6008f50ffa806b8ebcfec45c761fa7b8d99dbd97291946fa65e69fa345c138e1f7e4ae811eb7a617822b290168003ea36199d069d7bb6bcb43842c437879543f4f76a729ed8c4a6536ba19fe8f0611bc055d38cdbb6442941b4ee382a9b4cf59edf690b3ce637209b26faed17f2132142c4031ea8aaa47cb2ab7c642f5a3fed6dba33662005838d20fc62d539d7cc8d3786be1b0fcb67b68210c3261c59af29a1e23d3404729445a45a29a89a5abd8ad1a8c98762a096c70619824313203987534bca9522f23480c5d318c7380d139d15ec0b66a082b1169c96008840e9c1bc0305da3096161e896d3e02e1bad1c74de0e29e99a4d6948ba2db2a5bf19978db7163c042a604d992d8fb7b5e1d8aed7b135e055cdab47a3133b3bc23cf7171d439136bf6c8d9f25ead331bae5e03ae7e0e11e1cd53db8dd1e9a7b219a1f01a8fb4a1a24938cb099c02e2c708f2b8c68c03c4cdc5859e922bfb893d6acc3052e5a7a8a0d41278ebc9b6b62ba44e00d732e433bbc665a72d24a572913574629d24aa1ca63e3220d40b0c5c100142b8ed1b6568d413005966ac719a87e5e88d5d8bab44f817ad15d51cd362bb2225a431f54eb05ecc62f6adc6096517e74588de396c8a0be43c9b259d021e853a26d16265d5933846d1b847a629642fa11da6e88516fc35c866096b1708e9ca0769fb9b47ab01c452ac4f09b0799a681abd8f7b4d7586e08fe5fef2c2e32a5ad630e558fe4d61c7294c3d7987ba6e370c74e1e8ecce4606d9b2f909019f53c55af55dd06ba7975b07ab372046835d5067562ebb89be0e7f75e96c3c10d311a7a8508d2795aa8007058643d8256e57f7204097d956341f86de6479587b8602341c82cec374cc4473e70313e0de627645ea037fdc260dad131129d47128e0c441f7c3bb813e60607b63aec3c17e417101f04e4729204ebe1e2e3f240da8c3c9593532ea7d0509cf00612c4c2ce44bff132bb286fc08fc485821354ee9a88bd83c1a1d94a1b79865784ee05126d6e351c3e7a05f787a8a906ab6589212d47cb969eb86c6811e99062990b41d009991d848ac1e85ebee9668965db2b723c7fcdbd8223363ae424c1199ffd1c84248498661235032f060f2f2cef8cc683c8bf23b06e8eb1485eaca92be219f11d27bbf36f6c3c9fa0020f17607ed13435cab096dc1266c4eaf0ebf476b53cd2665f028225b5839e95e4e23bfcf2d178f786755513bc4059e4055c0cecc849f9727551f3c20fca33fa401ca5bd79a44cda0400fc7d308d343d50377ead49c8ff6ecf28e7ef36bfee622948090127440a3a770778d4a7bdf0ce8330829ec77a51352a07efbbddfbe62599b3f21df396bd2f19e6ee5bfc8d077237a8261f03cd5f01da936ce6f7c678d7667e7788e1413259e21710ed4c648b9e49c505cfead5f90e9ec5e3fefa1f2ebd484d3dbd70fbc900ea7ec6c6b937a9767cbae9cab45ec14c8ec69e2f923d7c2d5bebf178efa45633355ab95230b833c7a3b89ed4ce2d910d4408297328f4029583dc234c13c76100f3256770fd1deb93835e738fe485ab64f1dbbbedc2828191de43a2af9870b254f8205b0d383aa49fd1811c3927e7eecbf428a01a6c8fb227e43279a70656ab70551f8ffabc4655039859598475ec0df23a6eaf8dbdabb17d20f325d7e2f87a5623bbe3212e78898da9b8b83a9aa97dad336d99d2b6d8928359b8d7360bc47023428d4ea6d6b43a50b6459e593ec103fa7e6a4c9afe8efac45e947aaa6fb77c7c5bc5a823a8f86f04957a79d1e41832a9beca27029d040e46f6261428362591808d48c09828431d90bc847a4ac7223670bf3f52eee4f0da96df45a79eb6de2f702bac4ec52b23105648846e7543590dfcbde15d08a191b57556505fcf269099442ce5ff27ba0ae07f96f1f583c6d3203f1ce85b54eb82e006ca9f60a01001ed42d7d7ae4bd6db86b90ae18b8f20552d94e0e1346d34793e39301920dc63f34ae533d5571acfcb2b833222f298f9fb024bff600e05e568868038017aadda7a016b6360e40ff4ae14f8e8991f220b20a1585935d035e16a138e8ef77d7c6d0befeb2cfca378c11e6666a687991e3fb7a8f9a74d3eed44aa9a555216c42053d900d1189536d6007ca28e936bc6e5863f763f3cc8bca38815b85c50ab089caa75a8a8aaaee60765ce5d3f5b9ce65053cda5d17a1b19789455db6e20ef9454a3050bb48a38df99ad230f2ceeb18e2543694016a478210c859341423aa8f253d5945025edd86dca1c7b648a668c0539f6e0a32efd181463a209bacd8e4344f63821345d03ed07e864f0ae6fc7a6cc056a767cd630a48b4d515a86aa5be4676af336876b925f452696ef9a730725bc90bc10bae880b4807a352365a8fce3939137760f463c192f1ef162bc7ca8b7eac323b8bf8e0c38df13ee3451a616e23ecc1991d36916a446a9b2d9d474afc2193338c21f81af4caf017ca05ce46e82c8b0193abe85318d7a53dac1f69928a31c42a64002080b4c060165880a7e177df3c62ae0f8640a197de10854bb02103eb1829687b6da5596555585f5ea883b2bf6a17e97efbb565acd43aad12b8f0413e674c7fa5c36750f9791619cfc65264212af92779908ebad237b4dd9528cb0cf1374a5b1054deb0d64a4f365b837ba7011be08cdad658e975a1c8a511cc56936219757cb6307d6abb35cde00c9422bb41db4e62953e932e690898665f9eb06635cec1d055e899769f066431b801516bec88e125173db51b833f2566d73aa69298450a594b696fdd5daeebc2540aa778e46d43518a87816a988b685b1444258e5a3ad106bbde3a6aa6b96ffd55a2ad3a3a968177580667ba1b671778ab97e34d3f3d3425da4d4ea79b67aeaa58b844cdf26c9b1f067725c65913c909916999895ef51fa010e6775a96b4582affe31a92a44068de1433b74a0efb30c24efd17adaf490cc7d4d758a6d1abeac9f7816ab6ba68d5ce43d13d968474fd2d0f25e9bce811a73065ba6c16303b27913a398320c9e1ea3d74a7dd5826551ce9345292339fb9941802344194a18e0113df544c88a353048140c27da214f87677c9ed1f83c83229f859d69261bb81190918bc8f81b689f426c36a13368de3ce857cfcc2563ccae86d4b09525ee45887bb20cf98c66a8f122b32009fb0cb44c5a10d2308342362d19432f68dcab17bf4f22d41456c28462292d3c5d0646bbf228772c4407861791c610b2b8cef160079b690bed11c8b16019ce3156392cf44f1258a2ae2210b478087b46e4428a4cb046b2e6dc22844c18faa2a5f2ac124b149155570a99ae56b073e96d4b724493bd15f40b1ad2a93956982ccee36bac9808a105cb9af508d59aa28634142d51a5b67b56f423b6b6e586206d55ff08d4a6a50b0da22d71e687204b46d20e44a7c4ca357fab4552c9802f21a0b6ac1309d03a8faa91401f3a49d0c10782452ab4cc42f25df9a2f17901e70345a18085e9850aacb1c4a4213481d6d4fdc8f1b3d02a708da2aad168be498308d5189b0b460e7c771655690118f00f1e74c2e3a0f18c8737baf0d58679b4f49bbf09b2b5d2f284c048b80ea10eafe6e256ff89b5a6a8c662d13dcda0b6e268222af35aac65a829a35036d150f94bbfa945b669a4b316d79dce6006fe294b22dfa509020df0965e139efa00466feb16a4bcab140f429b06c6d2a8ed95a15a35525fc8077046150768b1565f0f0c5e46cc4968bce4ea6aeceeb0ca21931719ba2022827bbd3ad3788c54985519958604631d3c93192e400028063cf5ce5e99609623b690fb162a3810cecee36c2159023693c6d23f488be5baa1b03028ebb972ae06023db29fd3a70a919bc84fc4296b2c31f5219c60d2aa9374c5c97993834796e8456c315001cf178d87cf4a4157fc3aa4c7224ead1df748d1b4420483e075a50860cf48fc9a2e41d3fc2e9ae61c1433d1c38fe432ea5b819e0b2be3a311918fc87c668bac386dd43f4f76e83aeab21e2269e3c980f94c9c8a0291e0cd98f343f6bdf075ace0ca0403998e1249c8e4aaf27ea66b10e8e30097293d6779af1edfdbf3913e55ea7fe4b49b51fad1c405ec982c240917f52f18cb082b81fe2e5ad75a5ddc41907af371d7c758bb1df79f1ab7118cbb810ded2fbb6bc436dbdc469dedb84d7f6c10d104cd22c058f2590b33cd0fbec5fdc36f2b31483f576d42d6d4ea4abe35ed1b83c2ab28c2541aa8f630354e86b57513b3099064e25e50d715ec173a310e8d2091fa53fb861f744607d7f4b696e055455cf0c3e2b50ab8a7ef99bad8e008af0530d7b155c41c6b85cc510b662ec85e8662ddacdb4da54fa68e31f23011b12bc9984264ff86f5bdf5e09d41dde41feb7352af13988a430843f69ae7fa4002315b25d5af8ba34b64063fab2c0e4abd7b7b92d3459812720caaa093bff9480e6c94bf41e3581785da2c8304afb58cadd1fc229a9183ae31de6df8618dbab908b566bde9a81d1bad934eefaac4835f134f50fa11464145d367425eb0041c90f01889198b58c38125a0410a23725808ff1a85f9c159817a3be0df48668d126a506d167e5d89d9f885c71a8e20d7180db879ca09a3ad176c193a20113c29cc389d7c5ca0e76596895d5155dd1294fd56a8fb837db0eb51de79d0234839915c6be857f7c8a226ccc936c5de483a6f6a05fb43deacc7b694a42357920cff74ad4ce04f72c6f8855565794bcda6dc526474220c365388e4f4e9f72a72405477305bf4b90c081bd51a3da4921e2d82162168c1758fdde0f82bd8e3e0aa152e8502deea4957359b81ab17ee2912c4855539dab347ad0a6a7df550169883639b61bc70369c9d832397f020ac1a125724fef39e3f233081e08e67e6edc33b32c300f5b362d550cb3e1b3dce85d7363d44893c856e13e46c50df3aeba1631592c419c2659872bb81efbeef066ca76c8900d330b55382bf15ecbb90c951df09ec4b6309c81189d82dec14a66ad9257a84ab3c3f2a4730ea16352c2b35e3036a65c56306af7c51d8fecdc40629f48cc029687e82a9650bbe9339d68447e90bc3c34c8135b5d29d688db0cb5e68ae24dfdb61d8e5a3a1f903dcca377ca34777b3c766f7bd95a5f84687e17687e17687e97687e97687493b1cae3d2bb77b2cb77b2c3787781bf1cc45724335748432577dcfc97ab3f74d0c17be8989d980aa0e4df580de7cae2a05f4a20283b5dd5c0df9504e276d59533cc624742e9bcda4ad2fb913320d1d7ddb1e99abcdaeb4ae9fee67817e7136e7a08e9b5e07e82ae40f133c1f0c7e9ff5bbb92e50496a5bce71d687c2f16aa8991caae12ca918630675aad7f3861c1f193e38a1e9f16871c80e1a29e395e14bc8e565c930ae02ab7416aa2ddfab1fae20b054ac64162002c5f63da24824cc514b34a9e1139453231040086b6082b2d776f747bd9636da04295eb5e65dc843ed4b4baf1be6e21af49b03acbc6697fde8417ad07cf3d101d89b8605ec0d1c08ac1abe85a34395aaea5c34d231488e243c10c6330fe19037e8c51493e05749738069435e99ebabf3d83bebdd8ff58347dd76710bc9e4137d8be5d8cbae6e98ec685655ad94cb61b658b09eee30aa0f3292acd12302efb706b21d5b1d16d97f34bba0dfdabfb2077c3f1da9fb4daf2428fc6891d37f8436109cb6b7882791a315dc5c3965d93ae015a35da0fd19a8b4a179d5a9b02dfbabf81e5d585dd2bae799d9f9a0555dabf0869005a04b8886fa40af89a5b96da9924855c32a787b62b45c3888795e1fc5399f4333c21fbd37dc7831dc8c9fbeed98175c9869978641c8b9a6550ddc43484e97544638588fb4334a50eddb92379f6a1d812d71ae3da297ee905cee12f59b43472bb315e01bd4433ed54155529fb6919946c55f3eb2e62c7138b2d2fd3d2d95ebb211da96c90c875467fedc9df9ab290c8fc776ffe00376da050ed44cdae8031efff1322020b70ce025e9c117d5cfff202ec15873910fd5bce8f433f74f3ff5f83c2a66e0b8823353670a2d80094524e781aa2e6c7e7413530b665557195595bfad869959f36f3a14ef302e2b8937d3b5c4d664286f3d3c809add672800989577f6282ee80b8e4f54628423014f628458699638d035cf24bfd5d6ee87ea1f6278c622f24afd6a84db66b0aa1b48db20d46832ff3593d231729ac96b23b202869a8b6f8c3547206a41c21869535c5a02cd46cee873a53a403ac18830935db71c795292960310ea3e91d05ca2f6d80503a0991a7593c36d0fe11b7498c3ec94b0d3415c3f3d5784e9a5e092af41f6188ceb83718d2a9a341bc61cba99726682c8886491281430ad48ec9ee115291bd6321da41c3a7025a389b39f2ab456520fa108cd17469716066242e5c0f0c7b7354613da8b594554fdbcc05ff8d55441e3ba7c54950041ed26840ccd5daa9db5494d60b5bc23ad8c51134da206ad0dea8700f3a42a9af4f4a26207fb66068764278670278670579c73d41c222f6d4232fd054802afa61608caa14f7ac15f398713cd1ab548259949705bb00d1d2d530f6f80d02f2c06f51632301601b65ed824c8d433eb8f3a37434f6184e3314c8d2a179e31bb6c26eff03dbe86f851ae021856d9f9fca54d10b54567364330547baff6336ac779d22b5eb46f8f752c3a34bcccc17a212b9a39f5689e17ee06a7ceffd816c5af6576b4a0480aaa6b4842d04cad520062a4aa744846138aaa8a98e484fd9b61e644f102640637cc73ee1bbdf6ba4c839a6368f2f4dcd8cee6c5d2bc052d1ce29955cabffd06390e461906a3be0d8e39276784a8f3501bb51a545105145566ac2aac1ca45087ea8c787648d7029399b19a535b3612e387909994a5c76c3879de219859358dc95c18724b5fc2bb72a98d0fc5b75303764dd24d09dfb632c115e81bcc1bc81f427cac201f2bcc4705fa2850ef075d83e2b803e37801f9b1829eb1e4ad52d7d17b17f0afe0afd0efc017d80f047e857d74b52abf55808f0de26305798378037887f725b8c7edfc734709314caff14736f33ad03373650446308357f74a912dc08133da0f50dfe16c9f16c698cb711921a4562a6babc39ea10ca031e1588c9ea882bacd594e13343627b8456438f932349d4adba004d62017b8a67030d4cde9f382a9329e9346529a734966d54a59cd806a8923efe2846da911b84550c42013f900f7518fc9e6a220cfe56451cf58d0059d461e48dc5f66656f16c072c8a78d281b9c73b2a1a3ea4485c4ab4b876d5a378ff4c091c91c892ad2c1a4263df97ea09bb6e69fa6216680df09380dc850742c1b5820dc509cc221c4441d42e472e479e71c2dac48433c1d8904bd2ea1723cac372c5c1654eb7006975e5c3ab17c4ca69f1b9d3c907c1b7c8d6d3c824c4efd338dccdf6180f429d2b246b04a897c17498669d992ced5aa3ea99a181661bde3e8491b2c731ad0944a41aaa8f58ccb9e751b7a4bf3ec540d567434d0d598c7ba46c00321669989783995b22227b760cbe7f723cbeb9eac20c6c27e142378c49abe6fb9dc00a6aefd24c02dc213d20d12282e68464749372bf1cc7ed13c0603ed333a0d540f43cf0b0db78366f0a79e1abe2e2b1fb6e5569708854efd9d2a925474f129562fef02717c3257b6e9c17a37b946388364caccea372eba85f2b15157ebd096058aa276e71458517060a68b2cdc2a1c5cce5af85ff50604e10a21c09085020e0afcce86f93132cb6a8324aff47c08e457410fd50f8009663435f8c4ecda66c86a82d71cf15400b165ad6d452ecd68ea08e5a3e868820e91038462c62c3b39c37787d92784ba52b7084f1e14ed164c1de8eb225d618bd1aab79aaa2b130491ffd35a7e4ec8f67ef621aadedf327f3f2832313828d98764a9b95b22f73c4520c6d5001cdc914293ce74f5536719d5b5572aaf4abed825d4430748d38b50ca14320b38f224c3bc90d3a182c5a85310ad17348b79aef16c6ab207a36e6b1373b4bb9a5024a3681b71d4af75a574e1b092735d55b0ba510dd4743a21710502549448acec066b37d145e6c94ce9696f40686498e9ef29357c7e9f108d0f4632710b0b74e60aaa0a38c7a21c6ed2937254c31e191a85795f056244d09c47b2541389afa0a3ace2b91a2f3a4e01c9343b7c629d0272e99155e2d547d0479c7d683ef9a7ad5313c461304e97a974e87d5533d828dd21386b421d2a62e15302efe91a8472196992d71e5cf22571548e51212b936f3c8aa16a7172cc2c0074115843314898eb456e0118b8b247dec8c6ed12953f6dd6a6cfda72d6badb6b4221254f97298526fa2fa8a064ab7f5ad4ea9d1b4472870735295f1655562da45e752fc294178fa35096df9c9a15e20806eee52b4fb95981f262183c172be2de1dd46efea1aa953a76efed3525fff261a0da385f2f7c93fe5c1703ddfdd3b7179ffc0b06870d90a2599491de0dca9fadea1e863909af0b93144cfb48b644ed0346ac2566562ddab7aa2e44b54920fe6682386a7940673ab3d234a5f9a668faaf99b20c6aa45050c009a5ca37da53081c9af5adc6277a154b109758d998a48088aa32539341d7d5a824911630c242012d385b18d050a90b0c033c81e1e8b4c2a112dca747ca376a2a4b37a9ab0fa01f588a532315e1003cc04377aaae7c641a46752a518ea6d097b53adb4572f93c26e1dc31d089cfd311f16db5964df5820a2da7de0f749ba5db4c5a307accc1432ca2bf23f2045506570e38a727236a20aad37526d0f302230c191cbbe0c314444dd3b78dbef3c6e0f3794890b5a0f150234055021ce1a0ea0096419d17011af58ff4c004eaffd449044b859586e587c28c0b2bf59366d5b23b56fb865a95d3cd6c9458415475c35a82b55fd1b3a85d9850fd506d55790dccb40676b3bafda9e7087ddc2cea54424b0e65167d6705b821770ce15cc02ca2d5025fe3815ec2ea8be92bff1b223ea69e238eaabc49d933f2b19a46108e953a624be7a931572ca88c1cb90f8c0f7f7446a7ea03156f7dc3f185c84c6eb5aacc75c4b93e6a0b0327989ea3a97c7e1f609533e4822d969d8a402c3f4066eaaeac0eab2cdcaace26aad78c74d8ae323b772eef124bfcb056e7fcba6464fc011932f12030c4d72385dbae8e2bd3342e35aac2a86f22fd1342291a1b00c915921015ae418b1609d7e4610377702f828995e5915c5641cd2203142b8746e5919915919a318689015b10fa6399d3d321cb03e05feafab0fa94eedfd8673353aca130623850e63c3d76fb61e9d7d32fbffef479fcf0c379fcf3efdec88930f59997f81a2c3780f4771ac8706f24e5758044d12f5e2b744ea3943ceabe74ae3f6261d2129f14a8e3f66e7666e713b473ef817934d432ab858c198e8f50999299698cf8adc372546657ddb6b90219bb77fd78291669b4cda1f1f67871edfdb5bd6301ae6e0de1e10aecebcd9be989c154bb02b10f23e4d49eb60ff6ab6bf130d88f4e53738dc59bb756cce251dfb35b8b7dc85282e5c096def6e21308131c2fe2041126c87732d2d9d46e6f4501c2f1b4f57704815d186b89df37e2d7d64f3209448fdd75bb70e92d58ba07e93a6aaf1921f19c70695ba02ca53335d8192a91987323579e6355568e2c704d14e7eab9511a9f47a515f83c705d60d48459aa45ffd5e2a9c772d6dec817f205aaca6ad765dd3acdb553a79c26dfd06e33c304e13236c107d7d1f79ca50283e5cea2d39d1c86b31c606055c1c3812dbc3a8ae18b86413d382b2632c1d3d07dd5d021d454f4df655a16ca101a92123b8b4307ffa9d621549e870d23ade9a0b2b200bbf1e9e8d6aac4a2022f74d9e108480df207d8dddd023c4d2b1ae553821ac1935b180c96a0181d33b262d06178890c21247bbd804d8932622d5e18d4c9aec937d12f9eb63be9036ef348250c57717ec023b001fe6e34ccc91f702e86e165f26a2b2290212210e035c745422a145c05c445221ac3c13f51de65c9787938c3cfdfc9e02d6108f5785e042c486c0aac46f494663d97355a147e6a11a4d1891a549f346628d011015f438e18cdc802632162463c07cea960d6316a0e98e810d0152684b62032161c3e40e065fe707cf779915ee53fcd610d2a9e0540f017a7bcccdd802fc162ed97c2496bac4261a2124662108f184b035730ac7d012dc578ad2757485128ce1b60a63980a717560e4106f21f32e606d4e3858185788d2588fcd84a4da441c28f58e3c3e0b7e7340c036060da00c83dd80c0cd1c34800419a7120db21a607ff32e3418eac35bd9a732a22837bba91980166003de6642c056c3fdc15e0b6c26541654cf0f87089077193bfddd4d2a0a339a3a7d8034ff938d46a97101710c308929c9246353293b9ca042a593193722167407f08a8ee5bbe5808423273c850d5046e354b8b1d4aed8b6ef933eda5cd669d59bf33ca019e6bc8a5b21bf0ebc888030d539d3cb6434de332adb1e747678a7920c1ac468105232be0ca788e99458e0c38cc227a7d7233e372179116e4c862f046492b33c3a7c2b2bdd4661b8663687ce5c8c8058a57c9a96dc9d0063456571053532f2744481a9c838c2681d22414dd1c5a0793bd79ddb110b58e96be6bd212fc42a967f103d50371eae9bb16e6b7466c4f615efa42c32982742233deca3f34d1ff0cb308f3b7a504334b0b5386d30b8ca81a4b0ae751abf667a126206f487e8fea00d6e759be52d46506f1d94c5556bd208c927ee6bad791a94623c8416765c7249a864659a07abbcf645627cd16ee3568ae320c00dfc7e72dbda0bc0c92ebcd086d19030cfd180330b62047c180d04ed21f1e2519c920b0ca4cdaee86c71050e88c0a36e68498f42f79328f3571b0ab33f2db8c0e162fecb3800a87a5e5e3adaa96226c6908bcb65aa8195501204c330a7565f493dc86938a34c2d9e2e3796c05e80f78f278d725af260e802d234d2dcf4862e229f82554af06d73619c3e2d4a3696a97ea3e84e749ed78db657f3ed46b7d6ddc7a3b02d92e81c6ec90573b239a1ee1bf70b034743fa1b5c222e21b5ed4586415d0e9588025d7322474360eb53089baa4b5fccf76a9823ad52eb13913538757af87eaff2b4d669c85c82164b11af2e126601135296941df381d99057a456ae4caf0ceb17c32e0914853869ab6bfa6ba06213a3bfd246c5388db83cb53d35b09cb8b5113b391a7c4c37caf0c0f701cdc5ed188abc01051265132748b63daae943552249585fe4043ead6552d2aa16a58d03faab95405957d53b8995c6b42431f0cf74cc069eac43d4c6ad095b3557d3d1668a3520d48a76c57bf50b9155b95d69986555acf9b9e09ec87261e80e1104a3324da463a72cc80b15a41594b180fc91e81ab82248c39a90f01794246e90b8ecbc6cd99d9c5210de1346e6e08be8a8caa7d34cd7aca630c1e0c5afcdf9d2d483cce487952aae71e51bcfa37505840a00f86b4209b0c7d154193c1fe020c32917a18dac830fc336a111c215079101b428540d402eb08da647c9d57ad6754d5e5a08a72a7c8217c53910313d89613121b212657a84ece0b6810463b29274b55f894b1c2203781391f06650492b0631a5c0a9c6cec7aaae1fe9a9f9e9e8f2452582f92f6c954a708221a33b26810b5af5a4362bf923186a68c6ad8f05550a86cbd4d6b0b7a3f09a3d394218525d4e92ba5f8d98247e4b4d481808eb326bd21df6ee06620ab024c4cc880606a240d02143d0d02b5b63934a433cb6f80ed25e1ad88aaca40cd848110fbd8925b343903a5c259e604619a85294c46ada7a19c3a85a0b9f2873c32e5c898898ba6eac1653175c2dcc654c4daa6905d492ba8f01526253030bb16ff00770add2ca21a8f90aa9722b7178eedcdd35d1ddc4bd787947a0b9a30ab894eaebbc4f757fa1b076330fb319cda17fb072f5deb6bee15bb8f75dbc5b8b6162d73a2ebf6fa5236cd191f95d2353dfa4e5255d9a98677ec9a8e0f22ee509baad4116c39188f7525bd88f753a6f965c36983bf0794ae47fc52a86426437ce23cdd3351aabaf533adce9539dbae65355baccaae91dfc5433e63d4fe05a3f6770694352fe7e1ccca73502a3c73d02d72151fda6263c27ef3b4ac50fac83f44b30d83dc2e1476bac8077acf2cde8bc7be64f8971fbb18fe9531a6db33ebc3bf04c8c1f09f535aa53af550e6371ad9ce283ba30c930679c6c6620d5bcd931a6b99beb4584dad83fe92722ca1e9e437e161dd448aa15610bc5b0fe60884f92a085d559fd1ae8bc861b29b0a3ef5d9dc2ac0acfbe2300f41982e4078a56c8c53109a3d08f32b046173c29f9d73032390eac55d08979b105654313b24dda0ec14491560c8b95391d13c19c27f2948fabec621ce1ab780e01438d43b778f0ceccb353e810c7c291edb1d29303b4250ae4338ef216c5e1f84bd12d81aaee08eb49ed13c4a6dea9444ab6f9ec3366857e78dce87ce20be605a6a2bac47c5d7233bc76e73bdb825f2fb0553d41d439d6d64c1e1b2ab914e5eed191353cb311d1bb4e80ca080b48cff1975da1649d0c43948fb88c00c78990d91fa92cc7441907ca2a2127a408015959dd03440005b54b48e0836905744242cddc64315095c7b30805213daa90d8dfe570196bca9fa84506fca0c2908ba8147dd1120dc7ee91b642f007b01571a7f591b130779389b1c1ea3e5033dd88600d74cceb414675508513cf38312afe234d78cfcda07bb6fbc20703bd450c781a720f1ed0aa8a6d7a07c026cf604bda16f3fe6ce4503f01234d93509c71949abeea6be78098011d6c7084e980e9266e0318f08da35900e15a6d0552694d643cd16261981eb9d2fcac091c8d43b1ab6311ee755e84525ffcd24fff613bfcbd460138c90c641bb9286a03fa3fc06967a484cb4a617edc9585b8efae21164f749913558d22064c1e41511ac22f208ae316688b4e12d732062c1850d47401b0b9451ed956bae2bae2e12535390b225a8b0e5a6968cd5f56c3a4145aad024aa704877d2d71a699a76e9475a661826b3c1f8c3338797bfdef0c28cb806195e98e1e4c6343630a322ec56c6c81499d8534c1d639e35daf2b5465bb21c69b45aceaa72174a85ec7c49b91b3f991304bb584a0d3bb5aeec367fb76119e6b6f3e539b349d3d7980d830b8cddcc6a22ea96d48bf5d4ac57381d982d59730dd451cf17f3405241cb1c712e552b4e79dec476a9b83d2bc4228c3e332c16e6158f040409064043d70977f6e4188a050966c1e039263b1494558b4cd5602c6add1ef979a62d50c4ec2a394e2df1404d3b104e14715c95ca9dea47baa7d3967bc00c57d3c1a06fd283d429c3c384668f4a94c5e60af6144d4c725f673e1679b56b3d23e6682736cc3412c0d55fe09ef297ce2464181ae59885af9285c59b3ae9a9963bb3b3c6a8c3668f454688e173a7efbfd2f423f6edc4c879569e85b7d654d5a21645515df76b7bc942aac2078909da17da05ccae0681b52cbc8d1bbb0750e86b5394a7ed6d50ad449fa6dd98dbd323b085af03365715599bdaea2930722dc8c56df56a6f06b974a86ecae606daa389c6af33513fed5c22775821f79f38e383d026f7fc696eec83bd539f14c7c1ed02a521d4044867d448ec691de99c7a2fada3b95f18b4676c7457d5a10713bcd0d75d662fbae843eb11379eed0a10dfbbdb8fc2ee1155ebd77222652d92ec9b7edd5def0b6b38b8a792b3bda935a9e27e564f6bb50436dc318a3b4f7aa64eb68ad2c8b1e1533fc16e94d5b4f7ca80faa430d0e03275be7c1b6a9814237e1c1d4818a8a26e9772ce30085bc65ad2234c1dbeb894aed1286fdacab37a39d2e68b600d3dc8909b50750570d0d1207875eca2c6201223dda11f4dafd709b0294e205f6ea2a0c8f4bff0e0a073a53fbbe2550274ac2ba7ae9f1a4dea6ba58111f91162ef471305184dc480e488b49f26cdee44e17380bdfa9c73dee2c069c09e989b0899a1acf454315fbae4c8eaa7d6f6718e48a9b30e0d0ec03a68cd611c2ffad0827f8c1a1b90b271742b6b8af1da684a5c0e6bd4716d81cf440fe21b83e5991e1c5d1e4178d3b5ddd315ba9728d5dc17aa0a4dcde2e52bf9d79d1d587a8661c8dcfb36d49da466ce142ea9da03fb8f991ee6d4ecda96f6bded83d5e494eb17f171251ffda3db37d5e664cf263a2a7584b138dff1e8ec0553d55f2731d489f0a08a2632ff7c0d15443a2b7549a0e28615e6a6bc34364960a3119249508d3e8793262b87922dad55fe8f4845205b7ca6ee3f5a78f6c271d6d53d0b5760ee59031749fa53a93bb8d71879fa948239a37f1efcfd8c470816a3101374850b995432aa7031b531ab7e47dae2432ce0c01231dc5088029ce9dc836a83a3a68554ff196b5b711726b2a1069c8197dca4a33a5ed8432fb2ecf675e235ef3cb80d06029228928015a6a986b39ad38c6f9e6e20d4aed408c49add22c239060eb37c4ae7d70937b538819b9ab70a1d8c67fabb009335fd89437a18f58352f72957baaf8b27f9d311de16631e9ee98fb81ab8aadae1928e6be2075c22410f2ad2387a7d7b122fe48ed40ee424f5287f082a37f048f7ab1eb290017dbcec35d73ead6197a3f639d64e733f1c9c1687442020fdaf6b1633c13e4f926aa93b82be0b7f5ba769ce163809936fa61f95ce527556a9979751af1b3584f869a80186f5b62658a3865657e1f8a8cf7e7fb4a84fd5cebb4279889a82af501a678e1f26f49bcdce6361bc603c1ed6503ebad5b98246ca9863f068cce1e23874d32ebeea06dd35f7e9aedf6c27953fbf1d6a772f1a6baf81a38fe9f37b4badb30378c42d9991527f3d86e87ccdb213ab56a1809668c15d7a91b23e452d58c16367a90edb8faa58507fb08cfae6838a167aae103da6ea4e8b0b75a7adb6b7ae00e4f2374f11d355965d1e31d33ef2ec4256311b4b79e93ac2a10f9be743e33d47775f60e9c55f5f2de4cc43b65e155bd7f75b55767d17c8e5d70e39b79fa97b84731760fa82b607a028af19142154ed0688f6de16c7bb2654a1df54c5c3c13c7b79d35739cf3a1e2ac2d5bf2ced6deec655321b33b3bd8393493511931cab2b2b550dde9d3457951ad2135c4a0bd98a185873192f45c6fda3c7baa5dcc46a539396bba2b4d912ed4d478e9fbd08ea6b05e8f3e9b6e9c4cb4cd3be44777542c3a34155569da0f5f70f2066ff1220e6f710db11653799c15f57d37c3b88b9d70cb134edfc718968d7fc7137ef46ab60ba560424de7c3474cfc786c1577c540f20e8ffedd9c57b2dfca1f1f8ba44dbd6b70f6f1d4130ec73be7fef28f97f2d08b622abae41f1d26bfd411e86c76474e7dbeeafe2f501c037b616a1315403c2c341f5297472a07a332424022585f75d6d6b110dc340d9096546d70ce12f9364a01bfca545a726ad42da22b00b43441606f6d05782411aa1b6857083502c8d56c25ab20ee4a4de2d0c1f632d4ef5ab6272258d34a4e240e5dec8c6be82ddb6503e3b5d1831388d613367dc43cefcc5b0cab3471b830c814c67ee96c21a5dccbcc3fa2c98c3609282cb5470a5d706ae66e51abaddeb8199ab1ffa4780db1b09ba1185ee1334a686137dbb12344e67b94dd5e26853956bd2c33f3826e42bd7312142b0b5196ba3dba391efb7d108d855e01e83abd96d4baa417d51ddf5a8896f092a10cf5561c4102cad7c8b5b4b88d5a54e59c2786d66729ba1b74e5b8e48f54bd535fb41379af6807f2f2caa80aac5d2391352306f41762af21baac1801951933ed53a2f8c2dd5b82a64e2452017956041b5898c210cc42da3ae894683f2b42871f5982d350b1c747cc8bf457522358f50dad19b2fa9256083c03aee4053a1ffeec75f7e5ef1fbc3c71f3ffdfeb7ef3e9dfff8fbe1ff000000ffff010000ffffe78e658082f50000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffecbd5b8f253992a0f7debf82ca01e64102a368bc53d3dd0b687a3110a09016d8d10a7a120a5d597d0af0a91aeff239d38a5f2f7c46d2fd44648447e4a5aab3350b6486fb71a7f362248d76b7dffea7bffccb62aeeffffcf30f3ffdf8bb7772e7de999fb76f7ffceedbe5a71fdfffeedd8f3fbdfb4fbfffcd6fff873ffc1ffff8cffff77ff9cfe6e7eb9fcc7ff93fff97ffed7ffd47f3ce7ef3cdff15fef19b6ffef0cf7f30fff5bffd93913bf9e69bfffcbfbf33ef2edbf6affff337dffcfbbffffbddbf87bb9ffefca76ffee9cfdffeebe5873ffefccd7ffd6ffff40d05fff0cf7ff8e6e7eb9f44eebedbbe7b677eff9bdf52f55ffe65f9f1e7df3df3bd77ce51feddef7ff3db7f79bf7dfbddb7dbb7bfffed37fbed6f7efbddfbef7ffefd6f7efbfd4f3f6ee687ef7ef7ee4fcbff4b8b3ffdf8f3ff73f976f97ef9e1c73ffdfce7f77ffab7e5db3fbf33979ffefcc383fdf6bbabfdcbefde89774e7bc0b7f6fb6ffff8defcdb8f3f6c3fdb7f7dff67fbfe5f66816f7ffee3fb1fb7dfbd6bd9bd33dfbd1fbfac8fee9df9866efdf0f3cf3ffcf827ab0d3f6e21b951a6bf7beeeedf7efce18f3f7df7fe77effefeeffef2dd3fbc7bee8d79f6e9fff8ce7cf7bb77f7e29c49ce5dbd73179fda62a50623352c12c5489485df3cbcfad42edeb9aba5d86d294b313bcb51cbd552dd5e90778b7d5c941a2970a1e8756ff9283adba64a0a3e3c3b8affa98fc299e8dc353877e11a9dbb047a1ac703cb1b1be7237e5ee378f47cbd7fff777ff9d63d0fcebfffbbbfb0aafee1c96ac8c99f1497a7c525b87852de7f5cf5e123ab8f4fcbc770563c3d2d1e7c3e299e9f16f7524e8a978f2b5e9f16971c4e8ab70f6acfeea4f8b74f8b9713b8fbef3faaf2f4fd47c1f1db3ffec3a33dba7015e72e226175261693f4a7f5528f7721ada118c9c58878e35359450a3bd9f8a6d7d5476f9c0929b25fdb9a9db1e282c9d958efdac54a95d599948cf5e92e99d6369bc35d32b96eb62413f25dda2cf5fbbbb45a1f8d3336f9bb44359bcd7a17fc66335fdb5c369b1c77e2ca2514508cd326fb465c6d363666c385d144978f12a61769c6966802e821f8adb7d564cbded814efd2969ab1be6d31f3a2dca5d537e31890848d1e97ad0fa0dca5ad8f29dfa58b94b8da00fe2ade58f1d5585fea6ac51563019af505184959ad778961061f8da4bada908df6b91909023c6232dea5bb74b15eca8b38c57bf16352bd53a475690371d93610d5cb1f6727e3632bd1c41657676c7526e9b825944d02a3b3a95c4a72ab78671cdd32356f950ed6d5199e5afda513d95f33933133c406e4e36a63340d388478978ca45196894c8dd2925845d6f7e50364ad384098dd5da2633e196f6c28ab656549a458a577590b479d45f1fe2ebd3ce2e2da18b1aefc2576a02d008f83436ffc780136bf580edd877b67c0f1d9b9255034f467421d9907d905bdc92ecc4f9afeec1ff0b1cd677351dcf7fb5ce8ba5c42086cb9c57a1f8cf7e1e15e6a31d185c533782f71c9124d96b8589e78890ff7b5b24f6fcbb4669a5f2598fe2f788025c1847097162b29184901f0f3d6d266d8b858092ff6f6fd33e795d216f4df999787f9deed4b4e178ebbf067b10918a6e4ae36a571d6b2776d75ee2ae3f44de9b4deb90f249a1a57a9d4676a36b6a4bbb409d8ed2ead39192f465c33b96d2c492635ebd4494a579bbcac3647232cb1508d2dab2d60833cd696b1356c1d91591159d9d634a40f665377c9c4bbb416673c7328e08a7097b6c85d4b77e95a15278a33b618e12b2bc57866a4249047634a586860139aa608dba7dda5abcdb5f55e96d94b33ba39b780b1d51fddf467800b03703e98dc3808d80312bd09d16f217a23e36ab9e1850dd1b323f5bee81e957d5facc5d86ae818e89d0be8b7b15bdb6a8128cf8c2d1b97b2d8b13f562bd2f6eab8014b3ac592a31376f4eae15e4a1e9db512b222161fc2e64334b695cdb3b815296ffc31fa7bb3b7f74711c38ffe9d1d159d812b0d7071a4940acaccd1f86ac487ad04b00a6bc081e572dc1893d72760c950f777562f6bac2637d62348d1518c2512ea26a2b8ad7feb14b7e5b8154e176dc10361cf74d9548cd5d170b430b6066ef41c64563c63b6e215474a62d638ef362b9c377a64656f2a65226b584ae45de37614d42ab406a3758faab5496df10c5c7980cb166f2ac771690b4d835bbdde299a8dc52f3654af47e62231191b63e35134bea4fd5dd24f622c0ff792ab29b2782367ed975fa3fd7b1f8a29ce2dd2006af48b2dc158eff3228db5cb9b34b895128df76d91167871b13eb0eb8bf1224b5ffe223c7d8143e9c3aa6358ceb8abc4a007994fe52a6ca450d835990554fa54b1a8046c005f06e2f1d1d46abcf8cd8b37b5eac5d6ba55ed80578e4b179798feb9d5efb75eada5de2b4b515bb6346d25ceb3efa4e3c7f9ebae1c9f7a005cadc871d6eea4e740fe34a36df557e1e557e9e557e5e557edf9576174234fe62e0f7eafbfcacfbf12f762ef0d7d3cebffd900ce46f0d2105e9e0671fb34241d1d681f1e19e4c3342bddc74f18e0d58b12f84c3ccf36fe184b19e58959271eccb43fdeec51faa245acd661f77a37bb370625957f9d6e98f37e64f775c023bb2f0311f3b93d395941071d97dcd59f75d4bfd251ff3ac4fc6b107326fe3add7865053953bf067080947f917e984fe848fc5a20f2995363be4c4fea2f35379fd08fb74c8df9557af2356c9b136c37b9cbaf63ea14db8d5a6e2ab9a9e3712f8e4e848306dc7b70d381e7dbef4d6ff6c930fea6b05df902d8ae7c116c573e1f226fea48fdbc8e982fd293932db5cb1d9a89292e11ee3f7ab754a9a67a8750cbc3a12c362340cdae2cd6bb6cbc7b591efade491cf25071d904a98bafdef8ea173b6f66b5fce6e15e821734bad847aff4c63e7eb957c89fb3dea4ff2e5cf940b832d9a7abbfe15afc605af481f5b77c8c62809db53983f6143628b4e3c7415b26b47d87b66f0d5156398376edd0ae0aeddaa1cd7770c1069e7e40bbbc0aedf80ab4cb0eeb03d23b9ccb0e64f30194053e41210d43a07bfa0c82535c020475f7abcad42513826cbeb07398949c114f06157d5ac920a68412876de3f9cc4a41a1032e717e0b0e90fba437e2117325139c9fda83ec8df76ed39a10c34ace2b1a272aa451ebc56de2a82d2037b502d8555e65fd147421b2ae08afaa8abf90d2f2aa979b3ffabdd4a8f23a8a19fd88e7a61779b8471a5d5e3960e5ec80ddf9b603130eb4c96f5dc68fb0a6bd419be31438d324bf7732e53fc82f8443b6eb7cfcd4f9e80920d73477561a6f1fee8bbea8f345dd5fa87042aec860b44216b1bbbcb660a63cc767939dce4d0806a97b5c44654775f1c6e61575446c0661750b0b2b6741d5843e6845da969082025c971609c154598085bef3d920d20c8d477ea10e49b246c45306955a5a3d22af124de2ad2459b2f16b4461675a30fa6558843d55654dd9c468c4f1bf7743425c8249ab8fbc09cdb4b088c90b4aa950d764a23308d3402968164c09e84e7869162b86ed908d8e57bfb5c1a4a5d7ab9aaf4497677ba31b8b4d26acaae84b00855eda6c045923b2d7d56aa3682a518b3240ee015c8780a7062bab55a92efbbf0389a7744fa1b85a949911ec35c13bc0bea05ca06664ad8890fbd458a68bc13198dab5a955b5a905d45d13f38c60a15693511ec9062072d08bcd61cbecc4a46ba13663fb4f50de786f4779de483a3b3efd1453e932773e5d75578a3308a3113d6ffce1d7c597c41981225b8c477e5d9093f3477fa2555a23948a3eb23cdbf8632c0f54624625aba073d06a2d0de80d3faf561bb722e398058f185bd8ce494f5866886397c342eac3bd9e3ece5deb50cba2cea2060c5746cbb269eba0c9414c6917fbc3e0383e2958e49047722c96fd7c2c6cddf4caaba9429b3af2213a2c2f7fd65e7c75325b5324242c9125a76c728c4b8ed1d81ce3c04ef991c1cc6e5663877ef53584e3773d9f4abcaf223a2b2206986f80ff120b9abc69ab934b7a7edaeaf3b30654d03b861b65efe55491fedef949ccc6796ca254516260f368f6a46cbe35539d5e2c57f47a5ecac676a49cdd6ff6577616b6e3633b2ba3b06f0d9d701e4d5a2962bafec8fa86ea46ff7216cafea3dfeb5fa34f36fbe8c76d29fbe8fb51ae3fec4b2e8d5964775e6dd8cf8d37c06b52e9ac2be39618e8ba56d020fc3bd02543652b017bf1ae6aa9712aa550171b05e5fdc57a142e9c06fa2b85f6701f6b5561baf768c78b5661a59cf669d2eaa826a82936989036db777a9a86799aaadedab7b13e629beb184dc6c37d9500a97f918e952e969b57c8573fc957998bc87ba3ca64c8beae8093269b3480056ef2216801a8449038f8b0c954f7691954c6b2f14a89efcddedcde14b037dfd9a336bbb7608f56c777d46b67f75888f55888f45d919c6cbe57dc6fb8f2c6f3ed7e33de28b21b85edfcfee13e24e8587791a47bf232691c1e2cd6a7618e7102d7f21f6d730e902d008763639950ba58a068775a713e3a83dea16af4e91a4b521b19ce044e330106604c1e72805d6d7c09df8a3c8f70fd507cb1a3e827fb06e49bb456e67b81aba188cd2e9ff5b57d1533ed8f990e37f37cccf23ec730d9ac8c4f9c67907068e51a9d980573bc570ecfe09eb277ca49dd32523b1fb5b351b75cd40d133579a8c9421d1c942eb2c903da9d09b43b1768271b68071f38f9c1c91fae12b01df35807540c7cb99198a7352e4f405c51edc66034919b2460e659619525f688fb9ba331b79ce2cdb8cf80264f81b6c3ec00d984d801300aacd2e84a502ed97bb52b9098c64911311888d850163aad2bd5fa584c852e294af01f309b7054e82ab7368fa90e0f401d31cef42c55433db6bab5d733013ee07c9913f40636da3ce2a357db8734a17d06b75b4a0d2353243d7e1e91d7a1ec9f34729b34721bb68b9de09d929f4316b4ebe6e3cbaff2cbafeaf3afd849f42d8dd736ed5472ba696b3ed5f79d08cacfbe32f7e9a6b1c7ef4e4036c9c80e937cc6ce50a08b77ab3770cf5136ac7253534ea7624dc96f9b9adab34a1495fe5ce4153e287fc0a4c0470d3685d217db9e302a3c9d9c8adbe5cf93e948e7c2900f94aacf4843ce8fa870c87c0fb3921bab9239f788653233d60919c39ef50727e911340535d9b2c16d22a030d9907345bf298d0280920e1ee69621390e6b2bc3864f6b53406063598d6edc001423e22d2c68fa331706e46d9e9f5f5f5b1c077deaf723965daf5c8f2d172c7499573d5b395a219740571ee3245ac69067930a602573ef6332a9e8c5a6325ed9fe6e4c38df28bdf5312d623e1919b6f7d8f941dd0f7a860b36ce60956228d3ef6cbf9571da514094dcd7079bbe064b9521df62d578778d99c5554d361237ac612f92dd0a8642e094373d37aeb6174364ab0fd050189b2f96c296d23cc81be2178c687fb9ba4fa67752ffba4e2f3bf70906aca0f8f9eb609a1fee73e524c2a20d39a51c9e2e45b08ddb5d646e1edb328aed2f8659dacdabbd2afe9cf5b97c429fa1166b2a4b6e268555a0132524cc648d0d02c8ad641096cb4a382cf806a4b8d68c2003935e1fd5ee1ca2a0055c12ceba7890afd1c974e559e6d962f76ece5ed2410c22eb69078bdb3b187a07d3331d4c7b07ef6b6da625593895578e55446408a18d0db14bff119675e4832490824b1563735eb2a92b6a0c29158591898efdef035fc568a2ab8bcda69e816152c61cc569f0b28ff42ae3809ad26294bb79e05119a2948957f74fc238d2ae360f09b2998fc3e4d2f8d3c995215479fa6a98dc3f772afb97adf3a6fc416e2b3cc40f4745a36ad39fcd4676d1b6da5a3ddb382f90895fe451f57304b3865d7b35f893a163b9af2f8fb8ed4d86bdc9fd4573cff646e44530bd3ceb71d2fb0ae109c61bf08c9979b63337f372b438bb03f8ddb5497fd1e4e6f1fecd9c8d09e9f2fc37f5ac9d36dbf1b39d4357f1524327f098a4bc604d0933fb92343996b894c1d24ef7046d3856d3126c9b8dde78985f59d987c199a2a440709bde0487d750ff5754c8618aac36408cf39516d9ecfc68a5c0febf9c61dd38096b7f3b8ac702d0b7f4bef7835ed22b8e06edbb098e11f94647e9b95eb47ffd8bd16f8aeb78eca8c122a52e6e48f2b00dfeb00fdc99e49698d486e56c94e13151e77c5aa4304fe9d23c34a173eef0ec1a0bbba49b67382dcd85d1ce17c6a42059f4a37731ead5c618a694d9bb9d889d246cbad8fa8136ef867a85084da7b379907553d8ee876f1edb061be365ecfdbed0cb58e75da95b8752776c0c9f82a9be2d4843c5c74b6a7ec9f07e71413d14625ead67b1588150545978b958cc8978e20ef301bd7db8f7558c8fcb504979768bb064a56c024906d9e986cea4a9ce64d3f756ca50d4f115755b74d516d935c4b24ae041b17bbb3c2c9b963af1787befe241280d0395c921d98345b29347bae5750e568732077b75702e83cde1b7f2d9ab8d86413022242fea1915eb66533059369b9bfeaa61bc1fc64adaa455f39051a9b1c96d1605586a9b4501880fe362d5aac4cdb60e8e6a3007c7b0fa116bee43ca08b6d9f188e1a009fc86c8695c6cf11b171edbfd66beb1a3246fa4f887fbd8e2a80e35250477495b61e50a97205b105310d9f44b7f6647099eda92fa89563ef6709ac4630ac65d424ed79c77ae92350ad1afae3090b2cd1b1fe225345914aedeafca64c0b800a38a6b8dba25181b83c22b5fa214ad34444347a389b85555d3892c834b9117130ad49304f4aab8a7d68bea4103423f718e1d13bb1294f92bc89c802b2227b83880a3aeae284d8d2db4fc701f259be46581648ba92c10abea0742af83948b0dd19933f04cc295cdefaeb5b1900723a74e7cba36f089bd565f86625a0ccce6a620f179ec34c4e91e01810214fc752dee128b4c874e1cff6add1aa3f190d6ddac8345df19dccde2cc8a67905aa34cdd00e63298c40095b5e2acc61e30b66d000bc671c379d3d8548706a2f1cc0f7792464b4899543cd13d42a16ef1ab434e9781706553a569d100ca932c6bc51f17dfc5c8b4e309074856a845d33d86451d79ad3a9a9a3ca4926db02071ba5384b616248d129c51a6375155d5aa5866c838836e611fd8eaacab50ce3c73e224b4f524b9a6b2164c8824b03223553165a6b2fba5045363037b269607160c9b6dac48f4ee8aa4993fda67b23dd28772893e615391caaa0b10c6931da1120896592813710484ef0a7806d1cd053c1356f10f642133d75824f17b6b14cc57dc7d5f9145a44958820998949260f82e1607298f74a1e8d54a2e3cbc72485ef0a39a4fbb44663a2b4b7298235e0eed44171a21a357b073500d8a4997a0b0eb18a38e1a5c6a64302f358dd3731cfe2a68853cd0a7c95d6b72034f87ee6beed4d77cc3de829d3357396d68ab4883acded0958b8dede1f918181d3693c80cc12459b06312e895922e1cd64008d326f14063dc5d4b1a4739d8947e36276f87cc63b844f70c54f26b50c92f41058c7206970995536a2e4d9a15ae8d7defdd6e5835cdaad2459e5855ed36555779cda2ea0d2655321cb94f3a503fb5fdfa5af3666f3f9f01c07d3200dc9b01d04e3a903fb5fdfc069bf1737a201dc2efbff612c97fa50ecc45e2ddf9322d9fda85f25a0f6e3ad07eb50e7cdc3299ac1acbe468ef68ee684de4d4c25dc73a86b87760f4d6ca73132613566160e9fc6a17dc6776e1e9b6961d5af4813334bcd687fa795da8674080af6faf75207f5e07f2075e77373d3859280763fdd75d286f98a25fa003b7cbe42d0bf5170641fb353af049cbe41027bcdac5cfece1e72e922fddfcc72e915f74f8ed576ffe76f820d2bf261efd9510f9cb1b44d9b1fccbf7e0bc03ed17ed8039efc1098e98a22ac421bbdac93be9dc5cdeb9b97c7073de0d9beda1be9a12b1ebb010e5e43ca1b0f4cc747a660226794a5d0dc8d83000f33c01bed927c0193663270d87671a36a72d878f68f98cb24e1f3be4f411433ea366e58586cd8b2dcbdb5a3e5951bb74efafb208e4a397c0175901021d627efd05209f34fd9f3bfbf7d5c907e648662a36433429b95d1604ce180a4d15c35ccb29423ad4f9f8bdf45d15c4786f52d812f1e2507ead81259a54aebb61de94c2d58e2d88e8be3f526d86f51e411de21f51f10f756c562bfcc85876ef5d3ef4ce315ec5897afa55bcb1ba4641c24544fc2ac8714778214c49b90902f8f41364f5763e1d618cac04e85dc1d053ad6b302e0a580b99209d2bf3392cd83f0a1ebc8d2a02a19508b598178fa7157ec12ab4af55adfa991377b5251498ef3abcff525657b084603434fedad0b6d08c6d2083711d8f6d2fc4e396cee483798ac2d0c696984638a67c63a1851b64f19bf75378ad1623cea8a1149e5679d869b55dc4ee51076c3dec54f1abde08725dc954623d52611942bd8a931855e1b7e60c4ae7a2fa022d8b8a012f53c292a1084406aee84f639b19496955d72d009183f1d93fdce3d75a4a9f33570c81f068073d855499b1f1cc4a2c4c9602734aa18d3ffd37caa57e8f48b317d03b742efd3555caa9b16e0e7fd33e14251c3e14054be8bbdd8376c6e0bad614310fcedd3c783e36d3bc1093a933004df1090149a3cbe84ca24141464c4fa4ba5274d6c41b496d13024396b2553a298db5c89158c4249ee0c7cc2c663619eb46c3332335dfd09f88944d4da5246fe8d275441baf299fa78b202b49f258637ce687320875681b7a1cd46d6a808ca91e86f90c1deca5e68a5d6db35982f189daa0fa184c94a89ea76a223b1a359b1072162be945b26975b5850aa51a94d321aa4a37045330cb4e46775234825671135d5177696926c96a1b9d45b72daa0cb752e92b6b971e9f4d43fa872791a641037904d4231c014b93bffdc91a087a0a3e426110178bdf177f2e3688bca63a4eafe8c9d3999a1c45c5b405b2de83af86cb371d0387b25efa653c1c1401a5dc283006b68dafb4f4c37d0cd904cc10b3a85588781309c000e61234b2d1a17571a8985b32e2242d82ba9297dc708510ab696132fc990d5cce1f07f38bcf8417c48a5e9d696d40cdd68f2468f9987e49b8ab9b8d7060a7f039207f0471f3701f7d30c9474c7c42358a9b8953e942a7719b2a8a0b5f1020163d93da8db725646702ee7421354ed0abf592d93bac078a6daa23af584568b74002b6e856e3f0e977983fa0d8ad756a96d4b3e56cdaca474e1b2532f49c705c7a5ca1097c214595eeed17dd2cb91d7b4572fb927b85233607e29670c6d7b8a49c4dca054f6fcc17ca6223d658116267f8959c817572205076a11bc4b67cc5bfe3b25b17f983e65bbc123a3c95d603e66839697518fdb63c0c7ea110a98632c3e7a0255c297b057b549247ed68cb67fdbd55ac7731c1600c9e9313bc262c8aa12cb085b112b852a6895aac9577f5b5b0374f3980b787bd290769fc650711ebc9886afdd5c6b79b5886ac0c4ecacae8bcdac1b33a27c5ccd4bb0566849aedb819129593ef2751c8f7c3f5e9c5c01ea791b3eaa744cedaeceda4d5ddb6f817ee88794b4f4e80169f01da73ec737d4917f85a1febdb8075d2c58388ea6cb66e0016da55175b8e04a8619d8dbbeb2beb2c9fd7b7ff08e5d337edd8f29fb3fdae318cfd6cd9d0af0c6a1e9fc13d33acaf6024677ddfa5649c4e5f4cd77adedfd30edfc419ee180c73e67ce6d753e6b9259554076d49300dd8e5fa888b2b9e3d09e22b2cf36ac79b935aeb3c46088f242d1edfce1b8f556c744bc2f43311a85a053e44abb14858163b3a72669c5c775ba60f1caa217ba05f70aae6be410311d7426f866b35777a99eed50093dbe07a308b5ea06ef6f86cdc0e376b6da0ea4761faf10d67f18306b9bd1ea6d17b91fdee6ca4f38cf9ea469ae778f21b4611beb651e0c38d879a876bf332f340f9e979463c3eec46a1d2f17082191c57dbdff8c3156d7aa71df1f88e8c51675089bf1054c6eda322e79029e3537df370ef0ba48c2cbe208c2c658922261208bebb3a10bc44e5944193d8607e7f26dca9e9171ae8a72fe21c7052b9488c2b6685c3a878530f840d8e306cc1b44db0f7c6ba10a6916bdec0be518bc0e03583796e338e2356f883c16f971fc364e1af4b70c3ae5df0c64698a40dc1a6f57e55d3d75d96dc1f0b021dac1d2d4eaa889c481c04d7d583e72b05960c61fa4ddd90cc1a155f8536632004218d8844a3a97308ffa520aa6dc3bf26e12780a88be445a90e6d63a21eccd07b06200cf26d42f48a60a786a1a073eeb136e0157552cd5fdbc4c7318af881bf607839d2e3c3f49affb8c11f028299794e5a5c854865266402bd61d9cd7a5219a7ce994e37f6d8fbe1016a5d49628592086c955953230f15f35e86fa0142c1bbb09f2da1c750130dd520d48b600ed1b51b5c3ebc6d19de82adac2ad86405a26ad0e41e9b6d15a181924c2d3edc13580841838f06517e199212d087ca590956a97dcff45d63b512630f1b75e39d908c89f6648e4ebb58a82c1f12e86890c9f0ed08cea56341cc4eb3d8c4db428b9a5a4645b7c4679ae396d339a95f4524965f35e60e29bc085e98d09718c4327ab790fd465cd3373c9c4596194b74b18f5ee98d7dfcb23f1a2fcfe0defec3c15d559465d01562968ae77459bc8ba42a536a02d262b1419d23e299f4a2b9af017c602e1535a725e58a8c8fe3c28d87d964749f45799453c8343880b41217289186a8715d991d676ee17b03788e4f0d21d33f3803d5640d9c49b16806ad88b43a0c92d5869d660dee8c3a6bfea97c179f47ce0aadd446b89a7e17eb3538b9bca27d6e87b847a751dd38f5aa9108e9966ff52612a1def876a6af699384441d4a79df320e8497a90dbff84644bad8cc6bbd3b84161c38486e517a18affa2b71103fd202b138d0696d5e8f1d72e45d7d911740b2a27d267227e17aad86d245ed96f0f82310d0d8607c21af08f0daad1024e21fa23a00df16dfa2f12ddeec289e587d34ca5c46588a904c29e528b97f3acaedd952f727673dda2518d1484183cf62051fe2d5e6db6526621d4f821bb266df662e15e7aeb78ded558c0ecaf1c128135e49e6fa5edca787851ab7768fa36567202dbb47d29a01991efdf8303ad3a3e04c6f0f72fc701f533635c94264dae0fcca44bae1bf17682c0cf5ee5dbaa438ec63ca1088984d0b528e0afaf7d8dc5513466eb05d0ea9b1af415568cf78a82e4314fb245257dc812eea2d1119dd93f08033eef5700e57a3912b3e9118f958427671246068814ed9a083d6fc7c44a8550d96279d2761942c41bca5c08bb17c635c95c38046c4652ca0946e069a293a03a7e67197a61870e8fe7952574c43a88cba093464213f8dcd44fc05d4e89bd18ce14849541f95d4e0c8dabb7ee490326e06423b9210efc132561be0b3548b1d08c2be10adac2ca488c400e1627d95198c614f683ceb7ab82f414827b5784286b5b2d2331cc88dc5c5726305e6b841f0e2690c9305d1eaf3c959217b8e414e2caf09cbbc64a3590e09b2c28633e45564b1b7b83508f00d8468046d240b39aeb140d02bffd189662233e53a6c3462dd441778287b902355d97bef0cdcd71e6385b90b91d6f80197a6915750856e33a7a8280ea564ae379615fb113bcaea86a02bb6db47909b1606a0e06dc9999fd283b957d44e1451e55875ce9517a160be4b6b4ae4960db9e0e24cd648968f1a49b00e37188bd254330bfbbc915dd548ac2b940f8242939d862e6a6863e95c316932b432f623adc2b8aebad6945585ff1d495467942a5b1b3d67bd074f565a0003fd10c113d16169802a1516d9d75d877936f5fbc18964c3d511211c18841500692c6ed213e8486787b05c430c8be05ef2461c78e6328d9051a44d0414011e8fd25e21d8c07323bfa9609fb2c2b910879b9914d66d66a6a3ca0350e9fbbe0501b365e150fa6e38c6e2250ef838489878c40b9b65cdd015dda46d1bb61e745e8ca51e46626cda0255f37a8bded43515e39dc1b93776639ac8ccd462721c2ec1cc036a5bef5716008201ca6020417b5ed3117bf83e661e7ffd481f7c5bc15d8217b5e258b50feac97283614db49eea58c72a4872887eae3655786e12404987dc7d89551ea203c40e081b31ee6b3df8609bd949f816d7615ea6111e3c9112b4ab5cc306b10d28b67ded817bd58887d5aad67ccc146f7d84249ee112987becfb347e041813ffe9812eb1f5d9ac24b55cd8b0f43178e4e3990f795fc79a2636014071181ef0a89ed03cb227f504d343297a1244ae880e98b4e0b6d87a06570d305081422ba621206052687513f0a3c1644ad190d767e3f83ede138eaf7f64f52b365773bd5eab152b09c92b0ed4a07db111928670070ec7706cf638541521edc547f003adc38e6aa73c429bb2470f46b24f5dc9588e1d3d1e85b4cc3136ea6b6ec457e82d4c88d091808dadc7904033940894fcf0fbc76e8b25bbe53aa409baf86d61b78711f64d9dc2072b428fbcdbbaad21fdda684f83eb6cac45b52ddc52375dd93813306234132185910cda908f02fcaf2662acf0d15d8e0293a259015c21ed358bb89bd870848f0498ac470ee3da6f3057e90933890c9048d6ad79bbc1cd168ed3334d3ed587fb508ac9296bfdc8580499923459c86f202e697e4e5a2a494371a4622a725e4887b3359a7f9935ba66d8410db74cc816cc46fc4522d94c94628084b2fc260b3cf133487f43e13c2645a559e260357a1a5be9f1456add2d6b6238d687d7c55bbd09c49b0821f736059c8bc92ec7175334024fd00484c25d329f0ef95894020aa6123361e470d014d060d14c0c8b687c7eb82f9c15e4db817e53342b1d5183b5d164c44d57768c1ba1363178049f1916da869967dc20af0d691fc88e8f904fc153d212b0acca2bf6de1a65625a6eee5b4279bf0d717ff24f763de99d19d348a16f41beccf5348f24a734d86ec8511836785e4606fb53da6c7257b6d18b10d78e92b14f67a836c48bf81657cd2aab06c470901dad927a7db119858d4b044a717ad4c46a42eee6609ea416ca65b94c014e00e2855033715224b1dde1cdd90d41afb6f880a9567acc14e8a33de8aac62d80d05f4652d8b773108fb4cad95f23b92a54eaab730d9100c5b6844cbef4740d0143aeeca154c4e58dd452119b39e6266e4abfb87cd5b87d7c6303218c995156d54cfb2c1456b31d19b61483a60098be11b301460046a25dadcf61a57f8403d1ab08b6fb71576043bcb40d608a86762ad85b79aff79adf09f26592049cb3c6b76b8eec229635963012ae98ca6ba3d647f216cd55d646c4077265e57302afbdc96ddfbd642552d21e11b8240d90d08676fdd5c8b1926e740b7b7cdda78f243dd6e83f6366b247549ebce2cdd7337a6178f69599f600cf7d363276bcf4eaf91aa7def7b95723cfc74baf5ea8f03654e293572303c84baf5ea8b0f40acd73ef46e290975ebd5063eb353ef3eae5857793c378aafc7d6acb90f75c7c945bdbc4f4c83691a42da90d191ad23b1ba23c0c9102b5e0363042760f85b35431527bc911e231976a429dd155fdfc285c24b5d3b6fd99ba5ae490acc6374481feec68cea91b6ec6dec13020ffc84cf6c64ab6b8537420533c2343ee36a2f95fad4f43baadf9a1385e118f931e9d935ca47436460c662922fdbc518a8fe3266184c6214bf8d7cd47a3ec83c44ef015627941dac4b43913cbd5a7ab4fb3e1010218224861651ff06bd93d8a246f16065709982e0e68dd030161e0704ce8e4b557f2baff18f7be995e8410aff4572b1b75690bda008e14daa641cb2b37d18f9e8407ad2fbe3a01fb94b040de6040a0ae42de972524bf1b176169b4402ff1c2a6524d3a0b66257b32c5e24c696549a8365269cbf874b17b657bf576347856edce6e114c9ebcf9a8457c0d63c3d4c0391e54a67b09352d9e09dbc31dea7e95960933ceea71ee2810aa0c6116fb8f6aa96ed64fb5e3fb7226ee973d93a054d342435c0025ef4cf4f89348435c28ed525b565a31fb15730da3421bcf8a0c6c30d8d34b9bd2d379f4c83877367bdccd97179b941740969045e581e82c589a14e34c47198f4487758bf7c7cc4bf5bccff33c300f23b66b9a4af8b3efcc0b1f3e394c6f5ebf6c9897425a542f8d11530853aa9ae20c4e072919c3d97c4c2215a504385a8ee4753252d1cec0b9fed39380dd04ea9753432b3912f0996e215f46a706aa2f47cf6462f1cfefd9349638edd9a4c7c8f9000a9156e7966147d461cc5f7763fea10a63c3d8f9fca4813d29dbe112aaea342cfe41f837075f1d079f6fe52aad0c75a0b493f09ee28fd36fd0119f71fac9eba79fb857cebafba033b860a953425b135eb61edf32b639c124efd22597e9ccca5ab61e464c852baa98d16f6d091e4a37d41e617ed23a93d4b911f0bff4f2046af3d8c5759679e999f27c24b9606c38df85ba10990fbb158ce745e038360b5ee3547223c0299f0cb7213d63f1bc527ad42777c5f94b0246588aea54fa2961c38a85d33899c0225f325101d388c567f38811482e489491bee42130e43c9e3e4a44d4874cb7ec435b2fb6865d7304a2207c256d8269901a8700239d4d48f2608ef0d835b91711d10b7858bbb963c21d0f72ff12ae3b9b89791297389cece94cd83b1346672e5216348652c2a27c6681652cc514f20db84d623415abc86a10c523de3714db507a86fd54814051e08f8412fa810d6545112c881c9069ed3e9a482f68ce06df9bb752c245ca004dd8f9a321042089cd840c8c3d7106d94ca8aabcf2975486324b900518645b9bf56ae4a63a2ecffa379e1e0ec10efb668142f2b83d55124ae0b95e86dc9e1e1297b610ed7f6591c4667647505a0b5406c8b88666108174bd441e5658505be886cea66992365e4c8c69e9feba69e949807d5cbc8fa6d40571840f61414e21a5e9d57243dece10b4982d55bf23d91c897823ba692449292dfdaa3a41728b2e5a0c67a8f9dda88704baa826d89934559dbec279b8172e956f71c05c8673715ca4b4571c4dc54f5aeb88203db32c2ada1f6976348638c13cd947b6a44b9691a5007e4e097378aae448aaea4c0d262150ab26c448047166b0991227be566602a9236b49f52b2a12bed8e0ebea55bd85148ec00792cebf508a035e40b556e8e5d00b20a06ccc37a7167995ac34e44b2c56e481a80d3a05f960a631e23510ae85b462e2556ae4255c4053426452fc93bd4f97a84f14cc3c0a84e6f412879bddc0c76710cf4fd9b7cb91f8d2ef1cbe9f698207d00f984f903f85b81d20b703e6ec44b67f89c74137a1686fc0681f41de0ed0237abcce4c5d67df3d82ff00ff80fe0e7c69d928f007ec731859f909da0e1427c4ed00f984f804f80eefc7e0dee50084dd3dc5ba934004adbc00f4aab132927a3043ab839f39a6ab4173b060c6bb04d5343a88fcccd199572913cbfa61b047dc8a387cc28d0d237907e4b8b1c12fb6b218bd5c308ba818f9aa6bba0a6d9362551162817392a0494536d7df3786aafe9cac3bd80e4ecb11457ce8d6a6da55699710c50c0fdce64c1522911bcc47a363504d17b9adede2c9671cc420c9cc4aa6ebfe924d6bf3002b104f1b2f1bceb966421ef1eedb30e9f0e3d8b291f0c099e61565c3f0828851406f36a99936b2c31eb6381a926b4369c04381cda105980065a73884507972d0d5ace75d00d9780d43ecce58823d2f61a77834df70f16876002ffb2714a640e7433cfa053104dfc6d6c34f1ac184a8b32a125694c69ad3810986a8442b959780ffae0faad98a66d8f732625deb79eb4f082970b5c903368e31d5e591fe0528f0b76b96111f61bd379c7d011372fa3e1bccbcce11eb20afb662318f665e038b702a883cdc936edac9c57b6fa018d06650bb77bbee997dabd3cd22ea73efb2a9ed9a00077ec49e98e7284f2a0e14aca71a0943ad30a26779647b424faee7b4ca4ec28804bc605d1f996debe7745b99ab0171b3c2c48d4532964b2c79587937d87127cf6cd393f99e7c4d2ba6485b34fb4dc801cb259460bb1e5ea70541d17c7261cb43b512e9a286ad62e072651e389a199f8270834d00800b6043417365169c2144b93e509da8a86691443f96ddace8b0ef606c1f1114128ea0abe059efaa1f52bb0d35a041734e136a640985d337388626c4e279b81f6cc65283a979517b3e89d9a08b02cb4040a73518a5ded5459f2d46c56993212b1383f00a9c91af24ae2ed79818087c4b3241e00620b3d58a2728a3e435193f49ccd9f3ca47a9cf0de0001ae0a4ab9afaa9746cc8da0796ef42bee99ef48a79a6ec4928c5a48add019d20b4bd4d61d9c1c21622a48f1f7e1a586ae9b21ec11ed023f53ed768c208285249dac66d8adb98a93e71cce43266155217f53ed3c238d938ba00c6926057b042363429c88c583e6846261fa009c4c6a6e3fc63a7a487fb82373e8464d12d9c5c5806a806e008f80f1cfb49c97cf38013b5c37c9f05a6da8f9847c9190a306dcce275282ff635c522ebd1e121c9232196d4100210b1702566483c8abbf871f334197114c6e571281dbf87c691c642d2bfc3b1c0eb32a7e08af5f4704299b6d2d39164772e798b1f15242a572ff5431faae9278758037919de90080615e6fda64e078c5619fa99f03d4c46da7deca8651fb5d7512b1866402177e3bf31230651c58c24843313ab0313ef4710a93b3c18e70b7e374fbdca8686140a8ca5779516390aaf1ee3e9a185388341f8fc9957beb903e5b361f0a9ab623f5ea65de8b876a9d4b9796f88ffbf8181ca27e1849631ec9bdccf3b785e397d43fadb07874f70d19af011c3df419f6d0ad9ca986c43868f4a1f3a31abf4811eab2edca8e9ab705e70d4746912c85f1ceca8d703baaa312b33bea59181835b6d42841c294867203a521ab6536a9b444b889a901b7679176c097e89838ca14c3f33c22073463a258a764ea2ac10c2d61b0f658b024d708dc4f6a56e4825da864125d46724e41b8a33d376953a2df006003478004d0cd20c16ba6e98f229d160bb510963541bbb3c6f208457983a159e11af0a23bea88688535be6c68d0ab4901ce56136ab663365a5f7c6e210814489c2c409ea672db4129fb07cbc81c2426984d162aa2b4a18bef57ea561b5bb90296fb371a78da1f3f59050d20218001bcbe28303b418a806c0a299748c7aa1ea0cd9c84ae0f81d46224c15338de607e284a4607434c806d5a97349dd885575b8550b9595a8855d364c0775e04a3c7b8013e70d75601345ab9da7d7486b909bc3ec0f17c661e3e649a489941c6116130f0b92eabe42742c108b945ab135664d994ec012324de9df94694ce1aad47e3150fe69886a99298bba86e5c933b523b575ac8241c8a91926fd837ed9099d210f64058ccff98ba93a4c46de45e675068ed25773628c25bade10e0b787fb84564e940af64c3bd0d9987e402663570e83d50d4ebe1b9bf0876232b14a558b55dad5a5c670b6619c3fa6cc303620c374c1b5eb769433815b38c43f9219249c261f25d50da87d426aadfb06c0b9c209d185309216ad92233af0407ad18614aab16c6b67d43c11a03473688661553f714d256abdba89b15a70fd21119cc6be4a48c9521bf303621b2825efa3811b0165f4d9d662c98473b39f80c0e5f2d39f7f78b0df7e77b57ff9dd3bf1deed236ff96bd0dc40c3fd4a1d31aff5a47d1d20e9cb2f3f97e85c95522d5fa68dceeea93d1293b6fc518e3a7be65d8450329559e20e152b8acc7b4923cd2a4e2736707c68973a393fb2205e4fd35149681f9802c9eed2fdd92053f6e21acfe66e80ece502fef5b97d45033ccc1ad2d319b8cd37bf67437deb1cdd24887dea218778dcb9c3856f9f8ba7ce7e505cfdc3f99d9d1feedfcdcfe657c365a9a039f06d2ffba809dc0477bfa4f9a47f538bec1d7cc90b10c3d359df19040e753348f257f5f8f5a349cdcab8fbee86e1218d2069dc72379d74b154c785f713dda57d2308b71a5343ce40a8288dca9f6161c50a40ac09f50ac2e148d15f6dd279aafc83ce83ea825033a18da4ffaa4fc0c0348f7a9b8a4ca18e51411f55b7eda8b48e4a8356dabfd0d754c1798f8b2536b8416dcfc9e9b725187b281a1af37911433c5648310b59f86c2fcc9bbaa172704c6045033c1db66ab484980a5173cff908f86c5630e79dc45103ff99879007c86f86eb4ac7b239a59bfe11515af2368458949761e47d973eecdd0d9026e44f56f76e16806762195e3e44aaa6a9125628f554108cda4a540cf802bf66da10513842a6649347f2c2e4a75786f23739ae517577a918cce649318ba9b83610716cc0de0de2c31783bc99a8c3e270a074f005a4475e956284b29bf961bb2639e117593147c13eb118ab29e36dc649a19850020c98c78fc084bafa00ed8a6e2fe03dd5a39e876cd06ab0a468d0aad24925a8b1749f21feabf74922460cd2740e044364e714b5e33a14469df316867f28ee256e23136b5cb19780ea36519680e17bac6bf2267943ac4f554e6f351a24928e7141b5af9e96d058c71547658082974883428596139c5cd0eca87283e020561ab894fefa086a637c3ee5e5008c5b1234bd88c1c9201743c4dc54b03c178c2924eb14908f30614c113830c24a125b94df82dd1ec5dc08e90a9c0871eb463c10f45bc4f14c30ab09de0e9f1efe93a65ef299b666cfe65c9a29159519fa09d4006ce20d617e83ecc75c01b3159d16326342e363120f726b2bd2d311069de238b8f4c8e7ec48c49eb2b30bf830829b0bf25cdac0e273776283c901ba4d2903780d4ce765c68b85115a6d84871a2a70e5e60831dc465d284adca27ad6a9b3269cbb7ac7953a5c2e39c9d5ccaf54fc40931b8327fe50504aa0b82deff1d135c43c8b60512ed8afb8c834a8323e71b2560f672078afbbb010a2dbaf391218429d375ad960394c6904d9d770ec9c14117dfbe876d6749a1a1f9eb50eab88d66d9882c808bd5cf09014538753141190938a01d45b74786de9df11edfb6cea77495a3121717c215857f140767bf8ae950719df1e98c9ccb6403bd34cce1af1d6d74091fe8fc0e4a3f00c09867e0f7030a7065fede14b8af128b024aa74401e1f087e4f76001febd2926145638653150fc162a9425e3aead758f7bd67fa5f527bb8f7b999a2aa21db82198ff7912cb0cf18f774772fd36395a100a77d9aa775eb6359346a43f7d0b65231ada00facd846b805836ba7e21f4d695beba6dcb09229ac8c0f20d0a1436fd42b4e61680744173b02078731a2788ce864f2a6dccf0fbbfe9e3e9eacc9cec4bc5541bac416255133590160a6d9bd2ae004dc1b462ab162b76463beda9980fe8426cfaf91e43380701e19d351656866742c87f20c571ac3dc64c8394a988970fb2dafba932e3f377b73bbbf37e35e5f8e22fae40c64870000ebc86042758b77b8ff62608944473cc4fee2f1f82e0de369f4ede009c8174d4082755a001faae00044be71564b9af19ffd3a18752e785be995133e4e2bb669ff6b040f044ce516eb990d9fcb64b070f081ce211415d11ace0636193a6265c4102ea2d690b620610430c2d9b68d50d720a26be84d9aa29b8bc19529b72a5e7dc4746513a7243a83c3f110fb35a8bdd63daea0343655d23b51b33828916ba9f0ee575b1ae7bd8dfaf55016824b871816fca762ae2e82aac341af632df502411e8c792670723b72c7d10d1895455dd0a359914695e1904ec56eb430a8153f84d668f9bb8c789733417e807abcba39233423d036e1c81981fa6a216b052984411e4155c1093326ac07462a6ff5d27fb88f399b5a0111642a89c9659a21c4c132ea79057657800021510b0612ab1b1bae1e14cf19594d77fc53f7f72112c28a0ec21b471ff499ab14876ba65ed4d20230c4380f439fd50dff4a2e82b305d49ef886c0a60a98ca239ed61a9538648fe46195de05e541a7178575735be60caa656dc5e0939b83a9750507d15b94646d630aad77951906dd40378568086f8017b361351092de489ce47a19eefe3d3e7d50220194ce7c711527c3288249b12c23950e5b1f87d4304f8f74eb71fdeec2cb7e43c141d6fb328c2b7dbcf8e8a730a415d403883f466cf8e93c625b54918e86ab80abc45685c58a998191ca4a2c444090e1498383226bb6ccdce64848176a16c85eaaf263a18239183155e3629f67708bc967902a1c6218eb448fd012248e41e9a0d495095a067da847a64452a2b156871c5c1c193786ae0100a3b11e02d94d71055b718dd5a4a4e63b1d3f409d22f1c6abf16c51eda9c8fd23c3f669e97ec142f0da7679481b8f9084f91ee8e47993f8fd93fd8b933e4c21c26171df86248620e54f4ceb47ec153f4cbced705a180b612f3123274e1fa68b6d6d2e96f1aab55d16d4376b51c3eff1cd4c6cf4d8887c78dc919baa8d1e4cb78257a2cb4af29f30ccb7756776fe35a0887c245418a7ffd871864f5c52c3acff1a9f1ff3e8d0cd28fca34271dc206ffd845ec7cfe8757ca54394a9af8cac7dca924a9fd8e9e9b94a43876f4c9aeb64067f8afbabf874991d2be8585dceb947f1405f334a4bf90b74ff71638fbaff4c1fcbcb2313f72c404eba5f9e86de7b436a9561d4a33c3f987b43504450a94e30b99b9c27f551ce13793de7c9939427301da3c64f4bc792a64cfe601e06a7a1bf4706c157f3c19cad80fa2c08c3109f4166783c87a5eee04b0abe2303cca498f5d95310be9a3626f4b43132d3c66c03ee5f1b08a711fe1242c039082b4bbd7915c2ed4508039c096340730be5a0501e6bae6dfb62948f86f0dfca22bd1f7e888b87d60b38a760501fc26b6820bb6721fc1a1a78eb3af637a8406e10417b1ec2f516c2f2f541982330a1781ad03d03ac3c052c280014bdb01b6c601bccbbeb81e7d34e207ec6b074d6e751f1e5d0ceb9d9dc9edc92f87e4990e0eec636d2304284d9c582475952228945e228d19a98a80e1470cb0b0c09060eca094a5e52a8260787d0439ac18205be1da9259c4f56c0add4808315a63e889d3c0cd8da59eb6c7053afa65e6cdb753c0c0b360fb704db46403bf089f55816f98426cf0d9b10b814ac66180fa2f9b3041db227a2d4a99f907d04d84770a5fb113e14de58d2556afa7059deaccac70be098ff63fa997d5103eb086005db2e3e9716b01d5f905dfa27bb6fac8c470b409706ecd2c72de29733a04a8e1f0f9b5b843e97ef7ecc5de1af4a350549f608c2712568d54019371bf9b1a0f1b3004c3659412a82eaa0b0f246e08c57416a064c915516d734364340574e0477890d6ca618677fd2dd36ec795c859cbe86e8d5bf66f0efe8b45db4d9d599544883b7492806b5973a08972db1634940b8dfcc3776942417ae8433d1e74dea4877edce9282cb82d44daa291771b1628187aa46835b6d60377c2facc7a10d86afc7fca8e07eb40c6904a65616d137f5061c7119e6dd88a6933a4b952647954ef14ef9523d2d63a3dbb9e3b96a301b6274a54fec5efd72dd4b0b7e0d36b8b460e4a6616c08f92649fb88e7b0ee290c8c927c526fdb97ea6dab75415949501215b98745632a6abe6910ab5c60ec726bc3edd48776b3f9771d16e880c79f329ae2bec468e0c9b2f843add6aa69654fd633a25e713a68b464a5f467af8f8f784250418f12dc8732b438edd30636c92c8dca80912b6ef455dd6251af4402101414805023a1856b548aa179d445e8efdd928b378db46adcd822fe4a6c9bb34627a5a3bddd33398ee06733f699249ca615dd2b52e78ade785a261db107c43c1b0e86ba151f941d333c0d68365c8266bc83915dd07da424a6842f331e4f5ced91cf08d52ae1e03c6b402db23d39584b7deb485245d19817758bf668bc3961bc1be9ce08b91534e4569f641b4bfed4e1c72f34fcccbe75f4ca418da031296e88453d4951836f6f1dfec948bac087c004b3857983da5570acc50c581fdcbcb0a22e6bfa2ab7bd0cd94afadbb2d84735f2eb0c6ce9cb802d0c41d621b6fa181885e9e4326f76df96fdc6cebb03aa87b07982f66ca0f9cb0c34ba1b93c89b55119dffc8119fb836854f1fe6413e8c786d2fe62795c7f9218f6c8bfcbca63402205d6dba09ebe8df12d651dcdb23368667c5a127037c24af7b1cbde8511dfa68a7d99e01e27d78f9d54863b5f7ecd99848951881437c5b6f86f2a42e969a09cf228193614eca6550725097a800397b202f64ae9d9196eba3de5117e3644913632396fd043b56cacdd47d9817f7c99abec658ba8ea13b97a1d51e8ebc69b82947cc62619ff437afa42e1ce7489a5af960a59a37a7d295eee5adbaf2daad1c514443ce8d838cd8845d5680818eea6e310f18f1882cc1ffae04513d59767bbece237d03f1728b328a15c6170b0e189d18afa145c51e36685eb96e1fcb3b5cd37aa6014b7c84bcd783d11c9a6937223805d59f22bc801c466069d0575f6b1d86b19e48bf23ac227e413e197f4d79acfc9457edd9686a9b8d03eaa85c337dc41e17ee573bdd6318e74775d02bac0d98494236dab0e1b45ff45ee0e0b12c0478bd5f87e3b32e8f341ceda0888df5547906e143d6f69aac307c8e502dbc515438c2c93245d356fd228e8cfac980703ad627ec0925879ab3a4c758ed89fee7c3989af39599ed1d0d0e95531d2dd278471f7ba3479b10139c4092834a262584eb2b169d7bc25455f6370262ae1d1e30e858dd36b70d57c1e8a649025c85ba2c7957d7492619af96b1564ade889704e94439d8f458b6c1ff6742118891055190648f652f86b361ec594c8175cf0a2692c807b1048c0818b138c067d00ac419dc8d08563791603bd8d16288e5d57d0af73068575f8ccf554364519686b0e0d2a86f44ccc7e4571bc3121b0b0d2cb5da70aef27e642bb01ac8067b123a4cfa83b91f3418c419780f721ca99af8416dec51f1d938eaa3a8014a820b3de25b543310a0e787072252330266611c83c1acbe851715c7437576a3d4b056610bc6859e82089cd66e830b33e68db6abeea0c3d685de5ce104f743e0e608980780bc92081a02a188378ff1b8f68c2296003d64a4096af51d157919bc22b8867a25b23a6690048665b3c5ad1fb2f080313faeb58e3a3d4e2408d5b44e3b2aadfbe11048b945b7337e8258c303399b8389745dbcca8ef0c5e0b7c74cdc21226ee84787639e7e21ba68f0e69de965bad54d578444678683e178cc49c45c2c845793578ffa3dbb2d1860d854dd5857741a6284e06bca8d13ca874595dc32d0c0aee91f28652735fc9b1eed54c144651a63f0accf936de0838ecbe22b78575ec7bbf1d09d883b95dcbee269256e47b94823d4c6f4d36b2bb3b213784ceec24eecdf25cfe2a39ab889df109dd48a9468e52904340ee7751b092bd8aceec52c16178c63c16f7cf924a3453f7298d136cd69db6e4e3b746fbb00d04ef7b2ddcd6c97111ee63af3ee31af32635a86a7a623da7573583e4cda7358639d43ae7ced909bbb64df5d328cb49edb6f43d8f5ab40ae7eed90db1d516f18dc9b35f7084c6f287b028af6358322a521dd40f0b0b3f6937b9534987e19828793296fee6b1ee7e8cfa5ecf665e556e72e61a0d95c35da3b944c198198c4911005d22b860b9e4d43c1960a26a54dc98a9c8c8ab85cd15c44d15f28c7b2e203cd3645292a217dd50cb4e7ce0c3f9b7ce500fd74bc2d8ab7776433298add080d8464bac86a4768fbf72710f37f0b10db83e41c631ff61f62e2537cfe4b432c7ccd102b63b8fb6a7ad61e77128e6c74792909487ef19599203cb3513d8160fc8fa717df73e19b49e35fc2ad3975273c1e3d3a83e021e6ffd521e8ff6a109c4956c384e263abf583de7862ad7edcc95363fec7ebfa04e007598b6b8c8a01b170d0d30923064de38c7d038140af33df2d337b118f378c3aca3ad28c6e15e60f011b4fadfe5246b3bf8665ed1ed80d0c81b349342a1f0830d792465937d25063c0a51cae477a20886e709902d3e004444f91266a10f2ee611877bfd13cb2f3712f882746f8e901befd34e2e1a1ce786d71d637c3aa2e51a2894284400225bab036cdd1a5917770bdda188391d2c125035ce56b03d7d472995def3596dd7e376fce0077ab24d895286a3e81f022a68b1a2816244e5749892586487cf035e5e90fba82d9e998b4108e3276147ab937deed22722fe07a2093c2886edbca70eacb807f48e267800afcb9068c00beba2176dfb935e56152d749c2fcdcc882cbea7a8bc79176dcad05fab5d743351b7f100cea7c71863693d28afd27a979911aa19ea08c8ac1d848ea4a2569e479712385e54a74727c01f19c23c720a2f1113b9f054b94e23cdcef191122a22928d2478c17191ff1b7f88bdfb386582300c28a6b9b1b70e810d8ec0d6806f4bff9fea71f37aedfbdfffee7dffff69b9faf7ffabdf9ff000000ffff0300e78e658082f50000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/fonts/glyphicons-halflings-regular.svg"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffccfd099c1bd591388ebfd7dd52eb965a52eb1cdd2369c6332379a49991c7331e0f3ef16d6cb0c760c0c63297398623c68031e0100e278681041302491c58c0cbd9929d9064cd6e4202ab1ccab5c649d84d62e76049383601ef26319ef6bfeab534a399b121d9fd7efe9fdfd86abd3ed45d55af5ebdaa7a55d584124224d808c4b160c1d9cb2efdf36f7e4b086d82a34d0be7cd5f400d7086d019b01f5db13a9bbbe4a92577c0fe10ec6fd874e5c6e15f9fb9d40bfb5f2584bb7cd3d6eba3a485bf0b6ed60ce70d170f5f72e5ea03d9a760bf1dce6fbe64e375c370dc4188fe3378fe922b6ebc78da0f1fbf07f61f25e41edfa59b3716a9b005daf71d80f33d97c201534eb0c3fedbb0df7ce995d76ffbe6bf48f7113202f7e08e5d71f5a68db907cfbe89904f238c5fbd72e3b6614ea07b09f9cc4184f7aa8d576e3ef4cf0fbf0ffbff4108bf7df8eaebaefffe19236b08f92cc06fb863f8dacdc3ca7b6d6e42f6c27992b961f345176f54571508f99205f6f5f041ea90efcf7aec87f8fd83f3330f69dfce5bd8191b7c7c788470b04fc9ebf011d81e3c4eb89d8e101d11780bf723d83f57fba6ff4072dc57f1273a72eabf7396ac5d4e0689f5d784ffc649c053e723dbeb90d4fea26c8faf7d9ab473b4087b94ed0bf44cf8c6bbe8188c56f8451bd91b3545b3d10ba3cfc69d299a36a6ed47f823c623fe23ad477a8f2c3ab2eec88623971db9f1c83d471e3a6a3cea3fda7ab4f7e882a38b8eae3b7ad9d11b8fde73f4c1a30fff9a9c3cc99e8ff79b06f72393ee478ee88e388e448f4c3f32786425dcefa223c3476e3b3272941e751c8d1e9d7e74f0e89947571edd7074f8e86d47478e3ec4ee474ffef7c9df9c7cfde4c547c45ffde657077e75e92ff9d7e59fdb7e6e8cfaa3bea8336a89eaa324723c722cf27ee49dc87f468e46b645ae8d5c11b938b2217261645d647564596461e48cc860f8f50934fa7ff247f5e384a7d8abdce40bb42ed0fe84d375e9ff47fef4930fb8ffe69fde475ab8bf28a45d21ae25cad29543cae2adeb149298ed53f46d43b3d6b163b7ac8b1e52a82be3eb50687bf475c5d2d6a170ed4b560dcd4fac8b75287cfb65bea832b87228a60caeeb508476fc692c11bb69e817c1eaba205c37341a7c675d301153746d43ca82adebd88975ebe07eba76ebfa733b147d7b294eef86a747ef5ebf3ea810b88dd85e6a668706c70e19da9d52b437dba118dba3b7e043be0db7892a7c725122aa08a9c50a5939b46bf3ae8d516ccc08c662eb82bbd8de2a6d0f1f68d2a073041d31b8a3b93dfa13868ea53d9a55c4b6f543d1e8c2c4828d974787a2c58bb45be075567c323c3aba2bba70d7828d895dd15d09f6b804de5c19842b013f3ca00c6ec61df88d8d3d69d6615f2c168c1ede0564801f2d0268cea9c1166397d9db13d1c3b58727a2434b5607630a5d37b40b105a94d89588ee5ab42bb1117fa0fd04bf3a1407768313e09610016c382721b00bbf121b2fdfd08809fed4d50e48ecba0bc9b6b898d8252ad19543fdc16fc01977fb7e324807e7cca14bbeea209b08dbe2c5e70ce176d550e222803e3127085f343107283fb86aa80c1263eea639651aa5f0a5443729fecd4df567c9ed0a1c05bac0a603b90d642948063b8cb616d8b9948fc2201349a64449b6bf2c0adc3bb9925ef71ffd659e832629f178588787cba29e3fd15fa6783c2fc5a4645e4ab4d041f5cd3ffe918f9e38d20212989222290a4b85a5c44b9a8942b28a35afd0aa62ce51c597555c87155d4e7156153157f2d33632bdd3d51d4b17bc525e2a78c5981cf38a69292189e94291f22fee7eb1021fcaaba3f5e6b14907d45176594d8cb0e79ac962523611d2860f17d9c375b93225a6b6fd839437b651c592554c87152ea718ab8a902b1b4d78ca281adbca2623364dc4d856b232e8fc3426d5ffd111da4e47d461f5d0784b1d86590871d6099f129e2705b29528b9acd2522db7e4f0562d19238323c6e088e4145d5669ca2bfaaa12c82972563157cbb2192f941d08d98cac526090c5aba5502407df8e52076d531c39255355ecb9522f6d2bc50b9253117b950ea96c8ee67a7b7b918a857c572151c8f7147af239afc79be8ca7089b88d1363624c2fc3262ce473035c775e2fea13f17486a68bc56b74af17ef4a2ef9d881f786fbf55fce2f5d1cf6f5ce9beda23717d5437aba07b6c6e9bd73f37268f1d2fcf3918d5b9eaaded03cc7428f15b3ddc5a7ceddfdd225971786a6fbddede70e14333dc5c5d7cf69b307a6afed7ef6f28f7d21f3cc36c667153a222ce50e027f39192ff055aa08d9924eeb79206a85bfe3c4762427e3cb93efd176e165e10ce22111a2f059c55e554cf0136fb6e4839f944cbce42c599cbdbdd33b79b7271fcbf57477a5127131431371bdecf6daa8ed9a15dcbbdb9e7e7a5ba6bdfdcb577df667dc9c73e8bb2baf7df6a66dcfd936def0d387fd16db16a643140505784500be37c17c4bbaa9b74093925107bd5c01a83f5849dbd543dc103704bddd5ec483ea7085ed1d1b7d823b4fb5d0f6d17d701ffee4fb27df175e125e82d1a447dd4b4c023b53f8147abab2341517ad74d9afce3dcc5d7c78cde14d56ebc3f666bb75f857676907d65bd3b687ad563251ff209d757e56048518499e94f5c8cf6255e1611899b28ae13090b2cc1b9073781d30ae81c7a6410f8c6b66c495625482e1d41d930405813d710448ab8cbe3efa7ab1c8a5f0fe366280f1f202480e920ad3c200ed9692524ab4516f6daf2b25ea6c5486637aa1f9ee156700310617af58eb94ee59b160b5cd34ea30d960ff9357b506e40dd3b80b1e19fdb3c31bb8b6a7d0eaf79e55e0af3199f96ff22ed3e80ac9e7c799dd7bf26de1dbc2c3c44582641d29db10236756f15695a0c6184d59851e56e4aa223b5036283a1803c0ee7e5972eee73987b3d9dbabe824e07c5272da6008187b15afa4387a95a0733f25a20ece4fef743a0021d92d528fdb4ef5f1340576e71c9e688f2315f5d22660b5a6e5b45d14af34b80deaa11beead7cfc65eafce637d577e93b784efd6de5de1bd44370f24a51a4edcbb92bd43fbefc4db842939b453224bc20cc237e2213aa04b20a39acd8aaf0bf14d458ba6b800b532f6c38d96de3c58c509c71ee0d37dcd83efde66d1f1bea997be31dfb06069ebae3c6b9bc6370ebea0e61d1bcf9670a1dabb70ef6de78dbade573ce29df7adb8d40ab935f250b8507a1ef4d0434653ee6cabb62d465a42ebe7026fdd503dc03f467eacefbd5dbd49d0f7c86e3a34c14fd419d4d5dea3bf45fe09bf165e33d3ca0472b5610bf4c0c09b9b1210503a9f1ee2eaf58f0a60b89b438f53973bfbde81bdf5afccdb7962d3fc513b9ebeffdf5a776ffee53fff66fa491775dc0cbddb43b9e9a45bb7a729e1075eb133295e94872b6f2176576928edc4769f1c17c65bba26cafe41f2caa27ef43fd57fbfd52362f9961a672c16c120499902069d0b4b33022145226c84142b5e48b82a014b34a3caf18aa4a734e316795545eb15495969c62cf2ad3f28aa3aab4e7145756c9e4157755999e43fcc3b93c92832a5dac1f85ea7e83c5e16ef6e614c181226abfd12ac9b8ebab2a4db0d5b8329453a2d5fdcd2dedd3f154d4518ac195c9d68e4edce5aba56e1454163308aa40b0b757b14ba5a6100aac4e365f7627ba277d500ac2288dd1539c13940f0e14c7fe40fc8cee639f83e30785a58d97a02c05617562fbd81146cab1b9d10e34cc92d9640e295b917aed79401b4916ca2afd8c345dc01b838c1e0e363ffa60eb28450171205a6b5599912b9dc1f806e4430f48608f2c419fc6e2293f9db84f3fe27c08b8400e85647518b7e36d6ee87467720d87b92b1b76465f3add1902d27332ee2bc885e46a722be8dc5b352a94faafc8231d4a5dc51c52a2b4747b1e69519a773dec77644beb3f05fbcdd5d2d977e4803a23489d920399ce08ccd79263149a0e926a7025b47baba53387f0db51da00c7866f83f665d5d2b6bb73b9d2fd8c723816faa8361ada683c25757ff83efd88f3ffd7eb43720509769a0d1df9bf9dcf8dedd1274ed53cf1dfffd70b34c38bc98bc63e5e53e3f17ec6e35d6cf82fcd2b4d55651ef6aab21efb54391b7a74c3841ef59ea6474b1b4fdd7b6e0fecf6c0e114eceae5c9e7b13790ef99ec8b69d4ffb0fd8fa63677107746e7e1f6d4ed469a8ffffaefa028caf010890bef09d3d0910293439aa643f4713e7768f44b3fa0afaaebf92e68fd10afbb9c5c2e2c1016809cc6eb0a46ea3552d1482fa701f58d4334400387d437d806be0626ee1fc26bd0c46fd40dd2e4c509da01a80589bc12aa2ab15cb929846a4e530a349e5013364311d0af6bfa434b83fe90028115ce29c9aa12cd959329bc3499805fa592d84c35c1af92635a462bf4740ab48c32e70191ddab2425c5d9abf841ebf03a020d5a87d7095a87a3b71402ed633fd1c93e3c1794ca6e3fededfd08dd8307799e97f372424e747fa81eb2a0580151ff11da88ba0e2f42e95ea7dde718ed22e4fc536956d1a99a55aca6597d1935aba6d087e8565f46ddaa29fc91da150fd3d854ccb6ed1ec70cb506fa6e0db1dd37aaaf891eb8ca40db9631c45ea68e970131a62b12aff030e80f4198ede713341cd10c6867f392582d8bcc9813c198534447c90218b9aa658b0b0f5ac08e4293a96411a193bcbea60400ce34336741066dd60dc082d190eef6384143e3e2198e6a0318f55d3680bd3f79ea92edc7e945c7b75ff2d44fce7be4b5b75f7be43cfa8b905cc4d151c409ea45da32f064a1b2ad74fc78695ba5f0e480fab317b7c2557031b58ccf5930ef160905b9541ad363a693b280338ec192cf53c5915504c40791002a8031a8983574ecd592c4a44d012c6c0ac671fd5f118c93f60a747d85a281428fa916b4bc41515889740b90a0b047d8431692b3c84504996065555992557a807aab18f5ceac2a673a4acbe01930a1ad063a9d49405531cf02b65f26edb7cbf95e64856667c99f05c3b2b41258be248202a32c91f6f3b1f47c3cdbe32cb59d810cef7247384f848a1e6fc1eb81663e379beb994dbd3d8574a1079add5d592e95a585545a4ca7a09988db39bd9da6f5a257d44353874aa1ecd6c75301fd439ccdc7afece8ff84aea353976a8907b3297d26abbb73c6f495bccf4e3fabd37d963abcfccaf6fe3b751dd3f5da15ba5cbbee137d9995bcdfca3da4a797ec28ef80ffdcba586b4adfd9aebbb32f73161f80533add439c35c09fd53eeb4e5d7b27feb8a923a5cf4fd3dd39337b16efb769b7b7f9f9b3b233efd46533fad474ffd08e1d43eb76ec00592892e2c99382a27383de3fae8116c83da41cc3b1d60cd6fef4ae1c4c15e96c795aae270fea42b00a2a262a15b676389ecdc2ccc2ccfbc0619c759a1da5248ec39cd2829a6939d9c2241401866e71a03c52a68396ea287542ab23a77455156baedcd5891775b9e0a22e07ea992014d121c09c2838898ccd240dd3890bd44ead85ace4820faa9bae5abb683751575f2b6d6feda32e93dd66fee00db3adc8dfd1d73a3aafb5afc8640cf299266d049a33d98bad7d7dadf0bb9cd9663b7115325f6b7f7f2b7770741e77102ce50f0e68cd9a9f89509d2cfc026c90765236327d1df81ef473e361d4d50d4ccf462a01d3977406103c940d56234dd49d2edc087747453d84ffb875dc1da3db47f7a11ce18690df5100be0972c246241227653096da28723d483b7db5aca74830bd0124824b1b4d4c271da3089568d166a6ff4e476ce613cf9b6ddc106df70b3bcc36d5327a3ea0c73b117794b1021184678567610cbb80077612b4c1ed55c58d42b6ec75e363bc32f48b0bbb9c19860081157acd51720382e317f9e122af0307378c7b341a4b6e2bc829136f73c1c82a495ed8310a76b47549c96d873d91581d78ca85a7f454b2d404b1b3392a381d9c106d76d604b00b25842ceca54e3a8f3af7ee55df550faaef7adea76bde7f5f7d7a21888caf349ed8bb97bb507dfa7d3c3daa02490f315b813bf928213a37d014e555a666658979c4182c2a7b96391eecccf1408dc8c228c76ad22a41f33cfca3313ec1bbf27ca2485ff8b1fc98fb47f485d1375adf6be93c127c5a50d0a1f2c14a36171cd3fc3e5c4d47d79eb99894cdf84ced696082d0dcb8a82c0b223e5a209ae8b7021d4d4c569644012415670449659540be32af187a41d04f091031db8bfe41fd25ca4ff597d03afac20bcc8f072214fd78156d7d87827dff0240e12561b2a9a641bad858d641c74658c74a5545d2265250269b1c25195aa03846714a9524e701abe0f432dda04952c2bd8aec3c60d1b93c21d6a75e17ccb9d4602481da8cda35c0e5c21c9b8a68bd277926ec797ae1de5f1efbe5de0bb5aff5efd1b3df7b4f7d76e59eca9ee3b4e1047c719cfaec7b785e65c315987622cf06c8c63acfd65834d8c0a28809d8b8be31be6c424c802f0f205fba11139fa4c8bd0a1c41e6f4304c5c76c4442f12a6079d9a25f9200ce484743a9e5c71fcf89ed373a57a91860b63126ecca760216ed25af3f05aab259d04f21506220748c9d99207cd6fab097881da718aea965c3167be36e05d796fac90e763153efaaf1484dd4eb3ad52c9d1f65c65e3e8637efaef28bad4248c7cfa06ccb3c7f0918d7494898f2c6818fb48473fa323f0aa658c7a01a09e6401e2084613eff18d0d65462c8f770ab15c359d240aa35b773a52bd4f67d2d6c9a4a207a0d79f7d06ceefa2f757807beb7a5313594dd0d50cfd2b65153fc019627002dc7647c9a4f16b18e034215c3ac1e5f6fab19b8d5249f6e0bc2f61ef536274cb78d82f290c687798432772c2ad8fa61ce86816639208b3ba8d7a6b8ad29ee3ea17402bbafbaa07e8c8fd9f7f15b422ee076f6baad156509740ab3a0bcfdc74de2344d3f30883d74162e4265296b04f618c79aa654f04c7b92700e3dc8a63af6cd5e1012b4137795c7350541587c6bc816a2901c8385095919cbd08f08ba2ce6c02fd2486f0079c25a301d1f244608a89311161c4eb74e848d7dc76804d418a1552e849e6a59808ca4c4f771ec665229e46ecb6befaf9fbd5e14f0fdf553abe888eb0ef3dda616e0895bf9bf0eccae3259c9ef09b1d2330f3156b7c7b2a5fd64aa218b28a2f8f622e90c38589a63c765a2887122f9a47511363ecdd9c47fd3d9963668ee170d9e270a3c261ae966d4e0fb45069008987b31b4ef9493036d20d1f174cfab3684c4e766b1fcd6fc4df71e248dd51847270fc839e2774cd6b2a80f67f745f716cb74166a34e0432db8b7d17d5f49c66e8b1c3d02b655d003b4d87d23ae0c0e94eb101c0413c68033d069520528ae2cc26d874013632d0afd4376e34e71b3c4aa0e0682a3a28205b57d1f6555b993272623b682f20c999ed5ae4865afb2aabb66e5d550125661f9ce77eb78769e3357d81f9827818cb5e6d6ea38a8771130f96bf464290bdae011ae6066841b2d10caf1701edfebbb66dbff6f2626bcbcd778cdc75fdb96ea41f1de99f6e8d05752bcea2c7ce9a6f6a6931cd3f4b93199aac7a08b8ba939c412e21e52c526720afa4aa4a0febe71090694ecd9ec1992c0eb469ab2a6d8e520e5a7d55a5cf517230d5a13417583bd70634b298434d4c9aa4b2303a4587cbcd23775b60be63b24e1ab3640668344ce5f1fd0c17b771b24bd25c134850744d2427eddbcca0d4edfe2dd5ff76376b6e7afc976ffef2f14d158b61afc1c236dcd0789bba40509a69fbad3fbef6da1fdfaa1ed2f6ae871fc0efae1f7d8d7e1f2f54bb70dbd0aec9f28a30c4bf4974a0b71129a69374315ac035132f580669307535c9cf29bb772facffa72320b62bea330d87eaf7e287d9bdecc44998aa603b8cf24daa2d84e0dd9305b033c43488d9b17b1f9c7ff5e573d76877ededbeeb857ffc44cf55bb1ea8f7dfd7858bf83fb37b86483f5bbb424f71b8766ff40fb934c11381de7119a1771c1eaf36c553824a9ccda5f1b39fc69252928e3d1f2c25198c676f01ad23aa41431f60b05c20de7c837896fede113dbd1fe03a71a442bf03803dfd89c255bbeeaf1a1efae64b7b0c730ce5dfbc51368cf9c815902a1270b31fe08c31bb6436990bf6df12b282528dc34bd3e78141e2ac965b3be7a3cc0864cbe9b6a59a91524e762cc363f16c89d3c3910428e53940b3643b13f662d552d722f479ae44c3a5a40ba37fb45ae2716532a479c8ac68dee4ab4a376c1da58cb94d99c6dc2e83b97286f95a3271635b999aedf894a4a35480dfcc3b13da7dd5526a317e3b4a4b81927372ca8a6ab977d602946567c145d3433033b9659fbf6b36aabf85a4e42cb7b4cec0e5ce523c081476b967305b35219585997de8b7093b078d3a8fbfa7d03f6b702e237e674c4a74a3cb25df1d4387ba261c297c78108a3caa84dda01e32af0c5ea41d050110c35ded6a1ec42545110a1f7a0c6d6f3a522caa16a669a1318ec2b35db3ca353f3db42ba3fbf00b2f61b2b52663db4168e0bae241dca23ce50ee29dd845c50ab48afc9bc89c1514c7c378110a68fe0e10bef3345fbe71acdfa7ce27c39a055416dc4dd8bba01cc9a15c8e1d63da6b335b38d4bc0c285b2c39546d51270433c58cb2066d131fb4c07a0d6a5eff44158574c9810b1aa2beb7b7140469cd9634700d00cd48204f1a28e6c59906be65f8eeae7de33e43bb32368b542afc9b1fac8416ffe6095fe5bcf34ef94db488af3a7fbb50df136b5e2d54badc4c9981212ee3fce114813588decc7c702e66e21929717b3db9424f573a15172958b9af7307c1aafb5928bc3a1cc20d74c1bf9beca0ee59f8df9c1d0a8743b861631f248aee17b5e7fa417e6b4ff6e6b5872bee5cddbe336aab7eecf907e0f91e3fe3b9534190cca307950301a9c1f153ed79b0e1cc453c92a32f234466db248814dc87936ced51a387094678cd2ad3b45e339b464c55c534c19c3611f4688a06366244dca1828ed1282f15406f031d2726155fe03f5f1c7516b97705fac2091fb05f91911fd7eed95c5982e711805eea4ac11cac972bf465fa72483e71440ed183ea7c61873b1c7637cc7968c37941b35947ca4984112c2413b3194d7c3db442117365de34c1826c612800677239644e83e61b1135d7acc18c739f3d1dd73c79c05ca8c5c3c08ec92e68f1198a90897c6180a2ce10a11ea62e54401500763b71a4bb855b397001e730a90326073727c5b94cf498c9c5a538b369749f093b00c6ebbc4a85fbf9f6edf45c9cc73eb8fdb3269bcd849bdafa99162f102249d2013c71358b4e0031d79a553a4045cbc240a218f8015844aae508f34f46b2805dc4514ab3a9bd94075422d00d8abfb7d496069462cdc9289b303a5a612f124fa045a8c4a452a6138499cb79c0ec0f64a76b4a512dda81f92833024cd636cece821e08bad0dcfa38e7f6e47aba527154fcfa5af9375131da23d8f385d5d32aadab6666cc963da02515472a232315fdc0d0c0c01005eae055a8301982f3bbdb604776f7b582290cd78cd0285e347001d3f5ba59dfbe0036711c3e1af759f34ab85ae62c28d9a992c8969a6b1a683758b2b369cc0bdd20c7ba53e90c85792e01c68f8dda2975795dddf4cad68166d77a7ad72a475b37fd52bcc519d6ebd55bcf57aff13559a6d9ed744b3973fd1c4f4ffb1f7ed9b176ce1c3acd95b159f9374e38a7072d0151a4ff4abff329f55bc073e8fff9168b6168855ed94cca51842c56d5be29eb9f6955a533ab59bac061ac9784c3700d4e543198b230bc0634ebe861099895f5523203bde4818e698d41a3a9579926c1aed289ce7f40b09b054764f874372e67bab127c2027cd3443acf2252da60bad04253403fe58c9ee608fde9b6af7b6371b30508ddd5b6f5c915952fadbbed960bbef8c9a557ec7b649d986fe1fb9abc21ab5d5ca07caa707ea1c520f296fc195b179c7dffb2cac6b3cfbdbd78f3b2551bb531c95fcd74ee68ad17285ba2051160c3156f144db5500404b3e0ea41f203a05e1b6fa7bcdee3952bcbae333c676a59a837eae83f72b19971bf4e779b69fac25e716e963f6b46b38bf2b4b7d79048272d9613ffdad5afef25dcc92fd77c4246e0ff1da41cd4ecb432a7f3e04463018eb7308e7700c79b801b524c406a9a2d5a9b305260ee0f313f43d9c716717c015cc4f1b1459c20fccca7cd49f62a8e979209646a49c76cb648505b8fb0488a00e44fc3c82f7813525e7449f918ec404fb451c90bac5690c014e5e5254b96dc720b7c8eefe10eeec939e44c2c5e29aac3c54a3c96754ba0ee3df5d489234ff19b707a0da5652377e2f95cb198e3577146391d62734fece45784fb40f621bef71050b7005faeca4cd346744d00b75d43d774f8ef4732043acd7ece60a4a8e0387c680581186322813300fe04c4b51291406e0798dc9e4d0149af08728f3f0deab1a734ec8a1aeaeeec38ea19d991bf45a30cffc331d447b722eadcae3aeab55881c6b55452133eb8c2c9a680bf773f240b4bd102fbe0006ef9286e71fe80f69baced63ab97436cf5721fa82c38a5b0d596ca87b6fe1658a549fbae8f383f11d6f176e5b44bad63e0d063634d75f854474f016faae66104bda2238b0b03a12c0661ccca2a5d558cc3a8c55acca213d7885ddd7fdf7e2326c546b2571afba332a113b4e52e009ebb62aca9be79aaa31f8e97869186dd47e1f5516be353d7ca4fdd5ba76b8f2345073fa28928194e195780b123ab6af82d65b105f358fccc7a163f73368b2db882c516147388f1f63caedf5c0f62e236d40fca8ee8f41c8b3428fb5a66a0b573fbff2a2ae454511fff375ad617383f7c837e960963f823db7f07d54f8cfc3d3da43ff948ad7ff4a0a506c83432932c869911adf5148b74cb00d59764d1c0d4cc723683e0523c4851b606f6f78bb609c11e9bbff3e49368d6e0c8c1718e2307c67c3bb6d543e3e35fb5d4a1465100e620ca61d5222cfde08dbf55d8114c30009b482737f0e35cc0d74bca83cc8fb524ab0c1e56e65619bad33b0bff47dc70d9d9e565a168f4efc38f8f16634f552a7fab1cffe00d74a671a95b961497d47c430aff26f3e674b0a845186268f5b9987a0d56971be64e2361b118a424586b4e5f74b54c0c3103aba53dc2bd1b696f8f8c3a23edfc1d60a6bbb85d6c7f2b6c69510be2e51bc6b91728bbae36ba61baaf2fe3b2188a093ee750155d20b5a51f8ca608f90122a95791a592cbc9141780acec70c9e89a084a8abb776ad0dc6caa4b53971473d6b4f880531872f9fdae0ff63903c53fd3698b69f2ed13be2b960f2f5f3edcce1f730602ce1316d8bef3958f3f4a07d423f498fa033cb71ce8f69993ef0b4dc24bc40d1afaac1a06210df608835d8bff4027ac415bac0aca403aab80b086105691f8341bba0b2ca95c98ba99c69e11d229e425665ee83f73e38f6fbaf947372e5af44fbdbda6f8a6f3af6b9bfdf243976f79e8a1c37bb8df6fffe9ed3b5efb9f07affd9f39738cf12d57ef5df2c93deccc43b50caedada9a4c168dafacb9b595354fc3ca9a4b23aa173d6b520d445c9ad0e1aa5ec9ed02450957d04eb97e9697e21397cd967da0289357cb8cea178ef36b00a68f1181ff95ce4e3ca0e711409b2f84f5a8d18b19ae10a6de42864ba77a66531bfdd8bc2bafbb2e2c2f5ab176d9ece4f21d9f5b71edb76edb613bef3c87e8c998ec9cd1b8be40f75cf0d52f7dfe950d0befdb7ac3751fbb73feba878b7d82fe82176f5d7545e022bd6771cba2dd5df9abf68cd9caaf089792261283b9722d2907901af62aae066087a5598769ab4a480d4fb5d482d4b0e01a70288c66a3e2914a913812c480cbc472485bea10a4fdd1782259733d02b7095e7182a2539035d64b1668da082866e95b4fd0ab225f57bf83c6203dd6da5779eb09f5d0136fd1035fa28f5eab6ea17fbde61a793d3ad89f784b6ff83a188816bcf296cad127de7aebf21c7df41ab8e62fd75c734ed3f85aee8330969a48869c59c30c864d3caba4ab8a511bcd0e450f58661996d62a3a124929ee00169443cdc082256300c652b2b724e8e13b3536b4bd7a31318e4b771d153ac047a86ca472dca6036474dc27ceb97f32324f852fb9e4c270885eaa3e2cface9cbb666eaf86d1cae8501da387bb01211a4b75b98c94fe98cea3033fe36cdefcdc2bc7f17a8af9001238ff076b7819b51e6baee132bee4cffc666c755f0c47d07781115531e82f2320badf03f6bed65fa568ac41789da6b78c5c9aa601b7f6feb965f5f1c9c8ada141aee76e75d5a7359cbca53a4af32bff8838ed567fcb15ee51cffab4169badc52479c97c527622161e663d827d63d49274dc87153e87b81958924ec9e18641a86732cd83f140562f8c474e2a110b5b75a032cc11469a802f23ed862f23c632cbe80505819bfe16f38256bea5fe1cb615ee3cdaf2aa76e455f567eaa1572bcc89faea98cf89bf0368ec022a6bab81e63c73f769b40578e4b1400e6de582c23f4d01aa68eac931d5c21a394dcb5597d0fffce0007d535d5c8f6baccb7a94f46519fde3563b4663f9d853a43ad6a4be14557fcaf8936a0f629103531ea6fa6b0ff48d3d9492bbc7f414772d9703e360c42c7aba30de45bbfbdd5c17de934b71a9f1bb34f8e2962217027559d20c5ec5f8b2428afc1d6cbe8c10529814cc3639b8ad72eac0dd3da7d6de6acf2e0a4bd9fd4961dc0da539031b7fb40727f486df219d5bea38335f85ae1167e01b0926687a8ceb1afdbeb01411c6ec14fae6943e626b1820d9f375e614722834fc63b7a193d6b1baf11874cfb8d28aaa163e013e5f1e3739e957b093e0791861c0bf59e73b06ab4de33b27cbc632576b7c173bd5b3da1b9fc49e33e119daba23e834420bd3699c359d06151a1415c8ce9375f3189d60a6094b475f3f3ea685533bd70534fa1a11f9bf0affc8e27331ec36fd356ee51fd52fd28d7fe4d68e2a7fa41ba185b8394efe4458255c8fd64cd2480bd42bca70906e54bff827ae5fa6a3cacbecd2d1573c948e963459c7134e784e788ef972e7b088075b15d3d5106adfd488077f2de2613f463c30ff858c21b218ef3035da210f720295ca849ce0f75217c859d7debdea3bea41f59d7b2aec8f8539d40fbebd772f5dc50e4f80cb0e3ac4784c12c2e56880cbaac1857141d60970593032442430c508768c11d1f74e062fd61d9b0216265d9c02a8a9b4ca31986afaaa6fcc09a65148a498bde640312a20185679cac3bd056f215d488b69d13b1988d5afeddefddabdf7c2f6d0ee29b0b4d6cfc0568389afc1244d84c9d900936b0c26db384cf62930016789e9c9d06ca16efac80b6f5f3c01927700923bd4b7e823cfbf7db1b68e3a4e9b1eb0cdae609014aaca4ca60bc4987adda5f55e1f1b6be1aa12d65cb2005f3fc017c6f926d605f34d463a60145ad8921f29a567d6ba518949ca348c522e5bec1954b4bba4929e4ec1219f43eff8806e268b2770eb459be0cd0f08dd5d191e140a17b0e364042b23825dd4733ace28d80417eff218bc3a7b3ae4a3159db323de94e89cd719bcecce7ba6322b17e0f41693a8e339ea76782c0e2a7823b352bca9353794cb2f6df1e98747ff549c481b5cbdedab73b3cc2663270bdcf15431be481877eb969c729d7f3da8194f45b510c3554e0c343f1556efd0116db1712adcbbc0182a56e07c63ac55807492cb48d9831211a461ac8a41f5245b76041379963c55b625b3ccff9f03680f97c5701bfa36e46ad9186d45df06bad3650f8cc0e6e4f44e2d120af6146f6f89a06f3dd2ab50a91c9dd6c6ec0ea93b0f1a78775e0e735e40c0464539d19da1e93ca8e270b4a790e8cec32e1c845379f965fbb4f95b16557ab66e717eef7bfe17d7553e75777883efea259507bbbe53f15fbca8b2e42adf8fe9b14affbad5056ba5e25ef9f1f9958bf7c55e7925f8d02d8baef4fde007d3bf5059bcc5fbfdeff9b69c59991cebe5829967c1a498b96843cc5c4df2a1e187b1522cd6cb1d981818c7e2014e1318d710ed70ba90af4577dd7565fdffe94337ffbde1aa2938f8a7e010988a4370020ebe4938f83f0407c0809e0efa2dbbd77eff5ef5c9d347f72576afaddeab3e3505e6600d660f1b02c25862a826d9fd1acc2c31b41e6367773398254f0d665bf01431765e8aa15d2e16d6d5959e02f50037a378fd33ef57370e3ff37edfd438bbeb8a5c61e0fdd1f7fade7f667823a9e9379b989f420fa30463ed7029b6a6dd94381d303761ca318b62c644e2d17d5c82bec22299ff6dcaef6bf125f5df8ff9aa30ff1cb424edf72c5cb55ded5307692d8ffffb304e17c338d5a39ea4635e2091c55e0955bc5149d03584542320dfa72fd383ea612db579741fe613e27d2e854e78a37e1f7dfd3e74fc3e304d28fade5ad236a82797d21680659a3abb7e9f3a4dfe95e96d98510d431941b7f1fa05f7fce7a378d585ca3fecacdcf1da739718a85dc3826b9ef7b14679a3875f4af5b82c5bb664afe9785e0aff5cf09162dedb69ea5ef5f5dbdfba97a66e57876fa723b503bc118fc02edc2f4e12c2bdc2bdec7e040cdd9804b6ae7697384de345eacfe9bedb69fa5ef5e7b72b70441ddea9fe9cebc69ba577ab3fdf8979352c96f261a65727c80aa6b545ab4a82f9b343d97a44056d740a1935abcfefc0a84a8b55882534999700596dc4e92a24013d7b27fb2da82b4c514dc7f53a54d35174d7232ab52fba66a69070f7b813c24cc127ffd0ed9b0e12fcd7e3e7e18b5ba0fec41d0ab96906b6a3af146b2570185d53641a994eba6096c993721aa579671e17465172f767954e5695a18b55659805f0777582c436bbba676a2bee525e8eb1981800ab9fa6b214f3eded30d2a08983ca4e6dd42db34c56571cf33f58904d218c01e02338a55ccd7171f3ac7434f45f1ea74b7e37164acf322538eeea22468117f9b8d379f8dfa179df7775b6a893e3a39a7d58a426b7e1d9f47469c4eaf159469c9954c9e03651562fe0d886e9d97fc1963accdff18aa5756daf66a39ffc83f002cb1bca8dc7f7e541dbc0d0d75217e326b9bb90c1d8be015dc1cdd9840c97107b3c859ee4000610a453716d9d5a107f6634f0f28c4cf6b2cd9fbbe7cd4bdb175f7acdc7b7ddbc7eb57d93333db740672cd8b8e5bc568f6010dd3129f1f959b3d4fbd679eefb6677ff6d17edeceb3f27df11ee0dbfa47eff478f9c9337ea5df6c2add6d573f6a5665f78dbd939b75117f2e7af8b465ff93e8e99ab89459827fc273913b88c2a8b1847cdaf2af31d4c015a0c9ffef9c03e337b58c88dd3ed85d9d0a3011ba6002be832618af808851e2eedf17ad2a974862b808613e66d82a8c736bd5a675cb0203267e68c167bd4e65eb146d2453c26a39de70d367fb3af77de96b983f6d413ffe091d30bedce15ab7579ebb44b1667ec9c4530506a76049ca9995ed1d6359b7e6d4e78c6ad1db22939302b3ce79df4ca7dc5d8a276973b6af71acc54d0bb9b66cdbd6aeeb374f595ede77c51c789b13b7ffea02172f1954fadb6f405bb822d5ebf43306596ad8966aec21cf56f915b8477843c8991d96421994b707a6a65fa2186919e9955fa0f83be585a044428f44bce4193d11f30b4b6740fb0f82e5232821ef822919a33dd03731632a10f74d1e7c2ba0890451fcfe8d285b02e0794c908692dd1a8bbaba0f77abc050c4a9815d0372d5871ddd64f8f7c7aeb752b1634e903930f3c22ceed38efec9b6ebb61d5baf633cdb4109ad91bee54fea2642efe44e765972597badcdc508ba965edd91b1666b30b379cbdb605a34027ee9b36ccba60465b4b7e7ddfa536ba28397fa67ff13a4c773f67d1daebb6cfbdccb7a119ab7909400b02b450888745339c41be44caf6ba253a278b51a04a4fe0ebb3feebbf7e41e4369362cfd814cb3774251bfdab4db17e43b13bf69bed1657db7e07dbfad936c0b6cd6c9bc46d19ce621999841e0cb45ec5dfab047a95e65e25d9ab987bc98b668bd5e10f342733b53f3a68824336fb8483998c3218a044a335864de76902095c7022596b049e4d59d8075c5148412770d821c218c1cf9cd59ffc8cfaea6792ab9ebcf6b2b4e9919fbc98dc721f236ad61b9e69b7d19694a7231b9feecad28d6de9b9b767ac8180983c77f3a7c7c92dddfbc1cafbe4cd97dc38c3dea2bed1b3dbf9d4551a45b36b9b560503a34fdd6f4fe552b37d83dcadd31fe8b96fd0ddd262e9da72111a26fcc92f8070740acf80bee1c418f414736ba40164112d6950a1652948bb135707933a5b32288fee70cf708feef86ffa127d497d0a177ce6cd5b1815a2317df483c345eedf463b8a28cab05288360f1689cce46e2b8c6a0c1a456e9e9655924cce7ab01a4ed9c3a2233d3e635b39e9a9e7a796da80d33d4918eea130da64c630343d49e694efeeea29a0f781f9a3c2147d05e9a4c47c06599a10f55863a120e9f4a9a2c74eb70c6d1fa25bec1e9fa43e5c0cb82ef8e505ae40517d58f2517dabbbd346e7d3d503434303ea73ea3fd93addad6eabe5afea5fcff360199ae5cb8d0e79d0731e15ff6ac1d9b81ee76d9c5481a100b3c958ac29594dd68eadbe77638a99160783114060e8e3ca7b6334fadfd9d67c211f1c109686e43d1830b9470e153ff48fad3d71efd62f0cc92716d743d761c6f99b5ab50a70ba6dc2f3c4441ca04b9fc1f4341f5b5974b1a01b032b2dc1e7c60d34ca72103c30d59ba5fda2d5c9f2271c60a9d9b0136751acf3805e52e03230f563b4e0d5f629cf0dfd09a7bd2f8eee539fa1fc4dbbbfac3ec33db9fba62fe2c13f552adcd0abe8417d0555b69bee3d30fae0bd376b071afd654ed025b5fc5f071893f9895ea689cb63544ac453f548b1d167d51f15318a1e83c1b4b879e4e63ea2c558b1fe4f023777900da41cc1fb1bc1c463291a468ce193731803332d8f667d1b5023c37ca9292c888145387847c9495964b017d31d4b59e0721e555c903c4ea9148e00b9bc1818a7d58392dd204040511fd0a7bb3069c16dd38128af9762023b552f9d93ee4ef6b546243398d9b0ef4b2fdbfcf96f7c7ef3b2b44f8fa599683b3754397e4d74bddf6c7637255ada333ec970bc326dd58ecbaf5999cfafbce6f21dabe81a2d801733fcb0f6d0afc957854bf95fa2d6c47bd15116fe0ddd4037fe7af49fe923bf4177d96fb8b948eb05e475e1fb02a9e7c51be9026e1e37e7d7ea17d5bd7c68f4256eee6fd009c77c43276792a26e2be8f80618472d0475ea9a134664aa357a60045153d1b5860da73096fde805150a3ee858975ea56db4fd7bf4f69f9e38423ff3537a0beeb755b88334a8fe8e9525c29cb661f5773488ea3cc0e8843e7b1afa2c07d096e3d85ffebcd20eb47728292d2a18175f842ab209ca2650919a317211c3159bb4d50b50964ade2600a983f54aaa00bc033a07fa3ed1f3c9dbb836ca6654d643ec3b81d32b5e168ba7d24e9ddde6e76f4be982d20538187bbec319c2ce48685a483d049b1c0ba3f14e1fbd2bdaa1e39ff1395d8f49321c3f315bb07ad8e8cd69dbb05b0e55a679c6d760b4ba30f6c6083801b571c59a63b88c652362c515da9daf317d4cc624e94a2dd9f51826aef27768c9aca41e6facfb05bbb703fa6aecde76163ba8d3ee2d6591911becb33c8d816131fe8c18c5324beaa186279df0615a597d68a1a381d20cf0d84e2653635a5e2cabeac43c7358bf4973cb0946a6f1d1bcc60479f8d9abafd26974daabafaa8791c3b520f21affc2ff2cd8933be19e4d2cab08ed51539695831a0f2763cb353194383179fc16f0cd6eaafef495571a62c014a0b29657b0488b2c5702cc011462a9493ac63dee2a4bc70ad67d771166059523ac905a040ba96166963122d586f7e4909f20d5a276bd2e5c34026198a8cba413bed6bec8b3ba6637b7d795d43dabde57d402ec5997c1869ec3fdc693eef08e36338f169bd71fa9c5e99a415ae5c1eaa9d7f6d15715bd561422c32af494527af47b7a18817960dad954ce08deeeae6e30cbfa68524a265c1e1b3034baf8d31cab6553139cf1d4e69cdf2fd8e2014f850a1663857b7cf4fc54963359ec3a7eb53b24cce07f1f929175b15440ce97b35a8f73a2ec899d739ce3ad66bbfef889e72beadb16336d92e959eaaff955cccbff7c2de688238b81f61703edd330cb7693721bd23d5b559259259c4779eacd95932c963a19c75cb8e959cc1227a56416306ae9d0d6ef7bfa68c10536413ac5162beca02f80f95cf77d727166c3e9c26872eae38b836e69eb6cfb4dab9d7aa7fb02376c57df649fbd557207034ecfaad1e37f9a39d319a04f59662c98619e7e05bd400e3d47172edbe18eba02b22be9ba7599fab5e700e316d1d8946995dd62eb7f74ce9ad5c94a7bcaa0073d2a3c8a3a162b2d85ffd2e8e12e88e8e44e7b45affcfbe5473a1e79a4e3c8f23f1c38f0877afbf7fbe94bec6b3f3bfd68fbd1e5bf3f70e0f7cb8fb63faacdcbc55a0e449c6460ce636bc92d87719d1c93523c2dc06eee7a860275f7515c0503eb5a0b76198b71b173a09748b3695e1663329f48e3826571d6cd8ee399e4bba15969f5ab4db3d2b9e074db86fbddc53d4530965f3e7ef86e2cc3f4fe92622f1d8ab415dbcfda2aee693faba5e9d56f0792ea3df48643afbd70f416f59e22218d71251a9cc3a4dc84bd99645e5e92c7e47f9829454c71c6e216a6b13571e866983305967fa12434ced53274b13680a461994a48ceb2a1a51dddde5a7e6eab536983992483627b1af31ee5a582d4d547910bbcaedaa01b0b19cb72a94204865d3ad6cd27c4048cae8fc2bd88626c74df2d740408a0ee9b4c00863cbd818d4598f38a3a593887e17e06b991a0fbb4999524696511d96e44bbdcc9f2e23b595ae79c53e2dea105d476b028da8e7e90289810d701c81fb04841f76c6608069b01e5045040527cbd25772750c6db7f06f32a1724460418e24918c411cad2af62f5598cb2d208de988b4d6d2c683b368dc398976eb076d33a43a5d864b3d1cb6d66c972a5cd7c0ecd9cb3e5cab3b7ec148376f519f149d0fb931fd707eddf73389d0eb540cd8291170581d3993ebd467d8e55ec5ac259a5a4c9f886de326c733b6d372e1d59ae3ee7493f165c4757cb2d61d91da294e375bcc560b3beb8faed9a1cdb2cbc20ac21613283f423ed7cf5e85098a367603e853233579ec1c4c08c5ea4dd2c367d60ee012b7a1069d7720f907180d06e07168a50faaba501649c08a60f5a9a845646bb50330bb2502292427a4bfc0ccc1449b733da715d3dde98460a4cf7d585b97ae4529a4fc4d37994dbb182941431a94db4e9a651542cf3d2e62b905066db955687d946af145db1670cead3f6a0fee3979f6dfa257ed3e4e5ead36bef37e9a80e086614cc546de6dfac70734796d21b1d0ed97a8d45ff86604d4ba3ef59bfd422d3d5c556b7fadc3afa3fefacfeaad96e36f2b8c042550b52581b6b1b514fd5d9c04ac1b5a685a45cc0b136435b5b920f2b05ac5f566e95913cad33813c7938e0405d14ebf7a1afa51517579c3dbdbd255b96c572810009d230575ff34e27bb31044a96d229bd9deab1e8496140d78fd9003d38a83c5e9d24c6305f6c23039db35a1f6b91d5e7968f2cbdd19eb40d7bce5bfda2c7af419ecb2152eb54a3811be27ea83ea877459e14e91a8d44576d3947fdc9d9166abdca635ef380d3aad188b7505a4107da771d41fd4ef575b0552ab5750b4a4cfc3b3a1ff37bf560c59cbc16695b60195f790d73562db2b5071983618e1548acd5d20cc41cf503530830b782f189ae009845f411983666531e334b66d1a4561212d7fded9477c5bc92cec68cd054b7d40fb3493a65a7a8bb53bb67d89ab2df48978d2ca7abddad8f5947df07fe373df20efdf33af559774b2ec78b7a9fe7ab0f00e28b229eabacd48cd85e71d5d997e348a26bc4279b6c76752774b9fafa4e7d93fdbb1ea7d3432b66d161e0adce07b86b2aac46908ec9d667880d66999935fdc4ce246b2d9e8c30a71f26f596ec1674c04abd2c3a8f6899df2583ab772cf7bbb64cc02563a2111424dd857b7f71d3630dcb63340dda127d40bd4a3dcc9d73d32ff65eb8577dbbb6e2e93ae3659aa2d35ea9eba7cf004c0e98f166d42c31571581d155ebd10b262d74a1e4c23a4b4e8ce32a9b88d4ab65a3a30b4b62106105490652017435f80710ddd8f8e00a583919f590060d035783e609f5f597bff34a037d1096813158ea712e2e2deb4fab9f81abd32e345c6d4822b754d261848fc959b26a1a926b224449569e823222ddc840aa13a952a99c12a44368944d8269590da671e200585a0a620358de0960396b60b9582c12101048e7ec1defc671108dacf0c4294064a99a9553c0388c662353a6f906387791cf913b6b908201755656b95d83f71106af265bcfd5e07d1403be88d6abe74a83369bce154b340f2c5d7bd39d777df2217404989c2f5ae32db959eb2fdbc644ef59ed92f3454f6ec6c0998bd7aec30b6e97068d26e23ef7fabb863fbd8715e572966fd876d3a95074e93da2db23e6d01dec0d73058f1eb65eb78da254e20a3022e13f9600d6831688cba83d198aed424f01ae0bd30868e270a630c01752055c1f2f64f8744f1a873c5c99d6a76d304eb11eac080fb2e1f82f7872b071c30333dc14cafed510d4c7a9c5e333b8873c03bd214386b7c809979eea366f8b449b795bd6625b20196745338e9c43a0fa568133f8035e97cb6cb0e95b827a4b8bcde61284a4a033893e8fde618838bd46536b62b6c56c8a142c667d6695dde5b4b705060cf6019b7b36cfbb28df49793ec09b24b3537448543fa7754abfd275f1ad4dc6057eb34b30450dd9b0e05ce296834141ef3259b86bc2cd03112315458799726673c2cb6539ab81f7a49c217fa829ecd0536a30ba924603bf48f6b6996cd3dc7ea3d3c51bcddeb41c155b792baf139a131e0bcf5b9c7a13e5f57a7dda6ef68af16bafb124459345129c9d69811a2c9a8f868339fd135815be9f162850bec7abf3163cd027541fcf523b37145bbee11fee5547efffc0fa0f37ef1c7dd6de66bffabe3607b776f3777ad66fdef5e68e17d62fc88e3eeb705ca5159bdf003cfa6c2d165cf3c7ad24585ba0ab8a825fc74ae74dafe25c205751bf9c51c5a93059656b3edd874b9dbde3352ccbddcc62eb468b0d2b21e23ad0ff22438362712956606aa22177ba36589ec7548b96275da97c7bdcb8fbdaa99aeac3e8fce30e16c76b39a00d80595a5a8e566d1955ab90acd9d2a4440c35631da7271de827a254e4df3cb198bf437dac222c2d6232b77a68acde4bddb640db3c493630af0d6559aca13c6a0abe3866b26b199fa9ac623facc45995417b1c0908aa0980c10a7727b41a84980c9660c960587590cb61ea1729d94c2cfc81b904e5c60aa963691dcc53c75cd1ac126c9d76b4bdafb5d2da87be05201cfb63c6301d69edd30885299c2ce79c4cb0e5712dd40d1a423d1acf99c795755c49d79658311a81b2f823f44a622d14be800ba7e2ed957b2bb8d2aa7d713fafed557019b5821f38c047d9d78987b593dc1bda35b5972208fb847d2cce25425ac95c90fc3fa9d5ef81f93a9c5596e4157f555990c334e05e5683a02b576e61346b39c3d8562fb5b3bc210c0066f55a927accdc865535528ed23438365055061ca579d05a54dd5f5834cfd0a674326ba35055168d453cac4025186b08986cac844669601acc321dfda0ff14c074f8b2d1ee12b27d4c4287b51a5c1649ab83b37f5a47ff00365bb4e01b252195b27d289d7df454157d3cb50a7789780f732160cb0bb608c73c65da7e5ad4ae4825b52c00689dae08d095914c34eaf52c834f34eaf19e352d7213356a3b0e2b183acdc93c8d4433113817a5e5d3140bba4b3bffdbf6583413fd7ab453b783da6c1edc31ddef0935e7f3ab3c517c0ea1277f46fa84af03efac000d0b631cd255b4e2409af46495c555ac0b81b51a48298d7106b37b95a874c06c0f0431d247919da55c9e19113031d17c0e27a59e02c535d634ae1a8b14f8ad56af5ec4429270b8760916e309c3bc038a6684e22f50bd4cc445f84d30303db175c1ec19b1c1d6748b4477257cf6e0b96e63666e5cdd2ecea737ba5dde60caee681efd45d7a2c2b9469d7d7a73cae7a6ebbb666de9f17b2fdc6a10cf3b312acee7747367488e8b57cc5fbde5dc96852aa187ff7979ef6093a3735a5b3bdef5a2ec5a898bc7d5ebc5b9f4631e87b7398d779c333b3130d8dc2ce3fd0add82f4c8459bcffbeb4922f6d393730f9cbff6cee658c10737d3fc382b488ff02d61883481463e97a0232ac3fc37628ee9e5a03c6829246d9af2806a78484b0f57daa4fdbc68d72271f82e38a6d3bc3a4016e687c20c05aca398e1d219012764b06abd60e00e70ac1ebe9d514bbfe2d58f8f5c74c9bd3b5f4eac9dbbf0950d92a3edda05736676af4e869f993530e8397fe3aa6b2c83730736cc5c3473ebb5f9457d9b78c7c75fb9fdf6573e9e39f78a850bfee5e35eefccdb179c31b3fbc2592b53c135670c78cebd7afdd5968105434d0b37ae7ee6f9959b343c7b4fbe27dc20fc33f181ed415c6e0005fa9ab2e2f769112be1437f8b7a2c1559485390311e2f5697ef62a103d0efbd7376cf39ffc2411a9c3367b745bcec907af39f2f746642853987e6ec765d76887e027723a14441bb4efddd1cbaf10268cf815f7c1d7ea2c39ffce54267c78cc21c2a9c3f67b7c47eb4c199e90e252e6f3b1f2f557f37a8c9f64aad5e20564c0961fc3b5b61f533cbdac96c07bd56e5057a07f69d5af605ab98c1c44d96957b093a41f1d4eb3cdaab170a9216ca51b78c0b3129e6ac65e357fa2f7ef4e9cf15676a4b2a453456bf3d6bcdac596b8afcd7e45c261ccee4e4130b416aff893b78e2e778621669ac9fee1b8f86f167b1b0188a6a8aeb46e95457a127e7a51eb788ca0305394db33ffc0968b7e684dd6e9b66a341f6d5acfefed08fe8f61f1da2de66d8b5dbd537ecf89550ff5b3df4931f129ea68913ecefa3a043f49133c8fd4469cb2a2d2cafb13f576e13500ab74d0329dccb264047b5ece8c5630ea9e6b2b11cc6f90ef40cca4a5682142e0b0e4c6c44a3d319ea672d073a1ed0f115c03c7bbf56d4683a461571b8f4d1068d74afd22b7d8558fcc919036768ab668aab17fddfa0704ac0e8dd5da07d6274a0e04d804090bc9875afc718414c7277b9c33cf09664a32e56e095a63fa95bbb389899b33c1b1bdab9a2989abfaabf8dffa2a167f160bc7f65a1a5fc48f1b3e7b4f8f739a469ee265198b5e80f4f0fada2a5659bec748568f3677b870aebef9a2b2e5f21b8da675f36e7cc2556b56a135dedfd9b073ef9b479d972696dcb162e1c6af7c97a11ec79a7a16fb4cdf989c1857e6dfdf762fe33c273e44cb29ba0accc56513549b095c318ab446dae965decc51f2e1bd271115b121a60b5e65b73651fab72e8c38ac522f37b8944cb1eb76bde0a8c0af161815b6ed6c233915676e9cbe6e664b66706eed89c4a275035db03935a6e00262922953ab582addd79a415d091c99144177b7386a897dd613d865c7aa02d0ed07c0f8e612fd63e4bb16898f4800ee34d8a4ffadc26239fedd8f8e4eddf7d78decae6e4d9ee569dde6a701bbcdc7baf1864b97566e22239faa9f8d2fe5ceb8af434ef4f72e9355e5f412f9b248b649c6e69e6868a9d8540df9a0b5a86ca37742d690ac9edbde6a01c75266d2d4231b733d4d763b6d178f8d37e2f3f47100216f3fd824d6f354ba6c27d0b99bc012b5b78bea6cf44ea353f6d8c0fcd552450c90cd6be62182b249a908c1c6e39b67652a10fd4bed9ea1f4ba560cb2027b6f377e0aa1e5793117af6de04ac78f164ad068c3e0f825a6b1a5840a5bb5a2202b0b795c5c733ef5c39c45645435e2c79867595b047395620438ba7c3970b60489dd50b3f34d55ef362629984a52096207780daeacfe5f63b1db281790f5c289bb0ba463d0c4f314830239092dbc0722015aba448d8b99a591e6375a67181472b7cc44b5a11a402a891dcab155c3b1a29b2ac9b4dc54de8f02e522cd1838460855ef1ac56d2e8181624aa5426d0c4c6f20be364af162758124d586988f9250157035b2f03c9e9608ede2626523d5309200232761990055e96900036075bf70a4535a2b88038fb0326b341d3f2b25868ab9100a246807804b30c041d6619949a1c70ce871e1e9842c7a951c39e9f4c95066a200d8a6324d9344e0d8d02dcc171aa9cd88ea9388c0797d678c4095cb2a55eb3345b73f70021185394ad3656b690094b6f5691305a70bfcb2119d8eb5c44f67a24178b1fc40a4c66863d7bd70d56bd3940385ecfaa43d830cb5527b0315c736d30c4785ce090d0daaa7137f232f499d6a77506072d1d10e1df0443075f0e51211f8683c8a07722266527aba9edc4b21e9824c65ec5a398d187b5dfc57ac7caf014190e260d0789b130c3c1351107cf641c920c7a8d41d38d38b0b2c3c54d8d381419f0f88f71254e918c2f6bb68d093813b158456a0898ebb9218eda5065b0db0f9745b30ba72460522c33c92ad97a4c46dc8224d6e0361b50b4f27a2d7ba54e705607acfec9cb7571c2f2c058395b0c5a6c203a2bd53df6f9dfc16b0678ed0c5e1bbe0aa96c627386c989f09a3c1f016f5ee61b601e83b7a201592bc07b7a70c76a3c6af9e6d3c673769ba6a6447bc712a1a7449ac45393f3c63091f1b177df791c93172b5a2aa396b1a8e52f6a27de3aa39ee6d8d75a5f4babc312478fe624681253a14191e105b1c0522c49a9c985cdf8a952c52743e8eaa0ef3e86b06086e5e3ef4c00b24297a9fb11d2c7dfd1f22f1b21552dc78f8faffdfdafe18d8fc31bfb5be0f506318d770ca209f0168f1fd7a07df7b1c9d016019165ff7fa76d8c4758ebfd3c11567aecb8c6048808f0c8ec06d20e03ac8c27756e96571820fd646c3a66ae1fcc18706ad58d2de84cc6601610a52233a1ea511b8a2429bc165b8f8110aeee31e0baeb506baf77abd4e1a223080fc255511f531fc357814d48c0653afbcf08e50f0b9f070ae27a7494957a776b1922ee00cb794185044801b65b844313299d12633dce424f16943050b388a8ff99c943338fe87446a3d16ae50276a3c107e3f3731c6730181d46e125f5bf974a9ce85217bb65b3813ab8ad40754ea02f582493c1ae7b4dfdb721577ddc321af9009ad9a4ccd76864c0bc6b4c290ab0d80d1081eeb1616cd6066f29c0d7a20f1587a434695545a1fb0add314c76641e2a8cec64052218fdb02fb3dd5abe313d66ab9c7ffe136fe12cc4040c10cefe358d565f13448e7b8bad0568546c88fb0f916672760d4e3b9b40030058b2c10e336b9e9b14aa77607e7dd9e70f47a2cda8ed822e90c0d500cc583118c3f1043b1a9098776d2c913a2dd6bc6c5a526f218d3189499619501b39cb6faa674d3fba7571f189b7e81fe9c607e9b107d5a7b5eebf68d1cd9fa933e34dcbdf7ac248d73ca85a1e54bf38257fe5dc7ace4d90bd84c3d598c5a265de60d908b03afd631ea9fa8bce5e148c269bdde9d2b215bd413842f5a2c5ea90a66652d6f370b85af188d365e278afdd7bedb57b4f9f88e3c4d3d736c82cf47d3a40cfcbd46af64bb52a0dec258e324bd433806ec0d6139de830f6d47c88f5716fe458e97e98d16d66c167769cf0d197e8cb18ad889530b170acdda4bec347b1d230b2c9e8d61c39c5f3db263dff6f7bb81626689cf0f4764c4457e7d20bc69fce1d64ab52c73e7863c2b36f8567634dcd3aeeda6cacd38a4e82f666c9e1e325f678091f2f4fc11d4ccf090fefe4cefe9cba600ff78986877f53fd2177f623ea82874667e6c6decf8578a38fd63786b95cc575319d5667dcc330f7b0477bf0d181c9987b0b693e2661f66823001b0f6d1ce60e1e7ff0d083f4070dd4ff8f8b5edb78e228f74fff0527546b8e9053c091390d1caedcdf008acb2b7a0b5e891f0765fba18b5ee3f1797fd9d4408be2a18d87e85b00df67a053b03bf05d812759bd411d31601e87c06c3c23cb6cd257b1a29c96348f393d46ea151e5693ea7757707b4737d02ff28e0fbe431dea0d746f957f7a74465d8f2932bc30d37219c159420f9da8679d88efd3b0e5ca7a89bd2c42803d43aefe6e3f903d7a267eeccc0dc452e5ec7ac959367abc9af7471327f88e012fcb08475d369e42f287e4e3c0ed95b07bcfe8bc3d180b562ceee1df748799003cf13c26e0abc321fe920ae3bd01565fcfc5e2ed0609ea8100a19d416807e3a1acb74f002f5c9f90f5ac023129e941fc95cd5ead804b035032483e0652b280e94ffc3860eadbdb76a8772264f36fde4637deac268f3642f8bafaceb65b01c0129cdc70b3dacc3f5da98f13bdcec660f592047b0f62730330c986ca091a55c4341da389ab21edb106c6254fab87d5176b042a3efdf4adf5ffe3a0a8efb38b18b13e788d9dbced1f714b1aeb8e3a19edae20e80b00dad918ed6c68bae81da85a0730ddb824187263e4b30385c9f8a20d9b1ced0ee46574cd5a72ccd917c012242e4c1db7c1c48804d60e9926d2195fb6c9c8ac29df3c8b5ad7e83cac5a187adaca73a581c6602f0056cf3041c4ca02572ae414389d770a9c4e85cd04f81b8157429262051430e9a36cb6f831d80026c8c928d43a4a5bde37d271142a40fdab180e6c815f3dd488c35515d637352cf04da9751c2a2cf6cdc83865726e80a6098259a2d567fb60a5a0d4ead3d191e32c363855645529469dec86b88e7431dceff99aeda455bcbe1d57254a9e401ecb9794bc4dec459025b33d8faf822c591c35ea88f5f2b866e63e436f8387cdc08c4866135a4f3acd48c5a082fd026f77d4d67b9c6e59d22a326a299da06e94a81be867d0bc93184e0bff987b817d984e84bb7d18d5afe991acf6f348ad18346b8221f62af775b67a8771b7875f656b79a4beaea9f97eb318d7e266b26f7aed1595e83cf19a715ada6f74780d58d719d7658c4c3337999973b053138f52edadcada461cab5791746336ce786d78ed2dafc55caef69f1504618500cd26d66e0ea86ffb93dc4156f61a735ceadbe1efa967b01a17ff520c75c77c7a9d7a1dabb7b72ae2923ee6981fa9e7c2683190798c88612b8f96bc92af9639b30dedda966cd9d794c46cf234be03012376b57768b6e431c13dca0a16c7ba1172f6de1e19ebcbf2dd2ca00fd8872d92e2b483ed308fdabb9d028597f8e931ff123ac20275737ebf3abcc4af5afc4bd4e1fa91199614dda56e4d5966cca03aa36ce3b779fc949b0187d5ad74d7d8e11377e3e1da3aa66623f948137b437f86e448d957d3123ca84f979d2c95c7e946774f36ab1536723a184bf99b9806274db1860af924d6621eb3d5635e3909e8a463b43b296b51e4a0991e42e368a47219e8316b8bea3dc57af2026658a62f63c75099d2aed60c97d179958bd4c3c531b37ea472d14595e2e882c73ff138f48b8338842f085f2431429c61de1be6f3b3690f6674020153e954ba3040314ad84e1d3432f0c0c597fcf8a19b2391c71d7ad7375c5d8e1beedbbe63f356496ffba4adc3f918ffdbb67bee3b74f1250fcc8ad1c7a4aced6e9b206dbd64fb8e7bb74a9dee975d7ae9b186d87d9cdfb2b5fa49f88ed62c8bd13b235b9ac3de9c1a443b320b03ac45da9f6acd0d32a26118428176770df039645eb996ffc42210dc9ed97480cea23d51afecc6eacfa0ed678444dc26d8a96ca357729ed4aae155290fa5f44a9b1c76b3a290eeefba43bbe9c06ed6d0078adb5fdfb1fec98f1567b71b8db943eebc8d66738235ea97ddc1269329a7fed8967763b93bee19d92fc484b8fc80cff7801c87a65fae18fc6774b6cab1969698d934367e31e6d73fb6d64e2756e68dc9c9581a6bb6ca5a757af691bcecad1c27f9afdcc25eb3a15593afec39cede3e8d7fea70b1e65ba83fc3c82ad634d756d3dd7926ed4c8dd15dda44a0bd1964acb05fbd844d7eac55c4b0029601c4be8ab51dcdecae876b700735ae62e625be27aa56330f6b256056d89935bf8163cce6696ff01b489adf00dfcd2739586dff68a2659a566d80ed07a2a996562d897352993c5c5273a19330af55ebd7e5d17108ea04d69757b67307319f7274de76855546620c8fb5a6806eb53798d16378856a812d6663ecd38418f3721735c7ddff239cfc7f1f4e6ccad0d8807e384eb517089c16a30a73ecb1b7128c61244cc02708dadabc29d8344fc52659c366bf5b6e0a8de1b2df1d688a7f48ef000343efd0daf7697b8539512a9ae93511030639839f898a09b07b602c4d853d3015f6e038ec5edf38ecb2a756c7e294b0eb2457073d25c435293b1154f5b13d7878cf9ea93ce3655c73ee38a46eb6fe93c86109c6247b854f0df69606d8dd63d1fd987b15ab6259f2665a7f1f430d33edfd3ea7849fbd591ad79bdba880d33a2871a7c00669bcb3b5a737b593ba7a5a77ee4c9d6258e07ac7d93bd7acd9793696c49a3c1e122c9f75722f4c9bda0b6d0d633cd69c9e301ee2a996bf758c27d81a4ee2c346f8fd455c25a87ce808473f37d14fc045cb6a4a93a553b069998a4d6b0d9b17dd72a0291c8927c7f079d1ed0b04c3d1e6d4876394c05c56ef47e053dc8c03fb34c88cb058ef3164501f9e804f88c5aab59147c6f109674bf156d0aa228cfd98b980dc98624c98ce9d4a9a853159205763402d0d70bfdfdb6460795f017c8f18f2637cac9e3b065cb5569556070bc0cae22affb84024a56084793d1537e6649c9a3c5ee8ebda7a1c9028c6562c7049e89424da54dcf4af6c4c56a6c8bf0aeb6b9c209990644b4593ebca84308f42f3cb859807d4cf96266b74084f7a6523623ce69d63a6941bf8d76473f1ec457e8a247dd968179cde2063864008332aac0ee2aebd2e4dee553ccefd7a8b444f5f3ea7bb1063b643ec748ebbd0b89277fa3737b20941f3dd08c2be1aae51d009f6d6b18d654133c020c560b641002527211c85f3b97294a5e344c360616aafb657fc39cc3cf1e6f09da5d2b81336ea66e173cc518945b83cf8d2d8fd46bb536004f9ffb176eec15555671b5fcfe1e41e8e27f790406e7289408ee73df7938010481003021f7e624215b9444824102e0182979656a494a2a58a5625d556ac558a34a8b468a96d955ab4d45eb44a6b653a0ee3381d873ab4fdc799267dd7de4f4882b1d3e9f49cacf3aebdce5abf7539cfde7bad9db5d7aeaa648394f119c945c5e576d45930a45186ce98bbd828aa09a743649fc963cf8e9fd13463ecfe6dfb44ad9fdd348fd801cbc053f03dfd17fa7bbc2f7beb4d89ee2b1b9dd96c4e2fa9d76767a4a4bb7771570d1966d91dc0bd2336cdbdf8636f64f4dae7a81416dbc7441f2d1fab5dd5bc4af565d9a715f82becff41ec434c9f3359e39c7afb729ef517ba9764463907663db2b9f71855a539332f0af28b7c80fb9f6adfc68567b16f47f7a143dd81a9538fad7fe88c67d6f5a87546dc38bf6893d5c1e1dbba9ff1add8f6cec363b27d6bfb1ee0b31e07eb66aff797b9ff7d28b3f3cbdc8589cbb4cc392529ee052ba72019b053b08617c39d24a1a548c7a3b87fd9f6e1c5702f5e38e578b46f5d494febf64f17c49e33c079b9eb747c6154e069dad1ad441876515084edc00ccb7458d677e190fdd344d69e3a505373c0b97a706ddf7303f7b36e574edc7b40cf39262fbfb0480b6927a5e42830878b38397598c41a1416d8396fdb93577adb9b12536d2e8158537b46a8eef69692fb97751df4f8329ada3d27a2cd05fec2a6f6b2be376a6a102a6b6f2a2eaf583d7de95da92d1b0f7679fda33ced5c23d5d4070e35efbff9b269ff30e53a1ad7d71b37e5de3960fb6bfb7b52de4bb1ab73a6bb631b77de564a71ff4ee7b3b6efcd94f7dc995c435e8fe1b8a9b6b421ae75c0efd96b530e5ae8d8017334d51fe80edaa55bd5ce75adfd8ee94f797bfb2fb89cfebfd9130499f63a5d911ba7ffb8eb86e53de0c6a9bb95718b06d398125ad07a95d1c33470b71dbff79274c3ea3582b3693d8365bf181e1d529f636a2b474edf7f808c81b0fddcde3242fc01fe6efa4f0dcfd3deafede4f982fafd763ef967b8817a7a87d4dbbad3eada866c57b11d06b63bb5bcafd03d3642bbd8dfe67db573d4d6da6b7e836da4e3d8e175b66b7d150c499bcef015ac7f0ac3538638e33de9e6e34ab0ff8c596892762624269956673ed5e07bf075c6f9f40e0929ba44cbad0ed1effafa7b86bf6d4a3d2a5dd0ef743f3693f95e6d9ef8776f04311777e071bc8a37d0e789791ef29cf69c1f3575d4fc517ff76e4a694df5a556a536a4be9f363fad37edc3f4fd19c18ce519fb3333331b3277679ecf5a92755fd687d933b39f1d5d36ba7bf461df34df4adf5edfd9cbfee85fe0dfe37f3bc7973331677f6e7eeef2dc3379f9797bf333f397e71f2f185fb0af7079e199a2a6a29d451f14c78a77149f183379cc83633e29995b725bc9f3256f95bc5f9a5b1a289d5fbab3f4f9d2b3a517c6668f6d1efbf8d88fc605c7bd54965db6bbec2fe50de58f96bf54fee70a6f457545434547c5bd151f57b655be5c35b3ead5cbefb8fcf0f8dcf1fbc69f9b903b61d7844726f44ef86862f3c45d134f4cfc6052f6a4b649af5707ab6faceebd2270c5e9c9ab279f9bb26cca5353ce4f4d4cdd5bf3839ab38165813d810b578682fee011a9926e391df2840e879786f744f2233d91bee8f2e8f1983fb6247624ee8d2f8d3f19ff283133f144323d3937b92b79b236bd767e6d57edc9ba92ba9575a7a705a62d99f6faf4d0f407a7f75d15bbead0557f9a513663f78c8f672e98f978bdbf7e6bfdd9591b667d307bebec730d931b1634ec758e5eef6a9fd25903c65921c1a32331bb90fbc071ed32f3228f717976cd097b27a2d73ea1ee56dd72fd30d5e60efa3dc6a7bfb2eb1f65fbdef47b4dc2f4d19f62ba11a33fd5c47084fe74538873f467a8ff13fab3cc788f9ffe6cf547e8cf537f33fda74ca167a00caf99a0e7be6ddbb605d6746cdfd0d6beaa73fde6c0aace7566b6e9341b54ab9b4cbb5963da4c97f6a19e561732412326acbe95fa6d8569322bcc7ab5f334fe56f5dbf86b4d4043ea4d87be2b8610363b5bb7a8bd45ed56fd6cd598576bcd5bcc223d8a5fa3b92e340bcc751a6faeb23a74ffe8d0d4eb35fd66b358e3af315b34c4e6229a32e894a5ce5cafb9dfa0e9ea46647d9a547309eb3f2d41c525e99638f5d8acdf773a6d30b44c8b1c86bb3518daa631bbcc2a27fed68b290226ae9f75669d52d72ad3c659ada136e795dae20113755c42db3da4c7aaffae9623ff5223876e73de014ddda1bff2062d773b4bbd5943ad6fddff2cce0d5aca955a721bda75b14dae659b5ea7df6e704263ce67d4d4aa8be8a7bdc36b508f3c56f71f332133d2eb5ddd4f3db0abcc8e363e78918254a4215d7b7e99c8423646c3e75cdacd41aef92bf2908f0214a208c518831294622cc6c1de5750814a54e1728cc7044cc42454e30a4cb6cbd1a0c659e12b084148bb76114411431c0924b5b358876998ae7dcc1998897accc26ceda337620eaed6e3fb3568c23cccc7b55880855884ffc3625c87ffc7f558821bd08c162cc5e770236ec232dc6c57e0c14aac422b6ec16aac411bda712bd6a203ebb01e9dd8808dd884cde8c2166cc53674633b6ec3ed7a16b9139fc717b0035fc497701776e26eecc297b11b5fc11e7c157b710feec5d7b00f5fc77db81ffbf1001ec437f0101ec62338801e7c53bbc18fe15bf8b69e8f0ee2097c074fe2bb780a4fe310be87c3780647f07df4e2289ec573781ec77424f2431cc70b78113fc209fc182fe127f8297e8697f10a4ee2e77a56fb054ee135bc8e5fe2347ea5e7b85fe337f82d7e8737f1167e8fb7f14eaa735892b42debdb83c160836beb83d6863480566843b461da086d9436461ba74dd02669eb5d1b9ae3dae81c6fe3964d9dce468c99c419391e742235b2108d2c44230bd1c8423432f34666dec8cc1b997923336f0c0a39428e9023e44884963c214fc813f284bc107921f242e485c80b9117222f445e88bc107921f2c2e485c90b9317262f4c5e98bc307961f2c2e485c98b9017212f425e84bc087911f222e445c88b9017212f4a5e94bc287951f2a2e445c98b9217252f4a5e94bc1879317262e4c4c88991132327464e8c9c18397172e22c579cbc387971f2e2e4c5c98b9317272f4e5e82bc047909f212e425c84b9097202f415e82bc047949f292e425c94b9297242f495e92bca4cb13ea5ea87ba1eec5ddf9d4466963b403e912b46e3984fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5fa87fa1fe85fa17ea5ea87ba1ee85ba17ea5ea87ba1ee85ba17ea5ea87ba1ee85ba17ea5e62e451ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fd0bf52fd4bf50ff42fdcb80ee93e4245d8e9e3d8ea3ffee5edc63e6f5a62f6a3e0adcdb72744eea94e6ca5e7fcbbcdefcc5ead9d132ae3775ca8dcd2dbdf953ecf591c5f115ff34e65f000000ffff010000ffff9de12aa314a10000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffccfd09981bd5952800df535552696b49555249ea457b4beab6d452abd46ab5dc767bdf8d6d6c9636ab8d65c098a559626c3004086171626808981093c481b00c10b8259b40f29c99840446c9a06c633a093361ec24c363426032e0793331eef2ff9dab52777b0bc99bf7ffdfdfb654ab6e9d73eeb9e79e7bb622400891801081b8172e3ceb8ccbffebb7bf23043a08211d8be62f580816221002038490c8ca3539f5b2a797dd49080c1342d66db86afdc86f162ff71302af10c25db161cb0d11d2c5df4d88d04908b15c3a72d9556bf6e59e2644c810c26dbc6cfdf52384103721e687f0fa65576ebb74da4f9eb89710f36384dc1bb87ce3fa0a089b1f23e4fe7d8490fecb2fdfb8dea60a2e42eeff0321a4f3f2ab6ed8fabdbf93ee2764d44d0877f8ca6b36ac571f3eeb2642be8030be72d5faad239c007b0879683fc27bf5faab361ef8db473f22e4a17f2684df3e72cdf537bc3167f41c42be384c88e5ce91eb368ed00fd35e42f6fc3321247be3c64b2e5dafaf2e11f2350721c44c08a30e7963e6e33fc1ed8f2fcc3ed2d8cab7b02b4e424800cf108e0001f21601a416e10821bc703b8c1213117807f75342c8798d2d7c9da8dc2bf8131339f5dfd9cbce5d41669396df10febbc7dc849802647b1312e32fc28e78e3d3c18e085408cff63a88008b0921d88a89c1d84222244df6446c915ce4e2c8f3313909296bca75903f683dd87ab0fb60f9e092836b0fae3bb8e9e0b683f71e7ce490f550eba1ee43e5430b0f2d39b4f6d0a643db0edd7be8e1438ffe861c3b864f62ed4d237b22e484f6c841d341f7c1c8c1de83b30fae3ab8eee02507470ede7670f4101c721f8a1cea3d34fbd0e243ab0ead3b3472e8b643a3871e61edc1b1ff3cf6db636f1dbbf4a0f82fbffd977dff72f9dbfc5bcaaf9cbfb2465a2381881c7144cc11123e123e1cfe28fc7ef87f870f85b786af0b5f19be34bc2e7c71786d784df88cf0a2f09cf0ecd05bc7d1e8ffc91f9827090f9cd1b353ffa0d1058d7fc2e9baf4ff4ffecc271c136f73e713ffee275ddc7f5392a1c4b38c2e5f354c976e594b497c56809ad3c333d7b273b7ac8d1ca0e0c9067a2864226f5147ba87729965ab8717c4d7467b289fd91488d0d9ab86a374f6da1e2a64f0a7d178f4a6e15fb7d7d7b62f5b3d3c3cdefefedaf678949ad2c374e196b5ecc2dab5811e6acab45c705e0f3567b418dcb36a9846eeb9e082764ad6f65031a375b253b3274e5932b21429e77aa83513b9051ff283f6fada08e5134be2112a249752b26a78c7c61deb23b833d01e8dae6ddfc18e56378ef081b60674ee7677746d0fb567223f67e83832911c15d3170c47228be20bd75f11198e542e613f6ac7fb5af0c991722eb223b268c7c2f5f11d911d71f6b83825ab87e9ec81f6e8daf6389ea0b337e2c1da1eea644f9a39168846db23633b96ad1e8eec882f895072b6011b42d4435d997864cc78783c32bc6c4d7b94c2dae11d54482e89ef8847762cd9115f8f3f60b0b04d0f756337c8e91e2a2102b8239f80c00ec423befe8a75533141543c99c88ec88ebb916c4b2bf11d228dac1a9ed1feddb53dd49bd94b66c3ecb97361d92b6eb281b06fbcf9ec61fc5e3d1cbf2442497c6efb25110af1b96b2374f6eae12a8990791be6562102f336cca5910db4756347f3594a86c2bc0d714ae66d88f720bb018163c7888b70a48b10b89c8f109e8824ab01c9cda88a02f7beaa994dff3ca3ca73dcfb2ad1783c6dc2d355d1cc1f9d51053c5f90a252a220c5bb60b6feee1fffc8478e1eece27e4a80544845582e2c277ed24928c9d19602853ab5ab400339ea19a32695ca752aaa5a2ba4496fde538ca64a7ea92095fc625489fac5941497c454a902fccb3b5faebdbcf365e0f5f1e6eee1134ee8e3ec36438cb0e7dac95252b51192c6878bece126b50ac496de3b1b786b1aa823476d639453a9b54e05b56ab5e125ab684d576d56dcb5116b5a6b61d0b542546afe8351c8c0a83ea21f98dcd3476094e16c123e2fbc404a640ba16a8e76d5ab5d2a36d595b53238a20c8eb04a4d39da51a0e63a6d53a992a3f67a55b1e38d8a1b211bc8d112832c56d7826155a531b7d60369ea5669b64e5daa5686b4162b493215cbb447aada236ab95c462a960a7da578a9d05fea2fa87e9f3fde97e5e231272746c5a859898ad190505087b862c12c9ae3b154165295cab5a6b72a7727967d6adf872333cc2f15962f0d05caf36779e0e68a7ec00cbb2afa016b6f795e41092e5d5e7821bc7ef3d3f51b3be73ae0702557ac3c7ddeceef5c764569b8b7d59b396fa892edaf2cbd616edad5d67b6ef1f92b3ef595ec735b09f6490d4685e5dc7ec21399f1025f072ae43413a3ad272a456bfc9d47b72339195f1efb1032c2abc21ce2236142f91c75d5a9ad0ed49fd30290269a8d9764cd2197cbbd79deeb2b44d5fe625f321e13b3108f9915afdf09ce6b57721f6c7df6d9add94ce6a5abbff84b6eeed9f0c1aaeb9ebf69eb379ceb6ffcc5a3ad0ee766a64354042a2c270211898db41052047f091292d524c168ad06a31faf828c7e801be686f50390a9e0497da4c68e0e8f3fc99daf3b2033fe0c6a08c73e3af691f01de13b842366d4bdc444aae48754c95feaefcb413226b6c019ff72de1877e9d839631b5a5a1e7575ba5a46fee5ccc6890b5a52ce475b5a4ed03f48bec9cf54a0c44a0aa46a467e16eb945781da72d43246f97a95b720e7f0266bba6ae171d762b6a6353b23ae140529aa448b5149a008ecd1839011e8f85be36f552a5c12db77128bb05c789144084986a03404452921254527f88da3bea4687282222545b3d079cfca39c3dcf0eca52bcf95a57b572e5ce3b48dbb6dced94b577eeeeaee3665dd34eea2dde3ffe5f6b75dd75fea6ef59f59e2afb5d9f9eff11edbf84a29d08a33bbffd81f841f088f120f69276b49d58918c939eaafd3f6066374e4288c51a54e1537ca066aaa6b41486bad8a24efe539b7dce92f539344c532d164a724536b99fa25ea2ed376792f10d1d4e92ff7e665378910c52b82cfeb02732c05e6788c73fb22fdee64c40f1d300a1d2b20238a5759bc16fdc08df7d53ef32ac8dffb9efe01bc8fd7f4dfd5eebb513f60f15aae1245c8ace0aed4fff8eaf740fe1e760b8ef361e145613e69250a01da96a3648c3aebd459d7da19d53d7d435c08fc435c8853bc4e5ecc0a9581f36ebc715ba6f7e6ad9f1aee9fb7edce6786869ebe73db3cde3d7bcb9a1e61c9fc058b859e355b6697b7ddf6e9ead967573f7ddb3642b863af9045c2c3022536e220848f7a0a9e2878ace0e14b8be15f1ee41e845fea773ca0dfa6dff1e0431c1f61a2e8f7fa2cf0e8efc3dfe9b39077f8e3daf09100a12d39ea616248502786546ffeb8d63d7eb1e44f95e229f1e4e7ccfbc192ef7e7fe9f7de3b63c5299ec8dd70df6f3ebff35f3fff8fffd8e465c6bb1e42f24528c69233a1af5ff505c16b8e2ba0c0686216fd6f3a2b01a3f703541e2ed4b653babd5678b8a21fbb9fe07864bf5fcee6253b71110ff19376122671922269922305424995200709752d1051552ae668ac402d75daa9527b8e260bd451a75d2a75e5e8b40275d76946a59e1ccd16a8b74e7b55c43fa416901c40fb583f0af5bd1687dbdbe957a9e04611b5d7da22297818a8d30e95061a5c195469a4beb7b32bd38b97226e2d0ae9bd89ee9e3c1ef275ad8882ca619764adadbd5ca62e49eb08a2c0cab3f9b2182f9ef04129588c4a5138c535817ebcaf32f157e386c79f619ffdcd53958ab07cea2d284b2b307a747bf344859172626e749130c99159642ea9b620f532052a309205737406234d9f0a7436a3879bcd8f01955add5a04d248b4ee3a1d50b5398c6f8a525f7f21aafa14c96b8e4763c95638fe183ee17a508151251854f4112538759f1b3edd1575ca69eeaa2907e3df39dd1542ac27e1be925c4cae219f26f7932d0d2a6833ae2c201db4be8aaad2604e5bbebd80b4d0e6dfa0aab427a75df0f9428176d6b5b3ee5455a0a3481dcd8d4c67ad6b812edcbab55e486bb357a92a2dd7b5c5c3b8756beb20ad8ddca6aa74535ddb7a8faa6a0f30cae1581884c66848432c2915fffc3114ffbf7b7f50a929c1d37ec1e8692ffd45d7d5892378f254bb47fff37f7a03314fc88ba97d7c8ec1e333b06f691f1bfecb0bb4a34ee763afd20bb04fe9592ad075c7f5a8ff343daaad3f75ef797d83a0f6cf84be641a6266e5c4ebd87b382ef018c785f409c7a725e404b5b9fd78667cbe123cddfe549a37f794da5f415194e14112133e14a61113211e2ba42015842778f5c0f8d77e0cafeb17f07d07c6bff613bcef0a7285b0505848ececbe9215fc5610ad7005b4e9ef1c8036683ba0bfc3bef47760e8f8e303780f2ef1a7ea0629f2f271da41478ec60b3458a751b5da114435a723694d57831db81b0c5bd34dfda16b8afe9084340da93451a711b59a48e2ad89b8355d4d267037d9614dd3c48496d10d692da9487295f3b597cb659a90a85ca6adf25edeef6e9ba275f86549a6eeb216744af25e62520278ad5daa7a5ba15cfe04dd832f168a05a5a0c49578f1cfea210b2bb54aa5f609da88be166faa542669f725a65785c985a7d2ac22276b565143b37a0935ab8ee09fd1ad5e42ddaa23f489da151f2d464fc66cebce49cc506b800f0cc4766ed3df147de255160ba4cf6088bd0aee57f5b535a62b12bff0a840493b4993058406726c1990c1714ac57a55648b3991b7a6a9e8d61c90a69e7ad5e1c1930eb7358d4b26cd214af25e7fa023dee9670ba43eb9a43841f1fa22b86848157db2e27572b12c078d015c948c01ecfff9d3976d3f02971cd97ed9d33f3f7ff79b7f7873f7f9f0eba052c1d1515182dcf0cbd035f454a9b6553b7244db5a2b3d35a4fff2e52d6fee3efffcdd6f826372ce2202a91010a8a04de831bda42a1092d62c8e4201a83b4785312ad611090ba4a9bd4eed0d745c754d62d2a65490e2201526fe556a35c8d42ab54a0d7081028775071f397a50a01faf42bab591766197b08b2c2267924b0832c1aa3a5d96a3fd75a0ab19f516d7e962b77606a471425b03696d319164cd3eb35ca667487b5d4aa18cacd0296badb9729968ab6449d6c460b94c97497bf9686a015eed97b5f41c64788f37ccf9c220fafc25bf2fccf90aea2cae7f16f8fb4ba952ff2caebfd897e39239282553622a99e392f1988b33bb206516fda2d9c5994da8142a5e732cd9667e847306f8553d333e6beac99b925db1f65cd29ccd99ee1ae85dc5075cf04593e98be0f6f3ab3233ee32f5f49a1b7798d48ce9b383d9557c6b0bf788192ebbb57aebadd55bb9b5d1eea4399f31dd35983d936f6be11e31991ee15adaf8333333ef3265f2f8e38e9ea4b930cd74d7f4dc997cabb3d1bcb3953f3337fd2e532e6b4ef6b60edf7aebf0da5b6f258488a472ec98404d5ed23a45032d917b49358a5a5467bddad5dba7aa2a4de5aad3d4fe42a140dbebd49f43a5c29951559acb516b9d2defdbc670d6e9746b091c872aed42cdb49ae842de4d106b9a76b9b56ed4b6eab4d7ade5214d7b54da57a72d6ab52f8f37f579ac69dae7463d9306eb681040ee6693cac44c32653af1485143e14256f214a438aa9b1e63bfe2b28167b01b32dd83e0b1b99cf68fdfb13b2bfc9d83dde3f3bb0751e7ac5490cf1ad24600d5e6aa740f0e76575c36d5ee741ebd1a99af7bc68c6e6efff87c6e3f642a1fef6bec1a7626022645f835b1910ca95a91564201503fb78ea1ae6e617a3652c90169cd6491640a6544c70af1a6d1851be5eeace907f01fb796bb737cfbf8332847b861e4771480ef0a9438894462a4ea20240dc8f53046cdf5aa199060668b35ad7918994a4c279da008485071dae19f60d4693ffa82ddc90d43a655b8d5eed41de317da9d4e5e46dc09e1894004e179e17962271ed24aee20d4c66c135e14b255bf171fe357ac695431843a5b18c2186da9d316b7e685f4949b5aad69ea77e3e0a6225b346ade1649de6be39d9e4e7f5993fc92bcd72ab870ad4b34af4b92f78aa4c58d973c78c90c92c310c472674490dd9c10e9940d01ec4109a1087b4086f920efd9a37fa0efd73ff07d04e77cf491feeca24a0dbe39f5c29e3ddcc5fab31fe1e5711d46f5036cadc01d7b8c109357a04c5e658d559658408c1d755c4ea1e1c185c8f060451646396648ab3814f8025f80281fe73d053e5e81177fa63ceefd29bc38fe4ef7875df983edcf0a543f00998f57611fc261c890a6d55f586e3c7329a9da91531a4fb3d629a893a2b22a88f86801478ae846231db53159a98982246b9cb55ca62d92667130ab581e975552542a4063ed05bfd7df46f9a9bf0dbfd70fbdf8221a9e5084a21dafd6f0ef80f0a2f02271113f09910d8606e96163d954071a66ac25d5a9d4501e3aeab4c3ad2990a6d63a2e92b4564992f7b508b29fe9061d120d95a922ef73983c3e9c6389e6f748f24b60b192366346ed1be2d410c7a62268f6245fc0aee4e1e23d6f1f7e7bcfc58dcd051fc2591f7ea83fbf6a576dd7119872e1e28bf7709cfefc87785d67c3553f7002cfb691f54d9e3558b47d0a8b2226813a0d4cf0650762d222c9fb902fbd884940a24a994af23e644e1fc3c4e3424ccc2251020d4c4ec1927c3bc4a5b8743a9e5c79e4c8aed373a57e490317c84cf008da141cc44bba0d0b6f4b5d3349aa4abd39cad5812a39cd87cbef169b246be0c229aa2879a272c118f09e823f5a2af0d11a1ff97b70da3fbec3eeacd554c8a8b5f5e38fb7c23fa1e8d21376a713dea9d5e03021c78f7d8504c8c2261dbd0d3ab6323a3aead43141bd36486b9243925f12ac36de179818ca8c583eff49c4f2183a49c40c92e974a4fa08a643f789a4827dcf7ff8e1f3cf7df491be031ea81198d09b3ac81a82a6e6963a9572b4b50e34c8e074d5a9cbadd91afc1a82b466c34e34091eafbf15bbd92a698a0fe77d097b1f88d5abe0e9568932a0bd210e8dc871af399274a3a1598c4a62321e7382df5094761dd1bf72e48876cfd50fc2e8035f7e7dcb9bbbb91fa30275feee37b7684750ab3a13afdc74fe6ed6a77e4218bc6e12253791aa84e33e9ca3be7ad517c671ee6bb3a6d16e65aa575b4c78a285a0993cc65419779dba1bc3b0adaec521adb9519591e43202fcb268b2db3cde7014e16f9335ab05d1f2852599469988b0e27d2634a4a37238c415547f498a9692f19859e1a5a8e82ba8fdc582d7c9c56329c46ecbeb5f7e401ff9c2c8ddda912530cab6bb1aa7b96154fe6ec2abab8e68383d7d61d5118d9d23c4462a06df9eca96b58a504b8e060a68696953a98339075aea34a8a2c48b14a884cb1e64efce02eaef09952d732c635587db8b0a87bd5e75ca3e5555b56e26810b51298a537ea2585052533e9e68313a13a24aa2d8f834ec46fc9d470f360d452807273f687942d37c4305684cffe3cf54260ea7c86cd4899692aa1ffb2e82021b68678e9ac6685bbd6a6ac34e33a1b46e73e374479df5aab31d4f3a3dd6342a41448be0cc26384d6d6c6643bbd2e0e4a2b93069318268d150d107bb2b5b564366f516a68c1cddde3d88929c2d632bdc70f7606df5962dab6b83dde3cf740f0e72ffba8b69e386bec06c413c5188bf31b701f5316ee2eb9abf4142a920798620c40d4149724296378b954a65c6dd5bb75f7745a5bbebe63b47efbee13c2fd20f4667f4b644db4d2bcf84c3672eb07575d9169c498ca801ecf3474894e4c91c7219a9e6903a43059aacd37ed6cfc13ad0b9c67a06f5ff18a469ba4ed36e4d85341dacd341b7e666aa83360fd29a9a96e4bd0e7bb0834993644e92f7896e8f9747ee76489a85c93ac3145194fa8620120265f238cbc59c9ce261a46d10144d1389138e9d76d5eedcf93b30ff6e27dbddf0c4dbefbefdc4869ac3b2c7e2605fdcf0e43e78ec4ea71d329ffed975d7fdecd3fa81c6d10d6f3fb161c3136fdf30fe26bc813fd2fbf07bcabe21cb6bc230ff2e31118910296a924c5128a1cfc42ffac594089986e4e7e8ce9d8b9aff61b4c6476afa73534e35dbe247585b2e2213c081e31c43f926198e106c3d51f2295e31d55fec9b687bff826bae98774ea3d572f1ee17ffe6b3fd57ef78b0d97fdf162ee1ff8bb519243398ef0a2dc521a36db4f8791a82270c69cd6395e4bd6e9fbf31c5034125cee969f0732b44135202269e5ff2fb14af0bfc255c1d41031a7890c1729178f38de299e6fb46cdf0008cd68e1eacc10f8b77bff8ec674b57ef78a06e79e47bdfd965996ba9fef69daa65c2464e894824a29056122451b62e9945e6914564195909d0e070ad777ea140e57ab53bbf0065465bae9a4a2f6f2c52aa899e33f05c2ca771e64281c6ebd4a2d2504e732e2e1468b4aef52d419be7aa1c6d1bd34c21b48fd6351e3d93c186cdb3059737853a2daab4e0d6b2f6349dc6cc2eb3d56a96d95ab2316bba0a76173e25e1d64a90d6e62f56553a58d7924b71ebd696439ace55e9ca7ab53c7321cab23321adf50625f925af1268ed9b85ea6f2921c9d5aeee0174776ab17649deebf10eb0b56a5caa0ad307d16e1392675b4dbed6fed28c99b3e731e2e7a352bc88269742318ac6f6867004292af1c582c2178a712846a58655066f6a9c950a52148df18dbbf962540114a1c582028771ed0da3958aee609a162ec62b950a76235e410159410db336fe0c6ef016bc7cd890b1998ff7e1295c29e907509e72fbb1257653a5a63b2a15fe5d64ce5a050eeb2378130a68fece4a657c3e5a7b9af6ec53cf27238d155055f07660efb6d43525a8aaec1cd35e3b99e3b0616540d9e250a9a78eba8cc7ad79ed286b706d12b0a771f5dadeb0fac7eb28a435373a344473b9acb5fb2599b934d00780cbc86254494905c98f338d5490946241291a5b3c8fe8338b45e37fadc6bffbf1aa4aa5c6bf7b34503bfffc536e8d88af267f7b50df130dab162a5d5ea6cc58eba879134d1625b94acc766683431957885a8178fd3eb5d4df974ac64470d9e02d6ebfee18ff6530b42614c42f6e3ffc93cd353e5f77f0bf3d2b180a05f18b8d7d8e10d3af8de7b692bcf1647f01d795429d7ad5e6faceca16708de7ef2366bb0f55a853439028a0059573da0d387ed1785ef0ac2067afe019155e4588ecce1320a278acc2ab2cc6a0410f1b99d55c9535b45e3b9b466c756a3b6e396d2368d1142d6cc48878008289d1a82095a2922845c5a8547991ff72655cae701f08f0e2d140857fb7729485dc01a9b1f95d2336420a5690fa92f168ccacd4e0557835a81c3da80461bfbe40b8d51b0a79a7cc79b886f393147a7813d8631d75d497f97ad5c637432ba8a85679db712bc82e8682bd8ea10ff68631cca452b18e5a8d66b1e3dce74ac550baa282c0b478295e8c2a1e7fb4c467012113f9d210a0ce10061f53f66b156eb852ab1d3d58ece2560d5dc4b96dfa90cdcdcd4d721e1b1cb679b82467b78d3f63c30ea8d5c6e7d76adcafb66f87f3701efbf8f62fda9c4e1b7e19feb346bc409024480fc9936b587442a24ebb73b4a74ea339eaa903067e90311aae57c3cc3e19ce59d334ecd6526c6ad70a90d6c24492696b594ba724796fb433114194b49e6e49de1b8ec5714548a39296cd97cbd423efb3b7b6e57a194f158d680766a3cc0a69883939170b7a207dfdaacf6b8e715e9fdadf978ca1e237d8cdbf8b8ad12ec15528ad9956eb5e3d3d6b77ecea1e84ca686d74b4661e1a1e1a1a86e14a0def4285c9d2bea098ee1e1c54bc83ddfa08de330a11bc69e822a6eb15d95cf3220991180919dcd752a0a17a9573a064071acf699d86065a8c65611644fd3e735c891693a92ce400e260169de002f0f83d45b8aa7ba8d37301dcbdda9d2ec2d7625d72c86cd63f7da17e6da0c331cde582cdd5ec0d737dfd99dfbfdd73eedcb930cd9375b6f0ef1c957bdb1d6da2087f0f3ffcbcfe7dc2e398e0bf2fbc4822a49be4c946528d20cf45eb8d2db0fe9956a779b6126a71537ba39784311aade34415756b590cafa9d344644ca21d8d5e4a642599faca44eb8e4a32ed28d36912f595691e8dffbdf942114325fab27caa88ee4c2ff6444850bc4e88a770cd805d546cecc42bdd839cd5d719865f6cfdb63f1ab33b2ced0bfad25b9e5a59fbdadadb6eb9e8ab9f5b7ee533bbd78a852e7eb0c31f6c71890be9e74b1796ba2c22ef28ccd9b2f0ac07cea8ad3febbcdb2b379fb17a7d634cf2d7309d3b62f402d4698079bc9de8f146d16484222098254f3f923f558cfa9dbc0b78b3cfafd4ceb8def20d5bd722b3d5047fc345a7c75a4da6db6cbd8bcae2bc1c7fe640a7077828972df154c2e138faf77d33cc65c21d7bc9b009594982dc4aaaed48dd70aeca997c38d138ead5b08371bcdb9aa63615689209e886651b579b893aba5b82ccce500d30274ea00d9d3801dc0db65bd3687dc039c955c7f1a2d94449d64c6ccd166e6fc47a38242a947bf3a982142ff9e35241f4488568418af765f934487e255a2c49f1989357962d5b76cb2dcb96dd726417b77f97ea56b2d158ada28f546ab168ce2bd5f8c8d34f1f3df834bf01a7d7604ab172475f502b15955fcd59955490cd3dd163df14ee173486efbda46a417cb93a9a7f7c53d1b559d3d4d540d736f6d723194c48f25ece62055470dc015c05994554e989c659245923a6729986a52a086d4c6ecf02255af48bc5a8c29f06f5e8d30dec2a0dd4bdb949d4b38abb8054b965d932fe2713a88f6f41d4b91d4dd48970922f9518c2073d9c286895bff638a808cb7105f6f13efce623f88df34750e1dfc5efa301fce686f17bfc1925a8e294c2bc2db53fbbf797c02a9d70ecf984ebc7c33ab95f3badab75021c383cb1ab8f9ceaec29e04d1a1646a18e1ee9ce3a9a69bc753a3347fbea188761c45acc84e37dc49ee25f773c1593ca54b2d7a6f647edb84e60d057bca11077e5c4aefeeea9cefe79bc1a1835b0fb24bca4bfda573e89d75fb23f8914ccfe845d44c972125ecdd891d5067ecb596cc17c8c1ac178026f9d9ec5620bae64b10515157b727b01fd3737a8406f43fda0ea8ef4e25ac85aaf06ba0670b573fba9e30a3e216a432afecfe20c4e3c9e7470fef92fc84ce599bf64ffafa0fad1d14fb861ca2e21e663bb8dfe31131b6923d3c874b29444d86a3d89a15d34ab025d96c305666359ce661074c5fbc522fabf3c9f20ba4e717c5cb0c7c61f3ef5142e6b70e4e09047f115542083fbfa81c9f1af3b9a50a32880c31594c3ba4358fef13ba7106ca7da435634e39ac8a44ce1c7796429dab16633bfd7b21c9d3d46e7d519babdf9d2ff1037743b7bfcb86a2ec25f871f1fa9449faed5fe5239fef13b684ce392b72cab2c336c43947f9759737a58d4a25067a6760f0e1f6aad6b5e486b56d4a4dd65a2092d86d1b7377f62889915a44c98fb209cc984c7e57086bf1332e0e176b0e32de14c062a8d20dee6da05e9ea27615cbbb41896e8a61b97c5501c67730ed66970c2f583d114c15649a652992a92e69199e2d222c955b74741d344bb44bd8671732a84b3c094028f14950d2dbe4d16863dadad9e8f9f91db2aff05d39642e20f470357ae1859b16224c31f96dbdae4a30eb9adedfd6f7ee63118d20fc261fdc7786d05e1c843c73e123a84ef102f0991990606c106ec6146b946fc071a612d755cdb6bed8a246b2d02c21a44584512607a86a76f080a6a08bc4c63cf0aa924f2125b5e981fdaf6b39b6efee9b6254bfe57b96c8b6db8f0faf4ac571fb962f3238f8cede2fe6dfb2f6ebff5cdfff3f075ff67ee5c6b6cf3357b967d6e17bbf28891c165f8d614b2c480cfc3fc28689bf04df1aca1650d03b1d0b226192052aba499d0aba7793d92bc173d68a7f49f15a4d8f16eb3333ea6f4446f9955ffca11fe1cc2914f1181ff17938bf84882104fdf105f0a99312c44cc72a510f84b592e95ec9f054ef8d4fcabaebf3ea42c5979ee19b3122b6efdd2caebbe7fdbadcef3cf778bbeaccdc559ad179460d745af7cedcbafad5b74ff961bafffd45d0bd63e5a1914cc17bdfce9d557b65d62f62ded5ab2b3af70f5ae89b5f26bc2e5a4834449929c4baa6dc86fae3a7a03b0c352acc31a5e25a486afae7521351ce8030e8670d9487d92168e21412ce82656820d578720ed8dc4e209c3f4d8d75f10fce2718a4e4969b05ea204292bf84b3978ef49b83afc6dfd87b81884c3dd83b5f79ed40f3cf91eecfb1a3c769dbe19fe74edb5ca0568607ff23db3e5db83ddba03efbca576e8c9f7debb4285c7aed537c37f5f7bedd91d93bedc8785e5a48364c9620333779dc6723455a756c48f0a6e6aae034645107493a321916831b72457956067b95cd6ac6d924c13654d304b324d365189facd627c129762131518e2c3a0584189394def3d0926eeb3673f7022324f872ebbece250102ed71f15038be79d33afdcc0685564b889d1a3c527df7b0fa2c93e8f15e067301f867ec939fd8579574de2f534b301c4c96a630de4363062b63572bccb9fd9cd98775f0c85d176811155d132d1ac6e49deeb0bc73032890a9216894e115ea7e92d2b9782d47b4f4266c6bcaafec489c89d03ed5cff3dfaea2f3470f26b4d9416d4fe0671daa9ff8e2bddab9ff9058cc920464c929f2c205519f9ce97a3ce02e5ead4da48d2f18e515e45516761493a9adb2bc99a99c9341fc603b5f8cb65ca491a7120e012288562dc0a71a550b44231ae14ac18cbaca015143290fa7e8d45907c5fff957ea056e3ce87aed71b675ed77fa91f78bda61f804cedf5099b137fa7b09c7848d0f006da0bccdcd7a0ad45d59489400e547e14092430bc1735a6a3a0fb8aeda80d2d575f06fffbe37df0aebeb419d7d894f528e9ab0a4663b5b8301a2bc0b8516a626d84384f3e65f249c683f051273f4c6f351e18987828907b8c679a89d7c8e5c038183187962e8c77c1b641ba87ebc336b924979c6c658a2d6e39ce8e052bb0a419bc8bf1658d54f83bd97c1926a434e1f23126f1138e6bc7879236f7779d5a7b339e5d1196b3f689a15214278c81537fb40ba7fb29bf433a77357126c867a6a938e72590ac20c161ae6ffc0d6139223cfed6f85bf0ee497d84bfd5dc3ea38fbc6354505168b44e340313fdd4e89f2252f3301c9e545a51d5c227e8adf0d2e49213bea907d8f320c3e2880cbec3e7516783efe4316692ac1b7c173dd5b332539f242c3ff119d84715d469842ea6d3c8864e03d493435181ec7ca26e1e85e39669c2f2f1b78e349f100417d747807c8b88fc9f84bf61f1b950b242ea5bdcaa3fea5f85f57fe4ce1da77f84f5b0fe8f889bfbd8cf85d5c20dc44248c20a25f08b0a801bd6eb5ffd0f6e8602e3f45576ebf86b3e80710dc721875670e11bc237982d772e8b7870d6315d0de7a6c0c9110fad46c4c35e8c7860f60b05436431dee1e468878252604a655c89f37bc003f3c1b3678ffebebe5f7fffde1afb836f4e39f9873d7b60359ead1d07978b2c2193314908977b0a5c2d0db824486b2dc7c1e5b049b226927259135c1823622e9f085eb4183d092c4cba38055027d34a653019fa6a60c208d6a0900898bde62ea3d28a60b428273ddc5ff29752a5949812fd2702b1e6cd9d3bdfbcefbe3777ee3cb0f32458ba9b57ee7b73670326de80493a1e26790a4c9e09989c9330b94e82c90a29317522349bc10bbb5ffcc3a5c741f2fe9e3d70a7fe1eec7ee10f97226df829b4e927d3c9950c92529d4e67ba4094a9d77d0dae1a64632d54a7a1864956ac6b3320ad856449d6a27de532cd4afbac421773f9112d35dde8461a95e8b4326d97ab0e571615ed3e4933c349381454b48e0f99a6b37802af59740afec29050eccbf2f198d953289ec48ab551c1259a391367159c8287f7f82c7e932b150c40cd24f7c43ae2f9f9f9f64d77dd7b32b3726d9cd961134d3c075eb7cfe106c11f9e99e46dddeab05a58de15308f8cff47e578daa0f776b0c9cd0a9b8c6516b8e3abd3d66644a1e16b529afceb43cdf864544b51f47262a0f9a9b07a1f461bcec693e1de51814ca506a395a6ae83be9c3692279b48d58712d19b43a37948a5245775b7c7d1f00bf5aa339163f67f35477d6355319446db8652af5a23dd68db4073bae293e4bd9d89de3ceb3aaf4f92a9bfac11b4ad87cb14a46a645a9aad3ba46261882f150b4a88f31794b81344255ecc42aa10027fb1a0f497e2c54216524abce804b1a0bcea9ab660f3925aff96cdf23ffc43ebcb6b6b9fbf27b42e70cdb2dac37d3facb55ebaa4b6eceac0cfe0706dc6da35a5965acdbbea330b6a973e137dedb5f6476e597255e0c73feefd4a6de966ff1bff10d8bcb87662ac9787842763bd3c134bc089983943f2e1c20f63a558ac97b7edf8c038160f709ac0b829d10ea70bf95a72f7dd5735ff9f36486efc9fa6dc75120ead27e1d076320eedc7e110380187d63f8383bfe487d341bf79e7b96fdca73f755ac0f5f8ce73ebf7e94f9f0473bb01b38f0d01612231b4b11ac2702fd1480c6dc6d8b9bc2cc64ef219303bdb4f82d9e3f503867679701d5bec4b9d04f5103750b9e1b98feaeb479efb68f044a061dff515ae34f4d1f887831f3d37b29e18facd0666a7309336166bc7d527b41b8d33493225c8d2186b1095b8619c42b838bc86de7dfd1f4ffabd115fd2fcfd84ad0af3cfad60fc1eb57bc8e883fa6c30f2f8df2044582a5062465dd6c4ac40228bbd12ead8902698a6845423206fc0abb05f1f6ba4368f3f83f98408cbe584f0ef34db3137db81c9764092a919bdaf98ae2845e172e8820c4cd36735db69d2e4ef999e8b19d5437c09153c276f5e78efff7e0cefba987efd8eda9d6f7ee3320bb81a58709df33f3555de98490b919a7159ce9ce632743c3ff8c1eff1835f8afa6f87e47dfa5bb7bf771f246fd7476e8751e3046fc533307a3be1488cc485fb84fb587ba404a9a894b242a39518a4f037faafe099db21759ffeabdb29a46ed747eed07fc515b1b1d44efd5777605e0d8ba57c94e9d571b292696d913a8d337b7630d78ca880a946216b235aa2d58d51958e16218a6b3ca279e392ac5971ba0a4a9a05ca27da2dc0130254d3d15f876a3a8aee6644656303e74c17e2de7e6f5c982e04949f7803bdb51afc66f2faf9e7efe616ea3ff706835ec87a83c1f1d72a46091c16339024d3482fe92383a440aa2994e6f9029d56a728b967e4689e5565e863551966425aebcb4bf25ebba7381da1efcd4b0525ca62620ac5f80c48e6200bf1980bcc6232c706950b9ce055e2c81a9e58ff2cf063d80c944218003e8a53ca351c17b3cf4c4582ffee933dca07d1606aa62dce71d754300abcc2c76479ec9f6054bfff47266744e6f848637d58019bd7f27caa571a6df1051ca37236a959bc3660f5020eafebcdfd1deee923fc9daf39bacf2d335d8c1cfbbdf022cb1b5227e3fb0a399a1a439f5b1fe326a558ca626cdf90a9e4e59c42968b8bfdbe527f6208030852c958c34f2d88bfb45a7865209bdbb4f14bf7be7b7966e9e5d77e66ebcd17ac716d9053f34a30b070fde6f3bb7d8245f446a5f89767ced4ef5febbbff7bc519b75d72c7e08cb30b3da172e83bfa1b3fdd7d76c16af6b84a9f6e5933f799e4ac8b6f3b4bf55a4dc1d6c2f591c86b6fe098b9863884f9c2ff268b499c005dc2e4f3823a5de0660ad052486b331648b236bd9f85b4cb5e7f08fcbe06b021109d203a8510203e42a99f4bf9fcbe543295e54a4ec11fe29d8268c67db8c6645db8303c77fa40972be2f4ae3c4732857d36ab8be72dced6ce4079fee679b35dc927bfee53528b5cf2ca35a642cbb4cb96665d9c43b000d8dd6d7272ba5f74f6cd826fcd0d0d7cba47b125866686e6be9f5af54c25ba24e3f1465c7e8b1d04b3b763e6bcabe73d0f6baeca9cfd55132746effad5c396f0a5573dbdc631d8ded7dee56f750bb6ec19e744b257638efaf7c92dc2fb428144c92cb288cc231859d3cdf4430c235d9ca333c668a9ae2d81b4569a21c9b36dd6d6364b77577188c57711cd3a5d925f265267b63834771113fa7c69c8ac864c61109de658d6942a854cea9050ca0a29d4fb62c9625fc9ecf7f94b189430b3cddcb170e5f55bbe30fa852dd7af5cd8616e3bf1c46e715ecff967dd74db8dabd76616dba1149c5e0ee5e97fd3eca59fcd6fda9458eef172c35db6ae73cf5ab728975bb4eeac73bb6c5d271edbd6cdbc6820dd55b860f072272c492c98deba742da6bb9fbde4dcebb7cfdb1458d789d5bc8463df2744785fa0c4c7a219e690af91aaabb9129d9bc32850dadff6ed99fffeefbf264ada465d5927757cd7a439e14f4edaf25dea72efb5bb1c9ef45e37fb6e65df6decbb937d27f0bbea7639225837c7ec94e4326d2dd3b632ed2cd34499dacbe465bba3c5dddad699c81a7f30db6677b4385dc79dcc66e9ec36200d5a63d87401e248e0928c6435083c0b90c2501a329792a95288c30e112608be78e68cc443faeb0f25563f75dda6946df7cf5f4e6cbe9f1135e70f4d7739a12be9ebc9c57a3d39589f4ecdbb3ddbd2d62626cedbf88549724bf77dbcea7e65e365db065c5dfa3bfd3be5a7af6e5034776ec7eaf6b6f1a71f7025d5e4acc06ceed3bd0ff6df3fdbdbd5e5e8db7c092e4cf8635f21c4240bcf113b91899f9024b3b6a5200b2294ac102dc615a91d8af16bda132667a25d19bfd53be01dbff53fe13bf01dfd6974f8cc9fbf282244a2e6c8c76315ee1fc77b2a28cab05248b34e84c2e46e37594c68a811143d2d47134ccefab01a4ed5c7a2237d016bba9af035f353b534a4355f4292b56008d764d690246bbe0433ca17fbfa4b687d403b078400fd76a984c46c0639888be6e44ce82b492673b2e273c1e6e1edc3b0d9e50b48faa39536cf456f5fe469abe88f4a0130777bf34e58006b86868787f46fe8ffcb99f7767b5b1c7fd2ff74be6fb6e2b6ae5861752bb37de783f82707cec6cd386feb44d47a33ff6d7032d694ac21e74e78df8b9862c662b58b18011457d068eb3122d153c5c25fbddfb0857cbc4f581e54765574476597126cc6ad9ffa8ff99eb80f9a370695a34b9ba1ebfac85fb6675480336d155e2036e226ad640ef32c0670dd433d2ce8c6c24a4bf0eae4020d35374df295cbd42eed155b64963fe19635b3133b7126609d07b4925a21ea2fa5a250f2378e81e786ff03a7bdaf8e3fa33f07fc4d3b5fd29fe39eda79d357f1e47fd46adcf0eb68417d0d55b69beedb37fef07d37374e4cb597c9a4cdc8ff75e7a8a370bc95e978f71848f158b2192936febcfed30a46d173c3ecf099ee41fd006406910615a3ff13a49bf49075a41a46a964ad57ad61645b2b58d354513106665a0197f569156896d952935810038b70f06e4d061619ecc774472d07698d4715b7b34c65490b85cb65eac7c03854308b51c5ebf7f97d0575c89cea9b0951c5eb34c5b2a66629a60a64ccd2d9a96262b03b2cd9790e20630ea4ced8f8e5ef7e79e319a980194b3341861bae1db9367241abddeeed887765b201c972a4366df5ad575cbbaa505875ed15b7ae867390b2f86163f6d86fc82bc2e5fcdba835f17e3494857e0beb60fd6fc6ff1676ff16cd65bfe5e621ad1792b7843704d2cc8bb7c2426e3e37f737fa57f53d7c70fc3bdcbcdfa2110edbe48e4d2715d316fe5d622112e92218b069186144a65a7b20ad096243456fec38710a63d98ffea804fea8848675e9754843e61fe0f65f1c3d080ffd026ec1e3748ddb0fedfabfb2b24490a9e923fabf423baaf304884c88f0ac40894a16926a0cfbabb54033759a71d364232a189d2f421d83e551361572b4132317315cb1a3e1bde883b4e6ef9064da836a872759ea2f444b43a8a6fad0caca3bb934b01995f510dbc6717ac5dba2b1644a36b99cadfc6d4953bb74110ec6fe1f7296901c0e4e0bea0782d3822a5a33397feff8dd911e13ff5c40f63c2e29c169c1a3b384161f1bbd6ae33be45582b569be491f0cf2394f5c5323e004d4c6690b06554cc946c48a2b502c184c1f553049ba6624bb1ec6c455fe4e963f52695698c4b85f6cdb4dba26db76b1d84153a36d29a7c9c7adcf0a10b58234f98c28609925fdc094271d0d70fbc7e73787161a1a00b284f077b078ba68232f16d0fac342e6b07e53c32c275899c6078506131400b2afbf0ed360daebafeb63c8e1c8bbe3cf18fc4b08e404cadf41aca4836515e17ad496c3e6a68493e18a5489a2c4892a934d70c38d46f55fbcf6da9418304a5c465ec1924664396d6306a0204b4d3231eef1d6593a567bd3761766bedb6a9815520b632135ccccb2862563789f18f2d30e8da85dbf079d46fe4231de94494703dd83e1e74d9d5e6e8f27617a5ebf9f09e64aa3cbba0707e16ceeb7be548f7fbc9359b4d8bcbedb88d3b5930429902469d6f631d7a9d9ad25214db375cc9cd69266b4c562aa5b6f9e2ff6f5cf02252bf88b7dc5be216e10125222eef139211e4377428ac369b79988144b6e545b5b0567accd5703c161ad714f8c5f98cc713687cbc4aff1068501fedf820ab2ae12e486d580dad2728413155ff4ec231cdf6277998f1c7da1a6ffc161870e05ced47fc3afc69170f40523e688234b09112e152849911c29926a1a793c57a7891c0d15509efad56a82c5522762980bd79bc32c71a2257292ac75f5e02ce3417748c93304a55492392b5c10c2e573d3f6c9c5d81ace14c225a739b6b4dd2b6d99e5ba698d6c96bd177965b3bce626d7ac2d92b7bd4df6ad1e3ff21fd3a7cb6df0b46360e180bdf74ab848097e03169d71ab37e269533c09cfa7cfd0bff58da0e2ed12ad1dd96ec52b76ff737ee6cc3c2beda91045784c780c752c565a0a6d5e29b47097c414fef38b7ee5df561cecd9bdbbe7e08adfefdbf7fbe6febfed85efb0cd5e76f9b1cca115ffb66fdfbfad3894790ce724c2fa19732062244bda08a038eb1aa33ee64bd67c5d924cbdcd0c05f00e826f10d492c70876998871717166c523cd82822246153e9e42876565e6cdee23d9c407c19929fd958e9929b5bdd7b9ee016f65574555e1d52363f76019a68f9655ca301c4e5732676e117765ceecea78fd076d09fd5eb8f1c09b2f1eba45bfb732350f6cb901e708a976606f269895971430f93f9bc304305069a651e3a2e113e7eb94776b02cbbfa0f106e7363274b13680d4c0321997e4aaa52b8366ef467e6eb74cd365a265516c4f63d6a3825492fa0601b9c0ef3106dd44c8588e4b96c25028a6a2453e2ec66b954fc4bd82626cfc995b607459a5ac3f73220118f2702322cf130ba99814e16c86fb1cb28da0f9b4b34edbd90a2fcfac28a056f32c793ecfd23ae79e12f79e46406d4fc096de3bbb6786358d4b21ad272ec9fb1c52bb17b38388d6de29c9345ea6dd120d94356f5e92abfe19739855b9243122f4cf82447f410d034bbf8a366731cc1d4896fc510f9bda58d076741a87312fc5be599032596a950ea713ae70da25c7554efbd9903d7bf355676dbe436c77e9cf894f552a90f88cb9ddf50f6e5976eb25b00b565e1404ce64fbc239fa3790559e59c6b548099bf51db363c4e9959ddb968faed0bfe14b3ddebe16d6285d21c51b04e07813efb0385b5e5ef307438e6d145e14ce212132406620ed02cde8d0701dab48f0753a5dad0e3031305046dacd64d307e61eb0a207e14c23f70019c75ba75e37168aa033eada100abf30a60f3a3a846e46bb20d22e8991c69494357e00334552199629c2f5f5fba30d5260baaf29c4352397527c3c962aa0dc8e96a48488496da2d3340d50b12c481baf4442d99d57b5b8ed4eb84af4449fb3e8cfbadacd9fb9e22cdbdbb885c415fab3e73e603381891705ab6007bd937fb7c6cd1b5d0edbdc6ea5e55a87f91da125258d7fd8f2b52e05d654babdfa37d6c2ff797fcd2b7697ddcaa383057407521887194fd6a39e6a729276e66b5a44aa251c6b0375a08339aa8cd1924abbebd56ec596de3bbb7bba354d0b2aed76a32e8af5fb66405aeb46e78adc5f2e6bce1c8be522bdf97608714d9f772a51ec4bc6cc8a944a9a5d60c6a227a521d30c284a7dfd38a87c7e932446315f6c3d039d6b6979bc4bd1bfb16274f93657c239e23b7fcdcbbed606e4aa8a48add5ad166e98fb89feb0d9137e4a84739034579c75f5e6b3f59f9fe58096ab7df6731e945b1a34e21d003534a0fdc8dd6ebe437faba28fd40cbf05101bffbe29c0ec5efd5831a7d088b42db18c2f44b45eed66d522bbfb913118e60948a31f7d0031c770735bb05cd65a12928ca680debcc71c8610cc021e334b664262089a7e7f17f09ea85f3239d922345994664016524917783055cee51b6949bab6c119a32b608db7fbf196f18fa484cdb6fb7df8afb5faf3de2e55e54573c0f7ca83dc30b724ecbbba05ec88ed95579f75058e2438477caac3e9d2ef803515fdad3bcc1dae1ff964d90735bbe8b6f02df283dcb5883587fab74085e788932864baa19fb8986435e2c90833fa6152afe672a001562ab3e83cd2c8fcd62c1e23d88d2ba8869b804b44452b2851d3c57b7e7dd3e353dc6390820c070fea57eb63dcd937fd7acfc57bf43f181e4fcf9c572109d35e6beaa7cf09cf1137f193016325e6a9638631c2d4885eb0316f30d13c5867492e539f54b5110985b8091d63bd798965a38738c5cb402a411197d317eff9f5b6a90faec12864f5030d6818b80d689ed4df7af587af4da10fc23234014b33cec5d3c8fa83b4018fe6c185ab1349e495341346f8d864ada5a121798e8728c1ca530023d23606529348b55aed94201dc045d909309d61c034491c3266a4204e01cb7f1c58b20196078d233ea724576dd89be59341448d392e9d024474a1d46aa7807104978d4c99e6a7c0b9837c89dc65409aa9d33373f4f606bcbb19bc0dd97a5e03dec730e00b97b572999e27cd763a4d9e68bc7368f9b937dd75f7e71ee9f423ec2fb7c4bad499176cdaca44ef9919497ed9a70e0c2d5e7aee5abce17669b6d546bce7dd70f7c81776e109935cbd71eb4da742d163f6895e9fa8a239d81fe24a3eb33fc4f9bd4e40a9c495b280993f5802d89c4af617b390eacf82684e254bfda510e70f4118fafa53c9fed2105f4a96d03f5ecaf2a9fe140ef9fe2ca4cc29272f9ab11eac68f6894e1cff259f3a04256f08fc59ee24cafec9d26e8e81c317b078877d43e5a025cb3b94b8c70ca68d5bc3914ede997338174ad69991ac5b750b60ee16384b6b9bdfe3b15b9ce6ae76b3a3cbe9f408424230d9c480cfecb68465bfd5d61d9fe5b0dbc22587dd9c5dedf2c8ae74db90c535e4f4cee2790ff079e0f936de26d965d12d81796ef749fd0a6b635b3aac0b5bed1ec116b1e44282bcccabb4b70b668fcdc15d1bea1c0a5b4114dd76e0ecf6b89fcb712d16de979483adc18e90db0c60b17a12560bbf44f1a76dce69de56abece1ad767f4a8988dd7c0b6f123ae33e07cf3b64b30d78b3d99c72d9fd62ecba6b1d09d1e69004399f12c0e2405d98104e7851f82c56859f012548f697fafd267fc9279a53608ee5c0c50d4757acfbfa7dfaf8031fb77cfde63bc69f77a55dd7dc9f7673e76efc61ff051b77bc7beb8b172ccc8d3fef765f8db1e084ac13a8f0bc110bdeb0c7ad22d481929f96904969470eeb481572583a2293a303759c0a1375e6f3298e69f9f2640dcb6a91add88ab862734754559bf97f95a101585c0a3f272ce44eb7cf0d63c41bf3f7546ab51f4c2eeebe75aa5dfd5134fe71fb2b93b51c700d80595a8d1c2dc38d6a63d39e586f2c7e89c558ace3f4648a82244a15fedda34bf93bf5c76bc2f20a2673eb0726eabd34d71656122509b28e596d8065b1060ba8290462aa8aab31ccf84ce6a86b8cc6589541570c09e8b263c16f56b83bdea84118a8570371bc14c0aa839caaa51028a78d853fe082386ac47836ec7313691d6850c03c74452a14e3026dd20e3283ddb5ee41b462e80e0c8baa6116279c0da3dd8348a3eeca60371a31e1f094daaf4ddfaa97b44f44e3c905a01d39f4a4335b09abbd002cfe08ad92580b852fa5a2524abcbd765f0d3dad8d0df72be3a8763b8cde5ec3cf7db5dbf908db1c7db471917b876d6ac64b1184678467589c4b98749379e40cf273a37e8fab8ed6f16505da5aa70b55da95a3655683a04fad76319a75cdb1a69ba576564c0903f04333493d6a4f63558da45b9b06693a54a7436e6d3ea4e992faded292f99634cdb3fccf529d2e9988785809692d8935046c4e5642431b9a26c95acf8c72592be525f925abcb23e40699840eb9308097382414c6add2de693d338670b7ab117c43e392961b44e91c805355f4f11915eee2b17e6642f09ae3317f5fff2c2e599c384e89b82c8fc79209bc99ed9d26a6e2a3abc2d948c4ef3b23e2f745223eff99d3c23781b571e06e294985ce4401c2916c38e2f347a07a9a62417737aeff2e138d6423df8ee44db782d3e9c303db03be6067a1b0da17c1e71038f64b32287c5ba0642599ce621c52755cc52975da9fa34beb4057e5b433919b53186730ab4c23d23ebbabad1d237da8226b6a0129c399fd2128a83829f597007dac29f41a8b20fac1a8572f62a9cc642a69dcc215547f0844b30bf830e02f50bd8cc744b308ed6dbdf12d0b670d446777a7ba24d8110fb8dacff35ab3f362fa7671016cf37afced4997bb73fcd77d4b4ae7594daedece64c00b17f4cddcdcdfeabf788b453cffe8b8b88033cd1b90dc97ae5cb066f3795d8b7402637fbba23cbbc39d9f96ce60ab97e4ce95b8584cbf419c079ff2b9fd9d296c71eeacf8d0ecce4e05db2b150569f7251bcfffd33122ce8063f3f65d78ee5d9dd15260918e238e706425e917be2f0c930ed28f9ecd3e567c9a471f3bd3cbc918d6340dbad1e583ca03aae141541e5acb342dede54557803120df27c9d4c4a67f2415320dcb50c03a8a592e951570420e83cfdf3f0b86385cbfc65c8c5ae695af7f66f492cbeebbe3d5f8b9f316bdb64e72a7af5b38777a714d22f4dccca1d9be0bd7afbed6317bded0bae94ba66fb9aeb0647003effecc6bb7dffeda67b2e75db968e1df7dc6ef9f7efbc239d38b17cf5c956c3f67ce90efbc6b2eb8c631b470b863d1fa35cfbdb06a4303cff2b10f851b85bf2501a212e2f186c157504bc08adfa744ac849f4aa644b3c71be64a29f08be87c1d02ac7d928cc7443097e7ee9c7be1c5b3a17deedc9d0e71d301fde6ffba58ce064b730fccdde9d974003e8b87e160bcd4b84fffd7b9b0fea2b93be7ce85f6b9df9ebbd361c29ffcf7c572cf40692e0817cedd29b11fad93b3c560fc8af48578abfeafb311561ee3a859bd40ac9812c4f877e6616d652b6b99ad1dcc8d2a2f648cca752abbb17060a32e2713373956eea55d96e4aad9e4c3ca0a2c169d95706bae8c4b51292a1bd9f8b519973ef6ec972ad31b2e950a2e567f30f39c9933cfa9f0df52d46c28945595a38bba0707ff83db7ff4577861a6114783766e4a0293d130ad39adad21aa01fd46a9645fa95ff583cf2ba2f200524a84dc4f7e0e19b0c75d2ee73427b4b34da7fe6f077e0adb7f7a00fc9dce694e974b7fc7859bb8fe9ffa819fff84f09022b260150e911c192473c80384a673b4ab8001a333d46a5a40299c9e664dd3728e06d1dd557597f19c5b324c368e319cef7aeb682cef6552b82ab831b111179d727006db73a3e10133a4db2263126dad331b4e2f461571e8fa484b324d956959fa2671b4260686e6a00071cbd453eecd4321047e2995158a7d43c0a203057f3c0b29c91f1214af19630493a92c78bc21deeff34b4ef0a0e84d41ea73a67397b667e7aec84587ef5859492e583d23cd7fd5d2bf74766cc6aa52577577e58b6777b53ee396a6793b4461e692df3f3bbc1ab43336b860a5e86ccd95874b17dc3d4f5cb152f064666d9abb78598b5e778a9ecc8c8d439f7bd67ec60ae9dcaecd5c28980928669103902d83e369f9b3b317b562ff55c8a5fc43c237c862b293a0acccd5d17318679ec3a84a3decc51f1e3bd2d1e3443a2ec951718c0ea1b640bbd56a40c44b01ac582c32bb978825b4026e0c88a2ce3a8b0a0960815b6ee6a2c5482b97f492bd3391eb1fc003a74cf365a2e5fa25595387ca654a242d3f0745b1a758405a0d018a5d9f12efc3481eb36856bc2133865cfacca2591c82423f8e613fd63ec305800b5243a6d210a42a4f05bc362b9feb59ffd4ed3f7a74feaacec459de6e93b9c5e2b5f8b90f5fb3284af7f4f8254ae4f3b1e533d4ee95a969fe9faba973fc819259b1490ec9daebe8e4862bf952dbe03917750d576fec5bd6115432657bbb129113ce2ea1a2de111cecb73b2116fa42ab9f9f2b086d0efb0382d3dc62976ca5fb173179c31122bc60e833e166cd4f678e3ac6a8bd8e04d2ec0e49a6968942a271c9cae1629063be931a3c686c33fa01c8f0771eddde70831cddcedf895e3dce901166f6de04ac78f1945103c65ca0b67a63d752c74ef4d63522a82a4686390deb5c3518c6fe0afab1e419d655c21ee558810cd45e30349cd537d15afcaa8a729fbde6c5e6c60403ad1d4b90bbeb9abd5555f7ca6ec582858df141725deb9c0cc3a316899aca44f3a262ebc66a7654c2ce6d2ccba3acce343a78b0aa51a1c84b8d2248a54231cebd5e43dfd168a586b86fa86c408377052a950621b0f0500546999fa55119b352a9d58ea38993e517c6c89e461e8b26dab0d210b34bc672d4c2fc65729dba5158d20e26527d27134084b4e65254157959420238dda8ab6b412480adae79fcaabab7cd66b734b4bc1c6d3b9e00628300b1b024ef05c1445087eb704b320da08587b64ea186813d7f2255a6500369509920c986496a3428c0ed9fa4cad1ed35f48f71c6da037944263eb2b959b314970568eeb1d49128ce7ab5c589ecd0c284a53f47258c16dceb714b9634dafdc51c6d29e04fdc8d0a4c76863d7bd70d56bdd94738deccaa433831cbd524b0316c983618627c112bfa7a10990657232f576ab5469f3619bc06a3ba036bfbe80e186528fc191c4406bd8c9854650f422fbbad8d24b13abe3784dac7a8adbed7c37aa785e129321c6c0d1c24c6c20c07cff138f84ec421c1a06f30686a2a0e48f04c65c3541c709506a3f80ffb045168f0a511376a234e86c56ab65eb3d4313aa35141d66d0c5506bb6bac2ada3d3825c97556669255b2f5d9acf8edb4a6d94b5134bb05452b6f46be9a24381a37273e05c500160e2397602022d27b8a54c14496cce4e7ff0e5efb58557431789df82aa4aacd8ef0da6484d7e6fb04780b0a3f016f74125e8409df61d528c07b7a700977dcbb33a64de6ec769c9c12ed9f48843e29d224963c316f0c13191fffe0fd2730cd14fde48771dd8a198b68827bf2bdc685f7e634d31c07bb9bbeb4262c3132741234f193a14191e1774b324bb1245a87077763e5f22743e8e9810f1e47583017f689f78f03b20667e87b11d227de7fefc91321d51d478e10f23f863736096ff42f81d7df8e69bc13101d076fe5c89106b41f3c7e22b4157d2f9cf1ff73da46f9f79e9cece7e36185c30cd806e11fffe0fd5953483b02673478d2e46579856d648631335b987e052c6340ae03ce180e34266b1d383c2c1859632a37a336a824519ed1940542788a13c0159b50a3b95a1fa935e182518407e1aae98feb8fd7f491da7109b84c67ff25017e4cf83209337f742447dbc6a8978d08cddbc6725e5021f115a29c680e73b8444a25c568bf5ceacf413c263a8188e65fda7c90dd6d3259add69616aecd65b504f403f0258eb358ac6eabf01dfd3f974b9ce8d1977a15bb05dcdc168f247302bce8906c1697e94dfd1f873dcd71cb68142061ac16c71b34b2b8f1cd73ad389d62ec865c67622662145f435089d6c61bd187d42dd18e726fbe918b5c2a4663c922c641448b18d9c90a4430fa615fe68a387a9126ceda85173ef91ece424841249ceb5b78be7bf05b82c87148af26150df982f23b483ac959069cae3ace356d75a08929eb303be084898e70cd2e4bf24b81d65038d289daae28d17899683e9724bf64b186627176b64d62d6b58944ea946858d910874128a5e231b392609901c6c8597153336bfab12d4b2b4fbe077f84f50fc3e187f567118bf79ebc64c9cd0f3599f1a615ef3d6985731ed61d0feb5f3d297fe5bc66ce4d7b8efa27326f3aa664de60d988d63a6d9db048355f74f6b260b5395db287676b7f7fbb24bf0c66d1d1e296c869f37038a37884701aab91ffba3dd75db7e7f48938325ebe6e8acc42dba79b7849d6a8d92f19551ad84b1c1596a867a95765e64f94d160ec336c88cd716fe530500f037a9c762160771f0dc077e0558c5684c3950a78ec4e974d7f9f8f60a5616493f12d2a39c5f3d3273cff2f7b38e0b3a3d6e39e9ec144747d1e5c34f9746e3ff34a1dfef89de39efd69626535359bb837b40774f87899f6e650a9a55e95d8e3257cbc72c2e359a6fe710fcf73677d495fb88bfbec94877f4fff0977d66e7de123e3d355c346dbc01b6db48109cc953a0d349ede8af975f8681f7bb40f1fdd76c2a3c15f4af15109b347a702b0fec0fa116eff91870f3c0c3f9e0481ffe74bde5c7ff410f7bffefde1030feb2d2a21a780237b1a383cea5f008ac72ffa4b7e899f0465fb814bdee4f179ffbd610a2d2a07d61f80f78e3c7ce0a1f7b9fdd81d1cf1e3fb368417898958308f4360791c5696d964ae63fd7214a52c7fdc6f05bff0a89ed07fb492db33be0ebecabb3ffe21b8f51b614f9d7f767ca0a9c754185f2ba4959c41709630d7ab929975a2c79aa64eb56a96f0c82c58d3d4a236dfed27d75160ca8d75b7bd912ae7324b72d5eaf337ac3f0d712245a5829f6584a32e1b4b22f983ca11385ca985bcbbc6e7ef5282dc70a5b28b7fd71b42b6ab1d7d0113f0f591207f598df1de10abaf876f970892d904f55673bdea6210ba246bba6a761d075ea839219beb6892229ad925c955bb3fc03c855380522025329012254c7fe22701d3ffb0f556fd2e846cc1cd5b61fdcd7ae2d05408dfd2dfdffa697d24a8ddbc15d6ddac77f2cf1ab10684984d4e06ab9fc4d97b103ba70093985239810120892998a089674adaa301c665cfea63facb06812acf3efbe9e6ff4950f48fd84d8c581fbfc9aedff637f83d316e710e9119edae24d4c968e764b473e2d2c5ec46d5ba2d47dbeb9a605127c8e7aa575d64d269c32647971bcd6c689a75a84859ad0d4b9078ca44733825594302374ed98ea733be6c13b14c345636b8dc6cd279447730f41a9ee7da141aeb076afa48f039dc47459cdbdf8ce7381ea7f34f81d3a9b0390efea9c0d3a0445bca44c3a48faaddd18ac1066dd24928181dd570ef5b6112859a3ea65fcd70600e7efdc0541caec6ab1358c0e8240e58f36039b1324e393137a0a10916a34675c08f5709d4a84f07a347946050e19218bc1f54c665d620fa912e15a8f082b176c2911c24b7a35742f3b515b07c89e6ef602f82d4ecae02be0a5273b80dea88cdf2b8a845e0fb3add9a8fcdc08c48761bae9e4c8d85360615ec157897dbf0f7c85e055d3f13299d549434f096cbd482846d84d32a5105fbbbc03e4c27c2c3412836f548342fa0f6c80255d9eeb4d76baf73dfaee1dfebfa983ef63af3e591a65f13fb3e407218d7e265b2afd77845251a4ffc769c96f65add7e4b1a676513c670e32ac26647ec5838686fde23196f556e7c8913f52a125eccc699ac0ddf78cb6b45558dffac2a08abc668b7b1fdce36fd0fad096e3f8c562ad8e513df23ffa0cfc12e82bfab048bd180d9a45f8f87c2eab047fa947b41b8990b83bcbc9c143022066dd9989850a85739bb13d7b55db96aa02381d9e4297c070246ec62842ed1ba0a98e01e417956881651f964efed51a2c558964709370b57b538d944512dc5fd108f6b0e17546074592b1c6e5d06a32c50576d6dd54796b5ea8ed665fa48f3cc8023093bf42d49c7c00098ac8a93dfea6b056e60c091d4b7c08e89d347efc1d3861fb3b19e0b900ef686fe2c5149356068093ed4a7abb20f47a8ec45734f2e87619a4493b1de8fc0b762edfe098d74ca6aa854484851313ab1568ffa9544a1184d45a19850d0e18b5aa77ea07bb002a3b54d90d2cfade8f71a96115cc7d720b5899d4365aa7137eaa8f80ea7da25fa586562593f5abbe4925a657ce1139f7d82007113b7f015e1ab244a881ce2fd21be300bfa31a3d3ec82642a992a0d014609bbc00de1a1072fbdec678fdc1c0e3fe1367bbeebe973df78fff65b376e91cccecf397be4c7f9dfa5efbdffc0a5973d38330a8f4b39e73d4e41da72d9f65befdb22e5bdaf7accd2e313ba3ed64f9a4d7246fd247c472bc625009d93d3e622b11cedb8a4cc95cbb44bda9bec566733a261d478098a7d43bc8accab18f94fb8ba94bcbe59300433a13fe257bcbe30f8fa67425688c79c820b14275cc5f992ab4756277d00709553097951c804bd3ff20677c2d04eb6636eab6c7febd60b9efa546556c66a550f780b4ec8a9424ba455f1b677d86caafe3367c18be5fcb8e79456212ac49407038107959810155a959aa5754ebe5b89767545edb689f18b31bfad13be7638be326f54494453c5921457e2c5898fe4572ac2f28f8ff1dfbc050b3418d5e46bbb8e60b7a3a0a8e92315c3b6d07c06ca565c3b35bce9de029376b6a9d15dc1867e806368a226d044099bc2c45e05c30a2a6880619b8a71805ca48f34c335b8fdb81d9f8f30988e3d66c080da7298bd9777b16137704fac79324dcdc0dd58e958ebecdd7c12e6477b9548bc6b1a93ad1e76dc16497661446a6f9e974eb0206066311a090b8d6afda6021a0e8b710fd697a7dbb9fd984f393e7f3bada1258b313cd69a82d1462617aaf97887eed84e2966633cc3c4170a63c8541a86bbff4738b5fe7538b129a3c106f0e771325e20705a8c6a35385cabe98ea91809c7e1d34ee264fe49d8749e8c4dc2c066af57e9084ee0b2d7dbd611fb33bd538c4b8562148ced697b052514ea102763c02067f0137222ec3ed27a0ad8db4e86bd7d1276bf51c902a5afe2f3b79e1e7693e4e98153426c48d9e341d51fdf85a777ed3a9967b05e558a9c3709a997f97fe22a0de76882bdc2c780bd6b0aecde89e87eccbd8ad669d48da6402318d2c0acf17e9f53c28feffb29a0bf390d024eeb523c760a6c90c67774f797937780a7bffb8e3b92277701f3779c75c739e7dc7116644ec40ddf30d17d8af130ede45e481bbdf0925789443b5313fdf092b72d124b76fda5633cee414d34fee746f80315f412d4feec08473b37311f870bea8e519222cb4fc2a6eb646cba0d6c5ef62a6d1da1702c3181cfcbde405b7b28d299fcf318c53dc582e2ff047c2a1b2bb5d32233ca62bd279021e4047c109b044993dd93f884725aacbb50a061c67e6cb980569c2463c2947a2a6916c26401d560c00ec6807b5bfd1d167ccf0d2e885b193fc626eab94f8334e67274635221e67f764f90ac07a7f2764cfaf297a91773324e4d1e7f312a19feb8624189e2db57500a9e5aa86fa86cf87b36266b27c9bf1aeb6b9c20b1c74799abe8c4ba3241b2ad69970ba27d91993226fa3d34c53a87e311319eb0ceb1a59457925fb2393d3c7b911f95a497ac2e41f663a919a2b50531a3a2c54dbcc6ebd29432f5c97bcd0e094e6bb6e38ba5a8c2fe9dce70179c54f24e67bc1bd7d984d0b0dd08c23306ae11d249f634b18de6a8b780418aedb92902287102c2913a6d57ab11968e130959d3c6abed69ab8a99277e95764e90038db0112f0b9f63864a2cc2e5c397c6eeb5ba648111241635081232de91ec0f8471d5a94c214a004e411429eac7d950292871a5502c28a7214d2b8e6fd4892aa727cd976074f22df8dcb10f8f3d26bc2acc216d244aaec57563434ba24e8c48b1d429af365f2cd770d2e3006864c48a2c06488ba3071edfa3e20bb46391d27087246b9e68b9acd9f16d05ee08fa6cf025a67b893dc8f0764a55b7af6192e199608e4ac68b2162228bbc50bc7e27005b6f83f3da956fc3e86d5b9f7d766b369379e9ea2ffe929b7b364c47bdef007cb0ea3ae485e76fdafa0de7fa1b7ff168abc3b9597fd878d7e3246e011226a186f72184f165d8835a3024c955a90ddffd420c40ac30042782d10892705ebbd2025f812f5cb4ed7830d04251ab3138bea25fd5f65865dbc980e09c01465cee55c44d4829aa88d154310a05c0a2a050c085195cb40c46f50f9fc5ff07f403b8adedeee9d98d4f40cf5a339f751b214249d84dba31aacce7372b5883b82489d1946414716238a40c0c7c0ac6bc6d2be7844d4b0732cbe0a265d9fea59baceae0cd6bdbbe70d10d4f704eebd24ddcfee2b0e2f62ddd14d27fdcd3036a68d3d2403872e9ccf33e635e7bed1337086e9edb64d4482573b2cf0e3f74b16bc67f92b04011a41f5f28dfd2dc1e9b7eec31d3af4d0156259ec3b36cad4b4c816377b2efe9fa3f9a7e8d678efbfb2abc42bab0b5299f4a739ffb3cfe72720b5542602121e457c6e7094200c7f9e2c616164efcbe26d0631f621b023df6111c9e681bed74fec63dc75e697c26ae4dfd04054aae30ee6d7e10ae36630bc65610e8b1c78cdfe039c1d8174ef8dd71789de283bfe58c7b6a53ce1727cf1d7b49a0247a8adf12811edb6db4d13cf79071fca993ef9d68ff1e63bff931ae43c678e6b7040a6e8112fe349f269ecd4fb3fd37044a2e9f721c33e8d03cbe46a0c7be6f7cbe6a3cab79cda0d3b1df08942c14e8b1e968c734ce8340217b02ce58eb4b993c472cc6f9f506fe26e3bc69ca87083f60e758383821c77e495692324642428a540877dcbfc9bf5f9289e871e3cfdfdc31fe2a38d27194930aa91c7becf87ff8cb631f1efb9054c83642c834e3dfa5e4eb7fee1ff4c262d80e8fc3ebf063d0b97eee8bdc1bdc077c865fce1f16ae3355cc4e73cc3cdffc1b71b948c5772d0f597badebac0fd96cb6f9b67b6c1fd8cfb13f687fd731db516d09b56c6d79de39c37989f3f3ceb75d6fb957b877b8c724a794941e92bdf23af9971eafe7f35e9b779df715a55319f5adf3fdd2bfd47fa7ff9d407fe0b6c0fed669adbb5a8fb42d6ebba96d5fdb81b6dfb4cbedd9f6e5ed77b6ef6b7fbbfdc30e47c770c7e31d7f08f606ff36e408dd13fa7d787ef82be1bf0d1f8c0891aec8fcc89591fb227f8c5e1e7d35363bf67a7c7bfcf94eb973b4f37709397157e24b099af84372387957727ff29d94237579ea475dbd5d1774d1ee6cf71bd32e9df6bbf445e967d21f6406329feff966cfdbd98bb23bb21fe6d45e77ef0bf9587e6bfe0d95539f2f9c57d8d1e7ed7bac4f2fae2bbed2efee3fa7ff8592503aaff454e90f03b307be5eb6941797ef2aff60ba65faf2e9374cffc160dbe025836fccc8ce3867c68f66aa3377cdd487fa879e1dfae759a159f7ccfae3ec15b31f9fe39eb365cedb7347e6be336fcbbcdfcd9f367fc5fccf132084fc13d94d24d2ac90c0111f8a3968ca3517f9b621e33c5873023311052b21e40a221bfb40bac876639f234ef275639f47dddbd817c800d18d7d13d90afdc6be99f4c30bc6be85f8e077c6be95f8e088b16f279d9cdbd877904eaecfd8f7904e6ed8d8af111fd784e187a4977bf0c61b6fcc5e76e5b691cb376db8e6eaebb31baeb98acc23d79011b28d5c473691cbc8e5e40612217f43224425bd244f0a24422e21db48842c25ebc9d5244296916bc816b29eddbf99644984cc2157922b49644a0bd7b3a38de47ab2915c47b6908da442b26411594ed6925564315942e6919564055943226431594fae2497922bc9267235b98c5c4f56938de432f22972257b4a9e64492f8365909c4d969173c90a3278cab64e6ea9e784b6fe52082227fcee1c86c7f56413b986d1602a4cab18168da3c9b397936bc80d6403bb7fcbc42fb2a4447ac920b98aac279bc94676cfa524cb70bf84a8244b8aec33400a4425e5ff4b2c4fdd53a73e7b23fb972597912bc93632422e279b0ca8af2759b677d5ffb37bce251bc925e45276f686099a9c61d0740dd94846d8d97ef65d24d34991f491e92cc36b921f0d597dec25a24e48e4a9fffe8910e0002bf0b61027086002338860012bd8c00e0e68018ceb75830432f977f0801714f0811f02d00a6dd00e1d1004cc2b8840146210874e48401252d005dd300dd290811ec038905ec8830a05e88322f4430906a00cd3611066c04c188259301be6c05c9807f361012c8445b01896c0525806cbe10c58012b61159c09ab610d9c0567c339702e0cc35a380fce870be042b8082ec60a3c70096c800a6c844be132b81c36c115b019ae84abe06ab80646e05ab80eae871be053b0056e84adb00d6e829b613bdc02b7c2a7e136b81dee80cfc09df059b80bee867be05ed8019f83cfc34eb80fee875178001e842fc043f030ec8247e08bf0287c0976c363f065f80a7c15f6c0d7e0717802be0e4fc253f0343c037f03cfc273f03c7c035e801781820655d80bfbe025f826bc0cafc0b7e0dbf0bf603f7c07fe16fe0ebe0bdf8357e1fbf003780d5e87bf871afc107e04ff006f401d7e0c3f819fc2cfe0e7f08f7000de8431f8859989a5bcf8a9ab37f5f6f6ce6f6ce7f4e256eded6d6ef3c65635b60563db676c8bc6b6dfd8968ced80b12d1bdb398dadbab0b12d2e14167ceaba6bd841bff190526fd9d8b29b1618402c3080586000b1c0006281f1f005c6c317180f5f603c7c81f1f005bd79a39dbcd14ede68276fb493ef6bb49337dacb1bede58df6f2467b79a33dd5684f35da538df654a33dd5684f35da538df654a33dd5684f35da2b18ed158cf60a467b05a3bd82d15ec168af60b45730da2b18ed158cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf6fa8cf68a467b45a3bda2d15ed168af68b45734da2b1aed158df68a467b45a3bd7ea3bd7ea39d7ea39d7ea39d7ea39d7ea39d7ea39d7ea39d7ea39d92d14ec980ab64b45732da2b19ed958cf64a467b25a3bd92d15ec9686fc0686fc0686fc0686fc0686fc0686fc0686fc0686fc0686fc0686fc068af6cb45736da2b1bed958df6ca467b65a3bdb2d15eb9d15edee0fbbcc1f77983eff3bd7dc6b6686cfb8d6df37703c6ef1a70e40dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1f77983eff306dfe70dbecf1b7c9f37f83e6ff07ddee0fbbcc1f77983eff306dfe70dbecf1b7c9fef37da33f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fdee0ffbcc1ff7983fff306ffe70dfecf1bfc9f37f83f6ff07fbec9f765a39d72a31db5b7f71538f6590a3bc9326a5935ac01dcb7565b684e0f47a97bed32ea5d3d1ca5b7ad0d5273fa82e1b5d49b2684c0ead2fa7142fe3f000000ffff03009de12aa314a10000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/fonts/glyphicons-halflings-regular.ttf"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ff64776390274fb0ed983bf68e6ddbf68e6ddbb66ddb36766c7be637b66ddbf3f67fef8bf7e555c489ec732ab332ab3bba3bcb4d4e4c0c0818e8dfd0fc0442facf16a0fe2fffff879898b20c1010b0ffbf4b9cff60fe7270282e222af64fcbfec7f1fe8100180a084c4e899ee99fd6f58f0bff83be599974b0918d813d1010c83f0a8cf1cf5aee4bfcc130727526000202c5fc2742fd0f2840c34cedcd6cfe69ff74e0bffffc4c949ae8cbcc0c9cfec5822dfe3f3f202044336b0fd37fda261090cc0310500426d54c5184b98981311090e2ecbf79967f600306b3ca36ff2702012981fddf9a496098c010cc6d9cddff69ffe540fd97e3b1bf1729cedacee89f9ff2bf38e0aa7f68634a51f6b43170ff975765f7bf7dfd071030e07c5b031b132020d5fff611f4af4eefc59e8c077b3b27672020b5e07f9cef5f79c15382f1aaf68e26ff623587fe39d2fd87fa7b6a543713c37f356b3efee310ffc1e05b91fdbf85febbb753bc8533ffd9691dbab4ffb5c83eeeeb46fac6fafabba086d01088fa86fa60035a025320c78c404070e5c0704040ffcd9b5a1867f7f54df40d4c0d3a07040410c20831d3193018ae031f8296d407c9dfd8f34340426c8bff9a82fc86055a926458040ac14a48a6606000018235805efeb7062081571a541031d42c49fb50c4080a062f9c591db330c4622127862c04b9be834a9004b31e4ab5543a8b2e068cdbcfbfc48f4ccdfd21736042cac67a9915125d4d43fd75c09b9679a68f06e988b29996693aa9dd3e4563117cc1f92e59e8befcb0410579bdcc4b2315f46c6c50d66445f9eb3cf3786945c06a7d36556c7da907dea55ae9154a5e1854dc9c50085f38da21aad3ace1d96b21b0f5c43d792fc1535375ae2374ddb5cb09f754829c8142585e3c9c6cfdd4d01f892c9dce7dc477b53dc6462e2dd1e33e5d9befe82046c3e2589a204b31018d12e68ebcf701bbf71e36c8ee35b3ae6d54e7da7ec8cc7b60d47b6ccf357b7b8fde24f2119495f3c4712df41156118de77d85ca147fd98ff909dc85d844e2bf4710074401f2eabeaea3d029ab2588b80587001a30b44ac0b98eda98113fe255000e3db4146368cc1d720465c1ee9810e5008c2f102002b75e87955f502c9105418821e12dc44788cc6c155ec8c2cddde8349dc12c3d9150e9766635a37442123fb0050160fb9295686e047a29beb888896e0a82d4b4367d9c70f9f88685c89a4be6b6043ae2b5a344c092e10ed06c2afb9efb8409fdf8c47fe0c66c6259785ce4823e23043bce72900ebac102744c22bc072eed19323014a797eed3f1972a7eb7b29c584ed63727cc75053123bd8db3082859d980657e2f122e3d38a04ac74f96fe654b4f6ff82641e90f197756516a9e7a5defd769683cd50fa2020440ae0eaa7afcc2834720439d6f2599a081437a36ac1a82424ac281e13cda1cff2e167893401ee99294e23e086d86184958e9cb67936bfce1c992b1b1c31d78d5c0e977ebc592e67a1d0b5dc74432e436a4f48716111253790bfd3dd34d0371a8f8312390ec7f7417e79703d574bd2ac293d6ec02cfa80ae5e9bbb1793f19d9a9da3e1d25e4665769593e4f09f8d09dee1905cdc5831d1434eacf9af9f8960a78e7a1a96531870713f664f7fc296c3e0fafeac2e06b205fe8fdab09f43f6f14f0cf0f1082fb7a7737a43ff6510a10cfce9444115d4cf19cc47a8aaa09a94d4ad7bce5d1133ab6da660c1c9d7390bf3f65e9102603c41f589250f040684668789c5d79f10668c9e94d52127b298575ac307b8c5896d86047e857a968b7cfde179ff9da16cc81ad9f1f5de94c3280d78dd7ce872e7eecc93a47acb758b83d08b9c319f21e539089e4e1ce646a74a81f2e0d2c8fb523293002f6adbd06138c38e42458dcc96179f6d8f81d1b93b8dfc78c1f3a91f88ef581df0852ed18dab0c93e93ec912667cca4f973b6586526ab348b9fd803cefc2f0d80ce52b135a500573b166fae378b8afc51b6b67c4939b24a49324e5a8972814430866b8f7c0006d2e3dcfbf19e24e4a38227567e7f3fbfa71e756b30b516472d8a1ddd5c167683c98006d70b8030b03dc9bc25da94b3f84e03a0bd3464bd66ddaf643abea9d192eeb03090c12ded0aa3e32f72f48e8f45a8632cb2538d8fb333cf9e8414d928f819f84aa3b6db62afc956d4ac3269a3f159d1d29431805462df64cc2a2c66356d3152893e652925d6a83a198946afac0db53615ad9a1f3f5c59222f8dcea753623b5fd1dea0d951c9d958e23baf118966dc4af664b5e5b8f67bfcf9e585180db67d5ba82bfe82faf1a8a1522ee6a5f4cd7daa5f5a81d252799796e6b861d09bcf2c39fa4e9d4a467178ff287425aa953cd5d7f507320b3266e6249516fae3e364e639fe22f25d90780dfce618ebebc151ca7cbc61b391169b9d57a1bbc3a5360687cef47e985c76eb6d1f1c036d7906cabd531697aef42352ba3673e3ecd7b47a9adf7be18c2febd7a22afc68b190d890c927f02e3dfb117881140ba312537d0264a16ab056acadb0e3fe6d82117e3cdd1de91dd8f468b37d66fc5cb60a00444f819994dfbbc418c9d86dfcf8fa266f8ed8dda7ddbc857e272b7ab4982562343a52cd0571f488faeda595efed70d9596de521cdf78cd5f79af2f04b458dc7e76ad90c07bbeefb280838cb08857ca03bb210a28b0864478b837bf4284293007acdda5fa1166811bf8648e75dd097b7174facb32a1cf20980d6e1e6fec8f8cdb225d621f632700335cac7f35cf05af84a34ea24363deca008b29ad15735bb9245389e6e4c3ef973b528fcf916b68cc7b562eb37bb76de64f7dd9ab41ab07045391ae4caad76b0f3de9bcbc56f553892d18ea3c646c42fad22e36f613c6e5e2a2c30d1a02f348df4abaf8d0c985d464c5f9b9ab4168794e94f99b7323a2bd8f3699680a6cde3d3dc4a94285f44efcdee1f06bddc03229cb45f49030ce393d09c949ce19a1b17325210900abbe90ad65f9b1328fe984ea9d5da27a937eaedf631834ce70d1cfa3f0f692d90298ddcafcc6e71dfadd270ba736ae608e5e468c193b4aa7b9d81a34c1d8acf901cf9b19e973a771ffb41d96156fb6cf626908687d3e6962a5686d173d973638432159691b02641a6fe9c409778f2c62f7c016eed5a4bbe0002d01327d256d052ff763d2513c0521d6bfb0b922c53df1b16af2d0641b13be9fe035704632a51df75d724c699132931950584aa95ae6007c91972d24a317ed77bed04801ee9c5adb7aa576e7bd0e9fd86da4da7063f38294d02f94cb35faffae7ade466002d99df20b70e230fe5c40958762f1c7dca7c9310a010f253423632df3de89d5e7e3b42ad1b465032bc3fa554d7f39e598bb562f375f9dee3b1b2d9e0e6fe1aa1559b41de766cadb1404b97dbbb2bf2d70b90caf9b5b2e92efc96ac34c1bee90e377bbecade2ebaffc5f08c3e0d4183fe74c8a3b0cf881227cf91f0b9d1fdd97dc8ea33cafb1ef31eebd53b068b4415f95d3c4386f7c57b1f772bf9d670c7ee9d9db77049e05b8189dc68a40443a67850e7a55c915f0a7397ccf2c4f7d0d3fbcabda375bfe5965a977e1cfba9e2f6f50ccbfd731be2f76d569be0c6db37e99118471a2661a7b78c41a46ba44d08832539b5fc2d9e954340ec86a2d8c5afb6566ab141d5fe11f5feb793333beef163f2b4c3f828fb969bca5e0616e344519b3c2d00abc0c1049e53a23af513093159512cea884549c50a60b91c9d3a65d152b99cba948d55435cd65ea9a9a6fa60dce42cfba0da8ca531c49f0d20e40c5f961e55a8be665ed3de5665ad8aa04355f89872d5e8d7f153b10a751b4929a846afb8b6b2b9c80c2823aa5544adfdd3e4f6b25b2b4f7ba420bf564d501e28f3f595a944c71a26afff34fbc4843f23e759801aacf19b74211ba539ee1963f43be0156b4b5a0f72042172f237bda006ccc0f969cb5fe2e46423ab2214f3f43d5327a7f606dc8a9514d0a604e382683609c905d82ec6b0f941d11571feae4e2924019cf065dd2553536972f91ee0b75948e4d5b23186d239752601b2eb24390e7c103a792142d19de89b838dcff7839c71369c637a8b552c9f3b77338ef8dc2407bdf19c619d91adbe2d382bd612218bdb7d9cf98245ad292252a9c996a378f49237b57e5fb752e7770cfdde664daeb7541a7933b00a2547c2858c80b7f58664216601458006f8c6d7d27cc4c5dd820a1776ac4d0f520b7d756a8b2f5d16ed9630659893817ffddaaca4a124e7cfdd07c9cfcfd51361492018f73436d65b6fce4cec55c268e906cf7bdecf0aacfe56cd64d408a3aed6c19de0c441b7f33bfda40540c5862fb6d2952bb6c29529fa740d454c99773a3c954d96df5317fff2b97f8fe4f277caf889034bfc4dc56514d3b9dfe637b95216bf3f77d1c39dcffb9558669cdf87923caa897b7854047e386e76c081be83448272380f953142acef15f921da977301468b6fe48ace900e091d2a4660f6c8058c6961668431194642fef7b22032497fd0bbd6231c5f28f935fe90957e4d663e76711b069939643efa90936679a568e3d1316225274072d65d08d0866509fcd551cadfc47a8a2089a8be579d5a6e9960cd9930248de2f0aaca1490356efaaa6af867d23c2c41363055e1d887a0e1a339b8bafda319c81cb7927a35dfa5dc76964bfbab04d11cff8323687fd449c6710d9be0eb2b2fdf0b8a60c6494a3682faee73f49c10360a0df1292f3fe614fb9628f9da65dd2763be3684db880b40c5a4d218b9cc5275a2d06809ef136dfbc473ae0ad8935bac05531f29356c7139e1302d678d7fb10abfea12f353b9ef57219b6fcf267a4676e8060b57d049501a8614f4330f5cf2050e0b25ce04d671d649ac3489f8ed848ce9947948b506442cd76cfdb02b88bdaa60ce94628dfc2bf1dc2e16745799d9090375d6b41b880613aea26b06eef76f7ce9876019fd45b7333055bfbb21f08dd878a758ebcedd08bdec968f2537bd2d9fdf6f2e1294fb9714fb204f2016d8be5df26b9a5c8ec54dcd8aeedeea7e5721b4f668da7fac0facd0ab3b4563fdb5a962ce1899db1ac2a963c7532791002973224390094054fe8ce51a585cd39692a5f3a540e86cc0076ddf6a6007c55520c27a84e6f9f1831d70b476f2a8aa845b81da496dd29bc3b4fbb2fbd37bf1d0aa2acd25583508e5ad998a374395ae85554d38d7c0b0ebbff3172270caa99609f53f8eeef9ca122fc455cd5dd240b01868a4abfc8fa4912918d6153df2106568fb1a7dae154fa0c76ef1ec606fac0813e568cbf956a74ccc1e7c1a23d6d524d0def59977b2f692b014254a8b9591d90e18c9ed9c139315144989ad7fcb532a778da833997bfbbbbd86a86c05b5feeef0fa35dea2ae4466f1c7ec572b20fbafd42df778daaf507aa434aaeaf3df5d8059dec1b15a06e3ce5fadf0c56ad0c0dc3ffe09daf5dd5db8df0fd88557924672697b2d018f924288b258bf106e9ff3ed1557666d426bf13e1d9ddce6d32f1836d9a89d1211edebf3dcc08293981a6d998de7470ea3fbdeaf3656ec567b708d0b86d0e270515883301ebf1de09afab6fa538a385634fbd7f3e6b2424a885228dd8c3f1cf3e958540487454a06e6c04799b1d435ca3df07982ab64abaa92fb3cd297d6e59969c9d7cb83f7fd0b36b429e29ab0f24b3aa478eb9772963cab3279b5aeaffaa33c79ed15ea609ffe20469e22e6cba26a8b8ca826861e0d815a926f08a9def7c12ec9c8d9749e3621ce08709f59e213e0452d187c9dd25615516c32cdf271f5fae44b98fcbac74a62231ad7cc1e7e0e08707dd55d3eb7c7e946cda8f3b1afcfe167e0cae3074abd17ff58236b4cdb9dc90fef85c02e8ea2ceaae881f746786f7f433c3f27ab34234ef13f93864081c21322afca18ba90c5f00dee2d29c41fe2f705f59b3c6d99eba6107f7d1abba100da81e0dcdb35a61b49e9573f27197b22b88a5e6a4820e88f268b87c263cb2a97e51243224a2a1c94fa0e89e6a816cf6be67a7d2aa626b06de572de38c5f2cfdd15db541889cca6e0a3e583735a05e5a90f12c6ded458eaa2309eacc4b02ba262a0c3c371b4c4040173c564551b87858ad543f58f52c690b54d7f316749ed728d5742efdf0bc850495459658c926d72694f5c81f6423673dba344c865425e534519ba5e0e3f75bd332c5ed241e9c34b468c6c0b6b1bf7dedf6e14f7d3e3cfefbdf988affeba15fe1a172560ea9973379cc1fba6f91a47b87b2c8727e195b001ff5e15c631bb059151d02db1524d4d168eee2f74342d3fe897c445188aa68976ac26abd92721efc38f8071e84f42d917bddf9c4911efe2279e89e959fa16d49ac992918bfccda5cca729ba1238387533b8e2ecec3a43aabd16f962ec068a126c3c2a6aa2917cbb4c342ad28b7b6a5ed0d040124150e1ce2c41d5f9eb3ec573781636d057ca3f7c5df750ee1d9b82ff895dff827d8657fccb9d40682e4e8d728003b437d0e70747a0c0d1e07a3313e2acc0690d0874430852b5d692494b4230c3da3505751564983150ba92a6f36543b455ca25c85403f6c52ad2ab17b07b17729a5e89a7ee14313e056e456d79c39007f7e04c49f494805a5e8f31a6e1b5cdf13992dbb83a7a861f9fc4ff2ae0e7b9543b514b5cbc07e67ddbf5fd08e9ae5bb68096ee4a3403d11ee3925bf3d75c09348a884cffe07df4b5018b11b86733cefb4cf4cf6743e9a3953d58c68238e31d07124ad19002afb9672a6aa63ed1e8d1c1dde104b1b82b6e1ef59313265db2e42b635e60cc83e3152b425db726d318819e01d1bd7f7222e5dbec678b963f46384a5a79d76544875333f4cd78c59c906cb86af0183f12a22b7775ac14a97447434a4c511cd8586ffee93c0ac3c3b856fd34de8816dbbcc2dee7e640687b9cf32f792e90baf591720cb0f371368258f31628be9f55e5d2a1fb0aa5f0a38a3312a4f4add083847361a6a8263932b43584a2ef4725fb6f594739f0b6d65a7656272b0e67d6c1d7092c5a20fb508a6ca0d6059506065f38d06cf4e04902cf2e4ba0b0359a3454fcda0fa658bebd295d700448e318902bb887539158af988462585c80a272aabe8a1b627329c51557a11f5a53e18c8e273d56fb09759dbfead0cfa14ee96f1b6017e8a6caa4dba9e6b2abe3f4dc5105771dede4ce54ea498e528651efa5863af093767aa887cd58a73a6bf65d60ee30db7b4c277965560ca36dfd66544863edf289b16b5fb057f071fd667f5f64d1d7a0ebc4b560f3374dfd8808452483918b5fa71266b6dc7da504e2b0928f24b08eca2026a5f479411e587125889db475de47783e0f3e5976f4b81a7fc44e4fe85cd3f5e226769add5d971290e9e45e25e31ceaefd1acbcb973e8add0ca329832f9c30ce9d287fb449ce0216993452330c60bca23149f6d0105dfb9638acca3cee5d64e1e0a7bf5aa93f0ad082df21640116155d8746fd00c6c8a70f40834efc2249524f797c39f6203b4e23bd5dc91f2f0b1bdb30fc1b51ea520f53f455e51469d704cd82e44f16b54b13ac1679c350c8661faca13a104f099c0252eb3219339c740fe59b7f9a08f98ca816d8a606a6c730650f31901be25010e459c2bbb0a20e06115a7f9fed61a321daa760513637336f9ddfe24652d56097a3b7cf21add2693e730148c5e7b3c3f613acad87567b08b18247ddaaa6beb622cf84764c0347e6242f1ddb906c8c7619282aef14dfac94057fd1badb69dfb85865e1b2eadd19bbe4b15bac5f85858d906716c58aab922f0794d7df3cba61af6c849587f547981b4b70673fa74fa52982572f6b253ab2d7d9cb385054adb59bd20d41a30674b1b2b5d3aa434567f975b82d6264655c29778f4adafc9e627007e5b96ce936404ac51b6f3e802b76f405ce0a6b8dca79332ff2132288cbe297b4b0f52e361729221109c634d486fbaa90f546c7d78197afdc8717ed814df71ff88119fa959fde3f587ed7da40e7f870c0f315975de6570a6ae6dff84fd14726d5be516fc755ba5a82e11ff20c493be87dd7ac5d843549bbe0b8025607770582ec82622096c4446a50e41c9e0927178d29cc38db1f4938484b881f655112afcb483ccb6b0e3e8681b5b09132b4cb173a84900e6c7ab00c42d2c15908efb71050a4bd769beadf95cf5f5b691f7f15ee393b6ed61e7f3d9439b4bfcc9fe0540649730523ba5845ff1c5fe12c62373d7d477e631c5c44d933391e6d9938f7072c7e487946021241f5bfdea5754ec73578535c74582806b4f1b702c75de5a9a971ce9148b3ec4f3bf38cd42bb0705ba1cd782fd97406ee9b1cf4d6fd324d38aeae7baa6c1624abc36403921200b14c82c799db4b1dd43ff87f648157980a38bc5880f93e4dab2bddb0c78225abf34fb5c7b243ad9b1d08b132fca44aa0b29764890720624937434a21ea326d513a368c609ed371da930e657d152a27fc238e243fcda5cd7b84a33cfbf73858555d9b2e0169d53c311cb5373b1d1f134d35fae3b3a68b50aa09d0c3f226b0465e971d2d7332a5a50a969802575d5d1774108c5a46c609469b0bec93dd5b7c08db5b21f2cf49a8ead555ec4deb4623534c5e5dd74718ba5365d5423c4ac1d8aeb0c23b67740cfc0ee6cb647f22baf0267ae789a341dbe6818174a2c982e089428b0ca004f79d8227510c6df2202a0c2b6c95a74016d64d4825ba2b0b96732e2e142dba24cf4df07143c9de92ad39f8ff53a585b51f8eed02935ac581be042a0079190b7b847947e407e11bacad57daa0aeed8198d81fbeeccbac1209a6de0c137dec793ec71311f8b4fc6cc8dfe1385ad58e218c9c4a4c9807923652f9b9200e163138135c3c942cb282ec2744ad56456f3ac5703d8ea15b2e3a738350589346dccd9f8e8afaf12b2f86596e200462016e2001ecafcf4486a386faadb7f2dc93e9f6827534ba432d5c0067e4d20edde55d1f7844599cc87e4092bd6811dc2b99722a02a442a2d8d8a9961184d9b7f13b91ec2ac49113f3b2cd3dd74c04345af6576254b6a840444f5fae213b8fe5c1736642558f81542f8961e9dbcdc8b6dcf8d3c6c600fb167ae1d0179f8f662dd0794a0772d6709a7b3fd18b07cc5cd1cf53ef77113f6f101927c521a4490208c40850092d87f27edcaef7e5023ca93484a4f28a23a4a12ceb21996c4f23c766a1080a5db3891be6c5532195e52a94196be800ecf9b9918318d09ed166e9ecf32310e3ebb21301a31317c125c872abe25faed93c0e3b68870a9567c38bacaab432d6dcf0f9f9fe695540f819d02e311d185aa18db53113c06ec13d0b6850def85bdccd3b4a73c6bcd667f043600859e18b21e8d5e90ab5920b9d6a09fa12fd8a280eacffc46c35416f12646729cc9aae170e5546a3895043915a2c9f3e7b27c3f80965006914992e217ca229666785c252c9a3cb8ff566c4f86dd811a5cde7827cd7bfdf0f08ccc3d1e2ca7f5ddade4b625d696553fb0ff1b57afe29e5fb30d3627dacadf38dbf7c4e1bdfa5d2d5adf917b7021edc00b88dcb6685372ff74ab19f3adfc42137d22a43f9f40349a9547e8e779d6e340aeb4b58a9174572538f9f32083f9e0efd239940294eb99e1b3e0459db8cf9a860e4d50cde5da522ffe0c0f6d2f5aa0fd53bf4a51971a70cbe39868070caf767358ce0284313257300fba7bacf5ec98422377043a6fd9a8c43a45cc6aeb4b8be67b3c55d72d37c035151d8a99981419e1ebf0f174e5fcb9dcd1a8fe949682bfc9ae2a74815ee9c30cb212930462c0e549ba74f3e06e9cf491c5f64739e4f1968554996c8726e698dffda9682e0d38ee4fcc9129a4fa15840c2057b200b9d0c689c6877440de57e0f57094fcb0af3a7176fcb05433cbf5a65d3e86c78e7987333b4dfbc8b2c195255f5396d5635d5959792a63ef5801e44873f15884e9b85f947b8925d152481ee68d21f4e4fb21b8c37ff1ef1471feba62a03a7138821242af6990131a2d2baa7c324e2f81c5111397e4015bd915420b21855db012688cc5490cd038a3209d6790e2c34c7fa6b06679b1c265bab4e43dfd84047b60e124a22dbec79c7edc9708167d25b9538e352d761e70ec575412e03f02f42ca3df4fd6eca8163156c1416d6db41fdda5a4d4d9b4ddbd33e37f7f3644bd5c6f131cad070798b21abb49c136d3a34e53346590f4a7721b4c0e24dd68e8aeb9df57b0a3d569f5781267250d98289fc42e8a05cc22d28603418a7529b02c2922214e0596218c23f857c2f74b8215c9a9504dd6b1f01a596819b3608fadbc9d56abf7e9b7cd311929f4b2d01367b3342e0983cdefd8459621b36dccf99de3b23c3b7dfe3db1e22364befd71addd1cba6c62b2bbdd1332028d6d558875031ed5f2c74e54166002a0d7c8a453d63f8795e7155c9609e2f8210671f8fe1eb208ac7b5bcb3cacdef7a6d410284dfdb5686a6561780795551d21f08d3af519f83bcd29517c5ad40052ac30698caa5e53104249593e954ec7f3ebe996ab218abf9efa42717e4ebda4dc8e4ed5702e5a0dc2e526823cb6e72dd89e8eaeae20d9f0388e2f8b16bd71c072bbba58c8f92a02ef23d49d7d8c6fe8051e7e91b62fad4e71a1094907b6efcaf85413dec79344387fe1f240c634102676324e15319c910ad91d657726a06d59d3387e4390aad50c45403145e01a7a5490f71d6cb0764cfec57444418eba76f3299a69406e541e7f2bb0fcc16d59a1ee08866544b13df3b61f8a5c0784efca570229ad00fc79ced71b83e835f602d2ba57a6f58bc5b33d578cfe257022501a26a64599cff5c22e4025b7e9e62df801c50077a716877d910b029e93a7a5c22ef7fa94e596cc0a4f6c96ba83191313ca8401da88a2e8499511a128d58a51846c249cf74dae75275cf26fc265cac85df91f4c30f46b90ddb7a851b6a7d632eef73b77c6684cd7bce0b4cf0aa3a385b8e5bb96d10422273e03140526992c35ff76e22aab57daa276f0078befa93316bf63e34c2d7b46002d8529d2810d67f48e36b8e1bed822c244ed58340d8085e263c3b4b0d969e1878adab8a33aee079bf602783ace4f987f3298f33362232ff05c5c9c0f00851577551b1387d4b448b0d20fd84fed9b085ff64c34ed280938976636d7676accae25c08c84a49432d2cfd36d929178e6b8818ce38f35859bc6c93ee170d29f76db6bf1b5f7febe68e15aa480e9c311e1acde1832c1ef7e6a85e17e3513c91acb67b93de0e9533b9a702ac7b7b210cb7292c8522b399d3ea5fcc47718ac6f142fa9303f5cd82317899c23a74ae95589f57b44e75a783d7c928e92b6ea219471cff274eab05826fac3d8f4cea3dc0d5135096b559651d48ea92dd9b492dd736a99916e08a12aad06fbe32bada63659de5922dd063ee58ce047beb50372533c96e81e12ea510b62c2be68ca4ef3f2c440072848df75825799035784fdd26713a500421cffca244ae3b1ad6c0332364913175c1149aaf6f828230300c84251efc614dc64a2103a869f7205fa1ee674ddea5f778a8da025bbbc16fdee2201d4aad6d7c8f09d41822f0ad85dbd2f3159f84483ef5987f5df283cad20a7cfc346f89180fda86c0c96c782df1084105eb02139477ef928cb43812a3230f1d37444d584c12afb9f53a1d7e0d254d864ba957fcb8695913d22323146e8751f1eacea3ed658a3307e7cfc7a495dafb1d45ca77dcf02a2d8786ffb0a743ef15bbfee4bafeabe57bed43e5736db8f574b279f69be3ee93c8f814d67568cac605add791a8e4d1258f5848d0183e195856632b501e105d0a0624f52ba0814529882596cbea949462883a693fcf34de3734c8ddf4bc4039afe678dd9149f59fde36f023307403605886c8562f4256f42e9e5212c7952cf0032f8fbb5b7346d0f13758d2ec7adfbb508b48ddeaefbc89badb62d87750bbaf1b4160e8fc6b74790359763f6dee794b4195de797d4aabacf4b1b58361e751cc418b31efbc1f3a8a0134c1fe975f49b4b804e87d594aaafc6e7af952951831aedd19420093833b422600e7d4a2e7d9acae0c55b15691b0938ec117f6103166cd63c6dcb0842c13f9864aa42348b84131f447fdd0b8f3dc16c51542c020b95e4312f4861484c2050990f7346c74a687796fdfc47083837f9e43d4e18bf768d6ee2bff48811740aec88fcba3f1d8f9c9debf99f63e71e6d18106ddc8f9e5276789ea7b9314cc685d306597f9cde8eca58e2461d6fc2da17a74ada51acd2fb9b224c9a9455981bd72ee7a3fd4d420d2dea0a8913a887b5fb02a5cad1d4eb8de107b4a51ed34488bdc9464b5b2c93f70fda0e2fcae30dfef599a68543381002237d3b9e13bc49091e44defde06621c287ccef41893f431e56274fccd1aaa2cb2b8c9e0c085009e60d2b46648727c67c58be316ee5ed1e69d1074f604fc0430e5f76957b26a6c4cb9a83263c87725fa62c1df4215ab36e01582a0a98f4cc041d3390cf7cd8758a24eb045db1cc9aa67ca28afe5a2bbb5d0ddfa7c687cb89643d374eefe71f98b29615b1f381132b486539ef376f03b8ef922f7f37ad53938a1d61db1ab0ffde2a5c205d623353cfdfe1cd07d3ec9b168a7291a6619015244cb0e01f83aea9a4c77569ef5bb39c0ba0aa134f91c0e39b268621301f33519f82830764bde33d05aaf694fdc06c537f5a145fa1b91ea27db17eed52a39d8bf8984c9d906c82b03c03a568b0cc66165df38969f98b63491fea27c082f8fd01ae64783aacb72dc2d6283d3a971eeff56bcaebaaa1af38615d36e1957a6f08fc76e84469fcfbf948e7886b558b1759b963f229db8c3d371540c9be00da8cfd3e6a2bd536137346b9ad490b79dc2f81f5e86cd003fbf13473d7fd9ae59d2817fb2315446f8be548289f860ed85549a6a7d656680ba94da3c5514b4f9e62e36caf61f2d9bfae3d6b6e5def8eda677affd036d8841bce0e88d15b0f81f51c7c899fb671c9aea81b980d67be8df905cbc239f512fbadae1d0a65e402e3e1358139870921f1ac9dc3394c0313d3b73a132fe950cd8317b487a5a6a9b034c0dd77f1e69094e40d0a097d1d860531b0e1d25354cf85b9d36ec19adaac79d079eafc726ed985d23d13ec55df467cca6288bd64b1c99a0381f61b156d6c3ac9e5812243bf3462524c8f9fa93404857c0796e51d9d1cda0fad57f6c189f18aed89d67729ef296c7c84ffe142ac993f29a63ea4ad3df64b489b563764f9cfc01f189114540e09b673849f107ce5b1f267e46ee747137cc22252b20bb0588c3ca4bc829ecd7696837e856147d6e0757ed60342f949f7d5302c8445bf02fd489a63ee3f00d7b1806c8112a6daa110de2150c7e546b3b8ef5c5f01bf9b58982ff1059c73c89dadc6b0ead230f2be23305c431e72e83d47d63e13337b03dd781f106db6a95f091cea8622e4c7e1981fe4411800f132ff10518c0520d614550d182519bda738ce7ecc1092c0252b3ad422a24003b5d24d6ddaf2106542d212121726442bddadf687a5ceebc09720eeb2be795d7ed09b5f640510493c48646ea07f7219d3710e1838cbfac0b2494bc8841b23dcc7af4fb679fd40486e80495a4f6fe2b7667abcf8a28a62174a11ac1ba32849422ad9a2ce41142a755f1ae6b0f39d68b59706a648616fe140bc0db4ed0abbebb78b8fbe4cffc6847eb6295aaada60369757bbe8a73aae1dff292adca24e8b3686816fa7f11c0d836b34e70b58a1e0146165a739f65a00a140c5d31f1d8752151329333e84ebd2700ec7eb3f60ae8030a33a24470beae45ec6b9c9d85970deb371fb63a2ca37866d833e4e703b7471bfa62da2ac159a1858c2dbc997a5b57ccc6e8bf725ed4064c3e0c92d7f621a4237629bd9765065e89bc071bd4cdb9ec2f5f32ecf9c1b694918a920fb87250fbeea650c0a964d36e443962134128d6327dd0aedd0b30db6bd0181944862d01c2de9bcfdcfda12eaccdc7e45037142c1f70f8d8057394d08f75c6b81f2e4b104f73bdb6c4eedab3e440b99e963fd1f8ad585c56fe4b09ea754df98b1230971f266ee436f78965b6f59e8072a825d8244e49932492ac84a0e9c2beaa107ced590207baab2aec34bf57a0b8712bb49b297385f547703e123d83cd8bcab5e6b8ba3ef6d7c659013fa5a9aeec51f27c736a63603997edd0a77b5adae7cc7efedd3497a765efbaf7de94ffeb7f1e83ad5546b45bf4fe29f5214ea24a76e9efa7b9aec64660c8bb18c2a493e964f06b5c8634f8f2014940373be3e07e483df0db8b169f7cdafcf1bf4153ffcbee7c7bd2cdac33200959c68617b2a781914fb5bb901c25b460e208843f759bbf5f6eb51b7b3387cfe4efa1e279d27baaf084a06f625fde7394a8258b450a6713c7d86858159cfd89c056983541fd6745c9985c3d50a30a54e1e969a2a7656f073035fd3bbca32be6adbcdcfc53050a587cb5a9afb903d04717eccd7db0a00767ba6af13dd9312a641aa7e2d6ea8a1d5ba4c043b9093933267a9c694ba044f475a5c529a05d903bfdc9b2f6d6ff1f717a31eda725e50ea508fdc824ae723b00ffc9929c327e6f119727a99d862d2af455892b94deabf91fccac87070944b506371e3d287febee1296c71d7c39e429f39337d045d2a3fb8465f6e63af377d4c05581f56a21bf9b9dea14dc034b8ef97ef378b16f772ca76839196e913501dc20c745d073bf80355d3f3dfb6e247a96a79ed7d22d9bf607b5cbecdb08f62907a28571e1a7032121b4b0f2ac0be796e138bce65483cd2bedf656e364513d9cfb2ec88889b4103b1e2fae83e75a3a3a2dc22dce6760efa666326f42f1af4221250758b7f222fbb238aafe7fd5c9220c41f01dc8f7a771e9f7f1765bfd4b8eb6b438f15f9249ece395a132d6d122ad1cbbe2fe54b7315047fe708999a3e816ba7ab583446cd71247938c42f996ad70a31cd36fa35ec04ba0f802d3947af1464c61676e62a8cf38dad6b186e6e9cc444358af4de2bd5f94148dceec4e68521f8167cc3da511c925ab8e3fb601db61944d74ad60665f53f0d50251d4c50261ff372fe8a2e3cc49b32e76da216a6bf9a6000bb7d79f61f6af1bc6f541fd299a654c84401d5874b340ed5efee4631fd6dbd5fec880c7947d594b88432f88e9069ac046504f20a45479976e73dc5951c19fc3d78f24c71232e5c41289d7d424f6d88eeae10debe15f60dce76f35dd08fe4f02014bc16266a7897992b0b8817bfe4ebb519ca914dfda30b5bf005029fc74bfc10d75662876eebb1b6dc539bb7763afa4dc2277e11da70f0e6c339cbc6f0d4bc28dd2e398e319fbec16e4de1ee11967e7ca09857379b86390216a8cc34b2bf58f898b94642c1bc90013bf3718f6be7576a356e46d7eb71e15256e7907b210ba08ecf8065b7e5aad922cf24b1538ef5333197e8f50abab9be3986b4e3311beddba56cbfb6f77b15fd1ae5dff0919ab67ee480655f5d99dacacaf6598e2137eb3615a688ef6442f6781be530a33bb0def7bedb694709980c6f49775c0cac6ce91345cb27bdfdad0d74c95c1557bad9d64d551dca07619d0e549d3f190cbd4f8cfefe9a2cf7549258c1a20704474ec321321ebe71c814e4b13790dade68dac2490c7f33d1dc50306cc6c80dde7f08d5f4fe716297c93e9ae17d79e32d6599b0d0ed18436876d3a62fb93ebe218242a8aa369b935d2215bbbd76a8e534c86c77aa4a13b15770bbf9a258f58c5e14d5c9b31daec4aa1161fec88600e874de5e300690ea94f158ef969cdaf476ec2c3a7e93ca55ff856379626bae004e3397a4d7c396d48b643ddf39a8c5d84171d24ece02d0ee1f3bb4f1342b7d212e48c84d99078509e8d1d04210a14298bce0447122b234b71e8559f862a83105e1bd8290b7963e0c3a6128142038339a0e86a2134431a6b215c31808a35324a12b55cf52ec683154f235fb4b420b0b1a3d0ca4a8d9b6db1df6d0aad56aaf5aff92e5abd7ca5cf1591f5fd9767f3a87d321d7942464a9e2df2cfa8a4be5fb59ce85a5ff43f3e0fef66493dae2429ed74d91a4e1fc3c7eb1ce00ebbcdf737459c06ce6da72248bff1a1d44234b938ea6de003cb925a7d1753d61f340f798b7b51eb9806ade0f4ccb322dde8825bbdb339292e322ed6c0bd128d0dcb3db1af67dc0ef0ba0cc410764b89ab7dc604ac1dfab8599fec41e3b5f3572d9e44efbd0bde3e5df08be6f4beda0bcea8636267013bb8dc4e8e73f5905f8d2aed6353f5b45f802d667baf59bd77402f4ad88743ec48fd577e30739f7f4b96a00d0c8b173bf174a9d4862c1e8da12aa594166c858ae406b29480c10dd71101da277c9e1e1c87aca7bef8787ce47ebda184cd6bea23090b3ae4b4500e57b1ab29016d2ef1dcc7fb1727fadad6664ba29ade3fd928a509ae9b4cbdebcd7c1afe9d0e2bc09b432e5da786c7d77edc86b4b566069acf57051b27f8cf0ca1eb7766cf183f71b8cf6fab8ce3db0b16a7cb2e53af15ec7deab22c0d9da57cab76b6384e495dcfa4d09a42124fd5bd95cd496e8edd544063a8878ae38daf665fa31d6f647ee9ec843c10f0494efe1843e323ca19cf17eae80e867a6684b14d56495b2789b5ca8c0b115d794952c8688ddcdb8092ca591ef3254e979ee52226f675343f6f5fca9a24476d7590fa4d48b229a2d83f357560f98cbc489d707cca94ffe773983a09e2015a1529e60e81029f1b33ac5188451c3a953a2cc456b0d7956365f6f35bffa2fcf69ef833f72f5e5fc141f117fb7360e0860c732f79e2ec29ecc1e7bdd1edd37ba5d37f49e7a738f07d958a855d3752670762c44deabe0d40e3c735b3d14214341aac98cda4b377fdb6ea93ff2c6919ad2204030fbf7cd8e8e7919b1bd976d9af6c4c73d474d0a4dd0fd49df2c098ec4ff881bc97d7db9c0084d2e923eafb8a8dfff94d19a92a3c76c899c71449f06ef788456967b58182b0c23db4a37b78226e4b6cd47d20cda0d2ad9a6ba49bab4d5e4df03910604047188d4cf807a4a064cf62dc625064e3c47f4d5fdc0d27921c45d2fe5110d3473e7ade4ede3ef785c0dbadfd2a7c0f47ea33de6f744536d34bb5de177ffd45eac0688f965603b4baafe5c801831b1cf4db230f2881dbad368cca062fb68fe6820359e3262451f0188d911ec69462363fd40dadab3b8b3a85b776fb2fa878a487f9b4eed2935a4832e240837f6a0942794f62c92b00df591e104561d92b130ab0f3b8e2a42b4690ba06190c469d18568f96b53918dfcf5958fc40083a2381f1c51a647a775c785403c7a52fda35ff6fb4849f16d48c02bca1a9c19fbf9abe2e8c4e7885b8fd3ce63c26e68f94f71949e1ea7a3c7e1a3ffd2b94d6cab4bc957c3994489d1d373149d5eca644dc767e155d5b8666a5f149aefaad769eb26fbf00c945dfe3249f92905ec4f6cb60cdbb7eb23fed8f54e41e7a5db274ff193d0b5cbdd28915a5d147b52c8a7b7efb5dd53d813af90f9a3a8eff6cd2cf4a8e599a983e820faec9d0c43dc3ac76285952c6644e5f2690012696961231c249d41d3bb02381a8ceea97a9a1e614ef185f861a91e9e4f92f75ddaa1e7e64df52da444d237e47a2a94d53adb851f814cf93468b42d6623b9c15403900d67e801da98858e80575aaab1463fa63752d51819459680a8e5f094e81f356bd33958f954294b66ff451971520da06de01c509fbcd337541c6ff2026a2a5cc277acd12334faa89144cad6fe33282876323899fb0c0eac0fdfbeac81eb547971097e283b8d4cb1a269cc6ede791bc4dcfb4edeb831181c7e2eada68fd34b40c34bbd1ab40a97b7b6301ce6b21d0736f3aec8e208ab57ea3e936a4c38ae9a049f9ad17b79a0e87c8ae6df0b031a6d345b83e16a6dde9dd55babf70d3accaabf3baef39b82a01f369c41d19bfa794712a8bfa2eebc44a05b34e611d836cfcaf12727ce956071e1828b03465de5337e9bb8fc6daa42799a32f8213b4e4501281f10738e8071beae53d7c5c3cfdf4d50bf131edaa78ae81ee8bde6017b39427e9a32d75009792d26165ce58f2c8c9bb7914522935746ff8079cfe32ff9bddd4fc271eaff96cf5842a331cd38d28ce7f2176a621917681fd52a39529771d9d1816d0e9ca06d4ad08465531c9ca0718ad2ceda4e52f2cd849a5c57e1c5640131c0ac9b2c2efa7e0e482d83abd1f58d233770d7eced1c43be3c8befc7e60cf9c9a15faa896d518c43d759715bb977ae21a871d47f5af4fac0acca4c600d915880e72d2946facae593d2074e776553982fccbc010469b009203b125ddf71fa17cc0de7ed5bd06765ee4693ce4c42801412a33faa849b33abbd8a8bcf4898ba47012c1097dd85f7ffecc511826b6068811b7f69747e41d153f69c528abbf7dbbafc8ec3f16929ecb56a4c340dc92efe515597e9fe9c59768c75b7859efdf6cef781515de351bb16e930abd4242a0a71a2905bb2e61474aa4061ac0ec94ae8a772a84d1e0312b3d9a2f1dd611780c1ab875694fc5d6d1fd80374ecb4f5323e5a0e670655346e114d6b713fa165ca5da773c0d6d16de6b9e703689e5ed332b6539abdd2e2f53ca46f346d0f3a84de980a61ae61a62333a32a301b2b3cdae8469293bf23e161a759e97dfaa5bf499691b57015a966f9b3925b5080477c5f0ce2392bbc2f5c6e34b7acf89a2684e0262844ee2695a27c1447bd7db9ddddf90140f35beffd917bbae2bf26a69f8ff3797c14d6e9d5b81bfdfaf869db67d741f8ebd2ae5e1510ee60a1fe604ed37e853832fe97627805e2f2b8468d02f106a89f02537d8566cd846674783c489768868c5334924a88270478727fd6a596f86ce8a3a020f91b705ca0dc62117a70e9d535ac23ca90aac7e578eab41a7a365832ecdbd053d2b8ea328f6ea1b6226903a8f3e64f9fbd2150c3f77e6adfd1aedb51b0ae06d5520d726256b31d99fb234f4f9f36b8efa74e494ae330728c9efa379de317737506514defec7eb4934608dd33443c56ea78db255dd67ac7ec15e0dc69634b93bc9ac402750377d12b3909d6f10648904e9eb759923d9529752f29043dddb5c25c375cda215d272fd80b6978f574fb621c0a5160873b0308d5c3913527aafe180d2ed21d66f80becfa37fc56594d13a9243760ec9968fd084f1363477892eb4436ca8b5dbafcaa54a3692b8840a19ea0163b7ec72e29ababb18270751186623400a4e1f886dec3cb9fe97c177ab14a3b9e12493f925c1fb91551d317ada6ce30b8454cbe08afa369aef8ca1c328e93dea3de550942a964f5d23e3e0a4775cd3562d0134632afe12c3189fd2a3e33fb5d69fe20ff85dc968d8dd3efd0b153c733405db4912082c8ae7eb355d52fd4f5527362e32878f83375ae656dcf85bb5683ad41c86f24cdb3d80254a3a82904cd2e1c5197018a2d69f07b20d3cdcaf192e78ed38116e3d8d4314882db8f971b75f934435dae0030916aa6c8c8d0886fc12e928650cd9b2f83a0d9a17e05cc048fb87e312086b4aedfcc121dbd107814d462fa37679e6e512a89a6095de3e05d7ae04f35aaa48bc2fa5db78f657202c8a40f9c414f6e7b32f2fe5f65f823d7260272121acb249c50f8c0481359be1b4392e42587882d1397786912b947caa4efe2c671f7075172aded3eb9c436093fc72d5e745046a0fea9c294d39a810d34dfed3126c798c99255fa56ac1de42b4a8f47d08f9575d486d2d57f3fd73581ef8b6ed69d26ae1a5360501a9156c29035ed8d70cfca7e605598914c74872390e9e60c9a96629c7998d5deeacd5a028f7533600f47fbbe900e8ae71b613d696e08b54d9faf2f52f1d063c700200b22831ebc0759d2fb749092b18ae239c9e8d5c762066bcc214b7fbe6c65be250269856a8c0d09c193ab67f175d4af0c34e2c95a07329c2c9fb21a8f7e0cfd78b3c6f045882debfd9507fea51b764f4dbcb4314db14269f69cc29f7d63ecbf7ae78db5dd2b36662cc8d6c1cab301cfc26a490e61df5cab54299a9b81f6e87bbc4179efddeff546b2b126ebe8e6c36ab106b29da22d2f8446f2537f4123ba353323d28b7cf5a1818f5d19f8e6d080aac2ff82a7c6ac89f44d241226ead312325edf900492574e4040b20a25797820aa2629cf4bcabb91cbee987acd7bc069f0350456dfb5f01be27f21beee347cf0deef603f9675dbac5513607df5d86dc386d3dbdee95892ab0e3def4bcbf54099216b50f36e5fda9f73eb8f3dadb86166e5f03fb66ff129fe95a24bd277db99d4d32de00dfca5dcc237cca99f92a1b22097addcb07c3fce71fe3858b89db8100aafde9e677ae2507ddf697cff18b7e4bb3200e64d44952128c894dd7cb2b2c2378f88474c399b3ad6fd5ef24ef89d6ce5d77d52cdfbded91c5e76129c2691e8dca5bd22970792ae5dbf0fa8d630cca502312a5f6ec4a0a1712938ece793b69d3aa0d2e268cf53292a82952e4534809484510476667d050dafb5e87200afce6ef28d1714ee3799e529edfd9e47d0b5cd471860e28190e69d191eacfc56fff9152deb356bef4210d1e538ee8fc587ee363ecd423223128edcc40b5e20d2111aa84cae58a298815e1c649e2c1368e10157eab00fa45e0df61798c60544fdfab73a5e66141818d87cfbc76898038e08b30d0f235c78937223135c74d69c75f144d32cd049ce665afb86657c20b08ba25867447f67d6c0a4f145c5afed3086bd28a322cf4a9ed13d06f7c2bbe53d938bdaeeabe7197eff58a0a80f70af6a5a6ee7f710ad8a53de0a69d7deddc9691c3e87f10e38f77e997b9cc690875ccfa5abac9b0dd12cc7f64935bb6e572e545eb1bcc327490d67c82a839e5de681ce7317c464296f605392cf51f43eb15910c6e8f534b2369d53ea3e178379904c8e6cca5fb021c6c00707214df1e7d27bc961d44a23c9a0c794cf4129bb62f0d93fe07ae8db5df6832cfc210d7eacac584643c70f595d173e835f844a9af5940e710998d31bebc816683858594605d67891c53a1a6912d157447630602a71a3968e981b99d12d6c6183c491d073fa099f7c45938bfbaa80d4e757ebaca579c57a8d98b325d9b88d352c1c36ce30dcedb5c05d422104a4e07cfd841adb71529483cd67ca0329b6a116962c0c886abc396446938142af0a350b4be418bca8985e9e4dae4d43b2c4c306e46dcb982aefd0d62c30bd795c98e0cc413a6242b27fcd1d1b74145af3c2fee92dc4946d9c7e4b2525295133ac6b3f41cb880f9b607d7b0c3a1166cc48f242a5d45f6aba5652922819f42d5eca8474eb29f1c1c14c5fea93a3364145028137bea57c746f06b221cc4944f25ebe8efdcbde4e69fcee13e28f1d9d56a3470bb3b287864e6e9dcf8e07df59b7770fb79eeeaff6050c53b13cd776ba23f399c1c600f1bcdaa34066bf4d2a782fd84755855b6cf38b2e2c156dd864aaf334e6aa3654b24a96e3247ee5f333fc1b038d69a528da38746c57a1218ed99f42a1207d27ca757dcb4242b72e9b7a1e840b3655729d0a6fbfcdb68f65e7acf7a0d3cf0304d4512fce7c387f15cd6ab12a743113dcc40c5a400a8712407df4681ce83d820423a4358e0ec9760ebf227c32527daa061fe7e245b19576964dcede4ca90eae40a5ba00c712e61b53b5a13172c658cc096fdeb069329f39140c6c09ac2f3fa6f3a01a92103d68d88211d315f7b051fd7d3805073df35b402750ca286cf16f03fc794551adf93a748b2cf73cebacae05bec00e0862ec77d108039605be7ef000b2aca6d3b44cfd1123fa39385c416eccc9b481312ef119cd50e41a54f0317e811ab2570114f8af6c297695eb4afde2149fdbdeca2b158668cd7e0c1c44a2c783d3a04b921ff4ced0aaa7e34deaeb120f3a5a6d6e7604921f7acb2f2ffd6e78b3a6124e5af92574d3bfbfdaf29e094e01a91b1480755906b765adab6a7309507f956ed562bc665743b598e2aa45e0d1d0aaf5fa2a16fc1674b51734f41976174bdec5750ae5e661232c046a05e8113c1074489b0285dfd7fe2aa55e888fff3e3cf0335939788ccc02a4cee94fbb0de3d508d33db1d7fd069bd646e3ec9ada742610f25c1fe3ceb83edecdf06e77eaf82e84bba9302d5072bc26d6fca47fa913a155c181b446ad409c25a5b990157c26f7d0225e1793b8346b494d5b0e1a820a1632c71252253ac0a74525de380081b666277e3b6831c5c43bb18f982d28618361dca95ede05cbdab6cdf4812f7b637f57da80ef36fab8a905070770c294d16a38e2c97cb06c24d13e84f4eb206a93077882d6be3445b6ed5c20b21816c495364bac637f23d5332468a36394f480f4635ac1148d2003fb563d55a5d52790e0d4ea0402dc07eaaffd0bd7846eb1cb75478c90bdcb2839887b4cf62e33e638af2ad83236b15eb8b3649f32171e0cdfd92c80db472e14b21265d52e4eebbc30306459b1b39935937ce939bbddcc95f40478ada224b9ae4ecb9bfcd9a7b2283ba7f5daf501a54a1be867389c8f08d4ef272c9d9e5c5c9db76a33e3d5496fea7f7aa532c5f92e9c2a6a283f87db1b0f069d34b5d03ebb857493ddf29cb62dfc784724830e57bbbeccaa3b6b58356f07e318f9a7a56ae53e73db72ad2062bcf1c262cb599e7624342ad382eefba424b832eefd2dddc353c2ac72eef9041d1bfee01460c462a5ab418e097dc9ab17f8fba02e7853d1ee07fe52ad8181b86690fbd8e3a66c8ea6d66bf738cc86bd5ebf53f4deea12a5cfa3ede4aa53e77053f59521fa5c9cbdc5d9c1c256d3b3cd62b67bbdd48832b369276a75dfd5ee77799864df7abc079c29d1eb36dde2dba92e69b0d3f218a87e5717a88c973693fffa90edbe7c9cb41e845d61a75f6f3bae3053cb99bcbde47f0e706f7e45c8c6b746983e0097d7af86b6f1cd4bac47e18b6e351e524d084105c63a50fadbe94d2f83ebef7093d7e9748d65062094713ab96e92c4c05bacfbb869dc3c9337b8ee6fe7e6c5c02369ddb795a6d0d55f39f34b9766cb85de70dac3de09adc3bca5fe714aedf43235a0439a49ef542ad5e3dac8990edbc770c90d483bf74b1f34f62a59a647e42e0d66f8c91153075d6c7055dae81febe8ea3bedebc952d19b8dc552de3436ef4c2363a7c8150b8ae3f52c2068548eed30d10e9f5070abf9ddcbe4fe2d2ce7dbce1115a21c695eedeb677e353bbb5e784b4b9a051fb6a071744a14bfeeb78780f385968b9939cbbfc59f0b8732a66fc488ba04762056616c57405f859ca7267414e09f4d9691f70dd2a465b579e6eccc2181f265bef4e37e6b66bc31038fdc8ce69a23fb454bee3bf4f4b388f0bd5f962da0988d7d79b3e30fcfd897d3b7356891835513b3cc0a6f3a994324b5370d1f3ff0f57f4839abb0289c3eded312d24877378b80b2742e0d02b234020b48a7847448a7824a238db2940222d2ddcdb234ec4a0a4b2c5d7bfe6f3ce779afcf998bf9ccf799677e173337bfb9f9bcc17e63325a1b2eb7fdc14d946999c3ea486992bdd1914773f3fb6e4f0f485266b558b9754b651057a623daec359bad5eaa932d9832bd385d1fae79ee767ffa5cda925ddcef0a8f566a56ee7e1743106b4c009abc8cbe485c8c2a617c14c55e6de6efd5c7ac2cec7b19d48b73fce9809d7569e363998297d9cf3201f94075ded6f0c1117bdfeefd6efd4d3be3f50fc0866e93d8e65314e2b3be0bc981ff30cb9a9316b3c55d25df421d6ff9d68eccb0f630e5d0599d587ef8ad9c4bbff6f4acd46dcf2f3ed1a6bf26f17ff618e5bcaed006169feb32169a3c10bdc5a29df6595d8581790420d9d59f13e634b627ebe858b1a4496f3e5f5960d6c71cc16fbc3e8cc09419fd3998eba22adbdda60f3b0bdd6cc614e0506abd50e4d6e5b3aa6280f0a7aa751d84d9794be65b2180505f3aa9e775b13a1f674dfe291a0653abf0e24549af5890bf909a7d946c11404f2ba02f7a5e1f5773fd4fdfbcabb9a5d9f65901a8fa5470ad9ed25834ac5e8434d38a6dc2ee4948118fcb335d5778e4356fffe0bd4db2f9d01bafd0bb0a3605e90813378483d7ef6ee7e76ff50431dda3bcea2e454f14351243399add01cb1d4fe78b1f38f32caa6f4840fd9ccb0ee8c796429d1d70634232a8fc0f8fb0ebfdb5f2718ef2eaf5a532b515228d3cb3311421e999390b376d87d817623f7571e71f6b5c322901d5ee022bed09234cf5dffb5fbbdde8eab4ef54c69c163725e1099a772f2de84252d590df7306030b07f405ffac2df1a53e4c1b113312005bf55af04b8343f540f47b7ed4f5c87e64132b608099d0b5d9a584e8e39a6b227554fba1a46261f0ce0807e313d2aff0d3c7b7829b09a78dca04a395bdf124b6da05d6b16216aee96f1ba6b47b8ca89bd903a39618879c2e7f5927250693cbf1e7d5ff06c9931a480cd7737af3d425c2945c03cc337dbc73cc59cbc32ac4bb7a19d9889eba45bfc492fa10511edd50fa9eb3a615f8334854a62d19cff28c9dc3b9d4546ed4829165204eede3085eaeb473985dca72ef299ece077c5952c34eee0dd79293a719f9a2fbbb1e159cf3cf3f6251b1235f62996f3832106d784fb798f2f72420b01107241650de8a2dc7fa693017363728d28c04cc9040007dca2ff57b239f4d5eaefe5d9a71695c3b204790744e89916650d317252d458f7f858b7c096c25d5f1e12717ad3e49606a71306e7fed52b18dc2567951c1bfccd2e8c1f1e4932b2853dcd2bb6890276f1769c57d13c44d3eced647a60b4e920f839106b42f4e9e44bf0f88f7b6fd58ad5ce6d7b2bdf42581887e23637ae99bcff3d3a7290c4cb33231319fc81208bd628a63c8e3abbcd9e263d48a03b9781d9f1f7e3d1c686635e306b1f5979a4fc7374daa6a0cea9f64f193d1c6c5e89a672336721262bc9e921111cb43a65e7291351e7a9fac8098936882ee4aad1275b8b746733d20d8136ac2603f293ce8f91285a9c737237841fcc887f9181d2a8040734e59817b18ad44470cb793b7a51ebf053e57b5cd57eafc0becea4e5f6debfc2919c0d2046d262d5382bea57f7afb43e0b105b60d5fec4254ba8aa0e56eae9d0f571bed3a5f579ef13b82ecc18d1a00bb1b69503a39ca2c5d03ecb5266ef36359ae89db8245e8dd7605adbd412d53afca80c65ac491a1b00db4de08046e18daa75b4c0cd4affa0c246b5c80b8f89bd9ef4ee7e3e9850a4af23f7d4941d2d8f741482ac45be299f5d72b0742f9d53ff71a14b4d0fad0b3762225f0906f189bcacd6e08f4e13ba5d16ac09fa1a083a308522f77e6b5dd759cbaa5778e6d100abdc89f417f08632eee3578552df1d43e6130facb5591f5b0c1b79cd03fc79403780984c7430704339e046602068c6d8db58021525fc8a8b8ffbd6d7f649efff3e66209864c73a52cedb977df5e7b387f871209ff64a5e690bb16df38f5af5a44ae0afab70c11f6c4789b9c699c691c1bda52137b7f9d7860d478a78a308d8ca64f4f9b021fd9803910a433b9742f3523696c7f371d3b73967f306a9cb4de1d8da42270a5e56378f5e34a33f867b7edb3bcc300855dc9bc3b8062e7189a3773d191f6d51cc62f674c87352cd1b4213721791de7e58efa69be5e73767e9ce97984cdd1d7494b6d2e4525893c211229c69c7a14a34e52b46420aeda97407746cbc53f22f8b11617c5db1f9a9c602634717519e9f60d5ce72fec9cf83c2894f7e22139c33f8221be704320326570addb2481989e3d1f6d99025ea7e2298c881fc0a17fc274faa06a2514e84baacf67ad14836556d24df6e3e9a41ed5bc2ca0b3f0f2518fc87bf05c80325d0244124b08acbbed2dac9f4ee0b86fcd2454a6af2525c624b42f5206e0135716c2759578dddf74fe6d2fbe595840e182ec5a6231481118daf9c0cdf4e047fbeab25f46c2e637a26070cce1f0bef530fc7e53e05ca812e6e699bbab2a2d976a220c3d25580645ce33d0a335e7d9de5c443b374be77b9257835fe66d108a6b3ffbadb39d6619dfbb90abc15c47a5d16e3ff9c4f40018ce91c664f06a9afdc7d4e51e8ebc98f5df45b18556c9a97869c1c6179378add8a97cadd37607d5d7147fd16b63b3b0fbfa125968c9fabecc3e9fd887cf004b4a593b7ba459a57a8441826da59d43e6b488b967ee6da07e2b663dc0ef13af73a1f7a5a8b0dac2ce7b89c1a309b62f865f7bd9daa536d79e77b537543ab10436333299cd6a4dadb99bb7b5eb7a89210f3f0ab4c4ec0137b7e5ef865fc1e850082f8f96ebc0f3a253be23189d02404648f62254b48f29acf1417ceca3e040ff63e8644bba3daaf4e7c5664d4ca6b82989008720e4dc990b8b89dc73658c4dda17d37d3b6cc05885a9db696b3dd9f5baeac4904b172fff209d24628ae70a6396965becc4a64917fc8a6f423ba0bc18709c6f8adfb68ec95f564a4674a73e1a96c55aa7b37be12d7d6c1e35e8dca98cfbba4786ecfd529093c5a203d45e0308964c9792772a4922df27ba8f17ba962f7d9d198fbfa0a1c82e7aeffabd52500e28f3a3769046c0c6cce5577eaf490f618c73594cc2e4acb7c32828474158e59dbc71f383bb62863c48303c7f8e544d672b1fb468fdecf6e5f6bd1d02af48fccb4c92f79c23448b3b421388b362431412983ab11efbcbb61f21bae9e1954ddc553a698fddd101e4b67a51dcf65cf7f6ef5be78e5566b273a5c8292d8e37d1272f9ec9f045d2d58a0d8196a2b2851894ef3ed608ba6dcc6065e17c488fcee2b7bdce0490653fa06087371e848bd17f9b3fa42eec25ae4baa5cb53eacc198b0baa8f2e56e65da4d82be1b15f18162627abef9fadc30f7c1086ca9480ba8e94dca68184a132b8b0f1a0806f00df6e3cfe85cf10415aeff909e63905f76d96692f91b14823f16b69dfcbd52283eaaca9b41d99385612bc93e2a2fc0d5a32b2531f9cbfe89e9b39a71404aa8fe17c073d6c6e2e4d2d9d8b6945a73cb4f32b7d1f0e8be9872249919fdfbae830c47ce8213bbbcac31dd6b5a67984c29da6915340d79bfa38d9bda6d83052d2c894e68c6af7dd9e2e22de3c1985fd1eb847397dae5c661fe0397d8ecccb0790927db1d8be48f038d2b6e7fcfadd3d33415fcaa0881fe2882abf39854692b8b6288712f4e5196c560b18adffa589631ef0201408f628c0a5674586a5202fe6ba0014a9b5ad970781f504eef3fcbd6273d737d1c320973e7839ddcf0a66867857633378b097c1d3f53cee0a996884f8f165eb492dad73cbf6801ef2ba87ac5a4d776656982492c0c089ccaec8dba150cbd5b5d4f587f9abe37d700816788f641894151e1bcdb057d6f2cae4a51f83725329428325bb0e22c97cbd1ce7b0b09e756a238b8d0dd4f973332b42779bb0ae42d447e3fe7e879cc5b111bc15ba73956c3c409eaf9a4d119851f3a5276af5ea78c667f75f26c30dab125a74ef73dfc3a72a0c7f8d7f4fdb1bdd4449057d045d682fd33cb5b03a6e82950249864d59374ff8880ea0e7be982d1cd6aff282fa2ac4829c4ea0e229e957e934a70afbde4e5e59facf1007553d669a78ddb52da0670c581583d8022a62268f0a3a5268f9cef9dc0a8f93f02a104384e07df5f3b376fd577c9ab30d16aa00981619ee9d1f78eb1727abbb817c5ca465601deb2c25f2e50dc4a9be5d8bed2f55929536aabeeec5fbc6c18a73ca2ca6fb66fdee73473fffaa7116e1ccfd77aecd4cfe17e8e4fa8484df6d772a800129f1e4e46be80c81e039dfa8d5be11d6c4a7ede1a79c8fdb4a11ad67a50512ee729d61af29401061f3a2c117ee25cc1982fd7cf28f8870a9efc760c9cfe4d1b674a4b5d4ba1b85ed8774a97bcc447d2e817c559b6bffa7d9a7d7b10b690b031dd0ca44ab7a13a67f3a94ca76f5a7895b4132cfde504b0d288d9fa6ab726bda7a545faf395c9899de8880faafe4b5cec8afb4cfc9302a52e07c11cc1c7d463540154742e0c1d977724e10f5dfe2222e9ad29854741c1d6a6ebed1eb76f2d1d4a91e834cf4bae9e23d9a396db8d6bba15591a9ef42186c11de692b6ad688fe5a325cecba057a7f45b1a8cbe973e61a373377c9b121612921a47ef745d44c37c5da997de3364db7a9c7638840490f1305670d06876049a0d71a2059b6ef872a336cec7797b1d12ced45ff627af16292f1b249f64abbea1ea5716dd0c1b9ecf33e50b7b97a1c3ac0dd7c953098ed8253de4ee95dd8b13375bb9b8b99865c3b8fb047c5ecc97df1d076af20a2d7161f72fcfb937389c17c9fadab8070e2a96e3f555d11ba3ce6f8f4ceceb195e08567411c91f6a37d688b836a58cbf1abb264de95f1aee9b6833e4a1d4b39b04c7fa57568a99971b6c49f38622836fb249617a1ddfff1286dd483ecc3ea773eb88bd911f6b84e8b989e4a2c60d5d5ebb8ca1ec49b0439e0e99413a8349f256e060ca5962677c8a7b7e5840f6a2b5c1c20639a0b6649b4781ef1b4c823c84c7d6049e28d519e536b6bde77acc3434e61539b99d69ddf833827b0dd6dd4a3a756fcbbd089b4892e66b1aeecdc87d54f00e08ab59cf501de4a8a286bfb2591e60eb0a1e99be5e965c62487c2a77918bd33a5f680b22433e69255d6b60848851dbcf8d5a3ba4fdc67cf6260e95c9dffdd00d8f0c95d36f7a44c84c574aec7f305d499562c7addf5549d4d8746d5cef5290a4571237ae5037c3ccd8eb58f41cce7c9b3d8d4d1037cdd134894c23f62f7999bd2745894b0c8c2d4a4a22aabfb515d58b95977e7251e10337c1481f24d5c106ed2de0a4df2bf374497cf446f4f14c221ce858d79adc0427abb2dcf459e2e774908a27c2cdb6ba531a5773b65e6e91abb29f56d39b4ca7059b79bc78ce77c4e70090c9cb1cdaf01b079794e78154949ffd5f202aba095cda4adbeb147fc504dd2c051e68ea4b8800d67c4ed39adc02f353441a7827e3a56b5e17c811b3186b14ffb04bd3b034a6de56155ebcf83971229dbad9a7e819b76edcc7afbc05792d7df30b88c4b33233314eb27c869fd7fc15deb850ffb6bb1a2faf57a4a18f5e412ce8bebbc2bb4ceafa84bebfe193c93d5e68d2ce669f02d9532ec98590c70c73f17c1bd425bf5c0b56503ea13d39465096c66fb423e173564a9eba46bcba8e95994e76c2b44330fa8c8fd82892aa715e8bcb277d3b091c8a030337bf203d2621266e81d378d0c7a0eb417c4e5357d7621b83f1642986d94c8dd886da7e64a49a21634357c65423b7cccf2094e234d03c871323f806ea1355a6f2212e89f7f223911d0b3e7a34b6ea91a4926aa694cc3b3ef9ef94e2ace80943e311923b379f20bc1b7645bd4f45cf65d57f2ea9e570d0f72411ff4e46c3583f579cb9295c2a94eaf10e7a15658aa97d3da4902f448a4ad6fe61c10ca7be51b6ed60325cb1ec95c291e92e94699e7eec4c905d06cf72a1504ad422d4d8086d662ede927e0f30cb3e98beb0604ffec8e74a5b642e982e6bc76593aba0c1f211fe6e349e3c75f57540abba7686592328c3fb9061f85b277e8d7e79147f2a831f440dbbf00b31a542e623d34164066f249ac9bf0471a285769434832b42fbef37e0aa081d049115c5f6c896645eaaab562eae84912e34491666ebb48967fba481caefc196a33461d2f0e4fa447cbafc93d30b137943f877c42cb115654acc86aaeea50f8ca27f99e3b3b5c2f607ca2a5b82e101d7147baaf1b6176ddf622868bf0658759c923ef545c2f88a567251fbc10f3362ed0393f2b16325b4cfdf46cc3f197a64f0eaf739c3e04075c4b56dd736bc6cfd55080c29edb97b44fe6357951e3d6a064100ba841f918ed9a9638b53eb76e71212e87cc0e2d7fd53fda743a22e8790c0cfe68574c7b75754a15541e32f95bcc7d99bda5674ba3a3622bb795ebc29e5d6a817e01f2eb6034de0fdd0c56c68dd91bfea991c41a150ab7321f556eba675afdb09c22bd9517152f1e8a03041ac442d81a7775facdc106a3e9af38063816c74805e04a8cd7b784cb44da7d56b3876fba0cb9f29fab2e0ed7c695b6fdb1f9507a5f076c4a9c82f001453f63bcfa1974ef4e4a7da049bccb913326e94ad5dfb0dd15dec0a0637a332b10aebbfce445b07ef39cb27e23c85ebdb423172a3045f087b7f99155bd0dfafef639861bfa1544aa28f70a93569b0b4954846ff7be6e3d53a0e6fcd83b553fb28168d32361a3b519640e79fbd95ee2fdddfa3a2b0892abbe0baf28f1f43a908737da8297e8b03002bef10fd4a0a2419179153ecc0aa076a61a68788e86c34460249d7b218aa244c66888e7884570a27edfdae948f6f229436d5cb8e5fce502c493295027c219f42a1903d1e5d41deffca0ca02946dd73e8ed63cc8d71c79f49829f638a48a570799fe18f88f597b03a853fca0ac399d707b15415c846b963b38fa7e0f6de0d47fff1a250c0ed63d00ce57196eebaba56ba648d435c9bf5e0d33c4f8f6c64ca68c699514900d8cfa9a27fd0512473ee6d4526f1a9dc4f123f79addef3585883fd0210d98d617afcf0f8d363bec83d0d16fe817738941b04d90eca5494af95f0056ca9f1d9a118bf171c9a3b18038c22f69620b61d4b6dc8bfeecb762aba47328ee78abd14bbf4118414c9390049d9a1c788e7888fdbcaf49ea599c72fc65f93e850ba1133b1e551e5912c7305b05d901e88495778337af37aeb6f2210be9bfb1bc1832c03f289b7f8e9b893bc36c035e235a9d067a15aa1f2a197575e4457446f88aca8ff50bfa4cee7e4e6cc627fa36aa6d6a2f14e875bd921be2a6917af01af8109618310414c728f7133725f68ba6ae967def16c7198f034a9ef27cd71b970b8c8bbb0edd1ee89cc5c96290e1f0fa90d097f78cbe5c6fedb204da35615f5b12df94eee3aec949cc68c4692c68ac697e6864681df899fd5c8f7854c8555691b485dd3317a84da8463fed9bcf937a697eb55ea554d5f0f0d432a264a0b8bc3b57b95b84090988ac26d861ac91abe259f25a1c6f53db3ddb35dcfd9f64abaca85f24badf521a52edb82e20f76b19b0c5cbca552038603d6bc9cbc7eba0f39bcd92800358044c2f2f5843dab63c7d85d6a6c4c3f950e990ef9e8b3512e30335801cc03d680964333a193f0ddc5778bf9dfa5be1738dd4e4e8c797e5c650901de92dd929385932533e3338f321f019f48cbfb89fa85234ab6103b33b858eac109ccffb18b2e63e5fdc7ed8a85f34fc6c1a2fdb722f1dfded1a0a59a0fe3fa3d4a34b106248c89a0546ee86fefcd81b80865f040ec95017792287ef14b620f6123ad50e2a2ee54263ad1309c30f2a29ed8933100a732ed00dc0915eab7b32611e849628b3d39c55ec8ffc95975aa8772e2b315a9592fb1e793bfd983ed088a8be332e91466051a834dd0a86021081cf2522e26fba2425a25dc542ed931ccf084195823adad6072179dfd60d22b84b8b1a6c0abd83a6bafb0f693ed30f75b86bf23210fb3ea8e8be56d051c77bd5763262ad3344131c2bb8132f9810ceb5cc957b4bac1cf368ea54034a1bfbef9d5faa1a66d00bec9cf155589d4ddd173aacc5fc65a389b9825a5ddf72dc780f66db16ec17d02444e038e22f8cce8336234317553b8604d9acb7d330e44f8486dad77a74328046061ace5fa18bcbec6cbf5bb6af86a0dd0dbc7e881edf1adb94ac09ef12c29b3faa02531851978219f78c7e605bcd6d1bb203895ca393c160a812e834d6ecf885d3196bab12660f35fc701f1f28ba6f2227e0b996bd04606a85f998bf1c1d271c41d5e866e42ee3f6fe0316fb290e58805da5c8cb48d5a5dadf1eb44c3e0fce8c5d5559ca4487ec180c07f865d60e06094624fd197a2b1ed31e0af85b1e09de0c218793719880c890d66ec88a60013723fbf07886b286828482cd04a81500e4844a99ba7f49b47f3e40c717fb277f964b738b12e3c780aed4a82f68d407bbe4107f6a1ddb9d0fe25686f2b74f0c6bd2bcebd6fc0bda7c27d60dbbdfb93bbfae71753a5652515c5e5ff7ca37a9bdd072f0ebad2749df8029c887141f058ebc5d4d5c518f9c5e4f6c5f8b0c5f47b60242e302106f84e1598d20b8ce602269502e320c03424308a1a989899332259e1475cf93466367b58d8c94fc64f3a462267d43c7f1c3886b49a40868a6ea88822ec45378d4491beb51bdab508d7da4d8b5a64b0e706c813e1e4b909f64406a036f451084fd4a60d0a19cebaa1cc8ab063dd346445fa146e6815225c0a37cd0b916f6d36d46d108e369b263648ff6d3481d729ad179aece894f5088dcd764ac986262a3a652842e3bf3a7df20a4dba7ecab28ec6553ca5564493749e3275a21f45a415c940d60e5c720081db679e3f651d5b327f633cc46fa2987e7a8778ee813d0f9f79febd581dee1501c559a6a6308d1548b875e72da932b279b692b0320625467da6ffcf74d5d5fb8855e1f8382a9ebc753a51ff4d49e6cc7cb2cd59095dbb4add94d019f1ffe0efdef95970b07c7ba18d415de5c2ecbfa276bb51dd9cd0f9ff60a6fa4cb05dfb7fe0da6e5109fbeaef5af05f802a4f6c526d4a1776a582ff8b1a9bea85fda6ffc5c2dfbfe7e737c161ed1d36afea14cfec2f1b6ee161718a1dd3352b4bd657ed309635ae0bb6dbf4fbc10708a601038f3850fc7f8cff943d500860fb9dbe3a88825cfedf6850f3faffaf2e46f8a0a36e964da614c38ac1e19d95e0c1c26ac1c6c4d663a762e9d413be306bc0c64e336f0011089971d45398ebd4d318fdb3883467a92710b23233afa711fa971fd6e8b9dd3d16d6ff010000ffff010000ffffc5187c99fc5a0000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ff00fc5a03a5774f46460001000000005afc001100000000a1140001000000000000000000000000000000000000000000004646544d000001800000001c0000001c68f7e3e4474445460000019c0000001e00000020010800044f532f32000001bc000000430000006067a74b84636d617000000200000001170000026ae2484c1763767420000003180000000800000008002803876670676d00000320000001b10000026553b42fa767617370000004d4000000080000000800000010676c7966000004dc00004df3000089182acfa38968656164000052d0000000340000003601046b9c68686561000053040000001c000000240a32040f686d74780000532000000114000002f4c1bf118f6c6f636100005434000001ab000001b83295547a6d617870000055e00000002000000020020401a06e616d6500005600000001830000037cd4be99f3706f7374000057840000033e00000884cc4190567072657000005ac40000002e0000002eb0f22b147765626600005af4000000060000000661fe52370000000100000000cc3da2cf00000000ce5d2e9700000000ce5d127d78da6360646060e0036209061060626004c25b40cc02e63100000da8010d000078da63606669649cc0c0cac0c2ccc374818181210a42332e613062da01e403a5b08350ef703f060706de470ccc07fe0b00d54930d4008519919428303002000b6109d60078dacd913d4b0341108667935ce44463080a1e88335818a28569d39d8d268512b0ba2a412418b00856a64b9b2e8d043b7f80a57f265778f39ac2ca4a6d6cd6350716575958f8c27c2d33cfc02c11e529b52d32ce93b9709559d405d374f19442f2a8e4b25512daa77b599603e9c8c34e65d754fdea9ae6d5d7406bdad09646dad5be0e75ac53f808504303476821421f438c718bbb67b2f67bd382b7e77894e1917a5a56d1ba86da76bc731de8482730284350478826dae862801126982e78c67ed8b98d6d4f979279f2985c3ee5e38d5969e64b209b529115290af127bff31bbff20b836ff89aafb8c75dee70c4677cc2c77cc8e1769cdee12f658af4033539e772d986f40b52153cfad72a661fd67f33f50575236548000000008f002802f878da5d51bb4e5b4110dd0d0f0381c4d82039da14b39990c67ba10509c4d58d62643b85e508693772918b71011f4081440ddaaf19a0a1a4489b062117487c423e2112336b88a2343b3bb373ce99334bca91aa77e96bcf53e72490c2dd06cd36fd4e48b5b300f7a4ebeb8d8cb483075a6c66f4ca75fbfe0b069bd1943b6a00e53d6f290f194d3b815ab4a7fed1fc0a86fbfc1ff33b18b434d3f4d43a09e92104e69b714b83ef19cdbacb0ffa82d5e1623030a498a6e22e3fa652feb734e76acbb0b591d1bc833311f9c93440536b6d049afed421d5f371144b90cba6b1369898b2fe2413c185c9745553b5ccf8dac15db2b3e860832acd8107d8c356790c1e0e0f2614d2b724ca2c0d11f662abc40811931c0a39e5dcc9fea440f94812c6bc494a3be386b506c691d7c0a0364fb3ff3c9b4d6d6f1dc2f8591cc177bf194b3af8c886da1811623b6229800944424655f9861acfbd2c06e452fbcf409480e5f18f7f9d08b4eed8443c97b5750e3156087a7edbdcf2cb8abb56b9ce8b42776faa6aa8d229cdfb5ecebec7039e1e0bc34163c19bcffbfe4a81fa3c2cae34680e04437a377affa2b5ea88abbc173eb227d3bfb15a00000000010001ffff000f78dabdbd07801be595003cdfcc48a32e8da4d148da955665256d95bcd26ae5f5161b57dc8d0d2e7483808029a6c41830064c0b248605820931090e1ce05047b20949cedc2524704a51da198770178e348e847209f84a8c77fcbff77dd2aeb618c2ddffff5e4b9a26cd7bef7bdffb5e1f8ee7da388e7c468870022771e912e132836549e4dfcb968c867f1d2c0b3c6c7225010f1bf07059320a4707cb048fe7e4a89cc8c9f13632477ffbcf7f162247df6ce37fc611aec815c565e2324ee55a398dcb68f69c46aa9a354b347f46f31cd20c59cd5dd5a46c29403ab9193d9e7c345550e59c5c50a5a81255a5941c97a554a148841776be50811711f4d1fae6e14907f4517a19a0c1c13f7a5f2bb7842b5b38ae136f2ed19b1bb265c2593af7cd2182b99368b68c6639a4f159cd5cd5c46cd96cc15366c9dc59b69871d3c2993b4b760a5d8044e5fa1f19215d6444dfac1f1cdfd23793118ab341fc82f82c57e0b6705a36a3b555cb6d59fca9b6b499c211a570b4643543466bce69c6aa16cc6a4a46b356cb8a152f545c08d9cc8c56a090c5aaa5504b163e5da56ed2a9b9b25abaaa39b3a57ed2598a1564b726f56bdd72d91ac9f6f7f723150bb9de42bc90eb2bf4e5b2aa4f8df7a6f978ccc14b51296a54e02d2ce6b2c37c3e67948cf1582a4d52c5e2e586d78bb727967e76ff079b078dcfe7962d09fbfbe7cff690eb8afa4123d905efe619fdf3724a68c9b2dcb32d1b373d51bdba75ae8d1c2e66f2c5274eddf9e2051715d6cf0878bb4e1d2ea6fb8a4bae9adbe90cce58977ffaa2cf7e35fdd4560ec7a44246c465fc01e02f37e505a14a34315332b09107a256845b8e6e4372e2f091631f902ef125f104cec7b5709a90d19c55cd025f5133253f7ca564116477c9e6eeef9fd123787db968b62fdf9b8cc7a43489c78c8a577510c7e52bf9f7b73ef9e4d67457d7f3977ee9357eee29e4fd55573c7dedd6671c1baffee583019b631327c2bd8aa206bc2202df5b383bc7e5895a2009d96c8051ae00d41fad225dfa417e3dbf1e46bbab8807f5cd15ba7778f431fe34dd46ba46f7c2ef08c73e3cf6a1f8a2f822c77346cec5715202d899c0abd0d79b21c9986427cbffedd443fcf987d61e3ad76e7fd0d9eab46ffeb793d881d3ed29c783763b57e3dffabf9e3a3f6ba2c699b91c5736223f4b554d806964c968a64340cab26042ce110cc0b82601374d46605c2b25ae1c25324ca77c54163504f6e89b405a6df4f5d1d78b453e89bfefe04c305f9ee3221c970c93c230c9cb09392939885adbeb4d4a060751e098516cfddcca1380187396ac5ce796ef58b9708dc332eab23860fff397b60795b33bf833778ffeb74b0d5ed157680fa8271584cb2d56e1bb82c732ba52f60738a08e7aec5df1fbe2839c876be23670650762e4ce686a556b628cd19cd1c8214da96a8a0b658366803900ec1e5064f73e8177b95bd57ecd2003e77325b703a680b95f5365cdd5af35b9f7114e32c0f9193d6e1720a47825e2f33a89319622c0eebccb17e97325232a6906566b5e41ba24e91293d7a41fbcfaaecacd2f11f777bfabbf4fdec373faef2b775dad1f8493974812e95ac15facfff9a5efc215943f619eaf179f13e773014ee18816cc68dc21cd5185ffa526c6d2bdc37c98a8f0c62b5e8720a5c5e2cc53afbefa9aae19d76dfdecfabe79d7dcb27778f8895bae9927b8e66c59d32d2e9ebfe044b17bcd9639fdd7dc7843f99453ca37dc780dd0ead837b945e2fd30f616ce062c16f5e43c51e231138f503891fcdbbdfcbde4357dc73dfa8dfa8e7bbfc80b112a8afea4cf261efd3df28ff049f9b2f1377c9c9fd3ec207ea91812b363530a2652e3af7b54a9a0a60af19434f53ef3bebff83bdf5bf2dd7796af98e68efc5577fdf60b3bfff0857ffe67ae91773dc0cb79928f2587486f5fd617225e635c210a1949ccd6fe479b9d2023771352bc3f57d9a669db2ab9fb8bfab1bb399c8ff4fbcbe8ba64e59cf03b2a704d0b17e7525c27978119a171650e3948ac96fc11109452468be53453556bcd6ad68c96cc69b6aad696d59c19ad23a7b9aa5a5756f364b4744ef356b51959c43f9ccd213988d64bc751acee33d95cde5635ab892e1451fbcc7659c15d7f556b8677c695a1ac16a9ee6b6deb9a81a722ae5214ae4cb477f7e0ae502de55150d9ac20a8824dfdfd9a532e35875060f5d0f5321fcf4f7aa11484591a25d39c13b58ff617c7fe81f819dd4b5f07c60f8acb1a2f41590ac2eae8b6b1239494636ba3136898e1667373b9b21da9d79503b49164a18c364849d30bbc3187d2c345d7473fbcbb4a11401c88d65ed566664b2750be01f9d00712d8a7c830a6d158324026ee934f381f022e50422145df8cefe3dbfcfae39dc9361ce62f69d8197df1786738909e93715fc99dc55dc6ddc0dd0d6b35a54269f0e21cd2a1d45bcc22254acbb6e59016a5f957c17e77a674fa1760bfb55a3af9962c506704a9537221d39981f9dab29442334052cd5905dbfdd5d289ebf1d3553a1b8e6dbe11b62fac96b67e2e9b2ddd432987736180b0d9d049624939fff1fb24ffffedf521a582043bce1b19f9bf9dcf8eed91c7a6db3cfa9fffd70b60ddadcb8bc6315e5be3f141cae3bd74fa2fcb69cd556d3e8eaa763a8ea976328ce8d91346543dce8896364e3f7a5e1fecf6c1e124ec1a95c9e7713490efa9ec8b32ea7fdcfe27539b3f803ba3f3f17dfaed469a8f7ffb5350146578888b891f881d9c81e36071489154883c2a640f8e7eed27e415fd74a117b67e8ad75dc45d242e1417829cc6eb0a66a29a8964261791a0fed6411224c183fa5bf40d3e8627ee1fc46b60ad9aa01ba4b817266807a016c4735aa8aa45b3e5e610aa39cd49d07842cdb8196a01fdbaa63fb435e80f491058e1ac96a86a916c3991c44b1371f85632819bc966f856624ccb6887914e829651e67d20b2fbb584acb9fbb500681daa2bd8a075a86ed03a5cfda510681ffb3883e2c7734d72d91b20fdfd9fa07b0820cf734a4e892bf1fcc7ea210b8b1510f59fa08de81bf02294ee75da7d99d2ae853b633acd2a3255b38ad634abe751b36a0e7d8c6ef53cea56cde14fd4ae0458c6a662b675e73866a83590f76b88edbc467f55f2c15526d2b99c22f61271bd0488515d9153c507417f6882d57e018786239a015d745d92aa65891a731218739ae42ad900234fb56cf3e0411bd8516832956c120c92ea6f8e03e05433731714d066bd002c180da9bccf0d1a1a1f4bf3844d60d477e904567ff1c405db8e90738e6cbbe0895f9cb6fbd5775fdd7d1af9754829e2ec28e202f502691b7ebc50d95a3a72a4b4b552787c587fed852d70155c4c6ce36b16acbb458e805c2a8de93133b8b2882b8ec996cb11cd95d144c40791002a8031a859193ace6a49a6d2a600163601e3b8fe5704e3a4ab02435f2168a090c3ba0d2d6f50145621dd825c93b84bdcc52de24ee2cee190095655b5a519ad0fa8b79a52efc4aa76a2abb41cee010bda1aa0d3891ca82ad62160fbe5f23ea792eb47566875970219302c4bab80e54b122830da52799f104d2dc0b37dee52e709c8f01e6f0bef6b21924f2da83ed8cc6567f37db389da574815fa6033df9be193195248a6a4541236e331276f749294515225236c1a502954bcc65832687c8077f88555dd83b71aba7b0cc9b6585326694c670cb7cd9cb14af03bc9970c862f11972aacea1abccdd03dc3c8ae3064bb0cb70ea45709013bff80915cb0bdbc1dfef31ba2ed49634f97e1b681f44942104e190c0ff0f6a07052d7d06d86ae1efc727377d298eb30dc362b73921070b09f7704849332b36e3364d2c6e48cc0faeddbd76fd8be1d64a1c4158f1d13358317f4fe710db4c0ddc195a338d75ac1da9fd19b85a52295297764fb72a02e345541c544a5c2d105c733195859a8791f3c84ab4eabab94c07998d5da50332d27daa884e280a1db5c288fb419a0a5ba4a3db0d59dd57aab9a3d5beeedc18b7a3d7051af0bf54c108ae810a04e145c44c6569286e5c4036a27db4256f2c00bd54d4f6dbbe8b410cf403be96a1f201e8bd361fde82daba328dc32d03e3abf7da048650cf219933622c95a9cc5f6818176f85ed6ea701cbd1499af7d70b09d3f303a9f3f0096f247fbd926b397e0cfa088bf061ba48b2b9ba9be0e7c0ffab9f910eaea26aa67239580e94b0613081e4227ab99c4eb4e177e84bfa5a21fc43f7e037fcbe8b6d1bd2847f8f5c8ef2800df0639e1e0642ec695c158ea24c8f520ed8cd5b29120c18c2690081e369ba84e3a46112293a2c34afe858c38ac479fb53af8f5a42b206eb73a74dbe819809ee046dc51c68a9c283e2d3e0d73d8033cb083431bdc59d5bc2864cbaa176fa32a302e1e1c726a18020476183557c90b088e5f14808b54174e6e98f7683496bc76905316c1e18199559255d8318b4eb475b992d7097b126777e1290f9e3212d95613c4eed688e876f162a4d55d13c01e94108ab887b8c97ce2deb3477f5f3fa0bfeffb90acfdf043fdc9452032bed17862cf1efe2cfdc90ff1f4a80e243d486d05fed8431c67f0024d515ea56b569694438cc1a27266a8e3c1491d0fc48c2c8c72ac26ade22427c01f890a71c19313e245f2dccf9547bc3f23cf8dbed5fe415bcf9b4d4f8a1a3a543e5a45d782c3ccefc3d7747476cf255cd98af7647703138464c745655994f0d622c744bf1de868a1b2b2248920a97833482abb0cf2957ac5d00b827e4a8088da5ee44ffa1b283ff53760eb37cf3d47fd782042d18f5729c23803bf827dff1c40a17261eedc9a06e9a173d90003db420756ae6a325b4841996c769514d802c531824baa2cbbf7db45b74a758366590bf76b8a7bbfcde0f185e898aa1e587389c9cc056b2b6aef309f0df3742922f59114a8b017c8597bde38fcc69eb3d8c7e91f90933ff8407f7ad5aecaae23a4e1047cf0bcfef407785ea7d315987622cf06b98d759eadb16853038b222660e3fac7f8b2193101bedc8f7ce9454cfcb2a6f46b700499d34731f1381113a3c4513d687a96149a6022c7e5e3f1e4ca2347761d9f2bf573182e9449f8319f828df372ed350fafbd5a32c8205f6122f280949229f9d0fcb65b8017881397a8bcec89ba73b509efc9a9d1424e885684c83f1110763bac8e4a254bbab2958da38f04c8bfa0e8d21330f3c95bb0ce1ec65b36d251e1fcdcc286b98f740c503a02afdac6a81704eac936208e68b6083effd854a6c4f2a95388e5a9e9241198dd86e391ea43328bb44f2615d90fa3fef45370fe4e724f05b8b7ae3735736b387435c3f8ca192d007086289c00b7d355b2307e0d039c1684cb207abc6a0087d92c97141faefb328e3ee1cc5e050f07648d02ed0df3e8448e7b8d91a40b1dcd52549660557710b5a628ed3aa27f15b4a2cf5d7a2f19b9e72baf8056c4ffe45da61a6d017509b4aa93f0ccb5a7ede6989ec751785d5c94bb962bcb38a630c77cd5b2af05e7b92f08f3dc8e73af6c37e0013b876ef218735054351763de60b51407645ca8cac8ee7e04f805c960b5807e1245f883ee92d98468f95a608989521161c6eb0ce848676e3bc0a620470b49f4240b72540265a62f9f8379198fa510bb2daf7ce51e7df37d9b6f2f1d594c46e8e72e76985f8fcadfb57876d591122e4ff8498f71b0f2156b7c3b9d2f6b15a799329a3f87622e98c5c044730e072d94458917c9a1a88952f66ecda1fe9ec85233c774a86c737951e1b056cb0eb70fb65069008987ab1b2ef9093036520d2f0f2cfa4324aa24f2ecc5fc46c22d47dfac3b8a500e8ebfd0f384ae79a602b0ffa37b8b63bb0d321b752290d92a8e5d84e939ad3062876054ca86200e9a01a575d085cb9de600809bf0a003f4185482b852045736d16108d299817ea58171a339d7e051020587a9e8a0806c594dba566fa1cac8d16da0bd8024a7b66b915fdf3e5059bd65cbea0a28317be13cff875d541bafe90bd41724c05c56d9da46341fe526012c7f464290bd9e6112e68749417690b4609400edc1dbb76ebbe2a2627bdb75b78cdc7ed5a95ea41f19199c618f3619569e440e9fb4c0d2d66659701293194c563d005cddc39dc05dc09533489de19c96ac6a7d749c4340a6b9357b0657b218d0a6b3aa75ba4a59d81aa86a03ae928baa0ea579c0dad94ea091cd1a6aa6d2249981d929b93c5e01b9db06eb1d9575f29825334c2261a28cefa7f99883573c32734d2041d1359198b4efb08252b7f3f7c4f8fb9d74f3dc47df78fb8d47cfadd84c7b4c36fac6af1fdf261e109456d275c3cfafb8e2e737e807d9de55f005f8de55a3af921fe3857a2fbe376cd76479455c2fbccd19406fe3e4a8413644490163262a580629307599e4e7b59d3b17d5ff931110db15fda98643f5df1236d3df72726e8eaa0a8e4328dfe45a20047f3d51003b434a81981dfbed030b2ebb68de5af6abfdf9db9ffbfaad7d97de796f7dfcbe2d9e23fc37fdcd1037486357e8290ed77e1bfd431e26785a60743c66181d974f654b3ce150897378183f0748342127c8d8fdc15252c078560b681d11060db997c272a674ddd5d249c6bb468ce41e80ebe89b15f20300ecc95b0b97de794fd5f4c0775fdc659a6b2affeeadb269cc47ae815491819b03006794da25b3b97960ff2de55612c238bc34633e1824ee6ab9bd6701ca8c60a69cea5cc68c9472a27b391e8b654abc118ec44129cf029a25c789b017ad967a17a3cf73151a2e254318fda3d5928091c910f390d9d1bcc955b53cbcbb4a696ba7d641dd2e73b2e534f5b5a463e6ce32b13af12e0957a900df997f226c0f544bc925f8e92a2d034acecd6a2babe5fea18528cb4e828b66846065f22afe40ef6c547f0b09d95d6e6b9f89e1ce52ac0928ecf1cea4b66a5c2e8bb306d06f1376cf311b7c81bec2e0d09c7994f83d51399e47974b2e1f45873a138e045e0208450155c23ca887d42b8317b1a32000a2b8cbae16405c1214a1f02287d1f62623c5a26ea39a161ae3283cbb9855cefcf4b05d19dd8b1f780995ad3519db054203e38a07f01de5297f007f895e54acc05651781b99b382e278335e84025ab80584ef7ce6cb378f8dfbd4f56433b380caa2b719471794232594cdd263547b6da58143e66540d962cba26a8b3a219829569435689bf8610bacd726e6f58f575148975c18d0908cfdfda52690d634a48131003423813c29a0988a2b0d7c2af099af7de23e45bb32b68a542ac2db1fad822de1eda3feca69a74dfb49e73519e36f0fea7b52cdab854a97972a3330c5155c3fdc12b00667b4521f9c879a7866c279555fb6d0d79b4ac6240256eeebfc01b0ea5e0b85d78443f80643f02f1627a87b36e1772787c2e110bed1b90f12c5f0ebda7d0320bfd99dd51cbbb9e6cdd6ed3b338bfad1fbef87fbfb0294e7a6832091430f2a0f0292c1f14b763f78e3ad453c92252f214456c7248834dc879334f6c8e86181195eb3ca98d66ba5cb88a5aa592698d3160e3d9a9289ce1809778868a034cac905d0db40c789cac5e784af1447dd45fe7d913c77d40fec57a4e4c7d83d5d2b4b703f0ea0977b93b0061b950a7989bc14528ebea984c8017d81b8dd1b0e7b1bd63cb4e154d06c3670e504c208169285da8c16a19e5aa149d9b260996041b651148033f92c32a789f94624e69a355971ed73a662cc9307cc855a3c4ceca8e2812d214d103249280c13d4195a888faa0b15500580dd8ebe996fe3570d9fc9bb2cfab0c5c5cf4df21e0b396cf1f049de6a19dd6bc10180f93abf52e17fb56d1b3915d7b18f6efa92c5e1b0e05b2d7ec6f205425c82eb069eb88c662780986bcf68dda0a2656022114cfc002c5aaae516ea9f6cc900762dae528a2eeda51ca0d202c3a005fa4b9d294029da9a88d005a3bb1df65a6271b408b5a85c4af78030f3b8f75b03c1cc0ca614d5b21da88f322dc262ede09d34e98143179ad718e3bdbe6c5f6f32868adf40bbf0362a46bb4467aeb0a6a3d2be7a56da6adb055a5271a4323252310eaf1f1e5e4f803a78152a4ca6a605f94ed851bc03ed600ac335232482170d9f4975bd3c1ddbe7c0268ec18b719f3da785ab65de86929d68f14ca9b5a681e6c1929d4da22a0c8312cd27536902eb5c1c8c1f077112e2513d797249fb70abe77472fb6a57679e7c2dd6e60e1b8dfa0d67e897fb9b6d1d4e27d9544e5f35d7d7d7f5a737bad7cd9d4b3a3c69875d78eba87b46932d2849e49fc80fbea07f0f780efd3fdfa3390ced302ae771e5084216adb24f42c7a7a3aaf56498a50b1c4647493c04d7e0421585250bd36b40b38e1c928159e92825d2304a3e1898f6286c34f76b1d32ec6a3de8fc0704f33439222da4f218cef4e2488445f824f1548e66a474c272c15253403fe5cdbed616f2cbaddf56a331ab0d08dddbb9e5f19595af6db8f1fa331ffefcb28bf7eede20e5da8481663564774a0bb52f14ce28b49924c1963b61cbc293ef595ed978f2a93715af5bbe7a239b93c26554e78ed44681d0102d88000746bc5134d5521110cc82a70fc90f80aa0ec14904a34f552acbaf343d63695b64341bc8d7f9e8ac58c060b8d1326351bf342f239c34b3d54304d2df6f8aa71236dbd17fea1d34f673fcb1e76b3e2133f0ff76aedcc4ecb4326ff0e14263038eb7518e7701c75b801b92544032cd16ad4d9829b0f687a89fa1eca7411c7f1083387e1ac46982aff9d99ae4ace27c295940a6960cd4666b6962f1089bac8940fe14ccfc821a97739247ce45610746a293c82ab05a4106535450962e5d7afdf5f03ab28b3fb02beb52d2d158a5a86f2e5662d18c570675ef89278ebef984702e2eafa19462e68f3e9b2d16b3c26adeaca44274ed891efb867837c83ec4f70e0ed42dc097af52d3b4115d0bc0ed64e85a0e7d7a2443a0d3ece34d66820a8ecb8f561088312a127813e0cf81b8d65a6490db412ab7671340529540ee09c7413dfa04c3aec850f766c6514f2baedcf58c32c24fc7501fdd82a8f377d651afe50a34c652b99af0c108275d023eed7e481197a105f6d17e7c1722f88eeb076cbf4db7fd347ab99e462ff782ca824b0a8db6543e76eb6f81559eb4eff984f313611ddfae1c37d43a060e393cb6a96f9eeee834f0266b1e46d02bba331818086530096328a3f556310fa3966b314426c6883df94fb7df8849b191ec95c6f1a84c180416ee02e0f98bc736f5b7a73bfaf178318c18769f8497fca963e5d38fd6f1b6c79122733e611351324d9b5780b923ab6bf82da3b905f369fecce9347fe6649a5b7031cd2d286611e36d398cdf5c0562e246d40fcaaec88c2ccd3428fbdb66a2b573d3ff2a2b64baac8fff1b2deb01ce8f7f433fcb8439fc89db9f82ea47473ecd08198fedae8d8f11b4d420d7c1cde296c0ca88d67a9266baa581ea4b33686032b39cae20188a07294a63609f5eb44d48f638ef078f3f8e660dce1c9ce7387360ce77e1b67e707cfeebb63ad4280ac01c4439acdbc4651fbdf5b70a3bf867449bc8a034f0e33cc057e5ca73a81f6b69469b73489b57a5e8cee829fc1f71c3b0b347a5a968e4d3e127448ad1272a95bf558e7ff4163ad3f8e4f54b8b4b6bbe214d789b7a73ba69d6224c31b4fa3c54bd06abcb0b6ba799a3b9185c49b4d79cbee86a99986206564b570bff7e4b5757cba8bba54bb805cc740f7f27dddf02efa4c89278858679ae026537d466372cf7f5302ecda198e0730e55d105520bfd603645280010c9fd9a22973c6eaab8006465974741d74493ac79fba726cdcd268614f1c851774d8b0fbac5f59e40c0f3d15e77b0f8dfa4630949bc7bd47ff18acd2b566cee120ebb8341f7511bbcbff78d9b1f22c3fa9be4b0fe133cb702e8f6c5631f8acde28b9c1734f4a11a0621067b0b859de57fa013d6c482554d0a90ce2e22ac218455e2fccc86ee054b2a1b265eaab1a7c5541279899a17c62f5ef3f36baffbd9358b17ff7d7fbf2576ee195776ce7ee9818b363df0c0a15dfc1fb7fdf2a6edaffed7fd57fcd7dcb9e6d8a6cbf62cfdfc2e7ae60166cfd7636b0ab7783cb2e66591355f4364cdc388aaa2674dae8188a1090346f54a5e0f284a18419b367e96936313c366cb3fd2b4c9d132b3fed523c25a80e9b39c28fc9bc1c9f940cfe3006da11036a2462fa5f94298a885349f4af6cd260ef2d9f9975c79655859bc72ddf2d98915dbbfbcf28aefddb8dd71da692ec997b63879b3f9f402d975e637bff69597cf5e74f796abaffcec6d0b363c581c108d67be70c3ea8b83e7187d4bda16efeccd5dba6bcc567e59fc0cd7cc4561ad5cc79583480d6715a3013860293a602caa84d4f0554b6d480d1bc6804361341b359f5c6a8921414c182656422cd421cafb22b178a2e67a046e13556982a2535018eb250a2465061433e49dc7c8a52ddfd67f80c62039dc3e5079e731fde063ef90fd5f230f5da16f227fbdfc72e57474b03ff68ed1f46d30106d78e5f595df3cf6ce3b1765c94397c335ff73f9e5a7348fc772ef87b9d4cca5b9136b98c1b48965b4545533b3d9ecd28c8065866269afa223912bc55cc0824aa81558b0640ec25c4af49744237c26c7a6b66a94e2e3b8e4eba89061a1852866a2c41c0640c6c0df7aca3d939179227cc105678543e433fa8392ffc4796be7f5338c5645d6d7317a300f089168b2d76326e4e7643e197e8d77a8b979978ce3f504f501c471fd6faae1656623d65ac3653ce44ffd6634ba2f855bd07781195551182f3320bacf07f63e1baf5224da20bc8e335a663e4552805bd7e0bcb2fee864e4d69226beef73faeafb184e6aa98ed282cad711a79dfaeff9c21dfa49f7b1dc6c9693a4720bb8b21bb1f051eb11ec1b332bd2f11ed2842ce266a2453a25971726a191ca341fe603d955988fbc5ce26c34ea40145823cc240e1f6692870f33e6322be80505819bfa1ef58256bea7ff0ade2bfc69a4ed1576e415fd35fde02b15ea447d65cce724dc0234f600955934d09aa3ee3e465b8047194be460910b027f4c01aa30f5e4b06ea31b59a6e5ea4bc9bf7fb49fbcad2fa9e735d6653d4afab282fe71bb13b3b1fcf42e721d6bae1e8aaadf65fc4eb51bd1cc812937d303b51bfac76e4ab8cf8de929de5a2d07e6c14819f47461be0bfbf5cff1bdf89b7c924f8eff4a832f6e197221509716cde055942f2b5c51b885ae972d1c579894cc3639b9ad327de2eeaee9b5b7dabd8be232fafb5c61dc0dc59c818d5fda850b7ac3f790ce6d759ca9afc2d08833f08d0c0b3439ccf78efe585c860863750a797bca18d1180648f65c9d39c52c0a8dc0d8cf904971ac3c1e83e119575a51d5c23bc0ebf97193937c030709ee871906c2db75bea3b03a18dfb9693596b55ae3bbe874f7ea6abc13bdcf847bb0b823e834621bd569dc359d06151a1415c8ce9375f3289960a689cb465f3f32a6851327df0b34fa1627097f15bf4ef37331ed36f52d7ed59ff587c9c63ff3eb46b53f938db085b8b98efd425c2d5e85d64cc24c0a449514384836ea0fff851f54c8a8f612bd74f4651f21a32526eb048e179f119fa1bedcb934e3c151c5723584da3f35e32150cb78d887190fd47fa1608a2ce63b4ccd76c8819c40a532aec4853dc40372d6b3678ffe9e7e407fef8e0afd47d31cea07dfddb387aca68727c0e5041d623c2709e17235c0656770615e907d025c36cc0c913858624427e68818fb278317cd47a780854517d300359556590a534d5ff58f39c118852482d56b2e14a322826157a6dc5c2da885542125a5247532106b5eddb9f3d5bbee82f7833ba7c0d25e3f03ef0c26a106933c112677034c9e31981ce33039a7c0049c25a52643b38978c9eee7de3d7f0224ef0124b7e8ef90ddcfbe7b3e8ba38ed3a60f6cb38b292485aa368bea0251aa5ef7b2d11ba0732d5cd5c2cc250bf00d027c615c6fa2bdb0dea4e5fd66b18d86fcb8526a566d18b5a8ac75609672d9e64ca3a2dd2b978c640a0eb92c7ac7870db3683e81d728394435372ce67bd30228141e60c7c908564644a764e40dbc5974881ec1e333a906672ae4271583bb3bd61cef99dfd374e16d774c65563ec81b6d16c920f0c4ebf2d95c44545b869282a53dbb3e9b5bd6e6376e1efd4b71226d307a3b50e766852ec66e9ab8e3ab627e9138eed62db9953afffa50339e8a6a218a514e4c349f0eabf7c8080b364e85fb4e30868a1538df986b15e47ab80bb9b20f252248c3681593eab94cd9d514cfd1e2a9b22391a1feff2c407ba82c853bd1b7a154cbe6483bfa36d09daef86006b62666f4b04c28d8d3d4fe1287bef5967e8dc8e5484727b53be47c0e34f07c4e09f32a20e0209212cfa7492a07aa381ced2bc4f339d88583702aa7bce4ec58b06971a56fcb26f78f7e14786143e50b9f0b9fedbf6c69e5fede1f5402e72fae2cbdd4ff7372b832b8614dc15ea97857ddbca072fedee8cb2f373d70fde24bfc3ff9c98caf56966c527ffc23ffa6132b9373bd3cb0f22c9c94331769c899ab493e34fc30578ae67a79831313e3683ec07112e31ab21d8e97f2b5f8f6db2fa9ff3f7eeae6bf345cc54dcd559d8c43702a0e4d1370f04fc221f031380006e478d06fdab9eec777e98f1f3fbb2fbe735df52efd89293037d560f6d129208e158632c91e6030d3c2d07a8e9dd34b61967d35981d4dd3e4d8a90453bb3c34adab373505ea617e66f1aaa73eac6edcfcd4870353f3ecae2cf285e10f473f18f8f0a9cd1bb99a7e732ef55318619660ae1d86626bda4d89370073735439a659cc58483cba978f93976926f33f4ff97e2dbfa4fefd315f15d69f8396c4be4fd355bbf4017d0ee96630fc18e6e91298a746d4930cd40b24d1dc2bb18a3f54120d0d29d508c88fc94be4807e8895368feec57a42fc9dcfc020bc55ff1d63fd77c8f8efc032a119fb6b45dba09e7c86b4012c1dfaecfaefd469f24f546fc38a6a98ca08ba43302ebce3df1fc2abced2fe6e47e596579fb9c0449c0c0bbe75fe671be58d11be29d7f3b21c9992b3a6e3a904fe3cf092a3ea4d247997fefa4defdc459237e99b6f2223b50382198fc02efc5e8c8b8b778977d1dfe3c0d08dca60ebb25f8991145ea4ff8aecbd89a4eed27f75930647f4cd3bf45ff179fcb1d44efd573bb0ae86e6523e48f5ea38b7926a6d91aa16a7feec50a69e51411a9d426666f5055c985569b38bd13893797190d5665cae4232d0b37fb2df8278c204d5748cd7a19a8ea2bb9e51c93ec8da5962dcdbe78d8bb344bff253af7f0648f0df8e9f870f7ea1fe0b6f28e42569781f7d19e4b8618cae49ae839bc1f5c22a93e3ca2994e63d390c8ca2e41ecc683db42b432fedca3004f0f7f680c4b67af2b358c45dce29519a1303600d92648660bdbd13661a6ce2a4721207f12ab492d513c3fa0f9a6453086302f8082e2997f17ccc3a948a84fec3e7f628ef4743a9214b9ce72f2b6216785188b9dd87fe0536effea1c11171f34284d9874562f19a9e4ecd9047ec3ebf6dc49d4e964c5e0ba1fd020e9f3d23f38fb8a56f166e79d9d6beae9fd9e8c7fe243e47eb86b2e3f97d39d03630f5b5d44bb949c917d298db376c2878798798e6e3529fafd09718c604825432c6e2d4a2f49ad9242833d3990bcffbf21d6f7fa66bc9672ebf79eb75a7af719eeb4ecd2b90990b376e3aaddd279a246f548e7f656848bf7b83efeeefe6076f3c67c7c0e029b9ee707ff845fdc73fdb7d4ace6cf4380b37d8d7ccdd9b9c7dd68d2767bd66432890bb321279f9c738672ee36ce27cf1dfb91381cb88b69872d482aab6c04515a025f01a5c00ec33ab8fa6dcb8bd2aac863e066c9800aca0cb8409e22316faf8944ff5a592a9345f000d272c3844c988dbe4328379e1c296b9b366b639230eefcab5b2a1c567313b05c1e408b4fafbe76f9a37c7997cecef7c4a6a91d3bd728d2167efb86049dac9db4413215657d09d9ca54a8eded9e45b73c3336fe8562c89e1a1f0dcf752abf616a38bbb3cde885335598968f4360fcdbb74ded364cd255da73c6ce0a5e86dbfbadfd472fe254facb10d34f536b5a9019768492f5f1b495f8a35eadfe3ae17df13735c949bcd2de2e671b83cb553fd10d3484fcc688387405f2c2d0622140665f71c8b391034b5b7e587697e175732831ef80227b7a6f3c3731751a10f743166c38616208b319636a40a61431628931653acd028df5b30aa3eb5804909434163f3c295576eb96fe4be2d57ae5cd86c0c4e3eb05b9ad77ddac9d7de78f5ea0d5d275a492134ab3fdca3fd8f963effd69e0b2f4c2cf378f9f56d96b675279fbd28935974f6c9ebda300b74e2bee5eca1336776b6e54e1ff88c832c4e2c981558b201cbdd4f59bceeca6df32ef49fddda8ad31468c1012d34ce47b3194ee0bec6959d754b746e06b340b5bee0b787fee33f7ecd299d16cd997668b6ef184a0ef2578766ff8ee674edb33a6d9ece7d2efa1ea0ef41fade4adf13f85e86b3913b2377c68d60a0f56b817e2dd8afb5f66b897ecddacfbd60b5d95d81606b225dfb47e658e090c339e1603aadcd09128ed11ad3a673248e042eb891ac3502cf2634ed03ae28246110781c10718ce0270e0d26bea8bff2c5c4eac7afb83065d9fd8b17129beea644cda8e1594e07694bfaba33b1199e0cd9d8999a7753da1e0c4a8953cfbb6f9cdcf25d1fadba5b39ef826b663adbf4b7fa76ba9fb8945134b3ae79755370f4897b9cc96c72b67f0e7fc38c7bfbee9ee36d6bb3f56e3ae77cda1be1ab201cdde253a06fb831073d49dd1a290059424b1a5468456e22f9f8654d098323d1a48c6ef7cef48e6eff4ff22279517f02033ef3e72f8a8891a831f2d1a122ffcfa3dd451465d829a4de2742a172b71d6635268d22377764b40495b33eec8653f6d1ec489fdfdc594ef8eaf5a9a54ee0745f02a67b288c3699390c9bbe0475cae77bfb0ae87da0fea830415f412a21539f4186c42523f65828c80663b2e873924debb7ad279b9c3ebfac3f580c7ace7ce34c4fb0a83f28fb89b1dddbe3200bc89ae1f5eb87f567f4bf77f478dbbd76db5ff5bf9ee6c336342b56985dca1cdf6944faab0d57e37a9eb77952078602ac2663b9a6dc1a6edd58f43d8f25662c0f063380c0d0c7c87b6336faa7dc66be908ff68bcb42ca2e4c98dca5848a1ffb8fc69ef8f7eb178694a34beaa9ebb0e2fc4d5bcc4f2f18b68acf7216ce05baf409544ff3d3c8a28726dd98686b09213b6ea0115a83e083a5de2aef93ec6e5a3fe1024bcd81833844b0cf037a4981cbc0d48f9282caf689c0afff0b2e7b0f8feed59f22c2b53b9fd79fe21fdf79edc378f02f950abffe15f4a0be8c2adbb577ed1fbdffaeebd881467f991b744956ffeb02633237d1cb34313c46e4782c59cf141b7d5aff5911b3e831198ce5cd23370f702cc78a8e7f02b8b99b3b9b2bb7e0ef9bc1c4a3251a66cce153b29803d39143b3be13a891a6bed42436c4c0261c82abe426343358c572c75206b85c401517248f5b2e855b805c2a26c6b17e508a17040828eac3c6542f162d781d0610e5f5564c60a71ae55352f9c4407b8b6c05331bf6fda9e5e77de53b5f396f79ca6fc4d64ca48b5f5f397279e4f480d5ea6d8eb775a5fdb2e948a563f5f68b2e5f95cbadbafca2edabc95a96c08b157ed87be8b7dc37c5cf086fa0d624a8e8280bff8e9c4d36fe76f41fc8eedfa1bbec77fc3ca4f542ee75f1c72257af8b379385fc7c7eee6ff587f53d4268f4457edeefd009c76ae9667145c316d0f14d308fda38d4a96b4e1889aad6e8811125a6a2b30d072e61b4fa5105150a5ee858975f219da4eb47e4a65f1e7d937cf197e47adcefacf0074893fe07da96086bda36eb7f204da8ce038c6e18b32761ccb2006d3986e315c8695d407b97966459c1187c11abc826289b40456ac5cc454c576c66d10b50964a6a3380d44d47255900de019d037d9fe8f9141c7c27a12b2a1d21fa19c7e5152f8bc69229b7c1e9080837260d4df2993819fb7ec09bc2ee965047483f086f599a46a3ce18bd3dd26d109ef2bb3d8fc80a1c3f3a5bb4fbe8eccdb2f7b05709553a7c5c430d18d66f381b33e044d4c6357b96e232568d881d57483e5763faa88245d2955ab1eb612c5c156e61c5ac5c3ddfd8f06bfadb2e18abb1df76d2dc4103fb6d39838cdc609fe548140c8bf17b4409b659d20f36dce9a81fcbcaea530b1d0d84a481c77650991a6575b1b4ab13f5cc61ff26e69613cd54e32339c60439f8da2baf900ed2f1ca2bfa21e47096445ee35ff89f017b7207fc6633ad2a427bd492a1eda0c6d3c968b8268a1227aa8cff047cd21fd57ff9f2cb0d39601a5099d5152c6699e55a903a8042b434c940b9c75ba5e5584d75df5d0bb582ca2db4915a0b3652c3ca2c738b5c9bde93537e9a08cbda553d1834026118afcba4a3fef68196a70dad5e7e8f2761785abfbbc812ece990c11b3985ff9d2fd5ad8eb6528f165dd777d7f274ad20ad7260f5d47bfb18ab9a91358548d30e3da5a411fd9e3e4a600198763651d2a29aefcd83593640127222eef13980a1d1c59fe2692f9b9ae08c25cfcb0602a22316f45588683357f84747cf4866788bcd6910d67843e24ce18f210559175b0564fd59bbfd082f29bee8294778c16e751a8f1c7db6a2bf6bb39266859ca4ff56584dbdfccfd6728e786e09d0fe7ca07d0a56d93c57ee44ba67aa5a22a38573284fd56c394173a91331ac859b91c12a71ae94c800466ddd2c7edf37400a1eb00952491aac7082be00e673ddf7c9c7a80d6708a3c9698c2d69f2ca5b663baf5de336babd677ae17dcdb5ced95b646f53d0ed5b3d7ae42fb366b983e409dbcc8533ad332e26672aa167c8a2e5dbbd114f50f1243c372cd7bff50c60dc26999bd3ed8a576affd79ea1a11e23f2a4027ad043e243a863d1d652f897420f77414227774a9554e58f2bdeecdebdbbfbcd157fdabfff4ff5ed3fee232fd28f7df4f4435dbf59f1c7fdfbffb8e2375d0fb175b958ab8188716958f3682cb9ed10c6c9b128c5d706ece6ad572810ef00c1281858d72cd9652cc7c5c9835e22cf2639458a2a423c8501cbe2d075ae23e9c4fba1a194fecde6a154b66986e3ec7bbcc55d4530965f3a72e873d886e9c3a5c57eb2bea5b3d875d2166957d7496dcdaf7c3f98d0ef20571f7cf5b9df5cafdf516cac035b56837333576ec6d14c502f2f97c3e27f5829252c71c6e61696b198380c33ac9922adbfd0e28c7359852ef606901996c9b8ec2e9bdabad0edcdea73dbdd5a27ac246914db1dd47b94930b72ef00412e503db54937963296e19385169876a9685e884b71985d9f847b11c5d8e8deebc9081040df3b9900147972359d8bb0e6150d8a780ac5fd04ee1a0edda7adb425493bcdc8f622dae51e5a17df43cb3ae74e8b7b374ba8eda659b4dd832051b020ae1b90df6f939bbcb3a921d8d40a28c78102b2e6ef2f797b8032eae009d4ab5c902911608a276012b7105a7e15adaf6284b64650a31ebab4d1a4ed68078f392f79b076530653a5d8ec70908b1c56d97689c37a0a499fb2e9929337ed909a9cfa53d2e3a0f7276e3636397fe472bb5d7a815845b32089226fb0dcb7567f8676ec5acadbe584c5fc96d1b6d9e1753bae5936b2427fc6977aa4690359a3b485156f88105e30083693c3fec29a776b72ec3cf139712d17e6667283483b7f3d3b14d6e899584fa1cdca9667523130b31f693744970fac3da04d0f5aba58ed01320e10daebc24611da60b5348c8cd382e583b666b19dd22ed44a932cb41659e3fa4bc24cac14497551daf1bd7d6a949102cb7d0d61be9eb99412e2b1540ee576b42027242c6a931c860e828a654e3eef622494d57189dd6575904b244ff42993fea4b3c978f345275bdec04f92b8487f72dd3d16033100c1cca295e8adc2db157edec832728dcba5d82fb719df12ed2979f403fbd7da14b2a6d8eed59fd940feebbd35dfb43aad6601032c44b72185d95cdb887aaac101560ac69a1671e502ceb5992cb6a41cd20ad8bfacdcae2079da6701797270c085ba28f6ef435f4b3b0657dc7dfdfd254786e6728100692261be1ef34e25f29802a5c8a9a4d1498cd8f4a4306c18c46a803e9c543ed5204b51ac17db4841e7edf647da14fd991523cbae71261c9b7da7ad79c117609067b388d406dd6ce2d7f33fd5ef377a5a1e97c85a46a24b379da2ffe2641bb15feab3aebdd76d6734126c8454d081f64357937187fe3ad82a955adc827016e13d839ffabdfab0634e8e65da1668c5578e614ebb45b6f7216350ccb10389bd5a9a8998a37e600901e676303ed11500ab88b105968dd944c0ca922192602d2131eeef248227aaca0607354293797910569354d2495077274edf667bd2790d593eb282acf1b63f621ffd10f8dfb2fb3df2df1bf4a7bd6dd9ac2019fdbe6fde0b882f6ef15d6a2756c4f6e24b4fbe086712592b3ddeec70ea3b60c8f5d777189b9d3ff4b9dd3e52b14a2e936077dfcb5f5ea13d820c54b63ec53960959955d34f9c54b2d6f2c938eaf4c3a2de92d3860e58b99f66e771acf2bb64f2f48fd57ed7c2047c222a994141329cb5e7d7d73ed2101e2329d096c8bdfaa5fa21fe946b7fbde7ac3dfabbb588a7e78497489274bc5cd74f9f02985cb0e2cdac59629e2a0263a8d6b3172c2c75a1e4c13e4b6ecce32a5b38b99f55a3a30b4ba6106107490a52017435f80388ae69bc7105ac9cb47e904143c165d03ca6bffed20f5e6ea00fc2323c064b3dcfc5c3aafe58ff0c8c4e7bd070752089bc72c980193e1677c9ce3424cf448812b43d05a144ba8682542752a5529916a48368944d8269790da671e20058ac04b1012c750258ee1a581e9a8b040404d2b9fbc787711c44336d3c310d88b454b3320d8c9bd16ca4cab4d000e79ddc97b9db6a908201755246bb89c1bb9bc2cb64eba90cde8730e18b63a37aaa3cc7e13078a2f1d6e165ebaebdedf6cf3f808e008bfb057bac2d3b74fa855ba9e83da94b76bfe0cbce1c3e71c9ba0d78c14df21cb385f39e7ad5ed9befdb459b72b9cb576fbd763a143d469fe4f549597407ab61bee033c2bbea7510944a7c016624fcc716c046d002318cda9726b85de82bc07561d2029a389c290c0b856401e3e385b490ea4be194872b53c69403e629f68395e0460e9cff055f16debc70c3343f85b27f35351963c4e6f39bbceb7dc3fd21535ab029718f9118cedbda1269151c199b63a16c1e8aa45d5997488ced226f0a04558fc76a7218db9a8cb63687c3238a09d16091fc3ea3cbd4e256cd96f6f86c9bd5d252b0598de9d54e8fdbd9191c3639871dded982e021420f1184a06091ad6ec92513e3dcf629e34a36c4b6349b1706ac1ed1123165c2a27ba957696a128d1e8b8dbf3cdc3adc622692e4b212de6a8dab7c86b79b045fd21d0a849ac32e232126b3276136098b15b5d3e2e8f006cc6e8f60b6aa292522b50b76c120b6c67d3641b0b98d1622188dc694d3aa4ab12b2eb725248b4d16dd3d2991986ccc47c3c39a7e2be7e5b841522040f93ed5a0167c3026c418cb1027bf3ebae2ecbfbb4b1fbde723fbdf5db763f46967a7f3b2bb3b5dfcbaf37ed077fa7977bebdfdb9d31766469f76b92ee568cfc3b381479faee582337fdc2a0e7b0bf45651f01b68ebbc19555c0b942aea9733abb81426aa34e6933f54eae91fef6159ce538b2d8f161b7642c438d0ffa24283607329da606aa22177bc6db03c0feb3656275da97c7fdcb8fbd6749bfa83e8fce30f14c77b39a00d80555aac46ab1646651d92992dcd953853cd58c7e5c900fa89241785b78f2e116ed11fa988cb8a58ccad1f1cebf752b72dd0364f706753af0da155aca11c6a0afe1856b2b38acf6446731ed262b4cba033860404d504c0a08dbbe3ac07211683c5693118761de4b358fac5951c169afe405d824a6387d4b1b20eeaa9a3ae68da09b64e3bd235d05e691f40df02108efea3c63019691f6084c2124e5a73ce4db0e53116ea050da19e8de7ce61641d23e92cc48ad90884e61fa157127ba108050c9c4a3755eeaa60a4957df0bfaaed55308c5ac1171c1022f4e3e883ec24ff16bb86abf5e6dc2beea5792e2d5c3b370f24ff2f6afd7b60bd0e67b4a5392d50d51666b10cb89ff620e8cd96db28cdda4e3077d65bedac6848038055bd56a41eb57662578da4abd401c786abdab0ab341fb61657f71516cf37756a3dd4da2854b5c563190f2b5109c61e0216076da1511aee8055a67b10f49f02980ecf9b9d1e313340257498f5e0b2c9ac0fcebe8eeec161dc6c63c9375a5c2e6506503afbc9741d7d7cb50e77f1581f7521e0960ab6084f3d656c3f25b12b92095605005bc76b0274494b3a12517dcbe11589f8d4933a5aae2566b6e3b283a1d39ac8919648ba05ce4548f938cd826e67e77fdf158da423df8ef418b61387c3873b967b7ca1d65c6eb52f82f7e1c8b1d7b801f1dbc03b2b41c3c21c875415ad3890267d196d4915fb4260af06ae94c23c83d9fd5a44de6f75069b30d34753dca56c8e1a11b030915c1617a5be02c1186b0aa3c612017eabf5ab97b091241cae5d82cd78c2b0ee80a2d942f01ba85ec663127ca7293823be65e1ec99d139eda93699dc19f73b9b4ef59ad3f362fa366901b9c6eb519b924e57ebe8af7b17174e351b9c335a937e2f39bd7768535f403d6b8b493aede8a8b48037cc9b29bbce5fb960cda653db16e91c39f40f2bfae734bb7a3a3abbf057cfc9ac93f9584cbf4a9a473eeb73a9ad29fcc5b9b3e3c3735a5b15fcbd425e94779f73de697f3dc64983e4d8bcfd67acbbad355af0c38f313fce4aae4ffc9eb89e6b068d7c1e878ea834f5df4859aa9783f2c04a483a99f2806a788895876b9df23e4172b24c1ca1178e19985707c842fd5058a1807d14d37c2a2de2820c56ad0a06ee304ffbe13b29b58c2b5fb979e49c0beedaf1527cddbc452f9f2dbb3aaf583877567e4d22fcd4d0f01cdf191b575f6e9b336ff8ec598b676db922b778e05cc175f3cb37ddf4f2cde9532f5eb4f01f6f56d559372d3c6156feaca155c9a6b5270cfb4ebdecf4cb6cc30bd7372fdab8e6a967579dcbf0ec3ff68178b5f80f9c1f6c0fcee3055060ac096d7e9f92b0133e8cb764c4569185140119e353b1bb7c2f4d1d8071ef9fbb73ee1967cd214d73e7eeb449171ed4affbefb3dce95061eec1b93b3d171e24b7e26e4b285e60d7e97f984b369e09db73e11bdf86af18f02bff7396bb7b66612e11cf98bb53a65f3adb9dce87e217759e8197ea7f98c3647ba5d62f103ba68430ff9d465803d4b27653dbc1c8babcc0e8c0be9b555fd08e1954dc6468bb972637289e46838f3d7aa120b3548eba655c88ca5177ad1abf3278fe434f7eb9388b85548a68ac7e7f68edd0d0daa2f02d259b0e87d359e5e82290da7fe10f1cfd159e189ad03fdd3f9e0d13c860633114d504e346a9646fa12fab129f5742e581809c26999ffe02b45b6bdce97474384813fd68d5ff78f06764dbcf0e12b515769d4efd2d277ec4f5ffd40ffee2a79c40529c1becefdf800e31c09dc0ddc3699d19ad8dd6350e66cb9d224ae1ce0e90c2fd74017455cbae7e3ce6926b2e1bdb215cef40cf20b4652548e1b2e8c2c246343adda141bae542c7033abe8258671f604d8d666056118fa18f4ed848f56bfdf237385b203173f8041635d33cfde8ff0685530646cff782f689d981a21a078120ab58756fc41c412c72f778c302f096ec201edae095a43e6f58b7a4293d774526ba7ec7ca6272c1eac14ee16153df9239b1c15585b6f2eee2974e690bec75c91dde66491c5afca727d7af26a5e5e73ac94ac911c8f4af2f9c7efb3c69c54ad1d335fbc2b9272eb5eb5587e4e91a3c6ff8f34f5a97af90d7b56de2c3a12ebf6294c09e779b06463bddb7ce591460f1dff3852f8acf7027723b399495992aaa26711a398cd24ed4d66ad9431ffce171201d17d390d030ed35df9e2dfb6997433f762c96a8df4be258f5b893792b302bc48f0d6ef9a1452722ad9cf2f3d6d644a66f26ee38dc5a0f5035d3078b5a761816294e2ef5b086adf91cd20ae848e548bc973e3943322adeb011532e7db02d0d935c1fce61157b9f2569364c6ad880f926c5c7fd5e8b59c8746f7cfca61f3e387f556be2646fbbc16837794d2affc1cb2645699f153f47897c21b66c30dbbe32d5a1fe229b5aabfa0b46c522db64f30c5b2bbfbed853080eac3db36d7df9eadea5cd21a5abdfdaa444dc09479b58ccee080df4591d2416be2fa00a73453168b3de233a8c76ab6c29dcbd88ca1bb0b2c5676bfa4c4bbde7a783f2a1b58a042a59c1dad74c638d44e3b299c7779ec64e2ae4deda278dfed1520a1a0639ba4db805a37a7c4d4618e97313b0e3c5e3b51e30c61c086ab669a20995de6a891381bded343f9e7ae7ca211a150da9d8f20cfb2ae188f2b44106cba7c3870b604a9d5d852f5a6a8f79b1d04ac25213b6207781da1ac866f7b95d8a897a0f3c289bb0bb463d0d4f33c9b0227025af89d6406a765993717099591ea57da631c0c31a1f09326b82540035927fa582b1a39122adba39b7782e3abc8b045bf4202168a3573ccb5a1a1dc6864495ca049a38687d618cdbc3f2044b92053b0d51bf24e06aa2f132909c2eeae86da622d53795001220e3540059e0651909e070d1b85728c288e201e2ec0b5aac26a6e565b0d1562301244680580b56198806ac322835bbe09c1f3d3cb0848e53a386bd30992a0dd4401a14c74872ee38351805f803e35439ba0d4b71280f2eabf1881bb86453bd6769a6e6ee014250a628db1db46d2115966a4693315b709fc7259be8e35c24fa78240fcd1fc40e4c568a3d7dd60d76bdd9cff182917687706095ab41a473b8e6daa0880918e090d1daaa7137f2328c191bd33a8383960e88086f83a1830f87a8701f878344a17723266537eda9edc6b61e5824461fc5a359d187b5cf4347c74ef194280e1686834c5998e2e09988836f320e090a3d63d054230eb4ed70f1dc461c8a1478fca35c894b24e5cb9a6d6301ce442c56733504acf5da10576daa52d89d87ca92d5834b123029b699a49d6c7d1633be832466705b4d285a0523ab5ea9139cf601abbf724a5d9cd03a30dace1693161b884e5b758fbdfe77f05a015e2785d7818f422a5be89a617123bc16df27c09b53840698c7e0ad30206b0d788f0fee588f47566fde315eb3db3cb5245a1d2b84e6a616624fae1bc342c647de7fef512c5eacb0524656b1c8ea17d989774ea897390eb4d76369755862e8d19c044d7c2a34283254100bb4c4922b357b7033365da9f864083ddde4fd471016acb07cf4bd094056c8727d1f42fae87bacfeb21152dd76e4c878ecef7f0d6f6c1cdee8df02afda8465bc63104d80b778e40883f6fd4726435b044496ffff4edba880b0d6c77922ace4f011c6048808f0c8ec06d26e0658294f1abcb4ae30c80d7263cb3175fd60c5809b7537b6a13319935940944ad484aa676d68b2ac092cb71e13213cf931e0f275a8d9e3dd2a75b8c808c2837055f447f447f05160130a70a9cefe1a478443e2578082188f8ed056ef5e5621e20dd29a175448801460bbb5f06822a59252b4cf5de8cb8012066a1627195fb3f8487ab7c160369bed763ee8349bfc303fbfccf32693d965165fd4ff7399cc4b1e7d8957b19a888bdf0254e745f29c4db6989c8657f57f5eefa9cf5b4a233f40339b2b0b351a99b0ee1a4b8a8234770344a0776c1a5bd9e42d05855af6a1e692b566d6551486af908f62b123f5506166276d1041e9876399c9b37a6372d85139e38cc7dec155880a18209cf35b8c56df12259e7f87c60218151bf2fe435c2b77720d4e275d40830058a2c10eb332cf4d12d53b30bf9ef707c22d9156d4764117886334002b564ce6702c4e8f0665ea5d1b2ba44e49352f1b2bea2da4302731412b036a3367c5b5f5aae987b62c293ef60ef933d9783f397cbffe241bfe73165ff7c53a335ebbe29dc7cc64edfdbaed7efde129f52ba7d66b6e9ae843383c8d552cacf206db4680d51918f348d51f74f68268b6389c6e0fab56549be008314a36bb4be68e5b87c3d79a471caf1247bd62cf1557ec397e218e1b4f5fd120b3d0f7e9023d2f5debd92fd7ba34d087382ab450cf04ba018d27bad161ecabf910ebf3deccd3d6fdb0a23baca2dfea3aea272f9297305b113b6162e358a7457f4f8860a7616493d12d596e9afb774ebaffdf76739626689e70f72e2c44d7e79133c7efce1fa051a9c31fbd35e1de37c0bdb1a7661d77b61a1b58d349d0de6c59bcbd4c6f2fe3ed9529b883e939e1e63dfcc95fd617eee26f6db8f977f59ff227efd6173e303a2b3bf67c2ec41b7db4fe31cc952ac6c50caccfb88f62eea3b7f6e1ad839331570b29212a63f56823001b0f6edccc1f3872ffc1fbc94f1aa8ffafe7bcbaf1e86ff8bfff0f38a1dbb30dcf091b87237d1c383cd9bf01148f2aa9055516c641d976f09c5705bcdfff9cdb408be2c18d07c93b00df1761507038f05981c768bf410367c23a0e91da78665ad964ac62473956348f353d66a28a0fea09fd872bf93da367938705d7473f202efd6ab2a72a3c393ab3aec714295e5869b99cc355c2088368a48388cfd37064cb46993e2c42843d53b6fe6c3f903d462a7e9cd40d444be59c46d95d36fb54e6fd61e2049f31a0d28a70d4656349247f483902dc5e097b778dcedf85b960c5e22ee16d6f980ac0a3cf6201bebe39245c50a1bc374cfbeb7968bedd1c0ef54080d049217482f150363a278017ae2fc846da81982b1941fc95ad2a6be0d2009402928f82942860f993300e98feeed6edfa6d08d982ebb6928dd7e989df3442f8bafeded61b00c0129c3cfb3abd5578b2529f27468383c2aa7271fa1cc4d60660120d9d131855a41419a389a7a1ecb106c6054fea87f4176a042a3ef9e40df5ffe3a0e81fd28b28b13e7a959ebcf1ebf83ea1efa89bd2ee620e7d01403b07a59d034d17a30b55eb20961b974453768c7c4ea030371eb4a18ba3d385bc8cae595b963afb82d882c483a5e30e581891c0ec9065229df1619b94cc4cf91668d63aa3f366dd46d16391e74a038dc15e00ac9ea28288b605ae54b869703a6d1a9ca6c36602fc8dc06b2159b3030a58f451b6da02986c000be464146a03c5c2fb66328e4205a87f29c58106f8f5838d385c5aa16353c3029f945ac7a14273dfcc945326d706304d10cc12d69feda355a256eb4f47468ed0dce0649176a51875d31fc438d2f9f07bcfd66c27d6f1fa268c4a947cc11cb62f29a9cdf4419025ab33878f822cd95c35ea48f5f6b856ea3e436f838faec09448560b5a4f0666a46252c13e5170ba6af11eb7579159474656d209ea468978817e26e69dc4745af8a3ee05faa23a11ee0e60563fd32369efe7915a3368ba0986d82bfcb769f40ef36e0fbd426379633dea99ef3783792d5e2afb66d41e5189ce13d58acbd23eb34b35615f678ccb98a9666eb152e7600f138f72eda9caec4d1aeb5791f06235ce786f78f694d762365bfb4f1b82d04680560bdd6e0deaef0612fc01daf61a6b5ceaef9b7fa49f407b5cfc6331948ffa8d06fd4ada6f6f758b47feac6b414bbd1686e540e6302386461e6d392d572df35607dab56d99b2bf3981d5e4297c060266ecb26768b6e5b0c03d421b1647f308397d6e8f82fd65853c4de803f6a141525c76703b2ca0f6ee2440e1a5017238b0948cd044dd6c20a06f5e1ad06d81a5fae6fa9199b624b953df92b4cd9c490c66c5216cf505083f130eeb5bc89d63878f7e0e0fd7e298cc46f273cd5c04ece13497e5cafe9a96e0437dbaeca6a53c6e2fba7b3219d6d8c8eda22c1568a61a9c3cc51a2ae412d88b79cc568faa4a02d04945493ea1b02c72d04c0fa2713452b910f4987545fd8e62bd78012b2c5317d263a84cb1ab99e1323abf728e7ea83866d68f54ce39a7521c5df8e8ad8fc2b8b83897f855f1612eca71eeb0a08685dc6cd287159d40c0642a992a0c13cc127612176919bef7fc0b7efec0752d2d8fba8c9eef787a5d57dfbd6dfb795b64a3e3f38e6ef723c2ef3befb8fbe0f917dc3b14258fc819c7e71ca2bce5826ddbefda22f7785ff218e5471a72f7717dcbd4fa27e1335a333447ef844c692e7d726a13da911998606df2be647b760e251aa6211448be7758c822f32ab5fa279a81e0f5cd26c36488f44554c58bdd9f41db4f8bf198437412c5412ee17dc9d59b57277d84904b1c4ad84b9b427a7fe80ded24c33be9863158dcf6faf6d31fff6c717697d99c3de8cd3948262bda2301c5dbd46cb164f59f3b725e6c77c73fa504c4a81853eef5fbef5562b019502aa6c0093ded4ab4ad2d6ab58ccd5fccf90d8cc5dac9c4cebc5125114d61cf568575a7a72f59a54fe538267ce37afa980dd64dbeb2eb087dfa34fed337176bbe85fa3dccb4634d6b2d9aeecd51696769ccee620b017b32c85863bf7a0b9bdcd85611d30a680510fd28d67698d95d4fd7e00f30aea2e6253e27aad6330f7b256055d88935bf816bcce6e96af01bc8cc6f80cfe6935db4b77f24ded6c1ba0dd0fd6024d9d6ce8a3827b5c9c3909a079d8439d6addf9043c721a813d85f5edbc61fc07acad1f9db34da1989323cf69a02bad59e60460ee315ba0ddeb11a632f1362d4cb5d648ebbff97700a7c3a9ce892c1d8807c3c4eb507081c17a30a75ecd1a7128c61244ec0a709b4b5f953b0699d8a4da286cd3eafd21c1ac3659f37d81cfb98d1010686d121b5cfe38e0a75a25498e93511030a39859f8a8a09b0fb602e4d853d3815f6a671d855ff38ec8aafd6c7625ad80db2a79b4c0b714dca4e04557f64171edeb56b2acfa8946b4e1d87d44be33ff12cb6604cd047f8d4606f6b80dd3b96dd8fb557d12ab6256f25f5e731d43063cff799167efa64698c37771211977550e2a6c10669bca3bdaf3fb98378fada77ec484e332d30de71f28eb56b779c8c2db126cf8738ad679d3c0a1d5347a1b3618e475b5313e6432cd9f6b7cef1388de1c43f6e86df53c42841e5636738fab939e3045c5855538a5b36059bb6a9d8b4d7b079c1ab049bc32db1c4183e2f78fdc1a670a435f9f118c5b19655fd047c8ae7e1c43e0e322334d77b0c19d48727e013a2b96a9ddcee717cc29952ac1db4aa16ca7ed45c406e4c52264c65a79366612c16c8d618909501ee0ba8cd265af715c4e788213fc6c6fab963c2557b556b77d104ac0c46f9c70522576a6aa15e4fcd8b3519d3934785b1aec5e38044511ab1c090d0b4243ab778ee3fd139599922ff2a74ac7181a44292868a26f79509611d05f3cb85a80734404393353a84273db211311ef3ce5153ca0bfc6b717804fa203f4d969f373b45b7da44992118c28a0abb8bf3d61e97a6f46b3ef73ea34d26c76f9f932f44a9ed103d9ee32e34aee41dffc98d744160be1b51dc5bc335023ac19e3ab6d10c680698a4d89469104089490847e07cb61ca1e53891305898ecd1f65a208b95276a169f592a8f3b61235e9a3e471d95d884cb870f8ddd6776ba454a9058b4469070ed19c9aabf05ad4ea581288d19736344019ea00a113e930757c7e3902680f31b75a2e2f149f3653458d83facc5fde0d843e24be2095c10e6cae5349b8d6a499a0333524cac8a3bd66066e1046015b11273fe6021a388cf51f1f9f131d1a5966650553d51d8b2e2d30a5c118c83e0434cf771d610c5db21975d3ee69211a86006c9c66a8c6212cdbc50bcaa831016a9765cbef20d3272e3d6279fdc9aeeea7afed22fbdc6cf3d85cca21637797fd515c8074f5fbbf519c7c6ab7ff960c0e6d8a4df5f7bd6e3386ee8ef0fb3e84318f3cb5863e230c02c070dcc61450131134cc19a08064b9200284ce4abe4be33af990806735e5038beaa5f127ca878cd544070cd20b5bcdc4bc0bee080c1255074a32447b02928c9a16146ce04b34cffe049fc0f5fc2cfcaeeeeeeddd47bb05cdf57af67bd067ea720ee863587f3787d2a0089492932fca05c6be2447148d530f02998f3764d7f46bc70c9cc2ebc4bba6fc985e6ecc0751b82f79d79d5a3bcc3bce442fe407ebde2f22db930acffa4bb9b64c3172ef1b744ce1f3af566e386cb1fbd4a7409fc85dcff0390a789a80078da6360646060606470d45b1531259edfe62b833ccb06a008c3b958a15a18fddfeaff1cd6bbac12402e0703134814004a400bcb78da636064606095f8df0226adfe5d66bdcb00144101f301893b064b78da63dcc1a0c1b281810109a7c0d84c3d0c0cac12089a711310bb313030dc84e2c5403e0b90f680d02039a8fe132c1bfe7f8298f3ff33e317b8d97c402c0e51f37f0704a3d80dc3b2409c05552b8ed0c32005a519a1340bd08c39503d8c103e98cd82a60fc55f5830482f13c2ed70715324ff6c05d28ad8f5ff9f0d3503263605ca2fc5a21e667e07947d02d54e461da89d3b816c0120cd8c03c3fcc982e46f103e03c419487c256838c0f8f940f71e86e2f958c20514378f80b41b90b602d242883062d443f3b317108b22e9e5808a2742fdcf0a156745c20c2c4720f63080c1ff1b0cfe0c960c2780e9471d28c6840211e00698644112116740052960130520acff73502148e7ff4fff3f01e52a01611dae7b78da636060d082c2348625f820a301a307630de322c6638ce718ff319931cd603ac3f48e5987d987f90b4b116b0a1b1f9b129b0bdb23761ff60dec2f38a6701a7026704ee1e2e272e1eae07ac71dc63d89fb058f03cf265e39de0ade357c367c497c3d7cf7f86f09f80974095c13e41354139c222422942074435844b847844b24416487a88ae804b104b11be25ee22de2cf24cc241a24f6486a494e93fc25e5215525b545ea8ad4236921693d691fe916e92dd2f7a43fc9f0c844c82c9279236b20bb4f8e47ae43ee95bc8bfc3cf97df20f14581434145c147214fa143e2866281e5272503aa65ca3bc4645486582ca135521d536d559aa1b54dfa845a8b5a9ed517ba6cea39ea17e4ac34023466383a69ee619ad34ad27da71da2bb4dfe958e8f4e86ed3bda717a7d7a5f749dfc840c0609da1926185e1192326a335c651c65d262226734cfe992698ee3013300b335b67ce621e65beccfc8d8583c5124b0e4b0fcb36cb23561c563e56255647aca5ac93accfd8e8d984d99cb235b29d66fbcfcecc6e95dd1d7b39fb0efb0f0e7e0e8b1c051ccb1cef3915383d732e737ee2a2e5e2e7d20300457a881c000001000000db009b00110000000000020001000200160000010001010000000078daad92cd4ec24014854f0b1a89468d24acbb70e1c6867f4156c685f84f248a2e05a1540a6d2c52497c0a9fc18d1b172e7d027d0f9fc285f1cc30224116c6d866ee7c73e7de33776e0b6001cfd0209e28966843d0c211ce975c0d58c30a6e15eb98c7bde210f6f0a4388c1c3e144fe146cb2a9e46567b543c8398f6a638427e573c8b657d51f11c39ad384a3e55fc8298fe55c32be2fa5d1004a6e5f4bda65d733bbe5973dbd8840b0f7d5cc1868526ba30f0c091441c09a44855ee1ad8c1393a9c7719df238bf8164c7a36e0f03546147cb9aa73ae73eed15e30728b373f4309456cf3d4431ca0ccb822b51c34386cea5bcc3962bc856b7ac4290966c6652d051cf3f40af30a13b57e2aad8e69fdb602632cef44dec3e7be2b7b305a53496a0c56dfde2623bba8c9f8de30c3c41a6d016daab6a829621af48a93abecb8898c1c39f63d89fc1f6f39f94b4df606f23599edf02b7bacdb5655fbf40a6aff5b4c85555659b9f076873dd9573d2d73d793deacb419ac73a46953ecdaf07ffc04904c889a0078da6dd555d794650046e1d9806081ddddad73bff3d6d828f3d9dddd028a80282a7677777777617777c7813fc29fa09fcce6cc39b9d7cc7ae77a9e833d6b3a633a0b5effccef149dff7bfdd5e93086b19db19d899d498c6311c6338145598cc559822599c8249662699661d9cedf2cc7f2acc08aacc4caacc2aaacc6eaacc19aacc5daacc3baacc7fa6cc0866cc4c66cc2a66cc6e66cc1966c459750d0a3a4a2a6a1a5cfd66cc3b66cc7f6ecc08e4c662776660a0346d8855dd98dddd9833dd98bbdd9877dd98ffd398003398883398443398cc3398223398aa3398663398ee339811339899399ca34a6730aa73283d398c92c66733a7338833399cb599ccd39cce35ccee37c2ee0422ee2622ee1522ee372aee04aaee26aaee15aaee37a6ee0466ee2666ee1566ee376eee04eeee26eeee15eeee37e1ee0411ee2611ee1511ee3719ee0499ee2699ee1599ee3795ee0455ee2655ee1555ee375e6f3066ff2166ff30eeff21eeff3011ff2111ff3099ff2199ff3055ff2155ff30ddff21ddff3033ff2133ff30bbff21bbff3077f8e9f3a63deec69993077e6f46eb73b65b893bbff6d31fa811bb7707b6ee9566eed366eebf6ddc9c32d46865b8d8c1bcc9d336bc19bda431a1f6eba0b1e1a7889819718788981971878f8c0c3071e3ef0f081870fbad1894e74a293d2d58b5ef4a217bd42afd02bf40abd42afd02bf40abd42afd0ebe9f5f47a7a3dbd9e5e4fafa7d7d3ebe9f5f44abd52afd42bf54abd52afd42bf54abd52afd2abf42abd4aafd2abf42abd4aafd2abf46abd5aa7d6a9756a9d5aa7d6a9756a9d46a7f15e8d5ea3d7e8357a8d5ea3d7e8357aad5eabd7eab57aad5eabd7eab57aad5eabd7d7ebebf5f5fa7a7dbdbe5e5faf3ff462f7b1fbd87d863fbed1addcda5df8bdd61dde23f61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1fbd87dec3e761fbb8fddc7ee63f7b1fbd87dec3e761fbb4fad67ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ffd87fec3ff61ffb8ffdc7fe63ffb1ff2cecbeafd31f3aa3ff1eff0226d333250000b801ff85b0018d004bb0085058b101018e59b146062b5821b010594bb014525821b080591db0062b5c5859b0142b00000001523761fd0000000000ffff0300c5187c99fc5a0000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/fonts/glyphicons-halflings-regular.woff"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffd43d6b8fe3c691dff32b34b46f865c5194c69be0026ab982bdd9e00cd88e2fdec0c029ca818fa6c4198e288bd43e32a3fbed57d5ef6e3625ed6481c37dd911fbddd5f5aeeadee98babdf8d5e8cbe6b9aaeedf6e96ef4fe65741bcd46fea6eb76f174ba265d26eaa2bc7908b0f59b66f7695fad37dde89bd9eded04fef9fde8dd87aaebc83e1c7dbfcd236cf44395936d4b8ad1615b90fde8c7efdfb1415b1cb5ea36870cc79b761fb2762aa7986675934d1fd216869afef0fd9bb73ffdf216a79cfeee77e3f2b0cdbbaad9fa69f0e81d5a32821e55de79f3f7e97e942537cb22edd24951b50f55db265e5a937de7ad6ec23c913df3e031857f22f8e9e57595df7b6116769baa8df2ba6949709ce7d16edf744df769475899ea9c058fe2f7a8f4834712c1fceb35d9e358d0b288b23662b306d19e3c34ef891f1c71717992fa384b1016491ea55d075de862bb740f00f68279f1f4e4abbacd9e941e362eaeaf0b186a57a739f1a7d10b7f917cb5fcc7dfdbd58baf8369e8794140374f60fc229867d7d7192c9fbc27dbee4fa44c0f75e70721896ab25d771b9881c00c9bb47d53a76deb7300058b3c864da77be8e407d85cec2a4bd2e82d8ec5f7a76d2f9857a59f4555cba7f9994d4a0a18614fbac37e3b271c047cb26a0b1b4aa3f6b0db35fb0ee648b76d85a0bcbe26da9acab420b0240227447c57f3886c8bb00c22f270a8d38ebc93156fb7857ffb875910c3d11c29540a587fb9654b9eab9fc681b2c58e280a9034dff8b23278e463f093234911e199f99e02c39c3c3df54aa1e5967c18e5ac5f10222a35252068e221c26ed71e6c7999ada23cad6b38b663700cd5eaa237cd169a1df2aed927b95eb16da0aa04a4d5762037a06dafa0080d63fa45931f1ee058347c976748573d49771552400fe983a37ff79f07b2ff1484e7c84ed15658048f14905f939ae0bc09d21a23af66876d5a3811f2b18323f41f8f6116fde9ed9fbffddb0fef7e819eac59d5fed0a405c028b9ba3dce5583e4b166e5efa07becf18f288a3c1c462dbe25dd2f1da08571c68c023d600c695693c203ba3296498fb6a238ba3b204178efd3da8bbd4df7507b6129ce1de86b9c78b8002804e486b9f04361802c8219966485c444ff9670d84f4f3a18a02008b171f5409a4307880e5bf8f849473e44176dcfdec2b701340b8b282d0a4638c0d328ebc8c33c0862b3e9f575afef6d5818e4990b86f52d1d24009464583f0b4c0077cd7a5d93c4a29214579399506588d4763ee7cbbca7971dba0e40008c993311c69dc43199639415a08a3817c69b77be872bf10200d01eb6d30039f9a226df90fc1e8e38b8be3607d2b81e2cfc3df21858f46d9cf12922516c722d510aacebfada9e24bc3a3747a049884dba5d43d131b597c60063f5641c2c671c8c816caefd3624da3379181b09b8729970169503489bec8e0071c36e2de6c69b73ee96d11181110721f6627bf0160241fc20ce91b10b7a84654a36c70632f85c66d49c67741c08f9394ec70754acced371f11f09ab5f013df7d8450a98c9a433e29d3adaac03908128cd41940a0cf7225a0aa0e033fa022041d817c8c7e73057e0d17de69a71aef935a07095a700c9d6493f2069f60d0c5e4f5443cf62cc0c96a007403bce1fdbbaa2dc82310f90ee7be08b7c0286a7fca3eac8439b6c0f756d8cc90603ecd80041ed3d1bef114c0fb82c541cf65e2898a05a07e341816a59931488c36c997fca6bd1d2141962c9f11fc8cb900e18f3a5841f40d58caf66266fa323b9b4830cce5b87ced5ad10577c06a01658dafe7bfee91b9526a0b53e57da9892470b3003e5c8e18cfd6e411a70e6ec1c37083855e85b0334fe969ed8f7a08b7f749095e3542d1cc243965c32d40f5eef2b15c928df547501bf7da3312c1316e0eb5d7a12c621bc29b3e16869eec56752e4b53e07932893dba7a7ecd54ce8a3fa4639662f6c8424be8755c83a04c968bc2178cc6175b0a863101749922dd4f9e17e117b7c2e7b711402ab2a161e9e172813c80500737d6d9da805589b671473090ace0458ad63a2e831a24c07841983c4f5b55bf1f62d41c465d590dacd67641b85055844909ca50173afb8521d13e118f5c3193a3738230a521b72b0e1e78dc64065e9915865715f264a2fa00db0b24072c2e9fa54b6ea7008d7092a7674030baf2625a206b5a4bd70a35795d5bec53a103a5055d15110d1af88d497f0cbe000c8d4a4f535bc502f586e606947e84f5c3a8b835080e3518174a72c420a2193500057d1242bde51b11993e56c1516d59e5018c6eb633077e3db1d25e1bb93d6a47351b3b0e4428513614f1c4a02f07b3517e97de1e7720826b653bf32e652bc7059f5f8d78a1bee5297574a603064320f299ef45440b7859395c3819680471135650942e5d7aae836bae5b046935fff2aced8de6ab3a689bfccc2f52aba6b2a90d623d47dfb1bb2ac8ea5a8303b86958677ba99a493771f8dfa0703440df6cb80afa088725c9adadda438ec53fc01c80043e5c49f8593dbe0c52d790926955f0c600871edd3229ed08df88e150712a5b93c618c53b70644e3b9f1f5252c02b50ce05b43963a378643a7cd100083d32ab8bf6391c72563a9964da148a8675568a36c0f0f19286dd4b880ddc5eb0559ae8183c1a04a9922b62096f68698c46571c8baf3368704f459ab430edab33b280c56e148fb025b64c0fee00ec3109d7b4ea7a1c365180cf90bc7c25f689d2c11c799f31f7882c66c6295607fa32741c19caac144eed62f83d01fec1be00939100d8f74edb69200c01f8087361f1878d11962b0da547816f628ab3d39e6ea2670227d26600a46825c7426b67d1cb6cba4d397f8054e9b29ef6e48bdc9eed94a3f152ec03cb16423236a94e099c6bd9b1dd94a010cab147ca2506277c3a56eb16f76001ab0c773e68c2d4e8acfcc645e6c22c58760d4826ced7101209abf5b6266e67460e7d426ce4c073618c9d7d7d3af96df4efe2b9dfc73358d3a3497f3007d29e7bdda05f646000be94fbde14ca22f8a3893b6c6d161397b722b932ccdeff103f694180e80443459a14f50a7417ac806512bc050aeca060083b338e11cb38919cf29a79ec6483823c3512cfd92f2b4d8e64b9fb2d3a2872073c28c9eab75f0e835dbae39e49b160ea2f32a8011e74991f8f196091d30370bcd61b14ddf67e97e027f742bc1bf795554ef4739ce96787d004e5f036d55db96ecbb6fcb0e4d055f33d1794085b0d3dbb871be708517da4df3c104f2e692104361face6cacc651b7f6b0c0e84a804c0b58c346b9ba3d86fa21de934fd8d6384504f6d47ff9c7a7dfcf9ebef9f780e371866ddf3405b10e4eb1995e2c268bdaaed9fd0ceb49d729e31682782f410ac02d60418014a48f1414219e9ed6a84af27525092c561890d1874d956fb0085931d57e8175715884881b707abe383b6f5457f1b6e97c58d47be039fb207e5fb5152c6994a23dc247f096fb06301e10ecb0f2c69bb107b28d96d455db65cd472c644bab84dda26de72ea9b83fa082e16a44282fa62b4216a06de3e51fafafef5ecfe09fc924d4ca7f8f45af2a69f0c3d7781cfecf1df0a2bb64868a24f90dcc0b79e274d63553a30452cc8dafcf0a0ee59a1a952be926d18dc6875c155cdd292e0b1169be533182a1cb1456dd795d46ee767d4e9791832a5d869c6fa298efa86cf60f9ad806719af669e0787ec83cec735ad68b13ec503f8593144b7b08ea22fe7fcd436bb9545d0e5bc151b977a0fd055995c3838a52bb23b6bdc74dd787a648eb490e12000a918d8386e43bba878ef0d280418243b038361ddc137120cbb32a961f83090e20cb9a745fe06fe4b83db7aa2d155383b0963a041654bff162cababd1534b5dc32503cec1dec8913b607db2f910d3a220acaaaf405a1b5724a0219c7070060aca2cdd39df246e8fe6e86e13c45415ba29dbbc08a5737a6ab1b81130a968105e21c1cf69dd36d90bb7c062ce43ed7eaa40f57240e6875e90ec44df1ae910c23ca9ae2138a55d904c10f9ddb1c08ad7e07ab034e5ce893dbde087d78694b63c200d726d37d954e988eea856875e411d90263c9c99f19631749103a026c4f6340b1c80708aaa8d2ba597bc1c56e107d20b61e895164d0fdf072360be2333d4de4df982ec9e0d19dee91f58d054ecb035e888c096813ed4f2b5e26dadf5a686f0893b2040cc3bd55dbe1a5f432459c073fe3c30d10d1e7faca78aa49dfc33778e63d62fc11271651aae16336dbfbd6b9eaa89c18f6ed5930529ed22beef3fbd462f840805709e009b3de44244a87912f2a957560c920ae5209f9606e89a24262cb1b8e317c2821a48400e99d0415ed879d835dba3698ea0aae84b85a616c22b83519c27760360719d273ec27415027bd054aba86616e9d72dcff4ed42116f7e5b232d0c59aac5599a3f4202f150e718ce25b79322cc5047511738aac3778e64edae89118fb1b7b9e2d5e2d4c10339c1162736ba996d9cab8b8a81ddd8cf3f18d37a2a6eb90f0ba5c50bb300f3625a88552557ed8a3fc64c2c66099f646a9be0f3a70ee2d6cfa6404c60c00bb4ee328aa81447494b526846c796ba180297399574a9ad80babf14906999dcacbcb801649dd922b0706c8f1799a938d9fe793082fe2f25f6617f00f485edfccd1a1f831573fadbcb873a62491a66499688e5221d9d643ee7872da1d3f2f9f9e7ac3c1149ab37d6d3adb85cbbe5ce62b5088e33555e7aeaf4ba6d669e98a743097539d559cb742199ccebad3d970caa2b3f2c9a4aa7cc2876e27d69e70a90f66dcea1ef4fe092d44c64fac0e8a595ff1d5f42bee302ad03deff2b9cf995730c5bc3567062f9dc52f1985b33024b5944c3514c7106e1a8f3a59859466ee7413c09695e449ec70a9208c516aac827159e67be2e6bb2da42e1bd2207073d46758e129502f137988d389618f33e2db52bf1afbe85860917dd0d420963d6adaedca2cafb65587b95d4ddd553b100630996918a7dbea81fa34d01aa6388423c4d0059a63fe12d2498c1a3379d82187890dd125467eed289ca4fb3d18c5af5f4da1ced9a0da6ec95e36a0ffde845c85e0994f23e65d0bbbaaab0908e4b000c3e8533c0b31d715d785de84b4827162f48beae21ff76e783b64baaf00a9b07f29e4b39ef3c34e05a61f40757f61df687d334e68884abefea8ddd508fb11082850977d6c8974c894d3793999cc19d5af13b22c5728c4d6c0cd984b3a1892efde582ed8f4bb88a332557e46e25cccce51968d709e2b6042e9f6806ce091794fd732e96da1a7b8c54257470f6aaf0d4b6ee36d002bbd796fd99bb1f7b96ba6331b4e037dc0eaf307a4cb145affd1d98149dbff76e57cebedc347899c1c7e8a443cefc8b59cb2faf80eb1d5369b007338876c1d02461165af17c7376796557fadda343d8f0e67e319faf52921811ac23db9ec5b05afafaf7d51f648bd64fc2b44362e3e8049678e4dd6640d9ca2b76c11678495e6497fad46ca8c380bcc04a3ea8751aab96791a8f3650ae621a62466f02b41916faf8b6295c310c84615a803e9364720b03406a51f2cb218b36a4df57829916fe5ab4d185b0601a9e4ad86ad203631e34c6487e4829da360d598396a8d54a9cd25a252683f3dd92554c51101939cfbb1e672dcc49d8a624c466743c9cb3ac3d1b8a630814929eaff0b302963ba0c9a88da0a9acc12fe5c68e274084e61cb3be738e52496b17fdb396cec5dd8a56837307fba2fae1070e936e432cf2e8853f67358415cfbdc0b0d6090539afc572a13a8922acd8bfb6bb9ac64fcc6e827f50e809f800337307b4d94fd181698af37606af6fa81123cfd7bbb480f5db380bfd30a4c949269b984258a9084483dba44c5f9e989aa41186b2c48874c28a029588f500a1a082643c21f30b977a8927859dd80e83e6a3a27b1e023759545a12c7b778b202e8cb8b5b14d11f31414f373c36c3e388f2a296ce3f94e2ffa0f82b99b54db6088766ff942842b3dac13123e2443d179e52c070b44d78f5545b84ddc7b8353c696de8265cb44540da46b8def23a01ffe016b683e6704b6353104fb823176e7c69861170a1c1a4028bb396002adedbae6018879037ad46ebc893674c8f1dde4e175b3a0a811137a9564271a4d1e2677af660bd19556b364596c407f8dabd75b91488bd5f417d6e28f49f56ab7e03d6262251fd60666d16b9abf49147893d639f500147f615b21e126acc23b4eb3806df5a79f052df8bf85023535de85560345ac7de28e15f4f314bc71cef8d1717ecac901cca3efe03893b5b93fe5d3d803673579a8b94157227448344e8626b14d276bbd88a14fb849801e5af23dc08cb0f4cb07105cd576c218c32d86ec879ad093a56de655fb53fa93bf010eed6f68989f7e57f85de17786c893640ccfc28ce242c2fe8c2bd066d99a90f38ad345d6a7543f54d1d7b16e7212c5a97e4c3bc0bde6000d539c21609ccb28c612b45f8fa81bcecc1c51ea6363d90f3d98ddf76136cf254ddc5f25c858a9a1a56f713db9a74268cae8e409ca64a6153bad3a99cdd9fe5fcd60849a03e3c5e41b019d19ac91532d2cf9b2a5719ce74cfe5b344ffd7a528eefc2bb90112273f88dfacdee27ebf03e64f2605e60428a9cdcf66bab5e86952fa3edd42ce6a2240fd3c51f662ffcdb493acd82b1f76fe874b6d503296fdd0e7c8ed199e405dcfa40df1e0b139af63657ba044344437ac1ae8e82d98fc9fc2b84686afa39906841bb1b010046ecd44608b011e35527037f463e62f098693ce74a6a9f42c0c238a78209de38e30c872949540fc8753038520f0dc5c9ad141517a4244ac5f0f33cbc4ee6979f667ee414f393b10f8b773b6eac08537400710418e6b012e65ba46e16d47cb89a96ea3ec70680556dd37ac25b5d49e76b8066da70cbb037bc2723a6bc450feb95623b74f94b617acf04150a515fabb6d5c5b9f4ef6a66b4308961a4ef9041c216dfd41574f82bd8fa868e3ad0c60fe2c70f54a1c90c9ec4340859c8b8d2d1f672705da4bf2d5bc85bfc4573dba74a7f595021c0186fc675182601b880c922bad4e937937cfacd314e39fbd6fa4d8a731d28f374cd048d8ae9377af7497e8c2f6a28e639f6e0e0c669fb3eb7e00b9cd1c99336f3816da4c66b6b3cb810d122e3bc79193342326a6ef0226002561651b51bbcb68875f2d7d3536a1a01c2d56a21406a8a14fb2624add57ef38831e58ab620609e596bfcf7695d15c65304fd203c370f7e6a0a22eef7e9016387075af8d4b0a4974780866a6f3ae59d359bf30cd3e1f6b7ae34aeb7bc76b097fe753a0f4cf939165fd693414d56ea68c0b352c202650ba01c75b7e03d929cb9b130bfd2840ca84efbe693a1b6ebbe113d742064073d352b9fc0581417737f722dd8082472a49aeb1f5fe2728f882d0cdff7a7d70be10794721078f23aa1716d4706404edddad11259f395761d877776050e45d5f9d0a1800b0f1efe4b812216d4d9353b76359c05751016fa4c41b7011a1fe176df02b503bbfb997518edc96f876a4fda9158fd5deb057a5048978543105001dd472d6ac42f864a2f394fb0e7199f18be718791c45e8c2891193edabcb41a73860df59b97af1d83c93c5333be740c74d2b96cafb279605ebf3710e25f77f53f57d1d7bce9d231a7947f135c972aff566799b3ebe8ee88c58b1199934b0d9dbbed0aa75131e29ab57b1b746a1fa4f57003ee807dae52c9e5a70ed4be4276f2b8a42292b915115d0d910056fa4716f1424be3e4a54c0749a90e220bbfbccae05415cee8364235a07ace9072235406686388137e8e73fde34b8813c163be803891acf739e2847776891351755e9c08b83c5f9c0861a2e7fa1fe7e7c3127aa710efcfc92b5a879adfbf11b7b0f0fa42405f85e397163debf5b8ec19afc7d1d80577527b75e505c32f02f088436ede9c8ff18582510aac6cb6a2774bad304b9766fd97018e8176bbb1a4ab28cf4650d6ec913be6f4c1b9f15d2173f1a1c83190f5eb70ad7cf0faf35a72fa473c9d583961e9827bebb5f32dc504bd8c08e99c59f72ec84b807208be1e99873b828233cf11b85e0908cb05b59d0cd338b33c8ec8d47a92823d4fc4e167ae8565224964ac2bed5693f3623fa01a7fec50a188b61f7c466de81992fefb7ff3f2dc0b80eb532e9d35cd99eaf9954c353bcde6e2c71751af016decf700292ae94ced420d39cd9cda31145fa019c376cea6d4e150830975b86a79475d14eeaaba9764e7c854e3a0c109180b78661a974a2ebaf0e1c0af59e82a31ef8cb3e0197df3b02cab8fda9ecd27a4f04137e1e4d21f9d524f265edcfdd7aadb5016f843d3ec9c79375a2a141d5624a31db6bb8ac7f876e85a165e2965fc1b13f954befcf5ed2f6fdf81728f038de8bf184be1bfb8cb2ad433d4189388677d279f3ea7e3211d7d4dc61b317a85e50fd64990afd5e01eb84a1e0d114a1e0f41aacb43521fef79f4e643ab4872ea66b33c48c3c7a46fd7f12ae3108e84b7c189192c185a572ccc544d33514027db8d88bb16c3f0b1dffa11f0118f0f095d9195b3403de0e606fe320c991bc828c24d3ea11ec5a4904cabbcd2143c1fd3dc4a59b7d6d56aa8e5b3589176d97c6335578bb17b50a0541a895c518a0079335665af12bad8c5d56dbc11f56cf9266804385f27f964a302cb6b39e6ab64cd63d157b7322b444226a98c747e3ab59d7ace5e95c17c4f4f06fa38a68ffd6ae14dbc718551a9f05e2a4b77634f32196f28a6c2627cf7e7ef6589d556a1c6562acd832df31c3492f18358f1990be9f72ed09e0ce33b91ea26df72e8f1e50092637057cb0370222d7565c3d94cdce7763485381d7bae7e7e0941ce4fe1c25731f92e9f63b93099e210f4ace282e77ee99695b0bfecb59476f749e0e3d99752a845c21fbfcdf91125e2c7d3d323cdc7a21fdfd153a52fb1f2e83a3be7c46c10c80eeff02e926a8dd16cad8a6abab84676f9fdffea3962a574e23b4eae072e517796af8bb14fdb4467a58195bcc4142b4e05e2d15433999c95f6ee5317209e6055fd705c3a780f8b067e3c2930d3052f893d4658f62bca96a92af8a0010efb0a9de967d7259a23f92e07e195ee5a32c8ed3e2fad4e805e3015f6a5ac905dba25359a57e282538e4172fde1e1427fc0415b1d86b1d18a36b62e1690cb96fc3a068869e3c1073950c8e2355a7ebb3c45df068161b7a8a5687a132fa4a1627c607ae64457f6645f39187f724e44c7ec4f45a8fb10e7f230e5cf734e381b7ce64cd8d66a37c2c3de37ef846e596a57dbe4bb1d79fa40ea3729007cc9957be0bde2edb8093a342ca972c975def2f40d5eb02897220aa5c5ee96ebd5993c8d01b2197c35fa04dd88dc8b2f4d37835888a2aa571004bd8c2e73156e141dc4e87ee6c7201af7238e17e29e4a76199af80ce65f82ab858eab3db0cdce3c2520f0b0388b87e7de62c7fecb53e8653fb061aa5362cb73e3eb8bbc2c2881f9ec9705e7e84c2ea5dca49a156e02b588e40a04bbf5b4a064bdcfd1cd446fe79b81a2ee8237030508cfbf1928061d78ab3c110dce3e163870afb1efac79cef381787a98878dd69deb7037cc7c623721c5f54650fb8d4531e9ec619a61858f8e55f2f1aaabb52568efaeafef9818bf714363a98d987837e36a7c43d55a749de72e722ed04bbc2c7b74215ae27bbc8a3b0089e83de7a592f89b0b1e0ccc98164ac53e457cfd85f37d9393b60d1d4c832aaad4454053aa8385d4ed63a5c27e8d75492adaf04226147956b9ed17303c62ec179a031ace959fa71f8beb5a891594a25847ffbf9954fecf140ac5c84914a3d96c636f844fd48dea0ab4b694bfb9c7040f2cca5fae84a8a0331945cce3cc3cf79aca0e93ef49bb11a9351cfad6db812e4fd9edccce48a5039dcf854b1276680b8f8d848f8073df10bfda776e438e8b23f4d41945182710440fa9eb19a01e33d66e3a9be1a23299fee3ab682aee70206112693194c87ac5432065efa2f17259d2e7aea9c7054565d5fe4af70e069e8992e8770081482f275b359a4b0b16bc5a3d3dd1372882a80599e99b891b82c902982718fb38f60d59614dc2591f5a76e10d83bb608072186be5b7ab5e808723c8908d35b8f4b1cbe596b97aa12ec50a982e250c2a3c61bb0e0df1896b66db3fc8f72cb3f3d956852750a78d708d5c377d9de452e3be4a7c7c5a10237a3eaa7ae20a948aa6b1501de0c62b7a0bc6ea39d005efebe23b96fcaeee1aefea420f7cc417467a0d23e10fff0afe8e6fe1d8e9e0f8b33718e92bdd8e409c480cd71941269ede5704c38446fbb76d57d52efe159e7b1c5c57a4c5b06321a7d8b42091329448e1cdd86e866427eb6f30d11a59255f148fc5f6036df8dee840c04ebd755e24c5e5a13bbc8f25b565014d2520403e589133593e373fbf849ea8cd79a1034ef57896a227bbbb343d55795ed553607896438e1bb397bc5d2c67fabcc78b33c9c1e405b37c4fe0ac392b414582ff277c18174e1e7f25d97dd5292324f63e5825609678e18fcd3ff5364a7fc354a5f02f7a5d63741d3556db6e7098a3bcf39fe3ad892ca04feeb4dda71a8ff32a49f0bf1804a1480ace911ea1579c41dd712e5f7935043d3d33979de5c84fc5ff2d8bbded24c29b17bf0c8759b7e2aaa65e8e49ca4570fe7fdc10cb1f6961291266814431e329a9fe204966fc7f43ff0b0000ffff010000ffff38ff2d66ed710000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffd47d5d93db3612e0fbfe0a09c9718008e268ec4ded16658e2af17aeb529564731b6fa5eab4da2b900429ce704885a4c69e1de97efb55e38b00494963c72ff7628bf868341a8dfe420373fdcdf44f936f26df5755dbb435db4d1e5ffb37fe6282b76dbb0baeaf33de46bace8fab0702addf56bba73acfb6ede4d5e2e666fe6a71f3e7c9fb0f79dbf29a4e7e28631f1afd98c7bc6c7832d99709af273ffdf05e026d006ade6ef711c0bb6e3f44cdb519e23a2aaae8fa81352dafaf7ffce1edbb9f7f7d07435effe94fb3745fc66d5e95989167b46ff8a469eb3c6ed1f291d59328bc5a27ac65f3246f1ef2a609112b78dda2cd158d43d33326cf0cc7c4af4a8ce2228fef118d68bbcd1b3f2eaa8693e332f67775d556edd38ecbb2ae73449ef5ef498ac933f7db3acf325e03acaae1891f35be1c95f8357fa81e392647402e0e198651084dc2d8676d5b6324906d599df11691657238e0ae6e5bf31441e3c4f312bfe6bb82c51c5ffbdfe055f8d5fa3fff6e36df7c4dae29428488c9f390e1842c23cf8bfc5dcd1f79d9fe8da76c5fb49850ee17bcccdaede1807918fb5bd6bc2d58d3604520b28a83d8dfb19a972d268476b38a42e6bf03586a7ed6f4c8324f71e4e78d1ae61739284f3021356ff775b9e48a046ab0bc448432bfd9ef7655ddfa6dcdca2607527a1eb7704a59c2115971bf2a391e6beef332a129f1f9c3be602d7f6f2ade9509bef97641821493a3a04a12323f2de58a2cbb9fce824a6427b0383e67f1169b4af2ac60a895e361e2c39a61d49161c90f874129e561c93f4c62d98f5060a52a9d4461888061cb0c791e5f471b3f66458113722447da61e7bfadcaa6adf7715bd5616c5794d5dbaa4c8b3cb6666026d0b50b13c1d047ca7052c5fb075eb616bf9b351458cfd92e871d30607a72c477ff6bcfeb27422f6d3b531dd3843cc3d0fed7bce0306e087b4d60e3573b68d384cce71f5b5e26f8f94823ff6feffefeddbf7e7cff2b4d54b3bcf9b162495e66e1f4e6b8ec1a84cf852c7fcf3fb601521fbeef2300d321dff0f6d796b5eea6053ce3102579c3a282278826a183a658da5cf0e86edf22b2428fac4001dab60f05a2a95e77b28c66210204104dfd9a37bc858f8e034c11a2c99a6f603389ffd375b4391c6c32aca30da1d0387fe0d5bec50ce8fff1c9663e60176bce6885fb045ad0c4674922374e4ca45889694c48e036f5bc41df1b9a38db33d602eb3b018490a3580f4217c425705b6559619157529785d3058d5caa4ae9d9b458c965d51345fbb6adca066dae941091d2492f930b23cdcbc4ac8b94cd3b8c6047211286a866495e21cfc3ba26def2f89e2788789e0bc8927a719b3f828c61e1f42688d410be2e76c8621a13ca3caf3f089d5e1a83581a62cbca8c2372647dd424617a3da5048ba50493245b5abfbb05883f5b86494888d03454222a0e435445773c6e91e7c53de1a69a2be9160996a22921147ac939a09566104c82d8f3bafd88e34ecc49408e9c8b9c9acb824e1121be24e914c04ed4219b17ff13cafa0da24371c170e44bed4c96b6d28cda1291c301c7616c381cf9a294d0588d883541081d2ae4e3e708d788c643e11a29a9f9755e2679ccdaaa6e46f78f1fb3bada37bc98770d11a1b64452b4f4776cdf70251f9b2207f1213ff2b2e5f5232bd40092c3d547def287262cf745e1c094c0c2106dab475ea33edf834e7a00b4388046540bc10e0f018d90ae65c1d923efb58c9fe242b77455864639f896bfa6029740a1423fd46c174c17ae6c13902c921b9e8b0e070bab249cde10ea10c5f3e282b3fa07f5899d4a97d0569fa905d3f3dc4e61c35b03ce996fc93fb64a388fc22544ed0a7b6a196fbf132bf64399f08f23db6a64557b3c048b6ca4a4e63bb1f0765f6348faf1362f929a9758e1291bfb392080ed2e030de32c41a715b4da76e782a516b9b5c7901a657e7338446f16da1eb527aa387bd567488e115481e8d05bc6920de439f6db0a47e44882240ca355b77e9848eec14af702148ea3db648560bd5080c02c4794a9990b3cc10ae84d5e0073e67f8a051784bad8ab6582e1e844081d4414253c6fdcf0c62e04adab4e99dd6a4439d1e942315ac7b217f7803b57c0b49b2a79ce536c2fcea975e35892b44fb99a3f7e1e34492a171af4b28d4890bec08949e8926c746ff0303e1c9275b4c142b7da74a05908869d98c00a153c6d518084278de8d6ae4af3ba81ba82352da2b91816187daabd3931c3a9230140a8e9799ed9c488acb71b4c8e798a6dcf4ba34ffa24021530bd110ae9aef3080585dc8df25c7370c992f7426d067cbdd8d024afb95892203b92e538bfdd892d7c77d69b1c456a4153cfb337e1401d9a0d8007358a22e7ed3efaa91202a814850ce7ce589d2c5ce703f9b5518ebbb1e5cde84772ca6576d1ea16117003db16f3ce35880885a5f0ab346d78fb5b9eb45bdb73c808b51a678426177cef6eb2ae8bbf8e68b6f1efaabcc46802b6ef70423daf63ad2bdc8e34b7f8ce76932c3ae743361a2e0c3982ff72225690f831a0d6cd6e9eec6b063f1081f1638e17747e43beb9e1af0909b08b7a37258b785d616ff3d071c61fc198189656fa447475bc01dd78e97c7d098f40c3123ec1294f5d39c374d4672034b39d0915ef58c5412aa8c17b3e851970e8555850cafd43c46be95ce09804d98aafb30d26416ae42af81a3d456cfc0d3dc898c761ea2efb1cbae965afc3001df81d82061b3ab1bee66d75c2ff500143ca218c3216341c09199253f1c2998e17f65696ebe58cd50f584167348d2522cbccf3704773610673335b9c128a4ff625b042238c06065536ee2551863fe465527d900e0004431c51cb7464a1065d8d0ccccd151965fa4833fd32ea908ef4b48fa7fd32fd7bc27102f1e3482b0b4c2844b84e8c9662351eb8c83ddd08e547d07ad3c892ded58e9746012f232327924eed6e95d64dea6a97541f4a04f11e00949c559f912bbce4409d1cdae649c2cb3edc23399aa9a71d674663bcb88c854f1cb901ec388c3deffaabf577f3ffcde6ffdd5cfb2d6f5a1c1388a55c8e6a27d01b08acad36110d971a7d950491f1358e239e33325399472cbe870f44e3d0090084bac9066282660123b5c8cea6d64d91d8fd2acc418ecbc4b21a15d4939b19d6291691465f0723e924307149b35ad0290953089e666132609025974ecf3423cfa82adb6a1f6f9b96d52dcacb899649befef14e2a1dcf9b2656c0a2648f11abe72573bc047cf526c91f27317049888604bcbebd227e5e36bc6ebf4b5b5e1b0eef0420a25caede769ce793b1e385665b7d7089bc252a3a788eab13b50c27b81aa0f6987a0b822eade27d83c951527b7a73a4f622def32740c16107d8a8d7f8f55f0f7f5e1c5efd85283e8efc7bfef4b64a786fe1f46a0f851a8dfca6ad76bfd4d58e65c2d6c066f3be84297898e20498820f990270cc0e87ccf30c5e61f8ea2faafb24f23f6cf3780b45208a85f51b134d0b0abc91c7f758af1d9a147950562df693fc314f784d82c7bcc9a3824f18f8230a025ad755c1c3075eee3768b69d213a912545deb451f5110ac50ca7b9e2327b3a7761aee201b99fe60530140a04462002ac69bcfeabe7dddd2e3cef6e3ea756f99fa1e88d063dbff1bcbbd98cfedfbbc301df850b42739fff8eefcc2ca51995c9a0aa668aa5f3e52cfb2533aad3d270b066b49b8625cf87c62a94b99368fd70fe8828ee8e883404c796497a75976d19dd34cc2ed932066867cbf0cb4d3ae13b49abfac152db8c3cb3e11e385e06193b9b54895fd14b6dd853fd3a9e145c3a60d0b1cdffc722b4ca1f0f63d7ecb703b65aa2aae840f32b88aa91082a68ed967bdea833ff5025ac98c755d9f2128e9cc142c223dd4dbcd4e0da0b2577221340c8736c011c91e3686455a31f4c17f49e3f4515ab13f80d12771056ed6b45e66cacb54d8195b06f50800010da60d60fcb6cab0f23ea55450707ea44ce81f6f450743210910851652374389cb7abecb6700626be7913b35d178dd0a3741cae52142c14fbb90bb27873e586ba81386a3dc47fbe5e87be604ac231f71dac2e834da73fe491fbd2aa33315c9d3860d5b1dd8e97c9fbca080c3faa922742ad26b07898f84d5c5745f1bedae105a1893d783f1a61f5ed7c69481850d624ab733697362aa2e075c43e2fd3aa8ef9df415928a5c55d7ba23ccf01c92a3eb1a1929c155586c88bc3203620898fe1287e32fcf07ab120c1859e2ef36fdd9024791e4ff78886ce82dacb3d7ed4234582eba72edb479fc0f6373db67794499a6224d46f6e2d487f6b38cec9c9855f28702736d1a7c6ca54aa895b7d76cd079bf1271858edc833cbecb6c73da166b372b7bee4f9056414fa6f503c94f7ac27f0d78bcd340c993ae0d4275186085bd6605dd979076e1bc5af463fb8531212d09e8ccd319ee72829ad4006a73118ddf3a7fd6e445c8e4d90d906aea1788761e032786fb0343d395a6f6e661dede981d0654299f7540bb4c667a43553bcffbdae032e1e6c51cb41d738f5b072a10c286f0c0ecf73bfd5f04659e972618bb8434403e08e023eb3c5e4ff01427df5dae3043dc20525a6486cf0e9b9ad8242c6679d5ccde2d9159a08d7f594f27ab9a21ee3bc3c35bb45ecaa785f83fe94cac61199fd890a7b9fb5798c5ce607052936987400fa759644e91a1846075deb52a8af6fdd5a2b7e9d6b4732d26827ab5ee3b302323aa1ec445e5e84c991170d9f8e708081bfc2eeb7e24f0bbf3f22e5bfcc2c48009a1713272a2fb86ed9fdec7689c88bb32cded1702137ae641a5a8152b5db69762a714eb53d158e5fa687c3001c4ded607bd6f99f76c83e5dc71b9c90201346b7e7a5e27f3b5d51e0361654971597bd50d12e8c2fb9a0a299e5d1295b59b9159da93c144b9aa483c4da3321f5e42511f40149c94a67fc04dd4201ebb63c985e7fa502460984e7c762ee4b191564888c9a745c8e8253412a6998824ae04e3c5cc2d0611a00d585bac8b1679695032f4999bf0ec8ae8bb4f22d51211acf65ec49b9ef7d25f53290ce0677a17e8617ce68a4541e6c88d096baf2839722c4263f5a79b0283f446a90cc1e758489e596e765de426e5755b4f90e5118cc758c59993f88b81e78c322be0d330d500bd1e786171cf64930bda12d7fd88184091cd5a521df8e14ce595d571fd0ed9beb247f1c6d909725af4d03d1ec8a2a1342653e4d044320dae66dc1038468c20bf6142c28e4ba025e104d6079c9eb607ae3aa7f987b47e78876e9be9aa4daff15948fa84bc4612ab04ee6f987548a90c00e8be92e9a3681fc665700ed27882cd3aac6d01224a5344e97e97cbe94bb3e0bf93add8012cbc25085a4c929fd8e66066137eea297cae87b2be8afd4ec1274d904c69986e881957b100302856d9899a4b7959de21668131e22a8833632b94db5a9f62dea99925589b733f4a9388b91b5653000987f3a4081a602783c8e769036c3ff51c58eeab2dbd367c39c8a7edd1641e8a8ac9c34fff81eb8b5ef36655c4bc86644c1749b72d04bf19bc5cb5daf21aed630b84f4025c62338bf141bc9f3942655dfdde1b5e7615df60c8237505f14c4b8fe38121a8d4cb2e0196bf9006d7dcef87ca47138c4551f9a396b019960c2fc704a3bf9cc6053c76bb6998609a0bc669b302143bc04573914045ce2309ae465d3b2320673428c1177f6c12a0a20abd6358fd786f936b89b8433654c48a76f2d6e254b9171a6b343622dce213e640973b01a411e4c63c3a882f48743bf449838caf29dc4e20b93a5811b8ea7a2388389d13c4f773ed2d1215c628a1df5ff0b3185607a193581b53b6ac2d7a753138603726a5f7e748c734162bd4b9cd8a0df9fbbf64bc17595f174acaf1028ed469eddbdef46cfce87cb9c28b5323c765845a11bde9a215df96b8c0930493bcb4bc56b95ae94f2c6e967ec8e30449a0e6835dea4f31f6902f97a275ccd413f9a86d7ff6e566cdf56ab7f37abeb9c66612aad5c2e134578c88d1d9dc2a1fee120cc2048304b780b42888814ace7b6da050b0ac990c1822679b3039304454515dfa3a3657372421d648cadb24a3acf7ebc050912e7dcda99a63ef3d43be6974afa7c98d03c4cfacef39d5df43f39e46e02076492d1ee7bb1101d4aa745c8e943686cdfdee97c172c3f1c4c1b30b9bb0a5a86e3730b43042dd14a66cbf8c20c148e7e70ef57fb567d6042ab4f8120a7a641c82f4ce8ee128c057411f412070869bbe421ece4a86adbea0179ded66fabdd6ceb6f05c8d9ddfce1b602f769870268073f54a3f9c3fceecd62a5bb8a6a992c0b0dc4af597e5bea445aa816bfa0167eccf337bb954aaf0d782ff9b070384b5cd3fcddb0c05b56c47bb0cf937fc8a970baa539bd537b96ed76c5d32f7a2fe0dfa9664d4b7681d72018ab0ec7cf0ab404e94e2bd02c96f2e8b83c17e468f3dd30c071216bb33e17d3a83139ba32d49d6037812e119a2a3bbdcd216699868314d3cc2e92ec43b7e18ed50dffa16c31177b1f3db03acbcb392c3aa13770647faa092ca86cb3cc9b9fd9cf784b3c0f6fc531bff8cee13b87ef08382c14ffceb634125c18caff6639650a27bfe1ad5e5d107d9de907267a16984933c8743592ea27d66efdbada970944fdaa1d9192cb29861270ba8f34822b744e8ea888b1015fdc0d69763fa4d932367be27e1a8260158e963dc56c7e2f8e70aee53e39b4d54e051c6222a552112e9672fe6f169e870b458c6fe6af34751694ab61e190fa65a8299e5742fe3b704f71314f6777f48eca2d29037e9361b3fb7946efa9d8f46499789e35b8cb8876af8e0d19ed4edb855bac54494cd9eadbc537f866ceae233243ff03056890da6ff4ed78005fea667d9b31032345781f10db93c784aebf4dd68e4004477a25af8e06a88564fe0d5054c7f9ad4d3bc9cb495bed2672d52640b0899455670ffe9c7c44f21c5932676aac4fad606974f63001cd22257060fe72cada9990644886a7898ee1e46a3b3d44f282944463187e5a847754f8c5e7851f3f27fc381e97dd2a2c692f8476454f308e26c31233155b146116440e07e516ea7230c0e7559d6779c98ab96a3535c157e279e75ad20178644e4c558b01d77786ad8dbc5a831ea7bb3d2d83a83fefc89df77ab1d1abeab8d1da25ce78fb3d08c8bcccde16392fdb7ff2d8b5514fb4c12478fe00522888949c90e2525a10a6504aa52375905275b87f4439a2e4bbe909f96285ed5967bfac841290823752368cd4004ac144be40f5fad53cbe7e750c9812df56bf7972a903948f8e74fd6a9e5cbfb2bbcfe363f0a2867a9c9ea6d7cbde5f5b467bab4b63c7f2332b1d466758156eca2ade0737ba2db8b3deaa4c3a219170375411a1ccc5b3cd77a738f76ba833bf0e07866d447d1d6aed310073554a0fa4acb57eab13632115fb8a4034e8efb84756e489f31441cf8504752add839fab84ebfb7df681f148045a4d4a94f406948eea60b82e3aeb365719a6a7dbf7e2bff27ce39daa3dd9cbfe1a033016e7587dd94886881b8a4003ac55a72cc0f25ac532dc02f748208b08724ee31e2113deb475f56453c6898d284650e1116bd5cc8281d0c14e64559f73fc6d0c61e7205131d5d2fef812977b14a833f7fd21aac33d0f4a15099056d6eeb51d0deaecad1da5cb209135de58d77154e7b183435d75f9e850b5d487877fe8a0080e3630da553bd0fffa500768618f44da6d5d7d98c0b9e9bbbaae6a8c7e911d2635ff7d9fd7bc99a896fe5d83887d2864ebc2531430ade9b309b1988ba1caaa0a7482bdcaf884e39bf163243d17e794c83d3edabeee355602fbf6cdf5f6f5ed08309367aa21887faf8ec4de3a2f9bab69eef4b5839b61e4d45871ed4f08f57781b513f6da09435f2bbaccf4b78d7f975c2f35fe7b9d352dc7ba0fb7908128835c1de878dcaf18752a26cab21e9f86181a93c3e174031580fd5ca3f2701810d505965d5a2e2d5cf58af50d11db0c3104eeec0f786d4c14f62c4e552a6d10266c1053f8e54d865153c191f03da8c2a2514931d0ee9471a34d8636df39ea44ade3d2fef812ea4481fa12ea4483fa2c75a23adb02268cdcaacbea44b5fc03ea442b13c5a322d7ffb8bc7c2c6177a270b7ce5cd1da17eafe8dbad33087eb0b04ee5087ead2223297ef127115b477f9ee45afc7c1db19fa1e1d2a7264db4bfa36b35e35c0182eba685616d541c19a76c21081105a3a3c66695934c8bfe64762dd6e4c45b020bd788292c947ee04c5046aace53de4a9ce3130f519cd740b62a7a174c33f0393055d1056203cc0b79f6fa907b038406644e8ef4936b8206fae872b0ade4edcc59ddc4ecc2312e31de9d82b01345de1a81faeb31b82e42741ef0aa88c9cd08e7e2e2e903e643163919b6a44c6700056538f1d762c62cd079e513bf50c897d994d1d6ea5975e00ccce8574329133e54c1768e09ad92c5226368bbe843c04b6e9bf0708658e507ba185cca23171d6b2e82596318bb4183b9d5207a04e26d401d6e68eba2edce5c520c96e24534d9106b09009669f99c6d525172945e75896230f077e2d8fae42f7ceb83c3c8319b334cd3f5a7376f27ac4836e3ac8a5525b5c82bdbcfb6f79bb1522f0c7aada29580ac5612a9400ab93d1f6e52e57677c3b082deba854e7fc3b0341fe69e4fff3ddafefde8748009a887fe12c45fd52212b2b5f347cae04d0a0170c80209f3de6c8433a364e4a2c4f0615bd94257b0b2a5c1de90158aad3106de4a9234873ecd9d9e38388def2141621bcd3b49bf72248a797c99eae9595708947e80d3933428f86bd2b166eaaa6ade6e2d0deb65b7dee9a9ca60f0f5dd89a3efaf1216d2bca7279505fede8364c7dc9214b8719f571138690cd6e16264668a553cbc0c390e6969abacc36ab3d0fab51b0835a27ffb6bde61d32fd1e823b726b8b4c45f0cbf3925957f62614c8aea637c156d78b929903cd90f3368ce7dbee60393330df84993a8b9ede98db0a863261ae57aa1bdaf3dc21e0a05040809c0775cd5971fa0ce72b3447b31c4ea5e8bd3196ee66c8081974ea4c459ef1dd9f35931c6c73da2119e656045b3b62f68ec724e8e4cc0bf7ef5d775bcdccc4989b6aca1429741081877d3a2c3c6f946945283b9e6fe7e3eb767495b880ad9ef6859f5f4291ab5538e9d6b82a5ecdf2733c17d1754cd1cb8acbaa5eb4b394fdcb5e4b69764f4a5f5c7e29457824eaf1db582d51a87f1c0e9079a83ebe17ab2a5e6255a7eb726f99d6b201311dde573bbb359c665b5510191238ca8743ff980931a2785f68557446675e66c328bcb29dcdeb62f25311afd78810b7ab34acd42ed08fa6bac9e4b274709f3ac91f78d98c1cc7f5e22496552d4ea4904909652b551220b9b1d0f90c3a2d071d721c0ea746cbad476c4e24dfc55551b05dc34f4abb4f4babd3a4d742457e755ec88e95bc00f74a5f708a3d2f56b9e35a1858ef3458d8c1cb3a907ee74c5d23109b96ea3a06a1c9e130068802ef68f748e0685611f749600b5d6420d876932a8478088107a6f55b900e92a17cb22fb517f2f24002e670282ec287301682943f343ae082baf087094e1a1a3211f6a17ba7883b49adab6ddafff763f6c08bb7ace178ad8c7b44b97e3b6e0eaf86b8289cf521b57da7aed59cf0285f7fbb10447600af179b75b6b990a77162db0c2fdb5ede373af7a223e0729cd69fb86f4e7221a8aa410121fd14a0de8a8fb3e828a30d621c7939ce5537ea559d3e1bbf90f7ba649753035fe0fc97f06a62f3aa8308d071415ec687c9453e74f94df9e63dc2accfb157ff810dd79cd2535e3a5f5fc2a8d2b0fec0cb824b0826a7466f42ad08308015114e63425dc3cc8cf8595165dd7bcc3c3375972d34ddf47244c600ed620cee5365bac1c5c7024fdc6b1c066b3ee7f940583dcce105aa747471b7d27d82254281528460f63b4849ed8c20cd308707de72f378d534b3f60628da3bcfbb936afc6a9c1a6b0b6288ae66f9ec4a98b5103a8fc7b67302cfd2add3c1bed02de13dde4e3aa08dd373999ac9e2ed69b354ff9e44d20a0501c681746aa38bddb3abab98370d1d111ac25015b739455a365919db3ee84cd8afa12e64ba8d2a944a516595f7ec413722267fcd9bdd9315d74a5d2bf5927dacaf6b85bd4329c175e2efcd30f397293a16e36759ec704088ccd0c42fd9e3a4c827b7f0e09898b1543c4dc8f07aa3109027204e910c8bcb9306cb64af795af366ab536b14f57b6f078e45ca6e7ae6b70274e22847137bbdd884a15cb4159288c323e02a3684962f9ad0c8c511b1ea7247382b40fc07b67b8930ee2442e2ac0b5ce8f8cf57be4a1ce6908288b9f1185210bdfa21907470d178bd86bf7102afd754bb19a8cabcf94dcc1dc73d9684b8035e00170c6aac9016a17cb3391c60f58ec46faadaba2204f91946c8ae179b399c7d1c878eacf6261b7fb76fb6820e70b84b634d63abfc663378504331c8291feb24eab3b1905b34d60b6c2959201317b543052bdcaf03477c3e02c30446347fa8399bec7c39551d09b4f706cd40eab2db30d6564c3a0d313c2d58b0a6c560eae92b50dd699a90d4a9e7b137e2164cafe7892e705f17deb154777533b8ab9b4e4378c4d7f3d86d98881f789aacb3d9cde67010c0e1e700181f1addba6e7814eb088288b2fe86914aa3f957d9e60576564d3208d5479c7d8351975adbd3809d693d254080468a4023d1ab59bf198843537f05cf97e1d820a5ce623bf565c64c4e1ed899fd89933079f9d11ddcc732d6b2a666a7209add53efe4cc942fddcf2f61271a602f0ec0753d3ecbd033ddc72cbdaef2b2a967da7e5e404e39b32f79bbd88cf4698f17470a14eb2ea1c535672d57a20423f347f8e05c387cfe8d47f779db392101fad02b79572688fe54fdd76ed3d96f1c6aff61d755ddc7bb329954bdb6ed49304773e73f865b131101c9e537ed5301cb390d43f81383695ef24449a4675e2641b48e3747a5c1a637aea2178b3fe6678543eb7a7aa3a4eb522dc27977ce0020cf71385d1cf5554dbb1c9294133b7c3e0e8b68f427d6b114a7ea1949f8136f16d0312061e4fcbda1ff070000ffff030038ff2d66ed710000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["bootstrap/js/bootstrap.min.js"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ff7478055494ddf7f50043a3a0d2201d82c0d0dddd03d21d430d5d4a0f21a0e89002437723a1a0d2dd201d221d43878c74e8876f7cff77b9fc3d6bddb9f79eb3f7dde79cb967d6ac274a4b53e90e1e251e0000b8a3a22cffe47696fe3570b06e3fcba01e8b00009e0c544e4e4b454e8e4ecbd3cd0eea6c0b0028dfc94cf6d1d599257d31d22cd43c7a9f818ef998d21f976e5c4c9904a81da512a2851e19cef24c1e5391f81e9bbe8d6e1b9ad20b06880231fef330eb195c1491564fbbb6bc027201ee847b5821f07d31eda6d7686976f3d3ccacfd61d04c0340eb3a0cd8e16d8816c29f6b487cb0db6e7affc160d7193a3911da5d43b4b6153b3ba0d2b93cfae475b3446a6a89724fcde90157884de31c47ab168bd7f4cfe078062139262c009ec294a0388c850c899ea853d34dc1fb1338709cc4c61bc9c34b9a8b9bf79661adabcaf9b478e371637f0a82653d97d26898a2893f0bdea8b598c6bc920f07e69aa6302fdbe70762db36ebaaa40190678f2626ce33d401dcc4a916439b2cb9ce51bc0ac41407512874004e62974893e7ddd717220e5900df22fbf557c02815eba27b332950e4569fdde5518dce8535cfe3c44e8d9597beea23c7b50431dc6421556ec90a68cc462ea90ef1fb15d4fbfb46d31b0e17adb34b34309d1b893eff2bbd2396bd912c197896d192f3de5b503329e094c938fd614d4b921ea0d6054b1ab9f2167c48074061026a1733bed3443a9b2774ad12b0e2bfdedb73f642cb76e27abc2574951de636d01a2972963a7870a52f56442615caa842c267ab802c2a2e52931f1a22b88b18b1b93923fab9d62602fddcdfc99191dd467614b1a5a7bd95881b1ee5d018211e6a18c76583171e3cb5124c2ec88a4d01cb742276786a01088a10d1aa53c1ee739300c8316230b94af9a307ed9a10f1e0f5b5343954c45779c5f2faffd8bca4c5377de5baa22ec3a0b3714a106be3c99cc74191bf14eedc8e7e3f0edb3b078f3f948b1b9dc3f20e75089f3bc6221d203c4499082319834b191d2a0d7c2f23f9e5f60af27c417bf6dcd11d3019ea3f0ad40c1374c732051ab4dd71c727085192c68b6c57e3465b0c85e2847d2322c993298b232d204c959d26a232c6f19393d11226c6e8968b8a234a90be18652921677f5e962ba72571cce84dd98063f6fc32077b948e1feb062d28fc5e0afa84d59d2f808f21b3d562d4384a1d83cbf2be2419d8646d732baa75a492773f7590ad507d0370445874bcc191eec3c1185334c4a13a92978d336442bb47ef14cf50805eaa901677b780aa3e5a39de631d03eb45ae550a39690fc5da0b23f88ab5bed5b4b52af7540a24455f9d6c43d08e882ff422acd07aea5d9121eb5ba967b882f7d2a339bbf42d632d4daa11ef30be58ed8951dfed6da358a57687d5919bf2ba0a54023f11cec01b43763befacb01cf936b12f121f8611b67b7db30ea46fc10ba60ea6598aa5ed69658dc335063a8511d227d3313933e91251e26ce2dc84dfebe0d70e2d017b334412f975995ab3bd8bf922d3a442cda2f98658259289ec09135b6a6da8ae8a2383198b044b33fd9b7e511b254f72236973702553369d053361829456e2231662b02ab582ecaca6886abdb49fb49f5ce1933b25ae938665290d99c6e066f920d52090d21b3ec477c45a7fc59bf278a7a45d440033387106e183b0e0ca66b5a00f56fd51745d87aedd2eddae139a5fad9fabb852db2111a1a290969764fdd23acd3acafaf9aa40b73673534268c2a7fbe052e9edea71e6427a4ad98942bff1ba5a8d5ad1afb65fb91f2a09155c4dbcd9ee3ea6dd6a3ec6b565b635413e425223299135034fb03ab82dcff99e5014c2dfc01be1b1dff937ea47d646e604aba0e63f9bd8922ed85dd903931892ec92d09262cbd4f5dca688a732cb5ccac093dc8e2c8e568eb38e57535ce5d123c5233e59239916fc3fd7af5fec543744f9d75d3a9e74ee48ecbca14ca36ca1a4a0d4a344f0f70850a4b9a6f5a721d336f97b6b1d8cf13f008d91c69b1fccb993520f8674ed986b5e8be45654ee17738e49162c944b54404a6a0cedf3356b861f9825709908c97c32fda4e3230b560319822caab40f7325b5b32bb20b5a955a4d8207da46e9be5abe96496f2fc97d95db6a956595bf02612c8b2f1f63cf37ce17eb11867cf4787524b966c2521d95a992a012ddafa1f3657251d7b261a47684c069c38185bf5ca0b137af7adec8e8ade2748e48a7785f60be5ffac5637c89d786af334917482d38f63974387739688dd88c300d5d6a77aa2f3bbf76a5399941827a231dd72035c3450b1b1f2a4a39979eb5ee0789a599552f90366a368e9cde3d65b862b9e2c19678e1fba2f9eed917ef678f1ac383a6eeed6eadd40d1fc1d7b0590358df4da8142f651a0db30ddf19cec72720fb4636c949ee39e6b15247ed19cf65c305b7f075cf9dd49b4b5a33debd1cd29889de7cd6e5d2b5f04562f6a6eeea31a11a61bcd2ebdec4e2379b9423f8e62f115506f106559f12ceac0f1d0f8b254525a324a3251fb43c6b290f029d5bdda0df3cbb5cf83ef36df372e60a0c1406bec5ddc22b002a02f5b125f17971ef633e0576b6a3b53786d182c62d984c2749d165afe59e4588e30a1098915c7a917371f0bf65d9f3abd3f385f9fa0039ad15e4e5ba1a9563fbc6fb945e4793aa91fa28f62b752b4c29692804282014d410861c4635eb8a7063385d9104292d154900d333cfd2b3f105b5176b7bab786b075c7ca6c9357b5f3d3f204b07472ae32cda8d3cd869a8681f2e11fd8c7ad0518a54af3cb6a8f7410267de34beb84ad8ec79bc197d64766e7b1d0f4484bf0f1f934774994541542a554854d6d0c1321f969fbeb74085ae9726c625e6b3f1958e957e8686d79ed74ec8325bbdaa7e6cc627c1da0ceae4cfe7966b18804e1de83dc8931e8b577fe46564d79812f343fd4419ce94cefcdc08bfc6f1fda2bd926df680f6d0bd8de3f5b475d7c187fddffbaf600276023e4fed5b9efd88bc6e173870ba5b11315d693a57afe963d6deb072d2b21beb42e7a23cfb6d507130da966ac9f5d5e2a4768e76758ba543cde1468f6cbdacf64a33e7b0c95de76e6712e7fd05bb9a0ac7390483f9d889f5e0eaab5530f37cc2ee925190c1c5c2d069f87e73e169e195cff2331c9ff985ed86ef86a9d646e6b3a395cb6615c821541eea53a3c15e8bbe958987252134d626b696b7e47db36a95a3d254f14ec14e28656cda46f34ec0a7fdccafbd7333ad4b2ec11368d118ddd89234c4d44dc17d675a4e15322edd56f32b6f3b4776f3761a5aed832d82e5f7958fe2479422940a7b3b629f4e894dce089508250a764427f4bc5bf3e875bb593e910b7850beadc5975f7f0e14a617395aed3da73dcc22109ff57b1554b6cd7a937dfa71d4f89571eef4fc74d4c7024168807ac0d276c844e6c3e1879c52e93f038fe1a7a9693503f66a23f1c32419e922d93e30946c4fc4d1eb28b217a206de920db5ebe39995d5958b83a1882af3a37acfd2b9e6ab6b3d44b97da5889958506650f37ee44df4344d6356b2c51e0cbafde1d0a579dbbde62679ad22a6053368f3d2ec0ab478da10848cf9994985f11aebf280ab25e0a7f9bcc476d0e2cd187629a1b9d490bff08dc30d646f2d8f150fcc9e5526557133753e6357da7cbc9733633952f3d23e36b24e0ef433e9a20adc8939523af258b1567147a94269047e015ff07f77dcfd6d108e98588b6865fbf1f12c69ac3c1be673d56c6fdddff5cd696c37554a75b1a445e472fad465d3666b7dc2077a5854fc6ab1bc45ea34f426f70a72dc1bbfbd073e072765474bf904e25ea37c595cec3696cec2bf5ff18a1ac16eff53e2ba2b1b7901005c8cbf069a6fa1d4330000c804d535f436d4501785b8b97059d9b859db72f9bab8037e3de2bea2b74b175b6f2b3a5f1767572f515f0986bf10a2b7eb5f661003dd5f106f270906430d2d3a39374f5b3a012e7e2e6e06493c3a3a3a714f1b3bd127f28affd06f77120c0ededeeea220908f8f0f970f1f979ba73d8847444404c4cd0be2e5e5bc45707af9b97a5bf972ba7a31fe7dc8bfe7c8db7a413ca1eede503757ba5f7b2b6bb7a7de120c0cff60fe7efe16f286dafd9f92abd73f69dd2608fae501f1707183fe44b3f585fe0fda2fcfffa4d940fe3fc9fda9a7f35f39d94040b6ceb62eb6aede5eb73c9e3ff26e0bf767355f2bf7bfc524ff8f25fe2b72d127b65e6ece4f7f9540cf15ea2dc9230efa93f977969c9b8bbba7ad97d7ad5f52e01fca7f6dbfe30dffef3c493e6eee7f18ffb5fece007b426f53b5facbf76f50ffb5fd8e37faa382d19f157e155f540bea6beb6c280fbd2de95f310bf28b83fee8f89d28e7e6ece6a9e36e05b1fd15d9efa63fca18fd2f19a33fcad84044bd9e5a3bda42fe5bf97f6fadac953de8bf60d01fd1e2b7774154c3cd066ae7276fe56d2bc9cbcdc3cfc9cdcbc92da4cbcb2bcacd2b2a2c240efa0df31b5bced3d6cadbcd53d7cdcd59f2af605d7e6de9f8b878fe66fed7ff6f5b817eebabbf9b16f44fd74ae2dd32fffd0590c4c32e0fc7000008a02af232babe68fbc64ecec433249235e08019f2f4137cf79010bf244122d92480022e341c1da94d8c5b5a91a6bb935d56a68bc9ec984e0b2511804e5cab5029943ed16356a5ed8f15d4639455f3ab8dd325c60b27957db28836f7a5c7ad6a66772173ff2c53cce9da706fd6acb72ca24a424c8cef2cabd76758e26c9ecf69e1fb40b08f49f20715d7ce64cde2a5a3f9ca8189c5c9052f2a4ad8cb011d0041e89a60530a2a646e744b4ef787bc441be4ceca49b0df0a15616911a6c4fb679a861bea67837b5061d51b5ea734307b87a44ac1f8380958c58483d9de64d5b3a81e1c33dd5bd0f29887e62d7e9155c5e545bd778b6ef20c71bf0102319826427f3486508ed2077fef9c56e3f8781e33e70faa40ec3dcbcb052f8ace31078cf8a4c3be3aad4aa1d07318d6df643ab1afe75e30c791ef1862c9dde82aa4da1430bb508997e81ba3a193438f3ddb6419188ad35751f3b976224bd352475f9f4386792c617ed2b00fcdfa34c6443dee10db82c432040f335a689696b9781df86e0cea18332cf163b4c197ad9ab7a27455524f1e53ede06c747dca00a4986c202c9cd339f5e80913ab154e4214dd9da7e03d023a6dd36fc648479c1b6094ac59a58176feeedc4a26a8a14bc7d9a63aef8e7bee0f813738db23b0164555f45a3a336a6c4e70d06889f8a199e3ebcecc37e35fd3135b6c8ccbd4029f3c381d783881711fcdfd15b198e88d886631d130b8a23c2ed6b50b69ea94c1c45a508fb53ce893a4b24090cdc37e9c83f2b672942d3f10996174cbd87e82f95ee5003384709122011454a49bac470c71d4f2827747d125d06c8bdf482705ee57382584cda8cb7b6102a29b5c70374a06f670a2a35cfc9607eace06de09faeddf0c6b230b65839b89a6209b3b2ff70cf5554fa69c841594bf766ce651c0ea83cd0def9aee51c81c8e9120dfd7f20aafc3c49267b29be678d7cdb9c82a01863c35e4bb8d649584e5cf4525beac8a479e068bd03ed4b958416ff62dd073540b7a5ff411a70103e84b8cc188a60c8149c04352ee8420a7648cc484d6bb888fb17ef6644dbaa344cc1af1c27c1850c5f06ccad5986edf7767b26e9a04b3149dc88b33e37bf2f7d473e5296b27487305423d0542707db77de4a86ec2db11e6f6c53a766fd36c1c5a4d2b4dd1b44850e3f20329c9338a6e2153aec71bf6a1455905fcdee91fb2f53f49bf6eabe6292d932515c95b11c1e0be89cb2955af632fa847a7a3e65e70406bd8d348866a047c2759d685b446a151bc1a6e70c4881ae0003cb290d7f8cc0e25e26d615337b7b609f563caec3908671861cda8f2c63cc1c83058d2e6c0064513183296c29b6a76755f5037513fbc00f8547ac387080414b52066a2a351e65a965e986d0280109c420211fb0f47f00013d208e428d2dcef6c6def159c65696a38fc9bfb5cdf93354c693aac3457b1d5ba429ea6ecc780e18fc76ae39a51e0a68194ac328a874cab03df3a221dfd6e9632dfefda341eb36490f364e8d0cc20159685fafa60a07942995154cab20a7ece8ba1478a70ca491cdd80ecc62febfe7cc70b1feecdbe8fbb906244a364d2999d7c20beaa3079a066f4fed09a98e65ed25a344fd4291956cf41d5d0c39798c74dc4f0b6f39c5043210ac5bbd9b1d20bf26524a17e6b55209d019ef4c7847449339a4f077a30bb579919ed3ce4d12c3eb37146d9a85efb9e463458cf3655cb1f66876e52571c26059bef264d7c3ecaa869b4e1658b82015ce3b0c43cd724812f3a53dbd9421303e8f2bb104c950b8233007eeb59ffd3e6677e8e2ea9e775f743eea5dec7d4c4c882ba89a99075874aaa319a173417a24e1a0799b07235cd9578d68dd563d012f1bffc88612e4a3dcc292b7f2ee2d71751c84230ed5b908671f745ca9d117759fe08aa9a7aac90c453862497d8595427f60033b5b45d5fba28664e0b23203437a2ba7a4218ef5544a9cc619d8f9df4413a0971ac3dd646c91d3a6bd415a1283208f0a6d9a39995f385b08cbe864774f0e787db34eba5e61db170abf73ad4b099a704e4e2d5ae8ad259753ea2a728190cf45459e8602ae531d08c5304079dcfc1634abf3742f69280d6fc6d294d15d9511e18e697671623d48555131f473ba2c7e37575b5cbe069c17986ae9599b26ca40396a50968f4eb13162d98b3cc78836d5357edff3d5a8213356ba4f0de038673d296131ed732b64af93197ff2779ca23d60983e879dfe1a8043b3cb8b1d580aaa3968939f20d4268aa8f566a277e93f0d316398a32371c2352e1d09a43164cb599851ee6620ed012fe0829d93db25c8419e869e9b7371b7ce648687849eb31fea8ca09d38c78cb909b75f3112b16b23820ff2d03a93e474f7c05be1ca044151658e70700daae07968ce774bb089796cf89e5101c2006cfc2007ddc3b2f047e620a6dd08fb315ca54bd2dc1ed1b48cb997f9e30d3c4abab4d0c5c43b3a3d65ba61632d8e103580d7658ebc633a25063d30417a135ec1595e063de4ff87296a680be9709223504b02d5575c245888283860bcff806433d551b70197768951a54f4b82def1b167bc407586f71076a635bd3b93681226103be496ed7a60b2cb906dc97bb6a68ca9382adeefbf33307b75dc33c97eecc5e6e3cbdb8973c719798d721e829249392208120098061b2a08400d3d293e94d7d262f26c3de89ee10b7940590bfc5c598a39924bafd1a1462267ba315acfccbdac9550bc9d5851877a99bd06d1c52aeb365423c14eef5890fec8aef105b3e8c9d4a75ae10499b7c2a3ec72774765eb485fde2ebbacf5c7901815bb2b76a891e051de711fdf8489749d18a5b1c9620c4324fa7841bbbed875d628fe8a2cfb47cd7883f0ec56a5f0698f1b32d838e72bed05eeb246b2c473b0d869dc1f31d456c3fa0ef4877f9c71540c9b45db31e213981b2e47b381e0057d0fbb4fd7e35514b7948032c2a475590ab02ed2854d581438be61b60d8437d18c5d128aed1c886c158c4a8461f06a7c030aeae1947d019eed425f3917c7847649027d88fc88d10a4e5703d0e91cd197954a58f8913f889bb561bca7756cf64ac8e83e70c41ff964daafbfc11f14f3ae2dd3376e604c4e7d7c5247de636b8092c09d42926aa4b21a30487e48b9318a3d9657cf81cb5d1b86d30dd8049d90102bad1136e7a6760e943b5ce8e571e2dd1202303abe7f2848c002cec479844d651899335f705dea67ed2ee21ef7a05c18342d0db5c76bb6312b198799f8043da768a4a0bae8f571c57c43a4da91075f6d306aab8913b77ef7f25dfa524ff71ead1da55b3b7abb1903858e46513a654892e7cd30b0cb93197802eaeecc2bd9a8687f44c06aed9664fba8d505d5f554bdb03a4855c98cd6b9b1a9e7e0c140d9bc44ecdd6c67cf54610a766bee58af4906a729520c15460395a26d84a72597595f124c87359355bfca519ad4cce0951e5e4e3fb5a6dcf5dbe7d6c60986da923bdf147ca71c4cf684bafb47b7f2417eb7ecf10cb51ca91f0f49ca75bb5a0334a5e3681ad1230f955fe07816f700bf504de930d51af83981ada670993a93af72d35e4cc18c2ce48399e23274cd1a43ba4c416ef189cf505368d8f5bbe24cfe9d616cf2779603821f12870de820aa0a510177bd122029f72984d2cd0127ddd5d075db5c91bfb4c6131e65c3f6f3ba8d6db3084ac1e2125866c71ea66d028130993b49ed5e3f96d3ba49beeaaee3c05be2bc48c4a4b4fd6912920d0fb4a360d4660a67cbfaca47df07275827da765dda136cc3963a2962babd396df9767aeb8b79a0600a8ff3281269ef6c5775dae493ff8d23d59232ebf7f5a8119755ec6ed40ded2a4a1f4ede2fa3e8e257e0c7352f462f5b690c1f195216da5b574a432b6eae7d8c3c338a9ccde2be9b490c7e89bc024644601031070c687a641df26e0d29b49d31cb6a4677f678d7a373ee88bc4c1fc92a027768a55cc77b4b7ca0bb8a3f4bece74c3056ca95b0843b72af97c09e1bcfbb14b655d7691777e0ce102eeda9c20bb210ba8fda04ccf3c5c11f44c72bc9d5d76182b779940808e08f5f95aa095054cc08b3c76ec4c57a735d0c9a251fc2c96eb46065369a12cfecc4d6a199fdc717805403a451cc76f2e73bd42c391d9e8520969730fe3f093f2abb6287b37b3c40d7af4b8ecfa3d91884c1f613f11b11e1036aae4b1e19cb3ca1129e80d619c4a896bb5888654f80819f05d2f093fe8062927a03e27d1a7bece5eb5f26423442a81a9b915edbab63633acdbb60a261f5d2eb6de978a609391a961029c854b6b689ba405b858197fde3d72d0a202d905f162b79ae03c270ceee81c0f60d5c743594b7b4fa04e439cbb4abfea71b7a449bcb4a74a389340e525b8923493e46740da2a7034a6af7400eafa83d188791d1a6e73654d7dd1dbd98853c8dc13ecd7e64b2f62b4adeed766f361deb75f0289b616b16e53b826d3be9ad9cf1e98eb99ef5f7d157cdab626517ca812f3eb55848a82a67ca5ac65d8ff030000ffff010000ffffa1b0e2c9341b0000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ff00341bcbe489504e470d0a1a0a0000000d4948445200000040000000400806000000aa6971de00000a41694343504943432050726f66696c650000480d9d96775453d91687cfbd37bdd012222025f41a7a0920d23b48150451894980500286842676440546141129566454c0014787226345140b838262d709f21050c6c1514445e5dd8c6b09efad35f3de9afdc759dfd9e7b7d7d967ef7dd7ba0050fc8204c27458018034a15814eeebc15c1213cbc4f7021810010e5801c0e166660447f84402d4fcbd3d9999a848c6b3f6ee2e8064bbdb2cbf502673d6ff7f912237432406000a45d5363c7e2617e5029453b3c51932ff04caf495293286313216a109a2ac22e3c4af6cf6a7e62bbbc9989726e4a11a59ce19bc349e8cbb50de9a25e1a38c04a15c9825e067a37c0765bd54499a00e5f728d3d3f89c4c003014995fcce726a16c8932451419ee89f202000894c439bc720e8bf939689e0078a667e48a04894962a611d79869e5e8c866faf1b353f962312b94c34de188784ccff4b40c8e301780af6f96450125596d996891edad1ceded59d6e668f9bfd9df1e7e53fd3dc87afb55f126eccf9e418c9e59df6cecac2fbd1600f6245a9b1db3be955500b46d0640e5e1ac4fef2000f20500b4de9cf31e866c5e92c4e20c270b8becec6c73019f6b2e2be837fb9f826fcabf8639f799cbeefb563ba6173f81234915336545e5a6a7a64b44cccc0c0e97cf64fdf710ffe3c03969cdc9c32c9c9fc017f185e85551e89409848968bb853c8158902e640a847fd5e17f18362707197e9d6b1468755f007d853950b84907c86f3d00432303246e3f7a027deb5b10310ac8bebc68ad91af738f327afee7fa1f0b5c8a6ee14c412253e6f60c8f647225a22c19a3df846cc10212900774a00a34812e30022c600d1c80337003de2000848048100396032e4802694004b2413ed8000a4131d80176836a7000d4817ad0044e823670065c0457c00d700b0c8047400a86c14b3001de81690882f01015a241aa9016a40f9942d6101b5a0879434150381403c5438990109240f9d026a8182a83aaa143503df423741aba085d83faa007d0203406fd017d84119802d3610dd800b680d9b03b1c0847c2cbe04478159c0717c0dbe14ab8163e0eb7c217e11bf0002c855fc2930840c80803d14658081bf144429058240111216b9122a402a9459a900ea41bb98d489171e4030687a161981816c619e387598ce1625661d6624a30d5986398564c17e63666103381f982a562d5b1a65827ac3f760936119b8d2dc456608f605bb097b103d861ec3b1c0ec7c019e21c707eb8185c326e35ae04b70fd78cbb80ebc30de126f178bc2ade14ef820fc173f0627c21be0a7f1c7f1edf8f1fc6bf2790095a046b820f219620246c2454101a08e708fd8411c2345181a84f7422861079c45c6229b18ed841bc491c264e93144986241752242999b48154496a225d263d26bd2193c93a6447721859405e4fae249f205f250f923f50942826144f4a1c4542d94e394ab94079407943a5520da86ed458aa98ba9d5a4fbd447d4a7d2f47933397f397e3c9ad93ab916b95eb977b254f94d79777975f2e9f275f217f4afea6fcb80251c140c15381a3b056a146e1b4c23d8549459aa2956288629a62896283e235c55125bc928192b7124fa940e9b0d225a5211a42d3a579d2b8b44db43ada65da301d4737a4fbd393e9c5f41fe8bdf4096525655be528e51ce51ae5b3ca5206c23060f8335219a58c938cbb8c8ff334e6b9cfe3cfdb36af695effbc2995f92a6e2a7c952295669501958faa4c556fd514d59daa6daa4fd4306a266a616ad96afbd52eab8dcfa7cf779ecf9d5f34ffe4fc87eab0ba897ab8fa6af5c3ea3dea931a9a1abe1a191a551a9734c635199a6e9ac99ae59ae734c7b4685a0bb5045ae55ae7b55e309599eecc546625b38b39a1adaeeda72dd13ea4ddab3dad63a8b35867a34eb3ce135d922e5b3741b75cb75377424f4b2f582f5faf51efa13e519fad9fa4bf47bf5b7fcac0d020da608b419bc1a8a18aa1bf619e61a3e16323aa91abd12aa35aa33bc63863b6718af13ee35b26b0899d4992498dc94d53d8d4de5460bacfb4cf0c6be6682634ab35bbc7a2b0dc5959ac46d6a039c33cc87ca3799bf92b0b3d8b588b9d16dd165f2ced2c532deb2c1f59295905586db4eab0fac3dac49a6b5d637dc7866ae363b3cea6dde6b5ada92ddf76bfed7d3b9a5db0dd16bb4ebbcff60ef622fb26fb31073d877887bd0ef7d8747628bb847dd511ebe8e1b8cef18ce307277b27b1d349a7df9d59ce29ce0dcea30b0c17f017d42d1872d171e1b81c72912e642e8c5f7870a1d455db95e35aebfacc4dd78de776c46dc4ddd83dd9fdb8fb2b0f4b0f91478bc794a793e71acf0b5e8897af579157afb792f762ef6aefa73e3a3e893e8d3e13be76beab7d2ff861fd02fd76faddf3d7f0e7fad7fb4f043804ac09e80aa404460456073e0b320912057504c3c101c1bb821f2fd25f245cd4160242fc4376853c09350c5d15fa73182e2c34ac26ec79b855787e7877042d62454443c4bb488fc8d2c8478b8d164b167746c947c545d5474d457b4597454b97582c59b3e4468c5a8c20a63d161f1b157b247672a9f7d2dd4b87e3ece20ae3ee2e335c96b3ecda72b5e5a9cbcfae905fc159712a1e1b1f1ddf10ff8913c2a9e54caef45fb977e504d793bb87fb92e7c62be78df15df865fc91049784b284d14497c45d896349ae491549e3024f41b5e075b25ff281e4a9949094a32933a9d1a9cd6984b4f8b4d34225618ab02b5d333d27bd2fc334a33043baca69d5ee5513a240d1914c28735966bb988efe4cf5488c249b2583590bb36ab2de6747659fca51cc11e6f4e49ae46ecb1dc9f3c9fb7e356635777567be76fe86fcc135ee6b0ead85d6ae5cdbb94e775dc1bae1f5beeb8f6d206d48d9f0cb46cb8d651bdf6e8aded451a051b0be6068b3efe6c642b94251e1bd2dce5b0e6cc56c156ceddd66b3ad6adb97225ed1f562cbe28ae24f25dc92ebdf597d57f9ddccf684edbda5f6a5fb77e0760877dcdde9baf3589962595ed9d0aee05dade5ccf2a2f2b7bb57ecbe56615b71600f698f648fb432a8b2bd4aaf6a47d5a7eaa4ea811a8f9ae6bdea7bb7ed9ddac7dbd7bfdf6d7fd3018d03c5073e1e141cbc7fc8f7506bad416dc561dce1acc3cfeba2eababf677f5f7f44ed48f191cf478547a5c7c28f75d53bd4d737a8379436c28d92c6b1e371c76ffde0f5437b13abe95033a3b9f804382139f1e2c7f81fef9e0c3cd9798a7daae927fd9ff6b6d05a8a5aa1d6dcd689b6a436697b4c7bdfe980d39d1dce1d2d3f9bff7cf48cf6999ab3ca674bcf91ce159c9b399f777ef242c685f18b8917873a57743ebab4e4d29daeb0aedecb8197af5ef1b972a9dbbdfbfc5597ab67ae395d3b7d9d7dbded86fd8dd61ebb9e965fec7e69e9b5ef6dbde970b3fd96e3ad8ebe057de7fa5dfb2fdef6ba7de58eff9d1b038b06faee2ebe7bff5edc3de97ddefdd107a90f5e3fcc7a38fd68fd63ece3a2270a4f2a9eaa3fadfdd5f8d766a9bdf4eca0d760cfb388678f86b8432fff95f9af4fc305cfa9cf2b46b446ea47ad47cf8cf98cdd7ab1f4c5f0cb8c97d3e385bf29feb6f795d1ab9f7e77fbbd6762c9c4f06bd1eb993f4adea8be39fad6f66de764e8e4d37769efa6a78adeabbe3ff681fda1fb63f4c791e9ec4ff84f959f8d3f777c09fcf278266d66e6dff784f3fb323a597e000000097048597300002e2300002e230178a53f760000042469545874584d4c3a636f6d2e61646f62652e786d7000000000003c783a786d706d65746120786d6c6e733a783d2261646f62653a6e733a6d6574612f2220783a786d70746b3d22584d5020436f726520352e342e30223e0a2020203c7264663a52444620786d6c6e733a7264663d22687474703a2f2f7777772e77332e6f72672f313939392f30322f32322d7264662d73796e7461782d6e7323223e0a2020202020203c7264663a4465736372697074696f6e207264663a61626f75743d22220a202020202020202020202020786d6c6e733a746966663d22687474703a2f2f6e732e61646f62652e636f6d2f746966662f312e302f220a202020202020202020202020786d6c6e733a657869663d22687474703a2f2f6e732e61646f62652e636f6d2f657869662f312e302f220a202020202020202020202020786d6c6e733a64633d22687474703a2f2f7075726c2e6f72672f64632f656c656d656e74732f312e312f220a202020202020202020202020786d6c6e733a786d703d22687474703a2f2f6e732e61646f62652e636f6d2f7861702f312e302f223e0a2020202020202020203c746966663a5265736f6c7574696f6e556e69743e313c2f746966663a5265736f6c7574696f6e556e69743e0a2020202020202020203c746966663a436f6d7072657373696f6e3e353c2f746966663a436f6d7072657373696f6e3e0a2020202020202020203c746966663a585265736f6c7574696f6e3e3330303c2f746966663a585265736f6c7574696f6e3e0a2020202020202020203c746966663a4f7269656e746174696f6e3e313c2f746966663a4f7269656e746174696f6e3e0a2020202020202020203c746966663a595265736f6c7574696f6e3e3330303c2f746966663a595265736f6c7574696f6e3e0a2020202020202020203c657869663a506978656c5844696d656e73696f6e3e36343c2f657869663a506978656c5844696d656e73696f6e3e0a2020202020202020203c657869663a436f6c6f7253706163653e313c2f657869663a436f6c6f7253706163653e0a2020202020202020203c657869663a506978656c5944696d656e73696f6e3e36343c2f657869663a506978656c5944696d656e73696f6e3e0a2020202020202020203c64633a7375626a6563743e0a2020202020202020202020203c7264663a4261672f3e0a2020202020202020203c2f64633a7375626a6563743e0a2020202020202020203c786d703a4d6f64696679446174653e323031342d30322d30375432323a30323a38373c2f786d703a4d6f64696679446174653e0a2020202020202020203c786d703a43726561746f72546f6f6c3e506978656c6d61746f7220332e313c2f786d703a43726561746f72546f6f6c3e0a2020202020203c2f7264663a4465736372697074696f6e3e0a2020203c2f7264663a5244463e0a3c2f783a786d706d6574613e0a07ab840300000c69494441547801ed5a6b6c14d7153eb34f7bd7189bf50b708080799536104295004509698402e5511409a9ad9a54ea9faaaa5405256a9b1f69153569d3fc491b45a95255254a1fc98f365523424b79b49054140a84164252de01dbd8c66fafd7ebdd9dedf79d3b6bfc58ecd95dc7aa85af3d3b3b33f79ec777ce3df7dc336bddf3ca7f775b96b5496ec3964ea7dff1dcaecad3ded4dd731b1a7e88ca53000c81e336bc98f280dbd0e84354fe443dc0630de1f57f79e11b0fa9a6053db2764e58e64cf7cbec69384afd326b9a4f2ac23e49a4d2d2154f495b2c25675be272a6b94f8ed6c7a4be2b311eac0ba661adfaf9b974be5496d714c9579797cb9a3921f1d1974889564ff3c3d64b2cb6f88edb7a2fad97ec76a2a14fde3adb257bcf779b7eda6be23ff202a022e4939d6b2ae4a1f9259018ea580643fd54459964a4256d1b3ca8565a01021869f472c0422222a79be2f2dca16639dfd63ff1da8363ce31607e79407eb5bd561e5a10c6706365a494aa97312501819e505e3cb84f0e8ef2f4058294b68d57d8e8b3ac3a20af3f522b1bea08e6c4b79c00985be6975f6c9b2d55210f94b045f58046706cd58cc63553c0d693d116cf6cba7e1a279ea97c667a7030475bf2ccfa6ad9bcb874c211701d04fd08e9cf7ebe464a02b4205d1c072d4f7dd0a83cef5d6a8bc39d93d2da9b94be645aaa4b7c5213f6ca1dd3031201708a143b3afd394ea710ce4fadab908f6ec4e55c6b9c2427a4b906e0cb779549dd0c9f312af4a0f274616a42abee39d7236f9ce95205b249ee05800fde19922f7da654965514636a50738cc58920921ee93cfd40957cedad6b9282d74c447305008dbc6d09e6a8caec088d896d79e0cab8f7cab13679edfdce51e5a5427fbd10d563e7ea88ec58564af5d56b384548dac2e7a2197eb97f5e580e5cec1941efd115e5b2b43238e47e3b96d79fbcdb32e45e2e17ae005831b318ebbb17ae0fab833a3dd8e23c86f67f391f1d53f9e102bd78a4556a4b7db2a6b608ba0304781403230148637e3d8c80980d80e5d541593b37e4c414f406ffc69ed470f2395dbb0a827722f2a78c9f1ae28ec578b1f7426f4e0cd919c3e5f9f75a1144114ca1441ab4d316a135817235800978e977431bfd84c1975e67a75366ac9a6468bf5cae5c015015f2d244ca9896d7466f80d56ef4e66781a69ea434749bb59f56b7408bc0b031a9aa421639a2e1390330fd90a0a94cb82aa4b902201c30dd6801baec4d96694d7bf315e05463bf8901198aceba6a0389ca2c00285f444dcd2a691032be294c5e62648179249dc6ee8422cf279caf7405f5039c57df512c072f8d0c5823a98cbcb3eb54871cbc1c1df90077ae748ccc0c354650635d3ad0895e50607305c035008008a50c3967b5f18c7b5b1685e5d0e55e79f7e3ec8a8c26dfd5ce84f070dbc852e3054020069a6e3be2b8a531bc9f2b00ceb6f44bd24e894fbcca989eaa191d24e2caf0c2866a79fddf9db2eb64bbf4269c18319c531ed7e545e037c8c87e2fdc0f41d0f298e0490ba087cc28468c1ad408547b9fbbd8e47a33f4ddb511d9b290f93f23011a2453d9d4133ce245d4ee4b59b2ef62141e1195e38d3189f61706c6eeafcc1d8805f4bc148cc0f8a081583719460e9f8f40dd44aa158179e3af2f53ca319b2b0f2095d74e75cac605c5e22523667144015fcd292d89644afc78f685ba62d9bcb044ef9f81e71cadef957f5eeb95d3cdf19cb3bb643229897e006e90063b72e33e0487c399c12981947b20a3c49724aedd36d7003462d97af6bd76796a6d99f8b81280119912054e039e696f3b4917c5813e4b235ea4bda5f2f5bbcb2406a14e5e4731e45a4c8e01940bd8fe8e25a699efa0aaab833979c885a5260cd678a49a030e87980dcf704234851bb3b9068094f622956d8fd9f2c307ca251c4066c89b3a05a0be230081a185b0b0d3380a8a85a941efb8776640ee9b15148f6706e6a80d2062f2fb0f3ae57d0093bd71bd272d873841564d718d7fcd1de91ee4a95ec28f8c61b2531c7ed7750c183cb06e46409eb8773af6f2410302994269cb991af4045d2d390802336871d556c78542fa0c1f5eaca91eaf17f1a24f7e79a25d8e37c406b391901fcf553173fbfbeb22725f2df702509d98e059534f421e7bbb79c8383aa4db609c930764b8b07af38d3d2df2d95945b2717e08f5c0a08490bed1e21ab6a134ff18982827d3578ddc78ce8992660a8c5a61ca4a6a505b5ee5939737d5c81f3fea91e70fb7e818f21aa184038645ef622c424f1be79e02826d5e005038b663a8ebf160de7e57555056c1bd57cd2c92ba881f71d228af6b055d14e8583027e7282706e5a77ba3ac2216562cc691ad0b4300a84a7e7cb879000465e47ca8d2a0c56d38a9abdb3ba00cee97cbf78200c830ea8735ff0537e621d229a541afaca809c8ca9aa0dc8392d7bc3254511404e301661c55e01c3757ea330061cbc262549123f2f2d1d60cf981b32aadae4ff432b70b43605c00c8889239b30c7ee84a4c0fde6345684d6d31d2e622b91bc004e009cce21c2fa62bc0a2f0062a85b57ec7a7c2f2e6e94e6cb4921992e68ce71866c05404a8fc001243fbbabca23f7ee2ed7a34257fc0fc7e72df0dd9fae675f91196d30e1432687d75639d1a0c920c9500035bdd47974f1f211755d5cd18a71707ea8dc23c60420018ac0903db1ed4101e7bbb458ed4c78d45617aaac1184aa5184c3723eb1cbc02646898fc9f4180714511c83ccaeb3cea14601d8fd5996cad399ad4753cdb3337f7f8a6e80787dae477dbaba40c6f96744aa85519202df121d2cfc45ba6e16f9006366360a253a83007c0fe6694c63ade77d644c4cf7a0819e2c89c4f23cd65225348a337ecbfd4278f2cc11e03665783ea4607790478cfc5abb6e100a8d666bd550f180c483eb28c0a00093674f6c112b010beab80ba7e89a04a362ead01f1814a682c501ef000ce714ccef22cbb3c4dbb290323a6234b21828c19035ab0b3d2972058eab8963315b5b11057a236c997a185361f2c6ef48042a0cf28af5605087cb730b4516933f7b9245a4c080a6c6302f09f1654832814ff2014ebf72a259297cd8ba715c85e64099226921c985b4adf80d00cef18ded403d09faa330b2cb48d09c07e547bd442ca0c20d0f530216c04a91d4bc3c28a71be8d2f59576183440f23000607280510628994d4b31235ac99b751c618c48a630a696302c06debc58e94062532524f80c0eaa6a9a4fcf4e16ae13bc35c1b97b867d6574a0986ea0e12da18eb1a18fef671bfc4b3eceb4ddd94cba673648247ae0238fdc70480fd5e3d69dee1eb8c73bccecc555bcafc29d9f5c559f2c4da4aa9c17b40376d255eb4bcba75b67c3a82d407999f51058a933608b3dcbefb16ef1bd4e20c925c35e08d417f613ee04ae223f57d72e04a9f3c885d1f41a0f510aed42b1250c0836df0b6ba22d9beb816fd7ae5432c91d75140e1c174b6183bc5b2228f2ca92c9275f831c54aa4c38944429229ae00d4da44fe0c787fbe1cd30a52e63a73ee8eb31f7692d4995312604d435d2282f7162c83e5d35c0140c23f3bde0d57f7c87cbcd2d2608818a0c5513ca3151358d33d287cdc5f1b005045908ff9be398cd568d994a4503a8bc5b869e264a2d1cd195fd16cb9dc65cb4bc7bacce5b0cf161463e82d549c1e48103815bff7b90a79e9689b5ceb4aea7504b1a505899a9b965341a40c55da17d64f970598f3faae1f1388e2d32aeabee468b4826c9cd3b42e9ec365347831dba7c7b01e0000b9d8d3013c9ad878e443bc567ffa70964d90a3c9d64525f2f8aa306818bebc4d47f0f9fc1208600b8e25958ddeb0e93757f4fb581fae62408648074acd8fefef903f9dc7fb409a802b02e70495e59ca4220400f733014d21c0356dc79d1ebd82df55670de31ceb917dd83deedcdf36720798618ef301ac48dd09d021786c20cea42999e897586faf44a33d38a2128fdfaac466860dfecc09000e64f5e5c563ddf2ed7d9d72a20939029d180aeaeb2a7e2730aa357b53391cf8e37c615f7e3573188fd1f75453bf7c6b5fbb3cf78f2efd410547ddaa91f76f3fe00b186acee10016d51490345e41fce11e2c9dbb6dae63c070822c793f79b05faab1d7df302f282baafcb2103941c80fc9101455047ed04771e66ca0e22c8636746323d59890bf5f8d63ad77375733fcdf3813c52f4892f2cd9521998b9fe26172290fe5474f1c304666c4e8e79c62c0e8a4d428fadebf123f85294141b32400f784404d515b9a7b6d615acdebf168501b2f667d3207bf5b08830f7fc2c3d27b27560af2624074d3f2f6806cc4a9da5530be9a3d88671b92f73df2a2926e15bd15a39c63c0ad084dd6fb53004c56cb8d97dc531e305e484e563a531e30592d375e724f79c078213959e94c79c064b5dc78c93de501e385e464a5e341c1e29dc92a7ca172a37ab0fb7ff6c0e33da7ef498e0000000049454e44ae426082000000ffff0300a1b0e2c9341b0000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["favicon.png"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffec3d6977dc3872dffd2be0dec958de886cf918ef46963a9125cfae32e3e35976369b79933c3489eec68824680294dc2b6b7f7baa0abc8f6eaa25d9da9df960ab490085425da82a1cdcbb7ff4e6f0fd5fdfbe640b1306937b7bf71de7dea18a97899c2f0cdb3a7cc81eef3c7acafe939faa297ba19239e391cf94598884792a32899ca64625da650741c0a8956689d0223913be7bef83164ccd985948cdb44a134f402b5f30789cab339144c267d325c064af8edf3bda2c03c102e98908da990537cc83a2a9b8375329742b237829d88fc7872f5f9fbc64331908f79ee300da883d0b7834df1f8968c4a2b9c3e3787fa49791077d47737a45f8aa2010c9fee8242f39344930625ec0b5de1f61a540f1d3118214dc9fdc636c2f1486336fc1132dccfe283533e78fa3b260614cec888fa93cdb1ffdb7f3e1c0395461cc8d9c066244141211b43a7eb92ffcb9a8b48b7828f64767529cc72a3195aae7d2378b7d5f9c01191c7ad886814b2379e0688f0762ff91bbd302e40bed25323652451558ad6a3c350b95b46a04323a05ae05403128365e6a98f410d22211b3fdd18c9fe1a31b032127f7b081912610938288ec33bbb84026bf06e6be869eb61e5e5eee8d6dada2030b6caa94d126e1f1d8d37a5c3cb9a18c5c7833caf04051d00b218cc5d08a8659c63008233e196c4c258c4d95bf6417f493b198fb3e20e44c95312adc657fd8893f3dcfca66306867c643192c77d9e8cf223813467a9cbd16a9186db3e2c5363b4880dadb4cf3483b20ca7266415cdea33f69f0af8bef8a1e439ecc65e41815efb247ee7722acd5751159275491d23107e9bfe8c2e5958802b5cd5ea9887bf0f750455a81406eb3d121e88c04557b2dce01c3024ca30b0ec286d2eda3def81343a26b92098a4ebb14e9d55f3a0386f4971690fd95903b4b0bc87e4185a94a7c9158da452a6a8c2b5073d520f32edb795ee774e50d8171be2b191e2b2d51237651a64027cf9a1d486d9c4839d3148c8229baa2d724700e0a9c45add1100616a46154b4f1a58e030ebc9411c8ba70a681f24e733c40b4ad26833ce6f251080ed94c109db260cabdd37982160f7b5109a03f9f6e3d7ef26c9b3d7eba83ff3d7af8bc4ec184fb32d5bbec4965f0397d1ec59fd8d3f27d4ec8c7f0fa71fefab2392e10b3c8f539188e869c076206d8ee94825e1bdea39df235493e0fe41ce98f837cbe9e5639817343dca62ff282dd97215a4d1e990e4d8039a26876be904638a433d8f41c0c4dcd1a9c0b4bfda73b3b9d90fc9661b1e37f0c66651d16beab431e044e8d8abd08658dff2314bee46c2be49f329afee1d91fe24f0f0b00995ec1141b839900919ed83755ed2b2a3336fe3dc83e4cb4867156d85a06339941b32ddcb9bb5bd465bf07a2246065a630b1b2780194d6a0540c06a1ce19b26a9a087eaa711e0e14344f449c28681380088e35cc90309b9f4bb3a842b4f204fec1efc7c5eb0611122053ce95cb421cf7c6a48230138fed547c6f8f4648f30fb829ecbd8a415512860e00be8bf859318ff3332cb17fd0bce43f7d31e36900d32df80182eac939a749d3ce257bbe2c80e01cc961d0495686b3106845bd0f679a8043349aecc9709e97a0dd1a319d78388d39f8e43c7afc479a3d999ddd474f1ec3dc4ab2677f8f27ac984cf7486c72600be98301753ec16c57efdf4e2ca911d0fb672016144d3a67610237c96ae4234983ca3872ea547e92c21603a7193c6fe0272af6d579542985729e4defbf1b35eb01fde773f4865011b2872a9497be34df46531d3fdf9b16b4075982eef7c653f8c76b1d9598171d84224a6bd810be930227ebfd815f790a1e90efbf13b1da7a08d0abe49c07cb78815e0e2b7e390b1f396b09f7ad0801c303df67d81ce615952c11b5bd31f434b86be4cca0ae61f8e7c292a0d13d82e8edb8208d3c933e4aee15d003c3634eac61d08370f4d4bc815fdefc8a8491c3e9f231c1f8a1d9ed02ecd3f1d1ad50452f52834236083b359bb550b3cdaf481130ef862766a0a8cca0faa2d1f13b0ba1abdfbd711a5434bb525a96ec8d8158e4798fc1225803db631b6d7db4c8dfcb441b30ade7db4c45c1924130711e31396391f084d63c593e67195eec9c27114e4199f5cec003052490e23ec09fc9f9718446b1302800b834c535640227f4c1c656ed55a51c88220246ff3b59b775dbd5acebe08443b5f6164fea2514d28c26f9285e0be10b1f66a827938262fd6071fe6adaa978f27e8141318e374d682e620bae21e815e085f1330c90211c8b14cce01eb8b01c0cbe5b4e162c4c91e4193a3859679528528ec4791db4bb378eeba2b0166974db2b7360560d703280a90dc8ec43c1a7a98198dd448e0ee94f36e7b218bcec6c5eb91d49b7683410adca49207832939f461dbcaabfa83d561eb29f2d8917f334007f0304b421cf35c9cde161c37212a170836da19382bee5c31242a7943f5b25e40e860df188491f099bf520855e2dee996a5c5c6093432cd8c25feef111f80fc42b7814dc5898e8fde1df1f016de45a2fb91baa54ab07357b34ab518d3c8b9ae300740878ac737f22064f011319bfab8d377746f0250cec1b0951e827184c1b7cc3ab5be706304b25f74826c223f67d66943379cbcde2f2721df8520758c5b1b3304f0c376995f47597ad2aacbc454db440754e34453c634e2e1a55aad41991139815946ef536c4b2352b36e3966e5ed8d8a9da84351209d93304323246dfb7030ac2b1b142571996267d4558b8586f890c6fba3e1585464f0480ace8c1af39f199af9d49d6f111a5cefc5ee4c7fdd85f7f60369873542ca2c600bfa792eb8cacd0996b0d30f71142f003839f3292fdeccae88c07b24f1e068f3ff30f1c2de74d02bc4c12b5e9f8bb91fd9a8cf65408615333c040bf6291a848fe8ddc856b70bbc39a7dada1ce03356d860c7f82773ca8c57237c1d83981fd5e0642c3c4c08373bed4afd3702a92cb4b260d74bdcd7a1abd581a6a349511471579f1f508b65061935e3f2aef16c81520d42b528bdadc216279814a7d0763bc40f166bee00db8ee6a46eefa4614ebafcf7bec60041189a5e884edd40359758ee14aa192b993d4c19712c860b660930eaeb43c95ca1088b2eb46b1bf0fc398ece4fdeeb0177d6e5101f7ab8902e6b11b02f08a6b889eaeab38bdf51b5424fabd038ffb0d84dfa3c95f855e47ac0680fb0d08afd5dda5760a5395f04e4553e78ee7914a047b2b92506a0db398be7d9adb3eb14bbd39d96b40ee32e529d5eff0a0e53dd82580bf48b3d8707e20c89a12b448928ddd0628e90b00a088d64b3ac2aa56c444afbbe3b7eef085f7e440e244866012473d69d772c003b220e09a7b32683aa700a5c7daf622e5f3682e9251a7f160df7ecb86ce2cb8912391bee89859d62b71dc356b6600d9e10251d49d03eb568faeb07783644f355583b9e94a9286b87f63599a08800f4bcf144b57957c0cb63e9ccd3125f353bef0b3f5f0e7bb9394b1c3cbb331f8e4209e43d65eaa3e28b81b51bea6958d994cc335f320253e6b322040e0df9220ed24482b137bf08a7d3032b85ef4a897e032852efcb91b0ebee1fa5437467af8f6c3cd8dd48b5398f43dd09886bb0d8f1144d1090f761f5d5efecb1d8d718eb2d7ec1d3762434a402791f09094faa70746191e3cc02cc9344619080588b97779894f5b3d758f230a3edee363556c1e7e6da2754e701fe25b21984acd708a41687af324cb7d894cb201e5832852e0a98b373fb0fbfb2c05fb359351afc91a4c5cdcb2b55049334797f7c64e7003ea66d9bab54e7bbe9a8cfb7aa8a14e3d5cdf1cad1afd68f286763b0d8d0c5a9d34bcb5fb3d9dcc66437af99ad6b49939ff2f91e8cd6de8996d7d9703854da302f2e67277e7ba81c1b5dde5412b88842dad20966e5a9fc74a9bc8718cfa2e2d237678acd75d3f6ceee5e980d1e9df6eba988810b2f47bd559bea5c5c426897e5b4cac150e491bcaa899363cf07d18dc66892b2b0008a1c6fe7fc685a61e49ff55b8c9d9805db4a0764d71adefd76cf26b769a9bb418e23b37dbdc8a0b7dc7fc2b1c3340b8b67efde668dd445eb2fc697393e3fa96337c45db1474f7be4a816576e3961b88686e1636977bf73658be56467ae25a1b2bab6e270c1c5dcecaf891cdb431fee202debaef65284083c11714bba33fef86e12ec4739797bbf9f679f0d0668914911f2cadcb8a8d88d4a411b7bfa9b2e2ec75efafac0a35ed74b482306cdf72d3ff78f3c397dd4e5993e962737171b4035fbfa0137dc34e7ce45b4eb3474052f8d991c0aaab7caf2de8ad231f7d0725ea67232aa627ab424e5d666ff08ce8ee1886a83d95265ab8c5b9543712663c9a9ca4319e246263f6bd4ad2b0bd697b50171afa984bb348a72e385d638f07e1625c74354e04304ce36ac38f20e5dab077f6c586bdad189007f0e72a598e7de5a5e8fc65476c8eaa8fb73348a9758a437c91769d42b8811e8059f61cf361c7398cd5fbe76931096241959c5a4b842b6ce038e4e25c4454b60ee96fa1ffb6ea8cfba2436ea9d0f1250fd4bcd382db0ad9a9df1e2b6eeba06506cde3019d19c3fff3cc534dc1174febcdbac2eeb566477c82c290a4a1bee1ae06e6b0f0c0ecdc56b3338ba73d66a66b6cad69622fae77556eabd702ec20eea79f0a860efd36037661c282ceae7316270a5c96908ebbb12548043b06da26c03a567a8c6e0df83b702c9700fbdb850802599c43b498c783ad65cd6cdaade4b4ff9d0e0374ca535254b80bd224a399ba05596a9e0d68903ea7c017111ea959497397bd2533cb162af06f9af7f9f1a21ecee78797ee02dff324f9cdb3be7aeaaace919c3a782743208cf822dc2f4fef40f7643cdc9b62f7f1510fa3a5ff7183a9c20273829be1f62d70b671daafd698b6651c832b67e44c7af6e0d4b7a1cff5e279359d5a6e8ba86754afcdff4a9573506a86ffa14bdeb8f0811e716d17097471112e81879f99b748a353bbd3bfe93ae341e27c5324542eb86a61d8c3f20c2a39e0a184d38843206acf1a7f4cc66df8a3f11506d911950c3ae8554420944a07af1037de6540071deb0ad55973c3f161a0d0356d062157d51892128cf17bbdad3c0170e7d4074f212cd4f9fee83ea20896e5e527885c5bb378ae59e5d9e0aa6037400d8284998c0e501be8c84c256176d41e7f8eb25b6850395f124f56acfb60837ca71445b6f4f6e201985787bce7077877400ec88d2827e77e931dadc0fd6b1da5be4cccb2bd8cb317f0a908f0f6038b1ca8ddc4da972370efb1acd54246311ecbcc16635b742d078a2a9caf9354d4998697dd48d4b019a3ca3d373476da880716204d70910a11cb52907859cec754e2a64b1ab5839d481f3489d0eb5c6ac953413d92b0c296510ab585c34a6b46bdc6396c707e626bc0ba9677aa1b623b5867d9faf973175be384460118e261dac8720ebd77b2998cdc767b9314abdd243522519fb0ec00391830abd8ca16db6bae109ccb4e90029a6ebfc239067f025045372e81b9d892b479d77fe8762538f3a1f5e8f3e42f0b7017ec0523105be0a95dec749b9d0a1123aea1846ee93eac3d114ee836ad88f4139eec09601819b40739a8a1ae7113a551caed5ffdeb79bf8619a4806e217c2b94adce1260029e64066ca7018f4efbf11ad43f89c46b12f9f528902800ae392abe129a8e55074a9d5af571d9b1c1d39c69e01341d9778fe9e22feea114e12221f0c7c31547e21b9eec00af12caac5c44b4694b6fdba050b704662aa85126323d636fe412bb33c7dd6672a555e3a1c86c1aba45abad5a1c00ea0b3aefb73ffab30af3ed34992143505d666c90dde2765b67b77deab41427f61681481b982791e69965ce95d80b526df9830b81cd13ee3745406e576431b77490ffbc0215fd25904d7a4416709070bdc3efb2e878dec5ba7d48eab2d34de95de07a62922bd2fd25d94e5cbee54c8b18d4c080fa74dc4153ce0f32dec53c66be6bb7401f93272b1b66e4c91b82e58e4542de034f8dc21491c728db0852b8b4370a8a1cfc008eef8d11d617f0848b85a6cab92b7e26b2eb4deaccaf98ab6f8ad3ab574fd59f00fcae64fd5df3dc4b8cba3d10b4e0f9cc782282d9680de69494b4974ae0bd095592831640b03ff84e19985dd3e606df230271fdf8034f76ac8f3fb0d63f43fc513de6762351481fc09b8b45f0cccada58a4ba30da55deb540da556f484c5322e4e2cf464cd351da13d350df9509cc56c7ab6b6a171574ce60d4368b700a2ae1a4549fd3b2e51cdd306c2df6e6774ef4843f6be6b177f6545125d64923f931c5ed5fa05b503de6e88345fba3f1fffec49dbf1d38ffb3e3fc9bf37feecf178fb69f3dbdfc66dc3bf1d13887852754b579eeafc1892244e9282b439413bc2e046862335760c3667669811537982c5df62a73eaf1bd0616a05f6f6f85b3ce0e6d53ec759f87206b5d684b4ab23fe4252755e928420b5beb06baab460c7da2dc85c8d0b861382699d05c159122d8b2b7be54d9b8f5ec69192d90cb138077f2b03b60d8cea3050a1090cbf86f0fd39d1310ddbd31fdaac18b82e58a71b79c20fbb27b03cdf54c125e75b3ca28d9f22b98256c50334cf8e22aa68900d48dd3dfc747a579ca0d90adb7a1092aae2f292dd117b02b96987d96c59696b6059fd17bae9b933c29405727a0470f288bc4657f9160514098bd44906f2f674c9a32301668c05d864a602450b5120b5b41fd7b21a636b792e28e5aae73e5c06ba4d1b6d9cac571278285b9227abdb12adba1af352b15616ce9738cd41a6e5a86abd890dd5e1b7918cf863b189d23a842c4c3f353f5a94f267bb58f4aad065aa52920f5284e799d40f5da337b334277df2b54bfdba2f5a99b3daf8c99ba388188ce43299f252a4451c693c52c04d717d5a34cf58169c60bf79a15f2c49bcdb95112e93c531f8d27f70aa5d3268f4fb3b9ba1d9fae18c89d6766ed9202d6be6be10b3014730359776c2a8de56f967f65e7984ac5941eceb7687d3246ba8cbe4510b1ef0fded39703ac35eac81eacc0ea2adc692c296874fc1051bc1d8152587de9a36647358e54cfd1ac394453c7e44698af212a461da20e693b74be177ad43aa3dd7d80e52665e184b0b1175d2242a883748184fdde44c5d06342f60a8cee7ef94f628db5c4bd10a847d9c6705a8160a458e59b2f66973181e423e770eb2de670c31857b32c2274d538cf1c08579bfc75ee77d8dbeaacce03bbd1fff3d1f1b60920fab448b933f1863c647445d6d273882b6d1bfe2044dce74c576b0c74a7cb26a309fe9fb374a5b1a9bad31500760b51e57985e36ca399d532678114be5a2821647f74dbb17995865d5e74b5bcb18c68cfe0c3640e52564a24882a2ecb6de32c643f41b389075bed76a50fdb92812672056265689a156264791381731b5760dd7a34ffaa528b13ad6272c3701b1c384791c871ded0d7dec0d96e2e00946be17d4bb23578edf5d97272e95aa5c529a99667c32d81141da19b282b4188a4696b2ec8fd9cd241d1284bee60e0b5241be9e1f641f032f16b266782f84a840db9f11610a6414c162c8bd69826b210eef552e44b2f806457eed7f38415f1f96d0104278d6b2e780cfeaec16d2e78e49f00e8db919a15dfb9d58eae2588f2730637bcf6f025f300d5a821ffee0b18ab8211bd9e697e9985ade8a270e2eaf403cc913dc059b45562e79d072b7d58eba65c5ce48df1165cba0b277ba60f9961766885c79b392c284f0d40ab5c94b22a3e67f71264ce8a09e337b13de459c2fb79b56fd2ebf1f6c56e9dd49c2a15aca6589fd7dfa2d92abaf4c7061b0c7d9348e04b055a3de23e4fe5c9ed497c67590ca89dabc4ff07d5873f7d38fe35eac25586bd6144bc4ad4782c4fc5720d11daa3dd2abe22b3e6689e199fed387f741e3d75a02707bad2e3274fbe1b4d3e683ea7e36c0f574bdab05def28c06d5aa2968c9c51cf36d1ac83ab7f58a4e66b0a73f0f6f807b1dcb2dd8337f42711898477b9366b79b5716eff6bef37bad2679cfe611deaabbaa624e3147a25a6c73d4dbffa299a8dcf4c1dd057fa0e22152d4395ea6cb4ef68b4200bff7e3d27b67adcc9eea6f592658c39b6b44a55fccc2fae86f85c064bda4e5b0d7c13ee9dd2864260791c70834aa2b7a92dd3f26fd96e591ec745520340cc8ac5cc04bba30bd140cd11b04dedfb78feb2588c891315125eb4099712c1199bf89ccbca51acfa41acc6f0f87c9e88392d8ae2b6520cd0bc6c1d299d829e41b8cdcf608c74a7106ecbe68d03c48866eb98f78a4234bd7db85d49edca948625d9db44e04786eb9f5caa14b07de04b8a4bc7d973556e3a742e061a5452446507e57d0af6fd1132ea33fb45dbcbd9f2af1042fb2f6017ab37f2e55fdf8337b1f9f06e339b48777a6f6a12cb9bfb8a78ddc35bfa0622d36913f17ef06b1f8ea2af97d9d5b0deb3e858e54e19c5c107882b1f5f004b0454bb761cdf7ba158768158f37ab146eba41a9ccc302cc9c98b1a154978cb411ff1e5011d20df9ab56ec62befe929bf2e3b40862e2e10ea31ee45fa89ff8c2146e56ae5a23f5bd6bee0077b25a2a2333da34dfc364ab1bff1ea230e769f5e77b7aeeefbb6b798b4d1cfcccacc3d81b9802ea6a11ee8a976133021deeca779f550eb56a15fd5f147fc69bf086f0f878209c24fd4d157d67fb1b93f2a9d342bfef23115c9d279eceeb84fd6d72e3fe6fe4bf35bee2bdbc11cdfa80063a5eba140474d08d1c7ff030000ffff010000ffffa2543bf29a800000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffec7d79771cb7f1e0fffc14a5fe652d2acb99a10edb596a38bb3429275c5bc713a564b37ede3c4c77cd344c34d006d024c714f3d9f715fabee62229317162451a5c8542a1aa5055387afce8e4edf187bfbf7b05a18dc46467fc6830d83956f142f3796861f7f8093cdb7ffa02fe373b5753f84ee939301980b2216af095b49a4f13abb419c29110e05a19d068505f6030dcf96810d40c6cc80d1895681fc157010237305717a82506305d0093f0faf4c3c0d8854010dc4769106cc82cf84cc21477662a910170093644f8f1f4f8d59bb35730e302873b83c164674cd88360727ee8a1f440ce072c8e0f3db390be0db99cbb2c87af1202f5a17796971c5b2d3cf00533e6d0a34a42b1738f40220b263b00e3082d033f64daa03df4123b1bfcc92b0b426be301fe9af08b43efff0c3e1e0d8e551433cba7023d472194f6d03b7d7588c11c2bed248bf0d0bbe078192b6d2b552f7960c3c3002fb88f0397d8032eb9e54c0c8ccf041e3e1deeb70005687ccd63cb95acc06a5563890d956ed5105c9e834671e8995069eb2716b84f90428db3436fc62e28398ce5dc9bec1048cbadc0494144f804d7d734c96f54806f5884bb4f6e6ec6a3b456d1410a6caa943556b378e41b332a52c388cba16f8c97e141ac6042449b8e21650dbb88f1d0b37865a9b12b0198aa6001d7ee2740cc8280cbf960aaac55d1017cbb1f5fbdccca664adac18c455c2c0ec0fb0b8a0bb4dc67f00613f4f6a0c8d88323cd99d803c3a41918d47c9682b8a1b10324e2bf875f173d464ccfb91c58151fc0d3e1d718d5ea0e09d941a4a43231f311aebb70798d52a83d78ad24f3d51e1c2b699460660fbc6395688e1adee0a5b707059846176c2a70e02b1990dc0413eb58d7ea890df73a4a895efda533a56c7f690139580a39580a3928a830553a409dd24e2ad91897507355544dc97c00fb2feb335dc97160065f97131e2bc349220e88a798e517cd0eb8b103a906d34408b445572edb31dc80182e45add170e02b9144b2681370130bb638002e059738980ae59fe778445ca6927c00dfe6fc51308ed39907f0b42c9832ff7cae49e3512f4a1f809e4f779f3dff660f9ebdd8a7bf9e3e29eaa614d42ce0893980e7f1558b3e4fe32b7851e6e7847c165fc1b33cfba6392e1333390c9865705d4757e0cc1ec07ec9e8b5e13ddd2fb31de733c1e7f2205d185eaea6554ee05c11b7e94b73018f78445a93495b6fe6780e6c5834bb0cb9c58193196a7aa9599c63e1b4c125126207f0627fbf1352c9aa1939b3f13fdb8faf5661110c4dc48418d4a8d88b50d6f87f45187006bb11bbca68faed37dfc6574f0a00995c6934b192865fe024cda94a5f511960f447d07881da028342d782416b496de3703e3c28eac21f61a634446aca05421c2a8906ac022684ba0462eba946766e681d164ace4163ac15cc9408508f4cc8340670c96d588598ca8919c21f47457683083a62229f959b8c1800e39113c1c9ce78e4b4cececed88d90e844660a7c50314c99063200284fb28b621d67175492fe43ea25ff19e08c25c27aa09540578fcf19a9886c2d1907bc00426b24e3127556063026a9a8f731986a26036f32e6d13c2f21bde581d13e2d63034a0d9e3efb935b3dc1cde9a1f7fc9907a1e3bdf4f76802c5623a766c93030b7910a01c5c196f52efdf895794580cbcc9a7f1888a269dabb00337c96ae42349440e87c8968da5f2d3096c3170b782e70d02ade2405de624cbca59662bfc97d7ac37b06a3e276b8804214b54a1bc0ab8fd4a4e4dfc723ccddbfa4cd3ca3f1e4d27e311ab759488560711caa4868dc37752e0945a7f82fbe7871e0b82f718abdd27dea446ceb958c421593950fc1a8401cd6c4ab8af3032f1cba320006a6eb8557a41a88d4782afdf35d9476b75add15e3ae3a7d53d81e8ed380719f00b1e10e76e801e06dc9ea58ac1ac85a3afe60dfcf2e61b1286af4f975f35f90fcd6e437509a727f74215132696986c2decd46cd6422d6dbe2145341acbb45dab538d338d266c74fc3e85d0d5ef789488325d2d2d4bc6a3805fd0cff148b28b54c1f6e84607c969e4efb93616b4badc0325c5024ca82e25f01948f4d118a6172f21c30b2e9996b40465da3b032fe7033e3bf41ef94acef8fc5492522c148a56978594d791118328183c7d56d101d5f2984914e0fe1e64dd566a76d41dd082e36a8dc3e7f512e7d278937c146f10030cc6a3f0f9a4a0583f585abf6a3d038ce3c987909c621a6fa2dd5a04213330459460d80539c88905a92c30dff20b663118968b054409913c43c7aaa292f394255ed6410fc7a3b886e36aa4c921a8ac8159b56962ad92994396268a799a5a09532b072672ff646b2ec48910d9ba723f9c9ea2d140b4321e5f20d3337ee575cc553da396ac24b29f2d8ec779229826ce6ff073d673cab9393c6a582e22cedd805d3252c8b67c524268609f72f9379599a816a793456e43ec010f0e3d9df7c031f794978bc6f5353539a6825dfa353c3d797273e3d64e8d31329bc224eb8ffefd911ba79f2a90978a52ad1e409f6435aa39cba26638f84a08169bdc9e889976818cffaa8d3753a92e73707dfd072e03bcbab9e9000fb092edaa6600a4541a9e708dbe9bbe4fa4e6b47dc76c7873b30a7c29035031ec52986796d9a44afaaccb16cc8645447f9c06aae555b836ffcf4d4ece1a55aa14d8b989c8090c05a55bbdada3d99a159b7e4bf75cb85ab526d00824646963358f31e88442012ad2b5dd6554aafb8aa8305cad892c6b9a3e1581264bc486cb7a088a1192119fd9da19679d9ed0ccdba0affd7864f5fd0d2c75e6062a46d918e0f7aee436232b64e65603cc6d844805287eca48f6f390cb0b2678e0dd72fc997d30307cde24c02badd5b6e3ef46f64b4eb4afa20865d3c120bb22d44af2df9c25728bd9eed0665f6aa873a1a64d97e1cf424d99a8f9727731b1d41513df7381063e0113976c61de24d114f5cd0d708b91d9839e46df2dac6b34e592e9c5cdcd775f8e60a18a9af4fa51f9f7402ea1fc8da9e5da3c2062f94225c1807c3ca158335ef036b1b4ff4562b515c5faebb31e3d28118394a213d8af1adae48c91bb528864bebbd3312f2590b5a7859a74cc4acb5229ff4b29bb6a148787b0ef4df6f37ef7e1bb8cc0fd70b767851c1b37aaf7e823bfc0b7527499361bf1488cd2e7a253a48e4326e7683e137738e149bbece7921f2b95d6e19636d06eae599f1b1eadeaa1e0887b64865bce396d003566fc353316f56db5686ffd061133266641cac17fc795c46ace4203c21bf570a99d483f44ff1c9b0af8742e954678873ae2c67025cdfdd33ced93ba34db93bd06e42153deedfb0c98689992941fc0dfb80db722f9f5b5836c485a9c16dada861c8f7abdc1f1c87993eda20ef7b99ca89633dfc92063b63c20e6d527fc1d4b0c06355d8c36cdcc95f01e589d604515af9c9c98da3726c6c1ecd1c56be3bc3eca3326cc66380bb668a0fc1e4d126d8c73ac79c4f4a2e8ba8227ed75948ce56db982d3ced5c684a4454c7b9d4a1abefa0a7a0da1c6424da7a7340f3027f426144ee22e533503585a247731b0c284ea18db4a6324ddb9de66843de6f87b07101a9657c738b3563b2b95422bab91514b66893c457160daf8aa44809d36b9b310b054c19ab1dfaa78e7c15e6a7d3c9b53bcf7a77c5779f7c9cf35785f34e24b0896a15e4a0d084f6f430717aeaf65be619e8dd92d35b70cb296f8d447dd0aaf02af6fa5ff27c2ea22acad6d9ea3d7f0d17271bbd09459188bd1d02c1e48f4c032736e1a233d7ef7f1ee46eac7c93bd43e4adbf0cae013486613cdc4c1d39b9bfff6400328275936bc6716b7a484afa4449f48697e7a6c9565e231c58ba731f140845673ffe68652bb3d754fa58b6c7ca064956d9e7c69a2752ee41fe37b21984aecfa147b9bd8bb27596e5a649c8d57f6484a95481fdffe008f0e219101ceb8ec55596b1397ce83864a373700f2dee08c4eb76fb715b0d209ccdad00208aea1497c3a3ce12d1bbd3779eb8e5266e86ede49c3787bd4d3c96cb64e2f5f4e2adadb727f456db6d7a11769eb87ec786eebfd386b2e37776eeb0065993b2b87d2caca3276ba2d9afaf10487ad3b9e509a697d16abbba1426334bb4f1eb4c57adbc309cd83821d303aeddb557df59d542050d9de5e09acafefbb31a2ff7352e1162715e8e64d436a8f8240a3d92e109a320041a8f0d2bfe72e760fa7ff2ecce46cc043d2a0a7276b59cbcd26bf67a3b9498b756ce7669b7b31a16f49c0bbb6af68cc7fc5dbab92071fe1cf27b733624e339e45cdb37a0f28d0bf31e60f36de7fa7166f0bafacd6ce4a266b6535326ac94a22fd99468d47f593c694e54ea799eee3f44865e979dda14039b761baedfff0ced5bf5196fb78abf3f4558700b52667a0327ee247771feafa1ab51e7ee011c227b2d2f1c0fbcb41141d18e3dddc1ce4b7a6e0fa7aa639ca402c887dcc2e3572a476b6c0fd9fa5af98e14b0435e37277c0dd61b7de051ed5b40cdffef0794fd1d778bab8539233b163f5efdc45eef52efa6524c993337e85417613bceac4b48ee7576fb3b46e99d5efc715358acb3b6535ead399db9902a2a7010e46a3801b5f25dae0b0788e6028d18ebcc95912d3055218c1f74a2751fbaece5a5d9883d168ce6d984c87be8a463e1351382aba1a6914c80ced03fdc82c1a0befd38c2d7b5b32209f599c2bbd1805ca4fe8706976b3f2a49abc9f417263121ae277c9dcdc4b0fdee42c7dbee2b8e3faddf26b53c4c56fd05e2a7d9e6a22dae365a260e7c2d74deb38f92de43fad3a630176f0ad2b1c049c09556ade7685ecb187a246571d0a81a00626687fd4fd9dc704cb56141079516fd6151059a976f0ca172c72cc513f675d03735cd8c6e04852291c8fc21765e582e67d636b2d13e39ade86ca6d2a8318b93bcf530472b5f64069ba49a5dd93250c62ada6022377cb19162ad1702a2d3d6362a1b4e58735e0efd1ea0597f3af42148217d7cfe94f6d01a90da392287e663f7296caae3dd11dba6e7eca6e5611f33e006ee272a6ee81979a57c26aad4b127d16e6e126bf88472a1ede39350ba112c15dcf7d7eabb467e6f33bab0f61def3ed8bbb9ffaea65db5acb823ac72a8a055afc2cb35f5eda0c13eb94c7f0aea6fbf4a467a279f0abde7c925360037137b37d0f33dbb8e45d6b4c66389c06282d9f71df2d22f055143013beac1ee4280facd463ddb79eff4a954b1402e82f72401beffcb824edba1381aeafa3c5e9097c023f4ce4797ac1abd631807b3f2273d7a87231ab298cf43d19e0d17c60c3249a4ac645f6c4c4af7ad486ef8d3618648757b2d427c91d91c203717b1f0137112f80aee17d688cd4453366712c94c1b613521b432551fc6c480c4d3dd03657afb59547041e9cf8c8f9800ee11f7a8f08452ee7afaeb869afe2b964954f42842f7a41ad058936f33a401524ee1b475b46664a47d90b2bf4d3cb1e1fa3101175a2ea83aec3a406f91936e7d9ba215f3f0e991938ebf9f101948086f4f3f464f887ec461d9d32ec280db8b68bf606db58b0290a7af4263ded767a421108d22f27e3912b6bb5e0324e6c11d66bd1b51c288970be8355116737bcec21ba86cef03269a35c3776775cf2d0f3134ddb878458161ca637d27e4d381daf76a31e50273c988c470ebd16d2d550500f272cd165a4bfda382cd566592426831da2885305d69a817c31c8b0eb983a3740f8f4a96b5a63ed46819e7b438172e1f484ac77a733c199ede90382507b40d0a33e6002d9bb211e64824d419810b3d70d09dc10ce489b1bf7e821ad3168806904e51eda630276b93ba61f3ca9dbfc8da1f5c8f3e46f214a48df9502e61e6ba04ef7e01c31a6b855c465903e8338c668424b9aa3c27884d1247df8618ad41e833aea868ef15aa5865d0b687fb072f56438011c16ccb744d8ea53e233490f584c11a682c9f3e1edfa772c415cb85cde53141c2b6050a0122834ee350da1d43938504338b574893f118123287cfdccbdf7c87ce2223a0629e7e4de9974de40cd40a0b5a853be90ee389dd94b9d42d3629829928f96b34ccfd86bf66187da5da226976a35463739895640fbffcbb55a2c988fe4aed003997f51517ed029536404aa4b8dada5b7a871af7eead414249c12b83416594034cf34732ec4be48dc6d2ae30e230cef89802cdd2ba7d852b66d8e66032a060bc922ee3bb204dcd04e54d0a5d1e1f010c8864b495d76ba2dbd0b5ccfacde90eeaf48608036d619188c996616834c32b3eacdf581c70714c7cc381b0af42978b2b461469ebca15510a3a6b1024baca210914f2ffff974d961413c40139f815f63c6c7238235d9e9a970779670b1f354c6e2e9e99eec55abfae457d4556eb56c13aa3f63171d76f2c3b3dc4b8cba2d10d2e0f9ca788662e6adc0dc0525d3b784e8b99c2ac90324677feda7c4222e93e6d1eb1307a23d8a1ae75412c5cf86ff41778b56fb1f54ebdfc1ffa85e68bd132fa40f6041eebe31aded8bd09da295be487563b4abbc6b83b4abde3a3e4d89d0907e367c9a8ed21e9fa6b980514bf26b4a92f67b379555aca4122d4af5352ddbce318d55ad35bdf95343a727dbac6384f0b0e6eb2492ff9ad0c1bc5851f598910d260fbdd1fffb890d7e3b1afcdffdc1ff18fc63f8f3f5d3bd6f5edcfc61d4bbf0f52f7e1d151b5669c74c142e4a4759e9a29cd12b51c0b3c8156a9a1e67b3170f572d86f03a33ea29dfb008c9ae4f1f034d8d1d5a3c4caff9bc0eb28edf86292989c4a9955ce2401656ee5aa4b5eea0bbaac7d051a962aed71159d76f581f938c693645a470b6c034a771f79b17a5b7e04c1e81c63ce97618f6726fc1390834cbf4ff31853b27837f0cc723f7ab064f8ac59271b78ca00e3579472a895e385ba694d2f20dd41235a82926cad8443551fd8672fae728df6d36a5024aeb6da9828a57ab4a4df419f40aa1dcaf59d2d252b7509ae21e7575920705dc3d5bb2e8e3c4a21ec2dfb810c4ccbe4667dbf319705b3ac6480a7c082404968b004b6ecc18f59f059ba6b1153a1806cce4c2415f0f20dd96562e2ea23958142b72d95b8b320d750db55261c6963cc744adf555cbfa22d691d995b5b98551bdf1db55ad22cded8a0d88f44cc6545d75402c65b4bbac90c0d4422f20f5084ef97048c54084f40d94eebe97887e9b8ccbc42dbd4d4e91ba582b8b3e71f94cab8858995edb8188056e6d2d437d66cfbdb3daac9007ded2981b5584cb4c7c0cdda92c84ced8dc3fcdd6eab67fba6420ff0293593e865437fb7aa7dc35004adef36c1f37e6ac32a92e5acbe258700cd69e606a738eb14d03c889b45c648fe813105ab8297ef8bb9afeda6b34d07e54e79e6798e419e2a23b98729b8a77167e874b8aa4534497cc2d5a7cb2691e82fb029184ef8f3eb8ef05a58b91197e36bbc9f84cba3342174c9cf59b4f8d6a6b5b51b576644c5106e439b06b9eac6f50d561e56788ea994b8ca8d4b2ed57200d48c5e21d7179e8eddfbfb356ebbfcfb66a542a4d2cb2212c9d169eba0b771266097df0cad1c7e4b25f1a192f619f9443a626695d88f64020bb2006cd75507ace4cba33cfee3b5804a3e452b864d62755469bd84c26ace86f4beba931b8154654a376cd96a232e05961c53b4a79c0393777e3bb75a21c71790b6c4bc424d2c73f2e9679b8f7a5c52b429cbdd27246af5db9e7b28042987dbb0cbd6b40f322ec8a5bb0f7b0481814ced472e8ff24cbdd8d9fbdd6232bf55339e5ff968d7ab335e3cc61439297990256817b512cfd1a5dc51fa07dbb0d1684eeccbb37da2b2c423a21bb69e5368edd7a58662c235a3a294e9937a074ebf2eea96d22d0d113c038dde3a44dd504e9eacf1b052d4cd33a6b01309c8eef799333f7efed4059369fa3a65bb867f9cf4d008e47291dd766bf5c99f51391b6fc1ea7437c5cf558686b25706b077dc5c15816c574ce2325a1fbf612cb5ceba1b17976e65567df624acd218d2e321250482add1a716b4c71a4bd9be56f399e9cb85f6848f974a6a474463c59834c42a812bd47da40027d412c46edb25c14966eef2ff68afc802db2ec48491bbad52c2fbb443ccf5c02d22c11bbe25112019be3061aa4570f6cc835eb58a02987fd8018f7199fd51a6bda9d65136f427f17645fd7d8ac0070aaa99aee544beb9898552075ebf2e97d5b9765d7dd8665b5bc6e536616939a81121599b0ca9d02da23afc7392e5bd94fd56e975a7bd58a15d3a944ae40ecde6cbd36ae6d33af03cdbfab24b53f895cc02c19d9e48c4bcc715e82cd6714d95231ae25b579f5d7ecea688ebda2dbacb6aefcd6db7993d7991e3b9a23ec066cb1bedbd804958a7333737b996e42facc6e63a3ff1ee96e56ea1371a26c2edaa548cd94cedc45974fbe22ad3f1471da4e929ae82c17fd66ed8afc5796b7fb73f27ad0ed90fe3531fddc0ede6a15b05220735e38e1da9b64ed0ce49f5c594f12ab309c14d6329648e0b2ddaf1a8cda4e5bc554db42088f72bb8e706f8b049720f1aa88af12358dbb8bc720cef6ba18182ee70233407bc5377ef346150f8f1a4e8d1289c5bda233da57af747817f6dbaa69a6634b6fe983bd990bf78e62486ff58a49aeb8702580ee09ed9ec47765b7eb384bdee43d93818a36f2b098884336a5ef677b93a3e2f74620dc957b34d67d54cd9b9c654970e98d2009a6e725a01fd3d4167088b30a306f45b01d14899725943778b916948d3d4ea706ddd7a0c947bc0cb91f96b203f4418d7c13acb93f424c85c152fedfe9cc5d9ad53c7c99094dfff1b26a5580f6d9f8529ebb4ec8d3502bdb8ca7277461d7ed4c933ae01575c05d2c688e8e0a7934d75163081f425c38d7d1a7ab9b06a5e1b47e3823d72d8011856401af986fc5a28805932a4921ecf452a499bcefc3a7d9576eeb9b7595d53437297fcf874fbd1598ae3c6cbaf6a784eff3b069fed5dd9e93a6d9c7becd833b69da75fcb3fc82f01d9ffbac16def7198c6a283effd43a97f957d7eb77a19bca91cf8a3643624e17f0210bed315d826a95a4bec5e34e9075dbe3fa3a6f4c1f9ef326653a40e3eb9b9b2526486169f2a005a8db102104ab55297d735335506c14bf75ab9ff9a984f7f37253b2ce06ddf350dba5e8a4e65429b19c62dd65002d9a2da34bffa6c91643df6667a433bb3bf33ed87d9ef05c9aef81e33bcb6266cca5d2c1bfa83cfcf9e3e9ef51163619f6963b84cb588dc5fc1c172b88d01eedee98adf72c921d5dec0ffe3478fa62c0623e38c785193d7ffeb537f968d81ce9b1a265b1b6b55f1c20066ed392a4c41b783d5774eb26d25253a8ffd13183f6e8dde90fb8d84dbb7fe24dfe8c1235eb326d56ce55677647e64a77a351e1decded5cd5ed3ef97736a86b54ad248a9f0dd3d4f1b873bdb4ed314f13fda50dd3addfab3912425dc291547211a9c464a37def46cbe5fc7fdece88ad3e3543be3d4a5f2f62daf24caa54e5263d8a18302e16ee2a73d5f1d5cc3fa7a3df91927450ded2aa642856162b30fcb7eca6328bcbd8db104ecbc34c9aba23b6a275983ceaf4e852406f5f15076163ad2287171da4484f5764d3c4e68ccbd227ae45189ac363f3b9a6f33818d00eb12507cdcfe31353c17db10076c1b8a0db46b4cf5353bff4061ea1d950bdde644921a9de3edc3612bb32a49192ec9dc60b8e97550d512b8043f7bcab37c9d355bee990b95863be78d53b28dfb24cf34f68a23ec12f26fd64415a381ec51a37e0c26df5622e38955133dfc7d87e7cbf9d4e745f4e6c1263ddb929bf6791e112a04fdfae5813994e9df846b5f1a991b392287e3614e29b2c0ee78272dd0a310dd53d28a5b8f6e36d95ef1dc369facdd75bfaf1bdcfec67cfea371fdd6fb4d655e764466e494e5e9228c9eda1c7e0d085478fdce37dbbb3e649b9caebd54ea206a44bd6e0a1eb6b827a4af7c07e623fdfdc14dc4467e18afed2b2f6b3d7d4ab232a790db3211d9c2310f96f7a519d19a4dd93bed6ad17b7a18d7ea65666c333fe1bba47815d0f2e5579723c43bcd94ff341eed65bdbf7a66c1ea2c95395e1eaa70dff3525b9f18dc45b4a714cbb1306d3ef40c325ddabc82d095a57f2976dd2db15b4e6af73c302d2cf3952746dce2f28aa4f3be75423b365d2ed8e4a93d420497b49553c355286ae61323a342216e9f53777f5a3b92372f7baa8c6261bc9ff7f84fe61083d6dcffa9ac7367d8c8fc97922983bba34fc258df7bbd249b3e22fbf26a8178367c3fde1f3d5b5a74a5963358b47bf98519158dd2e42cb96d76071dca8301ed1230a939df128b49198ecfc7f000000ffff03007eeb2023239c0000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["index.html"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ffccbd7b77db46b22ffafffe1422c69b01cc1645d9997df7808178133b9964c6493cb133493645674124242126011a00f588c8f9ecb77e55dd8d0608ca9973ce5deb4cc6221e8d7e5657d7bb4e9ef68e7efbc72629ee8f6e9e0d47c3e747db237f1e1c3d1b8dfeace8efe973f3faab7c932de22acd3375f44d361f52c1df3ee0cd302fae4e96e93cc9cae43f9e9efc877fb9c9e628e7278a3e492ed32c59040f37717154a94c155175bf4ef2cb23fb4ea551325ce673a93ca79b453edfac92ac526594db9b2f97093f8ba980f4496de8f2895a460f3b358fa633b58e3c1e84a72ea3f9709e6754a7baa6cbf5a6bc560bba28d15175455729357ff7fda55a45cb6195bfa98a34bb52f774731d97dfdf66af8b7c9d14d5bdba89d6437ab952779133b02c7828926a53644759727b7437bccca8c2b4c21b55053b75119d4c07c7b3893f09cf174fcf87dbe07c31a09b69f2e58c5fd0ed36381996f9a6a00edd4627e76f062757ea6d74f20edf944ffdcfa6e7b7e73fcd0667c1f4ddd9ece9f64f3e3d389e3d0d822727ea0595fbcc3fbf1d0454f4fc6472461f7d767e727e7ab6c5ebf7f4fa78551e9fa8efa29363fa70111fff3e0b4eae52f5a53b8cca0ea3a249f8714d437e1197894f237853970b1ef26191acf29be4cb1b5a81576959255952f8decbefbf7d9167159ee5f1225978ea8dea9d062ae92ebea442a6c81d158917f7d4d218b317dd0dd7455ee5808de841002b5c2b5ac2b22a36f32a2fc23b85190eddde63190057854ac7e9a5df4b02339aebb4c413afe475f522037449f0408f8bc8fb8c9e11f4ccafe3e2f3ca1f05fdbe77d6784420996457d5f5f129bd333767d1f3c934db2c978a169a7e66e1db617297ccfd2450bd62bbed15d3d359bf5fe97ef4aaedb61aca68263edd64c190407e41c5437471e88ccfafec3bf41c15715faba83a4aa9549ccd3180bb49351dcdc28a2670951457898f7ae8661d1765f2f5db6f5ff197aaa24e0cb37c91bca5714faa617e4b2bf052ef24ea5498abde2808d48b61959495b4d6efdf0dd3f2f5324eb3ef2f7e4be6157529b8a49e15d481a32ac0dbafcceca3d969310b26fac2af7027a38aab8a3e52fc64ecacc84e5fa7b4abaf12b3a1bfb8ff66411d78360b54daefa718093dfd8efadeef73337aeea353c56d8d66511a28337d55725745b9dc96c932c1544689729b4bea89f01b9f99eaa478b399206c0c3709269986585a3b020ed3562f8a2c2233fdadfb612f9bfd4dcc15f6c12a7e9f7c5e14f1bd2ffd0868f399cf42cf53d2ad70a4aa9c8b85cebed4235c0ce7f172c98dd3c734b5ce2ea91115c12bc1372fd850d7e507e1e84c1e4d9d391824335ec96932db29e0ce37553c7fdfa894d179e4026103980907d44b4f5b3bb91190e23957556309cc8daa762a89e7d7612782ba1be29d807bc2389697a36ba41ab700a3ac5260333a43b2047b94c142f121d0318ddc193b5c7f318cd7ebe5bd34191757bc7778792ed3a2ac0e55907c2074b253cbf8d122845668b41f3ae6d4590795458364e0f31285a3c65e72fa999d4523daee67d984d7709acd66e17446d5afe275d7f4b43e0704ae6590b670e51c7089062e39d930fc245b1c9c3dbbd28464da4001080c04a0c26b55e64545fd1ce257956b5e13dcf2d54ed933d53918f08c5e10ac5017f8ce5c3bddc12cf2d9a0e8585039d11076ed68b36fb7442cc4b4c937f56333dbcba8773a06c2f32ef27c99c4597d6e94b4b79751d9a8ecd454f62c505ece83763ed86e1b08a40cb65bbf244a25a096a328a6fa4ad90cc7c77130de9cc5e378300808e163967a919f382dc53341c43809e808cb22daad33a2a412fc9484820a74afdfc74f1b871768388df058b67c1104c1c44fe9ff345c22b732c1fbf232233c07e009ed73b72e7e4b4346f39159077f49934c95866d5cc8a5e88501db7267d7ce7f48eed671b6c8434f48396fe0af07dfc6d5f5b0c0e3951f04b489d7cb789ef827e72f8932f2bc406539911b97041fceceaa1c407d42337b472de36a13e01034a4a27da16f63c2bc3b95963f300ea179608cf1534c04c6a9bace978b1ff6904b32d17805a50683d05030bd51272ea2058ca2de68727cec7cc6870a578d55b137544e253d14eff71be5cf46540c074f992f6f929fd2eadacfd5f46e16c8f620e2e6ea2aa155bff3f3c0dcf91e7fee05c3fcf2d2de60dfd6e0d881163cf30854d0dd10604c2ff1919c3afcd7c0093dfd896895fcf6d041d38be8f44e989ebae582a8e83b02e7229d777cd3a397f1773e13315f119948e45740f408751818403a821e3d76ae2503cf0bf7f661b2ddba23334f27cbe94af05a12d036369f85e63dbaeb407ea35dd095ba7ccf99aaedb6263464f3cb1461287a94a7e3aab8c7f7898b17fbfddebdee8bfbb8c67bcaa3ce989bef2fbdbabe1d31383814ed3c9e6aa2a737c208be5cadabfb8e11f0413376308aed5ffd755214447fb85f55d7457e7bf4259ef3825892738f26675a9c665553dfbd9afa76d66cbc8f6569c3fa594484400416818ec2ed361f33811fbdb07436a12d424dd399412b051d7bc339817995688a52c8d9594874feddf062932e175f15f115bf218a86ba9832a979e7a7816654fcc0d2d3c44616c403d0472041f9b8e781feedcdf7df85f823a4b63cfcb93178cbe48effd8040018b2080c243152af511fd18951260d7c45c48b70a534ab1ee8a393bbd5d20bf49217b41e35b6d5abee0bc351d3d6e517f76fe3abefe255e27b5c6bc1cb4ab8418e3ca6f9f991ef7d93ddc4cb747184311d7903504b3bc2b8f9da3deb89ba5ce617f1f24b2adb35f228a117639cd4e099b15ca0e24f1911688edbf73665728499a10d44271191efade5f3ca7991aee925118a422fab7c784d780c04199d1e2fb03ae0976a5641afa3791384e8142ddd9c86be044bdb8539127bc4bc571e71cb5e7de67ca7be0c30fa4582c9eba64865bbe33dce19734da4f5abfcd6f0d134f0aaf9a483c675d8d868c402104d9094d16f9a1d944d55060fd8b4e3fc8cf85da1160aaa5fc8d4649acea826220b226c9f0b9ad2f7bb6449738d6f52d9e87ff08bc36d099ec287a9c2cf1f6beff1afcc4e26ac0ba8790cc9138abf31781b04ae669d5a0b84c9cc803d6a2c610f25ff375f5333042013b3eb33b52f2a9810b20889dbbb9606336269880421bc9aedb7e90a84b8abd5e4f834bc32a4330446d45d34d5dd55b3e245bdf8040b2cc2c836ab8ba4a8fb95312938cef4ba24d36230984515cdea9867ff96f600716474efd2634e39fab1336e98de42d1e45f11f41f044cc28b394167693bc8e74716f57ad9b83ccbc739f5a5a03b9ad7694e6569aa84304d99d7e0a796144cdbccc947370191d9b4980c970db024e8328045902cab0c32389ec6ba02d0a0e3365cfec1cf74772ff586a13988c1606fd2059189743edf75421e180ffde91e54c911c7843bf05a1265387a9a9206da329aa3b72c807a86836f9fe94a0c8f2aec168e2e967afaed0a84001ca2eb34b5ce0f4815fc0e06742c86f589a2e2f93c29cbd61a199e4a461ad34a6dea955a4602fb7cfed594982191b2809018d1b80c3831d6210bee86d20cd71eab8c581d45c4305ab098a8cd57a08ee69c15c25c1125ae96603ce850d1488218131ca9603c4362e0405a1cd8b64bf9e20eb09681cf555afa657933400c752f53e5a4080b83d3e849acccaba0e676d249122e27a61f34b313141aa15098e368b90d5fd29947e7c6ad2a6ff73603759ca75838d8871d4f5a2e82b892b612644bd5fd92f75a7d49db3ba75d423484c6f0aa00120cdcaf9dd2a8a8de923b2b95359293a883cbcac091d092be4c2e89704816443a79f37cb55e265582c5cea58a37150d6e5226d5db7495e49bcad755d332e4c378b1f8c392e4fdb28e181908d98a792013d25222ef0b212d8fbe63e4792484d49101982346e04798ffa31f92ab2fefd64772260875ebb11082e8902322419afb7b39f5a602d8442255036fe6cdf60e789a6dd3ce6fb55827a9653a9665185b8195c32c4c7aa7a1104c869be8f7ab496f147a317a8d39ce5ca6867607d1c3fe08a4063d6f1f1790049f413e747c2aa86f57456017d547d434b2cf09b6376aa9e66aad2ed5b55aa82bb552508a7865fafbefcbc41b1c33fd4a33a92e5ccdcd2da186b7f4ef4554560422efe5e73bf9f912928737dd8a08308dd5c4ff129b7c1484c489fcb6af317a199d7ef6d9f353f539ed8cb6c2e6158eaa0fd1abe13a5fabaff10be5cff7e6e22bba102dd06bbad234e976bb2f871bd14a39b2b8b13e75ab7125d490084d675829ab76d00b7a7cba533f44defc3a99bf4f165b91e6d2455cde67f36dbca9f24b9aa792af88debcdf42025ae4cb72bbc0aeda2ed232be58d207d7e9629164dbb4a4d372bb245a7cbbda2cab9436db96469b6db1a5f26c794f171f366981b6e6f482b6c7b791373d3fbf7b363a3fafcecf8bf3f3ecfcfc72e6a99f220ffa25fadf704b056e8f67dbe93b2a381a1dd3df78340b069e7a12fd642961efd653deed9f68277c1179e7e7536ff0edc07bea7b839f065ea06f26a13f7dfaeec9b6f7afd924721f7e72eecd02bf6ef01d7e67c1d349707efe7c4b953ca14ab6f49f7c43ef3cf54de485ba7afed0f73f5a4feb851fd0c866b3ad37f8c20ee3b9fa6f1a59f034d80e9fd2476852fd1ec9e6f7bd77dcfe806b7a67ab37d5d257f2fe094dc515cdc4afad0f9f2af9a1573fb75ff9d3b3c1bfd0976fed7c51b11f4d31dc4fa9003dfbc57e1a994fa923338cfda93b41dc857f9ac2df04eaaf6e9b34a34fe8fddfa2876f5e86f6f99fcc8205eac5abcfdfbca9dfd0f8ea776f3fff6bfd068f5b60405d97829fbf7dfb43e8b4fa45a05ebff9f2c797dfbb0fa96b2fbefee695d30d42fe8056969f6f2121df66d535fe1de32638f699f1dee697c7d8f27afdf56424740a6cf3c58216091ad46de09f9f2f9e06d9d605387ea1efe9f580d6d94e1dafb99752ef217870c63909bdc10f34ae27fa7596248bf2852825c2d672ca6a86756f920fdb2b1a8b8ca41e58b3ef7443db6b114cb8cb4e87fc49347d477d7ea2bbb653ff884ede4ddf3dcc06e70f50ed4eb3b84a6f92a3f3db13f577510e6b45308d8d15c05b5a40fd00aadfff914269b6de541af36c31106295e3edc5a6aaf28c8a111b5651c1ebf305ae2bbafe647b7e7e72a5b2ca02136f25da498bf8f872f670aafe6bc71d9f6c6554b493b8d380c6629fac12b18d37baa373f2f8bffefce7e7ff650536e00bb6db0c3a8db3622267f3f0924ef117d771f1820e3dbf18f01741d8f5f2cf7f7ef697ffda166767a723f5e7ff7afe6cb43d1d3d7bde2f821d4b54bed7b4cfabe82b21be2e5c718e6adebd9abaf786f4b727af16b6a4743c7d1f3d70bde12b5d6ad23cbebeb624976e96c8c75d27b397d4ccde682cdc5a32cd8439038f46dc8a61cb7092ec76969cc82b9e5d3a9ba5aefa80bee483f94edd82f4f6ab2e8def4540f3beeef7e7d43391adada9074c1faac78555052a3da5affd4d546b95837eff2fa0cc7529e1ceaefbfd5ecab28a3cfabb11d9e1b48ca3dc68b4ff12e12b966744555b111c07aa576eb7bdd211ee34fa510e897589a2d83e14f692d6b2b01c436bf4a26b693edb6f97c6437c14311efdfec7dae0f14163addf1b902bd401e11b2407dc7b9e87e7d0b567cd922f086994224baa0ebcf9686bb62446435d85dc68f8a184aea9477c5e6f21ca7eea0ccffe2aba8a6ed45d04628dd7a4df4fd4a95c3802eeea80682b78b88cae509bf2af641d3faf088208c5d069912ebc2098500bf600a92a4518e549df0bc26a58b60bab55a05644b2d0bc7fe20d5603ef93d991470ce5a5a1be649f2c8f8f83cbe97216ad06abcac75530be8b7e34e3c2dcd56043404f23bb1cfe96a7994f982ac084dc06c0117b33793764238d375aeffe39eddf5b9e4341006f83871d519eb4adef1fae20621571636bc0b45575c5ef613f6246febbf29e9c4298c91bb7decd2085455b09d1867d5cf9ccfc59069f412f1b44e046ce52c22d4456bee249e9f71709d8aea36a4a4cdd757a4915ce5435cd6651617a52d5edb156c5d63bbd99b1daa97ebfa96ae277dd16c92ed21b2f18d773d7eb31c325d39339fa07334d55c390a33a2cabd5fcb983e69655135d6a6e6c8b83a6163f0944140411295b9c7c1d670b626bb36931234c5ad7366fd4069d20d5421c539bc73a8d2207bbd19ef957a5cdb3be0193b0ddbe24fae45f49fb191beb3430541648d73262c3333a79dfa4174b42acacdb451b816112ac146e721a12a2b73d5ebb0be5b2e04658d8bd218dc8898f7e661331efac38a7913af37bf9bf55bfef3440a78690147c171c68efda6d8fa0b043aa5045834ab9af5c0148c45a99cc1c9b0432b00b6c80414960904d5342aee56c0611db3425f0f60bfce09ace62fc47df55c46bfffcedab689fe14b5841dc3a3793a06d8068ad30261e945f4d1419d20e20be915a2937eb754e7411db26e68cf24cad5d6d4ff65b0e2f602c3924963026a6ef9f69726b9b9693fc2f2d88adda5d9df86bc2ee97d1de0b751df54aecbda2df2fb07b08a9b094856e419dd1c2af5b6f7c2fcf2e126282934d261218471a3987d88356283608b18c36ce62ba2a97b939a422a27789f0681d1cf6b52735761da707eb6ea88734067b91af0483d181a49beb508e3db52ab1fd56edb17ab0dd34a395033044de6784288f7810d127f127679f9dd0fd59e3e1516a1e7b2a1932c7c03d6ecdcc33464f07ce77b00aadfe828a39d0c1cbc6c41044d3297ba37a2dea01151391d0f1d4bfe96a6ce2a76cb038fce6654b92030a4c0b9f5a6416c1d66ffdfe758d695a54582d12cb4021d567c6840eb5703adb41867d992eaba468365b4bd9cca94b405e54b504bf6bd9f649166ca8dd2e087d7db0da11fe1f68560f59d3d68dc63142999afde7d2b1c6b4dcc4cb4da2bbaa741789738fba77cba4dbde777f81ea4fd097c94152d65112746b3f20801325cec13a5843f0940d6e8307735ce6ac9b0a7010670e5ad3d4776627c11237f9ce0c9f851aed097068ea7f630eec571a58db1351efc2c654a82b0c7b813f427547ff10b2b4da232dc16af8adadda4422c2b79f7d96af8552d402c4e813a00e794817ba14d0c81ef5ea4dcd47338b2ab6db854ca523c79b840c4d5b91807841675da1966476d454bf82655b734c6693b74849211f8271d66203b01c44a68bcc937be2a2ad2c6817f7d826ac73ecd53b9aaa7ae04428ebee424c398becd83ff9647bee9d1f1e7792b11cb66bdce695f24223ae3d50cb5315ded12bf3a51a3e0d3d5630119cac403f27a5296f6066456ccb6d72f13eadbe6d16d86e2f87abfcf78ea77957c9b2f51090d75aa46c48dd9fe7047c00162e1f19d3282564bfaaefa7650f33cb03bad203ea459efa06cbbf881676c2b51069a1b9af2d4edf2b62075befafdcf7f766f8976c121ca719ac2671b3c2496048a3d77999a2f7932ee1ca5f1a54fd2469533e21a8ffaac9908c1dd5038cf87d98f29f321d541b74f568b54caf26f525d1fe6172a887c454fc57ffe05bb6c46ca3509c9d9a7fa822b793ac7270940dbdd1d8f25cd0a5fc91592ab87e1ea6e15844cfc2465669541c1ec823637c6c80b536f4b49fd224666ceefb3201590965eec1160934a99be92411b5d6bd7fa19200361545fda00888715a4e5e0b742ea9c0b1bda66519859ff6537c727a7885f875630df6cc10ea2520b6c3a549609290ccd42622da64ace7356bcd2b0cc17288b382867a8b5a655690fa9fe3a63c3c908c063266795a14d95a98a7c5811825860fa6c57380251e6e329108600da2acbbd4c62d252562704c51b401cf44a771bd7ed424de297e13ea6217e8fcc65c9f425b5705e17aa788e1d1a8ad5bcbc7924c76a4e13f50ddd69f586cb8b735baf83323d54c58aa6909b15f94177df2e414a780a21dbd876a6951089d5ff5fb57fa982654b3c041a1ef0216148914dbe2bf4ad8fdedb6036bc2fe7361258ea7c01ff5835a6a6a69182d5a0e1e76f59c546a2d13428065ce9cb311cf8d41389df3f9917961d71299627068ad2a1eff58db5f36642c2d8d372c838891fc5c66c92da95a258349c112ecdeb5433a19180384db87139799c45a4d5af47a054b82a8835eae70c8e5c3729dccd3cb946aca85600e31b130bda0596093c7e89071abf7e69ee6faee884ba9a34d5624f3fc2a4b7f4f1647c9ddba48ca1236d46c24c9d56db2948efd3779d1c1dabbe4306f64c20c043dc45dccab971b383b106d54c2f74070e39b0ab404b02a6ba9fd11880abcf0df04ea4b432c13cb31cd412cf39130cd676c17a329e53c30bb393d3e0e12ed52c1c210751aec6a6bbb1c82099ac0b730b2ecb2e6f43c2829d841d300308620127ed0e984d3ff223fa77c2bd6d17ba6746cc6a90d3c2c26741fb2763d895c7e789c8cf1c095a065832887e19f91f33f97a63fe5961b5699ffc48ad7d68863cc57311b1783815452b36830e813f98ce085327a7044ace19f474ac8d7d765b259e4615c294624e1df540de6f089001b82df2259b2c62e7cf0cebcf0619116a157a35c4ffb0ec1c2da3bea784f8f07f67191dca4f9a6d4c36f7cfbaf43858845a6475f31b31a3eb09eb68bf99d9ece22fc6931ae2a993e9f457e32fd74466860fa67d8c63b66b1ba90f7af8859b7e933c01d7fe26137d0c5808d662cf0aa4f69878812f8d15e34b084f2b2ea5a1aa057a6a6e7c1849bda6ecd06667b2a74f9d35934e03e4fd0655cfe17153b0dc2674f7d0f9a5aa9ec395bfa2f16e62ec0b77f966fff9f1975ffbff70a84f82174d26a7167b4dd5d9ba6874a6903d3ec1820fbdb90e740eb2950c784b1912fd5a3ebae91db04df46781a127df54ff90ce255daf757d00fc07793efb2daaa3af0ac88f4b80a8eb3dac49b5b8cf0c74e26af34b591394fdc657b4e9429605aa00866011f177a744ba385c99fec992e121477db64ff31a36aea9d1832ecf7ebc5340114dac9a703cdafb5dafe3b6b8b41c544950df53b26f4051d4e5d0e73b2001d686d5e4b189c9bedb653cad325e1d1024f2fe07db6a3cdd2dab10d1bc5cbdae05164e3913ed1fdc2f1b71423cc7402c60c531556133f1d00977bf2609282520dcdfb49dae3db77fa96400ec664a9052d2241bda7f54bf7c519d17fde13f79d40500d7ed2d4bf7411d8d40d524612ed5ab66ee7b6dbd4c2a2a96a70ca950dbc632fecd1e62608dac72dc648552bc82346254c8ad5a04d74bc071b0df7f9f1a73031f6b4e509f7c4cc270eb642cfc9a4c377a9e7f2080e50a3271be947c3822ecaa9dd72e239279bd781ec570df68f98e3d8f874efef087517f536fd7e2fc6e9bc12fdbb2115ae8287350d466ed6d17a7a356385fc647d787bddb305e2ba4db5f64ec78be88a66295bb21d22817d8f68e6c6486a07246a64114dcbc9ca39d4c3d51033cfd73355f6fb77c1c33c5a4d6f08f3faf8610fc965342702982d13aea325905714ddf6fb4b78b65f361e3c43d087eb7e7fe558714caf6776b48301bd244a16a3a6162ea3eb6814b014275ffb6c98d01c68bf3f185c5271d6d33da017d1f49696ed72361607074b7bdcb147a85f49d72bddf500843b3a265d0cd0dbd38661fe1fe9d3bfb938bad3dc257f2d1d5a3b1dc2102ee9d89251357d2e2e8fa394e694051f80f0cbff2450bf3c29ce22da5d1dc79c2be64d876b268a4a5eac14f239a13be8418b43700e6d8f784ed18d11392d1508296dc5bbd4f509e221848561814e277e46ccb64a1461b18ac869a7ad96fda7df365398b86a45cbdf43adc8bcc80165621abd364c1f748a01bbad443d512cf2935dd0757ea1ce111b908705483199a0f021cbab30de178f56600e32fc29a278df66a03927cd8100bdd476403c18661d53359d051c25a531a89806450c133b133349106338157ef2a0391898fed6879f44482880ce507d0e8709e2551858a180a0e96f8fac43b5ecf2fd95c3da520586b3fdc3b5f895cb3ac01c8365e7e06208cec000dab325c1d9824696717675a081bf6ab28c8fe04380cadf8b5b5cf211ca47eda9d4c78bfc88b5ff5026704d6d7b99bbd532c40b74a0fd4e9e5bcf41a2d99acd419294c8eeadc9c104c7a46103dba2c4b6b143508b1269aaaab8688490700dd774941e8e88a2afb1ffae1bfa2939514fc5f92d5d104f94e79d2129200cbb243213c6d087deaf87f11cfc9496dfc296698d26bf620bea6d7ded8382ebf5b0ff59609b0caf8be472bbfd173d882fd85e836317b0a8be9bf434827cf64ddb2973fbf1c2c491693d48279dfc07ed352af41f0168c46c9c5891b571c8d6af8c4ec7440839d437d7f4c6bdb315f074a8fa5e0f2281c370a3ca3fc497b347b319e499f7ff7adbedf3861c5e78f4a4839ea81d8ea5979dcee1e69c1972ffd8f50feea049d1397a4daed73d82e713e6b8abf4ff7414169396ffcd95740c630c443a8faa9d62ebe27dafec765587daa41650435d3fac5e98f28770a8854298a60d18b7b4a909f9d80635b97650a499243a011883b65e3bf2c769757c8a32c98776899a7b998eceb24936a8c28c4b1257be5f9be019f1101b5767d9381b44cf82a4ad004ea80262d30f7d7ffa47be5fee0da6b65ab63d958a8e8f410e8d4d3d45a39eab3f5e4f75361814ddd5b06183817262592207e63f584ffd87225ea439313e826d2ef23b5c13979ee0774d8ce46d5e2c709daee22b3cdc05359156cda23524db7575e5e6629542a0a48a8408aafdf29752de18722d60b1b85bb821598c5d4459f7b8419db17bfba2aaebb8aa9a9e928e9351f4de70ee8450e6d6a966320ae756183a26c2062a97193109e9d04aba0c91133cf83d78a815d1af627d1c072c2961dfce28d6d514108568fe74bb8d03b5d18253aa172e65704fa22a7eb655b0d366616c2c552ec51fb45c59425370a50efd76c42af1ba51cb11cb0294f0c632b317f4a8b9bf1155695bdc6e97744b589f5ee00aca42f6bcfb482f4aa5f51c447e1e681d3aa94cfb6bdb39366eaf613ca9855d41f81ede61819dfddaa66f55353da51ca376cf6bfa4a1503f67715297c6dc361abbaafdc5d93c25a2d2d24308d2b1905fb4d74eedb5a2955c9a9346970dc452d22af5889252c5ee59c45b99515eb2f5a4c7b2c3dd18e6fd12d6072c0f1e4e2fdca3b6aa73357d76375b53bd735fb918e01f0a3164fa97cf0c3a9c357ae4519b661e632e0b37bbbddb86add8d1ce88659950aa2e99ab60dcb5c75ffb6db421ea074ddd97a6d6e1a36c3353bd69e7259b916cb01ed0347c2a0b675c18e833f8460b26ef1aeda13e2d0aac3f3bacbd9b81789eac03ae94227a4591c2082cccfb922dc97463b0227e14a6e62373c91edc345e57a3bd7bc1d6d473062c027d10594a61c47a497cab314cf503e6858b26a04d71004452cd9607b211b7dea2acab7db17155cb9bda79e8a6b23061a4f183357b78ae0a9d1cbe1117a15d2545da94b628a51bdba8fb2494a60934f929088c722984c676119aed82499c8721f8e9b5c92d66b19d1c7f7ea9a6efca5c2ece2c53c5a3657700ea6714d6036e719bd9f5ed315f8c695be5a076c559f3340a6d01c3e887e6889d1cda3fbcefaeea5bea5acc18aeea8a271caf40b8d03c89dbab3fbc8e704d5a9d199e76a4d1c2c9e13bf47af723809f4fc123fe8a3ec847b1e3514e9937ba3155b28d34810ded37a4e74374a9aad4d101a1701ba8578d682c86de588fc718cb9010bd2a1d1024d59ea0ecc0c002e2125b1af70d2111843f73fc254751b970ab11843e44fb8a8bbd06b1b6782d95d5d9aa0cc957bd44e053d443383129bdd770864a048086a789bebe2e15a5f04bbd958475d588e973a4c5973944b3dca805aa54e12deb8841fbd88be1e747939ec9cd27a7225a2269eeac38b3ea53d25dc47110d064b6ab960ed1da0cb6db730ed36a45ab41197670407d20dbec4816565c0cbe3d3c0444ad0e727ad066b7d96c7cfa4ca09edc2d0f3764e2432e3c94173724638e4b6ae12062774229f15f2d48a95ed533e2f097e0c296a4e5eee610d566f1bce0a62426964254eec0dba896b856dcc07d5a5d276b5da491bdbe886aab88bbc91a72e203ea6276f35dabc562fa28d7a1fc5db6d09b1bd31228555a6bae6bde938d22ce1ba7d3bd0f11d5e10c43662b56db743a1b9dfc2b0315a8af5c0127e16c158c7b35b10757707771d5a44f6fdeaf717c1c39575815bd1dc5f41a30d0936ed4a1a125c8c64be168116bfa281dbe83b05a0a049cc21f15c44bd152aa3e53e3e5634cc0bf311e3a69b4174a7a8e01df5eaa6d962252daefc0bdaec68509ff1f8ea6ca4cdb0ee08e95c50d7b7db7bfeebe327fa205bee92ce0fc12ac1cea08a4b4215ea2da4f1fdfebd5d30eaddc0aee529ab146bb301c080060833c24df42250173b6b1c01e95f1c84f12e8ed81a64b5a6ce458fd8df72c096ef1c8aba47585a7461ecb6053ab76ae2d78c869a4704c0f02122c06541ac3935c3d45c8da95e6af02d4e3b20076b8d5b53f92faa1627a4d2ba314625a9ded02211a47dac3247276f2b7a6f5c1e73a9aaace311c830646422d132de62bc829be812d4d2a5a3f604a1af57e0191197dfbcc496a76364038dac66a2ad7dfbbe7fc535368ac53d1bd65b33ee9138bdb53dbcbf3488cce509b4f21c86467496231448afaa1d876a9cb13114be50cc664cbb32fadbd0754d365e6fc41f6df605d80f4cf66d8875504ea771c2bbf81264e43a928eeb53358fd68ff65f7bdb6dccc1b6ef75a7fd0b37e6882d15227958293b23c30d9c31f63d1a73f8496a4d8b81ab185a5922ecd86e8820c1bafbc13fcf359a896e8cab98171893196d5fcaa6fd376adff026fa52cd21436dd8251ef0a3387dc4d4b1db61aec328bad369243e82ac32fae44fb0ef8ecf3ce5fd49043b8e7b4853a283f2603009375722dfd9b2b8f33a49afaeabed6dbaa8ae3d75408f9c4daca941d836ab529e558036654584f49fb5bd7cf6ac893b47c7b2ac93b6bb4bd39a9b415d2cbabd8f0c5d8adab1eb2f0f0d95a86991a5f50ecbd21ad361fcadd882e8d0f2e97864ad9ed586e0ba733fec758bd1e1b8631dfce2a0315be118b315da982d91d023bdd1a4b54c6ce6c6a17cb0a7e9ace0a0abeba261e3a49f4d89c09140aeebc24a6feef4b914354e287a2ca1b6adc1188760faf9db57b415ac571d3db3768a8ecde2ced811bee44846360a4f2d42780935e5c3ae0e88c3617c124143fe2da34cd5d4e2b19f2b2456aadadd0d5fd0697c11cfdf970d3bb624ea08ebf692d595683cace3d1ee94ee6223f80d0ed10df15c43221513a69f9675fd6ba14f216d5d25abbcb8eff7d7901d8150235668a48dfee25a32d21b8d8926c8cfca7129a46c0c918fe0be350e84b5e1e74fd900b0cad7df675fc5444123da2331cd5a6803f914bcba37938dc5a9cbfadc08c26a82ae8773a3a4f0391662f4102f1a919b85da115ad37473ecd70eca50fd98b8dbade0d072acdbc85ee346f8d8629268d0e9f7e750d05089ed3636b46fc85a22d3f13aee00ac2c5014ae9a4ee432554cea590c2be6b4332501168c2488e37a8b5f714768ea98c35c62187540b50ecac968cb10a14907f9f3392a5900b62a88cd7996e13c8344313f83902a27aab3e42b9cbb3b13651c7ad12ed438a92b27ce9d6d5f7a7e8fe8f05e6c8ffab622a61e8989c3276de8e5ed2cb8899c00a1cdf21de1bb7bf14e2d7337c8bbad6ae356b3dd3a40a56bc5779d756e58abc0718b3b436862d4346dbd0d53a51caf5155d0f357420a4df42f6135c4bb9e68316d1584bcfaba7934d1d1edf9d0b4dd8ee0ee7cd7d5eb5eb6b38868eec6ac3621cf3a3443d3a9a78334d3198668f39e7270127c67e7c99160099c70baecc29b297cc8a116947719a7cb8f7df71babebf8bb2cafd2cb7bfa6e5de457304a6e7d6b3e9bc1c2c0832715db1515d14389e06c1d5396ed54bcbc8defcb8e77a904d1af677188eefa7bb35a5d2799fbb90438b0c56a0c6f03c8d53edfddd8c650fe510e141947cd7089740e42e04a3fe3748a1823337fbff57e3fee0ee63f4e24ca795d9f0927174c927602011b885b8f1df7588f606856c087df22568570dcb41c78803e6fc68d325aac83d549aa0c157390d1ba3f407d080ac967785d1836bd7cf948bced89055438fab0f549ea1ca974c0a7eb04aeddb4406a7f9e13779e9f619e11a8645cc89c462502f1e1d0e10b778ae9e8a0559f9ebe4b66f49d410ef4e419df0339040a4b33a26a3ae08adfb4a72a9d1461f7ae6d96a77e995d4e1357d8194b39f0b04e5d9072c0e79dba6d8066437db1172e13b28b3a12eba9842c7a04588a1067fda9f82c858df088e5a19093ae3967050288479ce1aa9d9be0ec74b2d7c5b060d38f78926ba0135c078fa1e3632240f246dc783cdfed44d5c64e2c67a71cde328e4c5a00a274ece5d25e8e0badc6c9688bb5c68f47f514b87766cb1009a1e0c3a4774cbebf6350620355093a6ddd8ddabd471d5078d90dc114ae89b1b06faab217525a3b9dd2b29a578685b3b1c1116175ef3b71802c3b6254332ded41c756361c55f74a6a2b8e5ad390897cd1975f1d27f022bff3901805d7dfc3f30246b942ecd363aae38d31d588add58682bbd332c57efb96e022cd7e00f3070ab41a527d6fd2df09e3ffa04bc8e3757a972c0dd70a3a3233f626f23ecb5f2c69e15ee867f416b7cc8bf4468129abcadafc853fa3febdb40f62fb523db212c68f1d8ebc662658bf8d69e08b7f9af7513d156da761e37e4c6c2bd7d97c9b219084bc2a5adec466ae79b8f07c6c8eb371674d56edf8ab211b2da5d9179b0b1a68099b58fdc443944de8c011657588b3f8aa40eeb417cb744d6b2d466bc7bcdc69ab9dee4f182c96495c7057dfa04cb31e36d6eefa56ddb5cf42f036d4d935e170028d70345e31dcd0c5455ec0a06634a6b5434ccaf002987b7c2c6ec868e7b864780a9da6c7c7abfcf743efba1f7b38590e44e1b8c891aa82d03bd824bf03766437667ab0f3b264cf25cff69dc51ef42b5210ba586b480fe30b4226041363e2a9e8f932b9acc2e3bfd0ffd6777a0e8ef1e6748d0eb61dcfdd5b1c25ae74c3b34b6dbbd33167d2c3ce29735e753f358b457d33ab84cbe63ac9c83f6d8de63f0f4cc0e97f825644d8613fd6d1af633d8adff37c3579c05f38c6c2b1a9862007ab44ec74851423b4e37e42e3205cb0ae2ff2156df064c1800a279516cef1a871c492db2f4c0735476a465a1dd854ae3bd198478364b9eda315c87c7059aa8befd862f9514c2d323b4215cd056d2f70694bac1cac6b9e715bac73499b4f18b60e606c27f349d7b80a77629c46a16a6e84d3ca58e4480c52b5f3a92c4b345ea90feaebe80401291fa6e7e5f99bd9d3f3ddf67c6aae67081bf93d15987e7efc3f4812584b68bea24597c8c843e100ad95b5247782b414118e46eaa161326a489c87dd4ef3c3439df047a44db86aa6fbd97d35444cf253f51547045f574d414ec33b889594a72d03c3a6e33f3bc97de5e6147c9fdcb7b3b8f46c5b758e9623f185e7b84d1ca7dde9fb4c9bce10c9cbbd1d0c38205ad52c1419839c9dea9aba3429c51bb7cee3d1fabe4e7125055d21874cf93463fb10f79e0d4532d8851e4c9128d18c691ee0129c47cee7e9ac33596215e490326663ebf3306ce49481a3a9ed6aa33a384a5be74b9d3e309f161ce1d2fa65e5ed54754e94b6ba32a7d3b54757d3533804e57920607d4be2daf872bbad6af1931b303b6bd48f0c16e80475561c061a01e935a3a2dfde0d6dc611f6210f4d4640a30fe3ac086edf25935d5370d54c2560d64aaf5ce9ce8ec064635441e335384056c1d739be2ae256a2caa8bf2b4effe6f41b9d46be3077208af30495f4ddb4522967d649113c549ed29a86454354cbcac5625fb9a8c32a95d30259ea762c247b19577197d56f1bd89c61b5b625e7610b58ba358f8ba678a655b40e9978b836ead62b365bfc4a7dd0bfb50c481006f7d9628f474671f46aa85f7286a80fce1df5b7f94d83377ce52646c82c8cbc6c7f123cbc32398cc4c7ffd7c76afdd0aef5d783d57e6854bbd319c7cc34ec35e2c0abc9aba9f38570aa231d1ec30934af23a85b31b05f46af78131181c56cb4a378ed7d9037cad3d30712bf4452b3074942923a3aa9b1e1db6d52928cf6c110ac80828f4451bbcc6214c71edb873600bed062c73f131cbf86925b71aed360fc817772bb1fb0b6d9592b326dc0bd97884c126fb2d8c539275f718d92d5125e383621462b2163addc773b2aeaefb4dfaff6263733d3a99216d2b19e24e346b1e2f162320ff5db0385c5d0aa73a882daa5394956128c9de11740adc71236c3ae10af4ed64c00e24e19a7a4dce920226a5f6a226f748abe0e486fe5e5dc5b1cbd07ecfaecdcec0eaf9b478c8eefe9f4b449a34832240d74833a52c9f7ca3b8659d19e5b4f4bbd480bb47758410fc659c4bcaad8b0d636e3541197501ae9dbd3d0c32cc81dbb9d0fb28127b7832cfcdab8974feaec66aca4712294bc724e3059e12cda8bdf91ed6a34f961936c92c78f6264e38dd8daf1f20e61e5f91388a73fd4272d125b2018532341254a3868cccda09b210da55133053af9891ba46d91ecf70cba35dd8db1c0284703de24fab4770581596d7d4da57ee5625fe7f9fbd2b8123ad07337d4adf1bbffd88d21fa30a27a6038d164d91a11ca16f6a43e7a224e24990dd0e37e1b287d88e5ac228418413c154b363eed15fd7e8e1028ac4462f92824c84e67bb692ebd045cc21bdb13431623e3d3cb4e7ba61e444575585bd196133b07cab4b2cb9dcd24326bfb80e95a26e9e733e322b317af9c232220a712265081de50d9591b274ccce29a530ab9b81bf41e5e848f6030b702c640e326246864a1f43252b7dcc583588f9a8524d580478d5d3ae0f3710c75a092e55e4a346b4e4ab37c37c11f1815240bf1dd4dc244d94d20fc9aaeb553edeba4f581cd2922f9111cbab0cff22a53a6c0f2ee143ffbc7a3c3d3db45f7839318ef2b3e5c4bb7539536731109ad6c8864bd6f63b76bc7c78598e13672aae6d4d6f81190720ebe24d21d74630f6bac05cf609534761263b14c3623288dc140e93bde218e057a0c11b49bce4833f03fa86fd54fd1c9f4bc3a2fceb3f3cbd9c9957a129d9c17f4fbc51f4bf7306e6c2e104b87b26cbbe4c71d93552ae93c5aed91fa79b3b68f016cfd910bb334eaf51feb124a7eac4baf9bb53dde258723984af55fa577b22d66e81aad12c7fcec0af662734cc320d705b94d87e549bfcfd1935ae9de82c37d83f98564751f9a4ef8499deb9de640d8189b2100c9a7607927d9a223edd3dc60cdc6716d7e228c3640b688da515711eed0d42b613b9c0712c0c310303fb1b3132ca88920cfad7d6d8a6c64b0af1d9d3934b78dffc17b2192ebb153b7c91d5a745a77fcafac04a8fe36b0d4f92efecfac8fd3bbff9b97e8c00ae9e8801dab74168d02440db47980cc1bb5b76ed040eb9583dd7c63f1aafcea6ab9b7780ef1a197c09ced5da981eb0523c2b51276aade16a2506fae4310b6532b762c62562fa2d3cbc62266ad45546cc6bb0bbaa98486282dca0c848a35986e8a6df91b6b6e7cc34a09829c4352203da98249de18571584793df24a2872e63d0a3819dba98b246454a3ebfdfe879a7df27efdd5bef8f557af0daaadfba8794be8912dd59093f543cdd4b5eaac031b99f17468e039781803157c1e8afdb46b05bbf20eaccf1cb6443673b702aa308f1fdd0316c62b816ded86e604533d90dad8156d8cd974ae8552ac2d77cb42c508c0db50c73e7363331e7730295bd0b9f0672094fa06fb3e09f5015b833442d6f376d23f7a8e804f61cd37a501d77bc7d2beb42bca95936217a9d439032695a716998e112119db8e831f6b3eee36ab9d29deb549059528c7dcb590972a63c6db6b0864055a45319c1a5c6963fb35fa937674267db42757b627be8e3defd7fd085a7259962a89ce799feb9e6416aa9ec06639d4894f317930b3606ec6505ba677e1835802844d5d89135da01662359c6525ab776d039c181b6036c9dd99601007ea05e452ddd276c9b11c9b5120f2481b331cc36ecd8957303a83c36d2e3203f6c0cc09ae4e6d581e1cad67e9240ee971a89df6e3b3cd78638eae62ba9949f055d362bfbfe971cc46df5a6fb8b60393cc9a0e84a2969498ee9d36d601c2b03ab12dcc1bb056060cfc46c05e9862403bbef6b48b025261667a57a9bc4ee3581a43c35aacb7a75971259ef5f4e6912b8f609140baef9c5144298e74081a8d55476d157a4707a8ed127014c36b6f54db8fd0f4b516313a3ea5d288d8b447db3b118ff8d471037912b2798e6064fdfe7fcbcfb39e1365b8338a1ddbb10999ac6b0f192797621b2406e12cece6a8334d9916101087cd14d431633860eb733e0b8738bb4c00dec9b7e10f8144b7a9f7655a6f63bad420e2e734c7466644872502c38af9bb04c7135112efd0dcb1b8cf030b635c6f69ebe50a45e295a1ca066aa0ea93a699094dc2008e0b988a067fc30dd79ce3218ea9094becf4076469c8038efebc274c6c254dc0a16358176807337560623389fe54cc22c9afdbce6405ad805da2f081830a3cecc33e34a1f516aecd75681ec588470458ce4ea4eda74d7fea78428d900049673e002d05d465912e9b30de4e18461a6cf8e011dea123f0ba5a2dbfa22ba5232a864e2a990ef6d2d18bfedbfb22677b4044256e403c9c0c8151ec3a00be2b86793c3130df5601b640af7804f40a71fbc8c2ee6d5034b6811496a19bc5d47190f64f0abb0444a5b94b105f30b90432ee8bbd483926c6d2a48eb01472c2423afebe8df680c6d143554239b6364b8650fa0d18c85863bab3799df7615ab271e9bd72727e3b38b96ae76c36523bfed48d62cdb4834513e3ae124dd34d1be8a0ab2802cab9fe3c9dd5d5c8a017993a3b3d781c4f98fd5a52a07ac700d2350f64946ad7bc3e5f0e121dfb79105ae9115ad9db9ac483a46e0bcc024d3d03091eacd5e3c5f70815a9bc557c270195b13f93e5f2cd3a9eb3353adfbd160b277c92dfd2ab0ccff3a5beda94c9b7f11af6f10541dd176c01c505d8a6eccb45cace7fde4c35c44c761332d9da200699de76fbaccd0e6b43cc565d0d6277b60fd98eacca51b2db485e8db33d31673bf31f8e6e62e7aca7b602e5b56c368e0dde651923c7db9e479a266e275e9e11592f3814f276962b7e139dbc7b9fdc9fa8df4588b8ca69aa3917359195c4d96c82ed7c99cedf9fa85fa580b67094e4d5fc37df5417cb4d010ba29f5166fa6e387bcab96f87fe10d9779f9cd4fab21fdde0bff6e92fbe9b3dd03cfda72f9a2d83729b51e0b46a8a86bf83d209f9c908c097f905b16e0f2cb16b87844d6b07e2ce94e646ebc4a7ed3dd2a25cf3762b58334d78282acd13dae8a575a5e384589b14db4e2e68b1afd83688e3b6dc4be790c7c4b993a82e31dd4b95785bdf752daf26c5eea2da0e9dab82c0e5ea2a2938829bd0ed0e0e3285602ac828537c20e221757ee598d6d38cf1a3887328b04eae2992f23c04f3a8f6a3716c4c90a40adec3ecc0b38856117ca3d555e46fa6cf740471ed983b349eb981223addbf8c4c1799bb8997d3051b7350257e3eb91c42347b155762d27539c461c4c4cf76bb5007bf5d4735efc5e4cb42e5344b5cc74a8c2e52a5d732cc14562b94b5536655c3bc99043ae709af8f9e7d37ec9c0081b013cdf33c094d569b21123a97b4d48862bbc0fce82b8eff6247f722df647043b8c4e1b759f7fbfac2a8f55202d1189440ef14472e413767e47b9596344840e8fe337f812020a7443f5fe21da61abfa6c675a0d6069c0df8361f443223085f31b9368e6fad2e0f066a84b82bd7c22dad19a3f28ac856c440c1b6687f964e3ba756a09faecd19b95633905fd51b75c5f12656669341937b007a976de85d4a70a2b806e0a506e0ebe82a8a01c00484f123007c8dc0ca6d18bc1618bc266a6cb26ec2efda85df6b82df0d9766c63ae650fa4e8c72a49df706267d1292b44fc2e153e4b80fa02cf77185a8e560d0db5972731ada3cba9ce6c47c1379784590331f9a2dc01e77b2b8fc5c563f46b80a81e4f9d00232e2434946c8b9457890b53d7dea89434caf7e0ee0be348092c343d2fde6b20939c7c7047f020dfdbeb932c0390f823191dcbdcbda36684d5d8b8b457e9ba1b8b9361f2cd4ca2052bd490c6129992b1382245bc2e8ce31f9419d21e21aa66c9bc02ca755555f0f00180ca500e5b651da06dc94aed134a11c4db72790e971387d8dab9bc1c62cf4b7603f9ad2fce600c27b13dd4787719c4892d5b0220c5bbfb38bc605ec9d03b7a1a4e8866728e7fdced5f3468e2b6176dcfbdeaf02128bc1de71c3e3aef50543d614d0b3ab68e13449bdbfb2f60d577ae320ced4e8ccf93884320814ca60012df4d49a08cf26550803bcbba12ce442ed99558156852f475abe95ae45e9e459f85c39531039e8d87dfe6b9144ceed646ff75d7d74f78562780465f266d970b71d4a145d4841f465045a2132824222e3c386e58a62d6f0e0a186e4599766fa91144d5fdac81999c0bec8b5b0eda978968b7b0add00707fa219cf6f119388cde6905ddb45503854f5822f070ba14962376cf0381eb79e5c9b286a6a13c563049df38b763221c4c3d3e5366e32dbed76a3ab927e2178d8aeb4ba296afc7a5a42b441bdc7fa42a71b5f71c8e13755be5e43dd1fe87cc3e5d9e964e9100818ca3af2e5a888eb5dc886a253f968668e9218e67cd8b8041e08cb6ea824f09eeb68deefc744dbe0cdddb0b6fea431dba2cd6fb4fb3e826472b32f65c8364aec91eef3026b8a81e902afa5388685f5fd554f1570a7b9d6ad5c4bf06db3e42c87707a06543c6f79ea15203fda60c0876781c16d2078401836763350fbc4e542a106bfeb550df4b6960d74077a53ecd82e97c9cfa661ae85f4cbf4cedf0fbe2021fff6fd3237665945dbd45cd9c4e8043852437b2bd9b70f3b468412d93ca93781ec53aeb8b7c4fabdd43ddf6e1bb78e6a461f39c143699bd36454e9964274bdaa86ed3422764ec3767208b61fe844dc14d820ba63a9d0ed595d0fe494a6b969e654f8cd6a952c52a42deaaad9a7322e1a0455e9de6b92d621045868a89ba2a32fca316d046c51ce3faa887cbf3dddb9a539c4405b7f0ed74edde74003b41e58dc32ade736356a2dccbe62a7dbe6c652122ac319aa1fd4b12168e5f2b2324bc791cd9cfbc652aaa4065a33ad87adf20d8c564dda8683540aca6728ebf737ae6e8fa65ecc7288ac64ee5a02d37024a5404259f6442348f874d30cac041520075632aa8e9e84fedcab4807cee3dc02a371acb5a779849829ece56a283356ba163ae79f33effc286ff039933b3f55a285e4939b681f08304482a65fe9e4751b9bbc2ee0ca6d0edf9c90a0a5eab492e5016b1f6eea092f76b5e58731ed895bc5b931fb85091311c3f9a9149167197af1b2fa7b727f74212e9a47f3389b274bccdbd1bc2a9678d5d85f470c55af89d780116315a30007ce4a16ba005332782ccb7b54a5abe44d15afd6473774a021bcebfcda33e40ff260116ad3a2d79d7a9fdceb6bddbdf935c112febca0d53da2d7f887eb56159c83a953dc64a21071c3bc35f8ca44461d9aca27f525cd956e0569ac768a653ecd7e09781ec90f4ddb32a569f959fffe72242e7f3febdf5f8e68cf253ff3df5f8eca799124d9cffaf797a32ad7029b8f0fa9de5825f55c1a1fb7c6c94d1979379c62b967ac1e335b6e8ffa60fbdd76fa7b98ddc2d954e93aebca063ea7c4a701e4cbe5abe492aa4839ad91f360141c4b29f9c629e53ee0c47d3c2db6f65f1ab5bfcdd78dcaf9be55775dc6b91f71aa2e5eeaedb66c7a34591838ed23b0e833fafb3cfc94fe3e0b47b2e204936d47b8c421b96d6c64f740d678057a12635d65401bce63b151dc3b0fa338fa5dce91549bdd38a0f64df34dbd3510c40124262051def1a57156d26fb4e10d5f235a86c322204e44dbf3a86203cd02327a7695839148ad74b284bab98cf24049fa020d520ef6b665ec4b871a56b18e703a3117b0c90ec264a7f499183e2cf378816c304298b39ba2a4bf78d8e30e1b568b84dbff89a0ff32cdf862e2d7d77406222356c3d0dd913c78c6357da720adfd585b91db163ed04de1f20fb5946f2a0ff6be74283dd2562d6e3726301281519b19d1c70dfda1d07b5a8538f1eb52fb5d32f472978acbd548ea35545eec813fbf48e8d84c3699ac914b253429574d24ec912a2077d22c5e7ea94508684f6218984f601aa2ca74b5593602f0683998ab5d62f1a503d8b0b867ae3f5169f946d7c0a1fe1badd20edad1193b6991e9da311a8ec17b32612d4010bff60e6604d2a236c5c5ca0a47c4b217e12471dfba82ca8ec72c06e9c178578fb53b653016fc28cdca0ac73864e15278e273a01789a8c140d19810425816b40c16e307fb438d6cec3ffb08843101c8ebc6f0750ebfd7ad3999fc18fea2119334a62a911a5bf758361be4f7866e88a4efe61682ab8c583329e6206504d9a855870d998878fde93bd7e1797f80e12faa8bd5e0c78f300cf4beb9fefbe19df6e77d7c68927f94c03cebbd29dd8331d522eaff9d66f747611a6e55daf1c834dd35256e1f74538fcc1cb529eebf7b0d588df6039f8a989a22f4f89ab647e1095db64ce29bc43c669cdadc197b0cee2c7a68a0e34a1989085d0ab9bca703ceb4c1259ff20d7a97839a5b9e4f9f987e2f258a4482f5f5ee9c3cf83acabeecb1a866fe38068c512d7406e152fa9b8a75fd0dc57633ca0af687cc9a9c99f65c9363cd3d7cba4c00605aea8ede4e9f46a770663147425338c11e84e3f67457a20adeac5da8e0345c8301fcaff6943289489191fb4724d8ed0f8f8f114fb013499a6ff73ca51a998af6142aecd8bfe78b1a3cecb9ba885564068b154790e9e42a49c4b19c5a29b99584430bd7522de8d461f527024ea1d2612b06f779849a8e5c0426efa04bd873bfc707d0b73ac5f59366cf4029b2e8a5887eb1110a7a856baeef66f014b3aea205028628f003843261239e6e18dd29ad17cb8dbe2c6fa97b3bcd7c6b2083af8f9605156cda039be83ceba2025c87036751714252270f993169bba52ed46af7706da2ec3c5404e12d1998cc46e108c70bbb9f07ded01b38afc2fa952aac60812e8d9087e7e5101c02bc5217bca8dd94c68528124dd8d2e8474c87e080a5a7c18d572196bd0d30610342161c65d12f1f59a386b7af5da43d02b64d951caccf505f89760371abfb5a2ba00f85bf8095b90d13dc5521678176ed474484fad7e8e4dd70fa2efcd3f9f47ca8664f9f9ca8bf89f586702ae516f041b73f6655badc7ebe5c06c189fa477450bfadfe1e3d707255fa9a534f89dd4d896b2481e3d45354257899a69b19a744efb047967497faccb1bc9ae33651fbdf359c6f20ff213a89287e36a3d12c9633efe252331aa73a641d3bf3d4271418405e863ad38d4ec6e47ea4055a04cc28ef04a58f5a9d48cf60c126716ed9615ae28f09fc4b61733769dc713e2150f2d97e9458b1cabaf3854a6dc4e877a7627fec42118d4ceaa5649cec4d80d0a0c850eb4cc04e65ddc9195ba3b52ef72c5c4702f2ddbe20e70f7e7c8a8fd3aef0b8bd9e95ec77b867fdc386bc070084b6363d436039f3924a1c70161d991838da7c1de9be5419d95a6b9fb01a0027bc14227b1d6ad3a8c018bb9b1c06128482776d311b6712b1a01a2355a7231e80f3cae959c381ab9c945a982a49b0db1e5eda3c5107e37fa3e1c7a7c31706ec20ac4c66bb663aafe6ece77510490baf7910e69c1711469b5d96a0938ec8d957c22cde199f16a44d08af5c11faf037aabcb89f20ed4da87db2d8db595f38f331d1805c944875000c42b8c8376b19721e9b961d97c18f1d5de3650a1a2a559b415e922825e810c7cf582574c609af084552e06cf2369e31d3853417e8ce17b11b22b905ef38e28d178d16502537622810b6eead7c28688694f81f19a656ef24910e6f7bda6bd87ed789030d3ddc913cf351fbce53d4584bb6a06787898e4ec2d919b8fa0e69d960d2e0e4640bec177c9a1c32f7edfa94c3cf64ed1c98fa034c4323ab387b08d31973a86c3b7bbaae9b20ead1a17434f1b14f0eb4f4c7c6efb6c71380dafee0d4b5f3c3e3f3526e0eac977e8b8872ae1a09aab83ab902fcaf953ddf1fadc7cdc9808f0c21d06948aedfd69278b3ef08e7264eb676f0c26daef69263e0d6be72ae680cfe6bdab3d66e5b8fa7b091ceffcf12d527e340ed7bf8a2d0ce707a1732efaa9ca301097afcbf4fc5bb4ca38034507f332785248221469d338cab366110341dc03af41d752c85ca25f4c03f475e4827b2cf1e9130f09224814669761ab9263a9303074441abca61b9a6b3b0598463945d15c9ba11fbb94e71d27032d9051c55eb50d739cd50c39961dc465c7fe9b523e0b4dc581e247e1113742982ff9b445e72b2255615585868ef3c12a63a43d97e72e266837426f4746815dd401dbec645c436dccd43db23bcb21ee17a2213177eeb246bbdcad8a715622590893763d5ce82dc554f33ff5bfd71478c3e74f0afc669aaae528376054626185791fbc44eea632d5f3949e5a2917461c7874a159d7ce64f7a1262a2d8cef3e536595d248bed75b14d57575b09464193ff7e0b3dea96904fbc0a7c7f7a7e1bce06c1f4ddd9ec69707e72767295aa8c2bd36f4e5481db6dff4f93f3dbc1f844a5d25458ce8b745d6d399c26571c9ca42aaf84af316a842d9bf123da852ae99536be3f2f9f5299e9bb68b68de8dad8e40f51438c1a9e6ccf111bf3b7f826de26f3551c4863f47a83d788ab4405864fa9774b3ca04a3eebc1c27dfae2e5e76f3f3f9f6e8f8f832d1eccce67b83ea3124f4eaed4bc8a8cc7e7f454799f09f97fb422a63c5d2f93e81373f50912fe7c7622efcf3c302949bc908fd8b741deebcb1921de65387d665f7e46f7ecd528c5ec9df34555343ea8a0fbd495f2a55b941a7ede2efa5955e8e2c559c73756df321d290f497c66bbf19cbd52b827915cd344d09c0cf96b3caa2e735ac23922112e6db979cc05f93d6681bfb8e6db4553eeb5973fbb335ec8812d55fb54495e1b71afd6fefc9c64dc44fdf5fd3d1a76cfc04dc7914570588ee50caa4e142ec95e9412d0915cf101e5db7091afbe8db374dd99ab8477fb9ecbf8767bdaf1ec2fed4786265cdb8073e3aa11103791403d446afc5fd441e25c93a2fa827573c0c90d2204dd15b5ddff626fe5e8767d9d9a0ff69a37629cf8b23aa840fdffa3d1c699b6fb48d0d0482803ad82ae611beea55a42205919a11687a02d252e9640f9b4e7f29f207e10b42963b3c255c531d5b246c77dd671590943d6dc1b0acea68b8a3f25869ef1aad7aaa31dbeb821f3dbcf83c3620e36a5738721aa20901c2cc2691ff97e6b1c89b84688fffc0b215423cf6bb5cdb1d90f85d1327c5eef944367c96d35819e458c1d884cdd078e3b09f8de0c5e08cfd57f0f91c92ed1e888cd83f7e366b4236e0ae9b84f7c547530f34e0203c6c3695513bebd7935f5b34a9c261271af00c60f381d62c3cf0e469e890d94505574803c39a573e3c9b3339a6b787735827b5426ae078fe8b4957cb1bd8695aca1d3fd08e8221a39610b7795de5a2d949e3c8a9e75879b598b8c80cd6140f6976cdaa03c95cb76cd766200fa47b18425b213361485728e2fc6a964b32f9cba7ba2ddd0ebefe209d50c4fe44bec7a07b748765f4e175c3981ef4c7158772025e2419187757460b59619d01ebf9044975aad02ee4f04d536c91abc1e2264407665736b910fd3c97f7caa1611e449eaaa195865c114f1d576dbf34fcfa27987f46e010cd6f0a9e4140a04c5a586e8c563e19d2cd7b91e261f900ae58a5de747336396cc5da4710cb1856173aad27a5175b44ceee45c8798bdd81096b3094712d549511060ab5e26901bb084dce1d685ffabd9e7da47a68860c2a16dfb730da8b4518a1af3aacb4a7299681c31d7e99ce3a850f0204735c8af24480a59a4193238b48330efb95ab1c1bcc5e59c47c3cc053c6562b5e421972c15dd44f9d434777cda1ea8f431270c888c33a37169fb9373557a91621d8fc4839f48cf46a1a46e889fd99737f112af9c8308196530986159cce928fc35a1223f164b79003161fd25aaaf8f018bae961502bbb452ccd54ec30f824adee6a127579e219bf0485f7acadd6ca127948a79fa3953101e13129ec13a103f790e06ead0f9b7a423492befef9d84774fedac23269a49df1d4960b12c829157eeec785972acf69d8fd824011da6c892725d3b9888b0f490a8b468093df64fcf56c80515d3e1d0c87fa23691b384490b5874aa5f27704c33578d502feeb17fda7c2061646db806b1b62e230034268c33746b2d81dd2146c27f8fd08ec54ce2403317cf116e02d96bf9762b5f97880a21151ea8e8aa5111abad91c698c0d5ccabf4c8d9b4a593439a88a952f536924fdc25a962a2835de472c8b84c394e96d4c3759db6fe1266aa8732235d5b01cbfa6c3e9eeb44ec8496e733b8b1c076210d1a2a5e931b32458e00411ed72a75e4f1e92c4c03abb82f2a63128a0075393c605ccea43a90aaa38c2c2d923e468b10b011dd521269419ca4615755ee100ff0f81ca487a895011c340947c167c47120cdb1c02639d0b81e67ee0a38151c341d149eb7c84e7142d42e52559b974ca1a26c7d81a5b321fcaea7739d369d43251f9ff2dc9b80039ca19b1d7d9cad95b6b656aae1ffb29de9a606c20dc31ebc9c6981964ef384a9d1bcc1d5a98babb58c2db548f452a2c0667b51b8f733afb68c6d106b4a0010c6def03068c81d373aab7c9dd123935cd6d9f483b5a2d3015a3ed8e4083a0a541169a5ccfbe49ea86ded4dcc827265d4d31a598cc608ce06fcd8681e383526889eb42c09329adab0e98c8a5004c6470801e54d5f6c6682fad14e3f7965b27c4c5f35b2149853ed00f3f05b7ce73f6ce8356100b6cdfdeb976f3df67f17535dbdb62a2eefb3794844870ea040575e755de4b7a517b286d8958baeab56c00b37be8f04e2081a66bb4d3a7e52852e034f9f141e226574e783aa6c4228f67b7725154d086e6307fd214caf6dcf2fab867e822dce7c1d3aa71d36431c6d838177e20db4d1a853d3755533611bc30989e38d3d1c27c6a68dd04ab81f6549b7e0d6baa85c797652670db63a7d3941247e23223835e81f852871da33aefd8ea818dbcc55d5e1c854a7056c3189d81e4df77bda53751c7020601d4f12a9db97840ead2bbec9f561bca2cb3af8c5d85afd2cb5ee1cdceb923690b575d03c61c37a8afa8a3253c40c7fd5ec541cbd723ab589dcc4ce7418eb94016a0375ab9d8a556bc6bb80f0106c22cac0530f018b58e16d342f44be4df61fd9d2d3ee14369c88a6b195aa6062f56509ac50c2aceef77da3dfd5819887636d2b1fb1355e6e587881d3496583d3d83035a1ef7c411d3661a4eb48a28ddce85133557ab06b48676f8b78dd56a5f28e193ba172beaa5f76456675c2ebeaea9aa175111f32acc5b37e6d37b3c75305e0e04c5e3fa635f70d12e0b3db608e8d8983aadae29cdafc589f835a35aae144849349d4f1b456dd5b6984982469c9a2c248bf0155d28dd5ffdd49e3aa3aa6add36ecd181fd5a64746c7eb0736adca24ab5783ea71642abaf75df64ccd7e8f0ff3dcd9fe9a57cd58a8b4e7b8a94dd66cac657bc4cbea071db6792d8f12392a8839b0a212cb7c69271347574df5d1586d64f61be2612b75c18aa88c206bcb27a03fe91dcfa7493c0b86d069dde2b5246d3b516fabc8c41978e77b838b811740b7f484e8d694ce83171daf27bdf55d308d8f7fffcfd9c0947bef969b0e8e6741a48beb02df55d1c317dfbffc85984ee4072436f24b7a62b3017a261da0a76ed232bd489769751f7ad7e96291649e32b905edc76fe86342e7c4a9eac85de1485d126cfc2489163f1d8d76eab72a9a7a6f7344eae2e474f4fb455e55f98a2ee012e7cdd44b14f989b323d2c3efe9dfb7f9ef080f567ab39ac6f8bcb231062b310db581390dca833b23151b2166f38f04801ae70d8c272812b447f0f37bd90afb924ad8978a5ed05132c854bb05ab6eb6dd7955b564bf84cd61cba1a3a5de212f20a81f3d6980a5dea37c6c5df507e86c6b9a642fef5f2579ffea0fbede3fbdc5886dc4ecb41b33d90411870811e1c32519210e70bad5e1ae0ae5e5cb85ed3827ed929c85fa99aa265c9e8e031e6f8f8f06bf550ab273c519655a2ffafd5795041ad08dea33bad9aefaa1f28b3aac20d2a6499b446bf3f7caa713cced402f0d4c20eb5655e9240b65450a6745026de759b237f48179a99c36f6c6e1753ced98886aa2670b41995157d030d6720ec8f95e24f48f88fd5b02142428c470b47982893a675cbf3fb03c22adad47533dec7c0a3e7866c11677903816419d97cf5844f49a8a521eaa09fa6abf27547c301b43799ddf7660e8afb57e8f85c68473ba9477ba4c6062c7771c87fb51e2895cd3a6b6d770a392530e0df8074ebc57ba9589c1fefa43732bdfeedab2adb2b4119d091b12e67cd8cf64c842224da3ddb0b44617b67a269d83c93bf5c28c639a52bddf7124eff0614ed8799571400118585fa6cbe5f7baad5e03f5d2dd92d6e76b7b97d7c524592c5fac890a6793eddb74419c1dae7e97889db842b657b8a252075eb313f883778964a488a25a969c97d48353d4fdb2cb5fa10e989ab4820935ec6e047e1a72a8769a41844ee0526327e2b7e9d4349678c1ce7d446700270f57a514ac030b3bb7f1ac1545b8acc3a7966c0966d237f23e68c65c4dc30d62f510db63827f3ba6c951ce9fbfd74c60168807829f4298741a3c4da7cf660327b76bbd6920713161db4d706210dd36923baa4ecbefe2ef7ca45ef2cd0bea5bae0727a022b3920d226f7de7b9ae53ad94d1c049823c476cfa69a32fd529a37172f9186d4433739d14294b1febf0b4250faeb4e16969aa5acee7fc31b4c4b5ff8580f5e3e2c81618fc1b8bafc1e58f80c0e1451f41a845b8b2e9cf9bcaae4584d600a77db18a973a6d17d30c6f2a2ef4a6e2c04d9ee69a323a2f2367c553ed803212e933ad19cdebdccf83092de3284c8330056eb9d977a175a31353c582d0e30826e3ec7d2a897899f7a239db6e31e6d009c6d3de4a18348340cc3373983ce1c04f0eb60f40966a4d0cc1f76d658ccd58e9b4d1c99553ba5aa519a78226d8a69bf84e6eeae7ce53f35d147302fd5b5d529e15ee37a972beca59d45d938adf574d93c4b77a27d640544c38cf30d5e08f5441fbf2d8cf3884c2c02f24b81ff64d58d534d657d59e1f5d947168ab89ce340eb4288c91174c3e0d3dee357bb14f4ec351ad6ff994535046cf024fc87fcd8bfbe5c01ebfd9e0b78a03f58dd806b598f8b66a53f8b82631752a70aff991a9bdb7ff81eeaf941f783c899efe8e7867a7239d75d74f7b7b3d7fbc6e0b75f5bcbe6ead154a46eedc256e3af1d0dcc9c94620f541ab586a14679383d3d6ae13a63788725bc40372cf5977333a4306029d41432c50cd5ecf89d61c9d396f798feb9dc41bfd45ada8d0434cc7054284fb1dfd3249cbb7db944d52ea6ad2269220883454573a3000481bb1ec023902cc3c18006eebc9fdc19149e68472be839f8f9b30208bbed549156b42badfe710c35544ffc0007b9fa51c63f988ff4ac3d127a34f8e645be24a32ebe3f2e4cc0b78963d9d17ddb304b7e4a43feaa52b4c478c3e1b69c7db1c62a46638160e3a7b074368236f3011e11a0f6b598ef95e55c35b3aa512ea788f9ee1803e822afeec33f92b668eaca286631027d534b3700769166c1b60c0cdb31571ac713ba3df36a46c777b2aa8a43926b425ee259813a4f873f80fbb10b52146b1b3e1a7654e69fa642fccf634becea9d68ee3ddf018cd26230633671bf5fb171a60f7f954c818cbdb18a60a5f566a9f047f6dcebf5d10da6b37ce46d9d115b19828883dab1c49cff79a629868d82ed4ffc62e663b87d0f84237853b3623c17e967d433f48607437757f07f56ee5f8768a1eac5024cd40711f1bd9c84da5a68941c222f998351d3395932a619dde25cbd75a0ec31e5ed970eddc9a30ea2c47598ad8e4df8207071a7ccd795435dacac477ce36e943d1cfb824cc1a9d4694709d43c2063f168b42c175ee83a1c88dba3cab09d7b6e0d17922a87da75ab5b144aa330e76afb35d963dd60115781d42cf53faecc2a5c055a80fa97d9b0a3ba3c900732a5ab670df1a0b471631def9be0b1a7202d531ae428418d23480a86d52aafa376866d0004c00b65bfa7bfc8c7f6b1710a206d56d557b24faadbe81f88ebeaf4c08f79faae8e43f9f8d901092aecea7e7b32727ea0b5c1693f38c1e7fa38de4cbcdc52aad7446c86dba8aaf926d9150655b9acb848de67faf1ecb25f93eb9bf4ab2a09d4ab22472365ea6bf77b1f07743b6fc3729f075419154c0eaacf9e89024b753f4ae13d0880437d1da991acfc2fcd5710414e67fd7e532ec0412c1423624d319e74beb1959404a475d58a71feaf77f3794f0b593020b264adf38f6924686ac6d547a561103317c7370cde3e6dae6fb6a8641d3399c1b2991c5902aeb30497cc020420973ab4cda2863e4f045a53ca41445f4a320ec289a7517154b209189f01247dd56c86c8fd47cd5d20554c1a4f24d3c880ae9b3e86c981aa5fb2c4ab239cdeb8f3f7c03b928912d7cee0ebcc81b74bca15dc1a6392d460e4d4219fe26a92a38cb712057e77e58c17104dd8939f6a999574970220ead3a74eaeb25f14c3af47302c3164639897b76a6be851db104be11ed9836756175a7889c7f8531000292a9ca89a351e8a0c37d37731d4dfe8066bee67b7ead5a8cf49e1c50f7ad91c097885d22f89e58089cc03a9c8e52d436f0a6de603f44433aa9903572e0cd3ce245332606acd10e0416fa839e35f8811312af753d6056f556816d26e5ea2080971a755f3d447139e2202e473ab2cb9109e97284a05c4784b1085d1c49bcbc2389d475c441c08e16174bb9e0683908ae22579bb5fc82ec3ab201768e4c4c9da33afece511d73076119b32b6a485c760479223c23d74bbf6be472c605559f14455e1c3969339ac10ef7adf79a6965ea037b4f843a126126af9db657ce4c88291d09a2da4f9d7d8d013e12ada21e3108817ad0d052c332faa219b6a199b8e7dac424d129f733d6c4ed7dd2fa00a1554c04b2ffa843c67d3cf609bbaf014458a2d4f955c377712fdbb7d33ec2d6ebd9c3830ad108f00c63d0c7e9cf95fab152bf0063481cae7fe2289d9ca8bfd2ef9f861c4c83aefce9a43f0b7e8da6effab3a727ea1f7c700e9f4ee8d83f3aaf664ffde93be0cad9533a57af56eaeffa608d2f08e0b6c433e0df7159e5054ee1e1e09857aea411f1618c73794bac00e2b084d4e2ffe8cffffae5dbedd75f7efe12c95688d83a79777e727e72a2aa8c13af9cdf5245b341c8c957e805fa7032f95328e95842ff7c81742cdbe04465488644f082cda38a8ce919fe5b6691f7f4c433c11661b1c056fa3f22f430123d69d3fa98e69c9ee56dab9b98c0fdc78a4bc2488d8ae8bbddcf5554652299f9b195ed48b29f5ac4b671894707756507431621dc0b77550bcc24815ab39166aad5b64767169804b8269b1a615b28b3882e4334a2c22a15d98c4349fab48469b8e92cb039fa3388545aef747c06c7ea64b917ef8f094a386be4593d11a51fcbfb8d250d21f3ec8d0ca19bb04874dae00ef427cb2896dc0256d3d09eba25519ddb6d3a5dce26f9a4e76fa2a543fc8760d1b599586947476763893f88d417a88dcd8ce81686b504b473539aa659bf5f320cd5439fef25bb6b9dc497cbb8fa5e322972946e7b5a56014217b7222efa293d9b246101451c72ea0488ee8982f569ea84e0a3a9837faed28a3880ff9ed0b533084dbf9f6546d89365ddf1a15c9706715d803ed6c9a75c677997440985f5558f39a5bcbe19b128bb4d23ed45350aa1acecc885e0a791f7fafb376f5b66c31dd680a96309086989580686d50ee2952cf15d5232aff12a55cbce0ec504e2a2457a03299036016162b04c604ecbd2118ea35388bd062195d51a066150e5364f0aad95cb1441e4942365123d57b2052c0241cd006ee29165b963061ae24b2034916b669771f942b7a36fbfc4e16c4a6d58b16aee60a0bfcf5bb7cfe7ae23a9a50e94d454e148c114f8db7cc1595a11f239a9e22bce47e5c078c88b40a78c63939996aff279bc0cffae29b39f613518587b4cc457cad173369ba55b6db1696331c92a022ed33947183cb93bbebdbd3da6edb33aa6e684565e8c39da3418c81fdf7e75fcdf9ed2b6b2e1036dd3b0cc14bbd4b2ddd7c91a94ae27be69f24480e40ef78d96564b75c405eef0feb792ad4b9c0278a24bc0c75a5b9d3ae1231e5027be3e91e6b8a513a989bf3e814f9600c55769b25ce84f3cf3f0e76f5f79baef2ef098ce98677f7bf3fd77d22ed147101f60dcdc312f7cc37b5ef148598408d3577d8b5a904a99811b95e8e718af794c5dd829077bc92a9b15e220593b0b079bf52161132148c6914db4885c71213d6f3ee5c01d78f09a4e5889304187679105fcf06d116725044e7898eb87ad66f7e3b131b794103a72e2a755b077215cdc6130aae63502dfac616b5971ca0c132869bb9dabcbfa9613f9d4de186bcd5b4122b5b68169d535d5f932b924aa1ad1671522ecbd88974ba829e93c21d204d1d95779017b952b2426aae26a53bed0a14dd40ac7e93dfedc102570117912f69d3876f5367a404ac27bc21c15b62b11583f68d8f89a9eb74ceec45a910e84677432df485a951cb890e6c2246bff87f53d60bb8a3d0f483a8c9ecd76442a4ddb09a9770d9ebe129ebedaa14f9f2f97cd6e951da211eed424d5617b4a8c8426b3acf606e21ab836ed43754d37087d1ddde3e8bc67e7cb44ad200daf0cd6053b51a48be4db74a513b1ed234754321fae74892831dfd68bd33db71c12e4d9d90d9b016b53ac2b20e0e99584ebae6633e123df0ee3e56d7c5f12ddf356af792b40a022fabae88c81b4dd5e382156e8044741d81bbca7f3d68c936507d7902bad529a9fb7f591152d607faca8593940a2b77c44d20366fde8f6324e69330c69cf47be4fcdf1e576fb63152031b0e5e3ffca3e65f636c91463fa817772e2215f96588c0f5749759dc32858fb52cced132942252dc505aebb48577efd484c8d0fd3bfc8862650471bb3c8e954c95784ebd988da10e9dcff169dae1ac5a39edf8bb75b78cd1024f2309028e799be4336333f9e3e87d29ec88b6a1d82a046e989f7df232ff43efdf4b9c7e60338ec5ac5b8b646396e1d03ecf7e743e730ac43fb586acd94d333121921a4dc62921d694fa09640996a4e84c6db40c94ed790f276bc417a343e83e1fe326285849cf588efda8ec9e81025762de5a769a348efaee3d238f3f4fea73269d7d8305b15114f7ea3146f2e3d2cfd7e10f9ffd45f16c1c4ebd3544dbc60a047a96defe58e570e0e242610a600eadfeacf0b0b927f23087d72fa6be40d7e81237d580c3a9bf16c09549e5e1aa28735032e15447c50bfff76d8c64fbef7cde5b12973fc26250c4da445fb4ba6b188847aac92ef68231e7f0be8f6ead2d42bbf86977a1e71e7904c3a7b52e53e0bba5bd2151ca388a71ab504aaeb83cf99b4f2dcadca46ea73e3a0346dbe994d0ebe19808be21c7ceee389a78ebc41990dbcf1d18768341c9d7a10d4857535e0c1c4ec7109c44a1321a749d0d15f8637798d3c88ecb33c147755d0c90c34f5ad9863afd55b350f743856d93a76ef68141b8ce900e64bcf76e44163d1f05431faa45f8368c3d35df096daf7e7a61359b404f9a23768f0f076589fe0d129b6e5656b03b2dbed947a36036832a58c59478cf57c538119f2cb88c6ff561eb8ba00d36b4f17466c37fb6520b2901b6a15513e89ee5fa9f7819688bc10f2804fb2807da78e5e8cdffbc7a7ea05f10a7c7ef19df75d7e642933cf71ab7a2fd6079681474a5a75a16ed58ba81a23f9f80df5fb267a062b2636ec32dd673bfddaea2785758f47a48e3b4fc9d968f229d813ba8a9e8d680a9e8f466774503c1f7d0a7d31fbc55d446b223e6949616273115de2e6826e89e35f4efcd626bfa5336f8f7af2bd57b47fedb6262c78db850fa25b7ad1fd3db6affd4cef652a4e88593a4a4383000c6784a0cbc98bc8cb72631811eaf1c8d36a653a12fa2fa20ba61988b4a12b4691f774c1f047d3d2bb87e4e63e7aa17078f75e406d437524c23bd254715cb6118e21437b4489bd04afc169475fe0b4a7d9ba060f9b2f6fc48760ada62bf542bd9d21516a9180e236cfdfd2f3fb595d29e824ff0a646d23a95a0de2cb4983910d5d1617304f8daf42d4b880e749e2b43363174ebfb55b6a6659ef98e36373c471acf5ae038e386dc72dfe2d93ace08a0e4730bc32c1d494c7bc54c0dfbc6146f0900db47ce32454ac7d511be9c661d1a73ce4ead8e7e5db827631e8ea72a5c9c4ce27dd6e1120bc197d5c75084faa5a78227294305306ad156d8fc9c3ceef9a03665b3d8bdb357341d81b7a2c48d436368566d14ed3451f1aa21b0759c79e6a9c5c0123d522b04a999873e0728a2efc35277df0b0b172bfd4049745e631ea0e7d950539f78c29f33a84b7c8cf7a1bedaf5933dad394431ee3c50c1c54aa6b2c61e114a5c18e3dbe4d88efa37ce2e73d193b92a7998ec0f9184ebb8e9542eddc79d8cf1dace0dc9d602d6993430e82963ac4bdd3e76039ddcb1bdf1853391be7603f65694cea5dcc672dc31271057bf7fad9b4e339b359f0e7e7e428e8e257ccca0b23ee3ce0dc04660830b08d72e534cfbef70c3139d6c66ae88472a0fa91542ee7f529a3e574c3eb91d3322d894ce04bd52b03c72d9313c5a6ae224b93fa391fa0a60af8c3d7b5f05d805c88b0789d94ecd219e20f27aa63e7519401aba41715b5060e84955c12cdd349974c8d23f22ca8a2d2373a451cc472afcfdfb5557733960f3d16ca141a810b99514e88bba0f35796b064a8215e0f704943a972ccc26eb76bd6531a91a1168eee1af20e2b3f13d4a40565b5944b1d350461079e23e860d7f3bbe3fa4d435ea65beb8c5ad8926fb1a04a57d1ed50e804429194703b83f1ac5cc9b7aee26e0d89652a9a5990e469ef14f1b59afca57689669967609bb1f4507733bc9f9c7a6cac80b159a946a83a46f1f061f43e93da209f665b91875a6a2a52d030194a911772afca624ecf08cdef02087a3dd62e6b186a1a3755b51d9fa4f4456e99d4d714439d8affd3d1a721115c3ac109c69c3399dd8c6d011cbf27bd081e20aa809585514e5e73e0fc45169df851703ef127517ffb24d89e4fce2727e3065ce29425609f6bb1990842d7468ab61fd4ea3a938cb27ce64b800162f184c373452c100b418bd20923688365a56bcfb539d8b3f5af865ca8a799d145a68d6830f1c462d25fa26bf6c29c6a6eaee737b83549cd6eb52c1f9580c36aa76e9099e37edfc3afa39fa14a6518ec0adfe0b9fcd474df4c66cb9ca5f9126ed68d0770436a3e51f1a4825e0f7f2c17bec8c0857b83d41637b355b5787f336b8631d7c5d9422665c3d7fac0d2db4be4d90e65649181e4d70108fb38b06fe3f288c8e823801193f398829d6a4e4924241d149830e7c01fb7e6b2d61c21838a16e13905f8839c6d417878cdc9cd5a93756d238ae8a854cedc23cf74ee8bc33692e2d7548213c724749fb6948f77d785db751c32466898dc1efdfcedabafab6aadd9667dee240832c79bf32a6beb32a93a420fab2c7a18310e387df6ec395d7cba53f78877700395fb38197eced4f6cf6254a4032103f988718be79a16e178e60d8b23fa260b6e10088a488f9b2c6a0ecbba00e54519f57a57f09ebc255ee045912c6829d27859c20de62a73caa2ef118d02c5f730f3235efc6e5bdb2d9aea35f0f5a485a09bf8801025cf13470c1be66b1873ea781b2c054b8483c71d9de76c5485a87a65799b170b9c30f4b5105335f1e23ee44c94ce0364abac496624956d4bb7bb9ef9f527ad536dbbcda6decfc71a2a92c531c7ec628facaee791d704232f183bd473b92f974989e4fdff28bbda1eb78d23fcbdbfa2160a438c56f29ddb14280586c81b90004692362e90e02014b28ecad196c90b49450a4efaef9d67667677f8e2b8fd2291bbcbe572f66d6676e619e0737653279276b0622515a5df6f60921db147348cf88c1b17a2a8e69d4af5312c28b75e4f939aad2b276e5805d2cb857630d71a4137499bf9fbeace27d197facb5eb171f88eb677b09b0b7c6f3ff16adc97d8c9697c12c2819daf2e7c1b84a2f87d9ddf81716a053a64bf232836d2e5a351987537454f2b289cb5387d4cedc48091c44e6c570f9ef5e7cabda9dca912031d7154bdc085f402c75118eabcae8ca37e9eaaaffe25f1cefde2d76f0000a82e1a004709944a15bca35dfff36ae3bea3f502c7b47753473b62632ac051a7a2903c8e7cbbe3909d65f6baf28e51c08623664594eed691b0d8c0fe52dc08db6c90257e52cf98ff5fc0a3db57a8fe0e12c11b92c9167ab9ec257b26d31addd28b44f610218f6e1d4bdaecb6be682e97dbf57dfde72d2bae569fce5cfb22db3aef7ee6eb75eda2f632d696aa23e144bfed45a3f131883b582e8f413151b29c52614c365db6a0a18c77ddb80a78feec230328870c9e9a79bbe8796ca60bfcc21964634c2ebfb63be5a4fef03c58872b6f4616e5d41f87ca806cfe5dc5ce8a301652c32b4a61dd2df5149cc9ca9e5b7fcb3e658cbfd650ebeb8d28632b984a04fdab412f783be13a8e5adf793c0f76bf8c07ae137bb42e2c47ee0bd8fed86d12de533e9cd0ed5ae13e685d04b9e06e139def0ed21720dce2b0ba3f36cca82d65a0be8829e8a53abb5d360c6b70cc0eab0e63baed91e1a8eeec3e93c4f15583a3eec8c711edbb72ff3b2bc10a7777a0fda622a2de7e4643f89857e97cdbd3d3a1c846cc9b00f4160e0225e078a141c52db010e612c43f6b61f4a478655f6f5bb81e107b40a40f315ad56bb32cdab40b89de64a072813e69a0a0274a5a05fa38f9e294269099f269df584edc15ce2bbf1c1c80c02e81fbe472d56b280f41cd283825d031909f39af069e4b1c1e33b5903331c062970ffa2be595a0ecc5e5e34d8f448980f314bab1926ebc35485ae36e72dd2649b75699ea539d87e9dcd1b0e11ee3777d056df6d47727fdf9f4ae0a130ac6b6fe9938af22df0383fb9dfbb1728781b9967f0dfa9296267beb0342f023fb331f2d34f3307c8ece8fb56d55be4f0f8e3782546be09b2b8e2cf161bfc000d9bfcbdfabe996a6f219b55efb1396848faa7d095c8709af69726796acaf86467be0dbd6c68e9d29673db82b069e000819a402dabba2617a2d8ad69a565bcdcc6ae6d82b89282ae693b53fc04481816377239edc22a6b23337769656e5562809c3b3cd2636b34ea4b5307690b7c2ee1e57652207346870565ecfabcf89f83cd1228ad85b445fc6001d99560c1199d47e4616f094edfa820a6d6d0391f5c15a3c3c68c5a6a3b4d9a365fccb1b255f059d592718fd9361f4bbfc5d150a1649fa2ec439e929a53f9f564ab3be94ad1777509b3e7a9755b7ef4139bc624fdc07059129dc6c7f06d3330348140f537521ff4fe464a454803ad8521b39f39e517afd0daff75b81ebe6130c676fecb6139fb95c8e40e8703165b1a0613fdec6a6d2e243cb250d53bef44dd50eba5c6c1b180c2491a83c3d8879efc5096355e264c495932d57a1fb6591627fa84fd9dd63b876f1f22773fdf3c6a98be134bc9087a9b19982d691b0855500e5e9f92932ecbe7f05970b4d0ab04f531432e5e2f3d0e19ac6db9c5b9bf3b3cd79b9f1e1161b31e965c76b5e274e55b0a30243a1335897901a5874cc5a33fc020335ccf7395a5d4055c4234f4d77710d38f4cbe51975dc03a6b4d14f26501d95d5b158ef69f3b9ee90fdf0fcf9035b6b47c4832681269a1d7ebe46c7abc3cf8e5ef2907b62d117002e7b9f3da81762923e1864c430271c5044a134f7e5b267fbc4edc50b53d1660e03935b60a015117cc68df34508ff35e2afc729182ca9768985ecd9c1edfc6ae8bf4bda8cd8d17d8ecf4f69ebc6b6c43944b939934eb72db7072fcdecb24fd17fe3be4fccb59f02b80310420f6cf1556551158c62e55515a396af4ae2cd4db9ab3230d9abcad972d913f524499847002ca69489e726c220bb5a836d605ff5a1e0c11664958f040b2e00e365d69ed8bf58cc7c8525cb3a1fbf1bdfaa6e7e2795b658b0924a88308d5c8a6c3110acaa28585d1d3d3756c0cab73daa53af6f64700a2d34e4bc80516aac1afb4c80348ef957477cd464f4d58fbc4c526afaf8ccd222b09fc0b8559e315270a3c08ba3d29f14eec6dd4ee725a90f6d42449d7b522e23c9934fba45bceb57d276c5a34677b04911295024475fbf0fa70af33f92def24a1041ff8092215f794a371aa8663cda3c67ebcb9e4270a481a7f64093a60145b8d5778574094e5171ef21ca04add6a6f992f9bcf39b814acc9c0e7343075781edb1abb130767947f332edbf66e8c8cf66f0672667a87f94c201587bad9b4f34cf00f6e8737d5c1e4d4c22ba58aff9d8614f8b82a7d5b0d5bcfd9eaea163b4ffc437f0354df18964c01072ccf6b47f92c52db650559cd00b7433f57213c7ffce6f514e362127dbd3d02641bd7bc5cb603de10dd85b1d6548d0da340174f6013f14f16fdb320b5bcc7f853408b453a978e42ab8dfd227d71f71c3d3039e579dc27d78183377e3373001c50c6f0da868dd35b8ef69de8770a0b2e166cb310cce10d68a7b3d45223960b803666f2b218015cbf168b29e97010e999dc4890128db871910ba686e93fc3ae798fe5ea1b392fcac059c7d2d8c209bb6198c554f5de112b590c352db97877b283713e6c8fcfab51753f8cec17becfa216fad2238ba0d6df3259c218e864ccb3062888175779b0fc43b977630fe8ece59aa67318bdcfa8c89cfe269cb10cf615b1159bee5e31afc72eb9f3f6fe62deba9fb2ebfedb8e0979587cf890f893620864a582ed7498d4730e39e498c3051ce724b398bdbfa8c67c79c1330c0a45f2b687f01f6c3a2d7ae9897ee96184f58683dab189cfcbe1042f1c8103459e9fec950a6feecadc894b07f4455da5f0db5a0d9baf3949d71b8ccbb3ea1377d4a377913d5262cbcfa81c98e7fb6d9dcbdd0e42a65e5df20e532ca71246b2764ed84ac1a7a0dd4ec3661b80b3c6867a9895a02253ba6a48f98de6ac474044aa7dec473d2d8de4d6c51e2c7bcffa881a5d4af03f88027e127d3e21ae2967502b1b5fedb67e5ba5c642f971d89cc6fd993bcb9f338580b929de92e005ad1ad8d7d3c6f56ba40658d028e15068fe7a93dd0cafd557daa526a908a178e13fffdc849bcb26bd26b01a744b26e00c4dcd3aafa6d15c121a58e2ba77f7fec4c06d724195a51ccd3ea3e1e1eb7182fd97e250eeed3722c88c5741a670e3d3f7607cafbcb2a5029d5501718ac085124a8764601420b49d459d26a256a5029da298a82f16ca4f46b443ef00f66cc6dd4fb7d7e934644441fbb3772a2f1328d975878845bc1e7b6b9b9be8ba5382eae4f8f1141347e77e3d5184ddc096e18ef4eef6519a08174b8473826258b8b97590f95c87c333f83c50fff666e38ad7ab43e693a187ae1365862798268bf9d0409a7e9c2b2cc44deead3252be47735318a9ff0e50fdf262f5e321f238b106c4c409a6cc4ee7a9de5eedd701f4604bcb088553a55e3f1c7da83d0aab2b7601f5d57c0e044dd7ad97a5117996ab9a465263c8421c6bc272c545cef8cc568517bfb2cd50c324a83bcfe8bb196ce224a20b219df958041f86d7bc86effea62b6fdbe3780487b53c164fc5b2d3c0f84e85792f85ad1565b075b6c87a7dfd006f546cc867c791e85c4981eea53faf79b1b5a10da8e8d0682f0c008db81098772ee7fc566d2b5e07eea0459e3ff86beb32afd00770d2b1839f9d11ef15ca4803865036bad51443d535184558d91a7c6f0f55a3124b0eff9cac64b5cfb5031a50f80082c64f05f370e7059e90d7091795fec015b4a4cb2802cda0e41e89cc1c404520a49548a8082edfc0be0a3d29cfaf25052d97fd132a9fa6a04da9acc87c754957d53c1429f9b57afe87781d097bf143fcb772d014c8707485c91c663b7de775aeaa74129482fd724add1f74afba740a33f0eb515bc353dd8a3c27fcde0afc911c3f6304799e104bfdcb1813dcb3c826eed4b67b3a6386c61af027bd0ec515b31175e5dab66037109e525090c6286e020f3883a8f1780992acfc53ddf3046f8e2688db920b2269f2d6fe17fd0d0cb2264191825509383e3a17a40595a40c51a808a7d8cc52392469ef56c671bce652a76be17461308128f62314f9399ef965beec23616c1bbb90c2ef49e4a711f023d131036bfb01638ef567ce35fb54fd24726d05e844823940590fefe29ab8f46e1575c578509d00c268097230279f590def67bde65d587466eaa0155b57f7fd0580aaeb3a9588c4dc812063c64cfe984e1188a500c5b1be8e64704971440361af7013654622d11e126ca61e4c7823aa1a44fb8ee65f8406101390a01aa648268bf48f5c3a21c998091c8a91fecf7fe5f7864236a5d2e213408733d364c9250897d17fd6c9b98981c3fc45619b4813095b75e104f51b901cbe7b070cc5c508648ba2e3b16ffceb080bd22009f1deb27ca8fa1d477a27345852d16bf88c35bf711b8731cf5a660dc69eae6516d336ff2c22e7e69ed9abc4e0bbb6c26fc5c565b946960688d30e8357a829531be19865311a8511cdd15e93fb22164b98f705316a7201f28d47a80ed14b85ad5848fb9757928601172c4b9d9a2f0f6dc297c82d3597dec38d93ccf5c3d774163bac080120f3f175a02a32c0aea03569d70d80645a22ec53edfcb4ba9c7984dd61feb5d033836a621c0dcc2b63adc5fef66b289d1376e98d02628189bd50ef7e3603922d8aa6cbe4a4304cfbbb277e70bc864f105fa77f1e54992368308f0987bf8b6162804ad8fbce11ad6ed74aece1b6399568751e5f92060924d98d3f29aa01c9314dc56f73f1687bdc02fd180f80236ad23b1eb7d7d7f843ed5ff7fa000b8bcbae9dabc7f9b9dd3996f4b7c441a8f1873f85f6ddfdffbebf94c5018a02e9ac0803dc37a760227629c163a901987b7ff44a5349bfe929d8950f3130f9164fda7ff020000ffff010000fffffe8a8a0e72460100")
+	bs, _ = hex.DecodeString("1f8b08000000000000ffccbd7b93dbc6b52ffafffe1424a20da3c51e0e2927b93ba07b706dc98e9d2d3f62c971bc41c885219b1c582040014d0dc704f3d96ffdfa850688919d73cead3ae5b20604faddab57aff7ba7e3a1efdf2f703af1e46ef9f4d67d38f47cd285891d1b3d9ec4f74f46c36ffd87cfea23c14eb54646541475f15abe9a819fdf20e15a765b5bdceb3152f6afe1f4fafff23d81c8a15ca059c1e8a35df64055f93d3fbb41a095ad08a89873d2f3723fb8d668c4ff372a51a2f199faecbd561c70b416b56da1f9fe75cbe4b199faa31d103e3d3273467a7335db138a17be6c9497874c356d35559ac5241efd86aba3fd47774cd56d31a03a55bb69a66c59a1fbfddd01dcba7a27c25aaacd8d207964fefd2fadbfbe2bbaadcf34a3cd0f76c3f1555b6a347e64cac20a78a8b43558c0a7e3f3a4e37c5342b32812f549033bd65d7f1e42a8982285cae9f2ea70d59ae274114c6fcf3447e58ae270db99ed6e5a15a717acfae97af26d75bfa9a5dbf419dfa69f049bcbc5ffe984c6e48fce62679dafc218897f757c953429e5cd3e7ecfacd27c1f27e4296f5d3e575741344e127cbebe5fca6c1e7b7ecfacdd5aebebaa6dfb0ebab205eaed3ab5f1372bdcde8e7ee34849d86988af287fd9e57cfd39a07e44c5fb5e5c8a99c567c57bee79fbfe7857899d58217bc0abc17df7efdbc2c04de95e99aaf3dfa8a8ee784f2e1e27999da22c769c5d3f54340ce0bac1e3b4ef755294ac0063b29c00af7745516b5a80e2b5156e19162854377f4b4507055d16c916d823127663677598d375e2df7d56306e83839659ba062de271e638c4f577769f5a90866c4f7bd9bce2b3ecd79b115775773e2fbe6c70dfb388a8b439e534ef127095f4ff991af024ee8b86a9a7115cf13df177a1c63d13462aa661305a2690a32dd64c53ae0241477593d75e61708fb0d23474372ac82895156d4222d5698c03112f12c09053d4e77bcdaf200edd0e3749f5635fff2f5d72f654d2a7c5f4c8b72cd5f3fec7924a6e57dc1ab17fa24358d084b3a9e11429f4f05af85ac437cff38cdeaeff2342bbebdfd85af442008d99455508db2622408be7e61561fddc6554222fd1008fc52b34a85a8828aca370b6747cefa3963e574cbcd81feece1ab7550c5cf124233dfcf30135e886fca35f77d393bbdf66c4ef12b9e252c23d42c9fe047c14af5b3e639c752324eddee78bb1041a79a698ed3cb6e48d8992e2751a121969330e0b6af3163169199f1b6e3b08fddf172f344b08de95bfe6955a50f80e8bbac26e44c4db5d0f3a89a7d38a3a294c5da13600fef7aba4af35c764ece74cbdd53620b015e19e372c3a6baad8084b31bf52a76d660c213b993314fce14b8f39548576f3b8de2d809e60261079809e5edd64ff7157faf408aa124157625dc65a1e24c79baba0b0711d4718a6f728e14efcf546e476750b6a8fc048cb2cb80cda6ebb2e038a3a87da6f212682bda7af83ab5d30dd6d374bfcf1f549769b59567476ecf26ab6af15803fc5d3023679aa71f2c72352767cadf0daca9b30fb460133e09e4168533b2181e6771c366be2f6e8a48ee615c24491827e44c77e9bed3fe707540e05e4dd21616ce05c73570d102ab8ee9f362ddb6db6bd6ee74d35c0005209028800aef685d56228c9329fed27a2ff7043fe5d399da3bd5b918f08e1ea7fc2878b196bfccb3331c40a6bc1b6845335ad29ad9bd8b6749d39cce3465737a685f9bd5ced978be00c2f36ecb32e769d1de1bb5ef0739ab3b8dcd4d63cf08f54a3969a742d37410484d9a26a8d9e94ce8813196fa7e50abc370759592c5e1265da49309c9367295c62ce04e4f69a210316e82112705ab6391d08a71fca9c78c55189eefe34f1f8757e8386378ad8e7c450889822c0a32369ed392150aefab8f05890a004f68dfbb6dc9afa73345f7ccec4390d3925684847d5c284b55c4826d7db67b179cf8719f16eb32f41429e74d82fde4eb54dc4d2bbcde05844c2bbecfd3150fae972faeb7d4f3082dcae765b1c9b39573b25a0cc1a74f186347df0ff07420b8040da9683fe89f29a1c733cdeaef8128c2f15c21931fd34c84737a57e6ebef2f900b8f345e41a9c924d4bf82f16c1017059c31369e4557574e3579a9c8a6b12bf6071bcf281fa3b8ef77cadfcc9a26c0c55397f97bfe6326ee8292c6c704f7c6a6005dbaddf2caf78f4149ccafc093d53d322d371bfb03e7b605c7ce48d5f678e615a8a0e314e72de0b292848c50fe6be08466f58f59b12eef071a1a2900e6be8f15e0d37b59100d7d73d8f12a5b0dd41967f537e937812462bec8cb54049c10dfcfea2f40f301759f2946f45877b8d7f8c4f3c28b73c89bc69d99791be5f14ee1354e92a631d542f3fd4c3b90dfe91774a52e3f7696aa695a42431d7eb544988a9ee57c21aa8753b609b88b177d7ffca0c7e2be6ef11ef5b2fa3bf3e3db8dd7b6775ea50297a25dc7b9267ac633cce0f3dd5e3c0ccc0028522c1c8c626aeba38adabcaacaaa534bdc55e5fde873bc971b62494ea714f0ae245bc758784d7d8fedb2eb8e24882c2eb1acf0fda060820a062e4230d134e50283add8734b67676c5cf87e9c18b45245b198ae2a9e0aae294a45ce266150b1e3f4f690e5eb2faa748b1b2088794205cd24a9790c32a2199580587a3a4e68355ddd65f91a24a8bceee544fff6eadb6f42fc0302b5e654fefbcfaf5f5e2e2c2d16bf6f01000c050303f9e2dbafbf437b1515ac501d7c51953bc595069c7aa08fae8fbbdc237acb2b72122db6d5bb1e2886a3a5adebcf1e5ea7db6fd21d0f3cd96a25b7d523faca9334bf7c15785f15efd33c5b8f30a7913701b574a645593a3404399de9362f6fd3fcf3f7693e3473c6dfa7f982e35454d90e2417a8f8b944049ae30ebc43cd47008d95f048140856f6b6cfab5755b6171ea1628a89334ecbe91d4f2541c68bf573ec0ef8a59655d0fb68be9010832267ba4a773c074bdb19acde046eaf98b7d4dbd5575e7be77c433f2798fd9a63f186295275dcf1ddf7dbe7a9285f96f7868f668c89ee9b011ad76163d94c0a40344152b35f343ba80e554d4e38b48bf2265b648a5aa898d0642a8fb384168456b877e6e4b6e2e9db33cf6b3e429d4c1df4df59e3f1be149e42c58ca2c7dfd7df876b9993cccf149296a1ad3248def3c2f7ba3549e06ad6a9b39c6a310b608f164bd84b29f825d0d40c272432a7bea0e6ac3a7744cc939093f04e7558504e082dce342b2efb74e865f4c49888aee6e1d690ce9c16182e18f6e1a19a1dafdacdcfd86c81aba638ec6e79d58eab90a4e0a2d0fbc2e36a32499888b36421f7eefe2ecb7980df2e3de6949b4cecaa98ce5845f9996e2bbe7f1430e384966c466b3b40797f146c3c2e16f54db928271352b1f158043c2e135a125a28c234932c957c6b49c1accf9cfce621a0298b13ac474d3a6059316100ab2052263346a7411aa7ba81845564d187cbdf594d0f77a30f4c9cd0140cf6215b8773baafcae3c3d07682f1d033bd802a75c549c25d304e392b08ed300a9c4441c534476f5900fa8cd0cce572f4c8b81e19f03e98894a4b3d837e0304175936dd1eb235e3ee1f902af83b99d08c84ed8d42d3d58ad7756f8f0c4fa5669ab2193db43b95332c2463c5c221915a6ab220e494b1f14c024e0a7c5490e3547583d5a3292de234a1e399ecc162a23e5f8136ba6b26799c9a8d6784e6603ca2c0a01c5a818ec0b04818e492b478e4d8e6aac611b05680cfa55afa657933404c9ad082d6511556ba030c38a5e61369b99d2ce2611e997190f010a1d00c08372c71b5dc872f52817be39ed6f7178781566a8915077b3acb452bb16882d4719940b6241e729c2ada3e321197c9226385060b4e2b2041e2d6764aa3213bdef399d09ee4a405b8f6d62bc091b0e3f405dff0aae2eb80506f55eef639171cac0344c5e9fae19548058f6a2e5e673b5e1e44a09b2661504ed3f5fa774b922fcb3a626420642be6814c484b89bccf14033ffa4622cf9122a44606604612818fb0fea3eff9f6f3e37ea4ee0445dd7a520821026fe491562622d1751e7bb102ec913711132ff1928b0b9e2c4c9dd12f96346a4f49d1b20c66ed3bcc42349e878a6032dc84ef8b683c0bbd14a3c61a172e53336660d58319488da6b9b82e2009be817ce86a8efde7e42c18d8457762f674593ace9c739ad203cde98aeee986ded135ddd21d8552c4abb35f7fcdb937b992f46b2a38bd753537f76c465fb3197dce6a1110fa56fdf946fdf91c9287576c98aac23ca2e073369ed119096767fa8b998a1d267dc1e69f7cf2f19c7eca4ee7bec2e6253441efd8cbe9bedcd32ff117ca9f6fcdc317eca5d6027dc75e1a2d50d3b463311b36a3852b8b5be85b572c84a286f00d120ec62c552ff4865ecdcff47be6adeef8ea2d5f374a9acbd74d5a3f14ab263d887253ae0eb57cdae7e94303716855e675b3c6a96ad6599ddee67cdddc65eb352f9aacdea5fb262fcb7db33be422dbe7bc29f7bc6870a4ca227f682afeee9055e86b55eef9daa35f332f5e2e8fcf66cba5582eabe5b2582e3789477f641ef44bcbe572dac44b28949af8cd72799ccdae96cb633a4bc8c4a34fd88f9612f6ee3deaddffc123f433e62d97b137f97ae23d0dbcc98f138fe81f5118c44fdf3c69c6ff4a22e6befc68e92524683b7cb35c2e9709791a91e5f2e326f0264f261e6948a3eb2c978947bf625ea89b971583e037dbe97d0848bc5c2649e34d3eb3d3f898fe179978e42969a64fc972892ee9af4c1dfec07b23fb9f3418ea1bdbbc69963c55e39b3cf1a8b7f508fdb957f129557f3c42ffd9ff14c437937f612c5fdbf5f208fdc114439178f2afc423f4275b9599aa6f96cb0473d703500b2487f00f53f82b42ff6a2bbe912bfac423f46fecf4d58bd0beff83d930429fbffcf4d5abf6cb72396dbfbdfef4afed17bcee81c1530f8be811fae9ebd7dfb725bdc967847ef7eaf31f5e7cebbefc8ad0e75f7ef5d2194618486895f2f3264f6bd114e20eff5fe107b90a24e3dd949b2b1c79b501815e0cfe9e174db95e3741000d6a4382e572fd9414067824c0c90ffaf772b99e9086d8a5937bee651ea1103cb4830aa2d09b7c3ff1c813fdb9e07c5dcbebe828da62aa19b59b613b1afeaed98a2657336927d61d7b108557cbe59a4472c8ce808288c56fae92e6891eda99fe9d5dbf89df9c92c9f204d56e5ca4227bcf47cbfb6bfadf4a39ac15c113d2480570b39c9a1750fdfe8f2a9415fb83d098a701139d563c6d6e0f42940579729d512ed8f59bbbe51acf42b0eb8f9ae5f27a4b0b61810967358897d01d6f92d39cfef92c071ee1dc02989ba91c34a0b1ba24ab80442be6cd8ede445cfdf94f7ffaf8cfe6b6033d57354d118970765345ea6e9e6eaa72f7fc2ead9e976b1e5413598384431ffff4a7677ff97353dddccc67f44f7ffef8d9ac99cf9e7dec57e42cc56bdf6adae725fb42115fb7ae3887767fbd8cdddf1ad527f6e6d5c2964c90d3b7ec24db0d5fea52913b6372fad2925cba5b41c879883b6829818acd168a5be371a19838f06864614ab0e26a7e3e9f4d23a31206060277b35adff682dec88bf948ef417a076248e37b4bc68ced7d7f15082d5bdbd38a49fa907e585855a1d1f998b1e0c05aad32f1fdbf8032d7a5147776e7fbe34cca2a4af6df466487db3265a5d168ff85a1169e6ae60aaba4223825745c37cdb876843b9d71d4d36c0d058a7d29aff5a026b4b21c436ff6600958efdd65bfc4f71f02416be2fbbfd507c65e4263adbf1b90abe823c237480ee4e8e53a7c9c10df2fba259fe7695da3aceff7dab05f7eb3375b3248d1df1972a3e9bb1abaa6f1ba69c66ba5ece7048cd826d8b12d7b4f8f0cc49adc13dfe71494e7c1f71d01b77844b4454e1bb6456b34d8aa7dfc54882abb3d081e78d9da2324dab1adbd4084a0de72f9c4f74828a675bf30dd11ba635e9cadd947de6437f13e4a461ecdd9469f047d4ef2ab2bb289f384ed263b11e0892c8eec07332fac5d0b364d83996da6bf94591178d42358907b021c71b192c7a934d278a5f5ee9fe679702fd7502180d7e474de64459ae70fa72d44ac4adcd89bf0f9ace5b0a3b72268858bbf52efc91cc24c7970dbd30c52581e630ed1867d2d02c9fce996b802ef62c2c08ddc64d355babae32fe5a2f8fe9a83ed1a89984febbb6c23029250111709abcc4844db9fd4aad876e3f789543bb5df0fa2257ef77d91ec3a7bef9145bb76e3310f8411465b2e7a3cb7cbe4ee44775f7ab25acd9f3b682e175d74a9b9b106170de35d88a8aeae48262d4ebe4c8b75cee322ae928439d35e755a834e9056e098fa3c167eb7d8cdf7837f096d9ef5159884a67941ae827ff1fe3b9ceaca1c4d79c60ba28656b0625af0a37895dde659b105162cd0872e7c65b42d231ecdc3ab793be2bdbb51e66dd04ee19103a99bf3e4d52fd944ac3b903966eaacefe67fabfdc0e9a0693c4552c85fe491feeedcfe52d11a08b65205c12682ba9f5c0108935a99426f3c1544da0576c0a0beba22459cb132ae930422b6384bd838a8f0a780989a9cf15fcd4a31cdea7f7efd925d327c5c2a883bb744d370d23740d4cb301291077bab2e8a0cc7f3332dd04b7dd8efcb4a28db44fce6c2b43ad47774d973780b63c9e99a6fd2432efe91f17bdbb5bac9ffd28358d11f6a14ec99a01b76f181deb1718db357f97e85d393aeeea444c6f725753615e5bef725f0cae2966fca8a1f0a2581b1b320a715c41eb4404bea06a8d9c1d9cc76f7f974652e29e6651e1df3dec5613f7baac5a1ebf4d1b63bea218dc19e973b85c13c427477170d0660dd34785df66aafd547fbcd8a82570006e67db2cede8fe424d847e947379f5cafb3f7379d97a3ccbcf6289f4a8e418eb8b732cf70881e1948e06517e305f5f4c800371dbd1927a06eded3718f7a40cf4d33f436783fd4591464d26071fad50b07a08169418169e193d318ea8c19fbc5f7ef5a4cd3fdec88c40a5048ed9d11c5058c9ace90616fb25cf0aadbad3d47f6d62d04ad846dce2da95ff5210f240b0ed4f94cc2405fac7686ff07bad553d6b475a773cc502d4d775078af06661a94cbf23ecd0f5c0f95ea21befef4af6cf8b4f4f814ddd0c006b555309668f89314651b39dc207f432b08e09412e7d136704f7a4f716b707232d765297553041771e1a0354d7d1776112c71539ecdf4a55083158f9c17dfff37d6c0d6d2c01a3df6bdbb14748b69aff18fa2bad9df15592a2e484b98b604bda3da45228a6fbff9a4dc63d0232340641f0175a897379f5ceb52402317d4ab179b4a8945154db3564be9c8f1a2504253a324201e196c2bd492cc8196da4fb06c13c100c4f74949453e9045d1630300921ef594cc538ec4455b05e917f7a8f7c87863f1867df4513b71df37c38598326176ee1f7dd42cbde5e3f3e68594c30ecddb7ca25e68c4b58fb4f29486478f5053934e9f86582d428362ba037bc16b53dec0cc8e6da6f7fcf66d26beee16689acd7457fe3af0b61c2a59f75e02f27a9b544cd759bd2a8b42028b2ccf8c691455643f6d7fc7f5182b2b27b4d5131a338f7e85db7acdd676c1b51069adb9af06b7ef966dfbdfb7eef70733fd0db49822cd0a584de2c70e3781218dbe2beb0c20d6436c0adafed2a1eaa35623a1c12fe420aaba0c89c12bc0a930e20f60ca0f7947651bc2dbc28e2a6a1f838a84fcb111fafefccffea35f838a5c8a8870776afe41307790601f84a36c18cff4b04171be62bf6b952ad9be9ca66e65a4f42cd2c82a63d5638df83eff5ff814b41af42c9afb59d38c0b69eefb8283ac8491e8a33d069c30c6b20883154df310dc524e605351b52f2a12cdc33cfa4e41674e39b9b2cf150967e11ffd0c55e68fef90fcdcd9830b3384760b68cd5c9a0426093ca107161752f685a116bd75852158097196798f42d23644b282d13c2cf1a37e7c220509a51d48c918b3ad489e161722e3860f66950b2ce9f450288900f68015c3a50e6e29552205c7c4d8013c533699b4fbb712f21b955f425dec16833f98e739b4758284fb332d85416d6cf0ea95924ca8e5a5c9060ccb9d2a065bf5aa427c36c09f19a92697524d4b88fd443df6d193396e013a0ec617a8b669c6774db3f5fdadbea649d3ac7151e85f440a8a702eaa161f0a698c05e1f525d66c9a16d9f8fe1cf8a37d611189d9412359cac8c95233221074af1624e689b9736e6672390dc2195ccfdf5817e95aa296181c5aaf890f57d6f6971d194b4fe39dd01236379faa55724bd25e491255180a1ddf39a493813140b87d19b9cc24f62aead1eb0296046c805e16b8e4ca69bde7ab6c93f175544e2589136261617a510a65f2c81e336ef55e3d14223d8e64293a3a14155f95db22fb95af47fcb8af785d6765a18c24657387227b77e0afca4a5cf2062e392c0ff2e76c5c4cd75cf095787180b3432a784d73a671e32b01ba0208576aa98319880a7c085e11fab9219605e3710962595e0971099907f0b7bc914b624e73767545b876a990c2103a2706dcf8999610546cb978cd8f43232f98e7d18acda483a601604c4149f841a7674d831b376b9ab9fc293f5c183d7169c6a90d3ccc09e8bc94da75ce5c7e78c11778e14ad08a092b03de5a067d8c2e9be68ff8d3368b91fe033bde5a232eb05e55b2a82613d548cba2c1a00fcb605cae6a767244ace19f665491afdfd5fcb02ec354508948c2bfd116ccc3d399820dc1df8ae75263179ebc1b2f3cadb32af45a94eb5139c11016d6de68e0fb997a13fbbae2efb3f250ebe977eafeebb142e733dd57fc0bc9238727a9a77577d6ac523c4f188fe7498f71a53cfe3861018fff98340d8fff04db788ff40b79ff6292758b9f01ee64150fa721fe3899404c4d2df0d23f92b356027f70141d2c41bd42dca90ee6896de96312c9ae9ac61ce080c7b384500c964de498230c198f7f4e9a664ec2674f030f9a5ad518ea7ae57a6d7e11d4fd93aafbff24131effd7458110e57cbfdfe3d968bb870ecd188dfa3e8f9f59e3aabf4de51a683d05da882436921342d13f768c3523d465781b16beff0f55ad00d728a07229a4efa6fc55180b96c0239e15915e0972659e510bebc4f08f5d4c81a93f4b58e1bc71b7ed6342ce80690545300bf86da14717d5eba96b26bfa542cde68f67e74190f89d46d5e7b3366470db50e37a1e7340a15d7cd13441abd50ede585b0c3ef1942a1bea77dc1acf034e07c624d4060ca0352bb2f37de747d3d802eeed3424e1d1024f8fc873762667da3bb11d1b45fbdac8c699bed183caf1b704648174f6c6f2948a50444136012ef7d48b2803a51a9aef510613b2d07ba37f16be3f0346b5a05590d07bda7e743fdc5ccd43ef89fb4d41500b7eaaab7fe9ae605337c9b03da4df4aa38bc8c1358d696966a17a32c7ce1713efca0bc773021c7a895b8c91aa569033894a2429d68236ad99071b0df7fdd51f094d99a72d4fe448cc7ae262abf49a4497e0311ebb3c8203d418c9418da36341c7ca316375e439379b3780ec771df68f3ec067f031d50c3db2f1c1f7c7296ee79dbc844b432a6cc969cf842608f66c1f6f13f09269b47ffc783d480bc4bdb540d497eb78be58b32df360c48325e2be3f5efb7e67269abc18cfa06d5db3b88e76cea51eeea65879f99cd0daf78fe4b462bbf87dd23401fe480fc99cad629e48cb843b9603793176effb393cdb379d17cf10f4e1cef7778e15477c97d8d94e2677bebff77dccba69820dbb633310fa300e0c08d1544c3b51df9f4c36bebfc7fe931346c1e27b7a4737c942b94758da037e86390b841abad0432720dc3152356682d1ce3b86f9bf674cffe6e6e841cb21057b35a0bd33204c619310aa66d5f5b9d85cb18c6e00e14d0308dffc67e5fb9bebea86cdcee7816bce15f366d3bd248a6ab95919e4738aeea8e3dea8dd4bdb3b145a37c6d723d58022a5cd90aaf87d1255812061a54ffecd3c0a0a1673caa9e7519150b7af9efd67d0eb9a44ae5ad1f2f7197896803faa4ccc98e6c039854e11d7a5d428429928b58ce44cc24b6430027f3383b707092b9062727e75782a4a117606626eab38a10538848aa5973603dd35e94e04e8a5b503929301e6a7198d13e0b29ea23cbdba2241c9ea3805804a7b75360e04fe94a43b1998feda0921dc04e3b40a84e24c4b384c8c0b05acb019bf4bebfecc7455fbcaf2a9da82c9616dcf841acef677b712089775803986949d838b699a12bcbbbd5b38b9810915a1795a6c1fe9e0af9a2c9357f063802aeb4b30a5fc37289f9684306765b12ec1ae14ec2e1253d952df5ee6b8cb437c0021d0ffa6de1bfe065605ddee2049e2eaf4b6e420c73569d8c0be28b16fec60c06c2c974aa455278484998513a5c7f7db679cbf3b03a7523fa56e54795b8b69b63ed3aa2cbb0ea8ba3484619b3395c6d08f7ddf4fd315f8292dbf852dd31e5d7e814a4dd33e07a0e0c6639c7f29b0e5d3bb8a6f9ae65f7c2ad25b69c321631780bd5e0ff63635827ce90f77a6e6e76f179e9da9d6830cd2c9c3a85caf596b4e21307e04a0912d8115d91b876cfdc9e8744c8490c7c6d6eeb5efbbbf3497cbd7723968fb5b4f82c361b8d3e4efe2cb21fcb4f7d58df7ff7a4df371470eaf78743e404fe887d9190eb590cf5fce676cef99a91c1f240014eea0bc1a283de29a5c6f4704cf27acf150e9ff1928ac4c5afe3777d2318c3110e9bc12670a1476d9c745538ff5e9fb1e5a68db87d58ba4fc03d1138b69d51a91b8a5d388ad6c839adc3928d22c523c4b4094a5bdcf8efc3116577394e1effa255aee259edd1451311161214bbee7c5656bb8120ba63cc416e2a6581413f68c181b38b3ce237e26b45caf1fab3fff3df5f38bc918d2a06276a4aaa1ab2b90430bd34eabd5403bdbdfdf8eb8994caae166a4618381f242dcb5b4d594bfb39efaa72a5d6765389e296c735b1ef1bcc9728ebffbb4aeefcb6a8de76c976ef1f24c6c43f055d943cedd36571f6e7719044ab4e2351797e537aabc9edc680d8bc5f3da0dc962ec22ea76c41dea4cbab7af45dbc6d635c7a395eb64c4de1ace3ddb042b73e1896816eac06dc18c2c52c6e90104538e1e8da4cb1039e4148ce1a156b19f95f5714a702d04d2b793a5ba992a9e259a04214d93127ad0825316277029837b5250b17fda2670b658656c2c69a98a9fb45c99622542d9a843bf8da0e4a56da7962356de7835bcb1ccea917150b1bfc575627b6c9a3cae13dfc7073c4159d834bf3d8a9a6a3d47583dd63b745285f6d7b66b6cdc5ec334b2041027e15b04f123ba8d19696dfa768eb1283ca50cb5492be6795d5fa96a2203d52829bc81f9aa6dea41b8a72683b55a56d112369aae641422095ab3d7ad524a28c6b2e5c9b5dfa4559f4255a559bc96d8699ad28016d7357a4c7baaa0533bbeb17bc0e4a4066b9d5e363ed0baef73dd8eee683c3bbbaed91f1818009ff5784a1a801fce1cbe722f39fce020994b9059e359d31c5cb52e7e985eb38d6e80c5fb84a20a33e36b9a4abd40e976b0eddebc774d37cd1edfcc2f961ceb95f5590e681f006c609d74c1818b3f8460b2edf1282e8438b4c6791f74361e33b1e838e94227a4591c20822228a5340838a036da1138090bf5236d59acba1dc3ad33068715aa7c7f0ce614f884dd42b52ae3888c33f52ec33bb04ea463c9aa115c471084f9dce19f35334e2774cbcaa6792e1026d07bead1d4c24514a749984aae6ec7c6bc69c6253c42b7e151045bbaa11cf813d6134594354d50463c5c374d45a23809eb7007b805591ec0715396acc8296747113cd03b42ab20a7585d7c58b1bcbb832b308d7b96c72bb9a20ff15dbc4ac006eff4d39e10c8794a0990193487f2011dc4095db187c1f61e547bb9da835dbc4ad89e2c3249bfd007ac4b4e0fe4fc1bd5839c6546675ed23d093768f6e66aeefb410927817150e30fc6a84ec2839c3514e9d183d18aada9e984840f8466911e464d1fe88184c645a0a60f3020b62072dfe23f007637604136355aa018c02d292c0070dd34ce276fe4e11683ee7f86a572a988f6cc01459c694ae1dcbe7fa4905e044115bbab4b6f58dc96a58e53c1b80604370d02341c48d30450249016de56ba78b8d70fe49c2c74d4857c91eb3065dd59e67a9664c3e20711bc17c186d08228d1d7499757979d535a2fae5c70f9565f5e8416f1fb444520a9d864922fca9b4a6aef005d6ebf95e9b723d5ba15417e33f77d350cf9880bcbdc6334bf9a13132941df9fde4892d2717ef54c3519794fbdd0f3ce84f4a53258939bdcf7efdb2673ac122d6f2af5d68a95ed5b744fc879634851a38595236cc1ea75c759013a63131ae4c68dbd7133a369abb04de545b5a1daae563b69e318bd67337a64decca3b7101fc7097dadd1e61d7dce0ef42d4b9ba6f6fdd6883400eeb99367d35ebd4d93c375fb7ea2e33b3c8fe66127565bd34c15cdfd1a868d2c57d60339fc2cc842c7b35bb3b7f131218be364229145edfb6b72da5a17b81de3f1763291f7e22e58634a849cf47aad8916bfa2837bf60d055014e45c42e2b966e31d1af3fdf7575734f5fd5b5349e2a6f71376a4a5ef1fc78cbdeff628548fbbe0963ec80ef51d8f5a37336d8675bcba22b7f131699a07f96f803fec9d3a721b42160aab90b341151bfa40e86b48e37ddfe01678e0bf9fd8bd9cfb7ec76c20d8588030333cb0e784de9e0d44971063a6244ccf2993d620bb7d96f316065c9a1a3baf03b67ce350d4e3929c942e0c34b8a473cd80f48a145757a464f722800f11a125848ed6a620d4815c4ab228d93701a7af71db55c48ebc3cb754fe73d1e3846866215942ca22d307ba9425e32aa12d7f56b407e2ad3c1040b00ab8eb361e0154a12a3a122e449061c65b4c02d8816d20badc386a4f10fa7a079ef9bef7d50b1cf9206707686435136deddb2ffd2bee7cdfc13d0768cf51c7c4e96dede183dc581bc5b30b0dbb20506c907896d0b1f1051a558b16671c0c85af286633a773cdfe36755d93b5e48244b3f0d0dd4778c3604c98599d5067d0b8e15d7c093272cfd4c0f5ad5ab2fd07c7afbded0ee662bbf4bad3fe850773c5d614913cac94ddf7772238c019c3ccde9c1cec32ad8ca6c5c0550aad2c094a6937442b6addfde09fe71acdb0f7267087478cc98cb62ff54025bfa7978637ec73ba0a08edda253ee24731ff80a9e3b0c3dc8051f4a0d3483a82ac927df407d877a7371ef5fe00d074c56e3d890eca83c16c9a5c28f94e8357cd1dcfb677a2b9cfd6e2ce71d1d18751775e44d6d420ec898a04958d799781cba279f8acefe5e3fbbf6776529675dd7777e95a734b505716ddde6f4c5d15b573d7351f9b6ad36859daf871595a67398cbf159a7d74fb743cb2dec88c48d80eeefb8b1d000eab1603fb10548f1ab3558e315ba59042088e5af278516f9ba4999b0ce58333cd4a440a87d15ac7c649bf8bbdd04b987ab6d29ba3be9758e786a247a973710cc6e85179d2bd2857d6ab8e1ead6134736c16cfc68ef0053b3997c4a7ad08e10534ad277bdbe9303e5ca1a1e05ea24c772d0b72c21d05a135e2141ea7cfd33cbf4d576f5dfb4872e2ecd26823425f4d83cec3361eed99ea215ab60f6c1b2ed1031bf36959acb8a49ff2b6fdbda24f216dddf15d593df8fe1eb223106a59d3ccb4d19f91acd00ab1af52df2f6fea45ad48d914221f85fbf6b810f6869f9f436e5f8b72ff6df1459ad730504470622db4817c2a05a91519c4effb797b6f905044089716ae8c922240f4af153ba56b47612091b496751476980b0beea33a10e4a4b742b4abef5ceb36b2d7c20db2caaa886bd0f1fd1514344101399ba17d61d464e451be6f7608f6f6be5f07d09d9e8913b98c5651bb8a2102a865aca079205ad203b178607a07a76c7782fa732ac35c227cb86d950e504efaf694e1ad7490bf4046252360ab486aeeb302f719248ae50d8454e5d515ade513eeddb389327e97d6e100e6e051db38a7295a0ec7c1386d9ab19923b950c4b4333171f8d48cf5f60e163c30274068b77c0708344f989e695eaede5e7e191ddc669ac6012add2aea0db679905a0519b7381c521b60d685ef8f0f922a95f11aa9809e5f285228d27f031222de75a4c5b4828472f775f7e8e2b2f3d16a6afaee477077ea0d8d7a5c9c2d225ab931ab4dc8b3b68ec55f71ece920cd1ef5106ddea30e4e82efec8a8f1496f0083565d75e425111f1c43cea6dd22cffad7a28aaeb15a5c8360f1ef5f655b9855172afaea996c0c2c08327158e19add8a94670b6cb998f8a334df3fbf4a11ef896a920faed2a4e31dce06255c51d2fc27e38f63696798be1cd6ab6d76b318c6d0ce5cf4aa0c8947542ffe11e44828e58248b2c468c9124b8ecddf7d3e160fe0beefbddf64c383912d9479340c006e2d673c76fec07999a1d08e0b7885d216111d7130f90ef25b25389168bb649996081a632c8683b1ea03e04859477785b1836bd722843184587368d2ca072c86e607d9239576a35dd677bceaa2936885eae3377d7f919d6b98c3f4e16955a535623681f986bf9e02e71c1d23315f1fc0d4fe26789410e54c4cfe46f200742b135b32461037025bff4972a8baab0b7551abebae5596d4ff999d0caae5846a83420954c7a26033e9fe97d0734edd985fae2225c2664171a1dd38cc199a36a9a0f004b15e2ae07138ac08f9df088357b8436b5af11cc19041066480b3cd981e831dccca38b21869534fd48a352031d96047276125e5d654d531a70b5efcf10241e680e9eb9ba9913902f29336901e8a17dccede3a2d26a9c2216496ffe78d52e81fbcb1c993a10143e4cfac494972706250e342572d006582f468f3668d9b60e3b95a38db16017d2dce4ac7cc4e99456f69361e16c6c704458bda8a71c20ebcb0f8a96f6a063ab3bfef51725b51547ab692824d51405eaaf8e13785b1e3d2446b9e3abb7dfc2f30246b98ad8a7625aeec52b63aa915aab0d8a40347986f3f6755a6db3e27b307fa040c5f4b63cbeca7ecd8aedf7ba847abdcf8e3c375c2be8c8c2d89ba8ef45f93c2f0bfe5cbf2ba62bfc84ed08121d98b2b436e75c572bf7e2857d91da8ff4033b61fcd83d01c375b51252bf8d65900fff30df59bb147da7613d208f7aaacdeed7028124d4a7aab34905316b2da7cbaafe3c3bbfacc9aa9dbf984aa3a5acf8ec707b9bf31a36b1fa8d9715230e1d38c2b34e71176f2be44e7b9e677be68125e285b892db9df5fa19ae82f558e53cade4505fa14cb71d209dc1eee8b17f1782b7a999b74fd7ebacd886b3c54ec24d385bdc96d59a57e16cb1ceea7d9e3e84b7c0dc8b2be5868cf15ed5129e42a7ebc5d5aefcf5b16fc3af3d9a76d23f396101bcdb72fde04132b6007733003bea34167ab2abba86cd1ff3ecd8a5d8239c2d9414249c2df61ad2c3f4b62ef383e00b51eec3d922e71b115efde52f7ff9cbfea8d7e00a5fe6fb23546f5d50e9fcc455e24a373c9af58733b0666a84834be67c1a7e6b366bbe3f9a5dc263779fd4ccffd89bcd7f3eb200f3ff04ad88b0c341aaa35fa77a16bf96e52e3ae1df707e86439303410e56617f9450576e3635173fa273102ed8d7e7e56e7f107c2d01154e2a3d9ce3cdff1386f7c165e12093632148ab43108266108d797fdc4b90ff70036a3d64d93399ca5fd262d9ddca61f002aae86e687f836b5b62e7605df34ef625752e59f78d84ad4730b693f964685e95bb304ea784d0b4134eab80292f152414e7e07456128d97f41dfd925d0751b83cc5cb7af92a79ba3c37cbd83c27e4c935fd965d07f1a757ff832481ad84e68b809c5464648421ca0a6eadac41aaa85864ec74a6337aea988c1a12e7743e6b7e78aa13fe2869139ebae97ece5f4c11937c4ebf9011c1f7a22bc8d137676b411875c3772937c4f6374c99e8178e9dd2e92def1a36422162fb6a73b48c6672cd64dc2619a7dd197ba24d674e0593a39d4c644034d12dc48c41ce990e2d5dc66b90816e1e8f5efd36c5952ae80a39d492c705f4509ddfb0f5a705ec424538287384020615def207c4ec2b99533d9333bb1095095242ca582caccfc3b49353262809b143ed34478513725fa70f2ce34a46b8b47e59653f559d15c4b8637306dd7a74753d8543509e8f04acd7b31facd934c2f72f67edfb45a77d64ccc020b65ceaa208ed04a4d78c8afe7a9cda8c23905090d0640434fa305a742a17a1209782ab762560bc42cbceced5eece9572e73ab3229dcfe000b113a736c7972011e28a69f537bceef781336e42c22063ce0bc4099399c7eaa862b1a099ccac93d18ac99dada3b84ac2aa23aa95cac5aaab948272518755aae30a59eace677a97d62f52918697677ddc0736675abd6329f3b011290d5ba555573cd32bda864c7cbcb5f399be94668b5fd077faaf85f213806c2fe4982df6f8c02c462fa7faa3cc10f5cef975a6eb6e9d0e6ff8d24d8c505818e975033879a92f00ede3fff3875a7dd76ff5e7479b7dd769f6ac338e9965b8e8c48157935753e70bc1cd65c263b4407ad211d4358cc03c8bbd9487282314b83db3b8dcf7c7efd417eae9e58392a4f6a067040b0bd3925627b5307cbb4d4a52c46532052b406790c2581f090fb3b8f2604a547500bed262c73f1142bf83929bd6c8654a16efa6f5c0382852c0e915365947dbf4171aade8c49b52bc65170ebb8716f1897278e1d88418bd848c767d3b0355eaefccf7bb18008b5b98e5a4bc8774cc4d572c3ac5aa0f1753ebd07e7da4b032b41a9caa9c80ee0e05684516cef42bf8625d41d2c2db1d92bbd3e9c9f7dd2503c82351130c88442b2c321030575fc6b3c70e903d198f6c8e3e03767fce67c71cfa3b73ba303315fad3dd867e8851287c2aa6816ed2462af9967a57302beaeaf168d1d307422c737159410f26b38879a23a481bd942a68ad84069a47fce430faba07ee1299c1413a9642da249117e69dccba336bb9954d2680a2523a7f34be706533b5cb4f334776b716ed1e4bb033ff00f5fc5c8c6cba4b5e3e688b0f2b20ae24dbc6b6fda02669c55d3b4975781cbab83c6dc0cba05d2501a3513d1c94fdc206d6b7e3932e8d6f43024ed07f58f2ca56f7b571058181d1b2dd971fab32cf66559bead8d2ba16d18b26cdd9bfcf61fe70514d15aec85d5cf9426cbb68850b6b0270dbc8d647004b46426408f5b97507d89955245481114587a2ad6d2f8745cf97e891028d25b46ca47214176063b4c73e92d90253cb34b6633a0c07396bda027d97af8b8b6a22f27762e94d8f4e5d122c1a9babc6086b6496dce333db4cb78e56036654e252c2005bd418b9b3e4e88cce69a5b0ab9b8dd632bf174f8010ce6362031d0a20b091a5950bd8ddcf7ddcd83582f9e4949aa018f16bb0cc0e78731d4238d402e30a4701b2133dfe618e11f1815f0b5f2dde521a7f6104841bb99a37305d913cc9cb43eb03945243f51ee5b868d9ca4bcca94a9b0bd672adffdfd83d3d3c7458f234e06151f161468c5e634631d61bba2950d91accfad632b494e57579532c36da5cb254572b2f3e2032065c103964c5c638bd6daaad0580b9ec194774e12a152dd2d8f0b288dc984ea5ff2843816e86900d99291714baa4b22a4efe9d7f447761d2fc5b25a16cb4d72bda54fd8f5b2baded2cf7e5fba8745e7708158ea2da81e4297fc38ca184c940f5eadf64afdb4dbda6f016c5bc985d97d55ee7fdf9050f2b786f45db7b50f0fc9e10862d5fc17d9511d8b04434bd7ebe788f3d169b0672ac26090eb82dc61c0f2c4f7f94273d1ad0a8990c7c726c8e928a74da666108149c78dc5103aadbc093c02e10f7a3848cd8e60daa7b9c39a2dd2d6fc44253802c856ac1f753528da7655d80ee7056c4a5bb3e81f297e86f8879c4a6b5f9b211b19ec6b67370ecd6de37fc8b3c0542c9085d3b6c91d5a0d5a77fcafec04a8fe3eb034cdffd9fd7146f77ff3163db2433a3ae0c02eddb019a958651bb25fe8c5bef1c8ee1cece63b9b27caed36bfd8bcf652375b60eef6a1d4c0ed86b12212f29a768e05515776671f48d8dbcb211eac6837d1196567138bde26822c04e3314c257444694c5f9a425b83e9aea42d7f67cf8d6f58ad8220979014a8910812959d79091296edcc858a8726798faa699ca5630558dbeed07dff5dcb3e793fff6c3ffcfcb347bb4549ef37ebfe6c1a70f4e379e479e1bbe9f69136dbc046663e978717a92d26d24e9d166c46b5b8cd3516afa42bf0c4facce14814897b14d08479fdc13360615c28d8d66e681af0104cf591d4c6ae6863214de77a28c5208aaa67a142e8009868b0c816663eee643256455df83310fa3ecd0342424e4d90a78c21eb793fe95f16c9804f61cb37650005d04a90f6657480007352ec22953a02140b7684da5592b54a8e86d398344dfff5b0596d820cfc5ecd0554a2c843d8425e46b5c52f71797b23d4969f586670a58dedd7194f363098ec8323d9da91043af67cd08ea33390a808a554497e1be0baa3c242d513d82c873af129ce4241a4d2a3e585cde8c293b20408bbba127b0ab823c4ea38cbaaacdead0d303736c0d224f76c82413cd22eec59198705415616b58ce568ec075414889279eac515ecd66c4880a699dd64c8b4a142d5c1479095513699dbb03cf4c06637599486659485da693fbd392c0ee6eaaae243a282af9a1e7dff3096311b036bbde1da0e4485351d904b0a66a92b04f1cc770ffed06e385e5b13ac950183a013b0d72bf7029af5bda75d14900ab3d0a78a5ab760b1d0aea2c211eb5d68562c13423367794be6ca23a44820d3cba52f5db00b15cb407505955d18c7e4f41854ed9080a3408cc13f5783036cda83de26b2ab39a12522365dd0f6866d53d4512b8ec2c9e2beff318291f9fe7fa93fcfc64e94610ded5d31146e994891c9baf550e264c4f2b206e152d80d93ceee3194cc1a06a85147d23481b63e9777e114d7be09c01b7d1d7e2ff508eeb9ccda639cf9be0691a06499951991a844605865fe2e4f941625c9135a3a16f725b13016652da28204060dd6b2c1828a1e6a2843b9f80e4c0a0a791adc1dc3a0c3dfc88e6d4df218c7d48525d891496469c8036c5479214cd44a159334a1622deb02ed60a14dfc2f1656d204f09b44ca77ca9de1aa0306ad80dda2f00418084f97b00f4d687b845b731ddfd7463cb8d63a27915363faa367cb7807cb8d7aabaafc508c1450976530dc3903cef564c393b7292b2ff4eec42effa2ac3caa232a869e2503bc01f6d20a33e8bf7f2e4a062935b2d475201e4e868170f64124484196e93706e67b92f33ee8551f00bd4ab97d14e1f031a83ac740155653379ba9e3205dde14760beed2dadd82f456924b084bf59976bbb23b4a4c8ca5a88db0843c56f2fafb9a5d008da38782ba623c8f7a87a520610f060a98fd15679bd7f912a655362ecd3e5d2fef27d75b42078e96f1726983f6caed71d0c462a804eb4cc0063a182a8a8072ae3fcf6073f63b1933d3e6a0078f391483ad64b4ec1840bae68112a5da3d6fef9747898ef67e347df6d223747f75ef1d49876a7207f46dec1948f060ad9eaebf45a848eaedd2a3ca5987f3c9f3fcd53e5d819753bfbe53164ea852debfdaa7054a95b97e3ad4fceb740ffbf82addf1cfa461942c204dcd3e5f673262b697b4db4e4ece21bccbeaeefa2a3b5b77ccdaecb035c4ecb565e94b544c2e21dbc2754b6f0bd88f6b6bc1ceddcecddd2ef90f47377176f6535b81cabdec768e7bce014adb33f6c1648c720eb0266e23af2cbc50e350c8dba55cf12b76fde62d7fb8a6bf2a21e2ae3cd45ce6a2e647b1e3c58134ab3c5bbdbda63fab02dac251fe69e4bfe541dce6870a1644ff4499f8cd34792a73df4e8329b2ef3eb96ef5653f58abeff1acf5f0fda97debe4c0fb07cccdda4c8d65370a9c564d71723a43e984cc65ecb4cdcbdb3487c95ac7cd49474ab4e6ed26da501b9095eee883d13a49e1cc033915d33b79dce0e653b38216ac366f68c96a7d0a4a284da7db43b6466c1ef9c08ef2cf64426890b30735b81ae450fb4b1aeb04297bd04de26bfb6b687b35297664ad1dba6c0aa2b2ed9657320c9d8a03e6e0205308a6825830ed03914e79ce77adfc1437be7cc538a15a27d71549795e42574c5c46e33898204902dec31064d035db31f846d32d0b0ef1331d415c3be64e8d672ea16bdf0f36cc0c517237691eafa531075db3a08c36538866b7a990f772b899e232c223699a357db4ee9eb5bc1716245cd3b2cab6a818ee94d14546f55e8605c56e8585dc34cd48955558769340237c807bf55cba619784c2c2a0dea72b1e9aac36538f9c694d6870c7303a6cb37a92f15fecec9e978702f9e63720800e7bdfd70f46ad97d12d4d41098ce7b872d3f55a66e47b99d58217bcf2fdcb77c11a4140e684d00d2445586afc352dee09dd6b68ab0cf8765f80ef3a646b84af88ee8ce35b6fc893099d21eeca9de296f612a36214537514e37522d916edcf62a8dfc7a335d3c1c3c95cab19df770fea0e4e906c670e19393d0abd791f7a914a16429c1680730dc0776ccb5200f09a05e90700f80e8195fb3078a7e0f78e0555b4b7cb25616fefc2ef1dddb0832c2d196bf463932279c19b66b99c126f62d22721497b144e9f2e97d38640591ee0e909f256d5acec67c92dafaec88a6de232a1e3ccf7b763c6565373049a46aaa3b0c7f23d1e9a2645b80a05c9aba90564d234481029cb99a30159dbd3a7b0e045aaa4f63d807b6300a58487a45ba73dca1272aeaee85ed37ebe6f9e0c70ae0859d4be3f36b3f2fd603f153cadd6e57de1fbedb3a9b0a63b0dbbe69018c2529e9380d3bbb684d19d63f1b52d0304177730653b10b39d56557d3701604086022b9f45df28ed006e4ab768baa08ea65b661f1030553a9fa9c6d52dfc77a0bf07fb2cae9aa604103e6849a0f6cc97f422e049d0adf3cd6e9a2c607f39701baa14dd293b3099f7bba41f77725c292180fb7bfcb30289f5e4e2ba91f36ef505530fd48cef075bb676baa46bb6b5f60d5b7d7010676a76e3540e61e4030a65b286163ab626c249244218e01da76a23d7f4c2ac4af83e84f1d3ac7ead569765d1b3f063ea2c0173d0b1fbfee78a33e7677471fab6bf79fa422036b8abf0fa9077dc6da72a8a2e249afa918156d011748a281649d8b15ca170647cfc5243f2ac8d59fea6b18ffa4aaf68a1605fc9b570ecc79b69512af714df97d6943f66c5babc47d832e9b89eb3f65462bf71a9ea0dcf276b9c67042f6c297fb24817bd371af32382224b17083a1754fd644225f17d5deee026b36d9a836e4a8d0bc1c3ceb5d54d0529bb8b6ba8427c7f8cf002d0e9a65b197ef89528f77ba8fb89ce375cdfcca3dc211030953d0bd45591b6a710b899c4aa5262ae92947aeae07a842254bca192c07beed9caf7d37895e0cb511b7cc25a3248892ddaada3ddf711245376fb424dd946891de931af6906b16e56eb02dfa9e29816f6f767bd54bedf3eeb5eee54f06db3e5520ee18cac224db3ea79ea55203ffa60202fcf0a933bf87e8007099eed35ae618cafd99aa28560e8530bf4b6950321f6509ca55dee1eb4a473f1233483696a931d4d7c088d10a90df977e1f4480f665bc18bf577961b9d808cd4d03f4af6ebe92c11a18a6ccedb43a0cea96c789c63ff5ee891374de7a746ba28c7f5b13bd5763e9a8caadd52f400cadac276c6ea5868d8e68fc1f6894f57870a674d0f2c93443a2dda7620a734ddc585d3e057bb1d5f67a9e0832d0763de41834dd3fdad49daf69d8ca1ca7557dfdefec24acaa7a0a45929ffd08a05417fb94b4b73c82527ba3a5c3bf5988906683db1b4675a2ffbd4a8b532e72ae017070b71ac45b977a61a903636443edd97b5305be7fbdddf9dada4a63b69992e8738a8aeeec2a8b00024691b7a800245ee988432df3f38572a965e99e5348d27b96b6f6c83304b9b8005342418d0e2c00eddc04a5052c376c0aa3a007bb3818674e03c995b60b648b5f6b464889922bd5c0d652695ae480cc6baeb2e5f951d3e273a0699d464eae8fac101577ea82468fa934e5e77b0c9eb08454b36876f49164679044f72f9ee84bd0f0fed825767bb778666bf497bc5318eb686091391c2f9a95622cf3af4d25cfc377f18ddca3bb01eadd262c573acdb6825aa1c9f3ae76b24b1c57777690d234691a2800c9cc5d7ba80a464f05adde82391edf82b91eef6a3f719bf47828fd59d67c81fe4c1da64472d7a3dd3b7fc413febe1adeed26a847f9e976b3e7acb1ff03f9e7b4d2066620f0abb2adba9ec581e0df9a443fc418ca41a8fdac7504c752f84f2f3994a994f775c0a3c47ea4f3d5ae5192fc43ff5df9f46cae5ef9ffaef4fa37dbae5ff94fffe34aa5715e7c53ff5df9f46a2d4029bdf9e527bb06a26f41159f4e6894efe69e4dd62aa4726d563e6c85d501fd291dfa461d4a39186bcb7e5fa81ea36dbc62608155d4deb5555e6f94bbe114d9321ec9bfb6246ae54293500a794fb4226ee43eb3fd9d67feab4febadc771a97bf7b6db7659cdf33242e539bde34b57b72a562507e60731f81459ff975f471f847bf8e9e8518904cea7574965e928bdc21b98d9283bb17b2c62bd09318eb2a03da71962c52a3b8775eb294fdaaee914c9bdd38a0f655f74b7b3410c401242620517d9387c5382be92fdaf0463e235a86c322204e043338465f93e2ea8a40192d122a5de5603b6240cbc08d5c394da897847eacc469f2b78bbd6d19fbd1a18669aa239c46e60136d924e467aaefc4f09497e91ad96014612edd14a520353c698aaadd9b56f87797d563c6fe1110df9713973522bde4780e08843d5d43f796b00f3d2db8f5ce14d2dadfea8bb97da182ee0a8fbfaba7f2203cd8fb66abb71fe8ab15b71b1318dc5dba5b59b9a33fc46bab42d42392a52e8764e8e5704092ea6a24f51e522ff590eeee966fca8a1f0ab5472e95d069c8100917a40ac89dac48f3cfb508019ba76218982a049acb3adb1df2545ccac15ced92341d71009b1654e96239cdea57ba853542f7777a0d4f301aae22438be9c5d78ed1700cbe90096b0182f26b1f6046202dea535c5223e788581c9135a4ede464f4ca1782ca81d7084d821d449b97add98dc3868fb2a216b8c6210b57273e82b2564bbe1550741684716a41cb6031f9e272aaccc6feb3af40186fb9f8ae337ddf1f781990e887f0278d985467b07eb25b89f7546853304b37303576f3b369009df73ab0968394d978e6b85e7564229c8a76e55c87e7cb09863fd1215643befe00c310fe44bbfb1f5e0458ba5cf7c5638bfc830accd36df1f20d3c567a44fdbfd3ede52c4cc7bd46075e99ae8796c41d83eeea032bc77e901b39d081d5689fe4ad88a5a9424f3e97ef79e529ba2ce7e97b6e5e4b9c6abbc7b69ffa1c17622876d0b1a04622120a4d2e5fe8800b6d70296ff90ebd2b839a5b9e4fdf98c1386b1a648c4434fc36dc6360a2ecab33c65ae64fc680d1a4fa70102eaaebc0d4e3dc556c77a3ac348d5935f9bebdd7d4b5e65e3e837965a89bfada593e8d4e0341ed9560be29e10464bfe7457fb98552051f1ccb12729ac13c793281ffd58552862b293272ff280976bfe2d515e2090ee14e5bf7c253aa2c2e2e1247a10216f4d217959c2e5c5d945564d134823a824c275709578ee56511c0f1a0a01c166c592bd5829d370cd6254f021d837ca8a4fb3c424d3b8d6a7349e856cd385a812e2a40dfea14d76fba2303a5089100a9d84f3642c1d866c0c060cdd8e6dadbae64550f0474816340a6e566238d788661f44cb55eac34fab2b2a7ee1d34f36d810cbe3e5a1654d142fbad96c51015a0076596db6c2a6ec872b379cc8c49db2d0da1567b86cde254eec1a6c7a02f0353ab51b502a0a8b2e779e24dbd89f3296c3f5163cf5856b4d21d546a5d1e83438057e682d7660359824cb7d8852d93fb4fca81e080a597c1011de09f3e98d0c2088e0af6d36fec9156dbe86d329b7441c0f6a99247db33d417d76e206e735f6a05f4a03784b632d77d1417e41c1a2c800e1caa571b75fc955dbf99c66fc23f2ce3e594264f9f5cd3bfc132230a15a75237808f200a7f284496379fe63921d7f4efec51fd36fd6f7692c9552b5e80ded47637359e91f8157fd1247899ae9b19044397264726dda5be732cafe6b84db4fe77ee6996f29f57225dbd5566349ac5b21d9870bcb345a643d649679ef6868a4522f7dfb8f420783059f42b698116a792617482d2b3de20b21b58b0a938b7d2611ab62006fe998efba17e459d5f10ba4d40c91710330e39441c010d18a91ba3df5d8acbb9a32237a9d38a1bbee0170b804150810cb5ce029c69319c9cb1375b2371e752638d04e4363ff8bf5d798eca5977e27a446353f4e262e0beff7713f93d020084b635bd426039cb9ad762e850519d84c0f10c2b018835b3ad36cd2500a22744bd95ab2141ff284838ebe6300008e9535b250bdc7d10e12e0ae65a9f6333e6f39b8e03571dd55a98aa5269f73dbcb479a20ec6ff4ac34f50500e03761056dad7dd44861e5c7d1378df85d792842536019d0fecc38847971b106d95b4fc687c5a903621d42fd127e5d35fde1d78f51021ed4d08cf6a554a31f5c818d0ae47a401b9aa45807890fcfda7791e18c1310c492fecb80c7e1c189adc26d251a9da0cf2584b1967958366384e77bcda2a8769a91f24ce21efad9c5d2ea4b9c0703e4b576f8796cb38a369f2474f0e935231a0c2de6f738039e98694f81f390d9d642ce04c87b79ddbe0f56ebad6113f1b7a782079e607ed3be7f3b19bdb027a761872ea249c432773749cae335c656e4e36626bc8dbe43173dfa1aa0847aaee8f81beb00c9dace2e80877cc6365fbd9d375db9fe6f950153b9e812e7eabca233dfdbef9bbfdc905406bbf73e97afdcaeab5faf1c87ee9af41e0028152c5b5c915103380dafbfd83edb83919c8b92504062a21518624135a49bc39777142b993ad1d82b7b601003f6808c4c0b56f3ba231f8af69cf5a7b6c3db9849d74fe7f022d88fc43b41a88f105ce0372367d0a25ef4a1dafc31be410fb6fe9036e514046e8dfcc4d01813c041815ec8909ed618d4c87b368e9a00b7d87a5db9970093d8811991716a508a447240cbc7027181a407981b4263ad123174445399161b9e224ec169131cab615dfbba11dda7deb3a999c81f5d6d963439769863ace0c8b3ee2fa4b177169ff4de70dd40f99efe34a996608fe6f1279c9f544982c3db2ca42fbe09510eb0c6597c989bb1dfa3e07e693424dd5815dfc0e22b6e16e4e7d8f7061a8a7915e48eec2af65dfc66361ecd3b01bf06453de8c2dd6fd403b7643e0632bdaca17902ca402e7afc669aa6d5283b600234316a2057641db45fd50cfe65ea71c7a5e358433365e0876fd49108d558889aa599579c377b77cdddc554db6db365246dfe459f1b6811eb5d9a755ba2341102fefc36442e23737c953b2bcbeb9de66b4908de92fd7b4c2cfc6ff43b4bc9f2cae69869f4114d6ab2adb8b4686d3940d93eb8c9642f135468dd048337e44bba0b560d7daf87e593f0da2307ec392862deba7c6267f8a1652b4f0a4592236e62fe9fbb4e1ab5d4a5467d7193de033e22a2daf83e953724d73bc58d64f3f19c3c23d7efee2d3d79f2ee3e6ea8a3478912c133cdf2ceba74faeb7742598f1f88ce7d4fb4491ffa3dd2117d93ee7ec23f3f41112fe7c72adbedf78605278ba5695a46f83faae1f13ba2af3307e663f7eb22a73e9d5a88ad95f4e0d51752a08e83e75a3f2d12dba0ee38ffb453f11952e5edd0cd4b1fa9678463d24f149ce8b950c5a2dc7c5d47356167425a6b2365e894d590a3c9811cbe75416c4a35c0559e34efe5c77e55e17f9b307e3850c14e804a243cc027e543c8796decbd82c8189fa1b041734ec85819b8e23fb9a1f259d85a01e3a26196f45481a85838e943168dbb1db91a183e9badc7d9d16d9be357aea9c4ec705de2292a6990fbcfb4bff95a109f7861b230ba167a902e2725c5c92d2fabf68805951f34a7c267573c0c91d2204c3556abbffc5d1ca21b5d491eff75e5c746fc438e9a673a7ffffde69e74e3b5b57d2a1cb10fede91c5f77acca196b71809813411413e692968cb2613229a663e76f94fd06c08da5448b3c21d62c441c4e10e3c903a2e2b6128ba6783c2d9742d6455ea29bceaf5dae8872f36d731863b90070700c2a9e84d43a982c02b49b94fffca0f7af3e05037c2860238449a371582795eaf6f199bbdb7beba00d776bc3c1acf65e82cf55344b0cac65064505567d8ba1ec6511656c2a8e48bf05c75baf91d880c8b208c941004fd40dc8c7ec44d453a5e121fa20d663e486070df1f67dac90584ef7825e2a010ca69029e0cb1c2f844a643ecf8d9c1c893db40094250ef9327f39b4fae9f3cbbf1880c9ddc09eea16754a81975477bb98742eda1337c0674c1664ed8c2b3f0fd2194ce3f889ef580bb598b8c80cd6140dc1d515b1677284feab25d09e260b3d9e2f762094b647369280ae59c7c58408f210d84dab61151016fd9059eb07143b4645beaf85ddc02d68a9c2149a6c2097c678ac3ba03291187c0d32dc8d1c2999a098517e6006ca3d52ae0fe94a05adb502aaf07860cc80ef8d2bd9c0cbd63abab395d33c893e8b61b58652dc9e96dd38c83f90d5b0d48efd64dd3aa12db6c0fc4f76b0dd16b421e17e45bae733fe5ef828a2cb6e0cf6035accd92e5102b9a4d718461734ab3765375b44c39c8950e317b7bc8f2b54d38c2e9204541c7733a2e14e4222749e5dc7832346ee5b0cf7abd64fb30e1d0b6fda506d49d08aa16f3d28d0c4861e4818b954ee79cb28a220ec29def07c8afa49014b248635fa90ceda098f792eea4c1bcc5e53881762de02993d25c4eb996c6ac0756c6a6bbab797fa26a8c25bd130420b0a8ed7890bf9ba67a93521d8fc4839fc8d846a14ca9a7fccc3e7f9fe61ee95c44c82883c94ceb6a151da73ff3f769fe4395ab171013b63583d4bd062cbaca05f5303fc32a61371c47e7934225afcbd0534f9e219bf04a3f7ad4bdc8434f512ae6eda79282f02421e119ac03f193a79f8181bcdf948ee8fbc0e6fd052b4d61186e569dd6e6b62c6f980a2c5630980d96ce89575b8edd3e06884d4262910405a177fadc56f07385b0f4315169d5137a5cde9e5670600e3ee3ddfc27f4c09c2de43d60d1a97e9dc031dd5c358a7a71affd79f745d374c2351009a0350340231efe0e821dad25b027c448f81f4480f8f52a0e34c62120ce2f64132512f7abda35abe563fa6843db4e43526d8d34c634b5eb5ab3ee11a30627decc243155d3f141a6d0e5ce31a4e9997690cb63c6652dca851716ddb769eb3730537d2c33d29d15b0ec6f568b954ec4ce389c4790eb120a76d251f19adc9019720428e47147dba0de519c2561a657008191f559cf6480ba121e302e676286d64fd551334b8b641fa24568ca5622ae93a65909eb4e434b877880c7e7247b8c5a99c04193e62c85b4ae75202d59d926075ab4f32c1d0c0dd8dab828bcec919dca0951bb48893e2f9911b2d8f46a60ebf428327617af74da74192af90a37441b704066e8968e3eced1ca7a472bd3f0df5df38c38407890b0072f67424eb9d37d19e7e8dee0eaccc5d55ac6965924ba39534bc48517146debfc4353d633b641ac29058030f68e0f4927328a0c3975ca36419bd103ac4750b2227e67ade8748096773aee7f99e8285015d34a99b7fca10e84f62606158a5c309a2e44df40a441c6aab8ee750f9c9ac6596215f59a2e2a6886cbc6b1e7090a9a51e3234416ed586c6682f6d559bf79a9df14f14b3b97e47ca6e656ebaca45ee9e334fd253d06a74395834181e5a8f7d7cf5f7bd2ff1d0730347b4bd3faa15885e339d50114c673ea89bbaabcaf3de8f4bb0aaabde83042ae352df5a44048ddc4f675978e8f44e832f0d41395874819c3f9a0844d0805434c17367b97431f3be88a30bdb623df086779b4c559a043e7f4c366e0a347c8c4bbf626da68d469e94e58906507c309c952ede518e91e443c4f42c3e45ef4e0b6bad62b6b622369b45c19b82ff40da2e23722825387fea18812a73de3fadf0869bbd98a0147a6362d6077bbe4f1e8badf07a5137e1d0858c79344eaf69c95ad2bbe065e133683d6fa13329258ab9f5cebce67b462799c257ad6962734e7495a4fd18ca24c5c24e4ece6d500a1c75e3a833ab426d4880642a84e19400f50b7daa5d8b94bc18681f031d844c0f8a71e09390265570f46f3f2699e4797af6ce9d8aa7a3a21c765229ace51122432b749cc1344162bda713f74c62d6cbdee95b7d0b6f23029f0fd52e3670da791e687a4d5b37e0a4d6e3ed4681acf84916e23891afb6863d4eefe24e78e74f6be4af77d552ad6599805f81d9159451b995537d70dad8bf890612b9e0d5abb990b9e8a80833379fd24ad698ab56c34e21c75046fba08589c9e38a7353fd6f7a0568d6a3891388a7036f0d64c9f9fad3402cd6825223953ccf42b5025c358fddf5d34d9d4c0b20dd434284d5797a6475a8b5b07c4a655898a7637486804c8d0d4e9d15fee793f20aa59840f47e335bd88c81d3e8284c9ae0e45b733bb488e3035201773748e190a529d74b0694cb70ef3a59d4c5a52ee4ca6806e1b99fdbda047416fa1160a8ab2e08dbc0183687cb58a799a90e9845cd37b7c5649dbaee96bc14c9c81378137b99d7804baa5271ef5328fd0e7039fa3f1fe48e2f4ead7ff4c26a6dc5bb75c3cb94a08d3c575816f043b7df6ed8b9f424fe607f4cef473c14e361ba067d2017af47d5667b7599e8987d0bbcbd66b5e78d4e416b4955f0976cab910bcd291bbc219dd9485f851255afce36c76a6bf08167baf4b44ea92c9e93cea7d560a51ee3ceac125ce4be80b14f9516647f4a8f7ad47bdafcb5f3deaed6a2f69698c4f357e039ba54c43cdceeafc1c405e69f5a908664813f8c37e6f70dec4788222413b43ecbd17d67c4e13ac2aec8b602f449c259382f67bd00f558b6e5feae1e82f1c7912603ba2a3a51e91f811d48f5e348f34cdf8837c6cdbf43b04ba34703b90f74f482d92737b7f79797b2b2336443d37348389c4aa78fd8a494b6d9d9e1017785c273adc5545bd325fdb81cba45db2d854bfa32292e59b46cd17fa81a6097aa5203ba732a34cef83efbf44fa87b6537d4777fba5df8ba0b2c792206d9aea33c898ac4f83c2f7dd018c11ce58111ebda6b2a808d58e54ce8e106de70902fed175114e1f17f3f006de0e2c8488f46a212833c6dbca4b78f7825c7522617748ea61b17f4f80820485988e364f3051e78cebf73bd0a808586d6022d3d32e63f0c189055bfc82c4b1b2032d0db7d631ed888e6aaa7a207a91018ce7c7b331d477e57d3b4b3bc52fb57e4f0a8defb2357fbc0c31b1e307aec3cb28f13cd29677e858e7589fa203f3dcbf0d5eea5e2283fd7545f353d535f9699cbdd3feaa25b0a178e807d434d8cbd068ef716c3d5dd8ea99740e266fee85858c69baaaeb6f6424eff0b42af3c3ae9001056060bdc9f2fc5bddd7b8837ac7339a6705ffd2fe3243c2234225a27a59edefd2429a6cdf67ebf25e3efd2ae334ca27647b852beaaa96fe6135a2aa2219a9177aabbafe423ec229ea21e70382a53660aa2b7dfbafde6ff8593de49a2468b9fc36991962e5325dcac022ec56cca0e254c50b767eb34f4570a029011f223fe84889d26ecbfe4c935e14e1ba0d9f5a4b4b3093dc519e830e6b1f65e101b17a82d2c058e1982633481432f6563381858c9351b0208330694e9e66f1b364e2e4766d0f0d242e266cbb094e5c344d1bc91d4d67f537e93701522f05e6c39841da28db51a0a256a598306f7ff45cd7a95eca68e024853c6730806aa32fb529a3717305982df3b2e28e5719043f58abba5d2bc44134e1692b42ba5c8caa0c2db17d09f3be3eaabb1047f6c0e0dfd87c0d2ebf07041edff419845a3473e7220bc8538b08ad04b77db54b736c39b2ed816678058fa98cbd12503babdbaf689a220a4ae6ec78a61d5066184e86e3cdab6c1594242a9b66166624cc805bde0bf628a6470ec5a651083d65301997dea72a11af64c502419a06736edd412e8f12764f8240da341f244f64e02707db1390a55a13437cff5e3f0bc07ac50e3ab972c60ed35d56fc287f94f8911ed58ff6bdf3d6d463a94ca07faf4bcabfac72eb64d4a985fc66e9b92515bfb5667a72b9d86b7d125b20aaa2af537187168219ade27972151408ce402641a582fbe1dc84a2a5b1bed06d3a810919f630a8229d691c685131461e89fe187a72d4000011cdc359ab6ff9a34c41c99e114f91ff9a170fea89bd7e8bc92f4206ea9b417747ab28b04d9bc257b6b0c9dbee752b99d6c79715f47855f9892717d1d3f548e80e64b0edf6edf862e41f6edb425dbbaedff5f60a3d3077edb89b4e3c34bfd4cd464b4dd2d4ac45713639b8efebd15ce97002ce2075110f444e297548b31b6420d01934e4456dcf7a496830bb71beca33ae4f923ce8e6286456739c2d2a84080f06c6659296370db08bdb4cd645124d33335457363100d834413d0472b4a2259900dfb78bfbbd23932c69c1be819f8fd904240c28d8d7b259a23917b5a1f872142c380a30c0de27998cb13c92ffaa8ed947b38f467293e493caac8fc7eb1b8fc855f6745e74cf12dca1641b47e36c87e548316623ed785d428ca4918d168cc0322238c210da588d9b88709d970645115b9f8ae97d954160fbc9785dae70418fa08abff944fd0bd6fec683fc463a0621649a5d8523c236c1b60106dc72b5602cee48d9beee48d98e172a28de9d13fa02d3a920af80bd42cb7fd88dd08260647b34be1bb1a7d6d4a3fa2c24171a5fe756ebc7f1ee788c16d14c8299738c7cff5603ac3d1276549031d6f7e93ee0f473c71adc36a70f6c05319b7d6eaf988b64e3edb5ce2adf7f271c49cfb79a6288346c5774e0b4fcde532ced1c42e30bdd15ee984607b2ec1bfa4112089dd4fdfd356d9931d12ed149af5be8650528ee2b231b792f68cc0d12960d7a9da4a367429d5409fbecc8f3efb41c069a924d31356219dfb73021a41c255762937f0b1eecd88b28d09c8768d15641221808d82e0328fa252e098bcea011255ce790b0c18f9505b9c275ee0bb0586b5e38248c1dc4eca60f8fce1b85dacfb4d79a94480dc6c11e0ff62b658f6d4005b9b1a1e7517d77e151e1b2505f52e7c757944fe0d5afb46cedb2da3b0b57d6e90cdaddf200862d880a27c6558810439a06506a9b2ce6b829ab041dc004a069cab8ba0205523a2e20d9f94c0d85c5490764e5d820f360df0a13c2fd47c1aefff3d90c092105bb5ec6cbe4c935fd0c8f55b42caeb7f42b6d245f1f6e7799d019219b6c976e7953f19a8b6693e55c1acdffaa8b0ee7927ccb1fb6bc20fd549235afb234cf7e1d62e18f90c2a63b93025f1754920a5848775f0d3430644969ccf010fc497b8a72ad9d69f12c8f3a8e808af93f0fb90cb7927ca918310dc817707147900b2d0bc8eac00b4de43d68397fd5fb646d98a5f8caf7c75fd90db4f9d78c8dcad82a622086ef4eae7bddb4f9becca0349718e1af93da0b475a365438606dd6f08449842acc2d3569a3b4883bf84c500f294511fd888403458be1a29271d23211b9c56c600ec61ea9fba9a70b1024123ae3052840cf0b05ad62a3744f182f56e59afff0fd57cfcbddbe2c20d2e764e2316f32f0451019d7b3a35ad32959a00c7fc585c88aadb466737f4f051c47309c3427b45d571c3fe3d0aa43a77e97a759a1433f7318b6486cedb8cf90531658d8a16d32339c571b6bba00fbc8c9cf028ebc88c8289c381a950e3aecbb99eb04f5261e71f89e9f458f91be9003eab139f73a7a39154df3c4426004ebf08c84686de2c5dee43244431609648d9c78894733dce07626b07f6a1a53616c0d7ee01f85c1391396aa5e416c37996c0e0278d5a21eab87282e2319c465a423bb8c4c48971182728d2a5e67bf7284172cf37ca422752130e1eaed687d9bab07192d07c155d4d361affe42ff3eb20176f4d3418cdaf83ba336e60ec232165b3ed22e3b0a79223ca36cf72d7fd857bcaef1e2b01ff1aa2aab91f60d47da8c6eb0c3de4db3297a69655a5fcf0b11ea2c32314084b1572eb420d3448210fa183a28f90e137c849cb171f8e48c4108b4930ea0d7803f45376c43c71db58d49a253eec30bf5505c54e955d86c6c0df21f6dc8b85e2fb4bae847baaf014464f8abc15ab6ce7c20cdab5e40d9bff7140a7bfb425098943e7dead1c2eaf3fe29e80f82fe0424a5e270fd035769744dff2ad8f51fa632988660d7411cf909f999c56ffce4e935fdbbbc38a74f2312c6a3a5489e06f11be0cae4297972bdddd1ffd6176b7a5b1e4493eef7f8ffaa1665855b783ab99297699d9585bc8c712f37f7d97acb05099f5cd3ffd1d5fffaf9ebe6cbcf3f7d419e5c535ec013ed7a797d4d051ee15b379d5c259350265fb95e5e630cd7d11f42958e250c966ba46369c8352d90c27c534c71786855409150ca7feb82794faf3d136c11160bd24aff078130c315df68d3fab420a71f042bfb5637a947e80f42968491da0f82e95fe77f0a2600a07c15fcd0cb7604ab3d47f77770eeae91dd68b9cd17e6ddc2867b9143d502339966b5d7899b0d2231a8d25e4205d1d9d42a934dcd9b408850c5b304d1882aab5404c078541adf30fc83b1139ba3bf2024ec7fd3f1191cab93dc81760c591394f04a2b8b7621ea2055d4c9c1dc0c19649ee3992174b91489c61dee4057c9592a83ea599dcec5d2e54d53364d16e7495446e3e0c07287f80fc1a26b33b1dace2e27b40e72196bf14ce8c1882b6ab730ac25a09d8bbda75ee2fb75808d69a7beead2073056eedec49b3c15df4a0740697eb7b0b7a520222e125730effb4190c54512f1b082220e397508a27ba2607b9b3a21f8c633c45e4210540bfe2e1e36cebe176bc57dbf288cb0a72886e343b92e0dca7501fa582b641fb5b469aa122554d6573d9529e5f58f99146577c05390e822aa51086565ffaec679c898f7ddb7af5ef7cc8607ac0133ba4e85dc62953b4f590686e20cf14ac11daa1406b976a2b456ce0e550471d13a7b7fe359431649efd71ce6b4b0af96aee948060df66cba2a777b18844195ebae3a3969ad5c41cba68965a4cc7d59d4fc353f0a04824ac0dc61456dccbcd8c36c5e89b4121ed5cf925dc6e373dd8ffef9392e67fdfcea2015abe6170cf493dfbc9f87ae247de79a0b57a5a60a67344f6bf175b996595ac3d39972916ef1d785f1509a64fe205c9bccac7e59aed23cfc6f4d99fd135683c4da6322be528991c3f709fa3a6db1696331a95d045c662b19c2f0fa78757f7f7fb529abddd5a1ca15adbc5e80aca9c040fef0fa8babfff2a8b6950d4fde532fac0b0a8bafd0c3bfd77b50ba1ec55eeb3778f4e87197f77adae574240b1cf1fd97ba2cba05f04697808fb5b63a75c2479cd0e6f571975fabeeaef1ef3545bdf01aff5e9fa9018a2f329eaf7515cfbcfce7d72f3d3d76f30ac0630663defdedd5b7df202c6d59bce715c40798b71c9817be92dc3c953395224498beea9f68c50b3570a311fd1ef335affff9f5cb3375b097da65132d4b06c93a5b3838ec1fa195a25511481cd9458b04715b5745d07d0b30942d7e57711d61e250045541e4cbd7555ad4fbb212e1a1084afdb2d7ed05024184638194080ea6414616898b070c46e9aa45e0877d703a23c2e99eada67ad64db3a29bf6a74ce46314c24db3d7bc1524527b1b9896deb1e3f405dff0aa422a0cba66c7e9f334cfa1a6ac03af2c5688cebe2b2bd8ab6c919848a4e2503fd7a14de80ed7e903fe79cf66f496792aec3b5f7bf4353b2125e1c32b81e8bb33bae5e27b0d1b5ff274dd33b9c37c056885678cb1f7524d302e810b4f676df427d8dfb5ba2923d2aea2ef7580cbe8597216ac8cfb09a9cdf569385ffc0dc51963fa34cfbbc372b4a71656e4a0a24c87eda9319377075e8b8b89d8fb96f5ed43754bef11fa9a3de0eac43fb06dda411aae83d59e29d8892a5bf3afb39d4ec4661bb7c34123abe94e979079e464dd767386d75686047976f35e9a016b53ac2d10708c7f29742589e2235f4fd3fc3e7da8031ebfd67bde5ef3ea7a486f01ef17fd30de34b7a62482734d65c14010fa369899a0bc67293bb89beeab7297d53c78dd5e596c8dd04ef4f5b45617087b2daf48fa7a2a593ff67aba49b39caea6872a6741c09b463e36cd0f824c3c878fffaba0ee4f5e5089e927def5b547e84a5b8c4f775cdc956b2487c10bb466dea82274d5525ce0baab6c17b4af248d4a1ea77f91cb0f5083a45eabaaaceb17e52ecda0964c2d912ec7df6d82d04e71360ec669d3c06b863126a7e1fb709251bf9ec11e378d3f86d2debb13621f82a046e9c8fbaf99177a7ffce3c79e341f08fe29fac5fe29fae508d173f6fdd5d4b90c7dff825a33e5f48a302384543fe9aa23ed213407caa42b2ae86b42e5a13294deebc501e9d1e41d4c0fbe3f930a0975d723be6b3f26a34394d8bd547fba368a7435bd4b6be3cc33fe1f7de7aba2d020c9c5ef9492874b4f4b7f9fb0e01fba664522cff7422ff2c844cf52dbdeab5f188d74203181306503ec6f6df5ca82e4df04f59ecc7f66dee42738d287d564b01bcf9640e3d9c6103d5233e052417195f8feeb691f3f05de579b2b53e6ea5556acb8472f6a42ae0112ea438d7c5316fcea6b70775e5b9a106a17cc5d47408f4332e9ec49d6ca19a7870cf7a41bb842118f765a2174a8c2a792b4f2dca32a8dd457c64129ee7e49a247bf4cc045c91c7ceeebc8a3236f5217136f317ac766d3d9dc83a02e6c9b010fa6cc1e7320d6d514f15478559381f1e6d47e8e73e5e1b89a2a77d557bc58fb7ee7a732c7ded3d774457438567574ecd9d128962c6e99271f3d3b9093c6a2e19c4af419cea941b4e1fc4c5ec77912accc200a96837cd107949c5e4fdb1b9ccd712c37bd0308aa9ec6afe92a01684a4a19db8e90ebe541c08732a859cdc56bf5a2e573d0b81c6ae0e9c2c8de696b1215b1e03d9bcb289fc53ad8d1b7444b449e2bf240de6444fa4e8d9e2fde065773fa9c9c957f9ffce57d538e2c65e6396e556fc17ed0d232f048494b6fe93d7dcec402c9c7dffb7ef09e3da3b5ef4bc32e337c1922d4526b3463290cbd68679df8cd2cfa23d813c66fd8b3d9ccf73f9ecd6e78d37c3cfb23f4c5d22fee96ed8b60455f5398d8dcb20d7edcd2d73427348f82de21bf67afa717d453e0bd4c6b618fb547e8fd103e60f7843e521fc7d756d36799dd13429fa981368d070118ee08852ea3e7cc2b4a7d18bd50cf47bd153b33903078ce6e25cdc0e98eddca73471fd8adbabe69cec60f8484c1037b4e71798f9fc3b2e939f3e4678fce6e401773368383bfa13d18b78fe03564d2dce7b8ed691edd81872df3f7ca87604fe31d7d4e5f2724c407b0ece6fd6bfa9c3e246da3a093822dc8da76473b209e471d463674595cc03ccda35d8816d7f047b1fdbfa6cf1382c312f44e4bcb2ceb13737565aeb8a679e4822bf7ae5bfc6b49b2822b7a4c2c7b04a468919727792922ebbc928c60a7d6459d761944eb8baa996fc8c06269c6493de4eab8e4e5fb8276489c9c2e3a1240293ac99aa6a2fde8e3744078225a37ca4c3e86053568adea7b4cda05714444d25a5fc36d2d6df52c6ed7cc85f714607e8044ed605368563dd516844886e86e1a3174a63a37179198bd2256299382fe4e658a2efc6b6e7a723a58b95f6682cb22f3583c4bb2625490528e4c52e66d086f253f1b1fb4bf66cb68c7990c798c0f0938a84cb758c3c28965e42c5de84d88ef51190525447df2c26c0702e7e3b8ec9856d845def485aa2d930a5670e52eb096b4c9a55841d0d286b877c64cf2f8226f7c674e75b228c17eca45227ad74aac672bc352e28ab804355cc403ef259b057f7e991c0530f08564e51523eebc80d8c94e0106b6aca44ef7e856414c89bdb11a3a45391c7c1f49e54ab93f35cbe383dc8f32699a3cf69eca473aae5bb0c8d15eca325791a5497ddc156d1329b6d4b6227f1172427089f12c42b12c09f10f482d5cbda5f4a0a72bbba968953810864447e319baaf7d9fc7c611392182d581d129e22256bff5fdbb37c7fa0444ca43049aad79a511b8fc13d6d13ec4fdabb610da96d1a62a7780cb8937122556e17c3e77dbd187da0a47cf1d7987a6b4c293424da1d79372d1514710f6c87b041d1c7a7fbc6abf74e465bab7c1a8853df9160634d24d38a8d6c182ad9b30ec8bb9cebfd1912b05d655dc6d815ba6c2c548e6ed788ef85a5dfe52bb444b9927b1dd587a68b81b799e9c7634534f0bcdd19fea4ea83a89e2e1c3e87da25a837c5ada8a9c5aa9a99282861c89d7b2bd78ae7ed3ba5a851cbcd19940c7e9414136d230d4356eb20efe26a52f72cb6481a618da54fc7f9cfd317c369be90427987329a9f06e6c0be0f80be9053941548154314639792703e7af0b761d30b28c8288f9cd13d22ca36574bde8c0256ed97de8adb4d84c0942f7468ae6f6817639bb8309da1e79676dcaa189f7b3e2f08ce00432128885a045198411f431c23f7b67032f513213535968ac99d175a18d68b0f024f20e55ee8586ab6f551b9a9b1b071d6e0db4ae4e47095786df9480c36aa7ed104d22f334fe3afa99a69164ca1ed8b4abdb8a82cc0cdf2c66cf9ca5fb9144bd177043eabea16924a0d7c33f960b5f17d47b32f72619097bab257abcbf5935c398ebe2d242269386afed85a58f97dc23cfa18c2c32a8e5fe0384035cd8f7693d2a4a310218c1e0896209ceb4bb244cae95070526cc39789c755aae5bcdd199564684e71490154a690b22a7d7591c56f416ebce4614d151a99cb5479ee932c098e00d54b658e9ecc43171680742bbf2f4e9f1cec90d434eb864f4c220dfd63fbf7ef9a5107bcd36eb7b8723c89c54fd6d8bbe2ef378570584ee0a769a491c307ff6ece3f0d9ec8f67fa80687defa1725ff0e9a752a0f44f6554a4032103f928e316e72829cf3ef4c5416cbd2fc87b04820ac8e2bd43ae9e3b2e406555b3f1780befc9fb4cdc3daff89a17224bf3dacb8ad1b670ca62ec6c5bc8e21798f942a66a0e4bb7afa64157e30ebe8e7a08ba8b0f18c7b22b5aac9e967b5ee85b824a444cf954626dfcaa79258daa1055afaeefcb6a8d1be678572922ab255edc9735c0cb79816c952dc98ca4b27de9f6d0bba0add2bbd59aa688bd7f5e69a8e0eb2b19b30be2ccc1f7cceb8211440066d805a92fe532192d647c4ec1062e6ffb8a9ca0fad542bff705dc370483b21220241fe4c5a46e2a2d8fc12515d5464e133a57575405b566489be68fb33f52f30b8c2e09ab6057c4e655d234e6b1538c5e60f0da12bf68263a813209bb2fcfede9a4f574501382207b48a168e686d854edfc84b981e1518075600fc564923011e849a330c031e01da9a0620e94d3c7d04d8c3092b8895dec214ffdb1a0b705bd97163851a81c551bb89036701c85a1ceebc271d48f42edabdf10e3dcaffcfa9d000005bb7e77e0072e4d7e9f5cd3b7058b3f2d12fa4dc1a49a361e30b5940607da1ae7f53dc721825a03996f57326567c65e6bc9be80e361e6fb9912babb8e843c893c2f8429381068ef93f2930213e1fb1378749b0665b1a098c2089772b867412ec79e49cfe4daf7ebf863d86d943aac177ed20cfe3771426b36a99a66be5897a3540aaea67ff2687dcd526adccf4cbbb49e9486c74ac78c05a999db75a5f363a4be7f7575b08209c4c9ac590198ac049bd44d83be66b4403c7fc14a5a20940383a766544f3a1e9be1047e9b7006491c93cbcfdd9b72507e78ece1e1c29891b51630affac200167c0383402c07318657df14d27e063b05673213c94fa28a452d7dca64fcb58a65719928616c0153092b7f75a217fcd2ef51b7fad6c4f3a06947e13a70476bc472907b01db1ff79a84f794ee763c5fe8701f45d360b9e06ed33adfe56a2fb070937cba3e549250bb52807addbec12e956c7e55c1918e1e583e1580e9bab30c07edce6e3ec67532ada0ea6ee9b869518a6cf3008c1c701ae7b4a44542e8fca6f4fd43548441da91d3a148a2cc9b10e8cd2a0255c271ae938ab7561f52d95dee85f36e3ca3271dafecf3b486ebc1e97ca605a12647a5f6dacc781d0afbd2980c14d4ae4f6857d02c4a58d8f5a16ac6619c50e7c8b7f8aadd6c769c1a74902302bb4adca71e4d603535500982fa03976fec3adae597a66c153c9728125a3a1d1a343443d08dc856d0997c660b0746a4198ddcc28c8d6736ce93a913176a1be7b67b115d6e131509095357986ade6a552ea12b966307f7aaaf1790660fcd9b74cfd3dbc21ea89c726aeab4e7ca8c0aee05fb60455f1554db8c5b3ad47483bd24beeffe3409216495cd51aa16aac082cf811a584b8b6c17e6545e04a16e41fe381342e5c4b630400ef427f35b9b6ee9b7783693361a162255d5a61e9eed81d7efd42fc768ef45311030acb5cc931afcaae3c15dc0bd11ae2e922b2812c7a61e44ba0c1a38371f19bc5f0845ac09646581e5242ba9463928d073ec46ec4cdff7149b0a2ab6c6cd526bbe3528495bb74ada619644fee1307680a94329edeef19411a5a0c18059763e4e3f2db29d3c686d14b15f0a7a92007a615ad1d97a4e226d3fa3107828edfaac086da197504606ed066bc9747037c436ac70d96364f25fdc0ceab7959921e4c6de30debdf188e86d610b7212bed51c13ef0aa53fbdbc15acbc144788ae2036dd1b9755bae9847240441cc4047da7c5f4dee608a2c743902809a6da85fce7969281fdf5e668431da4d343213f4266d4fe90f83e55e1baa50683ba3fdc6ba7add3340744e8a0ed9bc984e603d7d8d0bbb6d2d5153d4ee5a319aadea0a671c780ae70082f42cc1b2f4e783a348d76e5c40f407c21598a4d5edeb3786f9f69fbf84fe7f9a7846a17c38e0fa475db34616adc8f1b041b807423d8db58345d3f451976df7421cb991fcc867d1a5a21a75c5b1f32dcf6c33fdd2f73f7cb4fee97678949b758e128ca08ba1a4fdc6b6212d15fb38de164340a29118b4e92d6588f8cc2953ad844183587a848429e36ddc533c2a137cdf8ae69ee70a41df92481c6252b0e7cb161e3d97985cf77be8f624dd3463ca8c819da5c1cedcf0189dae16745c8e92e328b95152384cbdeb03bed8548c2bb36c0517b26e8e94ca482d59463e30da11be585a9a3cde43d935bc440e36df0197af91d98442cde1931a47b04ad25d58ab453127405eff5b3b3fe2b52b35745b08930fd70462bdc647267ee9a26c04ba6af2dba012d2dc965f346ff75dcf7aba6314700bfa279881491ed35f252639c8e120e829597459bb57c9a1599f56ca2193c835e03edb2970575cbb1d3aa2c6a511d1073317c5950d4eb6042134b5627dbc0bd6a52c1832c6085c9040b2a00f0e2d5f79038aad7a522c9984ee6a0e6aaddfcee35b705e6433752ac59a51e156fd163ac8a96b13ad3d5a1ba14c0aab9edb553af19a4750ae53ae5bc0a46a973d5b8756c48e3f6fb99568722bc10dcd0e2b73a43eb700f6682b96b61c94fc4b8d53463bb8249c03b2b674b3fe57446e7c3df48a82b15e53d0bcc525eb54b4e9e8a49fbabdb482df8def72f5eb5910225b762db37e95461fe57730167dddf5849fb5dd3941d009480eac0a3fb4d17540bcc4ea6c17e8c287388f5b2eb842272d4319238977ba945c56f13a20c65c6ba8c8e25a14b82eed09781e698e57b8ac06c7015480fa284265044229c91b0db4ddf915f9ac10377f0bd29125dbc9109583ba30b0686e704ecd1f5ba7179f44b125954d5193e6ed8fb09972c7b7fd49222b93fdb8dd1fba77c035f97fb0e9c43d353e639c210b2537fb67ac4ed65ae5fb8a14183a1ce9d3cfeb1b9a2a8ba9028ee097e6193a0bd7b11c35f248b4b6f832e765420d1344381ce1ef14351de81e01f90d0fe1db841443b550d5fb80a6ed2357f5d3a8b6174e803b9865f0a1deec38431a3337381e16a0888edf564429089b375dfd3df06ba32891dbb97adcc617004b3c6d7da9789d62e01886a82fd52c8334c2da50eaf0b424bb208321b0e1905bc4d5664f59d4708c2bc827f4514d8b3397d9825beb39a664d532a42105aecb91363d5acaefc18e842b4267d7ed8a5acd9c581973475b95f183645fea22228ec682e6c5ab97574ebdbe6ab7486500d3923835fe3e6e8d158f3c31751efd4dac9f83bfaccfa3e9f782db50e7d8e664f6b19e2d95e2b8a9787581da2bd2c91a3f7fd2ac08fbecb6f7d59f0b9d6be65a4ad048ac54d957075b52025ac51700a81b4eeb25a0967e548e527395644986b9a40be0080c93e90b542b0f19c96d27a61c5838cce0959c0426b8c38c6c7e91a32f68349c3048a9eaaed77f6d09e01b3c0c8c6ab16f643ab4a0b77b520d98acdca7af0866f7f4abce425dd95ae22e3fbae734c170630a5e39f3b6cb9bd90e4ea95557f9d48b938f7a25d56a19655a865d5a9d77c5fbe31e00e40932fdad5442b7625059d3b19d36b9d311d89d27d1fffeac1767eb4232206e6cda47a9652ef5c24492b7652f464c8cf366f99600294e5e28f37d9229bb067578214ec17680f6915eb102cdea4485815b701ad8a84b5010561423dd5088a553ae01877e2f19cea3c5bf317e57d11be2b344b41a87cf9c35ebe92985dbf7a2db1be7cad2f00428155bf2adae090aa8db37cffed41381f644bea836ea8fda69bfbedf4b8fc12651b4c6cdda725e297c8940da3293ee04fc8a32e5a0d4fd650b7c059f27d448dea0a407c9f5343df859c2aea3044362319e47cdc298e1072c2e2e0ca12864c521be56613cdc23622a21e555b2c6a1fc3f6118847d697d3ad35e5229fe3b654123aef2dd14775feeeca8831f4036e8219b86bf35ba1816a5ae66b5659e91a6d1fdb65eec988641d203ffc75ce06d54d5fe027fd1e19ff14b581156527c8075257fa6321809fa9e46506be4dff045bf9bbe9aaac03fe543e7ef715b97e26e9188584606382a56117e4ae9159aedeb281847c168915faa8b6ea8f850942ab85bdc88a502494c3e044bbf5f2a61106c914575774de56028861a7808ca452c5de7f67478ada0e8993130f249a570332f22fbc91eda45078eaba19c220bc4f7336ff98b69fddf9dd2244da6d0193f1af74e1c02e44b711625a052276db9016dbb6f66d41e86d2195b1a6bc844276aaf3f23efcf36c4637692da4d180651e64846ddd38df4338f77b6333695cb06697e060f2ff9aa56a314deb94886b4168cd8fde114345aa30639d7661366868515ddac8ce3b96624ee6a9ee352a119c6a181cdab7f2c9dc7836ec1108c2cc2440a4253b411f31a339df887086b8c8f25eec640000e1529bb1403bde0b42479d9898826690e4ea0828b8ce3f437cd4acd83ecf335e88eff94a687975c91ef91e40e0c6be2c829a5039bc722acafd04a92fb7fc2735af2b315dc90aafcbbd1a3c6eeb8dd0a5fed92b05eee54cc2127bafd7fe64d7281cc4ebae6097ae5a79a109ffe5c15f13c226ba81398a07557eb6829c0d29ebb4f4d7060b635ec5f314f62a1ef4bc7b3d8a40d1eaba69442e838ed0bec08c3c240709daa8f3e800c45476e46bd91b906b3939b8c65c6059c9cdd51cfe0715db3b21cb4028613565723c348f50966e40c5924089d889b178c0ab0bcf7ad81c31ab9729a4f33d0ee6584690d843c087bfead7558a7f27755b047dcb3278d0bfaf52f9679211ea2184cd164a0a1189a9fc61bada90702f1768a39848872933f36c77949cb28dcd4661302e2dec01a87a0740dfa8edf26a25bdbbef9160c563901bea84aa7a7fbfd3b914a89108995d7753964024ac020910198ec1c478c339a8e4ba198890255540b6d7e5de860d45318c7fa81c20bf2da80f94da13d9f6959da022015f231c009a4483a1de17d57cbf289a9665214970e7eb2eff6fc623bb58ada6b1a9417c7fec2e945925df774edbc0c194f943dc260d9dc49ba6b611c9813c4fad7023f41cc4e1512b0c093d07edb839051d12b0538431565cca27b2df8a522f807014515003f9b57178cb0efaafa33a2e9210847b18d4512bb609aa88bbc82f2c6915952177d12691f558e94699a6e8b97ff5e8ec092e8ff165f7facb6a156a14c1b078f8979e52a7cd7093f17bcb1fe850eb46da4d25609a20c097d4babcdf4e3616a1cc38e74db8b1e70ee1131c7ae501592027dca90fc25d51fb95b3054e50e2fe742125708445567c20452720632a1d891a1a94f12c32fc52a84f596b03fbe8eeb6129bc5e51a22989bbd56fbf76beca9abce9bf024fc4b27cb9432abed95a7d67244c5568d61c359e639ead3acf3cb145087c514e8fe6a3b2724ecb8d144eaec81a4a81185a03699376825653b829651e558a69516aa0c1d849864ed0659e872d2d96a0c9f16eb573cdf80bd29e052fe59ba7a4b2fd8ae5db93ee4dcf7cddf470a80ca2b2b5147dd9fec187a662c6d153557e498c3df69ba5b9be7c0535118202ea29793389ec9e508079945bb819270f8e5ef6894f1e91376246712dc67c5babc278bfff8ff000000ffff0300fe8a8a0e72460100")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["jquery-2.0.3.min.js"] = bs
 
-	bs, _ = hex.DecodeString("1f8b080000096e8800ff74b705545cdf923ddc0d8dbbbbbbbbbbbb0577777708eec13db8437009eeee103cb813dcdde1cb6fe6cdf79ff5d69bbbd6ed3ea76aefb3ebd4adbaeb9e4865452904585c5800008020232dfee5ef7fe03f3734e4dfdf0141e309000056c45a4c4c59464c8c58d9c5d1c2dace1c009046c8f9eea9a6ba821931ddc5d535834a4a4c7187fb1586788e4f1a03a4122913a80c161e4ae9210e21898e42a361a6d60b948a20359540870b09365982b941561eee531197d8df88b185b9ace6b8ddcc7c1fd1de5a396c595ab1bcf45b6a0728bf0583faddb48081ec055ae817a77d7aa86813838f60d8c840442d60ef8e850548ea491c6ce1ad4b2023a34c7ab8e1e18231d0ac638dbe4799d275f1332091944b8c1c12002bf19b93df9f126b1f2c45b5610887f513347e974ac31acec28a5900535845ba37586bf7507a40d731969e46f9a700577b0aa7933d37a64379339362a7280654a0974eb16d59e40b65dea5269309d87fa49e9f7fca960730a367184e1e5216d845b24aa0e35c44de8001a05306793a5d10a39f79ac72015e3f2cff448122654c7ea02ca55bef1f8d5abc5c37a83e9bb0d0a50c28ec7cf3929fbe6b848f63c60aac75fc2e01a4d0b6cfb04a3cafc63f3fd75e3cb07aee59d922f4577d1718fdfaaa7e4d79369d2b1293abbd657756c5d485097820d7c92268e84e550734da430aefef54295d12036e20008d9bd9b784e176064983bbf05470d1676776aec03c5b46ba23aed7bc60c7f19e709ec78c898b570dbe1f5842416432186ce612fb3f4a7fc8894f4ec223a64d9bbd3f227feef5f258ff1a1ba0cfceebc5ba0e3b5257394a81098db4ea08e30fd24a6034830d0df8bd1380cd490585e39f638b6ee56e08f00be3516e95811a751400889181933b087d05f33bd54566811dedeeb4aa4eac758d67fdfa71f84204a717e5b0232f42aa7af0001f6fe64251488f53b4156ad707869a00e5960fcb1ec4c80c466f84801fc8e604be490c080d944606ff0ece280d662d0caa17115cfe5b822ccb408f101b27c042d0d719906230a713a41e48b317c1090e3e504a1836bc4f8e19b819640d1d7c858c5128529180598c9421ba888ca703ed2d26a2cc8d0e3e241699809c24fc3c4359864d1b525120a62c7047e686db0ead1ff2920f3543cc0ef90ef40b4549079b374658063407aed4f1e1434bf54f6c8b7b61644361f5aeedc8b6620a22b6f463ede05d01e8c30cfb93a18547a1c16725b5a0f1aec54513b4c8812824b689a4c560e512990988c5786db1d289ce7fc021230a8cd3b1318771f622b463764c348c174d64997fa79ba66bc8631d705a20b3053d079798fcfef9438baa4ac8038613252b966150c328de48b72eed27f8b2f1191f3ee2482fce2ebe937f2bb61eab03470da8056929a623f074006187f2daab937613fd3218a9cff5cac497a41b36003f80702b9e68b8872a014607641b8c44f29d98dc8e5c0d1917fa10fa3d14a59f5d25a84cc98d341cd97b50cf84e667dcb248a70c3ea56232ba4c3839d617729a8cc62035191b527d4a01ca2e92e4315e3329176c6d6103a51af23c62430aa42421e5146a4a7425597c09d115451ed936616f616fb1922f08650e0b5a15e9ed393a4a5de27eb27e4c52c96c69b7697b63d5c99589b6a9a7693e144a294b699e69868c7954862401b21f3fde5ac154fa84fb54838aea340a24771afb05c26424320b534dbe99649a449a84ec720ca95074260525b5a02a950b1fd7cd519490e08ace9778cfb5362a34f2ae9aaf3213487115bfce271f0fdd111d75ddc1985398ebee53efe3efe3ee378c7f81ec67367a62fb825312931cd311137fcb7ed036bd37bdc6596b6df0d94993fa4ceb40eb9b4a9a6a910a4c8daf905777fc8dfe3ba7c2be426981d986d2c6d866c5e6f5376365ec74e9b467ee748e21fbe79fb78893baf6c8afad2f36f703270227c9b899b8ddb838b8eab869ecc31c38990e996399fb9987ec238d563a704d209d7d9dc32603e6d48c8b49350b8a86689e82ea9af3528659c1e28d4a816ad3b2062dcb22c5862934fd24465d2e9116bd16554f512539262d26c35a95cb024195bceabce21ea91edd80f1de19e255a36891acbeb282a8821ee35ce3a21d53b28ac4ca59da229d22be616ed366e7a86bc13d5dcabac81c992499d83105d5e5854d35a3f6e9c66978db032b4af64a8e8e91c2ba756ded2ac9c57c9e01fe51df22efac673a388168ade81ccc0d4c43fa737a5586537a226d1a6d082dfbc693ba9781d5c14c5b7d53bf91709b3dd386a91f1b074dd5e50c5b1e3de77e7c99fa751b981d8a1dd30f880fa4af94af2c5002115e115d888fcb6e1ed41da17ebf514e8f765aa7ae63f6a0a87ca87ececb946ee5684fd14c214c15c1c1635d612d3060bbcc3aefb4e2bb24329a31c6187a39152ca8afa5eee99cbe4c2a2cc51e7a0cda0f6e2c0bacbcb7bed221c921254a458fa494261fe24ec3197c4babd54cd4ac6d497a34b9b4b92c15e4158c148c1544ebf6e8aef4637a327e077bf778d9b85dba3a7c597a55027183aa608e608b4192200d2841385618540877d0401fb0af23988869ce905c6f01134cf44dcc238c1f86035e1fe3c5159b919ebd8af2ccbb55ddcbdfcb13c46022212e36d8211d3f3a372a151d8b2987e92939263524f15b4a41c247224d422e4d8b5ebbe18f648c4e0cf10f014c223c0c1f720f97f2c7b90db988bdb35dd8bd0b4636bdef0d67ab2e4dfbe513d33509867ddaceb4847844045bc89f9168fde5fbf23577866d9efba0a5e48e88d7a4c361bac3d86bfd27f3b744505a687de8ac78daa07ea4a94c8d0c86cc1e989248d3b67bbde14dd09ff2948494221ab6f2d9f25fd6a18d4f8df3a214c6517574fa6c02545d4c03ec45cc62ede3d6bf2fd4d10a856713e5a95db52d3ad2e33ee4efa563c8b32842b4e11a6cea372da5ccf3c65526510eeefe64fe71982018bb1d7bf5e7b0e0f074b7ecf6f8087febe3b8b045ac0e5bacd15b6b53f4d4ef6bdfb9ef3e8db727b6975eb99a909c8835c7db7288da5c50c957a9eb36b26ab83c18166d1355d9e96298d245b41bb2c3b03bdfb068a8b6594b233598bd3799d88dda55a2584f3addd2f6d37cde987c083def2a792879f5dcf680f65cdf386ebfd5ca30d1365899a9d9d6afde9fbc29bc69e9d03cebd630d6753642b28e378b6f642dabef92adb591fa5d7a527c12841b9f79d075e2d3729eb33ab2b6d4b3651f300f8c051f82122444c7ef0c187d54b6ad16b11f325edfa91a983e2d3c69efb10c300c103f97be4e9c960a932a19e98f77ffcdb7b0c455c695c2d91f9b34fc73cf79c4f17dfb5ecc07adf25899ada8ed09c44dc273bd3bf24474990bcfbfe21de557714cf59ef7d03ca313a553b0b8be18d95ccc69ed23efb3751c389f433045c02094f5e97b17f39091d9306e29379d3885919dc593e7e97f233a1c761d1d8915c1abe926d8def8672ea7a6ae66732228add6e0bacda57cadebf54d3dadd2b286479fcf2fc7afeb3cfc3d7691b023f7bbe199bff571d3a57dd7b153c3fbf7bdeab86e08bfc317fd57a6cd8776bffdb8cf1c3cf068c8970bc66e9f4f83758163bfcdf759a8722403a1c9afdcef56efa6677b8554b04ab4b91542d5efbf9f962ccabbeecef2978ca61bbe59c687b78a317da63ed72a0d404c974fd349364a9e48554b4dc73cc76c7cfd79377435119336bf17d643f3d1fc983a5b99e7eff9da6569323678653b7b9a2124bb59d6cdf3b2f8607f6876f467ded3faf24769d46665b7d043d07bc1abe9dd48e2f199d293526a5eac90a72fccdb8d17a5bdc5c1d663e8ed2b2bafb6ffdf6f4a1827696d57008091ec9f1be85522e401008028add5b4dcb414e4794d1ded198dcd1c4dcc19bdec9d00ff5cfc5ebc7f87f6e66ec6c45ef6760eaebc5e02a4ff85e0fd3bfec7cc444afc5f10375b01522d05656231471773620e467646665241586262627e17330bde2fe292ffa2ff9d09905ab9b939f13231797a7a327ab2313aba5832b1f0f0f03031b332b1b232fc4530b87a3bb8197b3138b892fdf722ffb38eb8b9aba98bb5939bb5a303f13f7363134777370152d27f61fefbfa6f21376b8bffa7e4e0faaf6dfddd20d33f1e26164666a6ff4433f7b2fe3f68ff78fe4f9a99e9ff4f727277b1fbaf3d99993299db99db9b3bb8b9fee5b1fc47dedfc4fd67352f63a7ff1613fc7f2cfe7f22e7fd62eeea68e7fe4f0ad41dacdd0459f899fe93f9df59628ef64e2ee6aeae7ffd821cffa2fc6fdbbfe3b5fedf7a826cccccff62fc6febbf33945cacff6ed5f8bf7cff13d4ffb6fd3b5efb3f2a68ff67857f92cfab6ced656ea7256efd37a5ff15330b2b373fd37ff4fc3b53ccd1ced145d5c9d8d4fc9fd0feddf41f75b4ff4f1dedffa86366caebea6e62636efabf73ff3f752b6a6cc9f4bfc14cff11cdffb71a78151ccdac2dbcc58dddcc05599959d81998591998b9d4585979995979d9fe06ff6f987f638bb9981bbb39baa8393ada09fe57b0f6ff4c89d918ffc5fcdffeff692ca67febacff6e5ba67ff5ad20ec5fe6ffbc030461153f07a600000e63197111352fe0b91f4ca381a6fba6bf92e30cbfd6add95848dd1f9869a3de38b8e540044e55ed504a6c4c33913974dc4c465c587a5c51d1244eed2064d51fe899a22c0532d0c92ccb16a8d8a67b40dd1b6233b9457aeddbac08ffad96479ec7f54f8131cc973c7239decbf7c7b38647c1d7b3e36cbe8f47479e4331248f98bca58f118b87034cdb8cf817339e290166bb812ca35dacaf9c8f0175b17d52db5b3ed96bbe739dfde02cb35bd1cbba74578e8c56547d6813e08e0e026d67795a284cd5e6676d60d9f45475508a94bd30f8be99feacc07f46174168eb6fc974bd6320ee3e01a7e0ac001708ee0c00552b94a2d3ad520d800a6d1bf4d80be3f03186b16d06c6cf2730c35233f4c4a38d380d6e1fb1e477f655e9d8aaa9c1c27df56625a719ec7e7dec9cb3a9e13725b850936bb67bb3f7950f54b8bdd265fef939de4dbbe728e1d66f4b2487f3a1d211ce4b3c6c3e8a78f0b11179b0ede662fd7aa3e02edf15f40ea70f902e7b93fe05c1d49af1c046a515c25a42018209147dfa6fce290b25bba682ef9cd77ace27e2780ece9ba5ed90a9d08f7627cd8e42ed4b1e6e488450c6b6b9300c69be372a8fceb7668449c5fbe91bf02f1353d9760d94cd2b57ccb99a2266ba28e324d226d80277f03c7073007e452b609c53ff3389c204b3572a20ffa72af9c0f64cddbb957ecbfbc48940b3cb86bfd86d28931403f0fbafb3bacfbbe0150f160b819029d6182bb6561b1aabec5b684a9818ee525f902171b2c83bbff1f2b4041009f22392ec25e14260b2f99ca8b156b071ed63a75b7f91ab0c4544e07a1c452910076d78e5529f77dea0c9b7a289358a5a1e0081cabc3715c816089fd69c1f8d262e55b4ff67db1ced1bc98c2e1a82df98986f154096711a7b9b9f67f192b02efec3a972a3a9206b924a8fa710c6093cf8032a6dba3e92001e111c3c806d79b5c874d6d28d630d474e294a0b933feeac37dfa544d38d02404f289fa433d3434c33f39bdbcc48b318cee6e1e0fb3d97c665734f9b6be9f909cfcf3894b8517700efd39621e7fcc7a9e324e7d9010af06c03c776526b4afcbc360c1c957aca42d12c2c5c65bf0f00672536a30594546dc8ad04fb48014b914964380b44aa06cfa183bd461feaae6ada7902a4232fe570fc76f07f17d5650969452b8df1066bdcbbfa8837645d72e8346a874225436431cb6e82472f2a2862c11f6253d6434006bf41165a87fd26e7258ea0e0815cfbdd8d1271c849237a3053c46c5ba0d5ccea37367feef63558f99b694f1950296bd921afd5ff670e5f1352355a518c35d895e8af911a5da3a56c161c12232364838b5214108416c9d50a8d27dd0cffc7c81f09172b6b25552c694a684f849f48caf94d7f266021b304548144c3c6b33810688cc10afad278fdd8f23c966208b9917b232b54061d93b97cd68ea86e7a4ba69980f52d43fe007efc32ebe8cc06c229cb7c6d747925084a28a5add1a28cdade117a4c364b86525eed5611521ad565506ffc1fe78f524e9cc2c0a90d2899a41d3cbbe743d6f474f9a4a9078dd735ae6c4b2c059e8f64a349d4ed718ae433774b25f7c7b9011756bf4b845ffc17216407513819cb5330fd8a31e94c1bd63fc082f09dd791157752b8a3388ac5f8d3bcf733b8f4c85face2c5f0bdbcd7779e47e2e4294f24aa3bdd1a5b96f3ba6dc214965b5898472c392835c12c956c1a90b6031dada0a516a47f6232f6af42a5b165c65932233762cecd8231ededa95c05566d744f38b41d3afbf8ae39acb5dbf6d8afcddbeb7a7aae5cd418e841e05baa52ba1db99e54d1783c146a379bccd23cbc1d4eb61c273bfb051572870e0f274d4fc45a9dcaab808e0668f2cd4c44899da543bbe50b719257cfbb34191a855e0ef616792c61ae460c7192e77be3f5f9b61875474bb62c31e31f9a7cc1d9266b0b7b47dcd8b85cb8972cb80d7c008356af9b84af0f02b66786173aa648fc3f63b323d4d2b135abb4ccb8f43c7ee8bb0a828d108b816f2990ab2c00809a75de9d7d6410cdaa26851ca155a1dd971f93f60f21bfaa47cc51d90580df151d5b3e5fc3259c08e39a77f815d93116747e1d9bed9a641c328a2842393879cd087efc325c2e75ecdaf25a69c6d93f1b6a388b3770cdd06fb7eba6221c49fde26d8610f264bb78ea05c65fbd8d23f9a599d8dbf3ecb2c9f6f30fdd229ff9ac731a48610b62bdce5767d26cd2fb625a85bd94745bcf02c7771f603d09fb76797edcd0b27547d0e1788e796fbd785070bf76de22f4a0e0b32637d520d4f9e18d7c1a59b2818a317f0d51c74f682ce2f1456aff9b1383c7f89feb8761acea3a25ff43c1e3551e9fc85b42b30e23d9aceaf45fea57c713717e021e978b45ae4bf5e530bdae1f9ad93c328af22807670f46a672b527448ae13d919638d8d8f59f5719971c024f8c1b2d79ef9be0bcfe4f0d5bedfdc3ce4ec37895311e3273a05566161cdf0b44b50626244eddb317b92935b03bbb0f0c276ed5743fda4828fa34c66cabcb0e170f2d215cdd9be8951cdbe665beee977477f93c2edcbdf4dd464fbb45684dc61cb3f99179ef04ec59f5b0587c3c6e5ec3571fa045decbc5fbc3bcd4f087d5abada78045dec705b8f22f8c1dd4e97e0621bdbab74234e2b9563c52e04baea0232e22ab65a1f1628377ecbd832ed59351d64e9fc8d962b81db225ed7a0902e20d18a68d7f1dff8d7c38117b95e53ceee9daeff5df9746ed143aaa68db511468e6f91e5bd9c66e84965f1626e17bcee4481721b90b4a1d673e765e9128fc863bbb8fd77aea465ace8214d357f476f5796c8a1a2038614e879358333813ecb5a052c721f35b51f9f5519f70e835f808b70b859438b4a97aea08839194cbefecfc275fe29d50d93980078ab8549f578c44e77f54886c6b5aaf82f798a7ff68be10c67d6c822b2d2b9e81c7ab1dff7df1f77cbc6ce066d9944b2b519642e98c0105dee7d8d52cafae3c37c24a3b18e491df1eed78b450bdb4dcbf07e114fc8cbad5ab61bfc04bc350ac77a862946285c9cc04343897464c15ee993193fed259992e4114dcf940afa1083c609eba7c14478fd3731f0939b577f4ec19f664689508d291d7200467c64b29eb7dce355ab6502070bc55cad0536db49c5855d104d38e8b6b7753d84990eb882c151246610c35ed94b5e3d248fbd890b2f09c690419e53f6b7d8757c9372eb641d61a68908523d6a6a3115eadbb1d357438c7c36ed4bdd4972b29ceb2fd31e3e5d44aef83180831be976c97c1170bd546a385786727816a679897a0d2407434e96e62e5b9a20403d9064383cb35b5d0e0cd9064643b8adb51610dc34c80f4f5ea201e1e96af9e873fbf102a7ad68a4b7f73316a6358855ae8ab5788e4bdaae094f4d19eecce2ad297603a0d5a7930f3de845f399c28cb76da51581e50f7e4caf5f3a21b4e2e2ba841318169e9586e8151f11775e4ae21fd7b313f158349c9058f6a3b72389cb3b3280c2926ac28059f4a13f9c116dba332f1dd06eb9f5b157cd1619140dad88804cf0ddf35065985372d926e4c903fcbaed78fe503a96153f62269927bcf2150d972e010d0be1da842bef70ebad642d37422a98c9131993f5c0ade5516e01f67a4fcd0873a5a52c0e14e79d8fe0a00e69acea3b07e4ff3098a93f9aedf65f71163596839efede528929cfda1d1571fef9e27d72d7f552662e878db5b07f130390b1b887892c617118379baf1d2ba8dc9a7cb8f2bc6321c3eec23758dfc6b284c821cca4f97381d447f3e6fc1571db9f683a297903f9e29c4882c67e8de80cb1896e8fa8ae9ecf7a4d15e5558a9d65aab065e6fdb5522bd801ff843cf85572fa5e7eeb5eb4518b6d349263afd8ee84f29ae735c0a1d71f5f00707bf7ec887ddb9db1978023927081541112f58ee11632c85f4750ce1701eabdf179183361eff94b9d75dfce157b38c03044f66071e5a41db8830f4705b43b989d974c19f03fe8fe658bb84bd1b8d4fa329d08b861fa7d31c06bf0e07d1fbdcc260e34266bfdcf56ef678429d0d5a937906449199f7d2c932938365ae62140b92d910b5adb3fe1c5d146cf2a613998f034222cfb83f49c6ad4181c35cdb60d16f4b1d0e34866fe663299703fc0f390ed7da4a39a7cb127c13647564213443d66e543d14a2924a028782393ff16667730c90136bf27fe17ec7dbe2084f5ba73a4b8650217e853d760a940ffe10585bb191b5d60153ce52e773724020639f3027df31fbb17403b414ba6aa7240e5d09e8b9e6b263b9388eb74e34dc68bb39b70a310fbe8d2c01b862adc2f85a2801d1fa7f72175e995912c4388b17e4f3d922a3fbf63f95c1a683a3ca8301ee1d6c0dfec914e17a78048db1edbaf8045a4e04281b90f9fe335aba41e4551cf9597864e963e2f4ec3e2d4fc27624bf556c7f22f3ae979b871a22ad3749abdf2cbef9e9cbdad08cc58a0223c04d243a5798c40011187e6aaa424e0597ec55ac438fc882b9ec8dd38ef35f99b2eab7b77d5ceb60cc72b0ff46fb6bef4baf59fe2aab20800013503051d0efccb1fdf8a67346d6f34cea73fc2c777ed9ed17bff169cb0fdf6f2c85a9192cf6d38ba03a949e1a6c488becab52fe377d5ecbcc58c7c359d33eb299b55b4bf9e05f81de04bd4860d42812b9220a822d3ea488e2694cdb2bc8cf2ae566c44edc03bde667232d9a58536e4c607452afe83d32a95f096537eea044056f76b53d307ab28f0f4ce706109cbf4aacb5cdcd42b02bd504ba364a2d7c8585ff18d9f96247977be2ed35a0f87a13af8973b97b7efec141f47b714ee7497d13e7781d4531da1f83d0af10af32c0779aee3c7b08ca83dff1e628917bb9b432d281e230341a465b1e5531b4d959c95504fead92ebacfeefe143238630a71cffe566f1ae360ba4a00f2c9bd6d43c6fa874d264864813166ca10427e817a2ae810bad0beb641fab4dbc1585c95e12b2b39ccab1b90c78df7fe85e32707814ecfeeddff922f33a5b41759ee6356a2a7da884ee950f16199627b98fda9518f95eec371b26931925e6fbd5dac848b8d989b805b6b1f3403506ca5004527082186ff058858724e24e1ed4219b448970944fa1aec34c03e9ec93814f2b11ec4241dc7cbea40593990bf87b50f97959b5e2a8992728d2fe22bb8d6836a9b3575f970dcef584c1e269e87605277d05a90d15f16aac57a9df881e9f9e2738b2e9b731d45c5a39edff7224a9dbf635e41d3aef7e2d1a4f36ccf4a8cc29cd57c3f6378c881201eeb7361c002d44c28b2b68d263f31c1d1385ec17173ecab9701d6a5a1c78282864be68ff4358aceff0279a791fa5409e747a8905197b206cd75a1efab17272fe318c3083a0ff126387ef2c286b41042ad4d428b21eaf61ed3132ac28e560ea701ae5a5c57374767c03eae3091922a71759bed713ced0e5c2a40317c9372940e0219a8e68f31b8649c846e4e072a4e593d6e86949f0c2d42f7876eea613cd675e2f756f515b4536d1ce5d98776c3bed72d817594b3762823ef11233a31a27d623412013aa33f753a0ff35b8d52854125215b3f75ba5c399bc204d2f6170e5a21339d0834a55d1f9856d394e068c3f2c8dd9f78f14df1999e6afb998a57ba161aca190cb3a5da8f4e171545e12bbe29fc4472630fc3f4c611e3315d9aeaa62732b7662b3bce7a9964ff35a263703d9a0c7b59b8c23deef76141e4cd7afda7c7e5908b8a192897a05b3228353fa56f85978c375666c00570bf024f19579553db1c7f29325a01e01d0e02396e457101c83d368214839499406d0467a3fc3e077fb421c6e20f621aae0c1aa8211d1f5311cef2fa4e9fad8e482596400b4f7629ddf76941cde53fdb66df41092dd1dbee53686fdae47f35a34688db93161811549ffe5dedb23240dd51e929d4317e4fa8da843d2c01a1f17172064c01032d2f27d8f7040d7d01731af79ee7a60fc37de3995d7598c25e673e45e3b941892d41773261ce186e0f288d3e03a199ea32926461358b656f86652e87437eb497d08736a1c686f938e59f1908bf097195cc05bc24ee4eed14d7dc31f1edcfcd35272c032e7f3f13f878f405e3f4bd5acd89e7b639f69b9b495560d4330376b74f6f0f84fb5152642f58ca98cdedde36df9cec949441d11e88272f26f2122066aa870ab49b1417f9426c7e11473ccb17238d736ddcfc39a7b3c7bfd1f962dce19bdde940512764d6dd6e16d900b95595907ba33458462c654c6fa5265db5d5e60e170d14dd95cc7c8958cf2608c30b8b81a518a44b0294665fb36654530cb17cad5900637cffd3b5e8f938ce4d102c703ece741bab7253e6764b5bc2316bb91b8188f297ca03a30cce1144d543f82fdfa7183bba825b7320b0864d4549bdd30e8c86c9e49b037570aec50cde7c9fed489c335754ba640a63c4ba4aa3563aecf3fc6afc302b6d5705cdf9637e420867a5578080b31ec88e2809860fa2555bbe32969b20b4551654efec8c864145d28cf470860f6216ebbc2f12a45164223d2c1bd85048d9b044a7920851e44e23049bbabeab51cdb73b1a55ceb69215b92ce7e88394d106ed53b1f705958685807082a94d4230d2cbcbcea23b9cbf099d097c47afecde1d6c77aa2e5694597fe6c85a96905d91f11acd6abe7ac3bd2a4cdc558bfde7983f23ac8f0b8d29dfb71c50445031b523667d2f023664973de1784943031548f086ee0bc49eaf33831956acf9f353b59e25c17a48ba94f00ba7c3ff558f571ec8ec6c699c6e8f50ce8c62de37bb91f705c7b7c01d6c025a0077927bbcb63eb51a5b76d077684b018006929da2db22d7a3e53a1ceeb792acf1e0d9d83180b679457cfa0040351a821899c4d76e946e348f0f4100c3c8c0bf6ace93c69c458717db449b287fd58d0825a8ae7d724d5c402afcada1e8de4c3c35faa0946101dbb5ddaba6e76e7cfa77b1e5c5f5f366cfd881d5814eda0cbce7a97968a854517bc74ae24b03e0b23a0d0564d99299aeb8139dccd2fdb532456599eaa06a8c3ab7850eb5b4b1945642fc20e123783676b7d5217ce1eccde7f1ab0e668bec63bff3641c36268c9b327a8e7fab8e73adbc8eb00cf88efd08157336308a9d00fb4438a705edcdd966190946b80eeb3fc9effb4430cf04a6ee68b262f0082e8d1c3c6306044292915e82cd0915869425afdf59633040b7e7dbc293035666d7e98640d5a4214674189e54a7484f7236304fb3e89a7cad408a8fd9163ce4f718bc8662d1aab8ac19ecd4047df2d42a787a12090ec9b87a0f753b26f0cf2e3eb52b18b9f814d90bc23373a6d36ac99b7dc89196b7de819acf1bf40e523dada6b7056ce508c5ac0b728369780e5a852cb925a85afc0bd21e818fb740bc5bde270eaee10f9921fb4b073759536c01b40c5b4ca1fbb8731bcb303f499bdf17e18f2ec5adf7cce58b347eb4ecd0643eea210c393523b470dd62b0f8dedce989cc5f05d0a66dfb217912691caafa78781333918036003d214dcaf33f2bef2f969c908ebb5a1e2ef80b6afd451ef049a6094202e1ba6033e45fc0e7620c7a39d629c78ba37fd56325cafc7bf2b826033f28d58a501d655ab7169d1a607474f8162f90a5a0d0c2fb760701d377b7d90c5e47bf2668509c1bd33d7d2600671827e0f66af371d860e3def91bae791043e5d8e7634c3d1e460e20c7536749309bf845de1f5b1e79242311896bfb8481e8ac6c9d55ed951ad4472ea9cf72da57378383a5118c9c838a1a3060e2c2507627fffc5f7b826f47187e287fd0dc34befec79e260876d8b71d07cfbba55a438b8f759856be0122a9c5d092b3b5b4c777c5bbb173949b803d8748c9ba2469281edda3c543bd16ec51604a96b371cead1716cafb9e2e8ff86b9167289aa0691b3ac44d187836245449ed3ee821fa08728222a79f32eb54e506c20db4d3e3d4f195ddb8a5fe27192fe0a42788a521329b7dc9aa28589fb02a69c3a9b4c4c33b67233135e7af7fcca4758ef01fa0c2e6938f7b69c6a909b4a8fd81892b7b58deeedd7d643e7df4ec19f95a8de66e59046dac98ea59727da6da46dc04a0ee2709adaa3ebdbc5413b37756c9bdb7c285fbb7fef4696ad91a5dea1a649d03372f3eba728c478c3253235c6d9fe865b7b746942eb3d44cc18024fa14da0f7080851a44c63f45d355aefce797b36aa02c178ba9d849007493156fe8e67725dbf5fc6f4abda175c47b26a804b9beaab31214c3269d680632c4d1d7368a5821cb47ae9233052de96e9ed51448278992b9787a139ce047942613165583bf669f75b5e6a61f0a6a23869ad893a9ec9a07f6ba46e384ebc5e303d9aafc987b51a75373e51b10f24df843077b3ba68043b351a3525bb47404fdea6a60ab41d6a00c7244463c75ce2b03650e9709eb5a0603caff11d7c95cf5a88144fafdc1882fb39b17d2d7e9362285d9ead68cd4b1948e61d1b98eb4cfb953ad00e184d8e8293055cfff01b899e6eaefddc8559abe7dba4a5dd6f04ba668e1d8d0673d2ac5e2643e2e8a048ef7ae999b4e209ff6a93ab92556b3b5f8e9a7ab0e2c7d134352aa265ad301b2c379610c854fe897ab69e8d41a3aec0b61c5babb29d80d9c95636ddedfaf9d5efa860fe50be292ddbb342dff58294cfb6cfed979ba4ba48e09e7b2f34cb4b38f3c3d047d9f78860129539276c8ba80bb9ac1d86fb8788257da3cf6739211802fdf8c63f964364a2b9fcd8e89f312ddd71359752757ddb4550bd8943fb8b5708653a0d8b65f7b4b846367fc45eb1650027da1422018ac1a2a6bcb04135de5505323463e34d4f73c7960eaf14824827de039589f09f0fdea56b9dd65ede6adf7e73b4a5e7745ee66b0d7277fe8a399b81bfdf076b0bc4d839f97b4239a341b3c363025937226356d7bbe8f3f77f3092f79066d80b6b3e48d286ae96d0cba03611725713c529d157489d662d4b16f1c4a1ed62d3e682ced90ec44a9533d55fa4e77ba9b464fac2d97e66a80cee041028ee08fa8c8478bc7f3efd781fe2ba98b7e9db7e05e2222163dce36399434f13a6d390968871c087e8f7d9320afc925a3202f303f7284769177612dd7e11a57fa5595cef4a988c654dca91a2e1703bd3e8af619787e7ad77ad76248d4812e11b460d94658599aa8fc3b94d1a56c15a0c45c0109b519e2d3580fdb852233290b895d0bbfcf4cc7baaa95e694ea3417e838edb0578529771bf4424f0c780eddf78b11cffc66282d00d795b67bcae819122d1e6c3beb8e5c00c86c997ae9ec9521f1d65a5c3772d118dc7a7831b2b866fd5e3845b604907389c8566831070e59e51a59609f48c81fb9040f4268236e012dee6cdd8da79dffd532616ccbac8dec0ebb50e023609e6ae48da40a498063300ee47ec881875ac21aa3ad2d61373b03e2132fa7ee223cc3465c422d5b02a92876479e1647811eb20a5730d91ae3a49f75b42b08782c176a090245a2f465552a03dfaea3e1e98815916b45503657071204c0574c64e57ebdea3e5e70b5ccf1d9cd44dbd0b3e442054e755b505bdce38d457d825e7f9fb57b8eb1813f1e9e7792f6fbb7c99c4bdb65c65e26fc50d16fb58c4d2e55854702312790a91634fa062def6e8c4c9598d30499bca83619475c245916df4b76ff35286bc2df8e7d6c653df3e1b6e585ec714bbadfa30789c4a31b3eaae78481fcf77574472b6f493bf42554fed0eae72976bc231b29e373a6e7c16ebbfae4b6c0f70485cd150ae0b23a6f920b1a6d9df810be88ff1055cd33c54bf2e96f645af62cc50d7a54696f4d3a38e8a75b7ce4a5017c8c02f8a6273e3ce1631a712e0eee0f2e131aa582dbb6ac1d411f52cb86f9e791c218410a7159a870e2bc371b706135b28b33e9426435d39e4ab2f2fd5020214c464ada8cd518b977e8813b514ee3adaa96618b95342fe34a1ba9320df6bc2a2efff139ef47428feb8360c4f9281429edd3e2bc9f20d611a5cfe423c8b025461838c888bc4992a95428cb21c18ab994d93daf623f5c7622a5932ce688c92f0368f1b2385a128e76e67cf9ada2c221dd06ab9d9cf8fd3152c577ba4159ebd214b673ed08d2276653678e2a81362a227833b76373aa1bdd75b5a26f0c38f5fc24d971d91d38095b1498a386a1b0a01a3c7ba0824c10519bc5a9ac3160fd3480aa2d5c7dba665c67884ae2745d51c935ffa689a233be92c90e869b5e486f5baae0dddf612b4194d86448c9076e14642e120cfb4eb3073f1b6dea679659f0c1c10845c8e22324c02378e853749084ab8be22b4bd38d57a45c826c92a5f990f265fb6d157da9db3d98f34eba3e6d008869870f310befd23eee25d04e0464e2c07b528234693e6629e0edb9ee4f64797ba5e41a850306e3955ed8aacf50bceeadac1e66118c1f0c0c8f7a9e375b2ffa23006f5cd04b1d2c4fb939a32da8599cf71a18532e90637e450b01971b28d03ebe3b85d81f3c654bd0fb9dc2e8bd0ecdf344cd875722755d9be0b6f639672d903a73db7dd04da789fa4d29703afc8ad6abb54d044939ae60acefacd8a7706add106413e5dce544ce792b0807d63613c02f7b1ef5d417f72cc4b7737798958ddfac439638024677042474a1d5890d9d9e8c58415775e5ea289baec73dacbd24424150ab14c0541934f157945ac7de690cf5768f970113cc807233375a10d392f8052dc61d7cf8a4162c451b6ab658f7b75a72a907c30424412c852e7d50ddf77ee9b9e0d82d1f1574c07348426bad356e2fbed1740f4ddd070c8941f9719b3cdd3a3d48731c4aaf8f948d7373d6aaa7814adf10271fa80750cd67ffa523dc1617710c8138fd527b5262163056d5b96421139859b6787e58dc61059b13a95f587c318629b517827b5a10c8abed7cb3f9680f98bfc085801faf95e3c7fe75970098744131ff71f7269ace8a3c0716ace75299ce3c1f5c9c0ab86eb40aa7b59949d4bb8e323023bf608144bd4d7dcf5e7f6a76deaa85781315302665a02ecdb3671f18c4a98cf23e4bf35e50cccdcb5f040d9d612b50a9154f936575a9cead48797ffdd97877456f286e801d6690b93bf64bc09657c22121f4ebbb6e00f7f3341fe4e37513f877bf788109693984a3742a5edc0405f5e2414a08e2f3d60989a2e48bc40c9b8aa3065159f2ef1f996d99ed3f104740899c78e50caf2049ff4f0595c2ee27ee5ce7ec6995d9d705a5c0c30130160626d2acded599579dbae6842ceb1bb27a07683a9d3aa4687f7ffa40be7cd5653c6720c850943de6ef806efe44f68e06da55b6bbf1eed38266a69c16dcb235b0392bffefb892460b07eb4ef25b0ce31adaf43bdaf7681d8ce25284ad21750172513b8d1d7c8d3074258ff28548d04545172c505083a90781e8990d12ab25f9571dc024719f31bf15c93dcf64accd24478ded452b9f049128b275866c54cad4a8935022a569ee932482870d0c5408b9526560ff3444e8263e388e2df6f800db1d3c65480a1cd0f7124c8fdee3806cc0b2182c29d28f399f44f5f7c1b682ef5cdc74c39fbf1a738a31858c847822fa8db1385d2c188f868e486e6a7d45ff65c42948e90c9603ccf5db1c0110878f0044ecaace1ee1f562ae044efd0e17744f088e802cc6e09d3324253f7ccf4ee22f0fd80413705e4e4725817b86dbcad7b92d45406f598e13f77f22609ed8d7298d0247728d0a2b43a0d5551b739c901b72bb549c68095314ec83e213a82f3e062e031343ed415cad963f70b6b45f1eeabcc527c478865376a7a6c9ee7d72a103b7c582a6ba115dfdc587e4e74a5f752764360456beea40e64f40b9bf3502d25ea7786659073d6b5f0a8a96e0597fbc270a29b839550ae4aa1f48e5ecd1fb2932f48aca96a7d891d157c3c65d2087243b0a1b7d21a41eec8cbb8410829fd5483ec14c045fedf964a6751a7c08246a12985a21deea32af908c9758c9926bb5b06dc25c34671a1e3c2d2809282c6949b779f7450bcac242501b29508ea767a6007380e8e8b0b06a27056e4b0d4b8e0d349f4dd1c840af2ca9d145a3a5c6e15e354de5611c3c0c3bbd4eeaf9cb3f4915d619539653d912fbdc486d794709ad835dc4ff36baa5f847e1f659b538a9c0cc67da0f0cea23236857071a1e5e8b37d69aedf48016d27b49860e40be750ce86b8509b8e8890bd464a25c71163a66ed65fffc0a26f40eab97671a3c224d2a0e5663643b5fbfa43264103acdb85f18fb4066619cb53d197009fbd68bb15fcee91089d56c68a1535cd8b1f00d11574ee24ab29869e4153ab54698fe8fe3872f242a81cfb71e255f30206c205e9044e5f43150af75b8d9f10418df24881567169d7675f9ae58207d65f5ec43629ab01e877189db816030642146a57d6cc3974502ed2b93a3cb2b2ae9009e1ed3d3d5b0ead4ee51b6f504b2bde4f94b5bae8e34537e45e935d52384aaac3d3fb17c449f95788230208f1517e1f049fc38cfcee3a9d2e61bca20de9e5ebb6aa011f15d49636f561a52d8d3b17541e2befa7f35c76a223e04745eb540ccfc1807de6863743184e15a62c52b23fb194ac7bac00ce4fd35a3d9b31e5ebaf5f2c1889c4fe50d600fc96133f7eebb7d6284aede6f53dd04305f6a51fbfd17effb62061afa613721597fd01d40d6732f185d91a7ebec2636c91609be7032fa7d10c3ed78241a48051a4db2c5b09b67dcc79e8601218e97ea9a93ce7497f0e7962a55e28369116a71d05d7b49adc8e0ed6e530231e53d5bc9b7e7fd2ec6d533cbba058adfa42520544a61e55dfe15e7e3c2e231adb9f26ba8aa5e246489c5ce0f9bd3dfd448e7f032ddb161c1f55f10d9b78fbe87279a1c60bf1d44239269dae9be84817e961a5898efbd5d779ff693560380ca9a6d2bfeec827cf6d5bbae596cb1df2da3479c9d0b7a2eba19eed6c93aa796de9bae95cf7c2d052e9fc74e8d5331194c065bc99b152c7ff44ddfbe759ee3c4662fe2cdcd562d51d8b7e982e8847926853eeac61652f9ae13da81f2358e7746d505683261ebfa066e0e1f362c5cab37a83c460abcef4770fe993002444e4c31778b003ef6d3f3df0496176cbbdcd5171fce4abd3636dc6155cd9b00a621a43ce15a973d8e35f0b8a4d6c9c1c40ea9b1fafede7cf725fed790d9107c13e8f00ca8bf3dad9284eec77f91d04db93701e57084669cc00cb6c8180a89c80018987a025fd0aa99f9a7b1fef27b11d76ae9eb5f70ad9d6af2fe1d7388341f270062feb36aabc577d451d6f63cdae876eab51da398b9bede823385e39f20179f7ce0e23dc2ee0b8762e2c14c519b012654cce7a3077155ef8e342cdee79efbf3c9ddbdae9bfb45f283753bb7219da6299fe84bb953155fe31ecaa18371b97666e789dcdc3ddbccde5c1cedd811e26a06cc269296abad6c6aaaddfa8e2285c5ed3fb83aab77b47f1359ac8ae1ea9c78ec51f1e8c4d0a189151755dfa2e989a74bba15531146ae404ed8a35b69f28443562b864d337346cedc5b2b06f39598fc5985574ebe472b2d69d8221514f32b8351b5179febeb1a8608c02d39e1be2f4e3d53a6721599154423815f10c5210b21a6a5aa0f3338fb06eb3d5b2f7a7fae09ed1b037976f2c7c83b61b12dfc8e4b328ff5cb4df9394fee64bffbc93efb5daf5fb27b698de9fda55bbdf6b22aaead72a1ebfabfbf9a6d6ba113b067fd85faef3f409de55377d5cf0313c3366d65cbd795a927f1e4f92f044974a9b450c405f1127fad87693d506e46fbdd4f1dedddf6dcfdb0ae80b2c6e6c14b55796ae7df5f1b92721dada19f14194a7dd5c98ed8231c71cea7f86ad81d25a19f10a5c72d4f3367a43ad5b31dce1e0b26db974254b523d167a5676c0944e2518c9eb3f0ee626ca9355466af2c3ce380493c16b66b350ac637a9f823b6fbfc55ad5eb94291198827ff27a199ce2f499eaa4f138b6f665a180dc1166f120acc3cfd6cb8828fab652594fe4357c874092a6b069fe89e00871dcabcbdc967ff32d7e781c52ad066b034c42f8a1c3e26b579211f23735df378e3b375297ef7da8898bf9428eadc8233f403cda4200180b8253133d8673dcadbc2b954092b8575f4d2a5430176edd67c8bb9ece7de3d7439fbc50614c54fc8a66ecac563b249aa9bb734f7de379a79fd19559164240051e2d78ff5505e2beb71a535ddd88d801469111b219f5a062afde4acecb262e7f1b0a28e16b16da99b51949ea5ae8081351aeb94c2fdca3a2c516a135ae0dc1ab7ba9fb5d88af325b5da52b733234c001511a42041aad4d8dc2515769685eb83a93910f37d265b7364c0e4f2c56f4e00c62f4e9223ad9ddeff15eb3d7bca55842ab67b5589a123334866fcbc4aa0f3be65f2edb9e3743405b25216b7059b4a0e22081c2578899343aa468291db37d66e542e61023fc78f5af7551eeeee72d7eaf1d551d95130f97549d5beded1d6e76e43cf531374fb3c3220e5dc776ab908c28c179e42f92cf5a4e5c046fde4bf0aff19f77cc9bc36172a53b809710a0457278e9366bef1d34f5b2b2f31c74bffe5c8a4c2ff1ab83280925115ce5d17468a21e7192fc6c6f11996f82b74492905e79ad75d17958bdae936be59aff61c096bde70199ff4992d7893b418e8e41c0274a364bef0eef874cd428a10371049185e85926751bc4a37419de5654bfdc423d86df3d7872128a6971c1324d6a0c59c2136a7664fe135147ac379853c26ff086b3612641b3bc6e3f0e0d70c6b13af9d988a0cc72786ab2e0664b0811f41b6339a93f48289dc317f87a526facf6a891c494a50d015520fe994266258212eccc84a06b76f2a418a1eb97e94787ba96f7f7e933cdfd0d4122595c95f08fe5d4745c06adc2978b0a86af035c9721c5fce6516de50b2342fdc083f2420fd549a323492167e75b92560a2159c2747e3b13395db52f0ca261ae928708f4aff889a06daf3d8b3c8bbc1ecf96aee31f6bce8ce79790266ac7be1912483bc9c132f8a6df63923fe0bf178691f92ec8e2dea8807df6159be782bb58c85096264f88848145e2d2be3073f7be6fff3c7fd0cdeb98177ca98eb0b3c4227cd80ea42faf0949d29eb3899975e632a0be1e23e22779d6d90f85b21c2dfe7a3672fdbc3359ba09db7e57b82b0b8eaca774a9472d41e1c206d000031f3d8297666c45bcfce904cb9dd2cfe79175a5ebd5f3f84cb406777510afb9bb3b99ea85a54465988ab8f1d65c49570e47254afbd5a5950f9e8c956d1b397187980286bf7440c0edf1f699f9bb7cfa46275b29e338370b90cbee7107e3ecf336c2f61c8b1b62224c3f5501072d904a894c232a6514dd78bbdbf79197cf7107dde75abb81a7338a9043b5581fe9a4f6aa226f8e2749e849e43031d645c84c592296ae57378ed668e5c21767ad77ebee14f06597188f12d8e0b55f94654f61f0c48c906655dbc7e550db655490d381dc535f02ce3b24fb859b6d1d2ff4881a1d1f82368e254eefe079db8d550411e9a6f1ada2f4d266651fa827dfeef75ba3e37ca7bad91cc9c1645ff4523cbd7e24580bd404cbed2dc56c610de2beddfa6c8a381b9e485b5e33ac03f5842147b95c0fd93d16f94539afa5afa51c0d0045757b86e4d766ab24e5626107ae5d68ee7ee143282651ef262909cad2c579cd22912efad3740c7d9fca35c162e481b87cceb1a1bbaad99a3f945f5467f544b074ba41140925809ce5e6cde0bfab7e28e89da7be90f526b126e8867b3afafefe3033bce1f14a06fdd59fcad003a1f518e1c1a65706f54fda112e52fb1f519c3660e0b9ec01a96fde953bd6648b662e1792e397c37296bb7d962854e0c38ce0bdf3ab57f3c0052c3f67fcf9d14fbc1ea75a2ddf1413e6a059c61477a4b6e5f59fc544d5a07ce01b037f175cbe32354f200753e28d14593a720365ecc8bc983d278c12f2cfdce068b2e4ebfd1867a76d83ecfa6b82ded7eb7ccabcc58d90afefaa1bcd90698e4b766b96efbea1ba170765d4250df87ba3674a608431895519348f6e8027dc722bb39920ce23dfd52a198fc5f5b1560a011ca19c250bb46bbb5db3750cfcad4d1b8199964a2222bd8303e7c18777bf668cf80d81c8c1f77d48fec805e2da7145e4e0efe04afba172a16525ecad711660ca5014411a60d4dfdd27021b6b0fa572303dcac6c166fada5aab2df2f92aacd2721a9601ca18c92d8c423a9b5dc631288db8a4ed7ffa660e8411f8b58bd16e386f21f269c94846763a05001b52ae3564fef0ca99b25e6fd925f579d340ceef3adb32552446816bc2a2173aa6289cd78987c7c179435e57ae6cf7bfa089926c1bc4930c301bd968c10bfc7fd7ac0d7c14cd06d2cddc4b92aaf1c7196721a7467f718d7155b9505102542c4592e71baba31a4146c4154f1cadd7a44c376e973f3a5734af46a2ca8a7ed1cf1f4cd78843cdf3526518867e63932babb084084b2984d5310e656e6224f396dd525b2d55acba9651883db09722386809813b47c23bcb6e10674d07a522955166672340e717e85f5371a01a5ac883167a63f5d6c2b01ebf31c68a509e4253a6198af9149928882f345da36daa17e5c78ce5b0fd20c764eedb1ef3d32b097dc0f47a138a7791af0214d06a809103e1b73dc1ebdd57f2cd88dbc0a78c6cb0f863d6becdf5527df5d027f9ee0b05fd56e6d2b75946a54262a88ee03043f6182b48ca755517108954afb92cc917af3e8ace728b1503882f07e5dfbc21f0630a621ae0a85333d8d8199949c05093e01224bc50b4af4a7cc65031cb5e499d2399d9670fba01dc08437c67cb76607d0b2dd54cc4733a28f52c4867989f805ed9d7cb72809312f240d35490f868f81cf26ed47662a906ae57131e8d73a829ada51ba4309d31ac64070a07980a0bb75a134d412e757ea4de3577cad5898a1d6ade3c9be32e75725d285cd7e966c530cf8146a099ea61d510982b2a39d869554f876831bc45fe898a6b54d17e02aeadce9aad34921ae97e50a09511994c990d1a3807eb0f8f8a152427078b7c64adb95b69882eced6f82c88ef05443487e90a009dc464e277bd765f55dc1478a4ac8659bb08b222d9f3700bb7c965f2d1572ab70ab4a6efca8a55508931b139b07a77d3f86440b7e951761b69f8ce8a24aa3e8aae19d2c40676cdeb7445c0c9894ca0e7fdc57e8bb32c1d22a841332509a26c7d52d8c4ae4caa04bcbce87170ad7761867fdbc8dc48aef524d05954aba2c4874578185c33df55ec2898b8ceaa41953dfe1994dfc167c990b4f4ace5b5989d7e069d68aa8a9356979de38d91b663ec67427d0a3e463efded1446cd185d1b4773067798811a19bf1a576957da7d48525dede6d86c2839628a1f844ae00ea6dffbd31b39dd8dc99602588537da119249d1b76a453c4be8eb7cc8281fb1fead1baa4232f9b8f33e4765144fdd2ecc0d5a1788e17fe751608eb332dc2de37ea5f6bd848595055856e99c8741273cd7e97a205abe599b4a6cacba61c57c2df18d362b4ca9cbc6fdf88f3876c9d6e8bb1fb3e5267c4ab7873dec17dcc2e624730251cf6bac1c9bf3de253a2c5d3794c29f04214295a881bc9ec4305a2f8f9cfe62524726aabccc0548db61677749b59972141a7de3af685f881b2839712bf03d98af5031ebd0447d256af3894b6cd83f8ca6ca050bd99039f42e88cba7db41f98beaa8db34b999ce17e5b3392cb0642b52f9c921b2a2305c77d6c432e03b7f47d1649c10822138810e14938cb1f626b0d9c53f386dcd6c6cbeb3ef741e36b002ada2930088151a605cdf92d9882a5325e118b976f2db2370f125fd1ff3470a0f1412c63d7a2114d3d719bdf09c893116ac52d221b933c982f3f6299bf05ecc5d2b72a75741f90c04a5f44625e4d211422548e2f8f6c919b8046a35e718d52e9aa81ab3f821cdca714c2de51136a8e324df4035c3b5e1a69d4ac04376eb05687e0add2e15f8dd77598cdcdf30c25025e4d8cb6bad7cf952b2aa1b483a84cb2c69c18e2983d8de995c510dfbbef6f56a401cf53b9a11ab33cd6636c36f311e981992e3be22e52c0bb83c0ca1b1bf1a04814711d9a0d727332606b9a05abe7ce3adde1a95de346db6408ceee0212d5b2885d4016fbc62fa463b38c5ec0ce4aa59c6991aa34869a78a648432db32abb20a149b981109b019885c50ab625dbc2514026483b610b311163ab24dd5d09c0e1ca7b6dc70a03ac6d3acd71c7753e680201aceefd68c3c972812fd33e8d0190ac383fc386bffc0d3561980ceba33acbf2df483b5e736e3d57950709e77bd0f7e32b80c7f67ea1a39546dc502d28e4c3e79ccd4408d224d86d41ec3b048fc2eaf0547f730cd332c77746d89c2058a6cbe1fbc008a9ab5a7977284bf136d9270dce826745fc9c0a9e9983ed9325ae1d9a1d326e5f7f106e4ec44c443ca40d4295a72b38a0907f7ec7668f56dba02929f244f1cfe9cbe38dc351a8175f8b52923b281ac378773c65ec76cce6145927d7c8e647d6bde7cbcda497ee0e1dbe5a25f3c2a8eda9247315a2ab51f48289b5409ba7ea273180573186bb33d6d0a32a205d65c916fb388bc8c6bba00cae937179bbbfd431646b396a305cff256c094531337699c30caf39786617cc7f27ef32b13b18a47fa9797b753e6a59353d9a88dd883cdacdfcdbb7a53483f946e57e62486852c67515841e499d6217c818425dc09161cf7ad8aace11095a4e0159bfa0e452168e15ce7946d6390fc2eec775abcc70b3c0afffb742b3d5fdb6c71f1d957edc6e8cba14e628aee16a84f40163a261320373aa39b7d8b44469c116765973c71698e0eac3eacf642ef4370c7f85750feb12cd8939911510015f2bef53b78f86472109826340e0a36d2b7e131bb921890544e6568a93f043d0ec23939fe510a6b916b4cb0bdf0ff075001affe9d1009407f75e867665a6c502b41e905c0b91980aa8c443c00fb4173b83ef1251ed4094c80da1919f4a29d2535e01a839fe4893f787375b0be8d3408a8d9e00ae00bb4c3fefbeba1d0f15cf195f26d9366848974103ed94f19c82e708ec0ab728c18025dcae06ccce802f2199d28214355c22595521b3513411fd2880f9aaafa484e0e7ae20b91b52ab104125f55df842f20db176a4cc77983bf359493203b2480c2e1c2e4369d927808128e4f460740bce8ec8352c2a211c7ff2259d8b5c6c78ea924b9680b2cfa9125eb92f885f486e4269e92eef0dcce812f8c3be59277fea06d722b5feb7906444f19c976fc61c68dc7af96f2654cfd7803a547a308407230bc977d1f4076767836a1b30c8acbc80a960b81cfa400881798daa8d93f351390da22a54663df2c8c40550af684e4cc9f3f7ee0efde3a6fcb07ff3fa75b991287c84a7a0000000049454e44ae426082000000ffff010000ffff664a5b8b2e440000")
+	bs, _ = hex.DecodeString("1f8b08000000000000ff002e44d1bb89504e470d0a1a0a0000000d4948445200000080000000800806000000c33e61cb00000a41694343504943432050726f66696c650000480d9d96775453d91687cfbd37bdd012222025f41a7a0920d23b48150451894980500286842676440546141129566454c0014787226345140b838262d709f21050c6c1514445e5dd8c6b09efad35f3de9afdc759dfd9e7b7d7d967ef7dd7ba0050fc8204c27458018034a15814eeebc15c1213cbc4f7021810010e5801c0e166660447f84402d4fcbd3d9999a848c6b3f6ee2e8064bbdb2cbf502673d6ff7f912237432406000a45d5363c7e2617e5029453b3c51932ff04caf495293286313216a109a2ac22e3c4af6cf6a7e62bbbc9989726e4a11a59ce19bc349e8cbb50de9a25e1a38c04a15c9825e067a37c0765bd54499a00e5f728d3d3f89c4c003014995fcce726a16c8932451419ee89f202000894c439bc720e8bf939689e0078a667e48a04894962a611d79869e5e8c866faf1b353f962312b94c34de188784ccff4b40c8e301780af6f96450125596d996891edad1ceded59d6e668f9bfd9df1e7e53fd3dc87afb55f126eccf9e418c9e59df6cecac2fbd1600f6245a9b1db3be955500b46d0640e5e1ac4fef2000f20500b4de9cf31e866c5e92c4e20c270b8becec6c73019f6b2e2be837fb9f826fcabf8639f799cbeefb563ba6173f81234915336545e5a6a7a64b44cccc0c0e97cf64fdf710ffe3c03969cdc9c32c9c9fc017f185e85551e89409848968bb853c8158902e640a847fd5e17f18362707197e9d6b1468755f007d853950b84907c86f3d00432303246e3f7a027deb5b10310ac8bebc68ad91af738f327afee7fa1f0b5c8a6ee14c412253e6f60c8f647225a22c19a3df846cc10212900774a00a34812e30022c600d1c80337003de2000848048100396032e4802694004b2413ed8000a4131d80176836a7000d4817ad0044e823670065c0457c00d700b0c8047400a86c14b3001de81690882f01015a241aa9016a40f9942d6101b5a0879434150381403c5438990109240f9d026a8182a83aaa143503df423741aba085d83faa007d0203406fd017d84119802d3610dd800b680d9b03b1c0847c2cbe04478159c0717c0dbe14ab8163e0eb7c217e11bf0002c855fc2930840c80803d14658081bf144429058240111216b9122a402a9459a900ea41bb98d489171e4030687a161981816c619e387598ce1625661d6624a30d5986398564c17e63666103381f982a562d5b1a65827ac3f760936119b8d2dc456608f605bb097b103d861ec3b1c0ec7c019e21c707eb8185c326e35ae04b70fd78cbb80ebc30de126f178bc2ade14ef820fc173f0627c21be0a7f1c7f1edf8f1fc6bf2790095a046b820f219620246c2454101a08e708fd8411c2345181a84f7422861079c45c6229b18ed841bc491c264e93144986241752242999b48154496a225d263d26bd2193c93a6447721859405e4fae249f205f250f923f50942826144f4a1c4542d94e394ab94079407943a5520da86ed458aa98ba9d5a4fbd447d4a7d2f47933397f397e3c9ad93ab916b95eb977b254f94d79777975f2e9f275f217f4afea6fcb80251c140c15381a3b056a146e1b4c23d8549459aa2956288629a62896283e235c55125bc928192b7124fa940e9b0d225a5211a42d3a579d2b8b44db43ada65da301d4737a4fbd393e9c5f41fe8bdf4096525655be528e51ce51ae5b3ca5206c23060f8335219a58c938cbb8c8ff334e6b9cfe3cfdb36af695effbc2995f92a6e2a7c952295669501958faa4c556fd514d59daa6daa4fd4306a266a616ad96afbd52eab8dcfa7cf779ecf9d5f34ffe4fc87eab0ba897ab8fa6af5c3ea3dea931a9a1abe1a191a551a9734c635199a6e9ac99ae59ae734c7b4685a0bb5045ae55ae7b55e309599eecc546625b38b39a1adaeeda72dd13ea4ddab3dad63a8b35867a34eb3ce135d922e5b3741b75cb75377424f4b2f582f5faf51efa13e519fad9fa4bf47bf5b7fcac0d020da608b419bc1a8a18aa1bf619e61a3e16323aa91abd12aa35aa33bc63863b6718af13ee35b26b0899d4992498dc94d53d8d4de5460bacfb4cf0c6be6682634ab35bbc7a2b0dc5959ac46d6a039c33cc87ca3799bf92b0b3d8b588b9d16dd165f2ced2c532deb2c1f59295905586db4eab0fac3dac49a6b5d637dc7866ae363b3cea6dde6b5ada92ddf76bfed7d3b9a5db0dd16bb4ebbcff60ef622fb26fb31073d877887bd0ef7d8747628bb847dd511ebe8e1b8cef18ce307277b27b1d349a7df9d59ce29ce0dcea30b0c17f017d42d1872d171e1b81c72912e642e8c5f7870a1d455db95e35aebfacc4dd78de776c46dc4ddd83dd9fdb8fb2b0f4b0f91478bc794a793e71acf0b5e8897af579157afb792f762ef6aefa73e3a3e893e8d3e13be76beab7d2ff861fd02fd76faddf3d7f0e7fad7fb4f043804ac09e80aa404460456073e0b320912057504c3c101c1bb821f2fd25f245cd4160242fc4376853c09350c5d15fa73182e2c34ac26ec79b855787e7877042d62454443c4bb488fc8d2c8478b8d164b167746c947c545d5474d457b4597454b97582c59b3e4468c5a8c20a63d161f1b157b247672a9f7d2dd4b87e3ece20ae3ee2e335c96b3ecda72b5e5a9cbcfae905fc159712a1e1b1f1ddf10ff8913c2a9e54caef45fb977e504d793bb87fb92e7c62be78df15df865fc91049784b284d14497c45d896349ae491549e3024f41b5e075b25ff281e4a9949094a32933a9d1a9cd6984b4f8b4d34225618ab02b5d333d27bd2fc334a33043baca69d5ee5513a240d1914c28735966bb988efe4cf5488c249b2583590bb36ab2de6747659fca51cc11e6f4e49ae46ecb1dc9f3c9fb7e356635777567be76fe86fcc135ee6b0ead85d6ae5cdbb94e775dc1bae1f5beeb8f6d206d48d9f0cb46cb8d651bdf6e8aded451a051b0be6068b3efe6c642b94251e1bd2dce5b0e6cc56c156ceddd66b3ad6adb97225ed1f562cbe28ae24f25dc92ebdf597d57f9ddccf684edbda5f6a5fb77e0760877dcdde9baf3589962595ed9d0aee05dade5ccf2a2f2b7bb57ecbe56615b71600f698f648fb432a8b2bd4aaf6a47d5a7eaa4ea811a8f9ae6bdea7bb7ed9ddac7dbd7bfdf6d7fd3018d03c5073e1e141cbc7fc8f7506bad416dc561dce1acc3cfeba2eababf677f5f7f44ed48f191cf478547a5c7c28f75d53bd4d737a8379436c28d92c6b1e371c76ffde0f5437b13abe95033a3b9f804382139f1e2c7f81fef9e0c3cd9798a7daae927fd9ff6b6d05a8a5aa1d6dcd689b6a436697b4c7bdfe980d39d1dce1d2d3f9bff7cf48cf6999ab3ca674bcf91ce159c9b399f777ef242c685f18b8917873a57743ebab4e4d29daeb0aedecb8197af5ef1b972a9dbbdfbfc5597ab67ae395d3b7d9d7dbded86fd8dd61ebb9e965fec7e69e9b5ef6dbde970b3fd96e3ad8ebe057de7fa5dfb2fdef6ba7de58eff9d1b038b06faee2ebe7bff5edc3de97ddefdd107a90f5e3fcc7a38fd68fd63ece3a2270a4f2a9eaa3fadfdd5f8d766a9bdf4eca0d760cfb388678f86b8432fff95f9af4fc305cfa9cf2b46b446ea47ad47cf8cf98cdd7ab1f4c5f0cb8c97d3e385bf29feb6f795d1ab9f7e77fbbd6762c9c4f06bd1eb993f4adea8be39fad6f66de764e8e4d37769efa6a78adeabbe3ff681fda1fb63f4c791e9ec4ff84f959f8d3f777c09fcf278266d66e6dff784f3fb323a597e000000097048597300002e2300002e230178a53f760000042669545874584d4c3a636f6d2e61646f62652e786d7000000000003c783a786d706d65746120786d6c6e733a783d2261646f62653a6e733a6d6574612f2220783a786d70746b3d22584d5020436f726520352e342e30223e0a2020203c7264663a52444620786d6c6e733a7264663d22687474703a2f2f7777772e77332e6f72672f313939392f30322f32322d7264662d73796e7461782d6e7323223e0a2020202020203c7264663a4465736372697074696f6e207264663a61626f75743d22220a202020202020202020202020786d6c6e733a746966663d22687474703a2f2f6e732e61646f62652e636f6d2f746966662f312e302f220a202020202020202020202020786d6c6e733a657869663d22687474703a2f2f6e732e61646f62652e636f6d2f657869662f312e302f220a202020202020202020202020786d6c6e733a64633d22687474703a2f2f7075726c2e6f72672f64632f656c656d656e74732f312e312f220a202020202020202020202020786d6c6e733a786d703d22687474703a2f2f6e732e61646f62652e636f6d2f7861702f312e302f223e0a2020202020202020203c746966663a5265736f6c7574696f6e556e69743e313c2f746966663a5265736f6c7574696f6e556e69743e0a2020202020202020203c746966663a436f6d7072657373696f6e3e353c2f746966663a436f6d7072657373696f6e3e0a2020202020202020203c746966663a585265736f6c7574696f6e3e3330303c2f746966663a585265736f6c7574696f6e3e0a2020202020202020203c746966663a4f7269656e746174696f6e3e313c2f746966663a4f7269656e746174696f6e3e0a2020202020202020203c746966663a595265736f6c7574696f6e3e3330303c2f746966663a595265736f6c7574696f6e3e0a2020202020202020203c657869663a506978656c5844696d656e73696f6e3e3132383c2f657869663a506978656c5844696d656e73696f6e3e0a2020202020202020203c657869663a436f6c6f7253706163653e313c2f657869663a436f6c6f7253706163653e0a2020202020202020203c657869663a506978656c5944696d656e73696f6e3e3132383c2f657869663a506978656c5944696d656e73696f6e3e0a2020202020202020203c64633a7375626a6563743e0a2020202020202020202020203c7264663a4261672f3e0a2020202020202020203c2f64633a7375626a6563743e0a2020202020202020203c786d703a4d6f64696679446174653e323031342d30322d30375432323a30323a33313c2f786d703a4d6f64696679446174653e0a2020202020202020203c786d703a43726561746f72546f6f6c3e506978656c6d61746f7220332e313c2f786d703a43726561746f72546f6f6c3e0a2020202020203c2f7264663a4465736372697074696f6e3e0a2020203c2f7264663a5244463e0a3c2f783a786d706d6574613e0a4effc3ce00003561494441547801ed7d09b45e5775de7e4f6fd03c58f364c983b0e409cf60c08e0bd8800d365359842618166441d2141a9a2e1a0a2c1a4242923659811053a6149a4231a149089331d866121863e3015bf220644bd62c59f39b877edfb7f739f7dcff3dc916fa9f244b3aeffdf7ecb3f73efbece99c3bfef76f39e7430f768c9fd7fec766f6e6166b998ffa6439ce3d306cc39b60e2177a36f77fb08dc147e0df7b9cdb7cd2bcc20331d1df8bd85b2bf06f2e6827c113cb036f6e3db9ec9f58112fad65ecb9029c2c27b0074e26c0091c7c9a7e32014e26c009ee8113dcfc932bc0c90438c13d70829b7f720538990027b8074e70f34fae002713e004f7c0096efec915e064023c3b3dd031aec5f839590ecf036d87d77dec7a4fe968b5b36675daf2d99db602f5dcc9ed36b5b3d526033fb5739c75b679f07b07866d4fefa0eded1bf2ba77c81edfd5670f6eedc5a7c736ef1b183b258f03c9c74c02e0b6a45db26082bd7cd914bb70fe045b34b55dee1dcedb61c3ddab680ddb90a10d62072c98d536ce664f6cb5a19636f15c71ea441b6e71dea7ba069508f76ceab66f3fbad776740f1e07616b9e092d977cf251f771f3641e92a4e598dd0cfacbce9c6cb326b6d9f0309e5741645b11ca214862183df40c390bd2003c4e68018e70c2f9214dcb30789500a0b15324c3e0d0b0fd685db7fdcbea3db672dd7e43f3842f472d0196cdecb0fff482d97631663d83ce328c6833b86a29689cf30826098c38a77c045f209341fd3c61d8cf45010f06fe8923fa90ee3dccb6ed1fb4af3eb4dbfee1be5dd637a811413df1ca114f801913c6d9ef5d3ad35eb57caab5328a284c00051230e3ce8033800c97b6a08b134447a3e5e4e065138821d05b130d7dc9437cac004a2ecf18e03c39d6ef1eb08ffe70abddb5a19bcc275c39a209700382fe0797cfb2891d0c0e03037f33d8daa363d1677435db194b4f8a58163c8eecc0a072a5577425002c1e4c925ad0bf2029d0d538646057a40b6af6e696f53756efb6bfb973a7edea39b18e118ec875003af8df5f36d3feeb6fcc36ece61101ecdd196bcc58ce44edaf85031228ce664e64858651e5c1000b6843d058014653b39eab02fe94029449912dec800fad039d2b0af114c5beacb5e1f8009810d79d35d56e7efd425b3183b813a78c7902b4e3f0fe8f5f32d76ebc60ba0712230e31649cb50c14a3a1a3311cc533505c0d1003f2103fdc0a884b78440d4d7610af9674260ec570568bc49a4164e03db86468d5183c9ee0f102a9fc63bfa8014f9bd86e9fb87eb19d3bb50fad13a38c6902f042cdc7ae5b60d79c3119216060105eeea71104055821f0d929772bd03cfec7e4457268694f5317482608770db10f50a0b5e4cb0a069b3d53011fc661d11905132e824d5d481bc2184c3967430d9e89e3c7d9275ebbcc9e3b696f12745cd7639a00dcdf5f3c7f3c1ca8698b9ab31ece67a059144c4f0526074fdfc8a399afc0c72c1633672da73a59b841222953d804c08fe471fea73619a280cedd074992e11b6cf9e73298984c92cef656fbdb379c67174d39fe9360cc12e0ca2593ec75674f75f7d2c372327def0dcd3cee8323e68a1999d8ce09c24e8c982fdd69a602811c71dc104ee194388a35a4433c9779e599375c24fa688f431c7879dce1f9c78e4c26ea45adbc74b4b7d89fbe6a854dd8b73130c76735265702674f6ab30fe0806f680847d448b1162ec2da0797339a8e673010b416d2d1022fe3e351f080acddbecb6eb9e7717b7cdb6edbba6bbf6ddbd36d5b7777d9eeae5e5c051c67532674e873eaac2976f6a259b662d129f6fce7ccb7e993717d81a23884c6c692cfcb8d68eb4c5104e8c329a064624220714884be4ca21993daedbd572db43ffae6e3d6396709f0c75f1993d3c08f5d3bdf2e5bc8a5df67168ff6b57c0333ccafa2c0c77433377238d766385e7b00c468fbde1efbf63d6bec5fee5a630f3cb18d9c8754981857ac5864375c76a65d733e02c7204303df4d2451d4000157b079bcc12305b65362a23584ac84beeffecc6df683cdadc76512343d01964e6fb7fffb8645701e8eb6e13c4e3415d45acde964e26299e72e414e076e7078d03efecd5fd8a76fbddf78d9b619e5ecc5b3ec8f5e73995dba6cbe271ec7955279640d83f86bd6eb78023cade0194652b6207977ecefb56bffe42bd63b65d17197044ddf05dcb07c5acc64cc79eecf5134a722e05c66197ce50069cc0afcefede9b3b7dff41dbb6fed16f569d6e6a1f5dbedb73ff64d7bdb4bceb33fbcfe7910eb89a5dd1215d3f105b03c1e6141765247e588702d76cae4f1f6c617adb04f7ee73ee9da397b89f31e64bb604a9bad98cd55f0e9cb8e7d3d76efd6a373d7b2a909c073fe579c39494e4c11e6ecf660634bafea1f4e86bf8967191818b2fff099ef353df82eddb79ffdde033a7ef8b3dfbac2c67170c61bab8c7649d204da3031197cee0e54b32f0f365becfa4bce5402f46cfe15703853987d2a89072c972e9c68efbb7276583f1a5bf28bd99d6bb6d83bbefa98754c9f371ae398e2b4776cd608572e9d64d3c7d3799018d155858d1c1b383a5ffb7b01669ffaeefdf6d347c6fe68fb6b773d669ffeee0350cd15e112cfc22d3f5cb0fca21320d2b81b2201fa9e3e6f1a0e3267a261d6b3798df56e5b27f8609be1c141ecd6866c10672adca50d0ef202166a7eb03cf24c861f34ac67d31aebdbd5dcd5ef60ba255a5313e08a251365a076df9c61741e6752d4cc0cbfec4b22d60638a1a76fd0fee1fb0f257dc6bcbee9dbbfb0c7b7ec814e98d95ce25d396aa3b3053609ebc692329936906d580795ca06a0b8123c5d12f8192c563b1ee0a23f4f355927bfb01e820f50a9f46c7eccfa8f7012343501e64e1a27fff02e1fede4511f6baeb8f082e330cfe457fa1e8efede034fd84eec038f54e9ed1fb4bffefadd1a9b6aa9484f26a7075ac9013dfdd2345467f0f077dd85a7bb2d4c10fcf76c395812785875968119a1b18af35cae34ee1b97154eb26eac2e4732099a9a00b371a787cea2e3643022cd5ad9cfa50e3ee1fe14ae2580e630ceeff744148e5c75c783eb6d6f77bfc6772db845815a4c561e03301b94a8c0f99e60d8664d1d6ff34f995c53b467cbda515702598e8eba744de1904bbb89a73f40112e8463bc4a6c375697fedd476677d0b40460a0f958960c51a061a46a1058c3b34a0b20592b2940dab0f3c85f6eed1b18b45bef5d4ba5a49b6ba6d07870b82c7b0e8005cae3936c993b8d07b929580ef46c45126c5f9f9051b31fe8cc75626877d94f0906b909291a37ced4b3792d92606bc81abbaa6909301d0f7af0e68f0c0a3bb2393ca0a2078bd168375dded68addc651283f7a788346f5533ece48e8e34ae1da0f52822b562a4c0265c090cd9d3611d86c598a177607f52450b2d342dd7072d912818c8097981774808a8feeb0247383c2e38cb14e8222243ee8afbb9d35719c9b255f25cb68263ef8a7913c02d6ba8a9a1c74c4b2f9b84d7c140a0f0459180e1df9b39656c43240de6692f26210ffa8f1dce94c80280a94e3892993c0ed0512339d0b0d1388e65306fdc0c473af0829bc0f51c9232f65f6ef3ef4aba11ce99994a65d07e8d74d193894f6e07a00019e63d3113296977b818e49965dbd6cfe8c67a267d379786f41939cfe56c1b28f759f4bbe4cf0a8415f6ff3c0b005f6ccd10a800eea973b878c16ebddfab8e0163b5b3c1a4331c686c67b47f110cdc5854524077d5b2098042cedd3663bad89dba625c01e3c8f2f8360239dc5f55e37610a1ff1f02fecd5cc60e3ac85a758dbb8561bc0b9f0912c3d7d03f6bfee78c02677e2f173ee851870ea9a142ce142b14737ed1a255a445586f66e7dc206f6e2d2f3d00c1d4b7005513e41780a38c761174d98dc1540863968d5ee89c46a761234350178f48fe77a6499e60d77306e392a3e6b878514866b5f4bcbd1983eb1c37ef76517e21ec0ddb4f8c89408d65ffeebcf355ecde7c414c174850a8e83d152f4c0debf7707ecc46923777f8a2365c02b4a3024029ada15a43e236a1fb9dc32b1c8d63eb5792b41d38e010610d075f8468edb070309f16a172ce047e7c3b486dea01750a9007ee735e7dbb9a736cfa8907c904a5a4a055783dbf253764d8a92a580c58236ff1bd1d19d5e1091aea0cd1a961bdf358697dc27ec93055120fb0ae970804c82fe3ddbd96a4a695a02509b553b706e0d2334cb6524e164a6d7f201673ff0ba262084d97fbff1376c31eee98f69915fddb9f2ed68836582f389a5007317da097c664f0113c2b138a2f064671d8c7144a1a03b6b10147cc2f8a80a9803124c0200f56e6b5e123435011edc868729616bdc44cb139d87049c009afdb18b48b341fb4410f940c7d7fecbebecf5979f456bc7a0b843e5cb9af4c03839280597c09258c2648fb6f852dbc5d066d94d2e0074418b9e40215e0e0186eb635124270b9378a45a2c0e3116d87919ba192b41d38e0168c26d6bf7dbbb2e9b6e6d882acde352c064a0da323e001d1600a1cba1c27135e0f7fc5aec23bff9227be9f94bedefbe7d8fddff6b3c0cc2614716578243b1781dad842c2802c558237a4fa0885d3a679a8d6fe7d163c123d0dbf3674c82cd80791dc00f022811459e414d3eb77b220e44972fe0d910f951a964207038c3c065ecc7b75717ce742f022b47fb14bf49957a1e4addf407423ef2e2597615bf9ce9e663d2c30d717a45dbb9d2d105344fae8073b447d47a0a0cfec7e1526c2b9eea7978c34efbf28f5719efe2ededfe351fd5d6d25af855de19e9dc114e8b7e151e7da21bab7f7ccf2bed9cc507763c6ff2e89138d8a7ab896e25e7848b09594c124e84b6714f73410cfcab37ecb2d77dec568c1e9da91cfaf2d6f4af9b044d5d01a8cf5757edb3ab7057300597403eb726031dc23f28ae0b84b085bc34c9af91f342099cd73f6c67ce9d6aef7ffde5f6bed75e6ef73ebed57ebcfa49fbd1aa27ed9778c86348e74f1478a00289859f46e512bd9189fda47dd185499a8a437c7a69606040b67020b70ab4bce6538c075f41063ecb201bc4e951392187f44c04bb4a92260de8a025555a302906717b991c2a4582722520b6edd758099a9e00f76ceab1efacd96f579f3e41bafa4ae00e64cc5ae3b2b09c0337f81531775f74f00b48f006590787fb615cab5db4148f989f3ec7de7dddc5b6a7abcf7efae8465bb97a83fd089ff5db8b1b4a8563e8a870977c566bd509414f1d1a88b919002a0590fa7304cc76deed14161123cd371c11ed40b012978e03840483d3a3e5fe4043c1e7b11365c1263d9f4898a5662334800abddb9f14e95093a0e909402d3e7ed72ebb7cd1049bd4410784636089b21bb3dbf78985a394e6cee7cf11fab777c8d1cadd07fef810059dc0104cc0770b5f72ee62bb1a0f7c927f3df68b2b1fde88156283dd89c4d88967f858c8eba582d4cecd0c3438b5ea57f93a785579426b4e3342bce209fd186c59a90afa4a6774201dc144a56460ae7032e83e012f127138f8807ef10368c807920fac3079fcab6eec4c3e29c01e82abd67024017627534e71fa33d88e49023c8597307ce4473bec2357cdd28cd7f53fc617c583efb05b0798c6ca123a216ca4b120e862093ca66309f79a4e33f0ac8d9eaae18d9b79d327d8eb9fb7ccde803308ca59b56187fdf4e14df663dcf0b97bcd663d741223427882321003267caa7d66790bbc99dd01daa11b461c503a537718c9a0934507f7000803c76724f00535c4cf13830f9d92265e08605cb1850ce2c1836ef205e505ac0c02ac820e145d156ff5ee482bc1334b823149002af543bc88e14f6efd957de06a5c0d93756e889eb485d5b21378d65eb88cf262310a4198cd75c0bf3f4807ba839c9d2b8173881fbb465e691c1a1a003f5e2d83c7b796e31ec35b5f7c0e9fb6d2f1c3cad51bed2778ec8c6716f989e3ba07430f4752652f1940b382a987eb82b04939e8ce2f2e620a33b80cb62208987469cc5d05652819087962bbd14483ee86fad01a00fc90e189e2e8f2b2b3e4391aa0eba72400d836f9e99360cc12803a7d67539b8dbff5617bcf4b97d9b8565f0274691434868fff54d92f1e5599ce99c0e2e9e04cbccccc0e5a4108a1a9248883415e54120bda928d81e44fccca0b4e9d65179d36dbde75ed85b6bf77c07ef6d866ed2e78fc503d90e2636adc0c6a10a2503232c3be9a413f8cc951c9c1954aba72fa0a0b1a14633373c9244f609f0960153737b411a3411825c84ac1de4a4116a9d4a9540fc4deed7ebbfbe992604c1380ca7f6dd3045bf3a57bec23af3ac766e2891a15f7943b04b00216c69457127d1de5b2ca5ef48ad774a866051012956ae28508899c39cc02fc7325188457f9ccc295cbe7db55672f802f9f670facdb6137ffe461fbc63d6bad0b3788d8dd4b05c5c051391ea2156c1f8e0116025da5ace2ca94974ad4a38aa8707e10102325bd11f9e80d026d8374e2408f51a3663fc74882c0a21db4de1d4f9f044dbf0e2085466cf0addb9d6bed43d79e69cf3f6b21bcc205d257041aacfd3b01d8403fc65a0104a2465c2280a2eff0c121f4997bcb5d4617f9d53574c132ea5877682b98f96816576806c2878254c05c715bc7b559372eb2dcbcf211fbc42df7d9be9efef02f07290a83a8d262b3a68cd757d37c040f90cbc5b20e035e71de227bf7cbce956d3c9bd1cca571b0815b165d1d04effdeb76da7b6fbe8b1819cf15c0777fbe1aa83bfaf5c36fdbf7fa01ae0b9014817993754c185c2798b9e080bb83315e012a25ba669c66bfff9507edb239abecf75f71812ea2d050daec073e801128061f7162e22bc88e211b5c0d5e7661a1e3692b772943321a7b59219cc67d311381b7a4d3db46544380f0a8b9f7604c8686faad1dc70e6fbe72b9bdf2e2d3edcfbff673fbfadd6b7d206e314e6c08a8ecc0d7d7a44042d4785af04517ffa2870713da633025b6b2019d38b6fae0ce21689b77535e12c6da1b07dcdfd799a323fa406e45f2c4ecdbc147ee71763079e4b317d2297a8f71d56213172db79f6d1db237fef537ecdd9fbb03998fbb5a0c54642d83aa5d806c0f8771d6e2e0492d464bb308c16796a0f8e120007893b63b8b24a101042c1485c915094226264d2b66140f322983b87e5cd8993ea1cdfefc4d2fb49bdefe629bc46705a49b07439e45bb86c21015827cf8449549ae3d54e44b30a09474879c32a3c9ac8c18d1991414e2a3142c09251d46c1d3052c7d4f6db4817d3bbd516c8fd00a9046f42460ebb65faed367e18cc9b8f6bfc4ae7eee123b1fdfe3b37150994e8ad41c66a465450a35ac549b47da0caa1af21dbbc9ff74841af03570bebb4152a081d4e1f0f097c33a7f272fda3c8de315c6e1e1017bd1f279f6c577bddcdef999db6d13be959c0210bd25431b4754ed12825ca9848dbe99ccd1157cd78234df6d41482147a0b22c090b62e6c9001800974d75f1999f7a3b7d5849405cb9121ce104e0f05512f43db5094f05efb3cf7fff417de6e106ca8b708c7039bede7dd99973f1ca964e040a99a06032480069acda8e978b63e6e8f88043a04d58b32dfaf3020bbbf2e3c106a424e34c64a29082c0d0f148025e8e3e7df664fbf2bb5f6ebffd895bed891d7bbd6f6c29c68b2402442db06a2bcc90cd40730c5d18d29284152dd8527246e7428e4baff06c27d989365aedc1d79835766f702560494970141280c3d793801896cd3bf7db3ffef4117d188815785cecf9cbe6e133df2ec465e0f1b841a482c0f95137c3ef0786344f179c9c435bcae059c0109240bb01b5e91a945421991873b6c5afcbba683381065b6cc68476bbe96d57d96f7efc16db83ef1254059dd84f25c11911681f249dba721c7f5c0e414246f2fd47dc1da45e4abe24cfd3268bafb81ca576ea98fb040df88a5441a967dfce2a098e52025095d1932029c9d9f2d086a7f4f9fb3b1eb27604ff82a5b3ed7967ceb34bce9887ddc54c6b6b8bc0c2595c143ce0941cd145ade03235480fe19c8d2c4c1fe2b42ab31793813570cee32bc1e21913ed636fb9d2def6a9dbf5fdbe104aae4a28e12829905c60747dc225a215fc1a1f636119e0881aafe8ef622a79c5202e830c25591d5cf700834e26972e7cda60dcbe9dfcf960ac04097774ea832481472eabd58f2f73dcf5d8167dace53edc0f68b30b97cec1ae621e9262b6560b2ea795c96eb82706c468fac11d6050e049c0bf4e0f05fad2a94c0929e99a047707172f99696fc159c267ef58153a95117058db426fec491488b428b3e991f1d463e8d3aea00a68c87561ea516d8024de05657468eeb402eb60c86323f50d1493e02827806bc5b303161e133416d735695e51bb71d166252eedae7cc4fb4c9bd4894498abddc50bb1cbe0f144f2945f48a99c4e693a268023798d809e712a7a28806c018b2411199b01dcfe7dc78bcfb6afffe209dbb23bde2a2ae5b401739ac9e8c8823629daef9306195a1148e2785c62b81b40cdb94bac4a54b9edd88acef6683c19576360a32a32aa64c4a961453d9a50b112ecdc9c1529554d33d88daf51e09016bc33a8cfbe73ff7a7de8a1d3e74cb5979c77aa5d73ee223b6bc174cd7446554180e375c00831fa8630f6c5fea89687822151d27018668aee4b9be12df5f687d75d60fff94b3f091d5d8fb4e467c523429e3c1814ff8cb7be8eaeef47b0c1e0a307c091c5e5d6f00da85ab3d6a8f52a1a606ae443fb184900ea5925417f4e8242633a0a81ae79aca14929c9cab5f8d2e9676efb253e0fea79c3ab910cfff679a7dbbc6978795488d535b9989dbcefa058c438bccd8cecd00cf5e502b98015e1ea7317daec29136c1b2f0469b41016adb2725d3cc2fe2e24bf22996d4057f6462a84daa52c4912b51e38ad1f8e4f2caa47eb9bd846a3398e13e2182a9e04ed33e643a7509a55fa48d3c0275849c18633b19951c4e17f1d4ee13e7bc78376fdfff8a6fdc537eed36ac1e0fb011a0f1015f51c176508cf1e982922a543350c835dc16b2e3dcd47d760027d0376a911ba407042e0fa422cfb29d6dcbd90cc8d32929d47293574ec56c2ae344c0c3ab273ad6f228792413bc612804a3209ceb28e19f392c65117d6840d1543d050d1a75e0afef0541f5e45f3455cefff779ff8aeadd9b6972910fc088ee2007728a838fa87903c8c02e5f27831e9d5172f055b83fcdc2ef1ae094721593b18eef335168209a048add09b55c8c8322b1c73a5428f1c2b0bc9a40cb85c360bd43198006eec849c040d1a4bf91247d81d5c6101e5060114b51db901d71bdef2c9dbedc1f5f89a1709dc41e3c3fbf15a0d182116e24197c389435630000ba68dc743288d5f12550fdff830825d1e1014e591f709cf2687d627c6530f7646a9a2cc86a332c42671ae1f5b5521befc9082764d5ee0803f4613c015ac92806d14dae51b025e649b0868b32682a48009aa0899e1bdb8e3f767fffa0bbff40b47f2b2721c058007bcf0ad662c5aca077a0ad771d3f5fb0bf18c6244cff9d378796ca04991e3b99e502088a4b366e5172048a917270bc7459f4d89c9f804a43aba8b91ccd15685466aabe6c611dc1ed641e014fc88d3475f3ab71ced6961ce88ffb3f231fbc94ebe68c1153970a7169bb0f03922f7ede2d9419ddf5d937a07adcee2c4da38a9570b2e32edb46fddffa45dfbdc45e0c33545f02926dc400e977b0f32976df4033d5dc93b7ff129f6adfbd6a7c18bda15d028b09589a5c22c12c843beb8d14528a1490cd64218381ad1a330951d1ae11a3b1b2e5168d8735809b01fbfd475deec76e80d832097a26584c6500b38b88220665032f6a79307f06ea055d8d7af007f4d434a68286512a4d7a6441fb7a2e26f6c539960752606b16227f44524e3b5172cc2e48692f8e744f7dbc60c219911249cbad14631880dcf034cee449b2504aa6a100e1a0f369547942011d8808d579c2995b9a695a6d695239319c88c4f40e039742e255f4236e2bcbfdb54d10e2b0168d8b67d7d3673029e59c7b80c34b39b5ad38074258dd9d182cbe8bc05cbbf99388de28b90bac079484980fe7d3c45745b340e365e322eb547208ae0070d15bf69a30445370682daa79d338f09b422042a5d1be02a3015f708b2221257c94cd7feb5d223eabca145bfb867380a78f1af84e0783e6ac893060e874872380f1135249a6cbb64727969e029fb34cc80c33e06d88a1f5f9203618d0c62908190f1f01e6beeeb58fb0d9c219ba9d9d38224d86a5d4fe2d26ae58964c12835568205cb747640f3e4447279a300381091a904b7500e8b229e16ebc66bea9ec28ba773f00551252edf70ace241dbc23ed15b6c0a9f15600939094cc1551bbc34cd256104c1d08132298f7e22632e6c8565d23713842f5b82b39d2533603633ca01b28e387301d36127c0f69e3872d60834885662345a9ecc53339640e016a7376d01cf77e0743db93af84798d880f02468afbd51134292b5d2a1ecc264a4e1c46913c4047bbd71272fed460a00e561401b1d75c996bd81e49d65a7e2de3a1f3274c144368c0121ec0b3fe84fbb17069c9c14a41eaaf4787842915b34d259d8481f21aa4d8d2fa181ccf8aa9fa332c16506f9f013a00b873910e6c7d0b084b39dfa00a71ac673ffaac4200ec833f0d876f55570ac044c820d879204675afb741c7cd2885c6a0d60e97a166e130db52bebb820f5e0fa008bf4c5c683ce7d3764306af8f747fe6105da44edc7bd885c28278f1158caa1442c7fdc1532bdb8e3f755906ee2c401398f17c147db454968086ba8942589ce1a9f6c1779132d89aadace9be4356105e02e40e195b3a8072cc0787e8d1d30524cc702a114ede38f3cbcec82a53545b53b38e42448178be880ca2836dc4719e9c4dccc80f0b3f18027f54a11e1ef0be9e81d6c3487116712fb9edcf9baf8e0288afb3dc9631d302a1d18f3b9444499ab09ffe8105d1be071019ae2667611e058426883465188d2a7a49570c11ba0967c3922d146f21ff60a70df163ca5cab511cacb891c0b59afa1e43cb61da0e1be1fc23575bec73f17704351be0dab6bc3c3c19f890700b03b987fc6282b8144a14f61aca294da1c2b91f9f3306d366fea04e7069f340da731f80c92f6ffa4a94175f07534ec369cad90eba4a4006441001617de4fd2e368a8796bd95706d234759012be02552a538b86c2b12b863a9c5450176f84098510e0b3880c707e1e5e79685baf6ddc8b6fc9426766386b394aae044cf1d086a73d5edce8e5783ddc35cf5d5a28e554ee0eba373e4221c17fb08a4980ddc1b439c1040ddcfea2538900ac26378e7f3e6e2177e841232a4e34f0a835f301c82ea0b41b739274e3a564974139fc5495371c2191a4cb31d1436368a720567faad9f92b99212519144304d6ab6268f6d31f703ec9ca0e4496f201b38dcf612700c5defe440f06e5be8e2d16ee3f1da2e18a65d098ff7c1c6a083755def79a4b6dcaf88e608c0a06f71d5212e0cba248820e1c13245f65eb2852e372834fe810230977ed7317030d1d391b3deaa8690bf5f6d9aa0b42f4140ce1411b1f2b7f64f31e97998495e3645cf4871c7d0181f2a124244b17ee1ab93ab8e34628275eb21686b1e19fcc1e6d609d2f1346e125138a58bc5f5312e0362400f3d9af926100d9c93606c13f2b1ded82a401e9081c07f011ecf7befa32aaa4e2fb49d78fbb8367be12988d9f777aac04340c859532a2683bd2c9a09d8bab79d79cb380aab89ecc5a068bfa3371c5af2612962dd804719bf140c8635baa3775d4c691746e683f9e51a248e15caee423ea520f329554c191bbaa3b39a8480d4bc50a84f34856817510d81a810d94c227c4342501d63cd5674fec8aeb01a1b43fce4da3e138e8ac4c8746dc15d0b56cf32d1ad75fb4443fe9a2a77fa5a1b494f2fd7b98048f4a46261d10684112e09880bb0319197272150e409b89c647ca3ef8ea8bf4d2055110d9f44713980b9eac2ece9fdfa32dc3f6fd47e2cd9dec98c7e24051d2505c4152c0205ff1260b5718ae38f8d3db53523fd5e89c652641eac48efe113ad1122eb5833737139d5d3352b6b2dd9404e090b76215a0409d26d183722863c74123fb8947938e201695deb2f1a6172cb30fbff1727427161f562a383064126c7aa649905602be722ee4485616a831f8a3527f7be38b6cd91cbec7875ab82eaad95457eea60042d914389268ce2dbfc463689548768bc28e2424a2079d6dca20da65a12d1ed44890367c5f512575f356b10581b44c0f80688e97f1ec52b64b82c3dcfafa43de26ad0014f415bc1a66c35e3f2554f069309d2b0772489e5a394a4a73069086babfbfdf6eb870a9ddfc07d7d979f826af5b14caa3f22478ccbd47194f53c6cfe5ee2092a0f00ec73d0d8f8a7dfe1dffc62e3c755abc72853a42b398a9dc6b537ffeaa28676ab285a7b7b4e1ee2776d9fdebd3376c42c703e8a3dc272df98200e530b3fc5f0936512f9b2223e48d2632e3320036ff63af5c6ac950f17a52662e1f43e426ae007dd891fed55db8ae4e47e29fc1f62c0780c198e9fc2a9688d4456d545a83f08e9cfe015b862f627c09dfc6f9f01b2eb74533d33b03a9295782ed58090e25094e2bdea8d9826bf79df6bb2f3dc7befaaeabed3998f983f83228d4a46248442ac8035768cce08b825b356420c95357f4cffdf857c0b94eecee25daac5221ac011028c855e138d8f7e9a81fc9e5cf1ff08d27380d91ccd439d5e0cff8241cf2084ab9828f8aaa881820e0e8efd8d4ae780eeb66508c982bbe1fe85b0ff6d9b52b38fb7c71e5f8da7fc6850faeab7eb0e87b9f74e048b7f3377506f16393d75f7caabdf6b233ecde27b6dbd7f1b5ed5bee5f674fedebc5fb779104903761de99d9b03cf828c0e4f94bed8c45d3ec867366da75172cc62b8139461fde4becb3d9e38b2dfd81c215825aebdbc44a56298f1ca1aec3f6ffeed96877addd215edfb063d5bf22f83d060589f6520c02e679e07d5c03cc403467e3dec8441c93f0ebe9592ed91a4a85aa20295f36d947ed0ae9cdd44eb53336350128f2a6fbbaedf94b7a6d3a5f10c402ffe80050d6d3599c117034f4a0bb1de0cc0b76f00d60973000679c3d7f8a9d7fc345f681d75daa47b157e3fefdea8dbb6c7377aff54d9c69fbfa86f119c4814c0b5efadc6a533af0c1a3bbfcc9b673e774da8a599dd6deba14c719789d4c7f9ff5716ec73872031a6c723125a4990a45a82f715c09f4aa781cca3fb6759ffdcd7771b9ba2c52baee50b62873375f6b1763b10bf3496350a6c6734e8ed18e97655ff19c3938b6e037763438bb14853d506236674263dbb932595db4516f40a9aec0a627c0bee14efb8b1f6cb20fbf6c317e0c023347018799acf1a7fd3e9592f3dd58aac5846070087317c9e5913f27c75fd76ac1c5c6697831d4e567ccb2172e9ba3f37072eadbbe941545b221c4dfd187abedfdddd64d611109ba9e8370a6fb699dd4934e224540950eec063e06ad07cf3dbcff9f1eb0deb867c0b155c4e360c679377c8d0cb359740f3bc7ccf9423cd28b7df8475ddefac2d3707cf154f1fdff18435ce4afda6cd5db415355f15176d5adc23bcedbbe0ebbc4a66defdc39c13ef4adb5feee3b2df92e9aaef0fc776724ffe9cc21398ba7486487c308f020917b6bbe23af7fa0dffab03af4f5f4e0d36b3d5d3dd6dddda3baaba7db7a7b7bf5211fdfe1c77b0e4c2aded4d1bd096516c5c2f90aae0758d9c77880d76f5c7960fc12b0d95fe135376bb7ef73239253e93ff9506e1648951dc79fc2e982381f9f4a500fb57d1899e702491bb633664eb02ffdce0bedbaf317da5cbc49a53dce0cf878fac4ce367bcebc2976e9697c312506e14031761eb01c9c5c9987a388d9abb21f78c6f40d21974dde65ff0d6f05e9c05b30b9a0f2888fc6ca0654ae17dd024540f6bb667abd92601088547cfc35f474227296c11291d6a03f6fb810c7a60439593f0009661deb625c2ded1adf9ff05180218efb7c5727120272385bf906f4bfbce561fbe77b9f844016121caab6189bb86c0f29cef4ed775d61d370b18bab9b663f014d39580c7eda4d20c1fcae635b5b878dc32e41c723d05581a478c0ab36efb5377e6a250740f1312ad0dbd04628af0887624237d29a781ae8a3d6b73fdb37dd3ef0f547f5e2057a49dfbda7d9d2492901c342291e0d2958de56a2c87348083a0bb062c7fe7422d874b916357717321482992014cfa32b4ae2968f78d1f13aa7173fdaecaf175745d0295ffcd82053f6e16552efb9f9de8304dfa583db0b9b2a04a4813da2fb05d0972b0f8b475a64e53050a23083601c8f557ab08a7575edb77dfbbbacbbabcbf6ef27bcdfbababb746c2439b28cf2f8d1c6416ebde96cda062e11829f24fa46f95870371dfc79d70cfb8ffff430dec6854ba7e100fa8301669386a7e032c0f40828d85071d208c25dd29449c020fb6e4221261f0babe8cf84915c20924cd1c0a3237c907945923f5ca9af69a3ed85b25b7073abdb7ee77fdf6d77e6237eea9278ea751d9d5a5e7fff119c31c0e13afe498ac81e6e20871f6642ec9a28b905f748a81bdf8ac24bd4b2157df50393921163a4a1d829958c2390f8129c89a0f81fbb8d79027090d503b3ecc62f3e649fbe7d3556032ec9b04cd187819aa90c282382b667054114f2614b47e40f11bcc6ee1c5c47fc326d12892026a7b37fac043cd0a2304f1da5189a9081f13424928c3ff1facff76eb0b7fdfdcfec57e5dd3e1f4a1a5186fed895981a2d58a2faeeaa2d7ac337ef83a43c658ab9a9d4c73fc201e6a9a27653cc60e8463f7171f321b8253f4a1e983c6c624b5b54522d42857346e70d2cfb1c9104e078e3669f669fbf77b7bde9a6db712eedef066254e97c99450fe19382491c63968c93fe0c96a285237cd44a1af25102ffc1a4659e0372664926a793cb418502891a2afa3038c0fde0b1edf65b9fbbd33efaad876c67171ef6e0802ca9ae839259d26a8cd171375e28f1c91f3c21f95ae5840767c8a47aecc7f1f9e1cca7de0abaf4a2e24a03105c4fef5c284525723300560203c8e80c482eb80eefb1700a3894c23b769bf05b78bff7f91fdb0bf0e68f57e2e2cc9578174f275f950eddfcb6ab2ba90b27422a7a72b83b0c233233e01091e12025093ca7c33d74f7f3f930964c98ddbc33a72eca383101376cf7e115ecfff3876bed173805cb855d954d191380274f23568a94c81461e0be7cf73a3b6fe1147bf159b339bc8a1e3553a45d0feaaff0aa9fd239923ff841e7918d723f6450458d2bc05b8e2a60d9ee7c19dbc0dff4eb0052e2201b2601b378e5a39b6d255ef8300d9768afc1376eafbd60a19dbb10bfb2a5033ac54a523833dc1b84e828141c052a8e318e5ce6f9e07432410e0ff2b4fcaaa7ef6ad9e5015c4cba7dd556bbede1adb6650f6e62e542ef4498b2c7825804b50a3879473066690438f3dfffb587ecc6cb97d8db5fb004bf85108a72f1c56cf7a37d8a71db98db94e8c301425bbfc54cdbd1ac464b8d0ac3f17229f4cd1c054e9228e2924f3e4a2b8e78d10f22d67e29bbc596ce9e62172e3905eff99d6a2bf0aedfd3e74cd65532770d6770f20e41841d06d1383a993cf4adf6a1c0d328ee4a7921691dbe0bf8e896fdf6d0c6dd76fb23087a7e275f760db8e90df66ac04912e497e81a0fbae44219b90180ed4ae6227c9ff07517ccb7579e370f6f52e7a931f4e5318a1201f6d03ef571fb78b6c3ee0a3c368fede8b11bbf70af587c94623081d12e94750fb9d8dcb1a41fad04a001fc2d3cbd0ba050283980352f91f247239810b3268dc7d3436d36094f10b19e828b2393f1e58c7170e05e9cb2f11dc0fbf1a0269fd6dd837afd53ddb606976fd76cdb67fd7c845beee6a828a5b31ce3c8ec4f021e8c91ac9929f77400f81aa96864d08136e87cdaac4976d6dcc9b8253d193f9dd76613f16ce264dc151cdfde6a3dd0b7ab1f1f5ce6e671c4f6fd7db6158fdd3d89c4fde5063c89d420afca4e108296034fe5844b84a8a534b88e6602500725815602289675cb80b4afe7479d1676a8ca02d0c1b9b8ad66a03baa68979db3d800501593b72e3f5adebd618c11b41a22bae4c1d0e6400d3c59a413f236f305a02a2321c7e1bc3d00dd4773ae237e0c509a4a78fc9ca5b29fcf0136965ae049ac216080db4042ee5a631136f191e7e9829fc578f07333f5cd081f926bb344925e16b4332a03a17ed5aedbc3fea3d1802bd0f54622543c8e619b10958b52738c7351ee514f00aad78924a06155126006878ea4cbe8dcae94cfb4e0a9f771aab6da89124a42525da0c498568e129f78532dc608fc334ba8aa67058594a20a5a618430b52e23794adf54daa74eacf1494d8de66d471de6b7830bed0f1bec9cb344329804950fa8269c9c0d08a0a15df12735125f664c04d4c08d5cdb8517f7882e8d4126eb0826975f437ba3624d44d4b5f113de55a3a08cc99d33a6241630c1e0c97d5ca584f61678fc3f8f724cac00a12a560224010ce07703b275d9a06420b903ce606a173c8cdb88d2c0277a765d21967c0708fca872c1dea82751215f550d6ed023f78d3e2237f0b07f890fb257d8463b8f55c81431f3178c008fa904a0f2fc0d3c163e165e1955282d6a6c6064450928211a1d90fa25bada45ff8c27304af047ed0f646d9cc47400741e837cd1a8e10a7ca2675462449dbb265c6622d030f801789cf1d84b00eaa5248063950444c886d210e28ae091a7561a78b51b297184632a677406402b610a46bb11350251313854b529a19e28a0895cf13894f0ea516c129fd3336fe22893b0840bbdebdeaac639e656806453e7acc50273122402ebacbfbb42a40c66a0ea51738aa3e5901a6b5adb6b480f5422e5818068602349a86a13033532a676aad90f706e66a01058d2f34805bd36b88f9b0596a11f29e7984d005a919320fd5cba7c531a940c0f9bb3d16c8723a34aed866674ccd824c8fb2774aa13b596504eac07918c8d9d0a5cf4cf1c230174cfc8346a480cbcaa9227c125bdd02291b33457f1984e00eaea498077f5eee5b7714a2b0e04b357949205a8dccc40626ca847a503a9a3f7c4dbc0d4d03ce06065604bb8ea80714258aaa57b3140c60357a07343e88220b068b353348ff904a0bb3b672d92d7f95878d69c98ec089163531997b09c9d791597e1617d6228eb03c924cf88eb09546114591957d01ac62d28101cad1a4fe24875c5465554f2e964c59387ce3c04121d75025dc0b1791098742f6b2501ac1bc8bb839a25c1da6820d11e7c71e72e4c87dc88be07aa4693e9bcf59d51c81b556c8574085b01157ef476a16519d9c6be4975f05496a531323101a8ab719f152b40d2bc73e642a9deaf95206153dd6830f145882a9b854fbd6a75e9e44418a5dfc8fd7d3067de0c94be766d0a521aa2b692651d4277f1179d6aedc0d72b175b7411a2b11d833fab12803a77200958faf7367e4347e862533a30a10fe085ecf492af9a4b2556c1cafb135220b326361aaa1c7630f01216b013427c45afa020a56a34fe60cea93ea23310355cee20a9cfba04a0d65512e0299e9a71eea9d19d310a23d947a093834a420937f469204903e14a42098b2336053e8319806e25cc2e8d6d620a9ca4a6366b646a6a9634e1bce7b33201688b92000eaa5602378893331fab95460b2e37e1a0ba871a1c467ef7a0c7a2e64d90ca76c0054e98dc2e781b712015d468949806389a23829fe526b51bfa6573aa9ecfda04a02d1da72c60a52448873fa50f441c6d93991a1d54aeed15ad72571256d11c83b6508e2fb7a9876a12ca218804aec6af8663bc4f01e73469d4083c255bbd510ce2fd4a159ed5094007a52418d8dbb83ba0474a53dd0f5a1ed83197826f94c418799a378ab3e57c6db254011955f429c768ec57b6335f888c76169946ca7c41a931d41a392d848dcdb33e01e8879c04fbf8642f2d4b81af3ba061dfc0ae51c09759338015be82c5586b074d95c3791ba42cb46c0b4e9c9910acd12ed0ae60894870aa930da86ba868a8aa116a8cc74502d0059e04b84eb08f67070d06b3593f30601714106aacc5d25a0b36594bc6805515f8d178d238b59ac33e1319d5b8999f728a215dec0884d0255fe6a08e697e80ebb849005adc710a7e6b08f66977e02ef0ed81829f79b27b1c9303493cbc95db2407afaad40fe149a0248ccec3ae4e299947e32dc6418fe23a6635bec629f90a388bafa54dee114aa07d0c3d115469777850877e702a92408ec8de08c1a5c3eb63c95d23d813a2ece770de2696cab3109c910ee7268012ce2a2464aacb6e759cba0855e02ba121b121f8357e367c1938ae5680b01caf94c74a8032f2e7d26178e9337185a346c13b6f23c1db793b3a59927d0386e0a9425276aae8b993c815b7ab59f62126b5531dbdb11435608235615933f8de3e2e1380aef024e03141faca577200a92cded6b6be7e83d4c89bd88b3eec9fd902509591de297015a9a0d7c6097cc99fd53c501f3244897ea9a9ba269f9824276a54c76d02d0dcf4d373792548f6a7e0ab9d918583d89b2568059f63b00d52060a1ee1823eca7cac89ae354246253a43ce9607253e6671b0709c6a5e93fd407deba4e33a01e8869c04fbab77fbb96b1a1c34da6cc92ca907ea8ca3f4d428ea04665a62cb04742b60f2e566912e19c77150729f444835bb17c117baa279e7036f8ffb04a0e94a02f864601f7f2710e569fd0386cc93810257081911180f88c6c96ca58c04479dab84a77e052c190ded241ce89432a3738c826d409d1009407f75e867665a6c502b41e905c0b91980aa8c443c00fb4173b83ef1251ed4094c80da1919f4a29d2535e01a839fe4893f787375b0be8d3408a8d9e00ae00bb4c3fefbeba1d0f15cf195f26d9366848974103ed94f19c82e708ec0ab728c18025dcae06ccce802f2199d28214355c22595521b3513411fd2880f9aaafa484e0e7ae20b91b52ab104125f55df842f20db176a4cc77983bf359493203b2480c2e1c2e4369d927808128e4f460740bce8ec8352c2a211c7ff2259d8b5c6c78ea924b9680b2cfa9125eb92f885f486e4269e92eef0dcce812f8c3be59277fea06d722b5feb7906444f19c976fc61c68dc7af96f2654cfd7803a547a308407230bc977d1f4076767836a1b30c8acbc80a960b81cfa400881798daa8d93f351390da22a54663df2c8c40550af684e4cc9f3f7ee0efde3a6fcb07ff3fa75b991287c84a7a0000000049454e44ae426082000000ffff0300664a5b8b2e440000")
 	gr, _ = gzip.NewReader(bytes.NewBuffer(bs))
 	bs, _ = ioutil.ReadAll(gr)
 	Assets["st-logo-128.png"] = bs
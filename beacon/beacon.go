@@ -22,6 +22,7 @@ type Beacon struct {
 	conns  []dst
 	inbox  chan []byte
 	outbox chan recv
+	stop   chan struct{}
 }
 
 func New(port int) (*Beacon, error) {
@@ -34,6 +35,7 @@ func New(port int) (*Beacon, error) {
 		port:   port,
 		inbox:  make(chan []byte),
 		outbox: make(chan recv, 16),
+		stop:   make(chan struct{}),
 	}
 
 	go b.reader()
@@ -43,12 +45,25 @@ func New(port int) (*Beacon, error) {
 }
 
 func (b *Beacon) Send(data []byte) {
-	b.inbox <- data
+	select {
+	case b.inbox <- data:
+	case <-b.stop:
+	}
 }
 
 func (b *Beacon) Recv() ([]byte, net.Addr) {
-	recv := <-b.outbox
-	return recv.data, recv.src
+	select {
+	case recv := <-b.outbox:
+		return recv.data, recv.src
+	case <-b.stop:
+		return nil, nil
+	}
+}
+
+// Close stops the beacon. It must be called at most once.
+func (b *Beacon) Close() {
+	close(b.stop)
+	b.conn.Close()
 }
 
 func (b *Beacon) reader() {
@@ -56,7 +71,11 @@ func (b *Beacon) reader() {
 	for {
 		n, addr, err := b.conn.ReadFrom(bs)
 		if err != nil {
-			l.Warnln("Beacon read:", err)
+			select {
+			case <-b.stop:
+			default:
+				l.Warnln("Beacon read:", err)
+			}
 			return
 		}
 		if debug {
@@ -76,7 +95,13 @@ func (b *Beacon) reader() {
 }
 
 func (b *Beacon) writer() {
-	for bs := range b.inbox {
+	for {
+		var bs []byte
+		select {
+		case bs = <-b.inbox:
+		case <-b.stop:
+			return
+		}
 
 		addrs, err := net.InterfaceAddrs()
 		if err != nil {
@@ -0,0 +1,32 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package beacon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBeaconClose(t *testing.T) {
+	b, err := New(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	done := make(chan struct{})
+	go func() {
+		b.Send([]byte("hello"))
+		if data, _ := b.Recv(); data != nil {
+			t.Errorf("Unexpected data %q after close", data)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Send or Recv blocks after close")
+	}
+}
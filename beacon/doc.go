@@ -2,5 +2,6 @@
 // Use of this source code is governed by an MIT-style license that can be
 // found in the LICENSE file.
 
-// Package beacon implements an UDP broadcast beacon
+// Package beacon implements UDP beacons for discovering nodes on the local
+// network, using IPv4 broadcast or IPv6 multicast.
 package beacon
@@ -0,0 +1,206 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package beacon
+
+import (
+	"errors"
+	"net"
+	"sync"
+)
+
+// An Interface sends packets to, and receives packets from, the other
+// nodes on the local network.
+type Interface interface {
+	Send(data []byte)
+	// Recv returns nil data once the beacon is closed.
+	Recv() ([]byte, net.Addr)
+	Close()
+}
+
+// Multicast is a beacon using an IPv6 multicast group. The group is joined
+// on every multicast capable interface, including interfaces that come up
+// later, and packets are sent out on all of them. Packets are not looped
+// back to other beacons on the sending host.
+type Multicast struct {
+	group  *net.UDPAddr
+	conns  map[string]*net.UDPConn // interface name -> conn joined to the group
+	mut    sync.Mutex
+	inbox  chan []byte
+	outbox chan recv
+	stop   chan struct{}
+	closed bool // set by Close, under mut; no more interfaces are joined
+}
+
+// NewMulticast returns a beacon for the IPv6 multicast group address, such
+// as "[ff32::5222]:21026". It is an error if the group cannot be joined on
+// any interface.
+func NewMulticast(addr string) (*Multicast, error) {
+	group, err := net.ResolveUDPAddr("udp6", addr)
+	if err != nil {
+		return nil, err
+	}
+	if !group.IP.IsMulticast() || group.IP.To4() != nil {
+		return nil, errors.New("not an IPv6 multicast address: " + addr)
+	}
+
+	b := &Multicast{
+		group:  group,
+		conns:  make(map[string]*net.UDPConn),
+		inbox:  make(chan []byte),
+		outbox: make(chan recv, 16),
+		stop:   make(chan struct{}),
+	}
+
+	intfs, err := b.join()
+	if err != nil {
+		b.Close()
+		return nil, err
+	}
+	if len(intfs) == 0 {
+		b.Close()
+		return nil, errors.New("no interface could join " + group.String())
+	}
+
+	go b.writer()
+
+	return b, nil
+}
+
+func (b *Multicast) Send(data []byte) {
+	select {
+	case b.inbox <- data:
+	case <-b.stop:
+	}
+}
+
+func (b *Multicast) Recv() ([]byte, net.Addr) {
+	select {
+	case recv := <-b.outbox:
+		return recv.data, recv.src
+	case <-b.stop:
+		return nil, nil
+	}
+}
+
+// Close leaves the group on all interfaces and stops the beacon. It must be
+// called at most once.
+func (b *Multicast) Close() {
+	close(b.stop)
+	b.mut.Lock()
+	b.closed = true
+	for _, conn := range b.conns {
+		conn.Close()
+	}
+	b.mut.Unlock()
+}
+
+// join joins the group on the multicast capable interfaces that have not
+// joined it yet, and returns the names of all the interfaces that have.
+func (b *Multicast) join() ([]string, error) {
+	intfs, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	if b.closed {
+		return nil, nil
+	}
+
+	var joined []string
+	for _, intf := range intfs {
+		if intf.Flags&net.FlagUp == 0 || intf.Flags&net.FlagMulticast == 0 {
+			continue
+		}
+		if _, ok := b.conns[intf.Name]; !ok {
+			intf := intf
+			conn, err := net.ListenMulticastUDP("udp6", &intf, b.group)
+			if err != nil {
+				if debug {
+					l.Debugf("join %s on %s: %v", b.group, intf.Name, err)
+				}
+				continue
+			}
+			b.conns[intf.Name] = conn
+			go b.reader(intf.Name, conn)
+		}
+		joined = append(joined, intf.Name)
+	}
+	return joined, nil
+}
+
+func (b *Multicast) reader(intf string, conn *net.UDPConn) {
+	bs := make([]byte, 65536)
+	for {
+		n, addr, err := conn.ReadFromUDP(bs)
+		if err != nil {
+			// The interface went away. It is joined again if it returns.
+			if debug {
+				l.Debugf("read on %s: %v", intf, err)
+			}
+			b.mut.Lock()
+			delete(b.conns, intf)
+			b.mut.Unlock()
+			conn.Close()
+			return
+		}
+		if debug {
+			l.Debugf("recv %d bytes from %s on %s", n, addr, intf)
+		}
+
+		if addr.IP.IsLinkLocalUnicast() && addr.Zone == "" {
+			// The node is only reachable through the interface it's on
+			addr.Zone = intf
+		}
+
+		c := make([]byte, n)
+		copy(c, bs)
+		select {
+		case b.outbox <- recv{c, addr}:
+		default:
+			if debug {
+				l.Debugln("dropping message")
+			}
+		}
+	}
+}
+
+func (b *Multicast) writer() {
+	for {
+		var bs []byte
+		select {
+		case bs = <-b.inbox:
+		case <-b.stop:
+			return
+		}
+		intfs, err := b.join()
+		if err != nil {
+			l.Warnln("Multicast beacon: interfaces:", err)
+			continue
+		}
+
+		for _, intf := range intfs {
+			b.mut.Lock()
+			conn, ok := b.conns[intf]
+			b.mut.Unlock()
+			if !ok {
+				continue
+			}
+
+			// The zone selects the interface the packet goes out on
+			dst := &net.UDPAddr{IP: b.group.IP, Port: b.group.Port, Zone: intf}
+			_, err := conn.WriteTo(bs, dst)
+			if err != nil {
+				if debug {
+					l.Debugln(err)
+				}
+			} else if debug {
+				l.Debugf("sent %d bytes to %s", len(bs), dst)
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package beacon
+
+import "testing"
+
+func TestMulticastInvalidAddr(t *testing.T) {
+	for _, addr := range []string{"192.0.2.42:21026", "[fe80::1]:21026", "[ff32::5222]"} {
+		if _, err := NewMulticast(addr); err == nil {
+			t.Errorf("Unexpected nil error for %q", addr)
+		}
+	}
+}
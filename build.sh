@@ -88,7 +88,7 @@ zipDist() {
 
 deps() {
 	check
-	godep save ./cmd/syncthing ./cmd/assets ./discover/cmd/discosrv
+	godep save ./cmd/syncthing ./cmd/assets ./cmd/discosrv ./cmd/strelaysrv
 }
 
 setup() {
@@ -138,9 +138,11 @@ case "$1" in
 		test || exit 1
 		assets
 
-		godep go build ./discover/cmd/discosrv
+		godep go build ./cmd/discosrv
+		godep go build ./cmd/strelaysrv
 		godep go build ./cmd/stpidx
 		godep go build ./cmd/stcli
+		godep go build ./cmd/streplay
 
 		for os in darwin-amd64 linux-386 linux-amd64 freebsd-amd64 windows-amd64 windows-386 solaris-amd64 ; do
 			export GOOS=${os%-*}
@@ -3,6 +3,10 @@
 // found in the LICENSE file.
 
 // Package cid provides a manager for mappings between node ID:s and connection ID:s.
+//
+// Connection ID:s are small integers, handed out from zero and reused after
+// Clear, so that they can index dense structures such as bitsets. There is
+// no upper limit on them.
 package cid
 
 import "sync"
@@ -50,10 +54,15 @@ func (m *Map) Get(name string) uint {
 	return cid
 }
 
+// Name returns the node ID for the connection ID, or the empty string if the
+// connection ID is not in use.
 func (m *Map) Name(cid uint) string {
 	m.Lock()
 	defer m.Unlock()
 
+	if cid >= uint(len(m.toName)) {
+		return ""
+	}
 	return m.toName[cid]
 }
 
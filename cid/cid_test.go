@@ -4,7 +4,10 @@
 
 package cid
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestGet(t *testing.T) {
 	m := NewMap()
@@ -29,3 +32,24 @@ func TestGet(t *testing.T) {
 		t.Errorf("Unexpected id %d != %c", i, LocalID)
 	}
 }
+
+func TestManyNodes(t *testing.T) {
+	m := NewMap()
+
+	for i := 1; i <= 100; i++ {
+		if id := m.Get(fmt.Sprintf("node%d", i)); id != uint(i) {
+			t.Fatalf("Unexpected id %d != %d", id, i)
+		}
+	}
+	if n := m.Name(100); n != "node100" {
+		t.Errorf("Unexpected name %q", n)
+	}
+	if n := m.Name(101); n != "" {
+		t.Errorf("Unexpected name %q for unused id", n)
+	}
+
+	m.Clear("node70")
+	if id := m.Get("new"); id != 70 {
+		t.Errorf("Unexpected id %d != 70", id)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/calmh/syncthing/discover"
+	"github.com/golang/groupcache/lru"
+)
+
+const (
+	// A cookie is valid for the window it was made in and the next one.
+	cookieWindow = time.Minute
+
+	// A source that answered a challenge is not challenged again for this
+	// long.
+	verifiedFor = 24 * time.Hour
+)
+
+// A verifier makes sure announcements come from the address they appear
+// to come from, before they are registered. Announcements from a source
+// that hasn't been verified are held back and answered with a challenge
+// cookie, which only the real source receives. The announcement is
+// registered once the cookie is sent back. Otherwise anyone could register
+// any node at a forged source address.
+type verifier struct {
+	secret   []byte
+	verified *lru.Cache // source address -> time.Time verified
+	pending  *lru.Cache // source address -> discover.AnnounceV2 held back
+}
+
+func newVerifier() *verifier {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return &verifier{
+		secret:   secret,
+		verified: lru.New(65536),
+		pending:  lru.New(4096),
+	}
+}
+
+// cookie returns the cookie for the source in the window of the time.
+func (v *verifier) cookie(src *net.UDPAddr, t time.Time) []byte {
+	var window [8]byte
+	binary.BigEndian.PutUint64(window[:], uint64(t.Unix()/int64(cookieWindow/time.Second)))
+
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(src.String()))
+	mac.Write(window[:])
+	return mac.Sum(nil)
+}
+
+// isVerified returns true if the source answered a challenge recently.
+func (v *verifier) isVerified(src *net.UDPAddr, now time.Time) bool {
+	t, ok := v.verified.Get(src.String())
+	return ok && now.Sub(t.(time.Time)) < verifiedFor
+}
+
+// challenge holds back the announcement and returns the challenge to send
+// to its source.
+func (v *verifier) challenge(src *net.UDPAddr, pkt discover.AnnounceV2, now time.Time) discover.ChallengeV2 {
+	v.pending.Add(src.String(), pkt)
+	return discover.ChallengeV2{
+		Magic:  discover.ChallengeMagicV2,
+		Cookie: v.cookie(src, now),
+	}
+}
+
+// respond checks the cookie sent back by the source. If it is correct, the
+// source is verified and the announcement held back, if any, is returned.
+func (v *verifier) respond(src *net.UDPAddr, cookie []byte, now time.Time) (discover.AnnounceV2, bool) {
+	if !hmac.Equal(cookie, v.cookie(src, now)) && !hmac.Equal(cookie, v.cookie(src, now.Add(-cookieWindow))) {
+		return discover.AnnounceV2{}, false
+	}
+
+	key := src.String()
+	v.verified.Add(key, now)
+	pkt, ok := v.pending.Get(key)
+	if !ok {
+		return discover.AnnounceV2{}, false
+	}
+	v.pending.Remove(key)
+	return pkt.(discover.AnnounceV2), true
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/discover"
+)
+
+func TestVerifier(t *testing.T) {
+	v := newVerifier()
+	src := &net.UDPAddr{IP: net.IP{192, 0, 2, 42}, Port: 22000}
+	other := &net.UDPAddr{IP: net.IP{192, 0, 2, 42}, Port: 22001}
+	now := time.Now()
+	ann := discover.AnnounceV2{Magic: discover.AnnouncementMagicV2, This: discover.Node{ID: "NODE1"}}
+
+	if v.isVerified(src, now) {
+		t.Fatal("Unexpectedly verified")
+	}
+	ch := v.challenge(src, ann, now)
+	if ch.Magic != discover.ChallengeMagicV2 || len(ch.Cookie) == 0 {
+		t.Fatalf("Unexpected challenge %#v", ch)
+	}
+
+	// The cookie is only good from the source it was sent to
+	if _, ok := v.respond(other, ch.Cookie, now); ok {
+		t.Error("Cookie accepted from another source")
+	}
+	if v.isVerified(other, now) {
+		t.Error("Other source verified")
+	}
+
+	// ... and only for a while
+	if _, ok := v.respond(src, ch.Cookie, now.Add(2*cookieWindow)); ok {
+		t.Error("Expired cookie accepted")
+	}
+
+	got, ok := v.respond(src, ch.Cookie, now.Add(cookieWindow))
+	if !ok || got.This.ID != "NODE1" {
+		t.Fatalf("Held back announcement not returned: %#v, %v", got, ok)
+	}
+	if !v.isVerified(src, now) {
+		t.Error("Source not verified")
+	}
+	if v.isVerified(src, now.Add(cookieWindow+verifiedFor)) {
+		t.Error("Source verified for too long")
+	}
+
+	// The announcement is only returned once
+	if _, ok := v.respond(src, ch.Cookie, now); ok {
+		t.Error("Held back announcement returned twice")
+	}
+}
@@ -21,26 +21,33 @@ import (
 	"github.com/juju/ratelimit"
 )
 
+// Nodes are forgotten when they have not announced themselves for this
+// long.
+const maxNodeAge = 60 * time.Minute
+
 type node struct {
-	addresses []address
-	updated   time.Time
+	Addresses []address
+	Updated   time.Time
 }
 
 type address struct {
-	ip   []byte
-	port uint16
+	IP   []byte
+	Port uint16
 }
 
 var (
-	nodes     = make(map[string]node)
-	lock      sync.Mutex
-	queries   = 0
-	announces = 0
-	answered  = 0
-	limited   = 0
-	unknowns  = 0
-	debug     = false
-	limiter   = lru.New(1024)
+	db         store
+	ver        *verifier // nil unless verifying addresses
+	lock       sync.Mutex
+	queries    = 0
+	announces  = 0
+	answered   = 0
+	limited    = 0
+	unknowns   = 0
+	challenges = 0
+	deleted    = 0
+	debug      = false
+	limiter    = lru.New(1024)
 )
 
 func main() {
@@ -48,12 +55,16 @@ func main() {
 	var timestamp bool
 	var statsIntv int
 	var statsFile string
+	var dbFile string
+	var verify bool
 
 	flag.StringVar(&listen, "listen", ":22025", "Listen address")
 	flag.BoolVar(&debug, "debug", false, "Enable debug output")
 	flag.BoolVar(&timestamp, "timestamp", true, "Timestamp the log output")
 	flag.IntVar(&statsIntv, "stats-intv", 0, "Statistics output interval (s)")
 	flag.StringVar(&statsFile, "stats-file", "/var/log/discosrv.stats", "Statistics file name")
+	flag.StringVar(&dbFile, "db", "", "File to keep the announced nodes in across restarts (default in memory only)")
+	flag.BoolVar(&verify, "verify", false, "Challenge announcements to verify their source address")
 	flag.Parse()
 
 	log.SetOutput(os.Stdout)
@@ -61,12 +72,26 @@ func main() {
 		log.SetFlags(0)
 	}
 
+	if dbFile != "" {
+		fs, err := newFileStore(dbFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		db = fs
+	} else {
+		db = newMemoryStore()
+	}
+	if verify {
+		ver = newVerifier()
+	}
+
 	addr, _ := net.ResolveUDPAddr("udp", listen)
 	conn, err := net.ListenUDP("udp", addr)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	go cleanNodes()
 	if statsIntv > 0 {
 		go logStats(statsFile, statsIntv)
 	}
@@ -95,7 +120,10 @@ func main() {
 
 		switch magic {
 		case discover.AnnouncementMagicV2:
-			handleAnnounceV2(addr, buf)
+			handleAnnounceV2(conn, addr, buf)
+
+		case discover.ChallengeResponseMagicV2:
+			handleChallengeResponseV2(addr, buf)
 
 		case discover.QueryMagicV2:
 			handleQueryV2(conn, addr, buf)
@@ -136,7 +164,7 @@ func limit(addr *net.UDPAddr) bool {
 	return false
 }
 
-func handleAnnounceV2(addr *net.UDPAddr, buf []byte) {
+func handleAnnounceV2(conn *net.UDPConn, addr *net.UDPAddr, buf []byte) {
 	var pkt discover.AnnounceV2
 	err := pkt.UnmarshalXDR(buf)
 	if err != nil && err != io.EOF {
@@ -152,6 +180,49 @@ func handleAnnounceV2(addr *net.UDPAddr, buf []byte) {
 	announces++
 	lock.Unlock()
 
+	if ver != nil && !ver.isVerified(addr, time.Now()) {
+		ch := ver.challenge(addr, pkt, time.Now())
+		if debug {
+			log.Printf("-> %v challenge", addr)
+		}
+		if _, _, err := conn.WriteMsgUDP(ch.MarshalXDR(), nil, addr); err != nil {
+			log.Println("Challenge write:", err)
+		}
+		lock.Lock()
+		challenges++
+		lock.Unlock()
+		return
+	}
+
+	register(addr, pkt)
+}
+
+func handleChallengeResponseV2(addr *net.UDPAddr, buf []byte) {
+	if ver == nil {
+		lock.Lock()
+		unknowns++
+		lock.Unlock()
+		return
+	}
+
+	var pkt discover.ChallengeV2
+	if err := pkt.UnmarshalXDR(buf); err != nil {
+		log.Println("ChallengeV2 Unmarshal:", err)
+		log.Println(hex.Dump(buf))
+		return
+	}
+
+	ann, ok := ver.respond(addr, pkt.Cookie, time.Now())
+	if debug {
+		log.Printf("<- %v challenge response, pending announcement %v", addr, ok)
+	}
+	if ok {
+		register(addr, ann)
+	}
+}
+
+// register remembers the addresses of the announcing node.
+func register(addr *net.UDPAddr, pkt discover.AnnounceV2) {
 	ip := addr.IP.To4()
 	if ip == nil {
 		ip = addr.IP.To16()
@@ -164,18 +235,18 @@ func handleAnnounceV2(addr *net.UDPAddr, buf []byte) {
 			tip = ip
 		}
 		addrs = append(addrs, address{
-			ip:   tip,
-			port: addr.Port,
+			IP:   tip,
+			Port: addr.Port,
 		})
 	}
 
 	node := node{
-		addresses: addrs,
-		updated:   time.Now(),
+		Addresses: addrs,
+		Updated:   time.Now(),
 	}
 
 	lock.Lock()
-	nodes[pkt.This.ID] = node
+	db.put(pkt.This.ID, node)
 	lock.Unlock()
 }
 
@@ -192,19 +263,19 @@ func handleQueryV2(conn *net.UDPConn, addr *net.UDPAddr, buf []byte) {
 	}
 
 	lock.Lock()
-	node, ok := nodes[pkt.NodeID]
+	node, ok := db.get(pkt.NodeID)
 	queries++
 	lock.Unlock()
 
-	if ok && len(node.addresses) > 0 {
+	if ok && len(node.Addresses) > 0 {
 		ann := discover.AnnounceV2{
 			Magic: discover.AnnouncementMagicV2,
 			This: discover.Node{
 				ID: pkt.NodeID,
 			},
 		}
-		for _, addr := range node.addresses {
-			ann.This.Addresses = append(ann.This.Addresses, discover.Address{IP: addr.ip, Port: addr.port})
+		for _, addr := range node.Addresses {
+			ann.This.Addresses = append(ann.This.Addresses, discover.Address{IP: addr.IP, Port: addr.Port})
 		}
 		if debug {
 			log.Printf("-> %v %#v", addr, pkt)
@@ -222,6 +293,16 @@ func handleQueryV2(conn *net.UDPConn, addr *net.UDPAddr, buf []byte) {
 	}
 }
 
+// cleanNodes forgets the nodes that have not been announced for a while.
+func cleanNodes() {
+	for {
+		time.Sleep(time.Minute)
+		lock.Lock()
+		deleted += db.clean(maxNodeAge)
+		lock.Unlock()
+	}
+}
+
 func next(intv int) time.Time {
 	d := time.Duration(intv) * time.Second
 	t0 := time.Now()
@@ -240,16 +321,8 @@ func logStats(file string, intv int) {
 
 		lock.Lock()
 
-		var deleted = 0
-		for id, node := range nodes {
-			if time.Since(node.updated) > 60*time.Minute {
-				delete(nodes, id)
-				deleted++
-			}
-		}
-
-		fmt.Fprintf(f, "%d Nr:%d Ne:%d Qt:%d Qa:%d A:%d U:%d Lq:%d Lc:%d\n",
-			t.Unix(), len(nodes), deleted, queries, answered, announces, unknowns, limited, limiter.Len())
+		fmt.Fprintf(f, "%d Nr:%d Ne:%d Qt:%d Qa:%d A:%d U:%d Lq:%d Lc:%d C:%d\n",
+			t.Unix(), db.len(), deleted, queries, answered, announces, unknowns, limited, limiter.Len(), challenges)
 		f.Sync()
 
 		queries = 0
@@ -257,6 +330,8 @@ func logStats(file string, intv int) {
 		answered = 0
 		limited = 0
 		unknowns = 0
+		challenges = 0
+		deleted = 0
 
 		lock.Unlock()
 	}
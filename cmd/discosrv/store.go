@@ -0,0 +1,107 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"time"
+)
+
+// A store holds the addresses of the announced nodes. The caller
+// synchronizes access.
+type store interface {
+	get(id string) (node, bool)
+	put(id string, n node)
+	// clean forgets the nodes not announced within maxAge, and returns how
+	// many there were.
+	clean(maxAge time.Duration) int
+	len() int
+}
+
+type memoryStore map[string]node
+
+func newMemoryStore() memoryStore {
+	return make(memoryStore)
+}
+
+func (s memoryStore) get(id string) (node, bool) {
+	n, ok := s[id]
+	return n, ok
+}
+
+func (s memoryStore) put(id string, n node) {
+	s[id] = n
+}
+
+func (s memoryStore) clean(maxAge time.Duration) int {
+	var deleted int
+	for id, n := range s {
+		if time.Since(n.Updated) > maxAge {
+			delete(s, id)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+func (s memoryStore) len() int {
+	return len(s)
+}
+
+// A fileStore keeps the nodes in memory and saves them to a file when
+// cleaned, so that a restarted server still knows the nodes announced
+// before it went down, instead of for up to an announcement interval not
+// answering queries for them.
+type fileStore struct {
+	memoryStore
+	path string
+}
+
+// newFileStore returns a store with the nodes saved in the file, if it
+// exists.
+func newFileStore(path string) (*fileStore, error) {
+	s := &fileStore{newMemoryStore(), path}
+
+	fd, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	if err := json.NewDecoder(fd).Decode(&s.memoryStore); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileStore) clean(maxAge time.Duration) int {
+	deleted := s.memoryStore.clean(maxAge)
+	if err := s.save(); err != nil {
+		log.Println("Saving nodes:", err)
+	}
+	return deleted
+}
+
+// save writes the nodes to a temporary file that then replaces the
+// previous one, so that a crash never leaves a partial file.
+func (s *fileStore) save() error {
+	tmp := s.path + ".tmp"
+	fd, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(fd).Encode(s.memoryStore); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
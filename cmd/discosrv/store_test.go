@@ -0,0 +1,51 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discosrv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nodes.json")
+
+	s, err := newFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fresh := node{
+		Addresses: []address{{IP: []byte{192, 0, 2, 42}, Port: 22000}},
+		Updated:   time.Now().Round(time.Second),
+	}
+	s.put("NODE1", fresh)
+	s.put("NODE2", node{Updated: time.Now().Add(-2 * maxNodeAge)})
+
+	if deleted := s.clean(maxNodeAge); deleted != 1 {
+		t.Errorf("Cleaned %d nodes, expected 1", deleted)
+	}
+
+	s2, err := newFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.len() != 1 {
+		t.Errorf("Loaded %d nodes, expected 1", s2.len())
+	}
+	n, ok := s2.get("NODE1")
+	if !ok || !reflect.DeepEqual(n.Addresses, fresh.Addresses) || !n.Updated.Equal(fresh.Updated) {
+		t.Errorf("Loaded node %#v differs from %#v", n, fresh)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/vitrun/qart/qr"
+)
+
+// The number of light modules around the code, that scanners need to find
+// it.
+const qrQuietZone = 2
+
+// showID prints our node ID, optionally as a QR code that a mobile client
+// can scan instead of having the ID typed in.
+func showID(args []string) {
+	fs := flag.NewFlagSet("id", flag.ExitOnError)
+	asQR := fs.Bool("qr", false, "Show the node ID as a QR code")
+	fs.Parse(args)
+
+	cert, err := loadCert(confDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	myID := certID(cert.Certificate[0])
+
+	if !*asQR {
+		fmt.Println(myID)
+		return
+	}
+
+	code, err := qr.Encode(myID, qr.M)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.WriteString(qrText(code))
+	fmt.Println(myID)
+}
+
+// qrText renders the code for a terminal with a dark background, two
+// characters per module so that it comes out roughly square. Light modules
+// are drawn as blocks and dark ones left blank.
+func qrText(code *qr.Code) string {
+	var buf bytes.Buffer
+	for y := -qrQuietZone; y < code.Size+qrQuietZone; y++ {
+		for x := -qrQuietZone; x < code.Size+qrQuietZone; x++ {
+			if code.Black(x, y) {
+				buf.WriteString("  ")
+			} else {
+				buf.WriteString("██")
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
@@ -7,6 +7,7 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -21,6 +22,7 @@ var (
 	confDir string
 	target  string
 	get     string
+	server  string
 	pc      protocol.Connection
 )
 
@@ -29,18 +31,41 @@ func main() {
 	log.SetOutput(os.Stdout)
 
 	flag.StringVar(&cmd, "cmd", "idx", "Command")
-	flag.StringVar(&confDir, "home", ".", "Certificates directory")
+	flag.StringVar(&confDir, "home", ".", "Certificates and configuration directory")
 	flag.StringVar(&target, "target", "127.0.0.1:22000", "Target node")
 	flag.StringVar(&get, "get", "", "Get file")
 	flag.BoolVar(&exit, "exit", false, "Exit after command")
+	flag.StringVar(&server, "server", "announce.syncthing.net:22025", "Global discovery server")
+	flag.Usage = usage
 	flag.Parse()
 
+	if flag.Arg(0) == "id" {
+		showID(flag.Args()[1:])
+		return
+	}
+
+	if flag.Arg(0) == "ping" {
+		if flag.NArg() < 2 {
+			usage()
+			os.Exit(2)
+		}
+		if !ping(flag.Arg(1), flag.Args()[2:]) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	connect(target)
 
 	select {}
 }
 
-func connect(target string) {
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n  %s [options]\n  %s [options] id [--qr]\n  %s [options] ping <node ID> [address...]\n\nOptions:\n", os.Args[0], os.Args[0], os.Args[0])
+	flag.PrintDefaults()
+}
+
+func tlsConfig() *tls.Config {
 	cert, err := loadCert(confDir)
 	if err != nil {
 		log.Fatal(err)
@@ -48,7 +73,7 @@ func connect(target string) {
 
 	myID := string(certID(cert.Certificate[0]))
 
-	tlsCfg := &tls.Config{
+	return &tls.Config{
 		Certificates:           []tls.Certificate{cert},
 		NextProtos:             []string{"bep/1.0"},
 		ServerName:             myID,
@@ -57,14 +82,20 @@ func connect(target string) {
 		InsecureSkipVerify:     true,
 		MinVersion:             tls.VersionTLS12,
 	}
+}
 
-	conn, err := tls.Dial("tcp", target, tlsCfg)
+func connect(target string) {
+	conn, err := tls.Dial("tcp", target, tlsConfig())
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	remoteID := certID(conn.ConnectionState().PeerCertificates[0].Raw)
 
+	if _, err := protocol.ExchangeHello(conn, protocol.HelloMessage{ClientName: "stcli", ClientVersion: "dev"}); err != nil {
+		log.Fatal(err)
+	}
+
 	pc = protocol.NewConnection(remoteID, conn, conn, Model{})
 
 	select {}
@@ -83,7 +114,7 @@ func prtIndex(files []protocol.FileInfo) {
 }
 
 func (m Model) Index(nodeID string, repo string, files []protocol.FileInfo) {
-	log.Printf("Received index for repo %q", repo)
+	log.Printf("Received index for repo %q from %s", repo, nodeName(nodeID))
 	if cmd == "idx" {
 		prtIndex(files)
 		if get != "" {
@@ -106,23 +137,29 @@ func getFile(f protocol.FileInfo) {
 		log.Fatal(err)
 	}
 
+	// All blocks are requested at once, and the responses written in order
+	// as they arrive.
 	var offset int64
+	var responses []<-chan protocol.Response
 	for _, b := range f.Blocks {
 		log.Printf("Request %q %d - %d", f.Name, offset, offset+int64(b.Size))
-		bs, err := pc.Request("default", f.Name, offset, int(b.Size))
-		log.Printf(" - got %d bytes", len(bs))
-		if err != nil {
-			log.Fatal(err)
-		}
+		responses = append(responses, pc.RequestAsync("default", f.Name, offset, int(b.Size)))
 		offset += int64(b.Size)
-		fd.Write(bs)
+	}
+	for _, rc := range responses {
+		res := <-rc
+		log.Printf(" - got %d bytes", len(res.Data))
+		if res.Err != nil {
+			log.Fatal(res.Err)
+		}
+		fd.Write(res.Data)
 	}
 
 	fd.Close()
 }
 
 func (m Model) IndexUpdate(nodeID string, repo string, files []protocol.FileInfo) {
-	log.Printf("Received index update for repo %q", repo)
+	log.Printf("Received index update for repo %q from %s", repo, nodeName(nodeID))
 	if cmd == "idx" {
 		prtIndex(files)
 		if exit {
@@ -132,15 +169,15 @@ func (m Model) IndexUpdate(nodeID string, repo string, files []protocol.FileInfo
 }
 
 func (m Model) ClusterConfig(nodeID string, config protocol.ClusterConfigMessage) {
-	log.Println("Received cluster config")
+	log.Println("Received cluster config from", nodeName(nodeID))
 	log.Printf("%#v", config)
 }
 
 func (m Model) Request(nodeID, repo string, name string, offset int64, size int) ([]byte, error) {
-	log.Println("Received request")
+	log.Println("Received request from", nodeName(nodeID))
 	return nil, io.EOF
 }
 
 func (m Model) Close(nodeID string, err error) {
-	log.Println("Received close")
+	log.Printf("Received close from %s: %v", nodeName(nodeID), err)
 }
@@ -0,0 +1,32 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/calmh/syncthing/config"
+)
+
+var (
+	cfg     config.Configuration
+	cfgOnce sync.Once
+)
+
+// nodeName returns how the node is referred to in the output, by the name
+// given to it in the configuration in the home directory, if there is one.
+func nodeName(node string) string {
+	cfgOnce.Do(func() {
+		fd, err := os.Open(filepath.Join(confDir, "config.xml"))
+		if err != nil {
+			return
+		}
+		defer fd.Close()
+		cfg, _ = config.Load(fd, "")
+	})
+	return cfg.NodeName(node)
+}
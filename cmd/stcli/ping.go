@@ -0,0 +1,116 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/tls"
+	"io"
+	"log"
+	"net"
+	"time"
+
+	"github.com/calmh/syncthing/discover"
+	"github.com/calmh/syncthing/protocol"
+)
+
+const (
+	dialTimeout      = 10 * time.Second
+	handshakeTimeout = 10 * time.Second
+	pingTimeout      = 30 * time.Second
+)
+
+// ping tries to reach the node on the given addresses, or on those known to
+// the discovery server if there are none, and reports each step of the way
+// until one address answers a protocol level ping.
+func ping(node string, addrs []string) bool {
+	if len(addrs) == 0 {
+		log.Printf("lookup %s: asking %s", nodeName(node), server)
+		res, err := discover.Lookup(server, node)
+		if err != nil {
+			log.Printf("lookup %s: FAIL: %v", nodeName(node), err)
+			return false
+		}
+		if len(res) == 0 {
+			log.Printf("lookup %s: FAIL: node is not known to the discovery server", nodeName(node))
+			return false
+		}
+		log.Printf("lookup %s: ok: %v", nodeName(node), res)
+		addrs = res
+	}
+
+	tlsCfg := tlsConfig()
+	for _, addr := range addrs {
+		if pingAddr(node, addr, tlsCfg) {
+			return true
+		}
+	}
+	return false
+}
+
+func pingAddr(node, addr string, tlsCfg *tls.Config) bool {
+	conn, err := net.DialTimeout("tcp", addr, dialTimeout)
+	if err != nil {
+		log.Printf("%s: tcp: FAIL: %v", addr, err)
+		return false
+	}
+	defer conn.Close()
+	log.Printf("%s: tcp: ok", addr)
+
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	tc := tls.Client(conn, tlsCfg)
+	if err := tc.Handshake(); err != nil {
+		log.Printf("%s: tls: FAIL: %v", addr, err)
+		return false
+	}
+	conn.SetDeadline(time.Time{})
+	log.Printf("%s: tls: ok", addr)
+
+	certs := tc.ConnectionState().PeerCertificates
+	if len(certs) != 1 {
+		log.Printf("%s: id: FAIL: got %d certificates, expected one", addr, len(certs))
+		return false
+	}
+	if remoteID := certID(certs[0].Raw); remoteID != node {
+		log.Printf("%s: id: FAIL: answered by node %s", addr, nodeName(remoteID))
+		return false
+	}
+	log.Printf("%s: id: ok", addr)
+
+	tc.SetDeadline(time.Now().Add(protocol.HelloTimeout))
+	hello, err := protocol.ExchangeHello(tc, protocol.HelloMessage{ClientName: "stcli", ClientVersion: "dev"})
+	if err != nil {
+		log.Printf("%s: hello: FAIL: %v", addr, err)
+		return false
+	}
+	tc.SetDeadline(time.Time{})
+	log.Printf("%s: hello: ok (%s %s, protocol version %d)", addr, hello.ClientName, hello.ClientVersion, hello.Version)
+
+	pc := protocol.NewConnection(node, tc, tc, pingModel{})
+	res := make(chan bool, 1)
+	go func() {
+		res <- pc.Ping()
+	}()
+	select {
+	case ok := <-res:
+		if !ok {
+			log.Printf("%s: ping: FAIL: connection closed; is node %s in the remote configuration, and not already connected?", addr, tlsCfg.ServerName)
+			return false
+		}
+	case <-time.After(pingTimeout):
+		log.Printf("%s: ping: FAIL: no answer within %v", addr, pingTimeout)
+		return false
+	}
+	log.Printf("%s: ping: ok", addr)
+	return true
+}
+
+// pingModel ignores everything received during a ping.
+type pingModel struct{}
+
+func (pingModel) Index(string, string, []protocol.FileInfo)                  {}
+func (pingModel) IndexUpdate(string, string, []protocol.FileInfo)            {}
+func (pingModel) ClusterConfig(string, protocol.ClusterConfigMessage)        {}
+func (pingModel) Close(string, error)                                        {}
+func (pingModel) Request(string, string, string, int64, int) ([]byte, error) { return nil, io.EOF }
@@ -5,11 +5,10 @@
 package main
 
 import (
-	"crypto/sha256"
 	"crypto/tls"
-	"encoding/base32"
 	"path/filepath"
-	"strings"
+
+	"github.com/calmh/syncthing/protocol"
 )
 
 func loadCert(dir string) (tls.Certificate, error) {
@@ -17,8 +16,5 @@ func loadCert(dir string) (tls.Certificate, error) {
 }
 
 func certID(bs []byte) string {
-	hf := sha256.New()
-	hf.Write(bs)
-	id := hf.Sum(nil)
-	return strings.Trim(base32.StdEncoding.EncodeToString(id), "=")
+	return protocol.NewNodeID(bs).String()
 }
@@ -0,0 +1,205 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/tls"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/relay"
+)
+
+// A protocolConn is the TLS connection of a node in protocol mode, over
+// which it joins the relay and asks for sessions with other nodes.
+type protocolConn struct {
+	id   protocol.NodeID
+	conn *tls.Conn
+	wmut sync.Mutex
+}
+
+var (
+	joined    = make(map[protocol.NodeID]*protocolConn)
+	joinedMut sync.RWMutex
+)
+
+// protocolListener accepts protocol connections until the listener fails.
+func protocolListener(l net.Listener, cfg *tls.Config) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		full := maxConns > 0 && numConns >= maxConns
+		if !full {
+			numConns++
+		}
+		lock.Unlock()
+
+		if full {
+			if debug {
+				log.Println("Connection limit reached; rejecting", conn.RemoteAddr())
+			}
+			conn.Close()
+			continue
+		}
+
+		go handleProtocolConn(tls.Server(conn, cfg))
+	}
+}
+
+func handleProtocolConn(conn *tls.Conn) {
+	defer func() {
+		lock.Lock()
+		numConns--
+		lock.Unlock()
+	}()
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(messageTimeout))
+	if err := conn.Handshake(); err != nil {
+		if debug {
+			log.Println("Handshake:", conn.RemoteAddr(), err)
+		}
+		return
+	}
+	conn.SetDeadline(time.Time{})
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) != 1 {
+		if debug {
+			log.Println("No certificate from", conn.RemoteAddr())
+		}
+		return
+	}
+
+	c := &protocolConn{
+		id:   protocol.NewNodeID(certs[0].Raw),
+		conn: conn,
+	}
+	if debug {
+		log.Println("Protocol connection from", c.id, conn.RemoteAddr())
+	}
+	defer c.leave()
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(networkTimeout))
+		msg, err := relay.ReadMessage(conn)
+		if err != nil {
+			if debug {
+				log.Println("Read:", c.id, err)
+			}
+			return
+		}
+
+		switch msg := msg.(type) {
+		case relay.Ping:
+			c.send(relay.Pong{})
+
+		case relay.JoinRelayRequest:
+			c.join()
+
+		case relay.ConnectRequest:
+			c.connect(msg)
+
+		default:
+			c.send(relay.ResponseUnexpectedMessage)
+			return
+		}
+	}
+}
+
+// send writes the message to the node. It is safe to call from other
+// nodes' connections.
+func (c *protocolConn) send(msg interface{}) {
+	c.wmut.Lock()
+	defer c.wmut.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(networkTimeout))
+	if err := relay.WriteMessage(c.conn, msg); err != nil && debug {
+		log.Println("Write:", c.id, err)
+	}
+}
+
+// join makes the node available to other nodes, unless another connection
+// of the same node already is.
+func (c *protocolConn) join() {
+	joinedMut.Lock()
+	_, ok := joined[c.id]
+	if !ok {
+		joined[c.id] = c
+	}
+	joinedMut.Unlock()
+
+	if ok {
+		c.send(relay.ResponseAlreadyConnected)
+		return
+	}
+	if debug {
+		log.Println("Joined:", c.id)
+	}
+	c.send(relay.ResponseSuccess)
+}
+
+// leave makes the node unavailable, if it joined through this connection.
+func (c *protocolConn) leave() {
+	joinedMut.Lock()
+	if joined[c.id] == c {
+		delete(joined, c.id)
+	}
+	joinedMut.Unlock()
+}
+
+// connect sets up a session between the node and the requested one, and
+// invites both to it.
+func (c *protocolConn) connect(req relay.ConnectRequest) {
+	var id protocol.NodeID
+	if len(req.ID) != len(id) {
+		c.send(relay.ResponseNotFound)
+		return
+	}
+	copy(id[:], req.ID)
+
+	joinedMut.RLock()
+	target, ok := joined[id]
+	joinedMut.RUnlock()
+	if !ok {
+		c.send(relay.ResponseNotFound)
+		return
+	}
+
+	s := newSession()
+	if s == nil {
+		c.send(relay.ResponseLimitReached)
+		return
+	}
+	if debug {
+		log.Println("Session:", c.id, "->", target.id)
+	}
+
+	var addr []byte
+	if extAddress != nil {
+		addr = extAddress
+		if ip4 := extAddress.To4(); ip4 != nil {
+			addr = ip4
+		}
+	}
+	target.send(relay.SessionInvitation{
+		From:    c.id[:],
+		Key:     s.keys[1],
+		Address: addr,
+		Port:    sessionPort,
+	})
+	c.send(relay.SessionInvitation{
+		From:    target.id[:],
+		Key:     s.keys[0],
+		Address: addr,
+		Port:    sessionPort,
+	})
+}
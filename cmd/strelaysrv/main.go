@@ -0,0 +1,97 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	debug          = false
+	extAddress     net.IP // advertised in session invitations; nil for the address the node connected to
+	sessionPort    uint16
+	perSessionRate int // KiB/s in each direction; 0 for no limit
+	maxConns       int // protocol connections; 0 for no limit
+	maxSessions    int // pending and active sessions; 0 for no limit
+	networkTimeout time.Duration
+	messageTimeout time.Duration
+	startTime      = time.Now()
+
+	lock         sync.Mutex
+	numConns     = 0
+	bytesProxied int64
+)
+
+func main() {
+	var listen string
+	var sessionListen string
+	var statusListen string
+	var keyDir string
+	var extAddr string
+	var timestamp bool
+
+	flag.StringVar(&listen, "listen", ":22067", "Protocol listen address")
+	flag.StringVar(&sessionListen, "session-listen", ":22068", "Session listen address")
+	flag.StringVar(&statusListen, "status-srv", ":22070", "Status HTTP listen address; empty to disable")
+	flag.StringVar(&keyDir, "keys", ".", "Directory holding cert.pem and key.pem, created if missing")
+	flag.StringVar(&extAddr, "ext-address", "", "IP address to invite nodes to; default the address they connected to")
+	flag.IntVar(&perSessionRate, "per-session-rate", 0, "Rate limit in each direction of a session (KiB/s); 0 for no limit")
+	flag.IntVar(&maxConns, "max-conns", 0, "Maximum number of protocol connections; 0 for no limit")
+	flag.IntVar(&maxSessions, "max-sessions", 0, "Maximum number of sessions; 0 for no limit")
+	flag.DurationVar(&networkTimeout, "network-timeout", 2*time.Minute, "Idle time after which connections are closed")
+	flag.DurationVar(&messageTimeout, "message-timeout", time.Minute, "Time to complete a handshake or join a session")
+	flag.BoolVar(&debug, "debug", false, "Enable debug output")
+	flag.BoolVar(&timestamp, "timestamp", true, "Timestamp the log output")
+	flag.Parse()
+
+	log.SetOutput(os.Stdout)
+	if !timestamp {
+		log.SetFlags(0)
+	}
+
+	if extAddr != "" {
+		extAddress = net.ParseIP(extAddr)
+		if extAddress == nil {
+			log.Fatalf("Invalid external address %q", extAddr)
+		}
+	}
+
+	cert, err := loadOrCreateCert(keyDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Relay ID:", certID(cert.Certificate[0]))
+
+	pl, err := net.Listen("tcp", listen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sl, err := net.Listen("tcp", sessionListen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sessionPort = uint16(sl.Addr().(*net.TCPAddr).Port)
+
+	if statusListen != "" {
+		go statusService(statusListen)
+	}
+
+	go func() {
+		log.Fatal(sessionListener(sl))
+	}()
+	log.Fatal(protocolListener(pl, &tls.Config{
+		Certificates:           []tls.Certificate{cert},
+		NextProtos:             []string{"bep-relay"},
+		ClientAuth:             tls.RequestClientCert,
+		SessionTicketsDisabled: true,
+		MinVersion:             tls.VersionTLS12,
+	}))
+}
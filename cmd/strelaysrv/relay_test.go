@@ -0,0 +1,173 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/relay"
+)
+
+type testRelay struct {
+	protoAddr   string
+	sessionAddr string
+	dir         string
+}
+
+func newTestRelay(t *testing.T) *testRelay {
+	networkTimeout = time.Minute
+	messageTimeout = 5 * time.Second
+
+	dir, err := ioutil.TempDir("", "strelaysrv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := loadOrCreateCert(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pl, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sl, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionPort = uint16(sl.Addr().(*net.TCPAddr).Port)
+
+	go sessionListener(sl)
+	go protocolListener(pl, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequestClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	return &testRelay{pl.Addr().String(), sl.Addr().String(), dir}
+}
+
+// node connects to the relay in protocol mode with a new certificate.
+func (r *testRelay) node(t *testing.T, name string) (*tls.Conn, protocol.NodeID) {
+	dir := r.dir + "/" + name
+	os.Mkdir(dir, 0755)
+	cert, err := loadOrCreateCert(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := tls.Dial("tcp", r.protoAddr, &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, protocol.NewNodeID(cert.Certificate[0])
+}
+
+func request(t *testing.T, conn net.Conn, msg interface{}) interface{} {
+	if err := relay.WriteMessage(conn, msg); err != nil {
+		t.Fatal(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	resp, err := relay.ReadMessage(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func TestRelaySession(t *testing.T) {
+	r := newTestRelay(t)
+	defer os.RemoveAll(r.dir)
+
+	a, idA := r.node(t, "a")
+	defer a.Close()
+	b, idB := r.node(t, "b")
+	defer b.Close()
+
+	if resp := request(t, b, relay.ConnectRequest{ID: idA[:]}); resp != relay.ResponseNotFound {
+		t.Errorf("Unexpected response %v for node not joined", resp)
+	}
+	if resp := request(t, a, relay.JoinRelayRequest{}); resp != relay.ResponseSuccess {
+		t.Fatalf("Unexpected response %v to join", resp)
+	}
+	if resp := request(t, a, relay.Ping{}); resp != (relay.Pong{}) {
+		t.Errorf("Unexpected response %v to ping", resp)
+	}
+
+	a2, _ := r.node(t, "a")
+	defer a2.Close()
+	if resp := request(t, a2, relay.JoinRelayRequest{}); resp != relay.ResponseAlreadyConnected {
+		t.Errorf("Unexpected response %v to second join", resp)
+	}
+
+	invB, ok := request(t, b, relay.ConnectRequest{ID: idA[:]}).(relay.SessionInvitation)
+	if !ok || !bytes.Equal(invB.From, idA[:]) || invB.Port != sessionPort {
+		t.Fatalf("Unexpected invitation %+v", invB)
+	}
+	a.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msg, err := relay.ReadMessage(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invA, ok := msg.(relay.SessionInvitation)
+	if !ok || !bytes.Equal(invA.From, idB[:]) || bytes.Equal(invA.Key, invB.Key) {
+		t.Fatalf("Unexpected invitation %+v", msg)
+	}
+
+	sa := joinSession(t, r, invA)
+	defer sa.Close()
+	sb := joinSession(t, r, invB)
+	defer sb.Close()
+
+	// A key can only be used once
+	sc, err := net.Dial("tcp", r.sessionAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+	if resp := request(t, sc, relay.JoinSessionRequest{Key: invA.Key}); resp != relay.ResponseNotFound {
+		t.Errorf("Unexpected response %v for used key", resp)
+	}
+
+	for _, dir := range [][2]net.Conn{{sa, sb}, {sb, sa}} {
+		data := []byte("hello through the relay")
+		if _, err := dir[0].Write(data); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, len(data))
+		dir[1].SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, err := io.ReadFull(dir[1], buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(buf, data) {
+			t.Errorf("Relayed %q, expected %q", buf, data)
+		}
+	}
+}
+
+func joinSession(t *testing.T, r *testRelay, inv relay.SessionInvitation) net.Conn {
+	host, _, _ := net.SplitHostPort(r.sessionAddr)
+	conn, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(int(inv.Port))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp := request(t, conn, relay.JoinSessionRequest{Key: inv.Key}); resp != relay.ResponseSuccess {
+		t.Fatalf("Unexpected response %v to session join", resp)
+	}
+	conn.SetReadDeadline(time.Time{})
+	return conn
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/rand"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/relay"
+	"github.com/juju/ratelimit"
+)
+
+// A session joins the connections of two nodes, each of which presents its
+// own key. Sessions that are not joined by both nodes within the message
+// timeout are dropped.
+type session struct {
+	keys    [2][]byte
+	conns   [2]net.Conn
+	expired bool
+}
+
+const sessionKeySize = 32
+
+var (
+	sessions    = make(map[string]*session) // key -> session, for sessions waiting to be joined
+	numSessions = 0                         // pending and active sessions
+	numActive   = 0
+	sessionMut  sync.Mutex
+)
+
+// newSession returns a session waiting to be joined, or nil if the session
+// limit is reached.
+func newSession() *session {
+	s := &session{}
+	for i := range s.keys {
+		s.keys[i] = make([]byte, sessionKeySize)
+		if _, err := io.ReadFull(rand.Reader, s.keys[i]); err != nil {
+			log.Println("Session key:", err)
+			return nil
+		}
+	}
+
+	sessionMut.Lock()
+	defer sessionMut.Unlock()
+	if maxSessions > 0 && numSessions >= maxSessions {
+		return nil
+	}
+	numSessions++
+	for _, key := range s.keys {
+		sessions[string(key)] = s
+	}
+
+	time.AfterFunc(messageTimeout, s.expire)
+	return s
+}
+
+// expire drops the session unless both nodes have joined it.
+func (s *session) expire() {
+	sessionMut.Lock()
+	defer sessionMut.Unlock()
+	if s.conns[0] != nil && s.conns[1] != nil {
+		return
+	}
+	s.expired = true
+	numSessions--
+	for i, key := range s.keys {
+		delete(sessions, string(key))
+		if s.conns[i] != nil {
+			s.conns[i].Close()
+		}
+	}
+}
+
+// join adds the connection to the session with the key, and starts
+// relaying when it was the last one missing. It returns false if there is
+// no such session.
+func (s *session) join(key []byte, conn net.Conn) bool {
+	sessionMut.Lock()
+	defer sessionMut.Unlock()
+	if s.expired || sessions[string(key)] != s {
+		return false
+	}
+	delete(sessions, string(key))
+
+	for i := range s.keys {
+		if string(s.keys[i]) == string(key) {
+			s.conns[i] = conn
+		}
+	}
+	if s.conns[0] != nil && s.conns[1] != nil {
+		numActive++
+		go s.proxy()
+	}
+	return true
+}
+
+func (s *session) proxy() {
+	errs := make(chan error, 2)
+	go s.copy(s.conns[0], s.conns[1], errs)
+	go s.copy(s.conns[1], s.conns[0], errs)
+
+	// When either side is done the session is over
+	err := <-errs
+	if debug {
+		log.Println("Session ended:", err)
+	}
+	s.conns[0].Close()
+	s.conns[1].Close()
+	<-errs
+
+	sessionMut.Lock()
+	numActive--
+	numSessions--
+	sessionMut.Unlock()
+}
+
+func (s *session) copy(dst, src net.Conn, errs chan<- error) {
+	var r io.Reader = &timeoutReader{src}
+	if perSessionRate > 0 {
+		rate := float64(1024 * perSessionRate)
+		r = ratelimit.Reader(r, ratelimit.NewBucketWithRate(rate, int64(rate)))
+	}
+
+	buf := make([]byte, 65536)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			lock.Lock()
+			bytesProxied += int64(n)
+			lock.Unlock()
+
+			dst.SetWriteDeadline(time.Now().Add(networkTimeout))
+			if _, werr := dst.Write(buf[:n]); werr != nil {
+				errs <- werr
+				return
+			}
+		}
+		if err != nil {
+			errs <- err
+			return
+		}
+	}
+}
+
+// timeoutReader fails reads after the network timeout without data.
+type timeoutReader struct {
+	conn net.Conn
+}
+
+func (r *timeoutReader) Read(buf []byte) (int, error) {
+	r.conn.SetReadDeadline(time.Now().Add(networkTimeout))
+	return r.conn.Read(buf)
+}
+
+// sessionListener accepts session connections until the listener fails.
+func sessionListener(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go handleSessionConn(conn)
+	}
+}
+
+func handleSessionConn(conn net.Conn) {
+	conn.SetDeadline(time.Now().Add(messageTimeout))
+	msg, err := relay.ReadMessage(conn)
+	if err != nil {
+		if debug {
+			log.Println("Session read:", conn.RemoteAddr(), err)
+		}
+		conn.Close()
+		return
+	}
+
+	req, ok := msg.(relay.JoinSessionRequest)
+	if !ok {
+		relay.WriteMessage(conn, relay.ResponseUnexpectedMessage)
+		conn.Close()
+		return
+	}
+
+	sessionMut.Lock()
+	s, ok := sessions[string(req.Key)]
+	sessionMut.Unlock()
+	if !ok {
+		relay.WriteMessage(conn, relay.ResponseNotFound)
+		conn.Close()
+		return
+	}
+
+	if err := relay.WriteMessage(conn, relay.ResponseSuccess); err != nil {
+		conn.Close()
+		return
+	}
+	conn.SetDeadline(time.Time{})
+	if !s.join(req.Key, conn) {
+		conn.Close()
+	}
+}
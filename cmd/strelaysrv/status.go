@@ -0,0 +1,51 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+// statusService serves the relay status as JSON on /status, for monitoring
+// and for relay pool listings.
+func statusService(addr string) {
+	http.HandleFunc("/status", getStatus)
+	if err := http.ListenAndServe(addr, nil); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func getStatus(w http.ResponseWriter, r *http.Request) {
+	status := make(map[string]interface{})
+
+	lock.Lock()
+	status["numConnections"] = numConns
+	status["bytesProxied"] = bytesProxied
+	lock.Unlock()
+
+	joinedMut.RLock()
+	status["numJoined"] = len(joined)
+	joinedMut.RUnlock()
+
+	sessionMut.Lock()
+	status["numPendingSessions"] = numSessions - numActive
+	status["numActiveSessions"] = numActive
+	sessionMut.Unlock()
+
+	status["uptimeSeconds"] = int(time.Since(startTime).Seconds())
+	status["options"] = map[string]interface{}{
+		"perSessionRate": perSessionRate,
+		"maxConns":       maxConns,
+		"maxSessions":    maxSessions,
+		"networkTimeout": int(networkTimeout.Seconds()),
+		"messageTimeout": int(messageTimeout.Seconds()),
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Command streplay shows the contents of a protocol capture recorded with
+// STCAPTURE, or replays the incoming messages in it to a logging model.
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/calmh/syncthing/protocol"
+)
+
+var showFiles = flag.Bool("f", false, "Show files in indexes")
+
+func main() {
+	log.SetFlags(0)
+	log.SetOutput(os.Stdout)
+
+	replay := flag.Bool("r", false, "Replay incoming messages to a logging model")
+	flag.Parse()
+	name := flag.Arg(0)
+
+	fd, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer fd.Close()
+
+	if *replay {
+		if err := protocol.Replay(fd, logModel{}); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	cr := protocol.NewCaptureReader(fd)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		dir := "->"
+		if rec.Incoming {
+			dir = "<-"
+		}
+		prefix := rec.Time.Format("15:04:05.000000") + " " + dir + " " + rec.NodeID + " " + rec.Type.String()
+
+		switch rec.Type {
+		case protocol.CaptureIndex, protocol.CaptureIndexUpdate:
+			log.Printf("%s: Repo: %q, Files: %d", prefix, rec.Index.Repository, len(rec.Index.Files))
+			printFiles(rec.Index.Files)
+		case protocol.CaptureRequest:
+			log.Printf("%s: Repo: %q, Name: %q, Offset: %d, Size: %d", prefix, rec.Request.Repository, rec.Request.Name, rec.Request.Offset, rec.Request.Size)
+		case protocol.CaptureResponse:
+			log.Printf("%s: Repo: %q, Name: %q, Offset: %d, Data: %d bytes (%d recorded), Error: %q", prefix, rec.Request.Repository, rec.Request.Name, rec.Request.Offset, rec.DataLen, len(rec.Data), rec.Error)
+		case protocol.CaptureClusterConfig:
+			log.Printf("%s: Client: %s %s, Repos: %d, Options: %d", prefix, rec.Config.ClientName, rec.Config.ClientVersion, len(rec.Config.Repositories), len(rec.Config.Options))
+			for _, repo := range rec.Config.Repositories {
+				log.Printf("   Repo: %q, Nodes: %d", repo.ID, len(repo.Nodes))
+			}
+		case protocol.CaptureClose:
+			log.Printf("%s: %s", prefix, rec.Error)
+		}
+	}
+}
+
+func printFiles(files []protocol.FileInfo) {
+	if !*showFiles {
+		return
+	}
+	for _, file := range files {
+		log.Printf("   File: %q, Ver: %d, Flags: 0%o, Modified: %d, Blocks: %d", file.Name, file.Version, file.Flags, file.Modified, len(file.Blocks))
+	}
+}
+
+type logModel struct{}
+
+func (logModel) Index(nodeID string, repo string, files []protocol.FileInfo) {
+	log.Printf("Index: %s: %q: %d files", nodeID, repo, len(files))
+	printFiles(files)
+}
+
+func (logModel) IndexUpdate(nodeID string, repo string, files []protocol.FileInfo) {
+	log.Printf("IndexUpdate: %s: %q: %d files", nodeID, repo, len(files))
+	printFiles(files)
+}
+
+func (logModel) Request(nodeID, repo string, name string, offset int64, size int) ([]byte, error) {
+	log.Printf("Request: %s: %q: %q %d/%d", nodeID, repo, name, offset, size)
+	return nil, nil
+}
+
+func (logModel) ClusterConfig(nodeID string, config protocol.ClusterConfigMessage) {
+	log.Printf("ClusterConfig: %s: %#v", nodeID, config)
+}
+
+func (logModel) Close(nodeID string, err error) {
+	log.Printf("Close: %s: %v", nodeID, err)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/calmh/syncthing/logger"
+)
+
+// daemonize starts a detached copy of ourselves, with standard input and
+// output connected to the null device, and returns the process ID of it.
+// The copy is recognized by STDAEMONIZED being set in the environment.
+func daemonize() (int, error) {
+	pgm, err := exec.LookPath(os.Args[0])
+	if err != nil {
+		return 0, err
+	}
+
+	null, err := os.OpenFile(os.DevNull, os.O_RDWR, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer null.Close()
+
+	proc, err := os.StartProcess(pgm, os.Args, &os.ProcAttr{
+		Env:   append(os.Environ(), "STDAEMONIZED=1"),
+		Files: []*os.File{null, null, null},
+		Sys:   daemonSysProcAttr(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	pid := proc.Pid
+	proc.Release()
+	return pid, nil
+}
+
+func isDaemonized() bool {
+	return os.Getenv("STDAEMONIZED") != ""
+}
+
+// setupLogFile directs all log output to the given file, rotated when it
+// grows beyond maxSizeMiB and keeping maxFiles old files.
+func setupLogFile(name string, maxSizeMiB, maxFiles int) error {
+	f, err := logger.NewRotatingFile(name, int64(maxSizeMiB)<<20, maxFiles)
+	if err != nil {
+		return err
+	}
+	l.SetOutput(f)
+	log.SetOutput(f)
+	return nil
+}
+
+func writePidFile(name string) error {
+	return ioutil.WriteFile(name, []byte(fmt.Sprintf("%d\n", os.Getpid())), 0644)
+}
+
+// removePidFile removes the pid file, unless it has been taken over by
+// another process such as our restarted self.
+func removePidFile(name string) {
+	bs, err := ioutil.ReadFile(name)
+	if err != nil {
+		return
+	}
+	if pid, err := strconv.Atoi(strings.TrimSpace(string(bs))); err == nil && pid == os.Getpid() {
+		os.Remove(name)
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package main
+
+import "syscall"
+
+func daemonSysProcAttr() *syscall.SysProcAttr {
+	// Start a new session to detach from the controlling terminal.
+	return &syscall.SysProcAttr{Setsid: true}
+}
@@ -15,16 +15,21 @@ import (
 	"mime"
 	"net"
 	"net/http"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strconv"
 	"sync"
 	"time"
 
 	"crypto/tls"
+
 	"code.google.com/p/go.crypto/bcrypt"
 	"github.com/calmh/syncthing/auto"
 	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/engine"
+	"github.com/calmh/syncthing/events"
 	"github.com/calmh/syncthing/logger"
 	"github.com/calmh/syncthing/model"
 	"github.com/codegangsta/martini"
@@ -38,45 +43,59 @@ type guiError struct {
 
 var (
 	configInSync = true
+	configMut    sync.Mutex // serializes applying configurations
 	guiErrors    = []guiError{}
 	guiErrorsMut sync.Mutex
 	static       func(http.ResponseWriter, *http.Request, *log.Logger)
 	apiKey       string
+	eventSub     = events.NewBufferedSubscription(events.Default.Subscribe(events.AllEvents), 1000)
 )
 
 const (
 	unchangedPassword = "--password-unchanged--"
+
+	// A request for events returns after this long if nothing happens.
+	eventPollTimeout = time.Minute
 )
 
 func init() {
 	l.AddHandler(logger.LevelWarn, showGuiError)
 }
 
-func startGUI(cfg config.GUIConfiguration, assetDir string, m *model.Model) error {
+// startGUI starts the GUI and REST API listeners. HTTPS uses the
+// certificate in https-cert.pem if there is one, or else the node
+// certificate.
+func startGUI(cfg config.GUIConfiguration, assetDir string, m *model.Model, nodeCert tls.Certificate) error {
 	var listener net.Listener
 	var err error
-	if cfg.UseTLS {
-		cert, err := loadCert(confDir, "https-")
+	if ls := activatedListeners["gui"]; len(ls) > 0 {
+		listener = ls[0]
+	} else if cfg.Address != "" {
+		listener, err = net.Listen("tcp", cfg.Address)
 		if err != nil {
-			l.Infoln("Loading HTTPS certificate:", err)
-			l.Infoln("Creating new HTTPS certificate")
-			newCertificate(confDir, "https-")
-			cert, err = loadCert(confDir, "https-")
+			return err
 		}
+	}
+
+	if listener != nil && cfg.UseTLS {
+		cert, err := engine.LoadCert(confDir, "https-")
 		if err != nil {
-			return err
+			cert = nodeCert
 		}
 		tlsCfg := &tls.Config{
 			Certificates: []tls.Certificate{cert},
 			ServerName:   "syncthing",
 		}
-		listener, err = tls.Listen("tcp", cfg.Address, tlsCfg)
-		if err != nil {
-			return err
-		}
-	} else {
-		listener, err = net.Listen("tcp", cfg.Address)
+		listener = tls.NewListener(listener, tlsCfg)
+	}
+
+	var unixListener net.Listener
+	if cfg.UnixSocket != "" {
+		unixListener, err = listenUnix(cfg.UnixSocket, cfg.UnixSocketPerms)
 		if err != nil {
+			if listener != nil {
+				listener.Close()
+			}
 			return err
 		}
 	}
@@ -87,16 +106,46 @@ func startGUI(cfg config.GUIConfiguration, assetDir string, m *model.Model) erro
 		static = embeddedStatic()
 	}
 
+	apiKey = cfg.APIKey
+	loadCsrfTokens()
+
+	if listener != nil {
+		go http.Serve(listener, guiHandler(cfg, m, true))
+		guiChecks = append(guiChecks, newGUICheck(listener.Addr().String(), cfg.UseTLS, ""))
+	}
+	if unixListener != nil {
+		// Access to the unix socket is controlled by its file permissions,
+		// so there is no need for CSRF tokens or authentication.
+		go http.Serve(unixListener, guiHandler(cfg, m, false))
+		guiChecks = append(guiChecks, newGUICheck("", false, cfg.UnixSocket))
+	}
+
+	return nil
+}
+
+func guiHandler(cfg config.GUIConfiguration, m *model.Model, auth bool) http.Handler {
 	router := martini.NewRouter()
 	router.Get("/", getRoot)
+	router.Get("/meta.js", getMeta)
 	router.Get("/rest/version", restGetVersion)
 	router.Get("/rest/model", restGetModel)
 	router.Get("/rest/need", restGetNeed)
+	router.Get("/rest/completion", restGetCompletion)
+	router.Get("/rest/ignored", restGetIgnored)
+	router.Get("/rest/localchanged", restGetLocalChanged)
+	router.Get("/rest/browse", restGetBrowse)
+	router.Get("/rest/preview", restGetPreview)
+	router.Get("/rest/itemerrors", restGetItemErrors)
+	router.Get("/rest/retries", restGetPullRetries)
+	router.Get("/rest/conflicts", restGetConflicts)
+	router.Get("/rest/stats", restGetStats)
 	router.Get("/rest/connections", restGetConnections)
 	router.Get("/rest/config", restGetConfig)
 	router.Get("/rest/config/sync", restGetConfigInSync)
 	router.Get("/rest/system", restGetSystem)
+	router.Get("/rest/system/id.png", restGetIDQR)
 	router.Get("/rest/errors", restGetErrors)
+	router.Get("/rest/events", restGetEvents)
 	router.Get("/rest/discovery", restGetDiscovery)
 	router.Get("/rest/report", restGetReport)
 	router.Get("/qr/:text", getQR)
@@ -109,11 +158,18 @@ func startGUI(cfg config.GUIConfiguration, assetDir string, m *model.Model) erro
 	router.Post("/rest/error/clear", restClearErrors)
 	router.Post("/rest/discovery/hint", restPostDiscoveryHint)
 	router.Post("/rest/model/override", restPostOverride)
+	router.Post("/rest/model/revert", restPostRevert)
+	router.Post("/rest/model/pause", restPostPause)
+	router.Post("/rest/model/resume", restPostResume)
+	router.Post("/rest/node/pause", restPostPauseNode)
+	router.Post("/rest/node/resume", restPostResumeNode)
 
 	mr := martini.New()
-	mr.Use(csrfMiddleware)
-	if len(cfg.User) > 0 && len(cfg.Password) > 0 {
-		mr.Use(basic(cfg.User, cfg.Password))
+	if auth {
+		mr.Use(csrfMiddleware)
+		if len(cfg.User) > 0 && len(cfg.Password) > 0 {
+			mr.Use(basic(cfg.User, cfg.Password))
+		}
 	}
 	mr.Use(static)
 	mr.Use(martini.Recovery())
@@ -121,12 +177,33 @@ func startGUI(cfg config.GUIConfiguration, assetDir string, m *model.Model) erro
 	mr.Action(router.Handle)
 	mr.Map(m)
 
-	apiKey = cfg.APIKey
-	loadCsrfTokens()
+	return mr
+}
 
-	go http.Serve(listener, mr)
+// listenUnix listens on the unix socket at path, replacing any stale socket
+// left from a previous run, and sets the socket permissions to perms (an
+// octal string such as "0600"). Until then only the owner can connect.
+func listenUnix(path string, perms string) (net.Listener, error) {
+	mode, err := strconv.ParseUint(perms, 8, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unix socket permissions %q: %v", perms, err)
+	}
 
-	return nil
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+
+	listener, err := listenUnixPrivate(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
 }
 
 func getRoot(w http.ResponseWriter, r *http.Request) {
@@ -134,6 +211,17 @@ func getRoot(w http.ResponseWriter, r *http.Request) {
 	static(w, r, nil)
 }
 
+// getMeta serves what the GUI needs to know before it can make any REST
+// calls.
+func getMeta(w http.ResponseWriter) {
+	meta, _ := json.Marshal(map[string]string{
+		"nodeIDShort": myID[:5],
+	})
+	w.Header().Set("Content-Type", "application/javascript")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprintf(w, "var metadata = %s;\n", meta)
+}
+
 func restMiddleware(w http.ResponseWriter, r *http.Request) {
 	if len(r.URL.Path) >= 6 && r.URL.Path[:6] == "/rest/" {
 		w.Header().Set("Cache-Control", "no-cache")
@@ -149,9 +237,12 @@ func restGetModel(m *model.Model, w http.ResponseWriter, r *http.Request) {
 	var repo = qs.Get("repo")
 	var res = make(map[string]interface{})
 
-	for _, cr := range cfg.Repositories {
+	for _, cr := range m.Configuration().Repositories {
 		if cr.ID == repo {
 			res["invalid"] = cr.Invalid
+			if cr.ReceiveOnly {
+				res["localChangedFiles"] = len(m.LocalChangedFiles(repo))
+			}
 			break
 		}
 	}
@@ -167,7 +258,21 @@ func restGetModel(m *model.Model, w http.ResponseWriter, r *http.Request) {
 
 	res["inSyncFiles"], res["inSyncBytes"] = globalFiles-needFiles, globalBytes-needBytes
 
+	ignoredFiles, ignoredBytes := m.IgnoredSize(repo)
+	res["ignoredFiles"], res["ignoredBytes"] = ignoredFiles, ignoredBytes
+	res["completion"] = m.Completion(repo, "")
+
+	res["itemErrors"] = len(m.ItemErrors(repo))
+	res["pullRetries"] = len(m.PullRetries(repo))
+	res["conflicts"] = len(m.Conflicts(repo))
+
 	res["state"] = m.State(repo)
+	if sp, ok := m.ScanProgress(repo); ok {
+		res["scanDiscoveredFiles"] = sp.DiscoveredFiles
+		res["scanFiles"], res["scanBytes"] = sp.HashedFiles, sp.HashedBytes
+		res["scanTotalFiles"], res["scanTotalBytes"] = sp.TotalFiles, sp.TotalBytes
+		res["scanPercent"], res["scanETA"] = sp.Percent, int(sp.ETA.Seconds())
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(res)
@@ -179,6 +284,36 @@ func restPostOverride(m *model.Model, r *http.Request) {
 	m.Override(repo)
 }
 
+func restPostRevert(m *model.Model, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+	m.Revert(repo)
+}
+
+func restPostPause(m *model.Model, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+	m.PauseRepo(repo)
+}
+
+func restPostResume(m *model.Model, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+	m.ResumeRepo(repo)
+}
+
+func restPostPauseNode(m *model.Model, r *http.Request) {
+	var qs = r.URL.Query()
+	var node = qs.Get("node")
+	m.PauseNode(node)
+}
+
+func restPostResumeNode(m *model.Model, r *http.Request) {
+	var qs = r.URL.Query()
+	var node = qs.Get("node")
+	m.ResumeNode(node)
+}
+
 func restGetNeed(m *model.Model, w http.ResponseWriter, r *http.Request) {
 	var qs = r.URL.Query()
 	var repo = qs.Get("repo")
@@ -189,14 +324,116 @@ func restGetNeed(m *model.Model, w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(files)
 }
 
+func restGetCompletion(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+	var node = qs.Get("node")
+
+	res := map[string]int{
+		"completion": m.Completion(repo, node),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func restGetIgnored(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	files := m.IgnoredFilesRepo(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(files)
+}
+
+func restGetLocalChanged(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	files := m.LocalChangedFiles(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(files)
+}
+
+func restGetBrowse(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+	var prefix = qs.Get("prefix")
+
+	files := m.BrowseRepo(repo, prefix)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(files)
+}
+
+func restGetPreview(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	preview := m.Preview(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(preview)
+}
+
+func restGetStats(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	stats := m.ReuseStats(repo)
+	res := map[string]interface{}{
+		"reusedBytes":       stats.Reused,
+		"deduplicatedBytes": stats.Deduplicated,
+		"renamedBytes":      stats.Renamed,
+		"unchangedBytes":    stats.Unchanged,
+		"downloadedBytes":   stats.Downloaded,
+		"totalBytes":        stats.Total(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func restGetItemErrors(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	errs := m.ItemErrors(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(errs)
+}
+
+func restGetPullRetries(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	retries := m.PullRetries(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(retries)
+}
+
+func restGetConflicts(m *model.Model, w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	var repo = qs.Get("repo")
+
+	cs := m.Conflicts(repo)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cs)
+}
+
 func restGetConnections(m *model.Model, w http.ResponseWriter) {
 	var res = m.ConnectionStats()
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(res)
 }
 
-func restGetConfig(w http.ResponseWriter) {
-	encCfg := cfg
+func restGetConfig(m *model.Model, w http.ResponseWriter) {
+	encCfg := m.Configuration()
 	if encCfg.GUI.Password != "" {
 		encCfg.GUI.Password = unchangedPassword
 	}
@@ -212,7 +449,7 @@ func restPostConfig(req *http.Request, m *model.Model) {
 		if newCfg.GUI.Password == "" {
 			// Leave it empty
 		} else if newCfg.GUI.Password == unchangedPassword {
-			newCfg.GUI.Password = cfg.GUI.Password
+			newCfg.GUI.Password = m.Configuration().GUI.Password
 		} else {
 			hash, err := bcrypt.GenerateFromPassword([]byte(newCfg.GUI.Password), 0)
 			if err != nil {
@@ -222,77 +459,125 @@ func restPostConfig(req *http.Request, m *model.Model) {
 			}
 		}
 
-		// Figure out if any changes require a restart
-
-		if len(cfg.Repositories) != len(newCfg.Repositories) {
-			configInSync = false
-		} else {
-			om := cfg.RepoMap()
-			nm := newCfg.RepoMap()
-			for id := range om {
-				if !reflect.DeepEqual(om[id], nm[id]) {
-					configInSync = false
-					break
-				}
+		for i := range newCfg.Repositories {
+			repo := &newCfg.Repositories[i]
+			if err := repo.ExpandDirectory(); err != nil {
+				l.Warnf("Repository %q: directory %q: %v", repo.ID, repo.Directory, err)
+				repo.Invalid = "directory cannot be expanded"
 			}
 		}
 
-		if len(cfg.Nodes) != len(newCfg.Nodes) {
-			configInSync = false
-		} else {
-			om := cfg.NodeMap()
-			nm := newCfg.NodeMap()
-			for k := range om {
-				if _, ok := nm[k]; !ok {
-					configInSync = false
-					break
-				}
+		applyConfig(newCfg, m)
+	}
+}
+
+// applyConfig activates and saves the new configuration. Changes that cannot
+// be applied without a restart mark the configuration as not in sync, which
+// is returned.
+func applyConfig(newCfg config.Configuration, m *model.Model) bool {
+	configMut.Lock()
+	defer configMut.Unlock()
+
+	// The model may add nodes and repositories or pause them meanwhile
+	cfg := m.Configuration()
+
+	// Figure out if any changes require a restart
+
+	if len(cfg.Repositories) != len(newCfg.Repositories) {
+		configInSync = false
+	} else {
+		om := cfg.RepoMap()
+		nm := newCfg.RepoMap()
+		for id := range om {
+			// Pausing and resuming takes effect right away
+			or, nr := om[id], nm[id]
+			or.Paused = nr.Paused
+			if !reflect.DeepEqual(or, nr) {
+				configInSync = false
+				break
 			}
 		}
+	}
 
-		if newCfg.Options.URAccepted > cfg.Options.URAccepted {
-			// UR was enabled
-			newCfg.Options.URAccepted = usageReportVersion
-			err := sendUsageReport(m)
-			if err != nil {
-				l.Infoln("Usage report:", err)
+	if len(cfg.Nodes) != len(newCfg.Nodes) {
+		configInSync = false
+	} else {
+		om := cfg.NodeMap()
+		nm := newCfg.NodeMap()
+		for k := range om {
+			if _, ok := nm[k]; !ok {
+				configInSync = false
+				break
 			}
-			go usageReportingLoop(m)
-		} else if newCfg.Options.URAccepted < cfg.Options.URAccepted {
-			// UR was disabled
-			newCfg.Options.URAccepted = -1
-			stopUsageReporting()
 		}
+	}
 
-		if !reflect.DeepEqual(cfg.Options, newCfg.Options) || !reflect.DeepEqual(cfg.GUI, newCfg.GUI) {
-			configInSync = false
+	if newCfg.Options.URAccepted > cfg.Options.URAccepted {
+		// UR was enabled
+		newCfg.Options.URAccepted = usageReportVersion
+		err := sendUsageReport(m)
+		if err != nil {
+			l.Infoln("Usage report:", err)
 		}
+		go usageReportingLoop(m)
+	} else if newCfg.Options.URAccepted < cfg.Options.URAccepted {
+		// UR was disabled
+		newCfg.Options.URAccepted = -1
+		stopUsageReporting()
+	}
+
+	if !reflect.DeepEqual(cfg.Options, newCfg.Options) || !reflect.DeepEqual(cfg.GUI, newCfg.GUI) {
+		configInSync = false
+	}
 
-		// Activate and save
+	// Activate and save
 
-		cfg = newCfg
-		saveConfig()
+	m.ReplaceConfiguration(newCfg)
+	saveConfig(m.Configuration())
+
+	for _, repo := range newCfg.Repositories {
+		if repo.Paused {
+			m.PauseRepo(repo.ID)
+		} else {
+			m.ResumeRepo(repo.ID)
+		}
 	}
+	for _, node := range newCfg.Nodes {
+		if node.Paused {
+			m.PauseNode(node.NodeID)
+		}
+	}
+
+	return configInSync
 }
 
 func restGetConfigInSync(w http.ResponseWriter) {
-	json.NewEncoder(w).Encode(map[string]bool{"configInSync": configInSync})
+	configMut.Lock()
+	inSync := configInSync
+	configMut.Unlock()
+	json.NewEncoder(w).Encode(map[string]bool{"configInSync": inSync})
 }
 
-func restPostRestart(w http.ResponseWriter) {
+func restPostRestart(m *model.Model, w http.ResponseWriter) {
 	flushResponse(`{"ok": "restarting"}`, w)
-	go restart()
+	go func() {
+		m.CancelScans()
+		restart()
+	}()
 }
 
-func restPostReset(w http.ResponseWriter) {
+func restPostReset(m *model.Model, w http.ResponseWriter) {
 	flushResponse(`{"ok": "resetting repos"}`, w)
-	resetRepositories()
+	resetRepositories(m.Configuration().Repositories)
 	go restart()
 }
 
-func restPostShutdown(w http.ResponseWriter) {
+func restPostShutdown(m *model.Model, w http.ResponseWriter) {
 	flushResponse(`{"ok": "shutting down"}`, w)
-	go shutdown()
+	go func() {
+		m.CancelScans()
+		shutdown()
+	}()
 }
 
 func flushResponse(s string, w http.ResponseWriter) {
@@ -304,17 +589,17 @@ func flushResponse(s string, w http.ResponseWriter) {
 var cpuUsagePercent [10]float64 // The last ten seconds
 var cpuUsageLock sync.RWMutex
 
-func restGetSystem(w http.ResponseWriter) {
-	var m runtime.MemStats
-	runtime.ReadMemStats(&m)
+func restGetSystem(m *model.Model, w http.ResponseWriter) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
 
 	res := make(map[string]interface{})
 	res["myID"] = myID
 	res["goroutines"] = runtime.NumGoroutine()
-	res["alloc"] = m.Alloc
-	res["sys"] = m.Sys
+	res["alloc"] = mem.Alloc
+	res["sys"] = mem.Sys
 	res["tilde"] = expandTilde("~")
-	if cfg.Options.GlobalAnnEnabled && discoverer != nil {
+	if m.Configuration().Options.GlobalAnnEnabled && discoverer != nil {
 		res["extAnnounceOK"] = discoverer.ExtAnnounceOK()
 	}
 	cpuUsageLock.RLock()
@@ -335,6 +620,29 @@ func restGetErrors(w http.ResponseWriter) {
 	guiErrorsMut.Unlock()
 }
 
+func restGetEvents(w http.ResponseWriter, r *http.Request) {
+	var qs = r.URL.Query()
+	since, _ := strconv.Atoi(qs.Get("since"))
+	limit, _ := strconv.Atoi(qs.Get("limit"))
+
+	// Flush before blocking, to indicate that we've received the request
+	// and that it should not be retried.
+	w.Header().Set("Content-Type", "application/json")
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	evs := eventSub.Since(since, eventPollTimeout)
+	if limit > 0 && len(evs) > limit {
+		evs = evs[len(evs)-limit:]
+	}
+	if evs == nil {
+		evs = []events.Event{}
+	}
+
+	json.NewEncoder(w).Encode(evs)
+}
+
 func restPostError(req *http.Request) {
 	bs, _ := ioutil.ReadAll(req.Body)
 	req.Body.Close()
@@ -374,7 +682,16 @@ func restGetReport(w http.ResponseWriter, m *model.Model) {
 }
 
 func getQR(w http.ResponseWriter, params martini.Params) {
-	code, err := qr.Encode(params["text"], qr.M)
+	writeQR(w, params["text"])
+}
+
+// restGetIDQR returns our node ID as a QR code, for pairing by scanning it.
+func restGetIDQR(w http.ResponseWriter) {
+	writeQR(w, myID)
+}
+
+func writeQR(w http.ResponseWriter, text string) {
+	code, err := qr.Encode(text, qr.M)
 	if err != nil {
 		http.Error(w, "Invalid", 500)
 		return
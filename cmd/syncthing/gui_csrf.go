@@ -27,22 +27,34 @@ func csrfMiddleware(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if strings.HasPrefix(r.URL.Path, "/rest/") {
-		token := r.Header.Get("X-CSRF-Token")
+		token := r.Header.Get(csrfHeaderName())
 		if !validCsrfToken(token) {
 			http.Error(w, "CSRF Error", 403)
 		}
 	} else if r.URL.Path == "/" || r.URL.Path == "/index.html" {
-		cookie, err := r.Cookie("CSRF-Token")
+		cookie, err := r.Cookie(csrfCookieName())
 		if err != nil || !validCsrfToken(cookie.Value) {
 			cookie = &http.Cookie{
-				Name:  "CSRF-Token",
+				Name:  csrfCookieName(),
 				Value: newCsrfToken(),
+				Path:  "/",
 			}
 			http.SetCookie(w, cookie)
 		}
 	}
 }
 
+// The CSRF cookie and header are named after the node, as cookies are not
+// kept apart by port and several instances on the same host would
+// otherwise overwrite each other's tokens.
+func csrfCookieName() string {
+	return "CSRF-Token-" + myID[:5]
+}
+
+func csrfHeaderName() string {
+	return "X-CSRF-Token-" + myID[:5]
+}
+
 func validCsrfToken(token string) bool {
 	csrfMut.Lock()
 	defer csrfMut.Unlock()
@@ -100,6 +112,20 @@ func saveCsrfTokens() {
 	osutil.Rename(tmp, name)
 }
 
+// newAPIKey returns a random API key, for when none is configured.
+func newAPIKey() string {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	bs := make([]byte, 32)
+	_, err := rand.Reader.Read(bs)
+	if err != nil {
+		l.Fatalln(err)
+	}
+	for i := range bs {
+		bs[i] = chars[int(bs[i])%len(chars)]
+	}
+	return string(bs)
+}
+
 func loadCsrfTokens() {
 	name := filepath.Join(confDir, "csrftokens.txt")
 	f, err := os.Open(name)
@@ -7,9 +7,10 @@ package main
 import (
 	"crypto/sha1"
 	"crypto/tls"
+	"errors"
+	_ "expvar"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"math/rand"
 	"net"
@@ -27,12 +28,13 @@ import (
 
 	"github.com/calmh/syncthing/config"
 	"github.com/calmh/syncthing/discover"
+	"github.com/calmh/syncthing/engine"
 	"github.com/calmh/syncthing/logger"
 	"github.com/calmh/syncthing/model"
-	"github.com/calmh/syncthing/osutil"
 	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/service"
+	"github.com/calmh/syncthing/systemd"
 	"github.com/calmh/syncthing/upnp"
-	"github.com/juju/ratelimit"
 )
 
 var (
@@ -63,12 +65,27 @@ var (
 	cfg        config.Configuration
 	myID       string
 	confDir    string
+	keyType    string
 	logFlags   int = log.Ltime
-	rateBucket *ratelimit.Bucket
-	stop       = make(chan bool)
+	stop           = make(chan bool)
 	discoverer *discover.Discoverer
+	capture    *protocol.Capture
+
+	serviceMode      bool
+	restartRequested bool
+	pidFile          string
+
+	// Listeners passed by systemd socket activation. Those named "gui" are
+	// used for the GUI, all others for the sync protocol.
+	activatedListeners map[string][]net.Listener
 )
 
+var errRepoDirMissing = errors.New("repo directory missing")
+
+// Hosts with less memory than this scan one repository at a time, unless
+// the number of concurrent scans is configured.
+const lowMemorySize = 1 << 30
+
 const (
 	usage      = "syncthing [options]"
 	extraUsage = `The value for the -logflags option is a sum of the following:
@@ -90,14 +107,16 @@ The following enviroment variables are interpreted by syncthing:
                runit, launchd, etc.
 
  STPROFILER    Set to a listen address such as "127.0.0.1:9090" to start the
-               profiler with HTTP access.
+               profiler with HTTP access. Internal performance counters are
+               available as JSON under /debug/vars on the same address.
 
  STTRACE       A comma separated string of facilities to trace. The valid
                facility strings:
                - "beacon"   (the beacon package)
                - "discover" (the discover package)
                - "files"    (the files package)
-               - "net"      (the main package; connections & network messages)
+               - "net"      (connections & network messages)
+               - "engine"   (the engine package)
                - "model"    (the model package)
                - "scanner"  (the scanner package)
                - "upnp"     (the upnp package)
@@ -106,6 +125,12 @@ The following enviroment variables are interpreted by syncthing:
 
  STCPUPROFILE  Write CPU profile to the specified file.
 
+ STCAPTURE     Record all protocol messages to the specified file. Inspect
+               or replay the capture with streplay.
+
+ STCAPTUREDATA Truncate the response data recorded by STCAPTURE to this many
+               bytes. Recorded in full by default.
+
  STGUIASSETS   Directory to load GUI assets from. Overrides compiled in assets.`
 )
 
@@ -117,11 +142,27 @@ func main() {
 	var reset bool
 	var showVersion bool
 	var doUpgrade bool
+	var installService bool
+	var uninstallService bool
+	var runAsService bool
+	var runAsDaemon bool
+	var logFile string
+	var logMaxSize int
+	var logMaxFiles int
 	flag.StringVar(&confDir, "home", getDefaultConfDir(), "Set configuration directory")
 	flag.BoolVar(&reset, "reset", false, "Prepare to resync from cluster")
 	flag.BoolVar(&showVersion, "version", false, "Show version")
 	flag.BoolVar(&doUpgrade, "upgrade", false, "Perform upgrade")
 	flag.IntVar(&logFlags, "logflags", logFlags, "Set log flags")
+	flag.BoolVar(&installService, "install-service", false, "Install as a Windows service")
+	flag.BoolVar(&uninstallService, "uninstall-service", false, "Uninstall the Windows service")
+	flag.BoolVar(&runAsService, "service", false, "Run as a Windows service (used by the service manager)")
+	flag.BoolVar(&runAsDaemon, "daemon", false, "Run detached in the background, logging to -logfile")
+	flag.StringVar(&pidFile, "pidfile", "", "Write process ID to file")
+	flag.StringVar(&logFile, "logfile", "", "Log to file instead of standard output (default \"syncthing.log\" in the configuration directory with -daemon)")
+	flag.IntVar(&logMaxSize, "logmaxsize", 10, "Rotate the log file when it grows larger than this many MiB")
+	flag.IntVar(&logMaxFiles, "logmaxfiles", 3, "Number of rotated log files to keep")
+	flag.StringVar(&keyType, "keytype", engine.KeyTypeRSA, "Key type for new certificates, \"rsa\" or \"ecdsa\"; faster on slow CPUs")
 	flag.Usage = usageFor(flag.CommandLine, usage, extraUsage)
 	flag.Parse()
 
@@ -132,6 +173,10 @@ func main() {
 
 	l.SetFlags(logFlags)
 
+	if keyType != engine.KeyTypeRSA && keyType != engine.KeyTypeECDSA {
+		l.Fatalf("Unknown key type %q; must be %q or %q", keyType, engine.KeyTypeRSA, engine.KeyTypeECDSA)
+	}
+
 	if doUpgrade {
 		err := upgrade()
 		if err != nil {
@@ -140,6 +185,15 @@ func main() {
 		return
 	}
 
+	if runAsDaemon && !isDaemonized() {
+		pid, err := daemonize()
+		if err != nil {
+			l.Fatalln("Starting daemon:", err)
+		}
+		l.Okln("Started daemon with process ID", pid)
+		return
+	}
+
 	if len(os.Getenv("GOGC")) == 0 {
 		debug.SetGCPercent(25)
 	}
@@ -150,6 +204,28 @@ func main() {
 
 	confDir = expandTilde(confDir)
 
+	if installService {
+		dir, err := filepath.Abs(confDir)
+		if err != nil {
+			l.Fatalln(err)
+		}
+		err = service.Install(serviceName, "Syncthing", "Syncthing file synchronization", "-service", "-home", dir)
+		if err != nil {
+			l.Fatalln("Installing service:", err)
+		}
+		l.Okln("Installed service", serviceName)
+		return
+	}
+
+	if uninstallService {
+		err := service.Uninstall(serviceName)
+		if err != nil {
+			l.Fatalln("Uninstalling service:", err)
+		}
+		l.Okln("Uninstalled service", serviceName)
+		return
+	}
+
 	if _, err := os.Stat(confDir); err != nil && confDir == getDefaultConfDir() {
 		// We are supposed to use the default configuration directory. It
 		// doesn't exist. In the past our default has been ~/.syncthing, so if
@@ -171,17 +247,45 @@ func main() {
 		}
 	}
 
+	if logFile == "" && isDaemonized() {
+		ensureDir(confDir, 0700)
+		logFile = filepath.Join(confDir, "syncthing.log")
+	}
+	if logFile != "" {
+		if err := setupLogFile(logFile, logMaxSize, logMaxFiles); err != nil {
+			l.Fatalln("Log file:", err)
+		}
+	}
+
+	if pidFile != "" {
+		if err := writePidFile(pidFile); err != nil {
+			l.Fatalln("Pid file:", err)
+		}
+	}
+
+	if runAsService {
+		runService(reset)
+		return
+	}
+
+	syncthingMain(reset)
+}
+
+func syncthingMain(reset bool) {
+	handleSignals()
+
 	// Ensure that our home directory exists and that we have a certificate and key.
 
 	ensureDir(confDir, 0700)
-	cert, err := loadCert(confDir, "")
+	cert, err := engine.LoadCert(confDir, "")
 	if err != nil {
-		newCertificate(confDir, "")
-		cert, err = loadCert(confDir, "")
+		l.FatalErr(engine.NewCertificate(confDir, "", keyType))
+		l.Okln("Created certificate and key files")
+		cert, err = engine.LoadCert(confDir, "")
 		l.FatalErr(err)
 	}
 
-	myID = certID(cert.Certificate[0])
+	myID = engine.CertID(cert.Certificate[0])
 	l.SetPrefix(fmt.Sprintf("[%s] ", myID[:5]))
 
 	l.Infoln(LongVersion)
@@ -195,14 +299,13 @@ func main() {
 	// Load the configuration file, if it exists.
 	// If it does not, create a template.
 
-	cf, err := os.Open(cfgFile)
+	cfg, err = config.LoadFile(cfgFile, myID)
 	if err == nil {
-		// Read config.xml
-		cfg, err = config.Load(cf, myID)
-		if err != nil {
-			l.Fatalln(err)
+		if cfg.OriginalVersion < config.CurrentVersion {
+			saveConfig(cfg)
 		}
-		cf.Close()
+	} else if !os.IsNotExist(err) {
+		l.Fatalln(err)
 	} else {
 		l.Infoln("No config file; starting with empty defaults")
 		name, _ := os.Hostname()
@@ -212,9 +315,11 @@ func main() {
 		cfg, err = config.Load(nil, myID)
 		cfg.Repositories = []config.RepositoryConfiguration{
 			{
-				ID:        "default",
-				Directory: defaultRepo,
-				Nodes:     []config.NodeConfiguration{{NodeID: myID}},
+				ID:                "default",
+				Directory:         defaultRepo,
+				ExpandedDirectory: defaultRepo,
+				Nodes:             []config.NodeConfiguration{{NodeID: myID}},
+				RescanIntervalS:   cfg.Options.RescanIntervalS,
 			},
 		}
 		cfg.Nodes = []config.NodeConfiguration{
@@ -233,12 +338,24 @@ func main() {
 		l.FatalErr(err)
 		cfg.Options.ListenAddress = []string{fmt.Sprintf("0.0.0.0:%d", port)}
 
-		saveConfig()
+		saveConfig(cfg)
 		l.Infof("Edit %s to taste or use the GUI\n", cfgFile)
 	}
 
+	if cfg.GUI.APIKey == "" {
+		// Scripts can then use the REST API with the key from the
+		// configuration, without knowing the GUI password.
+		cfg.GUI.APIKey = newAPIKey()
+		saveConfig(cfg)
+	}
+
+	if cfg.Options.URAccepted > 0 && cfg.Options.URAccepted < usageReportVersion {
+		l.Infoln("Anonymous usage report has changed; revoking acceptance")
+		cfg.Options.URAccepted = 0
+	}
+
 	if reset {
-		resetRepositories()
+		resetRepositories(cfg.Repositories)
 		return
 	}
 
@@ -270,22 +387,32 @@ func main() {
 		MinVersion:             tls.VersionTLS12,
 	}
 
-	// If the write rate should be limited, set up a rate limiter for it.
-	// This will be used on connections created in the connect and listen routines.
-
-	if cfg.Options.MaxSendKbps > 0 {
-		rateBucket = ratelimit.NewBucketWithRate(float64(1000*cfg.Options.MaxSendKbps), int64(5*1000*cfg.Options.MaxSendKbps))
+	m := model.NewModel(confDir, &cfg, "syncthing", Version)
+	m.SetNodeID(myID)
+	if cfg.Options.MaxConcurrentScans == 0 {
+		// Scanning all repositories at once at startup may exhaust the
+		// memory of small devices
+		if mem, err := memorySize(); err == nil && mem < lowMemorySize {
+			l.Infof("Scanning one repository at a time; only %d MiB of memory", mem>>20)
+			m.SetMaxConcurrentScans(1)
+		}
 	}
+	reloadOnHangup(m, cfgFile)
 
-	m := model.NewModel(confDir, &cfg, "syncthing", Version)
+	// Nodes added by introducers are saved as they appear
+	go func() {
+		for _ = range m.ConfigChanged() {
+			saveConfig(m.Configuration())
+		}
+	}()
 
 nextRepo:
-	for i, repo := range cfg.Repositories {
+	for _, repo := range cfg.Repositories {
 		if repo.Invalid != "" {
 			continue
 		}
 
-		repo.Directory = expandTilde(repo.Directory)
+		repo.Directory = repo.ExpandedDirectory
 
 		// Safety check. If the cached index contains files but the repository
 		// doesn't exist, we have a problem. We would assume that all files
@@ -295,7 +422,7 @@ nextRepo:
 		idxFile := filepath.Join(confDir, id+".idx.gz")
 		if _, err := os.Stat(idxFile); err == nil {
 			if fi, err := os.Stat(repo.Directory); err != nil || !fi.IsDir() {
-				cfg.Repositories[i].Invalid = "repo directory missing"
+				m.InvalidateRepo(repo.ID, errRepoDirMissing)
 				continue nextRepo
 			}
 		}
@@ -304,38 +431,64 @@ nextRepo:
 		m.AddRepo(repo)
 	}
 
+	activatedListeners, err = systemd.Listeners()
+	if err != nil {
+		l.Warnln(err)
+	}
+
 	// GUI
-	if cfg.GUI.Enabled && cfg.GUI.Address != "" {
-		addr, err := net.ResolveTCPAddr("tcp", cfg.GUI.Address)
-		if err != nil {
-			l.Fatalf("Cannot start GUI on %q: %v", cfg.GUI.Address, err)
-		} else {
-			var hostOpen, hostShow string
-			switch {
-			case addr.IP == nil:
-				hostOpen = "localhost"
-				hostShow = "0.0.0.0"
-			case addr.IP.IsUnspecified():
-				hostOpen = "localhost"
-				hostShow = addr.IP.String()
-			default:
-				hostOpen = addr.IP.String()
-				hostShow = hostOpen
+	guiActivated := len(activatedListeners["gui"]) > 0
+	if cfg.GUI.Enabled && (cfg.GUI.Address != "" || cfg.GUI.UnixSocket != "" || guiActivated) {
+		var addr *net.TCPAddr
+		if guiActivated {
+			addr, _ = activatedListeners["gui"][0].Addr().(*net.TCPAddr)
+		} else if cfg.GUI.Address != "" {
+			var err error
+			addr, err = net.ResolveTCPAddr("tcp", cfg.GUI.Address)
+			if err != nil {
+				l.Fatalf("Cannot start GUI on %q: %v", cfg.GUI.Address, err)
 			}
+		}
 
-			var proto = "http"
-			if cfg.GUI.UseTLS {
-				proto = "https"
+		var hostOpen, hostShow string
+		switch {
+		case addr == nil:
+		case addr.IP == nil:
+			hostOpen = "localhost"
+			hostShow = "0.0.0.0"
+		case addr.IP.IsUnspecified():
+			hostOpen = "localhost"
+			hostShow = addr.IP.String()
+		default:
+			hostOpen = addr.IP.String()
+			hostShow = hostOpen
+		}
+
+		var proto = "http"
+		if cfg.GUI.UseTLS {
+			proto = "https"
+		}
+
+		if addr != nil && !addr.IP.IsLoopback() {
+			if cfg.GUI.User == "" || cfg.GUI.Password == "" {
+				l.Warnf("The web GUI on %s is reachable from other hosts but no GUI user and password is set", addr)
+			} else if !cfg.GUI.UseTLS {
+				l.Warnf("The web GUI on %s is reachable from other hosts without HTTPS; the GUI password is sent in the clear", addr)
 			}
+		}
 
+		if addr != nil {
 			l.Infof("Starting web GUI on %s://%s:%d/", proto, hostShow, addr.Port)
-			err := startGUI(cfg.GUI, os.Getenv("STGUIASSETS"), m)
-			if err != nil {
-				l.Fatalln("Cannot start GUI:", err)
-			}
-			if cfg.Options.StartBrowser && len(os.Getenv("STRESTART")) == 0 {
-				openURL(fmt.Sprintf("%s://%s:%d", proto, hostOpen, addr.Port))
-			}
+		}
+		if cfg.GUI.UnixSocket != "" {
+			l.Infof("Starting REST API on unix socket %s", cfg.GUI.UnixSocket)
+		}
+		err := startGUI(cfg.GUI, os.Getenv("STGUIASSETS"), m, cert)
+		if err != nil {
+			l.Fatalln("Cannot start GUI:", err)
+		}
+		if addr != nil && cfg.Options.StartBrowser && len(os.Getenv("STRESTART")) == 0 {
+			openURL(fmt.Sprintf("%s://%s:%d", proto, hostOpen, addr.Port))
 		}
 	}
 
@@ -348,11 +501,14 @@ nextRepo:
 	m.ScanRepos()
 	m.SaveIndexes(confDir)
 
+	// The model has the configuration from here on, and may change it
+	cfg := m.Configuration()
+
 	// Remove all .idx* files that don't belong to an active repo.
 
 	validIndexes := make(map[string]bool)
 	for _, repo := range cfg.Repositories {
-		dir := expandTilde(repo.Directory)
+		dir := repo.ExpandedDirectory
 		id := fmt.Sprintf("%x", sha1.Sum([]byte(dir)))
 		validIndexes[id] = true
 	}
@@ -377,11 +533,24 @@ nextRepo:
 	if cfg.Options.UPnPEnabled {
 		// We seed the random number generator with the node ID to get a
 		// repeatable sequence of random external ports.
-		externalPort = setupUPnP(rand.NewSource(certSeed(cert.Certificate[0])))
+		externalPort = setupUPnP(rand.NewSource(certSeed(cert.Certificate[0])), cfg.Options.ListenAddress)
+	}
+
+	if capfile := os.Getenv("STCAPTURE"); len(capfile) > 0 {
+		f, err := os.Create(capfile)
+		if err != nil {
+			l.Fatalln("Capture:", err)
+		}
+		maxData := -1
+		if v := os.Getenv("STCAPTUREDATA"); len(v) > 0 {
+			maxData, _ = strconv.Atoi(v)
+		}
+		capture = protocol.NewCapture(f, maxData)
+		l.Infoln("Recording protocol messages to", capfile)
 	}
 
 	// Routine to connect out to configured nodes
-	discoverer = discovery(externalPort)
+	discoverer = discovery(cfg.Options, externalPort)
 	go listenConnect(myID, m, tlsCfg)
 
 	for _, repo := range cfg.Repositories {
@@ -394,6 +563,9 @@ nextRepo:
 		if repo.ReadOnly {
 			l.Okf("Ready to synchronize %s (read only; no external updates accepted)", repo.ID)
 			m.StartRepoRO(repo.ID)
+		} else if repo.DryRun {
+			l.Okf("Ready to synchronize %s (dry run; external updates are previewed only)", repo.ID)
+			m.StartRepoRO(repo.ID)
 		} else {
 			l.Okf("Ready to synchronize %s (read-write)", repo.ID)
 			m.StartRepoRW(repo.ID, cfg.Options.ParallelRequests)
@@ -415,10 +587,6 @@ nextRepo:
 		}
 	}
 
-	if cfg.Options.URAccepted > 0 && cfg.Options.URAccepted < usageReportVersion {
-		l.Infoln("Anonymous usage report has changed; revoking acceptance")
-		cfg.Options.URAccepted = 0
-	}
 	if cfg.Options.URAccepted >= usageReportVersion {
 		go usageReportingLoop(m)
 		go func() {
@@ -430,15 +598,28 @@ nextRepo:
 		}()
 	}
 
+	systemd.Notify("READY=1\nSTATUS=Ready to synchronize")
+	if intv := systemd.WatchdogInterval(); intv > 0 {
+		go watchdogLoop(m, intv/2)
+	}
+
 	<-stop
+	systemd.Notify("STOPPING=1")
+	m.CancelScans()
+	m.CloseAll(protocol.CloseShutdown, "shutting down")
+	if pidFile != "" {
+		removePidFile(pidFile)
+	}
 	l.Okln("Exiting")
 }
 
 func waitForParentExit() {
 	l.Infoln("Waiting for parent to exit...")
 	// Wait for the listen address to become free, indicating that the parent has exited.
+	network, addr, err := config.ParseListenAddress(cfg.Options.ListenAddress[0])
+	l.FatalErr(err)
 	for {
-		ln, err := net.Listen("tcp", cfg.Options.ListenAddress[0])
+		ln, err := net.Listen(network, addr)
 		if err == nil {
 			ln.Close()
 			break
@@ -448,45 +629,60 @@ func waitForParentExit() {
 	l.Okln("Continuing")
 }
 
-func setupUPnP(r rand.Source) int {
-	var externalPort = 0
-	if len(cfg.Options.ListenAddress) == 1 {
-		_, portStr, err := net.SplitHostPort(cfg.Options.ListenAddress[0])
+func setupUPnP(r rand.Source, listenAddrs []string) int {
+	// UPnP maps IPv4 ports only; use the first listen address that may be
+	// IPv4
+	port := 0
+	for _, la := range listenAddrs {
+		network, addr, err := config.ParseListenAddress(la)
 		if err != nil {
 			l.Warnln(err)
-		} else {
-			// Set up incoming port forwarding, if necessary and possible
-			port, _ := strconv.Atoi(portStr)
-			igd, err := upnp.Discover()
-			if err == nil {
-				for i := 0; i < 10; i++ {
-					r := 1024 + int(r.Int63()%(65535-1024))
-					err := igd.AddPortMapping(upnp.TCP, r, port, "syncthing", 0)
-					if err == nil {
-						externalPort = r
-						l.Infoln("Created UPnP port mapping - external port", externalPort)
-						break
-					}
-				}
-				if externalPort == 0 {
-					l.Warnln("Failed to create UPnP port mapping")
-				}
-			} else {
-				l.Infof("No UPnP IGD device found, no port mapping created (%v)", err)
-			}
+			continue
+		}
+		if network == "tcp6" {
+			continue
+		}
+		_, portStr, _ := net.SplitHostPort(addr)
+		port, _ = strconv.Atoi(portStr)
+		break
+	}
+	if port == 0 {
+		l.Infoln("No IPv4 listen address; not attempting UPnP port mapping")
+		return 0
+	}
+
+	// Set up incoming port forwarding, if necessary and possible
+	var externalPort = 0
+	igd, err := upnp.Discover()
+	if err != nil {
+		l.Infof("No UPnP IGD device found, no port mapping created (%v)", err)
+		return 0
+	}
+	for i := 0; i < 10; i++ {
+		r := 1024 + int(r.Int63()%(65535-1024))
+		err := igd.AddPortMapping(upnp.TCP, r, port, "syncthing", 0)
+		if err == nil {
+			externalPort = r
+			l.Infoln("Created UPnP port mapping - external port", externalPort)
+			break
 		}
-	} else {
-		l.Warnln("Multiple listening addresses; not attempting UPnP port mapping")
+	}
+	if externalPort == 0 {
+		l.Warnln("Failed to create UPnP port mapping")
 	}
 	return externalPort
 }
 
-func resetRepositories() {
+func resetRepositories(repos []config.RepositoryConfiguration) {
 	suffix := fmt.Sprintf(".syncthing-reset-%d", time.Now().UnixNano())
-	for _, repo := range cfg.Repositories {
-		if _, err := os.Stat(repo.Directory); err == nil {
-			l.Infof("Reset: Moving %s -> %s", repo.Directory, repo.Directory+suffix)
-			os.Rename(repo.Directory, repo.Directory+suffix)
+	for _, repo := range repos {
+		dir := repo.ExpandedDirectory
+		if dir == "" {
+			continue
+		}
+		if _, err := os.Stat(dir); err == nil {
+			l.Infof("Reset: Moving %s -> %s", dir, dir+suffix)
+			os.Rename(dir, dir+suffix)
 		}
 	}
 
@@ -502,8 +698,15 @@ func resetRepositories() {
 
 func restart() {
 	l.Infoln("Restarting")
-	if os.Getenv("SMF_FMRI") != "" || os.Getenv("STNORESTART") != "" {
-		// Solaris SMF
+	if serviceMode {
+		// The service manager restarts us when we exit with an error.
+		l.Infoln("Running as a service; exit for the service manager to restart")
+		restartRequested = true
+		stop <- true
+		return
+	}
+	if os.Getenv("SMF_FMRI") != "" || os.Getenv("STNORESTART") != "" || systemd.Supervised() {
+		// Solaris SMF, systemd
 		l.Infoln("Service manager detected; exit instead of restart")
 		stop <- true
 		return
@@ -533,192 +736,75 @@ func shutdown() {
 	stop <- true
 }
 
-var saveConfigCh = make(chan struct{})
+var saveConfigCh = make(chan config.Configuration)
 
 func saveConfigLoop(cfgFile string) {
-	for _ = range saveConfigCh {
-		fd, err := os.Create(cfgFile + ".tmp")
-		if err != nil {
-			l.Warnln(err)
-			continue
-		}
-
-		err = config.Save(fd, cfg)
-		if err != nil {
-			l.Warnln(err)
-			fd.Close()
-			continue
-		}
-
-		err = fd.Close()
-		if err != nil {
-			l.Warnln(err)
-			continue
-		}
-
-		err = osutil.Rename(cfgFile+".tmp", cfgFile)
-		if err != nil {
+	for cfg := range saveConfigCh {
+		if err := config.SaveFile(cfgFile, cfg); err != nil {
 			l.Warnln(err)
 		}
 	}
 }
 
-func saveConfig() {
-	saveConfigCh <- struct{}{}
+// saveConfig saves a copy of cfg. Once the model runs, the configuration
+// to save is to be taken from it.
+func saveConfig(cfg config.Configuration) {
+	saveConfigCh <- cfg.Copy()
 }
 
 func listenConnect(myID string, m *model.Model, tlsCfg *tls.Config) {
-	var conns = make(chan *tls.Conn)
-
-	// Listen
-	for _, addr := range cfg.Options.ListenAddress {
-		addr := addr
-		go func() {
-			if debugNet {
-				l.Debugln("listening on", addr)
-			}
-			listener, err := tls.Listen("tcp", addr, tlsCfg)
-			l.FatalErr(err)
-
-			for {
-				conn, err := listener.Accept()
-				if err != nil {
-					l.Warnln(err)
-					continue
-				}
-
-				if debugNet {
-					l.Debugln("connect from", conn.RemoteAddr())
-				}
-
-				tc := conn.(*tls.Conn)
-				err = tc.Handshake()
-				if err != nil {
-					l.Warnln(err)
-					tc.Close()
-					continue
-				}
-
-				conns <- tc
-			}
-		}()
-	}
-
-	// Connect
-	go func() {
-		var delay time.Duration = 1 * time.Second
-		for {
-		nextNode:
-			for _, nodeCfg := range cfg.Nodes {
-				if nodeCfg.NodeID == myID {
-					continue
-				}
-				if m.ConnectedTo(nodeCfg.NodeID) {
-					continue
-				}
-
-				var addrs []string
-				for _, addr := range nodeCfg.Addresses {
-					if addr == "dynamic" {
-						if discoverer != nil {
-							t := discoverer.Lookup(nodeCfg.NodeID)
-							if len(t) == 0 {
-								continue
-							}
-							addrs = append(addrs, t...)
-						}
-					} else {
-						addrs = append(addrs, addr)
-					}
-				}
-
-				for _, addr := range addrs {
-					host, port, err := net.SplitHostPort(addr)
-					if err != nil && strings.HasPrefix(err.Error(), "missing port") {
-						// addr is on the form "1.2.3.4"
-						addr = net.JoinHostPort(addr, "22000")
-					} else if err == nil && port == "" {
-						// addr is on the form "1.2.3.4:"
-						addr = net.JoinHostPort(host, "22000")
-					}
-					if debugNet {
-						l.Debugln("dial", nodeCfg.NodeID, addr)
-					}
-					conn, err := tls.Dial("tcp", addr, tlsCfg)
-					if err != nil {
-						if debugNet {
-							l.Debugln(err)
-						}
-						continue
-					}
-
-					conns <- conn
-					continue nextNode
-				}
-			}
-
-			time.Sleep(delay)
-			delay *= 2
-			if maxD := time.Duration(cfg.Options.ReconnectIntervalS) * time.Second; delay > maxD {
-				delay = maxD
-			}
-		}
-	}()
-
-next:
-	for conn := range conns {
-		certs := conn.ConnectionState().PeerCertificates
-		if cl := len(certs); cl != 1 {
-			l.Infof("Got peer certificate list of length %d != 1 from %s; protocol error", cl, conn.RemoteAddr())
-			conn.Close()
+	var listeners []net.Listener
+	for name, ls := range activatedListeners {
+		if name == "gui" {
 			continue
 		}
-		remoteID := certID(certs[0].Raw)
-
-		if remoteID == myID {
-			l.Infof("Connected to myself (%s) - should not happen", remoteID)
-			conn.Close()
-			continue
+		for _, listener := range ls {
+			l.Infoln("Using socket activated listener", listener.Addr())
+			listeners = append(listeners, tls.NewListener(listener, tlsCfg))
 		}
-
-		if m.ConnectedTo(remoteID) {
-			l.Infof("Connected to already connected node (%s)", remoteID)
-			conn.Close()
-			continue
-		}
-
-		for _, nodeCfg := range cfg.Nodes {
-			if nodeCfg.NodeID == remoteID {
-				var wr io.Writer = conn
-				if rateBucket != nil {
-					wr = &limitedWriter{conn, rateBucket}
-				}
-				protoConn := protocol.NewConnection(remoteID, conn, wr, m)
-				m.AddConnection(conn, protoConn)
-				continue next
+	}
+	if len(listeners) == 0 {
+		for _, addr := range m.Configuration().Options.ListenAddress {
+			network, laddr, err := config.ParseListenAddress(addr)
+			l.FatalErr(err)
+			if debugNet {
+				l.Debugln("listening on", network, laddr)
 			}
+			listener, err := tls.Listen(network, laddr, tlsCfg)
+			l.FatalErr(err)
+			listeners = append(listeners, listener)
 		}
+	}
 
-		l.Infof("Connection from %s with unknown node ID %s; ignoring", conn.RemoteAddr(), remoteID)
-		conn.Close()
+	c := &engine.Connector{
+		MyID:          myID,
+		Model:         m,
+		TLSConfig:     tlsCfg,
+		Listeners:     listeners,
+		Discoverer:    discoverer,
+		AddressCache:  filepath.Join(confDir, "addresses.txt"),
+		ClientName:    "syncthing",
+		ClientVersion: Version,
+		Capture:       capture,
 	}
+	c.Serve()
 }
 
-func discovery(extPort int) *discover.Discoverer {
-	disc, err := discover.NewDiscoverer(myID, cfg.Options.ListenAddress, cfg.Options.LocalAnnPort)
+func discovery(opts config.OptionsConfiguration, extPort int) *discover.Discoverer {
+	disc, err := discover.NewDiscoverer(myID, config.ListenHostPorts(opts.ListenAddress), opts.LocalAnnPort, opts.LocalAnnMCAddr)
 	if err != nil {
 		l.Warnf("No discovery possible (%v)", err)
 		return nil
 	}
 
-	if cfg.Options.LocalAnnEnabled {
+	if opts.LocalAnnEnabled {
 		l.Infoln("Sending local discovery announcements")
 		disc.StartLocal()
 	}
 
-	if cfg.Options.GlobalAnnEnabled {
+	if opts.GlobalAnnEnabled {
 		l.Infoln("Sending global discovery announcements")
-		disc.StartGlobal(cfg.Options.GlobalAnnServer, uint16(extPort))
+		disc.StartGlobal(opts.GlobalAnnServer, uint16(extPort))
 	}
 
 	return disc
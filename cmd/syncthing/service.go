@@ -0,0 +1,49 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+
+	"github.com/calmh/syncthing/logger"
+	"github.com/calmh/syncthing/service"
+)
+
+const serviceName = "syncthing"
+
+var errRestart = errors.New("restart requested")
+
+// runService runs syncthing under the Windows service manager, logging to
+// the event log in addition to the usual output.
+func runService(reset bool) {
+	serviceMode = true
+
+	h, err := service.EventLogHandler(serviceName)
+	if err != nil {
+		l.Warnln("Event log:", err)
+	} else {
+		for _, level := range []logger.LogLevel{logger.LevelInfo, logger.LevelOK, logger.LevelWarn, logger.LevelFatal} {
+			l.AddHandler(level, h)
+		}
+	}
+
+	err = service.Run(serviceName, func(svcStop <-chan struct{}) error {
+		go func() {
+			<-svcStop
+			l.Infoln("Stop requested by the service manager")
+			shutdown()
+		}()
+
+		syncthingMain(reset)
+
+		if restartRequested {
+			return errRestart
+		}
+		return nil
+	})
+	if err != nil {
+		l.Fatalln(err)
+	}
+}
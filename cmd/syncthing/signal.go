@@ -0,0 +1,61 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/model"
+)
+
+// handleSignals shuts down cleanly on SIGINT and SIGTERM, so that for
+// example the pid file is removed.
+func handleSignals() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigs
+		l.Infof("Received %v; shutting down", sig)
+		shutdown()
+	}()
+}
+
+// reloadOnHangup reloads the configuration file on SIGHUP and applies it the
+// same way as a configuration posted to the REST API.
+func reloadOnHangup(m *model.Model, cfgFile string) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	go func() {
+		for _ = range sigs {
+			l.Infoln("Received SIGHUP; reloading", cfgFile)
+			newCfg, err := loadConfigFile(cfgFile)
+			if err != nil {
+				l.Warnln("Not reloading configuration:", err)
+				continue
+			}
+			if !applyConfig(newCfg, m) {
+				l.Infoln("Some configuration changes require a restart to take effect")
+			}
+		}
+	}()
+}
+
+// loadConfigFile loads and validates the configuration file.
+func loadConfigFile(cfgFile string) (config.Configuration, error) {
+	newCfg, err := config.LoadFile(cfgFile, myID)
+	if err != nil {
+		return config.Configuration{}, err
+	}
+	for _, repo := range newCfg.Repositories {
+		if repo.Invalid != "" {
+			return config.Configuration{}, fmt.Errorf("repository %q: %s", repo.ID, repo.Invalid)
+		}
+	}
+	return newCfg, nil
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package main
+
+import "net"
+
+// listenUnixPrivate listens on the unix socket at path. There are no
+// permission bits to restrict it with.
+func listenUnixPrivate(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
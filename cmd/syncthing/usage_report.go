@@ -5,25 +5,29 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
 	"runtime"
 	"strings"
 	"time"
 
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/engine"
 	"github.com/calmh/syncthing/model"
 )
 
 // Current version number of the usage report, for acceptance purposes. If
 // fields are added or changed this integer must be incremented so that users
 // are prompted for acceptance of the new report.
-const usageReportVersion = 1
+const usageReportVersion = 2
 
 var stopUsageReportingCh = make(chan struct{})
 
 func reportData(m *model.Model) map[string]interface{} {
+	cfg := m.Configuration()
 	res := make(map[string]interface{})
-	res["uniqueID"] = strings.ToLower(certID([]byte(myID)))[:6]
+	res["uniqueID"] = strings.ToLower(engine.CertID([]byte(myID)))[:6]
 	res["version"] = Version
 	res["platform"] = runtime.GOOS + "-" + runtime.GOARCH
 	res["numRepos"] = len(cfg.Repositories)
@@ -66,6 +70,52 @@ func reportData(m *model.Model) map[string]interface{} {
 		res["memorySize"] = bytes / 1024 / 1024
 	}
 
+	res["features"] = featureCounts(cfg)
+
+	return res
+}
+
+// featureCounts returns how many repositories and nodes use each of the
+// optional features, and which global options are on.
+func featureCounts(cfg config.Configuration) map[string]int {
+	var res = make(map[string]int)
+	count := func(name string, used bool) {
+		if used {
+			res[name]++
+		} else if _, ok := res[name]; !ok {
+			res[name] = 0
+		}
+	}
+
+	for _, repo := range cfg.Repositories {
+		count("repoReadOnly", repo.ReadOnly)
+		count("repoReceiveOnly", repo.ReceiveOnly)
+		count("repoDryRun", repo.DryRun)
+		count("repoPaused", repo.Paused)
+		count("repoIgnorePerms", repo.IgnorePerms)
+		count("repoSyncACLs", repo.SyncACLs)
+		count("repoSyncOrder", len(repo.SyncOrderPatterns) > 0)
+		count("repoPullOrder", repo.PullOrder != "")
+		count("repoVersioning", repo.Versioning.Type != "")
+	}
+
+	for _, node := range cfg.Nodes {
+		count("nodeIntroducer", node.Introducer)
+		count("nodePaused", node.Paused)
+		count("nodeRateLimit", node.MaxSendKbps > 0 || node.MaxRecvKbps > 0)
+		count("nodeStaticAddress", len(node.Addresses) > 0 && node.Addresses[0] != "dynamic")
+	}
+
+	count("globalAnnounce", cfg.Options.GlobalAnnEnabled)
+	count("localAnnounce", cfg.Options.LocalAnnEnabled)
+	count("upnp", cfg.Options.UPnPEnabled)
+	count("watchFilesystem", cfg.Options.WatchFilesystem)
+	count("rateLimit", cfg.Options.MaxSendKbps > 0 || cfg.Options.MaxRecvKbps > 0)
+	count("memoryLimit", cfg.Options.MaxMemoryMiB > 0)
+	count("guiTLS", cfg.GUI.UseTLS)
+	count("guiAuth", cfg.GUI.User != "" && cfg.GUI.Password != "")
+	count("guiUnixSocket", cfg.GUI.UnixSocket != "")
+
 	return res
 }
 
@@ -74,8 +124,9 @@ func sendUsageReport(m *model.Model) error {
 	var b bytes.Buffer
 	json.NewEncoder(&b).Encode(d)
 
+	url := m.Configuration().Options.URURL
 	var client = http.DefaultClient
-	if BuildEnv == "android" {
+	if BuildEnv == "android" && strings.HasPrefix(url, "https://data.syncthing.net/") {
 		// This works around the lack of DNS resolution on Android... :(
 		tr := &http.Transport{
 			Dial: func(network, addr string) (net.Conn, error) {
@@ -84,8 +135,15 @@ func sendUsageReport(m *model.Model) error {
 		}
 		client = &http.Client{Transport: tr}
 	}
-	_, err := client.Post("https://data.syncthing.net/newdata", "application/json", &b)
-	return err
+	resp, err := client.Post(url, "application/json", &b)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return nil
 }
 
 func usageReportingLoop(m *model.Model) {
@@ -0,0 +1,145 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/calmh/syncthing/events"
+	"github.com/calmh/syncthing/model"
+	"github.com/calmh/syncthing/systemd"
+)
+
+// guiChecks are the GUI listeners the watchdog makes a request to, added by
+// startGUI.
+var guiChecks []guiCheck
+
+type guiCheck struct {
+	url    string
+	client *http.Client
+}
+
+// newGUICheck returns a check of the GUI listening at addr, on the unix
+// socket at path if it is not empty.
+func newGUICheck(addr string, useTLS bool, path string) guiCheck {
+	tr := &http.Transport{
+		// Only that the GUI answers is checked, not who it is.
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+		DisableKeepAlives: true,
+	}
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+	if path != "" {
+		tr.Dial = func(string, string) (net.Conn, error) {
+			return net.Dial("unix", path)
+		}
+		addr = "localhost"
+	}
+	return guiCheck{
+		url:    fmt.Sprintf("%s://%s/rest/version", scheme, addr),
+		client: &http.Client{Transport: tr},
+	}
+}
+
+// watchdogLoop notifies the systemd watchdog every intv, as long as the
+// process is alive: the model can be locked, events are delivered to the
+// GUI's subscription and the GUI answers requests, each within half the
+// interval. Otherwise the notification is left out, and systemd restarts
+// the service once the watchdog timeout passes without one.
+func watchdogLoop(m *model.Model, intv time.Duration) {
+	for {
+		start := time.Now()
+		if err := checkAlive(m, intv/2); err != nil {
+			l.Warnln("Not notifying the watchdog:", err)
+		} else {
+			systemd.Notify("WATCHDOG=1")
+		}
+		time.Sleep(intv - time.Since(start))
+	}
+}
+
+func checkAlive(m *model.Model, timeout time.Duration) error {
+	probes := map[string]func() error{
+		"model": func() error {
+			m.Ping()
+			return nil
+		},
+		"event delivery": func() error {
+			return pingEvents(timeout)
+		},
+	}
+	for _, gc := range guiChecks {
+		gc := gc
+		probes["GUI at "+gc.url] = func() error {
+			resp, err := gc.client.Get(gc.url)
+			if err != nil {
+				return err
+			}
+			// Any answer will do, as requests without a CSRF token
+			// or credentials are refused.
+			resp.Body.Close()
+			return nil
+		}
+	}
+	return runProbes(probes, timeout)
+}
+
+// runProbes runs the probes concurrently and returns the first error, or
+// an error naming a probe that does not return within the timeout.
+func runProbes(probes map[string]func() error, timeout time.Duration) error {
+	type result struct {
+		name string
+		err  error
+	}
+	// Buffered, so that a probe returning late does not block forever
+	results := make(chan result, len(probes))
+	for name, probe := range probes {
+		go func(name string, probe func() error) {
+			results <- result{name, probe()}
+		}(name, probe)
+	}
+
+	deadline := time.After(timeout)
+	done := make(map[string]bool)
+	for len(done) < len(probes) {
+		select {
+		case r := <-results:
+			if r.err != nil {
+				return fmt.Errorf("%s: %v", r.name, r.err)
+			}
+			done[r.name] = true
+		case <-deadline:
+			for name := range probes {
+				if !done[name] {
+					return fmt.Errorf("%s does not answer within %v", name, timeout)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pingEvents logs a Ping event and waits for it to reach the subscription
+// the GUI polls.
+func pingEvents(timeout time.Duration) error {
+	sub := events.Default.Subscribe(events.Ping)
+	defer events.Default.Unsubscribe(sub)
+	events.Default.Log(events.Ping, nil)
+	e, err := sub.Poll(timeout)
+	if err != nil {
+		return err
+	}
+	if len(eventSub.Since(e.ID-1, timeout)) == 0 {
+		return errors.New("ping event not delivered")
+	}
+	return nil
+}
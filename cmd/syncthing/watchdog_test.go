@@ -0,0 +1,49 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunProbes(t *testing.T) {
+	ok := func() error { return nil }
+	failing := func() error { return errors.New("failed") }
+	stuck := make(chan struct{})
+	defer close(stuck)
+	hanging := func() error {
+		<-stuck
+		return nil
+	}
+
+	var tests = []struct {
+		probes map[string]func() error
+		alive  bool
+	}{
+		{map[string]func() error{}, true},
+		{map[string]func() error{"a": ok, "b": ok}, true},
+		{map[string]func() error{"a": ok, "b": failing}, false},
+		{map[string]func() error{"a": ok, "b": hanging}, false},
+	}
+
+	for i, tc := range tests {
+		start := time.Now()
+		err := runProbes(tc.probes, 50*time.Millisecond)
+		if alive := err == nil; alive != tc.alive {
+			t.Errorf("#%d: unexpected result %v", i, err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("#%d: took %v", i, d)
+		}
+	}
+}
+
+func TestPingEvents(t *testing.T) {
+	if err := pingEvents(time.Second); err != nil {
+		t.Error(err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -18,18 +19,24 @@ import (
 
 	"code.google.com/p/go.crypto/bcrypt"
 	"github.com/calmh/syncthing/logger"
+	"github.com/calmh/syncthing/protocol"
 	"github.com/calmh/syncthing/scanner"
 )
 
 var l = logger.DefaultLogger
 
+// CurrentVersion is the version of the configuration that Save writes.
+// Configurations of older versions are migrated when loaded.
+const CurrentVersion = 3
+
 type Configuration struct {
-	Version      int                       `xml:"version,attr" default:"2"`
-	Repositories []RepositoryConfiguration `xml:"repository"`
-	Nodes        []NodeConfiguration       `xml:"node"`
-	GUI          GUIConfiguration          `xml:"gui"`
-	Options      OptionsConfiguration      `xml:"options"`
-	XMLName      xml.Name                  `xml:"configuration" json:"-"`
+	Version         int                       `xml:"version,attr" default:"3"` // Always CurrentVersion after loading
+	OriginalVersion int                       `xml:"-" json:"-"`               // The version before migration, set at load time
+	Repositories    []RepositoryConfiguration `xml:"repository"`
+	Nodes           []NodeConfiguration       `xml:"node"`
+	GUI             GUIConfiguration          `xml:"gui"`
+	Options         OptionsConfiguration      `xml:"options"`
+	XMLName         xml.Name                  `xml:"configuration" json:"-"`
 }
 
 // SyncOrderPattern allows a user to prioritize file downloading based on a
@@ -61,17 +68,36 @@ func (s *SyncOrderPattern) CompiledPattern() *regexp.Regexp {
 
 type RepositoryConfiguration struct {
 	ID                string                  `xml:"id,attr"`
-	Directory         string                  `xml:"directory,attr"`
+	Directory         string                  `xml:"directory,attr"` // May contain ~, $VAR and %{hostname}
+	ExpandedDirectory string                  `xml:"-"`              // Set at load time from Directory, not saved
 	Nodes             []NodeConfiguration     `xml:"node"`
 	ReadOnly          bool                    `xml:"ro,attr"`
+	DryRun            bool                    `xml:"dryRun,attr"`      // Changes from the cluster are previewed, but not applied
+	ReceiveOnly       bool                    `xml:"receiveOnly,attr"` // Changes from the cluster are applied, but local changes are not sent
+	Paused            bool                    `xml:"paused,attr"`      // Neither scanned, pulled nor sent until resumed
 	IgnorePerms       bool                    `xml:"ignorePerms,attr"`
-	Invalid           string                  `xml:"-"` // Set at runtime when there is an error, not saved
+	SyncACLs          bool                    `xml:"syncACLs,attr"` // Requires all nodes sharing the repository to support ACLs
+	Invalid           string                  `xml:"-"`             // Set at runtime when there is an error, not saved
 	Versioning        VersioningConfiguration `xml:"versioning"`
+	Priority          int                     `xml:"priority,attr"` // Higher priority repositories are served first by the worker scheduler
 	SyncOrderPatterns []SyncOrderPattern      `xml:"syncorder>pattern"`
+	PullOrder         string                  `xml:"pullOrder,attr,omitempty"` // The order of the needed files within the same sync order priority; see the PullOrder constants
+	RescanIntervalS   int                     `xml:"rescanIntervalS,attr"`     // Time between full rescans; 0 for none, leaving the watcher and manual rescans
 
 	nodeIDs []string
 }
 
+// The orders in which the needed files of a repository can be pulled. The
+// empty string means PullOrderRandom.
+const (
+	PullOrderRandom        = "random"
+	PullOrderAlphabetic    = "alphabetic"
+	PullOrderSmallestFirst = "smallestFirst"
+	PullOrderLargestFirst  = "largestFirst"
+	PullOrderOldestFirst   = "oldestFirst"
+	PullOrderNewestFirst   = "newestFirst"
+)
+
 type VersioningConfiguration struct {
 	Type   string `xml:"type,attr"`
 	Params map[string]string
@@ -122,6 +148,19 @@ func (r *RepositoryConfiguration) NodeIDs() []string {
 	return r.nodeIDs
 }
 
+// AddNode adds the node to the nodes sharing the repository, unless it is
+// already present. It returns true if the node was added.
+func (r *RepositoryConfiguration) AddNode(nodeID string) bool {
+	for _, n := range r.Nodes {
+		if n.NodeID == nodeID {
+			return false
+		}
+	}
+	r.Nodes = append(r.Nodes, NodeConfiguration{NodeID: nodeID})
+	r.nodeIDs = nil
+	return true
+}
+
 func (r RepositoryConfiguration) FileRanker() func(scanner.File) int {
 	if len(r.SyncOrderPatterns) <= 0 {
 		return nil
@@ -138,25 +177,47 @@ func (r RepositoryConfiguration) FileRanker() func(scanner.File) int {
 }
 
 type NodeConfiguration struct {
-	NodeID    string   `xml:"id,attr"`
-	Name      string   `xml:"name,attr,omitempty"`
-	Addresses []string `xml:"address,omitempty"`
+	NodeID      string   `xml:"id,attr"`
+	Name        string   `xml:"name,attr,omitempty"` // Nickname set by the user or by an introducer; never taken from the node itself
+	Addresses   []string `xml:"address,omitempty"`
+	Compression string   `xml:"compression,attr,omitempty"` // Messages compressed when sent to the node; "always" (the default), "metadata" or "never"
+	Introducer  bool     `xml:"introducer,attr"`            // The nodes this node shares repositories with are added to ours
+	AutoAccept  bool     `xml:"autoAcceptRepos,attr"`       // Repositories this node shares with us are added under Options.DefaultRepoPath
+	Paused      bool     `xml:"paused,attr"`                // Not connected to until resumed
+	MaxSendKbps int      `xml:"maxSendKbps,attr,omitempty"` // Limit on the data sent to the node, within the global limit; 0 for no limit
+	MaxRecvKbps int      `xml:"maxRecvKbps,attr,omitempty"` // Limit on the data received from the node, within the global limit; 0 for no limit
 }
 
 type OptionsConfiguration struct {
-	ListenAddress      []string `xml:"listenAddress" default:"0.0.0.0:22000"`
-	GlobalAnnServer    string   `xml:"globalAnnounceServer" default:"announce.syncthing.net:22025"`
-	GlobalAnnEnabled   bool     `xml:"globalAnnounceEnabled" default:"true"`
-	LocalAnnEnabled    bool     `xml:"localAnnounceEnabled" default:"true"`
-	LocalAnnPort       int      `xml:"localAnnouncePort" default:"21025"`
-	ParallelRequests   int      `xml:"parallelRequests" default:"16"`
-	MaxSendKbps        int      `xml:"maxSendKbps"`
-	RescanIntervalS    int      `xml:"rescanIntervalS" default:"60"`
-	ReconnectIntervalS int      `xml:"reconnectionIntervalS" default:"60"`
-	MaxChangeKbps      int      `xml:"maxChangeKbps" default:"10000"`
-	StartBrowser       bool     `xml:"startBrowser" default:"true"`
-	UPnPEnabled        bool     `xml:"upnpEnabled" default:"true"`
-	URAccepted         int      `xml:"urAccepted"` // Accepted usage reporting version; 0 for off (undecided), -1 for off (permanently)
+	ListenAddress          []string `xml:"listenAddress" default:"0.0.0.0:22000"`
+	GlobalAnnServer        string   `xml:"globalAnnounceServer" default:"announce.syncthing.net:22025"`
+	GlobalAnnEnabled       bool     `xml:"globalAnnounceEnabled" default:"true"`
+	LocalAnnEnabled        bool     `xml:"localAnnounceEnabled" default:"true"`
+	LocalAnnPort           int      `xml:"localAnnouncePort" default:"21025"`
+	LocalAnnMCAddr         string   `xml:"localAnnounceMCAddr" default:"[ff32::5222]:21026"` // IPv6 multicast group for local discovery; empty for IPv4 broadcasts only
+	ParallelRequests       int      `xml:"parallelRequests" default:"16"`
+	MaxSendKbps            int      `xml:"maxSendKbps"`
+	MaxRecvKbps            int      `xml:"maxRecvKbps"`                  // Limit on the data received from all nodes; 0 for no limit
+	RescanIntervalS        int      `xml:"rescanIntervalS" default:"60"` // The rescan interval of new repositories
+	ReconnectIntervalS     int      `xml:"reconnectionIntervalS" default:"60"`
+	MaxChangeKbps          int      `xml:"maxChangeKbps" default:"10000"`
+	StartBrowser           bool     `xml:"startBrowser" default:"true"`
+	UPnPEnabled            bool     `xml:"upnpEnabled" default:"true"`
+	URAccepted             int      `xml:"urAccepted"`                                         // Accepted usage reporting version; 0 for off (undecided), -1 for off (permanently)
+	URURL                  string   `xml:"urURL" default:"https://data.syncthing.net/newdata"` // Where usage reports are sent
+	DefaultRepoPath        string   `xml:"defaultRepoPath" default:"~/Sync/%{repo}"`           // Where automatically accepted repositories are created; %{repo} is the repository ID
+	MaxConcurrentScans     int      `xml:"maxConcurrentScans"`                                 // Repositories scanned at once; 0 for no limit, or one on hosts with little memory
+	MaxMemoryMiB           int      `xml:"maxMemoryMiB"`                                       // Soft memory limit; 0 for no limit
+	MaxWorkers             int      `xml:"maxWorkers" default:"32"`                            // Scanners and pullers working on the disk at once, shared by all repositories; 0 for no limit
+	PrecountScan           bool     `xml:"precountScan" default:"true"`                        // Count the data to hash before the first scan, for progress reporting
+	MaxConcurrentReads     int      `xml:"maxConcurrentReads" default:"8"`                     // Disk reads for requests from other nodes, shared by all nodes; 0 for no limit
+	MaxQueuedRequests      int      `xml:"maxQueuedRequests" default:"64"`                     // Requests from a single node waiting for a read; 0 for no limit
+	MaxOpenFiles           int      `xml:"maxOpenFiles"`                                       // Files open at once for scanning, pulling and requests; 0 for a limit based on the OS limit, -1 for no limit
+	WatchFilesystem        bool     `xml:"watchFilesystem"`                                    // Rescan what the OS reports as changed right away, and everything only every 60 rescan intervals
+	KeepDeletedHours       int      `xml:"keepDeletedHours" default:"720"`                     // Keep records of deleted files at least this long, and until all connected nodes have them; 0 to keep them forever
+	ReceiveTimeoutS        int      `xml:"receiveTimeoutS" default:"45"`                       // Connections that receive nothing for this long are closed as dead; at least 30
+	MaxOutstandingRequests int      `xml:"maxOutstandingRequests" default:"64"`                // Requests sent to a single node awaiting a response; 0 for no limit
+	MaxPullsPerNode        int      `xml:"maxPullsPerNode" default:"12"`                       // Block requests of a repository outstanding to a single node, so that the others get a share; 0 for no limit
 
 	Deprecated_UREnabled  bool   `xml:"urEnabled,omitempty" json:"-"`
 	Deprecated_URDeclined bool   `xml:"urDeclined,omitempty" json:"-"`
@@ -172,6 +233,40 @@ type GUIConfiguration struct {
 	Password string `xml:"password,omitempty"`
 	UseTLS   bool   `xml:"tls,attr"`
 	APIKey   string `xml:"apikey,omitempty"`
+
+	// If UnixSocket is set, the GUI and REST API are also served on a unix
+	// socket at that path, without authentication. Access is controlled by
+	// the socket permissions.
+	UnixSocket      string `xml:"unixSocket,omitempty"`
+	UnixSocketPerms string `xml:"unixSocketPerms,omitempty" default:"0600"`
+}
+
+// Copy returns a copy of the configuration that shares no slices with it,
+// so that one can be changed while the other is read.
+func (cfg *Configuration) Copy() Configuration {
+	c := *cfg
+	c.Nodes = copyNodes(cfg.Nodes)
+	c.Repositories = make([]RepositoryConfiguration, len(cfg.Repositories))
+	for i, repo := range cfg.Repositories {
+		repo.Nodes = copyNodes(repo.Nodes)
+		repo.SyncOrderPatterns = append([]SyncOrderPattern(nil), repo.SyncOrderPatterns...)
+		repo.nodeIDs = nil
+		c.Repositories[i] = repo
+	}
+	c.Options.ListenAddress = append([]string(nil), cfg.Options.ListenAddress...)
+	return c
+}
+
+func copyNodes(nodes []NodeConfiguration) []NodeConfiguration {
+	if nodes == nil {
+		return nil
+	}
+	c := make([]NodeConfiguration, len(nodes))
+	for i, n := range nodes {
+		n.Addresses = append([]string(nil), n.Addresses...)
+		c[i] = n
+	}
+	return c
 }
 
 func (cfg *Configuration) NodeMap() map[string]NodeConfiguration {
@@ -182,6 +277,22 @@ func (cfg *Configuration) NodeMap() map[string]NodeConfiguration {
 	return m
 }
 
+// NodeName returns how the node should be referred to in logs and messages:
+// the name given to it in the configuration followed by the start of the
+// node ID, or the full node ID when it has no name.
+func (cfg *Configuration) NodeName(nodeID string) string {
+	for _, n := range cfg.Nodes {
+		if n.NodeID == nodeID && n.Name != "" {
+			short := nodeID
+			if len(short) > protocol.ShortIDLen {
+				short = short[:protocol.ShortIDLen]
+			}
+			return fmt.Sprintf("%q (%s)", n.Name, short)
+		}
+	}
+	return nodeID
+}
+
 func (cfg *Configuration) RepoMap() map[string]RepositoryConfiguration {
 	m := make(map[string]RepositoryConfiguration, len(cfg.Repositories))
 	for _, r := range cfg.Repositories {
@@ -262,6 +373,18 @@ func Save(wr io.Writer, cfg Configuration) error {
 	return err
 }
 
+// canonicalNodeID returns the node ID in the form used everywhere else,
+// without the dashes and spaces that make it easier to read. IDs that
+// don't parse are only stripped and upper cased, and never match a node.
+func canonicalNodeID(s string) string {
+	if id, err := protocol.NodeIDFromString(s); err == nil {
+		return id.String()
+	}
+	s = strings.Replace(s, "-", "", -1)
+	s = strings.Replace(s, " ", "", -1)
+	return strings.ToUpper(s)
+}
+
 func uniqueStrings(ss []string) []string {
 	var m = make(map[string]bool, len(ss))
 	for _, s := range ss {
@@ -300,10 +423,10 @@ func Load(rd io.Reader, myID string) (Configuration, error) {
 	// Sanitize node IDs
 	for i := range cfg.Nodes {
 		node := &cfg.Nodes[i]
-		// Strip spaces and dashes
-		node.NodeID = strings.Replace(node.NodeID, "-", "", -1)
-		node.NodeID = strings.Replace(node.NodeID, " ", "", -1)
-		node.NodeID = strings.ToUpper(node.NodeID)
+		node.NodeID = canonicalNodeID(node.NodeID)
+		if _, err := protocol.NodeIDFromString(node.NodeID); err != nil {
+			l.Warnf("Node %q: invalid node ID: %v", node.NodeID, err)
+		}
 	}
 
 	// Check for missing, bad or duplicate repository ID:s
@@ -317,15 +440,27 @@ func Load(rd io.Reader, myID string) (Configuration, error) {
 			continue
 		}
 
+		if err := repo.ExpandDirectory(); err != nil {
+			l.Warnf("Repository %q: directory %q: %v", repo.ID, repo.Directory, err)
+			repo.Invalid = "directory cannot be expanded"
+		} else if !filepath.IsAbs(repo.ExpandedDirectory) {
+			l.Warnf("Repository %q: directory %q is relative to the working directory, which may change", repo.ID, repo.Directory)
+		}
+
 		if repo.ID == "" {
 			repo.ID = "default"
 		}
 
 		for i := range repo.Nodes {
 			node := &repo.Nodes[i]
-			// Strip spaces and dashes
-			node.NodeID = strings.Replace(node.NodeID, "-", "", -1)
-			node.NodeID = strings.Replace(node.NodeID, " ", "", -1)
+			node.NodeID = canonicalNodeID(node.NodeID)
+		}
+
+		switch repo.PullOrder {
+		case "", PullOrderRandom, PullOrderAlphabetic, PullOrderSmallestFirst, PullOrderLargestFirst, PullOrderOldestFirst, PullOrderNewestFirst:
+		default:
+			l.Warnf("Repository %q: unknown pull order %q; pulling in random order", repo.ID, repo.PullOrder)
+			repo.PullOrder = ""
 		}
 
 		if seen, ok := seenRepos[repo.ID]; ok {
@@ -350,9 +485,15 @@ func Load(rd io.Reader, myID string) (Configuration, error) {
 	cfg.Options.Deprecated_URDeclined = false
 	cfg.Options.Deprecated_UREnabled = false
 
-	// Upgrade to v2 configuration if appropriate
-	if cfg.Version == 1 {
-		convertV1V2(&cfg)
+	cfg.OriginalVersion = cfg.Version
+	if cfg.Version > CurrentVersion {
+		l.Warnf("Configuration version %d is newer than the supported %d; unknown settings are lost when it is saved", cfg.Version, CurrentVersion)
+	}
+	for _, m := range migrations {
+		if cfg.Version < m.to {
+			m.convert(&cfg)
+			cfg.Version = m.to
+		}
 	}
 
 	// Hash old cleartext passwords
@@ -377,11 +518,30 @@ func Load(rd io.Reader, myID string) (Configuration, error) {
 		if len(n.Addresses) == 0 || len(n.Addresses) == 1 && n.Addresses[0] == "" {
 			n.Addresses = []string{"dynamic"}
 		}
+		if _, err := protocol.ParseCompression(n.Compression); err != nil {
+			l.Warnf("Node %s: %v; compressing all messages", n.NodeID, err)
+			n.Compression = ""
+		}
+	}
+
+	if min := int(protocol.MinReceiveTimeout.Seconds()); cfg.Options.ReceiveTimeoutS < min {
+		l.Warnf("Receive timeout %ds is too short; using %ds", cfg.Options.ReceiveTimeoutS, min)
+		cfg.Options.ReceiveTimeoutS = min
 	}
 
 	return cfg, err
 }
 
+// The migrations are applied in order to configurations of an older
+// version than the one they migrate to.
+var migrations = []struct {
+	to      int
+	convert func(*Configuration)
+}{
+	{2, convertV1V2},
+	{3, convertV2V3},
+}
+
 func convertV1V2(cfg *Configuration) {
 	// Collect the list of nodes.
 	// Replace node configs inside repositories with only a reference to the nide ID.
@@ -413,6 +573,14 @@ func convertV1V2(cfg *Configuration) {
 	cfg.Version = 2
 }
 
+func convertV2V3(cfg *Configuration) {
+	// The rescan interval moved into the repositories, where zero means
+	// no periodic rescans.
+	for i := range cfg.Repositories {
+		cfg.Repositories[i].RescanIntervalS = cfg.Options.RescanIntervalS
+	}
+}
+
 type NodeConfigurationList []NodeConfiguration
 
 func (l NodeConfigurationList) Less(a, b int) bool {
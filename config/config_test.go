@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -17,18 +18,29 @@ import (
 
 func TestDefaultValues(t *testing.T) {
 	expected := OptionsConfiguration{
-		ListenAddress:      []string{"0.0.0.0:22000"},
-		GlobalAnnServer:    "announce.syncthing.net:22025",
-		GlobalAnnEnabled:   true,
-		LocalAnnEnabled:    true,
-		LocalAnnPort:       21025,
-		ParallelRequests:   16,
-		MaxSendKbps:        0,
-		RescanIntervalS:    60,
-		ReconnectIntervalS: 60,
-		MaxChangeKbps:      10000,
-		StartBrowser:       true,
-		UPnPEnabled:        true,
+		ListenAddress:          []string{"0.0.0.0:22000"},
+		GlobalAnnServer:        "announce.syncthing.net:22025",
+		GlobalAnnEnabled:       true,
+		LocalAnnEnabled:        true,
+		LocalAnnPort:           21025,
+		LocalAnnMCAddr:         "[ff32::5222]:21026",
+		ParallelRequests:       16,
+		MaxSendKbps:            0,
+		RescanIntervalS:        60,
+		ReconnectIntervalS:     60,
+		MaxChangeKbps:          10000,
+		StartBrowser:           true,
+		UPnPEnabled:            true,
+		MaxWorkers:             32,
+		PrecountScan:           true,
+		MaxConcurrentReads:     8,
+		MaxQueuedRequests:      64,
+		KeepDeletedHours:       720,
+		ReceiveTimeoutS:        45,
+		MaxOutstandingRequests: 64,
+		MaxPullsPerNode:        12,
+		URURL:                  "https://data.syncthing.net/newdata",
+		DefaultRepoPath:        "~/Sync/%{repo}",
 	}
 
 	cfg, err := Load(bytes.NewReader(nil), "nodeID")
@@ -81,10 +93,12 @@ func TestNodeConfig(t *testing.T) {
 
 		expectedRepos := []RepositoryConfiguration{
 			{
-				ID:        "test",
-				Directory: "~/Sync",
-				Nodes:     []NodeConfiguration{{NodeID: "NODE1"}, {NodeID: "NODE2"}},
-				ReadOnly:  true,
+				ID:                "test",
+				Directory:         "~/Sync",
+				ExpandedDirectory: filepath.Join(homeDir(), "Sync"),
+				Nodes:             []NodeConfiguration{{NodeID: "NODE1"}, {NodeID: "NODE2"}},
+				ReadOnly:          true,
+				RescanIntervalS:   60,
 			},
 		}
 		expectedNodes := []NodeConfiguration{
@@ -101,8 +115,8 @@ func TestNodeConfig(t *testing.T) {
 		}
 		expectedNodeIDs := []string{"NODE1", "NODE2"}
 
-		if cfg.Version != 2 {
-			t.Errorf("%d: Incorrect version %d != 2", i, cfg.Version)
+		if cfg.Version != CurrentVersion {
+			t.Errorf("%d: Incorrect version %d != %d", i, cfg.Version, CurrentVersion)
 		}
 		if !reflect.DeepEqual(cfg.Repositories, expectedRepos) {
 			t.Errorf("%d: Incorrect Repositories\n  A: %#v\n  E: %#v", i, cfg.Repositories, expectedRepos)
@@ -116,6 +130,126 @@ func TestNodeConfig(t *testing.T) {
 	}
 }
 
+func TestNodeCompression(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <node id="NODE1" compression="metadata"/>
+    <node id="NODE2" compression="sometimes"/>
+    <node id="NODE3"/>
+</configuration>
+`)
+
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := []string{"metadata", "", ""}
+	for i, node := range cfg.Nodes {
+		if node.Compression != expected[i] {
+			t.Errorf("%s: Incorrect compression %q != %q", node.NodeID, node.Compression, expected[i])
+		}
+	}
+}
+
+func TestNodeRateLimits(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <node id="NODE1" maxSendKbps="100" maxRecvKbps="200"/>
+    <node id="NODE2"/>
+</configuration>
+`)
+
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Error(err)
+	}
+	if n := cfg.Nodes[0]; n.MaxSendKbps != 100 || n.MaxRecvKbps != 200 {
+		t.Errorf("Incorrect rate limits %d, %d", n.MaxSendKbps, n.MaxRecvKbps)
+	}
+	if n := cfg.Nodes[1]; n.MaxSendKbps != 0 || n.MaxRecvKbps != 0 {
+		t.Errorf("Unexpected rate limits %d, %d", n.MaxSendKbps, n.MaxRecvKbps)
+	}
+}
+
+func TestRepositoryAddNode(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <repository id="test" directory="~/Sync">
+        <node id="NODE1"/>
+    </repository>
+    <node id="NODE1"/>
+    <node id="NODE2" introducer="true"/>
+</configuration>
+`)
+
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg.Nodes[0].Introducer || !cfg.Nodes[1].Introducer {
+		t.Errorf("Incorrect introducer flags %v, %v", cfg.Nodes[0].Introducer, cfg.Nodes[1].Introducer)
+	}
+
+	repo := &cfg.Repositories[0]
+	if repo.AddNode("NODE1") {
+		t.Error("Node already present was added")
+	}
+	if !repo.AddNode("NODE2") {
+		t.Error("Node not added")
+	}
+	if ids := repo.NodeIDs(); !reflect.DeepEqual(ids, []string{"NODE1", "NODE2"}) {
+		t.Errorf("Incorrect NodeIDs %v", ids)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <repository id="test" directory="~/Sync">
+        <node id="NODE1"/>
+    </repository>
+    <node id="NODE1">
+        <address>dynamic</address>
+    </node>
+</configuration>
+`)
+
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := cfg.Copy()
+
+	c := cfg.Copy()
+	c.Repositories[0].Paused = true
+	c.Repositories[0].AddNode("NODE2")
+	c.Nodes[0].Paused = true
+	c.Nodes[0].Addresses[0] = "1.2.3.4"
+	c.Options.ListenAddress[0] = ":0"
+	if !reflect.DeepEqual(cfg, orig) {
+		t.Errorf("Changing the copy changed the original: %+v", cfg)
+	}
+}
+
+func TestReceiveTimeoutMinimum(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <options>
+        <receiveTimeoutS>5</receiveTimeoutS>
+    </options>
+</configuration>
+`)
+
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg.Options.ReceiveTimeoutS != 30 {
+		t.Errorf("Incorrect receive timeout %d != 30", cfg.Options.ReceiveTimeoutS)
+	}
+}
+
 func TestNoListenAddress(t *testing.T) {
 	data := []byte(`<configuration version="1">
     <repository directory="~/Sync">
@@ -154,6 +288,7 @@ func TestOverriddenValues(t *testing.T) {
         <globalAnnounceEnabled>false</globalAnnounceEnabled>
         <localAnnounceEnabled>false</localAnnounceEnabled>
         <localAnnouncePort>42123</localAnnouncePort>
+        <localAnnounceMCAddr>[ff12::8384]:42124</localAnnounceMCAddr>
         <parallelRequests>32</parallelRequests>
         <maxSendKbps>1234</maxSendKbps>
         <rescanIntervalS>600</rescanIntervalS>
@@ -161,23 +296,50 @@ func TestOverriddenValues(t *testing.T) {
         <maxChangeKbps>2345</maxChangeKbps>
         <startBrowser>false</startBrowser>
         <upnpEnabled>false</upnpEnabled>
+        <maxWorkers>8</maxWorkers>
+        <precountScan>false</precountScan>
+        <maxConcurrentReads>4</maxConcurrentReads>
+        <maxQueuedRequests>16</maxQueuedRequests>
+        <maxOpenFiles>200</maxOpenFiles>
+        <watchFilesystem>true</watchFilesystem>
+        <keepDeletedHours>48</keepDeletedHours>
+        <receiveTimeoutS>90</receiveTimeoutS>
+        <maxRecvKbps>4321</maxRecvKbps>
+        <maxOutstandingRequests>256</maxOutstandingRequests>
+        <maxPullsPerNode>4</maxPullsPerNode>
+        <urURL>https://reports.example.com/</urURL>
+        <defaultRepoPath>/data/%{repo}</defaultRepoPath>
     </options>
 </configuration>
 `)
 
 	expected := OptionsConfiguration{
-		ListenAddress:      []string{":23000"},
-		GlobalAnnServer:    "syncthing.nym.se:22025",
-		GlobalAnnEnabled:   false,
-		LocalAnnEnabled:    false,
-		LocalAnnPort:       42123,
-		ParallelRequests:   32,
-		MaxSendKbps:        1234,
-		RescanIntervalS:    600,
-		ReconnectIntervalS: 6000,
-		MaxChangeKbps:      2345,
-		StartBrowser:       false,
-		UPnPEnabled:        false,
+		ListenAddress:          []string{":23000"},
+		GlobalAnnServer:        "syncthing.nym.se:22025",
+		GlobalAnnEnabled:       false,
+		LocalAnnEnabled:        false,
+		LocalAnnPort:           42123,
+		LocalAnnMCAddr:         "[ff12::8384]:42124",
+		ParallelRequests:       32,
+		MaxSendKbps:            1234,
+		MaxRecvKbps:            4321,
+		RescanIntervalS:        600,
+		ReconnectIntervalS:     6000,
+		MaxChangeKbps:          2345,
+		StartBrowser:           false,
+		UPnPEnabled:            false,
+		MaxWorkers:             8,
+		PrecountScan:           false,
+		MaxConcurrentReads:     4,
+		MaxQueuedRequests:      16,
+		MaxOpenFiles:           200,
+		WatchFilesystem:        true,
+		KeepDeletedHours:       48,
+		ReceiveTimeoutS:        90,
+		MaxOutstandingRequests: 256,
+		MaxPullsPerNode:        4,
+		URURL:                  "https://reports.example.com/",
+		DefaultRepoPath:        "/data/%{repo}",
 	}
 
 	cfg, err := Load(bytes.NewReader(data), "nodeID")
@@ -247,10 +409,14 @@ func TestStripNodeIs(t *testing.T) {
     <node id="AAAABBBBEEEE">
         <address></address>
     </node>
+    <node id="air6lpz-7k4pttu-xqsmuuc-pq5ywoe-dfiiqju-g7772yq-xxr5yd6-awq">
+        <address></address>
+    </node>
     <repository directory="~/Sync">
         <node id="AAA ABBB-BCC CC" name=""></node>
         <node id="AA-AAB BBBD-DDD" name=""></node>
         <node id="AAA AB-BBB EEE-E" name=""></node>
+        <node id="AIR6LPZ 7K4PTTU XQSMUUC PQ5YWOE DFIIQJU G7772YQ XXR5YD6 AWQ" name=""></node>
     </repository>
 </configuration>
 `)
@@ -268,6 +434,10 @@ func TestStripNodeIs(t *testing.T) {
 			NodeID:    "AAAABBBBEEEE",
 			Addresses: []string{"dynamic"},
 		},
+		{
+			NodeID:    "AIR6LPZ7K4PTTUXQSMUUCPQ5YWOEDFIIQJUG7772YQXXR5YD6AWQ",
+			Addresses: []string{"dynamic"},
+		},
 	}
 
 	cfg, err := Load(bytes.NewReader(data), "n4")
@@ -302,8 +472,8 @@ func TestSyncOrders(t *testing.T) {
 
 	expected := []SyncOrderPattern{
 		{
-			Pattern: "\\.jpg$",
-			Priority:  1,
+			Pattern:  "\\.jpg$",
+			Priority: 1,
 		},
 	}
 
@@ -361,9 +531,9 @@ func TestFileSorter(t *testing.T) {
 	if !reflect.DeepEqual(f, expected) {
 		t.Errorf(
 			"\n\nexpected:\n" +
-			formatFiles(expected) + "\n" +
-			"got:\n" +
-			formatFiles(f) + "\n\n",
+				formatFiles(expected) + "\n" +
+				"got:\n" +
+				formatFiles(f) + "\n\n",
 		)
 	}
 }
@@ -377,3 +547,60 @@ func formatFiles(f []scanner.File) string {
 
 	return ret
 }
+
+func TestNodeName(t *testing.T) {
+	cfg := Configuration{
+		Nodes: []NodeConfiguration{
+			{NodeID: "AIR6LPZ7K4PTTUXQSMUUCPQ5YWOEDFIIQJUG7772YQXXR5YD6AWQ", Name: "laptop"},
+			{NodeID: "GYRZZQBIRNPV4T7TC52WEQYJ3TFDQW6MWDFLMU4SSSU6EMFBK2VA"},
+		},
+	}
+
+	var tests = []struct {
+		id, name string
+	}{
+		{"AIR6LPZ7K4PTTUXQSMUUCPQ5YWOEDFIIQJUG7772YQXXR5YD6AWQ", `"laptop" (AIR6LPZ)`},
+		{"GYRZZQBIRNPV4T7TC52WEQYJ3TFDQW6MWDFLMU4SSSU6EMFBK2VA", "GYRZZQBIRNPV4T7TC52WEQYJ3TFDQW6MWDFLMU4SSSU6EMFBK2VA"},
+		{"UNKNOWN", "UNKNOWN"},
+	}
+	for _, tc := range tests {
+		if name := cfg.NodeName(tc.id); name != tc.name {
+			t.Errorf("%s: %q != expected %q", tc.id, name, tc.name)
+		}
+	}
+}
+
+func TestRescanIntervalMigration(t *testing.T) {
+	data := []byte(`
+<configuration version="2">
+    <repository id="test" directory="~/Sync"></repository>
+    <options>
+        <rescanIntervalS>300</rescanIntervalS>
+    </options>
+</configuration>
+`)
+	cfg, err := Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := cfg.Repositories[0].RescanIntervalS; r != 300 {
+		t.Errorf("Rescan interval %d not taken from the options", r)
+	}
+
+	// From version 3 on, zero means no periodic rescans
+	data = []byte(`
+<configuration version="3">
+    <repository id="test" directory="~/Sync" rescanIntervalS="0"></repository>
+    <options>
+        <rescanIntervalS>300</rescanIntervalS>
+    </options>
+</configuration>
+`)
+	cfg, err = Load(bytes.NewReader(data), "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := cfg.Repositories[0].RescanIntervalS; r != 0 {
+		t.Errorf("Unexpected rescan interval %d", r)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// The placeholders that may be used in paths as %{name}, and how to find
+// their values.
+var placeholders = map[string]func() (string, error){
+	"hostname": os.Hostname,
+}
+
+// ExpandPath returns the path with a leading ~ replaced by the home
+// directory, ${VAR} by the value of the environment variable and %{hostname}
+// by the host name, so that the same configuration can be used on machines
+// with different home directories. It is an error to refer to an unset
+// environment variable or an unknown placeholder. A $ that is not followed
+// by { is left as is, since existing directories may have one in their
+// names.
+func ExpandPath(p string) (string, error) {
+	p, err := expandBraces(p, "$", func(name string) (string, error) {
+		if v := os.Getenv(name); v != "" {
+			return v, nil
+		}
+		return "", fmt.Errorf("environment variable %q is not set", name)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	p, err = expandBraces(p, "%", func(name string) (string, error) {
+		if fn, ok := placeholders[name]; ok {
+			return fn()
+		}
+		return "", fmt.Errorf("unknown placeholder %%{%s}", name)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	p = filepath.FromSlash(p)
+	if p == "~" || strings.HasPrefix(p, fmt.Sprintf("~%c", os.PathSeparator)) {
+		home := homeDir()
+		if home == "" {
+			return "", fmt.Errorf("no home directory found")
+		}
+		p = filepath.Join(home, p[1:])
+	}
+	return p, nil
+}
+
+// RepoPath returns the directory for the repository according to the
+// template, with %{repo} replaced by the repository ID. The result is to be
+// expanded like any other directory. Templates without %{repo}, which would
+// put every repository in the same directory, and repository IDs that would
+// make the path point elsewhere are refused.
+func RepoPath(template, repo string) (string, error) {
+	if !strings.Contains(template, "%{repo}") {
+		return "", fmt.Errorf("repository path %q does not contain %%{repo}", template)
+	}
+	if repo == "" || repo == "." || repo == ".." || strings.ContainsAny(repo, `/\:%$~`) {
+		return "", fmt.Errorf("repository ID %q cannot be used in a path", repo)
+	}
+	return strings.Replace(template, "%{repo}", repo, -1), nil
+}
+
+// expandBraces replaces each occurrence of prefix{name} in p by the value
+// lookup returns for the name.
+func expandBraces(p, prefix string, lookup func(name string) (string, error)) (string, error) {
+	var res []string
+	for {
+		i := strings.Index(p, prefix+"{")
+		if i < 0 {
+			break
+		}
+		j := strings.Index(p[i:], "}")
+		if j < 0 {
+			return "", fmt.Errorf("unterminated %s{ in %q", prefix, p)
+		}
+
+		v, err := lookup(p[i+len(prefix)+1 : i+j])
+		if err != nil {
+			return "", err
+		}
+
+		res = append(res, p[:i], v)
+		p = p[i+j+1:]
+	}
+	res = append(res, p)
+	return strings.Join(res, ""), nil
+}
+
+func homeDir() string {
+	if runtime.GOOS == "windows" {
+		if home := filepath.Join(os.Getenv("HomeDrive"), os.Getenv("HomePath")); home != "" {
+			return home
+		}
+		return os.Getenv("UserProfile")
+	}
+	return os.Getenv("HOME")
+}
+
+// ExpandDirectory sets the ExpandedDirectory of the repository from its
+// configured Directory.
+func (r *RepositoryConfiguration) ExpandDirectory() error {
+	dir, err := ExpandPath(r.Directory)
+	if err != nil {
+		return err
+	}
+	r.ExpandedDirectory = dir
+	return nil
+}
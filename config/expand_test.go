@@ -0,0 +1,64 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	os.Setenv("STTESTVAR", "value")
+	host, _ := os.Hostname()
+	home := homeDir()
+
+	var tests = []struct {
+		in, out string
+	}{
+		{"/plain/path", "/plain/path"},
+		{"/a/${STTESTVAR}b", "/a/valueb"},
+		{"/a/$STTESTVAR/b", "/a/$STTESTVAR/b"},
+		{"/data/a$b", "/data/a$b"},
+		{"/data/$5", "/data/$5"},
+		{"/data/$", "/data/$"},
+		{"C:/$Recycle.Bin/x", "C:/$Recycle.Bin/x"},
+		{"/a/$%{hostname}", "/a/$" + host},
+		{"/sync/%{hostname}/x", "/sync/" + host + "/x"},
+		{"~", home},
+		{"~/Sync", home + "/Sync"},
+		{"/a/~/b", "/a/~/b"},
+	}
+	for _, tc := range tests {
+		out, err := ExpandPath(tc.in)
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", tc.in, err)
+		} else if out != filepath.FromSlash(tc.out) {
+			t.Errorf("%q: %q != expected %q", tc.in, out, tc.out)
+		}
+	}
+
+	for _, in := range []string{"/a/${STTESTUNSETVAR}", "/a/${STTESTVAR", "/a/%{unknown}", "/a/%{hostname"} {
+		if _, err := ExpandPath(in); err == nil {
+			t.Errorf("%q: unexpected nil error", in)
+		}
+	}
+}
+
+func TestRepoPath(t *testing.T) {
+	if p, err := RepoPath("~/Sync/%{repo}", "photos"); err != nil || p != "~/Sync/photos" {
+		t.Errorf("Unexpected path %q, %v", p, err)
+	}
+
+	for _, repo := range []string{"", ".", "..", "../etc", "a/b", `a\b`, "$HOME", "%{hostname}", "~"} {
+		if p, err := RepoPath("~/Sync/%{repo}", repo); err == nil {
+			t.Errorf("%q: unexpected path %q", repo, p)
+		}
+	}
+
+	if p, err := RepoPath("~/Sync", "photos"); err == nil {
+		t.Errorf("Unexpected path %q for template without %%{repo}", p)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+)
+
+// LoadFile loads the configuration from the named file. When it is of an
+// older version, the file is copied to <name>.v<version> before the
+// migrated configuration can be saved over it.
+func LoadFile(name, myID string) (Configuration, error) {
+	fd, err := os.Open(name)
+	if err != nil {
+		return Configuration{}, err
+	}
+	cfg, err := Load(fd, myID)
+	fd.Close()
+	if err != nil {
+		return Configuration{}, err
+	}
+
+	if cfg.OriginalVersion < CurrentVersion {
+		backup := fmt.Sprintf("%s.v%d", name, cfg.OriginalVersion)
+		l.Infof("Migrated configuration from version %d to %d; the original is kept in %s", cfg.OriginalVersion, CurrentVersion, backup)
+		if err := copyFile(name, backup); err != nil {
+			return Configuration{}, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// SaveFile saves the configuration to the named file, by way of a
+// temporary file, so that a crash halfway never leaves a broken file
+// behind. The previous file is kept as <name>.bak.
+func SaveFile(name string, cfg Configuration) error {
+	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
+	fd, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if err := Save(fd, cfg); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+
+	if err := copyFile(name, name+".bak"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return osutil.Rename(tmp, name)
+}
+
+func copyFile(from, to string) error {
+	bs, err := ioutil.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(to, bs, 0600)
+}
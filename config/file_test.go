@@ -0,0 +1,94 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFileMigrates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "config.xml")
+
+	v1data := []byte(`<configuration version="1">
+    <repository id="test" directory="~/Sync">
+        <node id="NODE1"></node>
+    </repository>
+</configuration>
+`)
+	ioutil.WriteFile(name, v1data, 0600)
+
+	cfg, err := LoadFile(name, "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != CurrentVersion || cfg.OriginalVersion != 1 {
+		t.Errorf("Unexpected version %d, original %d", cfg.Version, cfg.OriginalVersion)
+	}
+	if bs, err := ioutil.ReadFile(name + ".v1"); err != nil || !bytes.Equal(bs, v1data) {
+		t.Errorf("Original not kept: %q, %v", bs, err)
+	}
+
+	// Loading the current version makes no backup
+	if err := SaveFile(name, cfg); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(name + ".v1")
+	cfg, err = LoadFile(name, "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.OriginalVersion != CurrentVersion {
+		t.Errorf("Unexpected original version %d", cfg.OriginalVersion)
+	}
+	if _, err := os.Stat(name + ".v1"); !os.IsNotExist(err) {
+		t.Error("Unexpected backup of current version:", err)
+	}
+}
+
+func TestSaveFileBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "config.xml")
+
+	cfg, _ := Load(nil, "NODE1")
+	if err := SaveFile(name, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name + ".bak"); !os.IsNotExist(err) {
+		t.Error("Unexpected backup of nonexistent file:", err)
+	}
+	first, _ := ioutil.ReadFile(name)
+
+	cfg.Options.MaxSendKbps = 1234
+	if err := SaveFile(name, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if bs, _ := ioutil.ReadFile(name + ".bak"); !bytes.Equal(bs, first) {
+		t.Error("Previous file not kept")
+	}
+	loaded, err := LoadFile(name, "NODE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Options.MaxSendKbps != 1234 {
+		t.Error("Change not saved")
+	}
+
+	if fs, _ := filepath.Glob(filepath.Join(dir, "*.tmp.*")); len(fs) != 0 {
+		t.Errorf("Temporary files left behind: %v", fs)
+	}
+}
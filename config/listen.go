@@ -0,0 +1,59 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ParseListenAddress returns the network and address to listen on for a
+// listen address from the configuration. Listen addresses are host:port,
+// optionally prefixed by tcp://, tcp4:// or tcp6:// to pick the IP
+// version; plain host:port is the same as tcp://. An empty host, or "*",
+// listens on all addresses, of the IP version given by the prefix or both.
+func ParseListenAddress(s string) (network, addr string, err error) {
+	network, hostPort := "tcp", s
+	if i := strings.Index(s, "://"); i >= 0 {
+		network, hostPort = s[:i], s[i+3:]
+		switch network {
+		case "tcp", "tcp4", "tcp6":
+		default:
+			return "", "", fmt.Errorf("listen address %q: unsupported scheme %q", s, network)
+		}
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return "", "", fmt.Errorf("listen address %q: %v", s, err)
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 0 || p > 65535 {
+		return "", "", fmt.Errorf("listen address %q: invalid port %q", s, port)
+	}
+	if host == "*" {
+		host = ""
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if network == "tcp4" && ip.To4() == nil || network == "tcp6" && ip.To4() != nil {
+			return "", "", fmt.Errorf("listen address %q: %s is not a %s address", s, host, network)
+		}
+	}
+
+	return network, net.JoinHostPort(host, port), nil
+}
+
+// ListenHostPorts returns the host:port part of the valid listen addresses,
+// as announced to other nodes.
+func ListenHostPorts(addrs []string) []string {
+	var hostPorts []string
+	for _, s := range addrs {
+		if _, addr, err := ParseListenAddress(s); err == nil {
+			hostPorts = append(hostPorts, addr)
+		}
+	}
+	return hostPorts
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseListenAddress(t *testing.T) {
+	var tests = []struct {
+		in, network, addr string
+	}{
+		{"0.0.0.0:22000", "tcp", "0.0.0.0:22000"},
+		{":22000", "tcp", ":22000"},
+		{"tcp://:22000", "tcp", ":22000"},
+		{"tcp://*:22000", "tcp", ":22000"},
+		{"tcp4://*:22000", "tcp4", ":22000"},
+		{"tcp4://192.0.2.42:22000", "tcp4", "192.0.2.42:22000"},
+		{"tcp6://[::]:22000", "tcp6", "[::]:22000"},
+		{"tcp6://[2001:db8::42]:22000", "tcp6", "[2001:db8::42]:22000"},
+		{"tcp://example.com:22000", "tcp", "example.com:22000"},
+	}
+	for _, tc := range tests {
+		network, addr, err := ParseListenAddress(tc.in)
+		if err != nil {
+			t.Errorf("Unexpected error %v for %q", err, tc.in)
+		} else if network != tc.network || addr != tc.addr {
+			t.Errorf("%q: got %q %q, expected %q %q", tc.in, network, addr, tc.network, tc.addr)
+		}
+	}
+
+	for _, in := range []string{"", "22000", "udp://:22000", "tcp://:port", "tcp://:65536", "tcp4://[::1]:22000", "tcp6://127.0.0.1:22000"} {
+		if _, _, err := ParseListenAddress(in); err == nil {
+			t.Errorf("Unexpected nil error for %q", in)
+		}
+	}
+}
+
+func TestListenHostPorts(t *testing.T) {
+	addrs := ListenHostPorts([]string{"tcp4://:22000", "invalid", "tcp6://[::]:22001"})
+	if expected := []string{":22000", "[::]:22001"}; !reflect.DeepEqual(addrs, expected) {
+		t.Errorf("Unexpected addresses %v", addrs)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -21,7 +22,7 @@ type Discoverer struct {
 	listenAddrs      []string
 	localBcastIntv   time.Duration
 	globalBcastIntv  time.Duration
-	beacon           *beacon.Beacon
+	beacons          []beacon.Interface
 	registry         map[string][]string
 	registryLock     sync.RWMutex
 	extServer        string
@@ -30,6 +31,9 @@ type Discoverer struct {
 	forcedBcastTick  chan time.Time
 	extAnnounceOK    bool
 	extAnnounceOKmut sync.Mutex
+	changeHandler    func(node string)
+	stop             chan struct{}
+	running          sync.WaitGroup
 }
 
 var (
@@ -41,7 +45,11 @@ var (
 // When we hit this many errors in succession, we stop.
 const maxErrors = 30
 
-func NewDiscoverer(id string, addresses []string, localPort int) (*Discoverer, error) {
+// NewDiscoverer returns a discoverer that announces on the local network
+// by IPv4 broadcast to localPort and, unless localMCAddr is empty, to the
+// IPv6 multicast group localMCAddr. Networks without IPv6 get by with the
+// broadcasts.
+func NewDiscoverer(id string, addresses []string, localPort int, localMCAddr string) (*Discoverer, error) {
 	b, err := beacon.New(localPort)
 	if err != nil {
 		return nil, err
@@ -51,25 +59,57 @@ func NewDiscoverer(id string, addresses []string, localPort int) (*Discoverer, e
 		listenAddrs:     addresses,
 		localBcastIntv:  30 * time.Second,
 		globalBcastIntv: 1800 * time.Second,
-		beacon:          b,
+		beacons:         []beacon.Interface{b},
 		registry:        make(map[string][]string),
+		forcedBcastTick: make(chan time.Time, 1),
+		stop:            make(chan struct{}),
 	}
 
-	go disc.recvAnnouncements()
+	if localMCAddr != "" {
+		mb, err := beacon.NewMulticast(localMCAddr)
+		if err != nil {
+			l.Infof("No IPv6 local discovery (%v)", err)
+		} else {
+			disc.beacons = append(disc.beacons, mb)
+		}
+	}
+
+	for _, b := range disc.beacons {
+		b := b
+		disc.start(func() { disc.recvAnnouncements(b) })
+	}
 
 	return disc, nil
 }
 
 func (d *Discoverer) StartLocal() {
 	d.localBcastTick = time.Tick(d.localBcastIntv)
-	d.forcedBcastTick = make(chan time.Time)
-	go d.sendLocalAnnouncements()
+	d.start(d.sendLocalAnnouncements)
 }
 
 func (d *Discoverer) StartGlobal(server string, extPort uint16) {
 	d.extServer = server
 	d.extPort = extPort
-	go d.sendExternalAnnouncements()
+	d.start(d.sendExternalAnnouncements)
+}
+
+// Stop stops announcing and closes the beacons, and waits for the
+// announcements in progress to finish. It must be called at most once.
+func (d *Discoverer) Stop() {
+	close(d.stop)
+	for _, b := range d.beacons {
+		b.Close()
+	}
+	d.running.Wait()
+}
+
+// start runs fn in a goroutine that Stop waits for.
+func (d *Discoverer) start(fn func()) {
+	d.running.Add(1)
+	go func() {
+		fn()
+		d.running.Done()
+	}()
 }
 
 func (d *Discoverer) ExtAnnounceOK() bool {
@@ -100,6 +140,15 @@ func (d *Discoverer) Hint(node string, addrs []string) {
 	})
 }
 
+// SetChangeHandler sets a function to call with the node ID when the
+// addresses known for a node change, so that connecting to it can be
+// retried right away.
+func (d *Discoverer) SetChangeHandler(fn func(node string)) {
+	d.registryLock.Lock()
+	d.changeHandler = fn
+	d.registryLock.Unlock()
+}
+
 func (d *Discoverer) All() map[string][]string {
 	d.registryLock.RLock()
 	nodes := make(map[string][]string, len(d.registry))
@@ -158,11 +207,16 @@ func (d *Discoverer) sendLocalAnnouncements() {
 		}
 		d.registryLock.RUnlock()
 
-		d.beacon.Send(pkt.MarshalXDR())
+		bs := pkt.MarshalXDR()
+		for _, b := range d.beacons {
+			b.Send(bs)
+		}
 
 		select {
 		case <-d.localBcastTick:
 		case <-d.forcedBcastTick:
+		case <-d.stop:
+			return
 		}
 	}
 }
@@ -179,6 +233,7 @@ func (d *Discoverer) sendExternalAnnouncements() {
 		l.Warnf("Global discovery: %v; no external announcements", err)
 		return
 	}
+	defer conn.Close()
 
 	var buf []byte
 	if d.extPort != 0 {
@@ -207,7 +262,7 @@ func (d *Discoverer) sendExternalAnnouncements() {
 		} else {
 			// Verify that the announce server responds positively for our node ID
 
-			time.Sleep(1 * time.Second)
+			answerChallenge(conn, remote, time.Second)
 			res := d.externalLookup(d.myID)
 			if debug {
 				l.Debugln("discover: external lookup check:", res)
@@ -219,17 +274,25 @@ func (d *Discoverer) sendExternalAnnouncements() {
 		d.extAnnounceOK = ok
 		d.extAnnounceOKmut.Unlock()
 
-		if ok {
-			time.Sleep(d.globalBcastIntv)
-		} else {
-			time.Sleep(60 * time.Second)
+		intv := d.globalBcastIntv
+		if !ok {
+			intv = 60 * time.Second
+		}
+		select {
+		case <-time.After(intv):
+		case <-d.stop:
+			return
 		}
 	}
 }
 
-func (d *Discoverer) recvAnnouncements() {
+func (d *Discoverer) recvAnnouncements(b beacon.Interface) {
 	for {
-		buf, addr := d.beacon.Recv()
+		buf, addr := b.Recv()
+		if buf == nil {
+			// Closed by Stop
+			return
+		}
 
 		if debug {
 			l.Debugf("discover: read announcement:\n%s", hex.Dump(buf))
@@ -258,22 +321,63 @@ func (d *Discoverer) recvAnnouncements() {
 		}
 
 		if newNode {
+			// Announce at once, unless that is already pending
 			select {
 			case d.forcedBcastTick <- time.Now():
+			default:
 			}
 		}
 	}
 }
 
+// answerChallenge waits up to timeout for a challenge from the server, sent
+// if it verifies the addresses it registers, and answers it. Servers that
+// don't verify send nothing.
+func answerChallenge(conn *net.UDPConn, server *net.UDPAddr, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	conn.SetReadDeadline(deadline)
+	defer conn.SetReadDeadline(time.Time{})
+
+	buf := make([]byte, 256)
+	for {
+		n, addr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			// Usually the timeout
+			time.Sleep(deadline.Sub(time.Now()))
+			return
+		}
+		if !addr.IP.Equal(server.IP) || addr.Port != server.Port {
+			continue
+		}
+
+		var pkt ChallengeV2
+		if err := pkt.UnmarshalXDR(buf[:n]); err != nil || pkt.Magic != ChallengeMagicV2 {
+			continue
+		}
+		if debug {
+			l.Debugf("discover: challenge from %v", addr)
+		}
+		pkt.Magic = ChallengeResponseMagicV2
+		if _, err := conn.WriteTo(pkt.MarshalXDR(), server); err != nil && debug {
+			l.Debugln("discover: challenge response:", err)
+		}
+		// Give the server a moment to register us before the lookup
+		time.Sleep(deadline.Sub(time.Now()))
+		return
+	}
+}
+
 func (d *Discoverer) registerNode(addr net.Addr, node Node) bool {
 	var addrs []string
 	for _, a := range node.Addresses {
 		var nodeAddr string
 		if len(a.IP) > 0 {
-			nodeAddr = fmt.Sprintf("%s:%d", net.IP(a.IP), a.Port)
+			nodeAddr = net.JoinHostPort(net.IP(a.IP).String(), strconv.Itoa(int(a.Port)))
 			addrs = append(addrs, nodeAddr)
 		} else if addr != nil {
-			ua := addr.(*net.UDPAddr)
+			// The node's address as we see it; for IPv6 link local
+			// addresses this includes the interface
+			ua := *addr.(*net.UDPAddr)
 			ua.Port = int(a.Port)
 			nodeAddr = ua.String()
 			addrs = append(addrs, nodeAddr)
@@ -288,45 +392,64 @@ func (d *Discoverer) registerNode(addr net.Addr, node Node) bool {
 		l.Debugf("discover: register: %s -> %#v", node.ID, addrs)
 	}
 	d.registryLock.Lock()
-	_, seen := d.registry[node.ID]
+	prev, seen := d.registry[node.ID]
 	d.registry[node.ID] = addrs
+	handler := d.changeHandler
 	d.registryLock.Unlock()
+
+	if handler != nil && !sameStrings(prev, addrs) {
+		handler(node.ID)
+	}
 	return !seen
 }
 
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *Discoverer) externalLookup(node string) []string {
-	extIP, err := net.ResolveUDPAddr("udp", d.extServer)
+	addrs, err := Lookup(d.extServer, node)
 	if err != nil {
 		if debug {
 			l.Debugf("discover: %v; no external lookup", err)
 		}
 		return nil
 	}
+	return addrs
+}
+
+// Lookup asks the global discovery server for the addresses of the node. No
+// addresses and no error are returned when the server does not know about
+// the node.
+func Lookup(server, node string) ([]string, error) {
+	extIP, err := net.ResolveUDPAddr("udp", server)
+	if err != nil {
+		return nil, err
+	}
 
 	conn, err := net.DialUDP("udp", nil, extIP)
 	if err != nil {
-		if debug {
-			l.Debugf("discover: %v; no external lookup", err)
-		}
-		return nil
+		return nil, err
 	}
 	defer conn.Close()
 
 	err = conn.SetDeadline(time.Now().Add(5 * time.Second))
 	if err != nil {
-		if debug {
-			l.Debugf("discover: %v; no external lookup", err)
-		}
-		return nil
+		return nil, err
 	}
 
 	buf := QueryV2{QueryMagicV2, node}.MarshalXDR()
 	_, err = conn.Write(buf)
 	if err != nil {
-		if debug {
-			l.Debugf("discover: %v; no external lookup", err)
-		}
-		return nil
+		return nil, err
 	}
 
 	buf = make([]byte, 2048)
@@ -334,12 +457,9 @@ func (d *Discoverer) externalLookup(node string) []string {
 	if err != nil {
 		if err, ok := err.(net.Error); ok && err.Timeout() {
 			// Expected if the server doesn't know about requested node ID
-			return nil
-		}
-		if debug {
-			l.Debugf("discover: %v; no external lookup", err)
+			return nil, nil
 		}
-		return nil
+		return nil, err
 	}
 
 	if debug {
@@ -349,10 +469,7 @@ func (d *Discoverer) externalLookup(node string) []string {
 	var pkt AnnounceV2
 	err = pkt.UnmarshalXDR(buf[:n])
 	if err != nil && err != io.EOF {
-		if debug {
-			l.Debugln("discover:", err)
-		}
-		return nil
+		return nil, err
 	}
 
 	if debug {
@@ -364,7 +481,7 @@ func (d *Discoverer) externalLookup(node string) []string {
 		nodeAddr := fmt.Sprintf("%s:%d", net.IP(a.IP), a.Port)
 		addrs = append(addrs, nodeAddr)
 	}
-	return addrs
+	return addrs, nil
 }
 
 func addrToAddr(addr *net.TCPAddr) Address {
@@ -378,18 +495,25 @@ func addrToAddr(addr *net.TCPAddr) Address {
 	return Address{}
 }
 
+// resolveAddrs returns the addresses to announce for the host:port
+// addresses. Wildcard addresses, of any IP version, become the port alone
+// and are announced once per port.
 func resolveAddrs(addrs []string) []Address {
 	var raddrs []Address
+	seen := make(map[string]bool)
 	for _, addrStr := range addrs {
 		addrRes, err := net.ResolveTCPAddr("tcp", addrStr)
 		if err != nil {
 			continue
 		}
 		addr := addrToAddr(addrRes)
-		if len(addr.IP) > 0 {
+		if len(addr.IP) == 0 {
+			addr = Address{Port: addr.Port}
+		}
+		key := fmt.Sprintf("%x:%d", addr.IP, addr.Port)
+		if !seen[key] {
+			seen[key] = true
 			raddrs = append(raddrs, addr)
-		} else {
-			raddrs = append(raddrs, Address{Port: addr.Port})
 		}
 	}
 	return raddrs
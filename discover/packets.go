@@ -5,8 +5,10 @@
 package discover
 
 const (
-	AnnouncementMagicV2 = 0x029E4C77
-	QueryMagicV2        = 0x23D63A9A
+	AnnouncementMagicV2      = 0x029E4C77
+	QueryMagicV2             = 0x23D63A9A
+	ChallengeMagicV2         = 0x4C8A2D17
+	ChallengeResponseMagicV2 = 0x7E31B905
 )
 
 type QueryV2 struct {
@@ -29,3 +31,12 @@ type Address struct {
 	IP   []byte // max:16
 	Port uint16
 }
+
+// A ChallengeV2 is sent by a discovery server that verifies addresses, in
+// response to an announcement from an unverified source, with
+// ChallengeMagicV2. The announcement is registered when the same cookie is
+// sent back from the same source, with ChallengeResponseMagicV2.
+type ChallengeV2 struct {
+	Magic  uint32
+	Cookie []byte // max:32
+}
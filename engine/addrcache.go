@@ -0,0 +1,94 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+)
+
+// The addressCache remembers the last address each dynamic node was
+// successfully connected to, across restarts, so that it can be tried before
+// discovery has had a chance to answer.
+type addressCache struct {
+	file  string
+	addrs map[string]string // node ID -> address
+	mut   sync.Mutex
+}
+
+func newAddressCache(file string) *addressCache {
+	c := &addressCache{
+		file:  file,
+		addrs: make(map[string]string),
+	}
+	c.load()
+	return c
+}
+
+// get returns the cached address for the node, or the empty string.
+func (c *addressCache) get(node string) string {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.addrs[node]
+}
+
+// set records the address as working for the node.
+func (c *addressCache) set(node, addr string) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.addrs[node] == addr {
+		return
+	}
+	c.addrs[node] = addr
+	c.save()
+}
+
+func (c *addressCache) load() {
+	f, err := os.Open(c.file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 2 {
+			c.addrs[fields[0]] = fields[1]
+		}
+	}
+}
+
+func (c *addressCache) save() {
+	tmp := fmt.Sprintf("%s.tmp.%d", c.file, time.Now().UnixNano())
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		l.Infoln("Saving address cache:", err)
+		return
+	}
+	defer os.Remove(tmp)
+
+	for node, addr := range c.addrs {
+		if _, err := fmt.Fprintln(f, node, addr); err != nil {
+			f.Close()
+			l.Infoln("Saving address cache:", err)
+			return
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		l.Infoln("Saving address cache:", err)
+		return
+	}
+
+	osutil.Rename(tmp, c.file)
+}
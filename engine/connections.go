@@ -0,0 +1,338 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/discover"
+	"github.com/calmh/syncthing/model"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/reconnect"
+	"github.com/juju/ratelimit"
+)
+
+// Dialing a node is given up after this long, so that an unreachable
+// address does not hold up stopping.
+const dialTimeout = 20 * time.Second
+
+// A Connector accepts connections on the listeners and dials the configured
+// nodes that are not connected, and hands the connections to the model once
+// the node on the other end is identified. It is used both by the Engine and
+// by the syncthing binary. The configuration is read from the model, which
+// may change it.
+type Connector struct {
+	MyID      string
+	Model     *model.Model
+	TLSConfig *tls.Config
+
+	// The listeners are served until Stop is closed, and not closed by the
+	// Connector.
+	Listeners []net.Listener
+
+	// Discoverer looks up the addresses of "dynamic" nodes. It may be nil.
+	Discoverer *discover.Discoverer
+
+	// The last working address of each dynamic node is kept in the
+	// AddressCache file, if set, and tried before asking discovery.
+	AddressCache string
+
+	// ClientName and ClientVersion are sent in the Hello message.
+	ClientName    string
+	ClientVersion string
+
+	// Capture, if set, records the messages of all connections.
+	Capture *protocol.Capture
+
+	// Stop ends Serve when closed. A nil Stop serves forever.
+	Stop chan struct{}
+
+	sendBucket  *ratelimit.Bucket
+	recvBucket  *ratelimit.Bucket
+	nodeBuckets map[string][2]*ratelimit.Bucket // node ID -> send and receive buckets
+	addrCache   *addressCache
+	mut         sync.Mutex     // serializes adding connections to the model
+	running     sync.WaitGroup // the goroutines started by Serve
+}
+
+// Serve accepts and makes connections until Stop is closed. It returns once
+// the connections being set up are added to the model or closed.
+func (c *Connector) Serve() {
+	cfg := c.Model.Configuration()
+	c.sendBucket = newRateBucket(cfg.Options.MaxSendKbps)
+	c.recvBucket = newRateBucket(cfg.Options.MaxRecvKbps)
+	c.nodeBuckets = make(map[string][2]*ratelimit.Bucket)
+	if c.AddressCache != "" {
+		c.addrCache = newAddressCache(c.AddressCache)
+	}
+
+	var conns = make(chan *tls.Conn)
+	for _, listener := range c.Listeners {
+		listener := listener
+		c.start(func() { c.listen(listener, conns) })
+	}
+	c.start(func() { c.connect(conns) })
+	c.handleConns(conns)
+	c.running.Wait()
+}
+
+// start runs fn in a goroutine that Serve waits for.
+func (c *Connector) start(fn func()) {
+	c.running.Add(1)
+	go func() {
+		fn()
+		c.running.Done()
+	}()
+}
+
+func (c *Connector) stopped() bool {
+	select {
+	case <-c.Stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Connector) listen(listener net.Listener, conns chan<- *tls.Conn) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if c.stopped() {
+				return
+			}
+			l.Warnln(err)
+			continue
+		}
+
+		if debug {
+			l.Debugln("connect from", conn.RemoteAddr())
+		}
+
+		tc := conn.(*tls.Conn)
+		if err := tc.Handshake(); err != nil {
+			l.Warnln(err)
+			tc.Close()
+			continue
+		}
+
+		select {
+		case conns <- tc:
+		case <-c.Stop:
+			tc.Close()
+			return
+		}
+	}
+}
+
+func (c *Connector) connect(conns chan<- *tls.Conn) {
+	// Nodes that can't be reached are dialed less and less often, up to
+	// the reconnect interval, until discovery has new addresses for them.
+	sup := reconnect.NewSupervisor(time.Second, time.Duration(c.Model.Configuration().Options.ReconnectIntervalS)*time.Second)
+	if c.Discoverer != nil {
+		c.Discoverer.SetChangeHandler(sup.Reset)
+	}
+
+	for {
+		for _, nodeCfg := range c.Model.Configuration().Nodes {
+			if nodeCfg.NodeID == c.MyID {
+				continue
+			}
+			if c.Model.ConnectedTo(nodeCfg.NodeID) {
+				sup.Succeeded(nodeCfg.NodeID)
+				continue
+			}
+			if c.Model.NodePaused(nodeCfg.NodeID) || c.Model.BackingOff(nodeCfg.NodeID) || !sup.Due(nodeCfg.NodeID) {
+				continue
+			}
+
+			if c.dialNode(nodeCfg, conns) {
+				sup.Succeeded(nodeCfg.NodeID)
+			} else {
+				delay := sup.Failed(nodeCfg.NodeID)
+				if debug {
+					l.Debugf("no connection to %s; next attempt in %v", nodeCfg.NodeID, delay)
+				}
+			}
+		}
+
+		if !sup.Wait(time.Second, c.Stop) {
+			return
+		}
+	}
+}
+
+func (c *Connector) dialNode(nodeCfg config.NodeConfiguration, conns chan<- *tls.Conn) bool {
+	var addrs []string
+	for _, addr := range nodeCfg.Addresses {
+		if addr != "dynamic" {
+			addrs = append(addrs, addr)
+			continue
+		}
+
+		// The last working address is tried before discovery, which
+		// may be slow or unreachable.
+		var cached string
+		if c.addrCache != nil {
+			cached = c.addrCache.get(nodeCfg.NodeID)
+		}
+		if cached != "" && c.dial(nodeCfg.NodeID, cached, conns) {
+			return true
+		}
+		if c.Discoverer != nil {
+			for _, addr := range c.Discoverer.Lookup(nodeCfg.NodeID) {
+				if addr != cached {
+					addrs = append(addrs, addr)
+				}
+			}
+		}
+	}
+
+	for _, addr := range addrs {
+		if c.dial(nodeCfg.NodeID, addr, conns) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Connector) dial(nodeID, addr string, conns chan<- *tls.Conn) bool {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil && strings.HasPrefix(err.Error(), "missing port") {
+		// addr is on the form "1.2.3.4"
+		addr = net.JoinHostPort(addr, "22000")
+	} else if err == nil && port == "" {
+		// addr is on the form "1.2.3.4:"
+		addr = net.JoinHostPort(host, "22000")
+	}
+	if debug {
+		l.Debugln("dial", nodeID, addr)
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", addr, c.TLSConfig)
+	if err != nil {
+		if debug {
+			l.Debugln(err)
+		}
+		return false
+	}
+
+	if certs := conn.ConnectionState().PeerCertificates; c.addrCache != nil && len(certs) == 1 && CertID(certs[0].Raw) == nodeID {
+		c.addrCache.set(nodeID, addr)
+	}
+	select {
+	case conns <- conn:
+	case <-c.Stop:
+		conn.Close()
+	}
+	return true
+}
+
+func (c *Connector) handleConns(conns <-chan *tls.Conn) {
+	for {
+		select {
+		case conn := <-conns:
+			// Connections are handled concurrently, as two nodes dialing
+			// each other at the same time would otherwise each wait for
+			// the other's Hello.
+			c.start(func() { c.handleConn(conn) })
+		case <-c.Stop:
+			return
+		}
+	}
+}
+
+func (c *Connector) handleConn(conn *tls.Conn) {
+	certs := conn.ConnectionState().PeerCertificates
+	if cl := len(certs); cl != 1 {
+		l.Infof("Got peer certificate list of length %d != 1 from %s; protocol error", cl, conn.RemoteAddr())
+		conn.Close()
+		return
+	}
+	remoteID := CertID(certs[0].Raw)
+
+	if remoteID == c.MyID {
+		l.Infof("Connected to myself (%s) - should not happen", remoteID)
+		conn.Close()
+		return
+	}
+
+	if c.Model.NodePaused(remoteID) {
+		if debug {
+			l.Debugf("Connection from paused node %s; ignoring", remoteID)
+		}
+		conn.Close()
+		return
+	}
+
+	cfg := c.Model.Configuration()
+	conn.SetDeadline(time.Now().Add(protocol.HelloTimeout))
+	hello, err := protocol.ExchangeHello(conn, protocol.HelloMessage{ClientName: c.ClientName, ClientVersion: c.ClientVersion})
+	conn.SetDeadline(time.Time{})
+	if err != nil {
+		l.Infof("Connection to %s at %s: %v", cfg.NodeName(remoteID), conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	if debug {
+		l.Debugf("Hello from %s: %s %s, protocol version %d", remoteID, hello.ClientName, hello.ClientVersion, hello.Version)
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.Model.ConnectedTo(remoteID) {
+		l.Infof("Connected to already connected node %s", cfg.NodeName(remoteID))
+		conn.Close()
+		return
+	}
+
+	for _, nodeCfg := range cfg.Nodes {
+		if nodeCfg.NodeID != remoteID {
+			continue
+		}
+
+		// The node's buckets are kept across connections, so that
+		// reconnecting does not give a new burst.
+		nb, ok := c.nodeBuckets[remoteID]
+		if !ok {
+			nb = [2]*ratelimit.Bucket{newRateBucket(nodeCfg.MaxSendKbps), newRateBucket(nodeCfg.MaxRecvKbps)}
+			c.nodeBuckets[remoteID] = nb
+		}
+		var wr io.Writer = conn
+		if c.sendBucket != nil || nb[0] != nil {
+			wr = &limitedWriter{&limitedWriter{conn, nb[0]}, c.sendBucket}
+		}
+		var rd io.Reader = conn
+		if c.recvBucket != nil || nb[1] != nil {
+			rd = &limitedReader{&limitedReader{conn, nb[1]}, c.recvBucket}
+		}
+		var receiver protocol.Model = c.Model
+		if c.Capture != nil {
+			receiver = c.Capture.Model(c.Model)
+		}
+		compression, _ := protocol.ParseCompression(nodeCfg.Compression)
+		opts := protocol.ConnectionOptions{
+			Compression:    compression,
+			ReceiveTimeout: time.Duration(cfg.Options.ReceiveTimeoutS) * time.Second,
+			MaxOutstanding: cfg.Options.MaxOutstandingRequests,
+			Version:        hello.Version,
+		}
+		protoConn := protocol.NewConnectionWithOptions(remoteID, rd, wr, receiver, opts)
+		if c.Capture != nil {
+			protoConn = c.Capture.Connection(protoConn)
+		}
+		c.Model.AddConnection(conn, protoConn)
+		return
+	}
+
+	l.Infof("Connection from %s with unknown node ID %s; ignoring", conn.RemoteAddr(), remoteID)
+	conn.Close()
+}
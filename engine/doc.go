@@ -0,0 +1,26 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package engine makes it possible to embed the synchronization engine in
+// other programs.
+//
+// An Engine is created from an Options struct, given repositories and nodes
+// to synchronize with and then started:
+//
+//	e, err := engine.New(engine.Options{Home: dir})
+//	if err != nil {
+//		return err
+//	}
+//	e.AddNode(config.NodeConfiguration{NodeID: otherID, Addresses: []string{"dynamic"}})
+//	e.AddRepository(config.RepositoryConfiguration{
+//		ID:        "default",
+//		Directory: "/some/dir",
+//		Nodes:     []config.NodeConfiguration{{NodeID: otherID}},
+//	})
+//	events := e.Subscribe()
+//	err = e.Start()
+//
+// The Engine does not start the web GUI and does not attempt UPnP port
+// mapping; those are concerns of the syncthing binary.
+package engine
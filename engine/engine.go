@@ -0,0 +1,493 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/discover"
+	"github.com/calmh/syncthing/events"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/model"
+	"github.com/calmh/syncthing/protocol"
+)
+
+var (
+	ErrStarted     = errors.New("engine already started")
+	ErrStopped     = errors.New("engine stopped")
+	ErrNotStarted  = errors.New("engine not started")
+	ErrNoSuchRepo  = errors.New("no such repository")
+	ErrDuplicateID = errors.New("duplicate ID")
+)
+
+// Options controls the creation of an Engine.
+type Options struct {
+	// Home is the directory holding the certificate (cert.pem and key.pem)
+	// and the index cache. A certificate is generated if there is none.
+	Home string
+
+	// Config is the configuration to run with. Repositories and nodes can
+	// be given here or added later using AddRepository and AddNode. If
+	// Config is nil, the default configuration is used.
+	Config *config.Configuration
+
+	// ClientName and ClientVersion are announced to the other nodes. The
+	// defaults are "syncthing" and "embedded".
+	ClientName    string
+	ClientVersion string
+
+	// KeyType is the type of key a new certificate is generated with,
+	// KeyTypeRSA or KeyTypeECDSA. The default is RSA. Existing
+	// certificates of either type are used as they are.
+	KeyType string
+
+	// Filesystem is the filesystem the repositories are on. The default is
+	// the real one; an in memory filesystem is useful for tests.
+	Filesystem fs.Filesystem
+}
+
+// RepoStatus is a summary of the synchronization state of a repository.
+type RepoStatus struct {
+	State       string
+	Invalid     string
+	GlobalFiles int
+	GlobalBytes int64
+	LocalFiles  int
+	LocalBytes  int64
+	NeedFiles   int
+	NeedBytes   int64
+}
+
+type Engine struct {
+	home          string
+	clientName    string
+	clientVersion string
+	cert          tls.Certificate
+	myID          string
+	fs            fs.Filesystem
+
+	cfg        config.Configuration
+	model      *model.Model
+	discoverer *discover.Discoverer
+	listeners  []net.Listener
+	events     eventSource
+	started    bool
+	stopped    bool
+	stop       chan struct{}
+	running    sync.WaitGroup // the connector and the event loop
+	mut        sync.Mutex
+}
+
+// New creates a new, stopped, Engine. The home directory is created if it
+// does not exist.
+func New(opts Options) (*Engine, error) {
+	if opts.Home == "" {
+		return nil, errors.New("no home directory given")
+	}
+	if err := os.MkdirAll(opts.Home, 0700); err != nil {
+		return nil, err
+	}
+
+	cert, err := LoadCert(opts.Home, "")
+	if err != nil {
+		if err := NewCertificate(opts.Home, "", opts.KeyType); err != nil {
+			return nil, err
+		}
+		cert, err = LoadCert(opts.Home, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	myID := CertID(cert.Certificate[0])
+
+	var cfg config.Configuration
+	if opts.Config != nil {
+		cfg = *opts.Config
+		cfg.Nodes = ensureNode(cfg.Nodes, myID)
+		for i := range cfg.Repositories {
+			cfg.Repositories[i].Nodes = ensureNode(cfg.Repositories[i].Nodes, myID)
+		}
+	} else {
+		// Loading from a nil reader gives us the defaults
+		cfg, _ = config.Load(nil, myID)
+	}
+
+	e := &Engine{
+		home:          opts.Home,
+		clientName:    opts.ClientName,
+		clientVersion: opts.ClientVersion,
+		cert:          cert,
+		myID:          myID,
+		cfg:           cfg,
+		fs:            opts.Filesystem,
+		stop:          make(chan struct{}),
+	}
+	if e.clientName == "" {
+		e.clientName = "syncthing"
+	}
+	if e.clientVersion == "" {
+		e.clientVersion = "embedded"
+	}
+	return e, nil
+}
+
+// ID returns the node ID of this Engine.
+func (e *Engine) ID() string {
+	return e.myID
+}
+
+// AddNode adds a node to the configuration. It must be called before Start.
+func (e *Engine) AddNode(node config.NodeConfiguration) error {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	if e.started {
+		return ErrStarted
+	}
+	for _, n := range e.cfg.Nodes {
+		if n.NodeID == node.NodeID {
+			return ErrDuplicateID
+		}
+	}
+	if len(node.Addresses) == 0 {
+		node.Addresses = []string{"dynamic"}
+	}
+	e.cfg.Nodes = append(e.cfg.Nodes, node)
+	return nil
+}
+
+// AddRepository adds a repository to the configuration. It must be called
+// before Start. This node is added to the list of nodes sharing the
+// repository if it is not already present.
+func (e *Engine) AddRepository(repo config.RepositoryConfiguration) error {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	if e.started {
+		return ErrStarted
+	}
+	if repo.ID == "" || repo.Directory == "" {
+		return errors.New("repository ID and directory must be set")
+	}
+	for _, r := range e.cfg.Repositories {
+		if r.ID == repo.ID {
+			return ErrDuplicateID
+		}
+	}
+	repo.Nodes = ensureNode(repo.Nodes, e.myID)
+	e.cfg.Repositories = append(e.cfg.Repositories, repo)
+	return nil
+}
+
+// Configuration returns a copy of the running configuration.
+func (e *Engine) Configuration() config.Configuration {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	if e.model != nil {
+		// The model changes it as it runs
+		return e.model.Configuration()
+	}
+	return e.cfg.Copy()
+}
+
+// Subscribe returns a channel on which events will be delivered. The
+// channel is closed when the Engine is stopped.
+func (e *Engine) Subscribe() <-chan Event {
+	return e.events.subscribe()
+}
+
+// Start scans the repositories, starts listening for and making connections
+// to other nodes and starts synchronizing. Start returns when the initial
+// scan is complete. A stopped Engine cannot be started again.
+func (e *Engine) Start() error {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	if e.stopped {
+		return ErrStopped
+	}
+	if e.started {
+		return ErrStarted
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates:           []tls.Certificate{e.cert},
+		NextProtos:             []string{"bep/1.0"},
+		ServerName:             e.myID,
+		ClientAuth:             tls.RequestClientCert,
+		SessionTicketsDisabled: true,
+		InsecureSkipVerify:     true,
+		MinVersion:             tls.VersionTLS12,
+	}
+
+	for _, addr := range e.cfg.Options.ListenAddress {
+		network, laddr, err := config.ParseListenAddress(addr)
+		if err != nil {
+			e.closeListeners()
+			return err
+		}
+		listener, err := tls.Listen(network, laddr, tlsCfg)
+		if err != nil {
+			e.closeListeners()
+			return err
+		}
+		e.listeners = append(e.listeners, listener)
+	}
+
+	fsys := e.fs
+	if fsys == nil {
+		fsys = fs.DefaultFilesystem
+	}
+	for i, repo := range e.cfg.Repositories {
+		if repo.Invalid != "" {
+			continue
+		}
+		if err := repo.ExpandDirectory(); err != nil {
+			e.cfg.Repositories[i].Invalid = err.Error()
+			continue
+		}
+		e.cfg.Repositories[i].ExpandedDirectory = repo.ExpandedDirectory
+		if err := fsys.MkdirAll(repo.ExpandedDirectory, 0700); err != nil {
+			e.cfg.Repositories[i].Invalid = err.Error()
+		}
+	}
+
+	// The model keeps its own copy of the configuration from here on
+	m := model.NewModel(e.home, &e.cfg, e.clientName, e.clientVersion)
+	m.SetNodeID(e.myID)
+	if e.fs != nil {
+		m.SetFilesystem(e.fs)
+	}
+	for _, repo := range e.cfg.Repositories {
+		if repo.Invalid != "" {
+			continue
+		}
+		repo.Directory = repo.ExpandedDirectory
+		m.AddRepo(repo)
+	}
+
+	m.LoadIndexes(e.home)
+	m.CleanRepos()
+	m.ScanRepos()
+	m.SaveIndexes(e.home)
+	e.model = m
+
+	if e.cfg.Options.LocalAnnEnabled || e.cfg.Options.GlobalAnnEnabled {
+		e.discoverer = e.discovery()
+	}
+
+	c := &Connector{
+		MyID:          e.myID,
+		Model:         m,
+		TLSConfig:     tlsCfg,
+		Listeners:     e.listeners,
+		Discoverer:    e.discoverer,
+		AddressCache:  filepath.Join(e.home, "addresses.txt"),
+		ClientName:    e.clientName,
+		ClientVersion: e.clientVersion,
+		Stop:          e.stop,
+	}
+	e.running.Add(1)
+	go func() {
+		c.Serve()
+		e.running.Done()
+	}()
+
+	cfg := m.Configuration()
+	for _, repo := range cfg.Repositories {
+		if repo.Invalid != "" {
+			continue
+		}
+		if repo.ReadOnly {
+			m.StartRepoRO(repo.ID)
+		} else {
+			m.StartRepoRW(repo.ID, cfg.Options.ParallelRequests)
+		}
+	}
+
+	e.running.Add(1)
+	go func() {
+		e.eventLoop()
+		e.running.Done()
+	}()
+
+	e.started = true
+	return nil
+}
+
+// Stop closes the listening sockets and all connections to other nodes,
+// stops discovery, scanning and pulling and closes all event subscriptions.
+// Stop returns once everything the Engine started has ended. A stopped
+// Engine cannot be restarted.
+func (e *Engine) Stop() {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	if !e.started || e.stopped {
+		return
+	}
+
+	close(e.stop)
+	e.closeListeners()
+	if e.discoverer != nil {
+		e.discoverer.Stop()
+	}
+	// No connections are added once the connector has returned
+	e.running.Wait()
+	e.model.CloseAll(protocol.CloseShutdown, "engine stopped")
+	e.model.Stop()
+	e.model.SaveIndexes(e.home)
+	e.events.close()
+	e.stopped = true
+}
+
+// ListenAddresses returns the addresses actually being listened on. This is
+// useful when the configuration specifies port zero.
+func (e *Engine) ListenAddresses() []net.Addr {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	var addrs []net.Addr
+	for _, l := range e.listeners {
+		addrs = append(addrs, l.Addr())
+	}
+	return addrs
+}
+
+// RepoStatus returns the current status of the given repository.
+func (e *Engine) RepoStatus(repo string) (RepoStatus, error) {
+	e.mut.Lock()
+	m := e.model
+	e.mut.Unlock()
+
+	if m == nil {
+		return RepoStatus{}, ErrNotStarted
+	}
+	var rc config.RepositoryConfiguration
+	var ok bool
+	for _, r := range m.Configuration().Repositories {
+		if r.ID == repo {
+			rc, ok = r, true
+			break
+		}
+	}
+	if !ok {
+		return RepoStatus{}, ErrNoSuchRepo
+	}
+
+	var s RepoStatus
+	s.State = m.State(repo)
+	s.Invalid = rc.Invalid
+	s.GlobalFiles, _, s.GlobalBytes = m.GlobalSize(repo)
+	s.LocalFiles, _, s.LocalBytes = m.LocalSize(repo)
+	s.NeedFiles, s.NeedBytes = m.NeedSize(repo)
+	return s, nil
+}
+
+// Connections returns the connection statistics for each connected node, as
+// well as the totals under the key "total".
+func (e *Engine) Connections() map[string]model.ConnectionInfo {
+	e.mut.Lock()
+	m := e.model
+	e.mut.Unlock()
+	if m == nil {
+		return nil
+	}
+	return m.ConnectionStats()
+}
+
+// Rescan rescans the given repository immediately.
+func (e *Engine) Rescan(repo string) error {
+	e.mut.Lock()
+	m := e.model
+	e.mut.Unlock()
+	if m == nil {
+		return ErrNotStarted
+	}
+	return m.ScanRepo(repo)
+}
+
+func (e *Engine) closeListeners() {
+	for _, l := range e.listeners {
+		l.Close()
+	}
+}
+
+func (e *Engine) discovery() *discover.Discoverer {
+	disc, err := discover.NewDiscoverer(e.myID, config.ListenHostPorts(e.cfg.Options.ListenAddress), e.cfg.Options.LocalAnnPort, e.cfg.Options.LocalAnnMCAddr)
+	if err != nil {
+		l.Warnf("No discovery possible (%v)", err)
+		return nil
+	}
+	if e.cfg.Options.LocalAnnEnabled {
+		disc.StartLocal()
+	}
+	if e.cfg.Options.GlobalAnnEnabled {
+		disc.StartGlobal(e.cfg.Options.GlobalAnnServer, 0)
+	}
+	return disc
+}
+
+// eventLoop polls the model for changes in connection and repository state
+// and emits the corresponding events. The changes logged on the event bus
+// are picked up at once, the others at the next poll.
+func (e *Engine) eventLoop() {
+	connected := make(map[string]bool)
+	states := make(map[string]string)
+
+	sub := events.Default.Subscribe(events.StateChanged | events.NodeConnected | events.NodeDisconnected)
+	defer events.Default.Unsubscribe(sub)
+
+	for {
+		sub.Poll(250 * time.Millisecond)
+		select {
+		case <-e.stop:
+			return
+		default:
+		}
+
+		cfg := e.model.Configuration()
+		for _, node := range cfg.Nodes {
+			if node.NodeID == e.myID {
+				continue
+			}
+			if cur := e.model.ConnectedTo(node.NodeID); cur != connected[node.NodeID] {
+				connected[node.NodeID] = cur
+				t := NodeDisconnected
+				if cur {
+					t = NodeConnected
+				}
+				e.events.emit(Event{Type: t, Node: node.NodeID})
+			}
+		}
+
+		for _, repo := range cfg.Repositories {
+			if repo.Invalid != "" {
+				continue
+			}
+			if cur := e.model.State(repo.ID); cur != states[repo.ID] {
+				states[repo.ID] = cur
+				e.events.emit(Event{Type: RepoStateChanged, Repo: repo.ID, State: cur})
+			}
+		}
+	}
+}
+
+func ensureNode(nodes []config.NodeConfiguration, id string) []config.NodeConfiguration {
+	for _, n := range nodes {
+		if n.NodeID == id {
+			return nodes
+		}
+	}
+	return append(nodes, config.NodeConfiguration{NodeID: id, Addresses: []string{"dynamic"}})
+}
+
+func (s RepoStatus) String() string {
+	return fmt.Sprintf("RepoStatus{State:%q, Global:%d/%d, Local:%d/%d, Need:%d/%d}",
+		s.State, s.GlobalFiles, s.GlobalBytes, s.LocalFiles, s.LocalBytes, s.NeedFiles, s.NeedBytes)
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+)
+
+func TestEngineStartStatus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repoDir := filepath.Join(dir, "repo")
+	os.MkdirAll(repoDir, 0755)
+	ioutil.WriteFile(filepath.Join(repoDir, "foo"), []byte("foobar"), 0644)
+
+	cfg, _ := config.Load(nil, "")
+	cfg.Options.ListenAddress = []string{"127.0.0.1:0"}
+	cfg.Options.LocalAnnEnabled = false
+	cfg.Options.GlobalAnnEnabled = false
+
+	e, err := New(Options{Home: filepath.Join(dir, "home"), Config: &cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.RepoStatus("default"); err != ErrNotStarted {
+		t.Errorf("Unexpected error before start: %v", err)
+	}
+
+	err = e.AddRepository(config.RepositoryConfiguration{ID: "default", Directory: repoDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.AddRepository(config.RepositoryConfiguration{ID: "default", Directory: repoDir}); err != ErrDuplicateID {
+		t.Errorf("Unexpected error for duplicate repository: %v", err)
+	}
+
+	if err := e.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Stop()
+
+	if err := e.AddRepository(config.RepositoryConfiguration{ID: "other", Directory: repoDir}); err != ErrStarted {
+		t.Errorf("Unexpected error adding repository to started engine: %v", err)
+	}
+
+	if addrs := e.ListenAddresses(); len(addrs) != 1 {
+		t.Errorf("Unexpected listen addresses %v", addrs)
+	}
+
+	s, err := e.RepoStatus("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.LocalFiles != 1 || s.LocalBytes != 6 {
+		t.Errorf("Unexpected repository status %v", s)
+	}
+
+	if _, err := e.RepoStatus("nonexistent"); err != ErrNoSuchRepo {
+		t.Errorf("Unexpected error for nonexistent repository: %v", err)
+	}
+}
+
+func TestEngineRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg, _ := config.Load(nil, "")
+	cfg.Options.ListenAddress = []string{"127.0.0.1:0"}
+	cfg.Options.LocalAnnEnabled = false
+	cfg.Options.GlobalAnnEnabled = false
+
+	e, err := New(Options{Home: filepath.Join(dir, "home"), Config: &cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Start(); err != ErrStarted {
+		t.Errorf("Unexpected error starting twice: %v", err)
+	}
+
+	e.Stop()
+	if err := e.Start(); err != ErrStopped {
+		t.Errorf("Unexpected error restarting: %v", err)
+	}
+	// Stopping again does nothing
+	e.Stop()
+}
+
+func TestEngineConnect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var es [2]*Engine
+	for i := range es {
+		cfg, _ := config.Load(nil, "")
+		cfg.Options.ListenAddress = []string{"127.0.0.1:0"}
+		cfg.Options.LocalAnnEnabled = false
+		cfg.Options.GlobalAnnEnabled = false
+		cfg.Options.ReconnectIntervalS = 1
+		es[i], err = New(Options{Home: filepath.Join(dir, fmt.Sprint("home", i)), Config: &cfg, KeyType: KeyTypeECDSA})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first engine accepts the second, which dials it
+	es[0].AddNode(config.NodeConfiguration{NodeID: es[1].ID()})
+	if err := es[0].Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer es[0].Stop()
+	es[1].AddNode(config.NodeConfiguration{NodeID: es[0].ID(), Addresses: []string{es[0].ListenAddresses()[0].String()}})
+	if err := es[1].Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer es[1].Stop()
+
+	for i := 0; i < 100; i++ {
+		if _, ok := es[0].Connections()[es[1].ID()]; ok {
+			if _, ok := es[1].Connections()[es[0].ID()]; ok {
+				return
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Error("Engines did not connect")
+}
+
+func TestEngineStopGoroutines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	before := runtime.NumGoroutine()
+
+	var es [2]*Engine
+	for i := range es {
+		repoDir := filepath.Join(dir, fmt.Sprint("repo", i))
+		os.MkdirAll(repoDir, 0755)
+		ioutil.WriteFile(filepath.Join(repoDir, "foo"), []byte(fmt.Sprint("foo", i)), 0644)
+
+		cfg, _ := config.Load(nil, "")
+		cfg.Options.ListenAddress = []string{"127.0.0.1:0"}
+		cfg.Options.LocalAnnEnabled = false
+		cfg.Options.GlobalAnnEnabled = false
+		cfg.Options.ReconnectIntervalS = 1
+		cfg.Options.WatchFilesystem = true
+		es[i], err = New(Options{Home: filepath.Join(dir, fmt.Sprint("home", i)), Config: &cfg, KeyType: KeyTypeECDSA})
+		if err != nil {
+			t.Fatal(err)
+		}
+		es[i].AddRepository(config.RepositoryConfiguration{
+			ID:         "default",
+			Directory:  repoDir,
+			ReadOnly:   i == 1,
+			Versioning: config.VersioningConfiguration{Type: "staggered", Params: map[string]string{"cleanInterval": "1"}},
+		})
+	}
+	es[0].AddNode(config.NodeConfiguration{NodeID: es[1].ID()})
+	if err := es[0].Start(); err != nil {
+		t.Fatal(err)
+	}
+	es[1].AddNode(config.NodeConfiguration{NodeID: es[0].ID(), Addresses: []string{es[0].ListenAddresses()[0].String()}})
+	if err := es[1].Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, ok := es[0].Connections()[es[1].ID()]; ok {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if _, ok := es[0].Connections()[es[1].ID()]; !ok {
+		t.Fatal("Engines did not connect")
+	}
+
+	es[0].Stop()
+	es[1].Stop()
+
+	// Goroutines of the runtime and of closed network connections may
+	// take a moment to go away
+	for i := 0; i < 50 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		buf := make([]byte, 1<<20)
+		buf = buf[:runtime.Stack(buf, true)]
+		t.Errorf("%d goroutines before starting, %d after stopping:\n%s", before, n, buf)
+	}
+}
+
+func TestEngineDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var es [2]*Engine
+	var fss [2]*fs.FakeFilesystem
+	for i := range es {
+		cfg, _ := config.Load(nil, "")
+		cfg.Options.ListenAddress = []string{"127.0.0.1:0"}
+		cfg.Options.LocalAnnEnabled = false
+		cfg.Options.GlobalAnnEnabled = false
+		cfg.Options.ReconnectIntervalS = 1
+		fss[i] = fs.NewFakeFilesystem()
+		fss[i].MkdirAll("dry", 0755)
+		fss[i].MkdirAll("wet", 0755)
+		es[i], err = New(Options{Home: filepath.Join(dir, fmt.Sprint("home", i)), Config: &cfg, KeyType: KeyTypeECDSA, Filesystem: fss[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"dry/file", "wet/file"} {
+		fd, _ := fss[0].Create(name)
+		fd.Write([]byte("contents"))
+		fd.Close()
+	}
+
+	nodes := []config.NodeConfiguration{{NodeID: es[0].ID()}, {NodeID: es[1].ID()}}
+	es[0].AddNode(config.NodeConfiguration{NodeID: es[1].ID()})
+	es[0].AddRepository(config.RepositoryConfiguration{ID: "dry", Directory: "dry", Nodes: nodes})
+	es[0].AddRepository(config.RepositoryConfiguration{ID: "wet", Directory: "wet", Nodes: nodes})
+	if err := es[0].Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer es[0].Stop()
+
+	es[1].AddNode(config.NodeConfiguration{NodeID: es[0].ID(), Addresses: []string{es[0].ListenAddresses()[0].String()}})
+	es[1].AddRepository(config.RepositoryConfiguration{ID: "dry", Directory: "dry", Nodes: nodes, DryRun: true})
+	es[1].AddRepository(config.RepositoryConfiguration{ID: "wet", Directory: "wet", Nodes: nodes})
+	if err := es[1].Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer es[1].Stop()
+
+	// Both repositories learn about the file at the same time; once the
+	// regular one has pulled it, the dry run one would have too.
+	for i := 0; ; i++ {
+		if s, _ := es[1].RepoStatus("wet"); s.LocalFiles == 1 {
+			break
+		}
+		if i == 200 {
+			t.Fatal("Regular repository not synced")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if s, _ := es[1].RepoStatus("dry"); s.NeedFiles != 1 || s.LocalFiles != 0 {
+		t.Errorf("Unexpected dry run repository status %v", s)
+	}
+	if _, err := fss[1].Lstat("dry/file"); !os.IsNotExist(err) {
+		t.Errorf("Dry run repository changed on disk: %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type EventType int
+
+const (
+	NodeConnected EventType = iota
+	NodeDisconnected
+	RepoStateChanged
+)
+
+func (t EventType) String() string {
+	switch t {
+	case NodeConnected:
+		return "NodeConnected"
+	case NodeDisconnected:
+		return "NodeDisconnected"
+	case RepoStateChanged:
+		return "RepoStateChanged"
+	default:
+		return "Unknown"
+	}
+}
+
+// An Event describes something that happened in the Engine. Node is set for
+// the node events, Repo and State for RepoStateChanged.
+type Event struct {
+	Type  EventType
+	Time  time.Time
+	Node  string
+	Repo  string
+	State string
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("Event{Type:%v, Node:%q, Repo:%q, State:%q}", e.Type, e.Node, e.Repo, e.State)
+}
+
+// Events are delivered to subscribers over buffered channels of this size.
+// A subscriber that falls this far behind will miss events.
+const eventBufferSize = 64
+
+type eventSource struct {
+	subs []chan Event
+	mut  sync.Mutex
+}
+
+func (s *eventSource) subscribe() <-chan Event {
+	ch := make(chan Event, eventBufferSize)
+	s.mut.Lock()
+	s.subs = append(s.subs, ch)
+	s.mut.Unlock()
+	return ch
+}
+
+func (s *eventSource) emit(e Event) {
+	e.Time = time.Now()
+	if debug {
+		l.Debugln("event:", e)
+	}
+
+	s.mut.Lock()
+	for _, ch := range s.subs {
+		select {
+		case ch <- e:
+		default:
+			if debug {
+				l.Debugln("event: dropped for slow subscriber")
+			}
+		}
+	}
+	s.mut.Unlock()
+}
+
+func (s *eventSource) close() {
+	s.mut.Lock()
+	for _, ch := range s.subs {
+		close(ch)
+	}
+	s.subs = nil
+	s.mut.Unlock()
+}
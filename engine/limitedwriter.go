@@ -0,0 +1,63 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"io"
+
+	"github.com/juju/ratelimit"
+)
+
+type limitedWriter struct {
+	w      io.Writer
+	bucket *ratelimit.Bucket
+}
+
+func (w *limitedWriter) Write(buf []byte) (int, error) {
+	if w.bucket != nil {
+		w.bucket.Wait(int64(len(buf)))
+	}
+	return w.w.Write(buf)
+}
+
+// Close closes the underlying writer, so that the connection can close the
+// transport through the limiter.
+func (w *limitedWriter) Close() error {
+	if c, ok := w.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// limitedReader waits for the bucket after each read, so that the other
+// node is slowed down by TCP flow control once the buffers fill up.
+type limitedReader struct {
+	r      io.Reader
+	bucket *ratelimit.Bucket
+}
+
+func (r *limitedReader) Read(buf []byte) (int, error) {
+	n, err := r.r.Read(buf)
+	if r.bucket != nil && n > 0 {
+		r.bucket.Wait(int64(n))
+	}
+	return n, err
+}
+
+func (r *limitedReader) Close() error {
+	if c, ok := r.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// newRateBucket returns a bucket for the rate limit in KiB/s, or nil when
+// there is no limit. Five seconds worth of data may be sent in a burst.
+func newRateBucket(kbps int) *ratelimit.Bucket {
+	if kbps <= 0 {
+		return nil
+	}
+	return ratelimit.NewBucketWithRate(float64(1000*kbps), int64(5*1000*kbps))
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	mr "math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+)
+
+const (
+	tlsRSABits = 3072
+	tlsName    = "syncthing"
+)
+
+// The key types a certificate can be generated with. ECDSA keys make for
+// much faster TLS handshakes on slow CPUs. The node ID is the hash of the
+// certificate either way.
+const (
+	KeyTypeRSA   = "rsa"
+	KeyTypeECDSA = "ecdsa"
+)
+
+// LoadCert loads the certificate and key in prefix+"cert.pem" and
+// prefix+"key.pem" in the directory.
+func LoadCert(dir, prefix string) (tls.Certificate, error) {
+	return tls.LoadX509KeyPair(filepath.Join(dir, prefix+"cert.pem"), filepath.Join(dir, prefix+"key.pem"))
+}
+
+// CertID returns the node ID of the DER encoded certificate.
+func CertID(bs []byte) string {
+	return protocol.NewNodeID(bs).String()
+}
+
+// NewCertificate generates a self signed certificate with a key of the
+// given type and saves them as prefix+"cert.pem" and prefix+"key.pem" in
+// the directory.
+func NewCertificate(dir, prefix, keyType string) error {
+	pub, priv, keyBlock, err := generateKey(keyType)
+	if err != nil {
+		return err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: new(big.Int).SetInt64(mr.Int63()),
+		Subject: pkix.Name{
+			CommonName: tlsName,
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC),
+
+		KeyUsage:              keyUsage(keyType),
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, pub, priv)
+	if err != nil {
+		return err
+	}
+
+	certOut, err := os.Create(filepath.Join(dir, prefix+"cert.pem"))
+	if err != nil {
+		return err
+	}
+	pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	if err := certOut.Close(); err != nil {
+		return err
+	}
+
+	keyOut, err := os.OpenFile(filepath.Join(dir, prefix+"key.pem"), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	pem.Encode(keyOut, keyBlock)
+	return keyOut.Close()
+}
+
+// generateKey returns a new key pair of the type, and the private key as a
+// PEM block for key.pem.
+func generateKey(keyType string) (pub, priv interface{}, block *pem.Block, err error) {
+	switch keyType {
+	case KeyTypeRSA, "":
+		l.Infoln("Generating RSA certificate and key...")
+		key, err := rsa.GenerateKey(rand.Reader, tlsRSABits)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return &key.PublicKey, key, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}, nil
+
+	case KeyTypeECDSA:
+		l.Infoln("Generating ECDSA certificate and key...")
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return &key.PublicKey, key, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}, nil
+	}
+	return nil, nil, nil, fmt.Errorf("unknown key type %q", keyType)
+}
+
+// keyUsage returns the key usage for a certificate with the key type. Only
+// RSA keys are used for key encipherment; ECDSA keys only sign.
+func keyUsage(keyType string) x509.KeyUsage {
+	if keyType == KeyTypeECDSA {
+		return x509.KeyUsageDigitalSignature
+	}
+	return x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+}
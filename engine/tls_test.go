@@ -0,0 +1,83 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestECDSACertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ecDir := filepath.Join(dir, "ecdsa")
+	rsaDir := filepath.Join(dir, "rsa")
+	os.Mkdir(ecDir, 0700)
+	os.Mkdir(rsaDir, 0700)
+	if err := NewCertificate(ecDir, "", KeyTypeECDSA); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewCertificate(rsaDir, "", KeyTypeRSA); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewCertificate(dir, "", "dsa"); err == nil {
+		t.Error("Unexpected nil error for unknown key type")
+	}
+
+	ecCert, err := LoadCert(ecDir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ecCert.PrivateKey.(*ecdsa.PrivateKey); !ok {
+		t.Fatalf("Unexpected key type %T", ecCert.PrivateKey)
+	}
+	rsaCert, err := LoadCert(rsaDir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nodes with either type of key can talk to each other, and see the
+	// same node IDs as the nodes themselves.
+	c0, c1 := net.Pipe()
+	server := tls.Server(c0, &tls.Config{
+		Certificates:           []tls.Certificate{ecCert},
+		ClientAuth:             tls.RequestClientCert,
+		SessionTicketsDisabled: true,
+		MinVersion:             tls.VersionTLS12,
+	})
+	client := tls.Client(c1, &tls.Config{
+		Certificates:           []tls.Certificate{rsaCert},
+		InsecureSkipVerify:     true,
+		SessionTicketsDisabled: true,
+		MinVersion:             tls.VersionTLS12,
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Handshake()
+	}()
+	if err := client.Handshake(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+
+	if id := CertID(client.ConnectionState().PeerCertificates[0].Raw); id != CertID(ecCert.Certificate[0]) {
+		t.Errorf("Server node ID %s != %s", id, CertID(ecCert.Certificate[0]))
+	}
+	if id := CertID(server.ConnectionState().PeerCertificates[0].Raw); id != CertID(rsaCert.Certificate[0]) {
+		t.Errorf("Client node ID %s != %s", id, CertID(rsaCert.Certificate[0]))
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package events implements an event bus. Events are logged by the parts of
+// the program where things happen and delivered to any number of
+// subscribers, each of which has its own buffer so that a slow subscriber
+// never holds up the others.
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// An EventType is a single bit, so that types can be combined into a mask
+// when subscribing.
+type EventType int
+
+const (
+	StateChanged EventType = 1 << iota
+	ItemStarted
+	ItemFinished
+	NodeConnected
+	NodeDisconnected
+	RepoCompletion
+	Ping // logged to check that events are delivered
+
+	AllEvents = ^EventType(0)
+)
+
+func (t EventType) String() string {
+	switch t {
+	case StateChanged:
+		return "StateChanged"
+	case ItemStarted:
+		return "ItemStarted"
+	case ItemFinished:
+		return "ItemFinished"
+	case NodeConnected:
+		return "NodeConnected"
+	case NodeDisconnected:
+		return "NodeDisconnected"
+	case RepoCompletion:
+		return "RepoCompletion"
+	case Ping:
+		return "Ping"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText makes the type appear by name in JSON.
+func (t EventType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// An Event is something that happened. The IDs of the events logged by a
+// Logger start at one and increase by one for every event. The Data depends
+// on the type of the event.
+type Event struct {
+	ID   int         `json:"id"`
+	Type EventType   `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("Event{ID:%d, Type:%v, Data:%v}", e.ID, e.Type, e.Data)
+}
+
+// Events are buffered for every subscriber up to this many. A subscriber
+// that falls further behind misses events.
+const BufferSize = 64
+
+var (
+	ErrTimeout = errors.New("timeout")
+	ErrClosed  = errors.New("closed")
+)
+
+// A Logger delivers the events logged to it to its subscribers.
+type Logger struct {
+	subs    map[int]*Subscription
+	nextID  int
+	nextSub int
+	mut     sync.Mutex
+}
+
+// Default is the Logger that the events of the program are logged to.
+var Default = NewLogger()
+
+func NewLogger() *Logger {
+	return &Logger{
+		subs: make(map[int]*Subscription),
+	}
+}
+
+// Log delivers an event of the given type to the subscribers that are
+// interested in it.
+func (l *Logger) Log(t EventType, data interface{}) {
+	l.mut.Lock()
+	l.nextID++
+	e := Event{
+		ID:   l.nextID,
+		Type: t,
+		Time: time.Now(),
+		Data: data,
+	}
+	if debug {
+		dl.Debugln("log", e)
+	}
+	for _, s := range l.subs {
+		if s.mask&t == 0 {
+			continue
+		}
+		select {
+		case s.events <- e:
+		default:
+			if debug {
+				dl.Debugln("dropped", e.ID, "for slow subscriber", s.id)
+			}
+		}
+	}
+	l.mut.Unlock()
+}
+
+// Subscribe returns a Subscription to the events of the types in the
+// mask.
+func (l *Logger) Subscribe(mask EventType) *Subscription {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+	s := &Subscription{
+		id:     l.nextSub,
+		mask:   mask,
+		events: make(chan Event, BufferSize),
+	}
+	l.nextSub++
+	l.subs[s.id] = s
+	return s
+}
+
+// Unsubscribe stops the events being delivered to the Subscription. Polling
+// it returns ErrClosed once the buffered events are received.
+func (l *Logger) Unsubscribe(s *Subscription) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+	if _, ok := l.subs[s.id]; ok {
+		delete(l.subs, s.id)
+		close(s.events)
+	}
+}
+
+// A Subscription receives the events of the types it was subscribed to.
+type Subscription struct {
+	id     int
+	mask   EventType
+	events chan Event
+}
+
+// Poll returns the next event, waiting for at most the given time for one
+// to arrive. A zero timeout means waiting forever.
+func (s *Subscription) Poll(timeout time.Duration) (Event, error) {
+	var tc <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		tc = t.C
+	}
+
+	select {
+	case e, ok := <-s.events:
+		if !ok {
+			return e, ErrClosed
+		}
+		return e, nil
+	case <-tc:
+		return Event{}, ErrTimeout
+	}
+}
+
+// A BufferedSubscription keeps the latest events of a Subscription, so that
+// they can be fetched by several pollers, each starting from the last event
+// it has seen.
+type BufferedSubscription struct {
+	sub     *Subscription
+	buf     []Event
+	next    int // index in buf for the next event
+	full    bool
+	changed chan struct{} // closed and replaced when an event arrives
+	mut     sync.Mutex
+}
+
+// NewBufferedSubscription keeps the latest size events of the Subscription
+// until it is unsubscribed.
+func NewBufferedSubscription(s *Subscription, size int) *BufferedSubscription {
+	bs := &BufferedSubscription{
+		sub:     s,
+		buf:     make([]Event, size),
+		changed: make(chan struct{}),
+	}
+	go bs.pollingLoop()
+	return bs
+}
+
+func (s *BufferedSubscription) pollingLoop() {
+	for {
+		e, err := s.sub.Poll(0)
+		if err != nil {
+			return
+		}
+
+		s.mut.Lock()
+		s.buf[s.next] = e
+		s.next++
+		if s.next == len(s.buf) {
+			s.next = 0
+			s.full = true
+		}
+		close(s.changed)
+		s.changed = make(chan struct{})
+		s.mut.Unlock()
+	}
+}
+
+// Since returns the kept events with an ID larger than the given one, in
+// order. If there are none, it waits for at most the given time for one to
+// arrive and returns nil if none does.
+func (s *BufferedSubscription) Since(id int, timeout time.Duration) []Event {
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+
+	for {
+		s.mut.Lock()
+		res := s.since(id)
+		changed := s.changed
+		s.mut.Unlock()
+		if len(res) > 0 {
+			return res
+		}
+
+		select {
+		case <-changed:
+		case <-t.C:
+			return nil
+		}
+	}
+}
+
+func (s *BufferedSubscription) since(id int) []Event {
+	var res []Event
+	if s.full {
+		for _, e := range s.buf[s.next:] {
+			if e.ID > id {
+				res = append(res, e)
+			}
+		}
+	}
+	for _, e := range s.buf[:s.next] {
+		if e.ID > id {
+			res = append(res, e)
+		}
+	}
+	return res
+}
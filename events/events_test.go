@@ -0,0 +1,111 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const timeout = 100 * time.Millisecond
+
+func TestSubscriptionMask(t *testing.T) {
+	l := NewLogger()
+	s := l.Subscribe(NodeConnected | NodeDisconnected)
+	defer l.Unsubscribe(s)
+
+	l.Log(StateChanged, "ignored")
+	l.Log(NodeConnected, "node")
+
+	e, err := s.Poll(timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != NodeConnected || e.Data != "node" || e.ID != 2 {
+		t.Errorf("Unexpected event %v", e)
+	}
+	if _, err := s.Poll(timeout); err != ErrTimeout {
+		t.Errorf("Unexpected error %v; expected timeout", err)
+	}
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	l := NewLogger()
+	slow := l.Subscribe(AllEvents)
+	fast := l.Subscribe(AllEvents)
+
+	for i := 0; i < BufferSize+10; i++ {
+		l.Log(ItemStarted, i)
+		if _, err := fast.Poll(timeout); err != nil {
+			t.Fatal("Fast subscriber held up:", err)
+		}
+	}
+
+	for i := 0; i < BufferSize; i++ {
+		e, err := slow.Poll(timeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Data != i {
+			t.Fatalf("Unexpected event %v", e)
+		}
+	}
+	if _, err := slow.Poll(timeout); err != ErrTimeout {
+		t.Errorf("Unexpected error %v; events past the buffer should be dropped", err)
+	}
+
+	l.Unsubscribe(slow)
+	if _, err := slow.Poll(timeout); err != ErrClosed {
+		t.Errorf("Unexpected error %v after unsubscribe", err)
+	}
+}
+
+func TestBufferedSince(t *testing.T) {
+	l := NewLogger()
+	s := l.Subscribe(AllEvents)
+	defer l.Unsubscribe(s)
+	bs := NewBufferedSubscription(s, 4)
+
+	if evs := bs.Since(0, timeout); evs != nil {
+		t.Errorf("Unexpected events %v", evs)
+	}
+
+	go func() {
+		time.Sleep(timeout / 2)
+		l.Log(StateChanged, nil)
+	}()
+	evs := bs.Since(0, time.Second)
+	if len(evs) != 1 || evs[0].ID != 1 {
+		t.Fatalf("Unexpected events %v", evs)
+	}
+
+	for i := 0; i < 5; i++ {
+		l.Log(ItemFinished, nil)
+	}
+	time.Sleep(timeout / 2)
+
+	// Only the latest four are kept
+	evs = bs.Since(1, timeout)
+	if len(evs) != 4 || evs[0].ID != 3 || evs[3].ID != 6 {
+		t.Errorf("Unexpected events %v", evs)
+	}
+	evs = bs.Since(5, timeout)
+	if len(evs) != 1 || evs[0].ID != 6 {
+		t.Errorf("Unexpected events %v", evs)
+	}
+}
+
+func TestEventJSON(t *testing.T) {
+	bs, err := json.Marshal(Event{ID: 1, Type: RepoCompletion})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e map[string]interface{}
+	json.Unmarshal(bs, &e)
+	if e["type"] != "RepoCompletion" {
+		t.Errorf("Unexpected type %v in %s", e["type"], bs)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import "fmt"
+
+// A Bitset holds a bit per connection ID. It grows as needed, so there is no
+// limit on the number of nodes; the zero value is the empty set.
+type Bitset []uint64
+
+// NewBitset returns a bitset with the bits for the connection IDs set.
+func NewBitset(ids ...uint) Bitset {
+	var b Bitset
+	for _, id := range ids {
+		b = b.set(id)
+	}
+	return b
+}
+
+// Has returns true if the bit for the connection ID is set.
+func (b Bitset) Has(id uint) bool {
+	i := id / 64
+	return i < uint(len(b)) && b[i]&(1<<(id%64)) != 0
+}
+
+// IDs returns the connection IDs whose bits are set, in increasing order.
+func (b Bitset) IDs() []uint {
+	var ids []uint
+	for i, w := range b {
+		for j := uint(0); j < 64; j++ {
+			if w&(1<<j) != 0 {
+				ids = append(ids, uint(i)*64+j)
+			}
+		}
+	}
+	return ids
+}
+
+func (b Bitset) String() string {
+	return fmt.Sprint(b.IDs())
+}
+
+// set returns the bitset with the bit for the connection ID set. The
+// underlying array of b may be modified.
+func (b Bitset) set(id uint) Bitset {
+	i := id / 64
+	for uint(len(b)) <= i {
+		b = append(b, 0)
+	}
+	b[i] |= 1 << (id % 64)
+	return b
+}
+
+func (b Bitset) clone() Bitset {
+	if b == nil {
+		return nil
+	}
+	return append(Bitset(nil), b...)
+}
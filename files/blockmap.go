@@ -0,0 +1,75 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import (
+	"github.com/calmh/syncthing/cid"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// localID is cid.LocalID, for the methods where cid names a connection ID.
+var localID = cid.LocalID
+
+// A BlockLocation is a block of a local file.
+type BlockLocation struct {
+	Name  string
+	Block scanner.Block
+}
+
+// A blockMap finds the local files holding a block by its hash, so that the
+// block can be copied from them instead of being downloaded, such as when a
+// file has been renamed or duplicated on another node. It is kept in
+// memory, and rebuilt from the local index when that is loaded.
+type blockMap map[string][]BlockLocation
+
+// add adds the blocks of the file, if it has contents on disk.
+func (bm blockMap) add(f scanner.File) {
+	if !hasBlocks(f) {
+		return
+	}
+	for _, b := range f.Blocks {
+		h := string(b.Hash)
+		bm[h] = append(bm[h], BlockLocation{f.Name, b})
+	}
+}
+
+// remove removes the blocks added for the file.
+func (bm blockMap) remove(f scanner.File) {
+	if !hasBlocks(f) {
+		return
+	}
+	for _, b := range f.Blocks {
+		h := string(b.Hash)
+		locs := bm[h]
+		for i := 0; i < len(locs); i++ {
+			if locs[i].Name == f.Name && locs[i].Block.Offset == b.Offset {
+				locs[i] = locs[len(locs)-1]
+				locs = locs[:len(locs)-1]
+				break
+			}
+		}
+		if len(locs) == 0 {
+			delete(bm, h)
+		} else {
+			bm[h] = locs
+		}
+	}
+}
+
+func hasBlocks(f scanner.File) bool {
+	const noBlocks = protocol.FlagDeleted | protocol.FlagDirectory | protocol.FlagSymlink | protocol.FlagBlockGroups
+	return f.Flags&noBlocks == 0 && !f.Suppressed
+}
+
+// FindBlock returns the local files holding a block with the hash. The
+// files may have changed on disk since they were scanned, so the contents
+// must be verified.
+func (m *Set) FindBlock(hash []byte) []BlockLocation {
+	m.Lock()
+	defer m.Unlock()
+	locs := m.blocks[string(hash)]
+	return append([]BlockLocation(nil), locs...)
+}
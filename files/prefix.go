@@ -0,0 +1,51 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// WithPrefix calls fn for each file that the node has in the directory
+// prefix, the directory itself included, in order by name. An empty prefix
+// means all files. The names of the node's files are listed when first
+// needed and then kept sorted, so that only the files in the directory are
+// visited.
+func (m *Set) WithPrefix(id uint, prefix string, fn Iterator) {
+	if debug {
+		l.Debugf("WithPrefix(%d, %q)", id, prefix)
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	names, ok := m.names[id]
+	if !ok {
+		names = make([]string, 0, len(m.remoteKey[id]))
+		for n := range m.remoteKey[id] {
+			names = append(names, n)
+		}
+		m.names[id] = names
+		m.namesSorted[id] = false
+	}
+	if !m.namesSorted[id] {
+		sort.Strings(names)
+		m.namesSorted[id] = true
+	}
+
+	rk := m.remoteKey[id]
+	if prefix != "" {
+		if k, ok := rk[prefix]; ok && !fn(m.files[k].File) {
+			return
+		}
+		prefix += string(filepath.Separator)
+	}
+	for i := sort.SearchStrings(names, prefix); i < len(names) && strings.HasPrefix(names[i], prefix); i++ {
+		if !fn(m.files[rk[names[i]]].File) {
+			return
+		}
+	}
+}
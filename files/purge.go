@@ -0,0 +1,105 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import (
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// markDeleted records when the local file was first seen deleted. The time
+// is not saved with the index, so after a restart the retention starts over.
+func (m *Set) markDeleted(f scanner.File) {
+	if !protocol.IsDeleted(f.Flags) {
+		delete(m.tombstones, f.Name)
+	} else if _, ok := m.tombstones[f.Name]; !ok {
+		m.tombstones[f.Name] = time.Now()
+	}
+}
+
+// PurgeDeleted removes the records of local files that were deleted before
+// the given time, once every node in acked and every other node in the set
+// has the same record, so that no node still needs to learn of the
+// deletion. It returns the number of files removed.
+func (m *Set) PurgeDeleted(acked []uint, before time.Time) int {
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
+	m.Lock()
+	defer m.Unlock()
+
+	var purged int
+	touched := make(map[uint]bool)
+	for n, t := range m.tombstones {
+		if !t.Before(before) {
+			continue
+		}
+		if !m.purgeable(n, acked) {
+			if lk, ok := m.remoteKey[localID][n]; !ok || !protocol.IsDeleted(m.files[lk].File.Flags) {
+				// Left over from a replace that removed the file
+				delete(m.tombstones, n)
+			}
+			continue
+		}
+
+		gk := m.globalKey[n]
+		for id, rem := range m.remoteKey {
+			if _, ok := rem[n]; ok {
+				delete(rem, n)
+				touched[id] = true
+				m.notify(id, n, gk, key{})
+			}
+		}
+		delete(m.files, gk)
+		delete(m.globalKey, n)
+		delete(m.globalAvailability, n)
+		delete(m.tombstones, n)
+		purged++
+	}
+
+	if purged == 0 {
+		return 0
+	}
+	for id := range touched {
+		rem := m.remoteKey[id]
+		if cur, ok := m.names[id]; ok {
+			names := cur[:0]
+			for _, n := range cur {
+				if _, ok := rem[n]; ok {
+					names = append(names, n)
+				}
+			}
+			m.names[id] = names
+		}
+		m.changes[id]++
+	}
+	m.recount()
+
+	if debug {
+		l.Debugf("PurgeDeleted: %d files", purged)
+	}
+	return purged
+}
+
+// purgeable returns true if the local file is deleted and every node that
+// has it, and every node in acked, has the same version as the local one.
+func (m *Set) purgeable(n string, acked []uint) bool {
+	lk, ok := m.remoteKey[localID][n]
+	if !ok || lk != m.globalKey[n] || !protocol.IsDeleted(m.files[lk].File.Flags) {
+		return false
+	}
+	for _, id := range acked {
+		if rk, ok := m.remoteKey[id][n]; !ok || rk != lk {
+			return false
+		}
+	}
+	for _, rem := range m.remoteKey {
+		if rk, ok := rem[n]; ok && rk != lk {
+			return false
+		}
+	}
+	return true
+}
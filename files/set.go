@@ -6,7 +6,12 @@
 package files
 
 import (
+	"expvar"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/calmh/syncthing/cid"
 	"github.com/calmh/syncthing/lamport"
@@ -20,38 +25,69 @@ type fileRecord struct {
 	Global bool
 }
 
-type bitset uint64
+var expRecordsWritten = expvar.NewInt("files.recordsWritten")
+
+// UpdateBatchSize is the number of files that are updated at a time. The
+// lock is released between batches, so that readers are not stalled by
+// large updates, while other updates wait for the whole update to finish.
+var UpdateBatchSize = 10000
 
 type Set struct {
 	sync.Mutex
+	wmut               sync.Mutex // held by updates for their whole duration
 	files              map[key]fileRecord
-	remoteKey          [64]map[string]key
-	changes            [64]uint64
-	globalAvailability map[string]bitset
+	remoteKey          map[uint]map[string]key
+	changes            map[uint]uint64
+	globalAvailability map[string]Bitset
 	globalKey          map[string]key
+	globalSize         size
+	localSize          map[uint]size
+	needSize           map[uint]size
+	blocks             blockMap          // of the local files; nil when shrunk
+	names              map[uint][]string // built when first needed by WithPrefix
+	namesSorted        map[uint]bool
+	subs               []*subscriber
+	prevKeys           map[string]key       // of the node being replaced
+	tombstones         map[string]time.Time // when local files were seen deleted
+	vectorID           uint64               // of the local node
 }
 
 func NewSet() *Set {
 	var m = Set{
 		files:              make(map[key]fileRecord),
-		globalAvailability: make(map[string]bitset),
+		remoteKey:          make(map[uint]map[string]key),
+		changes:            make(map[uint]uint64),
+		globalAvailability: make(map[string]Bitset),
 		globalKey:          make(map[string]key),
+		localSize:          make(map[uint]size),
+		needSize:           make(map[uint]size),
+		blocks:             make(blockMap),
+		names:              make(map[uint][]string),
+		namesSorted:        make(map[uint]bool),
+		tombstones:         make(map[string]time.Time),
 	}
 	return &m
 }
 
+// SetVectorID sets the ID of the local node in the version vectors of the
+// files that the set marks as deleted.
+func (m *Set) SetVectorID(id uint64) {
+	m.Lock()
+	m.vectorID = id
+	m.Unlock()
+}
+
 func (m *Set) Replace(id uint, fs []scanner.File) {
 	if debug {
 		l.Debugf("Replace(%d, [%d])", id, len(fs))
 	}
-	if id > 63 {
-		panic("Connection ID must be in the range 0 - 63 inclusive")
-	}
 
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
 	m.Lock()
 	if len(fs) == 0 || !m.equals(id, fs) {
-		m.changes[id]++
 		m.replace(id, fs)
+		m.changes[id]++
 	}
 	m.Unlock()
 }
@@ -60,14 +96,11 @@ func (m *Set) ReplaceWithDelete(id uint, fs []scanner.File) {
 	if debug {
 		l.Debugf("ReplaceWithDelete(%d, [%d])", id, len(fs))
 	}
-	if id > 63 {
-		panic("Connection ID must be in the range 0 - 63 inclusive")
-	}
 
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
 	m.Lock()
 	if len(fs) == 0 || !m.equals(id, fs) {
-		m.changes[id]++
-
 		var nf = make(map[string]key, len(fs))
 		for _, f := range fs {
 			nf[f.Name] = keyFor(f)
@@ -85,6 +118,7 @@ func (m *Set) ReplaceWithDelete(id uint, fs []scanner.File) {
 					cf.Blocks = nil
 					cf.Size = 0
 					cf.Version = lamport.Default.Tick(cf.Version)
+					cf.Vector = cf.Vector.Update(m.vectorID)
 				}
 				fs = append(fs, cf)
 				if debug {
@@ -94,71 +128,201 @@ func (m *Set) ReplaceWithDelete(id uint, fs []scanner.File) {
 		}
 
 		m.replace(id, fs)
+		m.changes[id]++
 	}
 	m.Unlock()
 }
 
+// UpdateWithDelete updates the files in the subtree rooted at sub, which may
+// be a single file, to those in fs. Previously existing files in the subtree
+// that are not in fs are marked as deleted. Files outside the subtree are not
+// touched.
+func (m *Set) UpdateWithDelete(id uint, sub string, fs []scanner.File) {
+	if debug {
+		l.Debugf("UpdateWithDelete(%d, %q, [%d])", id, sub, len(fs))
+	}
+
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
+	m.Lock()
+	defer m.Unlock()
+
+	remFiles := m.remoteKey[id]
+	var nf = make(map[string]struct{}, len(fs))
+	var changed []scanner.File
+	for _, f := range fs {
+		nf[f.Name] = struct{}{}
+		if ck, ok := remFiles[f.Name]; !ok || ck != keyFor(f) {
+			changed = append(changed, f)
+		}
+	}
+
+	changed = append(changed, m.deleted(id, sub, nf)...)
+
+	if len(changed) > 0 {
+		m.updateBatched(id, changed)
+		m.changes[id]++
+	}
+}
+
+// deleted returns the files in the subtree rooted at sub, or in the whole
+// set if sub is empty, that are not in seen, marked as deleted. Files that
+// are already deleted are left out.
+func (m *Set) deleted(id uint, sub string, seen map[string]struct{}) []scanner.File {
+	var res []scanner.File
+	prefix := sub + string(filepath.Separator)
+	for n, ck := range m.remoteKey[id] {
+		if sub != "" && n != sub && !strings.HasPrefix(n, prefix) {
+			continue
+		}
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		cf := m.files[ck].File
+		if protocol.IsDeleted(cf.Flags) {
+			continue
+		}
+		cf.Flags |= protocol.FlagDeleted
+		cf.Blocks = nil
+		cf.Size = 0
+		cf.Version = lamport.Default.Tick(cf.Version)
+		cf.Vector = cf.Vector.Update(m.vectorID)
+		res = append(res, cf)
+		if debug {
+			l.Debugln("deleted:", n)
+		}
+	}
+	return res
+}
+
 func (m *Set) Update(id uint, fs []scanner.File) {
 	if debug {
 		l.Debugf("Update(%d, [%d])", id, len(fs))
 	}
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
 	m.Lock()
-	m.update(id, fs)
+	m.updateBatched(id, fs)
 	m.changes[id]++
 	m.Unlock()
 }
 
+// An Iterator is called for each file in turn, and returns false to stop
+// the iteration. It is called with the set locked, so it must not call
+// methods on the set.
+type Iterator func(f scanner.File) bool
+
+// Need returns the files that the node needs to reach the global version.
 func (m *Set) Need(id uint) []scanner.File {
+	var fs = make([]scanner.File, 0, m.globalLen()/2) // Just a guess, but avoids too many reallocations
+	m.WithNeed(id, func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithNeed calls fn for each file that the node needs, without building a
+// list of them.
+func (m *Set) WithNeed(id uint, fn Iterator) {
 	if debug {
-		l.Debugf("Need(%d)", id)
+		l.Debugf("WithNeed(%d)", id)
 	}
 	m.Lock()
-	var fs = make([]scanner.File, 0, len(m.globalKey)/2) // Just a guess, but avoids too many reallocations
-	rkID := m.remoteKey[id]
-	for gk, gf := range m.files {
+	defer m.Unlock()
+	withNeed(m.files, m.remoteKey[id], fn)
+}
+
+// withNeed calls fn for each global file in files that is newer than the
+// one the node has, given the keys of the files the node has.
+func withNeed(files map[key]fileRecord, rkID map[string]key, fn Iterator) {
+	for gk, gf := range files {
 		if !gf.Global || gf.File.Suppressed {
 			continue
 		}
 
 		if rk, ok := rkID[gk.Name]; gk.newerThan(rk) {
-			if protocol.IsDeleted(gf.File.Flags) && (!ok || protocol.IsDeleted(m.files[rk].File.Flags)) {
+			if ok && files[rk].File.Suppressed {
+				// The node has the file marked as invalid or ignored and
+				// won't take a newer version of it
+				continue
+			}
+			if protocol.IsDeleted(gf.File.Flags) && (!ok || protocol.IsDeleted(files[rk].File.Flags)) {
 				// We don't need to delete files we don't have or that are already deleted
 				continue
 			}
 
-			fs = append(fs, gf.File)
+			if !fn(gf.File) {
+				return
+			}
 		}
 	}
-	m.Unlock()
-	return fs
 }
 
+// Have returns the files that the node has.
 func (m *Set) Have(id uint) []scanner.File {
+	var fs []scanner.File
+	m.WithHave(id, func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithHave calls fn for each file that the node has, without building a
+// list of them.
+func (m *Set) WithHave(id uint, fn Iterator) {
 	if debug {
-		l.Debugf("Have(%d)", id)
+		l.Debugf("WithHave(%d)", id)
 	}
-	var fs = make([]scanner.File, 0, len(m.remoteKey[id]))
 	m.Lock()
-	for _, rk := range m.remoteKey[id] {
-		fs = append(fs, m.files[rk].File)
+	defer m.Unlock()
+	withHave(m.files, m.remoteKey[id], fn)
+}
+
+func withHave(files map[key]fileRecord, rkID map[string]key, fn Iterator) {
+	for _, rk := range rkID {
+		if !fn(files[rk].File) {
+			return
+		}
 	}
-	m.Unlock()
-	return fs
 }
 
+// Global returns the global version of every file.
 func (m *Set) Global() []scanner.File {
+	var fs = make([]scanner.File, 0, m.globalLen())
+	m.WithGlobal(func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithGlobal calls fn for the global version of each file, without building
+// a list of them.
+func (m *Set) WithGlobal(fn Iterator) {
 	if debug {
-		l.Debugf("Global()")
+		l.Debugf("WithGlobal()")
 	}
 	m.Lock()
-	var fs = make([]scanner.File, 0, len(m.globalKey))
-	for _, file := range m.files {
+	defer m.Unlock()
+	withGlobal(m.files, fn)
+}
+
+func withGlobal(files map[key]fileRecord, fn Iterator) {
+	for _, file := range files {
 		if file.Global {
-			fs = append(fs, file.File)
+			if !fn(file.File) {
+				return
+			}
 		}
 	}
-	m.Unlock()
-	return fs
+}
+
+func (m *Set) globalLen() int {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.globalKey)
 }
 
 func (m *Set) Get(id uint, file string) scanner.File {
@@ -179,12 +343,14 @@ func (m *Set) GetGlobal(file string) scanner.File {
 	return m.files[m.globalKey[file]].File
 }
 
-func (m *Set) Availability(name string) bitset {
+// Availability returns the connection IDs of the nodes that have the global
+// version of the file.
+func (m *Set) Availability(name string) Bitset {
 	m.Lock()
 	defer m.Unlock()
-	av := m.globalAvailability[name]
+	av := m.globalAvailability[name].clone()
 	if debug {
-		l.Debugf("Availability(%q) = %0x", name, av)
+		l.Debugf("Availability(%q) = %v", name, av)
 	}
 	return av
 }
@@ -226,12 +392,56 @@ func (m *Set) update(cid uint, fs []scanner.File) {
 		n := f.Name
 		fk := keyFor(f)
 
-		if ck, ok := remFiles[n]; ok && ck == fk {
+		ck, had := remFiles[n]
+		if had && ck == fk {
 			// The remote already has exactly this file, skip it
 			continue
 		}
 
+		// The need of the other nodes only changes with the global version
+		gk, ok := m.globalKey[n]
+		newGlobal := !(ok && fk == gk) && fk.newerThan(gk)
+		needers := []uint{cid}
+		if newGlobal {
+			needers = needers[:0]
+			for id := range m.remoteKey {
+				needers = append(needers, id)
+			}
+		}
+		m.subNeed(needers, n)
+
+		if had {
+			ls := m.localSize[cid]
+			ls.sub(m.files[ck].File)
+			m.localSize[cid] = ls
+			if cid == localID && m.blocks != nil {
+				m.blocks.remove(m.files[ck].File)
+			}
+		}
+		if cid == localID {
+			if m.blocks != nil {
+				m.blocks.add(f)
+			}
+			m.markDeleted(f)
+		}
+		if names, ok := m.names[cid]; ok && !had {
+			m.names[cid] = append(names, n)
+			m.namesSorted[cid] = false
+		}
+		ls := m.localSize[cid]
+		ls.add(f)
+		m.localSize[cid] = ls
+
+		old := ck
+		if !had {
+			old = m.prevKeys[n]
+		}
+		if old != fk {
+			m.notify(cid, n, old, fk)
+		}
+
 		remFiles[n] = fk
+		expRecordsWritten.Add(1)
 
 		// Keep the block list or increment the usage
 		if br, ok := m.files[fk]; !ok {
@@ -245,28 +455,108 @@ func (m *Set) update(cid uint, fs []scanner.File) {
 		}
 
 		// Update global view
-		gk, ok := m.globalKey[n]
 		switch {
 		case ok && fk == gk:
-			av := m.globalAvailability[n]
-			av |= 1 << cid
-			m.globalAvailability[n] = av
-		case fk.newerThan(gk):
+			m.globalAvailability[n] = m.globalAvailability[n].set(cid)
+		case newGlobal:
 			if ok {
 				f := m.files[gk]
 				f.Global = false
 				m.files[gk] = f
+				m.globalSize.sub(f.File)
 			}
 			f := m.files[fk]
 			f.Global = true
 			m.files[fk] = f
 			m.globalKey[n] = fk
-			m.globalAvailability[n] = 1 << cid
+			m.globalAvailability[n] = NewBitset(cid)
+			m.globalSize.add(f.File)
+		}
+
+		m.addNeed(needers, n)
+	}
+}
+
+// subNeed removes the file from the need sizes of the nodes, before it is
+// changed; addNeed adds it back afterwards.
+func (m *Set) subNeed(ids []uint, name string) {
+	for _, id := range ids {
+		if f, ok := m.needOf(id, name); ok {
+			ns := m.needSize[id]
+			ns.sub(f)
+			m.needSize[id] = ns
+		}
+	}
+}
+
+func (m *Set) addNeed(ids []uint, name string) {
+	for _, id := range ids {
+		if f, ok := m.needOf(id, name); ok {
+			ns := m.needSize[id]
+			ns.add(f)
+			m.needSize[id] = ns
 		}
 	}
 }
 
+// Drop removes the files of the node from the set, as when it disconnects or
+// is removed from the cluster, so that they no longer count towards the
+// global version.
+func (m *Set) Drop(id uint) {
+	if debug {
+		l.Debugf("Drop(%d)", id)
+	}
+
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
+	m.Lock()
+	if prev, ok := m.remoteKey[id]; ok {
+		m.changes[id]++
+		m.drop(id)
+		m.recount()
+		for n, k := range prev {
+			m.notify(id, n, k, key{})
+		}
+	}
+	m.Unlock()
+}
+
+// replace replaces the files of the node. It is called with both locks
+// held, and releases the lock between batches like updateBatched.
 func (m *Set) replace(cid uint, fs []scanner.File) {
+	prev := m.remoteKey[cid]
+	m.drop(cid)
+	m.remoteKey[cid] = make(map[string]key)
+	m.recount()
+
+	m.prevKeys = prev
+	m.updateBatched(cid, fs)
+	m.prevKeys = nil
+
+	for n, k := range prev {
+		if _, ok := m.remoteKey[cid][n]; !ok {
+			m.notify(cid, n, k, key{})
+		}
+	}
+}
+
+// updateBatched is like update, but releases the lock between batches of
+// UpdateBatchSize files. It is called with both locks held, so that other
+// updates are kept out while readers see the batches as they are applied.
+func (m *Set) updateBatched(cid uint, fs []scanner.File) {
+	for UpdateBatchSize > 0 && len(fs) > UpdateBatchSize {
+		m.update(cid, fs[:UpdateBatchSize])
+		fs = fs[UpdateBatchSize:]
+		m.Unlock()
+		runtime.Gosched()
+		m.Lock()
+	}
+	m.update(cid, fs)
+}
+
+// drop removes the files of the node and recalculates the global version
+// from those of the remaining nodes.
+func (m *Set) drop(cid uint) {
 	// Decrement usage for all files belonging to this remote, and remove
 	// those that are no longer needed.
 	for _, fk := range m.remoteKey[cid] {
@@ -281,39 +571,47 @@ func (m *Set) replace(cid uint, fs []scanner.File) {
 	}
 
 	// Clear existing remote remoteKey
-	m.remoteKey[cid] = make(map[string]key)
+	delete(m.remoteKey, cid)
+	delete(m.names, cid)
+	delete(m.namesSorted, cid)
+	if cid == localID && m.blocks != nil {
+		m.blocks = make(blockMap)
+	}
 
 	// Recalculate global based on all remaining remoteKey
 	for n := range m.globalKey {
-		var nk key    // newest key
-		var na bitset // newest availability
-
-		for i, rem := range m.remoteKey {
-			if rk, ok := rem[n]; ok {
-				switch {
-				case rk == nk:
-					na |= 1 << uint(i)
-				case rk.newerThan(nk):
-					nk = rk
-					na = 1 << uint(i)
-				}
-			}
-		}
+		m.recalcGlobal(n)
+	}
+}
 
-		if na != 0 {
-			// Someone had the file
-			f := m.files[nk]
-			f.Global = true
-			m.files[nk] = f
-			m.globalKey[n] = nk
-			m.globalAvailability[n] = na
-		} else {
-			// Noone had the file
-			delete(m.globalKey, n)
-			delete(m.globalAvailability, n)
+// recalcGlobal sets the global version of the file to the newest that any
+// node has, or removes it if no node has the file.
+func (m *Set) recalcGlobal(n string) {
+	var nk key    // newest key
+	var na Bitset // newest availability
+
+	for i, rem := range m.remoteKey {
+		if rk, ok := rem[n]; ok {
+			switch {
+			case rk == nk:
+				na = na.set(i)
+			case rk.newerThan(nk):
+				nk = rk
+				na = NewBitset(i)
+			}
 		}
 	}
 
-	// Add new remote remoteKey to the mix
-	m.update(cid, fs)
+	if na != nil {
+		// Someone had the file
+		f := m.files[nk]
+		f.Global = true
+		m.files[nk] = f
+		m.globalKey[n] = nk
+		m.globalAvailability[n] = na
+	} else {
+		// Noone had the file
+		delete(m.globalKey, n)
+		delete(m.globalAvailability, n)
+	}
 }
@@ -6,9 +6,11 @@ package files_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/calmh/syncthing/cid"
 	"github.com/calmh/syncthing/files"
@@ -128,16 +130,16 @@ func TestGlobalSet(t *testing.T) {
 		t.Errorf("Get incorrect;\n A: %v !=\n E: %v", f, remote1[0])
 	}
 
-	a := int(m.Availability("a"))
-	if av := 1<<0 + 1<<1; a != av {
+	a := m.Availability("a")
+	if av := []uint{0, 1}; !reflect.DeepEqual(a.IDs(), av) {
 		t.Errorf("Availability incorrect;\n A: %v !=\n E: %v", a, av)
 	}
-	a = int(m.Availability("b"))
-	if av := 1 << 1; a != av {
+	a = m.Availability("b")
+	if av := []uint{1}; !reflect.DeepEqual(a.IDs(), av) {
 		t.Errorf("Availability incorrect;\n A: %v !=\n E: %v", a, av)
 	}
-	a = int(m.Availability("d"))
-	if av := 1 << 0; a != av {
+	a = m.Availability("d")
+	if av := []uint{0}; !reflect.DeepEqual(a.IDs(), av) {
 		t.Errorf("Availability incorrect;\n A: %v !=\n E: %v", a, av)
 	}
 }
@@ -177,10 +179,10 @@ func TestLocalDeleted(t *testing.T) {
 
 	expectedGlobal1 := []scanner.File{
 		local1[0],
-		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted},
+		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 0, Value: 1}}},
 		local1[2],
-		scanner.File{Name: "d", Version: 1002, Flags: protocol.FlagDeleted},
-		scanner.File{Name: "z", Version: 1003, Flags: protocol.FlagDeleted | protocol.FlagDirectory},
+		scanner.File{Name: "d", Version: 1002, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 0, Value: 1}}},
+		scanner.File{Name: "z", Version: 1003, Flags: protocol.FlagDeleted | protocol.FlagDirectory, Vector: protocol.Vector{{ID: 0, Value: 1}}},
 	}
 
 	g := m.Global()
@@ -198,10 +200,10 @@ func TestLocalDeleted(t *testing.T) {
 
 	expectedGlobal2 := []scanner.File{
 		local1[0],
-		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted},
-		scanner.File{Name: "c", Version: 1004, Flags: protocol.FlagDeleted},
-		scanner.File{Name: "d", Version: 1002, Flags: protocol.FlagDeleted},
-		scanner.File{Name: "z", Version: 1003, Flags: protocol.FlagDeleted | protocol.FlagDirectory},
+		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 0, Value: 1}}},
+		scanner.File{Name: "c", Version: 1004, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 0, Value: 1}}},
+		scanner.File{Name: "d", Version: 1002, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 0, Value: 1}}},
+		scanner.File{Name: "z", Version: 1003, Flags: protocol.FlagDeleted | protocol.FlagDirectory, Vector: protocol.Vector{{ID: 0, Value: 1}}},
 	}
 
 	g = m.Global()
@@ -213,6 +215,117 @@ func TestLocalDeleted(t *testing.T) {
 	}
 }
 
+func TestUpdateWithDelete(t *testing.T) {
+	m := files.NewSet()
+	m.SetVectorID(42)
+	lamport.Default = lamport.Clock{}
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1000, Flags: protocol.FlagDirectory},
+		scanner.File{Name: filepath.Join("b", "c"), Version: 1000},
+		scanner.File{Name: filepath.Join("b", "d"), Version: 1000},
+		scanner.File{Name: "bb", Version: 1000},
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	c0 := m.Changes(cid.LocalID)
+
+	// Rescanning an unchanged subtree changes nothing
+	m.UpdateWithDelete(cid.LocalID, "b", local[1:4])
+	if c := m.Changes(cid.LocalID); c != c0 {
+		t.Errorf("Unexpected change %d != %d", c, c0)
+	}
+
+	// Only files within the subtree are deleted
+	m.UpdateWithDelete(cid.LocalID, "b", local[1:3])
+
+	expected := []scanner.File{
+		local[0],
+		local[1],
+		local[2],
+		scanner.File{Name: filepath.Join("b", "d"), Version: 1001, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 42, Value: 1}}},
+		local[4],
+	}
+
+	h := m.Have(cid.LocalID)
+	sort.Sort(fileList(h))
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("Have incorrect;\n A: %v !=\n E: %v", h, expected)
+	}
+	if c := m.Changes(cid.LocalID); c == c0 {
+		t.Error("Expected change")
+	}
+}
+
+func TestStream(t *testing.T) {
+	m := files.NewSet()
+	lamport.Default = lamport.Clock{}
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1000},
+		scanner.File{Name: "c", Version: 1000},
+	}
+
+	// The set need not have been replaced first
+	s := m.NewStream(cid.LocalID, "")
+	s.Add(local[:2])
+	s.Add(local[2:])
+	s.Finish()
+	c0 := m.Changes(cid.LocalID)
+
+	h := m.Have(cid.LocalID)
+	sort.Sort(fileList(h))
+	if !reflect.DeepEqual(h, local) {
+		t.Errorf("Have incorrect;\n A: %v !=\n E: %v", h, local)
+	}
+
+	// Unchanged files change nothing
+	s = m.NewStream(cid.LocalID, "")
+	s.Add(local)
+	s.Finish()
+	if c := m.Changes(cid.LocalID); c != c0 {
+		t.Errorf("Unexpected change %d != %d", c, c0)
+	}
+
+	// Files that didn't arrive are deleted only when finished
+	s = m.NewStream(cid.LocalID, "")
+	s.Add(local[:1])
+	s.Add(local[2:])
+	if f := m.Get(cid.LocalID, "b"); protocol.IsDeleted(f.Flags) {
+		t.Error("Deleted before finish")
+	}
+	s.Finish()
+	if f := m.Get(cid.LocalID, "b"); !protocol.IsDeleted(f.Flags) || f.Version != 1001 {
+		t.Errorf("Not deleted: %v", f)
+	}
+
+	if n := s.Names(); !reflect.DeepEqual(n, []string{"a", "c"}) {
+		t.Errorf("Incorrect names %v", n)
+	}
+
+	// Changed applies to the changed and deleted files only
+	var seen []string
+	s = m.NewStream(cid.LocalID, "")
+	s.Changed = func(f scanner.File) scanner.File {
+		seen = append(seen, f.Name)
+		f.Suppressed = true
+		return f
+	}
+	s.Add([]scanner.File{local[0], {Name: "c", Version: 1002}})
+	s.Finish()
+	if !reflect.DeepEqual(seen, []string{"c"}) {
+		t.Errorf("Changed called for %v", seen)
+	}
+	if f := m.Get(cid.LocalID, "c"); !f.Suppressed {
+		t.Errorf("Changed not applied: %v", f)
+	}
+	if f := m.Get(cid.LocalID, "a"); f.Suppressed {
+		t.Errorf("Changed applied to unchanged file: %v", f)
+	}
+}
+
 func Benchmark10kReplace(b *testing.B) {
 	var local []scanner.File
 	for i := 0; i < 10000; i++ {
@@ -429,6 +542,62 @@ func TestNeed(t *testing.T) {
 	if !reflect.DeepEqual(need, shouldNeed) {
 		t.Errorf("Need incorrect;\n%v !=\n%v", need, shouldNeed)
 	}
+
+	var iterated []scanner.File
+	m.WithNeed(0, func(f scanner.File) bool {
+		iterated = append(iterated, f)
+		return true
+	})
+	sort.Sort(fileList(iterated))
+	if !reflect.DeepEqual(iterated, shouldNeed) {
+		t.Errorf("WithNeed incorrect;\n%v !=\n%v", iterated, shouldNeed)
+	}
+
+	var n int
+	m.WithNeed(0, func(f scanner.File) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("WithNeed did not stop; %d calls", n)
+	}
+
+	n = 0
+	m.WithGlobal(func(f scanner.File) bool {
+		n++
+		return true
+	})
+	if n != 5 {
+		t.Errorf("WithGlobal incorrect; %d files != 5", n)
+	}
+}
+
+func TestNeedInvalid(t *testing.T) {
+	m := files.NewSet()
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Suppressed: true},
+	}
+
+	remote := []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+		scanner.File{Name: "b", Version: 1001},
+		scanner.File{Name: "c", Version: 1000, Suppressed: true},
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	m.Replace(1, remote)
+
+	// The file ignored locally is not needed, nor is the invalid one
+	need := m.Need(cid.LocalID)
+	if len(need) != 1 || need[0].Name != "a" {
+		t.Errorf("Need incorrect; %v", need)
+	}
+
+	if g := m.GetGlobal("b"); g.Version != 1001 {
+		t.Errorf("Ignored record became global; %v", g)
+	}
 }
 
 func TestChanges(t *testing.T) {
@@ -464,3 +633,480 @@ func TestChanges(t *testing.T) {
 		t.Fatal("Change number should be unchanged")
 	}
 }
+
+func TestManyNodes(t *testing.T) {
+	m := files.NewSet()
+
+	f1 := scanner.File{Name: "a", Version: 1000}
+	f2 := scanner.File{Name: "a", Version: 1001}
+
+	m.ReplaceWithDelete(cid.LocalID, []scanner.File{f1})
+	for id := uint(1); id < 200; id++ {
+		m.Replace(id, []scanner.File{f1})
+	}
+	m.Replace(150, []scanner.File{f2})
+
+	if av := m.Availability("a").IDs(); !reflect.DeepEqual(av, []uint{150}) {
+		t.Errorf("Availability incorrect; %v != [150]", av)
+	}
+	if f := m.GetGlobal("a"); f.Version != 1001 {
+		t.Errorf("Incorrect global version %d", f.Version)
+	}
+	if n := m.Need(199); len(n) != 1 || n[0].Version != 1001 {
+		t.Errorf("Incorrect need %v", n)
+	}
+
+	m.Replace(150, nil)
+	av := m.Availability("a")
+	if len(av.IDs()) != 199 || !av.Has(0) || !av.Has(199) || av.Has(150) {
+		t.Errorf("Availability incorrect; %v", av)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	m := files.NewSet()
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1000},
+	}
+	remote := []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+		scanner.File{Name: "b", Version: 1000},
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	m.Replace(1, remote)
+
+	s := m.Snapshot()
+
+	// Changes after the snapshot are not seen in it
+	m.ReplaceWithDelete(cid.LocalID, remote)
+	m.Replace(1, []scanner.File{remote[0], scanner.File{Name: "b", Version: 1002}})
+
+	if n := m.Need(cid.LocalID); len(n) != 1 || n[0].Name != "b" {
+		t.Errorf("Need incorrect; %v", n)
+	}
+
+	if n := s.Need(cid.LocalID); len(n) != 1 || n[0].Name != "a" || n[0].Version != 1001 {
+		t.Errorf("Snapshot need incorrect; %v", n)
+	}
+	if f := s.Get(cid.LocalID, "a"); f.Version != 1000 {
+		t.Errorf("Snapshot get incorrect; %v", f)
+	}
+	if f := s.GetGlobal("b"); f.Version != 1000 {
+		t.Errorf("Snapshot global incorrect; %v", f)
+	}
+	g := s.Global()
+	sort.Sort(fileList(g))
+	if !reflect.DeepEqual(g, remote) {
+		t.Errorf("Snapshot global incorrect;\n A: %v !=\n E: %v", g, remote)
+	}
+	h := s.Have(cid.LocalID)
+	sort.Sort(fileList(h))
+	if !reflect.DeepEqual(h, local) {
+		t.Errorf("Snapshot have incorrect;\n A: %v !=\n E: %v", h, local)
+	}
+}
+
+func sizeOf(fs []scanner.File) (files, deleted int, bytes int64) {
+	for _, f := range fs {
+		switch {
+		case protocol.IsDeleted(f.Flags):
+			deleted++
+			bytes += 128
+		case protocol.IsDirectory(f.Flags):
+			files++
+			bytes += 128
+		default:
+			files++
+			bytes += f.Size
+		}
+	}
+	return
+}
+
+func TestSizes(t *testing.T) {
+	m := files.NewSet()
+
+	check := func(step string) {
+		gf, gd, gb := sizeOf(m.Global())
+		if f, d, b := m.GlobalSize(); f != gf || d != gd || b != gb {
+			t.Errorf("%s: global size %d %d %d != %d %d %d", step, f, d, b, gf, gd, gb)
+		}
+		for _, id := range []uint{cid.LocalID, 1, 2} {
+			hf, hd, hb := sizeOf(m.Have(id))
+			if f, d, b := m.LocalSize(id); f != hf || d != hd || b != hb {
+				t.Errorf("%s: local size of %d %d %d %d != %d %d %d", step, id, f, d, b, hf, hd, hb)
+			}
+			nf, nd, nb := sizeOf(m.Need(id))
+			if f, d, b := m.NeedSize(id); f != nf || d != nd || b != nb {
+				t.Errorf("%s: need size of %d %d %d %d != %d %d %d", step, id, f, d, b, nf, nd, nb)
+			}
+		}
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1000, Size: 10},
+		scanner.File{Name: "b", Version: 1000, Size: 20},
+		scanner.File{Name: "d", Version: 1000, Flags: protocol.FlagDirectory},
+	})
+	check("local")
+
+	m.Replace(1, []scanner.File{
+		scanner.File{Name: "a", Version: 1001, Size: 15},
+		scanner.File{Name: "c", Version: 1000, Size: 30},
+	})
+	check("remote")
+
+	m.Update(1, []scanner.File{
+		scanner.File{Name: "b", Version: 1002, Size: 25},
+		scanner.File{Name: "c", Version: 1001, Flags: protocol.FlagDeleted},
+	})
+	check("update")
+
+	m.UpdateWithDelete(cid.LocalID, "", []scanner.File{
+		scanner.File{Name: "a", Version: 1001, Size: 15},
+		scanner.File{Name: "d", Version: 1000, Flags: protocol.FlagDirectory},
+	})
+	check("delete")
+
+	s := m.NewStream(2, "")
+	s.Add([]scanner.File{scanner.File{Name: "e", Version: 1000, Size: 5}})
+	check("stream")
+	s.Finish()
+	check("finish")
+
+	m.Replace(1, nil)
+	check("replace")
+}
+
+func TestDrop(t *testing.T) {
+	m := files.NewSet()
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+	}
+	remote := []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+		scanner.File{Name: "b", Version: 1000},
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	m.Replace(1, remote)
+	if n := m.Need(cid.LocalID); len(n) != 2 {
+		t.Fatalf("Need incorrect; %v", n)
+	}
+
+	m.Drop(1)
+
+	if n := m.Need(cid.LocalID); len(n) != 0 {
+		t.Errorf("Need incorrect after drop; %v", n)
+	}
+	if g := m.Global(); !reflect.DeepEqual(g, local) {
+		t.Errorf("Global incorrect after drop;\n A: %v !=\n E: %v", g, local)
+	}
+	if h := m.Have(1); len(h) != 0 {
+		t.Errorf("Have incorrect after drop; %v", h)
+	}
+	if av := m.Availability("a").IDs(); !reflect.DeepEqual(av, []uint{cid.LocalID}) {
+		t.Errorf("Availability incorrect after drop; %v", av)
+	}
+	if f, _, _ := m.GlobalSize(); f != 1 {
+		t.Errorf("Global size incorrect after drop; %d files", f)
+	}
+}
+
+func TestFindBlock(t *testing.T) {
+	m := files.NewSet()
+
+	b0 := scanner.Block{Offset: 0, Size: 10, Hash: []byte("hash0")}
+	b1 := scanner.Block{Offset: 10, Size: 10, Hash: []byte("hash1")}
+
+	m.ReplaceWithDelete(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1000, Blocks: []scanner.Block{b0, b1}},
+		scanner.File{Name: "b", Version: 1000, Blocks: []scanner.Block{b1}},
+	})
+	m.Replace(1, []scanner.File{
+		scanner.File{Name: "c", Version: 1000, Blocks: []scanner.Block{b0}},
+	})
+
+	if locs := m.FindBlock(b0.Hash); len(locs) != 1 || locs[0].Name != "a" || locs[0].Block.Offset != 0 {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+	if locs := m.FindBlock(b1.Hash); len(locs) != 2 {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+
+	// Changed and deleted files no longer hold the blocks
+	m.Update(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1001, Blocks: []scanner.Block{b1}},
+		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted},
+	})
+	if locs := m.FindBlock(b0.Hash); len(locs) != 0 {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+	if locs := m.FindBlock(b1.Hash); len(locs) != 1 || locs[0].Name != "a" || locs[0].Block.Offset != 10 {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	m := files.NewSet()
+
+	names := []string{
+		"photos",
+		filepath.Join("photos", "2023"),
+		filepath.Join("photos", "2023", "b.jpg"),
+		filepath.Join("photos", "2023", "a.jpg"),
+		filepath.Join("photos", "2023-old"),
+		filepath.Join("photos", "2024", "c.jpg"),
+		"zeta",
+	}
+	var fs []scanner.File
+	for _, n := range names {
+		fs = append(fs, scanner.File{Name: n, Version: 1000})
+	}
+	m.ReplaceWithDelete(cid.LocalID, fs[:4])
+	m.Update(cid.LocalID, fs[4:])
+
+	var tests = []struct {
+		prefix string
+		names  []string
+	}{
+		{filepath.Join("photos", "2023"), []string{names[1], names[3], names[2]}},
+		{filepath.Join("photos", "2024"), []string{names[5]}},
+		{"zeta", []string{"zeta"}},
+		{"missing", nil},
+		{"", []string{names[0], names[1], names[4], names[3], names[2], names[5], names[6]}},
+	}
+	for _, tc := range tests {
+		var found []string
+		m.WithPrefix(cid.LocalID, tc.prefix, func(f scanner.File) bool {
+			found = append(found, f.Name)
+			return true
+		})
+		if !reflect.DeepEqual(found, tc.names) {
+			t.Errorf("WithPrefix(%q) incorrect;\n A: %v !=\n E: %v", tc.prefix, found, tc.names)
+		}
+	}
+
+	var n int
+	m.WithPrefix(cid.LocalID, "photos", func(f scanner.File) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("WithPrefix did not stop; %d calls", n)
+	}
+}
+
+func TestShrinkCaches(t *testing.T) {
+	m := files.NewSet()
+
+	b0 := scanner.Block{Offset: 0, Size: 10, Hash: []byte("hash0")}
+	b1 := scanner.Block{Offset: 0, Size: 10, Hash: []byte("hash1")}
+	m.ReplaceWithDelete(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1000, Blocks: []scanner.Block{b0}},
+	})
+
+	prefixed := func() []string {
+		var found []string
+		m.WithPrefix(cid.LocalID, "", func(f scanner.File) bool {
+			found = append(found, f.Name)
+			return true
+		})
+		return found
+	}
+	if found := prefixed(); !reflect.DeepEqual(found, []string{"a"}) {
+		t.Errorf("Incorrect names before shrinking %v", found)
+	}
+
+	m.ShrinkCaches()
+	if locs := m.FindBlock(b0.Hash); len(locs) != 0 {
+		t.Errorf("Blocks found after shrinking: %v", locs)
+	}
+
+	// The names are listed again, and kept up to date from then on
+	m.Update(cid.LocalID, []scanner.File{
+		scanner.File{Name: "b", Version: 1000, Blocks: []scanner.Block{b1}},
+	})
+	if found := prefixed(); !reflect.DeepEqual(found, []string{"a", "b"}) {
+		t.Errorf("Incorrect names after shrinking %v", found)
+	}
+	m.Update(cid.LocalID, []scanner.File{
+		scanner.File{Name: "c", Version: 1000},
+	})
+	if found := prefixed(); !reflect.DeepEqual(found, []string{"a", "b", "c"}) {
+		t.Errorf("Incorrect names after updating %v", found)
+	}
+
+	// The block map holds the files changed meanwhile once restored
+	m.RestoreCaches()
+	if locs := m.FindBlock(b0.Hash); len(locs) != 1 || locs[0].Name != "a" {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+	if locs := m.FindBlock(b1.Hash); len(locs) != 1 || locs[0].Name != "b" {
+		t.Errorf("Incorrect locations %v", locs)
+	}
+}
+
+func TestUpdateBatches(t *testing.T) {
+	defer func(n int) { files.UpdateBatchSize = n }(files.UpdateBatchSize)
+	files.UpdateBatchSize = 3
+
+	m := files.NewSet()
+
+	var local, remote []scanner.File
+	for i := 0; i < 100; i++ {
+		local = append(local, scanner.File{Name: fmt.Sprint(i), Version: 1000, Size: 10})
+		remote = append(remote, scanner.File{Name: fmt.Sprint(i), Version: 1001, Size: 20})
+	}
+
+	// Readers are let in between the batches
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			m.Have(1)
+			m.GlobalSize()
+		}
+	}()
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	m.Replace(1, remote)
+	m.Update(cid.LocalID, remote[:50])
+	<-done
+
+	if n := m.Need(cid.LocalID); len(n) != 50 {
+		t.Errorf("Incorrect need; %d files != 50", len(n))
+	}
+	if f, _, b := m.NeedSize(cid.LocalID); f != 50 || b != 50*20 {
+		t.Errorf("Incorrect need size; %d files, %d bytes", f, b)
+	}
+	if h := m.Have(1); len(h) != 100 {
+		t.Errorf("Incorrect have; %d files != 100", len(h))
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	m := files.NewSet()
+	c := m.Subscribe()
+
+	m.ReplaceWithDelete(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1000},
+	})
+	m.Update(cid.LocalID, []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+	})
+	m.Replace(1, []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+	})
+	m.Replace(1, []scanner.File{
+		scanner.File{Name: "a", Version: 1001},
+		scanner.File{Name: "c", Version: 1000},
+	})
+	m.Drop(1)
+
+	expected := []files.Change{
+		{Node: cid.LocalID, Name: "a", OldVersion: 0, NewVersion: 1000},
+		{Node: cid.LocalID, Name: "b", OldVersion: 0, NewVersion: 1000},
+		{Node: cid.LocalID, Name: "a", OldVersion: 1000, NewVersion: 1001},
+		{Node: 1, Name: "a", OldVersion: 0, NewVersion: 1001},
+		{Node: 1, Name: "c", OldVersion: 0, NewVersion: 1000},
+	}
+	for i, e := range expected {
+		select {
+		case ch := <-c:
+			if ch != e {
+				t.Errorf("#%d: incorrect change %+v != %+v", i, ch, e)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("#%d: no change received", i)
+		}
+	}
+
+	// Drop reports the files in no particular order
+	dropped := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		ch := <-c
+		if ch.Node != 1 || ch.NewVersion != 0 {
+			t.Errorf("Incorrect change %+v for drop", ch)
+		}
+		dropped[ch.Name] = true
+	}
+	if !dropped["a"] || !dropped["c"] {
+		t.Errorf("Incorrect drops %v", dropped)
+	}
+
+	m.Unsubscribe(c)
+	if _, ok := <-c; ok {
+		t.Error("Channel not closed after unsubscribe")
+	}
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	m := files.NewSet()
+
+	local := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted},
+		scanner.File{Name: "c", Version: 1001, Flags: protocol.FlagDeleted},
+	}
+	remote := []scanner.File{
+		scanner.File{Name: "a", Version: 1000},
+		scanner.File{Name: "b", Version: 1001, Flags: protocol.FlagDeleted},
+		scanner.File{Name: "c", Version: 1000},
+	}
+
+	m.ReplaceWithDelete(cid.LocalID, local)
+	m.Replace(1, remote)
+
+	// Not yet old enough
+	if n := m.PurgeDeleted([]uint{1}, time.Now().Add(-time.Hour)); n != 0 {
+		t.Errorf("Purged %d files too early", n)
+	}
+
+	// Node 2 has not acknowledged anything
+	if n := m.PurgeDeleted([]uint{1, 2}, time.Now().Add(time.Hour)); n != 0 {
+		t.Errorf("Purged %d files not acknowledged by all nodes", n)
+	}
+
+	// Only b has been acknowledged by node 1
+	if n := m.PurgeDeleted([]uint{1}, time.Now().Add(time.Hour)); n != 1 {
+		t.Errorf("Purged %d files, expected 1", n)
+	}
+	if f := m.Get(cid.LocalID, "b"); f.Name != "" {
+		t.Errorf("Purged file remains locally; %v", f)
+	}
+	if f := m.Get(1, "b"); f.Name != "" {
+		t.Errorf("Purged file remains for the remote; %v", f)
+	}
+	if f := m.GetGlobal("b"); f.Name != "" {
+		t.Errorf("Purged file remains globally; %v", f)
+	}
+	if f, d, _ := m.GlobalSize(); f != 1 || d != 1 {
+		t.Errorf("Global size incorrect after purge; %d files, %d deleted", f, d)
+	}
+
+	var names []string
+	m.WithPrefix(1, "", func(f scanner.File) bool {
+		names = append(names, f.Name)
+		return true
+	})
+	if !reflect.DeepEqual(names, []string{"a", "c"}) {
+		t.Errorf("Incorrect names after purge; %v", names)
+	}
+
+	// Once c has been acknowledged, it goes as well
+	m.Update(1, []scanner.File{
+		scanner.File{Name: "c", Version: 1001, Flags: protocol.FlagDeleted},
+	})
+	if n := m.PurgeDeleted([]uint{1}, time.Now().Add(time.Hour)); n != 1 {
+		t.Errorf("Purged %d files, expected 1", n)
+	}
+	if g := m.Global(); len(g) != 1 || g[0].Name != "a" {
+		t.Errorf("Global incorrect after purge; %v", g)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+// ShrinkCaches frees the memory of what the Set keeps only to answer
+// queries faster: the block map, so that FindBlock finds nothing until
+// RestoreCaches is called, and the lists of names used by WithPrefix, which
+// are listed again when next needed.
+func (m *Set) ShrinkCaches() {
+	m.Lock()
+	defer m.Unlock()
+	m.blocks = nil
+	m.names = make(map[uint][]string)
+	m.namesSorted = make(map[uint]bool)
+}
+
+// RestoreCaches rebuilds the block map after ShrinkCaches.
+func (m *Set) RestoreCaches() {
+	m.Lock()
+	defer m.Unlock()
+	if m.blocks != nil {
+		return
+	}
+	m.blocks = make(blockMap)
+	for _, k := range m.remoteKey[localID] {
+		m.blocks.add(m.files[k].File)
+	}
+}
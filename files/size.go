@@ -0,0 +1,124 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import (
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// Somewhat arbitrary amount of bytes that we choose to let represent the size
+// of an unsynchronized directory entry or a deleted file. We need it to be
+// larger than zero so that it's visible that there is some amount of bytes to
+// transfer to bring the systems into synchronization.
+const ZeroEntrySize = 128
+
+// A size is the number of files, deleted files and bytes in a list of files.
+type size struct {
+	files   int
+	deleted int
+	bytes   int64
+}
+
+func entrySize(f scanner.File) int64 {
+	if protocol.IsDeleted(f.Flags) || protocol.IsDirectory(f.Flags) {
+		return ZeroEntrySize
+	}
+	return f.Size
+}
+
+func (s *size) add(f scanner.File) bool {
+	if protocol.IsDeleted(f.Flags) {
+		s.deleted++
+	} else {
+		s.files++
+	}
+	s.bytes += entrySize(f)
+	return true
+}
+
+func (s *size) sub(f scanner.File) {
+	if protocol.IsDeleted(f.Flags) {
+		s.deleted--
+	} else {
+		s.files--
+	}
+	s.bytes -= entrySize(f)
+}
+
+// GlobalSize returns the number of files, deleted files and bytes in the
+// global version of the set.
+func (m *Set) GlobalSize() (files, deleted int, bytes int64) {
+	m.Lock()
+	defer m.Unlock()
+	s := m.globalSize
+	return s.files, s.deleted, s.bytes
+}
+
+// LocalSize returns the number of files, deleted files and bytes that the
+// node has.
+func (m *Set) LocalSize(id uint) (files, deleted int, bytes int64) {
+	m.Lock()
+	defer m.Unlock()
+	s := m.localSize[id]
+	return s.files, s.deleted, s.bytes
+}
+
+// NeedSize returns the number of files, deleted files and bytes that the
+// node needs, as returned by Need.
+func (m *Set) NeedSize(id uint) (files, deleted int, bytes int64) {
+	m.Lock()
+	defer m.Unlock()
+	s, ok := m.needSize[id]
+	if !ok {
+		// Not tracked for nodes we have no files from
+		withNeed(m.files, nil, s.add)
+	}
+	return s.files, s.deleted, s.bytes
+}
+
+// needOf returns the global version of the file, and true if the node
+// needs it, by the same rules as withNeed.
+func (m *Set) needOf(id uint, name string) (scanner.File, bool) {
+	gk, ok := m.globalKey[name]
+	if !ok {
+		return scanner.File{}, false
+	}
+	gf := m.files[gk].File
+	if gf.Suppressed {
+		return gf, false
+	}
+	rk, ok := m.remoteKey[id][name]
+	if !gk.newerThan(rk) {
+		return gf, false
+	}
+	if ok && m.files[rk].File.Suppressed {
+		return gf, false
+	}
+	if protocol.IsDeleted(gf.Flags) && (!ok || protocol.IsDeleted(m.files[rk].File.Flags)) {
+		return gf, false
+	}
+	return gf, true
+}
+
+// recount calculates all the sizes from scratch.
+func (m *Set) recount() {
+	m.globalSize = size{}
+	withGlobal(m.files, m.globalSize.add)
+	m.localSize = make(map[uint]size, len(m.remoteKey))
+	m.needSize = make(map[uint]size, len(m.remoteKey))
+	for id := range m.remoteKey {
+		m.countNode(id)
+	}
+}
+
+// countNode calculates the sizes of the node from scratch.
+func (m *Set) countNode(id uint) {
+	var ls, ns size
+	withHave(m.files, m.remoteKey[id], ls.add)
+	withNeed(m.files, m.remoteKey[id], ns.add)
+	m.localSize[id] = ls
+	m.needSize[id] = ns
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import "github.com/calmh/syncthing/scanner"
+
+// A Snapshot is a view of a Set at one point in time. It is not affected by
+// later changes to the set, so that several reads from it are consistent
+// with each other. Unlike with the set, the iterators may call other
+// methods on the snapshot or the set.
+type Snapshot struct {
+	files     map[key]fileRecord
+	remoteKey map[uint]map[string]key
+	globalKey map[string]key
+}
+
+// Snapshot returns a view of the set as it is now. Taking it copies the
+// index of the set, but not the files themselves.
+func (m *Set) Snapshot() *Snapshot {
+	m.Lock()
+	defer m.Unlock()
+
+	s := &Snapshot{
+		files:     make(map[key]fileRecord, len(m.files)),
+		remoteKey: make(map[uint]map[string]key, len(m.remoteKey)),
+		globalKey: make(map[string]key, len(m.globalKey)),
+	}
+	for k, f := range m.files {
+		s.files[k] = f
+	}
+	for id, rem := range m.remoteKey {
+		rk := make(map[string]key, len(rem))
+		for n, k := range rem {
+			rk[n] = k
+		}
+		s.remoteKey[id] = rk
+	}
+	for n, k := range m.globalKey {
+		s.globalKey[n] = k
+	}
+	return s
+}
+
+// Get returns the file as the node has it.
+func (s *Snapshot) Get(id uint, file string) scanner.File {
+	return s.files[s.remoteKey[id][file]].File
+}
+
+// GetGlobal returns the global version of the file.
+func (s *Snapshot) GetGlobal(file string) scanner.File {
+	return s.files[s.globalKey[file]].File
+}
+
+// Need returns the files that the node needs to reach the global version.
+func (s *Snapshot) Need(id uint) []scanner.File {
+	var fs []scanner.File
+	s.WithNeed(id, func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithNeed calls fn for each file that the node needs.
+func (s *Snapshot) WithNeed(id uint, fn Iterator) {
+	withNeed(s.files, s.remoteKey[id], fn)
+}
+
+// Have returns the files that the node has.
+func (s *Snapshot) Have(id uint) []scanner.File {
+	var fs = make([]scanner.File, 0, len(s.remoteKey[id]))
+	s.WithHave(id, func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithHave calls fn for each file that the node has.
+func (s *Snapshot) WithHave(id uint, fn Iterator) {
+	withHave(s.files, s.remoteKey[id], fn)
+}
+
+// Global returns the global version of every file.
+func (s *Snapshot) Global() []scanner.File {
+	var fs = make([]scanner.File, 0, len(s.globalKey))
+	s.WithGlobal(func(f scanner.File) bool {
+		fs = append(fs, f)
+		return true
+	})
+	return fs
+}
+
+// WithGlobal calls fn for the global version of each file.
+func (s *Snapshot) WithGlobal(fn Iterator) {
+	withGlobal(s.files, fn)
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import "github.com/calmh/syncthing/scanner"
+
+// A Stream updates the files of a node in a Set from files that arrive in
+// batches, such as from scanner.Walker.WalkChan, so that they need not all
+// be held in memory at once. Only the names of the files are kept until
+// Finish marks the files that did not arrive as deleted.
+type Stream struct {
+	set   *Set
+	id    uint
+	sub   string
+	seen  map[string]struct{}
+	names []string // in the order they arrived
+
+	// Changed, if set, is applied to the files that are changed in the
+	// set, including those marked deleted, before they are updated.
+	Changed func(scanner.File) scanner.File
+}
+
+// NewStream returns a Stream updating the files in the subtree rooted at
+// sub, or all files if sub is empty, in the same manner as UpdateWithDelete.
+func (m *Set) NewStream(id uint, sub string) *Stream {
+	return &Stream{
+		set:  m,
+		id:   id,
+		sub:  sub,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// Add updates the set with a batch of files. The changed files are visible
+// in the set right away.
+func (s *Stream) Add(fs []scanner.File) {
+	if debug {
+		l.Debugf("Stream(%d, %q).Add([%d])", s.id, s.sub, len(fs))
+	}
+
+	m := s.set
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
+	m.Lock()
+	defer m.Unlock()
+
+	if m.remoteKey[s.id] == nil {
+		m.remoteKey[s.id] = make(map[string]key)
+		m.countNode(s.id)
+	}
+	remFiles := m.remoteKey[s.id]
+	var changed []scanner.File
+	for _, f := range fs {
+		s.seen[f.Name] = struct{}{}
+		s.names = append(s.names, f.Name)
+		if ck, ok := remFiles[f.Name]; !ok || ck != keyFor(f) {
+			changed = append(changed, f)
+		}
+	}
+
+	if len(changed) > 0 {
+		m.updateBatched(s.id, s.applyChanged(changed))
+		m.changes[s.id]++
+	}
+}
+
+// Finish marks the files in the subtree that were not added as deleted. It
+// should only be called when all the files that exist have been added.
+func (s *Stream) Finish() {
+	if debug {
+		l.Debugf("Stream(%d, %q).Finish() after %d files", s.id, s.sub, len(s.names))
+	}
+
+	m := s.set
+	m.wmut.Lock()
+	defer m.wmut.Unlock()
+	m.Lock()
+	defer m.Unlock()
+
+	if deleted := m.deleted(s.id, s.sub, s.seen); len(deleted) > 0 {
+		m.updateBatched(s.id, s.applyChanged(deleted))
+		m.changes[s.id]++
+	}
+}
+
+func (s *Stream) applyChanged(fs []scanner.File) []scanner.File {
+	if s.Changed != nil {
+		for i := range fs {
+			fs[i] = s.Changed(fs[i])
+		}
+	}
+	return fs
+}
+
+// Names returns the names of the files added so far, in the order they
+// were added.
+func (s *Stream) Names() []string {
+	return s.names
+}
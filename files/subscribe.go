@@ -0,0 +1,104 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package files
+
+import "sync"
+
+// A Change tells that the file of a node has changed in the set.
+type Change struct {
+	Node       uint // connection ID
+	Name       string
+	OldVersion uint64 // zero if the node did not have the file
+	NewVersion uint64 // zero if the file was dropped from the set
+}
+
+// A subscriber queues the changes for a receiver, so that updates to the
+// set are never held up by a slow receiver.
+type subscriber struct {
+	c      chan Change
+	queue  []Change
+	signal chan struct{}
+	stop   chan struct{}
+	mut    sync.Mutex
+}
+
+// Subscribe returns a channel on which every change to the set is sent, in
+// the order the changes are made, until Unsubscribe is called.
+func (m *Set) Subscribe() <-chan Change {
+	s := &subscriber{
+		c:      make(chan Change),
+		signal: make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+	go s.run()
+
+	m.Lock()
+	m.subs = append(m.subs, s)
+	m.Unlock()
+	return s.c
+}
+
+// Unsubscribe stops the changes being sent on the channel, which is then
+// closed. Changes not yet received are discarded.
+func (m *Set) Unsubscribe(c <-chan Change) {
+	m.Lock()
+	defer m.Unlock()
+	for i, s := range m.subs {
+		if s.c == c {
+			close(s.stop)
+			m.subs = append(m.subs[:i], m.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// notify queues the change for the subscribers. Called with the lock held.
+func (m *Set) notify(id uint, name string, oldKey, newKey key) {
+	if len(m.subs) == 0 {
+		return
+	}
+	c := Change{
+		Node:       id,
+		Name:       name,
+		OldVersion: oldKey.Version,
+		NewVersion: newKey.Version,
+	}
+	for _, s := range m.subs {
+		s.mut.Lock()
+		s.queue = append(s.queue, c)
+		s.mut.Unlock()
+		select {
+		case s.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *subscriber) run() {
+	defer close(s.c)
+	for {
+		s.mut.Lock()
+		queue := s.queue
+		s.queue = nil
+		s.mut.Unlock()
+
+		if len(queue) == 0 {
+			select {
+			case <-s.signal:
+				continue
+			case <-s.stop:
+				return
+			}
+		}
+
+		for _, c := range queue {
+			select {
+			case s.c <- c:
+			case <-s.stop:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+)
+
+// BasicFilesystem implements Filesystem by calling directly into the os
+// package. On Windows, absolute paths are given the \\?\ prefix so that
+// paths longer than MAX_PATH can be accessed.
+type BasicFilesystem struct{}
+
+func (BasicFilesystem) Chmod(name string, mode os.FileMode) error {
+	return os.Chmod(longFilename(name), mode)
+}
+
+func (BasicFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return os.Chtimes(longFilename(name), atime, mtime)
+}
+
+func (BasicFilesystem) Create(name string) (File, error) {
+	fd, err := os.Create(longFilename(name))
+	if err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// GetACL has the semantics of osutil.GetACL.
+func (BasicFilesystem) GetACL(name string) ([]byte, error) {
+	return osutil.GetACL(longFilename(name))
+}
+
+// Glob does not use long filenames, since the \\?\ prefix is itself a
+// pattern.
+func (BasicFilesystem) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (BasicFilesystem) Hide(name string) error {
+	return osutil.HideFile(longFilename(name))
+}
+
+func (BasicFilesystem) Lstat(name string) (os.FileInfo, error) {
+	return os.Lstat(longFilename(name))
+}
+
+func (BasicFilesystem) MkdirAll(name string, perm os.FileMode) error {
+	return os.MkdirAll(longFilename(name), perm)
+}
+
+func (BasicFilesystem) Open(name string) (File, error) {
+	fd, err := os.Open(longFilename(name))
+	if err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+func (BasicFilesystem) Readlink(name string) (string, error) {
+	return os.Readlink(longFilename(name))
+}
+
+func (BasicFilesystem) Remove(name string) error {
+	return os.Remove(longFilename(name))
+}
+
+// Rename has the semantics of osutil.Rename, i.e. an existing target is
+// replaced also on Windows.
+func (BasicFilesystem) Rename(oldname, newname string) error {
+	return osutil.Rename(longFilename(oldname), longFilename(newname))
+}
+
+// SetACL has the semantics of osutil.SetACL.
+func (BasicFilesystem) SetACL(name string, acl []byte) error {
+	return osutil.SetACL(longFilename(name), acl)
+}
+
+func (BasicFilesystem) Show(name string) error {
+	return osutil.ShowFile(longFilename(name))
+}
+
+func (BasicFilesystem) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(longFilename(name))
+}
+
+// Symlink has the semantics of osutil.Symlink.
+func (BasicFilesystem) Symlink(target, newname string) error {
+	return osutil.Symlink(target, longFilename(newname))
+}
+
+// Walk passes paths to walkFn relative to root as given, regardless of any
+// long filename prefix used internally.
+func (BasicFilesystem) Walk(root string, walkFn filepath.WalkFunc) error {
+	long := longFilename(root)
+	if long == root {
+		return filepath.Walk(root, walkFn)
+	}
+	return filepath.Walk(long, func(path string, info os.FileInfo, err error) error {
+		return walkFn(root+path[len(long):], info, err)
+	})
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package fs
+
+func longFilename(name string) string {
+	return name
+}
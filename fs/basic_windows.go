@@ -0,0 +1,31 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package fs
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// longFilename returns name with the \\?\ prefix that lifts the MAX_PATH
+// limit of the Win32 API. The prefix also turns off path normalization, so
+// it is only added to absolute paths, after cleaning them. Relative paths
+// and paths already on the \\?\ or \\.\ form are returned unchanged.
+func longFilename(name string) string {
+	if !filepath.IsAbs(name) {
+		return name
+	}
+	if strings.HasPrefix(name, `\\?\`) || strings.HasPrefix(name, `\\.\`) {
+		return name
+	}
+	name = filepath.Clean(name)
+	if strings.HasPrefix(name, `\\`) {
+		// UNC path, \\server\share\...
+		return `\\?\UNC\` + name[2:]
+	}
+	return `\\?\` + name
+}
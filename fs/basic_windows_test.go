@@ -0,0 +1,27 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package fs
+
+import "testing"
+
+var longFilenameCases = []struct {
+	in, out string
+}{
+	{`foo\bar`, `foo\bar`},
+	{`C:\foo\bar`, `\\?\C:\foo\bar`},
+	{`C:\foo\..\bar\`, `\\?\C:\bar`},
+	{`\\server\share\foo`, `\\?\UNC\server\share\foo`},
+	{`\\?\C:\foo`, `\\?\C:\foo`},
+}
+
+func TestLongFilename(t *testing.T) {
+	for _, tc := range longFilenameCases {
+		if res := longFilename(tc.in); res != tc.out {
+			t.Errorf("longFilename(%q) = %q, expected %q", tc.in, res, tc.out)
+		}
+	}
+}
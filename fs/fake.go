@@ -0,0 +1,555 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package fs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FakeOp names an operation on a FakeFilesystem, for the purpose of error
+// injection.
+type FakeOp string
+
+const (
+	OpChmod    FakeOp = "chmod"
+	OpChtimes  FakeOp = "chtimes"
+	OpCreate   FakeOp = "create"
+	OpGetACL   FakeOp = "getacl"
+	OpLstat    FakeOp = "lstat"
+	OpMkdirAll FakeOp = "mkdir"
+	OpOpen     FakeOp = "open"
+	OpRead     FakeOp = "read"
+	OpReadlink FakeOp = "readlink"
+	OpRemove   FakeOp = "remove"
+	OpRename   FakeOp = "rename"
+	OpSetACL   FakeOp = "setacl"
+	OpStat     FakeOp = "stat"
+	OpSymlink  FakeOp = "symlink"
+	OpTruncate FakeOp = "truncate"
+	OpWrite    FakeOp = "write"
+)
+
+type fakeEntry struct {
+	name    string
+	mode    os.FileMode
+	modTime time.Time
+	data    []byte
+	acl     []byte
+	target  string // for symlinks
+}
+
+type fakeErrKey struct {
+	op   FakeOp
+	name string
+}
+
+// FakeFilesystem is an in memory implementation of Filesystem, intended for
+// tests. Errors can be injected for any operation on a given path, writes
+// can be limited to simulate a full disk and modification times can be set
+// freely using Chtimes. The root directory always exists.
+type FakeFilesystem struct {
+	entries     map[string]*fakeEntry
+	errors      map[fakeErrKey]error
+	writeLimits map[string]int64
+	mut         sync.Mutex
+}
+
+func NewFakeFilesystem() *FakeFilesystem {
+	return &FakeFilesystem{
+		entries:     make(map[string]*fakeEntry),
+		errors:      make(map[fakeErrKey]error),
+		writeLimits: make(map[string]int64),
+	}
+}
+
+// InjectError causes all following calls of the given operation on the named
+// path to fail with the given error, wrapped in an *os.PathError. A nil error
+// removes a previously injected error.
+func (f *FakeFilesystem) InjectError(op FakeOp, name string, err error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	k := fakeErrKey{op, filepath.Clean(name)}
+	if err == nil {
+		delete(f.errors, k)
+	} else {
+		f.errors[k] = err
+	}
+}
+
+// LimitWrites limits the size of the named file to n bytes. A write that
+// would pass the limit is cut short and returns ENOSPC. A negative limit
+// removes a previously set limit.
+func (f *FakeFilesystem) LimitWrites(name string, n int64) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	name = filepath.Clean(name)
+	if n < 0 {
+		delete(f.writeLimits, name)
+	} else {
+		f.writeLimits[name] = n
+	}
+}
+
+func (f *FakeFilesystem) Chmod(name string, mode os.FileMode) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpChmod, name)
+	if err != nil {
+		return err
+	}
+	e.mode = e.mode&^os.ModePerm | mode&os.ModePerm
+	return nil
+}
+
+func (f *FakeFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpChtimes, name)
+	if err != nil {
+		return err
+	}
+	e.modTime = mtime
+	return nil
+}
+
+func (f *FakeFilesystem) Create(name string) (File, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	name = filepath.Clean(name)
+	if err := f.injected(OpCreate, name); err != nil {
+		return nil, err
+	}
+	if !f.isDir(filepath.Dir(name)) {
+		return nil, pathError(OpCreate, name, syscall.ENOENT)
+	}
+	if e, ok := f.entries[name]; ok && e.mode.IsDir() {
+		return nil, pathError(OpCreate, name, syscall.EISDIR)
+	}
+	e := &fakeEntry{
+		name:    name,
+		mode:    0666,
+		modTime: time.Now(),
+	}
+	f.entries[name] = e
+	return &fakeFile{fs: f, entry: e}, nil
+}
+
+func (f *FakeFilesystem) GetACL(name string) ([]byte, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpGetACL, name)
+	if err != nil {
+		return nil, err
+	}
+	return e.acl, nil
+}
+
+func (f *FakeFilesystem) Glob(pattern string) ([]string, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	var matches []string
+	for name := range f.entries {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			matches = append(matches, name)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func (f *FakeFilesystem) Hide(name string) error {
+	return nil
+}
+
+func (f *FakeFilesystem) Lstat(name string) (os.FileInfo, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpLstat, name)
+	if err != nil {
+		return nil, err
+	}
+	return e.info(), nil
+}
+
+func (f *FakeFilesystem) MkdirAll(name string, perm os.FileMode) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	name = filepath.Clean(name)
+	if err := f.injected(OpMkdirAll, name); err != nil {
+		return err
+	}
+
+	// Collect the missing directories, deepest first
+	var missing []string
+	for dir := name; !isRoot(dir); dir = filepath.Dir(dir) {
+		if e, ok := f.entries[dir]; ok {
+			if !e.mode.IsDir() {
+				return pathError(OpMkdirAll, dir, syscall.ENOTDIR)
+			}
+			break
+		}
+		missing = append(missing, dir)
+	}
+
+	for i := len(missing) - 1; i >= 0; i-- {
+		f.entries[missing[i]] = &fakeEntry{
+			name:    missing[i],
+			mode:    os.ModeDir | perm&os.ModePerm,
+			modTime: time.Now(),
+		}
+	}
+	return nil
+}
+
+func (f *FakeFilesystem) Open(name string) (File, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpOpen, name)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeFile{fs: f, entry: e}, nil
+}
+
+func (f *FakeFilesystem) Readlink(name string) (string, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpReadlink, name)
+	if err != nil {
+		return "", err
+	}
+	if e.mode&os.ModeSymlink == 0 {
+		return "", pathError(OpReadlink, name, syscall.EINVAL)
+	}
+	return e.target, nil
+}
+
+func (f *FakeFilesystem) Remove(name string) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpRemove, name)
+	if err != nil {
+		return err
+	}
+	if e.mode.IsDir() && len(f.children(e.name)) > 0 {
+		return pathError(OpRemove, name, syscall.ENOTEMPTY)
+	}
+	delete(f.entries, e.name)
+	return nil
+}
+
+func (f *FakeFilesystem) Rename(oldname, newname string) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpRename, oldname)
+	if err != nil {
+		return err
+	}
+	newname = filepath.Clean(newname)
+	if err := f.injected(OpRename, newname); err != nil {
+		return err
+	}
+	if !f.isDir(filepath.Dir(newname)) {
+		return pathError(OpRename, newname, syscall.ENOENT)
+	}
+	if t, ok := f.entries[newname]; ok && t.mode.IsDir() {
+		return pathError(OpRename, newname, syscall.EEXIST)
+	}
+
+	for _, child := range f.children(e.name) {
+		ce := f.entries[child]
+		delete(f.entries, child)
+		ce.name = newname + child[len(e.name):]
+		f.entries[ce.name] = ce
+	}
+	delete(f.entries, e.name)
+	e.name = newname
+	f.entries[newname] = e
+	return nil
+}
+
+func (f *FakeFilesystem) SetACL(name string, acl []byte) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpSetACL, name)
+	if err != nil {
+		return err
+	}
+	e.acl = append([]byte(nil), acl...)
+	return nil
+}
+
+func (f *FakeFilesystem) Show(name string) error {
+	return nil
+}
+
+func (f *FakeFilesystem) Stat(name string) (os.FileInfo, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	e, err := f.lookup(OpStat, name)
+	if err != nil {
+		return nil, err
+	}
+	return e.info(), nil
+}
+
+// Symlink creates a symlink entry. The target is recorded but never
+// followed; operations on the link apply to the link itself.
+func (f *FakeFilesystem) Symlink(target, newname string) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	newname = filepath.Clean(newname)
+	if err := f.injected(OpSymlink, newname); err != nil {
+		return err
+	}
+	if !f.isDir(filepath.Dir(newname)) {
+		return pathError(OpSymlink, newname, syscall.ENOENT)
+	}
+	if _, ok := f.entries[newname]; ok {
+		return pathError(OpSymlink, newname, syscall.EEXIST)
+	}
+	f.entries[newname] = &fakeEntry{
+		name:    newname,
+		mode:    os.ModeSymlink | 0777,
+		modTime: time.Now(),
+		target:  target,
+	}
+	return nil
+}
+
+// Walk walks the file tree rooted at root in lexical order, in the same
+// manner as filepath.Walk.
+func (f *FakeFilesystem) Walk(root string, walkFn filepath.WalkFunc) error {
+	info, err := f.Lstat(root)
+	if err != nil {
+		return walkFn(root, nil, err)
+	}
+	return f.walk(filepath.Clean(root), info, walkFn)
+}
+
+func (f *FakeFilesystem) walk(name string, info os.FileInfo, walkFn filepath.WalkFunc) error {
+	err := walkFn(name, info, nil)
+	if err != nil {
+		if info.IsDir() && err == filepath.SkipDir {
+			return nil
+		}
+		return err
+	}
+	if !info.IsDir() {
+		return nil
+	}
+
+	f.mut.Lock()
+	var names []string
+	for _, child := range f.children(name) {
+		if filepath.Dir(child) == name {
+			names = append(names, child)
+		}
+	}
+	f.mut.Unlock()
+	sort.Strings(names)
+
+	for _, child := range names {
+		info, err := f.Lstat(child)
+		if err != nil {
+			if err := walkFn(child, info, err); err != nil && err != filepath.SkipDir {
+				return err
+			}
+			continue
+		}
+		err = f.walk(child, info, walkFn)
+		if err != nil {
+			if !info.IsDir() || err != filepath.SkipDir {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lookup returns the entry for the named path, or an error if it doesn't
+// exist or an error has been injected for the operation.
+func (f *FakeFilesystem) lookup(op FakeOp, name string) (*fakeEntry, error) {
+	name = filepath.Clean(name)
+	if err := f.injected(op, name); err != nil {
+		return nil, err
+	}
+	if isRoot(name) {
+		return &fakeEntry{name: name, mode: os.ModeDir | 0777}, nil
+	}
+	e, ok := f.entries[name]
+	if !ok {
+		return nil, pathError(op, name, syscall.ENOENT)
+	}
+	return e, nil
+}
+
+func (f *FakeFilesystem) injected(op FakeOp, name string) error {
+	if err, ok := f.errors[fakeErrKey{op, name}]; ok {
+		return pathError(op, name, err)
+	}
+	return nil
+}
+
+func (f *FakeFilesystem) isDir(name string) bool {
+	if isRoot(name) {
+		return true
+	}
+	e, ok := f.entries[name]
+	return ok && e.mode.IsDir()
+}
+
+// children returns the names of all entries below the named directory, at
+// any depth.
+func (f *FakeFilesystem) children(dir string) []string {
+	prefix := dir + string(os.PathSeparator)
+	if isRoot(dir) {
+		prefix = ""
+		if dir != "." {
+			prefix = dir
+		}
+	}
+	var names []string
+	for name := range f.entries {
+		if name != dir && strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func isRoot(name string) bool {
+	return name == "." || name == string(os.PathSeparator) || filepath.Dir(name) == name
+}
+
+func pathError(op FakeOp, name string, err error) error {
+	return &os.PathError{Op: string(op), Path: name, Err: err}
+}
+
+type fakeFile struct {
+	fs     *FakeFilesystem
+	entry  *fakeEntry
+	offset int64
+}
+
+func (f *fakeFile) Read(bs []byte) (int, error) {
+	n, err := f.ReadAt(bs, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *fakeFile) ReadAt(bs []byte, offset int64) (int, error) {
+	f.fs.mut.Lock()
+	defer f.fs.mut.Unlock()
+	if err := f.fs.injected(OpRead, f.entry.name); err != nil {
+		return 0, err
+	}
+	if f.entry.mode.IsDir() {
+		return 0, pathError(OpRead, f.entry.name, syscall.EISDIR)
+	}
+	if offset >= int64(len(f.entry.data)) {
+		return 0, io.EOF
+	}
+	n := copy(bs, f.entry.data[offset:])
+	if n < len(bs) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *fakeFile) Write(bs []byte) (int, error) {
+	n, err := f.WriteAt(bs, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *fakeFile) WriteAt(bs []byte, offset int64) (int, error) {
+	f.fs.mut.Lock()
+	defer f.fs.mut.Unlock()
+	if err := f.fs.injected(OpWrite, f.entry.name); err != nil {
+		return 0, err
+	}
+
+	var err error
+	end := offset + int64(len(bs))
+	if limit, ok := f.fs.writeLimits[f.entry.name]; ok && end > limit {
+		end = limit
+		if end < offset {
+			end = offset
+		}
+		bs = bs[:end-offset]
+		err = pathError(OpWrite, f.entry.name, syscall.ENOSPC)
+	}
+
+	if end > int64(len(f.entry.data)) {
+		data := make([]byte, end)
+		copy(data, f.entry.data)
+		f.entry.data = data
+	}
+	copy(f.entry.data[offset:], bs)
+	f.entry.modTime = time.Now()
+	return len(bs), err
+}
+
+func (f *fakeFile) Truncate(size int64) error {
+	f.fs.mut.Lock()
+	defer f.fs.mut.Unlock()
+	if err := f.fs.injected(OpTruncate, f.entry.name); err != nil {
+		return err
+	}
+
+	data := make([]byte, size)
+	copy(data, f.entry.data)
+	f.entry.data = data
+	f.entry.modTime = time.Now()
+	return nil
+}
+
+func (f *fakeFile) Close() error {
+	return nil
+}
+
+func (f *fakeFile) Name() string {
+	return f.entry.name
+}
+
+func (f *fakeFile) Stat() (os.FileInfo, error) {
+	f.fs.mut.Lock()
+	defer f.fs.mut.Unlock()
+	return f.entry.info(), nil
+}
+
+func (e *fakeEntry) info() os.FileInfo {
+	return fakeFileInfo{
+		name:    filepath.Base(e.name),
+		size:    int64(len(e.data)),
+		mode:    e.mode,
+		modTime: e.modTime,
+	}
+}
+
+type fakeFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (i fakeFileInfo) Name() string       { return i.name }
+func (i fakeFileInfo) Size() int64        { return i.size }
+func (i fakeFileInfo) Mode() os.FileMode  { return i.mode }
+func (i fakeFileInfo) ModTime() time.Time { return i.modTime }
+func (i fakeFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i fakeFileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,184 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestFakeCreateReadWrite(t *testing.T) {
+	f := NewFakeFilesystem()
+
+	if _, err := f.Create("a/b"); !os.IsNotExist(err) {
+		t.Errorf("Unexpected error creating file in missing dir: %v", err)
+	}
+
+	if err := f.MkdirAll("a", 0755); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := f.Create("a/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.WriteAt([]byte("world"), 6); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.WriteAt([]byte("hello "), 0); err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+
+	bs, err := ReadFile(f, "a/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != "hello world" {
+		t.Errorf("Incorrect data %q", bs)
+	}
+}
+
+func TestFakeTruncate(t *testing.T) {
+	f := NewFakeFilesystem()
+	fd, _ := f.Create("a")
+	fd.Write([]byte("hello"))
+	if err := fd.Truncate(8); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.WriteAt([]byte("!"), 7); err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+
+	bs, _ := ReadFile(f, "a")
+	if string(bs) != "hello\x00\x00!" {
+		t.Errorf("Incorrect data %q", bs)
+	}
+
+	fd, _ = f.Create("a")
+	fd.Truncate(2)
+	fd.Close()
+	if info, _ := f.Stat("a"); info.Size() != 2 {
+		t.Errorf("Incorrect size %d", info.Size())
+	}
+}
+
+func TestFakeWalk(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.MkdirAll("r/b/c", 0755)
+	f.MkdirAll("r/a", 0755)
+	f.Create("r/b/f")
+	f.Create("r/a/f")
+	f.Create("other")
+
+	var seen []string
+	f.Walk("r", func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen = append(seen, p)
+		if p == "r/b/c" {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+
+	expected := []string{"r", "r/a", "r/a/f", "r/b", "r/b/c", "r/b/f"}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("Incorrect walk\n  E: %v\n  A: %v", expected, seen)
+	}
+}
+
+func TestFakeRenameRemove(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.MkdirAll("a/b", 0755)
+	f.Create("a/b/c")
+
+	if err := f.Remove("a"); err == nil {
+		t.Error("Unexpected nil error removing non empty dir")
+	}
+	if err := f.Rename("a", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Stat("x/b/c"); err != nil {
+		t.Error(err)
+	}
+	if _, err := f.Stat("a/b/c"); !os.IsNotExist(err) {
+		t.Errorf("Unexpected error for renamed file: %v", err)
+	}
+}
+
+func TestFakeInjectedErrors(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.Create("a")
+
+	f.InjectError(OpOpen, "a", syscall.EACCES)
+	if _, err := f.Open("a"); !os.IsPermission(err) {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	f.InjectError(OpOpen, "a", nil)
+	if _, err := f.Open("a"); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestFakeShortWrite(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.LimitWrites("a", 4)
+
+	fd, _ := f.Create("a")
+	n, err := fd.Write([]byte("hello"))
+	if n != 4 {
+		t.Errorf("Unexpected write length %d", n)
+	}
+	if pe, ok := err.(*os.PathError); !ok || pe.Err != syscall.ENOSPC {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestFakeChtimes(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.Create("a")
+
+	mt := time.Date(2010, 1, 2, 3, 4, 5, 6, time.UTC)
+	if err := f.Chtimes("a", mt, mt); err != nil {
+		t.Fatal(err)
+	}
+	info, _ := f.Stat("a")
+	if !info.ModTime().Equal(mt) {
+		t.Errorf("Incorrect modtime %v", info.ModTime())
+	}
+}
+
+func TestFakeSymlink(t *testing.T) {
+	f := NewFakeFilesystem()
+	f.MkdirAll("a", 0755)
+
+	if err := f.Symlink("../b", "a/link"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Symlink("../c", "a/link"); !os.IsExist(err) {
+		t.Errorf("Unexpected error replacing symlink: %v", err)
+	}
+
+	info, err := f.Lstat("a/link")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("Not a symlink: %v", info.Mode())
+	}
+	if target, err := f.Readlink("a/link"); err != nil || target != "../b" {
+		t.Errorf("Incorrect target %q, %v", target, err)
+	}
+	if _, err := f.Readlink("a"); err == nil {
+		t.Error("Unexpected nil error reading a directory as link")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package fs provides an abstraction of the file system operations used by
+// the scanner, puller and versioners.
+package fs
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The Filesystem interface abstracts access to the file system. Paths are
+// native paths, exactly as they would have been given to the corresponding
+// function in the os package.
+type Filesystem interface {
+	Chmod(name string, mode os.FileMode) error
+	Chtimes(name string, atime time.Time, mtime time.Time) error
+	Create(name string) (File, error)
+	GetACL(name string) ([]byte, error)
+	Glob(pattern string) ([]string, error)
+	Hide(name string) error
+	Lstat(name string) (os.FileInfo, error)
+	MkdirAll(name string, perm os.FileMode) error
+	Open(name string) (File, error)
+	Readlink(name string) (string, error)
+	Remove(name string) error
+	Rename(oldname, newname string) error
+	SetACL(name string, acl []byte) error
+	Show(name string) error
+	Stat(name string) (os.FileInfo, error)
+	Symlink(target, newname string) error
+	Walk(root string, walkFn filepath.WalkFunc) error
+}
+
+// The File interface abstracts access to a regular file.
+type File interface {
+	io.Reader
+	io.ReaderAt
+	io.Writer
+	io.WriterAt
+	io.Closer
+	Name() string
+	Stat() (os.FileInfo, error)
+	// Truncate changes the size of the file. A file extended by it reads
+	// as zeros, and is sparse where the file system supports it.
+	Truncate(size int64) error
+}
+
+// DefaultFilesystem is the Filesystem used when nothing else is specified.
+var DefaultFilesystem Filesystem = BasicFilesystem{}
+
+// ReadFile reads the named file and returns the contents, in the same manner
+// as ioutil.ReadFile.
+func ReadFile(fs Filesystem, name string) ([]byte, error) {
+	fd, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	return ioutil.ReadAll(fd)
+}
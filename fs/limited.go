@@ -0,0 +1,156 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package fs
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrTooManyOpenFiles is returned by TryOpen and TryCreate when the limit of
+// open files is reached.
+var ErrTooManyOpenFiles = errors.New("too many open files")
+
+// LimitedFilesystem is a Filesystem that allows at most a given number of
+// files to be open through it at the same time. Opening or creating another
+// file waits until one of the open ones is closed. Everything else is passed
+// straight through to the underlying Filesystem.
+//
+// Waiting for a file to be closed while holding others open may never end,
+// so those that open files in turn use TryOpen and TryCreate instead.
+type LimitedFilesystem struct {
+	Filesystem
+	max     int
+	open    int
+	waiting int
+	cond    *sync.Cond
+	mut     sync.Mutex
+}
+
+// NewLimitedFilesystem returns a LimitedFilesystem allowing at most max open
+// files on top of the given Filesystem.
+func NewLimitedFilesystem(filesystem Filesystem, max int) *LimitedFilesystem {
+	f := &LimitedFilesystem{
+		Filesystem: filesystem,
+		max:        max,
+	}
+	f.cond = sync.NewCond(&f.mut)
+	return f
+}
+
+func (f *LimitedFilesystem) Create(name string) (File, error) {
+	f.acquire()
+	fd, err := f.Filesystem.Create(name)
+	if err != nil {
+		f.release()
+		return nil, err
+	}
+	return &limitedFile{File: fd, fs: f}, nil
+}
+
+func (f *LimitedFilesystem) Open(name string) (File, error) {
+	f.acquire()
+	fd, err := f.Filesystem.Open(name)
+	if err != nil {
+		f.release()
+		return nil, err
+	}
+	return &limitedFile{File: fd, fs: f}, nil
+}
+
+// TryCreate is like Create, but returns ErrTooManyOpenFiles instead of
+// waiting when the limit is reached.
+func (f *LimitedFilesystem) TryCreate(name string) (File, error) {
+	if !f.tryAcquire() {
+		return nil, ErrTooManyOpenFiles
+	}
+	fd, err := f.Filesystem.Create(name)
+	if err != nil {
+		f.release()
+		return nil, err
+	}
+	return &limitedFile{File: fd, fs: f}, nil
+}
+
+// TryOpen is like Open, but returns ErrTooManyOpenFiles instead of waiting
+// when the limit is reached.
+func (f *LimitedFilesystem) TryOpen(name string) (File, error) {
+	if !f.tryAcquire() {
+		return nil, ErrTooManyOpenFiles
+	}
+	fd, err := f.Filesystem.Open(name)
+	if err != nil {
+		f.release()
+		return nil, err
+	}
+	return &limitedFile{File: fd, fs: f}, nil
+}
+
+// Stats returns the number of files currently open through the
+// LimitedFilesystem and the number of opens waiting for one to be closed.
+func (f *LimitedFilesystem) Stats() (open, waiting int) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	return f.open, f.waiting
+}
+
+func (f *LimitedFilesystem) acquire() {
+	f.mut.Lock()
+	for f.open >= f.max {
+		f.waiting++
+		f.cond.Wait()
+		f.waiting--
+	}
+	f.open++
+	f.mut.Unlock()
+}
+
+func (f *LimitedFilesystem) tryAcquire() bool {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if f.open >= f.max {
+		return false
+	}
+	f.open++
+	return true
+}
+
+func (f *LimitedFilesystem) release() {
+	f.mut.Lock()
+	f.open--
+	f.mut.Unlock()
+	f.cond.Signal()
+}
+
+// A limitedFile gives its slot back to the LimitedFilesystem when closed.
+type limitedFile struct {
+	File
+	fs   *LimitedFilesystem
+	once sync.Once
+}
+
+func (f *limitedFile) Close() error {
+	err := f.File.Close()
+	f.once.Do(f.fs.release)
+	return err
+}
+
+// TryCreate creates the named file through fs, without waiting if fs is a
+// LimitedFilesystem at its limit.
+func TryCreate(fs Filesystem, name string) (File, error) {
+	if lf, ok := fs.(*LimitedFilesystem); ok {
+		return lf.TryCreate(name)
+	}
+	return fs.Create(name)
+}
+
+// TryOpen opens the named file through fs, without waiting if fs is a
+// LimitedFilesystem at its limit.
+func TryOpen(fs Filesystem, name string) (File, error) {
+	if lf, ok := fs.(*LimitedFilesystem); ok {
+		return lf.TryOpen(name)
+	}
+	return fs.Open(name)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package fs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimitedFilesystem(t *testing.T) {
+	f := NewFakeFilesystem()
+	lf := NewLimitedFilesystem(f, 2)
+
+	a, err := lf.Create("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Failed opens don't use up the limit
+	if _, err := lf.Open("missing"); err == nil {
+		t.Fatal("Unexpected nil error")
+	}
+
+	b, err := lf.Create("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if open, waiting := lf.Stats(); open != 2 || waiting != 0 {
+		t.Fatalf("Unexpected stats %d open, %d waiting", open, waiting)
+	}
+
+	opened := make(chan File)
+	go func() {
+		fd, _ := lf.Open("a")
+		opened <- fd
+	}()
+
+	select {
+	case <-opened:
+		t.Fatal("Open did not wait for a file to be closed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, waiting := lf.Stats(); waiting != 1 {
+		t.Errorf("Unexpected %d waiting", waiting)
+	}
+
+	a.Close()
+	a.Close() // a second close gives nothing back
+	c := <-opened
+	if open, waiting := lf.Stats(); open != 2 || waiting != 0 {
+		t.Errorf("Unexpected stats %d open, %d waiting", open, waiting)
+	}
+
+	b.Close()
+	c.Close()
+	if open, _ := lf.Stats(); open != 0 {
+		t.Errorf("Unexpected %d open", open)
+	}
+}
+
+func TestLimitedFilesystemTry(t *testing.T) {
+	f := NewFakeFilesystem()
+	lf := NewLimitedFilesystem(f, 1)
+
+	a, err := TryCreate(lf, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := TryOpen(lf, "a"); err != ErrTooManyOpenFiles {
+		t.Fatalf("Unexpected error %v opening at the limit", err)
+	}
+	if _, err := TryCreate(lf, "b"); err != ErrTooManyOpenFiles {
+		t.Fatalf("Unexpected error %v creating at the limit", err)
+	}
+	if open, waiting := lf.Stats(); open != 1 || waiting != 0 {
+		t.Fatalf("Unexpected stats %d open, %d waiting", open, waiting)
+	}
+
+	a.Close()
+	b, err := TryOpen(lf, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	// Other file systems have no limit to wait for
+	if fd, err := TryOpen(f, "a"); err != nil {
+		t.Fatal(err)
+	} else {
+		fd.Close()
+	}
+}
@@ -3,7 +3,7 @@
 // found in the LICENSE file.
 
 /*jslint browser: true, continue: true, plusplus: true */
-/*global $: false, angular: false */
+/*global $: false, angular: false, metadata: false */
 
 'use strict';
 
@@ -11,8 +11,8 @@ var syncthing = angular.module('syncthing', []);
 var urlbase = 'rest';
 
 syncthing.config(function ($httpProvider) {
-    $httpProvider.defaults.xsrfHeaderName = 'X-CSRF-Token';
-    $httpProvider.defaults.xsrfCookieName = 'CSRF-Token';
+    $httpProvider.defaults.xsrfHeaderName = 'X-CSRF-Token-' + metadata.nodeIDShort;
+    $httpProvider.defaults.xsrfCookieName = 'CSRF-Token-' + metadata.nodeIDShort;
 });
 
 syncthing.controller('SyncthingCtrl', function ($scope, $http) {
@@ -50,12 +50,15 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
     $scope.settings = [
     {id: 'ListenStr', descr: 'Sync Protocol Listen Addresses', type: 'text'},
     {id: 'MaxSendKbps', descr: 'Outgoing Rate Limit (KiB/s)', type: 'number'},
-    {id: 'RescanIntervalS', descr: 'Rescan Interval (s)', type: 'number'},
+    {id: 'MaxRecvKbps', descr: 'Incoming Rate Limit (KiB/s)', type: 'number'},
+    {id: 'RescanIntervalS', descr: 'Default Rescan Interval (s)', type: 'number'},
+    {id: 'MaxConcurrentScans', descr: 'Max Concurrent Scans', type: 'number'},
     {id: 'ReconnectIntervalS', descr: 'Reconnect Interval (s)', type: 'number'},
     {id: 'ParallelRequests', descr: 'Max Outstanding Requests', type: 'number'},
     {id: 'MaxChangeKbps', descr: 'Max File Change Rate (KiB/s)', type: 'number'},
 
     {id: 'LocalAnnPort', descr: 'Local Discovery Port', type: 'number'},
+    {id: 'LocalAnnMCAddr', descr: 'Local Discovery IPv6 Multicast Address', type: 'text'},
     {id: 'LocalAnnEnabled', descr: 'Local Discovery', type: 'bool'},
     {id: 'GlobalAnnEnabled', descr: 'Global Discovery', type: 'bool'},
     {id: 'StartBrowser', descr: 'Start Browser', type: 'bool'},
@@ -148,6 +151,16 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
             state += " (" + $scope.syncPercentage(repo) + "%)";
         }
 
+        if (state == "Scanning" && $scope.model[repo].scanPercent >= 0) {
+            state += " (" + Math.floor($scope.model[repo].scanPercent) + "%";
+            if ($scope.model[repo].scanETA >= 0) {
+                state += ", " + $scope.scanETA(repo) + " left";
+            }
+            state += ")";
+        } else if (state == "Scanning" && $scope.model[repo].scanDiscoveredFiles > 0) {
+            state += " (" + $scope.model[repo].scanDiscoveredFiles + " items)";
+        }
+
         return state;
     };
 
@@ -170,6 +183,17 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
         return 'info';
     };
 
+    $scope.scanETA = function (repo) {
+        var s = $scope.model[repo].scanETA;
+        if (s < 60) {
+            return s + " s";
+        }
+        if (s < 3600) {
+            return Math.round(s / 60) + " min";
+        }
+        return Math.round(s / 3600) + " h";
+    };
+
     $scope.syncPercentage = function (repo) {
         if (typeof $scope.model[repo] === 'undefined') {
             return 100;
@@ -183,6 +207,10 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
     };
 
     $scope.nodeStatus = function (nodeCfg) {
+        if (nodeCfg.Paused) {
+            return 'Paused';
+        }
+
         var conn = $scope.connections[nodeCfg.NodeID];
         if (conn) {
             if (conn.Completion === 100) {
@@ -474,17 +502,27 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
             $scope.currentRepo.selectedNodes[n.NodeID] = true;
         });
         if ($scope.currentRepo.Versioning && $scope.currentRepo.Versioning.Type === "simple") {
-            $scope.currentRepo.simpleFileVersioning = true;
+            $scope.currentRepo.fileVersioning = "simple";
             $scope.currentRepo.simpleKeep = +$scope.currentRepo.Versioning.Params.keep;
+            $scope.currentRepo.versionsDir = $scope.currentRepo.Versioning.Params.versionsDir;
+        } else if ($scope.currentRepo.Versioning && $scope.currentRepo.Versioning.Type === "staggered") {
+            $scope.currentRepo.fileVersioning = "staggered";
+            if ($scope.currentRepo.Versioning.Params.maxAge) {
+                $scope.currentRepo.staggeredMaxAge = Math.round($scope.currentRepo.Versioning.Params.maxAge / 86400);
+            }
+            $scope.currentRepo.versionsDir = $scope.currentRepo.Versioning.Params.versionsDir;
         }
         $scope.currentRepo.simpleKeep = $scope.currentRepo.simpleKeep || 5;
+        if ($scope.currentRepo.staggeredMaxAge === undefined) {
+            $scope.currentRepo.staggeredMaxAge = 365;
+        }
         $scope.editingExisting = true;
         $scope.repoEditor.$setPristine();
         $('#editRepo').modal({backdrop: 'static', keyboard: true});
     };
 
     $scope.addRepo = function () {
-        $scope.currentRepo = {selectedNodes: {}};
+        $scope.currentRepo = {selectedNodes: {}, simpleKeep: 5, staggeredMaxAge: 365, RescanIntervalS: $scope.config.Options.RescanIntervalS};
         $scope.editingExisting = false;
         $scope.repoEditor.$setPristine();
         $('#editRepo').modal({backdrop: 'static', keyboard: true});
@@ -504,18 +542,30 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
         }
         delete repoCfg.selectedNodes;
 
-        if (repoCfg.simpleFileVersioning) {
+        if (repoCfg.fileVersioning === "simple") {
             repoCfg.Versioning = {
                 'Type': 'simple',
                 'Params': {
                     'keep': '' + repoCfg.simpleKeep,
                 }
             };
-            delete repoCfg.simpleFileVersioning;
-            delete repoCfg.simpleKeep;
+        } else if (repoCfg.fileVersioning === "staggered") {
+            repoCfg.Versioning = {
+                'Type': 'staggered',
+                'Params': {
+                    'maxAge': '' + (repoCfg.staggeredMaxAge * 86400),
+                }
+            };
         } else {
             delete repoCfg.Versioning;
         }
+        if (repoCfg.Versioning && repoCfg.versionsDir) {
+            repoCfg.Versioning.Params.versionsDir = repoCfg.versionsDir;
+        }
+        delete repoCfg.fileVersioning;
+        delete repoCfg.simpleKeep;
+        delete repoCfg.staggeredMaxAge;
+        delete repoCfg.versionsDir;
 
         $scope.repos[repoCfg.ID] = repoCfg;
         $scope.config.Repositories = repoList($scope.repos);
@@ -618,6 +668,13 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
         });
     };
 
+    $scope.showLocalChanged = function (repo) {
+        $('#localChanged').modal({backdrop: 'static', keyboard: true});
+        $http.get(urlbase + "/localchanged?repo=" + encodeURIComponent(repo)).success(function (data) {
+            $scope.localChanged = data;
+        });
+    };
+
     $scope.needAction = function (file) {
         var fDelete = 4096;
         var fDirectory = 16384;
@@ -639,6 +696,28 @@ syncthing.controller('SyncthingCtrl', function ($scope, $http) {
         });
     };
 
+    $scope.revert = function (repo) {
+        $http.post(urlbase + "/model/revert?repo=" + encodeURIComponent(repo)).success(function () {
+            $scope.refresh();
+        });
+    };
+
+    $scope.setPaused = function (repo, paused) {
+        var action = paused ? "/model/pause" : "/model/resume";
+        $http.post(urlbase + action + "?repo=" + encodeURIComponent(repo)).success(function () {
+            $scope.repos[repo].Paused = paused;
+            $scope.refresh();
+        });
+    };
+
+    $scope.setNodePaused = function (nodeCfg, paused) {
+        var action = paused ? "/node/pause" : "/node/resume";
+        $http.post(urlbase + action + "?node=" + encodeURIComponent(nodeCfg.NodeID)).success(function () {
+            nodeCfg.Paused = paused;
+            $scope.refresh();
+        });
+    };
+
     $scope.init();
     setInterval($scope.refresh, 10000);
 });
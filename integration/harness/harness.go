@@ -0,0 +1,300 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package harness runs clusters of in-process nodes for integration tests.
+//
+// Each node gets its own home directory below the cluster directory, for the
+// certificate and index, and its own in memory filesystem holding the
+// repository. All nodes share the repository "default" and connect to each
+// other over the loopback interface; discovery and UPnP are disabled.
+package harness
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/engine"
+	"github.com/calmh/syncthing/fs"
+)
+
+// RepoID is the ID of the repository shared by all nodes in a Cluster.
+const RepoID = "default"
+
+type Node struct {
+	*engine.Engine
+	Name    string
+	Home    string
+	FS      *fs.FakeFilesystem
+	RepoDir string // in FS
+	Address string
+}
+
+type Cluster struct {
+	Dir   string
+	Nodes []*Node
+}
+
+// NewCluster creates, but does not start, a cluster of n nodes with their
+// home directories below dir.
+func NewCluster(dir string, n int) (*Cluster, error) {
+	c := &Cluster{Dir: dir}
+
+	for i := 0; i < n; i++ {
+		port, err := freePort()
+		if err != nil {
+			return nil, err
+		}
+
+		name := "n" + strconv.Itoa(i+1)
+		node := &Node{
+			Name:    name,
+			Home:    filepath.Join(dir, name),
+			FS:      fs.NewFakeFilesystem(),
+			RepoDir: filepath.Join(string(os.PathSeparator), "repo"),
+			Address: fmt.Sprintf("127.0.0.1:%d", port),
+		}
+		if err := node.FS.MkdirAll(node.RepoDir, 0755); err != nil {
+			return nil, err
+		}
+
+		cfg, _ := config.Load(nil, "")
+		cfg.Options.ListenAddress = []string{node.Address}
+		cfg.Options.LocalAnnEnabled = false
+		cfg.Options.GlobalAnnEnabled = false
+		cfg.Options.UPnPEnabled = false
+		cfg.Options.ReconnectIntervalS = 1
+
+		node.Engine, err = engine.New(engine.Options{
+			Home:          node.Home,
+			Config:        &cfg,
+			ClientVersion: "harness",
+			KeyType:       engine.KeyTypeECDSA,
+			Filesystem:    node.FS,
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.Nodes = append(c.Nodes, node)
+	}
+
+	var repoNodes []config.NodeConfiguration
+	for _, node := range c.Nodes {
+		repoNodes = append(repoNodes, config.NodeConfiguration{NodeID: node.ID()})
+	}
+
+	for _, node := range c.Nodes {
+		for _, other := range c.Nodes {
+			if other == node {
+				continue
+			}
+			err := node.AddNode(config.NodeConfiguration{
+				NodeID:    other.ID(),
+				Name:      other.Name,
+				Addresses: []string{other.Address},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		err := node.AddRepository(config.RepositoryConfiguration{
+			ID:              RepoID,
+			Directory:       node.RepoDir,
+			Nodes:           repoNodes,
+			RescanIntervalS: 2,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Start starts all nodes in the cluster.
+func (c *Cluster) Start() error {
+	for _, node := range c.Nodes {
+		if err := node.Start(); err != nil {
+			c.Stop()
+			return fmt.Errorf("%s: %v", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// Stop stops all nodes in the cluster.
+func (c *Cluster) Stop() {
+	for _, node := range c.Nodes {
+		node.Stop()
+	}
+}
+
+// Connected returns true when all nodes are connected to all other nodes.
+func (c *Cluster) Connected() bool {
+	for _, node := range c.Nodes {
+		conns := node.Connections()
+		for _, other := range c.Nodes {
+			if other == node {
+				continue
+			}
+			if _, ok := conns[other.ID()]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// InSync returns true when all nodes are connected, idle, need nothing and
+// agree on the contents of the repository.
+func (c *Cluster) InSync() bool {
+	if !c.Connected() {
+		return false
+	}
+
+	var first engine.RepoStatus
+	for i, node := range c.Nodes {
+		s, err := node.RepoStatus(RepoID)
+		if err != nil || s.State != "idle" || s.NeedFiles != 0 {
+			return false
+		}
+		if s.LocalFiles != s.GlobalFiles || s.LocalBytes != s.GlobalBytes {
+			return false
+		}
+		if i == 0 {
+			first = s
+		} else if s.GlobalFiles != first.GlobalFiles || s.GlobalBytes != first.GlobalBytes {
+			return false
+		}
+	}
+	return true
+}
+
+// WaitForSync waits until the cluster is in sync, or returns an error when
+// the timeout expires.
+func (c *Cluster) WaitForSync(timeout time.Duration) error {
+	t0 := time.Now()
+	for time.Since(t0) < timeout {
+		if c.InSync() {
+			return nil
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return fmt.Errorf("cluster not in sync after %v", timeout)
+}
+
+// CompareRepos returns an error describing the first difference found
+// between the repository directories of the nodes, or nil if they are equal.
+func (c *Cluster) CompareRepos() error {
+	var first map[string]string
+	for i, node := range c.Nodes {
+		sums, err := node.TreeSums()
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			first = sums
+			continue
+		}
+		for name, sum := range first {
+			if sums[name] != sum {
+				return fmt.Errorf("%s: %q differs from %s", node.Name, name, c.Nodes[0].Name)
+			}
+		}
+		for name := range sums {
+			if _, ok := first[name]; !ok {
+				return fmt.Errorf("%s: %q missing on %s", node.Name, name, c.Nodes[0].Name)
+			}
+		}
+	}
+	return nil
+}
+
+// WriteFile writes the file, relative to the repository, creating the
+// directories leading up to it.
+func (n *Node) WriteFile(name string, data []byte) error {
+	p := filepath.Join(n.RepoDir, name)
+	if err := n.FS.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	fd, err := n.FS.Create(p)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// ReadFile returns the contents of the file, relative to the repository.
+func (n *Node) ReadFile(name string) ([]byte, error) {
+	return fs.ReadFile(n.FS, filepath.Join(n.RepoDir, name))
+}
+
+// Remove removes the file or empty directory, relative to the repository.
+func (n *Node) Remove(name string) error {
+	return n.FS.Remove(filepath.Join(n.RepoDir, name))
+}
+
+// Rename renames the file, relative to the repository.
+func (n *Node) Rename(oldname, newname string) error {
+	return n.FS.Rename(filepath.Join(n.RepoDir, oldname), filepath.Join(n.RepoDir, newname))
+}
+
+// TreeSums returns the MD5 sums of all regular files in the repository,
+// keyed by relative path. Directories are present with an empty sum.
+// Syncthing internal files and directories are skipped.
+func (n *Node) TreeSums() (map[string]string, error) {
+	sums := make(map[string]string)
+	err := n.FS.Walk(n.RepoDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rn, _ := filepath.Rel(n.RepoDir, p)
+		if rn == "." {
+			return nil
+		}
+		if base := filepath.Base(rn); base == ".stversions" || strings.HasPrefix(base, ".syncthing.") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			sums[rn] = ""
+			return nil
+		}
+
+		fd, err := n.FS.Open(p)
+		if err != nil {
+			return err
+		}
+		defer fd.Close()
+		h := md5.New()
+		if _, err := io.Copy(h, fd); err != nil {
+			return err
+		}
+		sums[rn] = fmt.Sprintf("%x", h.Sum(nil))
+		return nil
+	})
+	return sums, err
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
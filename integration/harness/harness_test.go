@@ -0,0 +1,117 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package harness
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const syncTimeout = 60 * time.Second
+
+func newTestCluster(t *testing.T, n int) (*Cluster, func()) {
+	dir, err := ioutil.TempDir("", "harness")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCluster(dir, n)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return c, func() {
+		c.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+// waitForSync rescans the repository on all nodes, so that the changes made
+// are noticed right away, and waits for the cluster to agree.
+func waitForSync(t *testing.T, c *Cluster) {
+	for _, node := range c.Nodes {
+		if err := node.Rescan(RepoID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.WaitForSync(syncTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CompareRepos(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClusterSync(t *testing.T) {
+	t.Parallel()
+	c, done := newTestCluster(t, 3)
+	defer done()
+
+	c.Nodes[0].WriteFile("dir/a", []byte("hello"))
+	c.Nodes[1].WriteFile("b", []byte("world"))
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	waitForSync(t, c)
+
+	sums, _ := c.Nodes[2].TreeSums()
+	if _, ok := sums["b"]; !ok || len(sums) != 3 {
+		t.Fatalf("Unexpected files %v", sums)
+	}
+
+	// A delete on one node is carried out on the others
+
+	if err := c.Nodes[2].Remove("b"); err != nil {
+		t.Fatal(err)
+	}
+	waitForSync(t, c)
+	if _, err := c.Nodes[0].ReadFile("b"); !os.IsNotExist(err) {
+		t.Errorf("Deleted file still present: %v", err)
+	}
+
+	// And so is a rename
+
+	if err := c.Nodes[1].Rename("dir/a", "c"); err != nil {
+		t.Fatal(err)
+	}
+	waitForSync(t, c)
+	if bs, err := c.Nodes[0].ReadFile("c"); err != nil || string(bs) != "hello" {
+		t.Errorf("Renamed file %q, %v", bs, err)
+	}
+	if _, err := c.Nodes[2].ReadFile("dir/a"); !os.IsNotExist(err) {
+		t.Errorf("Old name of renamed file still present: %v", err)
+	}
+}
+
+func TestClusterConflict(t *testing.T) {
+	t.Parallel()
+	c, done := newTestCluster(t, 3)
+	defer done()
+
+	// Two nodes change the same file without knowing of each other
+	c.Nodes[0].WriteFile("a", []byte("from the first node"))
+	c.Nodes[1].WriteFile("a", []byte("from the second node"))
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	waitForSync(t, c)
+
+	// One of the versions wins and the other is kept as a conflict copy,
+	// the same on all nodes
+	sums, _ := c.Nodes[2].TreeSums()
+	var conflicts int
+	for name := range sums {
+		if strings.HasPrefix(name, "a.sync-conflict-") {
+			conflicts++
+		}
+	}
+	if _, ok := sums["a"]; !ok || conflicts != 1 || len(sums) != 2 {
+		t.Errorf("Unexpected files %v", sums)
+	}
+}
@@ -7,6 +7,7 @@ package logger
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"sync"
@@ -53,6 +54,13 @@ func (l *Logger) SetPrefix(prefix string) {
 	l.logger.SetPrefix(prefix)
 }
 
+// SetOutput sets the destination for log messages, os.Stderr by default.
+func (l *Logger) SetOutput(w io.Writer) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+	l.logger = log.New(w, l.logger.Prefix(), l.logger.Flags())
+}
+
 func (l *Logger) callHandlers(level LogLevel, s string) {
 	for _, h := range l.handlers[level] {
 		h(level, s)
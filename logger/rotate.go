@@ -0,0 +1,94 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// A RotatingFile is a log file that is rotated when it grows beyond a
+// maximum size. The rotated files are named by appending .1, .2, etc to the
+// name, .1 being the most recent, and at most a given number of them are
+// kept.
+type RotatingFile struct {
+	name     string
+	maxSize  int64
+	maxFiles int
+	fd       *os.File
+	size     int64
+	mut      sync.Mutex
+}
+
+// NewRotatingFile opens name for appending. The file is rotated when a write
+// would make it larger than maxSize bytes, keeping maxFiles old files.
+func NewRotatingFile(name string, maxSize int64, maxFiles int) (*RotatingFile, error) {
+	f := &RotatingFile{
+		name:     name,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *RotatingFile) open() error {
+	fd, err := os.OpenFile(f.name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return err
+	}
+	f.fd = fd
+	f.size = fi.Size()
+	return nil
+}
+
+func (f *RotatingFile) Write(bs []byte) (int, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	if f.size > 0 && f.size+int64(len(bs)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.fd.Write(bs)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *RotatingFile) rotate() error {
+	f.fd.Close()
+
+	os.Remove(f.rotated(f.maxFiles))
+	for i := f.maxFiles - 1; i > 0; i-- {
+		os.Rename(f.rotated(i), f.rotated(i+1))
+	}
+	if f.maxFiles > 0 {
+		os.Rename(f.name, f.rotated(1))
+	} else {
+		os.Remove(f.name)
+	}
+
+	return f.open()
+}
+
+func (f *RotatingFile) rotated(i int) string {
+	return fmt.Sprintf("%s.%d", f.name, i)
+}
+
+func (f *RotatingFile) Close() error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	return f.fd.Close()
+}
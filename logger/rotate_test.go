@@ -0,0 +1,52 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package logger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "log")
+	f, err := NewRotatingFile(name, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"aaaaaa\n", "bbbbbb\n", "cccccc\n", "dddddd\n"} {
+		if _, err := f.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.Close()
+
+	expected := map[string]string{
+		"log":   "dddddd\n",
+		"log.1": "cccccc\n",
+		"log.2": "bbbbbb\n",
+	}
+	for file, data := range expected {
+		bs, err := ioutil.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(bs) != data {
+			t.Errorf("%s: incorrect data %q != %q", file, bs, data)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "log.3")); err == nil {
+		t.Error("log.3 should not exist")
+	}
+}
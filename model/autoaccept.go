@@ -0,0 +1,118 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/protocol"
+)
+
+// handleAutoAccept adds the repositories the node shares with us and that we
+// do not have, under the default repository path, and starts synchronizing
+// them. It is called for nodes that are set to have their repositories
+// accepted automatically. A repository that is removed from the
+// configuration is accepted again the next time the node connects.
+func (m *Model) handleAutoAccept(nodeID string, cm protocol.ClusterConfigMessage) {
+	var accepted []config.RepositoryConfiguration
+
+	m.rmut.Lock()
+	known := m.cfg.RepoMap()
+	for _, repo := range cm.Repositories {
+		if _, ok := known[repo.ID]; ok {
+			continue
+		}
+
+		dir, err := config.RepoPath(m.cfg.Options.DefaultRepoPath, repo.ID)
+		if err != nil {
+			l.Warnf("Not accepting repository from %s: %v", m.cfg.NodeName(nodeID), err)
+			continue
+		}
+		rc := config.RepositoryConfiguration{
+			ID:              repo.ID,
+			Directory:       dir,
+			Nodes:           []config.NodeConfiguration{{NodeID: m.nodeID}, {NodeID: nodeID}},
+			RescanIntervalS: m.cfg.Options.RescanIntervalS,
+		}
+		if err := rc.ExpandDirectory(); err != nil {
+			l.Warnf("Not accepting repository %q from %s: directory %q: %v", repo.ID, m.cfg.NodeName(nodeID), dir, err)
+			continue
+		}
+		if other, ok := m.overlappingRepo(rc.ExpandedDirectory); ok {
+			l.Warnf("Not accepting repository %q from %s: directory %q overlaps repository %q", repo.ID, m.cfg.NodeName(nodeID), rc.ExpandedDirectory, other)
+			continue
+		}
+
+		m.cfg.Repositories = append(m.cfg.Repositories, rc)
+		known[rc.ID] = rc
+		accepted = append(accepted, rc)
+	}
+	m.rmut.Unlock()
+
+	if len(accepted) == 0 {
+		return
+	}
+
+	select {
+	case m.configChanged <- struct{}{}:
+	default:
+	}
+
+	for _, rc := range accepted {
+		l.Infof("Accepted repository %q shared by %s, in %s", rc.ID, m.nodeName(nodeID), rc.ExpandedDirectory)
+		m.startAcceptedRepo(nodeID, rc)
+	}
+}
+
+// overlappingRepo returns the ID of a configured repository whose directory
+// is the same as, inside or around dir. Must be called with rmut held.
+func (m *Model) overlappingRepo(dir string) (string, bool) {
+	for _, rc := range m.cfg.Repositories {
+		if rc.ExpandedDirectory == "" {
+			if err := rc.ExpandDirectory(); err != nil {
+				rc.ExpandedDirectory = rc.Directory
+			}
+		}
+		if nestedDirs(rc.ExpandedDirectory, dir) || nestedDirs(dir, rc.ExpandedDirectory) {
+			return rc.ID, true
+		}
+	}
+	return "", false
+}
+
+// nestedDirs returns true if dir is the same as parent or inside it.
+func nestedDirs(parent, dir string) bool {
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// startAcceptedRepo adds the repository to the running model, the way it is
+// added at startup, and starts scanning and pulling it. The repository is
+// added before the node's index for it arrives, so that it is not rejected.
+func (m *Model) startAcceptedRepo(nodeID string, rc config.RepositoryConfiguration) {
+	rc.Directory = rc.ExpandedDirectory
+	if err := m.fs.MkdirAll(rc.Directory, 0755); err != nil {
+		l.Warnf("Accepted repository %q: %v", rc.ID, err)
+		return
+	}
+
+	m.AddRepo(rc)
+	m.SeedLocal(rc.ID, nil)
+	id := m.loadIndexID(rc.ID, m.indexDir, false)
+	m.rmut.Lock()
+	m.indexIDs[rc.ID] = id
+	m.rmut.Unlock()
+
+	m.background(func() {
+		if err := m.ScanRepo(rc.ID); err != nil {
+			l.Warnf("Accepted repository %q: %v", rc.ID, err)
+			return
+		}
+		m.StartRepoRW(rc.ID, m.options().ParallelRequests)
+		m.ResendIndex(nodeID, rc.ID)
+	})
+}
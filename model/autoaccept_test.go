@@ -0,0 +1,101 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+)
+
+func TestAutoAccept(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes: []config.NodeConfiguration{
+			{NodeID: "node0"},
+			{NodeID: "trusted", AutoAccept: true},
+			{NodeID: "other"},
+		},
+		Repositories: []config.RepositoryConfiguration{
+			{ID: "classical", Directory: "/synced/music/classical"},
+		},
+		Options: config.OptionsConfiguration{
+			DefaultRepoPath:  "/synced/%{repo}",
+			ParallelRequests: 1,
+			RescanIntervalS:  3600,
+		},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	f := fs.NewFakeFilesystem()
+	m.fs = f
+	m.SetNodeID("node0")
+
+	offer := protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{
+			{ID: "photos", Nodes: []protocol.Node{{ID: "node0", Flags: protocol.FlagShareTrusted}}},
+			{ID: "../escape", Nodes: []protocol.Node{{ID: "node0", Flags: protocol.FlagShareTrusted}}},
+			{ID: "music", Nodes: []protocol.Node{{ID: "node0", Flags: protocol.FlagShareTrusted}}},
+		},
+	}
+
+	m.ClusterConfig("other", offer)
+	if repos := m.Configuration().Repositories; len(repos) != 1 {
+		t.Fatalf("Repository accepted from node not trusted to share: %v", repos)
+	}
+
+	m.ClusterConfig("trusted", offer)
+	repos := m.Configuration().Repositories
+	if len(repos) != 2 {
+		t.Fatalf("Unexpected repositories %v", repos)
+	}
+	if rc := repos[1]; rc.ID != "photos" || rc.Directory != "/synced/photos" || len(rc.NodeIDs()) != 2 {
+		t.Errorf("Incorrect accepted repository %+v", rc)
+	}
+	select {
+	case <-m.ConfigChanged():
+	default:
+		t.Error("Configuration change not signalled")
+	}
+	if !m.repoSharedWith("photos", "trusted") {
+		t.Error("Accepted repository not shared with the node")
+	}
+	if info, err := f.Stat("/synced/photos"); err != nil || !info.IsDir() {
+		t.Error("Directory not created:", err)
+	}
+
+	// Being offered the same repository again changes nothing
+	m.ClusterConfig("trusted", offer)
+	if repos := m.Configuration().Repositories; len(repos) != 2 {
+		t.Errorf("Repository accepted twice: %v", repos)
+	}
+}
+
+func TestAutoAcceptSameDirectory(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes: []config.NodeConfiguration{
+			{NodeID: "node0"},
+			{NodeID: "trusted", AutoAccept: true},
+		},
+		Options: config.OptionsConfiguration{
+			DefaultRepoPath:  "/synced",
+			ParallelRequests: 1,
+			RescanIntervalS:  3600,
+		},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.fs = fs.NewFakeFilesystem()
+	m.SetNodeID("node0")
+
+	m.ClusterConfig("trusted", protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{
+			{ID: "photos", Nodes: []protocol.Node{{ID: "node0", Flags: protocol.FlagShareTrusted}}},
+			{ID: "music", Nodes: []protocol.Node{{ID: "node0", Flags: protocol.FlagShareTrusted}}},
+		},
+	})
+	if repos := m.Configuration().Repositories; len(repos) != 0 {
+		t.Errorf("Repositories accepted without %%{repo} in the path: %v", repos)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"github.com/calmh/syncthing/protocol"
+)
+
+// A requestBatcher sends the block requests to a node that answers Request
+// Vector messages. Requests for the same file that are waiting to be sent
+// at the same time, as they are when the puller fetches a large file, are
+// combined into a single Request Vector message, saving round trips on
+// links with a high latency.
+type requestBatcher struct {
+	conn     protocol.Connection
+	requests chan *batchedRequest
+	stop     chan struct{}
+}
+
+type batchedRequest struct {
+	repo   string
+	name   string
+	offset int64
+	size   int
+	res    chan protocol.Response
+}
+
+// requestBatcher returns the batcher for the node's connection, starting it
+// if needed. It returns nil if the node is not connected.
+func (m *Model) requestBatcher(nodeID string) *requestBatcher {
+	m.pmut.Lock()
+	defer m.pmut.Unlock()
+	conn, ok := m.protoConn[nodeID]
+	if !ok {
+		return nil
+	}
+	b, ok := m.batchers[nodeID]
+	if !ok {
+		b = newRequestBatcher(conn)
+		m.batchers[nodeID] = b
+	}
+	return b
+}
+
+func newRequestBatcher(conn protocol.Connection) *requestBatcher {
+	b := &requestBatcher{
+		conn:     conn,
+		requests: make(chan *batchedRequest),
+		stop:     make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// request returns the data for the block, like Connection.Request.
+func (b *requestBatcher) request(repo, name string, offset int64, size int) ([]byte, error) {
+	req := &batchedRequest{repo, name, offset, size, make(chan protocol.Response, 1)}
+	select {
+	case b.requests <- req:
+	case <-b.stop:
+		return nil, protocol.ErrClosed
+	}
+	res := <-req.res
+	return res.Data, res.Err
+}
+
+// close stops the batcher. Requests not yet sent fail with ErrClosed.
+func (b *requestBatcher) close() {
+	close(b.stop)
+}
+
+func (b *requestBatcher) run() {
+	var pending []*batchedRequest
+	for {
+		if len(pending) == 0 {
+			select {
+			case req := <-b.requests:
+				pending = append(pending, req)
+			case <-b.stop:
+				return
+			}
+		}
+
+		// Everything else already waiting is a candidate for the batch
+	drain:
+		for {
+			select {
+			case req := <-b.requests:
+				pending = append(pending, req)
+			case <-b.stop:
+				for _, req := range pending {
+					req.res <- protocol.Response{Err: protocol.ErrClosed}
+				}
+				return
+			default:
+				break drain
+			}
+		}
+
+		var batch []*batchedRequest
+		batch, pending = takeBatch(pending)
+		go b.send(batch)
+	}
+}
+
+// takeBatch returns the requests that can be sent in one message together
+// with the first one, and the rest.
+func takeBatch(pending []*batchedRequest) (batch, rest []*batchedRequest) {
+	first := pending[0]
+	batch = append(batch, first)
+	for _, req := range pending[1:] {
+		if len(batch) < protocol.MaxVectorRanges && first.size <= protocol.MaxVectorRangeSize &&
+			req.size <= protocol.MaxVectorRangeSize && req.repo == first.repo && req.name == first.name {
+			batch = append(batch, req)
+		} else {
+			rest = append(rest, req)
+		}
+	}
+	return batch, rest
+}
+
+func (b *requestBatcher) send(batch []*batchedRequest) {
+	if len(batch) == 1 {
+		req := batch[0]
+		data, err := b.conn.Request(req.repo, req.name, req.offset, req.size)
+		req.res <- protocol.Response{Data: data, Err: err}
+		return
+	}
+
+	ranges := make([]protocol.Range, len(batch))
+	for i, req := range batch {
+		ranges[i] = protocol.Range{Offset: uint64(req.offset), Size: uint32(req.size)}
+	}
+	if debug {
+		l.Debugf("REQ(out): %s: %q / %q: %d blocks in one vector request", b.conn.ID(), batch[0].repo, batch[0].name, len(batch))
+	}
+
+	data, err := b.conn.RequestVector(batch[0].repo, batch[0].name, ranges)
+	for i, req := range batch {
+		switch {
+		case err != nil:
+			req.res <- protocol.Response{Err: err}
+		case len(data[i]) != req.size:
+			// The block was not available. A plain request tells us why,
+			// which decides whether it is retried.
+			d, err := b.conn.Request(req.repo, req.name, req.offset, req.size)
+			req.res <- protocol.Response{Data: d, Err: err}
+		default:
+			req.res <- protocol.Response{Data: data[i]}
+		}
+	}
+}
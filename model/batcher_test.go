@@ -0,0 +1,117 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/calmh/syncthing/protocol"
+)
+
+// vectorConnection answers vector requests with a block of the requested
+// size for each range, except at the offsets in missing, and counts the
+// requests made.
+type vectorConnection struct {
+	FakeConnection
+	missing  map[int64]bool
+	vectors  *int
+	requests *int
+}
+
+func (c vectorConnection) Request(repo, name string, offset int64, size int) ([]byte, error) {
+	*c.requests++
+	if c.missing[offset] {
+		return nil, protocol.ErrRescanning
+	}
+	return bytes.Repeat([]byte{byte(offset)}, size), nil
+}
+
+func (c vectorConnection) RequestVector(repo, name string, ranges []protocol.Range) ([][]byte, error) {
+	*c.vectors++
+	data := make([][]byte, len(ranges))
+	for i, r := range ranges {
+		if !c.missing[int64(r.Offset)] {
+			data[i] = bytes.Repeat([]byte{byte(r.Offset)}, int(r.Size))
+		}
+	}
+	return data, nil
+}
+
+func TestTakeBatch(t *testing.T) {
+	pending := []*batchedRequest{
+		{repo: "default", name: "a", offset: 0, size: 128 << 10},
+		{repo: "default", name: "b", offset: 0, size: 128 << 10},
+		{repo: "default", name: "a", offset: 128 << 10, size: 128 << 10},
+		{repo: "other", name: "a", offset: 0, size: 128 << 10},
+		{repo: "default", name: "a", offset: 256 << 10, size: 1 << 20},
+		{repo: "default", name: "a", offset: 384 << 10, size: 1024},
+	}
+
+	batch, rest := takeBatch(pending)
+	if len(batch) != 3 || batch[0] != pending[0] || batch[1] != pending[2] || batch[2] != pending[5] {
+		t.Errorf("Incorrect batch %v", batch)
+	}
+	if len(rest) != 3 || rest[0] != pending[1] || rest[1] != pending[3] || rest[2] != pending[4] {
+		t.Errorf("Incorrect rest %v", rest)
+	}
+
+	// A request too large for a vector request goes alone
+	batch, rest = takeBatch(rest[2:])
+	if len(batch) != 1 || len(rest) != 0 {
+		t.Errorf("Incorrect batch %v, rest %v", batch, rest)
+	}
+}
+
+func TestTakeBatchLimit(t *testing.T) {
+	var pending []*batchedRequest
+	for i := 0; i < protocol.MaxVectorRanges+10; i++ {
+		pending = append(pending, &batchedRequest{repo: "default", name: "a", offset: int64(i) << 17, size: 128 << 10})
+	}
+	batch, rest := takeBatch(pending)
+	if len(batch) != protocol.MaxVectorRanges || len(rest) != 10 {
+		t.Errorf("Incorrect batch %d, rest %d", len(batch), len(rest))
+	}
+}
+
+func TestBatcherSend(t *testing.T) {
+	var vectors, requests int
+	conn := vectorConnection{
+		missing:  map[int64]bool{2: true},
+		vectors:  &vectors,
+		requests: &requests,
+	}
+	b := &requestBatcher{conn: conn}
+
+	var batch []*batchedRequest
+	for i := 0; i < 3; i++ {
+		batch = append(batch, &batchedRequest{"default", "a", int64(i), 10, make(chan protocol.Response, 1)})
+	}
+	b.send(batch)
+
+	if vectors != 1 {
+		t.Errorf("Incorrect number of vector requests %d", vectors)
+	}
+	if requests != 1 {
+		t.Errorf("Incorrect number of plain requests %d; the missing block should be retried alone", requests)
+	}
+	for i, req := range batch[:2] {
+		res := <-req.res
+		if res.Err != nil || !bytes.Equal(res.Data, bytes.Repeat([]byte{byte(i)}, 10)) {
+			t.Errorf("%d: Incorrect response %v, %v", i, res.Data, res.Err)
+		}
+	}
+	if res := <-batch[2].res; res.Err != protocol.ErrRescanning {
+		t.Errorf("Unexpected error %v for a missing block", res.Err)
+	}
+}
+
+func TestBatcherClose(t *testing.T) {
+	b := newRequestBatcher(FakeConnection{})
+	b.close()
+	if _, err := b.request("default", "a", 0, 10); err != protocol.ErrClosed {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
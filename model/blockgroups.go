@@ -0,0 +1,145 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/calmh/syncthing/cid"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// Files with more blocks than this are sent with grouped block lists to the
+// nodes that understand them.
+const blockGroupThreshold = 4 * scanner.BlocksPerGroup
+
+// groupedIndex returns the index with the block lists of large files
+// replaced by grouped block lists. The given index is not modified.
+func groupedIndex(idx []protocol.FileInfo) []protocol.FileInfo {
+	var res []protocol.FileInfo
+	for i, f := range idx {
+		if len(f.Blocks) <= blockGroupThreshold || protocol.HasBlockGroups(f.Flags) {
+			continue
+		}
+		if res == nil {
+			res = make([]protocol.FileInfo, len(idx))
+			copy(res, idx)
+		}
+
+		groups := scanner.BlockGroups(fileFromFileInfo(f).Blocks)
+		res[i].Blocks = make([]protocol.BlockInfo, len(groups))
+		for j, g := range groups {
+			res[i].Blocks[j] = protocol.BlockInfo{Size: g.Size, Hash: g.Hash}
+		}
+		res[i].Flags |= protocol.FlagBlockGroups
+	}
+	if res == nil {
+		return idx
+	}
+	return res
+}
+
+// BlockList returns the blocks of the local file that start within the
+// given range. Implements the protocol.BlockLister interface.
+func (m *Model) BlockList(nodeID, repo, name string, offset int64, size int) ([]protocol.BlockInfo, error) {
+	if !m.repoSharedWith(repo, nodeID) {
+		l.Warnf("Block list request from %s for file %s in unshared repo %q", m.nodeName(nodeID), name, repo)
+		return nil, ErrNoSuchFile
+	}
+
+	m.rmut.RLock()
+	lf := m.repoFiles[repo].Get(cid.LocalID, name)
+	m.rmut.RUnlock()
+
+	if lf.Suppressed || protocol.IsDeleted(lf.Flags) {
+		return nil, ErrInvalid
+	}
+
+	var blocks []protocol.BlockInfo
+	for _, b := range lf.Blocks {
+		if b.Offset >= offset && b.Offset < offset+int64(size) {
+			blocks = append(blocks, protocol.BlockInfo{Size: b.Size, Hash: b.Hash})
+		}
+	}
+	if len(blocks) == 0 || len(blocks) > scanner.BlocksPerGroup {
+		return nil, ErrNoSuchFile
+	}
+
+	if debug {
+		l.Debugf("BLK(in): %s: %q / %q o=%d s=%d: %d blocks", nodeID, repo, name, offset, size, len(blocks))
+	}
+	return blocks, nil
+}
+
+// expandBlocks returns the file with its grouped block list replaced by the
+// full block list. The blocks of groups that are unchanged from the local
+// file are taken from it, and the others are requested from the cluster.
+func (p *puller) expandBlocks(lf, f scanner.File) (scanner.File, error) {
+	var local []scanner.Block
+	if !protocol.IsDeleted(lf.Flags) && !protocol.HasBlockGroups(lf.Flags) {
+		local = scanner.BlockGroups(lf.Blocks)
+	}
+
+	var blocks []scanner.Block
+	for i, g := range f.Blocks {
+		if i < len(local) && local[i].Size == g.Size && bytes.Equal(local[i].Hash, g.Hash) {
+			end := (i + 1) * scanner.BlocksPerGroup
+			if end > len(lf.Blocks) {
+				end = len(lf.Blocks)
+			}
+			blocks = append(blocks, lf.Blocks[i*scanner.BlocksPerGroup:end]...)
+			continue
+		}
+
+		bs, err := p.requestBlockList(f, g)
+		if err != nil {
+			return f, err
+		}
+		blocks = append(blocks, bs...)
+	}
+
+	if debug {
+		l.Debugf("pull: %q / %q: expanded %d groups to %d blocks", p.repoCfg.ID, f.Name, len(f.Blocks), len(blocks))
+	}
+	f.Blocks = blocks
+	f.Flags &^= protocol.FlagBlockGroups
+	return f, nil
+}
+
+// requestBlockList fetches the blocks in the group from a node that has the
+// file, and verifies them against the group hash.
+func (p *puller) requestBlockList(f scanner.File, g scanner.Block) ([]scanner.Block, error) {
+	availability := p.model.repoFiles[p.repoCfg.ID].Availability(f.Name)
+	node := p.oustandingPerNode.fastestNode(availability, p.model.cm, p.model.nodeStats, 0)
+	if len(node) == 0 {
+		return nil, errNoNode
+	}
+	defer p.oustandingPerNode.decrease(node)
+
+	bis, err := p.model.requestBlocksGlobal(node, p.repoCfg.ID, f.Name, g.Offset, int(g.Size))
+	if err != nil {
+		return nil, fmt.Errorf("block list from %s: %v", node, err)
+	}
+
+	var blocks = make([]scanner.Block, len(bis))
+	var size uint32
+	hf := sha256.New()
+	for i, b := range bis {
+		blocks[i] = scanner.Block{
+			Offset: g.Offset + int64(size),
+			Size:   b.Size,
+			Hash:   b.Hash,
+		}
+		size += b.Size
+		hf.Write(b.Hash)
+	}
+	if size != g.Size || !bytes.Equal(hf.Sum(nil), g.Hash) {
+		return nil, errHashMismatch
+	}
+	return blocks, nil
+}
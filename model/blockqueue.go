@@ -27,6 +27,8 @@ type bqBlock struct {
 type blockQueue struct {
 	inbox  chan bqAdd
 	outbox chan bqBlock
+	flush  chan chan struct{}
+	done   chan struct{} // closed by close
 
 	queued []bqBlock
 
@@ -37,6 +39,8 @@ func newBlockQueue() *blockQueue {
 	q := &blockQueue{
 		inbox:  make(chan bqAdd),
 		outbox: make(chan bqBlock),
+		flush:  make(chan chan struct{}),
+		done:   make(chan struct{}),
 	}
 	go q.run()
 	return q
@@ -87,7 +91,14 @@ func (q *blockQueue) addBlock(a bqAdd) {
 func (q *blockQueue) run() {
 	for {
 		if len(q.queued) == 0 {
-			q.addBlock(<-q.inbox)
+			select {
+			case a := <-q.inbox:
+				q.addBlock(a)
+			case done := <-q.flush:
+				close(done)
+			case <-q.done:
+				return
+			}
 		} else {
 			q.mut.Lock()
 			next := q.queued[0]
@@ -99,17 +110,56 @@ func (q *blockQueue) run() {
 				q.mut.Lock()
 				q.queued = q.queued[1:]
 				q.mut.Unlock()
+			case done := <-q.flush:
+				q.mut.Lock()
+				q.queued = nil
+				q.mut.Unlock()
+				close(done)
+			case <-q.done:
+				return
 			}
 		}
 	}
 }
 
 func (q *blockQueue) put(a bqAdd) {
-	q.inbox <- a
+	select {
+	case q.inbox <- a:
+	case <-q.done:
+	}
 }
 
-func (q *blockQueue) get() bqBlock {
-	return <-q.outbox
+// get returns the next queued block, waiting for one. Ok is false if the
+// queue is closed.
+func (q *blockQueue) get() (b bqBlock, ok bool) {
+	select {
+	case b = <-q.outbox:
+		return b, true
+	case <-q.done:
+		return bqBlock{}, false
+	}
+}
+
+// clear drops the queued blocks. A block already handed out by get is not
+// taken back.
+func (q *blockQueue) clear() {
+	done := make(chan struct{})
+	select {
+	case q.flush <- done:
+		<-done
+	case <-q.done:
+	}
+}
+
+// close stops the queue. It must be called at most once.
+func (q *blockQueue) close() {
+	close(q.done)
+}
+
+func (q *blockQueue) len() int {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	return len(q.queued)
 }
 
 func (q *blockQueue) empty() bool {
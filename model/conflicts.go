@@ -0,0 +1,86 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// At most this many conflicts are remembered per repository.
+const maxConflicts = 1000
+
+// A Conflict is a file that was changed both here and on another node. The
+// local version was kept as ConflictName, and the file replaced by the
+// newer version from the cluster.
+type Conflict struct {
+	Name         string
+	ConflictName string
+	Time         time.Time
+}
+
+// conflictName returns the name the local version of the file is kept
+// under when it loses a conflict.
+func conflictName(name, nodeID string, t time.Time) string {
+	if len(nodeID) > 5 {
+		nodeID = nodeID[:5]
+	}
+	return fmt.Sprintf("%s.sync-conflict-%s-%s", name, t.Format("20060102-150405"), nodeID)
+}
+
+// isConflict returns true if the local file was changed independently of
+// the global version that is about to replace it, so that the local
+// changes would be lost.
+func isConflict(local, global scanner.File) bool {
+	return local.Name != "" && !protocol.IsDeleted(local.Flags) && local.Vector.Concurrent(global.Vector)
+}
+
+// Conflicts returns the most recent conflicts in the repository, oldest
+// first.
+func (m *Model) Conflicts(repo string) []Conflict {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	return append([]Conflict(nil), m.conflicts[repo]...)
+}
+
+func (m *Model) addConflict(repo string, c Conflict) {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	cs := append(m.conflicts[repo], c)
+	if len(cs) > maxConflicts {
+		cs = cs[len(cs)-maxConflicts:]
+	}
+	m.conflicts[repo] = cs
+}
+
+// moveForConflict keeps the local version of the file, if it conflicts
+// with the global version f, by renaming it out of the way.
+func (p *puller) moveForConflict(path string, f scanner.File) error {
+	lf := p.model.CurrentRepoFile(p.repoCfg.ID, f.Name)
+	if !isConflict(lf, f) {
+		return nil
+	}
+
+	now := time.Now()
+	name := conflictName(f.Name, p.model.nodeID, now)
+	if err := p.fs.Rename(path, filepath.Join(p.repoCfg.Directory, name)); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	l.Infof("%q / %q was changed both here and on another node; the local version is kept as %q", p.repoCfg.ID, f.Name, name)
+	p.model.addConflict(p.repoCfg.ID, Conflict{
+		Name:         f.Name,
+		ConflictName: name,
+		Time:         now,
+	})
+	return nil
+}
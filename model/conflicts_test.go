@@ -0,0 +1,65 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestMoveForConflict(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/a")
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.SetNodeID("ABCDEFGHIJ")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f}
+	path := filepath.Join("repo", "a")
+	lf := m.CurrentRepoFile("default", "a")
+
+	// A version derived from the local one is not a conflict
+	derived := scanner.File{Name: "a", Version: lf.Version + 1, Vector: lf.Vector.Update(protocol.VectorID("other"))}
+	if err := p.moveForConflict(path, derived); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Stat(path); err != nil {
+		t.Errorf("File was moved without a conflict: %v", err)
+	}
+	if cs := m.Conflicts("default"); len(cs) != 0 {
+		t.Errorf("Unexpected conflicts %v", cs)
+	}
+
+	// One changed independently is
+	concurrent := scanner.File{Name: "a", Version: lf.Version + 1, Vector: protocol.Vector{{ID: protocol.VectorID("other"), Value: 1}}}
+	if err := p.moveForConflict(path, concurrent); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Stat(path); err == nil {
+		t.Error("File was not moved for a conflict")
+	}
+
+	cs := m.Conflicts("default")
+	if len(cs) != 1 {
+		t.Fatalf("Incorrect conflicts %v", cs)
+	}
+	if c := cs[0]; c.Name != "a" || !strings.HasPrefix(c.ConflictName, "a.sync-conflict-") || !strings.HasSuffix(c.ConflictName, "-ABCDE") {
+		t.Errorf("Incorrect conflict %v", c)
+	}
+	if _, err := f.Stat(filepath.Join("repo", cs[0].ConflictName)); err != nil {
+		t.Errorf("Conflict copy missing: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"expvar"
+	"sync"
+)
+
+// The state published with expvar is that of the model created last, until
+// it is stopped. The published functions look it up when read, so that they
+// do not keep any model alive.
+var (
+	expModel *Model
+	expMut   sync.Mutex
+)
+
+func init() {
+	expvar.Publish("model.queuedBlocks", expFunc(func(m *Model) interface{} { // repo -> blocks waiting to be pulled
+		res := make(map[string]int)
+		for repo, p := range m.runningPullers() {
+			res[repo] = p.bq.len()
+		}
+		return res
+	}))
+	expvar.Publish("model.requestsInFlight", expFunc(func(m *Model) interface{} { // repo -> outstanding pull requests
+		res := make(map[string]int)
+		for repo, p := range m.runningPullers() {
+			res[repo] = cap(p.requestSlots) - len(p.requestSlots)
+		}
+		return res
+	}))
+
+	sched := new(expvar.Map).Init()
+	sched.Set("inUse", expFunc(func(m *Model) interface{} {
+		inUse, _ := m.sched.stats()
+		return inUse
+	}))
+	sched.Set("waiting", expFunc(func(m *Model) interface{} {
+		_, waiting := m.sched.stats()
+		return waiting
+	}))
+	expvar.Publish("model.scheduler", sched)
+
+	uploads := new(expvar.Map).Init()
+	uploads.Set("reads", expFunc(func(m *Model) interface{} {
+		reads, _ := m.uploads.stats()
+		return reads
+	}))
+	uploads.Set("waiting", expFunc(func(m *Model) interface{} {
+		_, waiting := m.uploads.stats()
+		return waiting
+	}))
+	expvar.Publish("model.uploads", uploads)
+
+	// Without a limit on open files there is nothing to count
+	openFiles := new(expvar.Map).Init()
+	openFiles.Set("limit", expFunc(func(m *Model) interface{} {
+		if m.limitedFS == nil {
+			return nil
+		}
+		return m.openFilesMax
+	}))
+	openFiles.Set("open", expFunc(func(m *Model) interface{} {
+		if m.limitedFS == nil {
+			return nil
+		}
+		open, _ := m.limitedFS.Stats()
+		return open
+	}))
+	openFiles.Set("waiting", expFunc(func(m *Model) interface{} {
+		if m.limitedFS == nil {
+			return nil
+		}
+		_, waiting := m.limitedFS.Stats()
+		return waiting
+	}))
+	expvar.Publish("model.openFiles", openFiles)
+}
+
+// expFunc returns an expvar.Func giving fn of the published model, or nil
+// when there is none.
+func expFunc(fn func(m *Model) interface{}) expvar.Func {
+	return func() interface{} {
+		expMut.Lock()
+		m := expModel
+		expMut.Unlock()
+		if m == nil {
+			return nil
+		}
+		return fn(m)
+	}
+}
+
+// publish makes m the model whose state is published.
+func (m *Model) publish() {
+	expMut.Lock()
+	expModel = m
+	expMut.Unlock()
+}
+
+// unpublish stops publishing the state of m, if it is published.
+func (m *Model) unpublish() {
+	expMut.Lock()
+	if expModel == m {
+		expModel = nil
+	}
+	expMut.Unlock()
+}
+
+// runningPullers returns the read/write pullers by repository.
+func (m *Model) runningPullers() map[string]*puller {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	res := make(map[string]*puller, len(m.pullers))
+	for repo, p := range m.pullers {
+		res[repo] = p
+	}
+	return res
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+)
+
+func TestExpvarCurrentModel(t *testing.T) {
+	limit := func() string {
+		return expvar.Get("model.openFiles").(*expvar.Map).Get("limit").String()
+	}
+
+	m1 := NewModel("/tmp", &config.Configuration{Options: config.OptionsConfiguration{MaxOpenFiles: 10}}, "syncthing", "dev")
+	if l := limit(); l != "10" {
+		t.Errorf("Limit %s published for the first model", l)
+	}
+
+	m2 := NewModel("/tmp", &config.Configuration{Options: config.OptionsConfiguration{MaxOpenFiles: 20}}, "syncthing", "dev")
+	if l := limit(); l != "20" {
+		t.Errorf("Limit %s published for the second model", l)
+	}
+
+	// Stopping a model that is no longer published changes nothing
+	m1.Stop()
+	if l := limit(); l != "20" {
+		t.Errorf("Limit %s published after stopping the first model", l)
+	}
+
+	m2.Stop()
+	if l := limit(); l != "null" {
+		t.Errorf("Limit %s published after stopping both models", l)
+	}
+	if q := expvar.Get("model.queuedBlocks").String(); q != "null" {
+		t.Errorf("Queued blocks %s published after stopping both models", q)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// How often the ignore files are checked for changes.
+const ignoreCheckInterval = 2 * time.Second
+
+type ignoreStamp struct {
+	exists   bool
+	modified time.Time
+	size     int64
+}
+
+// watchIgnores polls the ignore files of the repo and signals on the
+// returned channel when one of them is created, changed or removed, so that
+// the repo can be rescanned with the new patterns right away. The watched
+// files are the one in the repo root and those found at the last scan,
+// along with the files they include. Ignore files created in other
+// directories are found by the scans, see ScanRepoSubs. Nothing is checked
+// while the repo is paused, and the polling ends when stop is closed.
+func (m *Model) watchIgnores(repo string, stop <-chan struct{}) <-chan struct{} {
+	changed := make(chan struct{}, 1)
+	m.background(func() {
+		ticker := time.NewTicker(ignoreCheckInterval)
+		defer ticker.Stop()
+
+		prev := m.ignoreStamps(repo)
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+			if m.RepoPaused(repo) {
+				// Changes made meanwhile are noticed once resumed
+				continue
+			}
+
+			cur := m.ignoreStamps(repo)
+			isChanged := false
+			for name, stamp := range cur {
+				if ps, ok := prev[name]; ok && ps != stamp {
+					if debug {
+						l.Debugf("%q: ignore file %q changed", repo, name)
+					}
+					isChanged = true
+					break
+				}
+			}
+			if isChanged {
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+			prev = cur
+		}
+	})
+	return changed
+}
+
+// newIgnorePatterns returns true if ign, from a scan of part of the repo,
+// has patterns for a directory that differ from those of the last full scan,
+// that is, a new or changed ignore file.
+func (m *Model) newIgnorePatterns(repo string, ign *scanner.Matcher) bool {
+	m.rmut.RLock()
+	known := m.ignores[repo].Patterns()
+	m.rmut.RUnlock()
+
+	for dir, pats := range ign.Patterns() {
+		if !reflect.DeepEqual(known[dir], pats) {
+			if debug {
+				l.Debugf("%q: new ignore patterns in %q", repo, dir)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Model) ignoreStamps(repo string) map[string]ignoreStamp {
+	m.rmut.RLock()
+	dir := m.repoCfgs[repo].Directory
+	dirs := []string{"."}
+	for d := range m.ignores[repo].Patterns() {
+		if d != "." {
+			dirs = append(dirs, d)
+		}
+	}
+	includes := m.ignores[repo].Includes()
+	m.rmut.RUnlock()
+
+	stamps := make(map[string]ignoreStamp, len(dirs)+len(includes))
+	for _, d := range dirs {
+		name := filepath.Join(dir, d, ".stignore")
+		stamps[name] = stampFile(m.fs, name)
+	}
+	for _, name := range includes {
+		stamps[name] = stampFile(m.fs, name)
+	}
+	return stamps
+}
+
+func stampFile(fsys fs.Filesystem, name string) ignoreStamp {
+	fi, err := fsys.Lstat(name)
+	if err != nil {
+		return ignoreStamp{}
+	}
+	return ignoreStamp{true, fi.ModTime(), fi.Size()}
+}
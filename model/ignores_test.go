@@ -0,0 +1,42 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+)
+
+func TestScanSubNewIgnoreFile(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/a/b", 0755)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true})
+	m.ScanRepo("default")
+
+	// Created after the scan, and reported by the watcher
+	fd, _ := f.Create("repo/a/b/.stignore")
+	fd.Write([]byte("foo\n"))
+	fd.Close()
+	newIgnore := filepath.Join("repo", "a", "b", ".stignore")
+	if _, ok := m.ignoreStamps("default")[newIgnore]; ok {
+		t.Fatal("New ignore file already watched; test is broken")
+	}
+
+	if err := m.ScanRepoSubs("default", []string{filepath.Join("a", "b", ".stignore")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.ignoreStamps("default")[newIgnore]; !ok {
+		t.Error("New ignore file not watched")
+	}
+	if !m.repoIgnores("default").Match(filepath.Join("a", "b", "foo")) {
+		t.Error("New ignore patterns not in use")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+)
+
+// newIndexID returns a random, non zero, index ID.
+func newIndexID() uint64 {
+	var bs [8]byte
+	for {
+		if _, err := rand.Reader.Read(bs[:]); err != nil {
+			l.Fatalln("index ID:", err)
+		}
+		if id := binary.BigEndian.Uint64(bs[:]); id != 0 {
+			return id
+		}
+	}
+}
+
+// indexIDFile returns the name of the file holding the index ID for the
+// repo, next to the index.
+func (m *Model) indexIDFile(repo string, dir string) string {
+	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
+	return filepath.Join(dir, id+".idx.id")
+}
+
+// loadIndexID returns the index ID for the repo. A new one is created when
+// there is no saved index, so that other nodes can tell that what they knew
+// about our index no longer holds.
+func (m *Model) loadIndexID(repo string, dir string, haveIndex bool) uint64 {
+	name := m.indexIDFile(repo, dir)
+	if haveIndex {
+		bs, err := ioutil.ReadFile(name)
+		if err == nil {
+			id, err := strconv.ParseUint(strings.TrimSpace(string(bs)), 16, 64)
+			if err == nil && id != 0 {
+				return id
+			}
+		}
+	}
+
+	id := newIndexID()
+	if debug {
+		l.Debugf("new index ID %016x for %q", id, repo)
+	}
+	if err := saveIndexID(name, id); err != nil {
+		l.Infof("Saving index ID for %q: %v", repo, err)
+	}
+	return id
+}
+
+func saveIndexID(name string, id uint64) error {
+	return writeFileReplacing(name, []byte(fmt.Sprintf("%016x\n", id)))
+}
+
+// writeFileReplacing writes the data to a temporary file, which then
+// replaces the named file.
+func writeFileReplacing(name string, data []byte) error {
+	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return osutil.Rename(tmp, name)
+}
+
+// peerIndexIDsFile returns the name of the file holding the index IDs the
+// other nodes sent for the repo, next to the index.
+func (m *Model) peerIndexIDsFile(repo string, dir string) string {
+	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
+	return filepath.Join(dir, id+".idx.peers")
+}
+
+// repoPeerIndexIDs returns the index IDs the other nodes sent, by repo and
+// node.
+func (m *Model) repoPeerIndexIDs() map[string]map[string]uint64 {
+	m.pmut.RLock()
+	defer m.pmut.RUnlock()
+	res := make(map[string]map[string]uint64)
+	for node, ids := range m.peerIndexIDs {
+		for repo, id := range ids {
+			if res[repo] == nil {
+				res[repo] = make(map[string]uint64)
+			}
+			res[repo][node] = id
+		}
+	}
+	return res
+}
+
+// savePeerIndexIDs saves the index IDs of the nodes, one "node ID" line per
+// node, so that a node that lost its index while we were not running is
+// noticed as well.
+func savePeerIndexIDs(name string, ids map[string]uint64) error {
+	var lines []string
+	for node, id := range ids {
+		lines = append(lines, fmt.Sprintf("%s %016x\n", node, id))
+	}
+	sort.Strings(lines)
+	return writeFileReplacing(name, []byte(strings.Join(lines, "")))
+}
+
+// loadPeerIndexIDs returns the index IDs saved by savePeerIndexIDs, by node.
+func loadPeerIndexIDs(name string) map[string]uint64 {
+	bs, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil
+	}
+	ids := make(map[string]uint64)
+	for _, line := range strings.Split(string(bs), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if id, err := strconv.ParseUint(fields[1], 16, 64); err == nil && id != 0 {
+			ids[fields[0]] = id
+		}
+	}
+	return ids
+}
+
+// setPeerIndexIDs adds the loaded index IDs of the nodes, by repo and node,
+// to those sent since.
+func (m *Model) setPeerIndexIDs(repoIDs map[string]map[string]uint64) {
+	m.pmut.Lock()
+	defer m.pmut.Unlock()
+	for repo, ids := range repoIDs {
+		for node, id := range ids {
+			known, ok := m.peerIndexIDs[node]
+			if !ok {
+				known = make(map[string]uint64)
+				m.peerIndexIDs[node] = known
+			}
+			if _, ok := known[repo]; !ok {
+				known[repo] = id
+			}
+		}
+	}
+}
+
+// checkIndexIDs compares the index IDs the node sent in its cluster config
+// with those it sent before, also before a restart. A changed ID means that
+// the node has lost its index, for example by its database having been
+// deleted, and that the errors we recorded for the files it announced no
+// longer apply. The cached view of its files is dropped when the connection
+// closes and it always starts a connection with a full index, which
+// replaces that view. It has also lost what it knew about our index, so the
+// repositories with a changed ID are returned for our full index to be sent
+// again.
+func (m *Model) checkIndexIDs(nodeID string, ids map[string]uint64) []string {
+	m.rmut.RLock()
+	repos := m.nodeRepos[nodeID]
+	m.rmut.RUnlock()
+
+	var reset []string
+
+	m.pmut.Lock()
+	known, ok := m.peerIndexIDs[nodeID]
+	if !ok {
+		known = make(map[string]uint64)
+		m.peerIndexIDs[nodeID] = known
+	}
+	for _, repo := range repos {
+		id := ids[repo]
+		if id == 0 {
+			continue
+		}
+		if prev, ok := known[repo]; ok && prev != id {
+			reset = append(reset, repo)
+		}
+		known[repo] = id
+	}
+	m.pmut.Unlock()
+
+	for _, repo := range reset {
+		l.Infof("Node %s has a new index for repository %q; forgetting what was known about it", m.nodeName(nodeID), repo)
+		m.clearIndexErrors(repo, nodeID)
+	}
+	return reset
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/cid"
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestLoadIndexID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "indexid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := NewModel(dir, &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata"})
+
+	id1 := m.loadIndexID("default", dir, false)
+	if id1 == 0 {
+		t.Fatal("Zero index ID")
+	}
+	if id2 := m.loadIndexID("default", dir, true); id2 != id1 {
+		t.Errorf("Index ID changed with a saved index; %016x != %016x", id2, id1)
+	}
+	if id3 := m.loadIndexID("default", dir, false); id3 == id1 {
+		t.Error("Index ID unchanged without a saved index")
+	}
+}
+
+func TestCheckIndexIDs(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "node"}}})
+
+	m.checkIndexIDs("node", map[string]uint64{"default": 0x0123456789abcdef})
+	m.Rejected("node", "default", "../a", errors.New("bad name"))
+	m.Rejected("other", "default", "../b", errors.New("bad name"))
+
+	// The same ID again changes nothing
+	m.checkIndexIDs("node", map[string]uint64{"default": 0x0123456789abcdef})
+	if errs := m.ItemErrors("default"); len(errs) != 2 {
+		t.Fatalf("Unexpected item errors %v", errs)
+	}
+
+	// A new ID forgets the errors for the files from the node
+	m.checkIndexIDs("node", map[string]uint64{"default": 0xfedcba9876543210})
+	if errs := m.ItemErrors("default"); len(errs) != 1 || errs[0].Name != "../b" {
+		t.Errorf("Unexpected item errors %v", errs)
+	}
+}
+
+func TestPeerIndexIDsSaved(t *testing.T) {
+	dir, err := ioutil.TempDir("", "indexid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repoCfg := config.RepositoryConfiguration{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "node"}}}
+	m := NewModel(dir, &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(repoCfg)
+	m.checkIndexIDs("node", map[string]uint64{"default": 0x0123456789abcdef})
+	m.SaveIndexes(dir)
+
+	// A restart keeps the ID, so that a new one is noticed
+	m = NewModel(dir, &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(repoCfg)
+	m.LoadIndexes(dir)
+	if reset := m.checkIndexIDs("node", map[string]uint64{"default": 0x0123456789abcdef}); len(reset) != 0 {
+		t.Errorf("Unexpected reset %v for a known ID", reset)
+	}
+	if reset := m.checkIndexIDs("node", map[string]uint64{"default": 0xfedcba9876543210}); !reflect.DeepEqual(reset, []string{"default"}) {
+		t.Errorf("Unexpected reset %v for a new ID", reset)
+	}
+}
+
+func TestIndexResentOnNewIndexID(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes:        []config.NodeConfiguration{{NodeID: "other"}},
+		Repositories: []config.RepositoryConfiguration{{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "other"}}}},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.AddRepo(cfg.Repositories[0])
+	m.repoFiles["default"].Replace(cid.LocalID, []scanner.File{{Name: "a", Version: 1}})
+
+	c := &indexRecordingConnection{FakeConnection: FakeConnection{id: "other"}, idx: make(chan []protocol.FileInfo, 1)}
+	m.AddConnection(ioutil.NopCloser(nil), c)
+
+	cm := m.clusterConfig("other")
+	cm.Repositories[0].IndexID = 0x0123456789abcdef
+	m.ClusterConfig("other", cm)
+	select {
+	case <-c.idx:
+	case <-time.After(time.Second):
+		t.Fatal("No initial index sent")
+	}
+
+	// The node lost its index during the connection
+	cm.Repositories[0].IndexID = 0xfedcba9876543210
+	m.ClusterConfig("other", cm)
+	select {
+	case idx := <-c.idx:
+		if len(idx) != 1 {
+			t.Errorf("Unexpected index %v", idx)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Index not sent again for a new index ID")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+)
+
+// The version of the layout of the index files in the index directory. It
+// is kept in indexVersionFile and must be increased, with a migration
+// added, whenever the files change in a way older code would misread.
+const indexVersion = 1
+
+const indexVersionFile = "index.version"
+
+// indexMigrations[v] upgrades the index files in dir from version v to v+1
+// in place.
+var indexMigrations = []func(dir string) error{
+	// Version 0 is the layout before versioning. Interrupted writes could
+	// leave temporary files behind, which are removed.
+	func(dir string) error {
+		tmps, err := filepath.Glob(filepath.Join(dir, "*.idx*.tmp.*"))
+		if err != nil {
+			return err
+		}
+		for _, tmp := range tmps {
+			if err := os.Remove(tmp); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// readIndexVersion returns the version of the index files in dir. Without a
+// version file, the files are of version 0.
+func readIndexVersion(dir string) (int, error) {
+	bs, err := ioutil.ReadFile(filepath.Join(dir, indexVersionFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(bs)))
+}
+
+func writeIndexVersion(dir string) error {
+	name := filepath.Join(dir, indexVersionFile)
+	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if _, err := fmt.Fprintf(f, "%d\n", indexVersion); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return osutil.Rename(tmp, name)
+}
+
+// migrateIndexes upgrades the index files in dir to the current version.
+// It returns false if they cannot be used, because they were written by a
+// newer version or could not be upgraded; the repositories are then
+// rescanned from scratch and the files overwritten.
+func migrateIndexes(dir string) bool {
+	v, err := readIndexVersion(dir)
+	if err != nil {
+		l.Warnf("Reading index version: %v; ignoring the saved index", err)
+		return false
+	}
+	if v > indexVersion {
+		l.Warnf("The saved index is of version %d, newer than the supported %d; ignoring it", v, indexVersion)
+		return false
+	}
+	if v == indexVersion {
+		return true
+	}
+
+	for ; v < indexVersion; v++ {
+		if debug {
+			l.Debugf("migrating index from version %d to %d", v, v+1)
+		}
+		if err := indexMigrations[v](dir); err != nil {
+			l.Warnf("Upgrading index from version %d: %v; ignoring the saved index", v, err)
+			return false
+		}
+	}
+	if err := writeIndexVersion(dir); err != nil {
+		l.Warnf("Saving index version: %v", err)
+	}
+	return true
+}
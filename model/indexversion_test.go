@@ -0,0 +1,54 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrateIndexes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "indexversion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// An unversioned index with a leftover temporary file
+	idx := filepath.Join(dir, "abcd.idx.gz")
+	tmp := idx + ".tmp.12345"
+	for _, name := range []string{idx, tmp} {
+		if err := ioutil.WriteFile(name, []byte("data"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !migrateIndexes(dir) {
+		t.Fatal("Unversioned index not usable")
+	}
+	if v, err := readIndexVersion(dir); err != nil || v != indexVersion {
+		t.Errorf("Unexpected version %d, %v after migration", v, err)
+	}
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Error("Temporary file not removed")
+	}
+	if _, err := os.Stat(idx); err != nil {
+		t.Error("Index removed:", err)
+	}
+
+	if !migrateIndexes(dir) {
+		t.Error("Current index not usable")
+	}
+
+	// An index from the future is not used
+	if err := ioutil.WriteFile(filepath.Join(dir, indexVersionFile), []byte("999\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if migrateIndexes(dir) {
+		t.Error("Newer index usable")
+	}
+}
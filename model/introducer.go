@@ -0,0 +1,143 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/protocol"
+)
+
+// ConfigChanged returns a channel that is signalled when the model changes
+// the configuration by itself, such as when an introducer tells us about
+// new nodes, so that the configuration can be saved.
+func (m *Model) ConfigChanged() <-chan struct{} {
+	return m.configChanged
+}
+
+// Configuration returns a copy of the configuration, taken while the model
+// is not changing it. The model changes the configuration it was created
+// with, so that is to be read only through Configuration once the model
+// runs.
+func (m *Model) Configuration() config.Configuration {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.cfg.Copy()
+}
+
+// ReplaceConfiguration overwrites the configuration the model was created
+// with by a copy of cfg, while the model is not changing it.
+func (m *Model) ReplaceConfiguration(cfg config.Configuration) {
+	m.rmut.Lock()
+	*m.cfg = cfg.Copy()
+	m.rmut.Unlock()
+}
+
+// options returns the current options of the configuration.
+func (m *Model) options() config.OptionsConfiguration {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.cfg.Options
+}
+
+// nodeName returns how the node is to be referred to in logs, as
+// config.Configuration.NodeName. The caller must not hold rmut.
+func (m *Model) nodeName(nodeID string) string {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.cfg.NodeName(nodeID)
+}
+
+// InvalidateRepo marks the repository as invalid in the configuration, with
+// err as the reason.
+func (m *Model) InvalidateRepo(repo string, err error) {
+	m.rmut.Lock()
+	defer m.rmut.Unlock()
+	for i := range m.cfg.Repositories {
+		if m.cfg.Repositories[i].ID == repo {
+			m.cfg.Repositories[i].Invalid = err.Error()
+			return
+		}
+	}
+}
+
+// handleIntroductions adds the nodes the introducer shares our common
+// repositories with to the configuration, and shares the repositories with
+// them. Nodes and repositories are only ever added, never removed. The
+// names of nodes are chosen locally, so introduced nodes get none.
+func (m *Model) handleIntroductions(introducer string, cm protocol.ClusterConfigMessage) {
+	changed := false
+
+	m.rmut.Lock()
+	known := m.cfg.NodeMap()
+	for _, repo := range cm.Repositories {
+		if !m.sharedWithLocked(repo.ID, introducer) {
+			continue
+		}
+
+		for _, node := range repo.Nodes {
+			if node.ID == m.nodeID || node.ID == introducer {
+				continue
+			}
+
+			if _, ok := known[node.ID]; !ok {
+				nc := config.NodeConfiguration{
+					NodeID:    node.ID,
+					Addresses: node.Addresses,
+				}
+				if len(nc.Addresses) == 0 {
+					nc.Addresses = []string{"dynamic"}
+				}
+				m.cfg.Nodes = append(m.cfg.Nodes, nc)
+				known[node.ID] = nc
+				l.Infof("Adding node %s introduced by %s", m.cfg.NodeName(node.ID), m.cfg.NodeName(introducer))
+				changed = true
+			}
+
+			if m.introduceToRepoLocked(repo.ID, node.ID) {
+				l.Infof("Sharing repository %q with %s, introduced by %s", repo.ID, m.cfg.NodeName(node.ID), m.cfg.NodeName(introducer))
+				changed = true
+			}
+		}
+	}
+	m.rmut.Unlock()
+
+	if changed {
+		select {
+		case m.configChanged <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// introduceToRepoLocked shares the repository with the node, both in the
+// configuration and in the running model. It returns false if the
+// repository was already shared with the node. The caller must hold rmut.
+func (m *Model) introduceToRepoLocked(repo, node string) bool {
+	for i := range m.cfg.Repositories {
+		if m.cfg.Repositories[i].ID == repo {
+			m.cfg.Repositories[i].AddNode(node)
+		}
+	}
+
+	rc := m.repoCfgs[repo]
+	if !rc.AddNode(node) {
+		return false
+	}
+	m.repoCfgs[repo] = rc
+	m.repoNodes[repo] = append(m.repoNodes[repo], node)
+	m.nodeRepos[node] = append(m.nodeRepos[node], repo)
+	return true
+}
+
+// sharedWithLocked returns true if the repository is shared with the node.
+// The caller must hold rmut.
+func (m *Model) sharedWithLocked(repo, node string) bool {
+	for _, nrepo := range m.nodeRepos[node] {
+		if nrepo == repo {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The kinds of item errors.
+const (
+	ItemErrorScan  = "scan"  // the file could not be scanned
+	ItemErrorPull  = "pull"  // the file could not be synced from the cluster
+	ItemErrorIndex = "index" // an index entry for the file was rejected
+)
+
+// At most this many item errors are kept per repository.
+const maxItemErrors = 1000
+
+// An ItemError describes the last failure to handle a file in a repository.
+type ItemError struct {
+	Name  string
+	Kind  string
+	Error string
+	Time  time.Time
+}
+
+type itemErrorList []ItemError
+
+func (l itemErrorList) Len() int {
+	return len(l)
+}
+
+func (l itemErrorList) Less(a, b int) bool {
+	return l[a].Name < l[b].Name
+}
+
+func (l itemErrorList) Swap(a, b int) {
+	l[a], l[b] = l[b], l[a]
+}
+
+// ItemErrors returns the current item errors for the repository, sorted by
+// file name.
+func (m *Model) ItemErrors(repo string) []ItemError {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	var res = make([]ItemError, 0, len(m.itemErrors[repo]))
+	for _, e := range m.itemErrors[repo] {
+		res = append(res, e)
+	}
+	sort.Sort(itemErrorList(res))
+	return res
+}
+
+func (m *Model) setItemError(repo, name, kind string, err error) {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	errs, ok := m.itemErrors[repo]
+	if !ok {
+		errs = make(map[string]ItemError)
+		m.itemErrors[repo] = errs
+	}
+	if _, ok := errs[name]; !ok && len(errs) >= maxItemErrors {
+		return
+	}
+	errs[name] = ItemError{
+		Name:  name,
+		Kind:  kind,
+		Error: err.Error(),
+		Time:  time.Now(),
+	}
+}
+
+// clearItemError forgets the error of the given kind for the named file.
+func (m *Model) clearItemError(repo, name, kind string) {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	if e, ok := m.itemErrors[repo][name]; ok && e.Kind == kind {
+		delete(m.itemErrors[repo], name)
+	}
+}
+
+// clearIndexErrors forgets the index errors for files announced by the node.
+func (m *Model) clearIndexErrors(repo, nodeID string) {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	prefix := "from " + nodeID + ":"
+	for name, e := range m.itemErrors[repo] {
+		if e.Kind == ItemErrorIndex && strings.HasPrefix(e.Error, prefix) {
+			delete(m.itemErrors[repo], name)
+		}
+	}
+}
+
+// clearScanErrors forgets the scan errors for the files within sub, or for
+// all files if sub is empty, ahead of a new scan.
+func (m *Model) clearScanErrors(repo, sub string) {
+	m.emut.Lock()
+	defer m.emut.Unlock()
+	prefix := sub + string(os.PathSeparator)
+	for name, e := range m.itemErrors[repo] {
+		if e.Kind != ItemErrorScan {
+			continue
+		}
+		if sub == "" || name == sub || strings.HasPrefix(name, prefix) {
+			delete(m.itemErrors[repo], name)
+		}
+	}
+}
+
+// scanErrors records the errors reported by the scanner for a repository.
+// It implements scanner.ErrorReporter.
+type scanErrors struct {
+	m    *Model
+	repo string
+}
+
+func (s scanErrors) ReportError(name string, err error) {
+	s.m.setItemError(s.repo, filepath.Clean(name), ItemErrorScan, err)
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestItemErrors(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	for _, n := range []string{"repo/a", "repo/b"} {
+		fd, _ := f.Create(n)
+		fd.Write([]byte(n))
+		fd.Close()
+	}
+	f.InjectError(fs.OpOpen, "repo/b", syscall.EACCES)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	errs := m.ItemErrors("default")
+	if len(errs) != 1 || errs[0].Name != "b" || errs[0].Kind != ItemErrorScan {
+		t.Fatalf("Unexpected item errors %v", errs)
+	}
+
+	m.Rejected("node", "default", "../c", errors.New("bad name"))
+	m.setItemError("default", "a", ItemErrorPull, errors.New("pull failed"))
+	if errs := m.ItemErrors("default"); len(errs) != 3 || errs[0].Name != "../c" || errs[0].Kind != ItemErrorIndex {
+		t.Fatalf("Unexpected item errors %v", errs)
+	}
+
+	// A successful pull and a successful scan clear the errors
+	m.updateLocal("default", scanner.File{Name: "a", Version: 1})
+	f.InjectError(fs.OpOpen, "repo/b", nil)
+	m.ScanRepo("default")
+	if errs := m.ItemErrors("default"); len(errs) != 1 || errs[0].Name != "../c" {
+		t.Errorf("Unexpected item errors %v", errs)
+	}
+}
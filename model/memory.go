@@ -0,0 +1,114 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"runtime"
+	rtdebug "runtime/debug"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// Memory use above this percentage of the limit constrains the model,
+	// memory use below the lower percentage releases it again.
+	memoryHighPct = 80
+	memoryLowPct  = 60
+
+	// The GOGC value used while constrained.
+	constrainedGCPercent = 10
+
+	// The number of outstanding requests per puller while constrained.
+	constrainedRequests = 2
+
+	// The number of files per index message while constrained.
+	constrainedIndexBatch = 1000
+)
+
+// A memoryGovernor compares the memory in use against a soft limit. When the
+// limit is approached the model is marked as constrained, which makes it
+// drop its caches, collect garbage more aggressively, scan one repository
+// at a time, pull with fewer outstanding requests and send indexes in
+// smaller messages.
+type memoryGovernor struct {
+	limit       uint64
+	constrained int32
+	gcPercent   int
+	shrink      func() // drops the caches while constrained
+	restore     func() // rebuilds them once no longer constrained
+}
+
+func newMemoryGovernor(limitMiB int, shrink, restore func()) *memoryGovernor {
+	return &memoryGovernor{
+		limit:   uint64(limitMiB) << 20,
+		shrink:  shrink,
+		restore: restore,
+	}
+}
+
+func (g *memoryGovernor) serve(stop <-chan struct{}) {
+	var ms runtime.MemStats
+	for {
+		runtime.ReadMemStats(&ms)
+		g.check(ms.Sys - ms.HeapReleased)
+
+		select {
+		case <-time.After(2 * time.Second):
+		case <-stop:
+			return
+		}
+	}
+}
+
+// check constrains or releases the model for the memory used.
+func (g *memoryGovernor) check(used uint64) {
+	if !g.isConstrained() && used > g.limit*memoryHighPct/100 {
+		l.Infof("Memory use %d MiB is close to the limit of %d MiB; reducing activity", used>>20, g.limit>>20)
+		g.gcPercent = rtdebug.SetGCPercent(constrainedGCPercent)
+		atomic.StoreInt32(&g.constrained, 1)
+		g.shrink()
+	} else if g.isConstrained() && used < g.limit*memoryLowPct/100 {
+		l.Infof("Memory use %d MiB is below the limit of %d MiB; resuming normal activity", used>>20, g.limit>>20)
+		rtdebug.SetGCPercent(g.gcPercent)
+		atomic.StoreInt32(&g.constrained, 0)
+		g.restore()
+	}
+
+	if g.isConstrained() && used > g.limit {
+		// What was cached again meanwhile is dropped again
+		g.shrink()
+		rtdebug.FreeOSMemory()
+	}
+}
+
+// isConstrained returns true when memory is scarce. A nil governor is never
+// constrained.
+func (g *memoryGovernor) isConstrained() bool {
+	return g != nil && atomic.LoadInt32(&g.constrained) != 0
+}
+
+// shrinkCaches drops what the model keeps only to work faster: the block
+// maps and name lists of the repositories and the cached results of the
+// ignore patterns. Local blocks are not reused for pulling until the caches
+// are restored.
+func (m *Model) shrinkCaches() {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	for _, fs := range m.repoFiles {
+		fs.ShrinkCaches()
+	}
+	for _, ign := range m.ignores {
+		ign.ClearCache()
+	}
+}
+
+// restoreCaches rebuilds the block maps dropped by shrinkCaches.
+func (m *Model) restoreCaches() {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	for _, fs := range m.repoFiles {
+		fs.RestoreCaches()
+	}
+}
@@ -13,15 +13,21 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
 	"time"
+
 	"github.com/calmh/syncthing/cid"
 	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/events"
 	"github.com/calmh/syncthing/files"
+	"github.com/calmh/syncthing/fs"
 	"github.com/calmh/syncthing/lamport"
 	"github.com/calmh/syncthing/osutil"
 	"github.com/calmh/syncthing/protocol"
 	"github.com/calmh/syncthing/scanner"
+	"github.com/calmh/syncthing/versioner"
 )
 
 type repoState int
@@ -33,54 +39,120 @@ const (
 	RepoCleaning
 )
 
-// Somewhat arbitrary amount of bytes that we choose to let represent the size
-// of an unsynchronized directory entry or a deleted file. We need it to be
-// larger than zero so that it's visible that there is some amount of bytes to
-// transfer to bring the systems into synchronization.
-const zeroEntrySize = 128
+func (s repoState) String() string {
+	switch s {
+	case RepoIdle:
+		return "idle"
+	case RepoScanning:
+		return "scanning"
+	case RepoCleaning:
+		return "cleaning"
+	case RepoSyncing:
+		return "syncing"
+	default:
+		return "unknown"
+	}
+}
+
+// The size of a directory entry or a deleted file, as counted by files.Set.
+const zeroEntrySize = files.ZeroEntrySize
+
+// How often the nodes are sent a digest of what we know of their indexes.
+const indexDigestInterval = 5 * time.Minute
+
+// How often the records of old deleted files are purged.
+const purgeDeletedInterval = time.Hour
+
+// How long a node is not dialed after it closed the connection for a
+// reason that is not temporary.
+const closeBackoff = 5 * time.Minute
 
 type Model struct {
 	indexDir string
 	cfg      *config.Configuration
+	fs       fs.Filesystem // used for all access to repository contents
+	symlinks bool          // whether symbolic links can be created here
+
+	limitedFS    *fs.LimitedFilesystem // fs, when the open files are limited
+	openFilesMax int
 
 	clientName    string
 	clientVersion string
+	nodeID        string
+	vectorID      uint64 // of this node in version vectors
 
 	repoCfgs   map[string]config.RepositoryConfiguration // repo -> cfg
 	repoFiles  map[string]*files.Set                     // repo -> files
 	repoNodes  map[string][]string                       // repo -> nodeIDs
 	nodeRepos  map[string][]string                       // nodeID -> repos
 	suppressor map[string]*suppressor                    // repo -> suppressor
+	repoMtimes map[string]*mtimeStore                    // repo -> mtimes that could not be set exactly
+	ignores    map[string]*scanner.Matcher               // repo -> ignore patterns from the last scan
+	indexIDs   map[string]uint64                         // repo -> index ID, once loaded
+	resumed    map[string]chan struct{}                  // repo -> signalled when the repo is resumed
+	retries    map[string]*retryQueue                    // repo -> files that failed to be pulled
+	pullers    map[string]*puller                        // repo -> puller, for the repos pulled read/write
 	rmut       sync.RWMutex                              // protects the above
 
-	repoState map[string]repoState // repo -> state
-	smut      sync.RWMutex
+	repoState    map[string]repoState         // repo -> state
+	scanProgress map[string]*scanner.Progress // repo -> progress of the running scan
+	scanned      map[string]bool              // repo -> has been scanned at least once
+	scans        map[*runningScan]bool        // the scans in progress
+	smut         sync.RWMutex
+
+	itemErrors map[string]map[string]ItemError // repo -> file name -> last error
+	conflicts  map[string][]Conflict           // repo -> recent conflicts
+	emut       sync.Mutex
 
 	cm *cid.Map
 
 	protoConn map[string]protocol.Connection
 	rawConn   map[string]io.Closer
 	nodeVer   map[string]string
-	pmut      sync.RWMutex // protects protoConn and rawConn
-
-	sup suppressor
+	nodeOpts  map[string]map[string]string // nodeID -> cluster config options
+	batchers  map[string]*requestBatcher   // nodeID -> batcher, for nodes answering vector requests
+	pmut      sync.RWMutex                 // protects protoConn and rawConn
+
+	peerIndexIDs map[string]map[string]uint64 // nodeID -> repo -> index ID, saved with the index
+	backoff      map[string]time.Time         // nodeID -> not dialed before, after it closed the connection for a lasting reason
+
+	sup       suppressor
+	mem       *memoryGovernor // nil when there is no memory limit
+	sched     *scheduler      // shared by the scanners and pullers of all repos
+	scanSlots *scheduler      // limits the repos scanned at once
+	nodeStats *nodeStats      // how fast each node answers our requests
+	uploads   *uploadQueue    // limits the disk reads done for other nodes' requests
+	reuse     *reuseStats     // how the pulled bytes were obtained
+	progress  *downloadProgress
+
+	localChanged  chan struct{}  // signalled when a local index changes
+	configChanged chan struct{}  // signalled when the model changes the configuration
+	stop          chan struct{}  // closed by Stop
+	running       sync.WaitGroup // the pullers and background loops, waited for by Stop
 
 	addedRepo bool
 	started   bool
 }
 
+// The errors returned by Request are those that can be sent to the
+// requesting node in a Response Error message.
 var (
-	ErrNoSuchFile = errors.New("no such file")
-	ErrInvalid    = errors.New("file is invalid")
+	ErrNoSuchFile = protocol.ErrNoSuchFile
+	ErrInvalid    = protocol.ErrInvalidFile
+	ErrQueueFull  = protocol.ErrBusy
 )
 
 // NewModel creates and starts a new model. The model starts in read-only mode,
 // where it sends index information to connected peers and responds to requests
-// for file data without altering the local repository in any way.
+// for file data without altering the local repository in any way. The model
+// keeps a copy of cfg, which is to be read through Configuration from then on.
 func NewModel(indexDir string, cfg *config.Configuration, clientName, clientVersion string) *Model {
+	own := cfg.Copy()
 	m := &Model{
 		indexDir:      indexDir,
-		cfg:           cfg,
+		cfg:           &own,
+		fs:            fs.DefaultFilesystem,
+		symlinks:      osutil.SymlinksSupported(),
 		clientName:    clientName,
 		clientVersion: clientVersion,
 		repoCfgs:      make(map[string]config.RepositoryConfiguration),
@@ -88,30 +160,106 @@ func NewModel(indexDir string, cfg *config.Configuration, clientName, clientVers
 		repoNodes:     make(map[string][]string),
 		nodeRepos:     make(map[string][]string),
 		repoState:     make(map[string]repoState),
+		scanProgress:  make(map[string]*scanner.Progress),
+		scanned:       make(map[string]bool),
+		scans:         make(map[*runningScan]bool),
 		suppressor:    make(map[string]*suppressor),
+		repoMtimes:    make(map[string]*mtimeStore),
+		ignores:       make(map[string]*scanner.Matcher),
+		indexIDs:      make(map[string]uint64),
+		resumed:       make(map[string]chan struct{}),
+		retries:       make(map[string]*retryQueue),
+		pullers:       make(map[string]*puller),
+		itemErrors:    make(map[string]map[string]ItemError),
+		conflicts:     make(map[string][]Conflict),
 		cm:            cid.NewMap(),
 		protoConn:     make(map[string]protocol.Connection),
 		rawConn:       make(map[string]io.Closer),
 		nodeVer:       make(map[string]string),
+		nodeOpts:      make(map[string]map[string]string),
+		batchers:      make(map[string]*requestBatcher),
+		peerIndexIDs:  make(map[string]map[string]uint64),
+		backoff:       make(map[string]time.Time),
 		sup:           suppressor{threshold: int64(cfg.Options.MaxChangeKbps)},
+		sched:         newScheduler(cfg.Options.MaxWorkers),
+		scanSlots:     newScheduler(cfg.Options.MaxConcurrentScans),
+		nodeStats:     newNodeStats(),
+		reuse:         newReuseStats(),
+		progress:      newDownloadProgress(),
+		localChanged:  make(chan struct{}, 1),
+		configChanged: make(chan struct{}, 1),
+		stop:          make(chan struct{}),
+		uploads:       newUploadQueue(cfg.Options.MaxConcurrentReads, cfg.Options.MaxQueuedRequests),
+	}
+
+	if limit := openFilesLimit(cfg.Options.MaxOpenFiles, osutil.MaxOpenFiles()); limit > 0 {
+		m.limitedFS = fs.NewLimitedFilesystem(m.fs, limit)
+		m.openFilesMax = limit
+		m.fs = m.limitedFS
+		if debug {
+			l.Debugln("open files limit", limit)
+		}
 	}
 
-	go m.broadcastIndexLoop()
+	if cfg.Options.MaxMemoryMiB > 0 {
+		m.mem = newMemoryGovernor(cfg.Options.MaxMemoryMiB, m.shrinkCaches, m.restoreCaches)
+		m.background(func() { m.mem.serve(m.stop) })
+	}
+
+	m.background(m.broadcastIndexLoop)
+	m.background(m.indexDigestLoop)
+	m.background(m.sendDownloadProgressLoop)
+	if cfg.Options.KeepDeletedHours > 0 {
+		m.background(m.purgeDeletedLoop)
+	}
+	m.publish()
 	return m
 }
 
+// background runs fn in a goroutine that Stop waits for. It must return
+// once m.stop is closed.
+func (m *Model) background(fn func()) {
+	m.running.Add(1)
+	go func() {
+		fn()
+		m.running.Done()
+	}()
+}
+
+// Stop stops the background processing of the model, including the scans
+// and the pullers, and waits for it to end. Connections are not closed; see
+// CloseAll. It must be called at most once.
+func (m *Model) Stop() {
+	close(m.stop)
+	m.CancelScans()
+	m.running.Wait()
+	m.unpublish()
+}
+
+// Ping returns once the model's locks have been taken, so that a watchdog
+// can tell that the model is not stuck.
+func (m *Model) Ping() {
+	m.rmut.Lock()
+	m.rmut.Unlock()
+	m.pmut.Lock()
+	m.pmut.Unlock()
+	m.smut.Lock()
+	m.smut.Unlock()
+}
+
 // StartRW starts read/write processing on the current model. When in
 // read/write mode the model will attempt to keep in sync with the cluster by
-// pulling needed files from peer nodes.
+// pulling needed files from peer nodes. Dry run repositories are started
+// read only regardless, since their changes are previewed only.
 func (m *Model) StartRepoRW(repo string, threads int) {
 	m.rmut.RLock()
-	defer m.rmut.RUnlock()
+	cfg, ok := m.repoCfgs[repo]
+	m.rmut.RUnlock()
 
-	if cfg, ok := m.repoCfgs[repo]; !ok {
+	if !ok {
 		panic("cannot start without repo")
-	} else {
-		newPuller(cfg, m, threads, m.cfg)
 	}
+	newPuller(cfg, m, threads)
 }
 
 // StartRO starts read only processing on the current model. When in
@@ -123,6 +271,7 @@ func (m *Model) StartRepoRO(repo string) {
 
 type ConnectionInfo struct {
 	protocol.Statistics
+	Name          string // as configured, empty when the node has no name
 	Address       string
 	ClientVersion string
 	Completion    int
@@ -141,42 +290,20 @@ func (m *Model) ConnectionStats() map[string]ConnectionInfo {
 	for node, conn := range m.protoConn {
 		ci := ConnectionInfo{
 			Statistics:    conn.Statistics(),
+			Name:          m.cfg.NodeMap()[node].Name,
 			ClientVersion: m.nodeVer[node],
 		}
 		if nc, ok := m.rawConn[node].(remoteAddrer); ok {
 			ci.Address = nc.RemoteAddr().String()
 		}
 
-		var tot int64
-		var have int64
-
+		var tot, need int64
 		for _, repo := range m.nodeRepos[node] {
-			for _, f := range m.repoFiles[repo].Global() {
-				if !protocol.IsDeleted(f.Flags) {
-					size := f.Size
-					if protocol.IsDirectory(f.Flags) {
-						size = zeroEntrySize
-					}
-					tot += size
-					have += size
-				}
-			}
-
-			for _, f := range m.repoFiles[repo].Need(m.cm.Get(node)) {
-				if !protocol.IsDeleted(f.Flags) {
-					size := f.Size
-					if protocol.IsDirectory(f.Flags) {
-						size = zeroEntrySize
-					}
-					have -= size
-				}
-			}
-		}
-
-		ci.Completion = 100
-		if tot != 0 {
-			ci.Completion = int(100 * have / tot)
+			t, n := m.completionBytesLocked(repo, m.cm.Get(node))
+			tot += t
+			need += n
 		}
+		ci.Completion = completionPercent(tot, need)
 
 		res[node] = ci
 	}
@@ -196,21 +323,79 @@ func (m *Model) ConnectionStats() map[string]ConnectionInfo {
 	return res
 }
 
-func sizeOf(fs []scanner.File) (files, deleted int, bytes int64) {
-	for _, f := range fs {
-		if !protocol.IsDeleted(f.Flags) {
-			files++
-			if !protocol.IsDirectory(f.Flags) {
-				bytes += f.Size
-			} else {
-				bytes += zeroEntrySize
-			}
+// Completion returns how much of the global version of the repository the
+// node has, in percent. The empty node ID means this node.
+func (m *Model) Completion(repo, nodeID string) int {
+	id := cid.LocalID
+	if nodeID != "" && nodeID != m.nodeID {
+		id = m.cm.Get(nodeID)
+	}
+
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	if _, ok := m.repoFiles[repo]; !ok {
+		return 0
+	}
+	return completionPercent(m.completionBytesLocked(repo, id))
+}
+
+// logCompletion logs the completion of the repository on the node, which
+// changes as its index is updated.
+func (m *Model) logCompletion(nodeID, repo string) {
+	events.Default.Log(events.RepoCompletion, map[string]interface{}{
+		"node":       nodeID,
+		"repo":       repo,
+		"completion": m.Completion(repo, nodeID),
+	})
+}
+
+// completionBytesLocked returns the size of the global version of the
+// repository and how much of it the node needs. Deleted files are not
+// counted. The caller must hold rmut.
+func (m *Model) completionBytesLocked(repo string, id uint) (tot, need int64) {
+	rf := m.repoFiles[repo]
+	_, deleted, bytes := rf.GlobalSize()
+	tot = bytes - int64(deleted)*zeroEntrySize
+	_, deleted, bytes = rf.NeedSize(id)
+	need = bytes - int64(deleted)*zeroEntrySize
+	return
+}
+
+func completionPercent(tot, need int64) int {
+	if tot == 0 {
+		return 100
+	}
+	return int(100 * (tot - need) / tot)
+}
+
+// A sizeCounter adds up the files passed to add, for use as a files.Iterator.
+type sizeCounter struct {
+	files   int
+	deleted int
+	bytes   int64 // counting deleted files and directories as zeroEntrySize
+}
+
+func (c *sizeCounter) add(f scanner.File) bool {
+	if !protocol.IsDeleted(f.Flags) {
+		c.files++
+		if !protocol.IsDirectory(f.Flags) {
+			c.bytes += f.Size
 		} else {
-			deleted++
-			bytes += zeroEntrySize
+			c.bytes += zeroEntrySize
 		}
+	} else {
+		c.deleted++
+		c.bytes += zeroEntrySize
 	}
-	return
+	return true
+}
+
+func sizeOf(fs []scanner.File) (files, deleted int, bytes int64) {
+	var c sizeCounter
+	for _, f := range fs {
+		c.add(f)
+	}
+	return c.files, c.deleted, c.bytes
 }
 
 // GlobalSize returns the number of files, deleted files and total bytes for all
@@ -219,7 +404,7 @@ func (m *Model) GlobalSize(repo string) (files, deleted int, bytes int64) {
 	m.rmut.RLock()
 	defer m.rmut.RUnlock()
 	if rf, ok := m.repoFiles[repo]; ok {
-		return sizeOf(rf.Global())
+		return rf.GlobalSize()
 	}
 	return 0, 0, 0
 }
@@ -230,31 +415,105 @@ func (m *Model) LocalSize(repo string) (files, deleted int, bytes int64) {
 	m.rmut.RLock()
 	defer m.rmut.RUnlock()
 	if rf, ok := m.repoFiles[repo]; ok {
-		return sizeOf(rf.Have(cid.LocalID))
+		return rf.LocalSize(cid.LocalID)
 	}
 	return 0, 0, 0
 }
 
 // NeedSize returns the number and total size of currently needed files.
 func (m *Model) NeedSize(repo string) (files int, bytes int64) {
-	f, d, b := sizeOf(m.NeedFilesRepo(repo))
-	return f + d, b
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	rf, ok := m.repoFiles[repo]
+	if !ok {
+		return 0, 0
+	}
+	ign := m.ignores[repo]
+	var c sizeCounter
+	rf.WithNeed(cid.LocalID, func(f scanner.File) bool {
+		if !ign.MatchPath(f.Name) {
+			c.add(f)
+		}
+		return true
+	})
+	return c.files + c.deleted, c.bytes
 }
 
 // NeedFiles returns the list of currently needed files and the total size.
+// Files that are ignored in the local repository are not needed. The files
+// are in the order they are to be pulled in.
 func (m *Model) NeedFilesRepo(repo string) []scanner.File {
 	m.rmut.RLock()
 	defer m.rmut.RUnlock()
 	if rf, ok := m.repoFiles[repo]; ok {
-		f := rf.Need(cid.LocalID)
-		if r := m.repoCfgs[repo].FileRanker(); r != nil {
-			files.SortBy(r).Sort(f)
-		}
+		ign := m.ignores[repo]
+		var f []scanner.File
+		rf.WithNeed(cid.LocalID, func(nf scanner.File) bool {
+			if !ign.MatchPath(nf.Name) {
+				f = append(f, nf)
+			}
+			return true
+		})
+		sortNeeded(f, m.repoCfgs[repo])
 		return f
 	}
 	return nil
 }
 
+// IgnoredFilesRepo returns the list of files that exist in the cluster but
+// are ignored in the local repository.
+func (m *Model) IgnoredFilesRepo(repo string) []scanner.File {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	rf, ok := m.repoFiles[repo]
+	if !ok {
+		return nil
+	}
+	ign := m.ignores[repo]
+	snap := rf.Snapshot()
+	var fs []scanner.File
+	snap.WithGlobal(func(f scanner.File) bool {
+		if !protocol.IsDeleted(f.Flags) && (snap.Get(cid.LocalID, f.Name).IsIgnored() || ign.MatchPath(f.Name)) {
+			fs = append(fs, f)
+		}
+		return true
+	})
+	return fs
+}
+
+// BrowseRepo returns the files in the local repository that are directly in
+// the directory, given relative to the repository root with / as separator.
+// An empty directory means the root.
+func (m *Model) BrowseRepo(repo, dir string) []scanner.File {
+	m.rmut.RLock()
+	rf, ok := m.repoFiles[repo]
+	m.rmut.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	dir = filepath.FromSlash(strings.Trim(dir, "/"))
+	parent := dir
+	if parent == "" {
+		parent = "."
+	}
+	var fs []scanner.File
+	rf.WithPrefix(cid.LocalID, dir, func(f scanner.File) bool {
+		if filepath.Dir(f.Name) == parent && !protocol.IsDeleted(f.Flags) {
+			fs = append(fs, f)
+		}
+		return true
+	})
+	return fs
+}
+
+// IgnoredSize returns the number and total size of files that exist in the
+// cluster but are ignored in the local repository.
+func (m *Model) IgnoredSize(repo string) (files int, bytes int64) {
+	files, _, bytes = sizeOf(m.IgnoredFilesRepo(repo))
+	return
+}
+
 // Index is called when a new node is connected and we receive their full index.
 // Implements the protocol.Model interface.
 func (m *Model) Index(nodeID string, repo string, fs []protocol.FileInfo) {
@@ -263,7 +522,7 @@ func (m *Model) Index(nodeID string, repo string, fs []protocol.FileInfo) {
 	}
 
 	if !m.repoSharedWith(repo, nodeID) {
-		l.Warnf("Unexpected repository ID %q sent from node %q; ensure that the repository exists and that this node is selected under \"Share With\" in the repository configuration.", repo, nodeID)
+		l.Warnf("Unexpected repository ID %q sent from node %s; ensure that the repository exists and that this node is selected under \"Share With\" in the repository configuration.", repo, m.nodeName(nodeID))
 		return
 	}
 
@@ -289,6 +548,8 @@ func (m *Model) Index(nodeID string, repo string, fs []protocol.FileInfo) {
 		l.Fatalf("Index for nonexistant repo %q", repo)
 	}
 	m.rmut.RUnlock()
+
+	m.logCompletion(nodeID, repo)
 }
 
 // IndexUpdate is called for incremental updates to connected nodes' indexes.
@@ -299,7 +560,7 @@ func (m *Model) IndexUpdate(nodeID string, repo string, fs []protocol.FileInfo)
 	}
 
 	if !m.repoSharedWith(repo, nodeID) {
-		l.Warnf("Unexpected repository ID %q sent from node %q; ensure that the repository exists and that this node is selected under \"Share With\" in the repository configuration.", repo, nodeID)
+		l.Warnf("Unexpected repository ID %q sent from node %s; ensure that the repository exists and that this node is selected under \"Share With\" in the repository configuration.", repo, m.nodeName(nodeID))
 		return
 	}
 
@@ -325,17 +586,14 @@ func (m *Model) IndexUpdate(nodeID string, repo string, fs []protocol.FileInfo)
 		l.Fatalf("IndexUpdate for nonexistant repo %q", repo)
 	}
 	m.rmut.RUnlock()
+
+	m.logCompletion(nodeID, repo)
 }
 
 func (m *Model) repoSharedWith(repo, nodeID string) bool {
 	m.rmut.RLock()
 	defer m.rmut.RUnlock()
-	for _, nrepo := range m.nodeRepos[nodeID] {
-		if nrepo == repo {
-			return true
-		}
-	}
-	return false
+	return m.sharedWithLocked(repo, nodeID)
 }
 
 func (m *Model) ClusterConfig(nodeID string, config protocol.ClusterConfigMessage) {
@@ -345,9 +603,29 @@ func (m *Model) ClusterConfig(nodeID string, config protocol.ClusterConfigMessag
 		l.Debugf("  ... compare: %s: %v", nodeID, compErr)
 	}
 
+	for _, mm := range repoMismatches(m.clusterConfig(nodeID), config) {
+		l.Warnf("%s: configuration mismatch: %s", m.nodeName(nodeID), mm)
+	}
+
 	if compErr != nil {
-		l.Warnf("%s: %v", nodeID, compErr)
+		l.Warnf("%s: %v", m.nodeName(nodeID), compErr)
+		m.pmut.RLock()
+		conn, ok := m.protoConn[nodeID]
+		m.pmut.RUnlock()
+		if ok {
+			conn.Close(protocol.CloseConfigMismatch, compErr.Error())
+		}
 		m.Close(nodeID, compErr)
+	} else {
+		m.rmut.RLock()
+		nc := m.cfg.NodeMap()[nodeID]
+		m.rmut.RUnlock()
+		if nc.Introducer {
+			m.handleIntroductions(nodeID, config)
+		}
+		if nc.AutoAccept {
+			m.handleAutoAccept(nodeID, config)
+		}
 	}
 
 	m.pmut.Lock()
@@ -356,7 +634,29 @@ func (m *Model) ClusterConfig(nodeID string, config protocol.ClusterConfigMessag
 	} else {
 		m.nodeVer[nodeID] = config.ClientName + " " + config.ClientVersion
 	}
+	var opts = make(map[string]string, len(config.Options))
+	for _, opt := range config.Options {
+		opts[opt.Key] = opt.Value
+	}
+	_, seen := m.nodeOpts[nodeID]
+	m.nodeOpts[nodeID] = opts
 	m.pmut.Unlock()
+
+	ids := make(map[string]uint64, len(config.Repositories))
+	for _, repo := range config.Repositories {
+		ids[repo.ID] = repo.IndexID
+	}
+	reset := m.checkIndexIDs(nodeID, ids)
+
+	if compErr == nil && !seen {
+		// The options decide how the index is sent, so the initial index
+		// waits for them.
+		m.sendInitialIndexes(nodeID)
+	} else if compErr == nil {
+		for _, repo := range reset {
+			m.ResendIndex(nodeID, repo)
+		}
+	}
 }
 
 // Close removes the peer from the model and closes the underlying connection if possible.
@@ -366,19 +666,32 @@ func (m *Model) Close(node string, err error) {
 		l.Debugf("%s: %v", node, err)
 	}
 
-	if err != io.EOF {
-		l.Warnf("Connection to %s closed: %v", node, err)
+	if ce, ok := err.(*protocol.CloseError); ok {
+		// The other node told us why; that is not something to warn about
+		// unless it will keep happening.
+		if ce.Reason.Temporary() {
+			l.Infof("Connection to %s %v", m.nodeName(node), err)
+		} else {
+			l.Warnf("Connection to %s %v; not reconnecting for %v", m.nodeName(node), err, closeBackoff)
+			m.pmut.Lock()
+			m.backoff[node] = time.Now().Add(closeBackoff)
+			m.pmut.Unlock()
+		}
+	} else if err != io.EOF {
+		l.Warnf("Connection to %s closed: %v", m.nodeName(node), err)
 	} else if _, ok := err.(ClusterConfigMismatch); ok {
-		l.Warnf("Connection to %s closed: %v", node, err)
+		l.Warnf("Connection to %s closed: %v", m.nodeName(node), err)
 	}
 
 	cid := m.cm.Get(node)
 	m.rmut.RLock()
 	for _, repo := range m.nodeRepos[node] {
-		m.repoFiles[repo].Replace(cid, nil)
+		m.repoFiles[repo].Drop(cid)
 	}
 	m.rmut.RUnlock()
 	m.cm.Clear(node)
+	m.nodeStats.forget(node)
+	m.progress.forgetNode(node)
 
 	m.pmut.Lock()
 	conn, ok := m.rawConn[node]
@@ -388,7 +701,45 @@ func (m *Model) Close(node string, err error) {
 	delete(m.protoConn, node)
 	delete(m.rawConn, node)
 	delete(m.nodeVer, node)
+	delete(m.nodeOpts, node)
+	if b, ok := m.batchers[node]; ok {
+		b.close()
+		delete(m.batchers, node)
+	}
 	m.pmut.Unlock()
+
+	var reason string
+	if err != nil {
+		reason = err.Error()
+	}
+	events.Default.Log(events.NodeDisconnected, map[string]string{
+		"node":  node,
+		"error": reason,
+	})
+}
+
+// CloseAll closes the connections to all nodes, telling them the reason.
+func (m *Model) CloseAll(reason protocol.CloseReason, msg string) {
+	m.pmut.RLock()
+	var conns []protocol.Connection
+	for _, conn := range m.protoConn {
+		conns = append(conns, conn)
+	}
+	m.pmut.RUnlock()
+
+	for _, conn := range conns {
+		conn.Close(reason, msg)
+		m.Close(conn.ID(), errors.New(msg))
+	}
+}
+
+// BackingOff returns true if the node closed the connection to us for a
+// reason that is not temporary, recently enough that it should not be
+// dialed yet.
+func (m *Model) BackingOff(node string) bool {
+	m.pmut.RLock()
+	defer m.pmut.RUnlock()
+	return time.Now().Before(m.backoff[node])
 }
 
 // Request returns the specified data segment by reading it from local disk.
@@ -400,10 +751,17 @@ func (m *Model) Request(nodeID, repo, name string, offset int64, size int) ([]by
 	m.rmut.RUnlock()
 
 	if !ok {
-		l.Warnf("Request from %s for file %s in nonexistent repo %q", nodeID, name, repo)
+		l.Warnf("Request from %s for file %s in nonexistent repo %q", m.nodeName(nodeID), name, repo)
 		return nil, ErrNoSuchFile
 	}
 
+	// A block of a file we are pulling, announced in a Download Progress
+	// message, is read from the temporary file. The file may not be in the
+	// index yet at all.
+	if temp, ok := m.progress.localBlock(repo, name, offset); ok {
+		return m.requestTemp(nodeID, temp, offset, size)
+	}
+
 	lf := r.Get(cid.LocalID, name)
 	if lf.Suppressed || protocol.IsDeleted(lf.Flags) {
 		if debug {
@@ -412,11 +770,11 @@ func (m *Model) Request(nodeID, repo, name string, offset int64, size int) ([]by
 		return nil, ErrInvalid
 	}
 
-	if offset > lf.Size {
+	if offset < 0 || offset > lf.Size {
 		if debug {
 			l.Debugf("REQ(in; nonexistent): %s: %q o=%d s=%d", nodeID, name, offset, size)
 		}
-		return nil, ErrNoSuchFile
+		return nil, protocol.ErrInvalidOffset
 	}
 
 	if debug && nodeID != "<local>" {
@@ -425,21 +783,61 @@ func (m *Model) Request(nodeID, repo, name string, offset int64, size int) ([]by
 	m.rmut.RLock()
 	fn := filepath.Join(m.repoCfgs[repo].Directory, name)
 	m.rmut.RUnlock()
-	fd, err := os.Open(fn) // XXX: Inefficient, should cache fd?
-	if err != nil {
+
+	if err := m.uploads.acquire(nodeID); err != nil {
+		if debug {
+			l.Debugf("REQ(in; queue full): %s: %q / %q o=%d s=%d", nodeID, repo, name, offset, size)
+		}
+		return nil, err
+	}
+	defer m.uploads.release()
+
+	// A file that is gone or shorter than in the index has changed since
+	// it was scanned; the next scan announces the new version.
+	fd, err := m.fs.Open(fn) // XXX: Inefficient, should cache fd?
+	if os.IsNotExist(err) {
+		return nil, protocol.ErrRescanning
+	} else if err != nil {
 		return nil, err
 	}
 	defer fd.Close()
 
 	buf := make([]byte, size)
 	_, err = fd.ReadAt(buf, offset)
-	if err != nil {
+	if err == io.EOF {
+		return nil, protocol.ErrRescanning
+	} else if err != nil {
 		return nil, err
 	}
 
 	return buf, nil
 }
 
+// requestTemp returns the block from the temporary file of a file being
+// pulled. A temporary file that is gone means the pull finished or failed.
+func (m *Model) requestTemp(nodeID, temp string, offset int64, size int) ([]byte, error) {
+	if debug {
+		l.Debugf("REQ(in; temp): %s: %q o=%d s=%d", nodeID, temp, offset, size)
+	}
+
+	if err := m.uploads.acquire(nodeID); err != nil {
+		return nil, err
+	}
+	defer m.uploads.release()
+
+	fd, err := m.fs.Open(temp)
+	if err != nil {
+		return nil, ErrNoSuchFile
+	}
+	defer fd.Close()
+
+	buf := make([]byte, size)
+	if _, err := fd.ReadAt(buf, offset); err != nil {
+		return nil, ErrNoSuchFile
+	}
+	return buf, nil
+}
+
 // ReplaceLocal replaces the local repository index with the given list of files.
 func (m *Model) ReplaceLocal(repo string, fs []scanner.File) {
 	m.rmut.RLock()
@@ -452,7 +850,11 @@ func (m *Model) SeedLocal(repo string, fs []protocol.FileInfo) {
 	for i := 0; i < len(fs); i++ {
 		lamport.Default.Tick(fs[i].Version)
 		sfs[i] = fileFromFileInfo(fs[i])
-		sfs[i].Suppressed = false // we might have saved an index with files that were suppressed; the should not be on startup
+		sfs[i].Suppressed = sfs[i].IsIgnored() // we might have saved an index with files that were suppressed; the should not be on startup
+		if sfs[i].Flags&flagLocalChanged != 0 {
+			// Local changes in a receive only repository stay suppressed
+			sfs[i].Suppressed = true
+		}
 	}
 
 	m.rmut.RLock()
@@ -474,6 +876,13 @@ func (m *Model) CurrentGlobalFile(repo string, file string) scanner.File {
 	return f
 }
 
+// repoIgnores returns the ignore patterns found in the repo at the last scan.
+func (m *Model) repoIgnores(repo string) *scanner.Matcher {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.ignores[repo]
+}
+
 type cFiler struct {
 	m *Model
 	r string
@@ -508,13 +917,32 @@ func (m *Model) AddConnection(rawConn io.Closer, protoConn protocol.Connection)
 	m.rawConn[nodeID] = rawConn
 	m.pmut.Unlock()
 
+	events.Default.Log(events.NodeConnected, map[string]string{
+		"node": nodeID,
+	})
+
 	cm := m.clusterConfig(nodeID)
 	protoConn.ClusterConfig(cm)
+}
+
+// sendInitialIndexes sends the full index of each repository shared with the
+// node, once its cluster config has been received.
+func (m *Model) sendInitialIndexes(nodeID string) {
+	m.pmut.RLock()
+	protoConn, ok := m.protoConn[nodeID]
+	m.pmut.RUnlock()
+	if !ok {
+		return
+	}
 
 	var idxToSend = make(map[string][]protocol.FileInfo)
 
 	m.rmut.RLock()
 	for _, repo := range m.nodeRepos[nodeID] {
+		if m.repoCfgs[repo].Paused {
+			// The first index is sent when the repo is resumed
+			continue
+		}
 		idxToSend[repo] = m.protocolIndex(repo)
 	}
 	m.rmut.RUnlock()
@@ -524,11 +952,45 @@ func (m *Model) AddConnection(rawConn io.Closer, protoConn protocol.Connection)
 			if debug {
 				l.Debugf("IDX(out/initial): %s: %q: %d files", nodeID, repo, len(idx))
 			}
-			protoConn.Index(repo, idx)
+			m.sendIndex(protoConn, repo, idx)
 		}
 	}()
 }
 
+// sendIndex sends the index to the connection, split into several smaller
+// messages when memory is constrained.
+func (m *Model) sendIndex(conn protocol.Connection, repo string, idx []protocol.FileInfo) {
+	m.pmut.RLock()
+	groups := m.nodeOpts[conn.ID()][protocol.OptionBlockGroups] != ""
+	vectors := m.nodeOpts[conn.ID()][protocol.OptionVersionVectors] != ""
+	nanos := m.nodeOpts[conn.ID()][protocol.OptionModifiedNs] != ""
+	acls := m.nodeOpts[conn.ID()][protocol.OptionACLs] != ""
+	m.pmut.RUnlock()
+	if groups {
+		idx = groupedIndex(idx)
+	}
+	if !vectors {
+		idx = withoutVectors(idx)
+	}
+	if !nanos {
+		idx = withoutModifiedNs(idx)
+	}
+	if !acls {
+		idx = withoutACLs(idx)
+	}
+	idx = withoutLocalFlags(idx)
+
+	if !m.mem.isConstrained() {
+		conn.Index(repo, idx)
+		return
+	}
+	for len(idx) > constrainedIndexBatch {
+		conn.Index(repo, idx[:constrainedIndexBatch])
+		idx = idx[constrainedIndexBatch:]
+	}
+	conn.Index(repo, idx)
+}
+
 // protocolIndex returns the current local index in protocol data types.
 func (m *Model) protocolIndex(repo string) []protocol.FileInfo {
 	var index []protocol.FileInfo
@@ -553,12 +1015,28 @@ func (m *Model) protocolIndex(repo string) []protocol.FileInfo {
 func (m *Model) updateLocal(repo string, f scanner.File) {
 	m.rmut.RLock()
 	m.repoFiles[repo].Update(cid.LocalID, []scanner.File{f})
+	m.retries[repo].succeeded(f.Name)
 	m.rmut.RUnlock()
+	m.clearItemError(repo, f.Name, ItemErrorPull)
+
+	events.Default.Log(events.ItemFinished, map[string]interface{}{
+		"repo":  repo,
+		"item":  f.Name,
+		"error": nil,
+	})
+}
+
+// Rejected records an index entry from a node that was left out because
+// of its name. Implements the protocol.RejectionReporter interface.
+func (m *Model) Rejected(nodeID, repo, name string, err error) {
+	m.setItemError(repo, name, ItemErrorIndex, fmt.Errorf("from %s: %v", nodeID, err))
 }
 
 func (m *Model) requestGlobal(nodeID, repo, name string, offset int64, size int, hash []byte) ([]byte, error) {
 	m.pmut.RLock()
 	nc, ok := m.protoConn[nodeID]
+	batcher := m.batchers[nodeID]
+	vectors := m.nodeOpts[nodeID][protocol.OptionVectorRequests] != ""
 	m.pmut.RUnlock()
 
 	if !ok {
@@ -569,13 +1047,46 @@ func (m *Model) requestGlobal(nodeID, repo, name string, offset int64, size int,
 		l.Debugf("REQ(out): %s: %q / %q o=%d s=%d h=%x", nodeID, repo, name, offset, size, hash)
 	}
 
+	if batcher == nil && vectors {
+		batcher = m.requestBatcher(nodeID)
+	}
+	if batcher != nil {
+		return batcher.request(repo, name, offset, size)
+	}
 	return nc.Request(repo, name, offset, size)
 }
 
+func (m *Model) requestBlocksGlobal(nodeID, repo, name string, offset int64, size int) ([]protocol.BlockInfo, error) {
+	m.pmut.RLock()
+	nc, ok := m.protoConn[nodeID]
+	m.pmut.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("requestBlocksGlobal: no such node: %s", nodeID)
+	}
+
+	if debug {
+		l.Debugf("BLK(out): %s: %q / %q o=%d s=%d", nodeID, repo, name, offset, size)
+	}
+
+	return nc.RequestBlocks(repo, name, offset, size)
+}
+
+// broadcastIndexLoop sends the local index of a repository to the nodes
+// when it has changed. The changes of a few seconds are sent together.
 func (m *Model) broadcastIndexLoop() {
 	var lastChange = map[string]uint64{}
 	for {
-		time.Sleep(5 * time.Second)
+		select {
+		case <-m.localChanged:
+		case <-m.stop:
+			return
+		}
+		select {
+		case <-time.After(5 * time.Second):
+		case <-m.stop:
+			return
+		}
 
 		m.pmut.RLock()
 		m.rmut.RLock()
@@ -600,6 +1111,10 @@ func (m *Model) broadcastIndexLoop() {
 				indexWg.Done()
 			}()
 
+			if m.repoCfgs[repo].Paused {
+				// Saved, but sent only when the repo is resumed
+				continue
+			}
 			for _, nodeID := range m.repoNodes[repo] {
 				nodeID := nodeID
 				if conn, ok := m.protoConn[nodeID]; ok {
@@ -608,7 +1123,7 @@ func (m *Model) broadcastIndexLoop() {
 						l.Debugf("IDX(out/loop): %s: %d files", nodeID, len(idx))
 					}
 					go func() {
-						conn.Index(repo, idx)
+						m.sendIndex(conn, repo, idx)
 						indexWg.Done()
 					}()
 				}
@@ -622,6 +1137,152 @@ func (m *Model) broadcastIndexLoop() {
 	}
 }
 
+// watchLocalChanges wakes up broadcastIndexLoop when the local files of the
+// repository change.
+func (m *Model) watchLocalChanges(fs *files.Set) {
+	changes := fs.Subscribe()
+	defer fs.Unsubscribe(changes)
+	for {
+		select {
+		case c := <-changes:
+			if c.Node != cid.LocalID {
+				continue
+			}
+			select {
+			case m.localChanged <- struct{}{}:
+			default:
+			}
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// indexDigestLoop periodically sends the nodes a digest of what we know of
+// their indexes, so that they can tell when we have missed an update.
+func (m *Model) indexDigestLoop() {
+	for {
+		select {
+		case <-time.After(indexDigestInterval):
+		case <-m.stop:
+			return
+		}
+
+		type digest struct {
+			conn  protocol.Connection
+			repo  string
+			files []protocol.FileInfo
+		}
+		var digests []digest
+
+		m.pmut.RLock()
+		m.rmut.RLock()
+		for nodeID, conn := range m.protoConn {
+			if m.nodeOpts[nodeID][protocol.OptionIndexDigest] == "" {
+				continue
+			}
+			id := m.cm.Get(nodeID)
+			for _, repo := range m.nodeRepos[nodeID] {
+				fs := m.repoFiles[repo].Have(id)
+				var files = make([]protocol.FileInfo, len(fs))
+				for i, f := range fs {
+					files[i] = protocol.FileInfo{Name: f.Name, Version: f.Version}
+				}
+				digests = append(digests, digest{conn, repo, files})
+			}
+		}
+		m.rmut.RUnlock()
+		m.pmut.RUnlock()
+
+		for _, d := range digests {
+			if debug {
+				l.Debugf("DGST(out): %s: %q: %d files", d.conn.ID(), d.repo, len(d.files))
+			}
+			d.conn.IndexDigest(d.repo, d.files)
+		}
+	}
+}
+
+// purgeDeletedLoop periodically removes the records of files deleted more
+// than KeepDeletedHours ago, once all the connected nodes sharing the
+// repository have them. The index is then sent and saved without them.
+func (m *Model) purgeDeletedLoop() {
+	for {
+		select {
+		case <-time.After(purgeDeletedInterval):
+		case <-m.stop:
+			return
+		}
+		m.purgeDeleted(time.Now().Add(-time.Duration(m.options().KeepDeletedHours) * time.Hour))
+	}
+}
+
+func (m *Model) purgeDeleted(before time.Time) {
+	m.pmut.RLock()
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	defer m.pmut.RUnlock()
+
+	for repo, fs := range m.repoFiles {
+		var acked []uint
+		for _, nodeID := range m.repoNodes[repo] {
+			if _, ok := m.protoConn[nodeID]; ok {
+				acked = append(acked, m.cm.Get(nodeID))
+			}
+		}
+		if n := fs.PurgeDeleted(acked, before); n > 0 && debug {
+			l.Debugf("purged %d deleted files from %q", n, repo)
+		}
+	}
+}
+
+// ResendIndex sends the full local index for the repository to the node.
+// Implements the protocol.IndexResender interface.
+func (m *Model) ResendIndex(nodeID, repo string) {
+	if !m.repoSharedWith(repo, nodeID) || m.RepoPaused(repo) {
+		return
+	}
+
+	m.pmut.RLock()
+	conn, ok := m.protoConn[nodeID]
+	m.pmut.RUnlock()
+	if !ok {
+		return
+	}
+
+	m.rmut.RLock()
+	idx := m.protocolIndex(repo)
+	m.rmut.RUnlock()
+
+	if debug {
+		l.Debugf("IDX(out/resend): %s: %q: %d files", nodeID, repo, len(idx))
+	}
+	m.sendIndex(conn, repo, idx)
+}
+
+// SetNodeID sets the ID of this node, which counts the local changes in the
+// version vectors of files and names the conflict copies it makes. It must
+// be called before any repositories are added.
+func (m *Model) SetNodeID(nodeID string) {
+	m.nodeID = nodeID
+	m.vectorID = protocol.VectorID(nodeID)
+}
+
+// SetFilesystem makes the model scan and pull the repositories on the given
+// filesystem, such as an in memory one, instead of the real one. No limit on
+// open files is applied to it. It must be called before any repositories are
+// added.
+func (m *Model) SetFilesystem(fs fs.Filesystem) {
+	m.fs = fs
+}
+
+// SetMaxConcurrentScans limits the number of repositories scanned at once,
+// overriding the configured limit. Zero means no limit. It must be called
+// before any repositories are scanned.
+func (m *Model) SetMaxConcurrentScans(n int) {
+	m.scanSlots = newScheduler(n)
+}
+
 func (m *Model) AddRepo(cfg config.RepositoryConfiguration) {
 	if m.started {
 		panic("cannot add repo to started model")
@@ -630,10 +1291,21 @@ func (m *Model) AddRepo(cfg config.RepositoryConfiguration) {
 		panic("cannot add empty repo id")
 	}
 
+	if !cfg.IgnorePerms && !preservesPermissions(m.fs, cfg.Directory) {
+		l.Infof("Repository %q: the file system does not preserve permission bits; ignoring permissions", cfg.ID)
+		cfg.IgnorePerms = true
+	}
+
 	m.rmut.Lock()
 	m.repoCfgs[cfg.ID] = cfg
 	m.repoFiles[cfg.ID] = files.NewSet()
+	m.repoFiles[cfg.ID].SetVectorID(m.vectorID)
+	fs := m.repoFiles[cfg.ID]
+	m.background(func() { m.watchLocalChanges(fs) })
 	m.suppressor[cfg.ID] = &suppressor{threshold: int64(m.cfg.Options.MaxChangeKbps)}
+	m.repoMtimes[cfg.ID] = newMtimeStore()
+	m.resumed[cfg.ID] = make(chan struct{}, 1)
+	m.retries[cfg.ID] = newRetryQueue()
 
 	m.repoNodes[cfg.ID] = make([]string, len(cfg.Nodes))
 	for i, node := range cfg.Nodes {
@@ -657,13 +1329,19 @@ func (m *Model) ScanRepos() {
 	wg.Add(len(repos))
 	for _, repo := range repos {
 		repo := repo
-		go func() {
+		scan := func() {
 			err := m.ScanRepo(repo)
-			if err != nil {
-				invalidateRepo(m.cfg, repo, err)
+			if err != nil && err != scanner.ErrWalkStopped {
+				m.InvalidateRepo(repo, err)
 			}
 			wg.Done()
-		}()
+		}
+		if m.mem.isConstrained() {
+			// One repository at a time
+			scan()
+		} else {
+			go scan()
+		}
 	}
 	wg.Wait()
 }
@@ -680,8 +1358,9 @@ func (m *Model) CleanRepos() {
 	wg.Add(len(dirs))
 	for _, dir := range dirs {
 		w := &scanner.Walker{
-			Dir:       dir,
-			TempNamer: defTempNamer,
+			Dir:        dir,
+			TempNamer:  defTempNamer,
+			Filesystem: m.fs,
 		}
 		go func() {
 			w.CleanTempFiles()
@@ -692,28 +1371,182 @@ func (m *Model) CleanRepos() {
 }
 
 func (m *Model) ScanRepo(repo string) error {
+	return m.ScanRepoSubs(repo, nil)
+}
+
+// ScanRepoSubs scans the given files and directories, relative to the
+// repository root, and updates the local index for them. Files that were
+// previously within one of them and are no longer found are marked deleted.
+// An empty list of subs, or a sub of ".", scans the whole repository. A
+// paused repository is not scanned.
+func (m *Model) ScanRepoSubs(repo string, subs []string) error {
+	if m.RepoPaused(repo) {
+		if debug {
+			l.Debugf("not scanning paused repo %q", repo)
+		}
+		return nil
+	}
+
+	for _, sub := range subs {
+		if sub == "." {
+			subs = nil
+			break
+		}
+	}
+
 	m.rmut.RLock()
 	w := &scanner.Walker{
-		Dir:          m.repoCfgs[repo].Directory,
-		IgnoreFile:   ".stignore",
-		BlockSize:    scanner.StandardBlockSize,
-		TempNamer:    defTempNamer,
-		Suppressor:   m.suppressor[repo],
-		CurrentFiler: cFiler{m, repo},
-		IgnorePerms:  m.repoCfgs[repo].IgnorePerms,
+		Dir:           m.repoCfgs[repo].Directory,
+		IgnoreFile:    ".stignore",
+		BlockSize:     scanner.StandardBlockSize,
+		TempNamer:     defTempNamer,
+		Suppressor:    m.suppressor[repo],
+		CurrentFiler:  cFiler{m, repo},
+		IgnorePerms:   m.repoCfgs[repo].IgnorePerms,
+		ACLs:          m.repoCfgs[repo].SyncACLs,
+		MtimeMapper:   m.repoMtimes[repo],
+		ReportIgnored: true,
+		Errors:        scanErrors{m, repo},
+		VectorID:      m.vectorID,
+		Versions:      versioner.NewExclusion(m.repoCfgs[repo].Directory, m.repoCfgs[repo].Versioning.Params),
+		Filesystem:    m.fs,
+		Hashers:       runtime.NumCPU(),
+		// Where links cannot be created they are neither shared nor pulled.
+		IgnoreSymlinks: !m.symlinks,
+	}
+	scan := m.startScan(repo)
+	defer m.endScan(scan)
+	if m.repoCfgs[repo].Paused {
+		// Paused since the check above; PauseRepo did not see this scan
+		m.rmut.RUnlock()
+		return nil
 	}
+	w.Stop = scan.stop
+	if m.mem.isConstrained() {
+		// One file at a time, to keep the buffers in use down
+		w.Hashers = 1
+	}
+	prio := m.repoCfgs[repo].Priority
 	m.rmut.RUnlock()
+
+	m.scanSlots.acquire(prio)
+	defer m.scanSlots.release()
+	m.sched.acquire(prio)
+	defer m.sched.release()
+
 	m.setState(repo, RepoScanning)
-	fs, _, err := w.Walk()
-	if err != nil {
+	if len(subs) > 0 {
+		newIgnores := false
+		for _, sub := range subs {
+			m.clearScanErrors(repo, sub)
+			w.Sub = sub
+			_, ign, err := m.walkLocal(repo, w, sub)
+			if err == scanner.ErrWalkStopped {
+				m.setState(repo, RepoIdle)
+				return err
+			} else if err != nil {
+				return err
+			}
+			newIgnores = newIgnores || m.newIgnorePatterns(repo, ign)
+		}
+		if !newIgnores {
+			m.setState(repo, RepoIdle)
+			return nil
+		}
+
+		// A new or changed ignore file, such as one the watcher reported,
+		// is taken into use by a full scan.
+		l.Infof("Ignore patterns for %q changed; rescanning", repo)
+		w.Sub = ""
+	}
+
+	// The data to hash is counted up front only on the first scan, which
+	// is the one that may take a long time.
+	w.Progress = &scanner.Progress{}
+	precount := m.options().PrecountScan
+	m.smut.Lock()
+	w.Precount = precount && !m.scanned[repo]
+	m.scanProgress[repo] = w.Progress
+	m.smut.Unlock()
+	if debug {
+		w.ProgressFunc = func(s scanner.ProgressStatus) {
+			l.Debugf("scan %q: %d found, %d/%d files, %d/%d bytes hashed", repo, s.DiscoveredFiles, s.HashedFiles, s.TotalFiles, s.HashedBytes, s.TotalBytes)
+		}
+	}
+	defer func() {
+		m.smut.Lock()
+		delete(m.scanProgress, repo)
+		m.smut.Unlock()
+	}()
+
+	m.clearScanErrors(repo, "")
+	w.Resume = m.loadScanCheckpoint(repo)
+	stream, ign, err := m.walkLocal(repo, w, "")
+	if err == scanner.ErrWalkStopped {
+		m.saveScanCheckpoint(repo, m.localFiles(repo, stream.Names()))
+		m.setState(repo, RepoIdle)
+		return err
+	} else if err != nil {
 		return err
 	}
-	m.ReplaceLocal(repo, fs)
+	m.smut.Lock()
+	m.scanned[repo] = true
+	m.smut.Unlock()
+	m.rmut.Lock()
+	m.ignores[repo] = ign
+	m.rmut.Unlock()
 	m.setState(repo, RepoIdle)
 	return nil
 }
 
+// walkLocal walks the repo with w and updates the local index with the
+// files found as they come, in batches, so that they are never all in
+// memory at once. The files within sub, or the whole repo if sub is empty,
+// that are no longer found are marked as deleted only if the walk
+// completes.
+func (m *Model) walkLocal(repo string, w *scanner.Walker, sub string) (*files.Stream, *scanner.Matcher, error) {
+	m.rmut.RLock()
+	stream := m.repoFiles[repo].NewStream(cid.LocalID, sub)
+	if m.repoCfgs[repo].ReceiveOnly {
+		stream.Changed = markLocalChanged
+	}
+	m.rmut.RUnlock()
+
+	batches := make(chan []scanner.File)
+	done := make(chan struct{})
+	go func() {
+		for fs := range batches {
+			stream.Add(fs)
+		}
+		close(done)
+	}()
+	ign, err := w.WalkChan(batches)
+	<-done
+	if err == nil {
+		stream.Finish()
+	}
+	return stream, ign, err
+}
+
+// localFiles returns the named files from the local index.
+func (m *Model) localFiles(repo string, names []string) []scanner.File {
+	m.rmut.RLock()
+	rf := m.repoFiles[repo]
+	m.rmut.RUnlock()
+	fs := make([]scanner.File, len(names))
+	for i, name := range names {
+		fs[i] = rf.Get(cid.LocalID, name)
+	}
+	return fs
+}
+
 func (m *Model) SaveIndexes(dir string) {
+	if err := writeIndexVersion(dir); err != nil {
+		l.Infof("Saving index version: %v", err)
+	}
+
+	peerIDs := m.repoPeerIndexIDs()
+
 	m.rmut.RLock()
 	for repo := range m.repoCfgs {
 		fs := m.protocolIndex(repo)
@@ -721,23 +1554,54 @@ func (m *Model) SaveIndexes(dir string) {
 		if err != nil {
 			l.Infof("Saving index for %q: %v", repo, err)
 		}
+		err = m.repoMtimes[repo].save(m.mtimesFile(repo, dir))
+		if err != nil {
+			l.Infof("Saving modification times for %q: %v", repo, err)
+		}
+		err = savePeerIndexIDs(m.peerIndexIDsFile(repo, dir), peerIDs[repo])
+		if err != nil {
+			l.Infof("Saving index IDs of other nodes for %q: %v", repo, err)
+		}
 	}
 	m.rmut.RUnlock()
 }
 
 func (m *Model) LoadIndexes(dir string) {
+	usable := migrateIndexes(dir)
+
 	m.rmut.RLock()
+	var ids = make(map[string]uint64, len(m.repoCfgs))
+	var peerIDs = make(map[string]map[string]uint64, len(m.repoCfgs))
 	for repo := range m.repoCfgs {
-		fs := m.loadIndex(repo, dir)
+		peerIDs[repo] = loadPeerIndexIDs(m.peerIndexIDsFile(repo, dir))
+		var fs []protocol.FileInfo
+		if usable {
+			fs = m.loadIndex(repo, dir)
+			m.repoMtimes[repo].load(m.mtimesFile(repo, dir))
+			m.retries[repo].load(m.retriesFile(repo, dir))
+		}
+		ids[repo] = m.loadIndexID(repo, dir, fs != nil)
 		m.SeedLocal(repo, fs)
 	}
 	m.rmut.RUnlock()
+
+	m.rmut.Lock()
+	for repo, id := range ids {
+		m.indexIDs[repo] = id
+	}
+	m.rmut.Unlock()
+
+	m.setPeerIndexIDs(peerIDs)
 }
 
 func (m *Model) saveIndex(repo string, dir string, fs []protocol.FileInfo) error {
 	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
-	name := id + ".idx.gz"
-	name = filepath.Join(dir, name)
+	return writeIndexFile(filepath.Join(dir, id+".idx.gz"), repo, fs)
+}
+
+// writeIndexFile writes the files to a gzipped index file, replacing the
+// named file once it is complete.
+func writeIndexFile(name string, repo string, fs []protocol.FileInfo) error {
 	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
 	idxf, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
 	if err != nil {
@@ -774,11 +1638,21 @@ func (m *Model) saveIndex(repo string, dir string, fs []protocol.FileInfo) error
 	return osutil.Rename(tmp, name)
 }
 
+// mtimesFile returns the name of the file holding the modification time
+// mappings for the repo, next to the index.
+func (m *Model) mtimesFile(repo string, dir string) string {
+	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
+	return filepath.Join(dir, id+".idx.mtimes")
+}
+
 func (m *Model) loadIndex(repo string, dir string) []protocol.FileInfo {
 	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
-	name := id + ".idx.gz"
-	name = filepath.Join(dir, name)
+	return readIndexFile(filepath.Join(dir, id+".idx.gz"), repo)
+}
 
+// readIndexFile returns the files in the gzipped index file, or nil if it
+// cannot be read or is for another repository.
+func readIndexFile(name string, repo string) []protocol.FileInfo {
 	idxf, err := os.Open(name)
 	if err != nil {
 		return nil
@@ -805,19 +1679,31 @@ func (m *Model) clusterConfig(node string) protocol.ClusterConfigMessage {
 	cm := protocol.ClusterConfigMessage{
 		ClientName:    m.clientName,
 		ClientVersion: m.clientVersion,
+		Options: []protocol.Option{
+			{Key: protocol.OptionIndexDigest, Value: "1"},
+			{Key: protocol.OptionBlockGroups, Value: "1"},
+			{Key: protocol.OptionVectorRequests, Value: "1"},
+			{Key: protocol.OptionDownloadProgress, Value: "1"},
+			{Key: protocol.OptionVersionVectors, Value: "1"},
+			{Key: protocol.OptionResponseErrors, Value: "1"},
+			{Key: protocol.OptionModifiedNs, Value: "1"},
+			{Key: protocol.OptionACLs, Value: "1"},
+		},
 	}
 
 	m.rmut.RLock()
+	nodeCfgs := m.cfg.NodeMap()
 	for _, repo := range m.nodeRepos[node] {
 		cr := protocol.Repository{
-			ID: repo,
+			ID:      repo,
+			IndexID: m.indexIDs[repo],
+		}
+		if m.repoCfgs[repo].ReadOnly {
+			cr.Flags |= protocol.FlagRepoReadOnly
 		}
 		for _, node := range m.repoNodes[repo] {
 			// TODO: Set read only bit when relevant
-			cr.Nodes = append(cr.Nodes, protocol.Node{
-				ID:    node,
-				Flags: protocol.FlagShareTrusted,
-			})
+			cr.Nodes = append(cr.Nodes, clusterConfigNode(node, nodeCfgs[node]))
 		}
 		cm.Repositories = append(cm.Repositories, cr)
 	}
@@ -828,26 +1714,40 @@ func (m *Model) clusterConfig(node string) protocol.ClusterConfigMessage {
 
 func (m *Model) setState(repo string, state repoState) {
 	m.smut.Lock()
+	old, ok := m.repoState[repo]
 	m.repoState[repo] = state
 	m.smut.Unlock()
+
+	if !ok || old != state {
+		events.Default.Log(events.StateChanged, map[string]string{
+			"repo": repo,
+			"from": old.String(),
+			"to":   state.String(),
+		})
+	}
 }
 
 func (m *Model) State(repo string) string {
+	if m.RepoPaused(repo) {
+		return "paused"
+	}
+
 	m.smut.RLock()
 	state := m.repoState[repo]
 	m.smut.RUnlock()
-	switch state {
-	case RepoIdle:
-		return "idle"
-	case RepoScanning:
-		return "scanning"
-	case RepoCleaning:
-		return "cleaning"
-	case RepoSyncing:
-		return "syncing"
-	default:
-		return "unknown"
+	return state.String()
+}
+
+// ScanProgress returns the progress of the scan currently running in the
+// repo, if any.
+func (m *Model) ScanProgress(repo string) (scanner.ProgressStatus, bool) {
+	m.smut.RLock()
+	p, ok := m.scanProgress[repo]
+	m.smut.RUnlock()
+	if !ok {
+		return scanner.ProgressStatus{}, false
 	}
+	return p.Status(), true
 }
 
 func (m *Model) Override(repo string) {
@@ -858,6 +1758,7 @@ func (m *Model) Override(repo string) {
 	for i := range fs {
 		f := &fs[i]
 		h := r.Get(cid.LocalID, f.Name)
+		vector := f.Vector.Merge(h.Vector)
 		if h.Name != f.Name {
 			// We are missing the file
 			f.Flags |= protocol.FlagDeleted
@@ -867,6 +1768,7 @@ func (m *Model) Override(repo string) {
 			*f = h
 		}
 		f.Version = lamport.Default.Tick(f.Version)
+		f.Vector = vector.Update(m.vectorID)
 	}
 	m.rmut.Unlock()
 
@@ -6,13 +6,23 @@ package model
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	rtdebug "runtime/debug"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/calmh/syncthing/cid"
 	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/events"
+	"github.com/calmh/syncthing/files"
+	"github.com/calmh/syncthing/fs"
 	"github.com/calmh/syncthing/protocol"
 	"github.com/calmh/syncthing/scanner"
 )
@@ -73,6 +83,351 @@ func TestRequest(t *testing.T) {
 	}
 }
 
+func TestRequestReadError(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/foo")
+	fd.Write([]byte("foobar"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	if bs, err := m.Request("some node", "default", "foo", 0, 6); err != nil || string(bs) != "foobar" {
+		t.Fatalf("Unexpected request result %q, %v", bs, err)
+	}
+
+	f.InjectError(fs.OpRead, "repo/foo", syscall.EIO)
+	if bs, err := m.Request("some node", "default", "foo", 0, 6); err == nil || bs != nil {
+		t.Errorf("Unexpected request result %q, %v", bs, err)
+	}
+}
+
+func TestRequestErrors(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/foo")
+	fd.Write([]byte("foobar"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	if _, err := m.Request("some node", "nonexistent", "foo", 0, 6); err != protocol.ErrNoSuchFile {
+		t.Errorf("Unexpected error %v for nonexistent repo", err)
+	}
+	if _, err := m.Request("some node", "default", "foo", 7, 6); err != protocol.ErrInvalidOffset {
+		t.Errorf("Unexpected error %v for offset beyond the end", err)
+	}
+
+	// The file changing after the scan is temporary
+	fd, _ = f.Create("repo/foo")
+	fd.Write([]byte("foo"))
+	fd.Close()
+	if _, err := m.Request("some node", "default", "foo", 0, 6); err != protocol.ErrRescanning {
+		t.Errorf("Unexpected error %v for truncated file", err)
+	}
+	f.Remove("repo/foo")
+	if _, err := m.Request("some node", "default", "foo", 0, 6); err != protocol.ErrRescanning {
+		t.Errorf("Unexpected error %v for removed file", err)
+	}
+}
+
+func TestCloseBackoff(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+
+	m.Close("node1", &protocol.CloseError{Reason: protocol.CloseShutdown})
+	if m.BackingOff("node1") {
+		t.Error("Should not back off after a temporary close reason")
+	}
+
+	m.Close("node2", &protocol.CloseError{Reason: protocol.CloseConfigMismatch})
+	if !m.BackingOff("node2") {
+		t.Error("Should back off after a lasting close reason")
+	}
+}
+
+func TestIntroducer(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes: []config.NodeConfiguration{
+			{NodeID: "node0"},
+			{NodeID: "intro", Introducer: true},
+		},
+		Repositories: []config.RepositoryConfiguration{
+			{ID: "default", Directory: "default", Nodes: []config.NodeConfiguration{{NodeID: "node0"}, {NodeID: "intro"}}},
+			{ID: "other", Directory: "other", Nodes: []config.NodeConfiguration{{NodeID: "node0"}}},
+		},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.fs = fs.NewFakeFilesystem()
+	m.SetNodeID("node0")
+	for _, repo := range cfg.Repositories {
+		m.fs.MkdirAll(repo.Directory, 0755)
+		m.AddRepo(repo)
+	}
+
+	m.ClusterConfig("intro", protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{
+			{
+				ID: "default",
+				Nodes: []protocol.Node{
+					{ID: "node0", Flags: protocol.FlagShareTrusted},
+					{ID: "intro", Flags: protocol.FlagShareTrusted},
+					{ID: "node3", Name: "three", Addresses: []string{"192.0.2.3:22000"}, Flags: protocol.FlagShareTrusted},
+				},
+			},
+			{
+				// Not shared with the introducer, so nothing is taken from it
+				ID:    "other",
+				Nodes: []protocol.Node{{ID: "node4", Flags: protocol.FlagShareTrusted}},
+			},
+		},
+	})
+
+	select {
+	case <-m.ConfigChanged():
+	default:
+		t.Error("Configuration change not signalled")
+	}
+
+	newCfg := m.Configuration()
+	nodes := newCfg.NodeMap()
+	// The name the introducer announces is not taken as ours
+	if n, ok := nodes["node3"]; !ok || n.Name != "" || !reflect.DeepEqual(n.Addresses, []string{"192.0.2.3:22000"}) || n.Introducer {
+		t.Errorf("Incorrect introduced node %+v", n)
+	}
+	if _, ok := nodes["node4"]; ok {
+		t.Error("Node introduced in a repository not shared with the introducer")
+	}
+	if ids := m.Configuration().Repositories[0].NodeIDs(); !reflect.DeepEqual(ids, []string{"node0", "intro", "node3"}) {
+		t.Errorf("Incorrect configured nodes %v", ids)
+	}
+	if !m.repoSharedWith("default", "node3") {
+		t.Error("Repository not shared with the introduced node")
+	}
+
+	// Hearing of the same nodes again changes nothing
+	m.ClusterConfig("intro", protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{
+			{
+				ID:    "default",
+				Nodes: []protocol.Node{{ID: "node3", Flags: protocol.FlagShareTrusted}},
+			},
+		},
+	})
+	select {
+	case <-m.ConfigChanged():
+		t.Error("Unexpected configuration change")
+	default:
+	}
+	if nodes := m.Configuration().Nodes; len(nodes) != 3 {
+		t.Errorf("Incorrect number of nodes %d", len(nodes))
+	}
+}
+
+func TestIgnorePermsDetection(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permissions are not probed on Windows")
+	}
+
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("good", 0755)
+	f.MkdirAll("bad", 0755)
+	f.InjectError(fs.OpChmod, defTempNamer.TempName("bad/permcheck"), syscall.EPERM)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "good", Directory: "good"})
+	m.AddRepo(config.RepositoryConfiguration{ID: "bad", Directory: "bad"})
+
+	if m.repoCfgs["good"].IgnorePerms {
+		t.Error("Unexpected IgnorePerms for a file system that keeps permissions")
+	}
+	if !m.repoCfgs["bad"].IgnorePerms {
+		t.Error("Expected IgnorePerms for a file system that loses permissions")
+	}
+	if _, err := f.Lstat(defTempNamer.TempName("bad/permcheck")); !os.IsNotExist(err) {
+		t.Error("Probe file was not removed:", err)
+	}
+}
+
+func TestRemoveDirIgnored(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	for _, n := range []string{"repo/a/.DS_Store", "repo/a/b/.DS_Store", "repo/c/.DS_Store", "repo/c/keep"} {
+		f.MkdirAll(filepath.Dir(n), 0755)
+		fd, _ := f.Create(n)
+		fd.Close()
+	}
+	fd, _ := f.Create("repo/.stignore")
+	fd.Write([]byte(".DS_Store\n"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true})
+	m.ScanRepo("default")
+
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f}
+
+	if err := p.removeDir(filepath.Join("a", "b")); err != nil {
+		t.Error(err)
+	}
+	if err := p.removeDir("a"); err != nil {
+		t.Error(err)
+	}
+	if _, err := f.Lstat("repo/a"); !os.IsNotExist(err) {
+		t.Error("Directory with only ignored files was not removed:", err)
+	}
+
+	if err := p.removeDir("c"); err != errDirNotEmpty {
+		t.Errorf("Unexpected error %v removing directory with files", err)
+	}
+	if _, err := f.Lstat("repo/c/.DS_Store"); err != nil {
+		t.Error("Ignored file removed from directory that is kept:", err)
+	}
+}
+
+func TestDeletedDirWithLocalFiles(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	for _, n := range []string{"repo/a/gone", "repo/c/keep"} {
+		f.MkdirAll(filepath.Dir(n), 0755)
+		fd, _ := f.Create(n)
+		fd.Close()
+	}
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true})
+	m.ScanRepo("default")
+
+	// The other node deleted a and c, but never saw c/keep
+	var deleted []scanner.File
+	for _, n := range []string{"a", "a/gone", "c"} {
+		cur := m.CurrentRepoFile("default", filepath.FromSlash(n))
+		cur.Flags |= protocol.FlagDeleted
+		cur.Version = 1 << 40
+		cur.Blocks = nil
+		deleted = append(deleted, cur)
+	}
+	m.repoFiles["default"].Replace(m.cm.Get("other"), deleted)
+	f.Remove("repo/a/gone")
+	m.updateLocal("default", deleted[1])
+
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f, mtimes: m.repoMtimes["default"], deleteFailed: make(map[string]bool)}
+	p.fixupDirectories()
+
+	if _, err := f.Lstat("repo/a"); !os.IsNotExist(err) {
+		t.Error("Empty deleted directory was not removed:", err)
+	}
+	if _, err := f.Lstat("repo/c/keep"); err != nil {
+		t.Error("Local file in deleted directory was removed:", err)
+	}
+	if need := m.NeedFilesRepo("default"); len(need) != 0 {
+		t.Errorf("Unexpected need %v; the directory with local files is kept", need)
+	}
+	if cur := m.CurrentRepoFile("default", "c"); protocol.IsDeleted(cur.Flags) || cur.Version <= 1<<40 {
+		t.Errorf("Kept directory not announced with a new version: %v", cur)
+	}
+}
+
+func TestBrowseRepo(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	for _, n := range []string{"repo/a/b/c", "repo/a/d", "repo/e"} {
+		f.MkdirAll(filepath.Dir(n), 0755)
+		fd, _ := f.Create(n)
+		fd.Close()
+	}
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	var tests = []struct {
+		dir   string
+		names []string
+	}{
+		{"", []string{"a", "e"}},
+		{"a", []string{filepath.Join("a", "b"), filepath.Join("a", "d")}},
+		{"a/b/", []string{filepath.Join("a", "b", "c")}},
+		{"e", nil},
+	}
+	for _, tc := range tests {
+		var names []string
+		for _, f := range m.BrowseRepo("default", tc.dir) {
+			names = append(names, f.Name)
+		}
+		if !reflect.DeepEqual(names, tc.names) {
+			t.Errorf("BrowseRepo(%q) = %v != %v", tc.dir, names, tc.names)
+		}
+	}
+}
+
+func TestScanVectors(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/a")
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.SetNodeID("node1")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	id := protocol.VectorID("node1")
+	if v := m.CurrentRepoFile("default", "a").Vector; !reflect.DeepEqual(v, protocol.Vector{{ID: id, Value: 1}}) {
+		t.Errorf("Incorrect vector after scan; %v", v)
+	}
+
+	// A change counts on top of the previous version
+	f.Chtimes("repo/a", time.Now(), time.Now().Add(time.Hour))
+	m.ScanRepo("default")
+	if v := m.CurrentRepoFile("default", "a").Vector; !reflect.DeepEqual(v, protocol.Vector{{ID: id, Value: 2}}) {
+		t.Errorf("Incorrect vector after change; %v", v)
+	}
+
+	// And so does a deletion
+	f.Remove("repo/a")
+	m.ScanRepo("default")
+	if v := m.CurrentRepoFile("default", "a").Vector; !reflect.DeepEqual(v, protocol.Vector{{ID: id, Value: 3}}) {
+		t.Errorf("Incorrect vector after delete; %v", v)
+	}
+}
+
+func TestIgnoreStamps(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/sub", 0755)
+	fd, _ := f.Create("repo/sub/.stignore")
+	fd.Write([]byte("foo\n"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+
+	s0 := m.ignoreStamps("default")
+	if len(s0) != 2 || s0[filepath.Join("repo", ".stignore")].exists || !s0[filepath.Join("repo", "sub", ".stignore")].exists {
+		t.Fatalf("Unexpected stamps %v", s0)
+	}
+
+	fd, _ = f.Create("repo/.stignore")
+	fd.Close()
+	f.Chtimes("repo/sub/.stignore", time.Now(), time.Now().Add(time.Second))
+
+	s1 := m.ignoreStamps("default")
+	for name := range s0 {
+		if s0[name] == s1[name] {
+			t.Errorf("Change to %q not detected", name)
+		}
+	}
+}
+
 func genFiles(n int) []protocol.FileInfo {
 	files := make([]protocol.FileInfo, n)
 	t := time.Now().Unix()
@@ -157,10 +512,6 @@ type FakeConnection struct {
 	requestData []byte
 }
 
-func (FakeConnection) Close() error {
-	return nil
-}
-
 func (f FakeConnection) ID() string {
 	return string(f.id)
 }
@@ -175,8 +526,30 @@ func (f FakeConnection) Request(repo, name string, offset int64, size int) ([]by
 	return f.requestData, nil
 }
 
+func (f FakeConnection) RequestAsync(repo, name string, offset int64, size int) <-chan protocol.Response {
+	res := make(chan protocol.Response, 1)
+	res <- protocol.Response{Data: f.requestData}
+	return res
+}
+
 func (FakeConnection) ClusterConfig(protocol.ClusterConfigMessage) {}
 
+func (FakeConnection) RequestBlocks(string, string, int64, int) ([]protocol.BlockInfo, error) {
+	return nil, protocol.ErrNoBlockList
+}
+
+func (f FakeConnection) RequestVector(repo, name string, ranges []protocol.Range) ([][]byte, error) {
+	data := make([][]byte, len(ranges))
+	for i := range ranges {
+		data[i] = f.requestData
+	}
+	return data, nil
+}
+
+func (FakeConnection) IndexDigest(string, []protocol.FileInfo) {}
+
+func (FakeConnection) DownloadProgress(string, []protocol.FileDownloadProgress) {}
+
 func (FakeConnection) Ping() bool {
 	return true
 }
@@ -185,6 +558,8 @@ func (FakeConnection) Statistics() protocol.Statistics {
 	return protocol.Statistics{}
 }
 
+func (FakeConnection) Close(protocol.CloseReason, string) {}
+
 func BenchmarkRequest(b *testing.B) {
 	m := NewModel("/tmp", nil, "syncthing", "dev")
 	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata"})
@@ -205,7 +580,7 @@ func BenchmarkRequest(b *testing.B) {
 		id:          "42",
 		requestData: []byte("some data to return"),
 	}
-	m.AddConnection(fc, fc)
+	m.AddConnection(ioutil.NopCloser(nil), fc)
 	m.Index("42", "default", files)
 
 	b.ResetTimer()
@@ -232,16 +607,390 @@ func TestActivityMap(t *testing.T) {
 	}
 
 	m := make(activityMap)
-	if node := m.leastBusyNode(1<<fooID, cm); node != "foo" {
+	if node := m.leastBusyNode(files.NewBitset(fooID), cm); node != "foo" {
 		t.Errorf("Incorrect least busy node %q", node)
 	}
-	if node := m.leastBusyNode(1<<barID, cm); node != "bar" {
+	if node := m.leastBusyNode(files.NewBitset(barID), cm); node != "bar" {
 		t.Errorf("Incorrect least busy node %q", node)
 	}
-	if node := m.leastBusyNode(1<<fooID|1<<barID, cm); node != "foo" {
+	if node := m.leastBusyNode(files.NewBitset(fooID, barID), cm); node != "foo" {
 		t.Errorf("Incorrect least busy node %q", node)
 	}
-	if node := m.leastBusyNode(1<<fooID|1<<barID, cm); node != "bar" {
+	if node := m.leastBusyNode(files.NewBitset(fooID, barID), cm); node != "bar" {
 		t.Errorf("Incorrect least busy node %q", node)
 	}
 }
+
+func TestFastestNode(t *testing.T) {
+	cm := cid.NewMap()
+	fooID := cm.Get("foo")
+	barID := cm.Get("bar")
+	both := files.NewBitset(fooID, barID)
+
+	// Without measurements, the least busy node is selected
+	s := newNodeStats()
+	m := make(activityMap)
+	if node := m.fastestNode(both, cm, s, 0); node != "foo" {
+		t.Errorf("Incorrect fastest node %q", node)
+	}
+	if node := m.fastestNode(both, cm, s, 0); node != "bar" {
+		t.Errorf("Incorrect fastest node %q", node)
+	}
+
+	// A node ten times as fast gets most, but not all, requests
+	s.record("foo", 10e6, time.Second, nil)
+	s.record("bar", 1e6, time.Second, nil)
+	m = make(activityMap)
+	for i := 0; i < 100; i++ {
+		m.fastestNode(both, cm, s, 0)
+	}
+	if m["foo"] < 85 || m["bar"] < 5 {
+		t.Errorf("Unexpected distribution %v", m)
+	}
+
+	// Failures slow a node down
+	s.record("foo", 0, time.Second, errors.New("failed"))
+	s.record("foo", 0, time.Second, errors.New("failed"))
+	s.record("foo", 0, time.Second, errors.New("failed"))
+	s.record("foo", 0, time.Second, errors.New("failed"))
+	m = make(activityMap)
+	if node := m.fastestNode(both, cm, s, 0); node != "bar" {
+		t.Errorf("Incorrect fastest node %q after failures", node)
+	}
+
+	// A node at the limit is passed over, even if it is the fastest
+	s = newNodeStats()
+	s.record("foo", 10e6, time.Second, nil)
+	m = activityMap{"foo": 2}
+	if node := m.fastestNode(both, cm, s, 2); node != "bar" {
+		t.Errorf("Incorrect fastest node %q with foo at the limit", node)
+	}
+	if node := m.fastestNode(files.NewBitset(fooID), cm, s, 2); node != "" {
+		t.Errorf("Node %q selected with all at the limit", node)
+	}
+	if !m.atLimit(files.NewBitset(fooID), cm, 2) || m.atLimit(files.NewBitset(barID), cm, 2) {
+		t.Error("Incorrect atLimit")
+	}
+}
+
+func TestRequestBlockWaits(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata"})
+	p := &puller{
+		repoCfg:           m.repoCfgs["default"],
+		model:             m,
+		fs:                fs.DefaultFilesystem,
+		oustandingPerNode: activityMap{"foo": 1},
+		openFiles:         make(map[string]openFile),
+		requestResults:    make(chan requestResult),
+		maxPerNode:        1,
+	}
+
+	f := scanner.File{Name: "foo", Blocks: []scanner.Block{{Size: 7}}}
+	p.openFiles["foo"] = openFile{availability: files.NewBitset(m.cm.Get("foo"))}
+	b := bqBlock{file: f, block: f.Blocks[0], last: true}
+
+	// The only node with the block is at the limit
+	if p.requestBlock(b) {
+		t.Fatal("Block handled with the node at the limit")
+	}
+	if len(p.waiting) != 1 || p.openFiles["foo"].outstanding != 1 {
+		t.Fatalf("Block not waiting; %d waiting, %d outstanding", len(p.waiting), p.openFiles["foo"].outstanding)
+	}
+
+	// When the node has a request less outstanding the block is requested
+	p.oustandingPerNode.decrease("foo")
+	p.retryWaiting()
+	if len(p.waiting) != 0 || p.openFiles["foo"].outstanding != 1 {
+		t.Fatalf("Block not requested; %d waiting, %d outstanding", len(p.waiting), p.openFiles["foo"].outstanding)
+	}
+	select {
+	case res := <-p.requestResults:
+		if res.node != "foo" || res.offset != 0 {
+			t.Errorf("Unexpected request result %+v", res)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Block was not requested")
+	}
+}
+
+func TestPullOutOfOrder(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), scanner.StandardBlockSize)
+	blocks, _ := scanner.Blocks(bytes.NewReader(append(data, data...)), scanner.StandardBlockSize)
+
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true, Nodes: []config.NodeConfiguration{{NodeID: "other"}}})
+	m.ReplaceLocal("default", nil)
+	m.AddConnection(ioutil.NopCloser(nil), FakeConnection{id: "other", requestData: data})
+	gf := scanner.File{Name: "f", Flags: 0644, Version: 1, Size: int64(2 * len(data)), Blocks: blocks}
+	m.Index("other", "default", []protocol.FileInfo{fileInfoFromFile(gf)})
+
+	p := &puller{
+		repoCfg:           m.repoCfgs["default"],
+		model:             m,
+		fs:                f,
+		mtimes:            m.repoMtimes["default"],
+		oustandingPerNode: make(activityMap),
+		openFiles:         make(map[string]openFile),
+		requestResults:    make(chan requestResult, 2),
+	}
+
+	// The temporary file has its final size from the start
+	p.handleBlock(bqBlock{file: gf, block: blocks[0], first: true})
+	info, err := f.Stat(p.openFiles["f"].temp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != gf.Size {
+		t.Errorf("Temporary file not preallocated; size %d", info.Size())
+	}
+	p.handleBlock(bqBlock{file: gf, block: blocks[1], last: true})
+
+	// The blocks are written as they come, the last one first
+	res := []requestResult{<-p.requestResults, <-p.requestResults}
+	if res[0].offset == 0 {
+		res[0], res[1] = res[1], res[0]
+	}
+	for _, r := range res {
+		p.handleRequestResult(r)
+	}
+
+	if lf := m.CurrentRepoFile("default", "f"); lf.Version != gf.Version {
+		t.Errorf("File not pulled: %v", lf)
+	}
+	if bs, _ := fs.ReadFile(f, "repo/f"); !bytes.Equal(bs, append(data, data...)) {
+		t.Errorf("Incorrect contents, %d bytes", len(bs))
+	}
+}
+
+func TestMemoryGovernorCaches(t *testing.T) {
+	var shrunk, restored int
+	g := newMemoryGovernor(100, func() { shrunk++ }, func() { restored++ })
+	defer rtdebug.SetGCPercent(rtdebug.SetGCPercent(100))
+
+	var tests = []struct {
+		usedMiB     uint64
+		constrained bool
+		shrunk      int
+		restored    int
+	}{
+		{50, false, 0, 0},
+		{85, true, 1, 0},  // approaching the limit
+		{70, true, 1, 0},  // not yet low enough to release
+		{110, true, 2, 0}, // above the limit, again
+		{50, false, 2, 1},
+	}
+	for i, tc := range tests {
+		g.check(tc.usedMiB << 20)
+		if g.isConstrained() != tc.constrained || shrunk != tc.shrunk || restored != tc.restored {
+			t.Errorf("%d: %d MiB used: constrained %v, shrunk %d, restored %d", i, tc.usedMiB, g.isConstrained(), shrunk, restored)
+		}
+	}
+}
+
+type indexCountingConnection struct {
+	FakeConnection
+	sizes []int
+}
+
+func (c *indexCountingConnection) Index(repo string, fs []protocol.FileInfo) {
+	c.sizes = append(c.sizes, len(fs))
+}
+
+func TestSendIndexConstrained(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	idx := genFiles(2500)
+
+	c := &indexCountingConnection{}
+	m.sendIndex(c, "default", idx)
+	if len(c.sizes) != 1 || c.sizes[0] != 2500 {
+		t.Errorf("unconstrained index should be sent in one message, not %v", c.sizes)
+	}
+
+	m.mem = newMemoryGovernor(1, nil, nil)
+	m.mem.constrained = 1
+
+	c = &indexCountingConnection{}
+	m.sendIndex(c, "default", idx)
+	if len(c.sizes) != 3 || c.sizes[0] != 1000 || c.sizes[1] != 1000 || c.sizes[2] != 500 {
+		t.Errorf("constrained index should be sent in batches, not %v", c.sizes)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata"})
+
+	if c := m.Completion("default", ""); c != 100 {
+		t.Errorf("Empty repository should be complete, not %d%%", c)
+	}
+
+	blocks := []scanner.Block{{Size: 1000, Hash: make([]byte, 32)}}
+	m.repoFiles["default"].Replace(cid.LocalID, []scanner.File{
+		{Name: "a", Version: 1, Size: 1000, Blocks: blocks},
+	})
+	m.repoFiles["default"].Replace(m.cm.Get("other"), []scanner.File{
+		{Name: "a", Version: 1, Size: 1000, Blocks: blocks},
+		{Name: "b", Version: 1, Size: 1000, Blocks: blocks},
+		{Name: "c", Version: 1, Size: 1000, Blocks: blocks},
+		{Name: "d", Version: 1, Size: 1000, Blocks: blocks},
+	})
+
+	if c := m.Completion("default", ""); c != 25 {
+		t.Errorf("Unexpected local completion %d%%", c)
+	}
+	if c := m.Completion("default", "other"); c != 100 {
+		t.Errorf("Unexpected completion %d%% for other node", c)
+	}
+	if c := m.Completion("nonexistent", ""); c != 0 {
+		t.Errorf("Unexpected completion %d%% for nonexistent repository", c)
+	}
+}
+
+func TestStateEvents(t *testing.T) {
+	sub := events.Default.Subscribe(events.StateChanged)
+	defer events.Default.Unsubscribe(sub)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.setState("default", RepoScanning)
+	m.setState("default", RepoScanning)
+	m.setState("default", RepoIdle)
+
+	for _, to := range []string{"scanning", "idle"} {
+		e, err := sub.Poll(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data := e.Data.(map[string]string); data["repo"] != "default" || data["to"] != to {
+			t.Errorf("Unexpected event %v; expected change to %s", e, to)
+		}
+	}
+	if e, err := sub.Poll(10 * time.Millisecond); err == nil {
+		t.Errorf("Unexpected event %v for unchanged state", e)
+	}
+}
+
+func TestGroupedIndex(t *testing.T) {
+	var blocks = make([]protocol.BlockInfo, blockGroupThreshold+1)
+	for i := range blocks {
+		blocks[i] = protocol.BlockInfo{Size: 128 << 10, Hash: []byte{byte(i)}}
+	}
+	idx := []protocol.FileInfo{
+		{Name: "small", Blocks: blocks[:10]},
+		{Name: "large", Blocks: blocks},
+	}
+
+	grouped := groupedIndex(idx)
+	if len(grouped[0].Blocks) != 10 || protocol.HasBlockGroups(grouped[0].Flags) {
+		t.Error("Small file should not be grouped")
+	}
+	if l := len(grouped[1].Blocks); l != blockGroupThreshold/scanner.BlocksPerGroup+1 || !protocol.HasBlockGroups(grouped[1].Flags) {
+		t.Errorf("Large file should be grouped; got %d blocks, flags %x", l, grouped[1].Flags)
+	}
+	if len(idx[1].Blocks) != len(blocks) || protocol.HasBlockGroups(idx[1].Flags) {
+		t.Error("Original index was modified")
+	}
+}
+
+func TestBlockList(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "node"}}})
+
+	var blocks = make([]scanner.Block, scanner.BlocksPerGroup+10)
+	for i := range blocks {
+		blocks[i] = scanner.Block{Offset: int64(i) * 10, Size: 10, Hash: []byte{byte(i)}}
+	}
+	m.ReplaceLocal("default", []scanner.File{{Name: "large", Version: 1, Blocks: blocks}})
+
+	groups := scanner.BlockGroups(blocks)
+	bl, err := m.BlockList("node", "default", "large", groups[1].Offset, int(groups[1].Size))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bl) != 10 || !bytes.Equal(bl[0].Hash, blocks[scanner.BlocksPerGroup].Hash) {
+		t.Errorf("Unexpected block list %v", bl)
+	}
+
+	if _, err := m.BlockList("other", "default", "large", 0, int(groups[0].Size)); err == nil {
+		t.Error("Unexpected block list for unshared node")
+	}
+}
+
+type indexRecordingConnection struct {
+	FakeConnection
+	idx chan []protocol.FileInfo
+}
+
+func (c *indexRecordingConnection) Index(repo string, fs []protocol.FileInfo) {
+	c.idx <- fs
+}
+
+func TestSendIndexACLs(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	idx := []protocol.FileInfo{{Name: "a", Version: 1, ACL: []byte("acl")}}
+
+	for _, tc := range []struct {
+		opts map[string]string
+		acl  bool
+	}{
+		{nil, false},
+		{map[string]string{protocol.OptionACLs: "1"}, true},
+	} {
+		m.pmut.Lock()
+		m.nodeOpts["other"] = tc.opts
+		m.pmut.Unlock()
+
+		c := &indexRecordingConnection{FakeConnection: FakeConnection{id: "other"}, idx: make(chan []protocol.FileInfo, 1)}
+		m.sendIndex(c, "default", idx)
+		if sent := <-c.idx; len(sent) != 1 || (len(sent[0].ACL) > 0) != tc.acl {
+			t.Errorf("Options %v: unexpected index %v", tc.opts, sent)
+		}
+	}
+	if len(idx[0].ACL) == 0 {
+		t.Error("ACL removed from the local index")
+	}
+}
+
+func TestInitialIndexAfterClusterConfig(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes:        []config.NodeConfiguration{{NodeID: "other"}},
+		Repositories: []config.RepositoryConfiguration{{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "other"}}}},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.SetNodeID("local")
+	m.AddRepo(cfg.Repositories[0])
+	m.repoFiles["default"].Replace(cid.LocalID, []scanner.File{
+		{Name: "a", Version: 1, Vector: protocol.Vector{{ID: 1, Value: 1}}},
+	})
+
+	c := &indexRecordingConnection{FakeConnection: FakeConnection{id: "other"}, idx: make(chan []protocol.FileInfo, 1)}
+	m.AddConnection(ioutil.NopCloser(nil), c)
+	select {
+	case <-c.idx:
+		t.Fatal("Index sent before the cluster config was received")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cm := m.clusterConfig("other")
+	cm.Options = append(cm.Options, protocol.Option{Key: protocol.OptionVersionVectors, Value: "1"})
+	m.ClusterConfig("other", cm)
+	select {
+	case idx := <-c.idx:
+		if len(idx) != 1 || len(idx[0].Vector) == 0 {
+			t.Errorf("Unexpected initial index %v", idx)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No initial index sent")
+	}
+
+	// A repeated cluster config does not resend it
+	m.ClusterConfig("other", cm)
+	select {
+	case <-c.idx:
+		t.Error("Initial index sent twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/osutil"
+	"github.com/calmh/syncthing/xdr"
+)
+
+// An mtimeStore remembers the files whose modification time could not be
+// set exactly as requested, for example on file systems with a coarse
+// timestamp resolution such as FAT. For those files the store maps the
+// modification time found on disk back to the one that was requested, so
+// that the scanner doesn't mistake the difference for a local change.
+type mtimeStore struct {
+	mtimes map[string]mtimeMapping
+	mut    sync.Mutex
+}
+
+type mtimeMapping struct {
+	wanted time.Time // as in the index
+	actual time.Time // as read back from disk
+}
+
+func newMtimeStore() *mtimeStore {
+	return &mtimeStore{
+		mtimes: make(map[string]mtimeMapping),
+	}
+}
+
+// setMtime sets the modification time of the file at path, known in the
+// repository as name, and records the result. The time is read back to find
+// out what the file system made of it.
+//
+// The time is set to the nanosecond, as far as the file system can
+// represent it; files from nodes that do not send the sub-second part of
+// modification times get whole seconds. Where the file system rounds the
+// time, the rounded time is mapped back to the one wanted.
+func (s *mtimeStore) setMtime(fsys fs.Filesystem, name, path string, modified int64, modifiedNs int32) error {
+	t := time.Unix(modified, int64(modifiedNs))
+	if err := fsys.Chtimes(path, t, t); err != nil {
+		return err
+	}
+
+	fi, err := fsys.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if actual := fi.ModTime(); actual.Equal(t) {
+		delete(s.mtimes, name)
+	} else {
+		if debug {
+			l.Debugf("mtime: %q: wanted %v, got %v", name, t, actual)
+		}
+		s.mtimes[name] = mtimeMapping{t, actual}
+	}
+	return nil
+}
+
+// Mtime returns the modification time to use for the named file, given the
+// modification time found on disk. This implements scanner.MtimeMapper.
+func (s *mtimeStore) Mtime(name string, ondisk time.Time) time.Time {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if m, ok := s.mtimes[name]; ok {
+		if m.actual.Equal(ondisk) {
+			return m.wanted
+		}
+		// The file has been changed since we set the time
+		delete(s.mtimes, name)
+	}
+	return ondisk
+}
+
+func (s *mtimeStore) save(name string) error {
+	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
+	fd, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	s.mut.Lock()
+	xw := xdr.NewWriter(fd)
+	xw.WriteUint32(uint32(len(s.mtimes)))
+	for n, m := range s.mtimes {
+		xw.WriteString(n)
+		xw.WriteUint64(uint64(m.wanted.UnixNano()))
+		xw.WriteUint64(uint64(m.actual.UnixNano()))
+	}
+	s.mut.Unlock()
+
+	if err := xw.Error(); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return osutil.Rename(tmp, name)
+}
+
+func (s *mtimeStore) load(name string) error {
+	fd, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	mtimes := make(map[string]mtimeMapping)
+	xr := xdr.NewReader(fd)
+	n := int(xr.ReadUint32())
+	for i := 0; i < n && xr.Error() == nil; i++ {
+		name := xr.ReadStringMax(1024)
+		wanted := time.Unix(0, int64(xr.ReadUint64()))
+		actual := time.Unix(0, int64(xr.ReadUint64()))
+		mtimes[name] = mtimeMapping{wanted, actual}
+	}
+	if err := xr.Error(); err != nil {
+		return err
+	}
+
+	s.mut.Lock()
+	s.mtimes = mtimes
+	s.mut.Unlock()
+	return nil
+}
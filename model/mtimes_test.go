@@ -0,0 +1,102 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+)
+
+// fatFilesystem rounds modification times down to an even number of
+// seconds, like FAT does.
+type fatFilesystem struct {
+	*fs.FakeFilesystem
+}
+
+func (f fatFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return f.FakeFilesystem.Chtimes(name, atime, time.Unix(mtime.Unix()&^1, 0))
+}
+
+func TestMtimeStore(t *testing.T) {
+	f := fatFilesystem{fs.NewFakeFilesystem()}
+	fd, _ := f.Create("even")
+	fd.Close()
+	fd, _ = f.Create("odd")
+	fd.Close()
+
+	fd, _ = f.Create("nanos")
+	fd.Close()
+
+	s := newMtimeStore()
+	if err := s.setMtime(f, "even", "even", 1000, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.setMtime(f, "odd", "odd", 1001, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.setMtime(f, "nanos", "nanos", 1000, 500); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.mtimes) != 2 {
+		t.Errorf("Unexpected mappings %v", s.mtimes)
+	}
+	if mt := s.Mtime("even", time.Unix(1000, 0)); !mt.Equal(time.Unix(1000, 0)) {
+		t.Errorf("Incorrect mtime %v for exactly set file", mt)
+	}
+	if mt := s.Mtime("odd", time.Unix(1000, 0)); !mt.Equal(time.Unix(1001, 0)) {
+		t.Errorf("Incorrect mtime %v for rounded file", mt)
+	}
+	if mt := s.Mtime("nanos", time.Unix(1000, 0)); !mt.Equal(time.Unix(1000, 500)) {
+		t.Errorf("Incorrect mtime %v for file rounded to the second", mt)
+	}
+
+	dir, err := ioutil.TempDir("", "mtimes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "mtimes")
+	if err := s.save(name); err != nil {
+		t.Fatal(err)
+	}
+	s2 := newMtimeStore()
+	if err := s2.load(name); err != nil {
+		t.Fatal(err)
+	}
+	if mt := s2.Mtime("nanos", time.Unix(1000, 0)); !mt.Equal(time.Unix(1000, 500)) {
+		t.Errorf("Incorrect mtime %v after load", mt)
+	}
+
+	// A file changed since is no longer mapped
+	if mt := s.Mtime("odd", time.Unix(2000, 0)); !mt.Equal(time.Unix(2000, 0)) {
+		t.Errorf("Incorrect mtime %v for changed file", mt)
+	}
+	if len(s.mtimes) != 1 {
+		t.Errorf("Unexpected mappings %v", s.mtimes)
+	}
+}
+
+func TestMtimeStoreNanoseconds(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	fd, _ := f.Create("file")
+	fd.Close()
+
+	s := newMtimeStore()
+	if err := s.setMtime(f, "file", "file", 1000, 123456789); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := f.Stat("file"); !info.ModTime().Equal(time.Unix(1000, 123456789)) {
+		t.Errorf("Incorrect mtime %v set", info.ModTime())
+	}
+	if len(s.mtimes) != 0 {
+		t.Errorf("Unexpected mappings %v", s.mtimes)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/cid"
+	"github.com/calmh/syncthing/files"
+)
+
+// The weight of the latest sample in the moving average of a node's rate.
+const nodeRateWeight = 0.2
+
+// nodeStats keeps a moving average of the rate at which each node answers
+// our block requests, in bytes per second. As the time for a request
+// includes the round trip, the rate reflects both the latency and the
+// throughput of the connection.
+type nodeStats struct {
+	rates map[string]float64
+	mut   sync.Mutex
+}
+
+func newNodeStats() *nodeStats {
+	return &nodeStats{
+		rates: make(map[string]float64),
+	}
+}
+
+// record adds the result of a request to the node that returned the given
+// number of bytes after d. A failed request counts as a very slow one.
+func (s *nodeStats) record(node string, bytes int, d time.Duration, err error) {
+	if d <= 0 {
+		d = time.Microsecond
+	}
+	rate := float64(bytes) / d.Seconds()
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	cur, ok := s.rates[node]
+	switch {
+	case err != nil && ok:
+		s.rates[node] = cur / 2
+	case err != nil:
+		return
+	case !ok:
+		s.rates[node] = rate
+	default:
+		s.rates[node] = (1-nodeRateWeight)*cur + nodeRateWeight*rate
+	}
+	if debug {
+		l.Debugf("node %s: rate %.0f B/s", node, s.rates[node])
+	}
+}
+
+// forget removes what is known about the node, for example when it has
+// disconnected.
+func (s *nodeStats) forget(node string) {
+	s.mut.Lock()
+	delete(s.rates, node)
+	s.mut.Unlock()
+}
+
+// fastestNode selects the node, among those in the availability bitset, that
+// is expected to answer a new request the soonest, given the number of
+// requests already outstanding to each node and the rate at which each node
+// answers. Nodes that have not been measured yet are assumed to be as fast as
+// the fastest known node, so that they are tried. When no rates are known,
+// this is the least busy node. Nodes with limit requests outstanding are
+// not selected, unless limit is zero.
+func (m activityMap) fastestNode(availability files.Bitset, cm *cid.Map, stats *nodeStats, limit int) string {
+	stats.mut.Lock()
+	defer stats.mut.Unlock()
+
+	var best float64
+	for _, rate := range stats.rates {
+		if rate > best {
+			best = rate
+		}
+	}
+	if best == 0 {
+		best = 1
+	}
+
+	var low float64
+	var selected string
+	for _, node := range cm.Names() {
+		id := cm.Get(node)
+		if id == cid.LocalID || !availability.Has(id) {
+			continue
+		}
+		if limit > 0 && m[node] >= limit {
+			continue
+		}
+		rate, ok := stats.rates[node]
+		if !ok || rate <= 0 {
+			rate = best
+		}
+		// The time until a request queued now would be answered
+		cost := float64(m[node]+1) / rate
+		if selected == "" || cost < low || cost == low && m[node] < m[selected] {
+			low = cost
+			selected = node
+		}
+	}
+	if selected != "" {
+		m[selected]++
+	}
+	return selected
+}
+
+// atLimit returns true if a node in the availability bitset has limit
+// requests outstanding, so that it may be selected later.
+func (m activityMap) atLimit(availability files.Bitset, cm *cid.Map, limit int) bool {
+	if limit <= 0 {
+		return false
+	}
+	for _, node := range cm.Names() {
+		id := cm.Get(node)
+		if id != cid.LocalID && availability.Has(id) && m[node] >= limit {
+			return true
+		}
+	}
+	return false
+}
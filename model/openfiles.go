@@ -0,0 +1,33 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+// When the limit on open files is derived from the OS limit, this many files
+// are left for the connections, indexes, configuration and such, but never
+// more than half of them.
+const reservedOpenFiles = 128
+
+// openFilesLimit returns the number of repository files that the scanners,
+// pullers and requests together may have open at the same time, or zero for
+// no limit. A configured limit above zero is used as is and one below zero
+// means no limit. Otherwise the limit is derived from the OS limit, if
+// there is one.
+//
+// The pullers keep files open while waiting for their blocks, so the limit
+// should be well above the number of parallel requests of all repositories.
+func openFilesLimit(configured, osLimit int) int {
+	switch {
+	case configured > 0:
+		return configured
+	case configured < 0 || osLimit <= 0:
+		return 0
+	}
+
+	limit := osLimit - reservedOpenFiles
+	if limit < osLimit/2 {
+		limit = osLimit / 2
+	}
+	return limit
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestOpenFilesLimit(t *testing.T) {
+	var tests = []struct {
+		configured, osLimit, limit int
+	}{
+		{100, 0, 100},
+		{100, 4096, 100},
+		{-1, 4096, 0},
+		{0, 0, 0},
+		{0, 4096, 4096 - reservedOpenFiles},
+		{0, 256, 128},
+		{0, 100, 50},
+	}
+
+	for i, tc := range tests {
+		if limit := openFilesLimit(tc.configured, tc.osLimit); limit != tc.limit {
+			t.Errorf("Incorrect limit #%d; E: %d, A: %d", i, tc.limit, limit)
+		}
+	}
+}
+
+func TestPullAtOpenFilesLimit(t *testing.T) {
+	data := []byte("contents of the block")
+	blocks, _ := scanner.Blocks(bytes.NewReader(data), scanner.StandardBlockSize)
+
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/src")
+	fd.Write(data)
+	fd.Close()
+	lf := fs.NewLimitedFilesystem(f, 1)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = lf
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true, Nodes: []config.NodeConfiguration{{NodeID: "other"}}})
+	m.ReplaceLocal("default", []scanner.File{{Name: "src", Flags: 0644, Version: 1, Size: int64(len(data)), Blocks: blocks}})
+	m.AddConnection(ioutil.NopCloser(nil), FakeConnection{id: "other", requestData: data})
+	gf := scanner.File{Name: "dst", Flags: 0644, Version: 1, Size: int64(len(data)), Blocks: blocks}
+	m.Index("other", "default", []protocol.FileInfo{fileInfoFromFile(gf)})
+
+	p := &puller{
+		repoCfg:           m.repoCfgs["default"],
+		model:             m,
+		fs:                lf,
+		mtimes:            m.repoMtimes["default"],
+		oustandingPerNode: make(activityMap),
+		openFiles:         make(map[string]openFile),
+		requestResults:    make(chan requestResult, 1),
+	}
+
+	// The temporary file takes the only slot, so the block that "src" has
+	// is requested from the other node instead of waiting for the slot.
+	handled := make(chan bool)
+	go func() {
+		handled <- p.handleBlock(bqBlock{file: gf, block: blocks[0], first: true, last: true})
+	}()
+	select {
+	case done := <-handled:
+		if done {
+			t.Fatal("Block not requested")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Puller waits for the open files limit")
+	}
+	p.handleRequestResult(<-p.requestResults)
+
+	if local := m.CurrentRepoFile("default", "dst"); local.Version != gf.Version {
+		t.Errorf("File not pulled: %v", local)
+	}
+	if bs, _ := fs.ReadFile(f, "repo/dst"); !bytes.Equal(bs, data) {
+		t.Errorf("Incorrect contents %q", bs)
+	}
+	if open, _ := lf.Stats(); open != 0 {
+		t.Errorf("%d files left open", open)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+
+	"github.com/calmh/syncthing/protocol"
+)
+
+var errNodePaused = errors.New("node paused")
+
+// PauseRepo stops scanning and pulling the repository, and sending its index
+// to other nodes, until it is resumed. The configuration and the index are
+// kept. Index updates from other nodes are still recorded, so that what has
+// changed meanwhile is known when the repository is resumed. A scan in
+// progress is stopped, and the blocks queued to be pulled are dropped; once
+// PauseRepo returns nothing more is written to the repository.
+func (m *Model) PauseRepo(repo string) {
+	if !m.setRepoPaused(repo, true) {
+		return
+	}
+	m.cancelScans(repo)
+
+	m.rmut.RLock()
+	p := m.pullers[repo]
+	m.rmut.RUnlock()
+	if p != nil {
+		p.drop()
+	}
+	l.Infof("Paused repository %q", repo)
+}
+
+// ResumeRepo undoes PauseRepo. The repository is rescanned, and the changes
+// made to the local index while it was paused are sent to the connected
+// nodes.
+func (m *Model) ResumeRepo(repo string) {
+	if !m.setRepoPaused(repo, false) {
+		return
+	}
+	l.Infof("Resumed repository %q", repo)
+
+	m.rmut.RLock()
+	select {
+	case m.resumed[repo] <- struct{}{}:
+	default:
+	}
+	nodes := m.repoNodes[repo]
+	m.rmut.RUnlock()
+
+	for _, nodeID := range nodes {
+		go m.ResendIndex(nodeID, repo)
+	}
+}
+
+// RepoPaused returns true if the repository is paused.
+func (m *Model) RepoPaused(repo string) bool {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.repoCfgs[repo].Paused
+}
+
+// repoResumed returns a channel that is signalled when the repository is
+// resumed, so that it can be rescanned right away.
+func (m *Model) repoResumed(repo string) <-chan struct{} {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.resumed[repo]
+}
+
+// setRepoPaused pauses or resumes the repository, in the running model and
+// in the configuration, which is then to be saved. It returns false if the
+// repository does not exist or was already in that state.
+func (m *Model) setRepoPaused(repo string, paused bool) bool {
+	m.rmut.Lock()
+	cfg, ok := m.repoCfgs[repo]
+	if !ok || cfg.Paused == paused {
+		m.rmut.Unlock()
+		return false
+	}
+	cfg.Paused = paused
+	m.repoCfgs[repo] = cfg
+	for i := range m.cfg.Repositories {
+		if m.cfg.Repositories[i].ID == repo {
+			m.cfg.Repositories[i].Paused = paused
+		}
+	}
+	m.rmut.Unlock()
+
+	select {
+	case m.configChanged <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// PauseNode closes the connection to the node, if any, and keeps it from
+// being connected to until it is resumed. The node stays in the
+// configuration, which is then to be saved.
+func (m *Model) PauseNode(node string) {
+	if m.setNodePaused(node, true) {
+		l.Infof("Paused node %s", m.nodeName(node))
+	}
+
+	m.pmut.RLock()
+	conn, ok := m.protoConn[node]
+	m.pmut.RUnlock()
+	if ok {
+		conn.Close(protocol.ClosePaused, "")
+		m.Close(node, errNodePaused)
+	}
+}
+
+// ResumeNode undoes PauseNode. The node is connected to again as usual.
+func (m *Model) ResumeNode(node string) {
+	if m.setNodePaused(node, false) {
+		l.Infof("Resumed node %s", m.nodeName(node))
+	}
+}
+
+// NodePaused returns true if the node is paused, and connections to and
+// from it are not to be made.
+func (m *Model) NodePaused(node string) bool {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	for _, nc := range m.cfg.Nodes {
+		if nc.NodeID == node {
+			return nc.Paused
+		}
+	}
+	return false
+}
+
+// setNodePaused pauses or resumes the node in the configuration, which is
+// then to be saved. It returns false if the node does not exist or was
+// already in that state.
+func (m *Model) setNodePaused(node string, paused bool) bool {
+	changed := false
+	m.rmut.Lock()
+	for i := range m.cfg.Nodes {
+		if m.cfg.Nodes[i].NodeID == node && m.cfg.Nodes[i].Paused != paused {
+			m.cfg.Nodes[i].Paused = paused
+			changed = true
+		}
+	}
+	m.rmut.Unlock()
+
+	if changed {
+		select {
+		case m.configChanged <- struct{}{}:
+		default:
+		}
+	}
+	return changed
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestPauseRepo(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+
+	cfg := &config.Configuration{
+		Repositories: []config.RepositoryConfiguration{{ID: "default", Directory: "repo", IgnorePerms: true}},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(cfg.Repositories[0])
+
+	m.PauseRepo("default")
+	if !m.RepoPaused("default") || !m.Configuration().Repositories[0].Paused {
+		t.Fatal("Repository not paused")
+	}
+	if s := m.State("default"); s != "paused" {
+		t.Errorf("Unexpected state %q", s)
+	}
+	select {
+	case <-m.ConfigChanged():
+	default:
+		t.Error("Configuration change not signalled")
+	}
+
+	fd, _ := f.Create("repo/a")
+	fd.Write([]byte("new file"))
+	fd.Close()
+	if err := m.ScanRepo("default"); err != nil {
+		t.Fatal(err)
+	}
+	if lf := m.CurrentRepoFile("default", "a"); lf.Name != "" {
+		t.Errorf("Paused repository was scanned: %v", lf)
+	}
+
+	m.ResumeRepo("default")
+	if m.RepoPaused("default") || m.Configuration().Repositories[0].Paused {
+		t.Fatal("Repository not resumed")
+	}
+	select {
+	case <-m.repoResumed("default"):
+	default:
+		t.Error("Resume not signalled")
+	}
+	if err := m.ScanRepo("default"); err != nil {
+		t.Fatal(err)
+	}
+	if lf := m.CurrentRepoFile("default", "a"); lf.Name != "a" {
+		t.Error("Resumed repository was not scanned")
+	}
+}
+
+func TestPauseRepoStopsWrites(t *testing.T) {
+	data := []byte("contents of the block")
+	blocks, _ := scanner.Blocks(bytes.NewReader(data), scanner.StandardBlockSize)
+
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+
+	cfg := &config.Configuration{
+		Repositories: []config.RepositoryConfiguration{{ID: "default", Directory: "repo", IgnorePerms: true, Nodes: []config.NodeConfiguration{{NodeID: "other"}}}},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(cfg.Repositories[0])
+	m.AddConnection(ioutil.NopCloser(nil), FakeConnection{id: "other", requestData: data})
+	a := scanner.File{Name: "a", Flags: 0644, Version: 1, Size: int64(len(data)), Blocks: blocks}
+	b := scanner.File{Name: "b", Flags: 0644, Version: 1, Size: int64(len(data)), Blocks: blocks}
+	m.Index("other", "default", []protocol.FileInfo{fileInfoFromFile(a), fileInfoFromFile(b)})
+
+	p := &puller{
+		repoCfg:           m.repoCfgs["default"],
+		bq:                newBlockQueue(),
+		model:             m,
+		fs:                f,
+		mtimes:            m.repoMtimes["default"],
+		oustandingPerNode: make(activityMap),
+		openFiles:         make(map[string]openFile),
+		requestSlots:      make(chan bool, 2),
+		requestResults:    make(chan requestResult, 1),
+	}
+	m.pullers["default"] = p
+
+	// "a" is being pulled and "b" is queued when the repository is paused
+	if p.handleBlock(bqBlock{file: a, block: blocks[0], first: true, last: true}) {
+		t.Fatal("Block not requested")
+	}
+	p.bq.put(bqAdd{file: b, need: blocks})
+	m.PauseRepo("default")
+
+	p.mut.Lock()
+	p.handleRequestResult(<-p.requestResults)
+	p.mut.Unlock()
+	if p.lockUnpaused() {
+		t.Error("Puller not stopped by the pause")
+	}
+
+	for _, name := range []string{"a", defTempNamer.TempName("a"), "b", defTempNamer.TempName("b")} {
+		if _, err := f.Stat(filepath.Join("repo", name)); err == nil {
+			t.Errorf("%q written after the pause", name)
+		}
+	}
+	if !p.bq.empty() || len(p.openFiles) != 0 {
+		t.Errorf("Pull not dropped; %d blocks queued, %d files open", p.bq.len(), len(p.openFiles))
+	}
+	if lf := m.CurrentRepoFile("default", "a"); lf.Name != "" {
+		t.Errorf("File pulled while paused: %v", lf)
+	}
+}
+
+func TestPauseNode(t *testing.T) {
+	cfg := &config.Configuration{
+		Nodes: []config.NodeConfiguration{{NodeID: "other"}},
+	}
+	m := NewModel("/tmp", cfg, "syncthing", "dev")
+	m.AddConnection(ioutil.NopCloser(nil), FakeConnection{id: "other"})
+
+	m.PauseNode("other")
+	if !m.NodePaused("other") || !m.Configuration().Nodes[0].Paused {
+		t.Fatal("Node not paused")
+	}
+	if m.ConnectedTo("other") {
+		t.Error("Paused node still connected")
+	}
+
+	m.ResumeNode("other")
+	if m.NodePaused("other") || m.Configuration().Nodes[0].Paused {
+		t.Error("Node not resumed")
+	}
+	if m.NodePaused("unknown") {
+		t.Error("Unknown node paused")
+	}
+}
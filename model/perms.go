@@ -0,0 +1,47 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/calmh/syncthing/fs"
+)
+
+// preservesPermissions reports whether the file system holding dir keeps the
+// permission bits we set, by creating a temporary file and changing its mode
+// back and forth. File systems such as FAT and some network mounts silently
+// ignore or override the bits. When the probe cannot be performed at all (the
+// directory is missing or not writeable) we assume that permissions work.
+func preservesPermissions(fsys fs.Filesystem, dir string) bool {
+	if runtime.GOOS == "windows" {
+		// Permission bits are never synced from Windows anyway.
+		return true
+	}
+
+	name := defTempNamer.TempName(filepath.Join(dir, "permcheck"))
+	fd, err := fsys.Create(name)
+	if err != nil {
+		return true
+	}
+	fd.Close()
+	defer fsys.Remove(name)
+
+	for _, mode := range []os.FileMode{0600, 0755} {
+		if err := fsys.Chmod(name, mode); err != nil {
+			return false
+		}
+		fi, err := fsys.Stat(name)
+		if err != nil {
+			return true
+		}
+		if fi.Mode()&os.ModePerm != mode {
+			return false
+		}
+	}
+	return true
+}
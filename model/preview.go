@@ -0,0 +1,162 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// The actions the puller takes on a needed file.
+const (
+	PreviewCreate    = "create"    // the file or directory does not exist locally
+	PreviewOverwrite = "overwrite" // the local file is replaced
+	PreviewMetadata  = "metadata"  // only the modification time, permissions and such change
+	PreviewDelete    = "delete"    // the local file or directory is removed
+	PreviewRename    = "rename"    // a local file that is to be deleted is moved here instead
+	PreviewConflict  = "conflict"  // the local file was changed too and is kept as a conflict copy
+)
+
+// A PreviewAction describes what the next pull will do to a file.
+type PreviewAction struct {
+	Name    string
+	Action  string
+	Dir     bool
+	From    string // the local file that is renamed
+	Bytes   int64  // to fetch from other nodes
+	Reused  int64  // taken from the current local version, or the renamed file
+	Archive bool   // the current local version is kept by the versioner
+}
+
+// A Preview lists the actions of the next pull in a repository, with totals.
+type Preview struct {
+	Actions   []PreviewAction
+	Create    int
+	Overwrite int
+	Metadata  int
+	Delete    int
+	Rename    int
+	Conflict  int
+	Bytes     int64
+	Reused    int64
+}
+
+// Preview returns what the next pull of the repository would do, as far as
+// can be told from the index, without touching the disk. Needed files that
+// would only be recorded in the index, such as deletes of files that do not
+// exist locally, are not listed. A file that is deleted and moved to a new
+// name is listed once, as the rename.
+func (m *Model) Preview(repo string) Preview {
+	m.rmut.RLock()
+	versioning := m.repoCfgs[repo].Versioning.Type != ""
+	m.rmut.RUnlock()
+
+	need := m.NeedFilesRepo(repo)
+
+	// Renames the same way the puller finds them
+	renamedFrom := make(map[string]string) // new name -> local file
+	renamed := make(map[string]bool)       // local files that are moved
+	if sources := m.renameSources(repo, need); !sources.empty() {
+		for _, f := range need {
+			if !m.isRenameTarget(repo, f) {
+				continue
+			}
+			for _, src := range sources.candidates(f) {
+				if !renamed[src.local.Name] {
+					renamed[src.local.Name] = true
+					renamedFrom[f.Name] = src.local.Name
+					break
+				}
+			}
+		}
+	}
+
+	var p Preview
+	for _, f := range need {
+		lf := m.CurrentRepoFile(repo, f.Name)
+		exists := lf.Name != "" && !protocol.IsDeleted(lf.Flags) && !lf.IsIgnored()
+		a := PreviewAction{
+			Name: f.Name,
+			Dir:  protocol.IsDirectory(f.Flags),
+		}
+
+		switch {
+		case renamed[f.Name]:
+			continue
+
+		case renamedFrom[f.Name] != "":
+			a.Action = PreviewRename
+			a.From = renamedFrom[f.Name]
+			a.Reused = f.Size
+			a.Archive = versioning // copied rather than moved
+			p.Rename++
+
+		case exists && !a.Dir && isConflict(lf, f):
+			a.Action = PreviewConflict
+			if !protocol.IsDeleted(f.Flags) {
+				a.Bytes, a.Reused = previewBytes(lf, f, exists)
+			}
+			p.Conflict++
+
+		case protocol.IsDeleted(f.Flags):
+			if !exists {
+				continue
+			}
+			a.Action = PreviewDelete
+			a.Archive = versioning && !a.Dir
+			p.Delete++
+
+		case a.Dir:
+			if exists {
+				a.Action = PreviewMetadata
+				p.Metadata++
+			} else {
+				a.Action = PreviewCreate
+				p.Create++
+			}
+
+		default:
+			a.Bytes, a.Reused = previewBytes(lf, f, exists)
+			switch {
+			case !exists:
+				a.Action = PreviewCreate
+				p.Create++
+			case a.Bytes == 0 && a.Reused == f.Size && lf.Size == f.Size:
+				a.Action = PreviewMetadata
+				p.Metadata++
+			default:
+				a.Action = PreviewOverwrite
+				a.Archive = versioning
+				p.Overwrite++
+			}
+		}
+
+		p.Bytes += a.Bytes
+		p.Reused += a.Reused
+		p.Actions = append(p.Actions, a)
+	}
+	return p
+}
+
+// previewBytes returns the number of bytes of the global file that are to be
+// fetched and that can be reused from the local one.
+func previewBytes(lf, f scanner.File, exists bool) (fetch, reuse int64) {
+	if protocol.HasBlockGroups(f.Flags) {
+		// The block list is not known until the pull expands it.
+		return f.Size, 0
+	}
+	var local []scanner.Block
+	if exists && !protocol.HasBlockGroups(lf.Flags) {
+		local = lf.Blocks
+	}
+	have, need := scanner.BlockDiff(local, f.Blocks)
+	for _, b := range need {
+		fetch += int64(b.Size)
+	}
+	for _, b := range have {
+		reuse += int64(b.Size)
+	}
+	return
+}
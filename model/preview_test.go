@@ -0,0 +1,108 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestPreview(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{
+		ID:         "default",
+		Directory:  "testdata",
+		Nodes:      []config.NodeConfiguration{{NodeID: "node"}},
+		Versioning: config.VersioningConfiguration{Type: "simple"},
+	})
+
+	b1 := scanner.Block{Offset: 0, Size: 10, Hash: []byte{1}}
+	b2 := scanner.Block{Offset: 10, Size: 5, Hash: []byte{2}}
+	b3 := scanner.Block{Offset: 10, Size: 5, Hash: []byte{3}}
+	m.ReplaceLocal("default", []scanner.File{
+		{Name: "changed", Version: 1, Size: 15, Blocks: []scanner.Block{b1, b2}},
+		{Name: "touched", Version: 1, Size: 15, Blocks: []scanner.Block{b1, b2}},
+		{Name: "removed", Version: 1, Size: 10, Blocks: []scanner.Block{b1}},
+		{Name: "dir", Version: 1, Flags: protocol.FlagDirectory},
+	})
+
+	bi := func(bs ...scanner.Block) []protocol.BlockInfo {
+		var res []protocol.BlockInfo
+		for _, b := range bs {
+			res = append(res, protocol.BlockInfo{Size: b.Size, Hash: b.Hash})
+		}
+		return res
+	}
+	m.Index("node", "default", []protocol.FileInfo{
+		{Name: "changed", Version: 2, Blocks: bi(b1, b3)},
+		{Name: "touched", Version: 2, Modified: 1, Blocks: bi(b1, b2)},
+		{Name: "removed", Version: 2, Flags: protocol.FlagDeleted},
+		{Name: "new", Version: 2, Blocks: bi(b3)},
+		{Name: "gone", Version: 2, Flags: protocol.FlagDeleted},
+		{Name: "dir", Version: 2, Flags: protocol.FlagDirectory | 0755},
+	})
+
+	p := m.Preview("default")
+	if p.Create != 1 || p.Overwrite != 1 || p.Metadata != 2 || p.Delete != 1 {
+		t.Errorf("Unexpected counts %+v", p)
+	}
+	if p.Bytes != 10 || p.Reused != 25 {
+		t.Errorf("Unexpected bytes %d, reused %d", p.Bytes, p.Reused)
+	}
+
+	var expected = map[string]PreviewAction{
+		"changed": {Name: "changed", Action: PreviewOverwrite, Bytes: 5, Reused: 10, Archive: true},
+		"touched": {Name: "touched", Action: PreviewMetadata, Reused: 15},
+		"removed": {Name: "removed", Action: PreviewDelete, Archive: true},
+		"new":     {Name: "new", Action: PreviewCreate, Bytes: 5},
+		"dir":     {Name: "dir", Action: PreviewMetadata, Dir: true},
+	}
+	if len(p.Actions) != len(expected) {
+		t.Errorf("Unexpected actions %+v", p.Actions)
+	}
+	for _, a := range p.Actions {
+		if e := expected[a.Name]; a != e {
+			t.Errorf("Unexpected action %+v, expected %+v", a, e)
+		}
+	}
+}
+
+func TestPreviewRenameConflict(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata", Nodes: []config.NodeConfiguration{{NodeID: "node"}}})
+
+	b1 := scanner.Block{Offset: 0, Size: 10, Hash: []byte{1}}
+	m.ReplaceLocal("default", []scanner.File{
+		{Name: "old", Version: 1, Size: 10, Blocks: []scanner.Block{b1}, Vector: protocol.Vector{{ID: 1, Value: 1}}},
+		{Name: "edited", Version: 3, Size: 10, Blocks: []scanner.Block{b1}, Vector: protocol.Vector{{ID: 1, Value: 2}}},
+	})
+
+	m.Index("node", "default", []protocol.FileInfo{
+		{Name: "old", Version: 2, Flags: protocol.FlagDeleted, Vector: protocol.Vector{{ID: 1, Value: 1}, {ID: 2, Value: 1}}},
+		{Name: "new", Version: 2, Blocks: []protocol.BlockInfo{{Size: 10, Hash: []byte{1}}}},
+		{Name: "edited", Version: 4, Blocks: []protocol.BlockInfo{{Size: 10, Hash: []byte{2}}}, Vector: protocol.Vector{{ID: 1, Value: 1}, {ID: 2, Value: 1}}},
+	})
+
+	p := m.Preview("default")
+	if p.Rename != 1 || p.Conflict != 1 || p.Delete != 0 || p.Create != 0 {
+		t.Errorf("Unexpected counts %+v", p)
+	}
+
+	var expected = map[string]PreviewAction{
+		"new":    {Name: "new", Action: PreviewRename, From: "old", Reused: 10},
+		"edited": {Name: "edited", Action: PreviewConflict, Bytes: 10},
+	}
+	if len(p.Actions) != len(expected) {
+		t.Errorf("Unexpected actions %+v", p.Actions)
+	}
+	for _, a := range p.Actions {
+		if e := expected[a.Name]; a != e {
+			t.Errorf("Unexpected action %+v, expected %+v", a, e)
+		}
+	}
+}
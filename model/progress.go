@@ -0,0 +1,264 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// Download Progress messages are sent at most this often, when something
+// has changed.
+const progressInterval = 5 * time.Second
+
+// downloadProgress keeps track of the blocks we have of the files being
+// pulled, to tell the other nodes about, and of the blocks the other nodes
+// told us they have of the files they are pulling. Nodes pulling the same
+// large file can so fetch blocks from each other, instead of all from the
+// nodes that have the whole file.
+type downloadProgress struct {
+	local   map[string]map[string]*localDownload            // repo -> name -> download
+	changed map[string]bool                                 // repo -> local downloads changed since last sent
+	remote  map[string]map[string]map[string]remoteDownload // node -> repo -> name -> download
+	told    map[string]map[string]bool                      // node -> repo -> told about the downloads since connecting
+	mut     sync.Mutex
+}
+
+type localDownload struct {
+	version uint64
+	temp    string
+	blocks  []uint32 // indexes of the blocks written, in the order written
+	have    map[uint32]bool
+}
+
+type remoteDownload struct {
+	version uint64
+	have    map[uint32]bool
+}
+
+func newDownloadProgress() *downloadProgress {
+	return &downloadProgress{
+		local:   make(map[string]map[string]*localDownload),
+		changed: make(map[string]bool),
+		remote:  make(map[string]map[string]map[string]remoteDownload),
+		told:    make(map[string]map[string]bool),
+	}
+}
+
+// blockIndex returns the index of the block at the offset. All blocks but
+// the last are of the standard size.
+func blockIndex(offset int64) uint32 {
+	return uint32(offset / scanner.StandardBlockSize)
+}
+
+// started records that the version of the file is being pulled into the
+// temporary file.
+func (p *downloadProgress) started(repo, name string, version uint64, temp string) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if p.local[repo] == nil {
+		p.local[repo] = make(map[string]*localDownload)
+	}
+	p.local[repo][name] = &localDownload{
+		version: version,
+		temp:    temp,
+		have:    make(map[uint32]bool),
+	}
+}
+
+// gotBlock records that the block at the offset has been written to the
+// temporary file.
+func (p *downloadProgress) gotBlock(repo, name string, offset int64) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	d, ok := p.local[repo][name]
+	if !ok {
+		return
+	}
+	if i := blockIndex(offset); !d.have[i] {
+		d.have[i] = true
+		d.blocks = append(d.blocks, i)
+		p.changed[repo] = true
+	}
+}
+
+// finished records that the file is no longer being pulled, whether it
+// succeeded or not.
+func (p *downloadProgress) finished(repo, name string) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if d, ok := p.local[repo][name]; ok {
+		delete(p.local[repo], name)
+		if len(d.blocks) > 0 {
+			p.changed[repo] = true
+		}
+	}
+}
+
+// localBlock returns the temporary file holding the block at the offset,
+// if the file is being pulled and we have the block.
+func (p *downloadProgress) localBlock(repo, name string, offset int64) (string, bool) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	d, ok := p.local[repo][name]
+	if !ok || !d.have[blockIndex(offset)] {
+		return "", false
+	}
+	return d.temp, true
+}
+
+// takeChanged returns the repositories whose downloads changed since the
+// last call.
+func (p *downloadProgress) takeChanged() map[string]bool {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	changed := p.changed
+	p.changed = make(map[string]bool)
+	return changed
+}
+
+// files returns the Download Progress message contents for the repository.
+// Files of which we have no blocks yet are left out.
+func (p *downloadProgress) files(repo string) []protocol.FileDownloadProgress {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	var names []string
+	for name, d := range p.local[repo] {
+		if len(d.blocks) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var files []protocol.FileDownloadProgress
+	for _, name := range names {
+		d := p.local[repo][name]
+		files = append(files, protocol.FileDownloadProgress{
+			Name:    name,
+			Version: d.version,
+			Blocks:  append([]uint32(nil), d.blocks...),
+		})
+	}
+	return files
+}
+
+// setRemote replaces what we know of the node's downloads in the
+// repository.
+func (p *downloadProgress) setRemote(node, repo string, files []protocol.FileDownloadProgress) {
+	rds := make(map[string]remoteDownload, len(files))
+	for _, f := range files {
+		rd := remoteDownload{
+			version: f.Version,
+			have:    make(map[uint32]bool, len(f.Blocks)),
+		}
+		for _, i := range f.Blocks {
+			rd.have[i] = true
+		}
+		rds[f.Name] = rd
+	}
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if p.remote[node] == nil {
+		p.remote[node] = make(map[string]map[string]remoteDownload)
+	}
+	p.remote[node][repo] = rds
+}
+
+// forgetNode drops what we know of the node's downloads, and what it knows
+// of ours, when it disconnects.
+func (p *downloadProgress) forgetNode(node string) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	delete(p.remote, node)
+	delete(p.told, node)
+}
+
+// tell returns true if the node should be sent our downloads in the
+// repository, because they changed or the node has not been told since it
+// connected, and records that it has been told.
+func (p *downloadProgress) tell(node, repo string, changed bool) bool {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if !changed && p.told[node][repo] {
+		return false
+	}
+	if p.told[node] == nil {
+		p.told[node] = make(map[string]bool)
+	}
+	p.told[node][repo] = true
+	return true
+}
+
+// nodesWithBlock returns the nodes that told us they have the block at the
+// offset of the version of the file they are pulling.
+func (p *downloadProgress) nodesWithBlock(repo, name string, version uint64, offset int64) []string {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	i := blockIndex(offset)
+	var nodes []string
+	for node, repos := range p.remote {
+		if rd, ok := repos[repo][name]; ok && rd.version == version && rd.have[i] {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// DownloadProgress records which blocks of the files it is pulling the
+// node has. Implements the protocol.DownloadProgressReceiver interface.
+func (m *Model) DownloadProgress(nodeID, repo string, files []protocol.FileDownloadProgress) {
+	if !m.repoSharedWith(repo, nodeID) {
+		if debug {
+			l.Debugf("%s: download progress for unshared repository %q", nodeID, repo)
+		}
+		return
+	}
+	m.progress.setRemote(nodeID, repo, files)
+}
+
+// sendDownloadProgressLoop sends our download progress to the connected
+// nodes that understand it, when it has changed. Nodes that have not been
+// told about a repository since they connected are told at the next
+// interval, unless there is nothing to tell.
+func (m *Model) sendDownloadProgressLoop() {
+	for {
+		select {
+		case <-time.After(progressInterval):
+		case <-m.stop:
+			return
+		}
+
+		changed := m.progress.takeChanged()
+
+		m.rmut.RLock()
+		repoNodes := make(map[string][]string, len(m.repoNodes))
+		for repo, nodes := range m.repoNodes {
+			repoNodes[repo] = nodes
+		}
+		m.rmut.RUnlock()
+
+		for repo, nodes := range repoNodes {
+			files := m.progress.files(repo)
+			for _, node := range nodes {
+				m.pmut.RLock()
+				conn, ok := m.protoConn[node]
+				supported := m.nodeOpts[node][protocol.OptionDownloadProgress] != ""
+				m.pmut.RUnlock()
+
+				if !ok || !supported {
+					continue
+				}
+				if m.progress.tell(node, repo, changed[repo]) && (len(files) > 0 || changed[repo]) {
+					conn.DownloadProgress(repo, files)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestDownloadProgressLocal(t *testing.T) {
+	p := newDownloadProgress()
+	const bs = scanner.StandardBlockSize
+
+	p.started("default", "b", 42, "/tmp/.syncthing.b")
+	p.started("default", "a", 43, "/tmp/.syncthing.a")
+	if changed := p.takeChanged(); len(changed) != 0 {
+		t.Errorf("Starting a file changed %v", changed)
+	}
+	if files := p.files("default"); len(files) != 0 {
+		t.Errorf("Files without blocks announced: %v", files)
+	}
+
+	p.gotBlock("default", "b", 3*bs)
+	p.gotBlock("default", "b", 0)
+	p.gotBlock("default", "b", 3*bs)
+	p.gotBlock("default", "a", bs)
+	p.gotBlock("default", "unknown", 0)
+
+	if changed := p.takeChanged(); !reflect.DeepEqual(changed, map[string]bool{"default": true}) {
+		t.Errorf("Unexpected changes %v", changed)
+	}
+	expected := []protocol.FileDownloadProgress{
+		{Name: "a", Version: 43, Blocks: []uint32{1}},
+		{Name: "b", Version: 42, Blocks: []uint32{3, 0}},
+	}
+	if files := p.files("default"); !reflect.DeepEqual(files, expected) {
+		t.Errorf("Unexpected files %+v", files)
+	}
+
+	if temp, ok := p.localBlock("default", "b", 3*bs); !ok || temp != "/tmp/.syncthing.b" {
+		t.Errorf("Block 3 of b not found: %q %v", temp, ok)
+	}
+	if _, ok := p.localBlock("default", "b", bs); ok {
+		t.Error("Block 1 of b found")
+	}
+
+	p.finished("default", "b")
+	if changed := p.takeChanged(); !changed["default"] {
+		t.Error("Finishing a file with blocks was not a change")
+	}
+	if _, ok := p.localBlock("default", "b", 0); ok {
+		t.Error("Block of finished file found")
+	}
+	if files := p.files("default"); len(files) != 1 || files[0].Name != "a" {
+		t.Errorf("Unexpected files %+v", files)
+	}
+}
+
+func TestDownloadProgressRemote(t *testing.T) {
+	p := newDownloadProgress()
+	const bs = scanner.StandardBlockSize
+
+	p.setRemote("node1", "default", []protocol.FileDownloadProgress{
+		{Name: "a", Version: 42, Blocks: []uint32{0, 2}},
+	})
+	p.setRemote("node2", "default", []protocol.FileDownloadProgress{
+		{Name: "a", Version: 41, Blocks: []uint32{0, 1, 2}},
+	})
+
+	if nodes := p.nodesWithBlock("default", "a", 42, 2*bs); !reflect.DeepEqual(nodes, []string{"node1"}) {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+	if nodes := p.nodesWithBlock("default", "a", 42, bs); len(nodes) != 0 {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+	if nodes := p.nodesWithBlock("other", "a", 42, 0); len(nodes) != 0 {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+
+	// A new message replaces the previous one
+	p.setRemote("node1", "default", nil)
+	if nodes := p.nodesWithBlock("default", "a", 42, 0); len(nodes) != 0 {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+
+	p.forgetNode("node2")
+	if nodes := p.nodesWithBlock("default", "a", 41, 0); len(nodes) != 0 {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+}
+
+func TestDownloadProgressTell(t *testing.T) {
+	p := newDownloadProgress()
+
+	if !p.tell("node1", "default", false) {
+		t.Error("New node not told")
+	}
+	if p.tell("node1", "default", false) {
+		t.Error("Node told again without changes")
+	}
+	if !p.tell("node1", "default", true) {
+		t.Error("Node not told about changes")
+	}
+
+	p.forgetNode("node1")
+	if !p.tell("node1", "default", false) {
+		t.Error("Reconnected node not told")
+	}
+}
+
+func TestRequestTempBlock(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/.syncthing.new")
+	fd.Write([]byte("foobar"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+
+	// Not yet announced
+	if _, err := m.Request("some node", "default", "new", 0, 6); err == nil {
+		t.Error("Unexpected nil error for file not in the index")
+	}
+
+	m.progress.started("default", "new", 42, "repo/.syncthing.new")
+	m.progress.gotBlock("default", "new", 0)
+	if bs, err := m.Request("some node", "default", "new", 0, 6); err != nil || string(bs) != "foobar" {
+		t.Errorf("Unexpected request result %q, %v", bs, err)
+	}
+
+	// The pull failed and the temporary file is gone
+	f.Remove("repo/.syncthing.new")
+	if _, err := m.Request("some node", "default", "new", 0, 6); err != protocol.ErrNoSuchFile {
+		t.Errorf("Unexpected error %v for removed temporary file", err)
+	}
+}
@@ -6,13 +6,22 @@ package model
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
+
 	"github.com/calmh/syncthing/cid"
 	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/events"
+	"github.com/calmh/syncthing/files"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/lamport"
 	"github.com/calmh/syncthing/osutil"
 	"github.com/calmh/syncthing/protocol"
 	"github.com/calmh/syncthing/scanner"
@@ -31,8 +40,8 @@ type requestResult struct {
 type openFile struct {
 	filepath     string // full filepath name
 	temp         string // temporary filename
-	availability uint64 // availability bitset
-	file         *os.File
+	availability files.Bitset
+	file         fs.File
 	err          error // error when opening or writing to file, all following operations are cancelled
 	outstanding  int   // number of requests we still have outstanding
 	done         bool  // we have sent all requests for this file
@@ -40,7 +49,7 @@ type openFile struct {
 
 type activityMap map[string]int
 
-func (m activityMap) leastBusyNode(availability uint64, cm *cid.Map) string {
+func (m activityMap) leastBusyNode(availability files.Bitset, cm *cid.Map) string {
 	var low int = 2<<30 - 1
 	var selected string
 	for _, node := range cm.Names() {
@@ -49,7 +58,7 @@ func (m activityMap) leastBusyNode(availability uint64, cm *cid.Map) string {
 			continue
 		}
 		usage := m[node]
-		if availability&(1<<id) != 0 {
+		if availability.Has(id) {
 			if usage < low {
 				low = usage
 				selected = node
@@ -64,12 +73,28 @@ func (m activityMap) decrease(node string) {
 	m[node]--
 }
 
-var errNoNode = errors.New("no available source node")
+var (
+	errNoNode       = errors.New("no available source node")
+	errDirNotEmpty  = errors.New("directory contains files that are not deleted")
+	errDirPending   = errors.New("directory contains files that are still to be deleted")
+	errHashMismatch = errors.New("pulled data does not match the expected hashes")
+)
+
+const (
+	removeRetries    = 3
+	removeRetryDelay = 100 * time.Millisecond
+
+	// Requests failing with a temporary error are retried this many
+	// times, at increasing delays.
+	requestRetries    = 3
+	requestRetryDelay = 2 * time.Second
+)
 
 type puller struct {
-	cfg               *config.Configuration
 	repoCfg           config.RepositoryConfiguration
 	bq                *blockQueue
+	mtimes            *mtimeStore
+	deleteFailed      map[string]bool // directories we have warned about failing to delete
 	model             *Model
 	oustandingPerNode activityMap
 	openFiles         map[string]openFile
@@ -77,19 +102,37 @@ type puller struct {
 	blocks            chan bqBlock
 	requestResults    chan requestResult
 	versioner         versioner.Versioner
+	versions          versioner.Exclusion
+	fs                fs.Filesystem
+	maxPerNode        int        // outstanding requests to a single node; 0 for no limit
+	waiting           []bqBlock  // blocks whose nodes all have maxPerNode requests outstanding
+	mut               sync.Mutex // held while handling blocks and changing the repository; see drop
+	watcher           *scanner.Watcher
+	stop              chan struct{}  // closed when the puller stops
+	requests          sync.WaitGroup // the filler and the outstanding requests
 }
 
-func newPuller(repoCfg config.RepositoryConfiguration, model *Model, slots int, cfg *config.Configuration) *puller {
+func newPuller(repoCfg config.RepositoryConfiguration, model *Model, slots int) *puller {
+	if repoCfg.DryRun {
+		// Never changes the disk
+		slots = 0
+	}
+
 	p := &puller{
 		repoCfg:           repoCfg,
-		cfg:               cfg,
 		bq:                newBlockQueue(),
+		mtimes:            model.repoMtimes[repoCfg.ID],
+		deleteFailed:      make(map[string]bool),
 		model:             model,
 		oustandingPerNode: make(activityMap),
 		openFiles:         make(map[string]openFile),
 		requestSlots:      make(chan bool, slots),
 		blocks:            make(chan bqBlock),
 		requestResults:    make(chan requestResult),
+		fs:                model.fs,
+		versions:          versioner.NewExclusion(repoCfg.Directory, repoCfg.Versioning.Params),
+		maxPerNode:        model.options().MaxPullsPerNode,
+		stop:              make(chan struct{}),
 	}
 
 	if len(repoCfg.Versioning.Type) > 0 {
@@ -97,7 +140,7 @@ func newPuller(repoCfg config.RepositoryConfiguration, model *Model, slots int,
 		if !ok {
 			l.Fatalf("Requested versioning type %q that does not exist", repoCfg.Versioning.Type)
 		}
-		p.versioner = factory(repoCfg.Versioning.Params)
+		p.versioner = factory(repoCfg.Directory, p.fs, repoCfg.Versioning.Params)
 	}
 
 	if slots > 0 {
@@ -105,34 +148,62 @@ func newPuller(repoCfg config.RepositoryConfiguration, model *Model, slots int,
 		for i := 0; i < slots; i++ {
 			p.requestSlots <- true
 		}
+		model.rmut.Lock()
+		model.pullers[repoCfg.ID] = p
+		model.rmut.Unlock()
 		if debug {
 			l.Debugf("starting puller; repo %q dir %q slots %d", repoCfg.ID, repoCfg.Directory, slots)
 		}
-		go p.run()
+		model.background(p.run)
 	} else {
 		// Read only
 		if debug {
 			l.Debugf("starting puller; repo %q dir %q (read only)", repoCfg.ID, repoCfg.Directory)
 		}
-		go p.runRO()
+		model.background(p.runRO)
 	}
 	return p
 }
 
 func (p *puller) run() {
+	defer p.stopped()
+
+	p.requests.Add(1)
 	go func() {
+		defer p.requests.Done()
 		// fill blocks queue when there are free slots
 		for {
-			<-p.requestSlots
-			b := p.bq.get()
+			select {
+			case <-p.requestSlots:
+			case <-p.stop:
+				return
+			}
+			for p.model.mem.isConstrained() && cap(p.requestSlots)-len(p.requestSlots) > constrainedRequests {
+				select {
+				case <-time.After(100 * time.Millisecond):
+				case <-p.stop:
+					return
+				}
+			}
+			b, ok := p.bq.get()
+			if !ok {
+				return
+			}
 			if debug {
 				l.Debugf("filler: queueing %q / %q offset %d copy %d", p.repoCfg.ID, b.file.Name, b.block.Offset, len(b.copy))
 			}
-			p.blocks <- b
+			select {
+			case p.blocks <- b:
+			case <-p.stop:
+				return
+			}
 		}
 	}()
 
-	walkTicker := time.Tick(time.Duration(p.cfg.Options.RescanIntervalS) * time.Second)
+	changes, watched := p.watch()
+	nextRescan := p.nextRescan(watched)
+	ignoresChanged := p.model.watchIgnores(p.repoCfg.ID, p.stop)
+	resumed := p.model.repoResumed(p.repoCfg.ID)
 	timeout := time.Tick(5 * time.Second)
 	changed := true
 
@@ -144,23 +215,33 @@ func (p *puller) run() {
 			case res := <-p.requestResults:
 				p.model.setState(p.repoCfg.ID, RepoSyncing)
 				changed = true
-				p.requestSlots <- true
+				p.releaseSlot()
+				p.acquireWorker()
+				p.mut.Lock()
 				p.handleRequestResult(res)
+				p.retryWaiting()
+				p.mut.Unlock()
+				p.model.sched.release()
 
 			case b := <-p.blocks:
 				p.model.setState(p.repoCfg.ID, RepoSyncing)
 				changed = true
-				if p.handleBlock(b) {
+				done := true
+				p.acquireWorker()
+				if p.lockUnpaused() {
+					done = p.handleBlock(b)
+					p.mut.Unlock()
+				}
+				p.model.sched.release()
+				if done {
 					// Block was fully handled, free up the slot
-					p.requestSlots <- true
+					p.releaseSlot()
 				}
 
 			case <-timeout:
-				if len(p.openFiles) == 0 && p.bq.empty() {
-					// Nothing more to do for the moment
-					break pull
-				}
-				if debug {
+				p.mut.Lock()
+				idle := len(p.openFiles) == 0 && p.bq.empty()
+				if !idle && debug {
 					l.Debugf("%q: idle but have %d open files", p.repoCfg.ID, len(p.openFiles))
 					i := 5
 					for _, f := range p.openFiles {
@@ -171,54 +252,286 @@ func (p *puller) run() {
 						}
 					}
 				}
+				p.mut.Unlock()
+				if idle {
+					// Nothing more to do for the moment
+					break pull
+				}
+
+			case <-p.model.stop:
+				return
 			}
 		}
 
-		if changed {
+		if changed && p.lockUnpaused() {
 			p.model.setState(p.repoCfg.ID, RepoCleaning)
 			p.fixupDirectories()
+			p.mut.Unlock()
 			changed = false
 		}
 
 		p.model.setState(p.repoCfg.ID, RepoIdle)
 
-		// Do a rescan if it's time for it
+		if p.model.RepoPaused(p.repoCfg.ID) {
+			// Neither scanned nor pulled until resumed
+			continue
+		}
+
+		// Do a rescan if it's time for it, or if the ignore patterns
+		// have changed, or of what has changed according to the watcher
+		rescan := false
+		var subs []string
 		select {
-		case <-walkTicker:
+		case <-nextRescan:
 			if debug {
 				l.Debugf("%q: time for rescan", p.repoCfg.ID)
 			}
-			err := p.model.ScanRepo(p.repoCfg.ID)
-			if err != nil {
-				invalidateRepo(p.cfg, p.repoCfg.ID, err)
-				return
+			nextRescan = p.nextRescan(watched)
+			rescan = true
+		case <-ignoresChanged:
+			l.Infof("Ignore patterns for %q changed; rescanning", p.repoCfg.ID)
+			rescan = true
+		case <-resumed:
+			rescan = true
+		case cs, ok := <-changes:
+			if !ok {
+				changes, watched = nil, false
+				nextRescan = p.watchStopped()
 			}
-
+			subs = cs
 		default:
 		}
+		if rescan || len(subs) > 0 {
+			err := p.model.ScanRepoSubs(p.repoCfg.ID, subs)
+			if err == scanner.ErrWalkStopped {
+				if p.model.RepoPaused(p.repoCfg.ID) {
+					continue
+				}
+				return
+			} else if err != nil {
+				p.model.InvalidateRepo(p.repoCfg.ID, err)
+				return
+			}
+		}
 
 		// Queue more blocks to fetch, if any
-		p.queueNeededBlocks()
+		if p.lockUnpaused() {
+			p.queueNeededBlocks()
+			p.mut.Unlock()
+		}
 	}
 }
 
-func (p *puller) runRO() {
-	walkTicker := time.Tick(time.Duration(p.cfg.Options.RescanIntervalS) * time.Second)
+// remove removes the named file or empty directory. Failures other than the
+// file not existing are retried a few times, since files are often locked
+// for a moment by virus scanners, indexers and the like.
+func (p *puller) remove(path string) error {
+	var err error
+	for i := 0; i < removeRetries; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * removeRetryDelay)
+		}
+		err = p.fs.Remove(path)
+		if err == nil || os.IsNotExist(err) {
+			return err
+		}
+	}
+	return err
+}
 
-	for _ = range walkTicker {
-		if debug {
-			l.Debugf("%q: time for rescan", p.repoCfg.ID)
+// removeDir removes the directory known as rn in the repository. A directory
+// that is not empty is removed along with its contents, if all of it is
+// either ignored or temporary files, since the user won't see those as
+// reasons to keep the directory around. Otherwise errDirNotEmpty is
+// returned, or errDirPending if some of the contents are yet to be deleted.
+func (p *puller) removeDir(rn string) error {
+	path := filepath.Join(p.repoCfg.Directory, rn)
+	err := p.fs.Remove(path)
+	if err == nil || os.IsNotExist(err) {
+		return nil
+	}
+
+	ignores := p.model.repoIgnores(p.repoCfg.ID)
+	var contents []string
+	var ignoredDirs []string
+	walkErr := p.fs.Walk(path, func(cp string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if cp == path {
+			return nil
 		}
-		err := p.model.ScanRepo(p.repoCfg.ID)
+		crn, err := filepath.Rel(p.repoCfg.Directory, cp)
 		if err != nil {
-			invalidateRepo(p.cfg, p.repoCfg.ID, err)
+			return err
+		}
+
+		removable := defTempNamer.IsTemporary(crn) || filepath.Base(crn) == ".stignore" || ignores.Match(crn)
+		for _, dir := range ignoredDirs {
+			if strings.HasPrefix(crn, dir+string(os.PathSeparator)) {
+				removable = true
+				break
+			}
+		}
+		if !removable {
+			if gf := p.model.CurrentGlobalFile(p.repoCfg.ID, crn); gf.Name == crn && protocol.IsDeleted(gf.Flags) {
+				return errDirPending
+			}
+			return errDirNotEmpty
+		}
+
+		if info.IsDir() {
+			ignoredDirs = append(ignoredDirs, crn)
+		}
+		contents = append(contents, cp)
+		return nil
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+
+	for i := len(contents) - 1; i >= 0; i-- {
+		if debug {
+			l.Debugln("delete ignored:", contents[i])
+		}
+		if err := p.remove(contents[i]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return p.remove(path)
+}
+
+// applyACL sets the ACL of the file at path to that of f, if ACLs are synced
+// for the repository. A file system without ACL support is not an error.
+func (p *puller) applyACL(path string, f scanner.File) error {
+	if !p.repoCfg.SyncACLs {
+		return nil
+	}
+	err := p.fs.SetACL(path, f.ACL)
+	if err == osutil.ErrACLNotSupported {
+		return nil
+	}
+	return err
+}
+
+// releaseSlot returns a request slot to the puller.
+func (p *puller) releaseSlot() {
+	p.requestSlots <- true
+}
+
+// acquireWorker takes a worker slot from the scheduler for the disk work and
+// hashing of a block or a request result. The slot is held only by the
+// puller loop and never while waiting for the network or a channel, so that
+// it is always given back even with a budget of one.
+func (p *puller) acquireWorker() {
+	p.model.sched.acquire(p.repoCfg.Priority)
+}
+
+// lockUnpaused takes the puller's lock for work that changes the
+// repository. It returns false, without taking the lock, if the repository
+// is paused.
+func (p *puller) lockUnpaused() bool {
+	p.mut.Lock()
+	if p.model.RepoPaused(p.repoCfg.ID) {
+		p.mut.Unlock()
+		return false
+	}
+	return true
+}
+
+// drop forgets the queued blocks and the files being pulled, once the
+// repository is paused. The temporary files are removed, and the results of
+// the requests still outstanding are thrown away as they arrive. When drop
+// returns nothing more is written to the repository until it is resumed,
+// when what is still needed is queued again.
+func (p *puller) drop() {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	p.bq.clear()
+	for i := 0; i < len(p.waiting); i++ {
+		p.releaseSlot()
+	}
+	p.waiting = nil
+	for name, of := range p.openFiles {
+		if of.file != nil {
+			of.file.Close()
+			p.fs.Remove(of.temp)
+		}
+		p.forgetFile(name)
+	}
+}
+
+// stopped ends what the puller started, once it stops running: the filler,
+// the outstanding requests, the watchers and the cleaning of versions. The
+// files being pulled are closed, and their temporary files are kept for
+// when the repository is pulled again.
+func (p *puller) stopped() {
+	close(p.stop)
+	p.bq.close()
+	p.requests.Wait()
+
+	if p.watcher != nil {
+		p.watcher.Close()
+	}
+	if s, ok := p.versioner.(versioner.Stopper); ok {
+		s.Stop()
+	}
+
+	p.mut.Lock()
+	for _, of := range p.openFiles {
+		if of.file != nil {
+			of.file.Close()
+		}
+	}
+	p.mut.Unlock()
+}
+
+func (p *puller) runRO() {
+	defer p.stopped()
+
+	changes, watched := p.watch()
+	nextRescan := p.nextRescan(watched)
+
+	ignoresChanged := p.model.watchIgnores(p.repoCfg.ID, p.stop)
+	resumed := p.model.repoResumed(p.repoCfg.ID)
+
+	for {
+		var subs []string
+		select {
+		case <-nextRescan:
+			if debug {
+				l.Debugf("%q: time for rescan", p.repoCfg.ID)
+			}
+			nextRescan = p.nextRescan(watched)
+		case <-ignoresChanged:
+			l.Infof("Ignore patterns for %q changed; rescanning", p.repoCfg.ID)
+		case <-resumed:
+		case cs, ok := <-changes:
+			if !ok {
+				changes, watched = nil, false
+				nextRescan = p.watchStopped()
+				continue
+			}
+			subs = cs
+		case <-p.model.stop:
+			return
+		}
+		err := p.model.ScanRepoSubs(p.repoCfg.ID, subs)
+		if err == scanner.ErrWalkStopped {
+			if p.model.RepoPaused(p.repoCfg.ID) {
+				continue
+			}
+			return
+		} else if err != nil {
+			p.model.InvalidateRepo(p.repoCfg.ID, err)
 			return
 		}
 	}
 }
 
 func (p *puller) fixupDirectories() {
-	var deleteDirs []string
+	var deleteDirs []scanner.File
 	var changed = 0
 
 	var walkFn = func(path string, info os.FileInfo, err error) error {
@@ -239,34 +552,40 @@ func (p *puller) fixupDirectories() {
 			return nil
 		}
 
-		if filepath.Base(rn) == ".stversions" {
+		if p.versions.IsVersionsDir(rn) {
 			return nil
 		}
 
 		cur := p.model.CurrentRepoFile(p.repoCfg.ID, rn)
-		if cur.Name != rn {
-			// No matching dir in current list; weird
-			if debug {
-				l.Debugf("missing dir: %s; %v", rn, cur)
-			}
-			return nil
+		if cur.IsIgnored() {
+			// Ignored directories and their contents are left alone
+			return filepath.SkipDir
 		}
-
-		if protocol.IsDeleted(cur.Flags) {
+		if gf := p.model.CurrentGlobalFile(p.repoCfg.ID, rn); gf.Name == rn && protocol.IsDeleted(gf.Flags) {
 			if debug {
-				l.Debugf("queue delete dir: %v", cur)
+				l.Debugf("queue delete dir: %v", gf)
 			}
 
 			// We queue the directories to delete since we walk the
 			// tree in depth first order and need to remove the
-			// directories in the opposite order.
+			// directories in the opposite order, after the files in
+			// them. The local index is updated once the directory is
+			// actually gone.
 
-			deleteDirs = append(deleteDirs, path)
+			deleteDirs = append(deleteDirs, gf)
+			return nil
+		}
+
+		if cur.Name != rn {
+			// No matching dir in current list; weird
+			if debug {
+				l.Debugf("missing dir: %s; %v", rn, cur)
+			}
 			return nil
 		}
 
 		if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(cur.Flags) && !scanner.PermsEqual(cur.Flags, uint32(info.Mode())) {
-			err := os.Chmod(path, os.FileMode(cur.Flags)&os.ModePerm)
+			err := p.fs.Chmod(path, os.FileMode(cur.Flags)&os.ModePerm)
 			if err != nil {
 				l.Warnf("Restoring folder flags: %q: %v", path, err)
 			} else {
@@ -277,9 +596,22 @@ func (p *puller) fixupDirectories() {
 			}
 		}
 
-		if cur.Modified != info.ModTime().Unix() {
-			t := time.Unix(cur.Modified, 0)
-			err := os.Chtimes(path, t, t)
+		if p.repoCfg.SyncACLs {
+			if acl, err := p.fs.GetACL(path); err == nil && !bytes.Equal(acl, cur.ACL) {
+				err := p.applyACL(path, cur)
+				if err != nil {
+					l.Warnf("Restoring folder ACL: %q: %v", path, err)
+				} else {
+					changed++
+					if debug {
+						l.Debugf("restored dir ACL: %v", cur)
+					}
+				}
+			}
+		}
+
+		if !p.mtimes.Mtime(rn, info.ModTime()).Equal(time.Unix(cur.Modified, int64(cur.ModifiedNs))) {
+			err := p.mtimes.setMtime(p.fs, rn, path, cur.Modified, cur.ModifiedNs)
 			if err != nil {
 				if runtime.GOOS != "windows" {
 					// https://code.google.com/p/go/issues/detail?id=8090
@@ -299,7 +631,7 @@ func (p *puller) fixupDirectories() {
 	for {
 		deleteDirs = nil
 		changed = 0
-		filepath.Walk(p.repoCfg.Directory, walkFn)
+		p.fs.Walk(p.repoCfg.Directory, walkFn)
 
 		var deleted = 0
 		// Delete any queued directories
@@ -308,11 +640,18 @@ func (p *puller) fixupDirectories() {
 			if debug {
 				l.Debugln("delete dir:", dir)
 			}
-			err := os.Remove(dir)
+			err := p.removeDir(dir.Name)
 			if err == nil {
 				deleted++
-			} else if p.versioner == nil { // Failures are expected in the presence of versioning
-				l.Warnln(err)
+				delete(p.deleteFailed, dir.Name)
+				p.model.updateLocal(p.repoCfg.ID, dir)
+			} else if err == errDirNotEmpty && p.keepDir(dir) {
+				changed++
+				delete(p.deleteFailed, dir.Name)
+			} else if p.versioner == nil && !p.deleteFailed[dir.Name] { // Failures are expected in the presence of versioning
+				l.Warnf("Delete folder: %q: %v", dir.Name, err)
+				p.model.setItemError(p.repoCfg.ID, dir.Name, ItemErrorPull, err)
+				p.deleteFailed[dir.Name] = true
 			}
 		}
 
@@ -326,6 +665,25 @@ func (p *puller) fixupDirectories() {
 	}
 }
 
+// keepDir announces a new version of the directory that was deleted on
+// another node as gf, when it holds files that the other nodes don't know
+// about. The files are synced as usual, and the directory with them, rather
+// than the deletion staying needed forever. It returns false when the
+// directory is not in the local index yet; the scanner will pick it up.
+func (p *puller) keepDir(gf scanner.File) bool {
+	cur := p.model.CurrentRepoFile(p.repoCfg.ID, gf.Name)
+	if cur.Name != gf.Name || protocol.IsDeleted(cur.Flags) {
+		return false
+	}
+	if debug {
+		l.Debugln("keep dir:", cur)
+	}
+	cur.Version = lamport.Default.Tick(gf.Version)
+	cur.Vector = gf.Vector.Merge(cur.Vector).Update(p.model.vectorID)
+	p.model.updateLocal(p.repoCfg.ID, cur)
+	return true
+}
+
 func (p *puller) handleRequestResult(res requestResult) {
 	p.oustandingPerNode.decrease(res.node)
 	f := res.file
@@ -336,7 +694,15 @@ func (p *puller) handleRequestResult(res requestResult) {
 		return
 	}
 
+	if res.err != nil {
+		p.pullFailed(f.Name, fmt.Errorf("request from %s: %v", res.node, res.err))
+	}
+
 	_, of.err = of.file.WriteAt(res.data, res.offset)
+	if res.err == nil && of.err == nil {
+		p.model.reuse.downloaded(p.repoCfg.ID, res.node, int64(len(res.data)))
+		p.model.progress.gotBlock(p.repoCfg.ID, f.Name, res.offset)
+	}
 
 	of.outstanding--
 	p.openFiles[f.Name] = of
@@ -356,23 +722,44 @@ func (p *puller) handleRequestResult(res requestResult) {
 func (p *puller) handleBlock(b bqBlock) bool {
 	f := b.file
 
+	if protocol.IsSymlink(f.Flags) {
+		p.handleSymlink(f)
+		return true
+	}
+
+	if _, ok := p.openFiles[f.Name]; !ok {
+		if err := p.checkParents(f.Name); err != nil {
+			p.pullFailed(f.Name, err)
+			if !b.last {
+				p.openFiles[f.Name] = openFile{err: err}
+			}
+			return true
+		}
+	}
+
 	// For directories, making sure they exist is enough.
 	// Deleted directories we mark as handled and delete later.
 	if protocol.IsDirectory(f.Flags) {
+		path := filepath.Join(p.repoCfg.Directory, f.Name)
 		if !protocol.IsDeleted(f.Flags) {
-			path := filepath.Join(p.repoCfg.Directory, f.Name)
-			_, err := os.Stat(path)
+			_, err := p.fs.Stat(path)
 			if err != nil && os.IsNotExist(err) {
 				if debug {
 					l.Debugf("create dir: %v", f)
 				}
-				err = os.MkdirAll(path, 0777)
+				err = p.fs.MkdirAll(path, 0777)
 				if err != nil {
 					l.Warnf("Create folder: %q: %v", path, err)
+					p.model.setItemError(p.repoCfg.ID, f.Name, ItemErrorPull, err)
 				}
 			}
-		} else if debug {
-			l.Debugf("ignore delete dir: %v", f)
+		} else if _, err := p.fs.Lstat(path); err == nil {
+			// Existing directories are removed by fixupDirectories,
+			// which also updates the local index.
+			if debug {
+				l.Debugf("defer delete dir: %v", f)
+			}
+			return true
 		}
 		p.model.updateLocal(p.repoCfg.ID, f)
 		return true
@@ -385,18 +772,21 @@ func (p *puller) handleBlock(b bqBlock) bool {
 			l.Debugln("taking shortcut:", f)
 		}
 		fp := filepath.Join(p.repoCfg.Directory, f.Name)
-		t := time.Unix(f.Modified, 0)
-		err := os.Chtimes(fp, t, t)
+		err := p.mtimes.setMtime(p.fs, f.Name, fp, f.Modified, f.ModifiedNs)
 		if debug && err != nil {
 			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 		}
 		if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(f.Flags) {
-			err = os.Chmod(fp, os.FileMode(f.Flags&0777))
+			err = p.fs.Chmod(fp, os.FileMode(f.Flags&0777))
 			if debug && err != nil {
 				l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 			}
 		}
+		if err := p.applyACL(fp, f); debug && err != nil {
+			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+		}
 
+		p.model.reuse.unchanged(p.repoCfg.ID, f.Size)
 		p.model.updateLocal(p.repoCfg.ID, f)
 		return true
 	}
@@ -409,30 +799,39 @@ func (p *puller) handleBlock(b bqBlock) bool {
 			l.Debugf("pull: %q: opening file %q", p.repoCfg.ID, f.Name)
 		}
 
-		of.availability = uint64(p.model.repoFiles[p.repoCfg.ID].Availability(f.Name))
+		of.availability = p.model.repoFiles[p.repoCfg.ID].Availability(f.Name)
 		of.filepath = filepath.Join(p.repoCfg.Directory, f.Name)
 		of.temp = filepath.Join(p.repoCfg.Directory, defTempNamer.TempName(f.Name))
 
 		dirName := filepath.Dir(of.filepath)
-		_, err := os.Stat(dirName)
+		_, err := p.fs.Stat(dirName)
 		if err != nil {
-			err = os.MkdirAll(dirName, 0777)
+			err = p.fs.MkdirAll(dirName, 0777)
 		}
 		if err != nil {
 			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 		}
 
-		of.file, of.err = os.Create(of.temp)
+		// The puller holds its other temporary files open meanwhile, so
+		// it must not wait for the open files limit. The file is retried
+		// later instead.
+		of.file, of.err = fs.TryCreate(p.fs, of.temp)
 		if of.err != nil {
-			if debug {
-				l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, of.err)
-			}
+			p.pullFailed(f.Name, of.err)
 			if !b.last {
 				p.openFiles[f.Name] = of
 			}
 			return true
 		}
-		osutil.HideFile(of.temp)
+		p.fs.Hide(of.temp)
+
+		// The file gets its final size up front, so that the blocks can
+		// be written in whatever order they arrive without the file
+		// being extended for each, and the space is not fragmented.
+		if err := of.file.Truncate(f.Size); debug && err != nil {
+			l.Debugf("pull: preallocate %q / %q: %v", p.repoCfg.ID, f.Name, err)
+		}
+		p.model.progress.started(p.repoCfg.ID, f.Name, f.Version, of.temp)
 	}
 
 	if of.err != nil {
@@ -441,7 +840,7 @@ func (p *puller) handleBlock(b bqBlock) bool {
 			l.Debugf("pull: error: %q / %q has already failed: %v", p.repoCfg.ID, f.Name, of.err)
 		}
 		if b.last {
-			delete(p.openFiles, f.Name)
+			p.forgetFile(f.Name)
 		}
 
 		return true
@@ -472,12 +871,10 @@ func (p *puller) handleCopyBlock(b bqBlock) {
 		l.Debugf("pull: copying %d blocks for %q / %q", len(b.copy), p.repoCfg.ID, f.Name)
 	}
 
-	var exfd *os.File
-	exfd, of.err = os.Open(of.filepath)
+	var exfd fs.File
+	exfd, of.err = fs.TryOpen(p.fs, of.filepath)
 	if of.err != nil {
-		if debug {
-			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, of.err)
-		}
+		p.pullFailed(f.Name, of.err)
 		of.file.Close()
 		of.file = nil
 
@@ -493,9 +890,7 @@ func (p *puller) handleCopyBlock(b bqBlock) {
 			_, of.err = of.file.WriteAt(bs, b.Offset)
 		}
 		if of.err != nil {
-			if debug {
-				l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, of.err)
-			}
+			p.pullFailed(f.Name, of.err)
 			exfd.Close()
 			of.file.Close()
 			of.file = nil
@@ -503,9 +898,50 @@ func (p *puller) handleCopyBlock(b bqBlock) {
 			p.openFiles[f.Name] = of
 			return
 		}
+		p.model.reuse.reused(p.repoCfg.ID, int64(b.Size))
+		p.model.progress.gotBlock(p.repoCfg.ID, f.Name, b.Offset)
 	}
 }
 
+// copyLocalBlock copies the block into the file from another local file
+// that holds it, if there is one. Returns true if the block was copied.
+func (p *puller) copyLocalBlock(of openFile, f scanner.File, b scanner.Block) bool {
+	for _, loc := range p.model.repoFiles[p.repoCfg.ID].FindBlock(b.Hash) {
+		if loc.Block.Size != b.Size {
+			continue
+		}
+
+		// At the open files limit the block is requested from the
+		// network instead
+		fd, err := fs.TryOpen(p.fs, filepath.Join(p.repoCfg.Directory, loc.Name))
+		if err != nil {
+			continue
+		}
+		bs := make([]byte, b.Size)
+		_, err = fd.ReadAt(bs, loc.Block.Offset)
+		fd.Close()
+		if err != nil {
+			continue
+		}
+
+		// The file may have changed since it was scanned
+		if hash := sha256.Sum256(bs); !bytes.Equal(hash[:], b.Hash) {
+			continue
+		}
+
+		if _, err := of.file.WriteAt(bs, b.Offset); err != nil {
+			return false
+		}
+		if debug {
+			l.Debugf("pull: %q / %q: copied block at offset %d from %q", p.repoCfg.ID, f.Name, b.Offset, loc.Name)
+		}
+		p.model.reuse.deduplicated(p.repoCfg.ID, int64(b.Size))
+		p.model.progress.gotBlock(p.repoCfg.ID, f.Name, b.Offset)
+		return true
+	}
+	return false
+}
+
 // handleRequestBlock tries to pull a block from the network. Returns true if
 // the block could _not_ be fetched (i.e. it was fully handled, matching the
 // return criteria of handleBlock)
@@ -516,16 +952,57 @@ func (p *puller) handleRequestBlock(b bqBlock) bool {
 		panic("bug: request for non-open file")
 	}
 
-	node := p.oustandingPerNode.leastBusyNode(of.availability, p.model.cm)
+	if p.copyLocalBlock(of, f, b.block) {
+		p.openFiles[f.Name] = of
+		if of.done && of.outstanding == 0 {
+			p.closeFile(f)
+		}
+		return true
+	}
+
+	return p.requestBlock(b)
+}
+
+// requestBlock requests the block from the node that is expected to answer
+// the soonest, among those that have it and fewer than the maximum requests
+// outstanding. When all of them have the maximum, the block waits for one
+// of their requests to finish. Returns true if the block was fully handled,
+// like handleRequestBlock.
+func (p *puller) requestBlock(b bqBlock) bool {
+	f := b.file
+	of := p.openFiles[f.Name]
+
+	// Nodes pulling the same version of the file may have the block too
+	availability := of.availability
+	if nodes := p.model.progress.nodesWithBlock(p.repoCfg.ID, f.Name, f.Version, b.block.Offset); len(nodes) > 0 {
+		ids := availability.IDs()
+		for _, node := range nodes {
+			ids = append(ids, p.model.cm.Get(node))
+		}
+		availability = files.NewBitset(ids...)
+	}
+
+	node := p.oustandingPerNode.fastestNode(availability, p.model.cm, p.model.nodeStats, p.maxPerNode)
+	if len(node) == 0 && p.oustandingPerNode.atLimit(availability, p.model.cm, p.maxPerNode) {
+		if debug {
+			l.Debugf("pull: %q / %q offset %d waits for a node", p.repoCfg.ID, f.Name, b.block.Offset)
+		}
+		// Counted as outstanding, so that the file is not closed before
+		of.outstanding++
+		p.openFiles[f.Name] = of
+		p.waiting = append(p.waiting, b)
+		return false
+	}
 	if len(node) == 0 {
 		of.err = errNoNode
+		p.pullFailed(f.Name, of.err)
 		if of.file != nil {
 			of.file.Close()
 			of.file = nil
-			os.Remove(of.temp)
+			p.fs.Remove(of.temp)
 		}
 		if b.last {
-			delete(p.openFiles, f.Name)
+			p.forgetFile(f.Name)
 		} else {
 			p.openFiles[f.Name] = of
 		}
@@ -535,25 +1012,75 @@ func (p *puller) handleRequestBlock(b bqBlock) bool {
 	of.outstanding++
 	p.openFiles[f.Name] = of
 
+	p.requests.Add(1)
 	go func(node string, b bqBlock) {
+		defer p.requests.Done()
 		if debug {
 			l.Debugf("pull: requesting %q / %q offset %d size %d from %q outstanding %d", p.repoCfg.ID, f.Name, b.block.Offset, b.block.Size, node, of.outstanding)
 		}
 
-		bs, err := p.model.requestGlobal(node, p.repoCfg.ID, f.Name, b.block.Offset, int(b.block.Size), nil)
-		p.requestResults <- requestResult{
+		var bs []byte
+		var err error
+		for i := 1; ; i++ {
+			t0 := time.Now()
+			bs, err = p.model.requestGlobal(node, p.repoCfg.ID, f.Name, b.block.Offset, int(b.block.Size), nil)
+			p.model.nodeStats.record(node, len(bs), time.Since(t0), err)
+			if !protocol.IsTemporary(err) || i > requestRetries {
+				break
+			}
+			if debug {
+				l.Debugf("pull: request %q / %q offset %d from %q: %v; retrying", p.repoCfg.ID, f.Name, b.block.Offset, node, err)
+			}
+			select {
+			case <-time.After(time.Duration(i) * requestRetryDelay):
+			case <-p.stop:
+				return
+			}
+		}
+		select {
+		case p.requestResults <- requestResult{
 			node:     node,
 			file:     f,
 			filepath: of.filepath,
 			offset:   b.block.Offset,
 			data:     bs,
 			err:      err,
+		}:
+		case <-p.stop:
 		}
 	}(node, b)
 
 	return false
 }
 
+// retryWaiting requests the blocks that wait for a node, now that one may
+// have fewer requests outstanding.
+func (p *puller) retryWaiting() {
+	waiting := p.waiting
+	p.waiting = nil
+	for _, b := range waiting {
+		of, ok := p.openFiles[b.file.Name]
+		if !ok {
+			p.releaseSlot()
+			continue
+		}
+		of.outstanding--
+		p.openFiles[b.file.Name] = of
+
+		if of.err != nil {
+			// The file has failed while the block waited
+			if b.last {
+				p.forgetFile(b.file.Name)
+			}
+			p.releaseSlot()
+			continue
+		}
+		if p.requestBlock(b) {
+			p.releaseSlot()
+		}
+	}
+}
+
 func (p *puller) handleEmptyBlock(b bqBlock) {
 	f := b.file
 	of := p.openFiles[f.Name]
@@ -564,49 +1091,94 @@ func (p *puller) handleEmptyBlock(b bqBlock) {
 		}
 	}
 
+	if err := p.checkParents(f.Name); err != nil {
+		p.pullFailed(f.Name, err)
+		p.forgetFile(f.Name)
+		return
+	}
+
 	if protocol.IsDeleted(f.Flags) {
 		if debug {
 			l.Debugf("pull: delete %q", f.Name)
 		}
-		os.Remove(of.temp)
-		os.Chmod(of.filepath, 0666)
+		p.fs.Remove(of.temp)
+		if err := p.moveForConflict(of.filepath, f); err != nil {
+			p.pullFailed(f.Name, err)
+			p.forgetFile(f.Name)
+			return
+		}
+		p.fs.Chmod(of.filepath, 0666)
 		if p.versioner != nil {
 			if err := p.versioner.Archive(of.filepath); err == nil {
 				p.model.updateLocal(p.repoCfg.ID, f)
 			}
-		} else if err := os.Remove(of.filepath); err == nil || os.IsNotExist(err) {
+		} else if err := p.remove(of.filepath); err == nil || os.IsNotExist(err) {
 			p.model.updateLocal(p.repoCfg.ID, f)
+		} else {
+			p.pullFailed(f.Name, err)
 		}
 	} else {
 		if debug {
 			l.Debugf("pull: no blocks to fetch and nothing to copy for %q / %q", p.repoCfg.ID, f.Name)
 		}
-		t := time.Unix(f.Modified, 0)
-		if os.Chtimes(of.temp, t, t) != nil {
-			delete(p.openFiles, f.Name)
+		if err := p.mtimes.setMtime(p.fs, f.Name, of.temp, f.Modified, f.ModifiedNs); err != nil {
+			p.pullFailed(f.Name, err)
+			p.forgetFile(f.Name)
 			return
 		}
-		if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(f.Flags) && os.Chmod(of.temp, os.FileMode(f.Flags&0777)) != nil {
-			delete(p.openFiles, f.Name)
-			return
+		if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(f.Flags) {
+			if err := p.fs.Chmod(of.temp, os.FileMode(f.Flags&0777)); err != nil {
+				p.pullFailed(f.Name, err)
+				p.forgetFile(f.Name)
+				return
+			}
+		}
+		if err := p.applyACL(of.temp, f); debug && err != nil {
+			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 		}
-		osutil.ShowFile(of.temp)
-		if osutil.Rename(of.temp, of.filepath) == nil {
+		p.fs.Show(of.temp)
+		if err := p.moveForConflict(of.filepath, f); err != nil {
+			p.pullFailed(f.Name, err)
+		} else if err := p.fs.Rename(of.temp, of.filepath); err == nil {
 			p.model.updateLocal(p.repoCfg.ID, f)
+		} else {
+			p.pullFailed(f.Name, err)
 		}
 	}
-	delete(p.openFiles, f.Name)
+	p.forgetFile(f.Name)
 }
 
 func (p *puller) queueNeededBlocks() {
 	queued := 0
-	for _, f := range p.model.NeedFilesRepo(p.repoCfg.ID) {
+	retries := p.model.repoRetries(p.repoCfg.ID)
+	needed := make(map[string]bool)
+	now := time.Now()
+	for _, f := range p.handleRenames(p.model.NeedFilesRepo(p.repoCfg.ID)) {
+		needed[f.Name] = true
+		if !retries.due(f.Name, f.Version, now) {
+			continue
+		}
+		if err := p.skipPull(f); err != nil {
+			p.pullFailed(f.Name, err)
+			continue
+		}
 		lf := p.model.CurrentRepoFile(p.repoCfg.ID, f.Name)
+		if protocol.HasBlockGroups(f.Flags) {
+			var err error
+			if f, err = p.expandBlocks(lf, f); err != nil {
+				p.pullFailed(f.Name, err)
+				continue
+			}
+		}
 		have, need := scanner.BlockDiff(lf.Blocks, f.Blocks)
 		if debug {
 			l.Debugf("need:\n  local: %v\n  global: %v\n  haveBlocks: %v\n  needBlocks: %v", lf, f, have, need)
 		}
 		queued++
+		events.Default.Log(events.ItemStarted, map[string]string{
+			"repo": p.repoCfg.ID,
+			"item": f.Name,
+		})
 		p.bq.put(bqAdd{
 			file: f,
 			have: have,
@@ -616,6 +1188,9 @@ func (p *puller) queueNeededBlocks() {
 	if debug && queued > 0 {
 		l.Debugf("%q: queued %d blocks", p.repoCfg.ID, queued)
 	}
+
+	retries.prune(needed)
+	p.model.saveRetries(p.repoCfg.ID)
 }
 
 func (p *puller) closeFile(f scanner.File) {
@@ -625,15 +1200,13 @@ func (p *puller) closeFile(f scanner.File) {
 
 	of := p.openFiles[f.Name]
 	of.file.Close()
-	defer os.Remove(of.temp)
+	defer p.fs.Remove(of.temp)
 
-	delete(p.openFiles, f.Name)
+	p.forgetFile(f.Name)
 
-	fd, err := os.Open(of.temp)
+	fd, err := fs.TryOpen(p.fs, of.temp)
 	if err != nil {
-		if debug {
-			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
-		}
+		p.pullFailed(f.Name, err)
 		return
 	}
 	hb, _ := scanner.Blocks(fd, scanner.StandardBlockSize)
@@ -643,36 +1216,48 @@ func (p *puller) closeFile(f scanner.File) {
 		if debug {
 			l.Debugf("pull: %q / %q: nblocks %d != %d", p.repoCfg.ID, f.Name, l0, l1)
 		}
+		p.pullFailed(f.Name, errHashMismatch)
 		return
 	}
 
 	for i := range hb {
 		if bytes.Compare(hb[i].Hash, f.Blocks[i].Hash) != 0 {
 			l.Debugf("pull: %q / %q: block %d hash mismatch", p.repoCfg.ID, f.Name, i)
+			p.pullFailed(f.Name, errHashMismatch)
 			return
 		}
 	}
 
-	t := time.Unix(f.Modified, 0)
-	err = os.Chtimes(of.temp, t, t)
+	err = p.mtimes.setMtime(p.fs, f.Name, of.temp, f.Modified, f.ModifiedNs)
 	if debug && err != nil {
 		l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 	}
 	if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(f.Flags) {
-		err = os.Chmod(of.temp, os.FileMode(f.Flags&0777))
+		err = p.fs.Chmod(of.temp, os.FileMode(f.Flags&0777))
 		if debug && err != nil {
 			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
 		}
 	}
+	if err := p.applyACL(of.temp, f); debug && err != nil {
+		l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+	}
 
-	osutil.ShowFile(of.temp)
+	p.fs.Show(of.temp)
+
+	if err := p.checkParents(f.Name); err != nil {
+		p.pullFailed(f.Name, err)
+		return
+	}
+
+	if err := p.moveForConflict(of.filepath, f); err != nil {
+		p.pullFailed(f.Name, err)
+		return
+	}
 
 	if p.versioner != nil {
 		err := p.versioner.Archive(of.filepath)
 		if err != nil {
-			if debug {
-				l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
-			}
+			p.pullFailed(f.Name, err)
 			return
 		}
 	}
@@ -680,19 +1265,33 @@ func (p *puller) closeFile(f scanner.File) {
 	if debug {
 		l.Debugf("pull: rename %q / %q: %q", p.repoCfg.ID, f.Name, of.filepath)
 	}
-	if err := osutil.Rename(of.temp, of.filepath); err == nil {
+	if err := p.fs.Rename(of.temp, of.filepath); err == nil {
 		p.model.updateLocal(p.repoCfg.ID, f)
 	} else {
-		l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+		p.pullFailed(f.Name, err)
 	}
 }
 
-func invalidateRepo(cfg *config.Configuration, repoID string, err error) {
-	for i := range cfg.Repositories {
-		repo := &cfg.Repositories[i]
-		if repo.ID == repoID {
-			repo.Invalid = err.Error()
-			return
-		}
+// forgetFile drops the file from the open files, when it is done with or
+// failed, and stops announcing it to the other nodes.
+func (p *puller) forgetFile(name string) {
+	delete(p.openFiles, name)
+	p.model.progress.finished(p.repoCfg.ID, name)
+}
+
+// pullFailed records that syncing the named file failed. The file is tried
+// again later.
+func (p *puller) pullFailed(name string, err error) {
+	version := p.model.CurrentGlobalFile(p.repoCfg.ID, name).Version
+	next := p.model.repoRetries(p.repoCfg.ID).failed(name, version, err, time.Now())
+	if debug {
+		l.Debugf("pull: error: %q / %q: %v; retry at %v", p.repoCfg.ID, name, err, next)
 	}
+	p.model.setItemError(p.repoCfg.ID, name, ItemErrorPull, err)
+
+	events.Default.Log(events.ItemFinished, map[string]interface{}{
+		"repo":  p.repoCfg.ID,
+		"item":  name,
+		"error": err.Error(),
+	})
 }
@@ -0,0 +1,76 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// sortNeeded sorts the needed files of the repository into the order they
+// are to be pulled in. The files ranked higher by the sync order patterns
+// come first, and those ranked the same are in the pull order of the
+// repository.
+func sortNeeded(fs []scanner.File, cfg config.RepositoryConfiguration) {
+	var less func(a, b scanner.File) bool
+	switch cfg.PullOrder {
+	case config.PullOrderAlphabetic:
+		less = func(a, b scanner.File) bool { return a.Name < b.Name }
+	case config.PullOrderSmallestFirst:
+		less = func(a, b scanner.File) bool { return a.Size < b.Size }
+	case config.PullOrderLargestFirst:
+		less = func(a, b scanner.File) bool { return a.Size > b.Size }
+	case config.PullOrderOldestFirst:
+		less = func(a, b scanner.File) bool { return a.Modified < b.Modified }
+	case config.PullOrderNewestFirst:
+		less = func(a, b scanner.File) bool { return a.Modified > b.Modified }
+	default:
+		for i := range fs {
+			j := rand.Intn(i + 1)
+			fs[i], fs[j] = fs[j], fs[i]
+		}
+	}
+
+	s := neededSorter{files: fs, less: less}
+	if ranker := cfg.FileRanker(); ranker != nil {
+		s.ranks = make([]int, len(fs))
+		for i, f := range fs {
+			s.ranks[i] = ranker(f)
+		}
+	} else if less == nil {
+		return
+	}
+	sort.Stable(s)
+}
+
+type neededSorter struct {
+	files []scanner.File
+	ranks []int // by the sync order patterns, if any
+	less  func(a, b scanner.File) bool
+}
+
+func (s neededSorter) Len() int {
+	return len(s.files)
+}
+
+func (s neededSorter) Swap(i, j int) {
+	s.files[i], s.files[j] = s.files[j], s.files[i]
+	if s.ranks != nil {
+		s.ranks[i], s.ranks[j] = s.ranks[j], s.ranks[i]
+	}
+}
+
+func (s neededSorter) Less(i, j int) bool {
+	if s.ranks != nil && s.ranks[i] != s.ranks[j] {
+		return s.ranks[i] > s.ranks[j]
+	}
+	if s.less == nil {
+		return false
+	}
+	return s.less(s.files[i], s.files[j])
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestSortNeeded(t *testing.T) {
+	need := []scanner.File{
+		{Name: "b", Size: 3, Modified: 10},
+		{Name: "camera/a.jpg", Size: 5, Modified: 20},
+		{Name: "a", Size: 1, Modified: 30},
+		{Name: "c", Size: 2, Modified: 5},
+	}
+
+	var cases = []struct {
+		order string
+		names []string
+	}{
+		{config.PullOrderAlphabetic, []string{"a", "b", "c", "camera/a.jpg"}},
+		{config.PullOrderSmallestFirst, []string{"a", "c", "b", "camera/a.jpg"}},
+		{config.PullOrderLargestFirst, []string{"camera/a.jpg", "b", "c", "a"}},
+		{config.PullOrderOldestFirst, []string{"c", "b", "camera/a.jpg", "a"}},
+		{config.PullOrderNewestFirst, []string{"a", "camera/a.jpg", "b", "c"}},
+	}
+
+	names := func(fs []scanner.File) []string {
+		var ns []string
+		for _, f := range fs {
+			ns = append(ns, f.Name)
+		}
+		return ns
+	}
+
+	for _, tc := range cases {
+		fs := append([]scanner.File(nil), need...)
+		sortNeeded(fs, config.RepositoryConfiguration{PullOrder: tc.order})
+		if ns := names(fs); !reflect.DeepEqual(ns, tc.names) {
+			t.Errorf("Order %q: got %v, expected %v", tc.order, ns, tc.names)
+		}
+	}
+
+	// The sync order patterns come first
+	cfg := config.RepositoryConfiguration{
+		PullOrder:         config.PullOrderLargestFirst,
+		SyncOrderPatterns: []config.SyncOrderPattern{{Pattern: "^[ac]$", Priority: 10}},
+	}
+	fs := append([]scanner.File(nil), need...)
+	sortNeeded(fs, cfg)
+	if ns := names(fs); !reflect.DeepEqual(ns, []string{"c", "a", "camera/a.jpg", "b"}) {
+		t.Errorf("Unexpected order %v with sync order patterns", ns)
+	}
+
+	// Random order keeps all files
+	fs = append([]scanner.File(nil), need...)
+	sortNeeded(fs, config.RepositoryConfiguration{})
+	if len(fs) != len(need) {
+		t.Errorf("Unexpected files %v in random order", names(fs))
+	}
+}
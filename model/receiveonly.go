@@ -0,0 +1,130 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/calmh/syncthing/cid"
+	"github.com/calmh/syncthing/lamport"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// flagLocalChanged marks the files in the local index of a receive only
+// repository that were changed locally. Such files are suppressed, so that
+// they are neither sent to other nodes nor replaced by newer versions from
+// them. The flag is kept in the saved index, but never sent.
+const flagLocalChanged uint32 = 1 << 31
+
+// markLocalChanged marks the file found changed by a scan of a receive only
+// repository.
+func markLocalChanged(f scanner.File) scanner.File {
+	if !f.IsIgnored() {
+		f.Flags |= flagLocalChanged
+		f.Suppressed = true
+	}
+	return f
+}
+
+// withoutLocalFlags returns the index without the flags that are only
+// meaningful locally, for sending to other nodes.
+func withoutLocalFlags(idx []protocol.FileInfo) []protocol.FileInfo {
+	var res []protocol.FileInfo
+	for i, f := range idx {
+		if f.Flags&flagLocalChanged == 0 {
+			continue
+		}
+		if res == nil {
+			res = make([]protocol.FileInfo, len(idx))
+			copy(res, idx)
+		}
+		res[i].Flags &^= flagLocalChanged
+	}
+	if res == nil {
+		return idx
+	}
+	return res
+}
+
+// LocalChangedFiles returns the files in a receive only repository that
+// were changed locally, and are kept from the rest of the cluster.
+func (m *Model) LocalChangedFiles(repo string) []scanner.File {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	rf, ok := m.repoFiles[repo]
+	if !ok {
+		return nil
+	}
+	var fs []scanner.File
+	rf.WithHave(cid.LocalID, func(f scanner.File) bool {
+		if f.Flags&flagLocalChanged != 0 {
+			fs = append(fs, f)
+		}
+		return true
+	})
+	return fs
+}
+
+// Revert discards the local changes in a receive only repository. The
+// changed files get the global version again from the puller, and the files
+// that no other node has are removed.
+func (m *Model) Revert(repo string) {
+	m.rmut.RLock()
+	rf, ok := m.repoFiles[repo]
+	dir := m.repoCfgs[repo].Directory
+	m.rmut.RUnlock()
+	if !ok {
+		return
+	}
+
+	// The changed files get version zero, which any version on another
+	// node is newer than. The whole index is replaced, since lowering the
+	// version of a file requires recalculating the global version.
+	var reverted []string
+	fs := rf.Have(cid.LocalID)
+	for i, f := range fs {
+		if f.Flags&flagLocalChanged == 0 {
+			continue
+		}
+		f.Flags &^= flagLocalChanged
+		f.Suppressed = false
+		f.Version = 0
+		f.Vector = nil
+		fs[i] = f
+		reverted = append(reverted, f.Name)
+	}
+	if len(reverted) == 0 {
+		return
+	}
+	rf.Replace(cid.LocalID, fs)
+
+	// Contents before the directories they are in
+	sort.Sort(sort.Reverse(sort.StringSlice(reverted)))
+	var removed []scanner.File
+	for _, name := range reverted {
+		if gf := rf.GetGlobal(name); gf.Version != 0 {
+			// Another node has it
+			continue
+		}
+		f := rf.Get(cid.LocalID, name)
+		if !protocol.IsDeleted(f.Flags) {
+			if err := m.fs.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+				l.Warnf("Revert %q / %q: %v", repo, name, err)
+				continue
+			}
+		}
+		f.Flags |= protocol.FlagDeleted
+		f.Blocks = nil
+		f.Size = 0
+		f.Version = lamport.Default.Tick(0)
+		removed = append(removed, f)
+	}
+	rf.Update(cid.LocalID, removed)
+
+	l.Infof("Reverted %d local changes in %q", len(reverted), repo)
+}
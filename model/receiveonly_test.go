@@ -0,0 +1,86 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"os"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func localChangedNames(m *Model) map[string]bool {
+	names := make(map[string]bool)
+	for _, f := range m.LocalChangedFiles("default") {
+		names[f.Name] = true
+	}
+	return names
+}
+
+func TestReceiveOnly(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/a")
+	fd.Write([]byte("local"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", ReceiveOnly: true, IgnorePerms: true})
+	m.ScanRepo("default")
+
+	// The other node has a newer a, and b
+	other := m.cm.Get("other")
+	blocks := []scanner.Block{{Size: 6, Hash: make([]byte, 32)}}
+	m.repoFiles["default"].Replace(other, []scanner.File{
+		{Name: "a", Version: 1 << 40, Size: 6, Blocks: blocks},
+		{Name: "b", Version: 1 << 40, Size: 6, Blocks: blocks},
+	})
+
+	fd, _ = f.Create("repo/c")
+	fd.Write([]byte("only here"))
+	fd.Close()
+	m.ScanRepo("default")
+
+	if names := localChangedNames(m); len(names) != 2 || !names["a"] || !names["c"] {
+		t.Errorf("Unexpected local changes %v", names)
+	}
+	need := m.NeedFilesRepo("default")
+	if len(need) != 1 || need[0].Name != "b" {
+		t.Errorf("Unexpected need %v; local changes are not to be replaced", need)
+	}
+	for _, fi := range withoutLocalFlags(m.protocolIndex("default")) {
+		if fi.Flags&flagLocalChanged != 0 || !protocol.IsInvalid(fi.Flags) {
+			t.Errorf("Local change %q sent with flags 0%o", fi.Name, fi.Flags)
+		}
+	}
+
+	// Local changes stay local after a restart
+	m2 := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m2.fs = f
+	m2.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", ReceiveOnly: true, IgnorePerms: true})
+	m2.SeedLocal("default", m.protocolIndex("default"))
+	if lf := m2.CurrentRepoFile("default", "c"); !lf.Suppressed {
+		t.Errorf("Local change not suppressed after restart: %v", lf)
+	}
+
+	m.Revert("default")
+	if names := localChangedNames(m); len(names) != 0 {
+		t.Errorf("Unexpected local changes after revert %v", names)
+	}
+	if _, err := f.Lstat("repo/c"); !os.IsNotExist(err) {
+		t.Error("File that no other node has not removed:", err)
+	}
+	if lf := m.CurrentRepoFile("default", "c"); !protocol.IsDeleted(lf.Flags) || lf.Suppressed {
+		t.Errorf("Removed file not deleted in the index: %v", lf)
+	}
+	need = m.NeedFilesRepo("default")
+	if len(need) != 2 {
+		t.Errorf("Unexpected need after revert %v", need)
+	}
+}
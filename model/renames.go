@@ -0,0 +1,247 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+var (
+	errFileChanged = errors.New("file changed since it was scanned")
+	errFileExists  = errors.New("file exists")
+)
+
+// A renameSource is a local file that is to be deleted, and could be moved
+// instead.
+type renameSource struct {
+	local   scanner.File
+	deleted scanner.File // the global version
+}
+
+// blockListKey returns a key that is equal for equal block lists.
+func blockListKey(blocks []scanner.Block) string {
+	bs := make([]byte, 0, len(blocks)*32)
+	for _, b := range blocks {
+		bs = append(bs, b.Hash...)
+	}
+	return string(bs)
+}
+
+// isPlainFile returns true if f is a file and not a directory or a symlink.
+func isPlainFile(f scanner.File) bool {
+	return !protocol.IsDirectory(f.Flags) && !protocol.IsSymlink(f.Flags)
+}
+
+// renameSourceSet holds the local files that are to be deleted, by their
+// block lists and by their block group lists, for the new files that come
+// with groups.
+type renameSourceSet struct {
+	blocks map[string][]renameSource
+	groups map[string][]renameSource
+}
+
+// renameSources returns the local files to be deleted among the needed
+// files that could be moved instead.
+func (m *Model) renameSources(repo string, need []scanner.File) renameSourceSet {
+	set := renameSourceSet{
+		blocks: make(map[string][]renameSource),
+		groups: make(map[string][]renameSource),
+	}
+	for _, f := range need {
+		if !protocol.IsDeleted(f.Flags) || !isPlainFile(f) {
+			continue
+		}
+		lf := m.CurrentRepoFile(repo, f.Name)
+		if lf.Name == "" || protocol.IsDeleted(lf.Flags) || !isPlainFile(lf) || protocol.HasBlockGroups(lf.Flags) || len(lf.Blocks) == 0 {
+			continue
+		}
+		src := renameSource{lf, f}
+		key := blockListKey(lf.Blocks)
+		set.blocks[key] = append(set.blocks[key], src)
+		key = blockListKey(scanner.BlockGroups(lf.Blocks))
+		set.groups[key] = append(set.groups[key], src)
+	}
+	return set
+}
+
+func (s renameSourceSet) empty() bool {
+	return len(s.blocks) == 0
+}
+
+// candidates returns the sources with the same contents as the needed file.
+func (s renameSourceSet) candidates(f scanner.File) []renameSource {
+	sources := s.blocks
+	if protocol.HasBlockGroups(f.Flags) {
+		sources = s.groups
+	}
+	var res []renameSource
+	for _, src := range sources[blockListKey(f.Blocks)] {
+		if src.local.Size == f.Size {
+			res = append(res, src)
+		}
+	}
+	return res
+}
+
+// isRenameTarget returns true if the needed file could be the new name of a
+// local file that is to be deleted: a new plain file with contents.
+func (m *Model) isRenameTarget(repo string, f scanner.File) bool {
+	if protocol.IsDeleted(f.Flags) || !isPlainFile(f) || len(f.Blocks) == 0 {
+		return false
+	}
+	// A change to an existing file is not a rename
+	lf := m.CurrentRepoFile(repo, f.Name)
+	return lf.Name == "" || protocol.IsDeleted(lf.Flags)
+}
+
+// handleRenames carries out the renames among the needed files. A file to
+// delete and a new file with the same contents are taken to be the same file
+// moved, so the local file is moved to the new name instead of being
+// deleted while the new file is pulled. With versioning, the local file is
+// copied and then archived instead. The files that are left to pull are
+// returned.
+func (p *puller) handleRenames(need []scanner.File) []scanner.File {
+	sources := p.model.renameSources(p.repoCfg.ID, need)
+	if sources.empty() {
+		return need
+	}
+
+	moved := make(map[string]bool) // the names of the files moved and their sources
+	for _, f := range need {
+		if !p.model.isRenameTarget(p.repoCfg.ID, f) || p.skipPull(f) != nil {
+			continue
+		}
+		for _, src := range sources.candidates(f) {
+			if moved[src.local.Name] {
+				continue
+			}
+			if err := p.renameFile(src, f); err != nil {
+				if debug {
+					l.Debugf("pull: %q: rename %q to %q: %v", p.repoCfg.ID, src.local.Name, f.Name, err)
+				}
+				continue
+			}
+			moved[src.local.Name] = true
+			moved[f.Name] = true
+			break
+		}
+	}
+
+	var rest []scanner.File
+	for _, f := range need {
+		if !moved[f.Name] {
+			rest = append(rest, f)
+		}
+	}
+	return rest
+}
+
+// renameFile moves the local file of src to become the needed file f, which
+// has the same contents, and updates the local index for both.
+func (p *puller) renameFile(rs renameSource, f scanner.File) error {
+	src := rs.local
+	from := filepath.Join(p.repoCfg.Directory, src.Name)
+	to := filepath.Join(p.repoCfg.Directory, f.Name)
+
+	// The file must be as it was scanned, and must not overwrite anything
+	info, err := p.fs.Lstat(from)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() || info.Size() != src.Size || p.mtimes.Mtime(src.Name, info.ModTime()).Unix() != src.Modified {
+		return errFileChanged
+	}
+	if _, err := p.fs.Lstat(to); !os.IsNotExist(err) {
+		return errFileExists
+	}
+	if err := p.checkParents(f.Name); err != nil {
+		return err
+	}
+
+	if err := p.fs.MkdirAll(filepath.Dir(to), 0777); err != nil {
+		return err
+	}
+
+	if p.versioner == nil {
+		if debug {
+			l.Debugf("pull: %q: rename %q to %q", p.repoCfg.ID, src.Name, f.Name)
+		}
+		if err := p.fs.Rename(from, to); err != nil {
+			return err
+		}
+	} else {
+		if debug {
+			l.Debugf("pull: %q: copy %q to %q", p.repoCfg.ID, src.Name, f.Name)
+		}
+		temp := filepath.Join(p.repoCfg.Directory, defTempNamer.TempName(f.Name))
+		if err := p.copyFile(from, temp); err != nil {
+			p.fs.Remove(temp)
+			return err
+		}
+		if err := p.fs.Rename(temp, to); err != nil {
+			p.fs.Remove(temp)
+			return err
+		}
+		if err := p.versioner.Archive(from); err != nil {
+			// The file is in place; the old one is deleted the usual way
+			p.pullFailed(src.Name, err)
+		}
+	}
+
+	if err := p.mtimes.setMtime(p.fs, f.Name, to, f.Modified, f.ModifiedNs); debug && err != nil {
+		l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+	}
+	if !p.repoCfg.IgnorePerms && protocol.HasPermissionBits(f.Flags) {
+		if err := p.fs.Chmod(to, os.FileMode(f.Flags&0777)); debug && err != nil {
+			l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+		}
+	}
+	if err := p.applyACL(to, f); debug && err != nil {
+		l.Debugf("pull: error: %q / %q: %v", p.repoCfg.ID, f.Name, err)
+	}
+
+	// The local index gets the full block list even if the global one has
+	// groups, since the contents are the same.
+	f.Blocks = src.Blocks
+	f.Flags &^= protocol.FlagBlockGroups
+	p.model.reuse.renamed(p.repoCfg.ID, f.Size)
+	p.model.updateLocal(p.repoCfg.ID, f)
+
+	if _, err := p.fs.Lstat(from); os.IsNotExist(err) {
+		p.model.updateLocal(p.repoCfg.ID, rs.deleted)
+	}
+	return nil
+}
+
+// copyFile copies the contents of the file from to a new file to. At the open
+// files limit it fails, and the file is pulled instead.
+func (p *puller) copyFile(from, to string) error {
+	src, err := fs.TryOpen(p.fs, from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := fs.TryCreate(p.fs, to)
+	if err != nil {
+		return err
+	}
+	p.fs.Hide(to)
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return p.fs.Show(to)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+	"github.com/calmh/syncthing/versioner"
+)
+
+func setupRenameTest(t *testing.T) (*fs.FakeFilesystem, *Model, *puller) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/a", 0755)
+	for _, n := range []string{"repo/a/file", "repo/a/other"} {
+		fd, _ := f.Create(n)
+		fd.Write([]byte("contents of " + n))
+		fd.Close()
+	}
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo", IgnorePerms: true})
+	if err := m.ScanRepo("default"); err != nil {
+		t.Fatal(err)
+	}
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f, mtimes: m.repoMtimes["default"]}
+	return f, m, p
+}
+
+// renameNeed returns the needed files for a rename of the local file from
+// to the name to, and for a new file with other contents.
+func renameNeed(m *Model, from, to string) []scanner.File {
+	lf := m.CurrentRepoFile("default", from)
+	return []scanner.File{
+		{Name: from, Flags: protocol.FlagDeleted, Version: lf.Version + 1},
+		{Name: to, Flags: 0644, Modified: lf.Modified, Version: lf.Version + 2, Size: lf.Size, Blocks: lf.Blocks},
+		{Name: "new", Flags: 0644, Size: 3, Blocks: []scanner.Block{{Size: 3, Hash: make([]byte, 32)}}},
+	}
+}
+
+func TestHandleRenames(t *testing.T) {
+	f, m, p := setupRenameTest(t)
+	to := filepath.Join("b", "moved")
+	need := renameNeed(m, filepath.Join("a", "file"), to)
+
+	rest := p.handleRenames(need)
+	if len(rest) != 1 || rest[0].Name != "new" {
+		t.Fatalf("Unexpected files left to pull %v", rest)
+	}
+
+	if _, err := f.Lstat("repo/a/file"); !os.IsNotExist(err) {
+		t.Error("Renamed file still exists:", err)
+	}
+	fd, err := f.Open("repo/b/moved")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, _ := ioutil.ReadAll(fd)
+	fd.Close()
+	if string(bs) != "contents of repo/a/file" {
+		t.Errorf("Incorrect contents %q", bs)
+	}
+
+	if lf := m.CurrentRepoFile("default", to); lf.Version != need[1].Version || len(lf.Blocks) != 1 {
+		t.Errorf("Local index not updated for the new file: %v", lf)
+	}
+	if lf := m.CurrentRepoFile("default", filepath.Join("a", "file")); !protocol.IsDeleted(lf.Flags) {
+		t.Errorf("Local index not updated for the old file: %v", lf)
+	}
+	if rs := m.ReuseStats("default"); rs.Renamed != need[1].Size || rs.Reused != 0 {
+		t.Errorf("Unexpected reuse stats %+v", rs)
+	}
+}
+
+func TestHandleRenamesChanged(t *testing.T) {
+	f, m, p := setupRenameTest(t)
+	need := renameNeed(m, filepath.Join("a", "file"), "moved")
+
+	// Changed since it was scanned
+	fd, _ := f.Create("repo/a/file")
+	fd.Write([]byte("changed"))
+	fd.Close()
+
+	if rest := p.handleRenames(need); len(rest) != len(need) {
+		t.Errorf("Unexpected files left to pull %v", rest)
+	}
+	if _, err := f.Lstat("repo/moved"); !os.IsNotExist(err) {
+		t.Error("Changed file was renamed:", err)
+	}
+}
+
+func TestHandleRenamesVersioning(t *testing.T) {
+	f, m, p := setupRenameTest(t)
+	p.versioner = versioner.NewSimple("repo", f, map[string]string{"keep": "5"})
+	need := renameNeed(m, filepath.Join("a", "other"), "copied")
+
+	if rest := p.handleRenames(need); len(rest) != 1 {
+		t.Fatalf("Unexpected files left to pull %v", rest)
+	}
+	if _, err := f.Lstat("repo/copied"); err != nil {
+		t.Error("File not copied:", err)
+	}
+	if vs, _ := f.Glob("repo/a/.stversions/other~*"); len(vs) != 1 {
+		t.Errorf("Unexpected versions %v", vs)
+	}
+}
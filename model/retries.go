@@ -0,0 +1,251 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/osutil"
+	"github.com/calmh/syncthing/xdr"
+)
+
+// A file that failed to be pulled is not tried again until retryDelay has
+// passed, doubled for every failure in a row up to maxRetryDelay.
+const (
+	retryDelay    = time.Minute
+	maxRetryDelay = time.Hour
+)
+
+// A PullRetry describes a file that failed to be pulled, and when it will
+// be tried again.
+type PullRetry struct {
+	Name      string
+	Version   uint64
+	Failures  int
+	Error     string
+	NextRetry time.Time
+}
+
+type pullRetryList []PullRetry
+
+func (l pullRetryList) Len() int {
+	return len(l)
+}
+
+func (l pullRetryList) Less(a, b int) bool {
+	return l[a].Name < l[b].Name
+}
+
+func (l pullRetryList) Swap(a, b int) {
+	l[a], l[b] = l[b], l[a]
+}
+
+// A retryQueue holds the files of a repository that failed to be pulled, so
+// that they are not tried again at every turn of the puller while the
+// reason persists. A new version of a file is tried right away.
+type retryQueue struct {
+	retries map[string]PullRetry
+	dirty   bool // changed since it was saved
+	mut     sync.Mutex
+}
+
+func newRetryQueue() *retryQueue {
+	return &retryQueue{
+		retries: make(map[string]PullRetry),
+	}
+}
+
+// failed records that pulling the given version of the file failed, and
+// returns when it is to be tried again.
+func (q *retryQueue) failed(name string, version uint64, err error, now time.Time) time.Time {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	r, ok := q.retries[name]
+	if !ok || r.Version != version {
+		r = PullRetry{Name: name, Version: version}
+	}
+	r.Error = err.Error()
+	if r.Failures > 0 && now.Before(r.NextRetry) {
+		// Another failure of the same attempt, such as for another
+		// block of the file
+		q.retries[name] = r
+		return r.NextRetry
+	}
+	r.Failures++
+
+	delay := maxRetryDelay
+	if r.Failures < 16 {
+		if d := retryDelay << uint(r.Failures-1); d < maxRetryDelay {
+			delay = d
+		}
+	}
+	r.NextRetry = now.Add(delay)
+
+	q.retries[name] = r
+	q.dirty = true
+	return r.NextRetry
+}
+
+// succeeded forgets the failures of the file.
+func (q *retryQueue) succeeded(name string) {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	if _, ok := q.retries[name]; ok {
+		delete(q.retries, name)
+		q.dirty = true
+	}
+}
+
+// due returns true if the given version of the file is to be tried now.
+func (q *retryQueue) due(name string, version uint64, now time.Time) bool {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	r, ok := q.retries[name]
+	return !ok || r.Version != version || !now.Before(r.NextRetry)
+}
+
+// prune forgets the files that are no longer needed, for example because
+// they were deleted on the other nodes.
+func (q *retryQueue) prune(needed map[string]bool) {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	for name := range q.retries {
+		if !needed[name] {
+			delete(q.retries, name)
+			q.dirty = true
+		}
+	}
+}
+
+// list returns the files waiting to be retried, sorted by name.
+func (q *retryQueue) list() []PullRetry {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	var res = make([]PullRetry, 0, len(q.retries))
+	for _, r := range q.retries {
+		res = append(res, r)
+	}
+	sort.Sort(pullRetryList(res))
+	return res
+}
+
+// saveIfChanged saves the queue if it has changed since it was last saved
+// or loaded.
+func (q *retryQueue) saveIfChanged(name string) error {
+	q.mut.Lock()
+	dirty := q.dirty
+	q.mut.Unlock()
+	if !dirty {
+		return nil
+	}
+	return q.save(name)
+}
+
+func (q *retryQueue) save(name string) error {
+	tmp := fmt.Sprintf("%s.tmp.%d", name, time.Now().UnixNano())
+	fd, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	q.mut.Lock()
+	xw := xdr.NewWriter(fd)
+	xw.WriteUint32(uint32(len(q.retries)))
+	for _, r := range q.retries {
+		xw.WriteString(r.Name)
+		xw.WriteUint64(r.Version)
+		xw.WriteUint32(uint32(r.Failures))
+		if len(r.Error) > 1024 {
+			r.Error = r.Error[:1024]
+		}
+		xw.WriteString(r.Error)
+		xw.WriteUint64(uint64(r.NextRetry.UnixNano()))
+	}
+	q.dirty = false
+	q.mut.Unlock()
+
+	if err := xw.Error(); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return osutil.Rename(tmp, name)
+}
+
+func (q *retryQueue) load(name string) error {
+	fd, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	retries := make(map[string]PullRetry)
+	xr := xdr.NewReader(fd)
+	n := int(xr.ReadUint32())
+	for i := 0; i < n && xr.Error() == nil; i++ {
+		var r PullRetry
+		r.Name = xr.ReadStringMax(1024)
+		r.Version = xr.ReadUint64()
+		r.Failures = int(xr.ReadUint32())
+		r.Error = xr.ReadStringMax(1024)
+		r.NextRetry = time.Unix(0, int64(xr.ReadUint64()))
+		retries[r.Name] = r
+	}
+	if err := xr.Error(); err != nil {
+		return err
+	}
+
+	q.mut.Lock()
+	q.retries = retries
+	q.dirty = false
+	q.mut.Unlock()
+	return nil
+}
+
+// retriesFile returns the name of the file holding the pull retry queue for
+// the repo, next to the index.
+func (m *Model) retriesFile(repo string, dir string) string {
+	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
+	return filepath.Join(dir, id+".idx.retries")
+}
+
+func (m *Model) repoRetries(repo string) *retryQueue {
+	m.rmut.RLock()
+	defer m.rmut.RUnlock()
+	return m.retries[repo]
+}
+
+// saveRetries saves the pull retry queue of the repo, if it has changed.
+func (m *Model) saveRetries(repo string) {
+	m.rmut.RLock()
+	q := m.retries[repo]
+	name := m.retriesFile(repo, m.indexDir)
+	m.rmut.RUnlock()
+	if err := q.saveIfChanged(name); err != nil {
+		l.Infof("Saving pull retries for %q: %v", repo, err)
+	}
+}
+
+// PullRetries returns the files in the repository that failed to be
+// pulled, and when they will be tried again.
+func (m *Model) PullRetries(repo string) []PullRetry {
+	m.rmut.RLock()
+	q, ok := m.retries[repo]
+	m.rmut.RUnlock()
+	if !ok {
+		return nil
+	}
+	return q.list()
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRetryQueueBackoff(t *testing.T) {
+	q := newRetryQueue()
+	now := time.Now()
+	err := errors.New("permission denied")
+
+	if !q.due("a", 1, now) {
+		t.Error("File that never failed not due")
+	}
+
+	next := q.failed("a", 1, err, now)
+	if d := next.Sub(now); d != retryDelay {
+		t.Errorf("Unexpected first delay %v", d)
+	}
+	if q.due("a", 1, now) {
+		t.Error("Failed file due right away")
+	}
+	if !q.due("a", 2, now) {
+		t.Error("New version of failed file not due")
+	}
+
+	// Failures of the same attempt count once
+	q.failed("a", 1, err, now.Add(time.Second))
+	if r := q.list(); len(r) != 1 || r[0].Failures != 1 {
+		t.Errorf("Unexpected retries %+v", r)
+	}
+
+	// Failing again after the delay doubles it, up to the maximum
+	now = next
+	if !q.due("a", 1, now) {
+		t.Error("Failed file not due after the delay")
+	}
+	if d := q.failed("a", 1, err, now).Sub(now); d != 2*retryDelay {
+		t.Errorf("Unexpected second delay %v", d)
+	}
+	for i := 0; i < 20; i++ {
+		now = now.Add(maxRetryDelay)
+		next = q.failed("a", 1, err, now)
+	}
+	if d := next.Sub(now); d != maxRetryDelay {
+		t.Errorf("Unexpected delay %v after many failures", d)
+	}
+
+	// A new version starts over
+	if d := q.failed("a", 2, err, now).Sub(now); d != retryDelay {
+		t.Errorf("Unexpected delay %v for new version", d)
+	}
+
+	q.succeeded("a")
+	if !q.due("a", 2, now) || len(q.list()) != 0 {
+		t.Error("File not forgotten after success")
+	}
+
+	// A file that has no global version yet is recorded by name too
+	q.failed("b", 0, err, now)
+	if r := q.list(); len(r) != 1 || r[0].Name != "b" {
+		t.Errorf("Unexpected retries %+v", r)
+	}
+}
+
+func TestRetryQueuePruneSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "retries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "retries")
+
+	q := newRetryQueue()
+	now := time.Now()
+	q.failed("a", 1, errors.New("source vanished"), now)
+	q.failed("b", 1, errHashMismatch, now)
+	q.prune(map[string]bool{"b": true})
+	if err := q.saveIfChanged(name); err != nil {
+		t.Fatal(err)
+	}
+
+	q2 := newRetryQueue()
+	if err := q2.load(name); err != nil {
+		t.Fatal(err)
+	}
+	if l1, l2 := q.list(), q2.list(); len(l2) != 1 || l1[0].Error != l2[0].Error || !l1[0].NextRetry.Equal(l2[0].NextRetry) {
+		t.Errorf("Loaded retries %+v differ from saved %+v", l2, l1)
+	}
+	if q2.due("b", 1, now) {
+		t.Error("Loaded retry due right away")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import "sync"
+
+// ReuseStats tells how the bytes of the files pulled into a repository were
+// obtained since startup.
+type ReuseStats struct {
+	Reused       int64            // copied from the current local version of the file
+	Deduplicated int64            // copied from blocks of other local files
+	Renamed      int64            // moved from a local file that was to be deleted
+	Unchanged    int64            // already in place; only the metadata changed
+	Downloaded   map[string]int64 // nodeID -> bytes fetched over the network
+}
+
+// Total returns the number of bytes pulled, however they were obtained.
+func (s ReuseStats) Total() int64 {
+	t := s.Reused + s.Deduplicated + s.Renamed + s.Unchanged
+	for _, n := range s.Downloaded {
+		t += n
+	}
+	return t
+}
+
+// reuseStats keeps the ReuseStats of each repository.
+type reuseStats struct {
+	repos map[string]*ReuseStats
+	mut   sync.Mutex
+}
+
+func newReuseStats() *reuseStats {
+	return &reuseStats{
+		repos: make(map[string]*ReuseStats),
+	}
+}
+
+// get returns the stats for the repository, creating them as necessary.
+// Must be called with the lock held.
+func (s *reuseStats) get(repo string) *ReuseStats {
+	rs, ok := s.repos[repo]
+	if !ok {
+		rs = &ReuseStats{Downloaded: make(map[string]int64)}
+		s.repos[repo] = rs
+	}
+	return rs
+}
+
+func (s *reuseStats) reused(repo string, bytes int64) {
+	s.mut.Lock()
+	s.get(repo).Reused += bytes
+	s.mut.Unlock()
+}
+
+func (s *reuseStats) deduplicated(repo string, bytes int64) {
+	s.mut.Lock()
+	s.get(repo).Deduplicated += bytes
+	s.mut.Unlock()
+}
+
+func (s *reuseStats) renamed(repo string, bytes int64) {
+	s.mut.Lock()
+	s.get(repo).Renamed += bytes
+	s.mut.Unlock()
+}
+
+func (s *reuseStats) unchanged(repo string, bytes int64) {
+	s.mut.Lock()
+	s.get(repo).Unchanged += bytes
+	s.mut.Unlock()
+}
+
+func (s *reuseStats) downloaded(repo, node string, bytes int64) {
+	s.mut.Lock()
+	s.get(repo).Downloaded[node] += bytes
+	s.mut.Unlock()
+}
+
+// stats returns a copy of the stats for the repository.
+func (s *reuseStats) stats(repo string) ReuseStats {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	rs := s.get(repo)
+	res := ReuseStats{
+		Reused:       rs.Reused,
+		Deduplicated: rs.Deduplicated,
+		Renamed:      rs.Renamed,
+		Unchanged:    rs.Unchanged,
+		Downloaded:   make(map[string]int64, len(rs.Downloaded)),
+	}
+	for node, n := range rs.Downloaded {
+		res.Downloaded[node] = n
+	}
+	return res
+}
+
+// ReuseStats returns how the bytes pulled into the repository since startup
+// were obtained, so that the savings of copying blocks that are already
+// present, over downloading them, can be seen.
+func (m *Model) ReuseStats(repo string) ReuseStats {
+	return m.reuse.stats(repo)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestReuseStats(t *testing.T) {
+	s := newReuseStats()
+	s.reused("default", 100)
+	s.reused("default", 28)
+	s.deduplicated("default", 16)
+	s.renamed("default", 2000)
+	s.unchanged("default", 1000)
+	s.downloaded("default", "node1", 64)
+	s.downloaded("default", "node2", 32)
+	s.downloaded("default", "node1", 64)
+	s.downloaded("other", "node1", 1)
+
+	rs := s.stats("default")
+	if rs.Reused != 128 || rs.Deduplicated != 16 || rs.Renamed != 2000 || rs.Unchanged != 1000 {
+		t.Errorf("Unexpected stats %+v", rs)
+	}
+	if rs.Downloaded["node1"] != 128 || rs.Downloaded["node2"] != 32 {
+		t.Errorf("Unexpected downloads %v", rs.Downloaded)
+	}
+	if tot := rs.Total(); tot != 3304 {
+		t.Errorf("Unexpected total %d != 3304", tot)
+	}
+
+	// The returned stats are a copy
+	rs.Downloaded["node1"] = 0
+	if rs := s.stats("default"); rs.Downloaded["node1"] != 128 {
+		t.Error("Stats were modified through the copy")
+	}
+
+	if rs := s.stats("missing"); rs.Total() != 0 {
+		t.Errorf("Unexpected stats for unknown repo %+v", rs)
+	}
+}
+
+func TestCopyLocalBlock(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/orig")
+	fd.Write([]byte("some data to reuse"))
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f}
+
+	block := m.CurrentRepoFile("default", "orig").Blocks[0]
+	dst := scanner.File{Name: "copy", Blocks: []scanner.Block{block}}
+	of := openFile{}
+	of.file, _ = f.Create("repo/copy.tmp")
+	defer of.file.Close()
+
+	if !p.copyLocalBlock(of, dst, block) {
+		t.Fatal("Block not copied")
+	}
+	bs := make([]byte, block.Size)
+	if _, err := of.file.ReadAt(bs, 0); err != nil || string(bs) != "some data to reuse" {
+		t.Errorf("Incorrect copy %q, %v", bs, err)
+	}
+	if rs := m.ReuseStats("default"); rs.Deduplicated != int64(block.Size) || rs.Reused != 0 {
+		t.Errorf("Unexpected reuse stats %+v", rs)
+	}
+
+	// A changed source file is not used
+	fd, _ = f.Create("repo/orig")
+	fd.Write([]byte("other data of same size"[:block.Size]))
+	fd.Close()
+	if p.copyLocalBlock(of, dst, block) {
+		t.Error("Block copied from changed file")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/calmh/syncthing/lamport"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+// A runningScan can be told to stop, and tells when it has.
+type runningScan struct {
+	repo string
+	stop chan struct{}
+	done chan struct{}
+}
+
+func (m *Model) startScan(repo string) *runningScan {
+	s := &runningScan{
+		repo: repo,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	m.smut.Lock()
+	select {
+	case <-m.stop:
+		// The model is stopped; the scan returns right away
+		close(s.stop)
+	default:
+		m.scans[s] = true
+	}
+	m.smut.Unlock()
+	return s
+}
+
+func (m *Model) endScan(s *runningScan) {
+	m.smut.Lock()
+	delete(m.scans, s)
+	m.smut.Unlock()
+	close(s.done)
+}
+
+// CancelScans stops the scans in progress and waits for them to return,
+// for example before shutting down. A full scan that is stopped leaves a
+// checkpoint, from which the next full scan of the repository carries on.
+func (m *Model) CancelScans() {
+	m.cancelScans("")
+}
+
+// cancelScans stops the scans of the repo, or of all repos if repo is
+// empty, and waits for them to return.
+func (m *Model) cancelScans(repo string) {
+	m.smut.Lock()
+	var scans []*runningScan
+	for s := range m.scans {
+		if repo == "" || s.repo == repo {
+			close(s.stop)
+			delete(m.scans, s)
+			scans = append(scans, s)
+		}
+	}
+	m.smut.Unlock()
+
+	for _, s := range scans {
+		<-s.done
+	}
+}
+
+// scanCheckpointFile returns the name of the file holding the files found by
+// the last full scan of the repo that was stopped.
+func (m *Model) scanCheckpointFile(repo string) string {
+	id := fmt.Sprintf("%x", sha1.Sum([]byte(m.repoCfgs[repo].Directory)))
+	return filepath.Join(m.indexDir, id+".idx.scan.gz")
+}
+
+// saveScanCheckpoint saves the files found by a stopped full scan.
+func (m *Model) saveScanCheckpoint(repo string, fs []scanner.File) {
+	m.rmut.RLock()
+	name := m.scanCheckpointFile(repo)
+	m.rmut.RUnlock()
+
+	fis := make([]protocol.FileInfo, len(fs))
+	for i, f := range fs {
+		fis[i] = fileInfoFromFile(f)
+	}
+	if err := writeIndexFile(name, repo, fis); err != nil {
+		l.Infof("Saving scan checkpoint for %q: %v", repo, err)
+		return
+	}
+	l.Infof("Scan of %q stopped after %d files; it will be resumed", repo, len(fs))
+}
+
+// loadScanCheckpoint returns the files found by the last full scan of the
+// repo that was stopped, if any, and removes the checkpoint.
+func (m *Model) loadScanCheckpoint(repo string) []scanner.File {
+	m.rmut.RLock()
+	name := m.scanCheckpointFile(repo)
+	m.rmut.RUnlock()
+
+	fis := readIndexFile(name, repo)
+	os.Remove(name)
+	if len(fis) == 0 {
+		return nil
+	}
+
+	fs := make([]scanner.File, len(fis))
+	for i, fi := range fis {
+		fs[i] = fileFromFileInfo(fi)
+		// Files changed since are rescanned, and must get later versions
+		lamport.Default.Tick(fi.Version)
+	}
+	if debug {
+		l.Debugf("%q: resuming scan after %d files", repo, len(fs))
+	}
+	return fs
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestScanCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := NewModel(dir, &config.Configuration{}, "syncthing", "dev")
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "testdata"})
+
+	// A stopped scan got as far as "bar". The next scan carries on from
+	// there, keeping what the stopped scan found.
+	bar := scanner.File{Name: "bar", Version: 42, Modified: 1, Size: 10, Blocks: []scanner.Block{{Size: 10, Hash: []byte{1}}}}
+	m.saveScanCheckpoint("default", []scanner.File{bar})
+
+	if err := m.ScanRepo("default"); err != nil {
+		t.Fatal(err)
+	}
+	if f := m.CurrentRepoFile("default", "bar"); f.Version != 42 {
+		t.Errorf("Scan did not resume; bar is %v", f)
+	}
+	if f := m.CurrentRepoFile("default", "foo"); f.Name != "foo" {
+		t.Error("Scan did not carry on after the checkpoint")
+	}
+	if _, err := os.Stat(m.scanCheckpointFile("default")); !os.IsNotExist(err) {
+		t.Error("Checkpoint was not removed")
+	}
+
+	// Without a checkpoint, the scan starts over.
+	if err := m.ScanRepo("default"); err != nil {
+		t.Fatal(err)
+	}
+	if f := m.CurrentRepoFile("default", "bar"); f.Version == 42 {
+		t.Error("Unexpected resume without checkpoint")
+	}
+}
+
+func TestCancelScans(t *testing.T) {
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+
+	s := m.startScan("default")
+	go func() {
+		<-s.stop
+		m.endScan(s)
+	}()
+	other := m.startScan("other")
+	go func() {
+		<-other.stop
+		m.endScan(other)
+	}()
+	m.cancelScans("other")
+	select {
+	case <-s.stop:
+		t.Fatal("Scan of another repository stopped")
+	default:
+	}
+	m.CancelScans()
+
+	select {
+	case <-s.done:
+	default:
+		t.Error("CancelScans returned before the scan ended")
+	}
+	m.CancelScans()
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// A scheduler hands out a fixed budget of worker slots, shared by the
+// scanners and pullers of all repositories. Waiters with a higher priority
+// are served first, and waiters with equal priority in the order they
+// arrived.
+type scheduler struct {
+	budget  int
+	inUse   int
+	seq     int
+	waiting waiterQueue
+	mut     sync.Mutex
+}
+
+// newScheduler returns a scheduler with the given budget. A budget of zero
+// or less means no limit.
+func newScheduler(budget int) *scheduler {
+	return &scheduler{budget: budget}
+}
+
+// acquire blocks until a slot is available for a worker of the given
+// priority.
+func (s *scheduler) acquire(prio int) {
+	s.mut.Lock()
+	if s.budget <= 0 || s.inUse < s.budget && len(s.waiting) == 0 {
+		s.inUse++
+		s.mut.Unlock()
+		return
+	}
+
+	w := &waiter{prio: prio, seq: s.seq, ready: make(chan struct{})}
+	s.seq++
+	heap.Push(&s.waiting, w)
+	s.mut.Unlock()
+
+	<-w.ready
+}
+
+// release returns a slot obtained by acquire, handing it to the highest
+// priority waiter if there is one.
+func (s *scheduler) release() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if len(s.waiting) > 0 {
+		w := heap.Pop(&s.waiting).(*waiter)
+		close(w.ready)
+		return
+	}
+	s.inUse--
+}
+
+// stats returns the number of slots in use and the number of waiters.
+func (s *scheduler) stats() (inUse, waiting int) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.inUse, len(s.waiting)
+}
+
+type waiter struct {
+	prio  int
+	seq   int
+	ready chan struct{}
+}
+
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int {
+	return len(q)
+}
+
+func (q waiterQueue) Less(a, b int) bool {
+	if q[a].prio != q[b].prio {
+		return q[a].prio > q[b].prio
+	}
+	return q[a].seq < q[b].seq
+}
+
+func (q waiterQueue) Swap(a, b int) {
+	q[a], q[b] = q[b], q[a]
+}
+
+func (q *waiterQueue) Push(x interface{}) {
+	*q = append(*q, x.(*waiter))
+}
+
+func (q *waiterQueue) Pop() interface{} {
+	old := *q
+	w := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return w
+}
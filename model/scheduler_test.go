@@ -0,0 +1,56 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerPriority(t *testing.T) {
+	s := newScheduler(1)
+	s.acquire(0)
+
+	order := make(chan int, 3)
+	var wg sync.WaitGroup
+	for _, prio := range []int{1, 10, 5} {
+		prio := prio
+		wg.Add(1)
+		go func() {
+			s.acquire(prio)
+			order <- prio
+			s.release()
+			wg.Done()
+		}()
+		// Make sure the waiters queue up in a known order
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case <-order:
+		t.Fatal("budget exceeded")
+	default:
+	}
+
+	s.release()
+	for _, expected := range []int{10, 5, 1} {
+		if prio := <-order; prio != expected {
+			t.Errorf("incorrect order; got %d, expected %d", prio, expected)
+		}
+	}
+
+	wg.Wait()
+	if inUse, _ := s.stats(); inUse != 0 {
+		t.Errorf("%d slots still in use", inUse)
+	}
+}
+
+func TestSchedulerUnlimited(t *testing.T) {
+	s := newScheduler(0)
+	for i := 0; i < 100; i++ {
+		s.acquire(0)
+	}
+}
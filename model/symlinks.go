@@ -0,0 +1,106 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/calmh/syncthing/osutil"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+var errSymlinkParent = errors.New("a parent directory is a symbolic link")
+
+// handleSymlink creates, replaces or removes the symlink f. Symlinks are
+// recreated with their target as is; the target is never followed. Targets
+// outside the repository are marked invalid when the index is received.
+func (p *puller) handleSymlink(f scanner.File) {
+	path := filepath.Join(p.repoCfg.Directory, f.Name)
+
+	if err := p.checkParents(f.Name); err != nil {
+		p.pullFailed(f.Name, err)
+		return
+	}
+
+	if protocol.IsDeleted(f.Flags) {
+		if debug {
+			l.Debugf("pull: delete symlink %q / %q", p.repoCfg.ID, f.Name)
+		}
+		if info, err := p.fs.Lstat(path); err == nil && info.Mode()&os.ModeSymlink == 0 {
+			// Something else has taken its place, which is not ours to
+			// remove.
+			p.model.updateLocal(p.repoCfg.ID, f)
+			return
+		}
+		if err := p.remove(path); err == nil || os.IsNotExist(err) {
+			p.model.updateLocal(p.repoCfg.ID, f)
+		} else {
+			p.pullFailed(f.Name, err)
+		}
+		return
+	}
+
+	if info, err := p.fs.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSymlink != 0 {
+			if target, err := p.fs.Readlink(path); err == nil && target == f.Target {
+				p.model.updateLocal(p.repoCfg.ID, f)
+				return
+			}
+		}
+		// A file, empty directory or link with another target is
+		// replaced.
+		if err := p.remove(path); err != nil && !os.IsNotExist(err) {
+			p.pullFailed(f.Name, err)
+			return
+		}
+	}
+
+	if debug {
+		l.Debugf("pull: symlink %q / %q -> %q", p.repoCfg.ID, f.Name, f.Target)
+	}
+	if dir := filepath.Dir(path); dir != p.repoCfg.Directory {
+		if err := p.fs.MkdirAll(dir, 0777); err != nil {
+			p.pullFailed(f.Name, err)
+			return
+		}
+	}
+	if err := p.fs.Symlink(f.Target, path); err != nil {
+		p.pullFailed(f.Name, err)
+		return
+	}
+	p.model.updateLocal(p.repoCfg.ID, f)
+}
+
+// skipPull returns an error if the needed file f must not be pulled here:
+// when it is a symlink and symlinks cannot be created, or when it is below
+// a directory that has been replaced by a symlink locally, since writing to
+// it would then change files outside the repository.
+func (p *puller) skipPull(f scanner.File) error {
+	if protocol.IsSymlink(f.Flags) && !p.model.symlinks {
+		return osutil.ErrSymlinksNotSupported
+	}
+	return p.checkParents(f.Name)
+}
+
+// checkParents returns errSymlinkParent if a parent directory of the named
+// file is a symlink. It is called when the file is queued and again right
+// before anything is written, since one index can hold both a symlink and
+// files below it.
+func (p *puller) checkParents(name string) error {
+	for dir := filepath.Dir(name); dir != "."; dir = filepath.Dir(dir) {
+		info, err := p.fs.Lstat(filepath.Join(p.repoCfg.Directory, dir))
+		if err != nil {
+			// Doesn't exist yet and will be created as a directory.
+			continue
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return errSymlinkParent
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/calmh/syncthing/config"
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/osutil"
+	"github.com/calmh/syncthing/protocol"
+	"github.com/calmh/syncthing/scanner"
+)
+
+func TestHandleSymlink(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/file")
+	fd.Close()
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	m.ScanRepo("default")
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f}
+
+	var tests = []struct {
+		name, target string
+	}{
+		{filepath.Join("a", "link"), "../file"},    // new, in a missing dir
+		{filepath.Join("a", "link"), "/elsewhere"}, // new target
+		{"file", "a"}, // replaces a file
+	}
+	for i, tc := range tests {
+		sf := scanner.File{
+			Name:    tc.name,
+			Flags:   protocol.FlagSymlink | protocol.FlagNoPermBits | 0666,
+			Version: uint64(1000 + i),
+			Target:  tc.target,
+		}
+		p.handleSymlink(sf)
+		if target, err := f.Readlink(filepath.Join("repo", tc.name)); err != nil || target != tc.target {
+			t.Errorf("#%d: Incorrect link %q, %v", i, target, err)
+		}
+		if lf := m.CurrentRepoFile("default", tc.name); lf.Version != sf.Version {
+			t.Errorf("#%d: Local index not updated: %v", i, lf)
+		}
+	}
+
+	p.handleSymlink(scanner.File{
+		Name:    "file",
+		Flags:   protocol.FlagSymlink | protocol.FlagDeleted,
+		Version: 2000,
+	})
+	if _, err := f.Lstat("repo/file"); !os.IsNotExist(err) {
+		t.Error("Deleted link still exists:", err)
+	}
+}
+
+func TestSkipPull(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/dir", 0755)
+	f.Symlink("/etc", "repo/link")
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.fs = f
+	m.symlinks = true
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: "repo"})
+	p := &puller{repoCfg: m.repoCfgs["default"], model: m, fs: f}
+
+	link := scanner.File{Name: "other", Flags: protocol.FlagSymlink, Target: "x"}
+	var tests = []struct {
+		f   scanner.File
+		err error
+	}{
+		{scanner.File{Name: filepath.Join("dir", "file")}, nil},
+		{scanner.File{Name: filepath.Join("missing", "file")}, nil},
+		{scanner.File{Name: filepath.Join("link", "passwd")}, errSymlinkParent},
+		{scanner.File{Name: filepath.Join("link", "x", "y"), Flags: protocol.FlagDeleted}, errSymlinkParent},
+		{link, nil},
+	}
+	for i, tc := range tests {
+		if err := p.skipPull(tc.f); err != tc.err {
+			t.Errorf("#%d: Unexpected error %v != %v", i, err, tc.err)
+		}
+	}
+
+	m.symlinks = false
+	if err := p.skipPull(link); err != osutil.ErrSymlinksNotSupported {
+		t.Errorf("Unexpected error %v for unsupported symlink", err)
+	}
+}
+
+func TestSymlinkThenChild(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need privileges on Windows")
+	}
+
+	dir, err := ioutil.TempDir("", "symlinks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	repo := filepath.Join(dir, "repo")
+	outside := filepath.Join(dir, "outside")
+	os.Mkdir(repo, 0755)
+	os.Mkdir(outside, 0755)
+
+	m := NewModel("/tmp", &config.Configuration{}, "syncthing", "dev")
+	m.symlinks = true
+	m.AddRepo(config.RepositoryConfiguration{ID: "default", Directory: repo, IgnorePerms: true})
+	m.ReplaceLocal("default", nil)
+	p := &puller{
+		repoCfg:           m.repoCfgs["default"],
+		model:             m,
+		fs:                fs.DefaultFilesystem,
+		mtimes:            m.repoMtimes["default"],
+		oustandingPerNode: make(activityMap),
+		openFiles:         make(map[string]openFile),
+	}
+
+	// Both are queued before the link exists, so the queue time check
+	// passes for the file below it.
+	link := scanner.File{Name: "link", Flags: protocol.FlagSymlink, Version: 1, Target: outside}
+	child := scanner.File{Name: filepath.Join("link", "passwd"), Version: 1}
+	sub := scanner.File{Name: filepath.Join("link", "dir"), Flags: protocol.FlagDirectory, Version: 1}
+	for _, f := range []scanner.File{link, child, sub} {
+		if err := p.skipPull(f); err != nil {
+			t.Fatalf("%q: %v", f.Name, err)
+		}
+	}
+
+	p.handleBlock(bqBlock{file: link, last: true})
+	if target, err := os.Readlink(filepath.Join(repo, "link")); err != nil || target != outside {
+		t.Fatalf("Link not created: %q, %v", target, err)
+	}
+	p.handleBlock(bqBlock{file: child, first: true, last: true})
+	p.handleBlock(bqBlock{file: sub, last: true})
+
+	if names, _ := ioutil.ReadDir(outside); len(names) != 0 {
+		t.Errorf("Wrote outside the repository: %v", names)
+	}
+	errs := make(map[string]string)
+	for _, r := range m.PullRetries("default") {
+		errs[r.Name] = r.Error
+	}
+	for _, f := range []scanner.File{child, sub} {
+		if lf := m.CurrentRepoFile("default", f.Name); lf.Version == f.Version {
+			t.Errorf("%q marked as pulled", f.Name)
+		}
+		if errs[f.Name] != errSymlinkParent.Error() {
+			t.Errorf("%q: unexpected error %q", f.Name, errs[f.Name])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import "sync"
+
+// An uploadQueue limits the number of concurrent disk reads done to answer
+// requests from other nodes. Requests beyond the limit wait in a queue per
+// node, and the queues are served in turn so that a node with many
+// outstanding requests does not delay the requests of other nodes.
+type uploadQueue struct {
+	maxReads  int // concurrent reads, shared by all nodes; zero for no limit
+	maxQueued int // waiting requests per node; zero for no limit
+	reads     int
+	waiting   map[string][]chan struct{}
+	turns     []string // nodes with waiting requests, in the order they are served
+	mut       sync.Mutex
+}
+
+func newUploadQueue(maxReads, maxQueued int) *uploadQueue {
+	return &uploadQueue{
+		maxReads:  maxReads,
+		maxQueued: maxQueued,
+		waiting:   make(map[string][]chan struct{}),
+	}
+}
+
+// acquire blocks until the node may read, or returns ErrQueueFull if the
+// node already has too many requests waiting.
+func (q *uploadQueue) acquire(node string) error {
+	q.mut.Lock()
+	if q.maxReads <= 0 || q.reads < q.maxReads && len(q.turns) == 0 {
+		q.reads++
+		q.mut.Unlock()
+		return nil
+	}
+
+	queued := q.waiting[node]
+	if q.maxQueued > 0 && len(queued) >= q.maxQueued {
+		q.mut.Unlock()
+		return ErrQueueFull
+	}
+	if len(queued) == 0 {
+		q.turns = append(q.turns, node)
+	}
+	ready := make(chan struct{})
+	q.waiting[node] = append(queued, ready)
+	q.mut.Unlock()
+
+	<-ready
+	return nil
+}
+
+// release ends a read started by acquire, handing it over to the first
+// waiting request of the next node in turn.
+func (q *uploadQueue) release() {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	if len(q.turns) == 0 {
+		q.reads--
+		return
+	}
+
+	node := q.turns[0]
+	q.turns = q.turns[1:]
+	queued := q.waiting[node]
+	close(queued[0])
+	if len(queued) > 1 {
+		q.waiting[node] = queued[1:]
+		q.turns = append(q.turns, node)
+	} else {
+		delete(q.waiting, node)
+	}
+}
+
+// stats returns the number of reads in progress and the number of waiting
+// requests per node.
+func (q *uploadQueue) stats() (reads int, waiting map[string]int) {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	waiting = make(map[string]int, len(q.waiting))
+	for node, queued := range q.waiting {
+		waiting[node] = len(queued)
+	}
+	return q.reads, waiting
+}
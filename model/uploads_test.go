@@ -0,0 +1,62 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUploadQueueFairness(t *testing.T) {
+	q := newUploadQueue(1, 3)
+	if err := q.acquire("a"); err != nil {
+		t.Fatal(err)
+	}
+
+	order := make(chan string, 5)
+	for _, node := range []string{"a", "a", "a", "b", "c"} {
+		node := node
+		go func() {
+			if err := q.acquire(node); err != nil {
+				t.Error(err)
+				return
+			}
+			order <- node
+			q.release()
+		}()
+		// Make sure the requests queue up in a known order
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := q.acquire("a"); err != ErrQueueFull {
+		t.Errorf("expected ErrQueueFull, got %v", err)
+	}
+
+	select {
+	case <-order:
+		t.Fatal("read limit exceeded")
+	default:
+	}
+
+	q.release()
+	for _, expected := range []string{"a", "b", "c", "a", "a"} {
+		if node := <-order; node != expected {
+			t.Errorf("incorrect order; got %s, expected %s", node, expected)
+		}
+	}
+
+	if reads, waiting := q.stats(); reads != 0 || len(waiting) != 0 {
+		t.Errorf("%d reads and %d waiting after release", reads, len(waiting))
+	}
+}
+
+func TestUploadQueueUnlimited(t *testing.T) {
+	q := newUploadQueue(0, 0)
+	for i := 0; i < 100; i++ {
+		if err := q.acquire("a"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -8,10 +8,19 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/calmh/syncthing/config"
 	"github.com/calmh/syncthing/protocol"
 	"github.com/calmh/syncthing/scanner"
 )
 
+// The protocol limits on the node names and addresses in the Cluster Config
+// message.
+const (
+	maxNodeNameLen    = 64
+	maxNodeAddresses  = 16
+	maxNodeAddressLen = 256
+)
+
 func fileFromFileInfo(f protocol.FileInfo) scanner.File {
 	var blocks = make([]scanner.Block, len(f.Blocks))
 	var offset int64
@@ -27,10 +36,14 @@ func fileFromFileInfo(f protocol.FileInfo) scanner.File {
 		// Name is with native separator and normalization
 		Name:       filepath.FromSlash(f.Name),
 		Size:       offset,
-		Flags:      f.Flags &^ protocol.FlagInvalid,
+		Flags:      f.Flags &^ (protocol.FlagInvalid | protocol.FlagVector | protocol.FlagModifiedNs),
 		Modified:   f.Modified,
+		ModifiedNs: f.ModifiedNs,
 		Version:    f.Version,
+		Vector:     f.Vector,
 		Blocks:     blocks,
+		ACL:        f.ACL,
+		Target:     filepath.FromSlash(f.Target),
 		Suppressed: f.Flags&protocol.FlagInvalid != 0,
 	}
 }
@@ -44,11 +57,15 @@ func fileInfoFromFile(f scanner.File) protocol.FileInfo {
 		}
 	}
 	pf := protocol.FileInfo{
-		Name:     filepath.ToSlash(f.Name),
-		Flags:    f.Flags,
-		Modified: f.Modified,
-		Version:  f.Version,
-		Blocks:   blocks,
+		Name:       filepath.ToSlash(f.Name),
+		Flags:      f.Flags,
+		Modified:   f.Modified,
+		ModifiedNs: f.ModifiedNs,
+		Version:    f.Version,
+		Vector:     f.Vector,
+		Blocks:     blocks,
+		ACL:        f.ACL,
+		Target:     filepath.ToSlash(f.Target),
 	}
 	if f.Suppressed {
 		pf.Flags |= protocol.FlagInvalid
@@ -90,3 +107,116 @@ func compareClusterConfig(local, remote protocol.ClusterConfigMessage) error {
 
 	return nil
 }
+
+// clusterConfigNode returns the Cluster Config entry for the node, with
+// what we know of it from the configuration.
+func clusterConfigNode(id string, cfg config.NodeConfiguration) protocol.Node {
+	compression, _ := protocol.ParseCompression(cfg.Compression)
+	n := protocol.Node{
+		ID:    id,
+		Name:  cfg.Name,
+		Flags: protocol.FlagShareTrusted | protocol.CompressionFlags(compression),
+	}
+	if cfg.Introducer {
+		n.Flags |= protocol.FlagIntroducer
+	}
+	if len(n.Name) > maxNodeNameLen {
+		n.Name = n.Name[:maxNodeNameLen]
+	}
+	for _, addr := range cfg.Addresses {
+		if len(n.Addresses) == maxNodeAddresses {
+			break
+		}
+		if len(addr) <= maxNodeAddressLen {
+			n.Addresses = append(n.Addresses, addr)
+		}
+	}
+	return n
+}
+
+// repoMismatches returns a description of each difference between the
+// repositories shared with the node, as we see it in local and the node sees
+// it in remote.
+func repoMismatches(local, remote protocol.ClusterConfigMessage) []string {
+	lm := make(map[string]bool)
+	for _, r := range local.Repositories {
+		lm[r.ID] = true
+	}
+	rm := make(map[string]bool)
+	for _, r := range remote.Repositories {
+		rm[r.ID] = true
+	}
+
+	var res []string
+	for _, r := range local.Repositories {
+		if !rm[r.ID] {
+			res = append(res, fmt.Sprintf("repository %q is shared with the node, but the node does not share it with us", r.ID))
+		}
+	}
+	for _, r := range remote.Repositories {
+		if !lm[r.ID] {
+			res = append(res, fmt.Sprintf("the node shares repository %q with us, but it is not shared with the node", r.ID))
+		}
+	}
+	return res
+}
+
+// withoutModifiedNs returns the index with modification times in whole
+// seconds, for nodes that do not understand the nanoseconds.
+func withoutModifiedNs(idx []protocol.FileInfo) []protocol.FileInfo {
+	var res []protocol.FileInfo
+	for i, f := range idx {
+		if f.ModifiedNs == 0 {
+			continue
+		}
+		if res == nil {
+			res = make([]protocol.FileInfo, len(idx))
+			copy(res, idx)
+		}
+		res[i].ModifiedNs = 0
+	}
+	if res == nil {
+		return idx
+	}
+	return res
+}
+
+// withoutACLs returns the index without the access control lists, for nodes
+// that do not understand them.
+func withoutACLs(idx []protocol.FileInfo) []protocol.FileInfo {
+	var res []protocol.FileInfo
+	for i, f := range idx {
+		if len(f.ACL) == 0 {
+			continue
+		}
+		if res == nil {
+			res = make([]protocol.FileInfo, len(idx))
+			copy(res, idx)
+		}
+		res[i].ACL = nil
+	}
+	if res == nil {
+		return idx
+	}
+	return res
+}
+
+// withoutVectors returns the index without the version vectors, for nodes
+// that do not understand them.
+func withoutVectors(idx []protocol.FileInfo) []protocol.FileInfo {
+	var res []protocol.FileInfo
+	for i, f := range idx {
+		if len(f.Vector) == 0 {
+			continue
+		}
+		if res == nil {
+			res = make([]protocol.FileInfo, len(idx))
+			copy(res, idx)
+		}
+		res[i].Vector = nil
+	}
+	if res == nil {
+		return idx
+	}
+	return res
+}
@@ -5,8 +5,10 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/calmh/syncthing/config"
 	"github.com/calmh/syncthing/protocol"
 )
 
@@ -135,3 +137,44 @@ func TestCompareClusterConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestRepoMismatches(t *testing.T) {
+	local := protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{{ID: "foo"}, {ID: "bar"}},
+	}
+	remote := protocol.ClusterConfigMessage{
+		Repositories: []protocol.Repository{{ID: "bar"}, {ID: "baz"}},
+	}
+
+	if mm := repoMismatches(local, local); len(mm) != 0 {
+		t.Errorf("Unexpected mismatches %v", mm)
+	}
+
+	mm := repoMismatches(local, remote)
+	if len(mm) != 2 {
+		t.Fatalf("Incorrect mismatches %v", mm)
+	}
+	if !strings.Contains(mm[0], `"foo"`) || !strings.Contains(mm[1], `"baz"`) {
+		t.Errorf("Incorrect mismatches %v", mm)
+	}
+}
+
+func TestClusterConfigNode(t *testing.T) {
+	cfg := config.NodeConfiguration{
+		Name:        strings.Repeat("x", 100),
+		Addresses:   []string{"dynamic", strings.Repeat("a", 300), "192.0.2.42:22000"},
+		Compression: "never",
+		Introducer:  true,
+	}
+
+	n := clusterConfigNode("a", cfg)
+	if n.ID != "a" || len(n.Name) != maxNodeNameLen {
+		t.Errorf("Incorrect node %q, name %q", n.ID, n.Name)
+	}
+	if len(n.Addresses) != 2 || n.Addresses[0] != "dynamic" || n.Addresses[1] != "192.0.2.42:22000" {
+		t.Errorf("Incorrect addresses %v", n.Addresses)
+	}
+	if n.Flags&protocol.FlagShareTrusted == 0 || n.Flags&protocol.FlagIntroducer == 0 || protocol.NodeCompression(n.Flags) != protocol.CompressNever {
+		t.Errorf("Incorrect flags 0x%x", n.Flags)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/calmh/syncthing/scanner"
+)
+
+// How long the repository must be left alone before the changes to it are
+// rescanned.
+const watchDelay = 2 * time.Second
+
+// While a repository is watched, full rescans only catch what the
+// notifications missed and are done this many times less often.
+const watchedRescanFactor = 60
+
+// watch starts watching the repository directory, when enabled, and returns
+// the channel on which the changed paths are reported. The channel is nil
+// when the repository is not watched. Watched is true if all changes are
+// reported, so that full rescans are needed less often. The watcher is
+// closed by stopped.
+func (p *puller) watch() (changes <-chan []string, watched bool) {
+	if !p.model.options().WatchFilesystem {
+		return nil, false
+	}
+	w, err := scanner.NewWatcher(p.repoCfg.Directory, watchDelay)
+	if err != nil {
+		if d := p.rescanInterval(false); d > 0 {
+			l.Infof("Not watching %q for changes (%v); rescanning every %v", p.repoCfg.ID, err, d)
+		} else {
+			l.Infof("Not watching %q for changes (%v)", p.repoCfg.ID, err)
+		}
+		return nil, false
+	}
+	p.watcher = w
+	if !w.ReportsContents() {
+		if d := p.rescanInterval(false); d > 0 {
+			l.Infof("Watching %q for new and removed files; changes to files are found by rescanning every %v", p.repoCfg.ID, d)
+		}
+		return w.Changes(), false
+	}
+	return w.Changes(), true
+}
+
+// rescanInterval returns the time between full rescans of the repository,
+// or zero when it is not rescanned periodically.
+func (p *puller) rescanInterval(watched bool) time.Duration {
+	d := time.Duration(p.repoCfg.RescanIntervalS) * time.Second
+	if watched {
+		d *= watchedRescanFactor
+	}
+	return d
+}
+
+// nextRescan returns a channel that fires when the next full rescan is due,
+// or nil when the repository is not rescanned periodically.
+func (p *puller) nextRescan(watched bool) <-chan time.Time {
+	d := p.rescanInterval(watched)
+	if d <= 0 {
+		return nil
+	}
+	return time.After(jitter(d))
+}
+
+// jitter returns a duration randomly within a quarter of d, so that the
+// rescans of repositories with the same interval drift apart instead of all
+// happening at once.
+func jitter(d time.Duration) time.Duration {
+	j := int64(d / 4)
+	if j <= 0 {
+		return d
+	}
+	return d - time.Duration(j) + time.Duration(rand.Int63n(2*j))
+}
+
+// watchStopped is called when the watcher of the repository gives up, and
+// returns the channel for the next full rescan at the normal interval.
+func (p *puller) watchStopped() <-chan time.Time {
+	if d := p.rescanInterval(false); d > 0 {
+		l.Infof("Stopped watching %q for changes; rescanning every %v", p.repoCfg.ID, d)
+	} else {
+		l.Warnf("Stopped watching %q for changes and periodic rescans are disabled; changes are only noticed on manual rescans", p.repoCfg.ID)
+	}
+	return p.nextRescan(false)
+}
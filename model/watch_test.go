@@ -0,0 +1,50 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/config"
+)
+
+func TestJitter(t *testing.T) {
+	d := time.Minute
+	var min, max time.Duration = d, d
+	for i := 0; i < 1000; i++ {
+		j := jitter(d)
+		if j < d*3/4 || j >= d*5/4 {
+			t.Fatalf("Jittered %v out of range", j)
+		}
+		if j < min {
+			min = j
+		}
+		if j > max {
+			max = j
+		}
+	}
+	if min == d || max == d {
+		t.Errorf("No jitter in range %v - %v", min, max)
+	}
+}
+
+func TestRescanInterval(t *testing.T) {
+	p := &puller{repoCfg: config.RepositoryConfiguration{ID: "default", RescanIntervalS: 60}}
+	if d := p.rescanInterval(false); d != time.Minute {
+		t.Errorf("Unexpected interval %v", d)
+	}
+	if d := p.rescanInterval(true); d != watchedRescanFactor*time.Minute {
+		t.Errorf("Unexpected watched interval %v", d)
+	}
+	if p.nextRescan(false) == nil {
+		t.Error("No rescan scheduled")
+	}
+
+	p.repoCfg.RescanIntervalS = 0
+	if p.nextRescan(false) != nil || p.nextRescan(true) != nil {
+		t.Error("Rescan scheduled although disabled")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package osutil
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrACLNotSupported is returned by GetACL and SetACL when ACLs are not
+// supported on this platform or by the file system holding the file.
+var ErrACLNotSupported = errors.New("ACLs are not supported")
+
+// The opaque ACL representation is a sequence of length prefixed parts, in
+// the order given by the platform. An empty part means that the
+// corresponding list is not set.
+
+func joinACL(parts ...[]byte) []byte {
+	empty := true
+	for _, p := range parts {
+		if len(p) > 0 {
+			empty = false
+			break
+		}
+	}
+	if empty {
+		// No lists set at all
+		return nil
+	}
+
+	var bs []byte
+	for _, p := range parts {
+		var l [4]byte
+		binary.BigEndian.PutUint32(l[:], uint32(len(p)))
+		bs = append(bs, l[:]...)
+		bs = append(bs, p...)
+	}
+	return bs
+}
+
+func splitACL(bs []byte, n int) ([][]byte, error) {
+	parts := make([][]byte, n)
+	for i := range parts {
+		if len(bs) == 0 {
+			break
+		}
+		if len(bs) < 4 {
+			return nil, errors.New("short ACL")
+		}
+		l := int(binary.BigEndian.Uint32(bs))
+		bs = bs[4:]
+		if l > len(bs) {
+			return nil, errors.New("short ACL")
+		}
+		parts[i] = bs[:l]
+		bs = bs[l:]
+	}
+	return parts, nil
+}
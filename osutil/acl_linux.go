@@ -0,0 +1,80 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package osutil
+
+import (
+	"os"
+	"syscall"
+)
+
+// The access list applies to the file itself, the default list (directories
+// only) is inherited by new files created in the directory.
+var aclAttrs = []string{"system.posix_acl_access", "system.posix_acl_default"}
+
+// GetACL returns the POSIX ACLs of the named file in an opaque form suitable
+// for SetACL. A file without extended ACLs gives a nil slice.
+func GetACL(name string) ([]byte, error) {
+	var parts [][]byte
+	for _, attr := range aclAttrs {
+		bs, err := getxattr(name, attr)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, bs)
+	}
+	return joinACL(parts...), nil
+}
+
+// SetACL sets the POSIX ACLs of the named file to those returned by GetACL,
+// removing any lists that are not set in acl.
+func SetACL(name string, acl []byte) error {
+	parts, err := splitACL(acl, len(aclAttrs))
+	if err != nil {
+		return err
+	}
+	for i, attr := range aclAttrs {
+		if len(parts[i]) == 0 {
+			err = syscall.Removexattr(name, attr)
+			if err == syscall.ENODATA {
+				err = nil
+			}
+		} else {
+			err = syscall.Setxattr(name, attr, parts[i], 0)
+		}
+		if err != nil {
+			return aclError("setxattr", name, err)
+		}
+	}
+	return nil
+}
+
+func getxattr(name, attr string) ([]byte, error) {
+	buf := make([]byte, 256)
+	for {
+		n, err := syscall.Getxattr(name, attr, buf)
+		switch err {
+		case nil:
+			return buf[:n], nil
+		case syscall.ENODATA:
+			return nil, nil
+		case syscall.ERANGE:
+			// Find out how large the attribute is and try again
+			n, err = syscall.Getxattr(name, attr, nil)
+			if err != nil {
+				return nil, aclError("getxattr", name, err)
+			}
+			buf = make([]byte, n)
+		default:
+			return nil, aclError("getxattr", name, err)
+		}
+	}
+}
+
+func aclError(op, name string, err error) error {
+	if err == syscall.ENOTSUP {
+		return ErrACLNotSupported
+	}
+	return &os.PathError{Op: op, Path: name, Err: err}
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !linux
+
+package osutil
+
+// GetACL always returns ErrACLNotSupported on this platform.
+func GetACL(name string) ([]byte, error) {
+	return nil, ErrACLNotSupported
+}
+
+// SetACL always returns ErrACLNotSupported on this platform.
+func SetACL(name string, acl []byte) error {
+	return ErrACLNotSupported
+}
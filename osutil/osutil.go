@@ -6,17 +6,40 @@ package osutil
 
 import (
 	"os"
-	"runtime"
+	"time"
 )
 
+// How many times, and with what initial delay, to retry a rename that fails
+// because a file is locked. The delay doubles for each attempt.
+const (
+	lockedRetries    = 4
+	lockedRetryDelay = 100 * time.Millisecond
+)
+
+// Rename moves from to to, replacing any existing file at to, also when it is
+// read only. A rename that fails because one of the files is temporarily
+// locked by another process is retried for a while. The from file is
+// removed if the rename fails.
 func Rename(from, to string) error {
-	if runtime.GOOS == "windows" {
-		os.Chmod(to, 0666) // Make sure the file is user writeable
-		err := os.Remove(to)
-		if err != nil && !os.IsNotExist(err) {
+	defer os.Remove(from) // Don't leave a dangling temp file in case of rename error
+
+	return retryLocked(func() error {
+		return rename(from, to)
+	}, isLocked, time.Sleep)
+}
+
+// retryLocked calls op until it succeeds, fails with an error that locked
+// does not recognize or has been retried lockedRetries times, and returns
+// its last error. Sleep is called for the delay before each retry, which
+// starts at lockedRetryDelay and doubles.
+func retryLocked(op func() error, locked func(error) bool, sleep func(time.Duration)) error {
+	delay := lockedRetryDelay
+	for i := 0; ; i++ {
+		err := op()
+		if err == nil || i == lockedRetries || !locked(err) {
 			return err
 		}
+		sleep(delay)
+		delay *= 2
 	}
-	defer os.Remove(from) // Don't leave a dangling temp file in case of rename error
-	return os.Rename(from, to)
 }
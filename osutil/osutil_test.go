@@ -0,0 +1,87 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package osutil
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	errLocked = errors.New("locked")
+	errOther  = errors.New("other")
+)
+
+func TestRetryLocked(t *testing.T) {
+	const d = lockedRetryDelay
+
+	var tests = []struct {
+		errs   []error // returned by the attempts in turn
+		err    error
+		sleeps []time.Duration
+	}{
+		{[]error{nil}, nil, nil},
+		{[]error{errOther}, errOther, nil},
+		{[]error{errLocked, nil}, nil, []time.Duration{d}},
+		{[]error{errLocked, errLocked, errLocked, nil}, nil, []time.Duration{d, 2 * d, 4 * d}},
+		{[]error{errLocked, errOther}, errOther, []time.Duration{d}},
+		// Given up after lockedRetries retries
+		{[]error{errLocked, errLocked, errLocked, errLocked, errLocked, nil}, errLocked, []time.Duration{d, 2 * d, 4 * d, 8 * d}},
+	}
+
+	for i, tc := range tests {
+		// The clock only moves when slept on
+		var sleeps []time.Duration
+		sleep := func(d time.Duration) {
+			sleeps = append(sleeps, d)
+		}
+		attempts := 0
+		op := func() error {
+			err := tc.errs[attempts]
+			attempts++
+			return err
+		}
+		locked := func(err error) bool {
+			return err == errLocked
+		}
+
+		if err := retryLocked(op, locked, sleep); err != tc.err {
+			t.Errorf("%d: unexpected error %v", i, err)
+		}
+		if !reflect.DeepEqual(sleeps, tc.sleeps) {
+			t.Errorf("%d: unexpected delays %v", i, sleeps)
+		}
+	}
+}
+
+func TestRetryLockedResets(t *testing.T) {
+	var sleeps []time.Duration
+	sleep := func(d time.Duration) {
+		sleeps = append(sleeps, d)
+	}
+	locked := func(err error) bool {
+		return err == errLocked
+	}
+
+	// Every rename starts over at the shortest delay, also after one that
+	// was retried and succeeded
+	for i := 0; i < 2; i++ {
+		sleeps = nil
+		errs := []error{errLocked, errLocked, nil}
+		op := func() error {
+			err := errs[0]
+			errs = errs[1:]
+			return err
+		}
+		if err := retryLocked(op, locked, sleep); err != nil {
+			t.Fatalf("%d: unexpected error %v", i, err)
+		}
+		if exp := []time.Duration{lockedRetryDelay, 2 * lockedRetryDelay}; !reflect.DeepEqual(sleeps, exp) {
+			t.Errorf("%d: unexpected delays %v", i, sleeps)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package osutil
+
+import "os"
+
+func rename(from, to string) error {
+	return os.Rename(from, to)
+}
+
+// isLocked returns false; files are not locked against renames here.
+func isLocked(err error) bool {
+	return false
+}
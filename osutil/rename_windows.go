@@ -0,0 +1,71 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package osutil
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	movefileReplaceExisting = 0x1
+	movefileWriteThrough    = 0x8
+
+	errorAccessDenied     syscall.Errno = 5
+	errorSharingViolation syscall.Errno = 32
+	errorLockViolation    syscall.Errno = 33
+)
+
+var procMoveFileEx = syscall.NewLazyDLL("kernel32.dll").NewProc("MoveFileExW")
+
+// rename replaces to with from in one operation, so that the target is not
+// lost if the rename fails. The read only attribute of the target is cleared
+// first, since it would otherwise prevent the replace, and put back if the
+// rename fails.
+func rename(from, to string) error {
+	fi, err := os.Lstat(to)
+	readOnly := err == nil && fi.Mode()&0200 == 0
+	if readOnly {
+		os.Chmod(to, 0666)
+	}
+
+	err = moveFileEx(from, to, movefileReplaceExisting|movefileWriteThrough)
+	if err != nil && readOnly {
+		os.Chmod(to, fi.Mode()&os.ModePerm)
+	}
+	return err
+}
+
+func moveFileEx(from, to string, flags uint32) error {
+	pfrom, err := syscall.UTF16PtrFromString(from)
+	if err != nil {
+		return err
+	}
+	pto, err := syscall.UTF16PtrFromString(to)
+	if err != nil {
+		return err
+	}
+	r, _, e := procMoveFileEx.Call(uintptr(unsafe.Pointer(pfrom)), uintptr(unsafe.Pointer(pto)), uintptr(flags))
+	if r == 0 {
+		return &os.LinkError{Op: "rename", Old: from, New: to, Err: e}
+	}
+	return nil
+}
+
+// isLocked returns true if err is caused by a file being open by another
+// process, such as a virus scanner or an indexer, without sharing.
+func isLocked(err error) bool {
+	if le, ok := err.(*os.LinkError); ok {
+		err = le.Err
+	}
+	switch err {
+	case errorAccessDenied, errorSharingViolation, errorLockViolation:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package osutil
+
+import "syscall"
+
+// MaxOpenFiles returns the number of files the process may have open at the
+// same time, or zero if there is no such limit or it is unknown.
+func MaxOpenFiles() int {
+	var rl syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rl); err != nil {
+		return 0
+	}
+	// Anything this large is as good as no limit, which is also how
+	// RLIM_INFINITY looks.
+	if cur := uint64(rl.Cur); cur <= 1<<30 {
+		return int(cur)
+	}
+	return 0
+}
@@ -0,0 +1,14 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package osutil
+
+// MaxOpenFiles returns the number of files the process may have open at the
+// same time, or zero if there is no such limit or it is unknown. There is no
+// such limit for handles on Windows.
+func MaxOpenFiles() int {
+	return 0
+}
@@ -0,0 +1,23 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package osutil
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrSymlinksNotSupported is returned by Symlink when symbolic links cannot
+// be created on this system.
+var ErrSymlinksNotSupported = errors.New("symbolic links are not supported")
+
+// Symlink creates newname as a symbolic link to target, or returns
+// ErrSymlinksNotSupported.
+func Symlink(target, newname string) error {
+	if !SymlinksSupported() {
+		return ErrSymlinksNotSupported
+	}
+	return os.Symlink(target, newname)
+}
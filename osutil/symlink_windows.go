@@ -0,0 +1,34 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package osutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	symlinksSupported bool
+	symlinksOnce      sync.Once
+)
+
+// SymlinksSupported returns true if symbolic links can be created. Creating
+// them requires a privilege that only administrators hold by default, so
+// this is found out by trying once.
+func SymlinksSupported() bool {
+	symlinksOnce.Do(func() {
+		dir, err := ioutil.TempDir("", "syncthing-symlinks")
+		if err != nil {
+			return
+		}
+		defer os.RemoveAll(dir)
+		symlinksSupported = os.Symlink("target", filepath.Join(dir, "link")) == nil
+	})
+	return symlinksSupported
+}
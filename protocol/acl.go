@@ -0,0 +1,9 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+// OptionACLs is set in the Cluster Config options by nodes that understand
+// the access control lists of files in Index messages.
+const OptionACLs = "acls"
@@ -0,0 +1,27 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+// A Response is the outcome of a request made with RequestAsync.
+type Response struct {
+	Data []byte
+	Err  error
+}
+
+// RequestAsync sends a request for the specified block and returns without
+// waiting for the response, which is delivered on the returned channel.
+// Several requests can so be outstanding at once, keeping links with a high
+// latency busy. The requests are sent in the order RequestAsync is called,
+// but may be answered in any order. RequestAsync waits before sending when
+// the connection's maximum number of requests are already outstanding.
+func (c *rawConnection) RequestAsync(repo string, name string, offset int64, size int) <-chan Response {
+	rc := c.roundTripAsync(messageTypeRequest, RequestMessage{repo, name, uint64(offset), uint32(size)})
+	res := make(chan Response, 1)
+	go func() {
+		r := <-rc
+		res <- Response{r.val, r.err}
+	}()
+	return res
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"testing"
+	"time"
+)
+
+// blockingModel answers each request with the requested name, once told
+// to by a receive on release.
+type blockingModel struct {
+	*TestModel
+	started chan string
+	release chan struct{}
+}
+
+func (m *blockingModel) Request(nodeID, repo, name string, offset int64, size int) ([]byte, error) {
+	m.started <- name
+	<-m.release
+	return []byte(name), nil
+}
+
+func TestRequestAsync(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	m0 := &blockingModel{newTestModel(), make(chan string, 3), make(chan struct{})}
+	NewConnection("c0", ar, bw, m0)
+	c1 := NewConnectionWithOptions("c1", br, aw, newTestModel(), ConnectionOptions{MaxOutstanding: 2})
+
+	r0 := c1.RequestAsync("default", "a", 0, 1)
+	r1 := c1.RequestAsync("default", "b", 0, 1)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-m0.started:
+		case <-time.After(time.Second):
+			t.Fatal("Requests were not sent before the responses arrived")
+		}
+	}
+
+	// The third request waits for one of the first two to be answered
+	third := make(chan (<-chan Response))
+	go func() {
+		third <- c1.RequestAsync("default", "c", 0, 1)
+	}()
+	select {
+	case <-third:
+		t.Fatal("Request sent beyond the maximum outstanding")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	m0.release <- struct{}{}
+	var r2 <-chan Response
+	select {
+	case r2 = <-third:
+	case <-time.After(time.Second):
+		t.Fatal("Request not sent after a response arrived")
+	}
+	<-m0.started
+	m0.release <- struct{}{}
+	m0.release <- struct{}{}
+
+	for i, rc := range []<-chan Response{r0, r1, r2} {
+		res := <-rc
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if exp := string(rune('a' + i)); string(res.Data) != exp {
+			t.Errorf("Incorrect response %q != %q", res.Data, exp)
+		}
+	}
+}
+
+func TestRequestAsyncClosed(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	m0 := &blockingModel{newTestModel(), make(chan string, 1), make(chan struct{})}
+	c0 := NewConnection("c0", ar, bw, m0)
+	c1 := NewConnection("c1", br, aw, newTestModel())
+
+	rc := c1.RequestAsync("default", "a", 0, 1)
+	<-m0.started
+	c0.Close(CloseShutdown, "")
+
+	select {
+	case res := <-rc:
+		if res.Err != ErrClosed {
+			t.Errorf("Unexpected error %v", res.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No response after close")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import "errors"
+
+// OptionBlockGroups is set in the Cluster Config options by nodes that
+// understand indexes with grouped block lists and the Request Blocks message.
+const OptionBlockGroups = "blockGroups"
+
+var ErrNoBlockList = errors.New("block list not available")
+
+// A BlockLister returns the block list for a range of a file, for files that
+// are sent in the index with grouped block lists. The Model given to
+// NewConnection may implement it.
+type BlockLister interface {
+	BlockList(nodeID string, repo string, name string, offset int64, size int) ([]BlockInfo, error)
+}
+
+// RequestBlocks returns the list of blocks within the specified range of the
+// file after fetching it from the connected peer.
+func (c *rawConnection) RequestBlocks(repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	bs, err := c.request(messageTypeRequestBlocks, repo, name, offset, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var bl BlockListMessage
+	if err := bl.UnmarshalXDR(bs); err != nil {
+		return nil, err
+	}
+	if len(bl.Blocks) == 0 {
+		return nil, ErrNoBlockList
+	}
+	return bl.Blocks, nil
+}
+
+func (c *rawConnection) handleRequestBlocks(hdr header) error {
+	var req RequestMessage
+	req.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+	go c.processRequestBlocks(hdr.msgID, req)
+	return nil
+}
+
+func (c *rawConnection) processRequestBlocks(msgID int, req RequestMessage) {
+	// An empty block list is sent when there is none to give.
+	var bl BlockListMessage
+	if lister, ok := c.receiver.(BlockLister); ok {
+		bl.Blocks, _ = lister.BlockList(c.id, req.Repository, req.Name, int64(req.Offset), int(req.Size))
+	}
+
+	c.send(header{0, msgID, messageTypeResponse},
+		encodableBytes(bl.MarshalXDR()))
+}
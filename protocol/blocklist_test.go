@@ -0,0 +1,43 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"testing"
+)
+
+type listModel struct {
+	*TestModel
+	blocks []BlockInfo
+}
+
+func (m listModel) BlockList(nodeID, repo, name string, offset int64, size int) ([]BlockInfo, error) {
+	if name != "large" {
+		return nil, ErrNoBlockList
+	}
+	return m.blocks, nil
+}
+
+func TestRequestBlocks(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	m0 := listModel{newTestModel(), []BlockInfo{{Size: 10, Hash: []byte("abc")}, {Size: 5, Hash: []byte("def")}}}
+	NewConnection("c0", ar, bw, m0)
+	c1 := NewConnection("c1", br, aw, newTestModel())
+
+	bl, err := c1.RequestBlocks("default", "large", 0, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bl) != 2 || bl[1].Size != 5 || string(bl[1].Hash) != "def" {
+		t.Errorf("Unexpected block list %v", bl)
+	}
+
+	if _, err := c1.RequestBlocks("default", "other", 0, 15); err != ErrNoBlockList {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/xdr"
+)
+
+type CaptureType uint32
+
+const (
+	CaptureClusterConfig CaptureType = messageTypeClusterConfig
+	CaptureIndex         CaptureType = messageTypeIndex
+	CaptureRequest       CaptureType = messageTypeRequest
+	CaptureResponse      CaptureType = messageTypeResponse
+	CaptureIndexUpdate   CaptureType = messageTypeIndexUpdate
+	CaptureClose         CaptureType = 0xff
+)
+
+func (t CaptureType) String() string {
+	switch t {
+	case CaptureClusterConfig:
+		return "ClusterConfig"
+	case CaptureIndex:
+		return "Index"
+	case CaptureRequest:
+		return "Request"
+	case CaptureResponse:
+		return "Response"
+	case CaptureIndexUpdate:
+		return "IndexUpdate"
+	case CaptureClose:
+		return "Close"
+	default:
+		return fmt.Sprintf("CaptureType(%d)", uint32(t))
+	}
+}
+
+// A CaptureRecord is one message recorded by a Capture. Which of the message
+// fields are set depends on the Type.
+type CaptureRecord struct {
+	Time     time.Time
+	Incoming bool
+	NodeID   string
+	Type     CaptureType
+
+	Index   IndexMessage         // Index, IndexUpdate
+	Request RequestMessage       // Request, Response
+	Config  ClusterConfigMessage // ClusterConfig
+	Data    []byte               // Response, possibly truncated
+	DataLen int                  // Response, length before truncation
+	Error   string               // Response, Close
+}
+
+// A Capture records the messages passing through the models and connections
+// it wraps to a writer, for later inspection or replay. Names are recorded as
+// seen by the model, i.e. in native format.
+type Capture struct {
+	w       io.Writer
+	maxData int
+	mut     sync.Mutex
+}
+
+// NewCapture returns a Capture writing to w. Response payloads are truncated
+// to maxData bytes; a negative maxData records them in full.
+func NewCapture(w io.Writer, maxData int) *Capture {
+	return &Capture{
+		w:       w,
+		maxData: maxData,
+	}
+}
+
+// Model returns a Model that records incoming messages before passing them on
+// to next.
+func (c *Capture) Model(next Model) Model {
+	return captureModel{c, next}
+}
+
+// Connection returns a Connection that records outgoing messages before
+// passing them on to next. Indexes are recorded as given to the connection,
+// which may send only the difference to the previous index on the wire.
+func (c *Capture) Connection(next Connection) Connection {
+	return captureConnection{c, next}
+}
+
+func (c *Capture) record(rec CaptureRecord) {
+	var buf bytes.Buffer
+	xw := xdr.NewWriter(&buf)
+
+	var flags uint32
+	if rec.Incoming {
+		flags = 1
+	}
+	xw.WriteUint64(uint64(time.Now().UnixNano()))
+	xw.WriteUint32(flags)
+	xw.WriteString(rec.NodeID)
+	xw.WriteUint32(uint32(rec.Type))
+
+	switch rec.Type {
+	case CaptureIndex, CaptureIndexUpdate:
+		rec.Index.encodeXDR(xw)
+	case CaptureRequest:
+		rec.Request.encodeXDR(xw)
+	case CaptureResponse:
+		data := rec.Data
+		if c.maxData >= 0 && len(data) > c.maxData {
+			data = data[:c.maxData]
+		}
+		rec.Request.encodeXDR(xw)
+		xw.WriteUint32(uint32(len(rec.Data)))
+		xw.WriteBytes(data)
+		xw.WriteString(rec.Error)
+	case CaptureClusterConfig:
+		rec.Config.encodeXDR(xw)
+	case CaptureClose:
+		xw.WriteString(rec.Error)
+	}
+
+	c.mut.Lock()
+	_, err := c.w.Write(buf.Bytes())
+	c.mut.Unlock()
+	if err != nil && debug {
+		l.Debugln("capture:", err)
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+type captureModel struct {
+	c    *Capture
+	next Model
+}
+
+func (m captureModel) Index(nodeID string, repo string, files []FileInfo) {
+	m.c.record(CaptureRecord{Incoming: true, NodeID: nodeID, Type: CaptureIndex, Index: IndexMessage{repo, files}})
+	m.next.Index(nodeID, repo, files)
+}
+
+func (m captureModel) IndexUpdate(nodeID string, repo string, files []FileInfo) {
+	m.c.record(CaptureRecord{Incoming: true, NodeID: nodeID, Type: CaptureIndexUpdate, Index: IndexMessage{repo, files}})
+	m.next.IndexUpdate(nodeID, repo, files)
+}
+
+func (m captureModel) Request(nodeID, repo string, name string, offset int64, size int) ([]byte, error) {
+	req := RequestMessage{repo, name, uint64(offset), uint32(size)}
+	m.c.record(CaptureRecord{Incoming: true, NodeID: nodeID, Type: CaptureRequest, Request: req})
+	data, err := m.next.Request(nodeID, repo, name, offset, size)
+	m.c.record(CaptureRecord{NodeID: nodeID, Type: CaptureResponse, Request: req, Data: data, Error: errString(err)})
+	return data, err
+}
+
+func (m captureModel) ClusterConfig(nodeID string, config ClusterConfigMessage) {
+	m.c.record(CaptureRecord{Incoming: true, NodeID: nodeID, Type: CaptureClusterConfig, Config: config})
+	m.next.ClusterConfig(nodeID, config)
+}
+
+func (m captureModel) Close(nodeID string, err error) {
+	m.c.record(CaptureRecord{Incoming: true, NodeID: nodeID, Type: CaptureClose, Error: errString(err)})
+	m.next.Close(nodeID, err)
+}
+
+func (m captureModel) Rejected(nodeID, repo, name string, err error) {
+	if rr, ok := m.next.(RejectionReporter); ok {
+		rr.Rejected(nodeID, repo, name, err)
+	}
+}
+
+func (m captureModel) BlockList(nodeID, repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	lister, ok := m.next.(BlockLister)
+	if !ok {
+		return nil, ErrNoBlockList
+	}
+	return lister.BlockList(nodeID, repo, name, offset, size)
+}
+
+func (m captureModel) ResendIndex(nodeID, repo string) {
+	if ir, ok := m.next.(IndexResender); ok {
+		ir.ResendIndex(nodeID, repo)
+	}
+}
+
+func (m captureModel) DownloadProgress(nodeID, repo string, files []FileDownloadProgress) {
+	if r, ok := m.next.(DownloadProgressReceiver); ok {
+		r.DownloadProgress(nodeID, repo, files)
+	}
+}
+
+type captureConnection struct {
+	c    *Capture
+	next Connection
+}
+
+func (c captureConnection) ID() string {
+	return c.next.ID()
+}
+
+func (c captureConnection) Index(repo string, files []FileInfo) {
+	c.c.record(CaptureRecord{NodeID: c.next.ID(), Type: CaptureIndex, Index: IndexMessage{repo, files}})
+	c.next.Index(repo, files)
+}
+
+func (c captureConnection) Request(repo string, name string, offset int64, size int) ([]byte, error) {
+	req := RequestMessage{repo, name, uint64(offset), uint32(size)}
+	c.c.record(CaptureRecord{NodeID: c.next.ID(), Type: CaptureRequest, Request: req})
+	data, err := c.next.Request(repo, name, offset, size)
+	c.c.record(CaptureRecord{Incoming: true, NodeID: c.next.ID(), Type: CaptureResponse, Request: req, Data: data, Error: errString(err)})
+	return data, err
+}
+
+func (c captureConnection) RequestAsync(repo string, name string, offset int64, size int) <-chan Response {
+	req := RequestMessage{repo, name, uint64(offset), uint32(size)}
+	c.c.record(CaptureRecord{NodeID: c.next.ID(), Type: CaptureRequest, Request: req})
+	rc := c.next.RequestAsync(repo, name, offset, size)
+	res := make(chan Response, 1)
+	go func() {
+		r := <-rc
+		c.c.record(CaptureRecord{Incoming: true, NodeID: c.next.ID(), Type: CaptureResponse, Request: req, Data: r.Data, Error: errString(r.Err)})
+		res <- r
+	}()
+	return res
+}
+
+func (c captureConnection) ClusterConfig(config ClusterConfigMessage) {
+	c.c.record(CaptureRecord{NodeID: c.next.ID(), Type: CaptureClusterConfig, Config: config})
+	c.next.ClusterConfig(config)
+}
+
+func (c captureConnection) DownloadProgress(repo string, files []FileDownloadProgress) {
+	c.next.DownloadProgress(repo, files)
+}
+
+func (c captureConnection) RequestBlocks(repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	return c.next.RequestBlocks(repo, name, offset, size)
+}
+
+// RequestVector records each of the ranges as a separate request and
+// response.
+func (c captureConnection) RequestVector(repo string, name string, ranges []Range) ([][]byte, error) {
+	for _, r := range ranges {
+		req := RequestMessage{repo, name, r.Offset, r.Size}
+		c.c.record(CaptureRecord{NodeID: c.next.ID(), Type: CaptureRequest, Request: req})
+	}
+	data, err := c.next.RequestVector(repo, name, ranges)
+	for i, r := range ranges {
+		req := RequestMessage{repo, name, r.Offset, r.Size}
+		var d []byte
+		if i < len(data) {
+			d = data[i]
+		}
+		c.c.record(CaptureRecord{Incoming: true, NodeID: c.next.ID(), Type: CaptureResponse, Request: req, Data: d, Error: errString(err)})
+	}
+	return data, err
+}
+
+func (c captureConnection) IndexDigest(repo string, files []FileInfo) {
+	c.next.IndexDigest(repo, files)
+}
+
+func (c captureConnection) Ping() bool {
+	return c.next.Ping()
+}
+
+func (c captureConnection) Statistics() Statistics {
+	return c.next.Statistics()
+}
+
+func (c captureConnection) Close(reason CloseReason, msg string) {
+	c.next.Close(reason, msg)
+}
+
+// A CaptureReader reads the records written by a Capture.
+type CaptureReader struct {
+	xr *xdr.Reader
+}
+
+func NewCaptureReader(r io.Reader) *CaptureReader {
+	return &CaptureReader{xdr.NewReader(r)}
+}
+
+// Read returns the next record, or io.EOF when there are no more records.
+func (r *CaptureReader) Read() (CaptureRecord, error) {
+	var rec CaptureRecord
+
+	t := r.xr.ReadUint64()
+	if err := r.xr.Error(); err != nil {
+		return rec, err
+	}
+	rec.Time = time.Unix(0, int64(t))
+	rec.Incoming = r.xr.ReadUint32()&1 != 0
+	rec.NodeID = r.xr.ReadStringMax(64)
+	rec.Type = CaptureType(r.xr.ReadUint32())
+
+	switch rec.Type {
+	case CaptureIndex, CaptureIndexUpdate:
+		rec.Index.decodeXDR(r.xr)
+	case CaptureRequest:
+		rec.Request.decodeXDR(r.xr)
+	case CaptureResponse:
+		rec.Request.decodeXDR(r.xr)
+		rec.DataLen = int(r.xr.ReadUint32())
+		rec.Data = r.xr.ReadBytes()
+		rec.Error = r.xr.ReadString()
+	case CaptureClusterConfig:
+		rec.Config.decodeXDR(r.xr)
+	case CaptureClose:
+		rec.Error = r.xr.ReadString()
+	default:
+		return rec, fmt.Errorf("capture: unknown record type %d", rec.Type)
+	}
+
+	if err := r.xr.Error(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return rec, err
+	}
+	return rec, nil
+}
+
+// Replay reads a capture from r and feeds the incoming messages in it to m,
+// in the order they were recorded. Outgoing messages and responses are
+// skipped.
+func Replay(r io.Reader, m Model) error {
+	cr := NewCaptureReader(r)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if !rec.Incoming {
+			continue
+		}
+
+		switch rec.Type {
+		case CaptureIndex:
+			m.Index(rec.NodeID, rec.Index.Repository, rec.Index.Files)
+		case CaptureIndexUpdate:
+			m.IndexUpdate(rec.NodeID, rec.Index.Repository, rec.Index.Files)
+		case CaptureRequest:
+			m.Request(rec.NodeID, rec.Request.Repository, rec.Request.Name, int64(rec.Request.Offset), int(rec.Request.Size))
+		case CaptureClusterConfig:
+			m.ClusterConfig(rec.NodeID, rec.Config)
+		case CaptureClose:
+			var err error = io.EOF
+			if rec.Error != io.EOF.Error() {
+				err = errors.New(rec.Error)
+			}
+			m.Close(rec.NodeID, err)
+		}
+	}
+}
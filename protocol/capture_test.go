@@ -0,0 +1,102 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+type replayModel struct {
+	TestModel
+	calls []string
+}
+
+func (m *replayModel) Index(nodeID string, repo string, files []FileInfo) {
+	m.calls = append(m.calls, "Index "+nodeID+" "+repo+" "+files[0].Name)
+}
+
+func (m *replayModel) IndexUpdate(nodeID string, repo string, files []FileInfo) {
+	m.calls = append(m.calls, "IndexUpdate "+nodeID+" "+repo+" "+files[0].Name)
+}
+
+func (m *replayModel) Request(nodeID, repo, name string, offset int64, size int) ([]byte, error) {
+	m.calls = append(m.calls, "Request "+nodeID+" "+repo+" "+name)
+	return nil, nil
+}
+
+func (m *replayModel) Close(nodeID string, err error) {
+	m.calls = append(m.calls, "Close "+nodeID+" "+err.Error())
+}
+
+func TestCaptureReplay(t *testing.T) {
+	var buf bytes.Buffer
+	c := NewCapture(&buf, 2)
+
+	tm := newTestModel()
+	tm.data = []byte("hello")
+	m := c.Model(tm)
+
+	m.Index("n1", "default", []FileInfo{{Name: "a"}})
+	m.IndexUpdate("n1", "default", []FileInfo{{Name: "b"}})
+	m.Request("n1", "default", "a", 0, 5)
+	go m.Close("n1", io.EOF)
+	if !tm.isClosed() {
+		t.Fatal("close not passed on")
+	}
+
+	cr := NewCaptureReader(bytes.NewReader(buf.Bytes()))
+	var types []CaptureType
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if rec.NodeID != "n1" {
+			t.Errorf("incorrect node ID %q", rec.NodeID)
+		}
+		if rec.Type == CaptureResponse {
+			if rec.Incoming {
+				t.Error("response to incoming request should be outgoing")
+			}
+			if string(rec.Data) != "he" || rec.DataLen != 5 {
+				t.Errorf("incorrect truncated response %q (%d)", rec.Data, rec.DataLen)
+			}
+		}
+		types = append(types, rec.Type)
+	}
+
+	expected := []CaptureType{CaptureIndex, CaptureIndexUpdate, CaptureRequest, CaptureResponse, CaptureClose}
+	if len(types) != len(expected) {
+		t.Fatalf("incorrect records %v != %v", types, expected)
+	}
+	for i := range types {
+		if types[i] != expected[i] {
+			t.Errorf("incorrect record %d: %v != %v", i, types[i], expected[i])
+		}
+	}
+
+	rm := &replayModel{}
+	if err := Replay(bytes.NewReader(buf.Bytes()), rm); err != nil {
+		t.Fatal(err)
+	}
+	calls := []string{
+		"Index n1 default a",
+		"IndexUpdate n1 default b",
+		"Request n1 default a",
+		"Close n1 EOF",
+	}
+	if len(rm.calls) != len(calls) {
+		t.Fatalf("incorrect replay %v", rm.calls)
+	}
+	for i := range calls {
+		if rm.calls[i] != calls[i] {
+			t.Errorf("incorrect replay call %d: %q != %q", i, rm.calls[i], calls[i])
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"fmt"
+	"time"
+)
+
+// A CloseReason tells the other node why a connection is closed, sent in
+// the Close message.
+type CloseReason uint32
+
+// The reasons are those used on the wire, and must not be changed.
+const (
+	CloseUnknown        CloseReason = iota
+	CloseShutdown                   // the node is shutting down or restarting
+	CloseRepoStopped                // a repository shared with the node was stopped
+	CloseConfigMismatch             // the node configurations disagree
+	CloseTooManyErrors              // the other node caused too many errors
+	ClosePaused                     // the other node was paused
+)
+
+// The Close message is given this long to be sent, before the connection
+// is closed regardless.
+const closeTimeout = 2 * time.Second
+
+func (r CloseReason) String() string {
+	switch r {
+	case CloseShutdown:
+		return "shutting down"
+	case CloseRepoStopped:
+		return "repository stopped"
+	case CloseConfigMismatch:
+		return "configuration mismatch"
+	case CloseTooManyErrors:
+		return "too many errors"
+	case ClosePaused:
+		return "paused"
+	}
+	return "unknown reason"
+}
+
+// Temporary returns true if the reason is expected to go away by itself, so
+// that the connection may be made again right away. For other reasons, the
+// node that closed the connection will likely do so again until someone
+// changes something, and reconnecting should be backed off.
+func (r CloseReason) Temporary() bool {
+	return r != CloseConfigMismatch && r != CloseTooManyErrors && r != ClosePaused
+}
+
+// A CloseError is what the Model is told in Close when the other node
+// closed the connection with a Close message.
+type CloseError struct {
+	Reason  CloseReason
+	Message string
+}
+
+func (e *CloseError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("closed by the other node: %v", e.Reason)
+	}
+	return fmt.Sprintf("closed by the other node: %v: %s", e.Reason, e.Message)
+}
+
+// Close sends a Close message telling the other node why the connection is
+// closed, and then closes the connection. Nothing more is sent or received
+// afterwards.
+func (c *rawConnection) Close(reason CloseReason, msg string) {
+	var id int
+	select {
+	case id = <-c.nextID:
+	case <-c.closed:
+		return
+	}
+
+	// The message is written directly, so that it is sent before the
+	// writer is closed. The writer may be stuck on a dead connection, and
+	// is not waited for long.
+	sent := make(chan struct{})
+	go func() {
+		c.wmut.Lock()
+		c.write([]encodable{header{0, id, messageTypeClose}, CloseMessage{uint32(reason), msg}})
+		c.wmut.Unlock()
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(closeTimeout):
+	}
+	// The other node stops reading after the Close message, so nothing
+	// more may be written to the transport.
+	c.closeTransport()
+
+	if debug {
+		l.Debugf("%s: close -> %v: %s", c.id, reason, msg)
+	}
+	c.close(fmt.Errorf("closed: %v: %s", reason, msg))
+}
+
+func (c *rawConnection) handleClose() error {
+	var msg CloseMessage
+	msg.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+	if debug {
+		l.Debugf("%s: <- close %v: %s", c.id, CloseReason(msg.Reason), msg.Message)
+	}
+	return &CloseError{CloseReason(msg.Reason), msg.Message}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"testing"
+)
+
+func TestCloseMessage(t *testing.T) {
+	m0 := newTestModel()
+	m1 := newTestModel()
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+	NewConnection("c0", ar, bw, m0)
+	c1 := NewConnection("c1", br, aw, m1)
+
+	c1.Close(CloseConfigMismatch, "different sharing flags")
+
+	if !m1.isClosed() {
+		t.Fatal("Connection should be closed")
+	}
+	if !m0.isClosed() {
+		t.Fatal("Connection should be closed by the other node")
+	}
+	ce, ok := m0.closeErr.(*CloseError)
+	if !ok {
+		t.Fatalf("Unexpected close error %#v", m0.closeErr)
+	}
+	if ce.Reason != CloseConfigMismatch || ce.Message != "different sharing flags" {
+		t.Errorf("Incorrect close error %#v", ce)
+	}
+	if ce.Reason.Temporary() {
+		t.Error("Configuration mismatch should not be temporary")
+	}
+}
+
+func TestCloseReasonTemporary(t *testing.T) {
+	for _, r := range []CloseReason{CloseUnknown, CloseShutdown, CloseRepoStopped} {
+		if !r.Temporary() {
+			t.Errorf("%v should be temporary", r)
+		}
+	}
+	for _, r := range []CloseReason{CloseConfigMismatch, CloseTooManyErrors, ClosePaused} {
+		if r.Temporary() {
+			t.Errorf("%v should not be temporary", r)
+		}
+	}
+}
@@ -11,11 +11,14 @@ import (
 
 type TestModel struct {
 	data     []byte
+	err      error
 	repo     string
 	name     string
 	offset   int64
 	size     int
 	closedCh chan bool
+	closeErr error
+	configs  chan ClusterConfigMessage // receives the Cluster Config messages, if not nil
 }
 
 func newTestModel() *TestModel {
@@ -35,14 +38,18 @@ func (t *TestModel) Request(nodeID, repo, name string, offset int64, size int) (
 	t.name = name
 	t.offset = offset
 	t.size = size
-	return t.data, nil
+	return t.data, t.err
 }
 
 func (t *TestModel) Close(nodeID string, err error) {
+	t.closeErr = err
 	close(t.closedCh)
 }
 
 func (t *TestModel) ClusterConfig(nodeID string, config ClusterConfigMessage) {
+	if t.configs != nil {
+		t.configs <- config
+	}
 }
 
 func (t *TestModel) isClosed() bool {
@@ -0,0 +1,110 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"compress/flate"
+	"fmt"
+	"io"
+)
+
+// Compression selects the messages that are compressed when sent on a
+// connection. Each direction of a connection is a single deflate stream, in
+// which messages that are not compressed are sent as stored blocks. The
+// receiver reads them the same either way, so the choice is the sender's
+// alone and needs no negotiation.
+type Compression int
+
+const (
+	CompressAlways   Compression = iota // all messages
+	CompressMetadata                    // all messages but those carrying file data
+	CompressNever                       // no messages
+)
+
+// ParseCompression returns the compression with the name used in the
+// configuration; "always", "metadata" or "never". The empty string means
+// always.
+func ParseCompression(s string) (Compression, error) {
+	switch s {
+	case "", "always":
+		return CompressAlways, nil
+	case "metadata":
+		return CompressMetadata, nil
+	case "never":
+		return CompressNever, nil
+	}
+	return CompressAlways, fmt.Errorf("unknown compression %q", s)
+}
+
+// compresses returns true if messages of the type are compressed.
+func (c Compression) compresses(msgType int) bool {
+	switch c {
+	case CompressNever:
+		return false
+	case CompressMetadata:
+		return msgType != messageTypeResponse && msgType != messageTypeVectorResponse
+	}
+	return true
+}
+
+// A deflateWriter writes a deflate stream in which each message, ended by
+// Flush, is either compressed or stored. The compressor is reset after
+// stored data, since what it compressed before is then no longer where it
+// would refer back to.
+type deflateWriter struct {
+	w        io.Writer
+	comp     *flate.Writer
+	store    *flate.Writer
+	compress bool // the current message
+	reset    bool // stored data was written since the compressor was used
+}
+
+func newDeflateWriter(w io.Writer, c Compression) *deflateWriter {
+	d := &deflateWriter{w: w, compress: c != CompressNever}
+	var err error
+	if c != CompressNever {
+		if d.comp, err = flate.NewWriter(w, flate.BestSpeed); err != nil {
+			panic(err)
+		}
+	}
+	if c != CompressAlways {
+		if d.store, err = flate.NewWriter(w, flate.NoCompression); err != nil {
+			panic(err)
+		}
+	}
+	return d
+}
+
+// setCompress sets whether the next message is compressed. It must be
+// called between messages, when everything written has been flushed.
+func (d *deflateWriter) setCompress(compress bool) {
+	d.compress = compress && d.comp != nil || d.store == nil
+}
+
+func (d *deflateWriter) Write(bs []byte) (int, error) {
+	if !d.compress {
+		d.reset = true
+		return d.store.Write(bs)
+	}
+	if d.reset {
+		d.comp.Reset(d.w)
+		d.reset = false
+	}
+	return d.comp.Write(bs)
+}
+
+func (d *deflateWriter) Flush() error {
+	if d.compress {
+		return d.comp.Flush()
+	}
+	return d.store.Flush()
+}
+
+func (d *deflateWriter) Close() error {
+	if d.compress {
+		return d.comp.Close()
+	}
+	return d.store.Close()
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestDeflateWriter(t *testing.T) {
+	msgs := [][]byte{
+		bytes.Repeat([]byte("compressible metadata "), 100),
+		bytes.Repeat([]byte("file data "), 100),
+		bytes.Repeat([]byte("compressible metadata "), 100),
+		bytes.Repeat([]byte("more metadata "), 100),
+	}
+	compress := []bool{true, false, true, true}
+
+	sizes := make(map[Compression]int)
+	for _, c := range []Compression{CompressAlways, CompressMetadata, CompressNever} {
+		var buf bytes.Buffer
+		d := newDeflateWriter(&buf, c)
+		for i, msg := range msgs {
+			d.setCompress(compress[i])
+			d.Write(msg)
+			if err := d.Flush(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		sizes[c] = buf.Len()
+
+		// Whatever the compression, the stream reads back the same
+		bs, err := ioutil.ReadAll(flate.NewReader(&buf))
+		if err != nil && err != io.ErrUnexpectedEOF {
+			t.Fatal(err)
+		}
+		if exp := bytes.Join(msgs, nil); !bytes.Equal(bs, exp) {
+			t.Errorf("%d: incorrect data read back", c)
+		}
+	}
+
+	if !(sizes[CompressAlways] < sizes[CompressMetadata] && sizes[CompressMetadata] < sizes[CompressNever]) {
+		t.Errorf("Unexpected sizes %v", sizes)
+	}
+}
+
+func TestCompressedConnection(t *testing.T) {
+	for _, c := range []Compression{CompressMetadata, CompressNever} {
+		ar, aw := io.Pipe()
+		br, bw := io.Pipe()
+
+		m0 := newTestModel()
+		m0.data = []byte("response data")
+		NewConnectionWithCompression("c0", ar, bw, m0, c)
+		c1 := NewConnectionWithCompression("c1", br, aw, newTestModel(), c)
+
+		for i := 0; i < 3; i++ {
+			c1.Index("default", []FileInfo{{Name: "a"}})
+			data, err := c1.Request("default", "a", 0, 13)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != "response data" {
+				t.Errorf("%d: incorrect response %q", c, data)
+			}
+		}
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	cases := map[string]Compression{
+		"":         CompressAlways,
+		"always":   CompressAlways,
+		"metadata": CompressMetadata,
+		"never":    CompressNever,
+	}
+	for s, exp := range cases {
+		if c, err := ParseCompression(s); err != nil || c != exp {
+			t.Errorf("ParseCompression(%q) = %d, %v", s, c, err)
+		}
+	}
+	if _, err := ParseCompression("sometimes"); err == nil {
+		t.Error("Expected error for unknown compression")
+	}
+}
@@ -7,11 +7,13 @@ package protocol
 import (
 	"io"
 	"sync/atomic"
+	"time"
 )
 
 type countingReader struct {
 	io.Reader
-	tot uint64
+	tot  uint64
+	last int64 // unix nanoseconds
 }
 
 var (
@@ -23,6 +25,9 @@ func (c *countingReader) Read(bs []byte) (int, error) {
 	n, err := c.Reader.Read(bs)
 	atomic.AddUint64(&c.tot, uint64(n))
 	atomic.AddUint64(&totalIncoming, uint64(n))
+	if n > 0 {
+		atomic.StoreInt64(&c.last, time.Now().UnixNano())
+	}
 	return n, err
 }
 
@@ -30,15 +35,24 @@ func (c *countingReader) Tot() uint64 {
 	return atomic.LoadUint64(&c.tot)
 }
 
+// Last returns the time data was last read.
+func (c *countingReader) Last() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&c.last))
+}
+
 type countingWriter struct {
 	io.Writer
-	tot uint64
+	tot  uint64
+	last int64 // unix nanoseconds
 }
 
 func (c *countingWriter) Write(bs []byte) (int, error) {
 	n, err := c.Writer.Write(bs)
 	atomic.AddUint64(&c.tot, uint64(n))
 	atomic.AddUint64(&totalOutgoing, uint64(n))
+	if n > 0 {
+		atomic.StoreInt64(&c.last, time.Now().UnixNano())
+	}
 	return n, err
 }
 
@@ -46,6 +60,11 @@ func (c *countingWriter) Tot() uint64 {
 	return atomic.LoadUint64(&c.tot)
 }
 
+// Last returns the time data was last written.
+func (c *countingWriter) Last() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&c.last))
+}
+
 func TotalInOut() (uint64, uint64) {
 	return atomic.LoadUint64(&totalIncoming), atomic.LoadUint64(&totalOutgoing)
 }
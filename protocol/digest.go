@@ -0,0 +1,111 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.google.com/p/go.text/unicode/norm"
+)
+
+// OptionIndexDigest is set in the Cluster Config options by nodes that
+// understand the Index Digest message.
+const OptionIndexDigest = "indexDigest"
+
+// A mismatching digest is acted upon when this many are received in a row,
+// as index updates may have been in flight when the digest was calculated.
+const maxDigestMismatches = 2
+
+// An IndexResender is asked to send the full index for a repository again
+// when the peer's view of it has diverged from what was sent. The Model
+// given to NewConnection may implement it.
+type IndexResender interface {
+	ResendIndex(nodeID string, repo string)
+}
+
+// indexDigest returns a hash over the names and versions of the files. The
+// names are converted to wire format first, as the sender hashes the names
+// it sent and the receiver the native names it keeps. Names that a receiver
+// could leave out of its index are left out of the digest as well, so that
+// both sides agree on what to hash.
+func indexDigest(versions map[string]uint64) []byte {
+	var wire = make(map[string]uint64, len(versions))
+	var names = make([]string, 0, len(versions))
+	for name, version := range versions {
+		name = norm.NFC.String(filepath.ToSlash(name))
+		if checkName(name) != nil || strings.IndexByte(name, '\\') >= 0 {
+			continue
+		}
+		wire[name] = version
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	var buf [8]byte
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		binary.BigEndian.PutUint64(buf[:], wire[name])
+		h.Write(buf[:])
+	}
+	return h.Sum(nil)
+}
+
+// IndexDigest sends a digest of the given files, which are what we know of
+// the peer's index for the repository. The peer compares it to what it has
+// sent and resends the full index if they differ.
+func (c *rawConnection) IndexDigest(repo string, files []FileInfo) {
+	var versions = make(map[string]uint64, len(files))
+	for _, f := range files {
+		versions[f.Name] = f.Version
+	}
+	c.send(header{0, -1, messageTypeIndexDigest}, IndexDigestMessage{repo, indexDigest(versions)})
+}
+
+func (c *rawConnection) handleIndexDigest() error {
+	var dm IndexDigestMessage
+	dm.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+
+	c.imut.Lock()
+	sent, ok := c.indexSent[dm.Repository]
+	if !ok {
+		// We haven't sent an index yet, so there is nothing to compare to.
+		c.imut.Unlock()
+		return nil
+	}
+	if bytes.Equal(indexDigest(sent), dm.Digest) {
+		delete(c.digestMismatches, dm.Repository)
+		c.imut.Unlock()
+		return nil
+	}
+	c.digestMismatches[dm.Repository]++
+	resend := c.digestMismatches[dm.Repository] >= maxDigestMismatches
+	if resend {
+		// The next index for the repository is sent in full.
+		delete(c.indexSent, dm.Repository)
+		delete(c.digestMismatches, dm.Repository)
+	}
+	c.imut.Unlock()
+
+	if debug {
+		l.Debugf("%s: index digest mismatch for %q; resend: %v", c.id, dm.Repository, resend)
+	}
+	if resend {
+		l.Infof("Node %s has a differing view of repository %q; resending index", c.id, dm.Repository)
+		if c.resender != nil {
+			go c.resender.ResendIndex(c.id, dm.Repository)
+		}
+	}
+	return nil
+}
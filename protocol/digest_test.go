@@ -0,0 +1,84 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIndexDigest(t *testing.T) {
+	d0 := indexDigest(map[string]uint64{"a": 1, "b/c": 2})
+	if d1 := indexDigest(map[string]uint64{"b/c": 2, "a": 1, "../d": 3, "e\\f": 4}); !bytes.Equal(d0, d1) {
+		t.Error("Unexpected digest difference for rejected names")
+	}
+	if d1 := indexDigest(map[string]uint64{"a": 1, "b/c": 3}); bytes.Equal(d0, d1) {
+		t.Error("Digest does not reflect version")
+	}
+	if d1 := indexDigest(map[string]uint64{"a": 1}); bytes.Equal(d0, d1) {
+		t.Error("Digest does not reflect missing file")
+	}
+
+	// Native names hash as their wire format
+	if d1 := indexDigest(map[string]uint64{"a": 1, filepath.Join("b", "c"): 2}); !bytes.Equal(d0, d1) {
+		t.Error("Unexpected digest difference for native separators")
+	}
+	nfc := indexDigest(map[string]uint64{"\u00e5": 1})
+	if nfd := indexDigest(map[string]uint64{"a\u030a": 1}); !bytes.Equal(nfc, nfd) {
+		t.Error("Unexpected digest difference for NFD name")
+	}
+}
+
+type resendModel struct {
+	*TestModel
+	resends chan string
+}
+
+func (m resendModel) ResendIndex(nodeID, repo string) {
+	m.resends <- repo
+}
+
+func TestIndexDigestResend(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	m0 := resendModel{newTestModel(), make(chan string, 1)}
+	c0 := NewConnection("c0", ar, bw, m0).(wireFormatConnection).next.(*rawConnection)
+	c1 := NewConnection("c1", br, aw, newTestModel()).(wireFormatConnection).next.(*rawConnection)
+
+	files := []FileInfo{{Name: "a", Version: 1}, {Name: "b", Version: 2}}
+	c0.Index("default", files)
+
+	// Pings are answered after the preceding messages have been handled
+	c1.IndexDigest("default", files)
+	c1.ping()
+	c1.IndexDigest("default", files[:1])
+	c1.ping()
+	select {
+	case <-m0.resends:
+		t.Fatal("Unexpected resend after a single mismatch")
+	default:
+	}
+
+	c1.IndexDigest("default", files[:1])
+	select {
+	case repo := <-m0.resends:
+		if repo != "default" {
+			t.Errorf("Unexpected resend of %q", repo)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No resend after repeated mismatches")
+	}
+
+	c0.imut.Lock()
+	sent := c0.indexSent["default"]
+	c0.imut.Unlock()
+	if sent != nil {
+		t.Error("Index should be sent in full after a resend request")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/calmh/syncthing/xdr"
+)
+
+// ProtocolVersion is the version of the protocol spoken by this
+// implementation. It is increased for changes that older versions cannot
+// understand, and the lower version of the two nodes is then spoken.
+const ProtocolVersion = 3
+
+// MinProtocolVersion is the lowest version this implementation can speak.
+// Version 1 lacked the repository flags and node names and addresses in
+// the Cluster Config message.
+const MinProtocolVersion = 2
+
+// indexIDVersion is the first version with the IndexID field in the
+// Repository records of the Cluster Config message.
+const indexIDVersion = 3
+
+// HelloMagic starts the Hello message. A node from before the Hello
+// message starts with the compressed stream instead, which never begins
+// with these bytes.
+const HelloMagic uint32 = 0x9F79BC40
+
+// HelloTimeout is the time the Hello exchange is allowed to take before the
+// connection is given up.
+const HelloTimeout = 10 * time.Second
+
+var ErrNoHello = errors.New("no Hello message; the other node is running an older version")
+
+// ExchangeHello sends the Hello message on a new connection and reads the
+// one sent by the other node, before the connection is handed to
+// NewConnection. It returns the Hello message of the other node.
+func ExchangeHello(c io.ReadWriter, h HelloMessage) (HelloMessage, error) {
+	h.Version = ProtocolVersion
+
+	var buf bytes.Buffer
+	xw := xdr.NewWriter(&buf)
+	xw.WriteUint32(HelloMagic)
+	if _, err := h.encodeXDR(xw); err != nil {
+		return HelloMessage{}, err
+	}
+
+	// Both ends send first, so the sending must not wait for the reading.
+	sent := make(chan error, 1)
+	go func() {
+		_, err := c.Write(buf.Bytes())
+		sent <- err
+	}()
+
+	var remote HelloMessage
+	xr := xdr.NewReader(c)
+	magic := xr.ReadUint32()
+	if err := xr.Error(); err != nil {
+		return HelloMessage{}, err
+	}
+	if magic != HelloMagic {
+		return HelloMessage{}, ErrNoHello
+	}
+	if err := remote.decodeXDR(xr); err != nil {
+		return HelloMessage{}, err
+	}
+	if err := <-sent; err != nil {
+		return HelloMessage{}, err
+	}
+
+	if remote.Version < MinProtocolVersion {
+		return remote, fmt.Errorf("unsupported protocol version %d; the other node is running an older version", remote.Version)
+	}
+	return remote, nil
+}
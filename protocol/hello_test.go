@@ -0,0 +1,55 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"net"
+	"testing"
+)
+
+func TestExchangeHello(t *testing.T) {
+	c0, c1 := net.Pipe()
+	defer c0.Close()
+	defer c1.Close()
+
+	res := make(chan HelloMessage, 1)
+	go func() {
+		h, err := ExchangeHello(c1, HelloMessage{ClientName: "other", ClientVersion: "v1.2.3"})
+		if err != nil {
+			t.Error(err)
+		}
+		res <- h
+	}()
+
+	h, err := ExchangeHello(c0, HelloMessage{ClientName: "syncthing", ClientVersion: "v0.9.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ClientName != "other" || h.ClientVersion != "v1.2.3" || h.Version != ProtocolVersion {
+		t.Errorf("Incorrect hello %+v", h)
+	}
+
+	h = <-res
+	if h.ClientName != "syncthing" || h.ClientVersion != "v0.9.0" || h.Version != ProtocolVersion {
+		t.Errorf("Incorrect hello %+v", h)
+	}
+}
+
+func TestExchangeHelloOldNode(t *testing.T) {
+	c0, c1 := net.Pipe()
+	defer c0.Close()
+	defer c1.Close()
+
+	// A node from before the Hello message starts with its compressed
+	// stream, and does not read until it has sent a message.
+	go func() {
+		c1.Write([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+		c1.Read(make([]byte, 128))
+	}()
+
+	if _, err := ExchangeHello(c0, HelloMessage{ClientName: "syncthing"}); err != ErrNoHello {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
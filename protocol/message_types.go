@@ -10,11 +10,15 @@ type IndexMessage struct {
 }
 
 type FileInfo struct {
-	Name     string // max:1024
-	Flags    uint32
-	Modified int64
-	Version  uint64
-	Blocks   []BlockInfo // max:100000
+	Name       string // max:1024
+	Flags      uint32
+	Modified   int64
+	Version    uint64
+	Blocks     []BlockInfo // max:100000
+	ACL        []byte      // max:65536; only on the wire when FlagACL is set
+	Target     string      // max:1024; only on the wire when FlagSymlink is set
+	Vector     Vector      // max:1024; only on the wire when FlagVector is set
+	ModifiedNs int32       // only on the wire when FlagModifiedNs is set
 }
 
 type BlockInfo struct {
@@ -37,16 +41,70 @@ type ClusterConfigMessage struct {
 }
 
 type Repository struct {
-	ID    string // max:64
-	Nodes []Node // max:64
+	ID      string // max:64
+	Flags   uint32
+	Nodes   []Node // max:64
+	IndexID uint64 // only on the wire when FlagRepoIndexID is set
 }
 
 type Node struct {
-	ID    string // max:64
-	Flags uint32
+	ID        string   // max:64
+	Name      string   // max:64
+	Addresses []string // max:16; each max:256
+	Flags     uint32
 }
 
 type Option struct {
 	Key   string // max:64
 	Value string // max:1024
 }
+
+type IndexDigestMessage struct {
+	Repository string // max:64
+	Digest     []byte // max:32
+}
+
+type BlockListMessage struct {
+	Blocks []BlockInfo // max:1024
+}
+
+type RequestVectorMessage struct {
+	Repository string  // max:64
+	Name       string  // max:1024
+	Ranges     []Range // max:64
+}
+
+type Range struct {
+	Offset uint64
+	Size   uint32
+}
+
+type VectorResponseMessage struct {
+	Data [][]byte // max:64; each max:262144
+}
+
+type ResponseErrorMessage struct {
+	Code uint32
+}
+
+type CloseMessage struct {
+	Reason  uint32
+	Message string // max:1024
+}
+
+type DownloadProgressMessage struct {
+	Repository string                 // max:64
+	Files      []FileDownloadProgress // max:1000
+}
+
+type FileDownloadProgress struct {
+	Name    string // max:1024
+	Version uint64
+	Blocks  []uint32 // max:100000
+}
+
+type HelloMessage struct {
+	Version       uint32
+	ClientName    string // max:64
+	ClientVersion string // max:64
+}
@@ -79,7 +79,17 @@ func (o FileInfo) encodeXDR(xw *xdr.Writer) (int, error) {
 		return xw.Tot(), xdr.ErrElementSizeExceeded
 	}
 	xw.WriteString(o.Name)
-	xw.WriteUint32(o.Flags)
+	flags := o.Flags &^ (FlagACL | FlagVector | FlagModifiedNs)
+	if len(o.ACL) > 0 {
+		flags |= FlagACL
+	}
+	if len(o.Vector) > 0 {
+		flags |= FlagVector
+	}
+	if o.ModifiedNs != 0 {
+		flags |= FlagModifiedNs
+	}
+	xw.WriteUint32(flags)
 	xw.WriteUint64(uint64(o.Modified))
 	xw.WriteUint64(o.Version)
 	if len(o.Blocks) > 100000 {
@@ -89,6 +99,31 @@ func (o FileInfo) encodeXDR(xw *xdr.Writer) (int, error) {
 	for i := range o.Blocks {
 		o.Blocks[i].encodeXDR(xw)
 	}
+	if flags&FlagACL != 0 {
+		if len(o.ACL) > 65536 {
+			return xw.Tot(), xdr.ErrElementSizeExceeded
+		}
+		xw.WriteBytes(o.ACL)
+	}
+	if flags&FlagSymlink != 0 {
+		if len(o.Target) > 1024 {
+			return xw.Tot(), xdr.ErrElementSizeExceeded
+		}
+		xw.WriteString(o.Target)
+	}
+	if flags&FlagVector != 0 {
+		if len(o.Vector) > 1024 {
+			return xw.Tot(), xdr.ErrElementSizeExceeded
+		}
+		xw.WriteUint32(uint32(len(o.Vector)))
+		for _, c := range o.Vector {
+			xw.WriteUint64(c.ID)
+			xw.WriteUint64(c.Value)
+		}
+	}
+	if flags&FlagModifiedNs != 0 {
+		xw.WriteUint32(uint32(o.ModifiedNs))
+	}
 	return xw.Tot(), xw.Error()
 }
 
@@ -116,6 +151,26 @@ func (o *FileInfo) decodeXDR(xr *xdr.Reader) error {
 	for i := range o.Blocks {
 		(&o.Blocks[i]).decodeXDR(xr)
 	}
+	if o.Flags&FlagACL != 0 {
+		o.ACL = xr.ReadBytesMax(65536)
+	}
+	if o.Flags&FlagSymlink != 0 {
+		o.Target = xr.ReadStringMax(1024)
+	}
+	if o.Flags&FlagVector != 0 {
+		_VectorSize := int(xr.ReadUint32())
+		if _VectorSize > 1024 {
+			return xdr.ErrElementSizeExceeded
+		}
+		o.Vector = make(Vector, _VectorSize)
+		for i := range o.Vector {
+			o.Vector[i].ID = xr.ReadUint64()
+			o.Vector[i].Value = xr.ReadUint64()
+		}
+	}
+	if o.Flags&FlagModifiedNs != 0 {
+		o.ModifiedNs = int32(xr.ReadUint32())
+	}
 	return xr.Error()
 }
 
@@ -290,6 +345,11 @@ func (o Repository) encodeXDR(xw *xdr.Writer) (int, error) {
 		return xw.Tot(), xdr.ErrElementSizeExceeded
 	}
 	xw.WriteString(o.ID)
+	flags := o.Flags &^ FlagRepoIndexID
+	if o.IndexID != 0 {
+		flags |= FlagRepoIndexID
+	}
+	xw.WriteUint32(flags)
 	if len(o.Nodes) > 64 {
 		return xw.Tot(), xdr.ErrElementSizeExceeded
 	}
@@ -297,6 +357,9 @@ func (o Repository) encodeXDR(xw *xdr.Writer) (int, error) {
 	for i := range o.Nodes {
 		o.Nodes[i].encodeXDR(xw)
 	}
+	if flags&FlagRepoIndexID != 0 {
+		xw.WriteUint64(o.IndexID)
+	}
 	return xw.Tot(), xw.Error()
 }
 
@@ -313,6 +376,7 @@ func (o *Repository) UnmarshalXDR(bs []byte) error {
 
 func (o *Repository) decodeXDR(xr *xdr.Reader) error {
 	o.ID = xr.ReadStringMax(64)
+	o.Flags = xr.ReadUint32()
 	_NodesSize := int(xr.ReadUint32())
 	if _NodesSize > 64 {
 		return xdr.ErrElementSizeExceeded
@@ -321,6 +385,9 @@ func (o *Repository) decodeXDR(xr *xdr.Reader) error {
 	for i := range o.Nodes {
 		(&o.Nodes[i]).decodeXDR(xr)
 	}
+	if o.Flags&FlagRepoIndexID != 0 {
+		o.IndexID = xr.ReadUint64()
+	}
 	return xr.Error()
 }
 
@@ -341,6 +408,20 @@ func (o Node) encodeXDR(xw *xdr.Writer) (int, error) {
 		return xw.Tot(), xdr.ErrElementSizeExceeded
 	}
 	xw.WriteString(o.ID)
+	if len(o.Name) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Name)
+	if len(o.Addresses) > 16 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Addresses)))
+	for i := range o.Addresses {
+		if len(o.Addresses[i]) > 256 {
+			return xw.Tot(), xdr.ErrElementSizeExceeded
+		}
+		xw.WriteString(o.Addresses[i])
+	}
 	xw.WriteUint32(o.Flags)
 	return xw.Tot(), xw.Error()
 }
@@ -358,6 +439,15 @@ func (o *Node) UnmarshalXDR(bs []byte) error {
 
 func (o *Node) decodeXDR(xr *xdr.Reader) error {
 	o.ID = xr.ReadStringMax(64)
+	o.Name = xr.ReadStringMax(64)
+	_AddressesSize := int(xr.ReadUint32())
+	if _AddressesSize > 16 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Addresses = make([]string, _AddressesSize)
+	for i := range o.Addresses {
+		o.Addresses[i] = xr.ReadStringMax(256)
+	}
 	o.Flags = xr.ReadUint32()
 	return xr.Error()
 }
@@ -402,3 +492,451 @@ func (o *Option) decodeXDR(xr *xdr.Reader) error {
 	o.Value = xr.ReadStringMax(1024)
 	return xr.Error()
 }
+
+func (o IndexDigestMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o IndexDigestMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o IndexDigestMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Repository) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Repository)
+	if len(o.Digest) > 32 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteBytes(o.Digest)
+	return xw.Tot(), xw.Error()
+}
+
+func (o *IndexDigestMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *IndexDigestMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *IndexDigestMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Repository = xr.ReadStringMax(64)
+	o.Digest = xr.ReadBytesMax(32)
+	return xr.Error()
+}
+
+func (o BlockListMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o BlockListMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o BlockListMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Blocks) > 1024 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Blocks)))
+	for i := range o.Blocks {
+		o.Blocks[i].encodeXDR(xw)
+	}
+	return xw.Tot(), xw.Error()
+}
+
+func (o *BlockListMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *BlockListMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *BlockListMessage) decodeXDR(xr *xdr.Reader) error {
+	_BlocksSize := int(xr.ReadUint32())
+	if _BlocksSize > 1024 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Blocks = make([]BlockInfo, _BlocksSize)
+	for i := range o.Blocks {
+		(&o.Blocks[i]).decodeXDR(xr)
+	}
+	return xr.Error()
+}
+
+func (o RequestVectorMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o RequestVectorMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o RequestVectorMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Repository) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Repository)
+	if len(o.Name) > 1024 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Name)
+	if len(o.Ranges) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Ranges)))
+	for i := range o.Ranges {
+		o.Ranges[i].encodeXDR(xw)
+	}
+	return xw.Tot(), xw.Error()
+}
+
+func (o *RequestVectorMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *RequestVectorMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *RequestVectorMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Repository = xr.ReadStringMax(64)
+	o.Name = xr.ReadStringMax(1024)
+	_RangesSize := int(xr.ReadUint32())
+	if _RangesSize > 64 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Ranges = make([]Range, _RangesSize)
+	for i := range o.Ranges {
+		(&o.Ranges[i]).decodeXDR(xr)
+	}
+	return xr.Error()
+}
+
+func (o Range) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o Range) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o Range) encodeXDR(xw *xdr.Writer) (int, error) {
+	xw.WriteUint64(o.Offset)
+	xw.WriteUint32(o.Size)
+	return xw.Tot(), xw.Error()
+}
+
+func (o *Range) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *Range) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *Range) decodeXDR(xr *xdr.Reader) error {
+	o.Offset = xr.ReadUint64()
+	o.Size = xr.ReadUint32()
+	return xr.Error()
+}
+
+func (o VectorResponseMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o VectorResponseMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o VectorResponseMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Data) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Data)))
+	for i := range o.Data {
+		if len(o.Data[i]) > 262144 {
+			return xw.Tot(), xdr.ErrElementSizeExceeded
+		}
+		xw.WriteBytes(o.Data[i])
+	}
+	return xw.Tot(), xw.Error()
+}
+
+func (o *VectorResponseMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *VectorResponseMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *VectorResponseMessage) decodeXDR(xr *xdr.Reader) error {
+	_DataSize := int(xr.ReadUint32())
+	if _DataSize > 64 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Data = make([][]byte, _DataSize)
+	for i := range o.Data {
+		o.Data[i] = xr.ReadBytesMax(262144)
+	}
+	return xr.Error()
+}
+
+func (o ResponseErrorMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o ResponseErrorMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o ResponseErrorMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	xw.WriteUint32(o.Code)
+	return xw.Tot(), xw.Error()
+}
+
+func (o *ResponseErrorMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *ResponseErrorMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *ResponseErrorMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Code = xr.ReadUint32()
+	return xr.Error()
+}
+
+func (o CloseMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o CloseMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o CloseMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	xw.WriteUint32(o.Reason)
+	if len(o.Message) > 1024 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Message)
+	return xw.Tot(), xw.Error()
+}
+
+func (o *CloseMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *CloseMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *CloseMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Reason = xr.ReadUint32()
+	o.Message = xr.ReadStringMax(1024)
+	return xr.Error()
+}
+
+func (o DownloadProgressMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o DownloadProgressMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o DownloadProgressMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Repository) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Repository)
+	if len(o.Files) > 1000 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Files)))
+	for i := range o.Files {
+		_, err := o.Files[i].encodeXDR(xw)
+		if err != nil {
+			return xw.Tot(), err
+		}
+	}
+	return xw.Tot(), xw.Error()
+}
+
+func (o *DownloadProgressMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *DownloadProgressMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *DownloadProgressMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Repository = xr.ReadStringMax(64)
+	_FilesSize := int(xr.ReadUint32())
+	if _FilesSize > 1000 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Files = make([]FileDownloadProgress, _FilesSize)
+	for i := range o.Files {
+		(&o.Files[i]).decodeXDR(xr)
+	}
+	return xr.Error()
+}
+
+func (o FileDownloadProgress) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o FileDownloadProgress) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o FileDownloadProgress) encodeXDR(xw *xdr.Writer) (int, error) {
+	if len(o.Name) > 1024 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.Name)
+	xw.WriteUint64(o.Version)
+	if len(o.Blocks) > 100000 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteUint32(uint32(len(o.Blocks)))
+	for i := range o.Blocks {
+		xw.WriteUint32(o.Blocks[i])
+	}
+	return xw.Tot(), xw.Error()
+}
+
+func (o *FileDownloadProgress) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *FileDownloadProgress) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *FileDownloadProgress) decodeXDR(xr *xdr.Reader) error {
+	o.Name = xr.ReadStringMax(1024)
+	o.Version = xr.ReadUint64()
+	_BlocksSize := int(xr.ReadUint32())
+	if _BlocksSize > 100000 {
+		return xdr.ErrElementSizeExceeded
+	}
+	o.Blocks = make([]uint32, _BlocksSize)
+	for i := range o.Blocks {
+		o.Blocks[i] = xr.ReadUint32()
+	}
+	return xr.Error()
+}
+
+func (o HelloMessage) EncodeXDR(w io.Writer) (int, error) {
+	var xw = xdr.NewWriter(w)
+	return o.encodeXDR(xw)
+}
+
+func (o HelloMessage) MarshalXDR() []byte {
+	var buf bytes.Buffer
+	var xw = xdr.NewWriter(&buf)
+	o.encodeXDR(xw)
+	return buf.Bytes()
+}
+
+func (o HelloMessage) encodeXDR(xw *xdr.Writer) (int, error) {
+	xw.WriteUint32(o.Version)
+	if len(o.ClientName) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.ClientName)
+	if len(o.ClientVersion) > 64 {
+		return xw.Tot(), xdr.ErrElementSizeExceeded
+	}
+	xw.WriteString(o.ClientVersion)
+	return xw.Tot(), xw.Error()
+}
+
+func (o *HelloMessage) DecodeXDR(r io.Reader) error {
+	xr := xdr.NewReader(r)
+	return o.decodeXDR(xr)
+}
+
+func (o *HelloMessage) UnmarshalXDR(bs []byte) error {
+	var buf = bytes.NewBuffer(bs)
+	var xr = xdr.NewReader(buf)
+	return o.decodeXDR(xr)
+}
+
+func (o *HelloMessage) decodeXDR(xr *xdr.Reader) error {
+	o.Version = xr.ReadUint32()
+	o.ClientName = xr.ReadStringMax(64)
+	o.ClientVersion = xr.ReadStringMax(64)
+	return xr.Error()
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import "testing"
+
+func TestFileInfoModifiedNs(t *testing.T) {
+	f := FileInfo{
+		Name:       "foo",
+		Flags:      0644,
+		Modified:   1000,
+		ModifiedNs: 123456789,
+		Vector:     Vector{{VectorID("node1"), 3}},
+	}
+
+	var d FileInfo
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags&FlagModifiedNs == 0 || d.Modified != 1000 || d.ModifiedNs != 123456789 || len(d.Vector) != 1 {
+		t.Errorf("Incorrect decoded time %d.%09d, flags 0%o", d.Modified, d.ModifiedNs, d.Flags)
+	}
+
+	// Whole seconds are encoded as before, without the flag
+	f.ModifiedNs = 0
+	f.Vector = nil
+	d = FileInfo{}
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags != 0644 || d.ModifiedNs != 0 {
+		t.Errorf("Unexpected nanoseconds %d, flags 0%o", d.ModifiedNs, d.Flags)
+	}
+}
@@ -33,6 +33,26 @@ func (m nativeModel) Request(nodeID, repo string, name string, offset int64, siz
 	return m.next.Request(nodeID, repo, name, offset, size)
 }
 
+func (m nativeModel) BlockList(nodeID, repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	lister, ok := m.next.(BlockLister)
+	if !ok {
+		return nil, ErrNoBlockList
+	}
+	name = norm.NFD.String(name)
+	return lister.BlockList(nodeID, repo, name, offset, size)
+}
+
+func (m nativeModel) DownloadProgress(nodeID, repo string, files []FileDownloadProgress) {
+	r, ok := m.next.(DownloadProgressReceiver)
+	if !ok {
+		return
+	}
+	for i := range files {
+		files[i].Name = norm.NFD.String(files[i].Name)
+	}
+	r.DownloadProgress(nodeID, repo, files)
+}
+
 func (m nativeModel) ClusterConfig(nodeID string, config ClusterConfigMessage) {
 	m.next.ClusterConfig(nodeID, config)
 }
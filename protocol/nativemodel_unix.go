@@ -24,6 +24,20 @@ func (m nativeModel) Request(nodeID, repo string, name string, offset int64, siz
 	return m.next.Request(nodeID, repo, name, offset, size)
 }
 
+func (m nativeModel) BlockList(nodeID, repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	lister, ok := m.next.(BlockLister)
+	if !ok {
+		return nil, ErrNoBlockList
+	}
+	return lister.BlockList(nodeID, repo, name, offset, size)
+}
+
+func (m nativeModel) DownloadProgress(nodeID, repo string, files []FileDownloadProgress) {
+	if r, ok := m.next.(DownloadProgressReceiver); ok {
+		r.DownloadProgress(nodeID, repo, files)
+	}
+}
+
 func (m nativeModel) ClusterConfig(nodeID string, config ClusterConfigMessage) {
 	m.next.ClusterConfig(nodeID, config)
 }
@@ -7,52 +7,70 @@
 package protocol
 
 // Windows uses backslashes as file separator and disallows a bunch of
-// characters in the filename
+// characters and names in the filename
 
-import (
-	"path/filepath"
-	"strings"
-)
-
-var disallowedCharacters = string([]rune{
-	'<', '>', ':', '"', '|', '?', '*',
-	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31,
-})
+import "path/filepath"
 
 type nativeModel struct {
 	next Model
 }
 
 func (m nativeModel) Index(nodeID string, repo string, files []FileInfo) {
-	for i, f := range files {
-		if strings.ContainsAny(f.Name, disallowedCharacters) {
-			files[i].Flags |= FlagInvalid
-			l.Warnf("File name %q contains invalid characters; marked as invalid.", f.Name)
-		}
-		files[i].Name = filepath.FromSlash(f.Name)
-	}
+	nativeFiles(files)
 	m.next.Index(nodeID, repo, files)
 }
 
 func (m nativeModel) IndexUpdate(nodeID string, repo string, files []FileInfo) {
+	nativeFiles(files)
+	m.next.IndexUpdate(nodeID, repo, files)
+}
+
+// nativeFiles marks as invalid the files that can't be created under their
+// name here, so that the puller skips them, and converts the names to use
+// backslashes.
+func nativeFiles(files []FileInfo) {
 	for i, f := range files {
-		if strings.ContainsAny(f.Name, disallowedCharacters) {
+		if err := windowsInvalidName(f.Name); err != nil && !IsInvalid(f.Flags) {
 			files[i].Flags |= FlagInvalid
-			l.Warnf("File name %q contains invalid characters; marked as invalid.", f.Name)
+			l.Warnf("File name %q %v; marked as invalid.", f.Name, err)
 		}
-		files[i].Name = filepath.FromSlash(files[i].Name)
+		files[i].Name = filepath.FromSlash(f.Name)
 	}
-	m.next.IndexUpdate(nodeID, repo, files)
 }
 
 func (m nativeModel) Request(nodeID, repo string, name string, offset int64, size int) ([]byte, error) {
+	// Opening such a name would open a device, or another file than asked
+	// for.
+	if windowsInvalidName(name) != nil {
+		return nil, ErrNoSuchFile
+	}
 	name = filepath.FromSlash(name)
 	return m.next.Request(nodeID, repo, name, offset, size)
 }
 
+func (m nativeModel) BlockList(nodeID, repo string, name string, offset int64, size int) ([]BlockInfo, error) {
+	lister, ok := m.next.(BlockLister)
+	if !ok {
+		return nil, ErrNoBlockList
+	}
+	if windowsInvalidName(name) != nil {
+		return nil, ErrNoSuchFile
+	}
+	name = filepath.FromSlash(name)
+	return lister.BlockList(nodeID, repo, name, offset, size)
+}
+
+func (m nativeModel) DownloadProgress(nodeID, repo string, files []FileDownloadProgress) {
+	r, ok := m.next.(DownloadProgressReceiver)
+	if !ok {
+		return
+	}
+	for i := range files {
+		files[i].Name = filepath.FromSlash(files[i].Name)
+	}
+	r.DownloadProgress(nodeID, repo, files)
+}
+
 func (m nativeModel) ClusterConfig(nodeID string, config ClusterConfigMessage) {
 	m.next.ClusterConfig(nodeID, config)
 }
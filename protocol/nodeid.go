@@ -0,0 +1,86 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A NodeID is the SHA-256 hash of a node's certificate. Its string form is
+// the base32 encoding of the hash without padding, 52 characters long.
+type NodeID [sha256.Size]byte
+
+// ShortIDLen is the length of the short form of a node ID, used in logs and
+// messages where the full ID is unwieldy.
+const ShortIDLen = 7
+
+var nodeIDLen = base32.StdEncoding.EncodedLen(sha256.Size) - 4 // without the padding
+
+var errNodeIDLen = fmt.Errorf("node ID is not %d characters long", nodeIDLen)
+
+// NewNodeID returns the ID of the node with the raw, DER encoded,
+// certificate.
+func NewNodeID(rawCert []byte) NodeID {
+	return NodeID(sha256.Sum256(rawCert))
+}
+
+// NodeIDFromString parses a node ID in string form, as accepted by
+// UnmarshalText.
+func NodeIDFromString(s string) (NodeID, error) {
+	var n NodeID
+	err := n.UnmarshalText([]byte(s))
+	return n, err
+}
+
+// String returns the canonical string form of the node ID.
+func (n NodeID) String() string {
+	return strings.TrimRight(base32.StdEncoding.EncodeToString(n[:]), "=")
+}
+
+// Short returns the first characters of the string form of the node ID,
+// which in practice tell nodes apart.
+func (n NodeID) Short() string {
+	return n.String()[:ShortIDLen]
+}
+
+// MarshalText returns the canonical string form of the node ID. Implements
+// the encoding.TextMarshaler interface.
+func (n NodeID) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText parses a node ID in string form. Dashes and spaces, as
+// used to make the ID easier to read, are ignored and lower case letters
+// are accepted. Anything else that is not the canonical form is an error,
+// so that MarshalText returns the ID as given, save for the grouping and
+// case. Implements the encoding.TextUnmarshaler interface.
+func (n *NodeID) UnmarshalText(bs []byte) error {
+	s := strings.NewReplacer("-", "", " ", "").Replace(string(bs))
+	s = strings.ToUpper(s)
+	if len(s) != nodeIDLen {
+		return errNodeIDLen
+	}
+
+	dec, err := base32.StdEncoding.DecodeString(s + "====")
+	if err != nil {
+		return fmt.Errorf("node ID: %v", err)
+	}
+	if len(dec) != len(n) {
+		return errNodeIDLen
+	}
+
+	var id NodeID
+	copy(id[:], dec)
+	if id.String() != s {
+		// Trailing bits that don't fit in the hash were set
+		return errors.New("node ID is not in canonical form")
+	}
+	*n = id
+	return nil
+}
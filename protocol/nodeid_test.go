@@ -0,0 +1,78 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+var (
+	_ encoding.TextMarshaler   = NodeID{}
+	_ encoding.TextUnmarshaler = &NodeID{}
+)
+
+const testNodeID = "P56IOI7MZJNU2IQGDREYDM2MGTMGL3BXNPQ6W5BTBBZ4TJXZWICQ"
+
+func TestNodeIDString(t *testing.T) {
+	id := NewNodeID([]byte("some certificate"))
+	s := id.String()
+	if len(s) != 52 || strings.ContainsAny(s, "=-") {
+		t.Errorf("Unexpected string form %q", s)
+	}
+	if short := id.Short(); short != s[:ShortIDLen] {
+		t.Errorf("Unexpected short form %q", short)
+	}
+}
+
+func TestNodeIDRoundTrip(t *testing.T) {
+	f := func(id NodeID) bool {
+		bs, err := id.MarshalText()
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		var id2 NodeID
+		if err := id2.UnmarshalText(bs); err != nil {
+			t.Error(err)
+			return false
+		}
+		return id2 == id
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNodeIDUnmarshalText(t *testing.T) {
+	var tests = []struct {
+		s  string
+		ok bool
+	}{
+		{testNodeID, true},
+		{strings.ToLower(testNodeID), true},
+		{"P56IOI7-MZJNU2I-QGDREYD-M2MGTMG-L3BXNPQ-6W5BTBB-Z4TJXZW-ICQ", true},
+		{"P56IOI7 MZJNU2I QGDREYD M2MGTMG L3BXNPQ 6W5BTBB Z4TJXZW ICQ", true},
+		{"", false},
+		{testNodeID[1:], false},
+		{testNodeID + "A", false},
+		{testNodeID + "====", false},
+		{"1" + testNodeID[1:], false},
+		// The last character carries bits beyond the hash
+		{testNodeID[:51] + "D", false},
+	}
+
+	for _, tc := range tests {
+		id, err := NodeIDFromString(tc.s)
+		if (err == nil) != tc.ok {
+			t.Errorf("Incorrect result for %q: %v", tc.s, err)
+		}
+		if err == nil && id.String() != testNodeID {
+			t.Errorf("Incorrect canonical form for %q: %q", tc.s, id)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+// OptionDownloadProgress is set in the Cluster Config options by nodes that
+// understand the Download Progress message, and answer requests for the
+// blocks they announce in it.
+const OptionDownloadProgress = "downloadProgress"
+
+// A DownloadProgressReceiver is told which blocks of the files being
+// downloaded the other node already has. The Model given to NewConnection
+// may implement it.
+type DownloadProgressReceiver interface {
+	DownloadProgress(nodeID string, repo string, files []FileDownloadProgress)
+}
+
+// DownloadProgress tells the connected peer which blocks of the files
+// being downloaded to the repository we already have. Each message
+// replaces the previous one for the repository; files not listed are no
+// longer being downloaded.
+func (c *rawConnection) DownloadProgress(repo string, files []FileDownloadProgress) {
+	c.send(header{0, -1, messageTypeDownloadProgress}, DownloadProgressMessage{repo, files})
+}
+
+func (c *rawConnection) handleDownloadProgress() error {
+	var msg DownloadProgressMessage
+	msg.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+	if debug {
+		l.Debugf("%s: <- download progress %q: %d files", c.id, msg.Repository, len(msg.Files))
+	}
+	if r, ok := c.receiver.(DownloadProgressReceiver); ok {
+		go r.DownloadProgress(c.id, msg.Repository, msg.Files)
+	}
+	return nil
+}
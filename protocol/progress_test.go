@@ -0,0 +1,66 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type progressModel struct {
+	*TestModel
+	progress chan DownloadProgressMessage
+}
+
+func (m progressModel) DownloadProgress(nodeID, repo string, files []FileDownloadProgress) {
+	m.progress <- DownloadProgressMessage{repo, files}
+}
+
+func TestDownloadProgress(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	m0 := progressModel{newTestModel(), make(chan DownloadProgressMessage, 1)}
+	NewConnection("c0", ar, bw, m0)
+	c1 := NewConnection("c1", br, aw, newTestModel())
+
+	files := []FileDownloadProgress{
+		{Name: "a", Version: 42, Blocks: []uint32{0, 3, 1}},
+		{Name: "b/c", Version: 1 << 60, Blocks: []uint32{1 << 20}},
+	}
+	c1.DownloadProgress("default", files)
+
+	select {
+	case msg := <-m0.progress:
+		if msg.Repository != "default" {
+			t.Errorf("Unexpected repository %q", msg.Repository)
+		}
+		if !reflect.DeepEqual(msg.Files, files) {
+			t.Errorf("Unexpected files %+v", msg.Files)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Download progress not received")
+	}
+}
+
+func TestDownloadProgressMessageXDR(t *testing.T) {
+	m1 := DownloadProgressMessage{
+		Repository: "default",
+		Files: []FileDownloadProgress{
+			{Name: "a", Version: 1, Blocks: []uint32{2, 0}},
+			{Name: "empty", Version: 2, Blocks: []uint32{}},
+		},
+	}
+
+	var m2 DownloadProgressMessage
+	if err := m2.UnmarshalXDR(m1.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m1, m2) {
+		t.Errorf("Round trip mismatch:\n%+v\n%+v", m1, m2)
+	}
+}
@@ -8,38 +8,80 @@ import (
 	"bufio"
 	"compress/flate"
 	"errors"
+	"expvar"
 	"fmt"
 	"io"
 	"sync"
 	"time"
+
 	"github.com/calmh/syncthing/xdr"
 )
 
 const BlockSize = 128 * 1024
 
+var (
+	expIndexRecordsSent     = expvar.NewInt("protocol.indexRecordsSent")
+	expIndexRecordsReceived = expvar.NewInt("protocol.indexRecordsReceived")
+	expRequestsSent         = expvar.NewInt("protocol.requestsSent")
+	expRequestsPending      = expvar.NewInt("protocol.requestsPending")
+	expRequestsServed       = expvar.NewInt("protocol.requestsServed")
+	expBytesServed          = expvar.NewInt("protocol.bytesServed")
+)
+
 const (
-	messageTypeClusterConfig = 0
-	messageTypeIndex         = 1
-	messageTypeRequest       = 2
-	messageTypeResponse      = 3
-	messageTypePing          = 4
-	messageTypePong          = 5
-	messageTypeIndexUpdate   = 6
+	messageTypeClusterConfig    = 0
+	messageTypeIndex            = 1
+	messageTypeRequest          = 2
+	messageTypeResponse         = 3
+	messageTypePing             = 4
+	messageTypePong             = 5
+	messageTypeIndexUpdate      = 6
+	messageTypeIndexDigest      = 7
+	messageTypeRequestBlocks    = 8
+	messageTypeRequestVector    = 9
+	messageTypeVectorResponse   = 10
+	messageTypeResponseError    = 11
+	messageTypeClose            = 12
+	messageTypeDownloadProgress = 13
 )
 
 const (
-	FlagDeleted    uint32 = 1 << 12
-	FlagInvalid           = 1 << 13
-	FlagDirectory         = 1 << 14
-	FlagNoPermBits        = 1 << 15
+	FlagDeleted     uint32 = 1 << 12
+	FlagInvalid            = 1 << 13
+	FlagDirectory          = 1 << 14
+	FlagNoPermBits         = 1 << 15
+	FlagACL                = 1 << 16
+	FlagBlockGroups        = 1 << 17
+	FlagSymlink            = 1 << 18
+	FlagVector             = 1 << 19
+	FlagModifiedNs         = 1 << 20
 )
 
 const (
 	FlagShareTrusted  uint32 = 1 << 0
 	FlagShareReadOnly        = 1 << 1
 	FlagShareBits            = 0x000000ff
+	FlagIntroducer           = 1 << 8 // the sender introduces us to the nodes this node shares with
+	FlagCompression          = 3 << 9 // the Compression the sender uses towards the node
 )
 
+// The Repository flags in the Cluster Config message
+const (
+	FlagRepoReadOnly uint32 = 1 << 0 // the sender does not apply changes from other nodes
+	FlagRepoIndexID         = 1 << 1 // the IndexID field follows the nodes
+)
+
+// NodeCompression returns the Compression the sender of the Cluster Config
+// message uses towards the node with the flags.
+func NodeCompression(flags uint32) Compression {
+	return Compression(flags & FlagCompression >> 9)
+}
+
+// CompressionFlags returns the Node flags for the Compression.
+func CompressionFlags(c Compression) uint32 {
+	return uint32(c) << 9 & FlagCompression
+}
+
 var (
 	ErrClusterHash = fmt.Errorf("configuration error: mismatched cluster hash")
 	ErrClosed      = errors.New("connection closed")
@@ -58,31 +100,56 @@ type Model interface {
 	Close(nodeID string, err error)
 }
 
+// A RejectionReporter is told about the files left out of incoming indexes
+// because of their names. The Model given to NewConnection may implement it.
+type RejectionReporter interface {
+	Rejected(nodeID string, repo string, name string, err error)
+}
+
 type Connection interface {
 	ID() string
 	Index(repo string, files []FileInfo)
 	Request(repo string, name string, offset int64, size int) ([]byte, error)
+	RequestAsync(repo string, name string, offset int64, size int) <-chan Response
 	ClusterConfig(config ClusterConfigMessage)
+	RequestBlocks(repo string, name string, offset int64, size int) ([]BlockInfo, error)
+	RequestVector(repo string, name string, ranges []Range) ([][]byte, error)
+	IndexDigest(repo string, files []FileInfo)
+	DownloadProgress(repo string, files []FileDownloadProgress)
+	Ping() bool
 	Statistics() Statistics
+	Close(reason CloseReason, msg string)
 }
 
 type rawConnection struct {
 	id       string
 	receiver Model
+	rejects  RejectionReporter // may be nil
+	resender IndexResender     // may be nil
 
-	reader io.ReadCloser
 	cr     *countingReader
 	xr     *xdr.Reader
-	writer io.WriteCloser
+	writer *deflateWriter
+
+	compression    Compression
+	receiveTimeout time.Duration
+	pingInterval   time.Duration
 
 	cw   *countingWriter
 	wb   *bufio.Writer
 	xw   *xdr.Writer
 	wmut sync.Mutex
 
-	indexSent map[string]map[string]uint64
-	awaiting  []chan asyncResult
-	imut      sync.Mutex
+	indexSent        map[string]map[string]uint64
+	digestMismatches map[string]int
+	awaiting         []chan asyncResult
+	outstanding      chan struct{} // a slot per request awaiting a response; nil for no limit
+	responseErrors   bool          // the peer understands Response Error messages
+	version          uint32        // the protocol version of the peer
+	imut             sync.Mutex
+
+	incomingIndexes chan incomingIndex
+	closeErr        error
 
 	nextID chan int
 	outbox chan []encodable
@@ -90,41 +157,98 @@ type rawConnection struct {
 }
 
 type asyncResult struct {
-	val []byte
-	err error
+	val  []byte
+	vals [][]byte // for vector requests
+	err  error
 }
 
 const (
-	pingTimeout  = 30 * time.Second
-	pingIdleTime = 60 * time.Second
+	// A Ping is sent on a connection when nothing else has been sent for
+	// this long, or a third of the receive timeout if that is shorter.
+	PingSendInterval = 15 * time.Second
+	// DefaultReceiveTimeout is how long a connection may go without
+	// receiving anything before it is closed as dead.
+	DefaultReceiveTimeout = 45 * time.Second
+	// MinReceiveTimeout is the shortest receive timeout that does not risk
+	// closing live connections to nodes sending Pings at the usual
+	// interval.
+	MinReceiveTimeout = 2 * PingSendInterval
 )
 
+// ConnectionOptions are the settings of a connection that can differ from
+// node to node.
+type ConnectionOptions struct {
+	Compression    Compression
+	ReceiveTimeout time.Duration // zero for DefaultReceiveTimeout
+	MaxOutstanding int           // requests sent without a response yet, beyond which sending waits; zero for no limit
+	Version        uint32        // the protocol version in the other node's Hello message; zero for ProtocolVersion
+}
+
 func NewConnection(nodeID string, reader io.Reader, writer io.Writer, receiver Model) Connection {
-	cr := &countingReader{Reader: reader}
-	cw := &countingWriter{Writer: writer}
+	return NewConnectionWithOptions(nodeID, reader, writer, receiver, ConnectionOptions{})
+}
 
-	flrd := flate.NewReader(cr)
-	flwr, err := flate.NewWriter(cw, flate.BestSpeed)
-	if err != nil {
-		panic(err)
+// NewConnectionWithCompression is like NewConnection, with the given
+// compression of the messages sent.
+func NewConnectionWithCompression(nodeID string, reader io.Reader, writer io.Writer, receiver Model, compression Compression) Connection {
+	return NewConnectionWithOptions(nodeID, reader, writer, receiver, ConnectionOptions{Compression: compression})
+}
+
+// NewConnectionWithOptions is like NewConnection, with the given options.
+func NewConnectionWithOptions(nodeID string, reader io.Reader, writer io.Writer, receiver Model, opts ConnectionOptions) Connection {
+	if opts.ReceiveTimeout <= 0 {
+		opts.ReceiveTimeout = DefaultReceiveTimeout
+	}
+	pingInterval := PingSendInterval
+	if d := opts.ReceiveTimeout / 3; d < pingInterval {
+		pingInterval = d
 	}
+	compression := opts.Compression
+
+	now := time.Now().UnixNano()
+	cr := &countingReader{Reader: reader, last: now}
+	cw := &countingWriter{Writer: writer, last: now}
+	rejects, _ := receiver.(RejectionReporter)
+	resender, _ := receiver.(IndexResender)
+
+	flrd := flate.NewReader(cr)
+	flwr := newDeflateWriter(cw, compression)
 	wb := bufio.NewWriter(flwr)
 
+	version := opts.Version
+	if version == 0 {
+		version = ProtocolVersion
+	}
+
+	var outstanding chan struct{}
+	if opts.MaxOutstanding > 0 {
+		outstanding = make(chan struct{}, opts.MaxOutstanding)
+	}
+
 	c := rawConnection{
-		id:        nodeID,
-		receiver:  nativeModel{receiver},
-		reader:    flrd,
-		cr:        cr,
-		xr:        xdr.NewReader(flrd),
-		writer:    flwr,
-		cw:        cw,
-		wb:        wb,
-		xw:        xdr.NewWriter(wb),
-		awaiting:  make([]chan asyncResult, 0x1000),
-		indexSent: make(map[string]map[string]uint64),
-		outbox:    make(chan []encodable),
-		nextID:    make(chan int),
-		closed:    make(chan struct{}),
+		id:               nodeID,
+		receiver:         nativeModel{receiver},
+		rejects:          rejects,
+		resender:         resender,
+		cr:               cr,
+		xr:               xdr.NewReader(flrd),
+		writer:           flwr,
+		compression:      compression,
+		receiveTimeout:   opts.ReceiveTimeout,
+		pingInterval:     pingInterval,
+		cw:               cw,
+		wb:               wb,
+		xw:               xdr.NewWriter(wb),
+		awaiting:         make([]chan asyncResult, 0x1000),
+		outstanding:      outstanding,
+		version:          version,
+		indexSent:        make(map[string]map[string]uint64),
+		digestMismatches: make(map[string]int),
+		outbox:           make(chan []encodable),
+		nextID:           make(chan int),
+		closed:           make(chan struct{}),
+
+		incomingIndexes: make(chan incomingIndex, 100), // should be enough for anyone, right?
 	}
 
 	go c.indexSerializerLoop()
@@ -165,19 +289,57 @@ func (c *rawConnection) Index(repo string, idx []FileInfo) {
 		idx = diff
 	}
 
-	if len(idx) > 0 {
+	// The first index is sent even when empty, since the other side expects
+	// a full index before any updates.
+	if msgType == messageTypeIndex || len(idx) > 0 {
 		c.send(header{0, -1, msgType}, IndexMessage{repo, idx})
+		expIndexRecordsSent.Add(int64(len(idx)))
 	}
 	c.imut.Unlock()
 }
 
 // Request returns the bytes for the specified block after fetching them from the connected peer.
 func (c *rawConnection) Request(repo string, name string, offset int64, size int) ([]byte, error) {
+	return c.request(messageTypeRequest, repo, name, offset, size)
+}
+
+func (c *rawConnection) request(msgType int, repo string, name string, offset int64, size int) ([]byte, error) {
+	res := c.roundTrip(msgType, RequestMessage{repo, name, uint64(offset), uint32(size)})
+	return res.val, res.err
+}
+
+// roundTrip sends the message and waits for the response to it.
+func (c *rawConnection) roundTrip(msgType int, msg encodable) asyncResult {
+	return <-c.roundTripAsync(msgType, msg)
+}
+
+// roundTripAsync sends the message, first waiting for a free slot if the
+// maximum number of requests are outstanding, and returns a channel on
+// which the response will be delivered.
+func (c *rawConnection) roundTripAsync(msgType int, msg encodable) <-chan asyncResult {
+	res := make(chan asyncResult, 1)
+
+	if c.outstanding != nil {
+		select {
+		case c.outstanding <- struct{}{}:
+		case <-c.closed:
+			res <- asyncResult{err: ErrClosed}
+			return res
+		}
+	}
+	release := func() {
+		if c.outstanding != nil {
+			<-c.outstanding
+		}
+	}
+
 	var id int
 	select {
 	case id = <-c.nextID:
 	case <-c.closed:
-		return nil, ErrClosed
+		release()
+		res <- asyncResult{err: ErrClosed}
+		return res
 	}
 
 	c.imut.Lock()
@@ -188,24 +350,48 @@ func (c *rawConnection) Request(repo string, name string, offset int64, size int
 	c.awaiting[id] = rc
 	c.imut.Unlock()
 
-	ok := c.send(header{0, id, messageTypeRequest},
-		RequestMessage{repo, name, uint64(offset), uint32(size)})
-	if !ok {
-		return nil, ErrClosed
-	}
+	expRequestsSent.Add(1)
+	expRequestsPending.Add(1)
 
-	res, ok := <-rc
+	ok := c.send(header{0, id, msgType}, msg)
 	if !ok {
-		return nil, ErrClosed
+		expRequestsPending.Add(-1)
+		release()
+		res <- asyncResult{err: ErrClosed}
+		return res
 	}
-	return res.val, res.err
+
+	go func() {
+		r, ok := <-rc
+		if !ok {
+			r = asyncResult{err: ErrClosed}
+		}
+		expRequestsPending.Add(-1)
+		release()
+		res <- r
+	}()
+	return res
 }
 
 // ClusterConfig send the cluster configuration message to the peer and returns any error
 func (c *rawConnection) ClusterConfig(config ClusterConfigMessage) {
+	if c.version < indexIDVersion {
+		// The Repository records end after the nodes
+		repos := make([]Repository, len(config.Repositories))
+		for i, r := range config.Repositories {
+			r.IndexID = 0
+			repos[i] = r
+		}
+		config.Repositories = repos
+	}
 	c.send(header{0, -1, messageTypeClusterConfig}, config)
 }
 
+// Ping sends a Ping message and returns true when the peer answers it.
+func (c *rawConnection) Ping() bool {
+	return c.ping()
+}
+
 func (c *rawConnection) ping() bool {
 	var id int
 	select {
@@ -281,6 +467,39 @@ func (c *rawConnection) readerLoop() (err error) {
 				return err
 			}
 
+		case messageTypeIndexDigest:
+			if err := c.handleIndexDigest(); err != nil {
+				return err
+			}
+
+		case messageTypeRequestBlocks:
+			if err := c.handleRequestBlocks(hdr); err != nil {
+				return err
+			}
+
+		case messageTypeRequestVector:
+			if err := c.handleRequestVector(hdr); err != nil {
+				return err
+			}
+
+		case messageTypeVectorResponse:
+			if err := c.handleVectorResponse(hdr); err != nil {
+				return err
+			}
+
+		case messageTypeResponseError:
+			if err := c.handleResponseError(hdr); err != nil {
+				return err
+			}
+
+		case messageTypeClose:
+			return c.handleClose()
+
+		case messageTypeDownloadProgress:
+			if err := c.handleDownloadProgress(); err != nil {
+				return err
+			}
+
 		default:
 			return fmt.Errorf("protocol error: %s: unknown message type %#x", c.id, hdr.msgType)
 		}
@@ -294,20 +513,36 @@ type incomingIndex struct {
 	files  []FileInfo
 }
 
-var incomingIndexes = make(chan incomingIndex, 100) // should be enough for anyone, right?
-
 func (c *rawConnection) indexSerializerLoop() {
 	// We must avoid blocking the reader loop when processing large indexes.
 	// There is otherwise a potential deadlock where both sides has the model
 	// locked because it's sending a large index update and can't receive the
 	// large index update from the other side. But we must also ensure to
 	// process the indexes in the order they are received, hence the separate
-	// routine and buffered channel.
-	for ii := range incomingIndexes {
-		if ii.update {
-			c.receiver.IndexUpdate(ii.id, ii.repo, ii.files)
-		} else {
-			c.receiver.Index(ii.id, ii.repo, ii.files)
+	// routine and buffered channel. The receiver is told about the closed
+	// connection from here as well, so that it never sees an index after
+	// Close.
+	for {
+		select {
+		case ii := <-c.incomingIndexes:
+			select {
+			case <-c.closed:
+				// Handled on the next iteration
+				continue
+			default:
+			}
+			files := validateIndex(ii.id, ii.repo, ii.files, c.rejects)
+			if ii.update {
+				c.receiver.IndexUpdate(ii.id, ii.repo, files)
+			} else {
+				c.receiver.Index(ii.id, ii.repo, files)
+			}
+		case <-c.closed:
+			c.imut.Lock()
+			err := c.closeErr
+			c.imut.Unlock()
+			c.receiver.Close(c.id, err)
+			return
 		}
 	}
 }
@@ -318,6 +553,7 @@ func (c *rawConnection) handleIndex() error {
 	if err := c.xr.Error(); err != nil {
 		return err
 	} else {
+		expIndexRecordsReceived.Add(int64(len(im.Files)))
 
 		// We run this (and the corresponding one for update, below)
 		// in a separate goroutine to avoid blocking the read loop.
@@ -326,7 +562,11 @@ func (c *rawConnection) handleIndex() error {
 		// update and can't receive the large index update from the
 		// other side.
 
-		incomingIndexes <- incomingIndex{false, c.id, im.Repository, im.Files}
+		select {
+		case c.incomingIndexes <- incomingIndex{false, c.id, im.Repository, im.Files}:
+		case <-c.closed:
+			return ErrClosed
+		}
 	}
 	return nil
 }
@@ -337,7 +577,12 @@ func (c *rawConnection) handleIndexUpdate() error {
 	if err := c.xr.Error(); err != nil {
 		return err
 	} else {
-		incomingIndexes <- incomingIndex{true, c.id, im.Repository, im.Files}
+		expIndexRecordsReceived.Add(int64(len(im.Files)))
+		select {
+		case c.incomingIndexes <- incomingIndex{true, c.id, im.Repository, im.Files}:
+		case <-c.closed:
+			return ErrClosed
+		}
 	}
 	return nil
 }
@@ -366,7 +611,7 @@ func (c *rawConnection) handleResponse(hdr header) error {
 		c.imut.Unlock()
 
 		if rc != nil {
-			rc <- asyncResult{data, err}
+			rc <- asyncResult{val: data, err: err}
 			close(rc)
 		}
 	}(hdr, c.xr.Error())
@@ -393,6 +638,13 @@ func (c *rawConnection) handleClusterConfig() error {
 	if err := c.xr.Error(); err != nil {
 		return err
 	} else {
+		for _, opt := range cm.Options {
+			if opt.Key == OptionResponseErrors {
+				c.imut.Lock()
+				c.responseErrors = true
+				c.imut.Unlock()
+			}
+		}
 		go c.receiver.ClusterConfig(c.id, cm)
 	}
 	return nil
@@ -427,24 +679,33 @@ func (c *rawConnection) send(h header, es ...encodable) bool {
 }
 
 func (c *rawConnection) writerLoop() {
-	var err error
-	for es := range c.outbox {
-		c.wmut.Lock()
-		for _, e := range es {
-			e.encodeXDR(c.xw)
+	for {
+		var es []encodable
+		select {
+		case es = <-c.outbox:
+		case <-c.closed:
+			return
 		}
-
-		if err = c.flush(); err != nil {
-			c.wmut.Unlock()
+		c.wmut.Lock()
+		err := c.write(es)
+		c.wmut.Unlock()
+		if err != nil {
 			c.close(err)
 			return
 		}
-		c.wmut.Unlock()
 	}
 }
 
-type flusher interface {
-	Flush() error
+// write encodes and flushes the message, starting with its header. The
+// caller must hold wmut.
+func (c *rawConnection) write(es []encodable) error {
+	if h, ok := es[0].(header); ok {
+		c.writer.setCompress(c.compression.compresses(h.msgType))
+	}
+	for _, e := range es {
+		e.encodeXDR(c.xw)
+	}
+	return c.flush()
 }
 
 func (c *rawConnection) flush() error {
@@ -456,11 +717,7 @@ func (c *rawConnection) flush() error {
 		return err
 	}
 
-	if f, ok := c.writer.(flusher); ok {
-		return f.Flush()
-	}
-
-	return nil
+	return c.writer.Flush()
 }
 
 func (c *rawConnection) close(err error) {
@@ -473,6 +730,7 @@ func (c *rawConnection) close(err error) {
 	case <-c.closed:
 		return
 	default:
+		c.closeErr = err
 		close(c.closed)
 
 		for i, ch := range c.awaiting {
@@ -482,10 +740,9 @@ func (c *rawConnection) close(err error) {
 			}
 		}
 
+		// The reader is not closed here, as that would race with the
+		// reader loop; it returns when the transport is closed.
 		c.writer.Close()
-		c.reader.Close()
-
-		go c.receiver.Close(c.id, err)
 	}
 }
 
@@ -501,42 +758,34 @@ func (c *rawConnection) idGenerator() {
 	}
 }
 
+// pingerLoop sends a Ping when nothing else has been sent for a while, so
+// that the other node keeps receiving something, and closes the connection
+// when nothing has been received within the receive timeout. The other
+// node does the same, so a live connection never goes quiet for long; one
+// that does is half open, or the other node is gone.
 func (c *rawConnection) pingerLoop() {
-	var rc = make(chan bool, 1)
-	ticker := time.Tick(pingIdleTime / 2)
+	ticker := time.NewTicker(c.pingInterval / 4)
+	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker:
-			if d := time.Since(c.xr.LastRead()); d < pingIdleTime {
+		case <-ticker.C:
+			if d := time.Since(c.cr.Last()); d > c.receiveTimeout {
 				if debug {
-					l.Debugln(c.id, "ping skipped after rd", d)
+					l.Debugln(c.id, "nothing received for", d)
 				}
-				continue
-			}
-			if d := time.Since(c.xw.LastWrite()); d < pingIdleTime {
-				if debug {
-					l.Debugln(c.id, "ping skipped after wr", d)
-				}
-				continue
+				// The writer may be stuck on the dead connection, and must
+				// be freed before the connection can be closed.
+				c.closeTransport()
+				c.close(fmt.Errorf("nothing received for %v", c.receiveTimeout))
+				return
 			}
-			go func() {
+			if d := time.Since(c.cw.Last()); d >= c.pingInterval {
 				if debug {
-					l.Debugln(c.id, "ping ->")
-				}
-				rc <- c.ping()
-			}()
-			select {
-			case ok := <-rc:
-				if debug {
-					l.Debugln(c.id, "<- pong")
-				}
-				if !ok {
-					c.close(fmt.Errorf("ping failure"))
+					l.Debugln(c.id, "ping -> after", d)
 				}
-			case <-time.After(pingTimeout):
-				c.close(fmt.Errorf("ping timeout"))
-			case <-c.closed:
-				return
+				// The Pong is not waited for; the other node sending
+				// anything at all is enough.
+				go c.send(header{0, -1, messageTypePing})
 			}
 
 		case <-c.closed:
@@ -545,8 +794,35 @@ func (c *rawConnection) pingerLoop() {
 	}
 }
 
+// closeTransport closes the underlying reader and writer, where they can
+// be closed, so that reads and writes blocked on them return.
+func (c *rawConnection) closeTransport() {
+	if cl, ok := c.cr.Reader.(io.Closer); ok {
+		cl.Close()
+	}
+	if cl, ok := c.cw.Writer.(io.Closer); ok {
+		cl.Close()
+	}
+}
+
 func (c *rawConnection) processRequest(msgID int, req RequestMessage) {
-	data, _ := c.receiver.Request(c.id, req.Repository, req.Name, int64(req.Offset), int(req.Size))
+	data, err := c.receiver.Request(c.id, req.Repository, req.Name, int64(req.Offset), int(req.Size))
+	expRequestsServed.Add(1)
+	expBytesServed.Add(int64(len(data)))
+
+	if err != nil {
+		c.imut.Lock()
+		responseErrors := c.responseErrors
+		c.imut.Unlock()
+		if responseErrors {
+			c.send(header{0, msgID, messageTypeResponseError},
+				ResponseErrorMessage{errorCode(err)})
+			return
+		}
+		// Nodes that do not understand the Response Error message get
+		// the empty Response they expect instead.
+		data = nil
+	}
 
 	c.send(header{0, msgID, messageTypeResponse},
 		encodableBytes(data))
@@ -581,3 +857,11 @@ func IsDirectory(bits uint32) bool {
 func HasPermissionBits(bits uint32) bool {
 	return bits&FlagNoPermBits == 0
 }
+
+func HasBlockGroups(bits uint32) bool {
+	return bits&FlagBlockGroups != 0
+}
+
+func IsSymlink(bits uint32) bool {
+	return bits&FlagSymlink != 0
+}
@@ -7,8 +7,10 @@ package protocol
 import (
 	"errors"
 	"io"
+	"reflect"
 	"testing"
 	"testing/quick"
+	"time"
 )
 
 func TestHeaderFunctions(t *testing.T) {
@@ -221,3 +223,177 @@ func TestClose(t *testing.T) {
 		t.Error("Request should return an error")
 	}
 }
+
+func TestReceiveTimeout(t *testing.T) {
+	m0 := newTestModel()
+	opts := ConnectionOptions{ReceiveTimeout: 300 * time.Millisecond}
+
+	// Nothing is ever written to ar, nor read from bw, like on a half open
+	// connection.
+	ar, _ := io.Pipe()
+	_, bw := io.Pipe()
+	NewConnectionWithOptions("c0", ar, bw, m0, opts)
+
+	if !m0.isClosed() {
+		t.Fatal("Dead connection should be closed")
+	}
+}
+
+func TestReceiveTimeoutPing(t *testing.T) {
+	m0 := newTestModel()
+	m1 := newTestModel()
+	opts := ConnectionOptions{ReceiveTimeout: 300 * time.Millisecond}
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+	c0 := NewConnectionWithOptions("c0", ar, bw, m0, opts).(wireFormatConnection).next.(*rawConnection)
+	NewConnectionWithOptions("c1", br, aw, m1, opts)
+
+	// Pings keep the idle connection alive well past the receive timeout
+	select {
+	case <-c0.closed:
+		t.Fatal("Idle connection was closed:", c0.closeErr)
+	case <-time.After(time.Second):
+	}
+	c0.close(nil)
+}
+
+func TestFileInfoACL(t *testing.T) {
+	f := FileInfo{
+		Name:   "foo",
+		Flags:  0644,
+		Blocks: []BlockInfo{{100, []byte("some hash bytes")}},
+	}
+
+	plain := f.MarshalXDR()
+	f.ACL = []byte("some acl")
+	withACL := f.MarshalXDR()
+
+	if len(withACL) <= len(plain) {
+		t.Fatal("ACL not encoded")
+	}
+
+	var d FileInfo
+	if err := d.UnmarshalXDR(withACL); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags&FlagACL == 0 || string(d.ACL) != "some acl" {
+		t.Errorf("Incorrect decoded ACL %q, flags 0%o", d.ACL, d.Flags)
+	}
+
+	// A file without ACL is encoded as before, without the flag
+	d = FileInfo{}
+	if err := d.UnmarshalXDR(plain); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags != 0644 || d.ACL != nil {
+		t.Errorf("Unexpected ACL %q, flags 0%o", d.ACL, d.Flags)
+	}
+}
+
+func TestFileInfoSymlink(t *testing.T) {
+	f := FileInfo{
+		Name:   "foo",
+		Flags:  FlagSymlink | FlagNoPermBits | 0666,
+		Target: "../bar/baz",
+	}
+
+	var d FileInfo
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if !IsSymlink(d.Flags) || d.Target != "../bar/baz" {
+		t.Errorf("Incorrect decoded symlink %q, flags 0%o", d.Target, d.Flags)
+	}
+
+	// The target is only sent for symlinks
+	f.Flags = 0644
+	d = FileInfo{}
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Target != "" {
+		t.Errorf("Unexpected target %q", d.Target)
+	}
+}
+
+func TestClusterConfigNodes(t *testing.T) {
+	cm := ClusterConfigMessage{
+		ClientName:    "syncthing",
+		ClientVersion: "v0.9.0",
+		Repositories: []Repository{
+			{
+				ID:    "foo",
+				Flags: FlagRepoReadOnly,
+				Nodes: []Node{
+					{
+						ID:        "a",
+						Name:      "laptop",
+						Addresses: []string{"dynamic", "192.0.2.42:22000"},
+						Flags:     FlagShareTrusted | FlagIntroducer | CompressionFlags(CompressMetadata),
+					},
+				},
+			},
+		},
+		Options: []Option{},
+	}
+
+	var d ClusterConfigMessage
+	if err := d.UnmarshalXDR(cm.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(d, cm) {
+		t.Errorf("Incorrect decoded message\n%+v\n!=\n%+v", d, cm)
+	}
+
+	n := d.Repositories[0].Nodes[0]
+	if n.Flags&FlagIntroducer == 0 || NodeCompression(n.Flags) != CompressMetadata {
+		t.Errorf("Incorrect flags 0x%x", n.Flags)
+	}
+}
+
+func TestClusterConfigIndexID(t *testing.T) {
+	cm := ClusterConfigMessage{
+		Repositories: []Repository{
+			{ID: "foo", Flags: FlagRepoReadOnly, IndexID: 0x0123456789abcdef, Nodes: []Node{{ID: "a"}}},
+			{ID: "bar", Nodes: []Node{{ID: "a"}}},
+		},
+	}
+
+	var d ClusterConfigMessage
+	if err := d.UnmarshalXDR(cm.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if r := d.Repositories[0]; r.IndexID != 0x0123456789abcdef || r.Flags != FlagRepoReadOnly|FlagRepoIndexID {
+		t.Errorf("Incorrect decoded repository %+v", r)
+	}
+	if r := d.Repositories[1]; r.ID != "bar" || r.IndexID != 0 || r.Flags != 0 {
+		t.Errorf("Incorrect decoded repository %+v", r)
+	}
+
+	// A node speaking version 2 gets the records without the IDs
+	for _, version := range []uint32{2, ProtocolVersion} {
+		m1 := newTestModel()
+		m1.configs = make(chan ClusterConfigMessage, 1)
+		ar, aw := io.Pipe()
+		br, bw := io.Pipe()
+		c0 := NewConnectionWithOptions("c0", ar, bw, newTestModel(), ConnectionOptions{Version: version})
+		NewConnectionWithOptions("c1", br, aw, m1, ConnectionOptions{})
+
+		c0.ClusterConfig(cm)
+		select {
+		case rcm := <-m1.configs:
+			if id := rcm.Repositories[0].IndexID; (version >= indexIDVersion) != (id != 0) {
+				t.Errorf("Version %d: unexpected index ID %x", version, id)
+			}
+			if rcm.Repositories[0].Flags&FlagRepoIndexID != 0 && version < indexIDVersion {
+				t.Errorf("Version %d: index ID flag set", version)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Cluster Config not received")
+		}
+	}
+	if cm.Repositories[0].IndexID == 0 {
+		t.Error("Message modified by sending it")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import "errors"
+
+// OptionResponseErrors is set in the Cluster Config options by nodes that
+// understand the Response Error message. Requests that fail are answered
+// with one to such nodes, and with an empty Response to others.
+const OptionResponseErrors = "responseErrors"
+
+// The errors a request can fail with. The receiver of a Response Error
+// message gets the one corresponding to the error code in it.
+var (
+	ErrGeneric       = errors.New("request failed")
+	ErrNoSuchFile    = errors.New("no such file")
+	ErrInvalidFile   = errors.New("file is invalid")
+	ErrInvalidOffset = errors.New("offset is beyond the end of the file")
+	ErrBusy          = errors.New("too many outstanding requests")
+	ErrRepoPaused    = errors.New("repository is paused")
+	ErrRescanning    = errors.New("file has changed since it was scanned")
+)
+
+// The error codes are those used on the wire, and must not be changed.
+var responseErrors = []error{
+	0: ErrGeneric,
+	1: ErrNoSuchFile,
+	2: ErrInvalidFile,
+	3: ErrInvalidOffset,
+	4: ErrBusy,
+	5: ErrRepoPaused,
+	6: ErrRescanning,
+}
+
+// errorCode returns the error code to send for the error. Errors without a
+// code of their own are sent as ErrGeneric.
+func errorCode(err error) uint32 {
+	for code, e := range responseErrors {
+		if e == err {
+			return uint32(code)
+		}
+	}
+	return 0
+}
+
+// responseError returns the error for the received error code. Codes
+// unknown to us are read as ErrGeneric.
+func responseError(code uint32) error {
+	if code < uint32(len(responseErrors)) {
+		return responseErrors[code]
+	}
+	return ErrGeneric
+}
+
+// IsTemporary returns true if the request failed for a reason that is
+// expected to go away, so that it is worth retrying later. Other errors
+// mean that the node does not have the data asked for.
+func IsTemporary(err error) bool {
+	return err == ErrBusy || err == ErrRepoPaused || err == ErrRescanning
+}
+
+func (c *rawConnection) handleResponseError(hdr header) error {
+	var msg ResponseErrorMessage
+	msg.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+
+	go func() {
+		c.imut.Lock()
+		rc := c.awaiting[hdr.msgID]
+		c.awaiting[hdr.msgID] = nil
+		c.imut.Unlock()
+
+		if rc != nil {
+			rc <- asyncResult{err: responseError(msg.Code)}
+			close(rc)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"testing"
+)
+
+func TestResponseErrorCodes(t *testing.T) {
+	for code, err := range responseErrors {
+		if c := errorCode(err); c != uint32(code) {
+			t.Errorf("errorCode(%v) = %d, expected %d", err, c, code)
+		}
+		if e := responseError(uint32(code)); e != err {
+			t.Errorf("responseError(%d) = %v, expected %v", code, e, err)
+		}
+	}
+	if c := errorCode(io.EOF); c != 0 {
+		t.Errorf("Unexpected code %d for unknown error", c)
+	}
+	if e := responseError(1000); e != ErrGeneric {
+		t.Errorf("Unexpected error %v for unknown code", e)
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	for _, responseErrors := range []bool{false, true} {
+		ar, aw := io.Pipe()
+		br, bw := io.Pipe()
+
+		m0 := newTestModel()
+		m0.err = ErrRescanning
+		NewConnection("c0", ar, bw, m0)
+		c1 := NewConnection("c1", br, aw, newTestModel())
+
+		if responseErrors {
+			c1.ClusterConfig(ClusterConfigMessage{
+				Options: []Option{{Key: OptionResponseErrors, Value: "1"}},
+			})
+		}
+
+		data, err := c1.Request("default", "a", 0, 128)
+		if len(data) != 0 {
+			t.Errorf("Unexpected data %q", data)
+		}
+		if responseErrors && err != ErrRescanning {
+			t.Errorf("Unexpected error %v, expected %v", err, ErrRescanning)
+		}
+		if !responseErrors && err != nil {
+			t.Errorf("Unexpected error %v for node without the option", err)
+		}
+	}
+}
+
+func TestIsTemporary(t *testing.T) {
+	for _, err := range []error{ErrBusy, ErrRepoPaused, ErrRescanning} {
+		if !IsTemporary(err) {
+			t.Errorf("%v should be temporary", err)
+		}
+	}
+	for _, err := range []error{nil, ErrGeneric, ErrNoSuchFile, ErrInvalidFile, ErrInvalidOffset} {
+		if IsTemporary(err) {
+			t.Errorf("%v should not be temporary", err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"errors"
+	"path"
+	"runtime"
+	"strings"
+)
+
+// The longest file name component supported by common file systems, in
+// bytes.
+const maxNameComponent = 255
+
+var (
+	errNameEmpty     = errors.New("empty name")
+	errNameNUL       = errors.New("name contains NUL byte")
+	errNameAbsolute  = errors.New("name is absolute")
+	errNameUnclean   = errors.New("name is not in canonical form")
+	errNameEscapes   = errors.New("name refers outside of the repository")
+	errNameBackslash = errors.New("name contains backslash")
+
+	errTargetEmpty    = errors.New("symlink target is empty")
+	errTargetAbsolute = errors.New("symlink target is absolute")
+	errTargetEscapes  = errors.New("symlink target refers outside of the repository")
+)
+
+// checkName returns an error if the file name, in wire format, could refer
+// to something other than a file within the repository.
+func checkName(name string) error {
+	switch {
+	case name == "":
+		return errNameEmpty
+	case strings.IndexByte(name, 0) >= 0:
+		return errNameNUL
+	case strings.HasPrefix(name, "/"):
+		return errNameAbsolute
+	case path.Clean(name) != name:
+		return errNameUnclean
+	case name == "." || name == ".." || strings.HasPrefix(name, "../"):
+		return errNameEscapes
+	case runtime.GOOS == "windows" && strings.IndexByte(name, '\\') >= 0:
+		// Would be taken as a path separator
+		return errNameBackslash
+	}
+	return nil
+}
+
+// checkTarget returns an error if the target of the symlink name, both in
+// wire format, could refer to something outside the repository.
+func checkTarget(name, target string) error {
+	if runtime.GOOS == "windows" {
+		// Would be taken as path separators
+		target = strings.Replace(target, `\`, "/", -1)
+	}
+	switch {
+	case target == "":
+		return errTargetEmpty
+	case strings.HasPrefix(target, "/"):
+		return errTargetAbsolute
+	case runtime.GOOS == "windows" && len(target) >= 2 && target[1] == ':':
+		// Starts with a drive letter
+		return errTargetAbsolute
+	}
+	if dst := path.Join(path.Dir(name), target); dst == ".." || strings.HasPrefix(dst, "../") {
+		return errTargetEscapes
+	}
+	return nil
+}
+
+// tooLong returns true if a component of the file name, in wire format, is
+// longer than supported by the file system.
+func tooLong(name string) bool {
+	for _, c := range strings.Split(name, "/") {
+		if len(c) > maxNameComponent {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIndex removes the files whose names could refer to something
+// outside the repository and marks as invalid those that can't be created
+// here, or are symlinks pointing outside the repository. The remaining files are returned. Removed files are reported to rr,
+// unless it is nil.
+func validateIndex(nodeID, repo string, files []FileInfo, rr RejectionReporter) []FileInfo {
+	var valid = files[:0]
+	for _, f := range files {
+		if err := checkName(f.Name); err != nil {
+			l.Warnf("Rejecting file %q in repository %q from node %s: %v", f.Name, repo, nodeID, err)
+			if rr != nil {
+				rr.Rejected(nodeID, repo, f.Name, err)
+			}
+			continue
+		}
+		if tooLong(f.Name) && !IsInvalid(f.Flags) {
+			l.Warnf("File name %q is too long; marked as invalid.", f.Name)
+			f.Flags |= FlagInvalid
+		}
+		if IsSymlink(f.Flags) && !IsDeleted(f.Flags) && !IsInvalid(f.Flags) {
+			if err := checkTarget(f.Name, f.Target); err != nil {
+				l.Warnf("Symlink %q in repository %q from node %s: %v; marked as invalid.", f.Name, repo, nodeID, err)
+				f.Flags |= FlagInvalid
+			}
+		}
+		valid = append(valid, f)
+	}
+	return valid
+}
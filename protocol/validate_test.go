@@ -0,0 +1,91 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckName(t *testing.T) {
+	var tests = []struct {
+		name string
+		ok   bool
+	}{
+		{"foo", true},
+		{"foo/bar", true},
+		{".foo/..bar", true},
+		{"", false},
+		{".", false},
+		{"..", false},
+		{"../foo", false},
+		{"foo/../../bar", false},
+		{"foo/./bar", false},
+		{"foo//bar", false},
+		{"foo/", false},
+		{"/etc/passwd", false},
+		{"foo\x00bar", false},
+	}
+
+	for _, tc := range tests {
+		if err := checkName(tc.name); (err == nil) != tc.ok {
+			t.Errorf("Incorrect result for %q: %v", tc.name, err)
+		}
+	}
+}
+
+func TestCheckTarget(t *testing.T) {
+	var tests = []struct {
+		name, target string
+		ok           bool
+	}{
+		{"link", "foo", true},
+		{"link", "foo/bar", true},
+		{"a/b/link", "../c", true},
+		{"a/b/link", "../../c", true},
+		{"a/link", ".", true},
+		{"link", "", false},
+		{"link", "/etc", false},
+		{"link", "..", false},
+		{"link", "../foo", false},
+		{"a/b/link", "../../../c", false},
+		{"a/link", "b/../../..", false},
+	}
+
+	for _, tc := range tests {
+		if err := checkTarget(tc.name, tc.target); (err == nil) != tc.ok {
+			t.Errorf("Incorrect result for %q -> %q: %v", tc.name, tc.target, err)
+		}
+	}
+}
+
+func TestValidateIndex(t *testing.T) {
+	long := strings.Repeat("x", maxNameComponent+1)
+	files := []FileInfo{
+		{Name: "a"},
+		{Name: "../b"},
+		{Name: "c/" + long},
+		{Name: "/d"},
+		{Name: "e", Flags: FlagSymlink, Target: "/etc"},
+		{Name: "f", Flags: FlagSymlink, Target: "a"},
+	}
+
+	files = validateIndex("node", "repo", files, nil)
+	if len(files) != 4 {
+		t.Fatalf("Unexpected files %v", files)
+	}
+	if files[0].Name != "a" || IsInvalid(files[0].Flags) {
+		t.Errorf("Unexpected file %v", files[0])
+	}
+	if files[1].Name != "c/"+long || !IsInvalid(files[1].Flags) {
+		t.Errorf("Long name not marked invalid: %v", files[1])
+	}
+	if !IsInvalid(files[2].Flags) {
+		t.Errorf("Absolute symlink not marked invalid: %v", files[2])
+	}
+	if IsInvalid(files[3].Flags) {
+		t.Errorf("Relative symlink marked invalid: %v", files[3])
+	}
+}
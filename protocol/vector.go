@@ -0,0 +1,96 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OptionVectorRequests is set in the Cluster Config options by nodes that
+// answer the Request Vector message.
+const OptionVectorRequests = "vectorRequests"
+
+const (
+	// MaxVectorRanges is the largest number of ranges in one Request Vector
+	// message.
+	MaxVectorRanges = 64
+	// MaxVectorRangeSize is the largest size of each range.
+	MaxVectorRangeSize = 256 * 1024
+)
+
+var ErrVectorTooLarge = errors.New("too many or too large ranges in vector request")
+
+// RequestVector returns the data for each of the specified ranges of the
+// file after fetching them from the connected peer in a single round trip.
+// The data for a range is empty if it is not available.
+func (c *rawConnection) RequestVector(repo string, name string, ranges []Range) ([][]byte, error) {
+	if len(ranges) > MaxVectorRanges {
+		return nil, ErrVectorTooLarge
+	}
+	for _, r := range ranges {
+		if r.Size > MaxVectorRangeSize {
+			return nil, ErrVectorTooLarge
+		}
+	}
+
+	res := c.roundTrip(messageTypeRequestVector, RequestVectorMessage{repo, name, ranges})
+	if res.err != nil {
+		return nil, res.err
+	}
+	if len(res.vals) != len(ranges) {
+		return nil, fmt.Errorf("protocol error: %s: got %d ranges in vector response, expected %d", c.id, len(res.vals), len(ranges))
+	}
+	return res.vals, nil
+}
+
+func (c *rawConnection) handleRequestVector(hdr header) error {
+	var req RequestVectorMessage
+	req.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+	go c.processRequestVector(hdr.msgID, req)
+	return nil
+}
+
+func (c *rawConnection) processRequestVector(msgID int, req RequestVectorMessage) {
+	// Each range is served as a Request would be, in order. Ranges that are
+	// too large are answered with empty data, same as unavailable ones.
+	var res VectorResponseMessage
+	res.Data = make([][]byte, len(req.Ranges))
+	for i, r := range req.Ranges {
+		if r.Size > MaxVectorRangeSize {
+			continue
+		}
+		res.Data[i], _ = c.receiver.Request(c.id, req.Repository, req.Name, int64(r.Offset), int(r.Size))
+		expRequestsServed.Add(1)
+		expBytesServed.Add(int64(len(res.Data[i])))
+	}
+
+	c.send(header{0, msgID, messageTypeVectorResponse}, res)
+}
+
+func (c *rawConnection) handleVectorResponse(hdr header) error {
+	var res VectorResponseMessage
+	res.decodeXDR(c.xr)
+	if err := c.xr.Error(); err != nil {
+		return err
+	}
+
+	go func() {
+		c.imut.Lock()
+		rc := c.awaiting[hdr.msgID]
+		c.awaiting[hdr.msgID] = nil
+		c.imut.Unlock()
+
+		if rc != nil {
+			rc <- asyncResult{vals: res.Data}
+			close(rc)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"io"
+	"testing"
+	"testing/quick"
+)
+
+type rangeModel struct {
+	*TestModel
+}
+
+func (m rangeModel) Request(nodeID, repo, name string, offset int64, size int) ([]byte, error) {
+	if offset < 0 {
+		return nil, io.EOF
+	}
+	bs := make([]byte, size)
+	for i := range bs {
+		bs[i] = byte(offset)
+	}
+	return bs, nil
+}
+
+func TestRequestVector(t *testing.T) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	NewConnection("c0", ar, bw, rangeModel{newTestModel()})
+	c1 := NewConnection("c1", br, aw, newTestModel())
+
+	ranges := []Range{{Offset: 1, Size: 10}, {Offset: 1 << 63, Size: 5}, {Offset: 3, Size: 0}, {Offset: 4, Size: 7}}
+	data, err := c1.RequestVector("default", "file", ranges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != len(ranges) {
+		t.Fatalf("Got %d ranges, expected %d", len(data), len(ranges))
+	}
+	if len(data[0]) != 10 || data[0][9] != 1 {
+		t.Errorf("Unexpected data for range 0: %v", data[0])
+	}
+	if len(data[1]) != 0 || len(data[2]) != 0 {
+		t.Errorf("Unexpected data for unavailable ranges: %v", data[1:3])
+	}
+	if len(data[3]) != 7 || data[3][0] != 4 {
+		t.Errorf("Unexpected data for range 3: %v", data[3])
+	}
+
+	if _, err := c1.RequestVector("default", "file", make([]Range, MaxVectorRanges+1)); err != ErrVectorTooLarge {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestVectorMessageXDR(t *testing.T) {
+	f := func(offs []uint64, sizes []uint32) bool {
+		m1 := RequestVectorMessage{Repository: "default", Name: "some/file"}
+		for i := 0; i < len(offs) && i < len(sizes); i++ {
+			m1.Ranges = append(m1.Ranges, Range{offs[i], sizes[i]})
+		}
+
+		var m2 RequestVectorMessage
+		if err := m2.UnmarshalXDR(m1.MarshalXDR()); err != nil {
+			t.Error(err)
+			return false
+		}
+		if m2.Repository != m1.Repository || m2.Name != m1.Name || len(m2.Ranges) != len(m1.Ranges) {
+			return false
+		}
+		for i := range m1.Ranges {
+			if m1.Ranges[i] != m2.Ranges[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCountScale: 0.1}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// OptionVersionVectors is set in the Cluster Config options by nodes that
+// understand the version vectors of files in Index messages.
+const OptionVersionVectors = "versionVectors"
+
+// A Counter is the number of changes a node has made to a file.
+type Counter struct {
+	ID    uint64
+	Value uint64
+}
+
+// A Vector is the version of a file as the number of changes each node has
+// made to it, sorted by node ID. Unlike the Lamport version, it tells
+// whether one version was derived from the other or whether they were
+// changed independently.
+type Vector []Counter
+
+// An Ordering is the result of comparing two vectors.
+type Ordering int
+
+const (
+	Equal Ordering = iota
+	Greater
+	Lesser
+	Concurrent
+)
+
+// VectorID returns the ID used in version vectors for the node.
+func VectorID(nodeID string) uint64 {
+	h := sha256.Sum256([]byte(nodeID))
+	return binary.BigEndian.Uint64(h[:])
+}
+
+// Update returns a copy of the vector with the counter of the node
+// increased by one.
+func (v Vector) Update(id uint64) Vector {
+	res := make(Vector, 0, len(v)+1)
+	var done bool
+	for _, c := range v {
+		switch {
+		case c.ID == id:
+			c.Value++
+			done = true
+		case c.ID > id && !done:
+			res = append(res, Counter{id, 1})
+			done = true
+		}
+		res = append(res, c)
+	}
+	if !done {
+		res = append(res, Counter{id, 1})
+	}
+	return res
+}
+
+// Merge returns a vector with the highest counter of each node in v and o.
+func (v Vector) Merge(o Vector) Vector {
+	res := make(Vector, 0, len(v)+len(o))
+	i, j := 0, 0
+	for i < len(v) || j < len(o) {
+		switch {
+		case j == len(o) || i < len(v) && v[i].ID < o[j].ID:
+			res = append(res, v[i])
+			i++
+		case i == len(v) || o[j].ID < v[i].ID:
+			res = append(res, o[j])
+			j++
+		default:
+			c := v[i]
+			if o[j].Value > c.Value {
+				c.Value = o[j].Value
+			}
+			res = append(res, c)
+			i++
+			j++
+		}
+	}
+	return res
+}
+
+// Counter returns the number of changes the node has made.
+func (v Vector) Counter(id uint64) uint64 {
+	for _, c := range v {
+		if c.ID == id {
+			return c.Value
+		}
+	}
+	return 0
+}
+
+// Compare returns whether v is equal to, derived from (Greater), an
+// ancestor of (Lesser), or independent of (Concurrent) o.
+func (v Vector) Compare(o Vector) Ordering {
+	var greater, lesser bool
+	i, j := 0, 0
+	for i < len(v) || j < len(o) {
+		var a, b uint64
+		switch {
+		case j == len(o) || i < len(v) && v[i].ID < o[j].ID:
+			a = v[i].Value
+			i++
+		case i == len(v) || o[j].ID < v[i].ID:
+			b = o[j].Value
+			j++
+		default:
+			a, b = v[i].Value, o[j].Value
+			i++
+			j++
+		}
+		if a > b {
+			greater = true
+		} else if a < b {
+			lesser = true
+		}
+	}
+	switch {
+	case greater && lesser:
+		return Concurrent
+	case greater:
+		return Greater
+	case lesser:
+		return Lesser
+	}
+	return Equal
+}
+
+// Concurrent returns true if the versions were changed independently of
+// each other. An empty vector, from a node that does not keep them, is not
+// concurrent with anything.
+func (v Vector) Concurrent(o Vector) bool {
+	return len(v) > 0 && len(o) > 0 && v.Compare(o) == Concurrent
+}
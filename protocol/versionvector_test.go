@@ -0,0 +1,102 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorUpdate(t *testing.T) {
+	var v Vector
+
+	v = v.Update(42)
+	if !reflect.DeepEqual(v, Vector{{42, 1}}) {
+		t.Errorf("Incorrect vector %v", v)
+	}
+
+	v = v.Update(10)
+	v = v.Update(42)
+	v2 := v.Update(99)
+	if !reflect.DeepEqual(v, Vector{{10, 1}, {42, 2}}) {
+		t.Errorf("Incorrect vector %v", v)
+	}
+	if !reflect.DeepEqual(v2, Vector{{10, 1}, {42, 2}, {99, 1}}) {
+		t.Errorf("Incorrect vector %v", v2)
+	}
+	if c := v2.Counter(42); c != 2 {
+		t.Errorf("Incorrect counter %d", c)
+	}
+}
+
+func TestVectorMerge(t *testing.T) {
+	a := Vector{{1, 3}, {5, 1}}
+	b := Vector{{2, 2}, {5, 4}, {7, 1}}
+
+	expected := Vector{{1, 3}, {2, 2}, {5, 4}, {7, 1}}
+	if m := a.Merge(b); !reflect.DeepEqual(m, expected) {
+		t.Errorf("Incorrect merge %v", m)
+	}
+	if m := b.Merge(a); !reflect.DeepEqual(m, expected) {
+		t.Errorf("Incorrect merge %v", m)
+	}
+}
+
+func TestVectorCompare(t *testing.T) {
+	base := Vector{{1, 1}, {2, 1}}
+	here := base.Update(1)
+	there := base.Update(2)
+
+	cases := []struct {
+		a, b Vector
+		o    Ordering
+	}{
+		{base, base, Equal},
+		{here, base, Greater},
+		{base, here, Lesser},
+		{here, there, Concurrent},
+		{base.Update(3), base, Greater},
+		{Vector{}, base, Lesser},
+		{here.Merge(there), there, Greater},
+	}
+	for i, tc := range cases {
+		if o := tc.a.Compare(tc.b); o != tc.o {
+			t.Errorf("%d: %v.Compare(%v) = %d, expected %d", i, tc.a, tc.b, o, tc.o)
+		}
+	}
+
+	if !here.Concurrent(there) {
+		t.Error("Independent changes should be concurrent")
+	}
+	if (Vector{}).Concurrent(here) || here.Concurrent(nil) {
+		t.Error("Empty vectors should not be concurrent")
+	}
+}
+
+func TestFileInfoVector(t *testing.T) {
+	f := FileInfo{
+		Name:   "foo",
+		Flags:  0644,
+		Vector: Vector{{VectorID("node1"), 3}, {VectorID("node2"), 1}},
+	}
+
+	var d FileInfo
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags&FlagVector == 0 || !reflect.DeepEqual(d.Vector, f.Vector) {
+		t.Errorf("Incorrect decoded vector %v, flags 0%o", d.Vector, d.Flags)
+	}
+
+	// A file without a vector is encoded as before, without the flag
+	f.Vector = nil
+	d = FileInfo{}
+	if err := d.UnmarshalXDR(f.MarshalXDR()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Flags != 0644 || d.Vector != nil {
+		t.Errorf("Unexpected vector %v, flags 0%o", d.Vector, d.Flags)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	errWinDisallowedChar = errors.New("contains characters not allowed on Windows")
+	errWinReservedName   = errors.New("is a reserved name on Windows")
+	errWinTrailingChar   = errors.New("ends in a dot or space, which Windows strips")
+)
+
+// Characters that can't be part of a file name on NTFS, in addition to the
+// path separators.
+var windowsDisallowedChars = string([]rune{
+	'<', '>', ':', '"', '|', '?', '*',
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+	31,
+})
+
+// Device names that can't be used as a file name on Windows, with or
+// without an extension.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// windowsInvalidName returns an error if the file name, in wire format,
+// can't be created as is on Windows. Windows would either refuse it, or
+// create a file under another name that the next scan announces as a new
+// file.
+func windowsInvalidName(name string) error {
+	if strings.ContainsAny(name, windowsDisallowedChars) {
+		return errWinDisallowedChar
+	}
+	for _, c := range strings.Split(name, "/") {
+		if strings.HasSuffix(c, ".") || strings.HasSuffix(c, " ") {
+			return errWinTrailingChar
+		}
+		base := c
+		if i := strings.IndexByte(base, '.'); i >= 0 {
+			base = base[:i]
+		}
+		if windowsReservedNames[strings.ToUpper(strings.TrimRight(base, " "))] {
+			return errWinReservedName
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package protocol
+
+import "testing"
+
+func TestWindowsInvalidName(t *testing.T) {
+	var tests = []struct {
+		name string
+		err  error
+	}{
+		{"foo", nil},
+		{"foo/bar.txt", nil},
+		{".foo/..bar", nil},
+		{"CONSOLE", nil},
+		{"com10", nil},
+		{"nulfoo.txt", nil},
+		{"foo/aux", errWinReservedName},
+		{"CON", errWinReservedName},
+		{"nul.txt", errWinReservedName},
+		{"Lpt1.tar.gz/foo", errWinReservedName},
+		{"prn .txt", errWinReservedName},
+		{"foo.", errWinTrailingChar},
+		{"foo /bar", errWinTrailingChar},
+		{"foo/bar ", errWinTrailingChar},
+		{"foo?", errWinDisallowedChar},
+		{"a<b>c", errWinDisallowedChar},
+		{"c:/foo", errWinDisallowedChar},
+		{"foo\x01bar", errWinDisallowedChar},
+	}
+
+	for _, tc := range tests {
+		if err := windowsInvalidName(tc.name); err != tc.err {
+			t.Errorf("Incorrect result for %q: %v != %v", tc.name, err, tc.err)
+		}
+	}
+}
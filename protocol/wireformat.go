@@ -34,10 +34,55 @@ func (c wireFormatConnection) Request(repo, name string, offset int64, size int)
 	return c.next.Request(repo, name, offset, size)
 }
 
+func (c wireFormatConnection) RequestAsync(repo, name string, offset int64, size int) <-chan Response {
+	name = norm.NFC.String(filepath.ToSlash(name))
+	return c.next.RequestAsync(repo, name, offset, size)
+}
+
+func (c wireFormatConnection) RequestBlocks(repo, name string, offset int64, size int) ([]BlockInfo, error) {
+	name = norm.NFC.String(filepath.ToSlash(name))
+	return c.next.RequestBlocks(repo, name, offset, size)
+}
+
+func (c wireFormatConnection) RequestVector(repo, name string, ranges []Range) ([][]byte, error) {
+	name = norm.NFC.String(filepath.ToSlash(name))
+	return c.next.RequestVector(repo, name, ranges)
+}
+
+func (c wireFormatConnection) DownloadProgress(repo string, files []FileDownloadProgress) {
+	var myFiles = make([]FileDownloadProgress, len(files))
+	copy(myFiles, files)
+
+	for i := range files {
+		myFiles[i].Name = norm.NFC.String(filepath.ToSlash(myFiles[i].Name))
+	}
+
+	c.next.DownloadProgress(repo, myFiles)
+}
+
 func (c wireFormatConnection) ClusterConfig(config ClusterConfigMessage) {
 	c.next.ClusterConfig(config)
 }
 
+func (c wireFormatConnection) IndexDigest(repo string, fs []FileInfo) {
+	var myFs = make([]FileInfo, len(fs))
+	copy(myFs, fs)
+
+	for i := range fs {
+		myFs[i].Name = norm.NFC.String(filepath.ToSlash(myFs[i].Name))
+	}
+
+	c.next.IndexDigest(repo, myFs)
+}
+
+func (c wireFormatConnection) Ping() bool {
+	return c.next.Ping()
+}
+
 func (c wireFormatConnection) Statistics() Statistics {
 	return c.next.Statistics()
 }
+
+func (c wireFormatConnection) Close(reason CloseReason, msg string) {
+	c.next.Close(reason, msg)
+}
@@ -0,0 +1,7 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package reconnect decides when to try connecting to each node again,
+// backing off from nodes that cannot be reached.
+package reconnect
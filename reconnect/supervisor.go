@@ -0,0 +1,127 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package reconnect
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// A Supervisor keeps track of the failed attempts to connect to each node.
+// After each failure the node is not due to be dialed again for twice as
+// long as after the previous one, starting at the minimum delay and capped
+// at the maximum. The delays are jittered so that nodes that failed
+// together are not retried in lockstep.
+type Supervisor struct {
+	min, max time.Duration
+	nodes    map[string]*nodeState
+	rand     *rand.Rand
+	wake     chan struct{}
+	mut      sync.Mutex
+	now      func() time.Time
+}
+
+type nodeState struct {
+	failures int
+	next     time.Time
+}
+
+// NewSupervisor returns a Supervisor backing off from the minimum to the
+// maximum delay.
+func NewSupervisor(min, max time.Duration) *Supervisor {
+	if max < min {
+		max = min
+	}
+	return &Supervisor{
+		min:   min,
+		max:   max,
+		nodes: make(map[string]*nodeState),
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		wake:  make(chan struct{}, 1),
+		now:   time.Now,
+	}
+}
+
+// Due returns true if the node should be dialed now; that is, unless it
+// failed recently.
+func (s *Supervisor) Due(node string) bool {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	st, ok := s.nodes[node]
+	return !ok || !s.now().Before(st.next)
+}
+
+// Failed records a failed attempt to connect to the node and returns the
+// delay before it is due again.
+func (s *Supervisor) Failed(node string) time.Duration {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	st, ok := s.nodes[node]
+	if !ok {
+		st = &nodeState{}
+		s.nodes[node] = st
+	}
+	st.failures++
+
+	delay := s.max
+	if st.failures < 32 && s.min<<uint(st.failures-1) < s.max {
+		delay = s.min << uint(st.failures-1)
+	}
+	// Anywhere between half and all of the delay
+	delay = delay/2 + time.Duration(s.rand.Int63n(int64(delay/2)+1))
+
+	st.next = s.now().Add(delay)
+	return delay
+}
+
+// Succeeded forgets the failures of the node.
+func (s *Supervisor) Succeeded(node string) {
+	s.mut.Lock()
+	delete(s.nodes, node)
+	s.mut.Unlock()
+}
+
+// Reset makes the node due right away and wakes up Wait, for when there is
+// reason to believe a new attempt may succeed, such as new addresses for
+// the node.
+func (s *Supervisor) Reset(node string) {
+	s.mut.Lock()
+	_, ok := s.nodes[node]
+	delete(s.nodes, node)
+	s.mut.Unlock()
+
+	if ok {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Wait returns when a node that failed becomes due, when Reset is called
+// for a node that failed, or after the interval, whichever comes first.
+// The interval decides how soon nodes that have not failed, such as nodes
+// that just disconnected, are noticed. Wait returns false if it returned
+// because stop was closed.
+func (s *Supervisor) Wait(interval time.Duration, stop <-chan struct{}) bool {
+	s.mut.Lock()
+	now := s.now()
+	for _, st := range s.nodes {
+		// Nodes already due were handled before waiting
+		if d := st.next.Sub(now); d > 0 && d < interval {
+			interval = d
+		}
+	}
+	s.mut.Unlock()
+
+	select {
+	case <-time.After(interval):
+	case <-s.wake:
+	case <-stop:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package reconnect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSupervisorBackoff(t *testing.T) {
+	now := time.Unix(1400000000, 0)
+	s := NewSupervisor(time.Second, time.Minute)
+	s.now = func() time.Time { return now }
+
+	if !s.Due("node1") {
+		t.Error("Unknown node not due")
+	}
+
+	var prev time.Duration
+	for i := 0; i < 10; i++ {
+		d := s.Failed("node1")
+		base := time.Second << uint(i)
+		if base > time.Minute {
+			base = time.Minute
+		}
+		if d < base/2 || d > base {
+			t.Errorf("Failure %d: delay %v not within %v - %v", i+1, d, base/2, base)
+		}
+		if i > 0 && i < 6 && d <= prev/2 {
+			t.Errorf("Failure %d: delay %v did not grow from %v", i+1, d, prev)
+		}
+		prev = d
+
+		if s.Due("node1") {
+			t.Errorf("Failure %d: node due right away", i+1)
+		}
+		if !s.Due("node2") {
+			t.Errorf("Failure %d: other node not due", i+1)
+		}
+	}
+
+	now = now.Add(time.Minute)
+	if !s.Due("node1") {
+		t.Error("Node not due after the maximum delay")
+	}
+
+	s.Failed("node1")
+	s.Succeeded("node1")
+	if !s.Due("node1") {
+		t.Error("Node not due after success")
+	}
+	if d := s.Failed("node1"); d > time.Second {
+		t.Errorf("Delay %v after success not reset", d)
+	}
+}
+
+func TestSupervisorReset(t *testing.T) {
+	s := NewSupervisor(time.Hour, time.Hour)
+	s.Failed("node1")
+
+	done := make(chan struct{})
+	go func() {
+		s.Wait(time.Hour, nil)
+		close(done)
+	}()
+
+	s.Reset("node1")
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Wait not woken up by Reset")
+	}
+	if !s.Due("node1") {
+		t.Error("Node not due after Reset")
+	}
+}
+
+func TestSupervisorWaitNextDue(t *testing.T) {
+	s := NewSupervisor(100*time.Millisecond, 100*time.Millisecond)
+	s.Failed("node1")
+
+	t0 := time.Now()
+	s.Wait(time.Hour, nil)
+	if d := time.Since(t0); d > time.Second {
+		t.Errorf("Waited %v for a node due in at most 100ms", d)
+	}
+	if !s.Due("node1") {
+		t.Error("Node not due after Wait")
+	}
+
+	stop := make(chan struct{})
+	close(stop)
+	if s.Wait(time.Hour, stop) {
+		t.Error("Wait returned true when stopped")
+	}
+}
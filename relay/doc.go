@@ -0,0 +1,7 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package relay implements the relay protocol, through which nodes that
+// cannot connect to each other directly meet at a relay server.
+package relay
@@ -0,0 +1,57 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package relay
+
+const Magic = 0x9E79BC40
+
+const (
+	messageTypePing = iota
+	messageTypePong
+	messageTypeJoinRelayRequest
+	messageTypeJoinSessionRequest
+	messageTypeResponse
+	messageTypeConnectRequest
+	messageTypeSessionInvitation
+)
+
+type header struct {
+	Magic         uint32
+	MessageType   uint32
+	MessageLength uint32
+}
+
+type Ping struct{}
+
+type Pong struct{}
+
+// A JoinRelayRequest makes the sending node available to be connected to
+// through the relay, for as long as its protocol connection stays up.
+type JoinRelayRequest struct{}
+
+// A JoinSessionRequest is the first message on a session connection. It
+// carries the key from the Session Invitation.
+type JoinSessionRequest struct {
+	Key []byte // max:32
+}
+
+type Response struct {
+	Code    uint32
+	Message string // max:256
+}
+
+// A ConnectRequest asks the relay for a session with the joined node.
+type ConnectRequest struct {
+	ID []byte // max:32
+}
+
+// A SessionInvitation tells a node to connect to the session port of the
+// relay, at Address and Port, to reach the node From. An empty Address
+// means the address the protocol connection was made to.
+type SessionInvitation struct {
+	From    []byte // max:32
+	Key     []byte // max:32
+	Address []byte // max:16
+	Port    uint16
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// All messages are much smaller than this; anything larger is an error.
+const maxMessageLength = 1024
+
+const headerLength = 12
+
+var (
+	ErrIncorrectMagic  = errors.New("incorrect magic number")
+	ErrMessageTooLarge = errors.New("message too large")
+)
+
+// The Response messages sent by relays.
+var (
+	ResponseSuccess           = Response{0, "success"}
+	ResponseNotFound          = Response{1, "not found"}
+	ResponseAlreadyConnected  = Response{2, "already connected"}
+	ResponseLimitReached      = Response{3, "limit reached"}
+	ResponseUnexpectedMessage = Response{100, "unexpected message"}
+)
+
+type encoder interface {
+	MarshalXDR() []byte
+}
+
+// WriteMessage writes the message, one of the message types of this
+// package, with its header.
+func WriteMessage(w io.Writer, msg interface{}) error {
+	var typ uint32
+	switch msg.(type) {
+	case Ping:
+		typ = messageTypePing
+	case Pong:
+		typ = messageTypePong
+	case JoinRelayRequest:
+		typ = messageTypeJoinRelayRequest
+	case JoinSessionRequest:
+		typ = messageTypeJoinSessionRequest
+	case Response:
+		typ = messageTypeResponse
+	case ConnectRequest:
+		typ = messageTypeConnectRequest
+	case SessionInvitation:
+		typ = messageTypeSessionInvitation
+	default:
+		return fmt.Errorf("unknown message type %T", msg)
+	}
+
+	body := msg.(encoder).MarshalXDR()
+	hdr := header{
+		Magic:         Magic,
+		MessageType:   typ,
+		MessageLength: uint32(len(body)),
+	}
+	_, err := w.Write(append(hdr.MarshalXDR(), body...))
+	return err
+}
+
+// ReadMessage reads a message written by WriteMessage and returns it as a
+// value of one of the message types of this package.
+func ReadMessage(r io.Reader) (interface{}, error) {
+	var buf [headerLength]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return nil, err
+	}
+	var hdr header
+	if err := hdr.UnmarshalXDR(buf[:]); err != nil {
+		return nil, err
+	}
+	if hdr.Magic != Magic {
+		return nil, ErrIncorrectMagic
+	}
+	if hdr.MessageLength > maxMessageLength {
+		return nil, ErrMessageTooLarge
+	}
+
+	body := make([]byte, hdr.MessageLength)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+
+	switch hdr.MessageType {
+	case messageTypePing:
+		return Ping{}, nil
+	case messageTypePong:
+		return Pong{}, nil
+	case messageTypeJoinRelayRequest:
+		return JoinRelayRequest{}, nil
+	case messageTypeJoinSessionRequest:
+		var msg JoinSessionRequest
+		err := msg.UnmarshalXDR(body)
+		return msg, err
+	case messageTypeResponse:
+		var msg Response
+		err := msg.UnmarshalXDR(body)
+		return msg, err
+	case messageTypeConnectRequest:
+		var msg ConnectRequest
+		err := msg.UnmarshalXDR(body)
+		return msg, err
+	case messageTypeSessionInvitation:
+		var msg SessionInvitation
+		err := msg.UnmarshalXDR(body)
+		return msg, err
+	}
+	return nil, fmt.Errorf("unknown message type %d", hdr.MessageType)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package relay
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	msgs := []interface{}{
+		Ping{},
+		Pong{},
+		JoinRelayRequest{},
+		JoinSessionRequest{Key: []byte("0123456789abcdef0123456789abcdef")},
+		ResponseNotFound,
+		ConnectRequest{ID: bytes.Repeat([]byte{0x42}, 32)},
+		SessionInvitation{
+			From:    bytes.Repeat([]byte{0x17}, 32),
+			Key:     []byte("some key"),
+			Address: []byte{192, 0, 2, 42},
+			Port:    22068,
+		},
+	}
+
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		if err := WriteMessage(&buf, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, msg := range msgs {
+		read, err := ReadMessage(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(read, msg) {
+			t.Errorf("Round trip mismatch:\n%#v\n%#v", msg, read)
+		}
+	}
+}
+
+func TestReadMessageErrors(t *testing.T) {
+	var buf bytes.Buffer
+	WriteMessage(&buf, Ping{})
+	bs := buf.Bytes()
+	bs[0] ^= 0xff
+	if _, err := ReadMessage(bytes.NewReader(bs)); err != ErrIncorrectMagic {
+		t.Errorf("Unexpected error %v for incorrect magic", err)
+	}
+
+	hdr := header{Magic: Magic, MessageType: messageTypeResponse, MessageLength: maxMessageLength + 1}
+	if _, err := ReadMessage(bytes.NewReader(hdr.MarshalXDR())); err != ErrMessageTooLarge {
+		t.Errorf("Unexpected error %v for large message", err)
+	}
+
+	hdr = header{Magic: Magic, MessageType: 42}
+	if _, err := ReadMessage(bytes.NewReader(hdr.MarshalXDR())); err == nil {
+		t.Error("Unexpected nil error for unknown message type")
+	}
+
+	if err := WriteMessage(&buf, "not a message"); err == nil {
+		t.Error("Unexpected nil error for writing unknown message type")
+	}
+}
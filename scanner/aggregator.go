@@ -0,0 +1,172 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// A changeAggregator collects the paths reported as changed by a file system
+// watcher and hands them on in batches, once things have quieted down. Paths
+// within the same subtree are merged, so that applications saving a file by
+// writing, renaming and writing again cause a single scan of the affected
+// subtrees instead of one scan per change.
+type changeAggregator struct {
+	in       chan string
+	out      chan []string
+	stop     chan struct{}
+	delay    time.Duration
+	maxDelay time.Duration
+	maxPaths int
+}
+
+// newChangeAggregator returns an aggregator that hands on a batch when no
+// new change has been seen for delay, or at the latest maxDelay after the
+// first change. Batches never contain more than maxPaths paths; when more
+// have changed, they are replaced by their parent directories. The
+// aggregator runs until stop is closed.
+func newChangeAggregator(delay, maxDelay time.Duration, maxPaths int, stop chan struct{}) *changeAggregator {
+	a := &changeAggregator{
+		in:       make(chan string),
+		out:      make(chan []string),
+		stop:     stop,
+		delay:    delay,
+		maxDelay: maxDelay,
+		maxPaths: maxPaths,
+	}
+	go a.serve()
+	return a
+}
+
+// add records a change to the file or directory at path, relative to the
+// repository root.
+func (a *changeAggregator) add(path string) {
+	select {
+	case a.in <- filepath.Clean(path):
+	case <-a.stop:
+	}
+}
+
+// close hands on the changes added so far right away, and then closes the
+// channel returned by changes.
+func (a *changeAggregator) close() {
+	close(a.in)
+}
+
+// changes returns the channel on which batches of changed paths are
+// delivered. A batch not yet received is merged with later changes.
+func (a *changeAggregator) changes() <-chan []string {
+	return a.out
+}
+
+func (a *changeAggregator) serve() {
+	timer := time.NewTimer(a.delay)
+	timer.Stop()
+	defer timer.Stop()
+
+	in := a.in
+	var pending []string
+	var first time.Time
+	var batch []string
+	var out chan []string // nil while there is no batch to deliver
+
+	for {
+		select {
+		case p, ok := <-in:
+			if !ok {
+				// Closed; deliver what there is and quit
+				in = nil
+				timer.Stop()
+				batch = mergePaths(append(batch, pending...), a.maxPaths)
+				pending = nil
+				if len(batch) > 0 {
+					out = a.out
+					continue
+				}
+				close(a.out)
+				return
+			}
+			if len(pending) == 0 {
+				first = time.Now()
+			}
+			pending = append(pending, p)
+			if len(pending) > 2*a.maxPaths {
+				// Keep a storm of changes from piling up
+				pending = mergePaths(pending, a.maxPaths)
+			}
+			wait := a.delay
+			if rem := a.maxDelay - time.Since(first); rem < wait {
+				wait = rem
+			}
+			timer.Reset(wait)
+
+		case <-timer.C:
+			if len(pending) == 0 {
+				continue
+			}
+			batch = mergePaths(append(batch, pending...), a.maxPaths)
+			if debug {
+				l.Debugf("aggregator: %d changes merged into %v", len(pending), batch)
+			}
+			pending = nil
+			out = a.out
+
+		case out <- batch:
+			batch = nil
+			out = nil
+			if in == nil {
+				close(a.out)
+				return
+			}
+
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// mergePaths returns the paths with duplicates and those within another of
+// the paths removed. While more than max paths remain, they are replaced by
+// their parent directories, up to the repository root ".".
+func mergePaths(paths []string, max int) []string {
+	for {
+		merged := dropCovered(paths)
+		if len(merged) <= max {
+			return merged
+		}
+		paths = make([]string, len(merged))
+		for i, p := range merged {
+			paths[i] = filepath.Dir(p)
+		}
+	}
+}
+
+func dropCovered(paths []string) []string {
+	set := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		if p == "." {
+			return []string{"."}
+		}
+		set[p] = struct{}{}
+	}
+
+	var res []string
+	for p := range set {
+		covered := false
+		for d := filepath.Dir(p); d != "."; d = filepath.Dir(d) {
+			if _, ok := set[d]; ok {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			res = append(res, p)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
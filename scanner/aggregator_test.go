@@ -0,0 +1,91 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergePaths(t *testing.T) {
+	var tests = []struct {
+		in  []string
+		max int
+		out []string
+	}{
+		{[]string{"a", "b", "a"}, 10, []string{"a", "b"}},
+		{[]string{"a/b/c", "a", "a-b", "a/d"}, 10, []string{"a", "a-b"}},
+		{[]string{"a/b", "a/c", "d/e"}, 2, []string{"a", "d"}},
+		{[]string{"a/b", "a/c", "d/e"}, 1, []string{"."}},
+		{[]string{"a", "."}, 10, []string{"."}},
+		{[]string{"a b", "a/b", "ab"}, 10, []string{"a b", "a/b", "ab"}},
+		{[]string{"a/b", "a/c/d", "a/c"}, 10, []string{"a/b", "a/c"}},
+	}
+
+	for i, tc := range tests {
+		var in, out []string
+		for _, p := range tc.in {
+			in = append(in, filepath.FromSlash(p))
+		}
+		for _, p := range tc.out {
+			out = append(out, filepath.FromSlash(p))
+		}
+		if res := mergePaths(in, tc.max); !reflect.DeepEqual(res, out) {
+			t.Errorf("Incorrect merge #%d: %v != %v", i, res, out)
+		}
+	}
+}
+
+func TestChangeAggregator(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	a := newChangeAggregator(20*time.Millisecond, time.Second, 10, stop)
+
+	// A storm of changes within one directory
+	for i := 0; i < 20; i++ {
+		a.add(filepath.Join("dir", ".file.tmp"))
+		a.add(filepath.Join("dir", "file"))
+		a.add("dir")
+	}
+
+	select {
+	case batch := <-a.changes():
+		if !reflect.DeepEqual(batch, []string{"dir"}) {
+			t.Errorf("Unexpected batch %v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No batch delivered")
+	}
+
+	select {
+	case batch := <-a.changes():
+		t.Errorf("Unexpected second batch %v", batch)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestChangeAggregatorClose(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	a := newChangeAggregator(time.Hour, time.Hour, 10, stop)
+
+	a.add("a")
+	a.add(".")
+	a.close()
+
+	select {
+	case batch := <-a.changes():
+		if !reflect.DeepEqual(batch, []string{"."}) {
+			t.Errorf("Unexpected batch %v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Pending changes not delivered on close")
+	}
+	if batch, ok := <-a.changes(); ok {
+		t.Errorf("Unexpected batch %v after close", batch)
+	}
+}
@@ -7,11 +7,20 @@ package scanner
 import (
 	"bytes"
 	"crypto/sha256"
+	"expvar"
 	"io"
 )
 
 const StandardBlockSize = 128 * 1024
 
+// The number of blocks summarized by each entry in a grouped block list.
+const BlocksPerGroup = 1024
+
+var (
+	expBlocksHashed = expvar.NewInt("scanner.blocksHashed")
+	expBytesHashed  = expvar.NewInt("scanner.bytesHashed")
+)
+
 type Block struct {
 	Offset int64
 	Size   uint32
@@ -41,6 +50,9 @@ func Blocks(r io.Reader, blocksize int) ([]Block, error) {
 		}
 		blocks = append(blocks, b)
 		offset += int64(n)
+
+		expBlocksHashed.Add(1)
+		expBytesHashed.Add(n)
 	}
 
 	if len(blocks) == 0 {
@@ -78,3 +90,26 @@ func BlockDiff(src, tgt []Block) (have, need []Block) {
 
 	return have, need
 }
+
+// BlockGroups returns the grouped block list for the blocks. Each group
+// covers BlocksPerGroup consecutive blocks, except the last which may cover
+// fewer, and is hashed over the hashes of the blocks in it.
+func BlockGroups(blocks []Block) []Block {
+	var groups []Block
+	for i := 0; i < len(blocks); i += BlocksPerGroup {
+		end := i + BlocksPerGroup
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+
+		g := Block{Offset: blocks[i].Offset}
+		hf := sha256.New()
+		for _, b := range blocks[i:end] {
+			g.Size += b.Size
+			hf.Write(b.Hash)
+		}
+		g.Hash = hf.Sum(nil)
+		groups = append(groups, g)
+	}
+	return groups
+}
@@ -118,3 +118,27 @@ func TestDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestBlockGroups(t *testing.T) {
+	var blocks []Block
+	for i := 0; i < 2*BlocksPerGroup+1; i++ {
+		blocks = append(blocks, Block{Offset: int64(i) * 10, Size: 10, Hash: []byte{byte(i)}})
+	}
+
+	groups := BlockGroups(blocks)
+	if len(groups) != 3 {
+		t.Fatalf("Unexpected number of groups %d", len(groups))
+	}
+	if groups[1].Offset != 10*BlocksPerGroup || groups[1].Size != 10*BlocksPerGroup || groups[2].Size != 10 {
+		t.Errorf("Incorrect group offsets or sizes: %v", groups)
+	}
+
+	blocks[BlocksPerGroup+5].Hash = []byte{0}
+	changed := BlockGroups(blocks)
+	if !bytes.Equal(changed[0].Hash, groups[0].Hash) || !bytes.Equal(changed[2].Hash, groups[2].Hash) {
+		t.Error("Unchanged groups should keep their hash")
+	}
+	if bytes.Equal(changed[1].Hash, groups[1].Hash) {
+		t.Error("Changed group should change hash")
+	}
+}
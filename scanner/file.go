@@ -4,21 +4,29 @@
 
 package scanner
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/calmh/syncthing/protocol"
+)
 
 type File struct {
 	Name       string
 	Flags      uint32
 	Modified   int64
+	ModifiedNs int32 // the sub-second part of the modification time
 	Version    uint64
+	Vector     protocol.Vector
 	Size       int64
 	Blocks     []Block
+	ACL        []byte
+	Target     string // for symlinks, the path the link points to
 	Suppressed bool
 }
 
 func (f File) String() string {
-	return fmt.Sprintf("File{Name:%q, Flags:0%o, Modified:%d, Version:%d, Size:%d, NumBlocks:%d, Sup:%v}",
-		f.Name, f.Flags, f.Modified, f.Version, f.Size, len(f.Blocks), f.Suppressed)
+	return fmt.Sprintf("File{Name:%q, Flags:0%o, Modified:%d, Version:%d, Vector:%v, Size:%d, NumBlocks:%d, Sup:%v}",
+		f.Name, f.Flags, f.Modified, f.Version, f.Vector, f.Size, len(f.Blocks), f.Suppressed)
 }
 
 func (f File) Equals(o File) bool {
@@ -28,3 +36,11 @@ func (f File) Equals(o File) bool {
 func (f File) NewerThan(o File) bool {
 	return f.Modified > o.Modified || (f.Modified == o.Modified && f.Version > o.Version)
 }
+
+// IsIgnored returns true if f is an ignored record, standing in for a file
+// that exists but is ignored. Ignored records have the Suppressed flag set, a
+// zero version and no blocks, so that they never take precedence over a real
+// version of the file.
+func (f File) IsIgnored() bool {
+	return f.Suppressed && f.Version == 0
+}
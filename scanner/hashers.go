@@ -0,0 +1,102 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"path/filepath"
+	"sync"
+)
+
+// A hashPool hashes files on a number of goroutines while the walk goes on.
+// Each file is hashed into its position in the walk results, so that the
+// order of the results does not depend on which file is hashed first.
+type hashPool struct {
+	jobs    chan hashJob
+	pending sync.WaitGroup  // jobs not yet done
+	results map[int][]Block // index -> blocks; nil blocks for failed files
+	stopped int             // lowest index not hashed because the walk stopped, or -1
+	wg      sync.WaitGroup
+	mut     sync.Mutex
+}
+
+type hashJob struct {
+	index int
+	path  string
+	size  int64
+}
+
+func (w *Walker) startHashers(n int) *hashPool {
+	h := &hashPool{
+		jobs:    make(chan hashJob, n),
+		results: make(map[int][]Block),
+		stopped: -1,
+	}
+	h.wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer h.wg.Done()
+			for job := range h.jobs {
+				rn, _ := filepath.Rel(w.Dir, job.path)
+				blocks, err := w.hashFile(job.path, rn, job.size)
+				h.mut.Lock()
+				h.results[job.index] = blocks
+				if err == ErrWalkStopped && (h.stopped < 0 || job.index < h.stopped) {
+					h.stopped = job.index
+				}
+				h.mut.Unlock()
+				h.pending.Done()
+			}
+		}()
+	}
+	return h
+}
+
+// add queues the file at p, which is at index in the results, for hashing.
+func (h *hashPool) add(index int, p string, size int64) {
+	h.pending.Add(1)
+	h.jobs <- hashJob{index, p, size}
+}
+
+// wait waits for all queued files to be hashed and returns the results with
+// their blocks filled in. The files that could not be hashed are left out.
+// If the walk was stopped while files were being hashed, the results end
+// before the first file that was not hashed, and stopped is true.
+func (h *hashPool) wait(files []File) (res []File, stopped bool) {
+	close(h.jobs)
+	h.wg.Wait()
+	return h.collect(files)
+}
+
+// drain is like wait, but the hashers are kept for the files that follow,
+// whose indexes then start from zero again.
+func (h *hashPool) drain(files []File) (res []File, stopped bool) {
+	h.pending.Wait()
+	res, stopped = h.collect(files)
+	h.mut.Lock()
+	h.results = make(map[int][]Block)
+	h.stopped = -1
+	h.mut.Unlock()
+	return res, stopped
+}
+
+// collect fills in the blocks of the hashed files. The hashers must be idle.
+func (h *hashPool) collect(files []File) (res []File, stopped bool) {
+	if h.stopped >= 0 {
+		files = files[:h.stopped]
+		stopped = true
+	}
+
+	res = files[:0]
+	for i, f := range files {
+		if blocks, ok := h.results[i]; ok {
+			if blocks == nil {
+				continue
+			}
+			f.Blocks = blocks
+		}
+		res = append(res, f)
+	}
+	return res, stopped
+}
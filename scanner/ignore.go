@@ -0,0 +1,184 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// At most this many directory results are cached by a Matcher.
+const maxCachedDirs = 10000
+
+// A Matcher matches files against the ignore patterns found in a repository.
+// The patterns in an ignore file apply to the files anywhere below the
+// directory holding it; see pattern for the syntax. The last pattern that
+// matches a file decides whether it is ignored, and the patterns of a
+// deeper directory take precedence over those of the directories above it.
+//
+// The patterns are compiled per directory when added, so that a file is
+// only checked against the patterns of the directories above it, and the
+// results for directories are cached, since they are checked again for
+// every file below them by MatchPath. A nil Matcher matches nothing.
+type Matcher struct {
+	patterns map[string][]string   // directory -> patterns, as read
+	includes map[string][]string   // directory -> files included by its ignore file
+	dirs     map[string][]*pattern // directory -> compiled patterns
+	cache    map[string]bool       // directory -> MatchPath result
+	mut      sync.Mutex
+}
+
+// NewMatcher returns a Matcher for the given ignore patterns, keyed by the
+// directory holding the ignore file, relative to the repository root.
+func NewMatcher(patterns map[string][]string) *Matcher {
+	m := &Matcher{
+		patterns: make(map[string][]string, len(patterns)),
+		includes: make(map[string][]string),
+		dirs:     make(map[string][]*pattern, len(patterns)),
+		cache:    make(map[string]bool),
+	}
+	for dir, pats := range patterns {
+		m.add(dir, pats)
+	}
+	return m
+}
+
+// add sets the patterns for the directory, replacing any previous ones.
+// Patterns that cannot be compiled are skipped with a warning.
+func (m *Matcher) add(dir string, patterns []string) {
+	var compiled []*pattern
+	for _, line := range patterns {
+		p, err := compilePattern(line)
+		if err != nil {
+			l.Warnf("Ignore pattern %q in %q: %v", line, dir, err)
+			continue
+		}
+		if p != nil {
+			compiled = append(compiled, p)
+		}
+	}
+
+	m.mut.Lock()
+	m.patterns[dir] = patterns
+	m.dirs[dir] = compiled
+	m.cache = make(map[string]bool)
+	m.mut.Unlock()
+}
+
+// ClearCache forgets the results cached for directories, to free memory.
+func (m *Matcher) ClearCache() {
+	if m == nil {
+		return
+	}
+	m.mut.Lock()
+	m.cache = make(map[string]bool)
+	m.mut.Unlock()
+}
+
+// setIncludes records the files included by the ignore file of the
+// directory.
+func (m *Matcher) setIncludes(dir string, files []string) {
+	m.mut.Lock()
+	if len(files) > 0 {
+		m.includes[dir] = files
+	} else {
+		delete(m.includes, dir)
+	}
+	m.mut.Unlock()
+}
+
+// Includes returns the files included by the ignore files, so that changes
+// to them can be noticed as well.
+func (m *Matcher) Includes() []string {
+	if m == nil {
+		return nil
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	var res []string
+	for _, files := range m.includes {
+		res = append(res, files...)
+	}
+	return res
+}
+
+// Patterns returns the patterns the Matcher was created from, keyed by
+// directory. Included patterns are in place of the #include directive.
+func (m *Matcher) Patterns() map[string][]string {
+	if m == nil {
+		return nil
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	res := make(map[string][]string, len(m.patterns))
+	for dir, pats := range m.patterns {
+		res[dir] = pats
+	}
+	return res
+}
+
+// Match returns true if the file, relative to the repository root, is
+// ignored by the patterns of the directories above it.
+func (m *Matcher) Match(file string) bool {
+	if m == nil {
+		return false
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	return m.match(file)
+}
+
+func (m *Matcher) match(file string) bool {
+	file = filepath.ToSlash(file)
+	for dir := path.Dir(file); ; dir = path.Dir(dir) {
+		if pats, ok := m.dirs[filepath.FromSlash(dir)]; ok {
+			name := file
+			if dir != "." {
+				name = strings.TrimPrefix(file[len(dir):], "/")
+			}
+			for i := len(pats) - 1; i >= 0; i-- {
+				if pats[i].match(name) {
+					return !pats[i].negate
+				}
+			}
+		}
+		if dir == "." || dir == "/" {
+			return false
+		}
+	}
+}
+
+// MatchPath returns true if the file, relative to the repository root, or
+// any of the directories containing it is matched.
+func (m *Matcher) MatchPath(file string) bool {
+	if m == nil {
+		return false
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if m.match(file) {
+		return true
+	}
+	return m.matchDir(filepath.Dir(file))
+}
+
+// matchDir returns true if the directory or any directory containing it is
+// matched, caching the result.
+func (m *Matcher) matchDir(dir string) bool {
+	if dir == "." || dir == string(filepath.Separator) {
+		return false
+	}
+	if res, ok := m.cache[dir]; ok {
+		return res
+	}
+	res := m.match(dir) || m.matchDir(filepath.Dir(dir))
+	if len(m.cache) >= maxCachedDirs {
+		m.cache = make(map[string]bool)
+	}
+	m.cache[dir] = res
+	return res
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"errors"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// A pattern is a compiled line of an ignore file. The syntax follows
+// .gitignore:
+//
+//   - Lines starting with # are comments.
+//   - A pattern starting with ! negates the match, so that a file matched by
+//     an earlier pattern is not ignored after all.
+//   - A pattern starting with (?i) matches without regard to case.
+//   - A pattern starting with / only matches relative to the directory
+//     holding the ignore file. So does a pattern with a / in the middle.
+//     Other patterns match the name of a file at any depth below it.
+//   - * and ? match any characters but /, ** matches anything including
+//     /, and [...] matches a character class. \ escapes the next character,
+//     except on Windows where it is a path separator like /.
+//   - A trailing / is allowed, but does not restrict the match to
+//     directories.
+type pattern struct {
+	re     *regexp.Regexp
+	negate bool
+}
+
+var errEmptyPattern = errors.New("empty pattern")
+
+// compilePattern returns the pattern for the line, or nil for comments.
+func compilePattern(line string) (*pattern, error) {
+	if strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+
+	var p pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	var flags string
+	if strings.HasPrefix(line, "(?i)") {
+		flags = "(?i)"
+		line = line[4:]
+	}
+
+	if runtime.GOOS == "windows" {
+		line = strings.Replace(line, `\`, "/", -1)
+	}
+	line = strings.TrimSuffix(line, "/")
+	anchored := strings.HasPrefix(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return nil, errEmptyPattern
+	}
+	if strings.Contains(line, "/") {
+		anchored = true
+	}
+
+	expr, err := globToRegexp(line)
+	if err != nil {
+		return nil, err
+	}
+	if !anchored {
+		expr = "(.*/)?" + expr
+	}
+	p.re, err = regexp.Compile(flags + "^" + expr + "$")
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// match returns true if the pattern matches the name, which is relative to
+// the directory holding the ignore file and uses / as separator.
+func (p *pattern) match(name string) bool {
+	return p.re.MatchString(name)
+}
+
+// globToRegexp converts the glob to an unanchored regular expression.
+func globToRegexp(glob string) (string, error) {
+	var buf []byte
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					// Any number of leading directories, including none
+					i++
+					buf = append(buf, "(.*/)?"...)
+				} else {
+					buf = append(buf, ".*"...)
+				}
+			} else {
+				buf = append(buf, "[^/]*"...)
+			}
+
+		case '?':
+			buf = append(buf, "[^/]"...)
+
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return "", errors.New("missing ] in pattern")
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			buf = append(buf, '[')
+			buf = append(buf, strings.Replace(class, `\`, `\\`, -1)...)
+			buf = append(buf, ']')
+			i += end + 1
+
+		case '\\':
+			if i+1 == len(glob) {
+				return "", errors.New("trailing \\ in pattern")
+			}
+			i++
+			buf = append(buf, regexp.QuoteMeta(glob[i:i+1])...)
+
+		default:
+			buf = append(buf, regexp.QuoteMeta(glob[i:i+1])...)
+		}
+	}
+	return string(buf), nil
+}
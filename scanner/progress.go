@@ -0,0 +1,140 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// How often Walker.ProgressFunc is called when ProgressInterval is not set.
+const defaultProgressInterval = time.Second
+
+// A Progress tracks how far a walk has come. It is updated by the walker and
+// may be read concurrently by others.
+type Progress struct {
+	started     time.Time // when hashing started
+	discovered  int
+	totalFiles  int
+	totalBytes  int64
+	hashedFiles int
+	hashedBytes int64
+	counted     bool
+	mut         sync.Mutex
+}
+
+// ProgressStatus is a snapshot of a Progress.
+type ProgressStatus struct {
+	// DiscoveredFiles is the number of files and directories found by the
+	// walk so far, whether they need hashing or not.
+	DiscoveredFiles int
+	HashedFiles     int
+	HashedBytes     int64
+	// The totals are known only when the walker counts the files to hash
+	// before hashing them, and are zero otherwise.
+	TotalFiles int
+	TotalBytes int64
+	// Percent and ETA are negative when unknown.
+	Percent float64
+	ETA     time.Duration
+}
+
+// Status returns the current state of the walk.
+func (p *Progress) Status() ProgressStatus {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	s := ProgressStatus{
+		DiscoveredFiles: p.discovered,
+		HashedFiles:     p.hashedFiles,
+		HashedBytes:     p.hashedBytes,
+		TotalFiles:      p.totalFiles,
+		TotalBytes:      p.totalBytes,
+		Percent:         -1,
+		ETA:             -1,
+	}
+	if !p.counted {
+		return s
+	}
+
+	if p.totalBytes == 0 {
+		s.Percent = 100
+		s.ETA = 0
+		return s
+	}
+
+	done := p.hashedBytes
+	if done > p.totalBytes {
+		// Files grew after they were counted
+		done = p.totalBytes
+	}
+	s.Percent = 100 * float64(done) / float64(p.totalBytes)
+	if done > 0 {
+		elapsed := time.Since(p.started)
+		s.ETA = time.Duration(float64(elapsed) * float64(p.totalBytes-done) / float64(done))
+	}
+	return s
+}
+
+func (p *Progress) setTotal(files int, bytes int64) {
+	p.mut.Lock()
+	p.totalFiles = files
+	p.totalBytes = bytes
+	p.counted = true
+	p.mut.Unlock()
+}
+
+func (p *Progress) start() {
+	p.mut.Lock()
+	p.started = time.Now()
+	p.mut.Unlock()
+}
+
+func (p *Progress) addDiscovered() {
+	p.mut.Lock()
+	p.discovered++
+	p.mut.Unlock()
+}
+
+func (p *Progress) addFile() {
+	p.mut.Lock()
+	p.hashedFiles++
+	p.mut.Unlock()
+}
+
+func (p *Progress) addBytes(n int) {
+	p.mut.Lock()
+	p.hashedBytes += int64(n)
+	p.mut.Unlock()
+}
+
+// report calls fn with the status of the walk every interval until stop is
+// closed, and a last time then.
+func (p *Progress) report(fn func(ProgressStatus), interval time.Duration, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			fn(p.Status())
+		case <-stop:
+			fn(p.Status())
+			return
+		}
+	}
+}
+
+// progressReader counts the bytes read through it as hashed.
+type progressReader struct {
+	r io.Reader
+	p *Progress
+}
+
+func (r progressReader) Read(bs []byte) (int, error) {
+	n, err := r.r.Read(bs)
+	r.p.addBytes(n)
+	return n, err
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrWalkStopped is returned by Walk when it was stopped through
+// Walker.Stop before it completed.
+var ErrWalkStopped = errors.New("walk stopped")
+
+// stopped returns true if the walk has been asked to stop.
+func (w *Walker) stopped() bool {
+	select {
+	case <-w.Stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopReader fails reads with ErrWalkStopped once the walk has been asked to
+// stop, so that hashing a large file does not hold up the stop.
+type stopReader struct {
+	r io.Reader
+	w *Walker
+}
+
+func (r stopReader) Read(bs []byte) (int, error) {
+	if r.w.stopped() {
+		return 0, ErrWalkStopped
+	}
+	return r.r.Read(bs)
+}
+
+// resumeAfter returns the name of the last file that was handled by the walk
+// being resumed, or the empty string.
+func (w *Walker) resumeAfter() string {
+	if len(w.Resume) == 0 {
+		return ""
+	}
+	return w.Resume[len(w.Resume)-1].Name
+}
+
+// resumeIgnoredDir returns the directory that the walk being resumed was
+// within when it stopped, if it is ignored, since the files below it are
+// then reported as ignored records.
+func (w *Walker) resumeIgnoredDir(ign *Matcher) string {
+	after := w.resumeAfter()
+	if after == "" || !w.ReportIgnored {
+		return ""
+	}
+	parts := strings.Split(after, string(os.PathSeparator))
+	var dir string
+	for _, part := range parts {
+		dir = filepath.Join(dir, part)
+		if ign.Match(dir) {
+			return dir
+		}
+	}
+	return ""
+}
+
+// skipResumed returns true if the file at rn was already handled by the walk
+// being resumed, along with filepath.SkipDir if everything below it was
+// too. The files below the last directory handled were not.
+func (w *Walker) skipResumed(rn string, info os.FileInfo) (bool, error) {
+	after := w.resumeAfter()
+	if after == "" || !walkedBefore(rn, after) {
+		return false, nil
+	}
+	if info.IsDir() && rn != after && !strings.HasPrefix(after, rn+string(os.PathSeparator)) {
+		return true, filepath.SkipDir
+	}
+	return true, nil
+}
+
+// walkedBefore returns true if a is reached before b, or is b, when walking
+// a directory tree in lexical order as filepath.Walk does. Directories are
+// reached before the files within them.
+func walkedBefore(a, b string) bool {
+	as := strings.Split(a, string(os.PathSeparator))
+	bs := strings.Split(b, string(os.PathSeparator))
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] != bs[i] {
+			return as[i] < bs[i]
+		}
+	}
+	return len(as) <= len(bs)
+}
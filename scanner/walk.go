@@ -8,21 +8,38 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
-	"code.google.com/p/go.text/unicode/norm"
 
+	"code.google.com/p/go.text/unicode/norm"
+	"github.com/calmh/syncthing/fs"
 	"github.com/calmh/syncthing/lamport"
+	"github.com/calmh/syncthing/osutil"
 	"github.com/calmh/syncthing/protocol"
 )
 
+var (
+	errNotNFC      = errors.New("file name contains non-NFC UTF-8 sequences")
+	errIncludeLoop = errors.New("include loop")
+)
+
+// WalkBatchSize is the largest number of files sent at once by WalkChan.
+const WalkBatchSize = 1000
+
+// A line in an ignore file starting with this includes the patterns of the
+// file named by the rest of the line.
+const includeDirective = "#include "
+
 type Walker struct {
 	// Dir is the base directory for the walk
 	Dir string
+	// If Sub is not empty, only the file or directory Sub, relative to Dir,
+	// is walked. Ignore files in Sub and its parent directories still apply.
+	Sub string
 	// BlockSize controls the size of the block used when hashing.
 	BlockSize int
 	// If IgnoreFile is not empty, it is the name used for the file that holds ignore patterns.
@@ -39,6 +56,55 @@ type Walker struct {
 	// detected. Scanned files will get zero permission bits and the
 	// NoPermissionBits flag set.
 	IgnorePerms bool
+	// If ACLs is true, the POSIX ACLs of files and directories are read
+	// and changes to them are detected.
+	ACLs bool
+	// If MtimeMapper is not nil, it is queried for the modification time to
+	// use for each file, instead of the one found on disk.
+	MtimeMapper MtimeMapper
+	// If Progress is not nil, it is updated as files are hashed.
+	Progress *Progress
+	// If Precount is true, a quick pass over the file metadata counts the
+	// files and bytes that need hashing before hashing starts, so that
+	// Progress can tell how much remains. Requires Progress to be set.
+	Precount bool
+	// If ProgressFunc is not nil, it is called with the status of Progress
+	// every ProgressInterval while the walk goes on, and once more when it
+	// is done. Requires Progress to be set.
+	ProgressFunc     func(ProgressStatus)
+	ProgressInterval time.Duration
+	// If IgnoreSymlinks is true, symbolic links are skipped. Otherwise
+	// they are returned as files with the symlink flag and their Target
+	// set, and are never followed.
+	IgnoreSymlinks bool
+	// If ReportIgnored is true, ignored files and directories that are
+	// known to the CurrentFiler are returned as ignored records (see
+	// File.IsIgnored) instead of being left out. Requires CurrentFiler to
+	// be set.
+	ReportIgnored bool
+	// VectorID is the ID of this node in version vectors, whose counter is
+	// increased in the vectors of changed files.
+	VectorID uint64
+	// If Errors is not nil, it is told about the files that could not be
+	// scanned.
+	Errors ErrorReporter
+	// If Versions is not nil, the directories holding archived versions
+	// that it recognizes are not scanned.
+	Versions VersionsDirer
+	// If Filesystem is not nil, it is used for all file system access.
+	// Otherwise fs.DefaultFilesystem is used.
+	Filesystem fs.Filesystem
+	// Hashers is the number of files that are hashed concurrently; zero or
+	// one means that files are hashed one at a time, as they are walked.
+	// The files are returned in the order they were walked regardless.
+	Hashers int
+	// If Stop is not nil, the walk stops when it is closed. Walk then
+	// returns the files found so far and ErrWalkStopped.
+	Stop <-chan struct{}
+	// If Resume is not nil, it holds the files returned by a walk of the
+	// same Dir and Sub that was stopped, and the walk carries on after the
+	// last of them instead of starting over.
+	Resume []File
 }
 
 type TempNamer interface {
@@ -48,11 +114,29 @@ type TempNamer interface {
 	IsTemporary(path string) bool
 }
 
+type VersionsDirer interface {
+	// IsVersionsDir returns true if path refers to a directory holding
+	// archived versions.
+	IsVersionsDir(path string) bool
+}
+
 type Suppressor interface {
 	// Supress returns true if the update to the named file should be ignored.
 	Suppress(name string, fi os.FileInfo) (bool, bool)
 }
 
+type MtimeMapper interface {
+	// Mtime returns the modification time to use for the named file, given
+	// its modification time on disk.
+	Mtime(name string, ondisk time.Time) time.Time
+}
+
+type ErrorReporter interface {
+	// ReportError is called with the name of each file that could not be
+	// scanned and the reason why.
+	ReportError(name string, err error)
+}
+
 type CurrentFiler interface {
 	// CurrentFile returns the file as seen at last scan.
 	CurrentFile(name string) File
@@ -60,40 +144,163 @@ type CurrentFiler interface {
 
 // Walk returns the list of files found in the local repository by scanning the
 // file system. Files are blockwise hashed.
-func (w *Walker) Walk() (files []File, ignore map[string][]string, err error) {
+func (w *Walker) Walk() (files []File, ignore *Matcher, err error) {
+	return w.walk(nil)
+}
+
+// WalkChan is like Walk, but sends the files on the channel in batches of
+// at most WalkBatchSize files, in the order they are walked, as the walk
+// goes on, so that they need not all be held in memory at once. The channel
+// is closed when the walk is done. If the returned error is not nil, the
+// files sent are not all the files there are.
+func (w *Walker) WalkChan(batches chan<- []File) (ignore *Matcher, err error) {
+	defer close(batches)
+	_, ignore, err = w.walk(batches)
+	return
+}
+
+// walk returns the files found, or sends them on out in batches if it is
+// not nil.
+func (w *Walker) walk(out chan<- []File) (files []File, ignore *Matcher, err error) {
 	if debug {
 		l.Debugln("Walk", w.Dir, w.BlockSize, w.IgnoreFile)
 	}
 
-	err = checkDir(w.Dir)
+	err = w.checkDir()
 	if err != nil {
 		return
 	}
 
 	t0 := time.Now()
 
-	ignore = make(map[string][]string)
-	hashFiles := w.walkAndHashFiles(&files, ignore)
+	ignore = NewMatcher(nil)
 
-	filepath.Walk(w.Dir, w.loadIgnoreFiles(w.Dir, ignore))
-	filepath.Walk(w.Dir, hashFiles)
+	root := w.Dir
+	var ignoredDir string
+	if w.Sub != "" {
+		root = filepath.Join(w.Dir, w.Sub)
+		if ignoredDir = w.loadParentIgnores(ignore); ignoredDir != "" && !w.ReportIgnored {
+			// Sub is itself within an ignored directory
+			err = w.checkDir()
+			return
+		}
+	}
+	w.fs().Walk(root, w.loadIgnoreFiles(w.Dir, ignore))
+
+	if len(w.Resume) > 0 {
+		if debug {
+			l.Debugf("Resuming walk of %q after %q", w.Dir, w.resumeAfter())
+		}
+		files = append(files, w.Resume...)
+		if ignoredDir == "" {
+			ignoredDir = w.resumeIgnoredDir(ignore)
+		}
+	}
+
+	var hashers *hashPool
+	if w.Hashers > 1 {
+		hashers = w.startHashers(w.Hashers)
+	}
+
+	var sent int
+	var flush func() error
+	if out != nil {
+		// Sends the files found so far, once they are hashed.
+		flush = func() error {
+			batch := files
+			var stopped bool
+			if hashers != nil {
+				batch, stopped = hashers.drain(files)
+			}
+			if len(batch) > 0 {
+				out <- batch
+				sent += len(batch)
+			}
+			files = nil
+			if stopped {
+				return ErrWalkStopped
+			}
+			return nil
+		}
+	}
+
+	hashFiles := w.walkAndHashFiles(&files, ignore, ignoredDir, hashers, flush)
+	if w.Progress != nil {
+		if w.Precount {
+			var files int
+			var bytes int64
+			w.fs().Walk(root, w.countFiles(ignore, &files, &bytes))
+			if debug {
+				l.Debugf("Precount %d files, %d bytes in %v", files, bytes, time.Since(t0))
+			}
+			w.Progress.setTotal(files, bytes)
+		}
+		w.Progress.start()
+
+		if w.ProgressFunc != nil {
+			stop, done := make(chan struct{}), make(chan struct{})
+			go func() {
+				w.Progress.report(w.ProgressFunc, w.progressInterval(), stop)
+				close(done)
+			}()
+			defer func() {
+				close(stop)
+				<-done
+			}()
+		}
+	}
+	err = w.fs().Walk(root, hashFiles)
+	if hashers != nil {
+		var stopped bool
+		files, stopped = hashers.wait(files)
+		if stopped {
+			err = ErrWalkStopped
+		}
+	}
+	if out != nil && len(files) > 0 {
+		out <- files
+		sent += len(files)
+		files = nil
+	} else {
+		sent += len(files)
+	}
+	if err == ErrWalkStopped {
+		if debug {
+			l.Debugf("Walk of %q stopped after %d files", w.Dir, sent)
+		}
+		return
+	}
 
 	if debug {
 		t1 := time.Now()
 		d := t1.Sub(t0).Seconds()
-		l.Debugf("Walk in %.02f ms, %.0f files/s", d*1000, float64(len(files))/d)
+		l.Debugf("Walk in %.02f ms, %.0f files/s", d*1000, float64(sent)/d)
 	}
 
-	err = checkDir(w.Dir)
+	err = w.checkDir()
 	return
 }
 
+func (w *Walker) progressInterval() time.Duration {
+	if w.ProgressInterval > 0 {
+		return w.ProgressInterval
+	}
+	return defaultProgressInterval
+}
+
 // CleanTempFiles removes all files that match the temporary filename pattern.
 func (w *Walker) CleanTempFiles() {
-	filepath.Walk(w.Dir, w.cleanTempFile)
+	w.fs().Walk(w.Dir, w.cleanTempFile)
+}
+
+func (w *Walker) fs() fs.Filesystem {
+	if w.Filesystem != nil {
+		return w.Filesystem
+	}
+	return fs.DefaultFilesystem
 }
 
-func (w *Walker) loadIgnoreFiles(dir string, ign map[string][]string) filepath.WalkFunc {
+func (w *Walker) loadIgnoreFiles(dir string, ign *Matcher) filepath.WalkFunc {
 	return func(p string, info os.FileInfo, err error) error {
 		if err != nil {
 			return nil
@@ -106,29 +313,165 @@ func (w *Walker) loadIgnoreFiles(dir string, ign map[string][]string) filepath.W
 
 		if pn, sn := filepath.Split(rn); sn == w.IgnoreFile {
 			pn := filepath.Clean(pn)
-			l.Debugf("pn: %q", pn)
-			bs, _ := ioutil.ReadFile(p)
-			lines := bytes.Split(bs, []byte("\n"))
-			var patterns []string
-			for _, line := range lines {
-				lineStr := strings.TrimSpace(string(line))
-				if len(lineStr) > 0 {
-					patterns = append(patterns, lineStr)
-				}
+			if debug {
+				l.Debugf("ignore file in %q", pn)
+			}
+			patterns, includes := w.readIgnoreFile(p, make(map[string]bool))
+			ign.add(pn, patterns)
+			ign.setIncludes(pn, includes)
+		}
+
+		return nil
+	}
+}
+
+// readIgnoreFile returns the patterns in the ignore file at p, with each
+// #include directive replaced by the patterns in the file it names, and the
+// paths of the included files. Included files are found relative to the
+// file including them. The files in seen are being read further up, so
+// including one of them again would be a loop.
+func (w *Walker) readIgnoreFile(p string, seen map[string]bool) (patterns, includes []string) {
+	bs, err := fs.ReadFile(w.fs(), p)
+	if err != nil {
+		return nil, nil
+	}
+	seen[p] = true
+	defer delete(seen, p)
+
+	for _, line := range bytes.Split(bs, []byte("\n")) {
+		lineStr := strings.TrimSpace(string(line))
+		if strings.HasPrefix(lineStr, includeDirective) {
+			name := strings.TrimSpace(lineStr[len(includeDirective):])
+			inc := filepath.FromSlash(name)
+			if !filepath.IsAbs(inc) {
+				inc = filepath.Join(filepath.Dir(p), inc)
+			}
+			includes = append(includes, inc)
+
+			if seen[inc] {
+				w.reportIgnoreError(p, fmt.Errorf("include %q: %v", name, errIncludeLoop))
+				continue
+			}
+			if _, err := w.fs().Lstat(inc); err != nil {
+				w.reportIgnoreError(p, fmt.Errorf("include %q: %v", name, err))
+				continue
+			}
+			pats, incs := w.readIgnoreFile(inc, seen)
+			patterns = append(patterns, pats...)
+			includes = append(includes, incs...)
+			continue
+		}
+		if len(lineStr) > 0 {
+			patterns = append(patterns, lineStr)
+		}
+	}
+	return patterns, includes
+}
+
+// reportIgnoreError reports an error in the ignore file at p, when it is
+// within the repository.
+func (w *Walker) reportIgnoreError(p string, err error) {
+	if rn, rerr := filepath.Rel(w.Dir, p); rerr == nil && !strings.HasPrefix(rn, "..") {
+		w.reportError(rn, err)
+	} else if debug {
+		l.Debugf("ignore file %q: %v", p, err)
+	}
+}
+
+// loadParentIgnores loads the ignore files in the directories above Sub,
+// from the top down. If one of those directories is ignored or temporary,
+// its name is returned and nothing below it should be walked as usual.
+func (w *Walker) loadParentIgnores(ign *Matcher) string {
+	load := w.loadIgnoreFiles(w.Dir, ign)
+	parts := strings.Split(filepath.Clean(w.Sub), string(filepath.Separator))
+	var dir string
+	for i := 0; i < len(parts); i++ {
+		if i > 0 {
+			dir = filepath.Join(dir, parts[i-1])
+			if w.TempNamer != nil && w.TempNamer.IsTemporary(dir) {
+				return dir
+			}
+			if w.isVersionsDir(dir) || ign.Match(dir) {
+				return dir
+			}
+		}
+		if w.IgnoreFile != "" {
+			p := filepath.Join(w.Dir, dir, w.IgnoreFile)
+			if info, err := w.fs().Lstat(p); err == nil {
+				load(p, info, nil)
+			}
+		}
+	}
+	return ""
+}
+
+// countFiles returns a WalkFunc that counts the regular files that will need
+// hashing and their total size. It uses the same rules as walkAndHashFiles,
+// but looks only at the file metadata.
+func (w *Walker) countFiles(ign *Matcher, files *int, bytes *int64) filepath.WalkFunc {
+	return func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		rn, err := filepath.Rel(w.Dir, p)
+		if err != nil || rn == "." {
+			return nil
+		}
+
+		if skip, err := w.skipResumed(rn, info); skip {
+			return err
+		}
+
+		if w.TempNamer != nil && w.TempNamer.IsTemporary(rn) {
+			return nil
+		}
+
+		if sn := filepath.Base(rn); sn == w.IgnoreFile || w.isVersionsDir(rn) || ign.Match(rn) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		if w.CurrentFiler != nil {
+			cf := w.CurrentFiler.CurrentFile(rn)
+			permUnchanged := w.IgnorePerms || !protocol.HasPermissionBits(cf.Flags) || PermsEqual(cf.Flags, uint32(info.Mode()))
+			if !protocol.IsDeleted(cf.Flags) && cf.Modified == w.mtime(rn, info).Unix() && permUnchanged {
+				return nil
 			}
-			ign[pn] = patterns
 		}
 
+		*files++
+		*bytes += info.Size()
 		return nil
 	}
 }
 
-func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath.WalkFunc {
+// walkAndHashFiles returns a WalkFunc that hashes the files walked. If
+// ignoredDir is not empty, everything below it is considered ignored. If
+// hashers is not nil, the files are handed to it for hashing and their
+// blocks are filled in by hashers.wait. If flush is not nil, it is called
+// to pass on the files found whenever there are WalkBatchSize of them.
+func (w *Walker) walkAndHashFiles(res *[]File, ign *Matcher, ignoredDir string, hashers *hashPool, flush func() error) filepath.WalkFunc {
 	return func(p string, info os.FileInfo, err error) error {
+		if flush != nil && len(*res) >= WalkBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
 		if err != nil {
 			if debug {
 				l.Debugln("error:", p, info, err)
 			}
+			if rn, rerr := filepath.Rel(w.Dir, p); rerr == nil && rn != "." && !os.IsNotExist(err) {
+				w.reportError(rn, err)
+			}
 			return nil
 		}
 
@@ -144,6 +487,14 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 			return nil
 		}
 
+		if w.stopped() {
+			return ErrWalkStopped
+		}
+
+		if skip, err := w.skipResumed(rn, info); skip {
+			return err
+		}
+
 		if w.TempNamer != nil && w.TempNamer.IsTemporary(rn) {
 			// A temporary file
 			if debug {
@@ -152,11 +503,32 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 			return nil
 		}
 
-		if sn := filepath.Base(rn); sn == w.IgnoreFile || sn == ".stversions" || w.ignoreFile(ign, rn) {
+		if ignoredDir != "" && !strings.HasPrefix(rn, ignoredDir+string(os.PathSeparator)) {
+			// We have left the ignored directory
+			ignoredDir = ""
+		}
+
+		if sn := filepath.Base(rn); sn == w.IgnoreFile || w.isVersionsDir(rn) || ignoredDir != "" || ign.Match(rn) {
 			// An ignored file
 			if debug {
 				l.Debugln("ignored:", rn)
 			}
+			if w.ReportIgnored && w.CurrentFiler != nil && sn != w.IgnoreFile && !w.isVersionsDir(rn) {
+				// Files we used to have are kept in the index as ignored
+				// rather than deleted.
+				cf := w.CurrentFiler.CurrentFile(rn)
+				if cf.Name != "" && !protocol.IsDeleted(cf.Flags) {
+					*res = append(*res, File{
+						Name:       rn,
+						Flags:      cf.Flags & protocol.FlagDirectory,
+						Suppressed: true,
+					})
+					if info.IsDir() && ignoredDir == "" {
+						ignoredDir = rn
+					}
+					return nil
+				}
+			}
 			if info.IsDir() {
 				return filepath.SkipDir
 			}
@@ -165,6 +537,56 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 
 		if (runtime.GOOS == "linux" || runtime.GOOS == "windows") && !norm.NFC.IsNormalString(rn) {
 			l.Warnf("File %q contains non-NFC UTF-8 sequences and cannot be synced. Consider renaming.", rn)
+			w.reportError(rn, errNotNFC)
+			return nil
+		}
+
+		isSymlink := info.Mode()&os.ModeSymlink != 0
+		if isSymlink && w.IgnoreSymlinks {
+			return nil
+		}
+
+		if w.Progress != nil && (info.Mode().IsDir() || info.Mode().IsRegular() || isSymlink) {
+			w.Progress.addDiscovered()
+		}
+
+		// Only the seconds tell whether a file has changed, as they are
+		// all that files from older nodes have.
+		mtime := w.mtime(rn, info)
+		modified, modifiedNs := mtime.Unix(), int32(mtime.Nanosecond())
+
+		if isSymlink {
+			target, err := w.fs().Readlink(p)
+			if err != nil {
+				w.reportError(rn, err)
+				return nil
+			}
+			var cf File
+			if w.CurrentFiler != nil {
+				// The modification time of a link can't be set when it is
+				// pulled, so only the target tells whether it changed.
+				cf = w.CurrentFiler.CurrentFile(rn)
+				if protocol.IsSymlink(cf.Flags) && !protocol.IsDeleted(cf.Flags) && cf.Target == target {
+					if debug {
+						l.Debugln("unchanged:", cf)
+					}
+					*res = append(*res, cf)
+					return nil
+				}
+			}
+			f := File{
+				Name:       rn,
+				Version:    lamport.Default.Tick(0),
+				Vector:     cf.Vector.Update(w.VectorID),
+				Flags:      protocol.FlagSymlink | protocol.FlagNoPermBits | 0666,
+				Modified:   modified,
+				ModifiedNs: modifiedNs,
+				Target:     target,
+			}
+			if debug {
+				l.Debugln("symlink:", f)
+			}
+			*res = append(*res, f)
 			return nil
 		}
 
@@ -172,7 +594,9 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 			if w.CurrentFiler != nil {
 				cf := w.CurrentFiler.CurrentFile(rn)
 				permUnchanged := w.IgnorePerms || !protocol.HasPermissionBits(cf.Flags) || PermsEqual(cf.Flags, uint32(info.Mode()))
-				if cf.Modified == info.ModTime().Unix() && protocol.IsDirectory(cf.Flags) && permUnchanged {
+				acl := w.acl(p, cf)
+				aclUnchanged := !w.ACLs || bytes.Equal(acl, cf.ACL)
+				if cf.Modified == modified && protocol.IsDirectory(cf.Flags) && permUnchanged && aclUnchanged {
 					if debug {
 						l.Debugln("unchanged:", cf)
 					}
@@ -185,10 +609,13 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 						flags |= uint32(info.Mode() & os.ModePerm)
 					}
 					f := File{
-						Name:     rn,
-						Version:  lamport.Default.Tick(0),
-						Flags:    flags,
-						Modified: info.ModTime().Unix(),
+						Name:       rn,
+						Version:    lamport.Default.Tick(0),
+						Vector:     cf.Vector.Update(w.VectorID),
+						Flags:      flags,
+						Modified:   modified,
+						ModifiedNs: modifiedNs,
+						ACL:        acl,
 					}
 					if debug {
 						l.Debugln("dir:", cf, f)
@@ -200,10 +627,16 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 		}
 
 		if info.Mode().IsRegular() {
+			var cf File
+			if w.CurrentFiler != nil {
+				cf = w.CurrentFiler.CurrentFile(rn)
+			}
+			acl := w.acl(p, cf)
+
 			if w.CurrentFiler != nil {
-				cf := w.CurrentFiler.CurrentFile(rn)
 				permUnchanged := w.IgnorePerms || !protocol.HasPermissionBits(cf.Flags) || PermsEqual(cf.Flags, uint32(info.Mode()))
-				if !protocol.IsDeleted(cf.Flags) && cf.Modified == info.ModTime().Unix() && permUnchanged {
+				aclUnchanged := !w.ACLs || bytes.Equal(acl, cf.ACL)
+				if !protocol.IsDeleted(cf.Flags) && !protocol.IsSymlink(cf.Flags) && cf.Modified == modified && permUnchanged && aclUnchanged {
 					if debug {
 						l.Debugln("unchanged:", cf)
 					}
@@ -227,30 +660,8 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 				}
 
 				if debug {
-					l.Debugln("rescan:", cf, info.ModTime().Unix(), info.Mode()&os.ModePerm)
-				}
-			}
-
-			fd, err := os.Open(p)
-			if err != nil {
-				if debug {
-					l.Debugln("open:", p, err)
+					l.Debugln("rescan:", cf, modified, info.Mode()&os.ModePerm)
 				}
-				return nil
-			}
-			defer fd.Close()
-
-			t0 := time.Now()
-			blocks, err := Blocks(fd, w.BlockSize)
-			if err != nil {
-				if debug {
-					l.Debugln("hash error:", rn, err)
-				}
-				return nil
-			}
-			if debug {
-				t1 := time.Now()
-				l.Debugln("hashed:", rn, ";", len(blocks), "blocks;", info.Size(), "bytes;", int(float64(info.Size())/1024/t1.Sub(t0).Seconds()), "KB/s")
 			}
 
 			var flags = uint32(info.Mode() & os.ModePerm)
@@ -258,12 +669,27 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 				flags = protocol.FlagNoPermBits | 0666
 			}
 			f := File{
-				Name:     rn,
-				Version:  lamport.Default.Tick(0),
-				Size:     info.Size(),
-				Flags:    flags,
-				Modified: info.ModTime().Unix(),
-				Blocks:   blocks,
+				Name:       rn,
+				Version:    lamport.Default.Tick(0),
+				Vector:     cf.Vector.Update(w.VectorID),
+				Size:       info.Size(),
+				Flags:      flags,
+				Modified:   modified,
+				ModifiedNs: modifiedNs,
+				ACL:        acl,
+			}
+
+			if hashers != nil {
+				hashers.add(len(*res), p, info.Size())
+				*res = append(*res, f)
+				return nil
+			}
+
+			f.Blocks, err = w.hashFile(p, rn, info.Size())
+			if err == ErrWalkStopped {
+				return err
+			} else if err != nil {
+				return nil
 			}
 			*res = append(*res, f)
 		}
@@ -272,38 +698,98 @@ func (w *Walker) walkAndHashFiles(res *[]File, ign map[string][]string) filepath
 	}
 }
 
+// hashFile returns the blocks of the file at p. Errors are reported and
+// returned.
+func (w *Walker) hashFile(p, rn string, size int64) ([]Block, error) {
+	fd, err := w.fs().Open(p)
+	if err != nil {
+		if debug {
+			l.Debugln("open:", p, err)
+		}
+		w.reportError(rn, err)
+		return nil, err
+	}
+	defer fd.Close()
+
+	t0 := time.Now()
+	var r io.Reader = fd
+	if w.Progress != nil {
+		r = progressReader{fd, w.Progress}
+		defer w.Progress.addFile()
+	}
+	if w.Stop != nil {
+		r = stopReader{r, w}
+	}
+	blocks, err := Blocks(r, w.BlockSize)
+	if err == ErrWalkStopped {
+		return nil, err
+	}
+	if err != nil {
+		if debug {
+			l.Debugln("hash error:", rn, err)
+		}
+		w.reportError(rn, err)
+		return nil, err
+	}
+	if debug {
+		t1 := time.Now()
+		l.Debugln("hashed:", rn, ";", len(blocks), "blocks;", size, "bytes;", int(float64(size)/1024/t1.Sub(t0).Seconds()), "KB/s")
+	}
+	return blocks, nil
+}
+
+func (w *Walker) reportError(rn string, err error) {
+	if w.Errors != nil {
+		w.Errors.ReportError(rn, err)
+	}
+}
+
+// mtime returns the modification time to use for the file at rn.
+func (w *Walker) mtime(rn string, info os.FileInfo) time.Time {
+	if w.MtimeMapper != nil {
+		return w.MtimeMapper.Mtime(rn, info.ModTime())
+	}
+	return info.ModTime()
+}
+
+// acl returns the ACL of the file at p, if ACLs are enabled. Where ACLs
+// cannot be read, the ACL of the current file is kept so that ACLs received
+// from other nodes survive a rescan.
+func (w *Walker) acl(p string, cf File) []byte {
+	if !w.ACLs {
+		return nil
+	}
+	acl, err := w.fs().GetACL(p)
+	if err != nil {
+		if debug && err != osutil.ErrACLNotSupported {
+			l.Debugln("getacl:", p, err)
+		}
+		return cf.ACL
+	}
+	return acl
+}
+
 func (w *Walker) cleanTempFile(path string, info os.FileInfo, err error) error {
 	if err != nil {
 		return err
 	}
 	if info.Mode()&os.ModeType == 0 && w.TempNamer.IsTemporary(path) {
-		os.Remove(path)
+		w.fs().Remove(path)
 	}
 	return nil
 }
 
-func (w *Walker) ignoreFile(patterns map[string][]string, file string) bool {
-	first, last := filepath.Split(file)
-	for prefix, pats := range patterns {
-		if prefix == "." || prefix == first || strings.HasPrefix(first, fmt.Sprintf("%s%c", prefix, os.PathSeparator)) {
-			for _, pattern := range pats {
-				l.Debugf("%q %q", pattern, last)
-				if match, _ := filepath.Match(pattern, last); match {
-					return true
-				}
-			}
-		}
-	}
-	return false
+func (w *Walker) isVersionsDir(rn string) bool {
+	return w.Versions != nil && w.Versions.IsVersionsDir(rn)
 }
 
-func checkDir(dir string) error {
-	if info, err := os.Lstat(dir); err != nil {
+func (w *Walker) checkDir() error {
+	if info, err := w.fs().Lstat(w.Dir); err != nil {
 		return err
 	} else if !info.IsDir() {
-		return errors.New(dir + ": not a directory")
+		return errors.New(w.Dir + ": not a directory")
 	} else if debug {
-		l.Debugln("checkDir", dir, info)
+		l.Debugln("checkDir", w.Dir, info)
 	}
 	return nil
 }
@@ -6,9 +6,19 @@ package scanner
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
+
+	"github.com/calmh/syncthing/fs"
+	"github.com/calmh/syncthing/protocol"
 )
 
 var testdata = []struct {
@@ -57,7 +67,7 @@ func TestWalk(t *testing.T) {
 		}
 	}
 
-	if !reflect.DeepEqual(ignores, correctIgnores) {
+	if !reflect.DeepEqual(ignores.Patterns(), correctIgnores) {
 		t.Errorf("Incorrect ignores\n  %v\n  %v", correctIgnores, ignores)
 	}
 }
@@ -86,6 +96,326 @@ func TestWalkError(t *testing.T) {
 	}
 }
 
+func TestWalkFilesystemErrors(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	for _, n := range []string{"repo/a", "repo/b", "repo/c"} {
+		fd, _ := f.Create(n)
+		fd.Write([]byte(n))
+		fd.Close()
+	}
+	f.InjectError(fs.OpOpen, "repo/b", syscall.EACCES)
+	f.InjectError(fs.OpRead, "repo/c", syscall.EIO)
+
+	w := Walker{
+		Dir:        "repo",
+		BlockSize:  128 * 1024,
+		Filesystem: f,
+	}
+	files, _, err := w.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 || files[0].Name != "a" {
+		t.Errorf("Unexpected files from walk: %v", files)
+	}
+}
+
+type fakeCurrentFiler map[string]File
+
+func (f fakeCurrentFiler) CurrentFile(name string) File {
+	return f[name]
+}
+
+func TestWalkACLs(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	fd, _ := f.Create("repo/a")
+	fd.Write([]byte("a"))
+	fd.Close()
+	f.SetACL("repo/a", []byte("acl 1"))
+
+	cur := make(fakeCurrentFiler)
+	w := Walker{
+		Dir:          "repo",
+		BlockSize:    128 * 1024,
+		CurrentFiler: cur,
+		ACLs:         true,
+		Filesystem:   f,
+	}
+	files, _, err := w.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || string(files[0].ACL) != "acl 1" {
+		t.Fatalf("Unexpected files from walk: %v", files)
+	}
+
+	// Unchanged ACL; the current file is returned as is
+	cur["a"] = files[0]
+	files, _, _ = w.Walk()
+	if files[0].Version != cur["a"].Version {
+		t.Error("Unchanged file got a new version")
+	}
+
+	// Changed ACL only
+	f.SetACL("repo/a", []byte("acl 2"))
+	files, _, _ = w.Walk()
+	if files[0].Version == cur["a"].Version || string(files[0].ACL) != "acl 2" {
+		t.Errorf("ACL change not detected: %v %q", files[0], files[0].ACL)
+	}
+}
+
+func TestWalkProgress(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	for _, n := range []string{"repo/a", "repo/b", "repo/.syncthing.c"} {
+		fd, _ := f.Create(n)
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+
+	var reported []ProgressStatus
+	w := Walker{
+		Dir:        "repo",
+		BlockSize:  128 * 1024,
+		TempNamer:  fakeTempNamer{},
+		Progress:   &Progress{},
+		Precount:   true,
+		Filesystem: f,
+		ProgressFunc: func(s ProgressStatus) {
+			reported = append(reported, s)
+		},
+	}
+	if _, _, err := w.Walk(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := w.Progress.Status()
+	if s.DiscoveredFiles != 2 || s.TotalFiles != 2 || s.TotalBytes != 18 || s.HashedFiles != 2 || s.HashedBytes != 18 {
+		t.Errorf("Unexpected progress %+v", s)
+	}
+	if len(reported) == 0 || reported[len(reported)-1] != s {
+		t.Errorf("Final progress not reported: %+v", reported)
+	}
+	if s.Percent != 100 || s.ETA != 0 {
+		t.Errorf("Unexpected completion %+v", s)
+	}
+}
+
+func TestWalkChan(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	for i := 0; i < 2*WalkBatchSize+10; i++ {
+		name := fmt.Sprintf("repo/d%d/f%04d", i%3, i)
+		f.MkdirAll(filepath.Dir(name), 0755)
+		fd, _ := f.Create(name)
+		fmt.Fprintf(fd, "data %d", i)
+		fd.Close()
+	}
+
+	for _, hashers := range []int{0, 4} {
+		w := Walker{
+			Dir:          "repo",
+			BlockSize:    128 * 1024,
+			Filesystem:   f,
+			CurrentFiler: fakeCurrentFiler{},
+			Hashers:      hashers,
+		}
+		expected, _, err := w.Walk()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		batches := make(chan []File)
+		var streamed []File
+		var nbatches int
+		done := make(chan struct{})
+		go func() {
+			for b := range batches {
+				if len(b) > WalkBatchSize {
+					t.Errorf("Batch of %d files too large", len(b))
+				}
+				streamed = append(streamed, b...)
+				nbatches++
+			}
+			close(done)
+		}()
+		if _, err := w.WalkChan(batches); err != nil {
+			t.Fatal(err)
+		}
+		<-done
+
+		if nbatches < 3 {
+			t.Errorf("Only %d batches with %d hashers", nbatches, hashers)
+		}
+		if len(streamed) != len(expected) {
+			t.Fatalf("Incorrect number of streamed files %d != %d", len(streamed), len(expected))
+		}
+		for i := range expected {
+			if streamed[i].Name != expected[i].Name || !reflect.DeepEqual(streamed[i].Blocks, expected[i].Blocks) {
+				t.Errorf("Incorrect streamed file #%d with %d hashers: %v != %v", i, hashers, streamed[i], expected[i])
+				break
+			}
+		}
+	}
+}
+
+func TestWalkSymlinks(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/dir", 0755)
+	f.Symlink("dir", "repo/link")
+	f.Symlink("/outside", "repo/dir/abs")
+
+	w := Walker{
+		Dir:          "repo",
+		BlockSize:    128 * 1024,
+		Filesystem:   f,
+		CurrentFiler: fakeCurrentFiler{},
+	}
+	files, _, err := w.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	targets := make(map[string]string)
+	for _, sf := range files {
+		if protocol.IsSymlink(sf.Flags) {
+			targets[sf.Name] = sf.Target
+		}
+	}
+	if len(targets) != 2 || targets["link"] != "dir" || targets[filepath.Join("dir", "abs")] != "/outside" {
+		t.Errorf("Incorrect symlinks %v", targets)
+	}
+
+	// An unchanged link keeps its version; a changed one gets a new
+	cur := make(fakeCurrentFiler)
+	for _, sf := range files {
+		cur[sf.Name] = sf
+	}
+	f.Remove("repo/link")
+	f.Symlink("elsewhere", "repo/link")
+	w.CurrentFiler = cur
+	files, _, _ = w.Walk()
+	for _, sf := range files {
+		switch sf.Name {
+		case "link":
+			if sf.Version == cur["link"].Version || sf.Target != "elsewhere" {
+				t.Errorf("Changed link not detected: %v", sf)
+			}
+		case filepath.Join("dir", "abs"):
+			if sf.Version != cur[sf.Name].Version {
+				t.Errorf("Unchanged link rescanned: %v", sf)
+			}
+		}
+	}
+
+	w.IgnoreSymlinks = true
+	files, _, _ = w.Walk()
+	if len(files) != 1 || files[0].Name != "dir" {
+		t.Errorf("Symlinks not ignored: %v", files)
+	}
+}
+
+func TestWalkSub(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/a/b", 0755)
+	f.MkdirAll("repo/skip", 0755)
+	for _, n := range []string{"repo/a/b/c", "repo/a/b/skip", "repo/skip/x", "repo/other"} {
+		fd, _ := f.Create(n)
+		fd.Close()
+	}
+	fd, _ := f.Create("repo/.stignore")
+	fd.Write([]byte("skip\n"))
+	fd.Close()
+
+	var tests = []struct {
+		sub   string
+		files []string
+	}{
+		{"a", []string{"a/b/c"}},
+		{"a/b", []string{"a/b/c"}},
+		{"a/b/c", []string{"a/b/c"}},
+		{"skip/x", nil},
+		{"missing", nil},
+	}
+
+	for _, tc := range tests {
+		w := Walker{
+			Dir:        "repo",
+			Sub:        tc.sub,
+			BlockSize:  128 * 1024,
+			IgnoreFile: ".stignore",
+			Filesystem: f,
+		}
+		files, _, err := w.Walk()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, f := range files {
+			names = append(names, f.Name)
+		}
+		if !reflect.DeepEqual(names, tc.files) {
+			t.Errorf("Incorrect files for %q: %v != %v", tc.sub, names, tc.files)
+		}
+	}
+}
+
+func TestWalkReportIgnored(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/skip/sub", 0755)
+	for _, n := range []string{"repo/a", "repo/skip/b", "repo/skip/sub/c", "repo/skip/new"} {
+		fd, _ := f.Create(n)
+		fd.Close()
+	}
+	fd, _ := f.Create("repo/.stignore")
+	fd.Write([]byte("skip\n"))
+	fd.Close()
+
+	cur := fakeCurrentFiler{
+		"skip":       File{Name: "skip", Flags: protocol.FlagDirectory, Version: 1},
+		"skip/b":     File{Name: "skip/b", Version: 1},
+		"skip/sub":   File{Name: "skip/sub", Flags: protocol.FlagDirectory, Version: 1},
+		"skip/sub/c": File{Name: "skip/sub/c", Flags: protocol.FlagDeleted, Version: 2},
+	}
+	w := Walker{
+		Dir:           "repo",
+		BlockSize:     128 * 1024,
+		IgnoreFile:    ".stignore",
+		CurrentFiler:  cur,
+		ReportIgnored: true,
+		Filesystem:    f,
+	}
+	files, _, err := w.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ignored []string
+	for _, f := range files {
+		if f.IsIgnored() {
+			ignored = append(ignored, f.Name)
+		} else if f.Name != "a" {
+			t.Errorf("Unexpected file %v", f)
+		}
+	}
+	// Unknown and deleted files are not reported
+	if expected := []string{"skip", "skip/b", "skip/sub"}; !reflect.DeepEqual(ignored, expected) {
+		t.Errorf("Incorrect ignored files %v != %v", ignored, expected)
+	}
+}
+
+type fakeTempNamer struct{}
+
+func (fakeTempNamer) TempName(name string) string {
+	return ".syncthing." + name
+}
+
+func (fakeTempNamer) IsTemporary(name string) bool {
+	return strings.HasPrefix(name, ".syncthing.")
+}
+
 func TestIgnore(t *testing.T) {
 	var patterns = map[string][]string{
 		".":       {"t2"},
@@ -112,10 +442,323 @@ func TestIgnore(t *testing.T) {
 		{"foo/bazz/quux", false},
 	}
 
-	w := Walker{}
+	m := NewMatcher(patterns)
 	for i, tc := range tests {
-		if r := w.ignoreFile(patterns, tc.f); r != tc.r {
-			t.Errorf("Incorrect ignoreFile() #%d; E: %v, A: %v", i, tc.r, r)
+		if r := m.Match(tc.f); r != tc.r {
+			t.Errorf("Incorrect Match() #%d; E: %v, A: %v", i, tc.r, r)
+		}
+	}
+}
+
+func TestIgnoreSyntax(t *testing.T) {
+	var patterns = map[string][]string{
+		".": {
+			"# a comment",
+			"*.tmp",
+			"!keep.tmp",
+			"/top",
+			"(?i)*.BAK",
+			"docs/*.pdf",
+			"**/cache/**",
+			"a/**/z",
+			`\#hash`,
+			"[!x]y",
+		},
+		"sub": {"!b.tmp", "/anchored"},
+	}
+	var tests = []struct {
+		f string
+		r bool
+	}{
+		{"# a comment", false},
+		{"x.tmp", true},
+		{"dir/x.tmp", true},
+		{"keep.tmp", false},
+		{"dir/keep.tmp", false},
+		{"top", true},
+		{"dir/top", false},
+		{"x.bak", true},
+		{"dir/X.Bak", true},
+		{"docs/a.pdf", true},
+		{"docs/sub/a.pdf", false},
+		{"other/docs/a.pdf", false},
+		{"cache/x", true},
+		{"dir/cache/x/y", true},
+		{"cache", false},
+		{"a/z", true},
+		{"a/b/c/z", true},
+		{"b/a/z", false},
+		{"#hash", true},
+		{"ay", true},
+		{"xy", false},
+		{"sub/a.tmp", true},
+		{"sub/b.tmp", false},
+		{"sub/dir/b.tmp", false},
+		{"b.tmp", true},
+		{"sub/anchored", true},
+		{"sub/dir/anchored", false},
+		{"anchored", false},
+	}
+
+	m := NewMatcher(patterns)
+	for i, tc := range tests {
+		if r := m.Match(filepath.FromSlash(tc.f)); r != tc.r {
+			t.Errorf("Incorrect Match(%q) #%d; E: %v, A: %v", tc.f, i, tc.r, r)
+		}
+	}
+}
+
+func TestIgnoreBadPatterns(t *testing.T) {
+	for _, p := range []string{"[abc", "!", "/", `foo\`} {
+		if runtime.GOOS == "windows" && p == `foo\` {
+			continue
+		}
+		if _, err := compilePattern(p); err == nil {
+			t.Errorf("Unexpected nil error for %q", p)
+		}
+	}
+
+	// Bad patterns are skipped, the rest still apply
+	m := NewMatcher(map[string][]string{".": {"[abc", "foo"}})
+	if !m.Match("foo") || m.Match("[abc") {
+		t.Error("Incorrect matching with a bad pattern")
+	}
+}
+
+type errorMap map[string]error
+
+func (m errorMap) ReportError(name string, err error) {
+	m[name] = err
+}
+
+func TestIgnoreInclude(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo/sub", 0755)
+	f.MkdirAll("shared", 0755)
+	for name, data := range map[string]string{
+		"repo/.stignore":     "#include ../shared/ignores\nfoo\n",
+		"shared/ignores":     "bar\n#include more\n",
+		"shared/more":        "baz\n",
+		"repo/sub/.stignore": "#include loop\n#include missing\n",
+		"repo/sub/loop":      "quux\n#include .stignore\n",
+	} {
+		fd, _ := f.Create(name)
+		fd.Write([]byte(data))
+		fd.Close()
+	}
+
+	errs := make(errorMap)
+	w := Walker{
+		Dir:        "repo",
+		BlockSize:  128 * 1024,
+		IgnoreFile: ".stignore",
+		Filesystem: f,
+		Errors:     errs,
+	}
+	_, ign, err := w.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		".":   {"bar", "baz", "foo"},
+		"sub": {"quux"},
+	}
+	if p := ign.Patterns(); !reflect.DeepEqual(p, expected) {
+		t.Errorf("Incorrect patterns %v", p)
+	}
+	for _, n := range []string{"bar", "baz", "foo", "sub/quux"} {
+		if !ign.Match(filepath.FromSlash(n)) {
+			t.Errorf("%q not ignored", n)
+		}
+	}
+
+	incs := ign.Includes()
+	sort.Strings(incs)
+	expectedIncs := []string{
+		filepath.FromSlash("repo/sub/.stignore"),
+		filepath.FromSlash("repo/sub/loop"),
+		filepath.FromSlash("repo/sub/missing"),
+		filepath.FromSlash("shared/ignores"),
+		filepath.FromSlash("shared/more"),
+	}
+	if !reflect.DeepEqual(incs, expectedIncs) {
+		t.Errorf("Incorrect includes %v", incs)
+	}
+
+	if len(errs) != 2 || errs[filepath.FromSlash("sub/loop")] == nil || errs[filepath.FromSlash("sub/.stignore")] == nil {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+}
+
+func TestIgnorePath(t *testing.T) {
+	m := NewMatcher(map[string][]string{
+		".":   {"skip"},
+		"foo": {"b*"},
+	})
+	var tests = []struct {
+		f string
+		r bool
+	}{
+		{"skip", true},
+		{"skip/a/b", true},
+		{"foo/bar/a", true},
+		{"foo/a/bar", true},
+		{"foo/a/c", false},
+		{"bar/a", false},
+	}
+
+	for i, tc := range tests {
+		if r := m.MatchPath(tc.f); r != tc.r {
+			t.Errorf("Incorrect MatchPath() #%d; E: %v, A: %v", i, tc.r, r)
+		}
+	}
+
+	// Changed patterns must not be answered from the cache
+	m.add(".", []string{"a"})
+	if !m.MatchPath("foo/a/c") {
+		t.Error("Stale cached result after adding patterns")
+	}
+
+	var nilMatcher *Matcher
+	if nilMatcher.MatchPath("skip") {
+		t.Error("Nil matcher matched")
+	}
+}
+
+func TestWalkHashers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hashers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 50; i++ {
+		sub := filepath.Join(dir, fmt.Sprintf("dir%d", i%5))
+		os.MkdirAll(sub, 0777)
+		data := []byte(strings.Repeat(fmt.Sprintf("file %d\n", i), i*100))
+		ioutil.WriteFile(filepath.Join(sub, fmt.Sprintf("file%d", i)), data, 0644)
+	}
+
+	serial := Walker{Dir: dir, BlockSize: 1024}
+	expected, _, err := serial.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parallel := Walker{Dir: dir, BlockSize: 1024, Hashers: 8}
+	files, _, err := parallel.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != len(expected) {
+		t.Fatalf("Incorrect number of walked files %d != %d", len(files), len(expected))
+	}
+	for i := range expected {
+		if files[i].Name != expected[i].Name {
+			t.Errorf("Incorrect order; %q != %q at %d", files[i].Name, expected[i].Name, i)
+		}
+		if !reflect.DeepEqual(files[i].Blocks, expected[i].Blocks) {
+			t.Errorf("Incorrect blocks for %q", files[i].Name)
+		}
+	}
+}
+
+// stoppingFiler closes stop when asked about the file named at.
+type stoppingFiler struct {
+	at   string
+	stop chan struct{}
+}
+
+func (f stoppingFiler) CurrentFile(name string) File {
+	if name == f.at {
+		close(f.stop)
+	}
+	return File{}
+}
+
+func TestWalkStopResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 20; i++ {
+		sub := filepath.Join(dir, fmt.Sprintf("dir%d", i%4))
+		os.MkdirAll(sub, 0777)
+		ioutil.WriteFile(filepath.Join(sub, fmt.Sprintf("file%02d", i)), []byte(fmt.Sprintf("file %d", i)), 0644)
+	}
+
+	full := Walker{Dir: dir, BlockSize: 1024, CurrentFiler: stoppingFiler{}}
+	expected, _, err := full.Walk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stopping after a directory means resuming within it.
+	var tests = []struct {
+		hashers int
+		at      string
+	}{
+		{0, filepath.Join("dir2", "file06")},
+		{0, "dir1"},
+		{4, filepath.Join("dir2", "file06")},
+		{4, "dir1"},
+	}
+	for _, tc := range tests {
+		hashers := tc.hashers
+		stop := make(chan struct{})
+		w := Walker{
+			Dir:          dir,
+			BlockSize:    1024,
+			Hashers:      hashers,
+			Stop:         stop,
+			CurrentFiler: stoppingFiler{tc.at, stop},
+		}
+		partial, _, err := w.Walk()
+		if err != ErrWalkStopped {
+			t.Fatalf("%d hashers, %s: unexpected error %v", hashers, tc.at, err)
+		}
+		if len(partial) == 0 || len(partial) >= len(expected) {
+			t.Fatalf("%d hashers, %s: unexpected partial result of %d files", hashers, tc.at, len(partial))
+		}
+
+		w = Walker{Dir: dir, BlockSize: 1024, Hashers: hashers, CurrentFiler: stoppingFiler{}, Resume: partial}
+		files, _, err := w.Walk()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != len(expected) {
+			t.Fatalf("%d hashers, %s: incorrect number of files %d != %d", hashers, tc.at, len(files), len(expected))
+		}
+		for i := range expected {
+			if files[i].Name != expected[i].Name || !reflect.DeepEqual(files[i].Blocks, expected[i].Blocks) {
+				t.Errorf("%d hashers, %s: incorrect file %v != %v at %d", hashers, tc.at, files[i], expected[i], i)
+			}
+		}
+	}
+}
+
+func TestWalkedBefore(t *testing.T) {
+	var tests = []struct {
+		a, b string
+		res  bool
+	}{
+		{"a", "a", true},
+		{"a", "b", true},
+		{"b", "a", false},
+		{"a", "a/b", true},
+		{"a/b", "a", false},
+		{"a/z", "b", true},
+		{"a/b", "a b", true},
+		{"a b", "a/b", false},
+		{"a/b/c", "a/c", true},
+	}
+	for _, tc := range tests {
+		if res := walkedBefore(filepath.FromSlash(tc.a), filepath.FromSlash(tc.b)); res != tc.res {
+			t.Errorf("walkedBefore(%q, %q) = %v, expected %v", tc.a, tc.b, res, tc.res)
 		}
 	}
 }
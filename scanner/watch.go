@@ -0,0 +1,137 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	ErrWatchUnsupported = errors.New("file system notifications are not supported on this platform")
+	ErrWatchLimit       = errors.New("too many directories to watch")
+)
+
+// When more paths than this have changed during a burst, their parent
+// directories are reported instead.
+const maxWatchPaths = 1000
+
+// A burst of changes is reported at the latest after this many times the
+// coalescing delay, even if it goes on.
+const maxWatchDelays = 10
+
+// A watchEvent is sent by the platform specific backends. Overflow is set
+// when events have been lost.
+type watchEvent struct {
+	path     string // absolute
+	overflow bool
+}
+
+type watchBackend interface {
+	// events returns the channel on which changes are sent. It is closed
+	// when the backend fails; err then tells why.
+	events() <-chan watchEvent
+	err() error
+	close() error
+	// contents returns true if writes to existing files are reported, and
+	// not only files and directories being created, removed or renamed.
+	contents() bool
+}
+
+// A Watcher reports the files and directories below a directory that are
+// changed, using the notifications of the operating system (inotify,
+// kqueue or ReadDirectoryChangesW), so that only those need to be rescanned.
+//
+// Bursts of changes are coalesced and reported together. The reported
+// paths are relative to the watched directory; a path of "." means that
+// everything needs rescanning, for example because notifications were lost.
+// When the Watcher can no longer watch the directory, for example because
+// it has become too large for the limits of the operating system, it reports
+// "." a last time and closes the channel. Err then tells why.
+type Watcher struct {
+	dir     string
+	delay   time.Duration
+	backend watchBackend
+	agg     *changeAggregator
+	stop    chan struct{}
+}
+
+// NewWatcher starts watching the directory and all directories below it.
+// Changes are reported after no more have happened for delay. The error is
+// ErrWatchUnsupported on platforms without notifications, and ErrWatchLimit
+// when the directory holds more directories than can be watched.
+func NewWatcher(dir string, delay time.Duration) (*Watcher, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	b, err := newWatchBackend(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	stop := make(chan struct{})
+	w := &Watcher{
+		dir:     dir,
+		delay:   delay,
+		backend: b,
+		agg:     newChangeAggregator(delay, maxWatchDelays*delay, maxWatchPaths, stop),
+		stop:    stop,
+	}
+	go w.run()
+	return w, nil
+}
+
+// Changes returns the channel on which the changed paths are reported.
+func (w *Watcher) Changes() <-chan []string {
+	return w.agg.changes()
+}
+
+// ReportsContents returns true if changes to the contents of existing files
+// are reported. Otherwise only files and directories being created, removed
+// or renamed are, and the rest must be found by rescanning.
+func (w *Watcher) ReportsContents() bool {
+	return w.backend.contents()
+}
+
+// Err returns the reason the Watcher stopped, once the channel returned by
+// Changes has been closed.
+func (w *Watcher) Err() error {
+	return w.backend.err()
+}
+
+// Close stops watching. The channel returned by Changes is not closed.
+func (w *Watcher) Close() error {
+	close(w.stop)
+	return w.backend.close()
+}
+
+func (w *Watcher) run() {
+	events := w.backend.events()
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				if debug {
+					l.Debugf("watcher %q: stopped: %v", w.dir, w.backend.err())
+				}
+				w.agg.add(".")
+				w.agg.close()
+				return
+			}
+
+			if ev.overflow {
+				w.agg.add(".")
+			} else if rel, err := filepath.Rel(w.dir, ev.path); err == nil && !strings.HasPrefix(rel, "..") {
+				w.agg.add(rel)
+			}
+
+		case <-w.stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build darwin freebsd netbsd openbsd dragonfly
+
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const kqueueFflags = syscall.NOTE_WRITE | syscall.NOTE_DELETE | syscall.NOTE_RENAME |
+	syscall.NOTE_ATTRIB | syscall.NOTE_EXTEND
+
+// How often the reading loop checks whether the backend has been closed.
+const kqueuePollInterval = time.Second
+
+// The kqueue backends together keep at most this part of the process's
+// limit of open descriptors, so that connections and the files being
+// scanned and pulled are left enough.
+const kqueueDescriptorShare = 4
+
+var kqueueBudget struct {
+	inUse int
+	max   int // 0 for no limit
+	known bool
+	mut   sync.Mutex
+}
+
+// acquireKqueueDescriptor returns true if another descriptor may be opened
+// for watching.
+func acquireKqueueDescriptor() bool {
+	kqueueBudget.mut.Lock()
+	defer kqueueBudget.mut.Unlock()
+	if !kqueueBudget.known {
+		kqueueBudget.known = true
+		var rl syscall.Rlimit
+		if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rl); err == nil && rl.Cur <= 1<<30 {
+			kqueueBudget.max = int(rl.Cur) / kqueueDescriptorShare
+		}
+	}
+	if kqueueBudget.max > 0 && kqueueBudget.inUse >= kqueueBudget.max {
+		return false
+	}
+	kqueueBudget.inUse++
+	return true
+}
+
+func releaseKqueueDescriptor() {
+	kqueueBudget.mut.Lock()
+	kqueueBudget.inUse--
+	kqueueBudget.mut.Unlock()
+}
+
+// kqueueBackend watches each directory with kqueue, which needs an open
+// descriptor for each of them. Files are not watched, as that would take a
+// descriptor for each file; writes to existing files are thus not reported.
+// A change to a directory means that entries were added to, removed from or
+// renamed within it; the directory is then searched for new directories to
+// watch. When the descriptors allowed for watching run out, the backend
+// fails with ErrWatchLimit.
+type kqueueBackend struct {
+	kq    int
+	c     chan watchEvent
+	done  chan struct{}
+	paths map[int]string // descriptor -> path
+	fds   map[string]int // path -> descriptor
+	error error
+	mut   sync.Mutex
+}
+
+func newWatchBackend(dir string) (watchBackend, error) {
+	kq, err := syscall.Kqueue()
+	if err != nil {
+		return nil, err
+	}
+	b := &kqueueBackend{
+		kq:    kq,
+		c:     make(chan watchEvent),
+		done:  make(chan struct{}),
+		paths: make(map[int]string),
+		fds:   make(map[string]int),
+	}
+	if err := b.addTree(dir); err != nil {
+		b.closeAll()
+		return nil, err
+	}
+	go b.read()
+	return b, nil
+}
+
+func (b *kqueueBackend) events() <-chan watchEvent {
+	return b.c
+}
+
+func (b *kqueueBackend) err() error {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.error
+}
+
+func (b *kqueueBackend) close() error {
+	close(b.done)
+	return nil
+}
+
+func (b *kqueueBackend) contents() bool {
+	return false
+}
+
+// addTree watches the directory and all directories below it that are not
+// already watched.
+func (b *kqueueBackend) addTree(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		b.mut.Lock()
+		_, ok := b.fds[path]
+		b.mut.Unlock()
+		if ok {
+			return nil
+		}
+
+		if !acquireKqueueDescriptor() {
+			return ErrWatchLimit
+		}
+		fd, err := syscall.Open(path, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+		switch err {
+		case nil:
+		case syscall.EMFILE, syscall.ENFILE:
+			releaseKqueueDescriptor()
+			return ErrWatchLimit
+		default:
+			releaseKqueueDescriptor()
+			return nil
+		}
+		var ev syscall.Kevent_t
+		syscall.SetKevent(&ev, fd, syscall.EVFILT_VNODE, syscall.EV_ADD|syscall.EV_CLEAR)
+		ev.Fflags = kqueueFflags
+		if _, err := syscall.Kevent(b.kq, []syscall.Kevent_t{ev}, nil, nil); err != nil {
+			syscall.Close(fd)
+			releaseKqueueDescriptor()
+			return nil
+		}
+
+		b.mut.Lock()
+		b.paths[fd] = path
+		b.fds[path] = fd
+		b.mut.Unlock()
+		return nil
+	})
+}
+
+func (b *kqueueBackend) remove(fd int) {
+	b.mut.Lock()
+	delete(b.fds, b.paths[fd])
+	delete(b.paths, fd)
+	b.mut.Unlock()
+	syscall.Close(fd)
+	releaseKqueueDescriptor()
+}
+
+func (b *kqueueBackend) closeAll() {
+	b.mut.Lock()
+	for fd := range b.paths {
+		syscall.Close(fd)
+		releaseKqueueDescriptor()
+	}
+	b.paths = nil
+	b.fds = nil
+	b.mut.Unlock()
+	syscall.Close(b.kq)
+}
+
+func (b *kqueueBackend) read() {
+	defer close(b.c)
+	defer b.closeAll()
+
+	evs := make([]syscall.Kevent_t, 64)
+	timeout := syscall.NsecToTimespec(int64(kqueuePollInterval))
+	for {
+		select {
+		case <-b.done:
+			return
+		default:
+		}
+
+		n, err := syscall.Kevent(b.kq, nil, evs, &timeout)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err != nil {
+			b.setErr(err)
+			return
+		}
+
+		for _, ev := range evs[:n] {
+			fd := int(ev.Ident)
+			b.mut.Lock()
+			path, ok := b.paths[fd]
+			b.mut.Unlock()
+			if !ok {
+				continue
+			}
+
+			if ev.Fflags&(syscall.NOTE_DELETE|syscall.NOTE_RENAME) != 0 {
+				b.remove(fd)
+			} else if ev.Fflags&syscall.NOTE_WRITE != 0 {
+				if fi, err := os.Lstat(path); err == nil && fi.IsDir() {
+					if err := b.addTree(path); err != nil {
+						b.setErr(err)
+						return
+					}
+				}
+			}
+			if !b.send(watchEvent{path: path}) {
+				return
+			}
+		}
+	}
+}
+
+func (b *kqueueBackend) send(ev watchEvent) bool {
+	select {
+	case b.c <- ev:
+		return true
+	case <-b.done:
+		return false
+	}
+}
+
+func (b *kqueueBackend) setErr(err error) {
+	b.mut.Lock()
+	b.error = err
+	b.mut.Unlock()
+}
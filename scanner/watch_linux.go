@@ -0,0 +1,164 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MODIFY |
+	syscall.IN_ATTRIB | syscall.IN_CLOSE_WRITE | syscall.IN_MOVED_FROM |
+	syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF |
+	syscall.IN_ONLYDIR
+
+// inotifyBackend watches each directory with inotify. Directories created
+// later are added as they appear.
+type inotifyBackend struct {
+	file  *os.File
+	fd    int
+	c     chan watchEvent
+	done  chan struct{}
+	dirs  map[int]string // watch descriptor -> directory
+	error error
+	mut   sync.Mutex
+}
+
+func newWatchBackend(dir string) (watchBackend, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	b := &inotifyBackend{
+		file: os.NewFile(uintptr(fd), "inotify"),
+		fd:   fd,
+		c:    make(chan watchEvent),
+		done: make(chan struct{}),
+		dirs: make(map[int]string),
+	}
+	if err := b.addTree(dir); err != nil {
+		b.file.Close()
+		return nil, err
+	}
+	go b.read()
+	return b, nil
+}
+
+func (b *inotifyBackend) events() <-chan watchEvent {
+	return b.c
+}
+
+func (b *inotifyBackend) err() error {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.error
+}
+
+func (b *inotifyBackend) close() error {
+	close(b.done)
+	return b.file.Close()
+}
+
+func (b *inotifyBackend) contents() bool {
+	return true
+}
+
+// addTree watches the directory and all directories below it.
+func (b *inotifyBackend) addTree(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			// Directories that disappear or cannot be read are not watched.
+			return nil
+		}
+		wd, err := syscall.InotifyAddWatch(b.fd, path, inotifyMask)
+		switch err {
+		case nil:
+		case syscall.ENOSPC:
+			return ErrWatchLimit
+		default:
+			return nil
+		}
+		b.mut.Lock()
+		b.dirs[wd] = path
+		b.mut.Unlock()
+		return nil
+	})
+}
+
+func (b *inotifyBackend) read() {
+	defer close(b.c)
+
+	var buf [64 * (syscall.SizeofInotifyEvent + syscall.PathMax + 1)]byte
+	for {
+		n, err := b.file.Read(buf[:])
+		if err != nil {
+			b.setErr(err)
+			return
+		}
+
+		for offs := 0; offs+syscall.SizeofInotifyEvent <= n; {
+			ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offs]))
+			nameStart := offs + syscall.SizeofInotifyEvent
+			offs = nameStart + int(ev.Len)
+
+			if ev.Mask&syscall.IN_Q_OVERFLOW != 0 {
+				if !b.send(watchEvent{overflow: true}) {
+					return
+				}
+				continue
+			}
+
+			b.mut.Lock()
+			dir, ok := b.dirs[int(ev.Wd)]
+			if ev.Mask&syscall.IN_IGNORED != 0 {
+				delete(b.dirs, int(ev.Wd))
+			}
+			b.mut.Unlock()
+			if !ok {
+				continue
+			}
+
+			path := dir
+			if ev.Len > 0 {
+				name := buf[nameStart:offs]
+				for i, c := range name {
+					if c == 0 {
+						name = name[:i]
+						break
+					}
+				}
+				path = filepath.Join(dir, string(name))
+			}
+
+			if ev.Mask&syscall.IN_ISDIR != 0 && ev.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+				if err := b.addTree(path); err != nil {
+					b.setErr(err)
+					return
+				}
+			}
+			if !b.send(watchEvent{path: path}) {
+				return
+			}
+		}
+	}
+}
+
+func (b *inotifyBackend) send(ev watchEvent) bool {
+	select {
+	case b.c <- ev:
+		return true
+	case <-b.done:
+		return false
+	}
+}
+
+func (b *inotifyBackend) setErr(err error) {
+	b.mut.Lock()
+	b.error = err
+	b.mut.Unlock()
+}
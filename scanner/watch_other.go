@@ -0,0 +1,11 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build !linux,!windows,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package scanner
+
+func newWatchBackend(dir string) (watchBackend, error) {
+	return nil, ErrWatchUnsupported
+}
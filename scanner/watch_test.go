@@ -0,0 +1,67 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package scanner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := NewWatcher(dir, 50*time.Millisecond)
+	if err == ErrWatchUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// A burst of changes is reported together, with the changes within
+	// a new directory covered by the directory.
+	os.Mkdir(filepath.Join(dir, "sub"), 0777)
+	ioutil.WriteFile(filepath.Join(dir, "sub", "file"), []byte("data"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "file"), []byte("data"), 0644)
+
+	// Without notifications for files, the changed directory is reported.
+	expected := []string{"file", "sub"}
+	if !w.ReportsContents() {
+		expected = []string{"."}
+	}
+	select {
+	case paths := <-w.Changes():
+		if !reflect.DeepEqual(paths, expected) {
+			t.Errorf("%v != expected %v", paths, expected)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no changes reported")
+	}
+
+	// The new directory is watched.
+	ioutil.WriteFile(filepath.Join(dir, "sub", "other"), []byte("data"), 0644)
+
+	expected = []string{filepath.Join("sub", "other")}
+	if !w.ReportsContents() {
+		expected = []string{"sub"}
+	}
+	select {
+	case paths := <-w.Changes():
+		if !reflect.DeepEqual(paths, expected) {
+			t.Errorf("%v != expected %v", paths, expected)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no changes reported")
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package scanner
+
+import (
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+const rdcwFilter = syscall.FILE_NOTIFY_CHANGE_FILE_NAME | syscall.FILE_NOTIFY_CHANGE_DIR_NAME |
+	syscall.FILE_NOTIFY_CHANGE_ATTRIBUTES | syscall.FILE_NOTIFY_CHANGE_SIZE |
+	syscall.FILE_NOTIFY_CHANGE_LAST_WRITE
+
+// rdcwBackend watches the whole tree with a single ReadDirectoryChangesW
+// call, so there is no limit on the number of directories.
+type rdcwBackend struct {
+	dir    string
+	handle syscall.Handle
+	c      chan watchEvent
+	done   chan struct{}
+	error  error
+	mut    sync.Mutex
+}
+
+func newWatchBackend(dir string) (watchBackend, error) {
+	p, err := syscall.UTF16PtrFromString(dir)
+	if err != nil {
+		return nil, err
+	}
+	h, err := syscall.CreateFile(p, syscall.FILE_LIST_DIRECTORY,
+		syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE|syscall.FILE_SHARE_DELETE,
+		nil, syscall.OPEN_EXISTING, syscall.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		return nil, err
+	}
+	b := &rdcwBackend{
+		dir:    dir,
+		handle: h,
+		c:      make(chan watchEvent),
+		done:   make(chan struct{}),
+	}
+	go b.read()
+	return b, nil
+}
+
+func (b *rdcwBackend) events() <-chan watchEvent {
+	return b.c
+}
+
+func (b *rdcwBackend) err() error {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.error
+}
+
+func (b *rdcwBackend) close() error {
+	close(b.done)
+	// Interrupts the ReadDirectoryChanges call in progress.
+	syscall.CancelIoEx(b.handle, nil)
+	return nil
+}
+
+func (b *rdcwBackend) contents() bool {
+	return true
+}
+
+func (b *rdcwBackend) read() {
+	defer close(b.c)
+	defer syscall.CloseHandle(b.handle)
+
+	buf := make([]byte, 64*1024)
+	for {
+		var n uint32
+		err := syscall.ReadDirectoryChanges(b.handle, &buf[0], uint32(len(buf)), true, rdcwFilter, &n, nil, 0)
+		select {
+		case <-b.done:
+			return
+		default:
+		}
+		if err != nil {
+			b.setErr(err)
+			return
+		}
+
+		if n == 0 {
+			// The buffer was too small for the changes that happened.
+			if !b.send(watchEvent{overflow: true}) {
+				return
+			}
+			continue
+		}
+
+		for offs := uint32(0); ; {
+			info := (*syscall.FileNotifyInformation)(unsafe.Pointer(&buf[offs]))
+			name := (*[1 << 15]uint16)(unsafe.Pointer(&info.FileName))[:info.FileNameLength/2]
+			path := filepath.Join(b.dir, syscall.UTF16ToString(name))
+			if !b.send(watchEvent{path: path}) {
+				return
+			}
+			if info.NextEntryOffset == 0 {
+				break
+			}
+			offs += info.NextEntryOffset
+		}
+	}
+}
+
+func (b *rdcwBackend) send(ev watchEvent) bool {
+	select {
+	case b.c <- ev:
+		return true
+	case <-b.done:
+		return false
+	}
+}
+
+func (b *rdcwBackend) setErr(err error) {
+	b.mut.Lock()
+	b.error = err
+	b.mut.Unlock()
+}
@@ -0,0 +1,12 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package service installs, removes and runs the program as a Windows
+// service, and logs to the Windows event log. On other platforms all
+// functions return ErrNotSupported.
+package service
+
+import "errors"
+
+var ErrNotSupported = errors.New("Windows services are not supported on this platform")
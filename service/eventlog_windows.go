@@ -0,0 +1,98 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package service
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/calmh/syncthing/logger"
+)
+
+var (
+	procRegisterEventSource = advapi32.NewProc("RegisterEventSourceW")
+	procReportEvent         = advapi32.NewProc("ReportEventW")
+	procRegCreateKeyEx      = advapi32.NewProc("RegCreateKeyExW")
+	procRegSetValueEx       = advapi32.NewProc("RegSetValueExW")
+	procRegDeleteKey        = advapi32.NewProc("RegDeleteKeyW")
+)
+
+const (
+	hkeyLocalMachine = 0x80000002
+	keyWrite         = 0x20006
+	regExpandSz      = 2
+	regDword         = 4
+
+	eventlogErrorType       = 1
+	eventlogWarningType     = 2
+	eventlogInformationType = 4
+
+	// EventCreate.exe, used as message file, has messages for event IDs
+	// 1-1000 that show the event string unchanged.
+	eventID = 1
+)
+
+const eventLogKey = `SYSTEM\CurrentControlSet\Services\EventLog\Application\`
+
+func installEventSource(name string) error {
+	var key syscall.Handle
+	var disposition uint32
+	r1, _, _ := procRegCreateKeyEx.Call(hkeyLocalMachine, uintptr(unsafe.Pointer(utf16Ptr(eventLogKey+name))), 0, 0, 0, keyWrite, 0,
+		uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(&disposition)))
+	if r1 != 0 {
+		return syscall.Errno(r1)
+	}
+	defer syscall.RegCloseKey(key)
+
+	msgFile := syscall.StringToUTF16(`%SystemRoot%\System32\EventCreate.exe`)
+	r1, _, _ = procRegSetValueEx.Call(uintptr(key), uintptr(unsafe.Pointer(utf16Ptr("EventMessageFile"))), 0, regExpandSz,
+		uintptr(unsafe.Pointer(&msgFile[0])), uintptr(len(msgFile)*2))
+	if r1 != 0 {
+		return syscall.Errno(r1)
+	}
+
+	types := uint32(eventlogErrorType | eventlogWarningType | eventlogInformationType)
+	r1, _, _ = procRegSetValueEx.Call(uintptr(key), uintptr(unsafe.Pointer(utf16Ptr("TypesSupported"))), 0, regDword,
+		uintptr(unsafe.Pointer(&types)), 4)
+	if r1 != 0 {
+		return syscall.Errno(r1)
+	}
+
+	return nil
+}
+
+func removeEventSource(name string) error {
+	r1, _, _ := procRegDeleteKey.Call(hkeyLocalMachine, uintptr(unsafe.Pointer(utf16Ptr(eventLogKey+name))))
+	if r1 != 0 && syscall.Errno(r1) != syscall.ERROR_FILE_NOT_FOUND {
+		return syscall.Errno(r1)
+	}
+	return nil
+}
+
+// EventLogHandler returns a logger handler that writes messages to the
+// Windows event log under the given source name, as registered by Install.
+func EventLogHandler(name string) (logger.MessageHandler, error) {
+	h, _, err := procRegisterEventSource.Call(0, uintptr(unsafe.Pointer(utf16Ptr(name))))
+	if err := callErr(h, err); err != nil {
+		return nil, err
+	}
+
+	return func(level logger.LogLevel, msg string) {
+		var etype uintptr
+		switch level {
+		case logger.LevelWarn:
+			etype = eventlogWarningType
+		case logger.LevelFatal:
+			etype = eventlogErrorType
+		default:
+			etype = eventlogInformationType
+		}
+		strs := []*uint16{utf16Ptr(msg)}
+		procReportEvent.Call(h, etype, 0, eventID, 0, 1, 0, uintptr(unsafe.Pointer(&strs[0])), 0)
+	}, nil
+}
@@ -0,0 +1,26 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+//go:build !windows
+// +build !windows
+
+package service
+
+import "github.com/calmh/syncthing/logger"
+
+func Install(name, displayName, description string, args ...string) error {
+	return ErrNotSupported
+}
+
+func Uninstall(name string) error {
+	return ErrNotSupported
+}
+
+func Run(name string, run func(stop <-chan struct{}) error) error {
+	return ErrNotSupported
+}
+
+func EventLogHandler(name string) (logger.MessageHandler, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,309 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"bitbucket.org/kardianos/osext"
+)
+
+var (
+	advapi32 = syscall.NewLazyDLL("advapi32.dll")
+
+	procOpenSCManager                = advapi32.NewProc("OpenSCManagerW")
+	procCreateService                = advapi32.NewProc("CreateServiceW")
+	procOpenService                  = advapi32.NewProc("OpenServiceW")
+	procDeleteService                = advapi32.NewProc("DeleteService")
+	procCloseServiceHandle           = advapi32.NewProc("CloseServiceHandle")
+	procChangeServiceConfig2         = advapi32.NewProc("ChangeServiceConfig2W")
+	procControlService               = advapi32.NewProc("ControlService")
+	procStartServiceCtrlDispatcher   = advapi32.NewProc("StartServiceCtrlDispatcherW")
+	procRegisterServiceCtrlHandlerEx = advapi32.NewProc("RegisterServiceCtrlHandlerExW")
+	procSetServiceStatus             = advapi32.NewProc("SetServiceStatus")
+)
+
+const (
+	scManagerAllAccess = 0xf003f
+	serviceAllAccess   = 0xf01ff
+
+	serviceWin32OwnProcess = 0x10
+	serviceAutoStart       = 2
+	serviceErrorNormal     = 1
+
+	serviceConfigDescription       = 1
+	serviceConfigFailureActions    = 2
+	serviceConfigFailureActionFlag = 4
+	scActionRestart                = 1
+
+	serviceStopped      = 1
+	serviceStartPending = 2
+	serviceStopPending  = 3
+	serviceRunning      = 4
+
+	serviceAcceptStop     = 1
+	serviceAcceptShutdown = 4
+
+	serviceControlStop        = 1
+	serviceControlInterrogate = 4
+	serviceControlShutdown    = 5
+
+	errorCallNotImplemented     = 120
+	errorServiceSpecificError   = 1066
+	errorServiceDoesNotExist    = 1060
+	errorServiceNotActive       = 1062
+	errorFailedServiceCtrlrConn = 1063
+)
+
+type serviceStatus struct {
+	ServiceType             uint32
+	CurrentState            uint32
+	ControlsAccepted        uint32
+	Win32ExitCode           uint32
+	ServiceSpecificExitCode uint32
+	CheckPoint              uint32
+	WaitHint                uint32
+}
+
+type serviceTableEntry struct {
+	name *uint16
+	proc uintptr
+}
+
+type serviceDescription struct {
+	description *uint16
+}
+
+type scAction struct {
+	Type  uint32
+	Delay uint32
+}
+
+type serviceFailureActions struct {
+	resetPeriod uint32
+	rebootMsg   *uint16
+	command     *uint16
+	numActions  uint32
+	actions     *scAction
+}
+
+type serviceFailureActionsFlag struct {
+	onNonCrashFailures int32
+}
+
+func callErr(r1 uintptr, err error) error {
+	if r1 != 0 {
+		return nil
+	}
+	if errno, ok := err.(syscall.Errno); ok && errno != 0 {
+		return errno
+	}
+	return syscall.EINVAL
+}
+
+func openManager() (uintptr, error) {
+	h, _, err := procOpenSCManager.Call(0, 0, scManagerAllAccess)
+	return h, callErr(h, err)
+}
+
+func closeHandle(h uintptr) {
+	procCloseServiceHandle.Call(h)
+}
+
+// Install installs the running executable as an automatically started
+// service, run with the given arguments. The service is restarted by the
+// service control manager when it stops with an error.
+func Install(name, displayName, description string, args ...string) error {
+	exe, err := osext.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmdline := syscall.EscapeArg(exe)
+	for _, arg := range args {
+		cmdline += " " + syscall.EscapeArg(arg)
+	}
+
+	mgr, err := openManager()
+	if err != nil {
+		return err
+	}
+	defer closeHandle(mgr)
+
+	svc, _, err := procCreateService.Call(mgr,
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(name))),
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(displayName))),
+		serviceAllAccess, serviceWin32OwnProcess, serviceAutoStart, serviceErrorNormal,
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(cmdline))),
+		0, 0, 0, 0, 0)
+	if err := callErr(svc, err); err != nil {
+		return err
+	}
+	defer closeHandle(svc)
+
+	desc := serviceDescription{syscall.StringToUTF16Ptr(description)}
+	r1, _, err := procChangeServiceConfig2.Call(svc, serviceConfigDescription, uintptr(unsafe.Pointer(&desc)))
+	if err := callErr(r1, err); err != nil {
+		return err
+	}
+
+	actions := []scAction{
+		{scActionRestart, 5000},
+		{scActionRestart, 5000},
+		{scActionRestart, 60000},
+	}
+	failure := serviceFailureActions{
+		resetPeriod: 86400,
+		numActions:  uint32(len(actions)),
+		actions:     &actions[0],
+	}
+	r1, _, err = procChangeServiceConfig2.Call(svc, serviceConfigFailureActions, uintptr(unsafe.Pointer(&failure)))
+	if err := callErr(r1, err); err != nil {
+		return err
+	}
+
+	flag := serviceFailureActionsFlag{1}
+	r1, _, err = procChangeServiceConfig2.Call(svc, serviceConfigFailureActionFlag, uintptr(unsafe.Pointer(&flag)))
+	if err := callErr(r1, err); err != nil {
+		return err
+	}
+
+	return installEventSource(name)
+}
+
+// Uninstall stops and removes the service.
+func Uninstall(name string) error {
+	mgr, err := openManager()
+	if err != nil {
+		return err
+	}
+	defer closeHandle(mgr)
+
+	svc, _, err := procOpenService.Call(mgr, uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(name))), serviceAllAccess)
+	if err := callErr(svc, err); err != nil {
+		if err == syscall.Errno(errorServiceDoesNotExist) {
+			return fmt.Errorf("service %q is not installed", name)
+		}
+		return err
+	}
+	defer closeHandle(svc)
+
+	var status serviceStatus
+	r1, _, err := procControlService.Call(svc, serviceControlStop, uintptr(unsafe.Pointer(&status)))
+	if err := callErr(r1, err); err != nil && err != syscall.Errno(errorServiceNotActive) {
+		return err
+	}
+
+	r1, _, err = procDeleteService.Call(svc)
+	if err := callErr(r1, err); err != nil {
+		return err
+	}
+
+	return removeEventSource(name)
+}
+
+type serviceRunner struct {
+	name    string
+	run     func(stop <-chan struct{}) error
+	handle  uintptr
+	stop    chan struct{}
+	stopped bool
+}
+
+// There can only be one service per process.
+var runner *serviceRunner
+
+// Run connects to the service control manager and calls run, which should
+// return after the stop channel is closed. A non-nil error from run is
+// reported to the service control manager as a failure, which restarts the
+// service. Run returns an error immediately when the process was not
+// started by the service control manager.
+func Run(name string, run func(stop <-chan struct{}) error) error {
+	runner = &serviceRunner{
+		name: name,
+		run:  run,
+		stop: make(chan struct{}),
+	}
+
+	table := []serviceTableEntry{
+		{syscall.StringToUTF16Ptr(name), syscall.NewCallback(serviceMain)},
+		{nil, 0},
+	}
+	r1, _, err := procStartServiceCtrlDispatcher.Call(uintptr(unsafe.Pointer(&table[0])))
+	if err := callErr(r1, err); err != nil {
+		if err == syscall.Errno(errorFailedServiceCtrlrConn) {
+			return fmt.Errorf("not started by the service control manager; use the install option")
+		}
+		return err
+	}
+	return nil
+}
+
+func serviceMain(argc uint32, argv **uint16) uintptr {
+	h, _, err := procRegisterServiceCtrlHandlerEx.Call(
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(runner.name))),
+		syscall.NewCallback(serviceHandler), 0)
+	if callErr(h, err) != nil {
+		return 0
+	}
+	runner.handle = h
+
+	runner.setStatus(serviceStartPending, 0)
+	runner.setStatus(serviceRunning, 0)
+
+	err = runner.run(runner.stop)
+	if err != nil {
+		runner.setStatus(serviceStopped, 1)
+	} else {
+		runner.setStatus(serviceStopped, 0)
+	}
+	return 0
+}
+
+func serviceHandler(ctrl, eventType uint32, eventData, context uintptr) uintptr {
+	switch ctrl {
+	case serviceControlStop, serviceControlShutdown:
+		if !runner.stopped {
+			runner.stopped = true
+			runner.setStatus(serviceStopPending, 0)
+			close(runner.stop)
+		}
+		return 0
+	case serviceControlInterrogate:
+		return 0
+	default:
+		return errorCallNotImplemented
+	}
+}
+
+func (r *serviceRunner) setStatus(state uint32, exitCode uint32) {
+	status := serviceStatus{
+		ServiceType:  serviceWin32OwnProcess,
+		CurrentState: state,
+	}
+	if state == serviceRunning {
+		status.ControlsAccepted = serviceAcceptStop | serviceAcceptShutdown
+	}
+	if state == serviceStopPending || state == serviceStartPending {
+		status.WaitHint = 30000
+	}
+	if exitCode != 0 {
+		status.Win32ExitCode = errorServiceSpecificError
+		status.ServiceSpecificExitCode = exitCode
+	}
+	procSetServiceStatus.Call(r.handle, uintptr(unsafe.Pointer(&status)))
+}
+
+// utf16Ptr is syscall.StringToUTF16Ptr without the panic on NUL characters,
+// which are dropped.
+func utf16Ptr(s string) *uint16 {
+	return syscall.StringToUTF16Ptr(strings.Replace(s, "\x00", "", -1))
+}
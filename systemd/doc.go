@@ -0,0 +1,11 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// Package systemd implements socket activation and the sd_notify service
+// status protocol, for running under systemd.
+//
+// Both are driven by environment variables set by systemd (LISTEN_PID,
+// LISTEN_FDS, LISTEN_FDNAMES, NOTIFY_SOCKET, WATCHDOG_USEC, WATCHDOG_PID)
+// and do nothing when they are unset. On Windows, nothing is supported.
+package systemd
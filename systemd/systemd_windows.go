@@ -0,0 +1,28 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package systemd
+
+import (
+	"net"
+	"time"
+)
+
+func Listeners() (map[string][]net.Listener, error) {
+	return nil, nil
+}
+
+func Supervised() bool {
+	return false
+}
+
+func Notify(state string) error {
+	return nil
+}
+
+func WatchdogInterval() time.Duration {
+	return 0
+}
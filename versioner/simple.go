@@ -5,13 +5,11 @@
 package versioner
 
 import (
-	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
-	"time"
 
-	"github.com/calmh/syncthing/osutil"
+	"github.com/calmh/syncthing/fs"
 )
 
 func init() {
@@ -21,18 +19,24 @@ func init() {
 
 // The type holds our configuration
 type Simple struct {
-	keep int
+	keep        int
+	repoDir     string
+	versionsDir string
+	fs          fs.Filesystem
 }
 
 // The constructor function takes a map of parameters and creates the type.
-func NewSimple(params map[string]string) Versioner {
+func NewSimple(repoDir string, filesystem fs.Filesystem, params map[string]string) Versioner {
 	keep, err := strconv.Atoi(params["keep"])
-	if err != nil {
+	if err != nil || keep < 1 {
 		keep = 5 // A reasonable default
 	}
 
 	s := Simple{
-		keep: keep,
+		keep:        keep,
+		repoDir:     repoDir,
+		versionsDir: VersionsDir(params),
+		fs:          filesystem,
 	}
 
 	if debug {
@@ -44,40 +48,21 @@ func NewSimple(params map[string]string) Versioner {
 // Move away the named file to a version archive. If this function returns
 // nil, the named file does not exist any more (has been archived).
 func (v Simple) Archive(path string) error {
-	_, err := os.Stat(path)
-	if err != nil && os.IsNotExist(err) {
-		return nil
-	}
-
-	if debug {
-		l.Debugln("archiving", path)
-	}
-
-	file := filepath.Base(path)
-	dir := filepath.Join(filepath.Dir(path), ".stversions")
-	err = os.MkdirAll(dir, 0755)
-	if err != nil && !os.IsExist(err) {
-		return err
-	} else {
-		osutil.HideFile(dir)
-	}
-
-	ver := file + "~" + time.Now().Format("20060102-150405")
-	err = osutil.Rename(path, filepath.Join(dir, ver))
-	if err != nil {
+	dir, err := archive(v.fs, v.repoDir, v.versionsDir, path)
+	if err != nil || dir == "" {
 		return err
 	}
 
-	versions, err := filepath.Glob(filepath.Join(dir, file+"~*"))
+	versions, err := v.fs.Glob(filepath.Join(dir, filepath.Base(path)+"~*"))
 	if err != nil {
 		l.Warnln(err)
 		return nil
 	}
 
 	if len(versions) > v.keep {
-		sort.Strings(versions)
+		sort.Sort(versionList(versions))
 		for _, toRemove := range versions[:len(versions)-v.keep] {
-			err = os.Remove(toRemove)
+			err = v.fs.Remove(toRemove)
 			if err != nil {
 				l.Warnln(err)
 			}
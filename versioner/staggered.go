@@ -0,0 +1,210 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package versioner
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+)
+
+func init() {
+	// Register the constructor for this type of versioner with the name "staggered"
+	Factories["staggered"] = NewStaggered
+}
+
+// An interval of version ages in which one version is kept per step.
+type interval struct {
+	step time.Duration // between the versions kept; zero keeps all of them
+	end  time.Duration // the age at which the next interval starts; zero for none
+}
+
+// The versions kept get sparser as they get older: all of them for an
+// hour, one per hour for a day, one per day for a month and one per week
+// after that.
+var staggeredIntervals = []interval{
+	{0, time.Hour},
+	{time.Hour, 24 * time.Hour},
+	{24 * time.Hour, 30 * 24 * time.Hour},
+	{7 * 24 * time.Hour, 0},
+}
+
+const (
+	defaultMaxAge        = 365 * 24 * time.Hour
+	defaultCleanInterval = time.Hour
+)
+
+// Staggered keeps versions of files at decreasing densities with age, up to
+// a maximum age. The versions of a file are thinned out when a new one is
+// archived, and all versions in the repository every clean interval.
+type Staggered struct {
+	repoDir     string
+	versionsDir string
+	maxAge      time.Duration // zero for no limit
+	fs          fs.Filesystem
+	mut         sync.Mutex
+	now         func() time.Time
+	stop        chan struct{}
+}
+
+// NewStaggered returns a Staggered versioner. The "maxAge" parameter is the
+// age in seconds after which versions are removed, 0 for never, and
+// "cleanInterval" how often in seconds all versions are thinned out, 0 for
+// only when a new version of the file is archived.
+func NewStaggered(repoDir string, filesystem fs.Filesystem, params map[string]string) Versioner {
+	maxAge := defaultMaxAge
+	if s, err := strconv.Atoi(params["maxAge"]); err == nil && s >= 0 {
+		maxAge = time.Duration(s) * time.Second
+	}
+	cleanInterval := defaultCleanInterval
+	if s, err := strconv.Atoi(params["cleanInterval"]); err == nil && s >= 0 {
+		cleanInterval = time.Duration(s) * time.Second
+	}
+
+	v := &Staggered{
+		repoDir:     repoDir,
+		versionsDir: VersionsDir(params),
+		maxAge:      maxAge,
+		fs:          filesystem,
+		now:         time.Now,
+		stop:        make(chan struct{}),
+	}
+
+	if debug {
+		l.Debugf("instantiated %#v", v)
+	}
+
+	if cleanInterval > 0 {
+		go v.cleaner(cleanInterval)
+	}
+	return v
+}
+
+// Archive moves away the named file to a version archive and thins out its
+// versions. If this function returns nil, the named file does not exist
+// any more (has been archived).
+func (v *Staggered) Archive(path string) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	dir, err := archive(v.fs, v.repoDir, v.versionsDir, path)
+	if err != nil || dir == "" {
+		return err
+	}
+
+	versions, err := v.fs.Glob(filepath.Join(dir, filepath.Base(path)+"~*"))
+	if err != nil {
+		l.Warnln(err)
+		return nil
+	}
+	v.expire(versions)
+	return nil
+}
+
+// Stop stops the periodic cleaning. It must be called at most once.
+func (v *Staggered) Stop() {
+	close(v.stop)
+}
+
+func (v *Staggered) cleaner(intv time.Duration) {
+	for {
+		select {
+		case <-time.After(intv):
+			v.clean()
+		case <-v.stop:
+			return
+		}
+	}
+}
+
+// clean thins out the versions of all files in the repository.
+func (v *Staggered) clean() {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	root := v.repoDir
+	if IsVersionsPath(v.versionsDir) {
+		root = v.versionsDir
+		if !filepath.IsAbs(root) {
+			root = filepath.Join(v.repoDir, root)
+		}
+	}
+
+	files := make(map[string][]string) // the file versioned -> its versions
+	v.fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		if !IsVersionsPath(v.versionsDir) && filepath.Base(filepath.Dir(path)) != v.versionsDir {
+			return nil
+		}
+		if i := strings.LastIndex(path, "~"); i > len(filepath.Dir(path)) {
+			files[path[:i]] = append(files[path[:i]], path)
+		}
+		return nil
+	})
+
+	for _, versions := range files {
+		v.expire(versions)
+	}
+}
+
+// expire removes the versions of a file that are too old, or too close in
+// age to an older version kept.
+func (v *Staggered) expire(versions []string) {
+	sort.Sort(versionList(versions))
+	now := v.now()
+
+	var prevAge time.Duration
+	first := true
+	for _, version := range versions {
+		stamp, _ := versionTime(version)
+		t, err := time.ParseInLocation(versionTimeFormat, stamp, time.Local)
+		if err != nil {
+			// Not a version
+			continue
+		}
+		age := now.Sub(t)
+
+		if v.maxAge > 0 && age > v.maxAge {
+			v.remove(version)
+			continue
+		}
+
+		if first {
+			first = false
+			prevAge = age
+			continue
+		}
+
+		iv := staggeredIntervals[len(staggeredIntervals)-1]
+		for _, i := range staggeredIntervals {
+			if age < i.end {
+				iv = i
+				break
+			}
+		}
+		if prevAge-age < iv.step {
+			v.remove(version)
+			continue
+		}
+		prevAge = age
+	}
+}
+
+func (v *Staggered) remove(version string) {
+	if debug {
+		l.Debugln("expiring", version)
+	}
+	if err := v.fs.Remove(version); err != nil {
+		l.Warnln(err)
+	}
+}
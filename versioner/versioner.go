@@ -4,8 +4,222 @@
 
 package versioner
 
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+)
+
 type Versioner interface {
 	Archive(path string) error
 }
 
-var Factories = map[string]func(map[string]string) Versioner{}
+// A Stopper is a Versioner that does work in the background, until it is
+// stopped.
+type Stopper interface {
+	Stop()
+}
+
+// Factories holds the constructors for the available versioner types. The
+// constructor is given the repository directory, the Filesystem to operate
+// on and the user supplied parameters.
+var Factories = map[string]func(repoDir string, filesystem fs.Filesystem, params map[string]string) Versioner{}
+
+// DefaultVersionsDir is the name of the directories that versions are kept
+// in, next to the files they are versions of, unless the "versionsDir"
+// parameter says otherwise.
+const DefaultVersionsDir = ".stversions"
+
+// VersionsDir returns the versions directory given by the "versionsDir"
+// parameter. It is either a plain name, used for a versions directory in
+// each directory of the repository, or a path to a single directory that
+// holds the versions for the whole repository in the same structure as
+// the repository itself. A relative path is relative to the repository
+// directory.
+func VersionsDir(params map[string]string) string {
+	if dir := params["versionsDir"]; dir != "" {
+		return filepath.Clean(dir)
+	}
+	return DefaultVersionsDir
+}
+
+// IsVersionsPath returns true if the versions directory is a path to a
+// single directory rather than the name of one in each directory.
+func IsVersionsPath(dir string) bool {
+	return filepath.IsAbs(dir) || strings.ContainsAny(dir, `/\`)
+}
+
+// An Exclusion recognizes the versions directories of a repository, so that
+// they can be left out when scanning it.
+type Exclusion struct {
+	name string // the name of the versions directory in each directory
+	path string // the repository relative path of the single one
+}
+
+// NewExclusion returns the Exclusion for the versions directory given by the
+// parameters. Versions kept outside the repository need no exclusion.
+func NewExclusion(repoDir string, params map[string]string) Exclusion {
+	dir := VersionsDir(params)
+	if !IsVersionsPath(dir) {
+		return Exclusion{name: dir}
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(repoDir, dir)
+	}
+	rel, err := filepath.Rel(repoDir, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return Exclusion{}
+	}
+	return Exclusion{path: rel}
+}
+
+// IsVersionsDir returns true if the path, relative to the repository
+// directory, is a versions directory.
+func (e Exclusion) IsVersionsDir(path string) bool {
+	if e.name != "" {
+		return filepath.Base(path) == e.name
+	}
+	return e.path != "" && path == e.path
+}
+
+// archive moves the file to a new version in the directory its versions
+// are kept in, and returns that directory. It returns the empty string if
+// the file does not exist, so there is nothing to archive.
+func archive(filesystem fs.Filesystem, repoDir, versionsDir, path string) (string, error) {
+	_, err := filesystem.Stat(path)
+	if err != nil && os.IsNotExist(err) {
+		return "", nil
+	}
+
+	if debug {
+		l.Debugln("archiving", path)
+	}
+
+	file := filepath.Base(path)
+	dir, err := archiveDir(repoDir, versionsDir, path)
+	if err != nil {
+		return "", err
+	}
+	err = filesystem.MkdirAll(dir, 0755)
+	if err != nil && !os.IsExist(err) {
+		return "", err
+	} else if !IsVersionsPath(versionsDir) {
+		filesystem.Hide(dir)
+	}
+
+	ver := file + "~" + time.Now().Format(versionTimeFormat)
+	if same, _ := filesystem.Glob(filepath.Join(dir, ver+"*")); len(same) > 0 {
+		// Versions archived within the same second are numbered, after
+		// the ones already there
+		n := 0
+		for _, s := range same {
+			if _, c := versionTime(s); c > n {
+				n = c
+			}
+		}
+		ver = fmt.Sprintf("%s-%d", ver, n+1)
+	}
+	if err := move(filesystem, path, filepath.Join(dir, ver)); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// archiveDir returns the directory that versions of the file are kept in.
+func archiveDir(repoDir, versionsDir, path string) (string, error) {
+	if !IsVersionsPath(versionsDir) {
+		return filepath.Join(filepath.Dir(path), versionsDir), nil
+	}
+
+	rel, err := filepath.Rel(repoDir, filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+	base := versionsDir
+	if !filepath.IsAbs(base) {
+		base = filepath.Join(repoDir, base)
+	}
+	return filepath.Join(base, rel), nil
+}
+
+const versionTimeFormat = "20060102-150405"
+
+// versionTime returns the time stamp of the version, as formatted, and its
+// number among the versions archived within the same second.
+func versionTime(name string) (string, int) {
+	stamp := name[strings.LastIndex(name, "~")+1:]
+	if len(stamp) <= len(versionTimeFormat) {
+		return stamp, 0
+	}
+	n, _ := strconv.Atoi(stamp[len(versionTimeFormat)+1:])
+	return stamp[:len(versionTimeFormat)], n
+}
+
+// versionList sorts versions of a file from the oldest to the newest.
+type versionList []string
+
+func (l versionList) Len() int {
+	return len(l)
+}
+
+func (l versionList) Less(a, b int) bool {
+	ta, na := versionTime(l[a])
+	tb, nb := versionTime(l[b])
+	if ta != tb {
+		return ta < tb
+	}
+	return na < nb
+}
+
+func (l versionList) Swap(a, b int) {
+	l[a], l[b] = l[b], l[a]
+}
+
+// move renames the file, or copies and removes it when that fails, which it
+// does when the versions directory is on another device.
+func move(filesystem fs.Filesystem, from, to string) error {
+	err := filesystem.Rename(from, to)
+	if err == nil {
+		return nil
+	}
+
+	info, serr := filesystem.Stat(from)
+	if serr != nil || !info.Mode().IsRegular() {
+		return err
+	}
+	if debug {
+		l.Debugf("rename %q: %v; copying", from, err)
+	}
+
+	src, err := filesystem.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := filesystem.Create(to)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		filesystem.Remove(to)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		filesystem.Remove(to)
+		return err
+	}
+	filesystem.Chmod(to, info.Mode())
+	filesystem.Chtimes(to, info.ModTime(), info.ModTime())
+	src.Close()
+	if err := filesystem.Remove(from); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
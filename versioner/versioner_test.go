@@ -0,0 +1,171 @@
+// Copyright (C) 2014 Jakob Borg and other contributors. All rights reserved.
+// Use of this source code is governed by an MIT-style license that can be
+// found in the LICENSE file.
+
+package versioner
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/calmh/syncthing/fs"
+)
+
+func TestExclusion(t *testing.T) {
+	var tests = []struct {
+		dir string
+		rn  string
+		r   bool
+	}{
+		{"", ".stversions", true},
+		{"", "a/.stversions", true},
+		{"", "a/b", false},
+		{"old", "a/old", true},
+		{"old", "a/.stversions", false},
+		{"backup/versions", "backup/versions", true},
+		{"backup/versions", "a/backup/versions", false},
+		{"backup/versions", "versions", false},
+		{"/repo/versions", "versions", true},
+		{"/repo/versions", "a/versions", false},
+		{"/elsewhere", "elsewhere", false},
+		{"../elsewhere", "elsewhere", false},
+	}
+
+	for i, tc := range tests {
+		e := NewExclusion("/repo", map[string]string{"versionsDir": filepath.FromSlash(tc.dir)})
+		if r := e.IsVersionsDir(filepath.FromSlash(tc.rn)); r != tc.r {
+			t.Errorf("Incorrect IsVersionsDir() #%d; E: %v, A: %v", i, tc.r, r)
+		}
+	}
+}
+
+func TestSimpleVersionsPath(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll(filepath.FromSlash("repo/a"), 0755)
+	for _, n := range []string{"repo/a/file", "repo/top"} {
+		fd, _ := f.Create(filepath.FromSlash(n))
+		fd.Write([]byte(n))
+		fd.Close()
+	}
+	// A rename to another device fails; the file is copied instead
+	f.InjectError(fs.OpRename, filepath.FromSlash("repo/top"), syscall.EXDEV)
+
+	v := NewSimple("repo", f, map[string]string{"versionsDir": filepath.FromSlash("versions/old")})
+	for _, n := range []string{"repo/a/file", "repo/top"} {
+		if err := v.Archive(filepath.FromSlash(n)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Stat(filepath.FromSlash(n)); err == nil {
+			t.Errorf("%s still exists after archiving", n)
+		}
+	}
+
+	for _, pat := range []string{"repo/versions/old/a/file~*", "repo/versions/old/top~*"} {
+		if vs, _ := f.Glob(filepath.FromSlash(pat)); len(vs) != 1 {
+			t.Errorf("Unexpected versions for %s: %v", pat, vs)
+		}
+	}
+}
+
+func TestSimpleKeep(t *testing.T) {
+	f := fs.NewFakeFilesystem()
+	f.MkdirAll("repo", 0755)
+	name := filepath.FromSlash("repo/file")
+
+	v := NewSimple("repo", f, map[string]string{"keep": "2"})
+	for _, data := range []string{"one", "two", "three", "four"} {
+		fd, _ := f.Create(name)
+		fd.Write([]byte(data))
+		fd.Close()
+		if err := v.Archive(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, _ := f.Glob(filepath.FromSlash("repo/.stversions/file~*"))
+	if len(versions) != 2 {
+		t.Fatalf("Unexpected versions %v", versions)
+	}
+	sort.Sort(versionList(versions))
+	for i, data := range []string{"three", "four"} {
+		fd, err := f.Open(versions[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		bs, _ := ioutil.ReadAll(fd)
+		fd.Close()
+		if string(bs) != data {
+			t.Errorf("Version %s contains %q, expected %q", versions[i], bs, data)
+		}
+	}
+}
+
+func TestVersionList(t *testing.T) {
+	versions := []string{
+		"a/file~20141016-150405-10",
+		"a/file~20141016-150406",
+		"a/file~20141016-150405-9",
+		"a/file~20141016-150405",
+	}
+	sort.Sort(versionList(versions))
+	expected := []string{
+		"a/file~20141016-150405",
+		"a/file~20141016-150405-9",
+		"a/file~20141016-150405-10",
+		"a/file~20141016-150406",
+	}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Errorf("Unexpected order %v", versions)
+	}
+}
+
+func TestStaggeredExpire(t *testing.T) {
+	now := time.Date(2014, 10, 16, 12, 0, 0, 0, time.Local)
+	var tests = []struct {
+		age  time.Duration
+		kept bool
+	}{
+		{400 * 24 * time.Hour, false}, // past the maximum age
+		{40 * 24 * time.Hour, true},
+		{36 * 24 * time.Hour, false}, // within a week of the one above
+		{32 * 24 * time.Hour, true},
+		{10 * 24 * time.Hour, true},
+		{9*24*time.Hour + 12*time.Hour, false}, // within a day of the one above
+		{5 * time.Hour, true},
+		{4*time.Hour + 30*time.Minute, false}, // within an hour of the one above
+		{3 * time.Hour, true},
+		{30 * time.Minute, true},
+		{29 * time.Minute, true},
+		{time.Minute, true},
+	}
+
+	f := fs.NewFakeFilesystem()
+	dir := filepath.FromSlash("repo/a/.stversions")
+	f.MkdirAll(dir, 0755)
+	for _, tc := range tests {
+		fd, _ := f.Create(filepath.Join(dir, "file~"+now.Add(-tc.age).Format(versionTimeFormat)))
+		fd.Close()
+	}
+	// Not in a versions directory, so not a version
+	fd, _ := f.Create(filepath.FromSlash("repo/a/file~20010101-000000"))
+	fd.Close()
+
+	v := NewStaggered("repo", f, map[string]string{"cleanInterval": "0"}).(*Staggered)
+	v.now = func() time.Time { return now }
+	v.clean()
+
+	for _, tc := range tests {
+		name := filepath.Join(dir, "file~"+now.Add(-tc.age).Format(versionTimeFormat))
+		if _, err := f.Stat(name); (err == nil) != tc.kept {
+			t.Errorf("Version of age %v kept: %v, expected %v", tc.age, err == nil, tc.kept)
+		}
+	}
+	if _, err := f.Stat(filepath.FromSlash("repo/a/file~20010101-000000")); err != nil {
+		t.Error("File outside the versions directory removed")
+	}
+}